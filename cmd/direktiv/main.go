@@ -3,6 +3,7 @@ package main
 import (
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
@@ -19,8 +20,11 @@ import (
 
 	"github.com/vorteil/direktiv/pkg/direktiv"
 	"github.com/vorteil/direktiv/pkg/dlog"
-	"github.com/vorteil/direktiv/pkg/dlog/db"
-	"github.com/vorteil/direktiv/pkg/dlog/dummy"
+	_ "github.com/vorteil/direktiv/pkg/dlog/cloudwatch"
+	_ "github.com/vorteil/direktiv/pkg/dlog/db"
+	_ "github.com/vorteil/direktiv/pkg/dlog/dummy"
+	_ "github.com/vorteil/direktiv/pkg/dlog/elasticsearch"
+	_ "github.com/vorteil/direktiv/pkg/dlog/loki"
 )
 
 var (
@@ -71,43 +75,72 @@ var rootCmd = &cobra.Command{
 			log.Fatalf("failed to create server: %v", err)
 		}
 
-		var logger dlog.Log
+		logger, closeLogger, err := newInstanceLogger(c.InstanceLogging.Driver, c.InstanceLogging.Options,
+			c.Database.DB, c.Database.Replica)
+		if err != nil {
+			logrus.Error(err)
+			os.Exit(1)
+		}
+		defer closeLogger()
+
+		var sinks []dlog.Log
+		for _, s := range c.InstanceLogging.Sinks {
 
-		switch c.InstanceLogging.Driver {
-		case "database":
-			logrus.Info("creating logger type database")
-			l, err := db.NewLogger(c.Database.DB)
+			sink, closeSink, err := newInstanceLogger(s.Driver, s.Options, c.Database.DB, c.Database.Replica)
 			if err != nil {
 				logrus.Error(err)
 				os.Exit(1)
 			}
-			defer l.CloseConnection()
-			logger = l
-		default:
-			logrus.Info("creating logger type default")
-			logger, _ = dummy.NewLogger()
+			defer closeSink()
+
+			sinks = append(sinks, sink)
+
 		}
 
-		server.SetInstanceLogger(logger)
+		server.SetInstanceLogger(dlog.NewFanout(logger, sinks...))
+
+		vstore, err := newVariableStorage(c.VariablesStorage.Driver, c.Database.DB)
+		if err != nil {
+			logrus.Error(err)
+			os.Exit(1)
+		}
+		defer vstore.Close()
+
+		server.SetVariableStorage(vstore)
+
+		for _, r := range c.StorageRegions {
 
-		var vstore varstore.VarStorage
+			logrus.Infof("creating storage of region %s", r.Name)
 
-		switch c.VariablesStorage.Driver {
-		case "":
-			fallthrough
-		case "database":
-			vstore, err = varstore.NewPostgresVarStorage(c.Database.DB)
+			// logs of pinned namespaces must not leave their region, so they
+			// only go to the region's database, and nowhere unless the
+			// database is the logging driver
+			driver := c.InstanceLogging.Driver
+			if driver != "database" {
+				driver = "dummy"
+			}
+
+			logger, closeLogger, err := newInstanceLogger(driver, nil, r.Database, r.Replica)
+			if err != nil {
+				logrus.Error(err)
+				os.Exit(1)
+			}
+			defer closeLogger()
+
+			vstore, err := newVariableStorage(c.VariablesStorage.Driver, r.Database)
 			if err != nil {
 				logrus.Error(err)
 				os.Exit(1)
 			}
 			defer vstore.Close()
-		default:
-			logrus.Error(errors.New("unsupported variables storage driver"))
-			os.Exit(1)
-		}
 
-		server.SetVariableStorage(vstore)
+			err = server.SetStorageRegion(r.Name, logger, vstore)
+			if err != nil {
+				logrus.Error(err)
+				os.Exit(1)
+			}
+
+		}
 
 		go func() {
 			sig := make(chan os.Signal, 1)
@@ -134,6 +167,54 @@ var rootCmd = &cobra.Command{
 	},
 }
 
+// newInstanceLogger creates an instance logger of the log sink driver with
+// options, and returns a function to close it. The database sink writes to
+// database unless its options say otherwise.
+func newInstanceLogger(driver string, options map[string]string, database, replica string) (dlog.Log, func() error, error) {
+
+	if driver == "" {
+		driver = "dummy"
+	}
+
+	opts := make(map[string]string)
+	if driver == "database" {
+		opts["database"] = database
+		opts["replica"] = replica
+	}
+
+	for k, v := range options {
+		opts[k] = v
+	}
+
+	logrus.Infof("creating logger type %s", driver)
+
+	l, err := dlog.NewSink(driver, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can not create logger type %s: %v", driver, err)
+	}
+
+	closer := func() error { return nil }
+	if c, ok := l.(io.Closer); ok {
+		closer = c.Close
+	}
+
+	return l, closer, nil
+
+}
+
+func newVariableStorage(driver, database string) (varstore.VarStorage, error) {
+
+	switch driver {
+	case "":
+		fallthrough
+	case "database":
+		return varstore.NewPostgresVarStorage(database)
+	default:
+		return nil, errors.New("unsupported variables storage driver")
+	}
+
+}
+
 func main() {
 
 	rootCmd.Flags().BoolVarP(&debug, "debug", "d", false, "enabled debug output")
@@ -23,6 +23,13 @@ import (
 
 const (
 	workerThreads = 10
+
+	// defaultCancelGracePeriod applies when a cancel doesn't specify one
+	defaultCancelGracePeriod = 10 * time.Second
+
+	// maxFinishedRequests is how many finished requests are remembered, so
+	// that cancels arriving after a request finished are still confirmed.
+	maxFinishedRequests = 256
 )
 
 type LocalServer struct {
@@ -34,8 +41,10 @@ type LocalServer struct {
 	server  http.Server
 	workers []*inboundWorker
 
-	requestsLock sync.Mutex
-	requests     map[string]*activeRequest
+	requestsLock  sync.Mutex
+	requests      map[string]*activeRequest
+	finished      map[string]*isolateRequest
+	finishedOrder []string
 }
 
 func (srv *LocalServer) initFlow() error {
@@ -44,7 +53,7 @@ func (srv *LocalServer) initFlow() error {
 
 	log.Infof("Connecting to flow: %s.", flowAddr)
 
-	conn, err := direktiv.GetEndpointTLS(flowAddr, true)
+	conn, err := direktiv.GetEndpointPool(flowAddr, true)
 	if err != nil {
 		return err
 	}
@@ -73,8 +82,20 @@ func (srv *LocalServer) initPubSub() error {
 
 func (srv *LocalServer) handlePubSubCancel(in interface{}) {
 
-	actionId, ok := in.(string)
-	if !ok {
+	var actionId string
+	grace := defaultCancelGracePeriod
+
+	switch x := in.(type) {
+	case string:
+		actionId = x
+	case map[string]interface{}:
+		actionId, _ = x["ActionID"].(string)
+		if f, ok := x["GracePeriod"].(float64); ok {
+			grace = time.Duration(f) * time.Second
+		}
+	}
+
+	if actionId == "" {
 		log.Errorf("cancel data %v not valid", in)
 		return
 	}
@@ -82,7 +103,25 @@ func (srv *LocalServer) handlePubSubCancel(in interface{}) {
 
 	// TODO: do we need to find a better way to cancel requests that come late off the queue?
 
-	srv.cancelActiveRequest(context.Background(), actionId)
+	ir := srv.cancelActiveRequest(context.Background(), actionId, grace)
+	if ir == nil {
+		// flow waits for requests that finished before the cancel arrived
+		ir = srv.finishedRequest(actionId)
+	}
+	if ir == nil {
+		return
+	}
+
+	step := int32(ir.step)
+
+	_, err := srv.flow.ActionCancelled(context.Background(), &flow.ActionCancelledRequest{
+		InstanceId: &ir.instanceId,
+		Step:       &step,
+		ActionId:   &ir.actionId,
+	})
+	if err != nil {
+		log.Errorf("Failed to confirm cancellation of '%s': %v.", actionId, err)
+	}
 
 }
 
@@ -104,10 +143,12 @@ func (srv *LocalServer) Start() {
 
 	srv.queue = make(chan *inboundRequest, 100)
 	srv.requests = make(map[string]*activeRequest)
+	srv.finished = make(map[string]*isolateRequest)
 
 	srv.router = mux.NewRouter()
 	srv.router.HandleFunc("/log", srv.logHandler)
 	srv.router.HandleFunc("/var", srv.varHandler)
+	srv.router.HandleFunc("/heartbeat", srv.heartbeatHandler)
 
 	srv.server.Addr = "127.0.0.1:8889"
 	srv.server.Handler = srv.router
@@ -227,6 +268,71 @@ func (srv *LocalServer) logHandler(w http.ResponseWriter, r *http.Request) {
 
 }
 
+func (srv *LocalServer) heartbeatHandler(w http.ResponseWriter, r *http.Request) {
+
+	actionId := r.URL.Query().Get("aid")
+
+	srv.requestsLock.Lock()
+	req, _ := srv.requests[actionId]
+	srv.requestsLock.Unlock()
+
+	reportError := func(code int, err error) {
+		http.Error(w, err.Error(), code)
+		log.Warnf("Heartbeat handler for '%s' returned %v: %v.", actionId, code, err)
+		return
+	}
+
+	if req == nil {
+		code := http.StatusNotFound
+		reportError(code, fmt.Errorf("actionId %s not found", actionId))
+		return
+	}
+
+	var progress string
+
+	if r.Method == http.MethodPost {
+
+		cap := int64(0x1000) // 4 KiB
+		if r.ContentLength > cap {
+			code := http.StatusRequestEntityTooLarge
+			reportError(code, errors.New(http.StatusText(code)))
+			return
+		}
+		r := io.LimitReader(r.Body, cap)
+
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			code := http.StatusBadRequest
+			reportError(code, err)
+			return
+		}
+
+		progress = string(data)
+
+	} else {
+
+		progress = r.URL.Query().Get("progress")
+
+	}
+
+	step := int32(req.step)
+
+	_, err := srv.flow.ActionHeartbeat(req.ctx, &flow.ActionHeartbeatRequest{
+		InstanceId: &req.instanceId,
+		Step:       &step,
+		ActionId:   &req.actionId,
+		Progress:   &progress,
+	})
+	if err != nil {
+		code := http.StatusInternalServerError
+		reportError(code, err)
+		return
+	}
+
+	log.Debugf("Heartbeat handler for '%s' reported progress: %s.", actionId, progress)
+
+}
+
 func (srv *LocalServer) varHandler(w http.ResponseWriter, r *http.Request) {
 
 	actionId := r.URL.Query().Get("aid")
@@ -314,6 +420,15 @@ func (srv *LocalServer) deregisterActiveRequest(actionId string) {
 
 	srv.requestsLock.Lock()
 
+	if req, ok := srv.requests[actionId]; ok {
+		srv.finished[actionId] = req.isolateRequest
+		srv.finishedOrder = append(srv.finishedOrder, actionId)
+		if len(srv.finishedOrder) > maxFinishedRequests {
+			delete(srv.finished, srv.finishedOrder[0])
+			srv.finishedOrder = srv.finishedOrder[1:]
+		}
+	}
+
 	delete(srv.requests, actionId)
 
 	srv.requestsLock.Unlock()
@@ -322,7 +437,20 @@ func (srv *LocalServer) deregisterActiveRequest(actionId string) {
 
 }
 
-func (srv *LocalServer) cancelActiveRequest(ctx context.Context, actionId string) {
+// finishedRequest returns a request that finished recently, or nil.
+func (srv *LocalServer) finishedRequest(actionId string) *isolateRequest {
+
+	srv.requestsLock.Lock()
+	defer srv.requestsLock.Unlock()
+
+	return srv.finished[actionId]
+
+}
+
+// cancelActiveRequest asks the service to cancel the request, killing it if it
+// hasn't stopped within the grace period. It returns the request, or nil if
+// the request isn't being served here.
+func (srv *LocalServer) cancelActiveRequest(ctx context.Context, actionId string, grace time.Duration) *isolateRequest {
 
 	srv.requestsLock.Lock()
 
@@ -331,7 +459,7 @@ func (srv *LocalServer) cancelActiveRequest(ctx context.Context, actionId string
 	srv.requestsLock.Unlock()
 
 	if req == nil {
-		return
+		return nil
 	}
 
 	log.Infof("Attempting to cancel '%s'.", actionId)
@@ -340,11 +468,13 @@ func (srv *LocalServer) cancelActiveRequest(ctx context.Context, actionId string
 
 	select {
 	case <-req.ctx.Done():
-	case <-time.After(10 * time.Second):
+	case <-time.After(grace):
 		log.Warnf("Request '%s' failed to cancel punctually.", actionId)
 		req.cancel()
 	}
 
+	return req.isolateRequest
+
 }
 
 func (srv *LocalServer) sendCancelToService(ctx context.Context, ir *isolateRequest) {
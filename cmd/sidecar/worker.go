@@ -21,6 +21,7 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/direktiv"
 	"github.com/vorteil/direktiv/pkg/flow"
 )
 
@@ -424,7 +425,7 @@ func (worker *inboundWorker) handleIsolateRequest(req *inboundRequest) {
 		select {
 		case <-rctx.Done():
 		case <-ctx.Done():
-			worker.srv.cancelActiveRequest(rctx, ir.actionId)
+			worker.srv.cancelActiveRequest(rctx, ir.actionId, defaultCancelGracePeriod)
 		}
 	}()
 
@@ -573,7 +574,7 @@ func (worker *inboundWorker) respondToFlow(ctx context.Context, ir *isolateReque
 
 	step := int32(ir.step)
 
-	_, err := worker.srv.flow.ReportActionResults(ctx, &flow.ReportActionResultsRequest{
+	err := direktiv.ReportActionResults(ctx, worker.srv.flow, &flow.ReportActionResultsRequest{
 		InstanceId:   &ir.instanceId,
 		Step:         &step,
 		ActionId:     &ir.actionId,
@@ -10,11 +10,25 @@ import (
 	"github.com/google/uuid"
 	"github.com/vorteil/direktiv/ent/migrate"
 
+	"github.com/vorteil/direktiv/ent/eventcounter"
+	"github.com/vorteil/direktiv/ent/instancehistory"
+	"github.com/vorteil/direktiv/ent/jqmodule"
+	"github.com/vorteil/direktiv/ent/jsonschema"
+	"github.com/vorteil/direktiv/ent/locklease"
 	"github.com/vorteil/direktiv/ent/namespace"
+	"github.com/vorteil/direktiv/ent/node"
+	"github.com/vorteil/direktiv/ent/queuedinvocation"
+	"github.com/vorteil/direktiv/ent/queuedstate"
+	"github.com/vorteil/direktiv/ent/scheduledinvocation"
+	"github.com/vorteil/direktiv/ent/setting"
+	"github.com/vorteil/direktiv/ent/timer"
+	"github.com/vorteil/direktiv/ent/unmatchedevent"
+	"github.com/vorteil/direktiv/ent/wasmmodule"
 	"github.com/vorteil/direktiv/ent/workflow"
 	"github.com/vorteil/direktiv/ent/workflowevents"
 	"github.com/vorteil/direktiv/ent/workfloweventswait"
 	"github.com/vorteil/direktiv/ent/workflowinstance"
+	"github.com/vorteil/direktiv/ent/workflowrevision"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -26,8 +40,34 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// EventCounter is the client for interacting with the EventCounter builders.
+	EventCounter *EventCounterClient
+	// InstanceHistory is the client for interacting with the InstanceHistory builders.
+	InstanceHistory *InstanceHistoryClient
+	// JQModule is the client for interacting with the JQModule builders.
+	JQModule *JQModuleClient
+	// JSONSchema is the client for interacting with the JSONSchema builders.
+	JSONSchema *JSONSchemaClient
+	// LockLease is the client for interacting with the LockLease builders.
+	LockLease *LockLeaseClient
 	// Namespace is the client for interacting with the Namespace builders.
 	Namespace *NamespaceClient
+	// Node is the client for interacting with the Node builders.
+	Node *NodeClient
+	// QueuedInvocation is the client for interacting with the QueuedInvocation builders.
+	QueuedInvocation *QueuedInvocationClient
+	// QueuedState is the client for interacting with the QueuedState builders.
+	QueuedState *QueuedStateClient
+	// ScheduledInvocation is the client for interacting with the ScheduledInvocation builders.
+	ScheduledInvocation *ScheduledInvocationClient
+	// Setting is the client for interacting with the Setting builders.
+	Setting *SettingClient
+	// Timer is the client for interacting with the Timer builders.
+	Timer *TimerClient
+	// UnmatchedEvent is the client for interacting with the UnmatchedEvent builders.
+	UnmatchedEvent *UnmatchedEventClient
+	// WasmModule is the client for interacting with the WasmModule builders.
+	WasmModule *WasmModuleClient
 	// Workflow is the client for interacting with the Workflow builders.
 	Workflow *WorkflowClient
 	// WorkflowEvents is the client for interacting with the WorkflowEvents builders.
@@ -36,6 +76,8 @@ type Client struct {
 	WorkflowEventsWait *WorkflowEventsWaitClient
 	// WorkflowInstance is the client for interacting with the WorkflowInstance builders.
 	WorkflowInstance *WorkflowInstanceClient
+	// WorkflowRevision is the client for interacting with the WorkflowRevision builders.
+	WorkflowRevision *WorkflowRevisionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -49,11 +91,25 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.EventCounter = NewEventCounterClient(c.config)
+	c.InstanceHistory = NewInstanceHistoryClient(c.config)
+	c.JQModule = NewJQModuleClient(c.config)
+	c.JSONSchema = NewJSONSchemaClient(c.config)
+	c.LockLease = NewLockLeaseClient(c.config)
 	c.Namespace = NewNamespaceClient(c.config)
+	c.Node = NewNodeClient(c.config)
+	c.QueuedInvocation = NewQueuedInvocationClient(c.config)
+	c.QueuedState = NewQueuedStateClient(c.config)
+	c.ScheduledInvocation = NewScheduledInvocationClient(c.config)
+	c.Setting = NewSettingClient(c.config)
+	c.Timer = NewTimerClient(c.config)
+	c.UnmatchedEvent = NewUnmatchedEventClient(c.config)
+	c.WasmModule = NewWasmModuleClient(c.config)
 	c.Workflow = NewWorkflowClient(c.config)
 	c.WorkflowEvents = NewWorkflowEventsClient(c.config)
 	c.WorkflowEventsWait = NewWorkflowEventsWaitClient(c.config)
 	c.WorkflowInstance = NewWorkflowInstanceClient(c.config)
+	c.WorkflowRevision = NewWorkflowRevisionClient(c.config)
 }
 
 // Open opens a database/sql.DB specified by the driver name and
@@ -85,13 +141,27 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                ctx,
-		config:             cfg,
-		Namespace:          NewNamespaceClient(cfg),
-		Workflow:           NewWorkflowClient(cfg),
-		WorkflowEvents:     NewWorkflowEventsClient(cfg),
-		WorkflowEventsWait: NewWorkflowEventsWaitClient(cfg),
-		WorkflowInstance:   NewWorkflowInstanceClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		EventCounter:        NewEventCounterClient(cfg),
+		InstanceHistory:     NewInstanceHistoryClient(cfg),
+		JQModule:            NewJQModuleClient(cfg),
+		JSONSchema:          NewJSONSchemaClient(cfg),
+		LockLease:           NewLockLeaseClient(cfg),
+		Namespace:           NewNamespaceClient(cfg),
+		Node:                NewNodeClient(cfg),
+		QueuedInvocation:    NewQueuedInvocationClient(cfg),
+		QueuedState:         NewQueuedStateClient(cfg),
+		ScheduledInvocation: NewScheduledInvocationClient(cfg),
+		Setting:             NewSettingClient(cfg),
+		Timer:               NewTimerClient(cfg),
+		UnmatchedEvent:      NewUnmatchedEventClient(cfg),
+		WasmModule:          NewWasmModuleClient(cfg),
+		Workflow:            NewWorkflowClient(cfg),
+		WorkflowEvents:      NewWorkflowEventsClient(cfg),
+		WorkflowEventsWait:  NewWorkflowEventsWaitClient(cfg),
+		WorkflowInstance:    NewWorkflowInstanceClient(cfg),
+		WorkflowRevision:    NewWorkflowRevisionClient(cfg),
 	}, nil
 }
 
@@ -109,126 +179,1191 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		config:             cfg,
-		Namespace:          NewNamespaceClient(cfg),
-		Workflow:           NewWorkflowClient(cfg),
-		WorkflowEvents:     NewWorkflowEventsClient(cfg),
-		WorkflowEventsWait: NewWorkflowEventsWaitClient(cfg),
-		WorkflowInstance:   NewWorkflowInstanceClient(cfg),
+		config:              cfg,
+		EventCounter:        NewEventCounterClient(cfg),
+		InstanceHistory:     NewInstanceHistoryClient(cfg),
+		JQModule:            NewJQModuleClient(cfg),
+		JSONSchema:          NewJSONSchemaClient(cfg),
+		LockLease:           NewLockLeaseClient(cfg),
+		Namespace:           NewNamespaceClient(cfg),
+		Node:                NewNodeClient(cfg),
+		QueuedInvocation:    NewQueuedInvocationClient(cfg),
+		QueuedState:         NewQueuedStateClient(cfg),
+		ScheduledInvocation: NewScheduledInvocationClient(cfg),
+		Setting:             NewSettingClient(cfg),
+		Timer:               NewTimerClient(cfg),
+		UnmatchedEvent:      NewUnmatchedEventClient(cfg),
+		WasmModule:          NewWasmModuleClient(cfg),
+		Workflow:            NewWorkflowClient(cfg),
+		WorkflowEvents:      NewWorkflowEventsClient(cfg),
+		WorkflowEventsWait:  NewWorkflowEventsWaitClient(cfg),
+		WorkflowInstance:    NewWorkflowInstanceClient(cfg),
+		WorkflowRevision:    NewWorkflowRevisionClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Namespace.
+//		EventCounter.
 //		Query().
 //		Count(ctx)
-//
 func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
 	}
-	cfg := c.config
-	cfg.driver = dialect.Debug(c.driver, c.log)
-	client := &Client{config: cfg}
-	client.init()
-	return client
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+func (c *Client) Close() error {
+	return c.driver.Close()
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	c.EventCounter.Use(hooks...)
+	c.InstanceHistory.Use(hooks...)
+	c.JQModule.Use(hooks...)
+	c.JSONSchema.Use(hooks...)
+	c.LockLease.Use(hooks...)
+	c.Namespace.Use(hooks...)
+	c.Node.Use(hooks...)
+	c.QueuedInvocation.Use(hooks...)
+	c.QueuedState.Use(hooks...)
+	c.ScheduledInvocation.Use(hooks...)
+	c.Setting.Use(hooks...)
+	c.Timer.Use(hooks...)
+	c.UnmatchedEvent.Use(hooks...)
+	c.WasmModule.Use(hooks...)
+	c.Workflow.Use(hooks...)
+	c.WorkflowEvents.Use(hooks...)
+	c.WorkflowEventsWait.Use(hooks...)
+	c.WorkflowInstance.Use(hooks...)
+	c.WorkflowRevision.Use(hooks...)
+}
+
+// EventCounterClient is a client for the EventCounter schema.
+type EventCounterClient struct {
+	config
+}
+
+// NewEventCounterClient returns a client for the EventCounter from the given config.
+func NewEventCounterClient(c config) *EventCounterClient {
+	return &EventCounterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `eventcounter.Hooks(f(g(h())))`.
+func (c *EventCounterClient) Use(hooks ...Hook) {
+	c.hooks.EventCounter = append(c.hooks.EventCounter, hooks...)
+}
+
+// Create returns a create builder for EventCounter.
+func (c *EventCounterClient) Create() *EventCounterCreate {
+	mutation := newEventCounterMutation(c.config, OpCreate)
+	return &EventCounterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EventCounter entities.
+func (c *EventCounterClient) CreateBulk(builders ...*EventCounterCreate) *EventCounterCreateBulk {
+	return &EventCounterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EventCounter.
+func (c *EventCounterClient) Update() *EventCounterUpdate {
+	mutation := newEventCounterMutation(c.config, OpUpdate)
+	return &EventCounterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventCounterClient) UpdateOne(ec *EventCounter) *EventCounterUpdateOne {
+	mutation := newEventCounterMutation(c.config, OpUpdateOne, withEventCounter(ec))
+	return &EventCounterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventCounterClient) UpdateOneID(id int) *EventCounterUpdateOne {
+	mutation := newEventCounterMutation(c.config, OpUpdateOne, withEventCounterID(id))
+	return &EventCounterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EventCounter.
+func (c *EventCounterClient) Delete() *EventCounterDelete {
+	mutation := newEventCounterMutation(c.config, OpDelete)
+	return &EventCounterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *EventCounterClient) DeleteOne(ec *EventCounter) *EventCounterDeleteOne {
+	return c.DeleteOneID(ec.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *EventCounterClient) DeleteOneID(id int) *EventCounterDeleteOne {
+	builder := c.Delete().Where(eventcounter.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventCounterDeleteOne{builder}
+}
+
+// Query returns a query builder for EventCounter.
+func (c *EventCounterClient) Query() *EventCounterQuery {
+	return &EventCounterQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a EventCounter entity by its id.
+func (c *EventCounterClient) Get(ctx context.Context, id int) (*EventCounter, error) {
+	return c.Query().Where(eventcounter.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventCounterClient) GetX(ctx context.Context, id int) *EventCounter {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EventCounterClient) Hooks() []Hook {
+	return c.hooks.EventCounter
+}
+
+// InstanceHistoryClient is a client for the InstanceHistory schema.
+type InstanceHistoryClient struct {
+	config
+}
+
+// NewInstanceHistoryClient returns a client for the InstanceHistory from the given config.
+func NewInstanceHistoryClient(c config) *InstanceHistoryClient {
+	return &InstanceHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `instancehistory.Hooks(f(g(h())))`.
+func (c *InstanceHistoryClient) Use(hooks ...Hook) {
+	c.hooks.InstanceHistory = append(c.hooks.InstanceHistory, hooks...)
+}
+
+// Create returns a create builder for InstanceHistory.
+func (c *InstanceHistoryClient) Create() *InstanceHistoryCreate {
+	mutation := newInstanceHistoryMutation(c.config, OpCreate)
+	return &InstanceHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InstanceHistory entities.
+func (c *InstanceHistoryClient) CreateBulk(builders ...*InstanceHistoryCreate) *InstanceHistoryCreateBulk {
+	return &InstanceHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InstanceHistory.
+func (c *InstanceHistoryClient) Update() *InstanceHistoryUpdate {
+	mutation := newInstanceHistoryMutation(c.config, OpUpdate)
+	return &InstanceHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InstanceHistoryClient) UpdateOne(ih *InstanceHistory) *InstanceHistoryUpdateOne {
+	mutation := newInstanceHistoryMutation(c.config, OpUpdateOne, withInstanceHistory(ih))
+	return &InstanceHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InstanceHistoryClient) UpdateOneID(id int) *InstanceHistoryUpdateOne {
+	mutation := newInstanceHistoryMutation(c.config, OpUpdateOne, withInstanceHistoryID(id))
+	return &InstanceHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InstanceHistory.
+func (c *InstanceHistoryClient) Delete() *InstanceHistoryDelete {
+	mutation := newInstanceHistoryMutation(c.config, OpDelete)
+	return &InstanceHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *InstanceHistoryClient) DeleteOne(ih *InstanceHistory) *InstanceHistoryDeleteOne {
+	return c.DeleteOneID(ih.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *InstanceHistoryClient) DeleteOneID(id int) *InstanceHistoryDeleteOne {
+	builder := c.Delete().Where(instancehistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InstanceHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for InstanceHistory.
+func (c *InstanceHistoryClient) Query() *InstanceHistoryQuery {
+	return &InstanceHistoryQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a InstanceHistory entity by its id.
+func (c *InstanceHistoryClient) Get(ctx context.Context, id int) (*InstanceHistory, error) {
+	return c.Query().Where(instancehistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InstanceHistoryClient) GetX(ctx context.Context, id int) *InstanceHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InstanceHistoryClient) Hooks() []Hook {
+	return c.hooks.InstanceHistory
+}
+
+// JQModuleClient is a client for the JQModule schema.
+type JQModuleClient struct {
+	config
+}
+
+// NewJQModuleClient returns a client for the JQModule from the given config.
+func NewJQModuleClient(c config) *JQModuleClient {
+	return &JQModuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jqmodule.Hooks(f(g(h())))`.
+func (c *JQModuleClient) Use(hooks ...Hook) {
+	c.hooks.JQModule = append(c.hooks.JQModule, hooks...)
+}
+
+// Create returns a create builder for JQModule.
+func (c *JQModuleClient) Create() *JQModuleCreate {
+	mutation := newJQModuleMutation(c.config, OpCreate)
+	return &JQModuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JQModule entities.
+func (c *JQModuleClient) CreateBulk(builders ...*JQModuleCreate) *JQModuleCreateBulk {
+	return &JQModuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JQModule.
+func (c *JQModuleClient) Update() *JQModuleUpdate {
+	mutation := newJQModuleMutation(c.config, OpUpdate)
+	return &JQModuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JQModuleClient) UpdateOne(jm *JQModule) *JQModuleUpdateOne {
+	mutation := newJQModuleMutation(c.config, OpUpdateOne, withJQModule(jm))
+	return &JQModuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JQModuleClient) UpdateOneID(id int) *JQModuleUpdateOne {
+	mutation := newJQModuleMutation(c.config, OpUpdateOne, withJQModuleID(id))
+	return &JQModuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JQModule.
+func (c *JQModuleClient) Delete() *JQModuleDelete {
+	mutation := newJQModuleMutation(c.config, OpDelete)
+	return &JQModuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *JQModuleClient) DeleteOne(jm *JQModule) *JQModuleDeleteOne {
+	return c.DeleteOneID(jm.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *JQModuleClient) DeleteOneID(id int) *JQModuleDeleteOne {
+	builder := c.Delete().Where(jqmodule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JQModuleDeleteOne{builder}
+}
+
+// Query returns a query builder for JQModule.
+func (c *JQModuleClient) Query() *JQModuleQuery {
+	return &JQModuleQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a JQModule entity by its id.
+func (c *JQModuleClient) Get(ctx context.Context, id int) (*JQModule, error) {
+	return c.Query().Where(jqmodule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JQModuleClient) GetX(ctx context.Context, id int) *JQModule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JQModuleClient) Hooks() []Hook {
+	return c.hooks.JQModule
+}
+
+// JSONSchemaClient is a client for the JSONSchema schema.
+type JSONSchemaClient struct {
+	config
+}
+
+// NewJSONSchemaClient returns a client for the JSONSchema from the given config.
+func NewJSONSchemaClient(c config) *JSONSchemaClient {
+	return &JSONSchemaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jsonschema.Hooks(f(g(h())))`.
+func (c *JSONSchemaClient) Use(hooks ...Hook) {
+	c.hooks.JSONSchema = append(c.hooks.JSONSchema, hooks...)
+}
+
+// Create returns a create builder for JSONSchema.
+func (c *JSONSchemaClient) Create() *JSONSchemaCreate {
+	mutation := newJSONSchemaMutation(c.config, OpCreate)
+	return &JSONSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JSONSchema entities.
+func (c *JSONSchemaClient) CreateBulk(builders ...*JSONSchemaCreate) *JSONSchemaCreateBulk {
+	return &JSONSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JSONSchema.
+func (c *JSONSchemaClient) Update() *JSONSchemaUpdate {
+	mutation := newJSONSchemaMutation(c.config, OpUpdate)
+	return &JSONSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JSONSchemaClient) UpdateOne(js *JSONSchema) *JSONSchemaUpdateOne {
+	mutation := newJSONSchemaMutation(c.config, OpUpdateOne, withJSONSchema(js))
+	return &JSONSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JSONSchemaClient) UpdateOneID(id int) *JSONSchemaUpdateOne {
+	mutation := newJSONSchemaMutation(c.config, OpUpdateOne, withJSONSchemaID(id))
+	return &JSONSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JSONSchema.
+func (c *JSONSchemaClient) Delete() *JSONSchemaDelete {
+	mutation := newJSONSchemaMutation(c.config, OpDelete)
+	return &JSONSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *JSONSchemaClient) DeleteOne(js *JSONSchema) *JSONSchemaDeleteOne {
+	return c.DeleteOneID(js.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *JSONSchemaClient) DeleteOneID(id int) *JSONSchemaDeleteOne {
+	builder := c.Delete().Where(jsonschema.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JSONSchemaDeleteOne{builder}
+}
+
+// Query returns a query builder for JSONSchema.
+func (c *JSONSchemaClient) Query() *JSONSchemaQuery {
+	return &JSONSchemaQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a JSONSchema entity by its id.
+func (c *JSONSchemaClient) Get(ctx context.Context, id int) (*JSONSchema, error) {
+	return c.Query().Where(jsonschema.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JSONSchemaClient) GetX(ctx context.Context, id int) *JSONSchema {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JSONSchemaClient) Hooks() []Hook {
+	return c.hooks.JSONSchema
+}
+
+// LockLeaseClient is a client for the LockLease schema.
+type LockLeaseClient struct {
+	config
+}
+
+// NewLockLeaseClient returns a client for the LockLease from the given config.
+func NewLockLeaseClient(c config) *LockLeaseClient {
+	return &LockLeaseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `locklease.Hooks(f(g(h())))`.
+func (c *LockLeaseClient) Use(hooks ...Hook) {
+	c.hooks.LockLease = append(c.hooks.LockLease, hooks...)
+}
+
+// Create returns a create builder for LockLease.
+func (c *LockLeaseClient) Create() *LockLeaseCreate {
+	mutation := newLockLeaseMutation(c.config, OpCreate)
+	return &LockLeaseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LockLease entities.
+func (c *LockLeaseClient) CreateBulk(builders ...*LockLeaseCreate) *LockLeaseCreateBulk {
+	return &LockLeaseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LockLease.
+func (c *LockLeaseClient) Update() *LockLeaseUpdate {
+	mutation := newLockLeaseMutation(c.config, OpUpdate)
+	return &LockLeaseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LockLeaseClient) UpdateOne(ll *LockLease) *LockLeaseUpdateOne {
+	mutation := newLockLeaseMutation(c.config, OpUpdateOne, withLockLease(ll))
+	return &LockLeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LockLeaseClient) UpdateOneID(id int) *LockLeaseUpdateOne {
+	mutation := newLockLeaseMutation(c.config, OpUpdateOne, withLockLeaseID(id))
+	return &LockLeaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LockLease.
+func (c *LockLeaseClient) Delete() *LockLeaseDelete {
+	mutation := newLockLeaseMutation(c.config, OpDelete)
+	return &LockLeaseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *LockLeaseClient) DeleteOne(ll *LockLease) *LockLeaseDeleteOne {
+	return c.DeleteOneID(ll.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *LockLeaseClient) DeleteOneID(id int) *LockLeaseDeleteOne {
+	builder := c.Delete().Where(locklease.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LockLeaseDeleteOne{builder}
+}
+
+// Query returns a query builder for LockLease.
+func (c *LockLeaseClient) Query() *LockLeaseQuery {
+	return &LockLeaseQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a LockLease entity by its id.
+func (c *LockLeaseClient) Get(ctx context.Context, id int) (*LockLease, error) {
+	return c.Query().Where(locklease.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LockLeaseClient) GetX(ctx context.Context, id int) *LockLease {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LockLeaseClient) Hooks() []Hook {
+	return c.hooks.LockLease
+}
+
+// NamespaceClient is a client for the Namespace schema.
+type NamespaceClient struct {
+	config
+}
+
+// NewNamespaceClient returns a client for the Namespace from the given config.
+func NewNamespaceClient(c config) *NamespaceClient {
+	return &NamespaceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `namespace.Hooks(f(g(h())))`.
+func (c *NamespaceClient) Use(hooks ...Hook) {
+	c.hooks.Namespace = append(c.hooks.Namespace, hooks...)
+}
+
+// Create returns a create builder for Namespace.
+func (c *NamespaceClient) Create() *NamespaceCreate {
+	mutation := newNamespaceMutation(c.config, OpCreate)
+	return &NamespaceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Namespace entities.
+func (c *NamespaceClient) CreateBulk(builders ...*NamespaceCreate) *NamespaceCreateBulk {
+	return &NamespaceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Namespace.
+func (c *NamespaceClient) Update() *NamespaceUpdate {
+	mutation := newNamespaceMutation(c.config, OpUpdate)
+	return &NamespaceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NamespaceClient) UpdateOne(n *Namespace) *NamespaceUpdateOne {
+	mutation := newNamespaceMutation(c.config, OpUpdateOne, withNamespace(n))
+	return &NamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NamespaceClient) UpdateOneID(id string) *NamespaceUpdateOne {
+	mutation := newNamespaceMutation(c.config, OpUpdateOne, withNamespaceID(id))
+	return &NamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Namespace.
+func (c *NamespaceClient) Delete() *NamespaceDelete {
+	mutation := newNamespaceMutation(c.config, OpDelete)
+	return &NamespaceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *NamespaceClient) DeleteOne(n *Namespace) *NamespaceDeleteOne {
+	return c.DeleteOneID(n.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *NamespaceClient) DeleteOneID(id string) *NamespaceDeleteOne {
+	builder := c.Delete().Where(namespace.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NamespaceDeleteOne{builder}
+}
+
+// Query returns a query builder for Namespace.
+func (c *NamespaceClient) Query() *NamespaceQuery {
+	return &NamespaceQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Namespace entity by its id.
+func (c *NamespaceClient) Get(ctx context.Context, id string) (*Namespace, error) {
+	return c.Query().Where(namespace.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NamespaceClient) GetX(ctx context.Context, id string) *Namespace {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWorkflows queries the workflows edge of a Namespace.
+func (c *NamespaceClient) QueryWorkflows(n *Namespace) *WorkflowQuery {
+	query := &WorkflowQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(namespace.Table, namespace.FieldID, id),
+			sqlgraph.To(workflow.Table, workflow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, namespace.WorkflowsTable, namespace.WorkflowsColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Namespace.
+func (c *NamespaceClient) QueryParent(n *Namespace) *NamespaceQuery {
+	query := &NamespaceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(namespace.Table, namespace.FieldID, id),
+			sqlgraph.To(namespace.Table, namespace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, namespace.ParentTable, namespace.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Namespace.
+func (c *NamespaceClient) QueryChildren(n *Namespace) *NamespaceQuery {
+	query := &NamespaceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(namespace.Table, namespace.FieldID, id),
+			sqlgraph.To(namespace.Table, namespace.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, namespace.ChildrenTable, namespace.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NamespaceClient) Hooks() []Hook {
+	return c.hooks.Namespace
+}
+
+// NodeClient is a client for the Node schema.
+type NodeClient struct {
+	config
+}
+
+// NewNodeClient returns a client for the Node from the given config.
+func NewNodeClient(c config) *NodeClient {
+	return &NodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `node.Hooks(f(g(h())))`.
+func (c *NodeClient) Use(hooks ...Hook) {
+	c.hooks.Node = append(c.hooks.Node, hooks...)
+}
+
+// Create returns a create builder for Node.
+func (c *NodeClient) Create() *NodeCreate {
+	mutation := newNodeMutation(c.config, OpCreate)
+	return &NodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Node entities.
+func (c *NodeClient) CreateBulk(builders ...*NodeCreate) *NodeCreateBulk {
+	return &NodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Node.
+func (c *NodeClient) Update() *NodeUpdate {
+	mutation := newNodeMutation(c.config, OpUpdate)
+	return &NodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NodeClient) UpdateOne(n *Node) *NodeUpdateOne {
+	mutation := newNodeMutation(c.config, OpUpdateOne, withNode(n))
+	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NodeClient) UpdateOneID(id int) *NodeUpdateOne {
+	mutation := newNodeMutation(c.config, OpUpdateOne, withNodeID(id))
+	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Node.
+func (c *NodeClient) Delete() *NodeDelete {
+	mutation := newNodeMutation(c.config, OpDelete)
+	return &NodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *NodeClient) DeleteOne(n *Node) *NodeDeleteOne {
+	return c.DeleteOneID(n.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *NodeClient) DeleteOneID(id int) *NodeDeleteOne {
+	builder := c.Delete().Where(node.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NodeDeleteOne{builder}
+}
+
+// Query returns a query builder for Node.
+func (c *NodeClient) Query() *NodeQuery {
+	return &NodeQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Node entity by its id.
+func (c *NodeClient) Get(ctx context.Context, id int) (*Node, error) {
+	return c.Query().Where(node.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NodeClient) GetX(ctx context.Context, id int) *Node {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NodeClient) Hooks() []Hook {
+	return c.hooks.Node
+}
+
+// QueuedInvocationClient is a client for the QueuedInvocation schema.
+type QueuedInvocationClient struct {
+	config
+}
+
+// NewQueuedInvocationClient returns a client for the QueuedInvocation from the given config.
+func NewQueuedInvocationClient(c config) *QueuedInvocationClient {
+	return &QueuedInvocationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `queuedinvocation.Hooks(f(g(h())))`.
+func (c *QueuedInvocationClient) Use(hooks ...Hook) {
+	c.hooks.QueuedInvocation = append(c.hooks.QueuedInvocation, hooks...)
+}
+
+// Create returns a create builder for QueuedInvocation.
+func (c *QueuedInvocationClient) Create() *QueuedInvocationCreate {
+	mutation := newQueuedInvocationMutation(c.config, OpCreate)
+	return &QueuedInvocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QueuedInvocation entities.
+func (c *QueuedInvocationClient) CreateBulk(builders ...*QueuedInvocationCreate) *QueuedInvocationCreateBulk {
+	return &QueuedInvocationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QueuedInvocation.
+func (c *QueuedInvocationClient) Update() *QueuedInvocationUpdate {
+	mutation := newQueuedInvocationMutation(c.config, OpUpdate)
+	return &QueuedInvocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QueuedInvocationClient) UpdateOne(qi *QueuedInvocation) *QueuedInvocationUpdateOne {
+	mutation := newQueuedInvocationMutation(c.config, OpUpdateOne, withQueuedInvocation(qi))
+	return &QueuedInvocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QueuedInvocationClient) UpdateOneID(id int) *QueuedInvocationUpdateOne {
+	mutation := newQueuedInvocationMutation(c.config, OpUpdateOne, withQueuedInvocationID(id))
+	return &QueuedInvocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QueuedInvocation.
+func (c *QueuedInvocationClient) Delete() *QueuedInvocationDelete {
+	mutation := newQueuedInvocationMutation(c.config, OpDelete)
+	return &QueuedInvocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *QueuedInvocationClient) DeleteOne(qi *QueuedInvocation) *QueuedInvocationDeleteOne {
+	return c.DeleteOneID(qi.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *QueuedInvocationClient) DeleteOneID(id int) *QueuedInvocationDeleteOne {
+	builder := c.Delete().Where(queuedinvocation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QueuedInvocationDeleteOne{builder}
+}
+
+// Query returns a query builder for QueuedInvocation.
+func (c *QueuedInvocationClient) Query() *QueuedInvocationQuery {
+	return &QueuedInvocationQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a QueuedInvocation entity by its id.
+func (c *QueuedInvocationClient) Get(ctx context.Context, id int) (*QueuedInvocation, error) {
+	return c.Query().Where(queuedinvocation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QueuedInvocationClient) GetX(ctx context.Context, id int) *QueuedInvocation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *QueuedInvocationClient) Hooks() []Hook {
+	return c.hooks.QueuedInvocation
+}
+
+// QueuedStateClient is a client for the QueuedState schema.
+type QueuedStateClient struct {
+	config
+}
+
+// NewQueuedStateClient returns a client for the QueuedState from the given config.
+func NewQueuedStateClient(c config) *QueuedStateClient {
+	return &QueuedStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `queuedstate.Hooks(f(g(h())))`.
+func (c *QueuedStateClient) Use(hooks ...Hook) {
+	c.hooks.QueuedState = append(c.hooks.QueuedState, hooks...)
+}
+
+// Create returns a create builder for QueuedState.
+func (c *QueuedStateClient) Create() *QueuedStateCreate {
+	mutation := newQueuedStateMutation(c.config, OpCreate)
+	return &QueuedStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QueuedState entities.
+func (c *QueuedStateClient) CreateBulk(builders ...*QueuedStateCreate) *QueuedStateCreateBulk {
+	return &QueuedStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QueuedState.
+func (c *QueuedStateClient) Update() *QueuedStateUpdate {
+	mutation := newQueuedStateMutation(c.config, OpUpdate)
+	return &QueuedStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QueuedStateClient) UpdateOne(qs *QueuedState) *QueuedStateUpdateOne {
+	mutation := newQueuedStateMutation(c.config, OpUpdateOne, withQueuedState(qs))
+	return &QueuedStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QueuedStateClient) UpdateOneID(id int) *QueuedStateUpdateOne {
+	mutation := newQueuedStateMutation(c.config, OpUpdateOne, withQueuedStateID(id))
+	return &QueuedStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QueuedState.
+func (c *QueuedStateClient) Delete() *QueuedStateDelete {
+	mutation := newQueuedStateMutation(c.config, OpDelete)
+	return &QueuedStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *QueuedStateClient) DeleteOne(qs *QueuedState) *QueuedStateDeleteOne {
+	return c.DeleteOneID(qs.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *QueuedStateClient) DeleteOneID(id int) *QueuedStateDeleteOne {
+	builder := c.Delete().Where(queuedstate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QueuedStateDeleteOne{builder}
+}
+
+// Query returns a query builder for QueuedState.
+func (c *QueuedStateClient) Query() *QueuedStateQuery {
+	return &QueuedStateQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a QueuedState entity by its id.
+func (c *QueuedStateClient) Get(ctx context.Context, id int) (*QueuedState, error) {
+	return c.Query().Where(queuedstate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QueuedStateClient) GetX(ctx context.Context, id int) *QueuedState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *QueuedStateClient) Hooks() []Hook {
+	return c.hooks.QueuedState
+}
+
+// ScheduledInvocationClient is a client for the ScheduledInvocation schema.
+type ScheduledInvocationClient struct {
+	config
+}
+
+// NewScheduledInvocationClient returns a client for the ScheduledInvocation from the given config.
+func NewScheduledInvocationClient(c config) *ScheduledInvocationClient {
+	return &ScheduledInvocationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scheduledinvocation.Hooks(f(g(h())))`.
+func (c *ScheduledInvocationClient) Use(hooks ...Hook) {
+	c.hooks.ScheduledInvocation = append(c.hooks.ScheduledInvocation, hooks...)
+}
+
+// Create returns a create builder for ScheduledInvocation.
+func (c *ScheduledInvocationClient) Create() *ScheduledInvocationCreate {
+	mutation := newScheduledInvocationMutation(c.config, OpCreate)
+	return &ScheduledInvocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScheduledInvocation entities.
+func (c *ScheduledInvocationClient) CreateBulk(builders ...*ScheduledInvocationCreate) *ScheduledInvocationCreateBulk {
+	return &ScheduledInvocationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScheduledInvocation.
+func (c *ScheduledInvocationClient) Update() *ScheduledInvocationUpdate {
+	mutation := newScheduledInvocationMutation(c.config, OpUpdate)
+	return &ScheduledInvocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScheduledInvocationClient) UpdateOne(si *ScheduledInvocation) *ScheduledInvocationUpdateOne {
+	mutation := newScheduledInvocationMutation(c.config, OpUpdateOne, withScheduledInvocation(si))
+	return &ScheduledInvocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScheduledInvocationClient) UpdateOneID(id uuid.UUID) *ScheduledInvocationUpdateOne {
+	mutation := newScheduledInvocationMutation(c.config, OpUpdateOne, withScheduledInvocationID(id))
+	return &ScheduledInvocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScheduledInvocation.
+func (c *ScheduledInvocationClient) Delete() *ScheduledInvocationDelete {
+	mutation := newScheduledInvocationMutation(c.config, OpDelete)
+	return &ScheduledInvocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ScheduledInvocationClient) DeleteOne(si *ScheduledInvocation) *ScheduledInvocationDeleteOne {
+	return c.DeleteOneID(si.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ScheduledInvocationClient) DeleteOneID(id uuid.UUID) *ScheduledInvocationDeleteOne {
+	builder := c.Delete().Where(scheduledinvocation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScheduledInvocationDeleteOne{builder}
+}
+
+// Query returns a query builder for ScheduledInvocation.
+func (c *ScheduledInvocationClient) Query() *ScheduledInvocationQuery {
+	return &ScheduledInvocationQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ScheduledInvocation entity by its id.
+func (c *ScheduledInvocationClient) Get(ctx context.Context, id uuid.UUID) (*ScheduledInvocation, error) {
+	return c.Query().Where(scheduledinvocation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScheduledInvocationClient) GetX(ctx context.Context, id uuid.UUID) *ScheduledInvocation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ScheduledInvocationClient) Hooks() []Hook {
+	return c.hooks.ScheduledInvocation
+}
+
+// SettingClient is a client for the Setting schema.
+type SettingClient struct {
+	config
+}
+
+// NewSettingClient returns a client for the Setting from the given config.
+func NewSettingClient(c config) *SettingClient {
+	return &SettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `setting.Hooks(f(g(h())))`.
+func (c *SettingClient) Use(hooks ...Hook) {
+	c.hooks.Setting = append(c.hooks.Setting, hooks...)
+}
+
+// Create returns a create builder for Setting.
+func (c *SettingClient) Create() *SettingCreate {
+	mutation := newSettingMutation(c.config, OpCreate)
+	return &SettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Setting entities.
+func (c *SettingClient) CreateBulk(builders ...*SettingCreate) *SettingCreateBulk {
+	return &SettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Setting.
+func (c *SettingClient) Update() *SettingUpdate {
+	mutation := newSettingMutation(c.config, OpUpdate)
+	return &SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettingClient) UpdateOne(s *Setting) *SettingUpdateOne {
+	mutation := newSettingMutation(c.config, OpUpdateOne, withSetting(s))
+	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettingClient) UpdateOneID(id int) *SettingUpdateOne {
+	mutation := newSettingMutation(c.config, OpUpdateOne, withSettingID(id))
+	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Setting.
+func (c *SettingClient) Delete() *SettingDelete {
+	mutation := newSettingMutation(c.config, OpDelete)
+	return &SettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *SettingClient) DeleteOne(s *Setting) *SettingDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *SettingClient) DeleteOneID(id int) *SettingDeleteOne {
+	builder := c.Delete().Where(setting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettingDeleteOne{builder}
+}
+
+// Query returns a query builder for Setting.
+func (c *SettingClient) Query() *SettingQuery {
+	return &SettingQuery{
+		config: c.config,
+	}
 }
 
-// Close closes the database connection and prevents new queries from starting.
-func (c *Client) Close() error {
-	return c.driver.Close()
+// Get returns a Setting entity by its id.
+func (c *SettingClient) Get(ctx context.Context, id int) (*Setting, error) {
+	return c.Query().Where(setting.ID(id)).Only(ctx)
 }
 
-// Use adds the mutation hooks to all the entity clients.
-// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
-func (c *Client) Use(hooks ...Hook) {
-	c.Namespace.Use(hooks...)
-	c.Workflow.Use(hooks...)
-	c.WorkflowEvents.Use(hooks...)
-	c.WorkflowEventsWait.Use(hooks...)
-	c.WorkflowInstance.Use(hooks...)
+// GetX is like Get, but panics if an error occurs.
+func (c *SettingClient) GetX(ctx context.Context, id int) *Setting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
-// NamespaceClient is a client for the Namespace schema.
-type NamespaceClient struct {
+// Hooks returns the client hooks.
+func (c *SettingClient) Hooks() []Hook {
+	return c.hooks.Setting
+}
+
+// TimerClient is a client for the Timer schema.
+type TimerClient struct {
 	config
 }
 
-// NewNamespaceClient returns a client for the Namespace from the given config.
-func NewNamespaceClient(c config) *NamespaceClient {
-	return &NamespaceClient{config: c}
+// NewTimerClient returns a client for the Timer from the given config.
+func NewTimerClient(c config) *TimerClient {
+	return &TimerClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `namespace.Hooks(f(g(h())))`.
-func (c *NamespaceClient) Use(hooks ...Hook) {
-	c.hooks.Namespace = append(c.hooks.Namespace, hooks...)
+// A call to `Use(f, g, h)` equals to `timer.Hooks(f(g(h())))`.
+func (c *TimerClient) Use(hooks ...Hook) {
+	c.hooks.Timer = append(c.hooks.Timer, hooks...)
 }
 
-// Create returns a create builder for Namespace.
-func (c *NamespaceClient) Create() *NamespaceCreate {
-	mutation := newNamespaceMutation(c.config, OpCreate)
-	return &NamespaceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a create builder for Timer.
+func (c *TimerClient) Create() *TimerCreate {
+	mutation := newTimerMutation(c.config, OpCreate)
+	return &TimerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Namespace entities.
-func (c *NamespaceClient) CreateBulk(builders ...*NamespaceCreate) *NamespaceCreateBulk {
-	return &NamespaceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Timer entities.
+func (c *TimerClient) CreateBulk(builders ...*TimerCreate) *TimerCreateBulk {
+	return &TimerCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Namespace.
-func (c *NamespaceClient) Update() *NamespaceUpdate {
-	mutation := newNamespaceMutation(c.config, OpUpdate)
-	return &NamespaceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Timer.
+func (c *TimerClient) Update() *TimerUpdate {
+	mutation := newTimerMutation(c.config, OpUpdate)
+	return &TimerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NamespaceClient) UpdateOne(n *Namespace) *NamespaceUpdateOne {
-	mutation := newNamespaceMutation(c.config, OpUpdateOne, withNamespace(n))
-	return &NamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TimerClient) UpdateOne(t *Timer) *TimerUpdateOne {
+	mutation := newTimerMutation(c.config, OpUpdateOne, withTimer(t))
+	return &TimerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NamespaceClient) UpdateOneID(id string) *NamespaceUpdateOne {
-	mutation := newNamespaceMutation(c.config, OpUpdateOne, withNamespaceID(id))
-	return &NamespaceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TimerClient) UpdateOneID(id int) *TimerUpdateOne {
+	mutation := newTimerMutation(c.config, OpUpdateOne, withTimerID(id))
+	return &TimerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Namespace.
-func (c *NamespaceClient) Delete() *NamespaceDelete {
-	mutation := newNamespaceMutation(c.config, OpDelete)
-	return &NamespaceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Timer.
+func (c *TimerClient) Delete() *TimerDelete {
+	mutation := newTimerMutation(c.config, OpDelete)
+	return &TimerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a delete builder for the given entity.
-func (c *NamespaceClient) DeleteOne(n *Namespace) *NamespaceDeleteOne {
-	return c.DeleteOneID(n.ID)
+func (c *TimerClient) DeleteOne(t *Timer) *TimerDeleteOne {
+	return c.DeleteOneID(t.ID)
 }
 
 // DeleteOneID returns a delete builder for the given id.
-func (c *NamespaceClient) DeleteOneID(id string) *NamespaceDeleteOne {
-	builder := c.Delete().Where(namespace.ID(id))
+func (c *TimerClient) DeleteOneID(id int) *TimerDeleteOne {
+	builder := c.Delete().Where(timer.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NamespaceDeleteOne{builder}
+	return &TimerDeleteOne{builder}
 }
 
-// Query returns a query builder for Namespace.
-func (c *NamespaceClient) Query() *NamespaceQuery {
-	return &NamespaceQuery{
+// Query returns a query builder for Timer.
+func (c *TimerClient) Query() *TimerQuery {
+	return &TimerQuery{
 		config: c.config,
 	}
 }
 
-// Get returns a Namespace entity by its id.
-func (c *NamespaceClient) Get(ctx context.Context, id string) (*Namespace, error) {
-	return c.Query().Where(namespace.ID(id)).Only(ctx)
+// Get returns a Timer entity by its id.
+func (c *TimerClient) Get(ctx context.Context, id int) (*Timer, error) {
+	return c.Query().Where(timer.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NamespaceClient) GetX(ctx context.Context, id string) *Namespace {
+func (c *TimerClient) GetX(ctx context.Context, id int) *Timer {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -236,25 +1371,189 @@ func (c *NamespaceClient) GetX(ctx context.Context, id string) *Namespace {
 	return obj
 }
 
-// QueryWorkflows queries the workflows edge of a Namespace.
-func (c *NamespaceClient) QueryWorkflows(n *Namespace) *WorkflowQuery {
-	query := &WorkflowQuery{config: c.config}
-	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
-		id := n.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(namespace.Table, namespace.FieldID, id),
-			sqlgraph.To(workflow.Table, workflow.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, namespace.WorkflowsTable, namespace.WorkflowsColumn),
-		)
-		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *TimerClient) Hooks() []Hook {
+	return c.hooks.Timer
+}
+
+// UnmatchedEventClient is a client for the UnmatchedEvent schema.
+type UnmatchedEventClient struct {
+	config
+}
+
+// NewUnmatchedEventClient returns a client for the UnmatchedEvent from the given config.
+func NewUnmatchedEventClient(c config) *UnmatchedEventClient {
+	return &UnmatchedEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `unmatchedevent.Hooks(f(g(h())))`.
+func (c *UnmatchedEventClient) Use(hooks ...Hook) {
+	c.hooks.UnmatchedEvent = append(c.hooks.UnmatchedEvent, hooks...)
+}
+
+// Create returns a create builder for UnmatchedEvent.
+func (c *UnmatchedEventClient) Create() *UnmatchedEventCreate {
+	mutation := newUnmatchedEventMutation(c.config, OpCreate)
+	return &UnmatchedEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UnmatchedEvent entities.
+func (c *UnmatchedEventClient) CreateBulk(builders ...*UnmatchedEventCreate) *UnmatchedEventCreateBulk {
+	return &UnmatchedEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UnmatchedEvent.
+func (c *UnmatchedEventClient) Update() *UnmatchedEventUpdate {
+	mutation := newUnmatchedEventMutation(c.config, OpUpdate)
+	return &UnmatchedEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UnmatchedEventClient) UpdateOne(ue *UnmatchedEvent) *UnmatchedEventUpdateOne {
+	mutation := newUnmatchedEventMutation(c.config, OpUpdateOne, withUnmatchedEvent(ue))
+	return &UnmatchedEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UnmatchedEventClient) UpdateOneID(id int) *UnmatchedEventUpdateOne {
+	mutation := newUnmatchedEventMutation(c.config, OpUpdateOne, withUnmatchedEventID(id))
+	return &UnmatchedEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UnmatchedEvent.
+func (c *UnmatchedEventClient) Delete() *UnmatchedEventDelete {
+	mutation := newUnmatchedEventMutation(c.config, OpDelete)
+	return &UnmatchedEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *UnmatchedEventClient) DeleteOne(ue *UnmatchedEvent) *UnmatchedEventDeleteOne {
+	return c.DeleteOneID(ue.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *UnmatchedEventClient) DeleteOneID(id int) *UnmatchedEventDeleteOne {
+	builder := c.Delete().Where(unmatchedevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UnmatchedEventDeleteOne{builder}
+}
+
+// Query returns a query builder for UnmatchedEvent.
+func (c *UnmatchedEventClient) Query() *UnmatchedEventQuery {
+	return &UnmatchedEventQuery{
+		config: c.config,
 	}
-	return query
+}
+
+// Get returns a UnmatchedEvent entity by its id.
+func (c *UnmatchedEventClient) Get(ctx context.Context, id int) (*UnmatchedEvent, error) {
+	return c.Query().Where(unmatchedevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UnmatchedEventClient) GetX(ctx context.Context, id int) *UnmatchedEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *NamespaceClient) Hooks() []Hook {
-	return c.hooks.Namespace
+func (c *UnmatchedEventClient) Hooks() []Hook {
+	return c.hooks.UnmatchedEvent
+}
+
+// WasmModuleClient is a client for the WasmModule schema.
+type WasmModuleClient struct {
+	config
+}
+
+// NewWasmModuleClient returns a client for the WasmModule from the given config.
+func NewWasmModuleClient(c config) *WasmModuleClient {
+	return &WasmModuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wasmmodule.Hooks(f(g(h())))`.
+func (c *WasmModuleClient) Use(hooks ...Hook) {
+	c.hooks.WasmModule = append(c.hooks.WasmModule, hooks...)
+}
+
+// Create returns a create builder for WasmModule.
+func (c *WasmModuleClient) Create() *WasmModuleCreate {
+	mutation := newWasmModuleMutation(c.config, OpCreate)
+	return &WasmModuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WasmModule entities.
+func (c *WasmModuleClient) CreateBulk(builders ...*WasmModuleCreate) *WasmModuleCreateBulk {
+	return &WasmModuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WasmModule.
+func (c *WasmModuleClient) Update() *WasmModuleUpdate {
+	mutation := newWasmModuleMutation(c.config, OpUpdate)
+	return &WasmModuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WasmModuleClient) UpdateOne(wm *WasmModule) *WasmModuleUpdateOne {
+	mutation := newWasmModuleMutation(c.config, OpUpdateOne, withWasmModule(wm))
+	return &WasmModuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WasmModuleClient) UpdateOneID(id int) *WasmModuleUpdateOne {
+	mutation := newWasmModuleMutation(c.config, OpUpdateOne, withWasmModuleID(id))
+	return &WasmModuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WasmModule.
+func (c *WasmModuleClient) Delete() *WasmModuleDelete {
+	mutation := newWasmModuleMutation(c.config, OpDelete)
+	return &WasmModuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *WasmModuleClient) DeleteOne(wm *WasmModule) *WasmModuleDeleteOne {
+	return c.DeleteOneID(wm.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *WasmModuleClient) DeleteOneID(id int) *WasmModuleDeleteOne {
+	builder := c.Delete().Where(wasmmodule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WasmModuleDeleteOne{builder}
+}
+
+// Query returns a query builder for WasmModule.
+func (c *WasmModuleClient) Query() *WasmModuleQuery {
+	return &WasmModuleQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WasmModule entity by its id.
+func (c *WasmModuleClient) Get(ctx context.Context, id int) (*WasmModule, error) {
+	return c.Query().Where(wasmmodule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WasmModuleClient) GetX(ctx context.Context, id int) *WasmModule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WasmModuleClient) Hooks() []Hook {
+	return c.hooks.WasmModule
 }
 
 // WorkflowClient is a client for the Workflow schema.
@@ -390,6 +1689,22 @@ func (c *WorkflowClient) QueryWfevents(w *Workflow) *WorkflowEventsQuery {
 	return query
 }
 
+// QueryRevisions queries the revisions edge of a Workflow.
+func (c *WorkflowClient) QueryRevisions(w *Workflow) *WorkflowRevisionQuery {
+	query := &WorkflowRevisionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflow.Table, workflow.FieldID, id),
+			sqlgraph.To(workflowrevision.Table, workflowrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workflow.RevisionsTable, workflow.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *WorkflowClient) Hooks() []Hook {
 	return c.hooks.Workflow
@@ -760,3 +2075,109 @@ func (c *WorkflowInstanceClient) QueryInstance(wi *WorkflowInstance) *WorkflowEv
 func (c *WorkflowInstanceClient) Hooks() []Hook {
 	return c.hooks.WorkflowInstance
 }
+
+// WorkflowRevisionClient is a client for the WorkflowRevision schema.
+type WorkflowRevisionClient struct {
+	config
+}
+
+// NewWorkflowRevisionClient returns a client for the WorkflowRevision from the given config.
+func NewWorkflowRevisionClient(c config) *WorkflowRevisionClient {
+	return &WorkflowRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `workflowrevision.Hooks(f(g(h())))`.
+func (c *WorkflowRevisionClient) Use(hooks ...Hook) {
+	c.hooks.WorkflowRevision = append(c.hooks.WorkflowRevision, hooks...)
+}
+
+// Create returns a create builder for WorkflowRevision.
+func (c *WorkflowRevisionClient) Create() *WorkflowRevisionCreate {
+	mutation := newWorkflowRevisionMutation(c.config, OpCreate)
+	return &WorkflowRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WorkflowRevision entities.
+func (c *WorkflowRevisionClient) CreateBulk(builders ...*WorkflowRevisionCreate) *WorkflowRevisionCreateBulk {
+	return &WorkflowRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WorkflowRevision.
+func (c *WorkflowRevisionClient) Update() *WorkflowRevisionUpdate {
+	mutation := newWorkflowRevisionMutation(c.config, OpUpdate)
+	return &WorkflowRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WorkflowRevisionClient) UpdateOne(wr *WorkflowRevision) *WorkflowRevisionUpdateOne {
+	mutation := newWorkflowRevisionMutation(c.config, OpUpdateOne, withWorkflowRevision(wr))
+	return &WorkflowRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WorkflowRevisionClient) UpdateOneID(id int) *WorkflowRevisionUpdateOne {
+	mutation := newWorkflowRevisionMutation(c.config, OpUpdateOne, withWorkflowRevisionID(id))
+	return &WorkflowRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WorkflowRevision.
+func (c *WorkflowRevisionClient) Delete() *WorkflowRevisionDelete {
+	mutation := newWorkflowRevisionMutation(c.config, OpDelete)
+	return &WorkflowRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *WorkflowRevisionClient) DeleteOne(wr *WorkflowRevision) *WorkflowRevisionDeleteOne {
+	return c.DeleteOneID(wr.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *WorkflowRevisionClient) DeleteOneID(id int) *WorkflowRevisionDeleteOne {
+	builder := c.Delete().Where(workflowrevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WorkflowRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for WorkflowRevision.
+func (c *WorkflowRevisionClient) Query() *WorkflowRevisionQuery {
+	return &WorkflowRevisionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WorkflowRevision entity by its id.
+func (c *WorkflowRevisionClient) Get(ctx context.Context, id int) (*WorkflowRevision, error) {
+	return c.Query().Where(workflowrevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WorkflowRevisionClient) GetX(ctx context.Context, id int) *WorkflowRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWorkflow queries the workflow edge of a WorkflowRevision.
+func (c *WorkflowRevisionClient) QueryWorkflow(wr *WorkflowRevision) *WorkflowQuery {
+	query := &WorkflowQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := wr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowrevision.Table, workflowrevision.FieldID, id),
+			sqlgraph.To(workflow.Table, workflow.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workflowrevision.WorkflowTable, workflowrevision.WorkflowColumn),
+		)
+		fromV = sqlgraph.Neighbors(wr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WorkflowRevisionClient) Hooks() []Hook {
+	return c.hooks.WorkflowRevision
+}
@@ -24,11 +24,25 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Namespace          []ent.Hook
-	Workflow           []ent.Hook
-	WorkflowEvents     []ent.Hook
-	WorkflowEventsWait []ent.Hook
-	WorkflowInstance   []ent.Hook
+	EventCounter        []ent.Hook
+	InstanceHistory     []ent.Hook
+	JQModule            []ent.Hook
+	JSONSchema          []ent.Hook
+	LockLease           []ent.Hook
+	Namespace           []ent.Hook
+	Node                []ent.Hook
+	QueuedInvocation    []ent.Hook
+	QueuedState         []ent.Hook
+	ScheduledInvocation []ent.Hook
+	Setting             []ent.Hook
+	Timer               []ent.Hook
+	UnmatchedEvent      []ent.Hook
+	WasmModule          []ent.Hook
+	Workflow            []ent.Hook
+	WorkflowEvents      []ent.Hook
+	WorkflowEventsWait  []ent.Hook
+	WorkflowInstance    []ent.Hook
+	WorkflowRevision    []ent.Hook
 }
 
 // Options applies the options on the config object.
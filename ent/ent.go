@@ -10,11 +10,25 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/vorteil/direktiv/ent/eventcounter"
+	"github.com/vorteil/direktiv/ent/instancehistory"
+	"github.com/vorteil/direktiv/ent/jqmodule"
+	"github.com/vorteil/direktiv/ent/jsonschema"
+	"github.com/vorteil/direktiv/ent/locklease"
 	"github.com/vorteil/direktiv/ent/namespace"
+	"github.com/vorteil/direktiv/ent/node"
+	"github.com/vorteil/direktiv/ent/queuedinvocation"
+	"github.com/vorteil/direktiv/ent/queuedstate"
+	"github.com/vorteil/direktiv/ent/scheduledinvocation"
+	"github.com/vorteil/direktiv/ent/setting"
+	"github.com/vorteil/direktiv/ent/timer"
+	"github.com/vorteil/direktiv/ent/unmatchedevent"
+	"github.com/vorteil/direktiv/ent/wasmmodule"
 	"github.com/vorteil/direktiv/ent/workflow"
 	"github.com/vorteil/direktiv/ent/workflowevents"
 	"github.com/vorteil/direktiv/ent/workfloweventswait"
 	"github.com/vorteil/direktiv/ent/workflowinstance"
+	"github.com/vorteil/direktiv/ent/workflowrevision"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -35,11 +49,25 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		namespace.Table:          namespace.ValidColumn,
-		workflow.Table:           workflow.ValidColumn,
-		workflowevents.Table:     workflowevents.ValidColumn,
-		workfloweventswait.Table: workfloweventswait.ValidColumn,
-		workflowinstance.Table:   workflowinstance.ValidColumn,
+		eventcounter.Table:        eventcounter.ValidColumn,
+		instancehistory.Table:     instancehistory.ValidColumn,
+		jqmodule.Table:            jqmodule.ValidColumn,
+		jsonschema.Table:          jsonschema.ValidColumn,
+		locklease.Table:           locklease.ValidColumn,
+		namespace.Table:           namespace.ValidColumn,
+		node.Table:                node.ValidColumn,
+		queuedinvocation.Table:    queuedinvocation.ValidColumn,
+		queuedstate.Table:         queuedstate.ValidColumn,
+		scheduledinvocation.Table: scheduledinvocation.ValidColumn,
+		setting.Table:             setting.ValidColumn,
+		timer.Table:               timer.ValidColumn,
+		unmatchedevent.Table:      unmatchedevent.ValidColumn,
+		wasmmodule.Table:          wasmmodule.ValidColumn,
+		workflow.Table:            workflow.ValidColumn,
+		workflowevents.Table:      workflowevents.ValidColumn,
+		workfloweventswait.Table:  workfloweventswait.ValidColumn,
+		workflowinstance.Table:    workflowinstance.ValidColumn,
+		workflowrevision.Table:    workflowrevision.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -89,7 +117,6 @@ type AggregateFunc func(*sql.Selector) string
 //	GroupBy(field1, field2).
 //	Aggregate(ent.As(ent.Sum(field1), "sum_field1"), (ent.As(ent.Sum(field2), "sum_field2")).
 //	Scan(ctx, &v)
-//
 func As(fn AggregateFunc, end string) AggregateFunc {
 	return func(s *sql.Selector) string {
 		return sql.As(fn(s), end)
@@ -0,0 +1,776 @@
+// Code generated by entc, DO NOT EDIT.
+
+package eventcounter
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNamespace), v))
+	})
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldType), v))
+	})
+}
+
+// Received applies equality check predicate on the "received" field. It's identical to ReceivedEQ.
+func Received(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReceived), v))
+	})
+}
+
+// Matched applies equality check predicate on the "matched" field. It's identical to MatchedEQ.
+func Matched(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMatched), v))
+	})
+}
+
+// Unmatched applies equality check predicate on the "unmatched" field. It's identical to UnmatchedEQ.
+func Unmatched(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnmatched), v))
+	})
+}
+
+// Rejected applies equality check predicate on the "rejected" field. It's identical to RejectedEQ.
+func Rejected(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRejected), v))
+	})
+}
+
+// LastReceived applies equality check predicate on the "last_received" field. It's identical to LastReceivedEQ.
+func LastReceived(v time.Time) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastReceived), v))
+	})
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.EventCounter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNamespace), v...))
+	})
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.EventCounter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNamespace), v...))
+	})
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNamespace), v))
+	})
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldType), v))
+	})
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldType), v))
+	})
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.EventCounter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldType), v...))
+	})
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.EventCounter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldType), v...))
+	})
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldType), v))
+	})
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldType), v))
+	})
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldType), v))
+	})
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldType), v))
+	})
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldType), v))
+	})
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldType), v))
+	})
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldType), v))
+	})
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldType), v))
+	})
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldType), v))
+	})
+}
+
+// ReceivedEQ applies the EQ predicate on the "received" field.
+func ReceivedEQ(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReceived), v))
+	})
+}
+
+// ReceivedNEQ applies the NEQ predicate on the "received" field.
+func ReceivedNEQ(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReceived), v))
+	})
+}
+
+// ReceivedIn applies the In predicate on the "received" field.
+func ReceivedIn(vs ...int64) predicate.EventCounter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReceived), v...))
+	})
+}
+
+// ReceivedNotIn applies the NotIn predicate on the "received" field.
+func ReceivedNotIn(vs ...int64) predicate.EventCounter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReceived), v...))
+	})
+}
+
+// ReceivedGT applies the GT predicate on the "received" field.
+func ReceivedGT(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReceived), v))
+	})
+}
+
+// ReceivedGTE applies the GTE predicate on the "received" field.
+func ReceivedGTE(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReceived), v))
+	})
+}
+
+// ReceivedLT applies the LT predicate on the "received" field.
+func ReceivedLT(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReceived), v))
+	})
+}
+
+// ReceivedLTE applies the LTE predicate on the "received" field.
+func ReceivedLTE(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReceived), v))
+	})
+}
+
+// MatchedEQ applies the EQ predicate on the "matched" field.
+func MatchedEQ(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMatched), v))
+	})
+}
+
+// MatchedNEQ applies the NEQ predicate on the "matched" field.
+func MatchedNEQ(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMatched), v))
+	})
+}
+
+// MatchedIn applies the In predicate on the "matched" field.
+func MatchedIn(vs ...int64) predicate.EventCounter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMatched), v...))
+	})
+}
+
+// MatchedNotIn applies the NotIn predicate on the "matched" field.
+func MatchedNotIn(vs ...int64) predicate.EventCounter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMatched), v...))
+	})
+}
+
+// MatchedGT applies the GT predicate on the "matched" field.
+func MatchedGT(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMatched), v))
+	})
+}
+
+// MatchedGTE applies the GTE predicate on the "matched" field.
+func MatchedGTE(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMatched), v))
+	})
+}
+
+// MatchedLT applies the LT predicate on the "matched" field.
+func MatchedLT(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMatched), v))
+	})
+}
+
+// MatchedLTE applies the LTE predicate on the "matched" field.
+func MatchedLTE(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMatched), v))
+	})
+}
+
+// UnmatchedEQ applies the EQ predicate on the "unmatched" field.
+func UnmatchedEQ(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUnmatched), v))
+	})
+}
+
+// UnmatchedNEQ applies the NEQ predicate on the "unmatched" field.
+func UnmatchedNEQ(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUnmatched), v))
+	})
+}
+
+// UnmatchedIn applies the In predicate on the "unmatched" field.
+func UnmatchedIn(vs ...int64) predicate.EventCounter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUnmatched), v...))
+	})
+}
+
+// UnmatchedNotIn applies the NotIn predicate on the "unmatched" field.
+func UnmatchedNotIn(vs ...int64) predicate.EventCounter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUnmatched), v...))
+	})
+}
+
+// UnmatchedGT applies the GT predicate on the "unmatched" field.
+func UnmatchedGT(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUnmatched), v))
+	})
+}
+
+// UnmatchedGTE applies the GTE predicate on the "unmatched" field.
+func UnmatchedGTE(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUnmatched), v))
+	})
+}
+
+// UnmatchedLT applies the LT predicate on the "unmatched" field.
+func UnmatchedLT(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUnmatched), v))
+	})
+}
+
+// UnmatchedLTE applies the LTE predicate on the "unmatched" field.
+func UnmatchedLTE(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUnmatched), v))
+	})
+}
+
+// RejectedEQ applies the EQ predicate on the "rejected" field.
+func RejectedEQ(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRejected), v))
+	})
+}
+
+// RejectedNEQ applies the NEQ predicate on the "rejected" field.
+func RejectedNEQ(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRejected), v))
+	})
+}
+
+// RejectedIn applies the In predicate on the "rejected" field.
+func RejectedIn(vs ...int64) predicate.EventCounter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRejected), v...))
+	})
+}
+
+// RejectedNotIn applies the NotIn predicate on the "rejected" field.
+func RejectedNotIn(vs ...int64) predicate.EventCounter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRejected), v...))
+	})
+}
+
+// RejectedGT applies the GT predicate on the "rejected" field.
+func RejectedGT(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRejected), v))
+	})
+}
+
+// RejectedGTE applies the GTE predicate on the "rejected" field.
+func RejectedGTE(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRejected), v))
+	})
+}
+
+// RejectedLT applies the LT predicate on the "rejected" field.
+func RejectedLT(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRejected), v))
+	})
+}
+
+// RejectedLTE applies the LTE predicate on the "rejected" field.
+func RejectedLTE(v int64) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRejected), v))
+	})
+}
+
+// LastReceivedEQ applies the EQ predicate on the "last_received" field.
+func LastReceivedEQ(v time.Time) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastReceived), v))
+	})
+}
+
+// LastReceivedNEQ applies the NEQ predicate on the "last_received" field.
+func LastReceivedNEQ(v time.Time) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastReceived), v))
+	})
+}
+
+// LastReceivedIn applies the In predicate on the "last_received" field.
+func LastReceivedIn(vs ...time.Time) predicate.EventCounter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLastReceived), v...))
+	})
+}
+
+// LastReceivedNotIn applies the NotIn predicate on the "last_received" field.
+func LastReceivedNotIn(vs ...time.Time) predicate.EventCounter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.EventCounter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLastReceived), v...))
+	})
+}
+
+// LastReceivedGT applies the GT predicate on the "last_received" field.
+func LastReceivedGT(v time.Time) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastReceived), v))
+	})
+}
+
+// LastReceivedGTE applies the GTE predicate on the "last_received" field.
+func LastReceivedGTE(v time.Time) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastReceived), v))
+	})
+}
+
+// LastReceivedLT applies the LT predicate on the "last_received" field.
+func LastReceivedLT(v time.Time) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastReceived), v))
+	})
+}
+
+// LastReceivedLTE applies the LTE predicate on the "last_received" field.
+func LastReceivedLTE(v time.Time) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastReceived), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EventCounter) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EventCounter) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EventCounter) predicate.EventCounter {
+	return predicate.EventCounter(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
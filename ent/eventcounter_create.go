@@ -0,0 +1,341 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/eventcounter"
+)
+
+// EventCounterCreate is the builder for creating a EventCounter entity.
+type EventCounterCreate struct {
+	config
+	mutation *EventCounterMutation
+	hooks    []Hook
+}
+
+// SetNamespace sets the "namespace" field.
+func (ecc *EventCounterCreate) SetNamespace(s string) *EventCounterCreate {
+	ecc.mutation.SetNamespace(s)
+	return ecc
+}
+
+// SetType sets the "type" field.
+func (ecc *EventCounterCreate) SetType(s string) *EventCounterCreate {
+	ecc.mutation.SetType(s)
+	return ecc
+}
+
+// SetReceived sets the "received" field.
+func (ecc *EventCounterCreate) SetReceived(i int64) *EventCounterCreate {
+	ecc.mutation.SetReceived(i)
+	return ecc
+}
+
+// SetNillableReceived sets the "received" field if the given value is not nil.
+func (ecc *EventCounterCreate) SetNillableReceived(i *int64) *EventCounterCreate {
+	if i != nil {
+		ecc.SetReceived(*i)
+	}
+	return ecc
+}
+
+// SetMatched sets the "matched" field.
+func (ecc *EventCounterCreate) SetMatched(i int64) *EventCounterCreate {
+	ecc.mutation.SetMatched(i)
+	return ecc
+}
+
+// SetNillableMatched sets the "matched" field if the given value is not nil.
+func (ecc *EventCounterCreate) SetNillableMatched(i *int64) *EventCounterCreate {
+	if i != nil {
+		ecc.SetMatched(*i)
+	}
+	return ecc
+}
+
+// SetUnmatched sets the "unmatched" field.
+func (ecc *EventCounterCreate) SetUnmatched(i int64) *EventCounterCreate {
+	ecc.mutation.SetUnmatched(i)
+	return ecc
+}
+
+// SetNillableUnmatched sets the "unmatched" field if the given value is not nil.
+func (ecc *EventCounterCreate) SetNillableUnmatched(i *int64) *EventCounterCreate {
+	if i != nil {
+		ecc.SetUnmatched(*i)
+	}
+	return ecc
+}
+
+// SetRejected sets the "rejected" field.
+func (ecc *EventCounterCreate) SetRejected(i int64) *EventCounterCreate {
+	ecc.mutation.SetRejected(i)
+	return ecc
+}
+
+// SetNillableRejected sets the "rejected" field if the given value is not nil.
+func (ecc *EventCounterCreate) SetNillableRejected(i *int64) *EventCounterCreate {
+	if i != nil {
+		ecc.SetRejected(*i)
+	}
+	return ecc
+}
+
+// SetLastReceived sets the "last_received" field.
+func (ecc *EventCounterCreate) SetLastReceived(t time.Time) *EventCounterCreate {
+	ecc.mutation.SetLastReceived(t)
+	return ecc
+}
+
+// Mutation returns the EventCounterMutation object of the builder.
+func (ecc *EventCounterCreate) Mutation() *EventCounterMutation {
+	return ecc.mutation
+}
+
+// Save creates the EventCounter in the database.
+func (ecc *EventCounterCreate) Save(ctx context.Context) (*EventCounter, error) {
+	var (
+		err  error
+		node *EventCounter
+	)
+	ecc.defaults()
+	if len(ecc.hooks) == 0 {
+		if err = ecc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ecc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EventCounterMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ecc.check(); err != nil {
+				return nil, err
+			}
+			ecc.mutation = mutation
+			node, err = ecc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ecc.hooks) - 1; i >= 0; i-- {
+			mut = ecc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ecc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ecc *EventCounterCreate) SaveX(ctx context.Context) *EventCounter {
+	v, err := ecc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (ecc *EventCounterCreate) defaults() {
+	if _, ok := ecc.mutation.Received(); !ok {
+		v := eventcounter.DefaultReceived
+		ecc.mutation.SetReceived(v)
+	}
+	if _, ok := ecc.mutation.Matched(); !ok {
+		v := eventcounter.DefaultMatched
+		ecc.mutation.SetMatched(v)
+	}
+	if _, ok := ecc.mutation.Unmatched(); !ok {
+		v := eventcounter.DefaultUnmatched
+		ecc.mutation.SetUnmatched(v)
+	}
+	if _, ok := ecc.mutation.Rejected(); !ok {
+		v := eventcounter.DefaultRejected
+		ecc.mutation.SetRejected(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ecc *EventCounterCreate) check() error {
+	if _, ok := ecc.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New("ent: missing required field \"namespace\"")}
+	}
+	if _, ok := ecc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New("ent: missing required field \"type\"")}
+	}
+	if _, ok := ecc.mutation.Received(); !ok {
+		return &ValidationError{Name: "received", err: errors.New("ent: missing required field \"received\"")}
+	}
+	if _, ok := ecc.mutation.Matched(); !ok {
+		return &ValidationError{Name: "matched", err: errors.New("ent: missing required field \"matched\"")}
+	}
+	if _, ok := ecc.mutation.Unmatched(); !ok {
+		return &ValidationError{Name: "unmatched", err: errors.New("ent: missing required field \"unmatched\"")}
+	}
+	if _, ok := ecc.mutation.Rejected(); !ok {
+		return &ValidationError{Name: "rejected", err: errors.New("ent: missing required field \"rejected\"")}
+	}
+	if _, ok := ecc.mutation.LastReceived(); !ok {
+		return &ValidationError{Name: "last_received", err: errors.New("ent: missing required field \"last_received\"")}
+	}
+	return nil
+}
+
+func (ecc *EventCounterCreate) sqlSave(ctx context.Context) (*EventCounter, error) {
+	_node, _spec := ecc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ecc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ecc *EventCounterCreate) createSpec() (*EventCounter, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventCounter{config: ecc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: eventcounter.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: eventcounter.FieldID,
+			},
+		}
+	)
+	if value, ok := ecc.mutation.Namespace(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: eventcounter.FieldNamespace,
+		})
+		_node.Namespace = value
+	}
+	if value, ok := ecc.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: eventcounter.FieldType,
+		})
+		_node.Type = value
+	}
+	if value, ok := ecc.mutation.Received(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldReceived,
+		})
+		_node.Received = value
+	}
+	if value, ok := ecc.mutation.Matched(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldMatched,
+		})
+		_node.Matched = value
+	}
+	if value, ok := ecc.mutation.Unmatched(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldUnmatched,
+		})
+		_node.Unmatched = value
+	}
+	if value, ok := ecc.mutation.Rejected(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldRejected,
+		})
+		_node.Rejected = value
+	}
+	if value, ok := ecc.mutation.LastReceived(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: eventcounter.FieldLastReceived,
+		})
+		_node.LastReceived = value
+	}
+	return _node, _spec
+}
+
+// EventCounterCreateBulk is the builder for creating many EventCounter entities in bulk.
+type EventCounterCreateBulk struct {
+	config
+	builders []*EventCounterCreate
+}
+
+// Save creates the EventCounter entities in the database.
+func (eccb *EventCounterCreateBulk) Save(ctx context.Context) ([]*EventCounter, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(eccb.builders))
+	nodes := make([]*EventCounter, len(eccb.builders))
+	mutators := make([]Mutator, len(eccb.builders))
+	for i := range eccb.builders {
+		func(i int, root context.Context) {
+			builder := eccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventCounterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, eccb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, eccb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, eccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eccb *EventCounterCreateBulk) SaveX(ctx context.Context) []*EventCounter {
+	v, err := eccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
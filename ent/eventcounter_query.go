@@ -0,0 +1,904 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/eventcounter"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// EventCounterQuery is the builder for querying EventCounter entities.
+type EventCounterQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.EventCounter
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EventCounterQuery builder.
+func (ecq *EventCounterQuery) Where(ps ...predicate.EventCounter) *EventCounterQuery {
+	ecq.predicates = append(ecq.predicates, ps...)
+	return ecq
+}
+
+// Limit adds a limit step to the query.
+func (ecq *EventCounterQuery) Limit(limit int) *EventCounterQuery {
+	ecq.limit = &limit
+	return ecq
+}
+
+// Offset adds an offset step to the query.
+func (ecq *EventCounterQuery) Offset(offset int) *EventCounterQuery {
+	ecq.offset = &offset
+	return ecq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ecq *EventCounterQuery) Unique(unique bool) *EventCounterQuery {
+	ecq.unique = &unique
+	return ecq
+}
+
+// Order adds an order step to the query.
+func (ecq *EventCounterQuery) Order(o ...OrderFunc) *EventCounterQuery {
+	ecq.order = append(ecq.order, o...)
+	return ecq
+}
+
+// First returns the first EventCounter entity from the query.
+// Returns a *NotFoundError when no EventCounter was found.
+func (ecq *EventCounterQuery) First(ctx context.Context) (*EventCounter, error) {
+	nodes, err := ecq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{eventcounter.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ecq *EventCounterQuery) FirstX(ctx context.Context) *EventCounter {
+	node, err := ecq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EventCounter ID from the query.
+// Returns a *NotFoundError when no EventCounter ID was found.
+func (ecq *EventCounterQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ecq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{eventcounter.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ecq *EventCounterQuery) FirstIDX(ctx context.Context) int {
+	id, err := ecq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EventCounter entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one EventCounter entity is not found.
+// Returns a *NotFoundError when no EventCounter entities are found.
+func (ecq *EventCounterQuery) Only(ctx context.Context) (*EventCounter, error) {
+	nodes, err := ecq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{eventcounter.Label}
+	default:
+		return nil, &NotSingularError{eventcounter.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ecq *EventCounterQuery) OnlyX(ctx context.Context) *EventCounter {
+	node, err := ecq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EventCounter ID in the query.
+// Returns a *NotSingularError when exactly one EventCounter ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (ecq *EventCounterQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ecq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{eventcounter.Label}
+	default:
+		err = &NotSingularError{eventcounter.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ecq *EventCounterQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ecq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EventCounters.
+func (ecq *EventCounterQuery) All(ctx context.Context) ([]*EventCounter, error) {
+	if err := ecq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ecq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ecq *EventCounterQuery) AllX(ctx context.Context) []*EventCounter {
+	nodes, err := ecq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EventCounter IDs.
+func (ecq *EventCounterQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := ecq.Select(eventcounter.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ecq *EventCounterQuery) IDsX(ctx context.Context) []int {
+	ids, err := ecq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ecq *EventCounterQuery) Count(ctx context.Context) (int, error) {
+	if err := ecq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ecq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ecq *EventCounterQuery) CountX(ctx context.Context) int {
+	count, err := ecq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ecq *EventCounterQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ecq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ecq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ecq *EventCounterQuery) ExistX(ctx context.Context) bool {
+	exist, err := ecq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EventCounterQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ecq *EventCounterQuery) Clone() *EventCounterQuery {
+	if ecq == nil {
+		return nil
+	}
+	return &EventCounterQuery{
+		config:     ecq.config,
+		limit:      ecq.limit,
+		offset:     ecq.offset,
+		order:      append([]OrderFunc{}, ecq.order...),
+		predicates: append([]predicate.EventCounter{}, ecq.predicates...),
+		// clone intermediate query.
+		sql:  ecq.sql.Clone(),
+		path: ecq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Namespace string `json:"namespace,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EventCounter.Query().
+//		GroupBy(eventcounter.FieldNamespace).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ecq *EventCounterQuery) GroupBy(field string, fields ...string) *EventCounterGroupBy {
+	group := &EventCounterGroupBy{config: ecq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ecq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ecq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Namespace string `json:"namespace,omitempty"`
+//	}
+//
+//	client.EventCounter.Query().
+//		Select(eventcounter.FieldNamespace).
+//		Scan(ctx, &v)
+func (ecq *EventCounterQuery) Select(field string, fields ...string) *EventCounterSelect {
+	ecq.fields = append([]string{field}, fields...)
+	return &EventCounterSelect{EventCounterQuery: ecq}
+}
+
+func (ecq *EventCounterQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ecq.fields {
+		if !eventcounter.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ecq.path != nil {
+		prev, err := ecq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ecq.sql = prev
+	}
+	return nil
+}
+
+func (ecq *EventCounterQuery) sqlAll(ctx context.Context) ([]*EventCounter, error) {
+	var (
+		nodes = []*EventCounter{}
+		_spec = ecq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &EventCounter{config: ecq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ecq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ecq *EventCounterQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ecq.querySpec()
+	return sqlgraph.CountNodes(ctx, ecq.driver, _spec)
+}
+
+func (ecq *EventCounterQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ecq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ecq *EventCounterQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   eventcounter.Table,
+			Columns: eventcounter.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: eventcounter.FieldID,
+			},
+		},
+		From:   ecq.sql,
+		Unique: true,
+	}
+	if unique := ecq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ecq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventcounter.FieldID)
+		for i := range fields {
+			if fields[i] != eventcounter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ecq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ecq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ecq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ecq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ecq *EventCounterQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ecq.driver.Dialect())
+	t1 := builder.Table(eventcounter.Table)
+	selector := builder.Select(t1.Columns(eventcounter.Columns...)...).From(t1)
+	if ecq.sql != nil {
+		selector = ecq.sql
+		selector.Select(selector.Columns(eventcounter.Columns...)...)
+	}
+	for _, p := range ecq.predicates {
+		p(selector)
+	}
+	for _, p := range ecq.order {
+		p(selector)
+	}
+	if offset := ecq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ecq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EventCounterGroupBy is the group-by builder for EventCounter entities.
+type EventCounterGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ecgb *EventCounterGroupBy) Aggregate(fns ...AggregateFunc) *EventCounterGroupBy {
+	ecgb.fns = append(ecgb.fns, fns...)
+	return ecgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ecgb *EventCounterGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ecgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ecgb.sql = query
+	return ecgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ecgb *EventCounterGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := ecgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ecgb *EventCounterGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(ecgb.fields) > 1 {
+		return nil, errors.New("ent: EventCounterGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := ecgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ecgb *EventCounterGroupBy) StringsX(ctx context.Context) []string {
+	v, err := ecgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ecgb *EventCounterGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ecgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{eventcounter.Label}
+	default:
+		err = fmt.Errorf("ent: EventCounterGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ecgb *EventCounterGroupBy) StringX(ctx context.Context) string {
+	v, err := ecgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ecgb *EventCounterGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(ecgb.fields) > 1 {
+		return nil, errors.New("ent: EventCounterGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := ecgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ecgb *EventCounterGroupBy) IntsX(ctx context.Context) []int {
+	v, err := ecgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ecgb *EventCounterGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ecgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{eventcounter.Label}
+	default:
+		err = fmt.Errorf("ent: EventCounterGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ecgb *EventCounterGroupBy) IntX(ctx context.Context) int {
+	v, err := ecgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ecgb *EventCounterGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ecgb.fields) > 1 {
+		return nil, errors.New("ent: EventCounterGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := ecgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ecgb *EventCounterGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := ecgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ecgb *EventCounterGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ecgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{eventcounter.Label}
+	default:
+		err = fmt.Errorf("ent: EventCounterGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ecgb *EventCounterGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := ecgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ecgb *EventCounterGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(ecgb.fields) > 1 {
+		return nil, errors.New("ent: EventCounterGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := ecgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ecgb *EventCounterGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := ecgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ecgb *EventCounterGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ecgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{eventcounter.Label}
+	default:
+		err = fmt.Errorf("ent: EventCounterGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ecgb *EventCounterGroupBy) BoolX(ctx context.Context) bool {
+	v, err := ecgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ecgb *EventCounterGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ecgb.fields {
+		if !eventcounter.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ecgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ecgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ecgb *EventCounterGroupBy) sqlQuery() *sql.Selector {
+	selector := ecgb.sql
+	columns := make([]string, 0, len(ecgb.fields)+len(ecgb.fns))
+	columns = append(columns, ecgb.fields...)
+	for _, fn := range ecgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(ecgb.fields...)
+}
+
+// EventCounterSelect is the builder for selecting fields of EventCounter entities.
+type EventCounterSelect struct {
+	*EventCounterQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ecs *EventCounterSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ecs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ecs.sql = ecs.EventCounterQuery.sqlQuery(ctx)
+	return ecs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ecs *EventCounterSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ecs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ecs *EventCounterSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ecs.fields) > 1 {
+		return nil, errors.New("ent: EventCounterSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ecs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ecs *EventCounterSelect) StringsX(ctx context.Context) []string {
+	v, err := ecs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ecs *EventCounterSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ecs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{eventcounter.Label}
+	default:
+		err = fmt.Errorf("ent: EventCounterSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ecs *EventCounterSelect) StringX(ctx context.Context) string {
+	v, err := ecs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ecs *EventCounterSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ecs.fields) > 1 {
+		return nil, errors.New("ent: EventCounterSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ecs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ecs *EventCounterSelect) IntsX(ctx context.Context) []int {
+	v, err := ecs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ecs *EventCounterSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ecs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{eventcounter.Label}
+	default:
+		err = fmt.Errorf("ent: EventCounterSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ecs *EventCounterSelect) IntX(ctx context.Context) int {
+	v, err := ecs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ecs *EventCounterSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ecs.fields) > 1 {
+		return nil, errors.New("ent: EventCounterSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ecs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ecs *EventCounterSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ecs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ecs *EventCounterSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ecs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{eventcounter.Label}
+	default:
+		err = fmt.Errorf("ent: EventCounterSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ecs *EventCounterSelect) Float64X(ctx context.Context) float64 {
+	v, err := ecs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ecs *EventCounterSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ecs.fields) > 1 {
+		return nil, errors.New("ent: EventCounterSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ecs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ecs *EventCounterSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ecs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ecs *EventCounterSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ecs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{eventcounter.Label}
+	default:
+		err = fmt.Errorf("ent: EventCounterSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ecs *EventCounterSelect) BoolX(ctx context.Context) bool {
+	v, err := ecs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ecs *EventCounterSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ecs.sqlQuery().Query()
+	if err := ecs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ecs *EventCounterSelect) sqlQuery() sql.Querier {
+	selector := ecs.sql
+	selector.Select(selector.Columns(ecs.fields...)...)
+	return selector
+}
@@ -0,0 +1,591 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/eventcounter"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// EventCounterUpdate is the builder for updating EventCounter entities.
+type EventCounterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventCounterMutation
+}
+
+// Where adds a new predicate for the EventCounterUpdate builder.
+func (ecu *EventCounterUpdate) Where(ps ...predicate.EventCounter) *EventCounterUpdate {
+	ecu.mutation.predicates = append(ecu.mutation.predicates, ps...)
+	return ecu
+}
+
+// SetNamespace sets the "namespace" field.
+func (ecu *EventCounterUpdate) SetNamespace(s string) *EventCounterUpdate {
+	ecu.mutation.SetNamespace(s)
+	return ecu
+}
+
+// SetType sets the "type" field.
+func (ecu *EventCounterUpdate) SetType(s string) *EventCounterUpdate {
+	ecu.mutation.SetType(s)
+	return ecu
+}
+
+// SetReceived sets the "received" field.
+func (ecu *EventCounterUpdate) SetReceived(i int64) *EventCounterUpdate {
+	ecu.mutation.ResetReceived()
+	ecu.mutation.SetReceived(i)
+	return ecu
+}
+
+// SetNillableReceived sets the "received" field if the given value is not nil.
+func (ecu *EventCounterUpdate) SetNillableReceived(i *int64) *EventCounterUpdate {
+	if i != nil {
+		ecu.SetReceived(*i)
+	}
+	return ecu
+}
+
+// AddReceived adds i to the "received" field.
+func (ecu *EventCounterUpdate) AddReceived(i int64) *EventCounterUpdate {
+	ecu.mutation.AddReceived(i)
+	return ecu
+}
+
+// SetMatched sets the "matched" field.
+func (ecu *EventCounterUpdate) SetMatched(i int64) *EventCounterUpdate {
+	ecu.mutation.ResetMatched()
+	ecu.mutation.SetMatched(i)
+	return ecu
+}
+
+// SetNillableMatched sets the "matched" field if the given value is not nil.
+func (ecu *EventCounterUpdate) SetNillableMatched(i *int64) *EventCounterUpdate {
+	if i != nil {
+		ecu.SetMatched(*i)
+	}
+	return ecu
+}
+
+// AddMatched adds i to the "matched" field.
+func (ecu *EventCounterUpdate) AddMatched(i int64) *EventCounterUpdate {
+	ecu.mutation.AddMatched(i)
+	return ecu
+}
+
+// SetUnmatched sets the "unmatched" field.
+func (ecu *EventCounterUpdate) SetUnmatched(i int64) *EventCounterUpdate {
+	ecu.mutation.ResetUnmatched()
+	ecu.mutation.SetUnmatched(i)
+	return ecu
+}
+
+// SetNillableUnmatched sets the "unmatched" field if the given value is not nil.
+func (ecu *EventCounterUpdate) SetNillableUnmatched(i *int64) *EventCounterUpdate {
+	if i != nil {
+		ecu.SetUnmatched(*i)
+	}
+	return ecu
+}
+
+// AddUnmatched adds i to the "unmatched" field.
+func (ecu *EventCounterUpdate) AddUnmatched(i int64) *EventCounterUpdate {
+	ecu.mutation.AddUnmatched(i)
+	return ecu
+}
+
+// SetRejected sets the "rejected" field.
+func (ecu *EventCounterUpdate) SetRejected(i int64) *EventCounterUpdate {
+	ecu.mutation.ResetRejected()
+	ecu.mutation.SetRejected(i)
+	return ecu
+}
+
+// SetNillableRejected sets the "rejected" field if the given value is not nil.
+func (ecu *EventCounterUpdate) SetNillableRejected(i *int64) *EventCounterUpdate {
+	if i != nil {
+		ecu.SetRejected(*i)
+	}
+	return ecu
+}
+
+// AddRejected adds i to the "rejected" field.
+func (ecu *EventCounterUpdate) AddRejected(i int64) *EventCounterUpdate {
+	ecu.mutation.AddRejected(i)
+	return ecu
+}
+
+// SetLastReceived sets the "last_received" field.
+func (ecu *EventCounterUpdate) SetLastReceived(t time.Time) *EventCounterUpdate {
+	ecu.mutation.SetLastReceived(t)
+	return ecu
+}
+
+// Mutation returns the EventCounterMutation object of the builder.
+func (ecu *EventCounterUpdate) Mutation() *EventCounterMutation {
+	return ecu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ecu *EventCounterUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ecu.hooks) == 0 {
+		affected, err = ecu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EventCounterMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ecu.mutation = mutation
+			affected, err = ecu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ecu.hooks) - 1; i >= 0; i-- {
+			mut = ecu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ecu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecu *EventCounterUpdate) SaveX(ctx context.Context) int {
+	affected, err := ecu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ecu *EventCounterUpdate) Exec(ctx context.Context) error {
+	_, err := ecu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecu *EventCounterUpdate) ExecX(ctx context.Context) {
+	if err := ecu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ecu *EventCounterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   eventcounter.Table,
+			Columns: eventcounter.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: eventcounter.FieldID,
+			},
+		},
+	}
+	if ps := ecu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ecu.mutation.Namespace(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: eventcounter.FieldNamespace,
+		})
+	}
+	if value, ok := ecu.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: eventcounter.FieldType,
+		})
+	}
+	if value, ok := ecu.mutation.Received(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldReceived,
+		})
+	}
+	if value, ok := ecu.mutation.AddedReceived(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldReceived,
+		})
+	}
+	if value, ok := ecu.mutation.Matched(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldMatched,
+		})
+	}
+	if value, ok := ecu.mutation.AddedMatched(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldMatched,
+		})
+	}
+	if value, ok := ecu.mutation.Unmatched(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldUnmatched,
+		})
+	}
+	if value, ok := ecu.mutation.AddedUnmatched(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldUnmatched,
+		})
+	}
+	if value, ok := ecu.mutation.Rejected(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldRejected,
+		})
+	}
+	if value, ok := ecu.mutation.AddedRejected(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldRejected,
+		})
+	}
+	if value, ok := ecu.mutation.LastReceived(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: eventcounter.FieldLastReceived,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ecu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventcounter.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// EventCounterUpdateOne is the builder for updating a single EventCounter entity.
+type EventCounterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventCounterMutation
+}
+
+// SetNamespace sets the "namespace" field.
+func (ecuo *EventCounterUpdateOne) SetNamespace(s string) *EventCounterUpdateOne {
+	ecuo.mutation.SetNamespace(s)
+	return ecuo
+}
+
+// SetType sets the "type" field.
+func (ecuo *EventCounterUpdateOne) SetType(s string) *EventCounterUpdateOne {
+	ecuo.mutation.SetType(s)
+	return ecuo
+}
+
+// SetReceived sets the "received" field.
+func (ecuo *EventCounterUpdateOne) SetReceived(i int64) *EventCounterUpdateOne {
+	ecuo.mutation.ResetReceived()
+	ecuo.mutation.SetReceived(i)
+	return ecuo
+}
+
+// SetNillableReceived sets the "received" field if the given value is not nil.
+func (ecuo *EventCounterUpdateOne) SetNillableReceived(i *int64) *EventCounterUpdateOne {
+	if i != nil {
+		ecuo.SetReceived(*i)
+	}
+	return ecuo
+}
+
+// AddReceived adds i to the "received" field.
+func (ecuo *EventCounterUpdateOne) AddReceived(i int64) *EventCounterUpdateOne {
+	ecuo.mutation.AddReceived(i)
+	return ecuo
+}
+
+// SetMatched sets the "matched" field.
+func (ecuo *EventCounterUpdateOne) SetMatched(i int64) *EventCounterUpdateOne {
+	ecuo.mutation.ResetMatched()
+	ecuo.mutation.SetMatched(i)
+	return ecuo
+}
+
+// SetNillableMatched sets the "matched" field if the given value is not nil.
+func (ecuo *EventCounterUpdateOne) SetNillableMatched(i *int64) *EventCounterUpdateOne {
+	if i != nil {
+		ecuo.SetMatched(*i)
+	}
+	return ecuo
+}
+
+// AddMatched adds i to the "matched" field.
+func (ecuo *EventCounterUpdateOne) AddMatched(i int64) *EventCounterUpdateOne {
+	ecuo.mutation.AddMatched(i)
+	return ecuo
+}
+
+// SetUnmatched sets the "unmatched" field.
+func (ecuo *EventCounterUpdateOne) SetUnmatched(i int64) *EventCounterUpdateOne {
+	ecuo.mutation.ResetUnmatched()
+	ecuo.mutation.SetUnmatched(i)
+	return ecuo
+}
+
+// SetNillableUnmatched sets the "unmatched" field if the given value is not nil.
+func (ecuo *EventCounterUpdateOne) SetNillableUnmatched(i *int64) *EventCounterUpdateOne {
+	if i != nil {
+		ecuo.SetUnmatched(*i)
+	}
+	return ecuo
+}
+
+// AddUnmatched adds i to the "unmatched" field.
+func (ecuo *EventCounterUpdateOne) AddUnmatched(i int64) *EventCounterUpdateOne {
+	ecuo.mutation.AddUnmatched(i)
+	return ecuo
+}
+
+// SetRejected sets the "rejected" field.
+func (ecuo *EventCounterUpdateOne) SetRejected(i int64) *EventCounterUpdateOne {
+	ecuo.mutation.ResetRejected()
+	ecuo.mutation.SetRejected(i)
+	return ecuo
+}
+
+// SetNillableRejected sets the "rejected" field if the given value is not nil.
+func (ecuo *EventCounterUpdateOne) SetNillableRejected(i *int64) *EventCounterUpdateOne {
+	if i != nil {
+		ecuo.SetRejected(*i)
+	}
+	return ecuo
+}
+
+// AddRejected adds i to the "rejected" field.
+func (ecuo *EventCounterUpdateOne) AddRejected(i int64) *EventCounterUpdateOne {
+	ecuo.mutation.AddRejected(i)
+	return ecuo
+}
+
+// SetLastReceived sets the "last_received" field.
+func (ecuo *EventCounterUpdateOne) SetLastReceived(t time.Time) *EventCounterUpdateOne {
+	ecuo.mutation.SetLastReceived(t)
+	return ecuo
+}
+
+// Mutation returns the EventCounterMutation object of the builder.
+func (ecuo *EventCounterUpdateOne) Mutation() *EventCounterMutation {
+	return ecuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ecuo *EventCounterUpdateOne) Select(field string, fields ...string) *EventCounterUpdateOne {
+	ecuo.fields = append([]string{field}, fields...)
+	return ecuo
+}
+
+// Save executes the query and returns the updated EventCounter entity.
+func (ecuo *EventCounterUpdateOne) Save(ctx context.Context) (*EventCounter, error) {
+	var (
+		err  error
+		node *EventCounter
+	)
+	if len(ecuo.hooks) == 0 {
+		node, err = ecuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EventCounterMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ecuo.mutation = mutation
+			node, err = ecuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ecuo.hooks) - 1; i >= 0; i-- {
+			mut = ecuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ecuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecuo *EventCounterUpdateOne) SaveX(ctx context.Context) *EventCounter {
+	node, err := ecuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ecuo *EventCounterUpdateOne) Exec(ctx context.Context) error {
+	_, err := ecuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecuo *EventCounterUpdateOne) ExecX(ctx context.Context) {
+	if err := ecuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ecuo *EventCounterUpdateOne) sqlSave(ctx context.Context) (_node *EventCounter, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   eventcounter.Table,
+			Columns: eventcounter.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: eventcounter.FieldID,
+			},
+		},
+	}
+	id, ok := ecuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing EventCounter.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ecuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventcounter.FieldID)
+		for _, f := range fields {
+			if !eventcounter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != eventcounter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ecuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ecuo.mutation.Namespace(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: eventcounter.FieldNamespace,
+		})
+	}
+	if value, ok := ecuo.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: eventcounter.FieldType,
+		})
+	}
+	if value, ok := ecuo.mutation.Received(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldReceived,
+		})
+	}
+	if value, ok := ecuo.mutation.AddedReceived(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldReceived,
+		})
+	}
+	if value, ok := ecuo.mutation.Matched(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldMatched,
+		})
+	}
+	if value, ok := ecuo.mutation.AddedMatched(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldMatched,
+		})
+	}
+	if value, ok := ecuo.mutation.Unmatched(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldUnmatched,
+		})
+	}
+	if value, ok := ecuo.mutation.AddedUnmatched(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldUnmatched,
+		})
+	}
+	if value, ok := ecuo.mutation.Rejected(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldRejected,
+		})
+	}
+	if value, ok := ecuo.mutation.AddedRejected(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: eventcounter.FieldRejected,
+		})
+	}
+	if value, ok := ecuo.mutation.LastReceived(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: eventcounter.FieldLastReceived,
+		})
+	}
+	_node = &EventCounter{config: ecuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ecuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventcounter.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -9,6 +9,71 @@ import (
 	"github.com/vorteil/direktiv/ent"
 )
 
+// The EventCounterFunc type is an adapter to allow the use of ordinary
+// function as EventCounter mutator.
+type EventCounterFunc func(context.Context, *ent.EventCounterMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EventCounterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.EventCounterMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EventCounterMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The InstanceHistoryFunc type is an adapter to allow the use of ordinary
+// function as InstanceHistory mutator.
+type InstanceHistoryFunc func(context.Context, *ent.InstanceHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InstanceHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.InstanceHistoryMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InstanceHistoryMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The JQModuleFunc type is an adapter to allow the use of ordinary
+// function as JQModule mutator.
+type JQModuleFunc func(context.Context, *ent.JQModuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JQModuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.JQModuleMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JQModuleMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The JSONSchemaFunc type is an adapter to allow the use of ordinary
+// function as JSONSchema mutator.
+type JSONSchemaFunc func(context.Context, *ent.JSONSchemaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JSONSchemaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.JSONSchemaMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JSONSchemaMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The LockLeaseFunc type is an adapter to allow the use of ordinary
+// function as LockLease mutator.
+type LockLeaseFunc func(context.Context, *ent.LockLeaseMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LockLeaseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.LockLeaseMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LockLeaseMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The NamespaceFunc type is an adapter to allow the use of ordinary
 // function as Namespace mutator.
 type NamespaceFunc func(context.Context, *ent.NamespaceMutation) (ent.Value, error)
@@ -22,6 +87,110 @@ func (f NamespaceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return f(ctx, mv)
 }
 
+// The NodeFunc type is an adapter to allow the use of ordinary
+// function as Node mutator.
+type NodeFunc func(context.Context, *ent.NodeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.NodeMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NodeMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The QueuedInvocationFunc type is an adapter to allow the use of ordinary
+// function as QueuedInvocation mutator.
+type QueuedInvocationFunc func(context.Context, *ent.QueuedInvocationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QueuedInvocationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.QueuedInvocationMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QueuedInvocationMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The QueuedStateFunc type is an adapter to allow the use of ordinary
+// function as QueuedState mutator.
+type QueuedStateFunc func(context.Context, *ent.QueuedStateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QueuedStateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.QueuedStateMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QueuedStateMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ScheduledInvocationFunc type is an adapter to allow the use of ordinary
+// function as ScheduledInvocation mutator.
+type ScheduledInvocationFunc func(context.Context, *ent.ScheduledInvocationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduledInvocationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ScheduledInvocationMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScheduledInvocationMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The SettingFunc type is an adapter to allow the use of ordinary
+// function as Setting mutator.
+type SettingFunc func(context.Context, *ent.SettingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.SettingMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SettingMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The TimerFunc type is an adapter to allow the use of ordinary
+// function as Timer mutator.
+type TimerFunc func(context.Context, *ent.TimerMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TimerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.TimerMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TimerMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The UnmatchedEventFunc type is an adapter to allow the use of ordinary
+// function as UnmatchedEvent mutator.
+type UnmatchedEventFunc func(context.Context, *ent.UnmatchedEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UnmatchedEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.UnmatchedEventMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UnmatchedEventMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The WasmModuleFunc type is an adapter to allow the use of ordinary
+// function as WasmModule mutator.
+type WasmModuleFunc func(context.Context, *ent.WasmModuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WasmModuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.WasmModuleMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WasmModuleMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The WorkflowFunc type is an adapter to allow the use of ordinary
 // function as Workflow mutator.
 type WorkflowFunc func(context.Context, *ent.WorkflowMutation) (ent.Value, error)
@@ -74,6 +243,19 @@ func (f WorkflowInstanceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return f(ctx, mv)
 }
 
+// The WorkflowRevisionFunc type is an adapter to allow the use of ordinary
+// function as WorkflowRevision mutator.
+type WorkflowRevisionFunc func(context.Context, *ent.WorkflowRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WorkflowRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.WorkflowRevisionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WorkflowRevisionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -169,7 +351,6 @@ func HasFields(field string, fields ...string) Condition {
 // If executes the given hook under condition.
 //
 //	hook.If(ComputeAverage, And(HasFields(...), HasAddedFields(...)))
-//
 func If(hk ent.Hook, cond Condition) ent.Hook {
 	return func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
@@ -184,7 +365,6 @@ func If(hk ent.Hook, cond Condition) ent.Hook {
 // On executes the given hook only for the given operation.
 //
 //	hook.On(Log, ent.Delete|ent.Create)
-//
 func On(hk ent.Hook, op ent.Op) ent.Hook {
 	return If(hk, HasOp(op))
 }
@@ -192,7 +372,6 @@ func On(hk ent.Hook, op ent.Op) ent.Hook {
 // Unless skips the given hook only for the given operation.
 //
 //	hook.Unless(Log, ent.Update|ent.UpdateOne)
-//
 func Unless(hk ent.Hook, op ent.Op) ent.Hook {
 	return If(hk, Not(HasOp(op)))
 }
@@ -213,7 +392,6 @@ func FixedError(err error) ent.Hook {
 //			Reject(ent.Delete|ent.Update),
 //		}
 //	}
-//
 func Reject(op ent.Op) ent.Hook {
 	hk := FixedError(fmt.Errorf("%s operation is not allowed", op))
 	return On(hk, op)
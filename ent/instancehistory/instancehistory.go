@@ -0,0 +1,42 @@
+// Code generated by entc, DO NOT EDIT.
+
+package instancehistory
+
+const (
+	// Label holds the string label denoting the instancehistory type in the database.
+	Label = "instance_history"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldInstanceID holds the string denoting the instanceid field in the database.
+	FieldInstanceID = "instance_id"
+	// FieldStep holds the string denoting the step field in the database.
+	FieldStep = "step"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// FieldTime holds the string denoting the time field in the database.
+	FieldTime = "time"
+	// FieldData holds the string denoting the data field in the database.
+	FieldData = "data"
+	// Table holds the table name of the instancehistory in the database.
+	Table = "instance_histories"
+)
+
+// Columns holds all SQL columns for instancehistory fields.
+var Columns = []string{
+	FieldID,
+	FieldInstanceID,
+	FieldStep,
+	FieldRevision,
+	FieldTime,
+	FieldData,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
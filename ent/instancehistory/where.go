@@ -0,0 +1,575 @@
+// Code generated by entc, DO NOT EDIT.
+
+package instancehistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// InstanceID applies equality check predicate on the "instanceID" field. It's identical to InstanceIDEQ.
+func InstanceID(v string) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInstanceID), v))
+	})
+}
+
+// Step applies equality check predicate on the "step" field. It's identical to StepEQ.
+func Step(v int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStep), v))
+	})
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevision), v))
+	})
+}
+
+// Time applies equality check predicate on the "time" field. It's identical to TimeEQ.
+func Time(v time.Time) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTime), v))
+	})
+}
+
+// Data applies equality check predicate on the "data" field. It's identical to DataEQ.
+func Data(v []byte) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldData), v))
+	})
+}
+
+// InstanceIDEQ applies the EQ predicate on the "instanceID" field.
+func InstanceIDEQ(v string) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDNEQ applies the NEQ predicate on the "instanceID" field.
+func InstanceIDNEQ(v string) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDIn applies the In predicate on the "instanceID" field.
+func InstanceIDIn(vs ...string) predicate.InstanceHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldInstanceID), v...))
+	})
+}
+
+// InstanceIDNotIn applies the NotIn predicate on the "instanceID" field.
+func InstanceIDNotIn(vs ...string) predicate.InstanceHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldInstanceID), v...))
+	})
+}
+
+// InstanceIDGT applies the GT predicate on the "instanceID" field.
+func InstanceIDGT(v string) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDGTE applies the GTE predicate on the "instanceID" field.
+func InstanceIDGTE(v string) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDLT applies the LT predicate on the "instanceID" field.
+func InstanceIDLT(v string) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDLTE applies the LTE predicate on the "instanceID" field.
+func InstanceIDLTE(v string) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDContains applies the Contains predicate on the "instanceID" field.
+func InstanceIDContains(v string) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDHasPrefix applies the HasPrefix predicate on the "instanceID" field.
+func InstanceIDHasPrefix(v string) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDHasSuffix applies the HasSuffix predicate on the "instanceID" field.
+func InstanceIDHasSuffix(v string) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDEqualFold applies the EqualFold predicate on the "instanceID" field.
+func InstanceIDEqualFold(v string) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDContainsFold applies the ContainsFold predicate on the "instanceID" field.
+func InstanceIDContainsFold(v string) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldInstanceID), v))
+	})
+}
+
+// StepEQ applies the EQ predicate on the "step" field.
+func StepEQ(v int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStep), v))
+	})
+}
+
+// StepNEQ applies the NEQ predicate on the "step" field.
+func StepNEQ(v int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStep), v))
+	})
+}
+
+// StepIn applies the In predicate on the "step" field.
+func StepIn(vs ...int) predicate.InstanceHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStep), v...))
+	})
+}
+
+// StepNotIn applies the NotIn predicate on the "step" field.
+func StepNotIn(vs ...int) predicate.InstanceHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStep), v...))
+	})
+}
+
+// StepGT applies the GT predicate on the "step" field.
+func StepGT(v int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStep), v))
+	})
+}
+
+// StepGTE applies the GTE predicate on the "step" field.
+func StepGTE(v int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStep), v))
+	})
+}
+
+// StepLT applies the LT predicate on the "step" field.
+func StepLT(v int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStep), v))
+	})
+}
+
+// StepLTE applies the LTE predicate on the "step" field.
+func StepLTE(v int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStep), v))
+	})
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevision), v))
+	})
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRevision), v))
+	})
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int) predicate.InstanceHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRevision), v...))
+	})
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int) predicate.InstanceHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRevision), v...))
+	})
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRevision), v))
+	})
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRevision), v))
+	})
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRevision), v))
+	})
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRevision), v))
+	})
+}
+
+// TimeEQ applies the EQ predicate on the "time" field.
+func TimeEQ(v time.Time) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTime), v))
+	})
+}
+
+// TimeNEQ applies the NEQ predicate on the "time" field.
+func TimeNEQ(v time.Time) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTime), v))
+	})
+}
+
+// TimeIn applies the In predicate on the "time" field.
+func TimeIn(vs ...time.Time) predicate.InstanceHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTime), v...))
+	})
+}
+
+// TimeNotIn applies the NotIn predicate on the "time" field.
+func TimeNotIn(vs ...time.Time) predicate.InstanceHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTime), v...))
+	})
+}
+
+// TimeGT applies the GT predicate on the "time" field.
+func TimeGT(v time.Time) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTime), v))
+	})
+}
+
+// TimeGTE applies the GTE predicate on the "time" field.
+func TimeGTE(v time.Time) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTime), v))
+	})
+}
+
+// TimeLT applies the LT predicate on the "time" field.
+func TimeLT(v time.Time) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTime), v))
+	})
+}
+
+// TimeLTE applies the LTE predicate on the "time" field.
+func TimeLTE(v time.Time) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTime), v))
+	})
+}
+
+// DataEQ applies the EQ predicate on the "data" field.
+func DataEQ(v []byte) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldData), v))
+	})
+}
+
+// DataNEQ applies the NEQ predicate on the "data" field.
+func DataNEQ(v []byte) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldData), v))
+	})
+}
+
+// DataIn applies the In predicate on the "data" field.
+func DataIn(vs ...[]byte) predicate.InstanceHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldData), v...))
+	})
+}
+
+// DataNotIn applies the NotIn predicate on the "data" field.
+func DataNotIn(vs ...[]byte) predicate.InstanceHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldData), v...))
+	})
+}
+
+// DataGT applies the GT predicate on the "data" field.
+func DataGT(v []byte) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldData), v))
+	})
+}
+
+// DataGTE applies the GTE predicate on the "data" field.
+func DataGTE(v []byte) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldData), v))
+	})
+}
+
+// DataLT applies the LT predicate on the "data" field.
+func DataLT(v []byte) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldData), v))
+	})
+}
+
+// DataLTE applies the LTE predicate on the "data" field.
+func DataLTE(v []byte) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldData), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InstanceHistory) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InstanceHistory) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InstanceHistory) predicate.InstanceHistory {
+	return predicate.InstanceHistory(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,253 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/instancehistory"
+)
+
+// InstanceHistoryCreate is the builder for creating a InstanceHistory entity.
+type InstanceHistoryCreate struct {
+	config
+	mutation *InstanceHistoryMutation
+	hooks    []Hook
+}
+
+// SetInstanceID sets the "instanceID" field.
+func (ihc *InstanceHistoryCreate) SetInstanceID(s string) *InstanceHistoryCreate {
+	ihc.mutation.SetInstanceID(s)
+	return ihc
+}
+
+// SetStep sets the "step" field.
+func (ihc *InstanceHistoryCreate) SetStep(i int) *InstanceHistoryCreate {
+	ihc.mutation.SetStep(i)
+	return ihc
+}
+
+// SetRevision sets the "revision" field.
+func (ihc *InstanceHistoryCreate) SetRevision(i int) *InstanceHistoryCreate {
+	ihc.mutation.SetRevision(i)
+	return ihc
+}
+
+// SetTime sets the "time" field.
+func (ihc *InstanceHistoryCreate) SetTime(t time.Time) *InstanceHistoryCreate {
+	ihc.mutation.SetTime(t)
+	return ihc
+}
+
+// SetData sets the "data" field.
+func (ihc *InstanceHistoryCreate) SetData(b []byte) *InstanceHistoryCreate {
+	ihc.mutation.SetData(b)
+	return ihc
+}
+
+// Mutation returns the InstanceHistoryMutation object of the builder.
+func (ihc *InstanceHistoryCreate) Mutation() *InstanceHistoryMutation {
+	return ihc.mutation
+}
+
+// Save creates the InstanceHistory in the database.
+func (ihc *InstanceHistoryCreate) Save(ctx context.Context) (*InstanceHistory, error) {
+	var (
+		err  error
+		node *InstanceHistory
+	)
+	if len(ihc.hooks) == 0 {
+		if err = ihc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ihc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InstanceHistoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ihc.check(); err != nil {
+				return nil, err
+			}
+			ihc.mutation = mutation
+			node, err = ihc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ihc.hooks) - 1; i >= 0; i-- {
+			mut = ihc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ihc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ihc *InstanceHistoryCreate) SaveX(ctx context.Context) *InstanceHistory {
+	v, err := ihc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ihc *InstanceHistoryCreate) check() error {
+	if _, ok := ihc.mutation.InstanceID(); !ok {
+		return &ValidationError{Name: "instanceID", err: errors.New("ent: missing required field \"instanceID\"")}
+	}
+	if _, ok := ihc.mutation.Step(); !ok {
+		return &ValidationError{Name: "step", err: errors.New("ent: missing required field \"step\"")}
+	}
+	if _, ok := ihc.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New("ent: missing required field \"revision\"")}
+	}
+	if _, ok := ihc.mutation.Time(); !ok {
+		return &ValidationError{Name: "time", err: errors.New("ent: missing required field \"time\"")}
+	}
+	if _, ok := ihc.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New("ent: missing required field \"data\"")}
+	}
+	return nil
+}
+
+func (ihc *InstanceHistoryCreate) sqlSave(ctx context.Context) (*InstanceHistory, error) {
+	_node, _spec := ihc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ihc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ihc *InstanceHistoryCreate) createSpec() (*InstanceHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InstanceHistory{config: ihc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: instancehistory.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: instancehistory.FieldID,
+			},
+		}
+	)
+	if value, ok := ihc.mutation.InstanceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: instancehistory.FieldInstanceID,
+		})
+		_node.InstanceID = value
+	}
+	if value, ok := ihc.mutation.Step(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: instancehistory.FieldStep,
+		})
+		_node.Step = value
+	}
+	if value, ok := ihc.mutation.Revision(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: instancehistory.FieldRevision,
+		})
+		_node.Revision = value
+	}
+	if value, ok := ihc.mutation.Time(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: instancehistory.FieldTime,
+		})
+		_node.Time = value
+	}
+	if value, ok := ihc.mutation.Data(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: instancehistory.FieldData,
+		})
+		_node.Data = value
+	}
+	return _node, _spec
+}
+
+// InstanceHistoryCreateBulk is the builder for creating many InstanceHistory entities in bulk.
+type InstanceHistoryCreateBulk struct {
+	config
+	builders []*InstanceHistoryCreate
+}
+
+// Save creates the InstanceHistory entities in the database.
+func (ihcb *InstanceHistoryCreateBulk) Save(ctx context.Context) ([]*InstanceHistory, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ihcb.builders))
+	nodes := make([]*InstanceHistory, len(ihcb.builders))
+	mutators := make([]Mutator, len(ihcb.builders))
+	for i := range ihcb.builders {
+		func(i int, root context.Context) {
+			builder := ihcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InstanceHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ihcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ihcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ihcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ihcb *InstanceHistoryCreateBulk) SaveX(ctx context.Context) []*InstanceHistory {
+	v, err := ihcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,904 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/instancehistory"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// InstanceHistoryQuery is the builder for querying InstanceHistory entities.
+type InstanceHistoryQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.InstanceHistory
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InstanceHistoryQuery builder.
+func (ihq *InstanceHistoryQuery) Where(ps ...predicate.InstanceHistory) *InstanceHistoryQuery {
+	ihq.predicates = append(ihq.predicates, ps...)
+	return ihq
+}
+
+// Limit adds a limit step to the query.
+func (ihq *InstanceHistoryQuery) Limit(limit int) *InstanceHistoryQuery {
+	ihq.limit = &limit
+	return ihq
+}
+
+// Offset adds an offset step to the query.
+func (ihq *InstanceHistoryQuery) Offset(offset int) *InstanceHistoryQuery {
+	ihq.offset = &offset
+	return ihq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ihq *InstanceHistoryQuery) Unique(unique bool) *InstanceHistoryQuery {
+	ihq.unique = &unique
+	return ihq
+}
+
+// Order adds an order step to the query.
+func (ihq *InstanceHistoryQuery) Order(o ...OrderFunc) *InstanceHistoryQuery {
+	ihq.order = append(ihq.order, o...)
+	return ihq
+}
+
+// First returns the first InstanceHistory entity from the query.
+// Returns a *NotFoundError when no InstanceHistory was found.
+func (ihq *InstanceHistoryQuery) First(ctx context.Context) (*InstanceHistory, error) {
+	nodes, err := ihq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{instancehistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ihq *InstanceHistoryQuery) FirstX(ctx context.Context) *InstanceHistory {
+	node, err := ihq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InstanceHistory ID from the query.
+// Returns a *NotFoundError when no InstanceHistory ID was found.
+func (ihq *InstanceHistoryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ihq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{instancehistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ihq *InstanceHistoryQuery) FirstIDX(ctx context.Context) int {
+	id, err := ihq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InstanceHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one InstanceHistory entity is not found.
+// Returns a *NotFoundError when no InstanceHistory entities are found.
+func (ihq *InstanceHistoryQuery) Only(ctx context.Context) (*InstanceHistory, error) {
+	nodes, err := ihq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{instancehistory.Label}
+	default:
+		return nil, &NotSingularError{instancehistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ihq *InstanceHistoryQuery) OnlyX(ctx context.Context) *InstanceHistory {
+	node, err := ihq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InstanceHistory ID in the query.
+// Returns a *NotSingularError when exactly one InstanceHistory ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (ihq *InstanceHistoryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ihq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{instancehistory.Label}
+	default:
+		err = &NotSingularError{instancehistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ihq *InstanceHistoryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ihq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InstanceHistories.
+func (ihq *InstanceHistoryQuery) All(ctx context.Context) ([]*InstanceHistory, error) {
+	if err := ihq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ihq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ihq *InstanceHistoryQuery) AllX(ctx context.Context) []*InstanceHistory {
+	nodes, err := ihq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InstanceHistory IDs.
+func (ihq *InstanceHistoryQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := ihq.Select(instancehistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ihq *InstanceHistoryQuery) IDsX(ctx context.Context) []int {
+	ids, err := ihq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ihq *InstanceHistoryQuery) Count(ctx context.Context) (int, error) {
+	if err := ihq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ihq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ihq *InstanceHistoryQuery) CountX(ctx context.Context) int {
+	count, err := ihq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ihq *InstanceHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ihq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ihq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ihq *InstanceHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := ihq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InstanceHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ihq *InstanceHistoryQuery) Clone() *InstanceHistoryQuery {
+	if ihq == nil {
+		return nil
+	}
+	return &InstanceHistoryQuery{
+		config:     ihq.config,
+		limit:      ihq.limit,
+		offset:     ihq.offset,
+		order:      append([]OrderFunc{}, ihq.order...),
+		predicates: append([]predicate.InstanceHistory{}, ihq.predicates...),
+		// clone intermediate query.
+		sql:  ihq.sql.Clone(),
+		path: ihq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		InstanceID string `json:"instanceID,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InstanceHistory.Query().
+//		GroupBy(instancehistory.FieldInstanceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ihq *InstanceHistoryQuery) GroupBy(field string, fields ...string) *InstanceHistoryGroupBy {
+	group := &InstanceHistoryGroupBy{config: ihq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ihq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ihq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		InstanceID string `json:"instanceID,omitempty"`
+//	}
+//
+//	client.InstanceHistory.Query().
+//		Select(instancehistory.FieldInstanceID).
+//		Scan(ctx, &v)
+func (ihq *InstanceHistoryQuery) Select(field string, fields ...string) *InstanceHistorySelect {
+	ihq.fields = append([]string{field}, fields...)
+	return &InstanceHistorySelect{InstanceHistoryQuery: ihq}
+}
+
+func (ihq *InstanceHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ihq.fields {
+		if !instancehistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ihq.path != nil {
+		prev, err := ihq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ihq.sql = prev
+	}
+	return nil
+}
+
+func (ihq *InstanceHistoryQuery) sqlAll(ctx context.Context) ([]*InstanceHistory, error) {
+	var (
+		nodes = []*InstanceHistory{}
+		_spec = ihq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &InstanceHistory{config: ihq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ihq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ihq *InstanceHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ihq.querySpec()
+	return sqlgraph.CountNodes(ctx, ihq.driver, _spec)
+}
+
+func (ihq *InstanceHistoryQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ihq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ihq *InstanceHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   instancehistory.Table,
+			Columns: instancehistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: instancehistory.FieldID,
+			},
+		},
+		From:   ihq.sql,
+		Unique: true,
+	}
+	if unique := ihq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ihq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, instancehistory.FieldID)
+		for i := range fields {
+			if fields[i] != instancehistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ihq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ihq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ihq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ihq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ihq *InstanceHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ihq.driver.Dialect())
+	t1 := builder.Table(instancehistory.Table)
+	selector := builder.Select(t1.Columns(instancehistory.Columns...)...).From(t1)
+	if ihq.sql != nil {
+		selector = ihq.sql
+		selector.Select(selector.Columns(instancehistory.Columns...)...)
+	}
+	for _, p := range ihq.predicates {
+		p(selector)
+	}
+	for _, p := range ihq.order {
+		p(selector)
+	}
+	if offset := ihq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ihq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// InstanceHistoryGroupBy is the group-by builder for InstanceHistory entities.
+type InstanceHistoryGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ihgb *InstanceHistoryGroupBy) Aggregate(fns ...AggregateFunc) *InstanceHistoryGroupBy {
+	ihgb.fns = append(ihgb.fns, fns...)
+	return ihgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ihgb *InstanceHistoryGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ihgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ihgb.sql = query
+	return ihgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ihgb *InstanceHistoryGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := ihgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *InstanceHistoryGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(ihgb.fields) > 1 {
+		return nil, errors.New("ent: InstanceHistoryGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := ihgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ihgb *InstanceHistoryGroupBy) StringsX(ctx context.Context) []string {
+	v, err := ihgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *InstanceHistoryGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ihgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{instancehistory.Label}
+	default:
+		err = fmt.Errorf("ent: InstanceHistoryGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ihgb *InstanceHistoryGroupBy) StringX(ctx context.Context) string {
+	v, err := ihgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *InstanceHistoryGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(ihgb.fields) > 1 {
+		return nil, errors.New("ent: InstanceHistoryGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := ihgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ihgb *InstanceHistoryGroupBy) IntsX(ctx context.Context) []int {
+	v, err := ihgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *InstanceHistoryGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ihgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{instancehistory.Label}
+	default:
+		err = fmt.Errorf("ent: InstanceHistoryGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ihgb *InstanceHistoryGroupBy) IntX(ctx context.Context) int {
+	v, err := ihgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *InstanceHistoryGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ihgb.fields) > 1 {
+		return nil, errors.New("ent: InstanceHistoryGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := ihgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ihgb *InstanceHistoryGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := ihgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *InstanceHistoryGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ihgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{instancehistory.Label}
+	default:
+		err = fmt.Errorf("ent: InstanceHistoryGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ihgb *InstanceHistoryGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := ihgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *InstanceHistoryGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(ihgb.fields) > 1 {
+		return nil, errors.New("ent: InstanceHistoryGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := ihgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ihgb *InstanceHistoryGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := ihgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ihgb *InstanceHistoryGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ihgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{instancehistory.Label}
+	default:
+		err = fmt.Errorf("ent: InstanceHistoryGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ihgb *InstanceHistoryGroupBy) BoolX(ctx context.Context) bool {
+	v, err := ihgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ihgb *InstanceHistoryGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ihgb.fields {
+		if !instancehistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ihgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ihgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ihgb *InstanceHistoryGroupBy) sqlQuery() *sql.Selector {
+	selector := ihgb.sql
+	columns := make([]string, 0, len(ihgb.fields)+len(ihgb.fns))
+	columns = append(columns, ihgb.fields...)
+	for _, fn := range ihgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(ihgb.fields...)
+}
+
+// InstanceHistorySelect is the builder for selecting fields of InstanceHistory entities.
+type InstanceHistorySelect struct {
+	*InstanceHistoryQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ihs *InstanceHistorySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ihs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ihs.sql = ihs.InstanceHistoryQuery.sqlQuery(ctx)
+	return ihs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ihs *InstanceHistorySelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ihs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ihs *InstanceHistorySelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ihs.fields) > 1 {
+		return nil, errors.New("ent: InstanceHistorySelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ihs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ihs *InstanceHistorySelect) StringsX(ctx context.Context) []string {
+	v, err := ihs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ihs *InstanceHistorySelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ihs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{instancehistory.Label}
+	default:
+		err = fmt.Errorf("ent: InstanceHistorySelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ihs *InstanceHistorySelect) StringX(ctx context.Context) string {
+	v, err := ihs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ihs *InstanceHistorySelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ihs.fields) > 1 {
+		return nil, errors.New("ent: InstanceHistorySelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ihs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ihs *InstanceHistorySelect) IntsX(ctx context.Context) []int {
+	v, err := ihs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ihs *InstanceHistorySelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ihs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{instancehistory.Label}
+	default:
+		err = fmt.Errorf("ent: InstanceHistorySelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ihs *InstanceHistorySelect) IntX(ctx context.Context) int {
+	v, err := ihs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ihs *InstanceHistorySelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ihs.fields) > 1 {
+		return nil, errors.New("ent: InstanceHistorySelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ihs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ihs *InstanceHistorySelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ihs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ihs *InstanceHistorySelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ihs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{instancehistory.Label}
+	default:
+		err = fmt.Errorf("ent: InstanceHistorySelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ihs *InstanceHistorySelect) Float64X(ctx context.Context) float64 {
+	v, err := ihs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ihs *InstanceHistorySelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ihs.fields) > 1 {
+		return nil, errors.New("ent: InstanceHistorySelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ihs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ihs *InstanceHistorySelect) BoolsX(ctx context.Context) []bool {
+	v, err := ihs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ihs *InstanceHistorySelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ihs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{instancehistory.Label}
+	default:
+		err = fmt.Errorf("ent: InstanceHistorySelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ihs *InstanceHistorySelect) BoolX(ctx context.Context) bool {
+	v, err := ihs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ihs *InstanceHistorySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ihs.sqlQuery().Query()
+	if err := ihs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ihs *InstanceHistorySelect) sqlQuery() sql.Querier {
+	selector := ihs.sql
+	selector.Select(selector.Columns(ihs.fields...)...)
+	return selector
+}
@@ -0,0 +1,419 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/instancehistory"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// InstanceHistoryUpdate is the builder for updating InstanceHistory entities.
+type InstanceHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InstanceHistoryMutation
+}
+
+// Where adds a new predicate for the InstanceHistoryUpdate builder.
+func (ihu *InstanceHistoryUpdate) Where(ps ...predicate.InstanceHistory) *InstanceHistoryUpdate {
+	ihu.mutation.predicates = append(ihu.mutation.predicates, ps...)
+	return ihu
+}
+
+// SetInstanceID sets the "instanceID" field.
+func (ihu *InstanceHistoryUpdate) SetInstanceID(s string) *InstanceHistoryUpdate {
+	ihu.mutation.SetInstanceID(s)
+	return ihu
+}
+
+// SetStep sets the "step" field.
+func (ihu *InstanceHistoryUpdate) SetStep(i int) *InstanceHistoryUpdate {
+	ihu.mutation.ResetStep()
+	ihu.mutation.SetStep(i)
+	return ihu
+}
+
+// AddStep adds i to the "step" field.
+func (ihu *InstanceHistoryUpdate) AddStep(i int) *InstanceHistoryUpdate {
+	ihu.mutation.AddStep(i)
+	return ihu
+}
+
+// SetRevision sets the "revision" field.
+func (ihu *InstanceHistoryUpdate) SetRevision(i int) *InstanceHistoryUpdate {
+	ihu.mutation.ResetRevision()
+	ihu.mutation.SetRevision(i)
+	return ihu
+}
+
+// AddRevision adds i to the "revision" field.
+func (ihu *InstanceHistoryUpdate) AddRevision(i int) *InstanceHistoryUpdate {
+	ihu.mutation.AddRevision(i)
+	return ihu
+}
+
+// SetTime sets the "time" field.
+func (ihu *InstanceHistoryUpdate) SetTime(t time.Time) *InstanceHistoryUpdate {
+	ihu.mutation.SetTime(t)
+	return ihu
+}
+
+// SetData sets the "data" field.
+func (ihu *InstanceHistoryUpdate) SetData(b []byte) *InstanceHistoryUpdate {
+	ihu.mutation.SetData(b)
+	return ihu
+}
+
+// Mutation returns the InstanceHistoryMutation object of the builder.
+func (ihu *InstanceHistoryUpdate) Mutation() *InstanceHistoryMutation {
+	return ihu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ihu *InstanceHistoryUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ihu.hooks) == 0 {
+		affected, err = ihu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InstanceHistoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ihu.mutation = mutation
+			affected, err = ihu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ihu.hooks) - 1; i >= 0; i-- {
+			mut = ihu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ihu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ihu *InstanceHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := ihu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ihu *InstanceHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := ihu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ihu *InstanceHistoryUpdate) ExecX(ctx context.Context) {
+	if err := ihu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ihu *InstanceHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   instancehistory.Table,
+			Columns: instancehistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: instancehistory.FieldID,
+			},
+		},
+	}
+	if ps := ihu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ihu.mutation.InstanceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: instancehistory.FieldInstanceID,
+		})
+	}
+	if value, ok := ihu.mutation.Step(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: instancehistory.FieldStep,
+		})
+	}
+	if value, ok := ihu.mutation.AddedStep(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: instancehistory.FieldStep,
+		})
+	}
+	if value, ok := ihu.mutation.Revision(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: instancehistory.FieldRevision,
+		})
+	}
+	if value, ok := ihu.mutation.AddedRevision(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: instancehistory.FieldRevision,
+		})
+	}
+	if value, ok := ihu.mutation.Time(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: instancehistory.FieldTime,
+		})
+	}
+	if value, ok := ihu.mutation.Data(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: instancehistory.FieldData,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ihu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{instancehistory.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InstanceHistoryUpdateOne is the builder for updating a single InstanceHistory entity.
+type InstanceHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InstanceHistoryMutation
+}
+
+// SetInstanceID sets the "instanceID" field.
+func (ihuo *InstanceHistoryUpdateOne) SetInstanceID(s string) *InstanceHistoryUpdateOne {
+	ihuo.mutation.SetInstanceID(s)
+	return ihuo
+}
+
+// SetStep sets the "step" field.
+func (ihuo *InstanceHistoryUpdateOne) SetStep(i int) *InstanceHistoryUpdateOne {
+	ihuo.mutation.ResetStep()
+	ihuo.mutation.SetStep(i)
+	return ihuo
+}
+
+// AddStep adds i to the "step" field.
+func (ihuo *InstanceHistoryUpdateOne) AddStep(i int) *InstanceHistoryUpdateOne {
+	ihuo.mutation.AddStep(i)
+	return ihuo
+}
+
+// SetRevision sets the "revision" field.
+func (ihuo *InstanceHistoryUpdateOne) SetRevision(i int) *InstanceHistoryUpdateOne {
+	ihuo.mutation.ResetRevision()
+	ihuo.mutation.SetRevision(i)
+	return ihuo
+}
+
+// AddRevision adds i to the "revision" field.
+func (ihuo *InstanceHistoryUpdateOne) AddRevision(i int) *InstanceHistoryUpdateOne {
+	ihuo.mutation.AddRevision(i)
+	return ihuo
+}
+
+// SetTime sets the "time" field.
+func (ihuo *InstanceHistoryUpdateOne) SetTime(t time.Time) *InstanceHistoryUpdateOne {
+	ihuo.mutation.SetTime(t)
+	return ihuo
+}
+
+// SetData sets the "data" field.
+func (ihuo *InstanceHistoryUpdateOne) SetData(b []byte) *InstanceHistoryUpdateOne {
+	ihuo.mutation.SetData(b)
+	return ihuo
+}
+
+// Mutation returns the InstanceHistoryMutation object of the builder.
+func (ihuo *InstanceHistoryUpdateOne) Mutation() *InstanceHistoryMutation {
+	return ihuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ihuo *InstanceHistoryUpdateOne) Select(field string, fields ...string) *InstanceHistoryUpdateOne {
+	ihuo.fields = append([]string{field}, fields...)
+	return ihuo
+}
+
+// Save executes the query and returns the updated InstanceHistory entity.
+func (ihuo *InstanceHistoryUpdateOne) Save(ctx context.Context) (*InstanceHistory, error) {
+	var (
+		err  error
+		node *InstanceHistory
+	)
+	if len(ihuo.hooks) == 0 {
+		node, err = ihuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InstanceHistoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ihuo.mutation = mutation
+			node, err = ihuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ihuo.hooks) - 1; i >= 0; i-- {
+			mut = ihuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ihuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ihuo *InstanceHistoryUpdateOne) SaveX(ctx context.Context) *InstanceHistory {
+	node, err := ihuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ihuo *InstanceHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := ihuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ihuo *InstanceHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := ihuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ihuo *InstanceHistoryUpdateOne) sqlSave(ctx context.Context) (_node *InstanceHistory, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   instancehistory.Table,
+			Columns: instancehistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: instancehistory.FieldID,
+			},
+		},
+	}
+	id, ok := ihuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing InstanceHistory.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ihuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, instancehistory.FieldID)
+		for _, f := range fields {
+			if !instancehistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != instancehistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ihuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ihuo.mutation.InstanceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: instancehistory.FieldInstanceID,
+		})
+	}
+	if value, ok := ihuo.mutation.Step(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: instancehistory.FieldStep,
+		})
+	}
+	if value, ok := ihuo.mutation.AddedStep(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: instancehistory.FieldStep,
+		})
+	}
+	if value, ok := ihuo.mutation.Revision(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: instancehistory.FieldRevision,
+		})
+	}
+	if value, ok := ihuo.mutation.AddedRevision(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: instancehistory.FieldRevision,
+		})
+	}
+	if value, ok := ihuo.mutation.Time(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: instancehistory.FieldTime,
+		})
+	}
+	if value, ok := ihuo.mutation.Data(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: instancehistory.FieldData,
+		})
+	}
+	_node = &InstanceHistory{config: ihuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ihuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{instancehistory.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,50 @@
+// Code generated by entc, DO NOT EDIT.
+
+package jqmodule
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the jqmodule type in the database.
+	Label = "jq_module"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldUpdated holds the string denoting the updated field in the database.
+	FieldUpdated = "updated"
+	// Table holds the table name of the jqmodule in the database.
+	Table = "jq_modules"
+)
+
+// Columns holds all SQL columns for jqmodule fields.
+var Columns = []string{
+	FieldID,
+	FieldNamespace,
+	FieldName,
+	FieldSource,
+	FieldUpdated,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUpdated holds the default value on creation for the "updated" field.
+	DefaultUpdated func() time.Time
+	// UpdateDefaultUpdated holds the default value on update for the "updated" field.
+	UpdateDefaultUpdated func() time.Time
+)
@@ -0,0 +1,254 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/jqmodule"
+)
+
+// JQModuleCreate is the builder for creating a JQModule entity.
+type JQModuleCreate struct {
+	config
+	mutation *JQModuleMutation
+	hooks    []Hook
+}
+
+// SetNamespace sets the "namespace" field.
+func (jmc *JQModuleCreate) SetNamespace(s string) *JQModuleCreate {
+	jmc.mutation.SetNamespace(s)
+	return jmc
+}
+
+// SetName sets the "name" field.
+func (jmc *JQModuleCreate) SetName(s string) *JQModuleCreate {
+	jmc.mutation.SetName(s)
+	return jmc
+}
+
+// SetSource sets the "source" field.
+func (jmc *JQModuleCreate) SetSource(s string) *JQModuleCreate {
+	jmc.mutation.SetSource(s)
+	return jmc
+}
+
+// SetUpdated sets the "updated" field.
+func (jmc *JQModuleCreate) SetUpdated(t time.Time) *JQModuleCreate {
+	jmc.mutation.SetUpdated(t)
+	return jmc
+}
+
+// SetNillableUpdated sets the "updated" field if the given value is not nil.
+func (jmc *JQModuleCreate) SetNillableUpdated(t *time.Time) *JQModuleCreate {
+	if t != nil {
+		jmc.SetUpdated(*t)
+	}
+	return jmc
+}
+
+// Mutation returns the JQModuleMutation object of the builder.
+func (jmc *JQModuleCreate) Mutation() *JQModuleMutation {
+	return jmc.mutation
+}
+
+// Save creates the JQModule in the database.
+func (jmc *JQModuleCreate) Save(ctx context.Context) (*JQModule, error) {
+	var (
+		err  error
+		node *JQModule
+	)
+	jmc.defaults()
+	if len(jmc.hooks) == 0 {
+		if err = jmc.check(); err != nil {
+			return nil, err
+		}
+		node, err = jmc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JQModuleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = jmc.check(); err != nil {
+				return nil, err
+			}
+			jmc.mutation = mutation
+			node, err = jmc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(jmc.hooks) - 1; i >= 0; i-- {
+			mut = jmc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, jmc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jmc *JQModuleCreate) SaveX(ctx context.Context) *JQModule {
+	v, err := jmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (jmc *JQModuleCreate) defaults() {
+	if _, ok := jmc.mutation.Updated(); !ok {
+		v := jqmodule.DefaultUpdated()
+		jmc.mutation.SetUpdated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jmc *JQModuleCreate) check() error {
+	if _, ok := jmc.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New("ent: missing required field \"namespace\"")}
+	}
+	if _, ok := jmc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if _, ok := jmc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New("ent: missing required field \"source\"")}
+	}
+	if _, ok := jmc.mutation.Updated(); !ok {
+		return &ValidationError{Name: "updated", err: errors.New("ent: missing required field \"updated\"")}
+	}
+	return nil
+}
+
+func (jmc *JQModuleCreate) sqlSave(ctx context.Context) (*JQModule, error) {
+	_node, _spec := jmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jmc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (jmc *JQModuleCreate) createSpec() (*JQModule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JQModule{config: jmc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: jqmodule.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: jqmodule.FieldID,
+			},
+		}
+	)
+	if value, ok := jmc.mutation.Namespace(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jqmodule.FieldNamespace,
+		})
+		_node.Namespace = value
+	}
+	if value, ok := jmc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jqmodule.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := jmc.mutation.Source(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jqmodule.FieldSource,
+		})
+		_node.Source = value
+	}
+	if value, ok := jmc.mutation.Updated(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jqmodule.FieldUpdated,
+		})
+		_node.Updated = value
+	}
+	return _node, _spec
+}
+
+// JQModuleCreateBulk is the builder for creating many JQModule entities in bulk.
+type JQModuleCreateBulk struct {
+	config
+	builders []*JQModuleCreate
+}
+
+// Save creates the JQModule entities in the database.
+func (jmcb *JQModuleCreateBulk) Save(ctx context.Context) ([]*JQModule, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(jmcb.builders))
+	nodes := make([]*JQModule, len(jmcb.builders))
+	mutators := make([]Mutator, len(jmcb.builders))
+	for i := range jmcb.builders {
+		func(i int, root context.Context) {
+			builder := jmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JQModuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jmcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jmcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jmcb *JQModuleCreateBulk) SaveX(ctx context.Context) []*JQModule {
+	v, err := jmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
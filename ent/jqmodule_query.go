@@ -0,0 +1,904 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/jqmodule"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// JQModuleQuery is the builder for querying JQModule entities.
+type JQModuleQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.JQModule
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the JQModuleQuery builder.
+func (jmq *JQModuleQuery) Where(ps ...predicate.JQModule) *JQModuleQuery {
+	jmq.predicates = append(jmq.predicates, ps...)
+	return jmq
+}
+
+// Limit adds a limit step to the query.
+func (jmq *JQModuleQuery) Limit(limit int) *JQModuleQuery {
+	jmq.limit = &limit
+	return jmq
+}
+
+// Offset adds an offset step to the query.
+func (jmq *JQModuleQuery) Offset(offset int) *JQModuleQuery {
+	jmq.offset = &offset
+	return jmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (jmq *JQModuleQuery) Unique(unique bool) *JQModuleQuery {
+	jmq.unique = &unique
+	return jmq
+}
+
+// Order adds an order step to the query.
+func (jmq *JQModuleQuery) Order(o ...OrderFunc) *JQModuleQuery {
+	jmq.order = append(jmq.order, o...)
+	return jmq
+}
+
+// First returns the first JQModule entity from the query.
+// Returns a *NotFoundError when no JQModule was found.
+func (jmq *JQModuleQuery) First(ctx context.Context) (*JQModule, error) {
+	nodes, err := jmq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{jqmodule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (jmq *JQModuleQuery) FirstX(ctx context.Context) *JQModule {
+	node, err := jmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first JQModule ID from the query.
+// Returns a *NotFoundError when no JQModule ID was found.
+func (jmq *JQModuleQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = jmq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{jqmodule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (jmq *JQModuleQuery) FirstIDX(ctx context.Context) int {
+	id, err := jmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single JQModule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one JQModule entity is not found.
+// Returns a *NotFoundError when no JQModule entities are found.
+func (jmq *JQModuleQuery) Only(ctx context.Context) (*JQModule, error) {
+	nodes, err := jmq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{jqmodule.Label}
+	default:
+		return nil, &NotSingularError{jqmodule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (jmq *JQModuleQuery) OnlyX(ctx context.Context) *JQModule {
+	node, err := jmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only JQModule ID in the query.
+// Returns a *NotSingularError when exactly one JQModule ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (jmq *JQModuleQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = jmq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{jqmodule.Label}
+	default:
+		err = &NotSingularError{jqmodule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (jmq *JQModuleQuery) OnlyIDX(ctx context.Context) int {
+	id, err := jmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of JQModules.
+func (jmq *JQModuleQuery) All(ctx context.Context) ([]*JQModule, error) {
+	if err := jmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return jmq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (jmq *JQModuleQuery) AllX(ctx context.Context) []*JQModule {
+	nodes, err := jmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of JQModule IDs.
+func (jmq *JQModuleQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := jmq.Select(jqmodule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (jmq *JQModuleQuery) IDsX(ctx context.Context) []int {
+	ids, err := jmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (jmq *JQModuleQuery) Count(ctx context.Context) (int, error) {
+	if err := jmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return jmq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (jmq *JQModuleQuery) CountX(ctx context.Context) int {
+	count, err := jmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (jmq *JQModuleQuery) Exist(ctx context.Context) (bool, error) {
+	if err := jmq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return jmq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (jmq *JQModuleQuery) ExistX(ctx context.Context) bool {
+	exist, err := jmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the JQModuleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (jmq *JQModuleQuery) Clone() *JQModuleQuery {
+	if jmq == nil {
+		return nil
+	}
+	return &JQModuleQuery{
+		config:     jmq.config,
+		limit:      jmq.limit,
+		offset:     jmq.offset,
+		order:      append([]OrderFunc{}, jmq.order...),
+		predicates: append([]predicate.JQModule{}, jmq.predicates...),
+		// clone intermediate query.
+		sql:  jmq.sql.Clone(),
+		path: jmq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Namespace string `json:"namespace,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.JQModule.Query().
+//		GroupBy(jqmodule.FieldNamespace).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (jmq *JQModuleQuery) GroupBy(field string, fields ...string) *JQModuleGroupBy {
+	group := &JQModuleGroupBy{config: jmq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := jmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return jmq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Namespace string `json:"namespace,omitempty"`
+//	}
+//
+//	client.JQModule.Query().
+//		Select(jqmodule.FieldNamespace).
+//		Scan(ctx, &v)
+func (jmq *JQModuleQuery) Select(field string, fields ...string) *JQModuleSelect {
+	jmq.fields = append([]string{field}, fields...)
+	return &JQModuleSelect{JQModuleQuery: jmq}
+}
+
+func (jmq *JQModuleQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range jmq.fields {
+		if !jqmodule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if jmq.path != nil {
+		prev, err := jmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		jmq.sql = prev
+	}
+	return nil
+}
+
+func (jmq *JQModuleQuery) sqlAll(ctx context.Context) ([]*JQModule, error) {
+	var (
+		nodes = []*JQModule{}
+		_spec = jmq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &JQModule{config: jmq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, jmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (jmq *JQModuleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := jmq.querySpec()
+	return sqlgraph.CountNodes(ctx, jmq.driver, _spec)
+}
+
+func (jmq *JQModuleQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := jmq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (jmq *JQModuleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   jqmodule.Table,
+			Columns: jqmodule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: jqmodule.FieldID,
+			},
+		},
+		From:   jmq.sql,
+		Unique: true,
+	}
+	if unique := jmq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := jmq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jqmodule.FieldID)
+		for i := range fields {
+			if fields[i] != jqmodule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := jmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := jmq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := jmq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := jmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (jmq *JQModuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(jmq.driver.Dialect())
+	t1 := builder.Table(jqmodule.Table)
+	selector := builder.Select(t1.Columns(jqmodule.Columns...)...).From(t1)
+	if jmq.sql != nil {
+		selector = jmq.sql
+		selector.Select(selector.Columns(jqmodule.Columns...)...)
+	}
+	for _, p := range jmq.predicates {
+		p(selector)
+	}
+	for _, p := range jmq.order {
+		p(selector)
+	}
+	if offset := jmq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := jmq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// JQModuleGroupBy is the group-by builder for JQModule entities.
+type JQModuleGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (jmgb *JQModuleGroupBy) Aggregate(fns ...AggregateFunc) *JQModuleGroupBy {
+	jmgb.fns = append(jmgb.fns, fns...)
+	return jmgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (jmgb *JQModuleGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := jmgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	jmgb.sql = query
+	return jmgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (jmgb *JQModuleGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := jmgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (jmgb *JQModuleGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(jmgb.fields) > 1 {
+		return nil, errors.New("ent: JQModuleGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := jmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (jmgb *JQModuleGroupBy) StringsX(ctx context.Context) []string {
+	v, err := jmgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (jmgb *JQModuleGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = jmgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jqmodule.Label}
+	default:
+		err = fmt.Errorf("ent: JQModuleGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (jmgb *JQModuleGroupBy) StringX(ctx context.Context) string {
+	v, err := jmgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (jmgb *JQModuleGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(jmgb.fields) > 1 {
+		return nil, errors.New("ent: JQModuleGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := jmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (jmgb *JQModuleGroupBy) IntsX(ctx context.Context) []int {
+	v, err := jmgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (jmgb *JQModuleGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = jmgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jqmodule.Label}
+	default:
+		err = fmt.Errorf("ent: JQModuleGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (jmgb *JQModuleGroupBy) IntX(ctx context.Context) int {
+	v, err := jmgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (jmgb *JQModuleGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(jmgb.fields) > 1 {
+		return nil, errors.New("ent: JQModuleGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := jmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (jmgb *JQModuleGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := jmgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (jmgb *JQModuleGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = jmgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jqmodule.Label}
+	default:
+		err = fmt.Errorf("ent: JQModuleGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (jmgb *JQModuleGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := jmgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (jmgb *JQModuleGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(jmgb.fields) > 1 {
+		return nil, errors.New("ent: JQModuleGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := jmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (jmgb *JQModuleGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := jmgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (jmgb *JQModuleGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = jmgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jqmodule.Label}
+	default:
+		err = fmt.Errorf("ent: JQModuleGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (jmgb *JQModuleGroupBy) BoolX(ctx context.Context) bool {
+	v, err := jmgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (jmgb *JQModuleGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range jmgb.fields {
+		if !jqmodule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := jmgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := jmgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (jmgb *JQModuleGroupBy) sqlQuery() *sql.Selector {
+	selector := jmgb.sql
+	columns := make([]string, 0, len(jmgb.fields)+len(jmgb.fns))
+	columns = append(columns, jmgb.fields...)
+	for _, fn := range jmgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(jmgb.fields...)
+}
+
+// JQModuleSelect is the builder for selecting fields of JQModule entities.
+type JQModuleSelect struct {
+	*JQModuleQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (jms *JQModuleSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := jms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	jms.sql = jms.JQModuleQuery.sqlQuery(ctx)
+	return jms.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (jms *JQModuleSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := jms.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (jms *JQModuleSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(jms.fields) > 1 {
+		return nil, errors.New("ent: JQModuleSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := jms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (jms *JQModuleSelect) StringsX(ctx context.Context) []string {
+	v, err := jms.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (jms *JQModuleSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = jms.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jqmodule.Label}
+	default:
+		err = fmt.Errorf("ent: JQModuleSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (jms *JQModuleSelect) StringX(ctx context.Context) string {
+	v, err := jms.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (jms *JQModuleSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(jms.fields) > 1 {
+		return nil, errors.New("ent: JQModuleSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := jms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (jms *JQModuleSelect) IntsX(ctx context.Context) []int {
+	v, err := jms.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (jms *JQModuleSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = jms.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jqmodule.Label}
+	default:
+		err = fmt.Errorf("ent: JQModuleSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (jms *JQModuleSelect) IntX(ctx context.Context) int {
+	v, err := jms.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (jms *JQModuleSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(jms.fields) > 1 {
+		return nil, errors.New("ent: JQModuleSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := jms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (jms *JQModuleSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := jms.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (jms *JQModuleSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = jms.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jqmodule.Label}
+	default:
+		err = fmt.Errorf("ent: JQModuleSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (jms *JQModuleSelect) Float64X(ctx context.Context) float64 {
+	v, err := jms.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (jms *JQModuleSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(jms.fields) > 1 {
+		return nil, errors.New("ent: JQModuleSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := jms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (jms *JQModuleSelect) BoolsX(ctx context.Context) []bool {
+	v, err := jms.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (jms *JQModuleSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = jms.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jqmodule.Label}
+	default:
+		err = fmt.Errorf("ent: JQModuleSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (jms *JQModuleSelect) BoolX(ctx context.Context) bool {
+	v, err := jms.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (jms *JQModuleSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := jms.sqlQuery().Query()
+	if err := jms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (jms *JQModuleSelect) sqlQuery() sql.Querier {
+	selector := jms.sql
+	selector.Select(selector.Columns(jms.fields...)...)
+	return selector
+}
@@ -0,0 +1,355 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/jqmodule"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// JQModuleUpdate is the builder for updating JQModule entities.
+type JQModuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JQModuleMutation
+}
+
+// Where adds a new predicate for the JQModuleUpdate builder.
+func (jmu *JQModuleUpdate) Where(ps ...predicate.JQModule) *JQModuleUpdate {
+	jmu.mutation.predicates = append(jmu.mutation.predicates, ps...)
+	return jmu
+}
+
+// SetNamespace sets the "namespace" field.
+func (jmu *JQModuleUpdate) SetNamespace(s string) *JQModuleUpdate {
+	jmu.mutation.SetNamespace(s)
+	return jmu
+}
+
+// SetName sets the "name" field.
+func (jmu *JQModuleUpdate) SetName(s string) *JQModuleUpdate {
+	jmu.mutation.SetName(s)
+	return jmu
+}
+
+// SetSource sets the "source" field.
+func (jmu *JQModuleUpdate) SetSource(s string) *JQModuleUpdate {
+	jmu.mutation.SetSource(s)
+	return jmu
+}
+
+// SetUpdated sets the "updated" field.
+func (jmu *JQModuleUpdate) SetUpdated(t time.Time) *JQModuleUpdate {
+	jmu.mutation.SetUpdated(t)
+	return jmu
+}
+
+// Mutation returns the JQModuleMutation object of the builder.
+func (jmu *JQModuleUpdate) Mutation() *JQModuleMutation {
+	return jmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jmu *JQModuleUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	jmu.defaults()
+	if len(jmu.hooks) == 0 {
+		affected, err = jmu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JQModuleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			jmu.mutation = mutation
+			affected, err = jmu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(jmu.hooks) - 1; i >= 0; i-- {
+			mut = jmu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, jmu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jmu *JQModuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := jmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jmu *JQModuleUpdate) Exec(ctx context.Context) error {
+	_, err := jmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jmu *JQModuleUpdate) ExecX(ctx context.Context) {
+	if err := jmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jmu *JQModuleUpdate) defaults() {
+	if _, ok := jmu.mutation.Updated(); !ok {
+		v := jqmodule.UpdateDefaultUpdated()
+		jmu.mutation.SetUpdated(v)
+	}
+}
+
+func (jmu *JQModuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   jqmodule.Table,
+			Columns: jqmodule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: jqmodule.FieldID,
+			},
+		},
+	}
+	if ps := jmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jmu.mutation.Namespace(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jqmodule.FieldNamespace,
+		})
+	}
+	if value, ok := jmu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jqmodule.FieldName,
+		})
+	}
+	if value, ok := jmu.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jqmodule.FieldSource,
+		})
+	}
+	if value, ok := jmu.mutation.Updated(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jqmodule.FieldUpdated,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, jmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jqmodule.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// JQModuleUpdateOne is the builder for updating a single JQModule entity.
+type JQModuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JQModuleMutation
+}
+
+// SetNamespace sets the "namespace" field.
+func (jmuo *JQModuleUpdateOne) SetNamespace(s string) *JQModuleUpdateOne {
+	jmuo.mutation.SetNamespace(s)
+	return jmuo
+}
+
+// SetName sets the "name" field.
+func (jmuo *JQModuleUpdateOne) SetName(s string) *JQModuleUpdateOne {
+	jmuo.mutation.SetName(s)
+	return jmuo
+}
+
+// SetSource sets the "source" field.
+func (jmuo *JQModuleUpdateOne) SetSource(s string) *JQModuleUpdateOne {
+	jmuo.mutation.SetSource(s)
+	return jmuo
+}
+
+// SetUpdated sets the "updated" field.
+func (jmuo *JQModuleUpdateOne) SetUpdated(t time.Time) *JQModuleUpdateOne {
+	jmuo.mutation.SetUpdated(t)
+	return jmuo
+}
+
+// Mutation returns the JQModuleMutation object of the builder.
+func (jmuo *JQModuleUpdateOne) Mutation() *JQModuleMutation {
+	return jmuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jmuo *JQModuleUpdateOne) Select(field string, fields ...string) *JQModuleUpdateOne {
+	jmuo.fields = append([]string{field}, fields...)
+	return jmuo
+}
+
+// Save executes the query and returns the updated JQModule entity.
+func (jmuo *JQModuleUpdateOne) Save(ctx context.Context) (*JQModule, error) {
+	var (
+		err  error
+		node *JQModule
+	)
+	jmuo.defaults()
+	if len(jmuo.hooks) == 0 {
+		node, err = jmuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JQModuleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			jmuo.mutation = mutation
+			node, err = jmuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(jmuo.hooks) - 1; i >= 0; i-- {
+			mut = jmuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, jmuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jmuo *JQModuleUpdateOne) SaveX(ctx context.Context) *JQModule {
+	node, err := jmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jmuo *JQModuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := jmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jmuo *JQModuleUpdateOne) ExecX(ctx context.Context) {
+	if err := jmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jmuo *JQModuleUpdateOne) defaults() {
+	if _, ok := jmuo.mutation.Updated(); !ok {
+		v := jqmodule.UpdateDefaultUpdated()
+		jmuo.mutation.SetUpdated(v)
+	}
+}
+
+func (jmuo *JQModuleUpdateOne) sqlSave(ctx context.Context) (_node *JQModule, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   jqmodule.Table,
+			Columns: jqmodule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: jqmodule.FieldID,
+			},
+		},
+	}
+	id, ok := jmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing JQModule.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jqmodule.FieldID)
+		for _, f := range fields {
+			if !jqmodule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != jqmodule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jmuo.mutation.Namespace(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jqmodule.FieldNamespace,
+		})
+	}
+	if value, ok := jmuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jqmodule.FieldName,
+		})
+	}
+	if value, ok := jmuo.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jqmodule.FieldSource,
+		})
+	}
+	if value, ok := jmuo.mutation.Updated(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jqmodule.FieldUpdated,
+		})
+	}
+	_node = &JQModule{config: jmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jqmodule.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
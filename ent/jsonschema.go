@@ -0,0 +1,152 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/vorteil/direktiv/ent/jsonschema"
+)
+
+// JSONSchema is the model entity for the JSONSchema schema.
+type JSONSchema struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Schema holds the value of the "schema" field.
+	Schema string `json:"schema,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// Created holds the value of the "created" field.
+	Created time.Time `json:"created,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JSONSchema) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case jsonschema.FieldID, jsonschema.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case jsonschema.FieldNamespace, jsonschema.FieldName, jsonschema.FieldSchema, jsonschema.FieldHash:
+			values[i] = new(sql.NullString)
+		case jsonschema.FieldCreated:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type JSONSchema", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JSONSchema fields.
+func (js *JSONSchema) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case jsonschema.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			js.ID = int(value.Int64)
+		case jsonschema.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				js.Namespace = value.String
+			}
+		case jsonschema.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				js.Name = value.String
+			}
+		case jsonschema.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				js.Version = int(value.Int64)
+			}
+		case jsonschema.FieldSchema:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field schema", values[i])
+			} else if value.Valid {
+				js.Schema = value.String
+			}
+		case jsonschema.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				js.Hash = value.String
+			}
+		case jsonschema.FieldCreated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created", values[i])
+			} else if value.Valid {
+				js.Created = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this JSONSchema.
+// Note that you need to call JSONSchema.Unwrap() before calling this method if this JSONSchema
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (js *JSONSchema) Update() *JSONSchemaUpdateOne {
+	return (&JSONSchemaClient{config: js.config}).UpdateOne(js)
+}
+
+// Unwrap unwraps the JSONSchema entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (js *JSONSchema) Unwrap() *JSONSchema {
+	tx, ok := js.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: JSONSchema is not a transactional entity")
+	}
+	js.config.driver = tx.drv
+	return js
+}
+
+// String implements the fmt.Stringer.
+func (js *JSONSchema) String() string {
+	var builder strings.Builder
+	builder.WriteString("JSONSchema(")
+	builder.WriteString(fmt.Sprintf("id=%v", js.ID))
+	builder.WriteString(", namespace=")
+	builder.WriteString(js.Namespace)
+	builder.WriteString(", name=")
+	builder.WriteString(js.Name)
+	builder.WriteString(", version=")
+	builder.WriteString(fmt.Sprintf("%v", js.Version))
+	builder.WriteString(", schema=")
+	builder.WriteString(js.Schema)
+	builder.WriteString(", hash=")
+	builder.WriteString(js.Hash)
+	builder.WriteString(", created=")
+	builder.WriteString(js.Created.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JSONSchemas is a parsable slice of JSONSchema.
+type JSONSchemas []*JSONSchema
+
+func (js JSONSchemas) config(cfg config) {
+	for _i := range js {
+		js[_i].config = cfg
+	}
+}
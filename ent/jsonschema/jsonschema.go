@@ -0,0 +1,54 @@
+// Code generated by entc, DO NOT EDIT.
+
+package jsonschema
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the jsonschema type in the database.
+	Label = "json_schema"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldSchema holds the string denoting the schema field in the database.
+	FieldSchema = "schema"
+	// FieldHash holds the string denoting the hash field in the database.
+	FieldHash = "hash"
+	// FieldCreated holds the string denoting the created field in the database.
+	FieldCreated = "created"
+	// Table holds the table name of the jsonschema in the database.
+	Table = "json_schemas"
+)
+
+// Columns holds all SQL columns for jsonschema fields.
+var Columns = []string{
+	FieldID,
+	FieldNamespace,
+	FieldName,
+	FieldVersion,
+	FieldSchema,
+	FieldHash,
+	FieldCreated,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreated holds the default value on creation for the "created" field.
+	DefaultCreated func() time.Time
+)
@@ -0,0 +1,763 @@
+// Code generated by entc, DO NOT EDIT.
+
+package jsonschema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNamespace), v))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVersion), v))
+	})
+}
+
+// Schema applies equality check predicate on the "schema" field. It's identical to SchemaEQ.
+func Schema(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSchema), v))
+	})
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHash), v))
+	})
+}
+
+// Created applies equality check predicate on the "created" field. It's identical to CreatedEQ.
+func Created(v time.Time) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.JSONSchema {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNamespace), v...))
+	})
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.JSONSchema {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNamespace), v...))
+	})
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNamespace), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.JSONSchema {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.JSONSchema {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVersion), v))
+	})
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldVersion), v))
+	})
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.JSONSchema {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldVersion), v...))
+	})
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.JSONSchema {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldVersion), v...))
+	})
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldVersion), v))
+	})
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldVersion), v))
+	})
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldVersion), v))
+	})
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldVersion), v))
+	})
+}
+
+// SchemaEQ applies the EQ predicate on the "schema" field.
+func SchemaEQ(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSchema), v))
+	})
+}
+
+// SchemaNEQ applies the NEQ predicate on the "schema" field.
+func SchemaNEQ(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSchema), v))
+	})
+}
+
+// SchemaIn applies the In predicate on the "schema" field.
+func SchemaIn(vs ...string) predicate.JSONSchema {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSchema), v...))
+	})
+}
+
+// SchemaNotIn applies the NotIn predicate on the "schema" field.
+func SchemaNotIn(vs ...string) predicate.JSONSchema {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSchema), v...))
+	})
+}
+
+// SchemaGT applies the GT predicate on the "schema" field.
+func SchemaGT(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSchema), v))
+	})
+}
+
+// SchemaGTE applies the GTE predicate on the "schema" field.
+func SchemaGTE(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSchema), v))
+	})
+}
+
+// SchemaLT applies the LT predicate on the "schema" field.
+func SchemaLT(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSchema), v))
+	})
+}
+
+// SchemaLTE applies the LTE predicate on the "schema" field.
+func SchemaLTE(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSchema), v))
+	})
+}
+
+// SchemaContains applies the Contains predicate on the "schema" field.
+func SchemaContains(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSchema), v))
+	})
+}
+
+// SchemaHasPrefix applies the HasPrefix predicate on the "schema" field.
+func SchemaHasPrefix(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSchema), v))
+	})
+}
+
+// SchemaHasSuffix applies the HasSuffix predicate on the "schema" field.
+func SchemaHasSuffix(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSchema), v))
+	})
+}
+
+// SchemaEqualFold applies the EqualFold predicate on the "schema" field.
+func SchemaEqualFold(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSchema), v))
+	})
+}
+
+// SchemaContainsFold applies the ContainsFold predicate on the "schema" field.
+func SchemaContainsFold(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSchema), v))
+	})
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHash), v))
+	})
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHash), v))
+	})
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.JSONSchema {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldHash), v...))
+	})
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.JSONSchema {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldHash), v...))
+	})
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldHash), v))
+	})
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldHash), v))
+	})
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldHash), v))
+	})
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldHash), v))
+	})
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldHash), v))
+	})
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldHash), v))
+	})
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldHash), v))
+	})
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldHash), v))
+	})
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldHash), v))
+	})
+}
+
+// CreatedEQ applies the EQ predicate on the "created" field.
+func CreatedEQ(v time.Time) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedNEQ applies the NEQ predicate on the "created" field.
+func CreatedNEQ(v time.Time) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedIn applies the In predicate on the "created" field.
+func CreatedIn(vs ...time.Time) predicate.JSONSchema {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedNotIn applies the NotIn predicate on the "created" field.
+func CreatedNotIn(vs ...time.Time) predicate.JSONSchema {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedGT applies the GT predicate on the "created" field.
+func CreatedGT(v time.Time) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedGTE applies the GTE predicate on the "created" field.
+func CreatedGTE(v time.Time) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLT applies the LT predicate on the "created" field.
+func CreatedLT(v time.Time) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLTE applies the LTE predicate on the "created" field.
+func CreatedLTE(v time.Time) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreated), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.JSONSchema) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.JSONSchema) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.JSONSchema) predicate.JSONSchema {
+	return predicate.JSONSchema(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
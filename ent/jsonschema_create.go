@@ -0,0 +1,288 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/jsonschema"
+)
+
+// JSONSchemaCreate is the builder for creating a JSONSchema entity.
+type JSONSchemaCreate struct {
+	config
+	mutation *JSONSchemaMutation
+	hooks    []Hook
+}
+
+// SetNamespace sets the "namespace" field.
+func (jsc *JSONSchemaCreate) SetNamespace(s string) *JSONSchemaCreate {
+	jsc.mutation.SetNamespace(s)
+	return jsc
+}
+
+// SetName sets the "name" field.
+func (jsc *JSONSchemaCreate) SetName(s string) *JSONSchemaCreate {
+	jsc.mutation.SetName(s)
+	return jsc
+}
+
+// SetVersion sets the "version" field.
+func (jsc *JSONSchemaCreate) SetVersion(i int) *JSONSchemaCreate {
+	jsc.mutation.SetVersion(i)
+	return jsc
+}
+
+// SetSchema sets the "schema" field.
+func (jsc *JSONSchemaCreate) SetSchema(s string) *JSONSchemaCreate {
+	jsc.mutation.SetSchema(s)
+	return jsc
+}
+
+// SetHash sets the "hash" field.
+func (jsc *JSONSchemaCreate) SetHash(s string) *JSONSchemaCreate {
+	jsc.mutation.SetHash(s)
+	return jsc
+}
+
+// SetCreated sets the "created" field.
+func (jsc *JSONSchemaCreate) SetCreated(t time.Time) *JSONSchemaCreate {
+	jsc.mutation.SetCreated(t)
+	return jsc
+}
+
+// SetNillableCreated sets the "created" field if the given value is not nil.
+func (jsc *JSONSchemaCreate) SetNillableCreated(t *time.Time) *JSONSchemaCreate {
+	if t != nil {
+		jsc.SetCreated(*t)
+	}
+	return jsc
+}
+
+// Mutation returns the JSONSchemaMutation object of the builder.
+func (jsc *JSONSchemaCreate) Mutation() *JSONSchemaMutation {
+	return jsc.mutation
+}
+
+// Save creates the JSONSchema in the database.
+func (jsc *JSONSchemaCreate) Save(ctx context.Context) (*JSONSchema, error) {
+	var (
+		err  error
+		node *JSONSchema
+	)
+	jsc.defaults()
+	if len(jsc.hooks) == 0 {
+		if err = jsc.check(); err != nil {
+			return nil, err
+		}
+		node, err = jsc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JSONSchemaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = jsc.check(); err != nil {
+				return nil, err
+			}
+			jsc.mutation = mutation
+			node, err = jsc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(jsc.hooks) - 1; i >= 0; i-- {
+			mut = jsc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, jsc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jsc *JSONSchemaCreate) SaveX(ctx context.Context) *JSONSchema {
+	v, err := jsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (jsc *JSONSchemaCreate) defaults() {
+	if _, ok := jsc.mutation.Created(); !ok {
+		v := jsonschema.DefaultCreated()
+		jsc.mutation.SetCreated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jsc *JSONSchemaCreate) check() error {
+	if _, ok := jsc.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New("ent: missing required field \"namespace\"")}
+	}
+	if _, ok := jsc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if _, ok := jsc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New("ent: missing required field \"version\"")}
+	}
+	if _, ok := jsc.mutation.Schema(); !ok {
+		return &ValidationError{Name: "schema", err: errors.New("ent: missing required field \"schema\"")}
+	}
+	if _, ok := jsc.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New("ent: missing required field \"hash\"")}
+	}
+	if _, ok := jsc.mutation.Created(); !ok {
+		return &ValidationError{Name: "created", err: errors.New("ent: missing required field \"created\"")}
+	}
+	return nil
+}
+
+func (jsc *JSONSchemaCreate) sqlSave(ctx context.Context) (*JSONSchema, error) {
+	_node, _spec := jsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jsc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (jsc *JSONSchemaCreate) createSpec() (*JSONSchema, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JSONSchema{config: jsc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: jsonschema.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: jsonschema.FieldID,
+			},
+		}
+	)
+	if value, ok := jsc.mutation.Namespace(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jsonschema.FieldNamespace,
+		})
+		_node.Namespace = value
+	}
+	if value, ok := jsc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jsonschema.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := jsc.mutation.Version(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: jsonschema.FieldVersion,
+		})
+		_node.Version = value
+	}
+	if value, ok := jsc.mutation.Schema(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jsonschema.FieldSchema,
+		})
+		_node.Schema = value
+	}
+	if value, ok := jsc.mutation.Hash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jsonschema.FieldHash,
+		})
+		_node.Hash = value
+	}
+	if value, ok := jsc.mutation.Created(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: jsonschema.FieldCreated,
+		})
+		_node.Created = value
+	}
+	return _node, _spec
+}
+
+// JSONSchemaCreateBulk is the builder for creating many JSONSchema entities in bulk.
+type JSONSchemaCreateBulk struct {
+	config
+	builders []*JSONSchemaCreate
+}
+
+// Save creates the JSONSchema entities in the database.
+func (jscb *JSONSchemaCreateBulk) Save(ctx context.Context) ([]*JSONSchema, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(jscb.builders))
+	nodes := make([]*JSONSchema, len(jscb.builders))
+	mutators := make([]Mutator, len(jscb.builders))
+	for i := range jscb.builders {
+		func(i int, root context.Context) {
+			builder := jscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JSONSchemaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jscb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jscb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jscb *JSONSchemaCreateBulk) SaveX(ctx context.Context) []*JSONSchema {
+	v, err := jscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
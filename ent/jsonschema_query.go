@@ -0,0 +1,904 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/jsonschema"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// JSONSchemaQuery is the builder for querying JSONSchema entities.
+type JSONSchemaQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.JSONSchema
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the JSONSchemaQuery builder.
+func (jsq *JSONSchemaQuery) Where(ps ...predicate.JSONSchema) *JSONSchemaQuery {
+	jsq.predicates = append(jsq.predicates, ps...)
+	return jsq
+}
+
+// Limit adds a limit step to the query.
+func (jsq *JSONSchemaQuery) Limit(limit int) *JSONSchemaQuery {
+	jsq.limit = &limit
+	return jsq
+}
+
+// Offset adds an offset step to the query.
+func (jsq *JSONSchemaQuery) Offset(offset int) *JSONSchemaQuery {
+	jsq.offset = &offset
+	return jsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (jsq *JSONSchemaQuery) Unique(unique bool) *JSONSchemaQuery {
+	jsq.unique = &unique
+	return jsq
+}
+
+// Order adds an order step to the query.
+func (jsq *JSONSchemaQuery) Order(o ...OrderFunc) *JSONSchemaQuery {
+	jsq.order = append(jsq.order, o...)
+	return jsq
+}
+
+// First returns the first JSONSchema entity from the query.
+// Returns a *NotFoundError when no JSONSchema was found.
+func (jsq *JSONSchemaQuery) First(ctx context.Context) (*JSONSchema, error) {
+	nodes, err := jsq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{jsonschema.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (jsq *JSONSchemaQuery) FirstX(ctx context.Context) *JSONSchema {
+	node, err := jsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first JSONSchema ID from the query.
+// Returns a *NotFoundError when no JSONSchema ID was found.
+func (jsq *JSONSchemaQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = jsq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{jsonschema.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (jsq *JSONSchemaQuery) FirstIDX(ctx context.Context) int {
+	id, err := jsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single JSONSchema entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one JSONSchema entity is not found.
+// Returns a *NotFoundError when no JSONSchema entities are found.
+func (jsq *JSONSchemaQuery) Only(ctx context.Context) (*JSONSchema, error) {
+	nodes, err := jsq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{jsonschema.Label}
+	default:
+		return nil, &NotSingularError{jsonschema.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (jsq *JSONSchemaQuery) OnlyX(ctx context.Context) *JSONSchema {
+	node, err := jsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only JSONSchema ID in the query.
+// Returns a *NotSingularError when exactly one JSONSchema ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (jsq *JSONSchemaQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = jsq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{jsonschema.Label}
+	default:
+		err = &NotSingularError{jsonschema.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (jsq *JSONSchemaQuery) OnlyIDX(ctx context.Context) int {
+	id, err := jsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of JSONSchemas.
+func (jsq *JSONSchemaQuery) All(ctx context.Context) ([]*JSONSchema, error) {
+	if err := jsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return jsq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (jsq *JSONSchemaQuery) AllX(ctx context.Context) []*JSONSchema {
+	nodes, err := jsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of JSONSchema IDs.
+func (jsq *JSONSchemaQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := jsq.Select(jsonschema.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (jsq *JSONSchemaQuery) IDsX(ctx context.Context) []int {
+	ids, err := jsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (jsq *JSONSchemaQuery) Count(ctx context.Context) (int, error) {
+	if err := jsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return jsq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (jsq *JSONSchemaQuery) CountX(ctx context.Context) int {
+	count, err := jsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (jsq *JSONSchemaQuery) Exist(ctx context.Context) (bool, error) {
+	if err := jsq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return jsq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (jsq *JSONSchemaQuery) ExistX(ctx context.Context) bool {
+	exist, err := jsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the JSONSchemaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (jsq *JSONSchemaQuery) Clone() *JSONSchemaQuery {
+	if jsq == nil {
+		return nil
+	}
+	return &JSONSchemaQuery{
+		config:     jsq.config,
+		limit:      jsq.limit,
+		offset:     jsq.offset,
+		order:      append([]OrderFunc{}, jsq.order...),
+		predicates: append([]predicate.JSONSchema{}, jsq.predicates...),
+		// clone intermediate query.
+		sql:  jsq.sql.Clone(),
+		path: jsq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Namespace string `json:"namespace,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.JSONSchema.Query().
+//		GroupBy(jsonschema.FieldNamespace).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (jsq *JSONSchemaQuery) GroupBy(field string, fields ...string) *JSONSchemaGroupBy {
+	group := &JSONSchemaGroupBy{config: jsq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := jsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return jsq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Namespace string `json:"namespace,omitempty"`
+//	}
+//
+//	client.JSONSchema.Query().
+//		Select(jsonschema.FieldNamespace).
+//		Scan(ctx, &v)
+func (jsq *JSONSchemaQuery) Select(field string, fields ...string) *JSONSchemaSelect {
+	jsq.fields = append([]string{field}, fields...)
+	return &JSONSchemaSelect{JSONSchemaQuery: jsq}
+}
+
+func (jsq *JSONSchemaQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range jsq.fields {
+		if !jsonschema.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if jsq.path != nil {
+		prev, err := jsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		jsq.sql = prev
+	}
+	return nil
+}
+
+func (jsq *JSONSchemaQuery) sqlAll(ctx context.Context) ([]*JSONSchema, error) {
+	var (
+		nodes = []*JSONSchema{}
+		_spec = jsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &JSONSchema{config: jsq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, jsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (jsq *JSONSchemaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := jsq.querySpec()
+	return sqlgraph.CountNodes(ctx, jsq.driver, _spec)
+}
+
+func (jsq *JSONSchemaQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := jsq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (jsq *JSONSchemaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   jsonschema.Table,
+			Columns: jsonschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: jsonschema.FieldID,
+			},
+		},
+		From:   jsq.sql,
+		Unique: true,
+	}
+	if unique := jsq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := jsq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jsonschema.FieldID)
+		for i := range fields {
+			if fields[i] != jsonschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := jsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := jsq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := jsq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := jsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (jsq *JSONSchemaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(jsq.driver.Dialect())
+	t1 := builder.Table(jsonschema.Table)
+	selector := builder.Select(t1.Columns(jsonschema.Columns...)...).From(t1)
+	if jsq.sql != nil {
+		selector = jsq.sql
+		selector.Select(selector.Columns(jsonschema.Columns...)...)
+	}
+	for _, p := range jsq.predicates {
+		p(selector)
+	}
+	for _, p := range jsq.order {
+		p(selector)
+	}
+	if offset := jsq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := jsq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// JSONSchemaGroupBy is the group-by builder for JSONSchema entities.
+type JSONSchemaGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (jsgb *JSONSchemaGroupBy) Aggregate(fns ...AggregateFunc) *JSONSchemaGroupBy {
+	jsgb.fns = append(jsgb.fns, fns...)
+	return jsgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (jsgb *JSONSchemaGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := jsgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	jsgb.sql = query
+	return jsgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (jsgb *JSONSchemaGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := jsgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (jsgb *JSONSchemaGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(jsgb.fields) > 1 {
+		return nil, errors.New("ent: JSONSchemaGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := jsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (jsgb *JSONSchemaGroupBy) StringsX(ctx context.Context) []string {
+	v, err := jsgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (jsgb *JSONSchemaGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = jsgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jsonschema.Label}
+	default:
+		err = fmt.Errorf("ent: JSONSchemaGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (jsgb *JSONSchemaGroupBy) StringX(ctx context.Context) string {
+	v, err := jsgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (jsgb *JSONSchemaGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(jsgb.fields) > 1 {
+		return nil, errors.New("ent: JSONSchemaGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := jsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (jsgb *JSONSchemaGroupBy) IntsX(ctx context.Context) []int {
+	v, err := jsgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (jsgb *JSONSchemaGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = jsgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jsonschema.Label}
+	default:
+		err = fmt.Errorf("ent: JSONSchemaGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (jsgb *JSONSchemaGroupBy) IntX(ctx context.Context) int {
+	v, err := jsgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (jsgb *JSONSchemaGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(jsgb.fields) > 1 {
+		return nil, errors.New("ent: JSONSchemaGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := jsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (jsgb *JSONSchemaGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := jsgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (jsgb *JSONSchemaGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = jsgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jsonschema.Label}
+	default:
+		err = fmt.Errorf("ent: JSONSchemaGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (jsgb *JSONSchemaGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := jsgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (jsgb *JSONSchemaGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(jsgb.fields) > 1 {
+		return nil, errors.New("ent: JSONSchemaGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := jsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (jsgb *JSONSchemaGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := jsgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (jsgb *JSONSchemaGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = jsgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jsonschema.Label}
+	default:
+		err = fmt.Errorf("ent: JSONSchemaGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (jsgb *JSONSchemaGroupBy) BoolX(ctx context.Context) bool {
+	v, err := jsgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (jsgb *JSONSchemaGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range jsgb.fields {
+		if !jsonschema.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := jsgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := jsgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (jsgb *JSONSchemaGroupBy) sqlQuery() *sql.Selector {
+	selector := jsgb.sql
+	columns := make([]string, 0, len(jsgb.fields)+len(jsgb.fns))
+	columns = append(columns, jsgb.fields...)
+	for _, fn := range jsgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(jsgb.fields...)
+}
+
+// JSONSchemaSelect is the builder for selecting fields of JSONSchema entities.
+type JSONSchemaSelect struct {
+	*JSONSchemaQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (jss *JSONSchemaSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := jss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	jss.sql = jss.JSONSchemaQuery.sqlQuery(ctx)
+	return jss.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (jss *JSONSchemaSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := jss.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (jss *JSONSchemaSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(jss.fields) > 1 {
+		return nil, errors.New("ent: JSONSchemaSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := jss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (jss *JSONSchemaSelect) StringsX(ctx context.Context) []string {
+	v, err := jss.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (jss *JSONSchemaSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = jss.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jsonschema.Label}
+	default:
+		err = fmt.Errorf("ent: JSONSchemaSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (jss *JSONSchemaSelect) StringX(ctx context.Context) string {
+	v, err := jss.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (jss *JSONSchemaSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(jss.fields) > 1 {
+		return nil, errors.New("ent: JSONSchemaSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := jss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (jss *JSONSchemaSelect) IntsX(ctx context.Context) []int {
+	v, err := jss.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (jss *JSONSchemaSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = jss.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jsonschema.Label}
+	default:
+		err = fmt.Errorf("ent: JSONSchemaSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (jss *JSONSchemaSelect) IntX(ctx context.Context) int {
+	v, err := jss.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (jss *JSONSchemaSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(jss.fields) > 1 {
+		return nil, errors.New("ent: JSONSchemaSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := jss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (jss *JSONSchemaSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := jss.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (jss *JSONSchemaSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = jss.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jsonschema.Label}
+	default:
+		err = fmt.Errorf("ent: JSONSchemaSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (jss *JSONSchemaSelect) Float64X(ctx context.Context) float64 {
+	v, err := jss.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (jss *JSONSchemaSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(jss.fields) > 1 {
+		return nil, errors.New("ent: JSONSchemaSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := jss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (jss *JSONSchemaSelect) BoolsX(ctx context.Context) []bool {
+	v, err := jss.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (jss *JSONSchemaSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = jss.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{jsonschema.Label}
+	default:
+		err = fmt.Errorf("ent: JSONSchemaSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (jss *JSONSchemaSelect) BoolX(ctx context.Context) bool {
+	v, err := jss.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (jss *JSONSchemaSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := jss.sqlQuery().Query()
+	if err := jss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (jss *JSONSchemaSelect) sqlQuery() sql.Querier {
+	selector := jss.sql
+	selector.Select(selector.Columns(jss.fields...)...)
+	return selector
+}
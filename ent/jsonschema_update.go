@@ -0,0 +1,390 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/jsonschema"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// JSONSchemaUpdate is the builder for updating JSONSchema entities.
+type JSONSchemaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JSONSchemaMutation
+}
+
+// Where adds a new predicate for the JSONSchemaUpdate builder.
+func (jsu *JSONSchemaUpdate) Where(ps ...predicate.JSONSchema) *JSONSchemaUpdate {
+	jsu.mutation.predicates = append(jsu.mutation.predicates, ps...)
+	return jsu
+}
+
+// SetNamespace sets the "namespace" field.
+func (jsu *JSONSchemaUpdate) SetNamespace(s string) *JSONSchemaUpdate {
+	jsu.mutation.SetNamespace(s)
+	return jsu
+}
+
+// SetName sets the "name" field.
+func (jsu *JSONSchemaUpdate) SetName(s string) *JSONSchemaUpdate {
+	jsu.mutation.SetName(s)
+	return jsu
+}
+
+// SetVersion sets the "version" field.
+func (jsu *JSONSchemaUpdate) SetVersion(i int) *JSONSchemaUpdate {
+	jsu.mutation.ResetVersion()
+	jsu.mutation.SetVersion(i)
+	return jsu
+}
+
+// AddVersion adds i to the "version" field.
+func (jsu *JSONSchemaUpdate) AddVersion(i int) *JSONSchemaUpdate {
+	jsu.mutation.AddVersion(i)
+	return jsu
+}
+
+// SetSchema sets the "schema" field.
+func (jsu *JSONSchemaUpdate) SetSchema(s string) *JSONSchemaUpdate {
+	jsu.mutation.SetSchema(s)
+	return jsu
+}
+
+// SetHash sets the "hash" field.
+func (jsu *JSONSchemaUpdate) SetHash(s string) *JSONSchemaUpdate {
+	jsu.mutation.SetHash(s)
+	return jsu
+}
+
+// Mutation returns the JSONSchemaMutation object of the builder.
+func (jsu *JSONSchemaUpdate) Mutation() *JSONSchemaMutation {
+	return jsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jsu *JSONSchemaUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(jsu.hooks) == 0 {
+		affected, err = jsu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JSONSchemaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			jsu.mutation = mutation
+			affected, err = jsu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(jsu.hooks) - 1; i >= 0; i-- {
+			mut = jsu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, jsu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jsu *JSONSchemaUpdate) SaveX(ctx context.Context) int {
+	affected, err := jsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jsu *JSONSchemaUpdate) Exec(ctx context.Context) error {
+	_, err := jsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jsu *JSONSchemaUpdate) ExecX(ctx context.Context) {
+	if err := jsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (jsu *JSONSchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   jsonschema.Table,
+			Columns: jsonschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: jsonschema.FieldID,
+			},
+		},
+	}
+	if ps := jsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jsu.mutation.Namespace(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jsonschema.FieldNamespace,
+		})
+	}
+	if value, ok := jsu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jsonschema.FieldName,
+		})
+	}
+	if value, ok := jsu.mutation.Version(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: jsonschema.FieldVersion,
+		})
+	}
+	if value, ok := jsu.mutation.AddedVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: jsonschema.FieldVersion,
+		})
+	}
+	if value, ok := jsu.mutation.Schema(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jsonschema.FieldSchema,
+		})
+	}
+	if value, ok := jsu.mutation.Hash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jsonschema.FieldHash,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, jsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jsonschema.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// JSONSchemaUpdateOne is the builder for updating a single JSONSchema entity.
+type JSONSchemaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JSONSchemaMutation
+}
+
+// SetNamespace sets the "namespace" field.
+func (jsuo *JSONSchemaUpdateOne) SetNamespace(s string) *JSONSchemaUpdateOne {
+	jsuo.mutation.SetNamespace(s)
+	return jsuo
+}
+
+// SetName sets the "name" field.
+func (jsuo *JSONSchemaUpdateOne) SetName(s string) *JSONSchemaUpdateOne {
+	jsuo.mutation.SetName(s)
+	return jsuo
+}
+
+// SetVersion sets the "version" field.
+func (jsuo *JSONSchemaUpdateOne) SetVersion(i int) *JSONSchemaUpdateOne {
+	jsuo.mutation.ResetVersion()
+	jsuo.mutation.SetVersion(i)
+	return jsuo
+}
+
+// AddVersion adds i to the "version" field.
+func (jsuo *JSONSchemaUpdateOne) AddVersion(i int) *JSONSchemaUpdateOne {
+	jsuo.mutation.AddVersion(i)
+	return jsuo
+}
+
+// SetSchema sets the "schema" field.
+func (jsuo *JSONSchemaUpdateOne) SetSchema(s string) *JSONSchemaUpdateOne {
+	jsuo.mutation.SetSchema(s)
+	return jsuo
+}
+
+// SetHash sets the "hash" field.
+func (jsuo *JSONSchemaUpdateOne) SetHash(s string) *JSONSchemaUpdateOne {
+	jsuo.mutation.SetHash(s)
+	return jsuo
+}
+
+// Mutation returns the JSONSchemaMutation object of the builder.
+func (jsuo *JSONSchemaUpdateOne) Mutation() *JSONSchemaMutation {
+	return jsuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jsuo *JSONSchemaUpdateOne) Select(field string, fields ...string) *JSONSchemaUpdateOne {
+	jsuo.fields = append([]string{field}, fields...)
+	return jsuo
+}
+
+// Save executes the query and returns the updated JSONSchema entity.
+func (jsuo *JSONSchemaUpdateOne) Save(ctx context.Context) (*JSONSchema, error) {
+	var (
+		err  error
+		node *JSONSchema
+	)
+	if len(jsuo.hooks) == 0 {
+		node, err = jsuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JSONSchemaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			jsuo.mutation = mutation
+			node, err = jsuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(jsuo.hooks) - 1; i >= 0; i-- {
+			mut = jsuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, jsuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jsuo *JSONSchemaUpdateOne) SaveX(ctx context.Context) *JSONSchema {
+	node, err := jsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jsuo *JSONSchemaUpdateOne) Exec(ctx context.Context) error {
+	_, err := jsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jsuo *JSONSchemaUpdateOne) ExecX(ctx context.Context) {
+	if err := jsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (jsuo *JSONSchemaUpdateOne) sqlSave(ctx context.Context) (_node *JSONSchema, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   jsonschema.Table,
+			Columns: jsonschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: jsonschema.FieldID,
+			},
+		},
+	}
+	id, ok := jsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing JSONSchema.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jsonschema.FieldID)
+		for _, f := range fields {
+			if !jsonschema.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != jsonschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jsuo.mutation.Namespace(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jsonschema.FieldNamespace,
+		})
+	}
+	if value, ok := jsuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jsonschema.FieldName,
+		})
+	}
+	if value, ok := jsuo.mutation.Version(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: jsonschema.FieldVersion,
+		})
+	}
+	if value, ok := jsuo.mutation.AddedVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: jsonschema.FieldVersion,
+		})
+	}
+	if value, ok := jsuo.mutation.Schema(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jsonschema.FieldSchema,
+		})
+	}
+	if value, ok := jsuo.mutation.Hash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: jsonschema.FieldHash,
+		})
+	}
+	_node = &JSONSchema{config: jsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jsonschema.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
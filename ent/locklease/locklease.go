@@ -0,0 +1,39 @@
+// Code generated by entc, DO NOT EDIT.
+
+package locklease
+
+const (
+	// Label holds the string label denoting the locklease type in the database.
+	Label = "lock_lease"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldHolder holds the string denoting the holder field in the database.
+	FieldHolder = "holder"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldExpires holds the string denoting the expires field in the database.
+	FieldExpires = "expires"
+	// Table holds the table name of the locklease in the database.
+	Table = "lock_leases"
+)
+
+// Columns holds all SQL columns for locklease fields.
+var Columns = []string{
+	FieldID,
+	FieldKey,
+	FieldHolder,
+	FieldToken,
+	FieldExpires,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,527 @@
+// Code generated by entc, DO NOT EDIT.
+
+package locklease
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v int64) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKey), v))
+	})
+}
+
+// Holder applies equality check predicate on the "holder" field. It's identical to HolderEQ.
+func Holder(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHolder), v))
+	})
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldToken), v))
+	})
+}
+
+// Expires applies equality check predicate on the "expires" field. It's identical to ExpiresEQ.
+func Expires(v time.Time) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpires), v))
+	})
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v int64) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKey), v))
+	})
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v int64) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldKey), v))
+	})
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...int64) predicate.LockLease {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LockLease(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldKey), v...))
+	})
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...int64) predicate.LockLease {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LockLease(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldKey), v...))
+	})
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v int64) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldKey), v))
+	})
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v int64) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldKey), v))
+	})
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v int64) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldKey), v))
+	})
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v int64) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldKey), v))
+	})
+}
+
+// HolderEQ applies the EQ predicate on the "holder" field.
+func HolderEQ(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHolder), v))
+	})
+}
+
+// HolderNEQ applies the NEQ predicate on the "holder" field.
+func HolderNEQ(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHolder), v))
+	})
+}
+
+// HolderIn applies the In predicate on the "holder" field.
+func HolderIn(vs ...string) predicate.LockLease {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LockLease(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldHolder), v...))
+	})
+}
+
+// HolderNotIn applies the NotIn predicate on the "holder" field.
+func HolderNotIn(vs ...string) predicate.LockLease {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LockLease(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldHolder), v...))
+	})
+}
+
+// HolderGT applies the GT predicate on the "holder" field.
+func HolderGT(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldHolder), v))
+	})
+}
+
+// HolderGTE applies the GTE predicate on the "holder" field.
+func HolderGTE(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldHolder), v))
+	})
+}
+
+// HolderLT applies the LT predicate on the "holder" field.
+func HolderLT(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldHolder), v))
+	})
+}
+
+// HolderLTE applies the LTE predicate on the "holder" field.
+func HolderLTE(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldHolder), v))
+	})
+}
+
+// HolderContains applies the Contains predicate on the "holder" field.
+func HolderContains(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldHolder), v))
+	})
+}
+
+// HolderHasPrefix applies the HasPrefix predicate on the "holder" field.
+func HolderHasPrefix(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldHolder), v))
+	})
+}
+
+// HolderHasSuffix applies the HasSuffix predicate on the "holder" field.
+func HolderHasSuffix(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldHolder), v))
+	})
+}
+
+// HolderEqualFold applies the EqualFold predicate on the "holder" field.
+func HolderEqualFold(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldHolder), v))
+	})
+}
+
+// HolderContainsFold applies the ContainsFold predicate on the "holder" field.
+func HolderContainsFold(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldHolder), v))
+	})
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldToken), v))
+	})
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldToken), v))
+	})
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.LockLease {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LockLease(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldToken), v...))
+	})
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.LockLease {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LockLease(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldToken), v...))
+	})
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldToken), v))
+	})
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldToken), v))
+	})
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldToken), v))
+	})
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldToken), v))
+	})
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldToken), v))
+	})
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldToken), v))
+	})
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldToken), v))
+	})
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldToken), v))
+	})
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldToken), v))
+	})
+}
+
+// ExpiresEQ applies the EQ predicate on the "expires" field.
+func ExpiresEQ(v time.Time) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresNEQ applies the NEQ predicate on the "expires" field.
+func ExpiresNEQ(v time.Time) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresIn applies the In predicate on the "expires" field.
+func ExpiresIn(vs ...time.Time) predicate.LockLease {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LockLease(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpires), v...))
+	})
+}
+
+// ExpiresNotIn applies the NotIn predicate on the "expires" field.
+func ExpiresNotIn(vs ...time.Time) predicate.LockLease {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LockLease(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpires), v...))
+	})
+}
+
+// ExpiresGT applies the GT predicate on the "expires" field.
+func ExpiresGT(v time.Time) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresGTE applies the GTE predicate on the "expires" field.
+func ExpiresGTE(v time.Time) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresLT applies the LT predicate on the "expires" field.
+func ExpiresLT(v time.Time) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresLTE applies the LTE predicate on the "expires" field.
+func ExpiresLTE(v time.Time) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpires), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LockLease) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LockLease) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LockLease) predicate.LockLease {
+	return predicate.LockLease(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
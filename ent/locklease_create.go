@@ -0,0 +1,236 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/locklease"
+)
+
+// LockLeaseCreate is the builder for creating a LockLease entity.
+type LockLeaseCreate struct {
+	config
+	mutation *LockLeaseMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (llc *LockLeaseCreate) SetKey(i int64) *LockLeaseCreate {
+	llc.mutation.SetKey(i)
+	return llc
+}
+
+// SetHolder sets the "holder" field.
+func (llc *LockLeaseCreate) SetHolder(s string) *LockLeaseCreate {
+	llc.mutation.SetHolder(s)
+	return llc
+}
+
+// SetToken sets the "token" field.
+func (llc *LockLeaseCreate) SetToken(s string) *LockLeaseCreate {
+	llc.mutation.SetToken(s)
+	return llc
+}
+
+// SetExpires sets the "expires" field.
+func (llc *LockLeaseCreate) SetExpires(t time.Time) *LockLeaseCreate {
+	llc.mutation.SetExpires(t)
+	return llc
+}
+
+// Mutation returns the LockLeaseMutation object of the builder.
+func (llc *LockLeaseCreate) Mutation() *LockLeaseMutation {
+	return llc.mutation
+}
+
+// Save creates the LockLease in the database.
+func (llc *LockLeaseCreate) Save(ctx context.Context) (*LockLease, error) {
+	var (
+		err  error
+		node *LockLease
+	)
+	if len(llc.hooks) == 0 {
+		if err = llc.check(); err != nil {
+			return nil, err
+		}
+		node, err = llc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LockLeaseMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = llc.check(); err != nil {
+				return nil, err
+			}
+			llc.mutation = mutation
+			node, err = llc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(llc.hooks) - 1; i >= 0; i-- {
+			mut = llc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, llc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (llc *LockLeaseCreate) SaveX(ctx context.Context) *LockLease {
+	v, err := llc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (llc *LockLeaseCreate) check() error {
+	if _, ok := llc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New("ent: missing required field \"key\"")}
+	}
+	if _, ok := llc.mutation.Holder(); !ok {
+		return &ValidationError{Name: "holder", err: errors.New("ent: missing required field \"holder\"")}
+	}
+	if _, ok := llc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New("ent: missing required field \"token\"")}
+	}
+	if _, ok := llc.mutation.Expires(); !ok {
+		return &ValidationError{Name: "expires", err: errors.New("ent: missing required field \"expires\"")}
+	}
+	return nil
+}
+
+func (llc *LockLeaseCreate) sqlSave(ctx context.Context) (*LockLease, error) {
+	_node, _spec := llc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, llc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (llc *LockLeaseCreate) createSpec() (*LockLease, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LockLease{config: llc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: locklease.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: locklease.FieldID,
+			},
+		}
+	)
+	if value, ok := llc.mutation.Key(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: locklease.FieldKey,
+		})
+		_node.Key = value
+	}
+	if value, ok := llc.mutation.Holder(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: locklease.FieldHolder,
+		})
+		_node.Holder = value
+	}
+	if value, ok := llc.mutation.Token(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: locklease.FieldToken,
+		})
+		_node.Token = value
+	}
+	if value, ok := llc.mutation.Expires(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: locklease.FieldExpires,
+		})
+		_node.Expires = value
+	}
+	return _node, _spec
+}
+
+// LockLeaseCreateBulk is the builder for creating many LockLease entities in bulk.
+type LockLeaseCreateBulk struct {
+	config
+	builders []*LockLeaseCreate
+}
+
+// Save creates the LockLease entities in the database.
+func (llcb *LockLeaseCreateBulk) Save(ctx context.Context) ([]*LockLease, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(llcb.builders))
+	nodes := make([]*LockLease, len(llcb.builders))
+	mutators := make([]Mutator, len(llcb.builders))
+	for i := range llcb.builders {
+		func(i int, root context.Context) {
+			builder := llcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LockLeaseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, llcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, llcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, llcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (llcb *LockLeaseCreateBulk) SaveX(ctx context.Context) []*LockLease {
+	v, err := llcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,365 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/locklease"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// LockLeaseUpdate is the builder for updating LockLease entities.
+type LockLeaseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LockLeaseMutation
+}
+
+// Where adds a new predicate for the LockLeaseUpdate builder.
+func (llu *LockLeaseUpdate) Where(ps ...predicate.LockLease) *LockLeaseUpdate {
+	llu.mutation.predicates = append(llu.mutation.predicates, ps...)
+	return llu
+}
+
+// SetKey sets the "key" field.
+func (llu *LockLeaseUpdate) SetKey(i int64) *LockLeaseUpdate {
+	llu.mutation.ResetKey()
+	llu.mutation.SetKey(i)
+	return llu
+}
+
+// AddKey adds i to the "key" field.
+func (llu *LockLeaseUpdate) AddKey(i int64) *LockLeaseUpdate {
+	llu.mutation.AddKey(i)
+	return llu
+}
+
+// SetHolder sets the "holder" field.
+func (llu *LockLeaseUpdate) SetHolder(s string) *LockLeaseUpdate {
+	llu.mutation.SetHolder(s)
+	return llu
+}
+
+// SetToken sets the "token" field.
+func (llu *LockLeaseUpdate) SetToken(s string) *LockLeaseUpdate {
+	llu.mutation.SetToken(s)
+	return llu
+}
+
+// SetExpires sets the "expires" field.
+func (llu *LockLeaseUpdate) SetExpires(t time.Time) *LockLeaseUpdate {
+	llu.mutation.SetExpires(t)
+	return llu
+}
+
+// Mutation returns the LockLeaseMutation object of the builder.
+func (llu *LockLeaseUpdate) Mutation() *LockLeaseMutation {
+	return llu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (llu *LockLeaseUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(llu.hooks) == 0 {
+		affected, err = llu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LockLeaseMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			llu.mutation = mutation
+			affected, err = llu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(llu.hooks) - 1; i >= 0; i-- {
+			mut = llu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, llu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (llu *LockLeaseUpdate) SaveX(ctx context.Context) int {
+	affected, err := llu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (llu *LockLeaseUpdate) Exec(ctx context.Context) error {
+	_, err := llu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (llu *LockLeaseUpdate) ExecX(ctx context.Context) {
+	if err := llu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (llu *LockLeaseUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   locklease.Table,
+			Columns: locklease.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: locklease.FieldID,
+			},
+		},
+	}
+	if ps := llu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := llu.mutation.Key(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: locklease.FieldKey,
+		})
+	}
+	if value, ok := llu.mutation.AddedKey(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: locklease.FieldKey,
+		})
+	}
+	if value, ok := llu.mutation.Holder(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: locklease.FieldHolder,
+		})
+	}
+	if value, ok := llu.mutation.Token(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: locklease.FieldToken,
+		})
+	}
+	if value, ok := llu.mutation.Expires(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: locklease.FieldExpires,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, llu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{locklease.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// LockLeaseUpdateOne is the builder for updating a single LockLease entity.
+type LockLeaseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LockLeaseMutation
+}
+
+// SetKey sets the "key" field.
+func (lluo *LockLeaseUpdateOne) SetKey(i int64) *LockLeaseUpdateOne {
+	lluo.mutation.ResetKey()
+	lluo.mutation.SetKey(i)
+	return lluo
+}
+
+// AddKey adds i to the "key" field.
+func (lluo *LockLeaseUpdateOne) AddKey(i int64) *LockLeaseUpdateOne {
+	lluo.mutation.AddKey(i)
+	return lluo
+}
+
+// SetHolder sets the "holder" field.
+func (lluo *LockLeaseUpdateOne) SetHolder(s string) *LockLeaseUpdateOne {
+	lluo.mutation.SetHolder(s)
+	return lluo
+}
+
+// SetToken sets the "token" field.
+func (lluo *LockLeaseUpdateOne) SetToken(s string) *LockLeaseUpdateOne {
+	lluo.mutation.SetToken(s)
+	return lluo
+}
+
+// SetExpires sets the "expires" field.
+func (lluo *LockLeaseUpdateOne) SetExpires(t time.Time) *LockLeaseUpdateOne {
+	lluo.mutation.SetExpires(t)
+	return lluo
+}
+
+// Mutation returns the LockLeaseMutation object of the builder.
+func (lluo *LockLeaseUpdateOne) Mutation() *LockLeaseMutation {
+	return lluo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lluo *LockLeaseUpdateOne) Select(field string, fields ...string) *LockLeaseUpdateOne {
+	lluo.fields = append([]string{field}, fields...)
+	return lluo
+}
+
+// Save executes the query and returns the updated LockLease entity.
+func (lluo *LockLeaseUpdateOne) Save(ctx context.Context) (*LockLease, error) {
+	var (
+		err  error
+		node *LockLease
+	)
+	if len(lluo.hooks) == 0 {
+		node, err = lluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LockLeaseMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			lluo.mutation = mutation
+			node, err = lluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(lluo.hooks) - 1; i >= 0; i-- {
+			mut = lluo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lluo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lluo *LockLeaseUpdateOne) SaveX(ctx context.Context) *LockLease {
+	node, err := lluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lluo *LockLeaseUpdateOne) Exec(ctx context.Context) error {
+	_, err := lluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lluo *LockLeaseUpdateOne) ExecX(ctx context.Context) {
+	if err := lluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lluo *LockLeaseUpdateOne) sqlSave(ctx context.Context) (_node *LockLease, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   locklease.Table,
+			Columns: locklease.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: locklease.FieldID,
+			},
+		},
+	}
+	id, ok := lluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing LockLease.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, locklease.FieldID)
+		for _, f := range fields {
+			if !locklease.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != locklease.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lluo.mutation.Key(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: locklease.FieldKey,
+		})
+	}
+	if value, ok := lluo.mutation.AddedKey(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: locklease.FieldKey,
+		})
+	}
+	if value, ok := lluo.mutation.Holder(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: locklease.FieldHolder,
+		})
+	}
+	if value, ok := lluo.mutation.Token(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: locklease.FieldToken,
+		})
+	}
+	if value, ok := lluo.mutation.Expires(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: locklease.FieldExpires,
+		})
+	}
+	_node = &LockLease{config: lluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{locklease.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -8,17 +8,317 @@ import (
 )
 
 var (
+	// EventCountersColumns holds the columns for the "event_counters" table.
+	EventCountersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "type", Type: field.TypeString},
+		{Name: "received", Type: field.TypeInt64, Default: 0},
+		{Name: "matched", Type: field.TypeInt64, Default: 0},
+		{Name: "unmatched", Type: field.TypeInt64, Default: 0},
+		{Name: "rejected", Type: field.TypeInt64, Default: 0},
+		{Name: "last_received", Type: field.TypeTime},
+	}
+	// EventCountersTable holds the schema information for the "event_counters" table.
+	EventCountersTable = &schema.Table{
+		Name:        "event_counters",
+		Columns:     EventCountersColumns,
+		PrimaryKey:  []*schema.Column{EventCountersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventcounter_namespace_type",
+				Unique:  true,
+				Columns: []*schema.Column{EventCountersColumns[1], EventCountersColumns[2]},
+			},
+		},
+	}
+	// InstanceHistoriesColumns holds the columns for the "instance_histories" table.
+	InstanceHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "instance_id", Type: field.TypeString},
+		{Name: "step", Type: field.TypeInt},
+		{Name: "revision", Type: field.TypeInt},
+		{Name: "time", Type: field.TypeTime},
+		{Name: "data", Type: field.TypeBytes},
+	}
+	// InstanceHistoriesTable holds the schema information for the "instance_histories" table.
+	InstanceHistoriesTable = &schema.Table{
+		Name:        "instance_histories",
+		Columns:     InstanceHistoriesColumns,
+		PrimaryKey:  []*schema.Column{InstanceHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+		Indexes: []*schema.Index{
+			{
+				Name:    "instancehistory_instance_id",
+				Unique:  false,
+				Columns: []*schema.Column{InstanceHistoriesColumns[1]},
+			},
+		},
+	}
+	// JqModulesColumns holds the columns for the "jq_modules" table.
+	JqModulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "source", Type: field.TypeString, Size: 2147483647},
+		{Name: "updated", Type: field.TypeTime},
+	}
+	// JqModulesTable holds the schema information for the "jq_modules" table.
+	JqModulesTable = &schema.Table{
+		Name:        "jq_modules",
+		Columns:     JqModulesColumns,
+		PrimaryKey:  []*schema.Column{JqModulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+		Indexes: []*schema.Index{
+			{
+				Name:    "jqmodule_namespace_name",
+				Unique:  true,
+				Columns: []*schema.Column{JqModulesColumns[1], JqModulesColumns[2]},
+			},
+		},
+	}
+	// JSONSchemasColumns holds the columns for the "json_schemas" table.
+	JSONSchemasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "schema", Type: field.TypeString},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "created", Type: field.TypeTime},
+	}
+	// JSONSchemasTable holds the schema information for the "json_schemas" table.
+	JSONSchemasTable = &schema.Table{
+		Name:        "json_schemas",
+		Columns:     JSONSchemasColumns,
+		PrimaryKey:  []*schema.Column{JSONSchemasColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+		Indexes: []*schema.Index{
+			{
+				Name:    "jsonschema_namespace_name_version",
+				Unique:  true,
+				Columns: []*schema.Column{JSONSchemasColumns[1], JSONSchemasColumns[2], JSONSchemasColumns[3]},
+			},
+		},
+	}
+	// LockLeasesColumns holds the columns for the "lock_leases" table.
+	LockLeasesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeInt64, Unique: true},
+		{Name: "holder", Type: field.TypeString},
+		{Name: "token", Type: field.TypeString},
+		{Name: "expires", Type: field.TypeTime},
+	}
+	// LockLeasesTable holds the schema information for the "lock_leases" table.
+	LockLeasesTable = &schema.Table{
+		Name:        "lock_leases",
+		Columns:     LockLeasesColumns,
+		PrimaryKey:  []*schema.Column{LockLeasesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+	}
 	// NamespacesColumns holds the columns for the "namespaces" table.
 	NamespacesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 64},
 		{Name: "created", Type: field.TypeTime},
+		{Name: "hold", Type: field.TypeString, Nullable: true},
+		{Name: "region", Type: field.TypeString, Nullable: true},
+		{Name: "event_config", Type: field.TypeBytes, Nullable: true},
+		{Name: "cron_suspension", Type: field.TypeString, Nullable: true},
+		{Name: "constants", Type: field.TypeBytes, Nullable: true},
+		{Name: "profiles", Type: field.TypeBytes, Nullable: true},
+		{Name: "profile", Type: field.TypeString, Nullable: true},
+		{Name: "retry_policies", Type: field.TypeBytes, Nullable: true},
+		{Name: "namespace_children", Type: field.TypeString, Nullable: true, Size: 64},
 	}
 	// NamespacesTable holds the schema information for the "namespaces" table.
 	NamespacesTable = &schema.Table{
-		Name:        "namespaces",
-		Columns:     NamespacesColumns,
-		PrimaryKey:  []*schema.Column{NamespacesColumns[0]},
+		Name:       "namespaces",
+		Columns:    NamespacesColumns,
+		PrimaryKey: []*schema.Column{NamespacesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "namespaces_namespaces_children",
+				Columns:    []*schema.Column{NamespacesColumns[10]},
+				RefColumns: []*schema.Column{NamespacesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// NodesColumns holds the columns for the "nodes" table.
+	NodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hostname", Type: field.TypeString, Unique: true},
+		{Name: "started", Type: field.TypeTime},
+		{Name: "heartbeat", Type: field.TypeTime},
+		{Name: "draining", Type: field.TypeBool, Default: false},
+		{Name: "timers", Type: field.TypeInt, Default: 0},
+		{Name: "queued", Type: field.TypeInt, Default: 0},
+		{Name: "promoted", Type: field.TypeBool, Default: false},
+		{Name: "timer_stats", Type: field.TypeBytes, Nullable: true},
+		{Name: "lock_stats", Type: field.TypeBytes, Nullable: true},
+	}
+	// NodesTable holds the schema information for the "nodes" table.
+	NodesTable = &schema.Table{
+		Name:        "nodes",
+		Columns:     NodesColumns,
+		PrimaryKey:  []*schema.Column{NodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+	}
+	// QueuedInvocationsColumns holds the columns for the "queued_invocations" table.
+	QueuedInvocationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "instance_id", Type: field.TypeString, Unique: true},
+		{Name: "workflow", Type: field.TypeUUID},
+		{Name: "source", Type: field.TypeString},
+		{Name: "params", Type: field.TypeBytes},
+		{Name: "created", Type: field.TypeTime},
+		{Name: "expires", Type: field.TypeTime},
+	}
+	// QueuedInvocationsTable holds the schema information for the "queued_invocations" table.
+	QueuedInvocationsTable = &schema.Table{
+		Name:        "queued_invocations",
+		Columns:     QueuedInvocationsColumns,
+		PrimaryKey:  []*schema.Column{QueuedInvocationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+		Indexes: []*schema.Index{
+			{
+				Name:    "queuedinvocation_workflow_created",
+				Unique:  false,
+				Columns: []*schema.Column{QueuedInvocationsColumns[2], QueuedInvocationsColumns[5]},
+			},
+		},
+	}
+	// QueuedStatesColumns holds the columns for the "queued_states" table.
+	QueuedStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hostname", Type: field.TypeString},
+		{Name: "instance_id", Type: field.TypeString},
+		{Name: "message", Type: field.TypeBytes},
+		{Name: "size", Type: field.TypeInt},
+		{Name: "created", Type: field.TypeTime},
+	}
+	// QueuedStatesTable holds the schema information for the "queued_states" table.
+	QueuedStatesTable = &schema.Table{
+		Name:        "queued_states",
+		Columns:     QueuedStatesColumns,
+		PrimaryKey:  []*schema.Column{QueuedStatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+		Indexes: []*schema.Index{
+			{
+				Name:    "queuedstate_hostname_created",
+				Unique:  false,
+				Columns: []*schema.Column{QueuedStatesColumns[1], QueuedStatesColumns[5]},
+			},
+		},
+	}
+	// ScheduledInvocationsColumns holds the columns for the "scheduled_invocations" table.
+	ScheduledInvocationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "workflow", Type: field.TypeString},
+		{Name: "time", Type: field.TypeTime},
+		{Name: "input", Type: field.TypeBytes},
+		{Name: "state", Type: field.TypeString, Nullable: true},
+		{Name: "external_id", Type: field.TypeString, Nullable: true},
+		{Name: "expires", Type: field.TypeTime, Nullable: true},
+		{Name: "notify", Type: field.TypeBool, Default: false},
+		{Name: "created", Type: field.TypeTime},
+	}
+	// ScheduledInvocationsTable holds the schema information for the "scheduled_invocations" table.
+	ScheduledInvocationsTable = &schema.Table{
+		Name:        "scheduled_invocations",
+		Columns:     ScheduledInvocationsColumns,
+		PrimaryKey:  []*schema.Column{ScheduledInvocationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scheduledinvocation_namespace_time",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledInvocationsColumns[1], ScheduledInvocationsColumns[3]},
+			},
+			{
+				Name:    "scheduledinvocation_time",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledInvocationsColumns[3]},
+			},
+		},
+	}
+	// SettingsColumns holds the columns for the "settings" table.
+	SettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "value", Type: field.TypeString},
+		{Name: "updated", Type: field.TypeTime},
+	}
+	// SettingsTable holds the schema information for the "settings" table.
+	SettingsTable = &schema.Table{
+		Name:        "settings",
+		Columns:     SettingsColumns,
+		PrimaryKey:  []*schema.Column{SettingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+	}
+	// TimersColumns holds the columns for the "timers" table.
+	TimersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "function", Type: field.TypeString},
+		{Name: "time", Type: field.TypeTime},
+		{Name: "data", Type: field.TypeBytes, Nullable: true},
+	}
+	// TimersTable holds the schema information for the "timers" table.
+	TimersTable = &schema.Table{
+		Name:        "timers",
+		Columns:     TimersColumns,
+		PrimaryKey:  []*schema.Column{TimersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+	}
+	// UnmatchedEventsColumns holds the columns for the "unmatched_events" table.
+	UnmatchedEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "type", Type: field.TypeString},
+		{Name: "source", Type: field.TypeString},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "received", Type: field.TypeTime},
+		{Name: "cloudevent", Type: field.TypeBytes},
+	}
+	// UnmatchedEventsTable holds the schema information for the "unmatched_events" table.
+	UnmatchedEventsTable = &schema.Table{
+		Name:        "unmatched_events",
+		Columns:     UnmatchedEventsColumns,
+		PrimaryKey:  []*schema.Column{UnmatchedEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+		Indexes: []*schema.Index{
+			{
+				Name:    "unmatchedevent_namespace_received",
+				Unique:  false,
+				Columns: []*schema.Column{UnmatchedEventsColumns[1], UnmatchedEventsColumns[5]},
+			},
+		},
+	}
+	// WasmModulesColumns holds the columns for the "wasm_modules" table.
+	WasmModulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "binary", Type: field.TypeBytes},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "updated", Type: field.TypeTime},
+	}
+	// WasmModulesTable holds the schema information for the "wasm_modules" table.
+	WasmModulesTable = &schema.Table{
+		Name:        "wasm_modules",
+		Columns:     WasmModulesColumns,
+		PrimaryKey:  []*schema.Column{WasmModulesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wasmmodule_namespace_name",
+				Unique:  true,
+				Columns: []*schema.Column{WasmModulesColumns[1], WasmModulesColumns[2]},
+			},
+		},
 	}
 	// WorkflowsColumns holds the columns for the "workflows" table.
 	WorkflowsColumns = []*schema.Column{
@@ -107,6 +407,7 @@ var (
 	WorkflowInstancesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "instance_id", Type: field.TypeString, Unique: true},
+		{Name: "external_id", Type: field.TypeString, Nullable: true},
 		{Name: "invoked_by", Type: field.TypeString},
 		{Name: "status", Type: field.TypeString},
 		{Name: "revision", Type: field.TypeInt},
@@ -114,15 +415,28 @@ var (
 		{Name: "end_time", Type: field.TypeTime, Nullable: true},
 		{Name: "flow", Type: field.TypeJSON, Nullable: true},
 		{Name: "input", Type: field.TypeString},
-		{Name: "output", Type: field.TypeString, Nullable: true},
-		{Name: "state_data", Type: field.TypeString, Nullable: true},
+		{Name: "output", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "state_data", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "memory", Type: field.TypeString, Nullable: true},
+		{Name: "memory_data", Type: field.TypeBytes, Nullable: true},
 		{Name: "deadline", Type: field.TypeTime, Nullable: true},
 		{Name: "attempts", Type: field.TypeInt, Nullable: true},
 		{Name: "error_code", Type: field.TypeString, Nullable: true},
 		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "error_data", Type: field.TypeString, Nullable: true},
+		{Name: "error_causes", Type: field.TypeJSON, Nullable: true},
+		{Name: "cancel_category", Type: field.TypeString, Nullable: true},
+		{Name: "cancel_reason", Type: field.TypeString, Nullable: true},
+		{Name: "progress", Type: field.TypeString, Nullable: true},
 		{Name: "state_begin_time", Type: field.TypeTime, Nullable: true},
 		{Name: "controller", Type: field.TypeString, Nullable: true},
+		{Name: "handoff", Type: field.TypeBytes, Nullable: true},
+		{Name: "simulation", Type: field.TypeBytes, Nullable: true},
+		{Name: "debug", Type: field.TypeBytes, Nullable: true},
+		{Name: "hold", Type: field.TypeString, Nullable: true},
+		{Name: "profile", Type: field.TypeString, Nullable: true},
+		{Name: "received_events", Type: field.TypeString, Nullable: true},
+		{Name: "awaiting_cancels", Type: field.TypeBytes, Nullable: true},
 		{Name: "workflow_instances", Type: field.TypeUUID, Nullable: true},
 	}
 	// WorkflowInstancesTable holds the schema information for the "workflow_instances" table.
@@ -133,26 +447,77 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "workflow_instances_workflows_instances",
-				Columns:    []*schema.Column{WorkflowInstancesColumns[18]},
+				Columns:    []*schema.Column{WorkflowInstancesColumns[32]},
+				RefColumns: []*schema.Column{WorkflowsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "workflowinstance_external_id_workflow_instances",
+				Unique:  true,
+				Columns: []*schema.Column{WorkflowInstancesColumns[2], WorkflowInstancesColumns[32]},
+			},
+		},
+	}
+	// WorkflowRevisionsColumns holds the columns for the "workflow_revisions" table.
+	WorkflowRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "revision", Type: field.TypeInt},
+		{Name: "definition", Type: field.TypeBytes},
+		{Name: "workflow_revisions", Type: field.TypeUUID, Nullable: true},
+	}
+	// WorkflowRevisionsTable holds the schema information for the "workflow_revisions" table.
+	WorkflowRevisionsTable = &schema.Table{
+		Name:       "workflow_revisions",
+		Columns:    WorkflowRevisionsColumns,
+		PrimaryKey: []*schema.Column{WorkflowRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "workflow_revisions_workflows_revisions",
+				Columns:    []*schema.Column{WorkflowRevisionsColumns[3]},
 				RefColumns: []*schema.Column{WorkflowsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "workflowrevision_revision_workflow_revisions",
+				Unique:  true,
+				Columns: []*schema.Column{WorkflowRevisionsColumns[1], WorkflowRevisionsColumns[3]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		EventCountersTable,
+		InstanceHistoriesTable,
+		JqModulesTable,
+		JSONSchemasTable,
+		LockLeasesTable,
 		NamespacesTable,
+		NodesTable,
+		QueuedInvocationsTable,
+		QueuedStatesTable,
+		ScheduledInvocationsTable,
+		SettingsTable,
+		TimersTable,
+		UnmatchedEventsTable,
+		WasmModulesTable,
 		WorkflowsTable,
 		WorkflowEventsTable,
 		WorkflowEventsWaitsTable,
 		WorkflowInstancesTable,
+		WorkflowRevisionsTable,
 	}
 )
 
 func init() {
+	NamespacesTable.ForeignKeys[0].RefTable = NamespacesTable
 	WorkflowsTable.ForeignKeys[0].RefTable = NamespacesTable
 	WorkflowEventsTable.ForeignKeys[0].RefTable = WorkflowsTable
 	WorkflowEventsTable.ForeignKeys[1].RefTable = WorkflowInstancesTable
 	WorkflowEventsWaitsTable.ForeignKeys[0].RefTable = WorkflowEventsTable
 	WorkflowInstancesTable.ForeignKeys[0].RefTable = WorkflowsTable
+	WorkflowRevisionsTable.ForeignKeys[0].RefTable = WorkflowsTable
 }
@@ -9,12 +9,27 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent/eventcounter"
+	"github.com/vorteil/direktiv/ent/instancehistory"
+	"github.com/vorteil/direktiv/ent/jqmodule"
+	"github.com/vorteil/direktiv/ent/jsonschema"
+	"github.com/vorteil/direktiv/ent/locklease"
 	"github.com/vorteil/direktiv/ent/namespace"
+	"github.com/vorteil/direktiv/ent/node"
 	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/queuedinvocation"
+	"github.com/vorteil/direktiv/ent/queuedstate"
+	"github.com/vorteil/direktiv/ent/scheduledinvocation"
+	"github.com/vorteil/direktiv/ent/schema"
+	"github.com/vorteil/direktiv/ent/setting"
+	"github.com/vorteil/direktiv/ent/timer"
+	"github.com/vorteil/direktiv/ent/unmatchedevent"
+	"github.com/vorteil/direktiv/ent/wasmmodule"
 	"github.com/vorteil/direktiv/ent/workflow"
 	"github.com/vorteil/direktiv/ent/workflowevents"
 	"github.com/vorteil/direktiv/ent/workfloweventswait"
 	"github.com/vorteil/direktiv/ent/workflowinstance"
+	"github.com/vorteil/direktiv/ent/workflowrevision"
 
 	"entgo.io/ent"
 )
@@ -28,40 +43,61 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeNamespace          = "Namespace"
-	TypeWorkflow           = "Workflow"
-	TypeWorkflowEvents     = "WorkflowEvents"
-	TypeWorkflowEventsWait = "WorkflowEventsWait"
-	TypeWorkflowInstance   = "WorkflowInstance"
+	TypeEventCounter        = "EventCounter"
+	TypeInstanceHistory     = "InstanceHistory"
+	TypeJQModule            = "JQModule"
+	TypeJSONSchema          = "JSONSchema"
+	TypeLockLease           = "LockLease"
+	TypeNamespace           = "Namespace"
+	TypeNode                = "Node"
+	TypeQueuedInvocation    = "QueuedInvocation"
+	TypeQueuedState         = "QueuedState"
+	TypeScheduledInvocation = "ScheduledInvocation"
+	TypeSetting             = "Setting"
+	TypeTimer               = "Timer"
+	TypeUnmatchedEvent      = "UnmatchedEvent"
+	TypeWasmModule          = "WasmModule"
+	TypeWorkflow            = "Workflow"
+	TypeWorkflowEvents      = "WorkflowEvents"
+	TypeWorkflowEventsWait  = "WorkflowEventsWait"
+	TypeWorkflowInstance    = "WorkflowInstance"
+	TypeWorkflowRevision    = "WorkflowRevision"
 )
 
-// NamespaceMutation represents an operation that mutates the Namespace nodes in the graph.
-type NamespaceMutation struct {
+// EventCounterMutation represents an operation that mutates the EventCounter nodes in the graph.
+type EventCounterMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *string
-	created          *time.Time
-	clearedFields    map[string]struct{}
-	workflows        map[uuid.UUID]struct{}
-	removedworkflows map[uuid.UUID]struct{}
-	clearedworkflows bool
-	done             bool
-	oldValue         func(context.Context) (*Namespace, error)
-	predicates       []predicate.Namespace
-}
-
-var _ ent.Mutation = (*NamespaceMutation)(nil)
-
-// namespaceOption allows management of the mutation configuration using functional options.
-type namespaceOption func(*NamespaceMutation)
-
-// newNamespaceMutation creates new mutation for the Namespace entity.
-func newNamespaceMutation(c config, op Op, opts ...namespaceOption) *NamespaceMutation {
-	m := &NamespaceMutation{
+	op            Op
+	typ           string
+	id            *int
+	namespace     *string
+	_type         *string
+	received      *int64
+	addreceived   *int64
+	matched       *int64
+	addmatched    *int64
+	unmatched     *int64
+	addunmatched  *int64
+	rejected      *int64
+	addrejected   *int64
+	last_received *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EventCounter, error)
+	predicates    []predicate.EventCounter
+}
+
+var _ ent.Mutation = (*EventCounterMutation)(nil)
+
+// eventcounterOption allows management of the mutation configuration using functional options.
+type eventcounterOption func(*EventCounterMutation)
+
+// newEventCounterMutation creates new mutation for the EventCounter entity.
+func newEventCounterMutation(c config, op Op, opts ...eventcounterOption) *EventCounterMutation {
+	m := &EventCounterMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNamespace,
+		typ:           TypeEventCounter,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -70,20 +106,20 @@ func newNamespaceMutation(c config, op Op, opts ...namespaceOption) *NamespaceMu
 	return m
 }
 
-// withNamespaceID sets the ID field of the mutation.
-func withNamespaceID(id string) namespaceOption {
-	return func(m *NamespaceMutation) {
+// withEventCounterID sets the ID field of the mutation.
+func withEventCounterID(id int) eventcounterOption {
+	return func(m *EventCounterMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Namespace
+			value *EventCounter
 		)
-		m.oldValue = func(ctx context.Context) (*Namespace, error) {
+		m.oldValue = func(ctx context.Context) (*EventCounter, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Namespace.Get(ctx, id)
+					value, err = m.Client().EventCounter.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -92,10 +128,10 @@ func withNamespaceID(id string) namespaceOption {
 	}
 }
 
-// withNamespace sets the old Namespace of the mutation.
-func withNamespace(node *Namespace) namespaceOption {
-	return func(m *NamespaceMutation) {
-		m.oldValue = func(context.Context) (*Namespace, error) {
+// withEventCounter sets the old EventCounter of the mutation.
+func withEventCounter(node *EventCounter) eventcounterOption {
+	return func(m *EventCounterMutation) {
+		m.oldValue = func(context.Context) (*EventCounter, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -104,7 +140,7 @@ func withNamespace(node *Namespace) namespaceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NamespaceMutation) Client() *Client {
+func (m EventCounterMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -112,7 +148,7 @@ func (m NamespaceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NamespaceMutation) Tx() (*Tx, error) {
+func (m EventCounterMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -121,896 +157,1006 @@ func (m NamespaceMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Namespace entities.
-func (m *NamespaceMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID
 // is only available if it was provided to the builder.
-func (m *NamespaceMutation) ID() (id string, exists bool) {
+func (m *EventCounterMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
 	return *m.id, true
 }
 
-// SetCreated sets the "created" field.
-func (m *NamespaceMutation) SetCreated(t time.Time) {
-	m.created = &t
+// SetNamespace sets the "namespace" field.
+func (m *EventCounterMutation) SetNamespace(s string) {
+	m.namespace = &s
 }
 
-// Created returns the value of the "created" field in the mutation.
-func (m *NamespaceMutation) Created() (r time.Time, exists bool) {
-	v := m.created
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *EventCounterMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreated returns the old "created" field's value of the Namespace entity.
-// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
+// OldNamespace returns the old "namespace" field's value of the EventCounter entity.
+// If the EventCounter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NamespaceMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
+func (m *EventCounterMutation) OldNamespace(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldCreated is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldNamespace is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldCreated requires an ID field in the mutation")
+		return v, fmt.Errorf("OldNamespace requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
 	}
-	return oldValue.Created, nil
+	return oldValue.Namespace, nil
 }
 
-// ResetCreated resets all changes to the "created" field.
-func (m *NamespaceMutation) ResetCreated() {
-	m.created = nil
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *EventCounterMutation) ResetNamespace() {
+	m.namespace = nil
 }
 
-// AddWorkflowIDs adds the "workflows" edge to the Workflow entity by ids.
-func (m *NamespaceMutation) AddWorkflowIDs(ids ...uuid.UUID) {
-	if m.workflows == nil {
-		m.workflows = make(map[uuid.UUID]struct{})
+// SetType sets the "type" field.
+func (m *EventCounterMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *EventCounterMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.workflows[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the EventCounter entity.
+// If the EventCounter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCounterMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
+	return oldValue.Type, nil
 }
 
-// ClearWorkflows clears the "workflows" edge to the Workflow entity.
-func (m *NamespaceMutation) ClearWorkflows() {
-	m.clearedworkflows = true
+// ResetType resets all changes to the "type" field.
+func (m *EventCounterMutation) ResetType() {
+	m._type = nil
 }
 
-// WorkflowsCleared reports if the "workflows" edge to the Workflow entity was cleared.
-func (m *NamespaceMutation) WorkflowsCleared() bool {
-	return m.clearedworkflows
+// SetReceived sets the "received" field.
+func (m *EventCounterMutation) SetReceived(i int64) {
+	m.received = &i
+	m.addreceived = nil
 }
 
-// RemoveWorkflowIDs removes the "workflows" edge to the Workflow entity by IDs.
-func (m *NamespaceMutation) RemoveWorkflowIDs(ids ...uuid.UUID) {
-	if m.removedworkflows == nil {
-		m.removedworkflows = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.removedworkflows[ids[i]] = struct{}{}
+// Received returns the value of the "received" field in the mutation.
+func (m *EventCounterMutation) Received() (r int64, exists bool) {
+	v := m.received
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedWorkflows returns the removed IDs of the "workflows" edge to the Workflow entity.
-func (m *NamespaceMutation) RemovedWorkflowsIDs() (ids []uuid.UUID) {
-	for id := range m.removedworkflows {
-		ids = append(ids, id)
+// OldReceived returns the old "received" field's value of the EventCounter entity.
+// If the EventCounter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCounterMutation) OldReceived(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldReceived is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldReceived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceived: %w", err)
+	}
+	return oldValue.Received, nil
 }
 
-// WorkflowsIDs returns the "workflows" edge IDs in the mutation.
-func (m *NamespaceMutation) WorkflowsIDs() (ids []uuid.UUID) {
-	for id := range m.workflows {
-		ids = append(ids, id)
+// AddReceived adds i to the "received" field.
+func (m *EventCounterMutation) AddReceived(i int64) {
+	if m.addreceived != nil {
+		*m.addreceived += i
+	} else {
+		m.addreceived = &i
 	}
-	return
 }
 
-// ResetWorkflows resets all changes to the "workflows" edge.
-func (m *NamespaceMutation) ResetWorkflows() {
-	m.workflows = nil
-	m.clearedworkflows = false
-	m.removedworkflows = nil
+// AddedReceived returns the value that was added to the "received" field in this mutation.
+func (m *EventCounterMutation) AddedReceived() (r int64, exists bool) {
+	v := m.addreceived
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *NamespaceMutation) Op() Op {
-	return m.op
+// ResetReceived resets all changes to the "received" field.
+func (m *EventCounterMutation) ResetReceived() {
+	m.received = nil
+	m.addreceived = nil
 }
 
-// Type returns the node type of this mutation (Namespace).
-func (m *NamespaceMutation) Type() string {
-	return m.typ
+// SetMatched sets the "matched" field.
+func (m *EventCounterMutation) SetMatched(i int64) {
+	m.matched = &i
+	m.addmatched = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *NamespaceMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.created != nil {
-		fields = append(fields, namespace.FieldCreated)
+// Matched returns the value of the "matched" field in the mutation.
+func (m *EventCounterMutation) Matched() (r int64, exists bool) {
+	v := m.matched
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *NamespaceMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case namespace.FieldCreated:
-		return m.Created()
+// OldMatched returns the old "matched" field's value of the EventCounter entity.
+// If the EventCounter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCounterMutation) OldMatched(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMatched is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMatched requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMatched: %w", err)
+	}
+	return oldValue.Matched, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *NamespaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case namespace.FieldCreated:
-		return m.OldCreated(ctx)
+// AddMatched adds i to the "matched" field.
+func (m *EventCounterMutation) AddMatched(i int64) {
+	if m.addmatched != nil {
+		*m.addmatched += i
+	} else {
+		m.addmatched = &i
 	}
-	return nil, fmt.Errorf("unknown Namespace field %s", name)
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *NamespaceMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case namespace.FieldCreated:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreated(v)
-		return nil
+// AddedMatched returns the value that was added to the "matched" field in this mutation.
+func (m *EventCounterMutation) AddedMatched() (r int64, exists bool) {
+	v := m.addmatched
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Namespace field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *NamespaceMutation) AddedFields() []string {
-	return nil
+// ResetMatched resets all changes to the "matched" field.
+func (m *EventCounterMutation) ResetMatched() {
+	m.matched = nil
+	m.addmatched = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *NamespaceMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetUnmatched sets the "unmatched" field.
+func (m *EventCounterMutation) SetUnmatched(i int64) {
+	m.unmatched = &i
+	m.addunmatched = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *NamespaceMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// Unmatched returns the value of the "unmatched" field in the mutation.
+func (m *EventCounterMutation) Unmatched() (r int64, exists bool) {
+	v := m.unmatched
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Namespace numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *NamespaceMutation) ClearedFields() []string {
-	return nil
+// OldUnmatched returns the old "unmatched" field's value of the EventCounter entity.
+// If the EventCounter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCounterMutation) OldUnmatched(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUnmatched is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUnmatched requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnmatched: %w", err)
+	}
+	return oldValue.Unmatched, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *NamespaceMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AddUnmatched adds i to the "unmatched" field.
+func (m *EventCounterMutation) AddUnmatched(i int64) {
+	if m.addunmatched != nil {
+		*m.addunmatched += i
+	} else {
+		m.addunmatched = &i
+	}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *NamespaceMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Namespace nullable field %s", name)
+// AddedUnmatched returns the value that was added to the "unmatched" field in this mutation.
+func (m *EventCounterMutation) AddedUnmatched() (r int64, exists bool) {
+	v := m.addunmatched
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *NamespaceMutation) ResetField(name string) error {
-	switch name {
-	case namespace.FieldCreated:
-		m.ResetCreated()
-		return nil
-	}
-	return fmt.Errorf("unknown Namespace field %s", name)
+// ResetUnmatched resets all changes to the "unmatched" field.
+func (m *EventCounterMutation) ResetUnmatched() {
+	m.unmatched = nil
+	m.addunmatched = nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NamespaceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.workflows != nil {
-		edges = append(edges, namespace.EdgeWorkflows)
-	}
-	return edges
+// SetRejected sets the "rejected" field.
+func (m *EventCounterMutation) SetRejected(i int64) {
+	m.rejected = &i
+	m.addrejected = nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *NamespaceMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case namespace.EdgeWorkflows:
-		ids := make([]ent.Value, 0, len(m.workflows))
-		for id := range m.workflows {
-			ids = append(ids, id)
-		}
-		return ids
+// Rejected returns the value of the "rejected" field in the mutation.
+func (m *EventCounterMutation) Rejected() (r int64, exists bool) {
+	v := m.rejected
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NamespaceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedworkflows != nil {
-		edges = append(edges, namespace.EdgeWorkflows)
-	}
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *NamespaceMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case namespace.EdgeWorkflows:
-		ids := make([]ent.Value, 0, len(m.removedworkflows))
-		for id := range m.removedworkflows {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NamespaceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedworkflows {
-		edges = append(edges, namespace.EdgeWorkflows)
-	}
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *NamespaceMutation) EdgeCleared(name string) bool {
-	switch name {
-	case namespace.EdgeWorkflows:
-		return m.clearedworkflows
-	}
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *NamespaceMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Namespace unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *NamespaceMutation) ResetEdge(name string) error {
-	switch name {
-	case namespace.EdgeWorkflows:
-		m.ResetWorkflows()
-		return nil
-	}
-	return fmt.Errorf("unknown Namespace edge %s", name)
-}
-
-// WorkflowMutation represents an operation that mutates the Workflow nodes in the graph.
-type WorkflowMutation struct {
-	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	name             *string
-	created          *time.Time
-	description      *string
-	active           *bool
-	revision         *int
-	addrevision      *int
-	workflow         *[]byte
-	logToEvents      *string
-	clearedFields    map[string]struct{}
-	namespace        *string
-	clearednamespace bool
-	instances        map[int]struct{}
-	removedinstances map[int]struct{}
-	clearedinstances bool
-	wfevents         map[int]struct{}
-	removedwfevents  map[int]struct{}
-	clearedwfevents  bool
-	done             bool
-	oldValue         func(context.Context) (*Workflow, error)
-	predicates       []predicate.Workflow
-}
-
-var _ ent.Mutation = (*WorkflowMutation)(nil)
-
-// workflowOption allows management of the mutation configuration using functional options.
-type workflowOption func(*WorkflowMutation)
-
-// newWorkflowMutation creates new mutation for the Workflow entity.
-func newWorkflowMutation(c config, op Op, opts ...workflowOption) *WorkflowMutation {
-	m := &WorkflowMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeWorkflow,
-		clearedFields: make(map[string]struct{}),
+// OldRejected returns the old "rejected" field's value of the EventCounter entity.
+// If the EventCounter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCounterMutation) OldRejected(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRejected is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRejected requires an ID field in the mutation")
 	}
-	return m
-}
-
-// withWorkflowID sets the ID field of the mutation.
-func withWorkflowID(id uuid.UUID) workflowOption {
-	return func(m *WorkflowMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Workflow
-		)
-		m.oldValue = func(ctx context.Context) (*Workflow, error) {
-			once.Do(func() {
-				if m.done {
-					err = fmt.Errorf("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Workflow.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRejected: %w", err)
 	}
+	return oldValue.Rejected, nil
 }
 
-// withWorkflow sets the old Workflow of the mutation.
-func withWorkflow(node *Workflow) workflowOption {
-	return func(m *WorkflowMutation) {
-		m.oldValue = func(context.Context) (*Workflow, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddRejected adds i to the "rejected" field.
+func (m *EventCounterMutation) AddRejected(i int64) {
+	if m.addrejected != nil {
+		*m.addrejected += i
+	} else {
+		m.addrejected = &i
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WorkflowMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m WorkflowMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+// AddedRejected returns the value that was added to the "rejected" field in this mutation.
+func (m *EventCounterMutation) AddedRejected() (r int64, exists bool) {
+	v := m.addrejected
+	if v == nil {
+		return
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Workflow entities.
-func (m *WorkflowMutation) SetID(id uuid.UUID) {
-	m.id = &id
+	return *v, true
 }
 
-// ID returns the ID value in the mutation. Note that the ID
-// is only available if it was provided to the builder.
-func (m *WorkflowMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ResetRejected resets all changes to the "rejected" field.
+func (m *EventCounterMutation) ResetRejected() {
+	m.rejected = nil
+	m.addrejected = nil
 }
 
-// SetName sets the "name" field.
-func (m *WorkflowMutation) SetName(s string) {
-	m.name = &s
+// SetLastReceived sets the "last_received" field.
+func (m *EventCounterMutation) SetLastReceived(t time.Time) {
+	m.last_received = &t
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *WorkflowMutation) Name() (r string, exists bool) {
-	v := m.name
+// LastReceived returns the value of the "last_received" field in the mutation.
+func (m *EventCounterMutation) LastReceived() (r time.Time, exists bool) {
+	v := m.last_received
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Workflow entity.
-// If the Workflow object wasn't provided to the builder, the object is fetched from the database.
+// OldLastReceived returns the old "last_received" field's value of the EventCounter entity.
+// If the EventCounter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *EventCounterMutation) OldLastReceived(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldLastReceived is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+		return v, fmt.Errorf("OldLastReceived requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastReceived: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.LastReceived, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *WorkflowMutation) ResetName() {
-	m.name = nil
+// ResetLastReceived resets all changes to the "last_received" field.
+func (m *EventCounterMutation) ResetLastReceived() {
+	m.last_received = nil
 }
 
-// SetCreated sets the "created" field.
-func (m *WorkflowMutation) SetCreated(t time.Time) {
-	m.created = &t
+// Op returns the operation name.
+func (m *EventCounterMutation) Op() Op {
+	return m.op
 }
 
-// Created returns the value of the "created" field in the mutation.
-func (m *WorkflowMutation) Created() (r time.Time, exists bool) {
-	v := m.created
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (EventCounter).
+func (m *EventCounterMutation) Type() string {
+	return m.typ
 }
 
-// OldCreated returns the old "created" field's value of the Workflow entity.
-// If the Workflow object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldCreated is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventCounterMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.namespace != nil {
+		fields = append(fields, eventcounter.FieldNamespace)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldCreated requires an ID field in the mutation")
+	if m._type != nil {
+		fields = append(fields, eventcounter.FieldType)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	if m.received != nil {
+		fields = append(fields, eventcounter.FieldReceived)
 	}
-	return oldValue.Created, nil
-}
-
-// ResetCreated resets all changes to the "created" field.
-func (m *WorkflowMutation) ResetCreated() {
-	m.created = nil
-}
-
-// SetDescription sets the "description" field.
-func (m *WorkflowMutation) SetDescription(s string) {
-	m.description = &s
-}
-
-// Description returns the value of the "description" field in the mutation.
-func (m *WorkflowMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
+	if m.matched != nil {
+		fields = append(fields, eventcounter.FieldMatched)
 	}
-	return *v, true
-}
-
-// OldDescription returns the old "description" field's value of the Workflow entity.
-// If the Workflow object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldDescription is only allowed on UpdateOne operations")
+	if m.unmatched != nil {
+		fields = append(fields, eventcounter.FieldUnmatched)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldDescription requires an ID field in the mutation")
+	if m.rejected != nil {
+		fields = append(fields, eventcounter.FieldRejected)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	if m.last_received != nil {
+		fields = append(fields, eventcounter.FieldLastReceived)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *WorkflowMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[workflow.FieldDescription] = struct{}{}
+	return fields
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *WorkflowMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[workflow.FieldDescription]
-	return ok
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *WorkflowMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, workflow.FieldDescription)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventCounterMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case eventcounter.FieldNamespace:
+		return m.Namespace()
+	case eventcounter.FieldType:
+		return m.GetType()
+	case eventcounter.FieldReceived:
+		return m.Received()
+	case eventcounter.FieldMatched:
+		return m.Matched()
+	case eventcounter.FieldUnmatched:
+		return m.Unmatched()
+	case eventcounter.FieldRejected:
+		return m.Rejected()
+	case eventcounter.FieldLastReceived:
+		return m.LastReceived()
+	}
+	return nil, false
 }
 
-// SetActive sets the "active" field.
-func (m *WorkflowMutation) SetActive(b bool) {
-	m.active = &b
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventCounterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case eventcounter.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case eventcounter.FieldType:
+		return m.OldType(ctx)
+	case eventcounter.FieldReceived:
+		return m.OldReceived(ctx)
+	case eventcounter.FieldMatched:
+		return m.OldMatched(ctx)
+	case eventcounter.FieldUnmatched:
+		return m.OldUnmatched(ctx)
+	case eventcounter.FieldRejected:
+		return m.OldRejected(ctx)
+	case eventcounter.FieldLastReceived:
+		return m.OldLastReceived(ctx)
+	}
+	return nil, fmt.Errorf("unknown EventCounter field %s", name)
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *WorkflowMutation) Active() (r bool, exists bool) {
-	v := m.active
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventCounterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case eventcounter.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case eventcounter.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case eventcounter.FieldReceived:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceived(v)
+		return nil
+	case eventcounter.FieldMatched:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMatched(v)
+		return nil
+	case eventcounter.FieldUnmatched:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnmatched(v)
+		return nil
+	case eventcounter.FieldRejected:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRejected(v)
+		return nil
+	case eventcounter.FieldLastReceived:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastReceived(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown EventCounter field %s", name)
 }
 
-// OldActive returns the old "active" field's value of the Workflow entity.
-// If the Workflow object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowMutation) OldActive(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldActive is only allowed on UpdateOne operations")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventCounterMutation) AddedFields() []string {
+	var fields []string
+	if m.addreceived != nil {
+		fields = append(fields, eventcounter.FieldReceived)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldActive requires an ID field in the mutation")
+	if m.addmatched != nil {
+		fields = append(fields, eventcounter.FieldMatched)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	if m.addunmatched != nil {
+		fields = append(fields, eventcounter.FieldUnmatched)
 	}
-	return oldValue.Active, nil
-}
-
-// ResetActive resets all changes to the "active" field.
-func (m *WorkflowMutation) ResetActive() {
-	m.active = nil
-}
-
-// SetRevision sets the "revision" field.
-func (m *WorkflowMutation) SetRevision(i int) {
-	m.revision = &i
-	m.addrevision = nil
-}
-
-// Revision returns the value of the "revision" field in the mutation.
-func (m *WorkflowMutation) Revision() (r int, exists bool) {
-	v := m.revision
-	if v == nil {
-		return
+	if m.addrejected != nil {
+		fields = append(fields, eventcounter.FieldRejected)
 	}
-	return *v, true
+	return fields
 }
 
-// OldRevision returns the old "revision" field's value of the Workflow entity.
-// If the Workflow object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowMutation) OldRevision(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldRevision is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldRevision requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventCounterMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case eventcounter.FieldReceived:
+		return m.AddedReceived()
+	case eventcounter.FieldMatched:
+		return m.AddedMatched()
+	case eventcounter.FieldUnmatched:
+		return m.AddedUnmatched()
+	case eventcounter.FieldRejected:
+		return m.AddedRejected()
 	}
-	return oldValue.Revision, nil
+	return nil, false
 }
 
-// AddRevision adds i to the "revision" field.
-func (m *WorkflowMutation) AddRevision(i int) {
-	if m.addrevision != nil {
-		*m.addrevision += i
-	} else {
-		m.addrevision = &i
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventCounterMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case eventcounter.FieldReceived:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReceived(v)
+		return nil
+	case eventcounter.FieldMatched:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMatched(v)
+		return nil
+	case eventcounter.FieldUnmatched:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUnmatched(v)
+		return nil
+	case eventcounter.FieldRejected:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRejected(v)
+		return nil
 	}
+	return fmt.Errorf("unknown EventCounter numeric field %s", name)
 }
 
-// AddedRevision returns the value that was added to the "revision" field in this mutation.
-func (m *WorkflowMutation) AddedRevision() (r int, exists bool) {
-	v := m.addrevision
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventCounterMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetRevision resets all changes to the "revision" field.
-func (m *WorkflowMutation) ResetRevision() {
-	m.revision = nil
-	m.addrevision = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventCounterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetWorkflow sets the "workflow" field.
-func (m *WorkflowMutation) SetWorkflow(b []byte) {
-	m.workflow = &b
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventCounterMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EventCounter nullable field %s", name)
 }
 
-// Workflow returns the value of the "workflow" field in the mutation.
-func (m *WorkflowMutation) Workflow() (r []byte, exists bool) {
-	v := m.workflow
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventCounterMutation) ResetField(name string) error {
+	switch name {
+	case eventcounter.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case eventcounter.FieldType:
+		m.ResetType()
+		return nil
+	case eventcounter.FieldReceived:
+		m.ResetReceived()
+		return nil
+	case eventcounter.FieldMatched:
+		m.ResetMatched()
+		return nil
+	case eventcounter.FieldUnmatched:
+		m.ResetUnmatched()
+		return nil
+	case eventcounter.FieldRejected:
+		m.ResetRejected()
+		return nil
+	case eventcounter.FieldLastReceived:
+		m.ResetLastReceived()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown EventCounter field %s", name)
 }
 
-// OldWorkflow returns the old "workflow" field's value of the Workflow entity.
-// If the Workflow object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowMutation) OldWorkflow(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldWorkflow is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldWorkflow requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWorkflow: %w", err)
-	}
-	return oldValue.Workflow, nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventCounterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetWorkflow resets all changes to the "workflow" field.
-func (m *WorkflowMutation) ResetWorkflow() {
-	m.workflow = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventCounterMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetLogToEvents sets the "logToEvents" field.
-func (m *WorkflowMutation) SetLogToEvents(s string) {
-	m.logToEvents = &s
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventCounterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// LogToEvents returns the value of the "logToEvents" field in the mutation.
-func (m *WorkflowMutation) LogToEvents() (r string, exists bool) {
-	v := m.logToEvents
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventCounterMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldLogToEvents returns the old "logToEvents" field's value of the Workflow entity.
-// If the Workflow object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowMutation) OldLogToEvents(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldLogToEvents is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldLogToEvents requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLogToEvents: %w", err)
-	}
-	return oldValue.LogToEvents, nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventCounterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearLogToEvents clears the value of the "logToEvents" field.
-func (m *WorkflowMutation) ClearLogToEvents() {
-	m.logToEvents = nil
-	m.clearedFields[workflow.FieldLogToEvents] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventCounterMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// LogToEventsCleared returns if the "logToEvents" field was cleared in this mutation.
-func (m *WorkflowMutation) LogToEventsCleared() bool {
-	_, ok := m.clearedFields[workflow.FieldLogToEvents]
-	return ok
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventCounterMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EventCounter unique edge %s", name)
 }
 
-// ResetLogToEvents resets all changes to the "logToEvents" field.
-func (m *WorkflowMutation) ResetLogToEvents() {
-	m.logToEvents = nil
-	delete(m.clearedFields, workflow.FieldLogToEvents)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventCounterMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EventCounter edge %s", name)
 }
 
-// SetNamespaceID sets the "namespace" edge to the Namespace entity by id.
-func (m *WorkflowMutation) SetNamespaceID(id string) {
-	m.namespace = &id
+// InstanceHistoryMutation represents an operation that mutates the InstanceHistory nodes in the graph.
+type InstanceHistoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	instanceID    *string
+	step          *int
+	addstep       *int
+	revision      *int
+	addrevision   *int
+	time          *time.Time
+	data          *[]byte
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*InstanceHistory, error)
+	predicates    []predicate.InstanceHistory
+}
+
+var _ ent.Mutation = (*InstanceHistoryMutation)(nil)
+
+// instancehistoryOption allows management of the mutation configuration using functional options.
+type instancehistoryOption func(*InstanceHistoryMutation)
+
+// newInstanceHistoryMutation creates new mutation for the InstanceHistory entity.
+func newInstanceHistoryMutation(c config, op Op, opts ...instancehistoryOption) *InstanceHistoryMutation {
+	m := &InstanceHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInstanceHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ClearNamespace clears the "namespace" edge to the Namespace entity.
-func (m *WorkflowMutation) ClearNamespace() {
-	m.clearednamespace = true
+// withInstanceHistoryID sets the ID field of the mutation.
+func withInstanceHistoryID(id int) instancehistoryOption {
+	return func(m *InstanceHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *InstanceHistory
+		)
+		m.oldValue = func(ctx context.Context) (*InstanceHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().InstanceHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// NamespaceCleared reports if the "namespace" edge to the Namespace entity was cleared.
-func (m *WorkflowMutation) NamespaceCleared() bool {
-	return m.clearednamespace
+// withInstanceHistory sets the old InstanceHistory of the mutation.
+func withInstanceHistory(node *InstanceHistory) instancehistoryOption {
+	return func(m *InstanceHistoryMutation) {
+		m.oldValue = func(context.Context) (*InstanceHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// NamespaceID returns the "namespace" edge ID in the mutation.
-func (m *WorkflowMutation) NamespaceID() (id string, exists bool) {
-	if m.namespace != nil {
-		return *m.namespace, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InstanceHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InstanceHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
-	return
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// NamespaceIDs returns the "namespace" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// NamespaceID instead. It exists only for internal usage by the builders.
-func (m *WorkflowMutation) NamespaceIDs() (ids []string) {
-	if id := m.namespace; id != nil {
-		ids = append(ids, *id)
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *InstanceHistoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return
+	return *m.id, true
 }
 
-// ResetNamespace resets all changes to the "namespace" edge.
-func (m *WorkflowMutation) ResetNamespace() {
-	m.namespace = nil
-	m.clearednamespace = false
+// SetInstanceID sets the "instanceID" field.
+func (m *InstanceHistoryMutation) SetInstanceID(s string) {
+	m.instanceID = &s
 }
 
-// AddInstanceIDs adds the "instances" edge to the WorkflowInstance entity by ids.
-func (m *WorkflowMutation) AddInstanceIDs(ids ...int) {
-	if m.instances == nil {
-		m.instances = make(map[int]struct{})
+// InstanceID returns the value of the "instanceID" field in the mutation.
+func (m *InstanceHistoryMutation) InstanceID() (r string, exists bool) {
+	v := m.instanceID
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.instances[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldInstanceID returns the old "instanceID" field's value of the InstanceHistory entity.
+// If the InstanceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InstanceHistoryMutation) OldInstanceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldInstanceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstanceID: %w", err)
 	}
+	return oldValue.InstanceID, nil
 }
 
-// ClearInstances clears the "instances" edge to the WorkflowInstance entity.
-func (m *WorkflowMutation) ClearInstances() {
-	m.clearedinstances = true
+// ResetInstanceID resets all changes to the "instanceID" field.
+func (m *InstanceHistoryMutation) ResetInstanceID() {
+	m.instanceID = nil
 }
 
-// InstancesCleared reports if the "instances" edge to the WorkflowInstance entity was cleared.
-func (m *WorkflowMutation) InstancesCleared() bool {
-	return m.clearedinstances
+// SetStep sets the "step" field.
+func (m *InstanceHistoryMutation) SetStep(i int) {
+	m.step = &i
+	m.addstep = nil
 }
 
-// RemoveInstanceIDs removes the "instances" edge to the WorkflowInstance entity by IDs.
-func (m *WorkflowMutation) RemoveInstanceIDs(ids ...int) {
-	if m.removedinstances == nil {
-		m.removedinstances = make(map[int]struct{})
+// Step returns the value of the "step" field in the mutation.
+func (m *InstanceHistoryMutation) Step() (r int, exists bool) {
+	v := m.step
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.removedinstances[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldStep returns the old "step" field's value of the InstanceHistory entity.
+// If the InstanceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InstanceHistoryMutation) OldStep(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStep is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStep requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStep: %w", err)
+	}
+	return oldValue.Step, nil
 }
 
-// RemovedInstances returns the removed IDs of the "instances" edge to the WorkflowInstance entity.
-func (m *WorkflowMutation) RemovedInstancesIDs() (ids []int) {
-	for id := range m.removedinstances {
-		ids = append(ids, id)
+// AddStep adds i to the "step" field.
+func (m *InstanceHistoryMutation) AddStep(i int) {
+	if m.addstep != nil {
+		*m.addstep += i
+	} else {
+		m.addstep = &i
 	}
-	return
 }
 
-// InstancesIDs returns the "instances" edge IDs in the mutation.
-func (m *WorkflowMutation) InstancesIDs() (ids []int) {
-	for id := range m.instances {
-		ids = append(ids, id)
+// AddedStep returns the value that was added to the "step" field in this mutation.
+func (m *InstanceHistoryMutation) AddedStep() (r int, exists bool) {
+	v := m.addstep
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetInstances resets all changes to the "instances" edge.
-func (m *WorkflowMutation) ResetInstances() {
-	m.instances = nil
-	m.clearedinstances = false
-	m.removedinstances = nil
+// ResetStep resets all changes to the "step" field.
+func (m *InstanceHistoryMutation) ResetStep() {
+	m.step = nil
+	m.addstep = nil
 }
 
-// AddWfeventIDs adds the "wfevents" edge to the WorkflowEvents entity by ids.
-func (m *WorkflowMutation) AddWfeventIDs(ids ...int) {
-	if m.wfevents == nil {
-		m.wfevents = make(map[int]struct{})
+// SetRevision sets the "revision" field.
+func (m *InstanceHistoryMutation) SetRevision(i int) {
+	m.revision = &i
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *InstanceHistoryMutation) Revision() (r int, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.wfevents[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the InstanceHistory entity.
+// If the InstanceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InstanceHistoryMutation) OldRevision(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
 	}
+	return oldValue.Revision, nil
 }
 
-// ClearWfevents clears the "wfevents" edge to the WorkflowEvents entity.
-func (m *WorkflowMutation) ClearWfevents() {
-	m.clearedwfevents = true
+// AddRevision adds i to the "revision" field.
+func (m *InstanceHistoryMutation) AddRevision(i int) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
 }
 
-// WfeventsCleared reports if the "wfevents" edge to the WorkflowEvents entity was cleared.
-func (m *WorkflowMutation) WfeventsCleared() bool {
-	return m.clearedwfevents
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *InstanceHistoryMutation) AddedRevision() (r int, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveWfeventIDs removes the "wfevents" edge to the WorkflowEvents entity by IDs.
-func (m *WorkflowMutation) RemoveWfeventIDs(ids ...int) {
-	if m.removedwfevents == nil {
-		m.removedwfevents = make(map[int]struct{})
+// ResetRevision resets all changes to the "revision" field.
+func (m *InstanceHistoryMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// SetTime sets the "time" field.
+func (m *InstanceHistoryMutation) SetTime(t time.Time) {
+	m.time = &t
+}
+
+// Time returns the value of the "time" field in the mutation.
+func (m *InstanceHistoryMutation) Time() (r time.Time, exists bool) {
+	v := m.time
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.removedwfevents[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldTime returns the old "time" field's value of the InstanceHistory entity.
+// If the InstanceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InstanceHistoryMutation) OldTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
 	}
+	return oldValue.Time, nil
 }
 
-// RemovedWfevents returns the removed IDs of the "wfevents" edge to the WorkflowEvents entity.
-func (m *WorkflowMutation) RemovedWfeventsIDs() (ids []int) {
-	for id := range m.removedwfevents {
-		ids = append(ids, id)
+// ResetTime resets all changes to the "time" field.
+func (m *InstanceHistoryMutation) ResetTime() {
+	m.time = nil
+}
+
+// SetData sets the "data" field.
+func (m *InstanceHistoryMutation) SetData(b []byte) {
+	m.data = &b
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *InstanceHistoryMutation) Data() (r []byte, exists bool) {
+	v := m.data
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// WfeventsIDs returns the "wfevents" edge IDs in the mutation.
-func (m *WorkflowMutation) WfeventsIDs() (ids []int) {
-	for id := range m.wfevents {
-		ids = append(ids, id)
+// OldData returns the old "data" field's value of the InstanceHistory entity.
+// If the InstanceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InstanceHistoryMutation) OldData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldData is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
 }
 
-// ResetWfevents resets all changes to the "wfevents" edge.
-func (m *WorkflowMutation) ResetWfevents() {
-	m.wfevents = nil
-	m.clearedwfevents = false
-	m.removedwfevents = nil
+// ResetData resets all changes to the "data" field.
+func (m *InstanceHistoryMutation) ResetData() {
+	m.data = nil
 }
 
 // Op returns the operation name.
-func (m *WorkflowMutation) Op() Op {
+func (m *InstanceHistoryMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Workflow).
-func (m *WorkflowMutation) Type() string {
+// Type returns the node type of this mutation (InstanceHistory).
+func (m *InstanceHistoryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *WorkflowMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.name != nil {
-		fields = append(fields, workflow.FieldName)
+func (m *InstanceHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.instanceID != nil {
+		fields = append(fields, instancehistory.FieldInstanceID)
 	}
-	if m.created != nil {
-		fields = append(fields, workflow.FieldCreated)
+	if m.step != nil {
+		fields = append(fields, instancehistory.FieldStep)
 	}
-	if m.description != nil {
-		fields = append(fields, workflow.FieldDescription)
+	if m.revision != nil {
+		fields = append(fields, instancehistory.FieldRevision)
 	}
-	if m.active != nil {
-		fields = append(fields, workflow.FieldActive)
-	}
-	if m.revision != nil {
-		fields = append(fields, workflow.FieldRevision)
-	}
-	if m.workflow != nil {
-		fields = append(fields, workflow.FieldWorkflow)
+	if m.time != nil {
+		fields = append(fields, instancehistory.FieldTime)
 	}
-	if m.logToEvents != nil {
-		fields = append(fields, workflow.FieldLogToEvents)
+	if m.data != nil {
+		fields = append(fields, instancehistory.FieldData)
 	}
 	return fields
 }
@@ -1018,22 +1164,18 @@ func (m *WorkflowMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *WorkflowMutation) Field(name string) (ent.Value, bool) {
+func (m *InstanceHistoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case workflow.FieldName:
-		return m.Name()
-	case workflow.FieldCreated:
-		return m.Created()
-	case workflow.FieldDescription:
-		return m.Description()
-	case workflow.FieldActive:
-		return m.Active()
-	case workflow.FieldRevision:
+	case instancehistory.FieldInstanceID:
+		return m.InstanceID()
+	case instancehistory.FieldStep:
+		return m.Step()
+	case instancehistory.FieldRevision:
 		return m.Revision()
-	case workflow.FieldWorkflow:
-		return m.Workflow()
-	case workflow.FieldLogToEvents:
-		return m.LogToEvents()
+	case instancehistory.FieldTime:
+		return m.Time()
+	case instancehistory.FieldData:
+		return m.Data()
 	}
 	return nil, false
 }
@@ -1041,90 +1183,75 @@ func (m *WorkflowMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *WorkflowMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *InstanceHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case workflow.FieldName:
-		return m.OldName(ctx)
-	case workflow.FieldCreated:
-		return m.OldCreated(ctx)
-	case workflow.FieldDescription:
-		return m.OldDescription(ctx)
-	case workflow.FieldActive:
-		return m.OldActive(ctx)
-	case workflow.FieldRevision:
+	case instancehistory.FieldInstanceID:
+		return m.OldInstanceID(ctx)
+	case instancehistory.FieldStep:
+		return m.OldStep(ctx)
+	case instancehistory.FieldRevision:
 		return m.OldRevision(ctx)
-	case workflow.FieldWorkflow:
-		return m.OldWorkflow(ctx)
-	case workflow.FieldLogToEvents:
-		return m.OldLogToEvents(ctx)
+	case instancehistory.FieldTime:
+		return m.OldTime(ctx)
+	case instancehistory.FieldData:
+		return m.OldData(ctx)
 	}
-	return nil, fmt.Errorf("unknown Workflow field %s", name)
+	return nil, fmt.Errorf("unknown InstanceHistory field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WorkflowMutation) SetField(name string, value ent.Value) error {
+func (m *InstanceHistoryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case workflow.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case workflow.FieldCreated:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreated(v)
-		return nil
-	case workflow.FieldDescription:
+	case instancehistory.FieldInstanceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetInstanceID(v)
 		return nil
-	case workflow.FieldActive:
-		v, ok := value.(bool)
+	case instancehistory.FieldStep:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActive(v)
+		m.SetStep(v)
 		return nil
-	case workflow.FieldRevision:
+	case instancehistory.FieldRevision:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetRevision(v)
 		return nil
-	case workflow.FieldWorkflow:
-		v, ok := value.([]byte)
+	case instancehistory.FieldTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWorkflow(v)
+		m.SetTime(v)
 		return nil
-	case workflow.FieldLogToEvents:
-		v, ok := value.(string)
+	case instancehistory.FieldData:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLogToEvents(v)
+		m.SetData(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Workflow field %s", name)
+	return fmt.Errorf("unknown InstanceHistory field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *WorkflowMutation) AddedFields() []string {
+func (m *InstanceHistoryMutation) AddedFields() []string {
 	var fields []string
+	if m.addstep != nil {
+		fields = append(fields, instancehistory.FieldStep)
+	}
 	if m.addrevision != nil {
-		fields = append(fields, workflow.FieldRevision)
+		fields = append(fields, instancehistory.FieldRevision)
 	}
 	return fields
 }
@@ -1132,9 +1259,11 @@ func (m *WorkflowMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *WorkflowMutation) AddedField(name string) (ent.Value, bool) {
+func (m *InstanceHistoryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case workflow.FieldRevision:
+	case instancehistory.FieldStep:
+		return m.AddedStep()
+	case instancehistory.FieldRevision:
 		return m.AddedRevision()
 	}
 	return nil, false
@@ -1143,9 +1272,16 @@ func (m *WorkflowMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WorkflowMutation) AddField(name string, value ent.Value) error {
+func (m *InstanceHistoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case workflow.FieldRevision:
+	case instancehistory.FieldStep:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStep(v)
+		return nil
+	case instancehistory.FieldRevision:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1153,235 +1289,126 @@ func (m *WorkflowMutation) AddField(name string, value ent.Value) error {
 		m.AddRevision(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Workflow numeric field %s", name)
+	return fmt.Errorf("unknown InstanceHistory numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *WorkflowMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(workflow.FieldDescription) {
-		fields = append(fields, workflow.FieldDescription)
-	}
-	if m.FieldCleared(workflow.FieldLogToEvents) {
-		fields = append(fields, workflow.FieldLogToEvents)
-	}
-	return fields
+func (m *InstanceHistoryMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *WorkflowMutation) FieldCleared(name string) bool {
+func (m *InstanceHistoryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *WorkflowMutation) ClearField(name string) error {
-	switch name {
-	case workflow.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case workflow.FieldLogToEvents:
-		m.ClearLogToEvents()
-		return nil
-	}
-	return fmt.Errorf("unknown Workflow nullable field %s", name)
+func (m *InstanceHistoryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown InstanceHistory nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *WorkflowMutation) ResetField(name string) error {
+func (m *InstanceHistoryMutation) ResetField(name string) error {
 	switch name {
-	case workflow.FieldName:
-		m.ResetName()
-		return nil
-	case workflow.FieldCreated:
-		m.ResetCreated()
-		return nil
-	case workflow.FieldDescription:
-		m.ResetDescription()
+	case instancehistory.FieldInstanceID:
+		m.ResetInstanceID()
 		return nil
-	case workflow.FieldActive:
-		m.ResetActive()
+	case instancehistory.FieldStep:
+		m.ResetStep()
 		return nil
-	case workflow.FieldRevision:
+	case instancehistory.FieldRevision:
 		m.ResetRevision()
 		return nil
-	case workflow.FieldWorkflow:
-		m.ResetWorkflow()
+	case instancehistory.FieldTime:
+		m.ResetTime()
 		return nil
-	case workflow.FieldLogToEvents:
-		m.ResetLogToEvents()
+	case instancehistory.FieldData:
+		m.ResetData()
 		return nil
 	}
-	return fmt.Errorf("unknown Workflow field %s", name)
+	return fmt.Errorf("unknown InstanceHistory field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *WorkflowMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.namespace != nil {
-		edges = append(edges, workflow.EdgeNamespace)
-	}
-	if m.instances != nil {
-		edges = append(edges, workflow.EdgeInstances)
-	}
-	if m.wfevents != nil {
-		edges = append(edges, workflow.EdgeWfevents)
-	}
+func (m *InstanceHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *WorkflowMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case workflow.EdgeNamespace:
-		if id := m.namespace; id != nil {
-			return []ent.Value{*id}
-		}
-	case workflow.EdgeInstances:
-		ids := make([]ent.Value, 0, len(m.instances))
-		for id := range m.instances {
-			ids = append(ids, id)
-		}
-		return ids
-	case workflow.EdgeWfevents:
-		ids := make([]ent.Value, 0, len(m.wfevents))
-		for id := range m.wfevents {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *InstanceHistoryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *WorkflowMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedinstances != nil {
-		edges = append(edges, workflow.EdgeInstances)
-	}
-	if m.removedwfevents != nil {
-		edges = append(edges, workflow.EdgeWfevents)
-	}
+func (m *InstanceHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *WorkflowMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case workflow.EdgeInstances:
-		ids := make([]ent.Value, 0, len(m.removedinstances))
-		for id := range m.removedinstances {
-			ids = append(ids, id)
-		}
-		return ids
-	case workflow.EdgeWfevents:
-		ids := make([]ent.Value, 0, len(m.removedwfevents))
-		for id := range m.removedwfevents {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *InstanceHistoryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *WorkflowMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearednamespace {
-		edges = append(edges, workflow.EdgeNamespace)
-	}
-	if m.clearedinstances {
-		edges = append(edges, workflow.EdgeInstances)
-	}
-	if m.clearedwfevents {
-		edges = append(edges, workflow.EdgeWfevents)
-	}
+func (m *InstanceHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *WorkflowMutation) EdgeCleared(name string) bool {
-	switch name {
-	case workflow.EdgeNamespace:
-		return m.clearednamespace
-	case workflow.EdgeInstances:
-		return m.clearedinstances
-	case workflow.EdgeWfevents:
-		return m.clearedwfevents
-	}
+func (m *InstanceHistoryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *WorkflowMutation) ClearEdge(name string) error {
-	switch name {
-	case workflow.EdgeNamespace:
-		m.ClearNamespace()
-		return nil
-	}
-	return fmt.Errorf("unknown Workflow unique edge %s", name)
+func (m *InstanceHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown InstanceHistory unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *WorkflowMutation) ResetEdge(name string) error {
-	switch name {
-	case workflow.EdgeNamespace:
-		m.ResetNamespace()
-		return nil
-	case workflow.EdgeInstances:
-		m.ResetInstances()
-		return nil
-	case workflow.EdgeWfevents:
-		m.ResetWfevents()
-		return nil
-	}
-	return fmt.Errorf("unknown Workflow edge %s", name)
+func (m *InstanceHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown InstanceHistory edge %s", name)
 }
 
-// WorkflowEventsMutation represents an operation that mutates the WorkflowEvents nodes in the graph.
-type WorkflowEventsMutation struct {
+// JQModuleMutation represents an operation that mutates the JQModule nodes in the graph.
+type JQModuleMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *int
-	events                  *[]map[string]interface{}
-	correlations            *[]string
-	signature               *[]byte
-	count                   *int
-	addcount                *int
-	clearedFields           map[string]struct{}
-	workflow                *uuid.UUID
-	clearedworkflow         bool
-	wfeventswait            map[int]struct{}
-	removedwfeventswait     map[int]struct{}
-	clearedwfeventswait     bool
-	workflowinstance        *int
-	clearedworkflowinstance bool
-	done                    bool
-	oldValue                func(context.Context) (*WorkflowEvents, error)
-	predicates              []predicate.WorkflowEvents
-}
-
-var _ ent.Mutation = (*WorkflowEventsMutation)(nil)
-
-// workfloweventsOption allows management of the mutation configuration using functional options.
-type workfloweventsOption func(*WorkflowEventsMutation)
-
-// newWorkflowEventsMutation creates new mutation for the WorkflowEvents entity.
-func newWorkflowEventsMutation(c config, op Op, opts ...workfloweventsOption) *WorkflowEventsMutation {
-	m := &WorkflowEventsMutation{
+	op            Op
+	typ           string
+	id            *int
+	namespace     *string
+	name          *string
+	source        *string
+	updated       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*JQModule, error)
+	predicates    []predicate.JQModule
+}
+
+var _ ent.Mutation = (*JQModuleMutation)(nil)
+
+// jqmoduleOption allows management of the mutation configuration using functional options.
+type jqmoduleOption func(*JQModuleMutation)
+
+// newJQModuleMutation creates new mutation for the JQModule entity.
+func newJQModuleMutation(c config, op Op, opts ...jqmoduleOption) *JQModuleMutation {
+	m := &JQModuleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeWorkflowEvents,
+		typ:           TypeJQModule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1390,20 +1417,20 @@ func newWorkflowEventsMutation(c config, op Op, opts ...workfloweventsOption) *W
 	return m
 }
 
-// withWorkflowEventsID sets the ID field of the mutation.
-func withWorkflowEventsID(id int) workfloweventsOption {
-	return func(m *WorkflowEventsMutation) {
+// withJQModuleID sets the ID field of the mutation.
+func withJQModuleID(id int) jqmoduleOption {
+	return func(m *JQModuleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *WorkflowEvents
+			value *JQModule
 		)
-		m.oldValue = func(ctx context.Context) (*WorkflowEvents, error) {
+		m.oldValue = func(ctx context.Context) (*JQModule, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().WorkflowEvents.Get(ctx, id)
+					value, err = m.Client().JQModule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1412,10 +1439,10 @@ func withWorkflowEventsID(id int) workfloweventsOption {
 	}
 }
 
-// withWorkflowEvents sets the old WorkflowEvents of the mutation.
-func withWorkflowEvents(node *WorkflowEvents) workfloweventsOption {
-	return func(m *WorkflowEventsMutation) {
-		m.oldValue = func(context.Context) (*WorkflowEvents, error) {
+// withJQModule sets the old JQModule of the mutation.
+func withJQModule(node *JQModule) jqmoduleOption {
+	return func(m *JQModuleMutation) {
+		m.oldValue = func(context.Context) (*JQModule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1424,7 +1451,7 @@ func withWorkflowEvents(node *WorkflowEvents) workfloweventsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WorkflowEventsMutation) Client() *Client {
+func (m JQModuleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1432,7 +1459,7 @@ func (m WorkflowEventsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m WorkflowEventsMutation) Tx() (*Tx, error) {
+func (m JQModuleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -1443,813 +1470,733 @@ func (m WorkflowEventsMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID
 // is only available if it was provided to the builder.
-func (m *WorkflowEventsMutation) ID() (id int, exists bool) {
+func (m *JQModuleMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
 	return *m.id, true
 }
 
-// SetEvents sets the "events" field.
-func (m *WorkflowEventsMutation) SetEvents(value []map[string]interface{}) {
-	m.events = &value
+// SetNamespace sets the "namespace" field.
+func (m *JQModuleMutation) SetNamespace(s string) {
+	m.namespace = &s
 }
 
-// Events returns the value of the "events" field in the mutation.
-func (m *WorkflowEventsMutation) Events() (r []map[string]interface{}, exists bool) {
-	v := m.events
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *JQModuleMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEvents returns the old "events" field's value of the WorkflowEvents entity.
-// If the WorkflowEvents object wasn't provided to the builder, the object is fetched from the database.
+// OldNamespace returns the old "namespace" field's value of the JQModule entity.
+// If the JQModule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowEventsMutation) OldEvents(ctx context.Context) (v []map[string]interface{}, err error) {
+func (m *JQModuleMutation) OldNamespace(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldEvents is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldNamespace is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldEvents requires an ID field in the mutation")
+		return v, fmt.Errorf("OldNamespace requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
 	}
-	return oldValue.Events, nil
+	return oldValue.Namespace, nil
 }
 
-// ResetEvents resets all changes to the "events" field.
-func (m *WorkflowEventsMutation) ResetEvents() {
-	m.events = nil
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *JQModuleMutation) ResetNamespace() {
+	m.namespace = nil
 }
 
-// SetCorrelations sets the "correlations" field.
-func (m *WorkflowEventsMutation) SetCorrelations(s []string) {
-	m.correlations = &s
+// SetName sets the "name" field.
+func (m *JQModuleMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Correlations returns the value of the "correlations" field in the mutation.
-func (m *WorkflowEventsMutation) Correlations() (r []string, exists bool) {
-	v := m.correlations
+// Name returns the value of the "name" field in the mutation.
+func (m *JQModuleMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCorrelations returns the old "correlations" field's value of the WorkflowEvents entity.
-// If the WorkflowEvents object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the JQModule entity.
+// If the JQModule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowEventsMutation) OldCorrelations(ctx context.Context) (v []string, err error) {
+func (m *JQModuleMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldCorrelations is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldCorrelations requires an ID field in the mutation")
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCorrelations: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Correlations, nil
+	return oldValue.Name, nil
 }
 
-// ResetCorrelations resets all changes to the "correlations" field.
-func (m *WorkflowEventsMutation) ResetCorrelations() {
-	m.correlations = nil
+// ResetName resets all changes to the "name" field.
+func (m *JQModuleMutation) ResetName() {
+	m.name = nil
 }
 
-// SetSignature sets the "signature" field.
-func (m *WorkflowEventsMutation) SetSignature(b []byte) {
-	m.signature = &b
+// SetSource sets the "source" field.
+func (m *JQModuleMutation) SetSource(s string) {
+	m.source = &s
 }
 
-// Signature returns the value of the "signature" field in the mutation.
-func (m *WorkflowEventsMutation) Signature() (r []byte, exists bool) {
-	v := m.signature
+// Source returns the value of the "source" field in the mutation.
+func (m *JQModuleMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSignature returns the old "signature" field's value of the WorkflowEvents entity.
-// If the WorkflowEvents object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the JQModule entity.
+// If the JQModule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowEventsMutation) OldSignature(ctx context.Context) (v []byte, err error) {
+func (m *JQModuleMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldSignature is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldSignature requires an ID field in the mutation")
+		return v, fmt.Errorf("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.Signature, nil
-}
-
-// ClearSignature clears the value of the "signature" field.
-func (m *WorkflowEventsMutation) ClearSignature() {
-	m.signature = nil
-	m.clearedFields[workflowevents.FieldSignature] = struct{}{}
-}
-
-// SignatureCleared returns if the "signature" field was cleared in this mutation.
-func (m *WorkflowEventsMutation) SignatureCleared() bool {
-	_, ok := m.clearedFields[workflowevents.FieldSignature]
-	return ok
+	return oldValue.Source, nil
 }
 
-// ResetSignature resets all changes to the "signature" field.
-func (m *WorkflowEventsMutation) ResetSignature() {
-	m.signature = nil
-	delete(m.clearedFields, workflowevents.FieldSignature)
+// ResetSource resets all changes to the "source" field.
+func (m *JQModuleMutation) ResetSource() {
+	m.source = nil
 }
 
-// SetCount sets the "count" field.
-func (m *WorkflowEventsMutation) SetCount(i int) {
-	m.count = &i
-	m.addcount = nil
+// SetUpdated sets the "updated" field.
+func (m *JQModuleMutation) SetUpdated(t time.Time) {
+	m.updated = &t
 }
 
-// Count returns the value of the "count" field in the mutation.
-func (m *WorkflowEventsMutation) Count() (r int, exists bool) {
-	v := m.count
+// Updated returns the value of the "updated" field in the mutation.
+func (m *JQModuleMutation) Updated() (r time.Time, exists bool) {
+	v := m.updated
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCount returns the old "count" field's value of the WorkflowEvents entity.
-// If the WorkflowEvents object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdated returns the old "updated" field's value of the JQModule entity.
+// If the JQModule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowEventsMutation) OldCount(ctx context.Context) (v int, err error) {
+func (m *JQModuleMutation) OldUpdated(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldCount is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldUpdated is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldCount requires an ID field in the mutation")
+		return v, fmt.Errorf("OldUpdated requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdated: %w", err)
 	}
-	return oldValue.Count, nil
+	return oldValue.Updated, nil
 }
 
-// AddCount adds i to the "count" field.
-func (m *WorkflowEventsMutation) AddCount(i int) {
-	if m.addcount != nil {
-		*m.addcount += i
-	} else {
-		m.addcount = &i
-	}
+// ResetUpdated resets all changes to the "updated" field.
+func (m *JQModuleMutation) ResetUpdated() {
+	m.updated = nil
 }
 
-// AddedCount returns the value that was added to the "count" field in this mutation.
-func (m *WorkflowEventsMutation) AddedCount() (r int, exists bool) {
-	v := m.addcount
-	if v == nil {
-		return
-	}
-	return *v, true
+// Op returns the operation name.
+func (m *JQModuleMutation) Op() Op {
+	return m.op
 }
 
-// ResetCount resets all changes to the "count" field.
-func (m *WorkflowEventsMutation) ResetCount() {
-	m.count = nil
-	m.addcount = nil
+// Type returns the node type of this mutation (JQModule).
+func (m *JQModuleMutation) Type() string {
+	return m.typ
 }
 
-// SetWorkflowID sets the "workflow" edge to the Workflow entity by id.
-func (m *WorkflowEventsMutation) SetWorkflowID(id uuid.UUID) {
-	m.workflow = &id
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JQModuleMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.namespace != nil {
+		fields = append(fields, jqmodule.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, jqmodule.FieldName)
+	}
+	if m.source != nil {
+		fields = append(fields, jqmodule.FieldSource)
+	}
+	if m.updated != nil {
+		fields = append(fields, jqmodule.FieldUpdated)
+	}
+	return fields
 }
 
-// ClearWorkflow clears the "workflow" edge to the Workflow entity.
-func (m *WorkflowEventsMutation) ClearWorkflow() {
-	m.clearedworkflow = true
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JQModuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case jqmodule.FieldNamespace:
+		return m.Namespace()
+	case jqmodule.FieldName:
+		return m.Name()
+	case jqmodule.FieldSource:
+		return m.Source()
+	case jqmodule.FieldUpdated:
+		return m.Updated()
+	}
+	return nil, false
 }
 
-// WorkflowCleared reports if the "workflow" edge to the Workflow entity was cleared.
-func (m *WorkflowEventsMutation) WorkflowCleared() bool {
-	return m.clearedworkflow
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JQModuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case jqmodule.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case jqmodule.FieldName:
+		return m.OldName(ctx)
+	case jqmodule.FieldSource:
+		return m.OldSource(ctx)
+	case jqmodule.FieldUpdated:
+		return m.OldUpdated(ctx)
+	}
+	return nil, fmt.Errorf("unknown JQModule field %s", name)
 }
 
-// WorkflowID returns the "workflow" edge ID in the mutation.
-func (m *WorkflowEventsMutation) WorkflowID() (id uuid.UUID, exists bool) {
-	if m.workflow != nil {
-		return *m.workflow, true
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JQModuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case jqmodule.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case jqmodule.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case jqmodule.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case jqmodule.FieldUpdated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdated(v)
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown JQModule field %s", name)
 }
 
-// WorkflowIDs returns the "workflow" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// WorkflowID instead. It exists only for internal usage by the builders.
-func (m *WorkflowEventsMutation) WorkflowIDs() (ids []uuid.UUID) {
-	if id := m.workflow; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JQModuleMutation) AddedFields() []string {
+	return nil
 }
 
-// ResetWorkflow resets all changes to the "workflow" edge.
-func (m *WorkflowEventsMutation) ResetWorkflow() {
-	m.workflow = nil
-	m.clearedworkflow = false
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JQModuleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// AddWfeventswaitIDs adds the "wfeventswait" edge to the WorkflowEventsWait entity by ids.
-func (m *WorkflowEventsMutation) AddWfeventswaitIDs(ids ...int) {
-	if m.wfeventswait == nil {
-		m.wfeventswait = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.wfeventswait[ids[i]] = struct{}{}
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JQModuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
+	return fmt.Errorf("unknown JQModule numeric field %s", name)
 }
 
-// ClearWfeventswait clears the "wfeventswait" edge to the WorkflowEventsWait entity.
-func (m *WorkflowEventsMutation) ClearWfeventswait() {
-	m.clearedwfeventswait = true
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JQModuleMutation) ClearedFields() []string {
+	return nil
 }
 
-// WfeventswaitCleared reports if the "wfeventswait" edge to the WorkflowEventsWait entity was cleared.
-func (m *WorkflowEventsMutation) WfeventswaitCleared() bool {
-	return m.clearedwfeventswait
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JQModuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// RemoveWfeventswaitIDs removes the "wfeventswait" edge to the WorkflowEventsWait entity by IDs.
-func (m *WorkflowEventsMutation) RemoveWfeventswaitIDs(ids ...int) {
-	if m.removedwfeventswait == nil {
-		m.removedwfeventswait = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.removedwfeventswait[ids[i]] = struct{}{}
-	}
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JQModuleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown JQModule nullable field %s", name)
 }
 
-// RemovedWfeventswait returns the removed IDs of the "wfeventswait" edge to the WorkflowEventsWait entity.
-func (m *WorkflowEventsMutation) RemovedWfeventswaitIDs() (ids []int) {
-	for id := range m.removedwfeventswait {
-		ids = append(ids, id)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JQModuleMutation) ResetField(name string) error {
+	switch name {
+	case jqmodule.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case jqmodule.FieldName:
+		m.ResetName()
+		return nil
+	case jqmodule.FieldSource:
+		m.ResetSource()
+		return nil
+	case jqmodule.FieldUpdated:
+		m.ResetUpdated()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown JQModule field %s", name)
 }
 
-// WfeventswaitIDs returns the "wfeventswait" edge IDs in the mutation.
-func (m *WorkflowEventsMutation) WfeventswaitIDs() (ids []int) {
-	for id := range m.wfeventswait {
-		ids = append(ids, id)
-	}
-	return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JQModuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetWfeventswait resets all changes to the "wfeventswait" edge.
-func (m *WorkflowEventsMutation) ResetWfeventswait() {
-	m.wfeventswait = nil
-	m.clearedwfeventswait = false
-	m.removedwfeventswait = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JQModuleMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetWorkflowinstanceID sets the "workflowinstance" edge to the WorkflowInstance entity by id.
-func (m *WorkflowEventsMutation) SetWorkflowinstanceID(id int) {
-	m.workflowinstance = &id
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JQModuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearWorkflowinstance clears the "workflowinstance" edge to the WorkflowInstance entity.
-func (m *WorkflowEventsMutation) ClearWorkflowinstance() {
-	m.clearedworkflowinstance = true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JQModuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// WorkflowinstanceCleared reports if the "workflowinstance" edge to the WorkflowInstance entity was cleared.
-func (m *WorkflowEventsMutation) WorkflowinstanceCleared() bool {
-	return m.clearedworkflowinstance
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JQModuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// WorkflowinstanceID returns the "workflowinstance" edge ID in the mutation.
-func (m *WorkflowEventsMutation) WorkflowinstanceID() (id int, exists bool) {
-	if m.workflowinstance != nil {
-		return *m.workflowinstance, true
-	}
-	return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JQModuleMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// WorkflowinstanceIDs returns the "workflowinstance" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// WorkflowinstanceID instead. It exists only for internal usage by the builders.
-func (m *WorkflowEventsMutation) WorkflowinstanceIDs() (ids []int) {
-	if id := m.workflowinstance; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JQModuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown JQModule unique edge %s", name)
 }
 
-// ResetWorkflowinstance resets all changes to the "workflowinstance" edge.
-func (m *WorkflowEventsMutation) ResetWorkflowinstance() {
-	m.workflowinstance = nil
-	m.clearedworkflowinstance = false
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JQModuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown JQModule edge %s", name)
 }
 
-// Op returns the operation name.
-func (m *WorkflowEventsMutation) Op() Op {
-	return m.op
+// JSONSchemaMutation represents an operation that mutates the JSONSchema nodes in the graph.
+type JSONSchemaMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	namespace     *string
+	name          *string
+	version       *int
+	addversion    *int
+	schema        *string
+	hash          *string
+	created       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*JSONSchema, error)
+	predicates    []predicate.JSONSchema
+}
+
+var _ ent.Mutation = (*JSONSchemaMutation)(nil)
+
+// jsonschemaOption allows management of the mutation configuration using functional options.
+type jsonschemaOption func(*JSONSchemaMutation)
+
+// newJSONSchemaMutation creates new mutation for the JSONSchema entity.
+func newJSONSchemaMutation(c config, op Op, opts ...jsonschemaOption) *JSONSchemaMutation {
+	m := &JSONSchemaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJSONSchema,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// Type returns the node type of this mutation (WorkflowEvents).
-func (m *WorkflowEventsMutation) Type() string {
-	return m.typ
+// withJSONSchemaID sets the ID field of the mutation.
+func withJSONSchemaID(id int) jsonschemaOption {
+	return func(m *JSONSchemaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *JSONSchema
+		)
+		m.oldValue = func(ctx context.Context) (*JSONSchema, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().JSONSchema.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *WorkflowEventsMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.events != nil {
-		fields = append(fields, workflowevents.FieldEvents)
-	}
-	if m.correlations != nil {
-		fields = append(fields, workflowevents.FieldCorrelations)
-	}
-	if m.signature != nil {
-		fields = append(fields, workflowevents.FieldSignature)
-	}
-	if m.count != nil {
-		fields = append(fields, workflowevents.FieldCount)
+// withJSONSchema sets the old JSONSchema of the mutation.
+func withJSONSchema(node *JSONSchema) jsonschemaOption {
+	return func(m *JSONSchemaMutation) {
+		m.oldValue = func(context.Context) (*JSONSchema, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *WorkflowEventsMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case workflowevents.FieldEvents:
-		return m.Events()
-	case workflowevents.FieldCorrelations:
-		return m.Correlations()
-	case workflowevents.FieldSignature:
-		return m.Signature()
-	case workflowevents.FieldCount:
-		return m.Count()
-	}
-	return nil, false
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JSONSchemaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *WorkflowEventsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case workflowevents.FieldEvents:
-		return m.OldEvents(ctx)
-	case workflowevents.FieldCorrelations:
-		return m.OldCorrelations(ctx)
-	case workflowevents.FieldSignature:
-		return m.OldSignature(ctx)
-	case workflowevents.FieldCount:
-		return m.OldCount(ctx)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JSONSchemaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
-	return nil, fmt.Errorf("unknown WorkflowEvents field %s", name)
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *WorkflowEventsMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case workflowevents.FieldEvents:
-		v, ok := value.([]map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEvents(v)
-		return nil
-	case workflowevents.FieldCorrelations:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCorrelations(v)
-		return nil
-	case workflowevents.FieldSignature:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSignature(v)
-		return nil
-	case workflowevents.FieldCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCount(v)
-		return nil
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *JSONSchemaMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return fmt.Errorf("unknown WorkflowEvents field %s", name)
+	return *m.id, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *WorkflowEventsMutation) AddedFields() []string {
-	var fields []string
-	if m.addcount != nil {
-		fields = append(fields, workflowevents.FieldCount)
-	}
-	return fields
+// SetNamespace sets the "namespace" field.
+func (m *JSONSchemaMutation) SetNamespace(s string) {
+	m.namespace = &s
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *WorkflowEventsMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case workflowevents.FieldCount:
-		return m.AddedCount()
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *JSONSchemaMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *WorkflowEventsMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case workflowevents.FieldCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCount(v)
-		return nil
+// OldNamespace returns the old "namespace" field's value of the JSONSchema entity.
+// If the JSONSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JSONSchemaMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldNamespace is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown WorkflowEvents numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *WorkflowEventsMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(workflowevents.FieldSignature) {
-		fields = append(fields, workflowevents.FieldSignature)
-	}
-	return fields
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *JSONSchemaMutation) ResetNamespace() {
+	m.namespace = nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *WorkflowEventsMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// SetName sets the "name" field.
+func (m *JSONSchemaMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *WorkflowEventsMutation) ClearField(name string) error {
-	switch name {
-	case workflowevents.FieldSignature:
-		m.ClearSignature()
-		return nil
+// Name returns the value of the "name" field in the mutation.
+func (m *JSONSchemaMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown WorkflowEvents nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *WorkflowEventsMutation) ResetField(name string) error {
-	switch name {
-	case workflowevents.FieldEvents:
-		m.ResetEvents()
-		return nil
-	case workflowevents.FieldCorrelations:
-		m.ResetCorrelations()
-		return nil
-	case workflowevents.FieldSignature:
-		m.ResetSignature()
-		return nil
-	case workflowevents.FieldCount:
-		m.ResetCount()
-		return nil
-	}
-	return fmt.Errorf("unknown WorkflowEvents field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *WorkflowEventsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.workflow != nil {
-		edges = append(edges, workflowevents.EdgeWorkflow)
+// OldName returns the old "name" field's value of the JSONSchema entity.
+// If the JSONSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JSONSchemaMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
 	}
-	if m.wfeventswait != nil {
-		edges = append(edges, workflowevents.EdgeWfeventswait)
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
 	}
-	if m.workflowinstance != nil {
-		edges = append(edges, workflowevents.EdgeWorkflowinstance)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return edges
+	return oldValue.Name, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *WorkflowEventsMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case workflowevents.EdgeWorkflow:
-		if id := m.workflow; id != nil {
-			return []ent.Value{*id}
-		}
-	case workflowevents.EdgeWfeventswait:
-		ids := make([]ent.Value, 0, len(m.wfeventswait))
-		for id := range m.wfeventswait {
-			ids = append(ids, id)
-		}
-		return ids
-	case workflowevents.EdgeWorkflowinstance:
-		if id := m.workflowinstance; id != nil {
-			return []ent.Value{*id}
-		}
-	}
-	return nil
+// ResetName resets all changes to the "name" field.
+func (m *JSONSchemaMutation) ResetName() {
+	m.name = nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *WorkflowEventsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedwfeventswait != nil {
-		edges = append(edges, workflowevents.EdgeWfeventswait)
-	}
-	return edges
+// SetVersion sets the "version" field.
+func (m *JSONSchemaMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *WorkflowEventsMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case workflowevents.EdgeWfeventswait:
-		ids := make([]ent.Value, 0, len(m.removedwfeventswait))
-		for id := range m.removedwfeventswait {
-			ids = append(ids, id)
-		}
-		return ids
+// Version returns the value of the "version" field in the mutation.
+func (m *JSONSchemaMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *WorkflowEventsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedworkflow {
-		edges = append(edges, workflowevents.EdgeWorkflow)
+// OldVersion returns the old "version" field's value of the JSONSchema entity.
+// If the JSONSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JSONSchemaMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldVersion is only allowed on UpdateOne operations")
 	}
-	if m.clearedwfeventswait {
-		edges = append(edges, workflowevents.EdgeWfeventswait)
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldVersion requires an ID field in the mutation")
 	}
-	if m.clearedworkflowinstance {
-		edges = append(edges, workflowevents.EdgeWorkflowinstance)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return edges
+	return oldValue.Version, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *WorkflowEventsMutation) EdgeCleared(name string) bool {
-	switch name {
-	case workflowevents.EdgeWorkflow:
-		return m.clearedworkflow
-	case workflowevents.EdgeWfeventswait:
-		return m.clearedwfeventswait
-	case workflowevents.EdgeWorkflowinstance:
-		return m.clearedworkflowinstance
+// AddVersion adds i to the "version" field.
+func (m *JSONSchemaMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
 	}
-	return false
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *WorkflowEventsMutation) ClearEdge(name string) error {
-	switch name {
-	case workflowevents.EdgeWorkflow:
-		m.ClearWorkflow()
-		return nil
-	case workflowevents.EdgeWorkflowinstance:
-		m.ClearWorkflowinstance()
-		return nil
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *JSONSchemaMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown WorkflowEvents unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *WorkflowEventsMutation) ResetEdge(name string) error {
-	switch name {
-	case workflowevents.EdgeWorkflow:
-		m.ResetWorkflow()
-		return nil
-	case workflowevents.EdgeWfeventswait:
-		m.ResetWfeventswait()
-		return nil
-	case workflowevents.EdgeWorkflowinstance:
-		m.ResetWorkflowinstance()
-		return nil
-	}
-	return fmt.Errorf("unknown WorkflowEvents edge %s", name)
+// ResetVersion resets all changes to the "version" field.
+func (m *JSONSchemaMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
 }
 
-// WorkflowEventsWaitMutation represents an operation that mutates the WorkflowEventsWait nodes in the graph.
-type WorkflowEventsWaitMutation struct {
-	config
-	op                   Op
-	typ                  string
-	id                   *int
-	events               *map[string]interface{}
-	clearedFields        map[string]struct{}
-	workflowevent        *int
-	clearedworkflowevent bool
-	done                 bool
-	oldValue             func(context.Context) (*WorkflowEventsWait, error)
-	predicates           []predicate.WorkflowEventsWait
+// SetSchema sets the "schema" field.
+func (m *JSONSchemaMutation) SetSchema(s string) {
+	m.schema = &s
 }
 
-var _ ent.Mutation = (*WorkflowEventsWaitMutation)(nil)
-
-// workfloweventswaitOption allows management of the mutation configuration using functional options.
-type workfloweventswaitOption func(*WorkflowEventsWaitMutation)
-
-// newWorkflowEventsWaitMutation creates new mutation for the WorkflowEventsWait entity.
-func newWorkflowEventsWaitMutation(c config, op Op, opts ...workfloweventswaitOption) *WorkflowEventsWaitMutation {
-	m := &WorkflowEventsWaitMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeWorkflowEventsWait,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// Schema returns the value of the "schema" field in the mutation.
+func (m *JSONSchemaMutation) Schema() (r string, exists bool) {
+	v := m.schema
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withWorkflowEventsWaitID sets the ID field of the mutation.
-func withWorkflowEventsWaitID(id int) workfloweventswaitOption {
-	return func(m *WorkflowEventsWaitMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *WorkflowEventsWait
-		)
-		m.oldValue = func(ctx context.Context) (*WorkflowEventsWait, error) {
-			once.Do(func() {
-				if m.done {
-					err = fmt.Errorf("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().WorkflowEventsWait.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldSchema returns the old "schema" field's value of the JSONSchema entity.
+// If the JSONSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JSONSchemaMutation) OldSchema(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSchema is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSchema requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchema: %w", err)
 	}
+	return oldValue.Schema, nil
 }
 
-// withWorkflowEventsWait sets the old WorkflowEventsWait of the mutation.
-func withWorkflowEventsWait(node *WorkflowEventsWait) workfloweventswaitOption {
-	return func(m *WorkflowEventsWaitMutation) {
-		m.oldValue = func(context.Context) (*WorkflowEventsWait, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ResetSchema resets all changes to the "schema" field.
+func (m *JSONSchemaMutation) ResetSchema() {
+	m.schema = nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WorkflowEventsWaitMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// SetHash sets the "hash" field.
+func (m *JSONSchemaMutation) SetHash(s string) {
+	m.hash = &s
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m WorkflowEventsWaitMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+// Hash returns the value of the "hash" field in the mutation.
+func (m *JSONSchemaMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return *v, true
 }
 
-// ID returns the ID value in the mutation. Note that the ID
-// is only available if it was provided to the builder.
-func (m *WorkflowEventsWaitMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+// OldHash returns the old "hash" field's value of the JSONSchema entity.
+// If the JSONSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JSONSchemaMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldHash is only allowed on UpdateOne operations")
 	}
-	return *m.id, true
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
 }
 
-// SetEvents sets the "events" field.
-func (m *WorkflowEventsWaitMutation) SetEvents(value map[string]interface{}) {
-	m.events = &value
+// ResetHash resets all changes to the "hash" field.
+func (m *JSONSchemaMutation) ResetHash() {
+	m.hash = nil
 }
 
-// Events returns the value of the "events" field in the mutation.
-func (m *WorkflowEventsWaitMutation) Events() (r map[string]interface{}, exists bool) {
-	v := m.events
+// SetCreated sets the "created" field.
+func (m *JSONSchemaMutation) SetCreated(t time.Time) {
+	m.created = &t
+}
+
+// Created returns the value of the "created" field in the mutation.
+func (m *JSONSchemaMutation) Created() (r time.Time, exists bool) {
+	v := m.created
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEvents returns the old "events" field's value of the WorkflowEventsWait entity.
-// If the WorkflowEventsWait object wasn't provided to the builder, the object is fetched from the database.
+// OldCreated returns the old "created" field's value of the JSONSchema entity.
+// If the JSONSchema object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowEventsWaitMutation) OldEvents(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *JSONSchemaMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldEvents is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldCreated is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldEvents requires an ID field in the mutation")
+		return v, fmt.Errorf("OldCreated requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
 	}
-	return oldValue.Events, nil
+	return oldValue.Created, nil
 }
 
-// ResetEvents resets all changes to the "events" field.
-func (m *WorkflowEventsWaitMutation) ResetEvents() {
-	m.events = nil
+// ResetCreated resets all changes to the "created" field.
+func (m *JSONSchemaMutation) ResetCreated() {
+	m.created = nil
 }
 
-// SetWorkfloweventID sets the "workflowevent" edge to the WorkflowEvents entity by id.
-func (m *WorkflowEventsWaitMutation) SetWorkfloweventID(id int) {
-	m.workflowevent = &id
+// Op returns the operation name.
+func (m *JSONSchemaMutation) Op() Op {
+	return m.op
 }
 
-// ClearWorkflowevent clears the "workflowevent" edge to the WorkflowEvents entity.
-func (m *WorkflowEventsWaitMutation) ClearWorkflowevent() {
-	m.clearedworkflowevent = true
-}
-
-// WorkfloweventCleared reports if the "workflowevent" edge to the WorkflowEvents entity was cleared.
-func (m *WorkflowEventsWaitMutation) WorkfloweventCleared() bool {
-	return m.clearedworkflowevent
-}
-
-// WorkfloweventID returns the "workflowevent" edge ID in the mutation.
-func (m *WorkflowEventsWaitMutation) WorkfloweventID() (id int, exists bool) {
-	if m.workflowevent != nil {
-		return *m.workflowevent, true
-	}
-	return
-}
-
-// WorkfloweventIDs returns the "workflowevent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// WorkfloweventID instead. It exists only for internal usage by the builders.
-func (m *WorkflowEventsWaitMutation) WorkfloweventIDs() (ids []int) {
-	if id := m.workflowevent; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetWorkflowevent resets all changes to the "workflowevent" edge.
-func (m *WorkflowEventsWaitMutation) ResetWorkflowevent() {
-	m.workflowevent = nil
-	m.clearedworkflowevent = false
-}
-
-// Op returns the operation name.
-func (m *WorkflowEventsWaitMutation) Op() Op {
-	return m.op
-}
-
-// Type returns the node type of this mutation (WorkflowEventsWait).
-func (m *WorkflowEventsWaitMutation) Type() string {
+// Type returns the node type of this mutation (JSONSchema).
+func (m *JSONSchemaMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *WorkflowEventsWaitMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.events != nil {
-		fields = append(fields, workfloweventswait.FieldEvents)
+func (m *JSONSchemaMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.namespace != nil {
+		fields = append(fields, jsonschema.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, jsonschema.FieldName)
+	}
+	if m.version != nil {
+		fields = append(fields, jsonschema.FieldVersion)
+	}
+	if m.schema != nil {
+		fields = append(fields, jsonschema.FieldSchema)
+	}
+	if m.hash != nil {
+		fields = append(fields, jsonschema.FieldHash)
+	}
+	if m.created != nil {
+		fields = append(fields, jsonschema.FieldCreated)
 	}
 	return fields
 }
@@ -2257,10 +2204,20 @@ func (m *WorkflowEventsWaitMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *WorkflowEventsWaitMutation) Field(name string) (ent.Value, bool) {
+func (m *JSONSchemaMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case workfloweventswait.FieldEvents:
-		return m.Events()
+	case jsonschema.FieldNamespace:
+		return m.Namespace()
+	case jsonschema.FieldName:
+		return m.Name()
+	case jsonschema.FieldVersion:
+		return m.Version()
+	case jsonschema.FieldSchema:
+		return m.Schema()
+	case jsonschema.FieldHash:
+		return m.Hash()
+	case jsonschema.FieldCreated:
+		return m.Created()
 	}
 	return nil, false
 }
@@ -2268,205 +2225,233 @@ func (m *WorkflowEventsWaitMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *WorkflowEventsWaitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *JSONSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case workfloweventswait.FieldEvents:
-		return m.OldEvents(ctx)
+	case jsonschema.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case jsonschema.FieldName:
+		return m.OldName(ctx)
+	case jsonschema.FieldVersion:
+		return m.OldVersion(ctx)
+	case jsonschema.FieldSchema:
+		return m.OldSchema(ctx)
+	case jsonschema.FieldHash:
+		return m.OldHash(ctx)
+	case jsonschema.FieldCreated:
+		return m.OldCreated(ctx)
 	}
-	return nil, fmt.Errorf("unknown WorkflowEventsWait field %s", name)
+	return nil, fmt.Errorf("unknown JSONSchema field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WorkflowEventsWaitMutation) SetField(name string, value ent.Value) error {
+func (m *JSONSchemaMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case workfloweventswait.FieldEvents:
-		v, ok := value.(map[string]interface{})
+	case jsonschema.FieldNamespace:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEvents(v)
+		m.SetNamespace(v)
+		return nil
+	case jsonschema.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case jsonschema.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case jsonschema.FieldSchema:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchema(v)
+		return nil
+	case jsonschema.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case jsonschema.FieldCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreated(v)
 		return nil
 	}
-	return fmt.Errorf("unknown WorkflowEventsWait field %s", name)
+	return fmt.Errorf("unknown JSONSchema field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *WorkflowEventsWaitMutation) AddedFields() []string {
-	return nil
+func (m *JSONSchemaMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, jsonschema.FieldVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *WorkflowEventsWaitMutation) AddedField(name string) (ent.Value, bool) {
+func (m *JSONSchemaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case jsonschema.FieldVersion:
+		return m.AddedVersion()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WorkflowEventsWaitMutation) AddField(name string, value ent.Value) error {
+func (m *JSONSchemaMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case jsonschema.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
-	return fmt.Errorf("unknown WorkflowEventsWait numeric field %s", name)
+	return fmt.Errorf("unknown JSONSchema numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *WorkflowEventsWaitMutation) ClearedFields() []string {
+func (m *JSONSchemaMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *WorkflowEventsWaitMutation) FieldCleared(name string) bool {
+func (m *JSONSchemaMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *WorkflowEventsWaitMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown WorkflowEventsWait nullable field %s", name)
+func (m *JSONSchemaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown JSONSchema nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *WorkflowEventsWaitMutation) ResetField(name string) error {
+func (m *JSONSchemaMutation) ResetField(name string) error {
 	switch name {
-	case workfloweventswait.FieldEvents:
-		m.ResetEvents()
+	case jsonschema.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case jsonschema.FieldName:
+		m.ResetName()
+		return nil
+	case jsonschema.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case jsonschema.FieldSchema:
+		m.ResetSchema()
+		return nil
+	case jsonschema.FieldHash:
+		m.ResetHash()
+		return nil
+	case jsonschema.FieldCreated:
+		m.ResetCreated()
 		return nil
 	}
-	return fmt.Errorf("unknown WorkflowEventsWait field %s", name)
+	return fmt.Errorf("unknown JSONSchema field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *WorkflowEventsWaitMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.workflowevent != nil {
-		edges = append(edges, workfloweventswait.EdgeWorkflowevent)
-	}
+func (m *JSONSchemaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *WorkflowEventsWaitMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case workfloweventswait.EdgeWorkflowevent:
-		if id := m.workflowevent; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *JSONSchemaMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *WorkflowEventsWaitMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *JSONSchemaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *WorkflowEventsWaitMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	}
+func (m *JSONSchemaMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *WorkflowEventsWaitMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedworkflowevent {
-		edges = append(edges, workfloweventswait.EdgeWorkflowevent)
-	}
+func (m *JSONSchemaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *WorkflowEventsWaitMutation) EdgeCleared(name string) bool {
-	switch name {
-	case workfloweventswait.EdgeWorkflowevent:
-		return m.clearedworkflowevent
-	}
+func (m *JSONSchemaMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *WorkflowEventsWaitMutation) ClearEdge(name string) error {
-	switch name {
-	case workfloweventswait.EdgeWorkflowevent:
-		m.ClearWorkflowevent()
-		return nil
-	}
-	return fmt.Errorf("unknown WorkflowEventsWait unique edge %s", name)
+func (m *JSONSchemaMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown JSONSchema unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *WorkflowEventsWaitMutation) ResetEdge(name string) error {
-	switch name {
-	case workfloweventswait.EdgeWorkflowevent:
-		m.ResetWorkflowevent()
-		return nil
-	}
-	return fmt.Errorf("unknown WorkflowEventsWait edge %s", name)
+func (m *JSONSchemaMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown JSONSchema edge %s", name)
 }
 
-// WorkflowInstanceMutation represents an operation that mutates the WorkflowInstance nodes in the graph.
-type WorkflowInstanceMutation struct {
+// LockLeaseMutation represents an operation that mutates the LockLease nodes in the graph.
+type LockLeaseMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	instanceID      *string
-	invokedBy       *string
-	status          *string
-	revision        *int
-	addrevision     *int
-	beginTime       *time.Time
-	endTime         *time.Time
-	flow            *[]string
-	input           *string
-	output          *string
-	stateData       *string
-	memory          *string
-	deadline        *time.Time
-	attempts        *int
-	addattempts     *int
-	errorCode       *string
-	errorMessage    *string
-	stateBeginTime  *time.Time
-	controller      *string
-	clearedFields   map[string]struct{}
-	workflow        *uuid.UUID
-	clearedworkflow bool
-	instance        map[int]struct{}
-	removedinstance map[int]struct{}
-	clearedinstance bool
-	done            bool
-	oldValue        func(context.Context) (*WorkflowInstance, error)
-	predicates      []predicate.WorkflowInstance
-}
-
-var _ ent.Mutation = (*WorkflowInstanceMutation)(nil)
-
-// workflowinstanceOption allows management of the mutation configuration using functional options.
-type workflowinstanceOption func(*WorkflowInstanceMutation)
-
-// newWorkflowInstanceMutation creates new mutation for the WorkflowInstance entity.
-func newWorkflowInstanceMutation(c config, op Op, opts ...workflowinstanceOption) *WorkflowInstanceMutation {
-	m := &WorkflowInstanceMutation{
+	op            Op
+	typ           string
+	id            *int
+	key           *int64
+	addkey        *int64
+	holder        *string
+	token         *string
+	expires       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LockLease, error)
+	predicates    []predicate.LockLease
+}
+
+var _ ent.Mutation = (*LockLeaseMutation)(nil)
+
+// lockleaseOption allows management of the mutation configuration using functional options.
+type lockleaseOption func(*LockLeaseMutation)
+
+// newLockLeaseMutation creates new mutation for the LockLease entity.
+func newLockLeaseMutation(c config, op Op, opts ...lockleaseOption) *LockLeaseMutation {
+	m := &LockLeaseMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeWorkflowInstance,
+		typ:           TypeLockLease,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2475,20 +2460,20 @@ func newWorkflowInstanceMutation(c config, op Op, opts ...workflowinstanceOption
 	return m
 }
 
-// withWorkflowInstanceID sets the ID field of the mutation.
-func withWorkflowInstanceID(id int) workflowinstanceOption {
-	return func(m *WorkflowInstanceMutation) {
+// withLockLeaseID sets the ID field of the mutation.
+func withLockLeaseID(id int) lockleaseOption {
+	return func(m *LockLeaseMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *WorkflowInstance
+			value *LockLease
 		)
-		m.oldValue = func(ctx context.Context) (*WorkflowInstance, error) {
+		m.oldValue = func(ctx context.Context) (*LockLease, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().WorkflowInstance.Get(ctx, id)
+					value, err = m.Client().LockLease.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2497,10 +2482,10 @@ func withWorkflowInstanceID(id int) workflowinstanceOption {
 	}
 }
 
-// withWorkflowInstance sets the old WorkflowInstance of the mutation.
-func withWorkflowInstance(node *WorkflowInstance) workflowinstanceOption {
-	return func(m *WorkflowInstanceMutation) {
-		m.oldValue = func(context.Context) (*WorkflowInstance, error) {
+// withLockLease sets the old LockLease of the mutation.
+func withLockLease(node *LockLease) lockleaseOption {
+	return func(m *LockLeaseMutation) {
+		m.oldValue = func(context.Context) (*LockLease, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2509,7 +2494,7 @@ func withWorkflowInstance(node *WorkflowInstance) workflowinstanceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WorkflowInstanceMutation) Client() *Client {
+func (m LockLeaseMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2517,7 +2502,7 @@ func (m WorkflowInstanceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m WorkflowInstanceMutation) Tx() (*Tx, error) {
+func (m LockLeaseMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -2528,966 +2513,1130 @@ func (m WorkflowInstanceMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID
 // is only available if it was provided to the builder.
-func (m *WorkflowInstanceMutation) ID() (id int, exists bool) {
+func (m *LockLeaseMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
 	return *m.id, true
 }
 
-// SetInstanceID sets the "instanceID" field.
-func (m *WorkflowInstanceMutation) SetInstanceID(s string) {
-	m.instanceID = &s
+// SetKey sets the "key" field.
+func (m *LockLeaseMutation) SetKey(i int64) {
+	m.key = &i
+	m.addkey = nil
 }
 
-// InstanceID returns the value of the "instanceID" field in the mutation.
-func (m *WorkflowInstanceMutation) InstanceID() (r string, exists bool) {
-	v := m.instanceID
+// Key returns the value of the "key" field in the mutation.
+func (m *LockLeaseMutation) Key() (r int64, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInstanceID returns the old "instanceID" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the LockLease entity.
+// If the LockLease object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldInstanceID(ctx context.Context) (v string, err error) {
+func (m *LockLeaseMutation) OldKey(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldInstanceID is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldInstanceID requires an ID field in the mutation")
+		return v, fmt.Errorf("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInstanceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.InstanceID, nil
+	return oldValue.Key, nil
 }
 
-// ResetInstanceID resets all changes to the "instanceID" field.
-func (m *WorkflowInstanceMutation) ResetInstanceID() {
-	m.instanceID = nil
+// AddKey adds i to the "key" field.
+func (m *LockLeaseMutation) AddKey(i int64) {
+	if m.addkey != nil {
+		*m.addkey += i
+	} else {
+		m.addkey = &i
+	}
 }
 
-// SetInvokedBy sets the "invokedBy" field.
-func (m *WorkflowInstanceMutation) SetInvokedBy(s string) {
-	m.invokedBy = &s
+// AddedKey returns the value that was added to the "key" field in this mutation.
+func (m *LockLeaseMutation) AddedKey() (r int64, exists bool) {
+	v := m.addkey
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// InvokedBy returns the value of the "invokedBy" field in the mutation.
-func (m *WorkflowInstanceMutation) InvokedBy() (r string, exists bool) {
-	v := m.invokedBy
+// ResetKey resets all changes to the "key" field.
+func (m *LockLeaseMutation) ResetKey() {
+	m.key = nil
+	m.addkey = nil
+}
+
+// SetHolder sets the "holder" field.
+func (m *LockLeaseMutation) SetHolder(s string) {
+	m.holder = &s
+}
+
+// Holder returns the value of the "holder" field in the mutation.
+func (m *LockLeaseMutation) Holder() (r string, exists bool) {
+	v := m.holder
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInvokedBy returns the old "invokedBy" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldHolder returns the old "holder" field's value of the LockLease entity.
+// If the LockLease object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldInvokedBy(ctx context.Context) (v string, err error) {
+func (m *LockLeaseMutation) OldHolder(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldInvokedBy is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldHolder is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldInvokedBy requires an ID field in the mutation")
+		return v, fmt.Errorf("OldHolder requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInvokedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldHolder: %w", err)
 	}
-	return oldValue.InvokedBy, nil
+	return oldValue.Holder, nil
 }
 
-// ResetInvokedBy resets all changes to the "invokedBy" field.
-func (m *WorkflowInstanceMutation) ResetInvokedBy() {
-	m.invokedBy = nil
+// ResetHolder resets all changes to the "holder" field.
+func (m *LockLeaseMutation) ResetHolder() {
+	m.holder = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *WorkflowInstanceMutation) SetStatus(s string) {
-	m.status = &s
+// SetToken sets the "token" field.
+func (m *LockLeaseMutation) SetToken(s string) {
+	m.token = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *WorkflowInstanceMutation) Status() (r string, exists bool) {
-	v := m.status
+// Token returns the value of the "token" field in the mutation.
+func (m *LockLeaseMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the LockLease entity.
+// If the LockLease object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *LockLeaseMutation) OldToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldStatus is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldStatus requires an ID field in the mutation")
+		return v, fmt.Errorf("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Token, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *WorkflowInstanceMutation) ResetStatus() {
-	m.status = nil
+// ResetToken resets all changes to the "token" field.
+func (m *LockLeaseMutation) ResetToken() {
+	m.token = nil
 }
 
-// SetRevision sets the "revision" field.
-func (m *WorkflowInstanceMutation) SetRevision(i int) {
-	m.revision = &i
-	m.addrevision = nil
+// SetExpires sets the "expires" field.
+func (m *LockLeaseMutation) SetExpires(t time.Time) {
+	m.expires = &t
 }
 
-// Revision returns the value of the "revision" field in the mutation.
-func (m *WorkflowInstanceMutation) Revision() (r int, exists bool) {
-	v := m.revision
+// Expires returns the value of the "expires" field in the mutation.
+func (m *LockLeaseMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRevision returns the old "revision" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldExpires returns the old "expires" field's value of the LockLease entity.
+// If the LockLease object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldRevision(ctx context.Context) (v int, err error) {
+func (m *LockLeaseMutation) OldExpires(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldRevision is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldExpires is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldRevision requires an ID field in the mutation")
+		return v, fmt.Errorf("OldExpires requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
-	}
-	return oldValue.Revision, nil
-}
-
-// AddRevision adds i to the "revision" field.
-func (m *WorkflowInstanceMutation) AddRevision(i int) {
-	if m.addrevision != nil {
-		*m.addrevision += i
-	} else {
-		m.addrevision = &i
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
 	}
+	return oldValue.Expires, nil
 }
 
-// AddedRevision returns the value that was added to the "revision" field in this mutation.
-func (m *WorkflowInstanceMutation) AddedRevision() (r int, exists bool) {
-	v := m.addrevision
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetExpires resets all changes to the "expires" field.
+func (m *LockLeaseMutation) ResetExpires() {
+	m.expires = nil
 }
 
-// ResetRevision resets all changes to the "revision" field.
-func (m *WorkflowInstanceMutation) ResetRevision() {
-	m.revision = nil
-	m.addrevision = nil
+// Op returns the operation name.
+func (m *LockLeaseMutation) Op() Op {
+	return m.op
 }
 
-// SetBeginTime sets the "beginTime" field.
-func (m *WorkflowInstanceMutation) SetBeginTime(t time.Time) {
-	m.beginTime = &t
+// Type returns the node type of this mutation (LockLease).
+func (m *LockLeaseMutation) Type() string {
+	return m.typ
 }
 
-// BeginTime returns the value of the "beginTime" field in the mutation.
-func (m *WorkflowInstanceMutation) BeginTime() (r time.Time, exists bool) {
-	v := m.beginTime
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LockLeaseMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.key != nil {
+		fields = append(fields, locklease.FieldKey)
 	}
-	return *v, true
-}
-
-// OldBeginTime returns the old "beginTime" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldBeginTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldBeginTime is only allowed on UpdateOne operations")
+	if m.holder != nil {
+		fields = append(fields, locklease.FieldHolder)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldBeginTime requires an ID field in the mutation")
+	if m.token != nil {
+		fields = append(fields, locklease.FieldToken)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBeginTime: %w", err)
+	if m.expires != nil {
+		fields = append(fields, locklease.FieldExpires)
 	}
-	return oldValue.BeginTime, nil
+	return fields
 }
 
-// ResetBeginTime resets all changes to the "beginTime" field.
-func (m *WorkflowInstanceMutation) ResetBeginTime() {
-	m.beginTime = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LockLeaseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case locklease.FieldKey:
+		return m.Key()
+	case locklease.FieldHolder:
+		return m.Holder()
+	case locklease.FieldToken:
+		return m.Token()
+	case locklease.FieldExpires:
+		return m.Expires()
+	}
+	return nil, false
 }
 
-// SetEndTime sets the "endTime" field.
-func (m *WorkflowInstanceMutation) SetEndTime(t time.Time) {
-	m.endTime = &t
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LockLeaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case locklease.FieldKey:
+		return m.OldKey(ctx)
+	case locklease.FieldHolder:
+		return m.OldHolder(ctx)
+	case locklease.FieldToken:
+		return m.OldToken(ctx)
+	case locklease.FieldExpires:
+		return m.OldExpires(ctx)
+	}
+	return nil, fmt.Errorf("unknown LockLease field %s", name)
 }
 
-// EndTime returns the value of the "endTime" field in the mutation.
-func (m *WorkflowInstanceMutation) EndTime() (r time.Time, exists bool) {
-	v := m.endTime
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LockLeaseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case locklease.FieldKey:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case locklease.FieldHolder:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHolder(v)
+		return nil
+	case locklease.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case locklease.FieldExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpires(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown LockLease field %s", name)
 }
 
-// OldEndTime returns the old "endTime" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldEndTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldEndTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LockLeaseMutation) AddedFields() []string {
+	var fields []string
+	if m.addkey != nil {
+		fields = append(fields, locklease.FieldKey)
 	}
-	return oldValue.EndTime, nil
+	return fields
 }
 
-// ClearEndTime clears the value of the "endTime" field.
-func (m *WorkflowInstanceMutation) ClearEndTime() {
-	m.endTime = nil
-	m.clearedFields[workflowinstance.FieldEndTime] = struct{}{}
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LockLeaseMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case locklease.FieldKey:
+		return m.AddedKey()
+	}
+	return nil, false
 }
 
-// EndTimeCleared returns if the "endTime" field was cleared in this mutation.
-func (m *WorkflowInstanceMutation) EndTimeCleared() bool {
-	_, ok := m.clearedFields[workflowinstance.FieldEndTime]
-	return ok
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LockLeaseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case locklease.FieldKey:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LockLease numeric field %s", name)
 }
 
-// ResetEndTime resets all changes to the "endTime" field.
-func (m *WorkflowInstanceMutation) ResetEndTime() {
-	m.endTime = nil
-	delete(m.clearedFields, workflowinstance.FieldEndTime)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LockLeaseMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetFlow sets the "flow" field.
-func (m *WorkflowInstanceMutation) SetFlow(s []string) {
-	m.flow = &s
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LockLeaseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// Flow returns the value of the "flow" field in the mutation.
-func (m *WorkflowInstanceMutation) Flow() (r []string, exists bool) {
-	v := m.flow
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LockLeaseMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LockLease nullable field %s", name)
 }
 
-// OldFlow returns the old "flow" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldFlow(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldFlow is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldFlow requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFlow: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LockLeaseMutation) ResetField(name string) error {
+	switch name {
+	case locklease.FieldKey:
+		m.ResetKey()
+		return nil
+	case locklease.FieldHolder:
+		m.ResetHolder()
+		return nil
+	case locklease.FieldToken:
+		m.ResetToken()
+		return nil
+	case locklease.FieldExpires:
+		m.ResetExpires()
+		return nil
 	}
-	return oldValue.Flow, nil
+	return fmt.Errorf("unknown LockLease field %s", name)
 }
 
-// ClearFlow clears the value of the "flow" field.
-func (m *WorkflowInstanceMutation) ClearFlow() {
-	m.flow = nil
-	m.clearedFields[workflowinstance.FieldFlow] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LockLeaseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// FlowCleared returns if the "flow" field was cleared in this mutation.
-func (m *WorkflowInstanceMutation) FlowCleared() bool {
-	_, ok := m.clearedFields[workflowinstance.FieldFlow]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LockLeaseMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetFlow resets all changes to the "flow" field.
-func (m *WorkflowInstanceMutation) ResetFlow() {
-	m.flow = nil
-	delete(m.clearedFields, workflowinstance.FieldFlow)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LockLeaseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetInput sets the "input" field.
-func (m *WorkflowInstanceMutation) SetInput(s string) {
-	m.input = &s
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LockLeaseMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Input returns the value of the "input" field in the mutation.
-func (m *WorkflowInstanceMutation) Input() (r string, exists bool) {
-	v := m.input
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LockLeaseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldInput returns the old "input" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldInput(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldInput is only allowed on UpdateOne operations")
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LockLeaseMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LockLeaseMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LockLease unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LockLeaseMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LockLease edge %s", name)
+}
+
+// NamespaceMutation represents an operation that mutates the Namespace nodes in the graph.
+type NamespaceMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *string
+	created          *time.Time
+	hold             *string
+	region           *string
+	event_config     *[]byte
+	cron_suspension  *string
+	constants        *[]byte
+	profiles         *[]byte
+	profile          *string
+	retry_policies   *[]byte
+	clearedFields    map[string]struct{}
+	workflows        map[uuid.UUID]struct{}
+	removedworkflows map[uuid.UUID]struct{}
+	clearedworkflows bool
+	parent           *string
+	clearedparent    bool
+	children         map[string]struct{}
+	removedchildren  map[string]struct{}
+	clearedchildren  bool
+	done             bool
+	oldValue         func(context.Context) (*Namespace, error)
+	predicates       []predicate.Namespace
+}
+
+var _ ent.Mutation = (*NamespaceMutation)(nil)
+
+// namespaceOption allows management of the mutation configuration using functional options.
+type namespaceOption func(*NamespaceMutation)
+
+// newNamespaceMutation creates new mutation for the Namespace entity.
+func newNamespaceMutation(c config, op Op, opts ...namespaceOption) *NamespaceMutation {
+	m := &NamespaceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNamespace,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldInput requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInput: %w", err)
+	return m
+}
+
+// withNamespaceID sets the ID field of the mutation.
+func withNamespaceID(id string) namespaceOption {
+	return func(m *NamespaceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Namespace
+		)
+		m.oldValue = func(ctx context.Context) (*Namespace, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Namespace.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.Input, nil
 }
 
-// ResetInput resets all changes to the "input" field.
-func (m *WorkflowInstanceMutation) ResetInput() {
-	m.input = nil
+// withNamespace sets the old Namespace of the mutation.
+func withNamespace(node *Namespace) namespaceOption {
+	return func(m *NamespaceMutation) {
+		m.oldValue = func(context.Context) (*Namespace, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetOutput sets the "output" field.
-func (m *WorkflowInstanceMutation) SetOutput(s string) {
-	m.output = &s
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NamespaceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// Output returns the value of the "output" field in the mutation.
-func (m *WorkflowInstanceMutation) Output() (r string, exists bool) {
-	v := m.output
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NamespaceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Namespace entities.
+func (m *NamespaceMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *NamespaceMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetCreated sets the "created" field.
+func (m *NamespaceMutation) SetCreated(t time.Time) {
+	m.created = &t
+}
+
+// Created returns the value of the "created" field in the mutation.
+func (m *NamespaceMutation) Created() (r time.Time, exists bool) {
+	v := m.created
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOutput returns the old "output" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldCreated returns the old "created" field's value of the Namespace entity.
+// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldOutput(ctx context.Context) (v string, err error) {
+func (m *NamespaceMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldOutput is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldCreated is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldOutput requires an ID field in the mutation")
+		return v, fmt.Errorf("OldCreated requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOutput: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
 	}
-	return oldValue.Output, nil
-}
-
-// ClearOutput clears the value of the "output" field.
-func (m *WorkflowInstanceMutation) ClearOutput() {
-	m.output = nil
-	m.clearedFields[workflowinstance.FieldOutput] = struct{}{}
-}
-
-// OutputCleared returns if the "output" field was cleared in this mutation.
-func (m *WorkflowInstanceMutation) OutputCleared() bool {
-	_, ok := m.clearedFields[workflowinstance.FieldOutput]
-	return ok
+	return oldValue.Created, nil
 }
 
-// ResetOutput resets all changes to the "output" field.
-func (m *WorkflowInstanceMutation) ResetOutput() {
-	m.output = nil
-	delete(m.clearedFields, workflowinstance.FieldOutput)
+// ResetCreated resets all changes to the "created" field.
+func (m *NamespaceMutation) ResetCreated() {
+	m.created = nil
 }
 
-// SetStateData sets the "stateData" field.
-func (m *WorkflowInstanceMutation) SetStateData(s string) {
-	m.stateData = &s
+// SetHold sets the "hold" field.
+func (m *NamespaceMutation) SetHold(s string) {
+	m.hold = &s
 }
 
-// StateData returns the value of the "stateData" field in the mutation.
-func (m *WorkflowInstanceMutation) StateData() (r string, exists bool) {
-	v := m.stateData
+// Hold returns the value of the "hold" field in the mutation.
+func (m *NamespaceMutation) Hold() (r string, exists bool) {
+	v := m.hold
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStateData returns the old "stateData" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldHold returns the old "hold" field's value of the Namespace entity.
+// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldStateData(ctx context.Context) (v string, err error) {
+func (m *NamespaceMutation) OldHold(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldStateData is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldHold is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldStateData requires an ID field in the mutation")
+		return v, fmt.Errorf("OldHold requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStateData: %w", err)
+		return v, fmt.Errorf("querying old value for OldHold: %w", err)
 	}
-	return oldValue.StateData, nil
+	return oldValue.Hold, nil
 }
 
-// ClearStateData clears the value of the "stateData" field.
-func (m *WorkflowInstanceMutation) ClearStateData() {
-	m.stateData = nil
-	m.clearedFields[workflowinstance.FieldStateData] = struct{}{}
+// ClearHold clears the value of the "hold" field.
+func (m *NamespaceMutation) ClearHold() {
+	m.hold = nil
+	m.clearedFields[namespace.FieldHold] = struct{}{}
 }
 
-// StateDataCleared returns if the "stateData" field was cleared in this mutation.
-func (m *WorkflowInstanceMutation) StateDataCleared() bool {
-	_, ok := m.clearedFields[workflowinstance.FieldStateData]
+// HoldCleared returns if the "hold" field was cleared in this mutation.
+func (m *NamespaceMutation) HoldCleared() bool {
+	_, ok := m.clearedFields[namespace.FieldHold]
 	return ok
 }
 
-// ResetStateData resets all changes to the "stateData" field.
-func (m *WorkflowInstanceMutation) ResetStateData() {
-	m.stateData = nil
-	delete(m.clearedFields, workflowinstance.FieldStateData)
+// ResetHold resets all changes to the "hold" field.
+func (m *NamespaceMutation) ResetHold() {
+	m.hold = nil
+	delete(m.clearedFields, namespace.FieldHold)
 }
 
-// SetMemory sets the "memory" field.
-func (m *WorkflowInstanceMutation) SetMemory(s string) {
-	m.memory = &s
+// SetRegion sets the "region" field.
+func (m *NamespaceMutation) SetRegion(s string) {
+	m.region = &s
 }
 
-// Memory returns the value of the "memory" field in the mutation.
-func (m *WorkflowInstanceMutation) Memory() (r string, exists bool) {
-	v := m.memory
+// Region returns the value of the "region" field in the mutation.
+func (m *NamespaceMutation) Region() (r string, exists bool) {
+	v := m.region
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMemory returns the old "memory" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldRegion returns the old "region" field's value of the Namespace entity.
+// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldMemory(ctx context.Context) (v string, err error) {
+func (m *NamespaceMutation) OldRegion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldMemory is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldRegion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldMemory requires an ID field in the mutation")
+		return v, fmt.Errorf("OldRegion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMemory: %w", err)
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
 	}
-	return oldValue.Memory, nil
+	return oldValue.Region, nil
 }
 
-// ClearMemory clears the value of the "memory" field.
-func (m *WorkflowInstanceMutation) ClearMemory() {
-	m.memory = nil
-	m.clearedFields[workflowinstance.FieldMemory] = struct{}{}
+// ClearRegion clears the value of the "region" field.
+func (m *NamespaceMutation) ClearRegion() {
+	m.region = nil
+	m.clearedFields[namespace.FieldRegion] = struct{}{}
 }
 
-// MemoryCleared returns if the "memory" field was cleared in this mutation.
-func (m *WorkflowInstanceMutation) MemoryCleared() bool {
-	_, ok := m.clearedFields[workflowinstance.FieldMemory]
+// RegionCleared returns if the "region" field was cleared in this mutation.
+func (m *NamespaceMutation) RegionCleared() bool {
+	_, ok := m.clearedFields[namespace.FieldRegion]
 	return ok
 }
 
-// ResetMemory resets all changes to the "memory" field.
-func (m *WorkflowInstanceMutation) ResetMemory() {
-	m.memory = nil
-	delete(m.clearedFields, workflowinstance.FieldMemory)
+// ResetRegion resets all changes to the "region" field.
+func (m *NamespaceMutation) ResetRegion() {
+	m.region = nil
+	delete(m.clearedFields, namespace.FieldRegion)
 }
 
-// SetDeadline sets the "deadline" field.
-func (m *WorkflowInstanceMutation) SetDeadline(t time.Time) {
-	m.deadline = &t
+// SetEventConfig sets the "event_config" field.
+func (m *NamespaceMutation) SetEventConfig(b []byte) {
+	m.event_config = &b
 }
 
-// Deadline returns the value of the "deadline" field in the mutation.
-func (m *WorkflowInstanceMutation) Deadline() (r time.Time, exists bool) {
-	v := m.deadline
+// EventConfig returns the value of the "event_config" field in the mutation.
+func (m *NamespaceMutation) EventConfig() (r []byte, exists bool) {
+	v := m.event_config
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeadline returns the old "deadline" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldEventConfig returns the old "event_config" field's value of the Namespace entity.
+// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldDeadline(ctx context.Context) (v time.Time, err error) {
+func (m *NamespaceMutation) OldEventConfig(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldDeadline is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldEventConfig is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldDeadline requires an ID field in the mutation")
+		return v, fmt.Errorf("OldEventConfig requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeadline: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventConfig: %w", err)
 	}
-	return oldValue.Deadline, nil
+	return oldValue.EventConfig, nil
 }
 
-// ClearDeadline clears the value of the "deadline" field.
-func (m *WorkflowInstanceMutation) ClearDeadline() {
-	m.deadline = nil
-	m.clearedFields[workflowinstance.FieldDeadline] = struct{}{}
+// ClearEventConfig clears the value of the "event_config" field.
+func (m *NamespaceMutation) ClearEventConfig() {
+	m.event_config = nil
+	m.clearedFields[namespace.FieldEventConfig] = struct{}{}
 }
 
-// DeadlineCleared returns if the "deadline" field was cleared in this mutation.
-func (m *WorkflowInstanceMutation) DeadlineCleared() bool {
-	_, ok := m.clearedFields[workflowinstance.FieldDeadline]
+// EventConfigCleared returns if the "event_config" field was cleared in this mutation.
+func (m *NamespaceMutation) EventConfigCleared() bool {
+	_, ok := m.clearedFields[namespace.FieldEventConfig]
 	return ok
 }
 
-// ResetDeadline resets all changes to the "deadline" field.
-func (m *WorkflowInstanceMutation) ResetDeadline() {
-	m.deadline = nil
-	delete(m.clearedFields, workflowinstance.FieldDeadline)
+// ResetEventConfig resets all changes to the "event_config" field.
+func (m *NamespaceMutation) ResetEventConfig() {
+	m.event_config = nil
+	delete(m.clearedFields, namespace.FieldEventConfig)
 }
 
-// SetAttempts sets the "attempts" field.
-func (m *WorkflowInstanceMutation) SetAttempts(i int) {
-	m.attempts = &i
-	m.addattempts = nil
+// SetCronSuspension sets the "cron_suspension" field.
+func (m *NamespaceMutation) SetCronSuspension(s string) {
+	m.cron_suspension = &s
 }
 
-// Attempts returns the value of the "attempts" field in the mutation.
-func (m *WorkflowInstanceMutation) Attempts() (r int, exists bool) {
-	v := m.attempts
+// CronSuspension returns the value of the "cron_suspension" field in the mutation.
+func (m *NamespaceMutation) CronSuspension() (r string, exists bool) {
+	v := m.cron_suspension
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAttempts returns the old "attempts" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldCronSuspension returns the old "cron_suspension" field's value of the Namespace entity.
+// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldAttempts(ctx context.Context) (v int, err error) {
+func (m *NamespaceMutation) OldCronSuspension(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldAttempts is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldCronSuspension is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldAttempts requires an ID field in the mutation")
+		return v, fmt.Errorf("OldCronSuspension requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+		return v, fmt.Errorf("querying old value for OldCronSuspension: %w", err)
 	}
-	return oldValue.Attempts, nil
+	return oldValue.CronSuspension, nil
 }
 
-// AddAttempts adds i to the "attempts" field.
-func (m *WorkflowInstanceMutation) AddAttempts(i int) {
-	if m.addattempts != nil {
-		*m.addattempts += i
-	} else {
-		m.addattempts = &i
-	}
-}
-
-// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
-func (m *WorkflowInstanceMutation) AddedAttempts() (r int, exists bool) {
-	v := m.addattempts
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearCronSuspension clears the value of the "cron_suspension" field.
+func (m *NamespaceMutation) ClearCronSuspension() {
+	m.cron_suspension = nil
+	m.clearedFields[namespace.FieldCronSuspension] = struct{}{}
 }
 
-// ClearAttempts clears the value of the "attempts" field.
-func (m *WorkflowInstanceMutation) ClearAttempts() {
-	m.attempts = nil
-	m.addattempts = nil
-	m.clearedFields[workflowinstance.FieldAttempts] = struct{}{}
-}
-
-// AttemptsCleared returns if the "attempts" field was cleared in this mutation.
-func (m *WorkflowInstanceMutation) AttemptsCleared() bool {
-	_, ok := m.clearedFields[workflowinstance.FieldAttempts]
+// CronSuspensionCleared returns if the "cron_suspension" field was cleared in this mutation.
+func (m *NamespaceMutation) CronSuspensionCleared() bool {
+	_, ok := m.clearedFields[namespace.FieldCronSuspension]
 	return ok
 }
 
-// ResetAttempts resets all changes to the "attempts" field.
-func (m *WorkflowInstanceMutation) ResetAttempts() {
-	m.attempts = nil
-	m.addattempts = nil
-	delete(m.clearedFields, workflowinstance.FieldAttempts)
+// ResetCronSuspension resets all changes to the "cron_suspension" field.
+func (m *NamespaceMutation) ResetCronSuspension() {
+	m.cron_suspension = nil
+	delete(m.clearedFields, namespace.FieldCronSuspension)
 }
 
-// SetErrorCode sets the "errorCode" field.
-func (m *WorkflowInstanceMutation) SetErrorCode(s string) {
-	m.errorCode = &s
+// SetConstants sets the "constants" field.
+func (m *NamespaceMutation) SetConstants(b []byte) {
+	m.constants = &b
 }
 
-// ErrorCode returns the value of the "errorCode" field in the mutation.
-func (m *WorkflowInstanceMutation) ErrorCode() (r string, exists bool) {
-	v := m.errorCode
+// Constants returns the value of the "constants" field in the mutation.
+func (m *NamespaceMutation) Constants() (r []byte, exists bool) {
+	v := m.constants
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorCode returns the old "errorCode" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldConstants returns the old "constants" field's value of the Namespace entity.
+// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldErrorCode(ctx context.Context) (v string, err error) {
+func (m *NamespaceMutation) OldConstants(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldErrorCode is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldConstants is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldErrorCode requires an ID field in the mutation")
+		return v, fmt.Errorf("OldConstants requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldConstants: %w", err)
 	}
-	return oldValue.ErrorCode, nil
+	return oldValue.Constants, nil
 }
 
-// ClearErrorCode clears the value of the "errorCode" field.
-func (m *WorkflowInstanceMutation) ClearErrorCode() {
-	m.errorCode = nil
-	m.clearedFields[workflowinstance.FieldErrorCode] = struct{}{}
+// ClearConstants clears the value of the "constants" field.
+func (m *NamespaceMutation) ClearConstants() {
+	m.constants = nil
+	m.clearedFields[namespace.FieldConstants] = struct{}{}
 }
 
-// ErrorCodeCleared returns if the "errorCode" field was cleared in this mutation.
-func (m *WorkflowInstanceMutation) ErrorCodeCleared() bool {
-	_, ok := m.clearedFields[workflowinstance.FieldErrorCode]
+// ConstantsCleared returns if the "constants" field was cleared in this mutation.
+func (m *NamespaceMutation) ConstantsCleared() bool {
+	_, ok := m.clearedFields[namespace.FieldConstants]
 	return ok
 }
 
-// ResetErrorCode resets all changes to the "errorCode" field.
-func (m *WorkflowInstanceMutation) ResetErrorCode() {
-	m.errorCode = nil
-	delete(m.clearedFields, workflowinstance.FieldErrorCode)
+// ResetConstants resets all changes to the "constants" field.
+func (m *NamespaceMutation) ResetConstants() {
+	m.constants = nil
+	delete(m.clearedFields, namespace.FieldConstants)
 }
 
-// SetErrorMessage sets the "errorMessage" field.
-func (m *WorkflowInstanceMutation) SetErrorMessage(s string) {
-	m.errorMessage = &s
+// SetProfiles sets the "profiles" field.
+func (m *NamespaceMutation) SetProfiles(b []byte) {
+	m.profiles = &b
 }
 
-// ErrorMessage returns the value of the "errorMessage" field in the mutation.
-func (m *WorkflowInstanceMutation) ErrorMessage() (r string, exists bool) {
-	v := m.errorMessage
+// Profiles returns the value of the "profiles" field in the mutation.
+func (m *NamespaceMutation) Profiles() (r []byte, exists bool) {
+	v := m.profiles
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "errorMessage" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldProfiles returns the old "profiles" field's value of the Namespace entity.
+// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+func (m *NamespaceMutation) OldProfiles(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldProfiles is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldErrorMessage requires an ID field in the mutation")
+		return v, fmt.Errorf("OldProfiles requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldProfiles: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
+	return oldValue.Profiles, nil
 }
 
-// ClearErrorMessage clears the value of the "errorMessage" field.
-func (m *WorkflowInstanceMutation) ClearErrorMessage() {
-	m.errorMessage = nil
-	m.clearedFields[workflowinstance.FieldErrorMessage] = struct{}{}
+// ClearProfiles clears the value of the "profiles" field.
+func (m *NamespaceMutation) ClearProfiles() {
+	m.profiles = nil
+	m.clearedFields[namespace.FieldProfiles] = struct{}{}
 }
 
-// ErrorMessageCleared returns if the "errorMessage" field was cleared in this mutation.
-func (m *WorkflowInstanceMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[workflowinstance.FieldErrorMessage]
+// ProfilesCleared returns if the "profiles" field was cleared in this mutation.
+func (m *NamespaceMutation) ProfilesCleared() bool {
+	_, ok := m.clearedFields[namespace.FieldProfiles]
 	return ok
 }
 
-// ResetErrorMessage resets all changes to the "errorMessage" field.
-func (m *WorkflowInstanceMutation) ResetErrorMessage() {
-	m.errorMessage = nil
-	delete(m.clearedFields, workflowinstance.FieldErrorMessage)
+// ResetProfiles resets all changes to the "profiles" field.
+func (m *NamespaceMutation) ResetProfiles() {
+	m.profiles = nil
+	delete(m.clearedFields, namespace.FieldProfiles)
 }
 
-// SetStateBeginTime sets the "stateBeginTime" field.
-func (m *WorkflowInstanceMutation) SetStateBeginTime(t time.Time) {
-	m.stateBeginTime = &t
+// SetProfile sets the "profile" field.
+func (m *NamespaceMutation) SetProfile(s string) {
+	m.profile = &s
 }
 
-// StateBeginTime returns the value of the "stateBeginTime" field in the mutation.
-func (m *WorkflowInstanceMutation) StateBeginTime() (r time.Time, exists bool) {
-	v := m.stateBeginTime
+// Profile returns the value of the "profile" field in the mutation.
+func (m *NamespaceMutation) Profile() (r string, exists bool) {
+	v := m.profile
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStateBeginTime returns the old "stateBeginTime" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldProfile returns the old "profile" field's value of the Namespace entity.
+// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldStateBeginTime(ctx context.Context) (v time.Time, err error) {
+func (m *NamespaceMutation) OldProfile(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldStateBeginTime is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldProfile is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldStateBeginTime requires an ID field in the mutation")
+		return v, fmt.Errorf("OldProfile requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStateBeginTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldProfile: %w", err)
 	}
-	return oldValue.StateBeginTime, nil
+	return oldValue.Profile, nil
 }
 
-// ClearStateBeginTime clears the value of the "stateBeginTime" field.
-func (m *WorkflowInstanceMutation) ClearStateBeginTime() {
-	m.stateBeginTime = nil
-	m.clearedFields[workflowinstance.FieldStateBeginTime] = struct{}{}
+// ClearProfile clears the value of the "profile" field.
+func (m *NamespaceMutation) ClearProfile() {
+	m.profile = nil
+	m.clearedFields[namespace.FieldProfile] = struct{}{}
 }
 
-// StateBeginTimeCleared returns if the "stateBeginTime" field was cleared in this mutation.
-func (m *WorkflowInstanceMutation) StateBeginTimeCleared() bool {
-	_, ok := m.clearedFields[workflowinstance.FieldStateBeginTime]
+// ProfileCleared returns if the "profile" field was cleared in this mutation.
+func (m *NamespaceMutation) ProfileCleared() bool {
+	_, ok := m.clearedFields[namespace.FieldProfile]
 	return ok
 }
 
-// ResetStateBeginTime resets all changes to the "stateBeginTime" field.
-func (m *WorkflowInstanceMutation) ResetStateBeginTime() {
-	m.stateBeginTime = nil
-	delete(m.clearedFields, workflowinstance.FieldStateBeginTime)
+// ResetProfile resets all changes to the "profile" field.
+func (m *NamespaceMutation) ResetProfile() {
+	m.profile = nil
+	delete(m.clearedFields, namespace.FieldProfile)
 }
 
-// SetController sets the "controller" field.
-func (m *WorkflowInstanceMutation) SetController(s string) {
-	m.controller = &s
+// SetRetryPolicies sets the "retry_policies" field.
+func (m *NamespaceMutation) SetRetryPolicies(b []byte) {
+	m.retry_policies = &b
 }
 
-// Controller returns the value of the "controller" field in the mutation.
-func (m *WorkflowInstanceMutation) Controller() (r string, exists bool) {
-	v := m.controller
+// RetryPolicies returns the value of the "retry_policies" field in the mutation.
+func (m *NamespaceMutation) RetryPolicies() (r []byte, exists bool) {
+	v := m.retry_policies
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldController returns the old "controller" field's value of the WorkflowInstance entity.
-// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldRetryPolicies returns the old "retry_policies" field's value of the Namespace entity.
+// If the Namespace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkflowInstanceMutation) OldController(ctx context.Context) (v string, err error) {
+func (m *NamespaceMutation) OldRetryPolicies(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldController is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldRetryPolicies is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldController requires an ID field in the mutation")
+		return v, fmt.Errorf("OldRetryPolicies requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldController: %w", err)
+		return v, fmt.Errorf("querying old value for OldRetryPolicies: %w", err)
 	}
-	return oldValue.Controller, nil
+	return oldValue.RetryPolicies, nil
 }
 
-// ClearController clears the value of the "controller" field.
-func (m *WorkflowInstanceMutation) ClearController() {
-	m.controller = nil
-	m.clearedFields[workflowinstance.FieldController] = struct{}{}
+// ClearRetryPolicies clears the value of the "retry_policies" field.
+func (m *NamespaceMutation) ClearRetryPolicies() {
+	m.retry_policies = nil
+	m.clearedFields[namespace.FieldRetryPolicies] = struct{}{}
 }
 
-// ControllerCleared returns if the "controller" field was cleared in this mutation.
-func (m *WorkflowInstanceMutation) ControllerCleared() bool {
-	_, ok := m.clearedFields[workflowinstance.FieldController]
+// RetryPoliciesCleared returns if the "retry_policies" field was cleared in this mutation.
+func (m *NamespaceMutation) RetryPoliciesCleared() bool {
+	_, ok := m.clearedFields[namespace.FieldRetryPolicies]
 	return ok
 }
 
-// ResetController resets all changes to the "controller" field.
-func (m *WorkflowInstanceMutation) ResetController() {
-	m.controller = nil
-	delete(m.clearedFields, workflowinstance.FieldController)
+// ResetRetryPolicies resets all changes to the "retry_policies" field.
+func (m *NamespaceMutation) ResetRetryPolicies() {
+	m.retry_policies = nil
+	delete(m.clearedFields, namespace.FieldRetryPolicies)
 }
 
-// SetWorkflowID sets the "workflow" edge to the Workflow entity by id.
-func (m *WorkflowInstanceMutation) SetWorkflowID(id uuid.UUID) {
-	m.workflow = &id
+// AddWorkflowIDs adds the "workflows" edge to the Workflow entity by ids.
+func (m *NamespaceMutation) AddWorkflowIDs(ids ...uuid.UUID) {
+	if m.workflows == nil {
+		m.workflows = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.workflows[ids[i]] = struct{}{}
+	}
 }
 
-// ClearWorkflow clears the "workflow" edge to the Workflow entity.
-func (m *WorkflowInstanceMutation) ClearWorkflow() {
-	m.clearedworkflow = true
+// ClearWorkflows clears the "workflows" edge to the Workflow entity.
+func (m *NamespaceMutation) ClearWorkflows() {
+	m.clearedworkflows = true
 }
 
-// WorkflowCleared reports if the "workflow" edge to the Workflow entity was cleared.
-func (m *WorkflowInstanceMutation) WorkflowCleared() bool {
-	return m.clearedworkflow
+// WorkflowsCleared reports if the "workflows" edge to the Workflow entity was cleared.
+func (m *NamespaceMutation) WorkflowsCleared() bool {
+	return m.clearedworkflows
 }
 
-// WorkflowID returns the "workflow" edge ID in the mutation.
-func (m *WorkflowInstanceMutation) WorkflowID() (id uuid.UUID, exists bool) {
-	if m.workflow != nil {
-		return *m.workflow, true
+// RemoveWorkflowIDs removes the "workflows" edge to the Workflow entity by IDs.
+func (m *NamespaceMutation) RemoveWorkflowIDs(ids ...uuid.UUID) {
+	if m.removedworkflows == nil {
+		m.removedworkflows = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.removedworkflows[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWorkflows returns the removed IDs of the "workflows" edge to the Workflow entity.
+func (m *NamespaceMutation) RemovedWorkflowsIDs() (ids []uuid.UUID) {
+	for id := range m.removedworkflows {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// WorkflowIDs returns the "workflow" edge IDs in the mutation.
+// WorkflowsIDs returns the "workflows" edge IDs in the mutation.
+func (m *NamespaceMutation) WorkflowsIDs() (ids []uuid.UUID) {
+	for id := range m.workflows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWorkflows resets all changes to the "workflows" edge.
+func (m *NamespaceMutation) ResetWorkflows() {
+	m.workflows = nil
+	m.clearedworkflows = false
+	m.removedworkflows = nil
+}
+
+// SetParentID sets the "parent" edge to the Namespace entity by id.
+func (m *NamespaceMutation) SetParentID(id string) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Namespace entity.
+func (m *NamespaceMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Namespace entity was cleared.
+func (m *NamespaceMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *NamespaceMutation) ParentID() (id string, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// WorkflowID instead. It exists only for internal usage by the builders.
-func (m *WorkflowInstanceMutation) WorkflowIDs() (ids []uuid.UUID) {
-	if id := m.workflow; id != nil {
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *NamespaceMutation) ParentIDs() (ids []string) {
+	if id := m.parent; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetWorkflow resets all changes to the "workflow" edge.
-func (m *WorkflowInstanceMutation) ResetWorkflow() {
-	m.workflow = nil
-	m.clearedworkflow = false
+// ResetParent resets all changes to the "parent" edge.
+func (m *NamespaceMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
 }
 
-// AddInstanceIDs adds the "instance" edge to the WorkflowEvents entity by ids.
-func (m *WorkflowInstanceMutation) AddInstanceIDs(ids ...int) {
-	if m.instance == nil {
-		m.instance = make(map[int]struct{})
+// AddChildIDs adds the "children" edge to the Namespace entity by ids.
+func (m *NamespaceMutation) AddChildIDs(ids ...string) {
+	if m.children == nil {
+		m.children = make(map[string]struct{})
 	}
 	for i := range ids {
-		m.instance[ids[i]] = struct{}{}
+		m.children[ids[i]] = struct{}{}
 	}
 }
 
-// ClearInstance clears the "instance" edge to the WorkflowEvents entity.
-func (m *WorkflowInstanceMutation) ClearInstance() {
-	m.clearedinstance = true
+// ClearChildren clears the "children" edge to the Namespace entity.
+func (m *NamespaceMutation) ClearChildren() {
+	m.clearedchildren = true
 }
 
-// InstanceCleared reports if the "instance" edge to the WorkflowEvents entity was cleared.
-func (m *WorkflowInstanceMutation) InstanceCleared() bool {
-	return m.clearedinstance
+// ChildrenCleared reports if the "children" edge to the Namespace entity was cleared.
+func (m *NamespaceMutation) ChildrenCleared() bool {
+	return m.clearedchildren
 }
 
-// RemoveInstanceIDs removes the "instance" edge to the WorkflowEvents entity by IDs.
-func (m *WorkflowInstanceMutation) RemoveInstanceIDs(ids ...int) {
-	if m.removedinstance == nil {
-		m.removedinstance = make(map[int]struct{})
+// RemoveChildIDs removes the "children" edge to the Namespace entity by IDs.
+func (m *NamespaceMutation) RemoveChildIDs(ids ...string) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[string]struct{})
 	}
 	for i := range ids {
-		m.removedinstance[ids[i]] = struct{}{}
+		m.removedchildren[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedInstance returns the removed IDs of the "instance" edge to the WorkflowEvents entity.
-func (m *WorkflowInstanceMutation) RemovedInstanceIDs() (ids []int) {
-	for id := range m.removedinstance {
+// RemovedChildren returns the removed IDs of the "children" edge to the Namespace entity.
+func (m *NamespaceMutation) RemovedChildrenIDs() (ids []string) {
+	for id := range m.removedchildren {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// InstanceIDs returns the "instance" edge IDs in the mutation.
-func (m *WorkflowInstanceMutation) InstanceIDs() (ids []int) {
-	for id := range m.instance {
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *NamespaceMutation) ChildrenIDs() (ids []string) {
+	for id := range m.children {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetInstance resets all changes to the "instance" edge.
-func (m *WorkflowInstanceMutation) ResetInstance() {
-	m.instance = nil
-	m.clearedinstance = false
-	m.removedinstance = nil
+// ResetChildren resets all changes to the "children" edge.
+func (m *NamespaceMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
 }
 
 // Op returns the operation name.
-func (m *WorkflowInstanceMutation) Op() Op {
+func (m *NamespaceMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (WorkflowInstance).
-func (m *WorkflowInstanceMutation) Type() string {
+// Type returns the node type of this mutation (Namespace).
+func (m *NamespaceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *WorkflowInstanceMutation) Fields() []string {
-	fields := make([]string, 0, 17)
-	if m.instanceID != nil {
-		fields = append(fields, workflowinstance.FieldInstanceID)
+func (m *NamespaceMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created != nil {
+		fields = append(fields, namespace.FieldCreated)
 	}
-	if m.invokedBy != nil {
-		fields = append(fields, workflowinstance.FieldInvokedBy)
+	if m.hold != nil {
+		fields = append(fields, namespace.FieldHold)
 	}
-	if m.status != nil {
-		fields = append(fields, workflowinstance.FieldStatus)
+	if m.region != nil {
+		fields = append(fields, namespace.FieldRegion)
 	}
-	if m.revision != nil {
-		fields = append(fields, workflowinstance.FieldRevision)
+	if m.event_config != nil {
+		fields = append(fields, namespace.FieldEventConfig)
 	}
-	if m.beginTime != nil {
-		fields = append(fields, workflowinstance.FieldBeginTime)
+	if m.cron_suspension != nil {
+		fields = append(fields, namespace.FieldCronSuspension)
 	}
-	if m.endTime != nil {
-		fields = append(fields, workflowinstance.FieldEndTime)
+	if m.constants != nil {
+		fields = append(fields, namespace.FieldConstants)
 	}
-	if m.flow != nil {
-		fields = append(fields, workflowinstance.FieldFlow)
+	if m.profiles != nil {
+		fields = append(fields, namespace.FieldProfiles)
 	}
-	if m.input != nil {
-		fields = append(fields, workflowinstance.FieldInput)
-	}
-	if m.output != nil {
-		fields = append(fields, workflowinstance.FieldOutput)
-	}
-	if m.stateData != nil {
-		fields = append(fields, workflowinstance.FieldStateData)
-	}
-	if m.memory != nil {
-		fields = append(fields, workflowinstance.FieldMemory)
-	}
-	if m.deadline != nil {
-		fields = append(fields, workflowinstance.FieldDeadline)
-	}
-	if m.attempts != nil {
-		fields = append(fields, workflowinstance.FieldAttempts)
-	}
-	if m.errorCode != nil {
-		fields = append(fields, workflowinstance.FieldErrorCode)
-	}
-	if m.errorMessage != nil {
-		fields = append(fields, workflowinstance.FieldErrorMessage)
-	}
-	if m.stateBeginTime != nil {
-		fields = append(fields, workflowinstance.FieldStateBeginTime)
+	if m.profile != nil {
+		fields = append(fields, namespace.FieldProfile)
 	}
-	if m.controller != nil {
-		fields = append(fields, workflowinstance.FieldController)
+	if m.retry_policies != nil {
+		fields = append(fields, namespace.FieldRetryPolicies)
 	}
 	return fields
 }
@@ -3495,42 +3644,26 @@ func (m *WorkflowInstanceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *WorkflowInstanceMutation) Field(name string) (ent.Value, bool) {
+func (m *NamespaceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case workflowinstance.FieldInstanceID:
-		return m.InstanceID()
-	case workflowinstance.FieldInvokedBy:
-		return m.InvokedBy()
-	case workflowinstance.FieldStatus:
-		return m.Status()
-	case workflowinstance.FieldRevision:
-		return m.Revision()
-	case workflowinstance.FieldBeginTime:
-		return m.BeginTime()
-	case workflowinstance.FieldEndTime:
-		return m.EndTime()
-	case workflowinstance.FieldFlow:
-		return m.Flow()
-	case workflowinstance.FieldInput:
-		return m.Input()
-	case workflowinstance.FieldOutput:
-		return m.Output()
-	case workflowinstance.FieldStateData:
-		return m.StateData()
-	case workflowinstance.FieldMemory:
-		return m.Memory()
-	case workflowinstance.FieldDeadline:
-		return m.Deadline()
-	case workflowinstance.FieldAttempts:
-		return m.Attempts()
-	case workflowinstance.FieldErrorCode:
-		return m.ErrorCode()
-	case workflowinstance.FieldErrorMessage:
-		return m.ErrorMessage()
-	case workflowinstance.FieldStateBeginTime:
-		return m.StateBeginTime()
-	case workflowinstance.FieldController:
-		return m.Controller()
+	case namespace.FieldCreated:
+		return m.Created()
+	case namespace.FieldHold:
+		return m.Hold()
+	case namespace.FieldRegion:
+		return m.Region()
+	case namespace.FieldEventConfig:
+		return m.EventConfig()
+	case namespace.FieldCronSuspension:
+		return m.CronSuspension()
+	case namespace.FieldConstants:
+		return m.Constants()
+	case namespace.FieldProfiles:
+		return m.Profiles()
+	case namespace.FieldProfile:
+		return m.Profile()
+	case namespace.FieldRetryPolicies:
+		return m.RetryPolicies()
 	}
 	return nil, false
 }
@@ -3538,183 +3671,9987 @@ func (m *WorkflowInstanceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *WorkflowInstanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NamespaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case workflowinstance.FieldInstanceID:
-		return m.OldInstanceID(ctx)
-	case workflowinstance.FieldInvokedBy:
-		return m.OldInvokedBy(ctx)
-	case workflowinstance.FieldStatus:
-		return m.OldStatus(ctx)
-	case workflowinstance.FieldRevision:
-		return m.OldRevision(ctx)
-	case workflowinstance.FieldBeginTime:
-		return m.OldBeginTime(ctx)
-	case workflowinstance.FieldEndTime:
-		return m.OldEndTime(ctx)
-	case workflowinstance.FieldFlow:
-		return m.OldFlow(ctx)
-	case workflowinstance.FieldInput:
-		return m.OldInput(ctx)
-	case workflowinstance.FieldOutput:
-		return m.OldOutput(ctx)
-	case workflowinstance.FieldStateData:
-		return m.OldStateData(ctx)
-	case workflowinstance.FieldMemory:
-		return m.OldMemory(ctx)
-	case workflowinstance.FieldDeadline:
-		return m.OldDeadline(ctx)
-	case workflowinstance.FieldAttempts:
-		return m.OldAttempts(ctx)
-	case workflowinstance.FieldErrorCode:
-		return m.OldErrorCode(ctx)
-	case workflowinstance.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case workflowinstance.FieldStateBeginTime:
-		return m.OldStateBeginTime(ctx)
-	case workflowinstance.FieldController:
-		return m.OldController(ctx)
+	case namespace.FieldCreated:
+		return m.OldCreated(ctx)
+	case namespace.FieldHold:
+		return m.OldHold(ctx)
+	case namespace.FieldRegion:
+		return m.OldRegion(ctx)
+	case namespace.FieldEventConfig:
+		return m.OldEventConfig(ctx)
+	case namespace.FieldCronSuspension:
+		return m.OldCronSuspension(ctx)
+	case namespace.FieldConstants:
+		return m.OldConstants(ctx)
+	case namespace.FieldProfiles:
+		return m.OldProfiles(ctx)
+	case namespace.FieldProfile:
+		return m.OldProfile(ctx)
+	case namespace.FieldRetryPolicies:
+		return m.OldRetryPolicies(ctx)
 	}
-	return nil, fmt.Errorf("unknown WorkflowInstance field %s", name)
+	return nil, fmt.Errorf("unknown Namespace field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WorkflowInstanceMutation) SetField(name string, value ent.Value) error {
+func (m *NamespaceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case workflowinstance.FieldInstanceID:
-		v, ok := value.(string)
+	case namespace.FieldCreated:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInstanceID(v)
+		m.SetCreated(v)
 		return nil
-	case workflowinstance.FieldInvokedBy:
+	case namespace.FieldHold:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInvokedBy(v)
+		m.SetHold(v)
 		return nil
-	case workflowinstance.FieldStatus:
+	case namespace.FieldRegion:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetRegion(v)
 		return nil
-	case workflowinstance.FieldRevision:
-		v, ok := value.(int)
+	case namespace.FieldEventConfig:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRevision(v)
+		m.SetEventConfig(v)
 		return nil
-	case workflowinstance.FieldBeginTime:
-		v, ok := value.(time.Time)
+	case namespace.FieldCronSuspension:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBeginTime(v)
+		m.SetCronSuspension(v)
 		return nil
-	case workflowinstance.FieldEndTime:
-		v, ok := value.(time.Time)
+	case namespace.FieldConstants:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndTime(v)
+		m.SetConstants(v)
 		return nil
-	case workflowinstance.FieldFlow:
-		v, ok := value.([]string)
+	case namespace.FieldProfiles:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFlow(v)
+		m.SetProfiles(v)
 		return nil
-	case workflowinstance.FieldInput:
+	case namespace.FieldProfile:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInput(v)
+		m.SetProfile(v)
 		return nil
-	case workflowinstance.FieldOutput:
-		v, ok := value.(string)
+	case namespace.FieldRetryPolicies:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOutput(v)
+		m.SetRetryPolicies(v)
 		return nil
-	case workflowinstance.FieldStateData:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStateData(v)
+	}
+	return fmt.Errorf("unknown Namespace field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NamespaceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NamespaceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NamespaceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Namespace numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NamespaceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(namespace.FieldHold) {
+		fields = append(fields, namespace.FieldHold)
+	}
+	if m.FieldCleared(namespace.FieldRegion) {
+		fields = append(fields, namespace.FieldRegion)
+	}
+	if m.FieldCleared(namespace.FieldEventConfig) {
+		fields = append(fields, namespace.FieldEventConfig)
+	}
+	if m.FieldCleared(namespace.FieldCronSuspension) {
+		fields = append(fields, namespace.FieldCronSuspension)
+	}
+	if m.FieldCleared(namespace.FieldConstants) {
+		fields = append(fields, namespace.FieldConstants)
+	}
+	if m.FieldCleared(namespace.FieldProfiles) {
+		fields = append(fields, namespace.FieldProfiles)
+	}
+	if m.FieldCleared(namespace.FieldProfile) {
+		fields = append(fields, namespace.FieldProfile)
+	}
+	if m.FieldCleared(namespace.FieldRetryPolicies) {
+		fields = append(fields, namespace.FieldRetryPolicies)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NamespaceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NamespaceMutation) ClearField(name string) error {
+	switch name {
+	case namespace.FieldHold:
+		m.ClearHold()
 		return nil
-	case workflowinstance.FieldMemory:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMemory(v)
+	case namespace.FieldRegion:
+		m.ClearRegion()
 		return nil
-	case workflowinstance.FieldDeadline:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeadline(v)
+	case namespace.FieldEventConfig:
+		m.ClearEventConfig()
 		return nil
-	case workflowinstance.FieldAttempts:
+	case namespace.FieldCronSuspension:
+		m.ClearCronSuspension()
+		return nil
+	case namespace.FieldConstants:
+		m.ClearConstants()
+		return nil
+	case namespace.FieldProfiles:
+		m.ClearProfiles()
+		return nil
+	case namespace.FieldProfile:
+		m.ClearProfile()
+		return nil
+	case namespace.FieldRetryPolicies:
+		m.ClearRetryPolicies()
+		return nil
+	}
+	return fmt.Errorf("unknown Namespace nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NamespaceMutation) ResetField(name string) error {
+	switch name {
+	case namespace.FieldCreated:
+		m.ResetCreated()
+		return nil
+	case namespace.FieldHold:
+		m.ResetHold()
+		return nil
+	case namespace.FieldRegion:
+		m.ResetRegion()
+		return nil
+	case namespace.FieldEventConfig:
+		m.ResetEventConfig()
+		return nil
+	case namespace.FieldCronSuspension:
+		m.ResetCronSuspension()
+		return nil
+	case namespace.FieldConstants:
+		m.ResetConstants()
+		return nil
+	case namespace.FieldProfiles:
+		m.ResetProfiles()
+		return nil
+	case namespace.FieldProfile:
+		m.ResetProfile()
+		return nil
+	case namespace.FieldRetryPolicies:
+		m.ResetRetryPolicies()
+		return nil
+	}
+	return fmt.Errorf("unknown Namespace field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NamespaceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.workflows != nil {
+		edges = append(edges, namespace.EdgeWorkflows)
+	}
+	if m.parent != nil {
+		edges = append(edges, namespace.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, namespace.EdgeChildren)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NamespaceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case namespace.EdgeWorkflows:
+		ids := make([]ent.Value, 0, len(m.workflows))
+		for id := range m.workflows {
+			ids = append(ids, id)
+		}
+		return ids
+	case namespace.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case namespace.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NamespaceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedworkflows != nil {
+		edges = append(edges, namespace.EdgeWorkflows)
+	}
+	if m.removedchildren != nil {
+		edges = append(edges, namespace.EdgeChildren)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NamespaceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case namespace.EdgeWorkflows:
+		ids := make([]ent.Value, 0, len(m.removedworkflows))
+		for id := range m.removedworkflows {
+			ids = append(ids, id)
+		}
+		return ids
+	case namespace.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NamespaceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedworkflows {
+		edges = append(edges, namespace.EdgeWorkflows)
+	}
+	if m.clearedparent {
+		edges = append(edges, namespace.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, namespace.EdgeChildren)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NamespaceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case namespace.EdgeWorkflows:
+		return m.clearedworkflows
+	case namespace.EdgeParent:
+		return m.clearedparent
+	case namespace.EdgeChildren:
+		return m.clearedchildren
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NamespaceMutation) ClearEdge(name string) error {
+	switch name {
+	case namespace.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Namespace unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NamespaceMutation) ResetEdge(name string) error {
+	switch name {
+	case namespace.EdgeWorkflows:
+		m.ResetWorkflows()
+		return nil
+	case namespace.EdgeParent:
+		m.ResetParent()
+		return nil
+	case namespace.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	}
+	return fmt.Errorf("unknown Namespace edge %s", name)
+}
+
+// NodeMutation represents an operation that mutates the Node nodes in the graph.
+type NodeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	hostname      *string
+	started       *time.Time
+	heartbeat     *time.Time
+	draining      *bool
+	timers        *int
+	addtimers     *int
+	queued        *int
+	addqueued     *int
+	promoted      *bool
+	timer_stats   *[]byte
+	lock_stats    *[]byte
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Node, error)
+	predicates    []predicate.Node
+}
+
+var _ ent.Mutation = (*NodeMutation)(nil)
+
+// nodeOption allows management of the mutation configuration using functional options.
+type nodeOption func(*NodeMutation)
+
+// newNodeMutation creates new mutation for the Node entity.
+func newNodeMutation(c config, op Op, opts ...nodeOption) *NodeMutation {
+	m := &NodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNodeID sets the ID field of the mutation.
+func withNodeID(id int) nodeOption {
+	return func(m *NodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Node
+		)
+		m.oldValue = func(ctx context.Context) (*Node, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Node.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNode sets the old Node of the mutation.
+func withNode(node *Node) nodeOption {
+	return func(m *NodeMutation) {
+		m.oldValue = func(context.Context) (*Node, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *NodeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetHostname sets the "hostname" field.
+func (m *NodeMutation) SetHostname(s string) {
+	m.hostname = &s
+}
+
+// Hostname returns the value of the "hostname" field in the mutation.
+func (m *NodeMutation) Hostname() (r string, exists bool) {
+	v := m.hostname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostname returns the old "hostname" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldHostname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldHostname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldHostname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostname: %w", err)
+	}
+	return oldValue.Hostname, nil
+}
+
+// ResetHostname resets all changes to the "hostname" field.
+func (m *NodeMutation) ResetHostname() {
+	m.hostname = nil
+}
+
+// SetStarted sets the "started" field.
+func (m *NodeMutation) SetStarted(t time.Time) {
+	m.started = &t
+}
+
+// Started returns the value of the "started" field in the mutation.
+func (m *NodeMutation) Started() (r time.Time, exists bool) {
+	v := m.started
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStarted returns the old "started" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldStarted(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStarted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStarted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStarted: %w", err)
+	}
+	return oldValue.Started, nil
+}
+
+// ResetStarted resets all changes to the "started" field.
+func (m *NodeMutation) ResetStarted() {
+	m.started = nil
+}
+
+// SetHeartbeat sets the "heartbeat" field.
+func (m *NodeMutation) SetHeartbeat(t time.Time) {
+	m.heartbeat = &t
+}
+
+// Heartbeat returns the value of the "heartbeat" field in the mutation.
+func (m *NodeMutation) Heartbeat() (r time.Time, exists bool) {
+	v := m.heartbeat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeartbeat returns the old "heartbeat" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldHeartbeat(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldHeartbeat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldHeartbeat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeartbeat: %w", err)
+	}
+	return oldValue.Heartbeat, nil
+}
+
+// ResetHeartbeat resets all changes to the "heartbeat" field.
+func (m *NodeMutation) ResetHeartbeat() {
+	m.heartbeat = nil
+}
+
+// SetDraining sets the "draining" field.
+func (m *NodeMutation) SetDraining(b bool) {
+	m.draining = &b
+}
+
+// Draining returns the value of the "draining" field in the mutation.
+func (m *NodeMutation) Draining() (r bool, exists bool) {
+	v := m.draining
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDraining returns the old "draining" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldDraining(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDraining is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDraining requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDraining: %w", err)
+	}
+	return oldValue.Draining, nil
+}
+
+// ResetDraining resets all changes to the "draining" field.
+func (m *NodeMutation) ResetDraining() {
+	m.draining = nil
+}
+
+// SetTimers sets the "timers" field.
+func (m *NodeMutation) SetTimers(i int) {
+	m.timers = &i
+	m.addtimers = nil
+}
+
+// Timers returns the value of the "timers" field in the mutation.
+func (m *NodeMutation) Timers() (r int, exists bool) {
+	v := m.timers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimers returns the old "timers" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldTimers(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTimers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTimers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimers: %w", err)
+	}
+	return oldValue.Timers, nil
+}
+
+// AddTimers adds i to the "timers" field.
+func (m *NodeMutation) AddTimers(i int) {
+	if m.addtimers != nil {
+		*m.addtimers += i
+	} else {
+		m.addtimers = &i
+	}
+}
+
+// AddedTimers returns the value that was added to the "timers" field in this mutation.
+func (m *NodeMutation) AddedTimers() (r int, exists bool) {
+	v := m.addtimers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimers resets all changes to the "timers" field.
+func (m *NodeMutation) ResetTimers() {
+	m.timers = nil
+	m.addtimers = nil
+}
+
+// SetQueued sets the "queued" field.
+func (m *NodeMutation) SetQueued(i int) {
+	m.queued = &i
+	m.addqueued = nil
+}
+
+// Queued returns the value of the "queued" field in the mutation.
+func (m *NodeMutation) Queued() (r int, exists bool) {
+	v := m.queued
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQueued returns the old "queued" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldQueued(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldQueued is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldQueued requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQueued: %w", err)
+	}
+	return oldValue.Queued, nil
+}
+
+// AddQueued adds i to the "queued" field.
+func (m *NodeMutation) AddQueued(i int) {
+	if m.addqueued != nil {
+		*m.addqueued += i
+	} else {
+		m.addqueued = &i
+	}
+}
+
+// AddedQueued returns the value that was added to the "queued" field in this mutation.
+func (m *NodeMutation) AddedQueued() (r int, exists bool) {
+	v := m.addqueued
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQueued resets all changes to the "queued" field.
+func (m *NodeMutation) ResetQueued() {
+	m.queued = nil
+	m.addqueued = nil
+}
+
+// SetPromoted sets the "promoted" field.
+func (m *NodeMutation) SetPromoted(b bool) {
+	m.promoted = &b
+}
+
+// Promoted returns the value of the "promoted" field in the mutation.
+func (m *NodeMutation) Promoted() (r bool, exists bool) {
+	v := m.promoted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromoted returns the old "promoted" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldPromoted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPromoted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPromoted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromoted: %w", err)
+	}
+	return oldValue.Promoted, nil
+}
+
+// ResetPromoted resets all changes to the "promoted" field.
+func (m *NodeMutation) ResetPromoted() {
+	m.promoted = nil
+}
+
+// SetTimerStats sets the "timer_stats" field.
+func (m *NodeMutation) SetTimerStats(b []byte) {
+	m.timer_stats = &b
+}
+
+// TimerStats returns the value of the "timer_stats" field in the mutation.
+func (m *NodeMutation) TimerStats() (r []byte, exists bool) {
+	v := m.timer_stats
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimerStats returns the old "timer_stats" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldTimerStats(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTimerStats is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTimerStats requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimerStats: %w", err)
+	}
+	return oldValue.TimerStats, nil
+}
+
+// ClearTimerStats clears the value of the "timer_stats" field.
+func (m *NodeMutation) ClearTimerStats() {
+	m.timer_stats = nil
+	m.clearedFields[node.FieldTimerStats] = struct{}{}
+}
+
+// TimerStatsCleared returns if the "timer_stats" field was cleared in this mutation.
+func (m *NodeMutation) TimerStatsCleared() bool {
+	_, ok := m.clearedFields[node.FieldTimerStats]
+	return ok
+}
+
+// ResetTimerStats resets all changes to the "timer_stats" field.
+func (m *NodeMutation) ResetTimerStats() {
+	m.timer_stats = nil
+	delete(m.clearedFields, node.FieldTimerStats)
+}
+
+// SetLockStats sets the "lock_stats" field.
+func (m *NodeMutation) SetLockStats(b []byte) {
+	m.lock_stats = &b
+}
+
+// LockStats returns the value of the "lock_stats" field in the mutation.
+func (m *NodeMutation) LockStats() (r []byte, exists bool) {
+	v := m.lock_stats
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockStats returns the old "lock_stats" field's value of the Node entity.
+// If the Node object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NodeMutation) OldLockStats(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldLockStats is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldLockStats requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockStats: %w", err)
+	}
+	return oldValue.LockStats, nil
+}
+
+// ClearLockStats clears the value of the "lock_stats" field.
+func (m *NodeMutation) ClearLockStats() {
+	m.lock_stats = nil
+	m.clearedFields[node.FieldLockStats] = struct{}{}
+}
+
+// LockStatsCleared returns if the "lock_stats" field was cleared in this mutation.
+func (m *NodeMutation) LockStatsCleared() bool {
+	_, ok := m.clearedFields[node.FieldLockStats]
+	return ok
+}
+
+// ResetLockStats resets all changes to the "lock_stats" field.
+func (m *NodeMutation) ResetLockStats() {
+	m.lock_stats = nil
+	delete(m.clearedFields, node.FieldLockStats)
+}
+
+// Op returns the operation name.
+func (m *NodeMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Node).
+func (m *NodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NodeMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.hostname != nil {
+		fields = append(fields, node.FieldHostname)
+	}
+	if m.started != nil {
+		fields = append(fields, node.FieldStarted)
+	}
+	if m.heartbeat != nil {
+		fields = append(fields, node.FieldHeartbeat)
+	}
+	if m.draining != nil {
+		fields = append(fields, node.FieldDraining)
+	}
+	if m.timers != nil {
+		fields = append(fields, node.FieldTimers)
+	}
+	if m.queued != nil {
+		fields = append(fields, node.FieldQueued)
+	}
+	if m.promoted != nil {
+		fields = append(fields, node.FieldPromoted)
+	}
+	if m.timer_stats != nil {
+		fields = append(fields, node.FieldTimerStats)
+	}
+	if m.lock_stats != nil {
+		fields = append(fields, node.FieldLockStats)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case node.FieldHostname:
+		return m.Hostname()
+	case node.FieldStarted:
+		return m.Started()
+	case node.FieldHeartbeat:
+		return m.Heartbeat()
+	case node.FieldDraining:
+		return m.Draining()
+	case node.FieldTimers:
+		return m.Timers()
+	case node.FieldQueued:
+		return m.Queued()
+	case node.FieldPromoted:
+		return m.Promoted()
+	case node.FieldTimerStats:
+		return m.TimerStats()
+	case node.FieldLockStats:
+		return m.LockStats()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case node.FieldHostname:
+		return m.OldHostname(ctx)
+	case node.FieldStarted:
+		return m.OldStarted(ctx)
+	case node.FieldHeartbeat:
+		return m.OldHeartbeat(ctx)
+	case node.FieldDraining:
+		return m.OldDraining(ctx)
+	case node.FieldTimers:
+		return m.OldTimers(ctx)
+	case node.FieldQueued:
+		return m.OldQueued(ctx)
+	case node.FieldPromoted:
+		return m.OldPromoted(ctx)
+	case node.FieldTimerStats:
+		return m.OldTimerStats(ctx)
+	case node.FieldLockStats:
+		return m.OldLockStats(ctx)
+	}
+	return nil, fmt.Errorf("unknown Node field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case node.FieldHostname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostname(v)
+		return nil
+	case node.FieldStarted:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStarted(v)
+		return nil
+	case node.FieldHeartbeat:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeartbeat(v)
+		return nil
+	case node.FieldDraining:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDraining(v)
+		return nil
+	case node.FieldTimers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimers(v)
+		return nil
+	case node.FieldQueued:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQueued(v)
+		return nil
+	case node.FieldPromoted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromoted(v)
+		return nil
+	case node.FieldTimerStats:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimerStats(v)
+		return nil
+	case node.FieldLockStats:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockStats(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Node field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NodeMutation) AddedFields() []string {
+	var fields []string
+	if m.addtimers != nil {
+		fields = append(fields, node.FieldTimers)
+	}
+	if m.addqueued != nil {
+		fields = append(fields, node.FieldQueued)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NodeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case node.FieldTimers:
+		return m.AddedTimers()
+	case node.FieldQueued:
+		return m.AddedQueued()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case node.FieldTimers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimers(v)
+		return nil
+	case node.FieldQueued:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQueued(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Node numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NodeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(node.FieldTimerStats) {
+		fields = append(fields, node.FieldTimerStats)
+	}
+	if m.FieldCleared(node.FieldLockStats) {
+		fields = append(fields, node.FieldLockStats)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NodeMutation) ClearField(name string) error {
+	switch name {
+	case node.FieldTimerStats:
+		m.ClearTimerStats()
+		return nil
+	case node.FieldLockStats:
+		m.ClearLockStats()
+		return nil
+	}
+	return fmt.Errorf("unknown Node nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NodeMutation) ResetField(name string) error {
+	switch name {
+	case node.FieldHostname:
+		m.ResetHostname()
+		return nil
+	case node.FieldStarted:
+		m.ResetStarted()
+		return nil
+	case node.FieldHeartbeat:
+		m.ResetHeartbeat()
+		return nil
+	case node.FieldDraining:
+		m.ResetDraining()
+		return nil
+	case node.FieldTimers:
+		m.ResetTimers()
+		return nil
+	case node.FieldQueued:
+		m.ResetQueued()
+		return nil
+	case node.FieldPromoted:
+		m.ResetPromoted()
+		return nil
+	case node.FieldTimerStats:
+		m.ResetTimerStats()
+		return nil
+	case node.FieldLockStats:
+		m.ResetLockStats()
+		return nil
+	}
+	return fmt.Errorf("unknown Node field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NodeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NodeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NodeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NodeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Node unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NodeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Node edge %s", name)
+}
+
+// QueuedInvocationMutation represents an operation that mutates the QueuedInvocation nodes in the graph.
+type QueuedInvocationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	instance_id   *string
+	workflow      *uuid.UUID
+	source        *string
+	params        *[]byte
+	created       *time.Time
+	expires       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*QueuedInvocation, error)
+	predicates    []predicate.QueuedInvocation
+}
+
+var _ ent.Mutation = (*QueuedInvocationMutation)(nil)
+
+// queuedinvocationOption allows management of the mutation configuration using functional options.
+type queuedinvocationOption func(*QueuedInvocationMutation)
+
+// newQueuedInvocationMutation creates new mutation for the QueuedInvocation entity.
+func newQueuedInvocationMutation(c config, op Op, opts ...queuedinvocationOption) *QueuedInvocationMutation {
+	m := &QueuedInvocationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQueuedInvocation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQueuedInvocationID sets the ID field of the mutation.
+func withQueuedInvocationID(id int) queuedinvocationOption {
+	return func(m *QueuedInvocationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QueuedInvocation
+		)
+		m.oldValue = func(ctx context.Context) (*QueuedInvocation, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QueuedInvocation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQueuedInvocation sets the old QueuedInvocation of the mutation.
+func withQueuedInvocation(node *QueuedInvocation) queuedinvocationOption {
+	return func(m *QueuedInvocationMutation) {
+		m.oldValue = func(context.Context) (*QueuedInvocation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QueuedInvocationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QueuedInvocationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *QueuedInvocationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (m *QueuedInvocationMutation) SetInstanceID(s string) {
+	m.instance_id = &s
+}
+
+// InstanceID returns the value of the "instance_id" field in the mutation.
+func (m *QueuedInvocationMutation) InstanceID() (r string, exists bool) {
+	v := m.instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstanceID returns the old "instance_id" field's value of the QueuedInvocation entity.
+// If the QueuedInvocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueuedInvocationMutation) OldInstanceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldInstanceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstanceID: %w", err)
+	}
+	return oldValue.InstanceID, nil
+}
+
+// ResetInstanceID resets all changes to the "instance_id" field.
+func (m *QueuedInvocationMutation) ResetInstanceID() {
+	m.instance_id = nil
+}
+
+// SetWorkflow sets the "workflow" field.
+func (m *QueuedInvocationMutation) SetWorkflow(u uuid.UUID) {
+	m.workflow = &u
+}
+
+// Workflow returns the value of the "workflow" field in the mutation.
+func (m *QueuedInvocationMutation) Workflow() (r uuid.UUID, exists bool) {
+	v := m.workflow
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkflow returns the old "workflow" field's value of the QueuedInvocation entity.
+// If the QueuedInvocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueuedInvocationMutation) OldWorkflow(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldWorkflow is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldWorkflow requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkflow: %w", err)
+	}
+	return oldValue.Workflow, nil
+}
+
+// ResetWorkflow resets all changes to the "workflow" field.
+func (m *QueuedInvocationMutation) ResetWorkflow() {
+	m.workflow = nil
+}
+
+// SetSource sets the "source" field.
+func (m *QueuedInvocationMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *QueuedInvocationMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the QueuedInvocation entity.
+// If the QueuedInvocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueuedInvocationMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *QueuedInvocationMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetParams sets the "params" field.
+func (m *QueuedInvocationMutation) SetParams(b []byte) {
+	m.params = &b
+}
+
+// Params returns the value of the "params" field in the mutation.
+func (m *QueuedInvocationMutation) Params() (r []byte, exists bool) {
+	v := m.params
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParams returns the old "params" field's value of the QueuedInvocation entity.
+// If the QueuedInvocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueuedInvocationMutation) OldParams(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldParams is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldParams requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParams: %w", err)
+	}
+	return oldValue.Params, nil
+}
+
+// ResetParams resets all changes to the "params" field.
+func (m *QueuedInvocationMutation) ResetParams() {
+	m.params = nil
+}
+
+// SetCreated sets the "created" field.
+func (m *QueuedInvocationMutation) SetCreated(t time.Time) {
+	m.created = &t
+}
+
+// Created returns the value of the "created" field in the mutation.
+func (m *QueuedInvocationMutation) Created() (r time.Time, exists bool) {
+	v := m.created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreated returns the old "created" field's value of the QueuedInvocation entity.
+// If the QueuedInvocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueuedInvocationMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	}
+	return oldValue.Created, nil
+}
+
+// ResetCreated resets all changes to the "created" field.
+func (m *QueuedInvocationMutation) ResetCreated() {
+	m.created = nil
+}
+
+// SetExpires sets the "expires" field.
+func (m *QueuedInvocationMutation) SetExpires(t time.Time) {
+	m.expires = &t
+}
+
+// Expires returns the value of the "expires" field in the mutation.
+func (m *QueuedInvocationMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpires returns the old "expires" field's value of the QueuedInvocation entity.
+// If the QueuedInvocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueuedInvocationMutation) OldExpires(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldExpires is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldExpires requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+	}
+	return oldValue.Expires, nil
+}
+
+// ResetExpires resets all changes to the "expires" field.
+func (m *QueuedInvocationMutation) ResetExpires() {
+	m.expires = nil
+}
+
+// Op returns the operation name.
+func (m *QueuedInvocationMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (QueuedInvocation).
+func (m *QueuedInvocationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QueuedInvocationMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.instance_id != nil {
+		fields = append(fields, queuedinvocation.FieldInstanceID)
+	}
+	if m.workflow != nil {
+		fields = append(fields, queuedinvocation.FieldWorkflow)
+	}
+	if m.source != nil {
+		fields = append(fields, queuedinvocation.FieldSource)
+	}
+	if m.params != nil {
+		fields = append(fields, queuedinvocation.FieldParams)
+	}
+	if m.created != nil {
+		fields = append(fields, queuedinvocation.FieldCreated)
+	}
+	if m.expires != nil {
+		fields = append(fields, queuedinvocation.FieldExpires)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QueuedInvocationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case queuedinvocation.FieldInstanceID:
+		return m.InstanceID()
+	case queuedinvocation.FieldWorkflow:
+		return m.Workflow()
+	case queuedinvocation.FieldSource:
+		return m.Source()
+	case queuedinvocation.FieldParams:
+		return m.Params()
+	case queuedinvocation.FieldCreated:
+		return m.Created()
+	case queuedinvocation.FieldExpires:
+		return m.Expires()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QueuedInvocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case queuedinvocation.FieldInstanceID:
+		return m.OldInstanceID(ctx)
+	case queuedinvocation.FieldWorkflow:
+		return m.OldWorkflow(ctx)
+	case queuedinvocation.FieldSource:
+		return m.OldSource(ctx)
+	case queuedinvocation.FieldParams:
+		return m.OldParams(ctx)
+	case queuedinvocation.FieldCreated:
+		return m.OldCreated(ctx)
+	case queuedinvocation.FieldExpires:
+		return m.OldExpires(ctx)
+	}
+	return nil, fmt.Errorf("unknown QueuedInvocation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QueuedInvocationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case queuedinvocation.FieldInstanceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstanceID(v)
+		return nil
+	case queuedinvocation.FieldWorkflow:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkflow(v)
+		return nil
+	case queuedinvocation.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case queuedinvocation.FieldParams:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParams(v)
+		return nil
+	case queuedinvocation.FieldCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreated(v)
+		return nil
+	case queuedinvocation.FieldExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpires(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QueuedInvocation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QueuedInvocationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QueuedInvocationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QueuedInvocationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown QueuedInvocation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QueuedInvocationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QueuedInvocationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QueuedInvocationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown QueuedInvocation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QueuedInvocationMutation) ResetField(name string) error {
+	switch name {
+	case queuedinvocation.FieldInstanceID:
+		m.ResetInstanceID()
+		return nil
+	case queuedinvocation.FieldWorkflow:
+		m.ResetWorkflow()
+		return nil
+	case queuedinvocation.FieldSource:
+		m.ResetSource()
+		return nil
+	case queuedinvocation.FieldParams:
+		m.ResetParams()
+		return nil
+	case queuedinvocation.FieldCreated:
+		m.ResetCreated()
+		return nil
+	case queuedinvocation.FieldExpires:
+		m.ResetExpires()
+		return nil
+	}
+	return fmt.Errorf("unknown QueuedInvocation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QueuedInvocationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QueuedInvocationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QueuedInvocationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QueuedInvocationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QueuedInvocationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QueuedInvocationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QueuedInvocationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown QueuedInvocation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QueuedInvocationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown QueuedInvocation edge %s", name)
+}
+
+// QueuedStateMutation represents an operation that mutates the QueuedState nodes in the graph.
+type QueuedStateMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	hostname      *string
+	instance_id   *string
+	message       *[]byte
+	size          *int
+	addsize       *int
+	created       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*QueuedState, error)
+	predicates    []predicate.QueuedState
+}
+
+var _ ent.Mutation = (*QueuedStateMutation)(nil)
+
+// queuedstateOption allows management of the mutation configuration using functional options.
+type queuedstateOption func(*QueuedStateMutation)
+
+// newQueuedStateMutation creates new mutation for the QueuedState entity.
+func newQueuedStateMutation(c config, op Op, opts ...queuedstateOption) *QueuedStateMutation {
+	m := &QueuedStateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQueuedState,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQueuedStateID sets the ID field of the mutation.
+func withQueuedStateID(id int) queuedstateOption {
+	return func(m *QueuedStateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QueuedState
+		)
+		m.oldValue = func(ctx context.Context) (*QueuedState, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QueuedState.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQueuedState sets the old QueuedState of the mutation.
+func withQueuedState(node *QueuedState) queuedstateOption {
+	return func(m *QueuedStateMutation) {
+		m.oldValue = func(context.Context) (*QueuedState, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QueuedStateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QueuedStateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *QueuedStateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetHostname sets the "hostname" field.
+func (m *QueuedStateMutation) SetHostname(s string) {
+	m.hostname = &s
+}
+
+// Hostname returns the value of the "hostname" field in the mutation.
+func (m *QueuedStateMutation) Hostname() (r string, exists bool) {
+	v := m.hostname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostname returns the old "hostname" field's value of the QueuedState entity.
+// If the QueuedState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueuedStateMutation) OldHostname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldHostname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldHostname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostname: %w", err)
+	}
+	return oldValue.Hostname, nil
+}
+
+// ResetHostname resets all changes to the "hostname" field.
+func (m *QueuedStateMutation) ResetHostname() {
+	m.hostname = nil
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (m *QueuedStateMutation) SetInstanceID(s string) {
+	m.instance_id = &s
+}
+
+// InstanceID returns the value of the "instance_id" field in the mutation.
+func (m *QueuedStateMutation) InstanceID() (r string, exists bool) {
+	v := m.instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstanceID returns the old "instance_id" field's value of the QueuedState entity.
+// If the QueuedState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueuedStateMutation) OldInstanceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldInstanceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstanceID: %w", err)
+	}
+	return oldValue.InstanceID, nil
+}
+
+// ResetInstanceID resets all changes to the "instance_id" field.
+func (m *QueuedStateMutation) ResetInstanceID() {
+	m.instance_id = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *QueuedStateMutation) SetMessage(b []byte) {
+	m.message = &b
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *QueuedStateMutation) Message() (r []byte, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the QueuedState entity.
+// If the QueuedState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueuedStateMutation) OldMessage(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *QueuedStateMutation) ResetMessage() {
+	m.message = nil
+}
+
+// SetSize sets the "size" field.
+func (m *QueuedStateMutation) SetSize(i int) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *QueuedStateMutation) Size() (r int, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the QueuedState entity.
+// If the QueuedState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueuedStateMutation) OldSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *QueuedStateMutation) AddSize(i int) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *QueuedStateMutation) AddedSize() (r int, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *QueuedStateMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetCreated sets the "created" field.
+func (m *QueuedStateMutation) SetCreated(t time.Time) {
+	m.created = &t
+}
+
+// Created returns the value of the "created" field in the mutation.
+func (m *QueuedStateMutation) Created() (r time.Time, exists bool) {
+	v := m.created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreated returns the old "created" field's value of the QueuedState entity.
+// If the QueuedState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueuedStateMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	}
+	return oldValue.Created, nil
+}
+
+// ResetCreated resets all changes to the "created" field.
+func (m *QueuedStateMutation) ResetCreated() {
+	m.created = nil
+}
+
+// Op returns the operation name.
+func (m *QueuedStateMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (QueuedState).
+func (m *QueuedStateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QueuedStateMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.hostname != nil {
+		fields = append(fields, queuedstate.FieldHostname)
+	}
+	if m.instance_id != nil {
+		fields = append(fields, queuedstate.FieldInstanceID)
+	}
+	if m.message != nil {
+		fields = append(fields, queuedstate.FieldMessage)
+	}
+	if m.size != nil {
+		fields = append(fields, queuedstate.FieldSize)
+	}
+	if m.created != nil {
+		fields = append(fields, queuedstate.FieldCreated)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QueuedStateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case queuedstate.FieldHostname:
+		return m.Hostname()
+	case queuedstate.FieldInstanceID:
+		return m.InstanceID()
+	case queuedstate.FieldMessage:
+		return m.Message()
+	case queuedstate.FieldSize:
+		return m.Size()
+	case queuedstate.FieldCreated:
+		return m.Created()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QueuedStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case queuedstate.FieldHostname:
+		return m.OldHostname(ctx)
+	case queuedstate.FieldInstanceID:
+		return m.OldInstanceID(ctx)
+	case queuedstate.FieldMessage:
+		return m.OldMessage(ctx)
+	case queuedstate.FieldSize:
+		return m.OldSize(ctx)
+	case queuedstate.FieldCreated:
+		return m.OldCreated(ctx)
+	}
+	return nil, fmt.Errorf("unknown QueuedState field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QueuedStateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case queuedstate.FieldHostname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostname(v)
+		return nil
+	case queuedstate.FieldInstanceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstanceID(v)
+		return nil
+	case queuedstate.FieldMessage:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case queuedstate.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case queuedstate.FieldCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreated(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QueuedState field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QueuedStateMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, queuedstate.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QueuedStateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case queuedstate.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QueuedStateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case queuedstate.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QueuedState numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QueuedStateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QueuedStateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QueuedStateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown QueuedState nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QueuedStateMutation) ResetField(name string) error {
+	switch name {
+	case queuedstate.FieldHostname:
+		m.ResetHostname()
+		return nil
+	case queuedstate.FieldInstanceID:
+		m.ResetInstanceID()
+		return nil
+	case queuedstate.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case queuedstate.FieldSize:
+		m.ResetSize()
+		return nil
+	case queuedstate.FieldCreated:
+		m.ResetCreated()
+		return nil
+	}
+	return fmt.Errorf("unknown QueuedState field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QueuedStateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QueuedStateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QueuedStateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QueuedStateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QueuedStateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QueuedStateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QueuedStateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown QueuedState unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QueuedStateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown QueuedState edge %s", name)
+}
+
+// ScheduledInvocationMutation represents an operation that mutates the ScheduledInvocation nodes in the graph.
+type ScheduledInvocationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	namespace     *string
+	workflow      *string
+	time          *time.Time
+	input         *[]byte
+	state         *string
+	external_id   *string
+	expires       *time.Time
+	notify        *bool
+	created       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ScheduledInvocation, error)
+	predicates    []predicate.ScheduledInvocation
+}
+
+var _ ent.Mutation = (*ScheduledInvocationMutation)(nil)
+
+// scheduledinvocationOption allows management of the mutation configuration using functional options.
+type scheduledinvocationOption func(*ScheduledInvocationMutation)
+
+// newScheduledInvocationMutation creates new mutation for the ScheduledInvocation entity.
+func newScheduledInvocationMutation(c config, op Op, opts ...scheduledinvocationOption) *ScheduledInvocationMutation {
+	m := &ScheduledInvocationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScheduledInvocation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScheduledInvocationID sets the ID field of the mutation.
+func withScheduledInvocationID(id uuid.UUID) scheduledinvocationOption {
+	return func(m *ScheduledInvocationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ScheduledInvocation
+		)
+		m.oldValue = func(ctx context.Context) (*ScheduledInvocation, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ScheduledInvocation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScheduledInvocation sets the old ScheduledInvocation of the mutation.
+func withScheduledInvocation(node *ScheduledInvocation) scheduledinvocationOption {
+	return func(m *ScheduledInvocationMutation) {
+		m.oldValue = func(context.Context) (*ScheduledInvocation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScheduledInvocationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScheduledInvocationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ScheduledInvocation entities.
+func (m *ScheduledInvocationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *ScheduledInvocationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *ScheduledInvocationMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *ScheduledInvocationMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the ScheduledInvocation entity.
+// If the ScheduledInvocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledInvocationMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *ScheduledInvocationMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetWorkflow sets the "workflow" field.
+func (m *ScheduledInvocationMutation) SetWorkflow(s string) {
+	m.workflow = &s
+}
+
+// Workflow returns the value of the "workflow" field in the mutation.
+func (m *ScheduledInvocationMutation) Workflow() (r string, exists bool) {
+	v := m.workflow
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkflow returns the old "workflow" field's value of the ScheduledInvocation entity.
+// If the ScheduledInvocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledInvocationMutation) OldWorkflow(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldWorkflow is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldWorkflow requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkflow: %w", err)
+	}
+	return oldValue.Workflow, nil
+}
+
+// ResetWorkflow resets all changes to the "workflow" field.
+func (m *ScheduledInvocationMutation) ResetWorkflow() {
+	m.workflow = nil
+}
+
+// SetTime sets the "time" field.
+func (m *ScheduledInvocationMutation) SetTime(t time.Time) {
+	m.time = &t
+}
+
+// Time returns the value of the "time" field in the mutation.
+func (m *ScheduledInvocationMutation) Time() (r time.Time, exists bool) {
+	v := m.time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTime returns the old "time" field's value of the ScheduledInvocation entity.
+// If the ScheduledInvocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledInvocationMutation) OldTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+	}
+	return oldValue.Time, nil
+}
+
+// ResetTime resets all changes to the "time" field.
+func (m *ScheduledInvocationMutation) ResetTime() {
+	m.time = nil
+}
+
+// SetInput sets the "input" field.
+func (m *ScheduledInvocationMutation) SetInput(b []byte) {
+	m.input = &b
+}
+
+// Input returns the value of the "input" field in the mutation.
+func (m *ScheduledInvocationMutation) Input() (r []byte, exists bool) {
+	v := m.input
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInput returns the old "input" field's value of the ScheduledInvocation entity.
+// If the ScheduledInvocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledInvocationMutation) OldInput(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldInput is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldInput requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInput: %w", err)
+	}
+	return oldValue.Input, nil
+}
+
+// ResetInput resets all changes to the "input" field.
+func (m *ScheduledInvocationMutation) ResetInput() {
+	m.input = nil
+}
+
+// SetState sets the "state" field.
+func (m *ScheduledInvocationMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *ScheduledInvocationMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the ScheduledInvocation entity.
+// If the ScheduledInvocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledInvocationMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ClearState clears the value of the "state" field.
+func (m *ScheduledInvocationMutation) ClearState() {
+	m.state = nil
+	m.clearedFields[scheduledinvocation.FieldState] = struct{}{}
+}
+
+// StateCleared returns if the "state" field was cleared in this mutation.
+func (m *ScheduledInvocationMutation) StateCleared() bool {
+	_, ok := m.clearedFields[scheduledinvocation.FieldState]
+	return ok
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *ScheduledInvocationMutation) ResetState() {
+	m.state = nil
+	delete(m.clearedFields, scheduledinvocation.FieldState)
+}
+
+// SetExternalID sets the "external_id" field.
+func (m *ScheduledInvocationMutation) SetExternalID(s string) {
+	m.external_id = &s
+}
+
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *ScheduledInvocationMutation) ExternalID() (r string, exists bool) {
+	v := m.external_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalID returns the old "external_id" field's value of the ScheduledInvocation entity.
+// If the ScheduledInvocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledInvocationMutation) OldExternalID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (m *ScheduledInvocationMutation) ClearExternalID() {
+	m.external_id = nil
+	m.clearedFields[scheduledinvocation.FieldExternalID] = struct{}{}
+}
+
+// ExternalIDCleared returns if the "external_id" field was cleared in this mutation.
+func (m *ScheduledInvocationMutation) ExternalIDCleared() bool {
+	_, ok := m.clearedFields[scheduledinvocation.FieldExternalID]
+	return ok
+}
+
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *ScheduledInvocationMutation) ResetExternalID() {
+	m.external_id = nil
+	delete(m.clearedFields, scheduledinvocation.FieldExternalID)
+}
+
+// SetExpires sets the "expires" field.
+func (m *ScheduledInvocationMutation) SetExpires(t time.Time) {
+	m.expires = &t
+}
+
+// Expires returns the value of the "expires" field in the mutation.
+func (m *ScheduledInvocationMutation) Expires() (r time.Time, exists bool) {
+	v := m.expires
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpires returns the old "expires" field's value of the ScheduledInvocation entity.
+// If the ScheduledInvocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledInvocationMutation) OldExpires(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldExpires is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldExpires requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpires: %w", err)
+	}
+	return oldValue.Expires, nil
+}
+
+// ClearExpires clears the value of the "expires" field.
+func (m *ScheduledInvocationMutation) ClearExpires() {
+	m.expires = nil
+	m.clearedFields[scheduledinvocation.FieldExpires] = struct{}{}
+}
+
+// ExpiresCleared returns if the "expires" field was cleared in this mutation.
+func (m *ScheduledInvocationMutation) ExpiresCleared() bool {
+	_, ok := m.clearedFields[scheduledinvocation.FieldExpires]
+	return ok
+}
+
+// ResetExpires resets all changes to the "expires" field.
+func (m *ScheduledInvocationMutation) ResetExpires() {
+	m.expires = nil
+	delete(m.clearedFields, scheduledinvocation.FieldExpires)
+}
+
+// SetNotify sets the "notify" field.
+func (m *ScheduledInvocationMutation) SetNotify(b bool) {
+	m.notify = &b
+}
+
+// Notify returns the value of the "notify" field in the mutation.
+func (m *ScheduledInvocationMutation) Notify() (r bool, exists bool) {
+	v := m.notify
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotify returns the old "notify" field's value of the ScheduledInvocation entity.
+// If the ScheduledInvocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledInvocationMutation) OldNotify(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldNotify is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldNotify requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotify: %w", err)
+	}
+	return oldValue.Notify, nil
+}
+
+// ResetNotify resets all changes to the "notify" field.
+func (m *ScheduledInvocationMutation) ResetNotify() {
+	m.notify = nil
+}
+
+// SetCreated sets the "created" field.
+func (m *ScheduledInvocationMutation) SetCreated(t time.Time) {
+	m.created = &t
+}
+
+// Created returns the value of the "created" field in the mutation.
+func (m *ScheduledInvocationMutation) Created() (r time.Time, exists bool) {
+	v := m.created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreated returns the old "created" field's value of the ScheduledInvocation entity.
+// If the ScheduledInvocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledInvocationMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	}
+	return oldValue.Created, nil
+}
+
+// ResetCreated resets all changes to the "created" field.
+func (m *ScheduledInvocationMutation) ResetCreated() {
+	m.created = nil
+}
+
+// Op returns the operation name.
+func (m *ScheduledInvocationMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (ScheduledInvocation).
+func (m *ScheduledInvocationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScheduledInvocationMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.namespace != nil {
+		fields = append(fields, scheduledinvocation.FieldNamespace)
+	}
+	if m.workflow != nil {
+		fields = append(fields, scheduledinvocation.FieldWorkflow)
+	}
+	if m.time != nil {
+		fields = append(fields, scheduledinvocation.FieldTime)
+	}
+	if m.input != nil {
+		fields = append(fields, scheduledinvocation.FieldInput)
+	}
+	if m.state != nil {
+		fields = append(fields, scheduledinvocation.FieldState)
+	}
+	if m.external_id != nil {
+		fields = append(fields, scheduledinvocation.FieldExternalID)
+	}
+	if m.expires != nil {
+		fields = append(fields, scheduledinvocation.FieldExpires)
+	}
+	if m.notify != nil {
+		fields = append(fields, scheduledinvocation.FieldNotify)
+	}
+	if m.created != nil {
+		fields = append(fields, scheduledinvocation.FieldCreated)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScheduledInvocationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case scheduledinvocation.FieldNamespace:
+		return m.Namespace()
+	case scheduledinvocation.FieldWorkflow:
+		return m.Workflow()
+	case scheduledinvocation.FieldTime:
+		return m.Time()
+	case scheduledinvocation.FieldInput:
+		return m.Input()
+	case scheduledinvocation.FieldState:
+		return m.State()
+	case scheduledinvocation.FieldExternalID:
+		return m.ExternalID()
+	case scheduledinvocation.FieldExpires:
+		return m.Expires()
+	case scheduledinvocation.FieldNotify:
+		return m.Notify()
+	case scheduledinvocation.FieldCreated:
+		return m.Created()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScheduledInvocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case scheduledinvocation.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case scheduledinvocation.FieldWorkflow:
+		return m.OldWorkflow(ctx)
+	case scheduledinvocation.FieldTime:
+		return m.OldTime(ctx)
+	case scheduledinvocation.FieldInput:
+		return m.OldInput(ctx)
+	case scheduledinvocation.FieldState:
+		return m.OldState(ctx)
+	case scheduledinvocation.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case scheduledinvocation.FieldExpires:
+		return m.OldExpires(ctx)
+	case scheduledinvocation.FieldNotify:
+		return m.OldNotify(ctx)
+	case scheduledinvocation.FieldCreated:
+		return m.OldCreated(ctx)
+	}
+	return nil, fmt.Errorf("unknown ScheduledInvocation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduledInvocationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case scheduledinvocation.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case scheduledinvocation.FieldWorkflow:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkflow(v)
+		return nil
+	case scheduledinvocation.FieldTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTime(v)
+		return nil
+	case scheduledinvocation.FieldInput:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInput(v)
+		return nil
+	case scheduledinvocation.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case scheduledinvocation.FieldExternalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalID(v)
+		return nil
+	case scheduledinvocation.FieldExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpires(v)
+		return nil
+	case scheduledinvocation.FieldNotify:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotify(v)
+		return nil
+	case scheduledinvocation.FieldCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreated(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledInvocation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScheduledInvocationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScheduledInvocationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduledInvocationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ScheduledInvocation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScheduledInvocationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(scheduledinvocation.FieldState) {
+		fields = append(fields, scheduledinvocation.FieldState)
+	}
+	if m.FieldCleared(scheduledinvocation.FieldExternalID) {
+		fields = append(fields, scheduledinvocation.FieldExternalID)
+	}
+	if m.FieldCleared(scheduledinvocation.FieldExpires) {
+		fields = append(fields, scheduledinvocation.FieldExpires)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScheduledInvocationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScheduledInvocationMutation) ClearField(name string) error {
+	switch name {
+	case scheduledinvocation.FieldState:
+		m.ClearState()
+		return nil
+	case scheduledinvocation.FieldExternalID:
+		m.ClearExternalID()
+		return nil
+	case scheduledinvocation.FieldExpires:
+		m.ClearExpires()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledInvocation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScheduledInvocationMutation) ResetField(name string) error {
+	switch name {
+	case scheduledinvocation.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case scheduledinvocation.FieldWorkflow:
+		m.ResetWorkflow()
+		return nil
+	case scheduledinvocation.FieldTime:
+		m.ResetTime()
+		return nil
+	case scheduledinvocation.FieldInput:
+		m.ResetInput()
+		return nil
+	case scheduledinvocation.FieldState:
+		m.ResetState()
+		return nil
+	case scheduledinvocation.FieldExternalID:
+		m.ResetExternalID()
+		return nil
+	case scheduledinvocation.FieldExpires:
+		m.ResetExpires()
+		return nil
+	case scheduledinvocation.FieldNotify:
+		m.ResetNotify()
+		return nil
+	case scheduledinvocation.FieldCreated:
+		m.ResetCreated()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledInvocation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScheduledInvocationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScheduledInvocationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScheduledInvocationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScheduledInvocationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScheduledInvocationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScheduledInvocationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScheduledInvocationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ScheduledInvocation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScheduledInvocationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ScheduledInvocation edge %s", name)
+}
+
+// SettingMutation represents an operation that mutates the Setting nodes in the graph.
+type SettingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	value         *string
+	updated       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Setting, error)
+	predicates    []predicate.Setting
+}
+
+var _ ent.Mutation = (*SettingMutation)(nil)
+
+// settingOption allows management of the mutation configuration using functional options.
+type settingOption func(*SettingMutation)
+
+// newSettingMutation creates new mutation for the Setting entity.
+func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
+	m := &SettingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSetting,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSettingID sets the ID field of the mutation.
+func withSettingID(id int) settingOption {
+	return func(m *SettingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Setting
+		)
+		m.oldValue = func(ctx context.Context) (*Setting, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Setting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSetting sets the old Setting of the mutation.
+func withSetting(node *Setting) settingOption {
+	return func(m *SettingMutation) {
+		m.oldValue = func(context.Context) (*Setting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *SettingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetKey sets the "key" field.
+func (m *SettingMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *SettingMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *SettingMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetValue sets the "value" field.
+func (m *SettingMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *SettingMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *SettingMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetUpdated sets the "updated" field.
+func (m *SettingMutation) SetUpdated(t time.Time) {
+	m.updated = &t
+}
+
+// Updated returns the value of the "updated" field in the mutation.
+func (m *SettingMutation) Updated() (r time.Time, exists bool) {
+	v := m.updated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdated returns the old "updated" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingMutation) OldUpdated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdated: %w", err)
+	}
+	return oldValue.Updated, nil
+}
+
+// ResetUpdated resets all changes to the "updated" field.
+func (m *SettingMutation) ResetUpdated() {
+	m.updated = nil
+}
+
+// Op returns the operation name.
+func (m *SettingMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Setting).
+func (m *SettingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SettingMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.key != nil {
+		fields = append(fields, setting.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, setting.FieldValue)
+	}
+	if m.updated != nil {
+		fields = append(fields, setting.FieldUpdated)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SettingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case setting.FieldKey:
+		return m.Key()
+	case setting.FieldValue:
+		return m.Value()
+	case setting.FieldUpdated:
+		return m.Updated()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case setting.FieldKey:
+		return m.OldKey(ctx)
+	case setting.FieldValue:
+		return m.OldValue(ctx)
+	case setting.FieldUpdated:
+		return m.OldUpdated(ctx)
+	}
+	return nil, fmt.Errorf("unknown Setting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case setting.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case setting.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case setting.FieldUpdated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdated(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Setting field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SettingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Setting numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SettingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SettingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SettingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Setting nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SettingMutation) ResetField(name string) error {
+	switch name {
+	case setting.FieldKey:
+		m.ResetKey()
+		return nil
+	case setting.FieldValue:
+		m.ResetValue()
+		return nil
+	case setting.FieldUpdated:
+		m.ResetUpdated()
+		return nil
+	}
+	return fmt.Errorf("unknown Setting field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SettingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SettingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Setting unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Setting edge %s", name)
+}
+
+// TimerMutation represents an operation that mutates the Timer nodes in the graph.
+type TimerMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	function      *string
+	time          *time.Time
+	data          *[]byte
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Timer, error)
+	predicates    []predicate.Timer
+}
+
+var _ ent.Mutation = (*TimerMutation)(nil)
+
+// timerOption allows management of the mutation configuration using functional options.
+type timerOption func(*TimerMutation)
+
+// newTimerMutation creates new mutation for the Timer entity.
+func newTimerMutation(c config, op Op, opts ...timerOption) *TimerMutation {
+	m := &TimerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTimer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTimerID sets the ID field of the mutation.
+func withTimerID(id int) timerOption {
+	return func(m *TimerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Timer
+		)
+		m.oldValue = func(ctx context.Context) (*Timer, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Timer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTimer sets the old Timer of the mutation.
+func withTimer(node *Timer) timerOption {
+	return func(m *TimerMutation) {
+		m.oldValue = func(context.Context) (*Timer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TimerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TimerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *TimerMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetName sets the "name" field.
+func (m *TimerMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TimerMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Timer entity.
+// If the Timer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimerMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TimerMutation) ResetName() {
+	m.name = nil
+}
+
+// SetFunction sets the "function" field.
+func (m *TimerMutation) SetFunction(s string) {
+	m.function = &s
+}
+
+// Function returns the value of the "function" field in the mutation.
+func (m *TimerMutation) Function() (r string, exists bool) {
+	v := m.function
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFunction returns the old "function" field's value of the Timer entity.
+// If the Timer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimerMutation) OldFunction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldFunction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldFunction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFunction: %w", err)
+	}
+	return oldValue.Function, nil
+}
+
+// ResetFunction resets all changes to the "function" field.
+func (m *TimerMutation) ResetFunction() {
+	m.function = nil
+}
+
+// SetTime sets the "time" field.
+func (m *TimerMutation) SetTime(t time.Time) {
+	m.time = &t
+}
+
+// Time returns the value of the "time" field in the mutation.
+func (m *TimerMutation) Time() (r time.Time, exists bool) {
+	v := m.time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTime returns the old "time" field's value of the Timer entity.
+// If the Timer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimerMutation) OldTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+	}
+	return oldValue.Time, nil
+}
+
+// ResetTime resets all changes to the "time" field.
+func (m *TimerMutation) ResetTime() {
+	m.time = nil
+}
+
+// SetData sets the "data" field.
+func (m *TimerMutation) SetData(b []byte) {
+	m.data = &b
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *TimerMutation) Data() (r []byte, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the Timer entity.
+// If the Timer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimerMutation) OldData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ClearData clears the value of the "data" field.
+func (m *TimerMutation) ClearData() {
+	m.data = nil
+	m.clearedFields[timer.FieldData] = struct{}{}
+}
+
+// DataCleared returns if the "data" field was cleared in this mutation.
+func (m *TimerMutation) DataCleared() bool {
+	_, ok := m.clearedFields[timer.FieldData]
+	return ok
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *TimerMutation) ResetData() {
+	m.data = nil
+	delete(m.clearedFields, timer.FieldData)
+}
+
+// Op returns the operation name.
+func (m *TimerMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Timer).
+func (m *TimerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TimerMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, timer.FieldName)
+	}
+	if m.function != nil {
+		fields = append(fields, timer.FieldFunction)
+	}
+	if m.time != nil {
+		fields = append(fields, timer.FieldTime)
+	}
+	if m.data != nil {
+		fields = append(fields, timer.FieldData)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TimerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case timer.FieldName:
+		return m.Name()
+	case timer.FieldFunction:
+		return m.Function()
+	case timer.FieldTime:
+		return m.Time()
+	case timer.FieldData:
+		return m.Data()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TimerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case timer.FieldName:
+		return m.OldName(ctx)
+	case timer.FieldFunction:
+		return m.OldFunction(ctx)
+	case timer.FieldTime:
+		return m.OldTime(ctx)
+	case timer.FieldData:
+		return m.OldData(ctx)
+	}
+	return nil, fmt.Errorf("unknown Timer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TimerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case timer.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case timer.FieldFunction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFunction(v)
+		return nil
+	case timer.FieldTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTime(v)
+		return nil
+	case timer.FieldData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Timer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TimerMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TimerMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TimerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Timer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TimerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(timer.FieldData) {
+		fields = append(fields, timer.FieldData)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TimerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TimerMutation) ClearField(name string) error {
+	switch name {
+	case timer.FieldData:
+		m.ClearData()
+		return nil
+	}
+	return fmt.Errorf("unknown Timer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TimerMutation) ResetField(name string) error {
+	switch name {
+	case timer.FieldName:
+		m.ResetName()
+		return nil
+	case timer.FieldFunction:
+		m.ResetFunction()
+		return nil
+	case timer.FieldTime:
+		m.ResetTime()
+		return nil
+	case timer.FieldData:
+		m.ResetData()
+		return nil
+	}
+	return fmt.Errorf("unknown Timer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TimerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TimerMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TimerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TimerMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TimerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TimerMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TimerMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Timer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TimerMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Timer edge %s", name)
+}
+
+// UnmatchedEventMutation represents an operation that mutates the UnmatchedEvent nodes in the graph.
+type UnmatchedEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	namespace     *string
+	_type         *string
+	source        *string
+	event_id      *string
+	received      *time.Time
+	cloudevent    *[]byte
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*UnmatchedEvent, error)
+	predicates    []predicate.UnmatchedEvent
+}
+
+var _ ent.Mutation = (*UnmatchedEventMutation)(nil)
+
+// unmatchedeventOption allows management of the mutation configuration using functional options.
+type unmatchedeventOption func(*UnmatchedEventMutation)
+
+// newUnmatchedEventMutation creates new mutation for the UnmatchedEvent entity.
+func newUnmatchedEventMutation(c config, op Op, opts ...unmatchedeventOption) *UnmatchedEventMutation {
+	m := &UnmatchedEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUnmatchedEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUnmatchedEventID sets the ID field of the mutation.
+func withUnmatchedEventID(id int) unmatchedeventOption {
+	return func(m *UnmatchedEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UnmatchedEvent
+		)
+		m.oldValue = func(ctx context.Context) (*UnmatchedEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UnmatchedEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUnmatchedEvent sets the old UnmatchedEvent of the mutation.
+func withUnmatchedEvent(node *UnmatchedEvent) unmatchedeventOption {
+	return func(m *UnmatchedEventMutation) {
+		m.oldValue = func(context.Context) (*UnmatchedEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UnmatchedEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UnmatchedEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *UnmatchedEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *UnmatchedEventMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *UnmatchedEventMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the UnmatchedEvent entity.
+// If the UnmatchedEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnmatchedEventMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *UnmatchedEventMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetType sets the "type" field.
+func (m *UnmatchedEventMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *UnmatchedEventMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the UnmatchedEvent entity.
+// If the UnmatchedEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnmatchedEventMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *UnmatchedEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetSource sets the "source" field.
+func (m *UnmatchedEventMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *UnmatchedEventMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the UnmatchedEvent entity.
+// If the UnmatchedEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnmatchedEventMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *UnmatchedEventMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetEventID sets the "event_id" field.
+func (m *UnmatchedEventMutation) SetEventID(s string) {
+	m.event_id = &s
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *UnmatchedEventMutation) EventID() (r string, exists bool) {
+	v := m.event_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the UnmatchedEvent entity.
+// If the UnmatchedEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnmatchedEventMutation) OldEventID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *UnmatchedEventMutation) ResetEventID() {
+	m.event_id = nil
+}
+
+// SetReceived sets the "received" field.
+func (m *UnmatchedEventMutation) SetReceived(t time.Time) {
+	m.received = &t
+}
+
+// Received returns the value of the "received" field in the mutation.
+func (m *UnmatchedEventMutation) Received() (r time.Time, exists bool) {
+	v := m.received
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceived returns the old "received" field's value of the UnmatchedEvent entity.
+// If the UnmatchedEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnmatchedEventMutation) OldReceived(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldReceived is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldReceived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceived: %w", err)
+	}
+	return oldValue.Received, nil
+}
+
+// ResetReceived resets all changes to the "received" field.
+func (m *UnmatchedEventMutation) ResetReceived() {
+	m.received = nil
+}
+
+// SetCloudevent sets the "cloudevent" field.
+func (m *UnmatchedEventMutation) SetCloudevent(b []byte) {
+	m.cloudevent = &b
+}
+
+// Cloudevent returns the value of the "cloudevent" field in the mutation.
+func (m *UnmatchedEventMutation) Cloudevent() (r []byte, exists bool) {
+	v := m.cloudevent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloudevent returns the old "cloudevent" field's value of the UnmatchedEvent entity.
+// If the UnmatchedEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UnmatchedEventMutation) OldCloudevent(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCloudevent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCloudevent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloudevent: %w", err)
+	}
+	return oldValue.Cloudevent, nil
+}
+
+// ResetCloudevent resets all changes to the "cloudevent" field.
+func (m *UnmatchedEventMutation) ResetCloudevent() {
+	m.cloudevent = nil
+}
+
+// Op returns the operation name.
+func (m *UnmatchedEventMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (UnmatchedEvent).
+func (m *UnmatchedEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UnmatchedEventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.namespace != nil {
+		fields = append(fields, unmatchedevent.FieldNamespace)
+	}
+	if m._type != nil {
+		fields = append(fields, unmatchedevent.FieldType)
+	}
+	if m.source != nil {
+		fields = append(fields, unmatchedevent.FieldSource)
+	}
+	if m.event_id != nil {
+		fields = append(fields, unmatchedevent.FieldEventID)
+	}
+	if m.received != nil {
+		fields = append(fields, unmatchedevent.FieldReceived)
+	}
+	if m.cloudevent != nil {
+		fields = append(fields, unmatchedevent.FieldCloudevent)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UnmatchedEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case unmatchedevent.FieldNamespace:
+		return m.Namespace()
+	case unmatchedevent.FieldType:
+		return m.GetType()
+	case unmatchedevent.FieldSource:
+		return m.Source()
+	case unmatchedevent.FieldEventID:
+		return m.EventID()
+	case unmatchedevent.FieldReceived:
+		return m.Received()
+	case unmatchedevent.FieldCloudevent:
+		return m.Cloudevent()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UnmatchedEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case unmatchedevent.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case unmatchedevent.FieldType:
+		return m.OldType(ctx)
+	case unmatchedevent.FieldSource:
+		return m.OldSource(ctx)
+	case unmatchedevent.FieldEventID:
+		return m.OldEventID(ctx)
+	case unmatchedevent.FieldReceived:
+		return m.OldReceived(ctx)
+	case unmatchedevent.FieldCloudevent:
+		return m.OldCloudevent(ctx)
+	}
+	return nil, fmt.Errorf("unknown UnmatchedEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UnmatchedEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case unmatchedevent.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case unmatchedevent.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case unmatchedevent.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case unmatchedevent.FieldEventID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case unmatchedevent.FieldReceived:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceived(v)
+		return nil
+	case unmatchedevent.FieldCloudevent:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudevent(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UnmatchedEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UnmatchedEventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UnmatchedEventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UnmatchedEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown UnmatchedEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UnmatchedEventMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UnmatchedEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UnmatchedEventMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UnmatchedEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UnmatchedEventMutation) ResetField(name string) error {
+	switch name {
+	case unmatchedevent.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case unmatchedevent.FieldType:
+		m.ResetType()
+		return nil
+	case unmatchedevent.FieldSource:
+		m.ResetSource()
+		return nil
+	case unmatchedevent.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case unmatchedevent.FieldReceived:
+		m.ResetReceived()
+		return nil
+	case unmatchedevent.FieldCloudevent:
+		m.ResetCloudevent()
+		return nil
+	}
+	return fmt.Errorf("unknown UnmatchedEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UnmatchedEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UnmatchedEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UnmatchedEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UnmatchedEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UnmatchedEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UnmatchedEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UnmatchedEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UnmatchedEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UnmatchedEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UnmatchedEvent edge %s", name)
+}
+
+// WasmModuleMutation represents an operation that mutates the WasmModule nodes in the graph.
+type WasmModuleMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	namespace     *string
+	name          *string
+	binary        *[]byte
+	hash          *string
+	updated       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*WasmModule, error)
+	predicates    []predicate.WasmModule
+}
+
+var _ ent.Mutation = (*WasmModuleMutation)(nil)
+
+// wasmmoduleOption allows management of the mutation configuration using functional options.
+type wasmmoduleOption func(*WasmModuleMutation)
+
+// newWasmModuleMutation creates new mutation for the WasmModule entity.
+func newWasmModuleMutation(c config, op Op, opts ...wasmmoduleOption) *WasmModuleMutation {
+	m := &WasmModuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWasmModule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWasmModuleID sets the ID field of the mutation.
+func withWasmModuleID(id int) wasmmoduleOption {
+	return func(m *WasmModuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WasmModule
+		)
+		m.oldValue = func(ctx context.Context) (*WasmModule, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WasmModule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWasmModule sets the old WasmModule of the mutation.
+func withWasmModule(node *WasmModule) wasmmoduleOption {
+	return func(m *WasmModuleMutation) {
+		m.oldValue = func(context.Context) (*WasmModule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WasmModuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WasmModuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *WasmModuleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *WasmModuleMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *WasmModuleMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the WasmModule entity.
+// If the WasmModule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WasmModuleMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *WasmModuleMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *WasmModuleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WasmModuleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the WasmModule entity.
+// If the WasmModule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WasmModuleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WasmModuleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetBinary sets the "binary" field.
+func (m *WasmModuleMutation) SetBinary(b []byte) {
+	m.binary = &b
+}
+
+// Binary returns the value of the "binary" field in the mutation.
+func (m *WasmModuleMutation) Binary() (r []byte, exists bool) {
+	v := m.binary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBinary returns the old "binary" field's value of the WasmModule entity.
+// If the WasmModule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WasmModuleMutation) OldBinary(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldBinary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldBinary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBinary: %w", err)
+	}
+	return oldValue.Binary, nil
+}
+
+// ResetBinary resets all changes to the "binary" field.
+func (m *WasmModuleMutation) ResetBinary() {
+	m.binary = nil
+}
+
+// SetHash sets the "hash" field.
+func (m *WasmModuleMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *WasmModuleMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the WasmModule entity.
+// If the WasmModule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WasmModuleMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *WasmModuleMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetUpdated sets the "updated" field.
+func (m *WasmModuleMutation) SetUpdated(t time.Time) {
+	m.updated = &t
+}
+
+// Updated returns the value of the "updated" field in the mutation.
+func (m *WasmModuleMutation) Updated() (r time.Time, exists bool) {
+	v := m.updated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdated returns the old "updated" field's value of the WasmModule entity.
+// If the WasmModule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WasmModuleMutation) OldUpdated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdated: %w", err)
+	}
+	return oldValue.Updated, nil
+}
+
+// ResetUpdated resets all changes to the "updated" field.
+func (m *WasmModuleMutation) ResetUpdated() {
+	m.updated = nil
+}
+
+// Op returns the operation name.
+func (m *WasmModuleMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (WasmModule).
+func (m *WasmModuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WasmModuleMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.namespace != nil {
+		fields = append(fields, wasmmodule.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, wasmmodule.FieldName)
+	}
+	if m.binary != nil {
+		fields = append(fields, wasmmodule.FieldBinary)
+	}
+	if m.hash != nil {
+		fields = append(fields, wasmmodule.FieldHash)
+	}
+	if m.updated != nil {
+		fields = append(fields, wasmmodule.FieldUpdated)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WasmModuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case wasmmodule.FieldNamespace:
+		return m.Namespace()
+	case wasmmodule.FieldName:
+		return m.Name()
+	case wasmmodule.FieldBinary:
+		return m.Binary()
+	case wasmmodule.FieldHash:
+		return m.Hash()
+	case wasmmodule.FieldUpdated:
+		return m.Updated()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WasmModuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case wasmmodule.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case wasmmodule.FieldName:
+		return m.OldName(ctx)
+	case wasmmodule.FieldBinary:
+		return m.OldBinary(ctx)
+	case wasmmodule.FieldHash:
+		return m.OldHash(ctx)
+	case wasmmodule.FieldUpdated:
+		return m.OldUpdated(ctx)
+	}
+	return nil, fmt.Errorf("unknown WasmModule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WasmModuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case wasmmodule.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case wasmmodule.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case wasmmodule.FieldBinary:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBinary(v)
+		return nil
+	case wasmmodule.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case wasmmodule.FieldUpdated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdated(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WasmModule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WasmModuleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WasmModuleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WasmModuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WasmModule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WasmModuleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WasmModuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WasmModuleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WasmModule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WasmModuleMutation) ResetField(name string) error {
+	switch name {
+	case wasmmodule.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case wasmmodule.FieldName:
+		m.ResetName()
+		return nil
+	case wasmmodule.FieldBinary:
+		m.ResetBinary()
+		return nil
+	case wasmmodule.FieldHash:
+		m.ResetHash()
+		return nil
+	case wasmmodule.FieldUpdated:
+		m.ResetUpdated()
+		return nil
+	}
+	return fmt.Errorf("unknown WasmModule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WasmModuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WasmModuleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WasmModuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WasmModuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WasmModuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WasmModuleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WasmModuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WasmModule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WasmModuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WasmModule edge %s", name)
+}
+
+// WorkflowMutation represents an operation that mutates the Workflow nodes in the graph.
+type WorkflowMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	name             *string
+	created          *time.Time
+	description      *string
+	active           *bool
+	revision         *int
+	addrevision      *int
+	workflow         *[]byte
+	logToEvents      *string
+	clearedFields    map[string]struct{}
+	namespace        *string
+	clearednamespace bool
+	instances        map[int]struct{}
+	removedinstances map[int]struct{}
+	clearedinstances bool
+	wfevents         map[int]struct{}
+	removedwfevents  map[int]struct{}
+	clearedwfevents  bool
+	revisions        map[int]struct{}
+	removedrevisions map[int]struct{}
+	clearedrevisions bool
+	done             bool
+	oldValue         func(context.Context) (*Workflow, error)
+	predicates       []predicate.Workflow
+}
+
+var _ ent.Mutation = (*WorkflowMutation)(nil)
+
+// workflowOption allows management of the mutation configuration using functional options.
+type workflowOption func(*WorkflowMutation)
+
+// newWorkflowMutation creates new mutation for the Workflow entity.
+func newWorkflowMutation(c config, op Op, opts ...workflowOption) *WorkflowMutation {
+	m := &WorkflowMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWorkflow,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWorkflowID sets the ID field of the mutation.
+func withWorkflowID(id uuid.UUID) workflowOption {
+	return func(m *WorkflowMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Workflow
+		)
+		m.oldValue = func(ctx context.Context) (*Workflow, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Workflow.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWorkflow sets the old Workflow of the mutation.
+func withWorkflow(node *Workflow) workflowOption {
+	return func(m *WorkflowMutation) {
+		m.oldValue = func(context.Context) (*Workflow, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WorkflowMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WorkflowMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Workflow entities.
+func (m *WorkflowMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *WorkflowMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetName sets the "name" field.
+func (m *WorkflowMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WorkflowMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Workflow entity.
+// If the Workflow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WorkflowMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreated sets the "created" field.
+func (m *WorkflowMutation) SetCreated(t time.Time) {
+	m.created = &t
+}
+
+// Created returns the value of the "created" field in the mutation.
+func (m *WorkflowMutation) Created() (r time.Time, exists bool) {
+	v := m.created
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreated returns the old "created" field's value of the Workflow entity.
+// If the Workflow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowMutation) OldCreated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreated: %w", err)
+	}
+	return oldValue.Created, nil
+}
+
+// ResetCreated resets all changes to the "created" field.
+func (m *WorkflowMutation) ResetCreated() {
+	m.created = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *WorkflowMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *WorkflowMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Workflow entity.
+// If the Workflow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *WorkflowMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[workflow.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *WorkflowMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[workflow.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *WorkflowMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, workflow.FieldDescription)
+}
+
+// SetActive sets the "active" field.
+func (m *WorkflowMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *WorkflowMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the Workflow entity.
+// If the Workflow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *WorkflowMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetRevision sets the "revision" field.
+func (m *WorkflowMutation) SetRevision(i int) {
+	m.revision = &i
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *WorkflowMutation) Revision() (r int, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the Workflow entity.
+// If the Workflow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowMutation) OldRevision(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds i to the "revision" field.
+func (m *WorkflowMutation) AddRevision(i int) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *WorkflowMutation) AddedRevision() (r int, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *WorkflowMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// SetWorkflow sets the "workflow" field.
+func (m *WorkflowMutation) SetWorkflow(b []byte) {
+	m.workflow = &b
+}
+
+// Workflow returns the value of the "workflow" field in the mutation.
+func (m *WorkflowMutation) Workflow() (r []byte, exists bool) {
+	v := m.workflow
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkflow returns the old "workflow" field's value of the Workflow entity.
+// If the Workflow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowMutation) OldWorkflow(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldWorkflow is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldWorkflow requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkflow: %w", err)
+	}
+	return oldValue.Workflow, nil
+}
+
+// ResetWorkflow resets all changes to the "workflow" field.
+func (m *WorkflowMutation) ResetWorkflow() {
+	m.workflow = nil
+}
+
+// SetLogToEvents sets the "logToEvents" field.
+func (m *WorkflowMutation) SetLogToEvents(s string) {
+	m.logToEvents = &s
+}
+
+// LogToEvents returns the value of the "logToEvents" field in the mutation.
+func (m *WorkflowMutation) LogToEvents() (r string, exists bool) {
+	v := m.logToEvents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogToEvents returns the old "logToEvents" field's value of the Workflow entity.
+// If the Workflow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowMutation) OldLogToEvents(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldLogToEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldLogToEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogToEvents: %w", err)
+	}
+	return oldValue.LogToEvents, nil
+}
+
+// ClearLogToEvents clears the value of the "logToEvents" field.
+func (m *WorkflowMutation) ClearLogToEvents() {
+	m.logToEvents = nil
+	m.clearedFields[workflow.FieldLogToEvents] = struct{}{}
+}
+
+// LogToEventsCleared returns if the "logToEvents" field was cleared in this mutation.
+func (m *WorkflowMutation) LogToEventsCleared() bool {
+	_, ok := m.clearedFields[workflow.FieldLogToEvents]
+	return ok
+}
+
+// ResetLogToEvents resets all changes to the "logToEvents" field.
+func (m *WorkflowMutation) ResetLogToEvents() {
+	m.logToEvents = nil
+	delete(m.clearedFields, workflow.FieldLogToEvents)
+}
+
+// SetNamespaceID sets the "namespace" edge to the Namespace entity by id.
+func (m *WorkflowMutation) SetNamespaceID(id string) {
+	m.namespace = &id
+}
+
+// ClearNamespace clears the "namespace" edge to the Namespace entity.
+func (m *WorkflowMutation) ClearNamespace() {
+	m.clearednamespace = true
+}
+
+// NamespaceCleared reports if the "namespace" edge to the Namespace entity was cleared.
+func (m *WorkflowMutation) NamespaceCleared() bool {
+	return m.clearednamespace
+}
+
+// NamespaceID returns the "namespace" edge ID in the mutation.
+func (m *WorkflowMutation) NamespaceID() (id string, exists bool) {
+	if m.namespace != nil {
+		return *m.namespace, true
+	}
+	return
+}
+
+// NamespaceIDs returns the "namespace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NamespaceID instead. It exists only for internal usage by the builders.
+func (m *WorkflowMutation) NamespaceIDs() (ids []string) {
+	if id := m.namespace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNamespace resets all changes to the "namespace" edge.
+func (m *WorkflowMutation) ResetNamespace() {
+	m.namespace = nil
+	m.clearednamespace = false
+}
+
+// AddInstanceIDs adds the "instances" edge to the WorkflowInstance entity by ids.
+func (m *WorkflowMutation) AddInstanceIDs(ids ...int) {
+	if m.instances == nil {
+		m.instances = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.instances[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInstances clears the "instances" edge to the WorkflowInstance entity.
+func (m *WorkflowMutation) ClearInstances() {
+	m.clearedinstances = true
+}
+
+// InstancesCleared reports if the "instances" edge to the WorkflowInstance entity was cleared.
+func (m *WorkflowMutation) InstancesCleared() bool {
+	return m.clearedinstances
+}
+
+// RemoveInstanceIDs removes the "instances" edge to the WorkflowInstance entity by IDs.
+func (m *WorkflowMutation) RemoveInstanceIDs(ids ...int) {
+	if m.removedinstances == nil {
+		m.removedinstances = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedinstances[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInstances returns the removed IDs of the "instances" edge to the WorkflowInstance entity.
+func (m *WorkflowMutation) RemovedInstancesIDs() (ids []int) {
+	for id := range m.removedinstances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InstancesIDs returns the "instances" edge IDs in the mutation.
+func (m *WorkflowMutation) InstancesIDs() (ids []int) {
+	for id := range m.instances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInstances resets all changes to the "instances" edge.
+func (m *WorkflowMutation) ResetInstances() {
+	m.instances = nil
+	m.clearedinstances = false
+	m.removedinstances = nil
+}
+
+// AddWfeventIDs adds the "wfevents" edge to the WorkflowEvents entity by ids.
+func (m *WorkflowMutation) AddWfeventIDs(ids ...int) {
+	if m.wfevents == nil {
+		m.wfevents = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.wfevents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWfevents clears the "wfevents" edge to the WorkflowEvents entity.
+func (m *WorkflowMutation) ClearWfevents() {
+	m.clearedwfevents = true
+}
+
+// WfeventsCleared reports if the "wfevents" edge to the WorkflowEvents entity was cleared.
+func (m *WorkflowMutation) WfeventsCleared() bool {
+	return m.clearedwfevents
+}
+
+// RemoveWfeventIDs removes the "wfevents" edge to the WorkflowEvents entity by IDs.
+func (m *WorkflowMutation) RemoveWfeventIDs(ids ...int) {
+	if m.removedwfevents == nil {
+		m.removedwfevents = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedwfevents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWfevents returns the removed IDs of the "wfevents" edge to the WorkflowEvents entity.
+func (m *WorkflowMutation) RemovedWfeventsIDs() (ids []int) {
+	for id := range m.removedwfevents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WfeventsIDs returns the "wfevents" edge IDs in the mutation.
+func (m *WorkflowMutation) WfeventsIDs() (ids []int) {
+	for id := range m.wfevents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWfevents resets all changes to the "wfevents" edge.
+func (m *WorkflowMutation) ResetWfevents() {
+	m.wfevents = nil
+	m.clearedwfevents = false
+	m.removedwfevents = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the WorkflowRevision entity by ids.
+func (m *WorkflowMutation) AddRevisionIDs(ids ...int) {
+	if m.revisions == nil {
+		m.revisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the WorkflowRevision entity.
+func (m *WorkflowMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the WorkflowRevision entity was cleared.
+func (m *WorkflowMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the WorkflowRevision entity by IDs.
+func (m *WorkflowMutation) RemoveRevisionIDs(ids ...int) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the WorkflowRevision entity.
+func (m *WorkflowMutation) RemovedRevisionsIDs() (ids []int) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *WorkflowMutation) RevisionsIDs() (ids []int) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *WorkflowMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// Op returns the operation name.
+func (m *WorkflowMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Workflow).
+func (m *WorkflowMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WorkflowMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, workflow.FieldName)
+	}
+	if m.created != nil {
+		fields = append(fields, workflow.FieldCreated)
+	}
+	if m.description != nil {
+		fields = append(fields, workflow.FieldDescription)
+	}
+	if m.active != nil {
+		fields = append(fields, workflow.FieldActive)
+	}
+	if m.revision != nil {
+		fields = append(fields, workflow.FieldRevision)
+	}
+	if m.workflow != nil {
+		fields = append(fields, workflow.FieldWorkflow)
+	}
+	if m.logToEvents != nil {
+		fields = append(fields, workflow.FieldLogToEvents)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WorkflowMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case workflow.FieldName:
+		return m.Name()
+	case workflow.FieldCreated:
+		return m.Created()
+	case workflow.FieldDescription:
+		return m.Description()
+	case workflow.FieldActive:
+		return m.Active()
+	case workflow.FieldRevision:
+		return m.Revision()
+	case workflow.FieldWorkflow:
+		return m.Workflow()
+	case workflow.FieldLogToEvents:
+		return m.LogToEvents()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WorkflowMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case workflow.FieldName:
+		return m.OldName(ctx)
+	case workflow.FieldCreated:
+		return m.OldCreated(ctx)
+	case workflow.FieldDescription:
+		return m.OldDescription(ctx)
+	case workflow.FieldActive:
+		return m.OldActive(ctx)
+	case workflow.FieldRevision:
+		return m.OldRevision(ctx)
+	case workflow.FieldWorkflow:
+		return m.OldWorkflow(ctx)
+	case workflow.FieldLogToEvents:
+		return m.OldLogToEvents(ctx)
+	}
+	return nil, fmt.Errorf("unknown Workflow field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WorkflowMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case workflow.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case workflow.FieldCreated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreated(v)
+		return nil
+	case workflow.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case workflow.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case workflow.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	case workflow.FieldWorkflow:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkflow(v)
+		return nil
+	case workflow.FieldLogToEvents:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogToEvents(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Workflow field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WorkflowMutation) AddedFields() []string {
+	var fields []string
+	if m.addrevision != nil {
+		fields = append(fields, workflow.FieldRevision)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WorkflowMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case workflow.FieldRevision:
+		return m.AddedRevision()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WorkflowMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case workflow.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Workflow numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WorkflowMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(workflow.FieldDescription) {
+		fields = append(fields, workflow.FieldDescription)
+	}
+	if m.FieldCleared(workflow.FieldLogToEvents) {
+		fields = append(fields, workflow.FieldLogToEvents)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WorkflowMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WorkflowMutation) ClearField(name string) error {
+	switch name {
+	case workflow.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case workflow.FieldLogToEvents:
+		m.ClearLogToEvents()
+		return nil
+	}
+	return fmt.Errorf("unknown Workflow nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WorkflowMutation) ResetField(name string) error {
+	switch name {
+	case workflow.FieldName:
+		m.ResetName()
+		return nil
+	case workflow.FieldCreated:
+		m.ResetCreated()
+		return nil
+	case workflow.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case workflow.FieldActive:
+		m.ResetActive()
+		return nil
+	case workflow.FieldRevision:
+		m.ResetRevision()
+		return nil
+	case workflow.FieldWorkflow:
+		m.ResetWorkflow()
+		return nil
+	case workflow.FieldLogToEvents:
+		m.ResetLogToEvents()
+		return nil
+	}
+	return fmt.Errorf("unknown Workflow field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WorkflowMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.namespace != nil {
+		edges = append(edges, workflow.EdgeNamespace)
+	}
+	if m.instances != nil {
+		edges = append(edges, workflow.EdgeInstances)
+	}
+	if m.wfevents != nil {
+		edges = append(edges, workflow.EdgeWfevents)
+	}
+	if m.revisions != nil {
+		edges = append(edges, workflow.EdgeRevisions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WorkflowMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case workflow.EdgeNamespace:
+		if id := m.namespace; id != nil {
+			return []ent.Value{*id}
+		}
+	case workflow.EdgeInstances:
+		ids := make([]ent.Value, 0, len(m.instances))
+		for id := range m.instances {
+			ids = append(ids, id)
+		}
+		return ids
+	case workflow.EdgeWfevents:
+		ids := make([]ent.Value, 0, len(m.wfevents))
+		for id := range m.wfevents {
+			ids = append(ids, id)
+		}
+		return ids
+	case workflow.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WorkflowMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedinstances != nil {
+		edges = append(edges, workflow.EdgeInstances)
+	}
+	if m.removedwfevents != nil {
+		edges = append(edges, workflow.EdgeWfevents)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, workflow.EdgeRevisions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WorkflowMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case workflow.EdgeInstances:
+		ids := make([]ent.Value, 0, len(m.removedinstances))
+		for id := range m.removedinstances {
+			ids = append(ids, id)
+		}
+		return ids
+	case workflow.EdgeWfevents:
+		ids := make([]ent.Value, 0, len(m.removedwfevents))
+		for id := range m.removedwfevents {
+			ids = append(ids, id)
+		}
+		return ids
+	case workflow.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WorkflowMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearednamespace {
+		edges = append(edges, workflow.EdgeNamespace)
+	}
+	if m.clearedinstances {
+		edges = append(edges, workflow.EdgeInstances)
+	}
+	if m.clearedwfevents {
+		edges = append(edges, workflow.EdgeWfevents)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, workflow.EdgeRevisions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WorkflowMutation) EdgeCleared(name string) bool {
+	switch name {
+	case workflow.EdgeNamespace:
+		return m.clearednamespace
+	case workflow.EdgeInstances:
+		return m.clearedinstances
+	case workflow.EdgeWfevents:
+		return m.clearedwfevents
+	case workflow.EdgeRevisions:
+		return m.clearedrevisions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WorkflowMutation) ClearEdge(name string) error {
+	switch name {
+	case workflow.EdgeNamespace:
+		m.ClearNamespace()
+		return nil
+	}
+	return fmt.Errorf("unknown Workflow unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WorkflowMutation) ResetEdge(name string) error {
+	switch name {
+	case workflow.EdgeNamespace:
+		m.ResetNamespace()
+		return nil
+	case workflow.EdgeInstances:
+		m.ResetInstances()
+		return nil
+	case workflow.EdgeWfevents:
+		m.ResetWfevents()
+		return nil
+	case workflow.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	}
+	return fmt.Errorf("unknown Workflow edge %s", name)
+}
+
+// WorkflowEventsMutation represents an operation that mutates the WorkflowEvents nodes in the graph.
+type WorkflowEventsMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	events                  *[]map[string]interface{}
+	correlations            *[]string
+	signature               *[]byte
+	count                   *int
+	addcount                *int
+	clearedFields           map[string]struct{}
+	workflow                *uuid.UUID
+	clearedworkflow         bool
+	wfeventswait            map[int]struct{}
+	removedwfeventswait     map[int]struct{}
+	clearedwfeventswait     bool
+	workflowinstance        *int
+	clearedworkflowinstance bool
+	done                    bool
+	oldValue                func(context.Context) (*WorkflowEvents, error)
+	predicates              []predicate.WorkflowEvents
+}
+
+var _ ent.Mutation = (*WorkflowEventsMutation)(nil)
+
+// workfloweventsOption allows management of the mutation configuration using functional options.
+type workfloweventsOption func(*WorkflowEventsMutation)
+
+// newWorkflowEventsMutation creates new mutation for the WorkflowEvents entity.
+func newWorkflowEventsMutation(c config, op Op, opts ...workfloweventsOption) *WorkflowEventsMutation {
+	m := &WorkflowEventsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWorkflowEvents,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWorkflowEventsID sets the ID field of the mutation.
+func withWorkflowEventsID(id int) workfloweventsOption {
+	return func(m *WorkflowEventsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WorkflowEvents
+		)
+		m.oldValue = func(ctx context.Context) (*WorkflowEvents, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WorkflowEvents.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWorkflowEvents sets the old WorkflowEvents of the mutation.
+func withWorkflowEvents(node *WorkflowEvents) workfloweventsOption {
+	return func(m *WorkflowEventsMutation) {
+		m.oldValue = func(context.Context) (*WorkflowEvents, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WorkflowEventsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WorkflowEventsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *WorkflowEventsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetEvents sets the "events" field.
+func (m *WorkflowEventsMutation) SetEvents(value []map[string]interface{}) {
+	m.events = &value
+}
+
+// Events returns the value of the "events" field in the mutation.
+func (m *WorkflowEventsMutation) Events() (r []map[string]interface{}, exists bool) {
+	v := m.events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvents returns the old "events" field's value of the WorkflowEvents entity.
+// If the WorkflowEvents object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowEventsMutation) OldEvents(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
+}
+
+// ResetEvents resets all changes to the "events" field.
+func (m *WorkflowEventsMutation) ResetEvents() {
+	m.events = nil
+}
+
+// SetCorrelations sets the "correlations" field.
+func (m *WorkflowEventsMutation) SetCorrelations(s []string) {
+	m.correlations = &s
+}
+
+// Correlations returns the value of the "correlations" field in the mutation.
+func (m *WorkflowEventsMutation) Correlations() (r []string, exists bool) {
+	v := m.correlations
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCorrelations returns the old "correlations" field's value of the WorkflowEvents entity.
+// If the WorkflowEvents object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowEventsMutation) OldCorrelations(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCorrelations is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCorrelations requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCorrelations: %w", err)
+	}
+	return oldValue.Correlations, nil
+}
+
+// ResetCorrelations resets all changes to the "correlations" field.
+func (m *WorkflowEventsMutation) ResetCorrelations() {
+	m.correlations = nil
+}
+
+// SetSignature sets the "signature" field.
+func (m *WorkflowEventsMutation) SetSignature(b []byte) {
+	m.signature = &b
+}
+
+// Signature returns the value of the "signature" field in the mutation.
+func (m *WorkflowEventsMutation) Signature() (r []byte, exists bool) {
+	v := m.signature
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignature returns the old "signature" field's value of the WorkflowEvents entity.
+// If the WorkflowEvents object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowEventsMutation) OldSignature(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSignature is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSignature requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
+	}
+	return oldValue.Signature, nil
+}
+
+// ClearSignature clears the value of the "signature" field.
+func (m *WorkflowEventsMutation) ClearSignature() {
+	m.signature = nil
+	m.clearedFields[workflowevents.FieldSignature] = struct{}{}
+}
+
+// SignatureCleared returns if the "signature" field was cleared in this mutation.
+func (m *WorkflowEventsMutation) SignatureCleared() bool {
+	_, ok := m.clearedFields[workflowevents.FieldSignature]
+	return ok
+}
+
+// ResetSignature resets all changes to the "signature" field.
+func (m *WorkflowEventsMutation) ResetSignature() {
+	m.signature = nil
+	delete(m.clearedFields, workflowevents.FieldSignature)
+}
+
+// SetCount sets the "count" field.
+func (m *WorkflowEventsMutation) SetCount(i int) {
+	m.count = &i
+	m.addcount = nil
+}
+
+// Count returns the value of the "count" field in the mutation.
+func (m *WorkflowEventsMutation) Count() (r int, exists bool) {
+	v := m.count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCount returns the old "count" field's value of the WorkflowEvents entity.
+// If the WorkflowEvents object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowEventsMutation) OldCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+	}
+	return oldValue.Count, nil
+}
+
+// AddCount adds i to the "count" field.
+func (m *WorkflowEventsMutation) AddCount(i int) {
+	if m.addcount != nil {
+		*m.addcount += i
+	} else {
+		m.addcount = &i
+	}
+}
+
+// AddedCount returns the value that was added to the "count" field in this mutation.
+func (m *WorkflowEventsMutation) AddedCount() (r int, exists bool) {
+	v := m.addcount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCount resets all changes to the "count" field.
+func (m *WorkflowEventsMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
+}
+
+// SetWorkflowID sets the "workflow" edge to the Workflow entity by id.
+func (m *WorkflowEventsMutation) SetWorkflowID(id uuid.UUID) {
+	m.workflow = &id
+}
+
+// ClearWorkflow clears the "workflow" edge to the Workflow entity.
+func (m *WorkflowEventsMutation) ClearWorkflow() {
+	m.clearedworkflow = true
+}
+
+// WorkflowCleared reports if the "workflow" edge to the Workflow entity was cleared.
+func (m *WorkflowEventsMutation) WorkflowCleared() bool {
+	return m.clearedworkflow
+}
+
+// WorkflowID returns the "workflow" edge ID in the mutation.
+func (m *WorkflowEventsMutation) WorkflowID() (id uuid.UUID, exists bool) {
+	if m.workflow != nil {
+		return *m.workflow, true
+	}
+	return
+}
+
+// WorkflowIDs returns the "workflow" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkflowID instead. It exists only for internal usage by the builders.
+func (m *WorkflowEventsMutation) WorkflowIDs() (ids []uuid.UUID) {
+	if id := m.workflow; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkflow resets all changes to the "workflow" edge.
+func (m *WorkflowEventsMutation) ResetWorkflow() {
+	m.workflow = nil
+	m.clearedworkflow = false
+}
+
+// AddWfeventswaitIDs adds the "wfeventswait" edge to the WorkflowEventsWait entity by ids.
+func (m *WorkflowEventsMutation) AddWfeventswaitIDs(ids ...int) {
+	if m.wfeventswait == nil {
+		m.wfeventswait = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.wfeventswait[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWfeventswait clears the "wfeventswait" edge to the WorkflowEventsWait entity.
+func (m *WorkflowEventsMutation) ClearWfeventswait() {
+	m.clearedwfeventswait = true
+}
+
+// WfeventswaitCleared reports if the "wfeventswait" edge to the WorkflowEventsWait entity was cleared.
+func (m *WorkflowEventsMutation) WfeventswaitCleared() bool {
+	return m.clearedwfeventswait
+}
+
+// RemoveWfeventswaitIDs removes the "wfeventswait" edge to the WorkflowEventsWait entity by IDs.
+func (m *WorkflowEventsMutation) RemoveWfeventswaitIDs(ids ...int) {
+	if m.removedwfeventswait == nil {
+		m.removedwfeventswait = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedwfeventswait[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWfeventswait returns the removed IDs of the "wfeventswait" edge to the WorkflowEventsWait entity.
+func (m *WorkflowEventsMutation) RemovedWfeventswaitIDs() (ids []int) {
+	for id := range m.removedwfeventswait {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WfeventswaitIDs returns the "wfeventswait" edge IDs in the mutation.
+func (m *WorkflowEventsMutation) WfeventswaitIDs() (ids []int) {
+	for id := range m.wfeventswait {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWfeventswait resets all changes to the "wfeventswait" edge.
+func (m *WorkflowEventsMutation) ResetWfeventswait() {
+	m.wfeventswait = nil
+	m.clearedwfeventswait = false
+	m.removedwfeventswait = nil
+}
+
+// SetWorkflowinstanceID sets the "workflowinstance" edge to the WorkflowInstance entity by id.
+func (m *WorkflowEventsMutation) SetWorkflowinstanceID(id int) {
+	m.workflowinstance = &id
+}
+
+// ClearWorkflowinstance clears the "workflowinstance" edge to the WorkflowInstance entity.
+func (m *WorkflowEventsMutation) ClearWorkflowinstance() {
+	m.clearedworkflowinstance = true
+}
+
+// WorkflowinstanceCleared reports if the "workflowinstance" edge to the WorkflowInstance entity was cleared.
+func (m *WorkflowEventsMutation) WorkflowinstanceCleared() bool {
+	return m.clearedworkflowinstance
+}
+
+// WorkflowinstanceID returns the "workflowinstance" edge ID in the mutation.
+func (m *WorkflowEventsMutation) WorkflowinstanceID() (id int, exists bool) {
+	if m.workflowinstance != nil {
+		return *m.workflowinstance, true
+	}
+	return
+}
+
+// WorkflowinstanceIDs returns the "workflowinstance" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkflowinstanceID instead. It exists only for internal usage by the builders.
+func (m *WorkflowEventsMutation) WorkflowinstanceIDs() (ids []int) {
+	if id := m.workflowinstance; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkflowinstance resets all changes to the "workflowinstance" edge.
+func (m *WorkflowEventsMutation) ResetWorkflowinstance() {
+	m.workflowinstance = nil
+	m.clearedworkflowinstance = false
+}
+
+// Op returns the operation name.
+func (m *WorkflowEventsMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (WorkflowEvents).
+func (m *WorkflowEventsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WorkflowEventsMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.events != nil {
+		fields = append(fields, workflowevents.FieldEvents)
+	}
+	if m.correlations != nil {
+		fields = append(fields, workflowevents.FieldCorrelations)
+	}
+	if m.signature != nil {
+		fields = append(fields, workflowevents.FieldSignature)
+	}
+	if m.count != nil {
+		fields = append(fields, workflowevents.FieldCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WorkflowEventsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case workflowevents.FieldEvents:
+		return m.Events()
+	case workflowevents.FieldCorrelations:
+		return m.Correlations()
+	case workflowevents.FieldSignature:
+		return m.Signature()
+	case workflowevents.FieldCount:
+		return m.Count()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WorkflowEventsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case workflowevents.FieldEvents:
+		return m.OldEvents(ctx)
+	case workflowevents.FieldCorrelations:
+		return m.OldCorrelations(ctx)
+	case workflowevents.FieldSignature:
+		return m.OldSignature(ctx)
+	case workflowevents.FieldCount:
+		return m.OldCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown WorkflowEvents field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WorkflowEventsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case workflowevents.FieldEvents:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	case workflowevents.FieldCorrelations:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCorrelations(v)
+		return nil
+	case workflowevents.FieldSignature:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignature(v)
+		return nil
+	case workflowevents.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowEvents field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WorkflowEventsMutation) AddedFields() []string {
+	var fields []string
+	if m.addcount != nil {
+		fields = append(fields, workflowevents.FieldCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WorkflowEventsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case workflowevents.FieldCount:
+		return m.AddedCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WorkflowEventsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case workflowevents.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowEvents numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WorkflowEventsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(workflowevents.FieldSignature) {
+		fields = append(fields, workflowevents.FieldSignature)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WorkflowEventsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WorkflowEventsMutation) ClearField(name string) error {
+	switch name {
+	case workflowevents.FieldSignature:
+		m.ClearSignature()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowEvents nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WorkflowEventsMutation) ResetField(name string) error {
+	switch name {
+	case workflowevents.FieldEvents:
+		m.ResetEvents()
+		return nil
+	case workflowevents.FieldCorrelations:
+		m.ResetCorrelations()
+		return nil
+	case workflowevents.FieldSignature:
+		m.ResetSignature()
+		return nil
+	case workflowevents.FieldCount:
+		m.ResetCount()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowEvents field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WorkflowEventsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.workflow != nil {
+		edges = append(edges, workflowevents.EdgeWorkflow)
+	}
+	if m.wfeventswait != nil {
+		edges = append(edges, workflowevents.EdgeWfeventswait)
+	}
+	if m.workflowinstance != nil {
+		edges = append(edges, workflowevents.EdgeWorkflowinstance)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WorkflowEventsMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case workflowevents.EdgeWorkflow:
+		if id := m.workflow; id != nil {
+			return []ent.Value{*id}
+		}
+	case workflowevents.EdgeWfeventswait:
+		ids := make([]ent.Value, 0, len(m.wfeventswait))
+		for id := range m.wfeventswait {
+			ids = append(ids, id)
+		}
+		return ids
+	case workflowevents.EdgeWorkflowinstance:
+		if id := m.workflowinstance; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WorkflowEventsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedwfeventswait != nil {
+		edges = append(edges, workflowevents.EdgeWfeventswait)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WorkflowEventsMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case workflowevents.EdgeWfeventswait:
+		ids := make([]ent.Value, 0, len(m.removedwfeventswait))
+		for id := range m.removedwfeventswait {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WorkflowEventsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedworkflow {
+		edges = append(edges, workflowevents.EdgeWorkflow)
+	}
+	if m.clearedwfeventswait {
+		edges = append(edges, workflowevents.EdgeWfeventswait)
+	}
+	if m.clearedworkflowinstance {
+		edges = append(edges, workflowevents.EdgeWorkflowinstance)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WorkflowEventsMutation) EdgeCleared(name string) bool {
+	switch name {
+	case workflowevents.EdgeWorkflow:
+		return m.clearedworkflow
+	case workflowevents.EdgeWfeventswait:
+		return m.clearedwfeventswait
+	case workflowevents.EdgeWorkflowinstance:
+		return m.clearedworkflowinstance
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WorkflowEventsMutation) ClearEdge(name string) error {
+	switch name {
+	case workflowevents.EdgeWorkflow:
+		m.ClearWorkflow()
+		return nil
+	case workflowevents.EdgeWorkflowinstance:
+		m.ClearWorkflowinstance()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowEvents unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WorkflowEventsMutation) ResetEdge(name string) error {
+	switch name {
+	case workflowevents.EdgeWorkflow:
+		m.ResetWorkflow()
+		return nil
+	case workflowevents.EdgeWfeventswait:
+		m.ResetWfeventswait()
+		return nil
+	case workflowevents.EdgeWorkflowinstance:
+		m.ResetWorkflowinstance()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowEvents edge %s", name)
+}
+
+// WorkflowEventsWaitMutation represents an operation that mutates the WorkflowEventsWait nodes in the graph.
+type WorkflowEventsWaitMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	events               *map[string]interface{}
+	clearedFields        map[string]struct{}
+	workflowevent        *int
+	clearedworkflowevent bool
+	done                 bool
+	oldValue             func(context.Context) (*WorkflowEventsWait, error)
+	predicates           []predicate.WorkflowEventsWait
+}
+
+var _ ent.Mutation = (*WorkflowEventsWaitMutation)(nil)
+
+// workfloweventswaitOption allows management of the mutation configuration using functional options.
+type workfloweventswaitOption func(*WorkflowEventsWaitMutation)
+
+// newWorkflowEventsWaitMutation creates new mutation for the WorkflowEventsWait entity.
+func newWorkflowEventsWaitMutation(c config, op Op, opts ...workfloweventswaitOption) *WorkflowEventsWaitMutation {
+	m := &WorkflowEventsWaitMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWorkflowEventsWait,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWorkflowEventsWaitID sets the ID field of the mutation.
+func withWorkflowEventsWaitID(id int) workfloweventswaitOption {
+	return func(m *WorkflowEventsWaitMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WorkflowEventsWait
+		)
+		m.oldValue = func(ctx context.Context) (*WorkflowEventsWait, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WorkflowEventsWait.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWorkflowEventsWait sets the old WorkflowEventsWait of the mutation.
+func withWorkflowEventsWait(node *WorkflowEventsWait) workfloweventswaitOption {
+	return func(m *WorkflowEventsWaitMutation) {
+		m.oldValue = func(context.Context) (*WorkflowEventsWait, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WorkflowEventsWaitMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WorkflowEventsWaitMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *WorkflowEventsWaitMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetEvents sets the "events" field.
+func (m *WorkflowEventsWaitMutation) SetEvents(value map[string]interface{}) {
+	m.events = &value
+}
+
+// Events returns the value of the "events" field in the mutation.
+func (m *WorkflowEventsWaitMutation) Events() (r map[string]interface{}, exists bool) {
+	v := m.events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvents returns the old "events" field's value of the WorkflowEventsWait entity.
+// If the WorkflowEventsWait object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowEventsWaitMutation) OldEvents(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
+}
+
+// ResetEvents resets all changes to the "events" field.
+func (m *WorkflowEventsWaitMutation) ResetEvents() {
+	m.events = nil
+}
+
+// SetWorkfloweventID sets the "workflowevent" edge to the WorkflowEvents entity by id.
+func (m *WorkflowEventsWaitMutation) SetWorkfloweventID(id int) {
+	m.workflowevent = &id
+}
+
+// ClearWorkflowevent clears the "workflowevent" edge to the WorkflowEvents entity.
+func (m *WorkflowEventsWaitMutation) ClearWorkflowevent() {
+	m.clearedworkflowevent = true
+}
+
+// WorkfloweventCleared reports if the "workflowevent" edge to the WorkflowEvents entity was cleared.
+func (m *WorkflowEventsWaitMutation) WorkfloweventCleared() bool {
+	return m.clearedworkflowevent
+}
+
+// WorkfloweventID returns the "workflowevent" edge ID in the mutation.
+func (m *WorkflowEventsWaitMutation) WorkfloweventID() (id int, exists bool) {
+	if m.workflowevent != nil {
+		return *m.workflowevent, true
+	}
+	return
+}
+
+// WorkfloweventIDs returns the "workflowevent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkfloweventID instead. It exists only for internal usage by the builders.
+func (m *WorkflowEventsWaitMutation) WorkfloweventIDs() (ids []int) {
+	if id := m.workflowevent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkflowevent resets all changes to the "workflowevent" edge.
+func (m *WorkflowEventsWaitMutation) ResetWorkflowevent() {
+	m.workflowevent = nil
+	m.clearedworkflowevent = false
+}
+
+// Op returns the operation name.
+func (m *WorkflowEventsWaitMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (WorkflowEventsWait).
+func (m *WorkflowEventsWaitMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WorkflowEventsWaitMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.events != nil {
+		fields = append(fields, workfloweventswait.FieldEvents)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WorkflowEventsWaitMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case workfloweventswait.FieldEvents:
+		return m.Events()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WorkflowEventsWaitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case workfloweventswait.FieldEvents:
+		return m.OldEvents(ctx)
+	}
+	return nil, fmt.Errorf("unknown WorkflowEventsWait field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WorkflowEventsWaitMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case workfloweventswait.FieldEvents:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowEventsWait field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WorkflowEventsWaitMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WorkflowEventsWaitMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WorkflowEventsWaitMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WorkflowEventsWait numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WorkflowEventsWaitMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WorkflowEventsWaitMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WorkflowEventsWaitMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WorkflowEventsWait nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WorkflowEventsWaitMutation) ResetField(name string) error {
+	switch name {
+	case workfloweventswait.FieldEvents:
+		m.ResetEvents()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowEventsWait field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WorkflowEventsWaitMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.workflowevent != nil {
+		edges = append(edges, workfloweventswait.EdgeWorkflowevent)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WorkflowEventsWaitMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case workfloweventswait.EdgeWorkflowevent:
+		if id := m.workflowevent; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WorkflowEventsWaitMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WorkflowEventsWaitMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WorkflowEventsWaitMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedworkflowevent {
+		edges = append(edges, workfloweventswait.EdgeWorkflowevent)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WorkflowEventsWaitMutation) EdgeCleared(name string) bool {
+	switch name {
+	case workfloweventswait.EdgeWorkflowevent:
+		return m.clearedworkflowevent
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WorkflowEventsWaitMutation) ClearEdge(name string) error {
+	switch name {
+	case workfloweventswait.EdgeWorkflowevent:
+		m.ClearWorkflowevent()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowEventsWait unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WorkflowEventsWaitMutation) ResetEdge(name string) error {
+	switch name {
+	case workfloweventswait.EdgeWorkflowevent:
+		m.ResetWorkflowevent()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowEventsWait edge %s", name)
+}
+
+// WorkflowInstanceMutation represents an operation that mutates the WorkflowInstance nodes in the graph.
+type WorkflowInstanceMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	instanceID      *string
+	externalID      *string
+	invokedBy       *string
+	status          *string
+	revision        *int
+	addrevision     *int
+	beginTime       *time.Time
+	endTime         *time.Time
+	flow            *[]string
+	input           *string
+	output          *string
+	stateData       *string
+	memory          *string
+	memoryData      *[]byte
+	deadline        *time.Time
+	attempts        *int
+	addattempts     *int
+	errorCode       *string
+	errorMessage    *string
+	errorData       *string
+	errorCauses     *[]schema.ErrorCause
+	cancelCategory  *string
+	cancelReason    *string
+	progress        *string
+	stateBeginTime  *time.Time
+	controller      *string
+	handoff         *[]byte
+	simulation      *[]byte
+	debug           *[]byte
+	hold            *string
+	profile         *string
+	receivedEvents  *string
+	awaitingCancels *[]byte
+	clearedFields   map[string]struct{}
+	workflow        *uuid.UUID
+	clearedworkflow bool
+	instance        map[int]struct{}
+	removedinstance map[int]struct{}
+	clearedinstance bool
+	done            bool
+	oldValue        func(context.Context) (*WorkflowInstance, error)
+	predicates      []predicate.WorkflowInstance
+}
+
+var _ ent.Mutation = (*WorkflowInstanceMutation)(nil)
+
+// workflowinstanceOption allows management of the mutation configuration using functional options.
+type workflowinstanceOption func(*WorkflowInstanceMutation)
+
+// newWorkflowInstanceMutation creates new mutation for the WorkflowInstance entity.
+func newWorkflowInstanceMutation(c config, op Op, opts ...workflowinstanceOption) *WorkflowInstanceMutation {
+	m := &WorkflowInstanceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWorkflowInstance,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWorkflowInstanceID sets the ID field of the mutation.
+func withWorkflowInstanceID(id int) workflowinstanceOption {
+	return func(m *WorkflowInstanceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WorkflowInstance
+		)
+		m.oldValue = func(ctx context.Context) (*WorkflowInstance, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WorkflowInstance.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWorkflowInstance sets the old WorkflowInstance of the mutation.
+func withWorkflowInstance(node *WorkflowInstance) workflowinstanceOption {
+	return func(m *WorkflowInstanceMutation) {
+		m.oldValue = func(context.Context) (*WorkflowInstance, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WorkflowInstanceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WorkflowInstanceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *WorkflowInstanceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetInstanceID sets the "instanceID" field.
+func (m *WorkflowInstanceMutation) SetInstanceID(s string) {
+	m.instanceID = &s
+}
+
+// InstanceID returns the value of the "instanceID" field in the mutation.
+func (m *WorkflowInstanceMutation) InstanceID() (r string, exists bool) {
+	v := m.instanceID
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstanceID returns the old "instanceID" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldInstanceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldInstanceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstanceID: %w", err)
+	}
+	return oldValue.InstanceID, nil
+}
+
+// ResetInstanceID resets all changes to the "instanceID" field.
+func (m *WorkflowInstanceMutation) ResetInstanceID() {
+	m.instanceID = nil
+}
+
+// SetExternalID sets the "externalID" field.
+func (m *WorkflowInstanceMutation) SetExternalID(s string) {
+	m.externalID = &s
+}
+
+// ExternalID returns the value of the "externalID" field in the mutation.
+func (m *WorkflowInstanceMutation) ExternalID() (r string, exists bool) {
+	v := m.externalID
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalID returns the old "externalID" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldExternalID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
+}
+
+// ClearExternalID clears the value of the "externalID" field.
+func (m *WorkflowInstanceMutation) ClearExternalID() {
+	m.externalID = nil
+	m.clearedFields[workflowinstance.FieldExternalID] = struct{}{}
+}
+
+// ExternalIDCleared returns if the "externalID" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) ExternalIDCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldExternalID]
+	return ok
+}
+
+// ResetExternalID resets all changes to the "externalID" field.
+func (m *WorkflowInstanceMutation) ResetExternalID() {
+	m.externalID = nil
+	delete(m.clearedFields, workflowinstance.FieldExternalID)
+}
+
+// SetInvokedBy sets the "invokedBy" field.
+func (m *WorkflowInstanceMutation) SetInvokedBy(s string) {
+	m.invokedBy = &s
+}
+
+// InvokedBy returns the value of the "invokedBy" field in the mutation.
+func (m *WorkflowInstanceMutation) InvokedBy() (r string, exists bool) {
+	v := m.invokedBy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInvokedBy returns the old "invokedBy" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldInvokedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldInvokedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldInvokedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvokedBy: %w", err)
+	}
+	return oldValue.InvokedBy, nil
+}
+
+// ResetInvokedBy resets all changes to the "invokedBy" field.
+func (m *WorkflowInstanceMutation) ResetInvokedBy() {
+	m.invokedBy = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *WorkflowInstanceMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WorkflowInstanceMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *WorkflowInstanceMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRevision sets the "revision" field.
+func (m *WorkflowInstanceMutation) SetRevision(i int) {
+	m.revision = &i
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *WorkflowInstanceMutation) Revision() (r int, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldRevision(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds i to the "revision" field.
+func (m *WorkflowInstanceMutation) AddRevision(i int) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *WorkflowInstanceMutation) AddedRevision() (r int, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *WorkflowInstanceMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// SetBeginTime sets the "beginTime" field.
+func (m *WorkflowInstanceMutation) SetBeginTime(t time.Time) {
+	m.beginTime = &t
+}
+
+// BeginTime returns the value of the "beginTime" field in the mutation.
+func (m *WorkflowInstanceMutation) BeginTime() (r time.Time, exists bool) {
+	v := m.beginTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBeginTime returns the old "beginTime" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldBeginTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldBeginTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldBeginTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBeginTime: %w", err)
+	}
+	return oldValue.BeginTime, nil
+}
+
+// ResetBeginTime resets all changes to the "beginTime" field.
+func (m *WorkflowInstanceMutation) ResetBeginTime() {
+	m.beginTime = nil
+}
+
+// SetEndTime sets the "endTime" field.
+func (m *WorkflowInstanceMutation) SetEndTime(t time.Time) {
+	m.endTime = &t
+}
+
+// EndTime returns the value of the "endTime" field in the mutation.
+func (m *WorkflowInstanceMutation) EndTime() (r time.Time, exists bool) {
+	v := m.endTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "endTime" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ClearEndTime clears the value of the "endTime" field.
+func (m *WorkflowInstanceMutation) ClearEndTime() {
+	m.endTime = nil
+	m.clearedFields[workflowinstance.FieldEndTime] = struct{}{}
+}
+
+// EndTimeCleared returns if the "endTime" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) EndTimeCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldEndTime]
+	return ok
+}
+
+// ResetEndTime resets all changes to the "endTime" field.
+func (m *WorkflowInstanceMutation) ResetEndTime() {
+	m.endTime = nil
+	delete(m.clearedFields, workflowinstance.FieldEndTime)
+}
+
+// SetFlow sets the "flow" field.
+func (m *WorkflowInstanceMutation) SetFlow(s []string) {
+	m.flow = &s
+}
+
+// Flow returns the value of the "flow" field in the mutation.
+func (m *WorkflowInstanceMutation) Flow() (r []string, exists bool) {
+	v := m.flow
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlow returns the old "flow" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldFlow(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldFlow is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldFlow requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlow: %w", err)
+	}
+	return oldValue.Flow, nil
+}
+
+// ClearFlow clears the value of the "flow" field.
+func (m *WorkflowInstanceMutation) ClearFlow() {
+	m.flow = nil
+	m.clearedFields[workflowinstance.FieldFlow] = struct{}{}
+}
+
+// FlowCleared returns if the "flow" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) FlowCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldFlow]
+	return ok
+}
+
+// ResetFlow resets all changes to the "flow" field.
+func (m *WorkflowInstanceMutation) ResetFlow() {
+	m.flow = nil
+	delete(m.clearedFields, workflowinstance.FieldFlow)
+}
+
+// SetInput sets the "input" field.
+func (m *WorkflowInstanceMutation) SetInput(s string) {
+	m.input = &s
+}
+
+// Input returns the value of the "input" field in the mutation.
+func (m *WorkflowInstanceMutation) Input() (r string, exists bool) {
+	v := m.input
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInput returns the old "input" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldInput(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldInput is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldInput requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInput: %w", err)
+	}
+	return oldValue.Input, nil
+}
+
+// ResetInput resets all changes to the "input" field.
+func (m *WorkflowInstanceMutation) ResetInput() {
+	m.input = nil
+}
+
+// SetOutput sets the "output" field.
+func (m *WorkflowInstanceMutation) SetOutput(s string) {
+	m.output = &s
+}
+
+// Output returns the value of the "output" field in the mutation.
+func (m *WorkflowInstanceMutation) Output() (r string, exists bool) {
+	v := m.output
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutput returns the old "output" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldOutput(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldOutput is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldOutput requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutput: %w", err)
+	}
+	return oldValue.Output, nil
+}
+
+// ClearOutput clears the value of the "output" field.
+func (m *WorkflowInstanceMutation) ClearOutput() {
+	m.output = nil
+	m.clearedFields[workflowinstance.FieldOutput] = struct{}{}
+}
+
+// OutputCleared returns if the "output" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) OutputCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldOutput]
+	return ok
+}
+
+// ResetOutput resets all changes to the "output" field.
+func (m *WorkflowInstanceMutation) ResetOutput() {
+	m.output = nil
+	delete(m.clearedFields, workflowinstance.FieldOutput)
+}
+
+// SetStateData sets the "stateData" field.
+func (m *WorkflowInstanceMutation) SetStateData(s string) {
+	m.stateData = &s
+}
+
+// StateData returns the value of the "stateData" field in the mutation.
+func (m *WorkflowInstanceMutation) StateData() (r string, exists bool) {
+	v := m.stateData
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStateData returns the old "stateData" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldStateData(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStateData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStateData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStateData: %w", err)
+	}
+	return oldValue.StateData, nil
+}
+
+// ClearStateData clears the value of the "stateData" field.
+func (m *WorkflowInstanceMutation) ClearStateData() {
+	m.stateData = nil
+	m.clearedFields[workflowinstance.FieldStateData] = struct{}{}
+}
+
+// StateDataCleared returns if the "stateData" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) StateDataCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldStateData]
+	return ok
+}
+
+// ResetStateData resets all changes to the "stateData" field.
+func (m *WorkflowInstanceMutation) ResetStateData() {
+	m.stateData = nil
+	delete(m.clearedFields, workflowinstance.FieldStateData)
+}
+
+// SetMemory sets the "memory" field.
+func (m *WorkflowInstanceMutation) SetMemory(s string) {
+	m.memory = &s
+}
+
+// Memory returns the value of the "memory" field in the mutation.
+func (m *WorkflowInstanceMutation) Memory() (r string, exists bool) {
+	v := m.memory
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemory returns the old "memory" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldMemory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMemory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMemory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemory: %w", err)
+	}
+	return oldValue.Memory, nil
+}
+
+// ClearMemory clears the value of the "memory" field.
+func (m *WorkflowInstanceMutation) ClearMemory() {
+	m.memory = nil
+	m.clearedFields[workflowinstance.FieldMemory] = struct{}{}
+}
+
+// MemoryCleared returns if the "memory" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) MemoryCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldMemory]
+	return ok
+}
+
+// ResetMemory resets all changes to the "memory" field.
+func (m *WorkflowInstanceMutation) ResetMemory() {
+	m.memory = nil
+	delete(m.clearedFields, workflowinstance.FieldMemory)
+}
+
+// SetMemoryData sets the "memoryData" field.
+func (m *WorkflowInstanceMutation) SetMemoryData(b []byte) {
+	m.memoryData = &b
+}
+
+// MemoryData returns the value of the "memoryData" field in the mutation.
+func (m *WorkflowInstanceMutation) MemoryData() (r []byte, exists bool) {
+	v := m.memoryData
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemoryData returns the old "memoryData" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldMemoryData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMemoryData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMemoryData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemoryData: %w", err)
+	}
+	return oldValue.MemoryData, nil
+}
+
+// ClearMemoryData clears the value of the "memoryData" field.
+func (m *WorkflowInstanceMutation) ClearMemoryData() {
+	m.memoryData = nil
+	m.clearedFields[workflowinstance.FieldMemoryData] = struct{}{}
+}
+
+// MemoryDataCleared returns if the "memoryData" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) MemoryDataCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldMemoryData]
+	return ok
+}
+
+// ResetMemoryData resets all changes to the "memoryData" field.
+func (m *WorkflowInstanceMutation) ResetMemoryData() {
+	m.memoryData = nil
+	delete(m.clearedFields, workflowinstance.FieldMemoryData)
+}
+
+// SetDeadline sets the "deadline" field.
+func (m *WorkflowInstanceMutation) SetDeadline(t time.Time) {
+	m.deadline = &t
+}
+
+// Deadline returns the value of the "deadline" field in the mutation.
+func (m *WorkflowInstanceMutation) Deadline() (r time.Time, exists bool) {
+	v := m.deadline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeadline returns the old "deadline" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldDeadline(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDeadline is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDeadline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeadline: %w", err)
+	}
+	return oldValue.Deadline, nil
+}
+
+// ClearDeadline clears the value of the "deadline" field.
+func (m *WorkflowInstanceMutation) ClearDeadline() {
+	m.deadline = nil
+	m.clearedFields[workflowinstance.FieldDeadline] = struct{}{}
+}
+
+// DeadlineCleared returns if the "deadline" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) DeadlineCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldDeadline]
+	return ok
+}
+
+// ResetDeadline resets all changes to the "deadline" field.
+func (m *WorkflowInstanceMutation) ResetDeadline() {
+	m.deadline = nil
+	delete(m.clearedFields, workflowinstance.FieldDeadline)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *WorkflowInstanceMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WorkflowInstanceMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *WorkflowInstanceMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WorkflowInstanceMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (m *WorkflowInstanceMutation) ClearAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+	m.clearedFields[workflowinstance.FieldAttempts] = struct{}{}
+}
+
+// AttemptsCleared returns if the "attempts" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) AttemptsCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldAttempts]
+	return ok
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WorkflowInstanceMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+	delete(m.clearedFields, workflowinstance.FieldAttempts)
+}
+
+// SetErrorCode sets the "errorCode" field.
+func (m *WorkflowInstanceMutation) SetErrorCode(s string) {
+	m.errorCode = &s
+}
+
+// ErrorCode returns the value of the "errorCode" field in the mutation.
+func (m *WorkflowInstanceMutation) ErrorCode() (r string, exists bool) {
+	v := m.errorCode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorCode returns the old "errorCode" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldErrorCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldErrorCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldErrorCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorCode: %w", err)
+	}
+	return oldValue.ErrorCode, nil
+}
+
+// ClearErrorCode clears the value of the "errorCode" field.
+func (m *WorkflowInstanceMutation) ClearErrorCode() {
+	m.errorCode = nil
+	m.clearedFields[workflowinstance.FieldErrorCode] = struct{}{}
+}
+
+// ErrorCodeCleared returns if the "errorCode" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) ErrorCodeCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldErrorCode]
+	return ok
+}
+
+// ResetErrorCode resets all changes to the "errorCode" field.
+func (m *WorkflowInstanceMutation) ResetErrorCode() {
+	m.errorCode = nil
+	delete(m.clearedFields, workflowinstance.FieldErrorCode)
+}
+
+// SetErrorMessage sets the "errorMessage" field.
+func (m *WorkflowInstanceMutation) SetErrorMessage(s string) {
+	m.errorMessage = &s
+}
+
+// ErrorMessage returns the value of the "errorMessage" field in the mutation.
+func (m *WorkflowInstanceMutation) ErrorMessage() (r string, exists bool) {
+	v := m.errorMessage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "errorMessage" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "errorMessage" field.
+func (m *WorkflowInstanceMutation) ClearErrorMessage() {
+	m.errorMessage = nil
+	m.clearedFields[workflowinstance.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "errorMessage" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "errorMessage" field.
+func (m *WorkflowInstanceMutation) ResetErrorMessage() {
+	m.errorMessage = nil
+	delete(m.clearedFields, workflowinstance.FieldErrorMessage)
+}
+
+// SetErrorData sets the "errorData" field.
+func (m *WorkflowInstanceMutation) SetErrorData(s string) {
+	m.errorData = &s
+}
+
+// ErrorData returns the value of the "errorData" field in the mutation.
+func (m *WorkflowInstanceMutation) ErrorData() (r string, exists bool) {
+	v := m.errorData
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorData returns the old "errorData" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldErrorData(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldErrorData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldErrorData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorData: %w", err)
+	}
+	return oldValue.ErrorData, nil
+}
+
+// ClearErrorData clears the value of the "errorData" field.
+func (m *WorkflowInstanceMutation) ClearErrorData() {
+	m.errorData = nil
+	m.clearedFields[workflowinstance.FieldErrorData] = struct{}{}
+}
+
+// ErrorDataCleared returns if the "errorData" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) ErrorDataCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldErrorData]
+	return ok
+}
+
+// ResetErrorData resets all changes to the "errorData" field.
+func (m *WorkflowInstanceMutation) ResetErrorData() {
+	m.errorData = nil
+	delete(m.clearedFields, workflowinstance.FieldErrorData)
+}
+
+// SetErrorCauses sets the "errorCauses" field.
+func (m *WorkflowInstanceMutation) SetErrorCauses(sc []schema.ErrorCause) {
+	m.errorCauses = &sc
+}
+
+// ErrorCauses returns the value of the "errorCauses" field in the mutation.
+func (m *WorkflowInstanceMutation) ErrorCauses() (r []schema.ErrorCause, exists bool) {
+	v := m.errorCauses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorCauses returns the old "errorCauses" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldErrorCauses(ctx context.Context) (v []schema.ErrorCause, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldErrorCauses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldErrorCauses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorCauses: %w", err)
+	}
+	return oldValue.ErrorCauses, nil
+}
+
+// ClearErrorCauses clears the value of the "errorCauses" field.
+func (m *WorkflowInstanceMutation) ClearErrorCauses() {
+	m.errorCauses = nil
+	m.clearedFields[workflowinstance.FieldErrorCauses] = struct{}{}
+}
+
+// ErrorCausesCleared returns if the "errorCauses" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) ErrorCausesCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldErrorCauses]
+	return ok
+}
+
+// ResetErrorCauses resets all changes to the "errorCauses" field.
+func (m *WorkflowInstanceMutation) ResetErrorCauses() {
+	m.errorCauses = nil
+	delete(m.clearedFields, workflowinstance.FieldErrorCauses)
+}
+
+// SetCancelCategory sets the "cancelCategory" field.
+func (m *WorkflowInstanceMutation) SetCancelCategory(s string) {
+	m.cancelCategory = &s
+}
+
+// CancelCategory returns the value of the "cancelCategory" field in the mutation.
+func (m *WorkflowInstanceMutation) CancelCategory() (r string, exists bool) {
+	v := m.cancelCategory
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancelCategory returns the old "cancelCategory" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldCancelCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCancelCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCancelCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelCategory: %w", err)
+	}
+	return oldValue.CancelCategory, nil
+}
+
+// ClearCancelCategory clears the value of the "cancelCategory" field.
+func (m *WorkflowInstanceMutation) ClearCancelCategory() {
+	m.cancelCategory = nil
+	m.clearedFields[workflowinstance.FieldCancelCategory] = struct{}{}
+}
+
+// CancelCategoryCleared returns if the "cancelCategory" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) CancelCategoryCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldCancelCategory]
+	return ok
+}
+
+// ResetCancelCategory resets all changes to the "cancelCategory" field.
+func (m *WorkflowInstanceMutation) ResetCancelCategory() {
+	m.cancelCategory = nil
+	delete(m.clearedFields, workflowinstance.FieldCancelCategory)
+}
+
+// SetCancelReason sets the "cancelReason" field.
+func (m *WorkflowInstanceMutation) SetCancelReason(s string) {
+	m.cancelReason = &s
+}
+
+// CancelReason returns the value of the "cancelReason" field in the mutation.
+func (m *WorkflowInstanceMutation) CancelReason() (r string, exists bool) {
+	v := m.cancelReason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancelReason returns the old "cancelReason" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldCancelReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCancelReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCancelReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelReason: %w", err)
+	}
+	return oldValue.CancelReason, nil
+}
+
+// ClearCancelReason clears the value of the "cancelReason" field.
+func (m *WorkflowInstanceMutation) ClearCancelReason() {
+	m.cancelReason = nil
+	m.clearedFields[workflowinstance.FieldCancelReason] = struct{}{}
+}
+
+// CancelReasonCleared returns if the "cancelReason" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) CancelReasonCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldCancelReason]
+	return ok
+}
+
+// ResetCancelReason resets all changes to the "cancelReason" field.
+func (m *WorkflowInstanceMutation) ResetCancelReason() {
+	m.cancelReason = nil
+	delete(m.clearedFields, workflowinstance.FieldCancelReason)
+}
+
+// SetProgress sets the "progress" field.
+func (m *WorkflowInstanceMutation) SetProgress(s string) {
+	m.progress = &s
+}
+
+// Progress returns the value of the "progress" field in the mutation.
+func (m *WorkflowInstanceMutation) Progress() (r string, exists bool) {
+	v := m.progress
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProgress returns the old "progress" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldProgress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldProgress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldProgress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProgress: %w", err)
+	}
+	return oldValue.Progress, nil
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (m *WorkflowInstanceMutation) ClearProgress() {
+	m.progress = nil
+	m.clearedFields[workflowinstance.FieldProgress] = struct{}{}
+}
+
+// ProgressCleared returns if the "progress" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) ProgressCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldProgress]
+	return ok
+}
+
+// ResetProgress resets all changes to the "progress" field.
+func (m *WorkflowInstanceMutation) ResetProgress() {
+	m.progress = nil
+	delete(m.clearedFields, workflowinstance.FieldProgress)
+}
+
+// SetStateBeginTime sets the "stateBeginTime" field.
+func (m *WorkflowInstanceMutation) SetStateBeginTime(t time.Time) {
+	m.stateBeginTime = &t
+}
+
+// StateBeginTime returns the value of the "stateBeginTime" field in the mutation.
+func (m *WorkflowInstanceMutation) StateBeginTime() (r time.Time, exists bool) {
+	v := m.stateBeginTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStateBeginTime returns the old "stateBeginTime" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldStateBeginTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStateBeginTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStateBeginTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStateBeginTime: %w", err)
+	}
+	return oldValue.StateBeginTime, nil
+}
+
+// ClearStateBeginTime clears the value of the "stateBeginTime" field.
+func (m *WorkflowInstanceMutation) ClearStateBeginTime() {
+	m.stateBeginTime = nil
+	m.clearedFields[workflowinstance.FieldStateBeginTime] = struct{}{}
+}
+
+// StateBeginTimeCleared returns if the "stateBeginTime" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) StateBeginTimeCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldStateBeginTime]
+	return ok
+}
+
+// ResetStateBeginTime resets all changes to the "stateBeginTime" field.
+func (m *WorkflowInstanceMutation) ResetStateBeginTime() {
+	m.stateBeginTime = nil
+	delete(m.clearedFields, workflowinstance.FieldStateBeginTime)
+}
+
+// SetController sets the "controller" field.
+func (m *WorkflowInstanceMutation) SetController(s string) {
+	m.controller = &s
+}
+
+// Controller returns the value of the "controller" field in the mutation.
+func (m *WorkflowInstanceMutation) Controller() (r string, exists bool) {
+	v := m.controller
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldController returns the old "controller" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldController(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldController is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldController requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldController: %w", err)
+	}
+	return oldValue.Controller, nil
+}
+
+// ClearController clears the value of the "controller" field.
+func (m *WorkflowInstanceMutation) ClearController() {
+	m.controller = nil
+	m.clearedFields[workflowinstance.FieldController] = struct{}{}
+}
+
+// ControllerCleared returns if the "controller" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) ControllerCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldController]
+	return ok
+}
+
+// ResetController resets all changes to the "controller" field.
+func (m *WorkflowInstanceMutation) ResetController() {
+	m.controller = nil
+	delete(m.clearedFields, workflowinstance.FieldController)
+}
+
+// SetHandoff sets the "handoff" field.
+func (m *WorkflowInstanceMutation) SetHandoff(b []byte) {
+	m.handoff = &b
+}
+
+// Handoff returns the value of the "handoff" field in the mutation.
+func (m *WorkflowInstanceMutation) Handoff() (r []byte, exists bool) {
+	v := m.handoff
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHandoff returns the old "handoff" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldHandoff(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldHandoff is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldHandoff requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHandoff: %w", err)
+	}
+	return oldValue.Handoff, nil
+}
+
+// ClearHandoff clears the value of the "handoff" field.
+func (m *WorkflowInstanceMutation) ClearHandoff() {
+	m.handoff = nil
+	m.clearedFields[workflowinstance.FieldHandoff] = struct{}{}
+}
+
+// HandoffCleared returns if the "handoff" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) HandoffCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldHandoff]
+	return ok
+}
+
+// ResetHandoff resets all changes to the "handoff" field.
+func (m *WorkflowInstanceMutation) ResetHandoff() {
+	m.handoff = nil
+	delete(m.clearedFields, workflowinstance.FieldHandoff)
+}
+
+// SetSimulation sets the "simulation" field.
+func (m *WorkflowInstanceMutation) SetSimulation(b []byte) {
+	m.simulation = &b
+}
+
+// Simulation returns the value of the "simulation" field in the mutation.
+func (m *WorkflowInstanceMutation) Simulation() (r []byte, exists bool) {
+	v := m.simulation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSimulation returns the old "simulation" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldSimulation(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSimulation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSimulation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSimulation: %w", err)
+	}
+	return oldValue.Simulation, nil
+}
+
+// ClearSimulation clears the value of the "simulation" field.
+func (m *WorkflowInstanceMutation) ClearSimulation() {
+	m.simulation = nil
+	m.clearedFields[workflowinstance.FieldSimulation] = struct{}{}
+}
+
+// SimulationCleared returns if the "simulation" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) SimulationCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldSimulation]
+	return ok
+}
+
+// ResetSimulation resets all changes to the "simulation" field.
+func (m *WorkflowInstanceMutation) ResetSimulation() {
+	m.simulation = nil
+	delete(m.clearedFields, workflowinstance.FieldSimulation)
+}
+
+// SetDebug sets the "debug" field.
+func (m *WorkflowInstanceMutation) SetDebug(b []byte) {
+	m.debug = &b
+}
+
+// Debug returns the value of the "debug" field in the mutation.
+func (m *WorkflowInstanceMutation) Debug() (r []byte, exists bool) {
+	v := m.debug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDebug returns the old "debug" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldDebug(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDebug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDebug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDebug: %w", err)
+	}
+	return oldValue.Debug, nil
+}
+
+// ClearDebug clears the value of the "debug" field.
+func (m *WorkflowInstanceMutation) ClearDebug() {
+	m.debug = nil
+	m.clearedFields[workflowinstance.FieldDebug] = struct{}{}
+}
+
+// DebugCleared returns if the "debug" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) DebugCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldDebug]
+	return ok
+}
+
+// ResetDebug resets all changes to the "debug" field.
+func (m *WorkflowInstanceMutation) ResetDebug() {
+	m.debug = nil
+	delete(m.clearedFields, workflowinstance.FieldDebug)
+}
+
+// SetHold sets the "hold" field.
+func (m *WorkflowInstanceMutation) SetHold(s string) {
+	m.hold = &s
+}
+
+// Hold returns the value of the "hold" field in the mutation.
+func (m *WorkflowInstanceMutation) Hold() (r string, exists bool) {
+	v := m.hold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHold returns the old "hold" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldHold(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldHold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldHold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHold: %w", err)
+	}
+	return oldValue.Hold, nil
+}
+
+// ClearHold clears the value of the "hold" field.
+func (m *WorkflowInstanceMutation) ClearHold() {
+	m.hold = nil
+	m.clearedFields[workflowinstance.FieldHold] = struct{}{}
+}
+
+// HoldCleared returns if the "hold" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) HoldCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldHold]
+	return ok
+}
+
+// ResetHold resets all changes to the "hold" field.
+func (m *WorkflowInstanceMutation) ResetHold() {
+	m.hold = nil
+	delete(m.clearedFields, workflowinstance.FieldHold)
+}
+
+// SetProfile sets the "profile" field.
+func (m *WorkflowInstanceMutation) SetProfile(s string) {
+	m.profile = &s
+}
+
+// Profile returns the value of the "profile" field in the mutation.
+func (m *WorkflowInstanceMutation) Profile() (r string, exists bool) {
+	v := m.profile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfile returns the old "profile" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldProfile(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldProfile is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldProfile requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfile: %w", err)
+	}
+	return oldValue.Profile, nil
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (m *WorkflowInstanceMutation) ClearProfile() {
+	m.profile = nil
+	m.clearedFields[workflowinstance.FieldProfile] = struct{}{}
+}
+
+// ProfileCleared returns if the "profile" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) ProfileCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldProfile]
+	return ok
+}
+
+// ResetProfile resets all changes to the "profile" field.
+func (m *WorkflowInstanceMutation) ResetProfile() {
+	m.profile = nil
+	delete(m.clearedFields, workflowinstance.FieldProfile)
+}
+
+// SetReceivedEvents sets the "receivedEvents" field.
+func (m *WorkflowInstanceMutation) SetReceivedEvents(s string) {
+	m.receivedEvents = &s
+}
+
+// ReceivedEvents returns the value of the "receivedEvents" field in the mutation.
+func (m *WorkflowInstanceMutation) ReceivedEvents() (r string, exists bool) {
+	v := m.receivedEvents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceivedEvents returns the old "receivedEvents" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldReceivedEvents(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldReceivedEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldReceivedEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceivedEvents: %w", err)
+	}
+	return oldValue.ReceivedEvents, nil
+}
+
+// ClearReceivedEvents clears the value of the "receivedEvents" field.
+func (m *WorkflowInstanceMutation) ClearReceivedEvents() {
+	m.receivedEvents = nil
+	m.clearedFields[workflowinstance.FieldReceivedEvents] = struct{}{}
+}
+
+// ReceivedEventsCleared returns if the "receivedEvents" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) ReceivedEventsCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldReceivedEvents]
+	return ok
+}
+
+// ResetReceivedEvents resets all changes to the "receivedEvents" field.
+func (m *WorkflowInstanceMutation) ResetReceivedEvents() {
+	m.receivedEvents = nil
+	delete(m.clearedFields, workflowinstance.FieldReceivedEvents)
+}
+
+// SetAwaitingCancels sets the "awaitingCancels" field.
+func (m *WorkflowInstanceMutation) SetAwaitingCancels(b []byte) {
+	m.awaitingCancels = &b
+}
+
+// AwaitingCancels returns the value of the "awaitingCancels" field in the mutation.
+func (m *WorkflowInstanceMutation) AwaitingCancels() (r []byte, exists bool) {
+	v := m.awaitingCancels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAwaitingCancels returns the old "awaitingCancels" field's value of the WorkflowInstance entity.
+// If the WorkflowInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowInstanceMutation) OldAwaitingCancels(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldAwaitingCancels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldAwaitingCancels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAwaitingCancels: %w", err)
+	}
+	return oldValue.AwaitingCancels, nil
+}
+
+// ClearAwaitingCancels clears the value of the "awaitingCancels" field.
+func (m *WorkflowInstanceMutation) ClearAwaitingCancels() {
+	m.awaitingCancels = nil
+	m.clearedFields[workflowinstance.FieldAwaitingCancels] = struct{}{}
+}
+
+// AwaitingCancelsCleared returns if the "awaitingCancels" field was cleared in this mutation.
+func (m *WorkflowInstanceMutation) AwaitingCancelsCleared() bool {
+	_, ok := m.clearedFields[workflowinstance.FieldAwaitingCancels]
+	return ok
+}
+
+// ResetAwaitingCancels resets all changes to the "awaitingCancels" field.
+func (m *WorkflowInstanceMutation) ResetAwaitingCancels() {
+	m.awaitingCancels = nil
+	delete(m.clearedFields, workflowinstance.FieldAwaitingCancels)
+}
+
+// SetWorkflowID sets the "workflow" edge to the Workflow entity by id.
+func (m *WorkflowInstanceMutation) SetWorkflowID(id uuid.UUID) {
+	m.workflow = &id
+}
+
+// ClearWorkflow clears the "workflow" edge to the Workflow entity.
+func (m *WorkflowInstanceMutation) ClearWorkflow() {
+	m.clearedworkflow = true
+}
+
+// WorkflowCleared reports if the "workflow" edge to the Workflow entity was cleared.
+func (m *WorkflowInstanceMutation) WorkflowCleared() bool {
+	return m.clearedworkflow
+}
+
+// WorkflowID returns the "workflow" edge ID in the mutation.
+func (m *WorkflowInstanceMutation) WorkflowID() (id uuid.UUID, exists bool) {
+	if m.workflow != nil {
+		return *m.workflow, true
+	}
+	return
+}
+
+// WorkflowIDs returns the "workflow" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkflowID instead. It exists only for internal usage by the builders.
+func (m *WorkflowInstanceMutation) WorkflowIDs() (ids []uuid.UUID) {
+	if id := m.workflow; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkflow resets all changes to the "workflow" edge.
+func (m *WorkflowInstanceMutation) ResetWorkflow() {
+	m.workflow = nil
+	m.clearedworkflow = false
+}
+
+// AddInstanceIDs adds the "instance" edge to the WorkflowEvents entity by ids.
+func (m *WorkflowInstanceMutation) AddInstanceIDs(ids ...int) {
+	if m.instance == nil {
+		m.instance = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.instance[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInstance clears the "instance" edge to the WorkflowEvents entity.
+func (m *WorkflowInstanceMutation) ClearInstance() {
+	m.clearedinstance = true
+}
+
+// InstanceCleared reports if the "instance" edge to the WorkflowEvents entity was cleared.
+func (m *WorkflowInstanceMutation) InstanceCleared() bool {
+	return m.clearedinstance
+}
+
+// RemoveInstanceIDs removes the "instance" edge to the WorkflowEvents entity by IDs.
+func (m *WorkflowInstanceMutation) RemoveInstanceIDs(ids ...int) {
+	if m.removedinstance == nil {
+		m.removedinstance = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedinstance[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInstance returns the removed IDs of the "instance" edge to the WorkflowEvents entity.
+func (m *WorkflowInstanceMutation) RemovedInstanceIDs() (ids []int) {
+	for id := range m.removedinstance {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InstanceIDs returns the "instance" edge IDs in the mutation.
+func (m *WorkflowInstanceMutation) InstanceIDs() (ids []int) {
+	for id := range m.instance {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInstance resets all changes to the "instance" edge.
+func (m *WorkflowInstanceMutation) ResetInstance() {
+	m.instance = nil
+	m.clearedinstance = false
+	m.removedinstance = nil
+}
+
+// Op returns the operation name.
+func (m *WorkflowInstanceMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (WorkflowInstance).
+func (m *WorkflowInstanceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WorkflowInstanceMutation) Fields() []string {
+	fields := make([]string, 0, 31)
+	if m.instanceID != nil {
+		fields = append(fields, workflowinstance.FieldInstanceID)
+	}
+	if m.externalID != nil {
+		fields = append(fields, workflowinstance.FieldExternalID)
+	}
+	if m.invokedBy != nil {
+		fields = append(fields, workflowinstance.FieldInvokedBy)
+	}
+	if m.status != nil {
+		fields = append(fields, workflowinstance.FieldStatus)
+	}
+	if m.revision != nil {
+		fields = append(fields, workflowinstance.FieldRevision)
+	}
+	if m.beginTime != nil {
+		fields = append(fields, workflowinstance.FieldBeginTime)
+	}
+	if m.endTime != nil {
+		fields = append(fields, workflowinstance.FieldEndTime)
+	}
+	if m.flow != nil {
+		fields = append(fields, workflowinstance.FieldFlow)
+	}
+	if m.input != nil {
+		fields = append(fields, workflowinstance.FieldInput)
+	}
+	if m.output != nil {
+		fields = append(fields, workflowinstance.FieldOutput)
+	}
+	if m.stateData != nil {
+		fields = append(fields, workflowinstance.FieldStateData)
+	}
+	if m.memory != nil {
+		fields = append(fields, workflowinstance.FieldMemory)
+	}
+	if m.memoryData != nil {
+		fields = append(fields, workflowinstance.FieldMemoryData)
+	}
+	if m.deadline != nil {
+		fields = append(fields, workflowinstance.FieldDeadline)
+	}
+	if m.attempts != nil {
+		fields = append(fields, workflowinstance.FieldAttempts)
+	}
+	if m.errorCode != nil {
+		fields = append(fields, workflowinstance.FieldErrorCode)
+	}
+	if m.errorMessage != nil {
+		fields = append(fields, workflowinstance.FieldErrorMessage)
+	}
+	if m.errorData != nil {
+		fields = append(fields, workflowinstance.FieldErrorData)
+	}
+	if m.errorCauses != nil {
+		fields = append(fields, workflowinstance.FieldErrorCauses)
+	}
+	if m.cancelCategory != nil {
+		fields = append(fields, workflowinstance.FieldCancelCategory)
+	}
+	if m.cancelReason != nil {
+		fields = append(fields, workflowinstance.FieldCancelReason)
+	}
+	if m.progress != nil {
+		fields = append(fields, workflowinstance.FieldProgress)
+	}
+	if m.stateBeginTime != nil {
+		fields = append(fields, workflowinstance.FieldStateBeginTime)
+	}
+	if m.controller != nil {
+		fields = append(fields, workflowinstance.FieldController)
+	}
+	if m.handoff != nil {
+		fields = append(fields, workflowinstance.FieldHandoff)
+	}
+	if m.simulation != nil {
+		fields = append(fields, workflowinstance.FieldSimulation)
+	}
+	if m.debug != nil {
+		fields = append(fields, workflowinstance.FieldDebug)
+	}
+	if m.hold != nil {
+		fields = append(fields, workflowinstance.FieldHold)
+	}
+	if m.profile != nil {
+		fields = append(fields, workflowinstance.FieldProfile)
+	}
+	if m.receivedEvents != nil {
+		fields = append(fields, workflowinstance.FieldReceivedEvents)
+	}
+	if m.awaitingCancels != nil {
+		fields = append(fields, workflowinstance.FieldAwaitingCancels)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WorkflowInstanceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case workflowinstance.FieldInstanceID:
+		return m.InstanceID()
+	case workflowinstance.FieldExternalID:
+		return m.ExternalID()
+	case workflowinstance.FieldInvokedBy:
+		return m.InvokedBy()
+	case workflowinstance.FieldStatus:
+		return m.Status()
+	case workflowinstance.FieldRevision:
+		return m.Revision()
+	case workflowinstance.FieldBeginTime:
+		return m.BeginTime()
+	case workflowinstance.FieldEndTime:
+		return m.EndTime()
+	case workflowinstance.FieldFlow:
+		return m.Flow()
+	case workflowinstance.FieldInput:
+		return m.Input()
+	case workflowinstance.FieldOutput:
+		return m.Output()
+	case workflowinstance.FieldStateData:
+		return m.StateData()
+	case workflowinstance.FieldMemory:
+		return m.Memory()
+	case workflowinstance.FieldMemoryData:
+		return m.MemoryData()
+	case workflowinstance.FieldDeadline:
+		return m.Deadline()
+	case workflowinstance.FieldAttempts:
+		return m.Attempts()
+	case workflowinstance.FieldErrorCode:
+		return m.ErrorCode()
+	case workflowinstance.FieldErrorMessage:
+		return m.ErrorMessage()
+	case workflowinstance.FieldErrorData:
+		return m.ErrorData()
+	case workflowinstance.FieldErrorCauses:
+		return m.ErrorCauses()
+	case workflowinstance.FieldCancelCategory:
+		return m.CancelCategory()
+	case workflowinstance.FieldCancelReason:
+		return m.CancelReason()
+	case workflowinstance.FieldProgress:
+		return m.Progress()
+	case workflowinstance.FieldStateBeginTime:
+		return m.StateBeginTime()
+	case workflowinstance.FieldController:
+		return m.Controller()
+	case workflowinstance.FieldHandoff:
+		return m.Handoff()
+	case workflowinstance.FieldSimulation:
+		return m.Simulation()
+	case workflowinstance.FieldDebug:
+		return m.Debug()
+	case workflowinstance.FieldHold:
+		return m.Hold()
+	case workflowinstance.FieldProfile:
+		return m.Profile()
+	case workflowinstance.FieldReceivedEvents:
+		return m.ReceivedEvents()
+	case workflowinstance.FieldAwaitingCancels:
+		return m.AwaitingCancels()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WorkflowInstanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case workflowinstance.FieldInstanceID:
+		return m.OldInstanceID(ctx)
+	case workflowinstance.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case workflowinstance.FieldInvokedBy:
+		return m.OldInvokedBy(ctx)
+	case workflowinstance.FieldStatus:
+		return m.OldStatus(ctx)
+	case workflowinstance.FieldRevision:
+		return m.OldRevision(ctx)
+	case workflowinstance.FieldBeginTime:
+		return m.OldBeginTime(ctx)
+	case workflowinstance.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case workflowinstance.FieldFlow:
+		return m.OldFlow(ctx)
+	case workflowinstance.FieldInput:
+		return m.OldInput(ctx)
+	case workflowinstance.FieldOutput:
+		return m.OldOutput(ctx)
+	case workflowinstance.FieldStateData:
+		return m.OldStateData(ctx)
+	case workflowinstance.FieldMemory:
+		return m.OldMemory(ctx)
+	case workflowinstance.FieldMemoryData:
+		return m.OldMemoryData(ctx)
+	case workflowinstance.FieldDeadline:
+		return m.OldDeadline(ctx)
+	case workflowinstance.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case workflowinstance.FieldErrorCode:
+		return m.OldErrorCode(ctx)
+	case workflowinstance.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case workflowinstance.FieldErrorData:
+		return m.OldErrorData(ctx)
+	case workflowinstance.FieldErrorCauses:
+		return m.OldErrorCauses(ctx)
+	case workflowinstance.FieldCancelCategory:
+		return m.OldCancelCategory(ctx)
+	case workflowinstance.FieldCancelReason:
+		return m.OldCancelReason(ctx)
+	case workflowinstance.FieldProgress:
+		return m.OldProgress(ctx)
+	case workflowinstance.FieldStateBeginTime:
+		return m.OldStateBeginTime(ctx)
+	case workflowinstance.FieldController:
+		return m.OldController(ctx)
+	case workflowinstance.FieldHandoff:
+		return m.OldHandoff(ctx)
+	case workflowinstance.FieldSimulation:
+		return m.OldSimulation(ctx)
+	case workflowinstance.FieldDebug:
+		return m.OldDebug(ctx)
+	case workflowinstance.FieldHold:
+		return m.OldHold(ctx)
+	case workflowinstance.FieldProfile:
+		return m.OldProfile(ctx)
+	case workflowinstance.FieldReceivedEvents:
+		return m.OldReceivedEvents(ctx)
+	case workflowinstance.FieldAwaitingCancels:
+		return m.OldAwaitingCancels(ctx)
+	}
+	return nil, fmt.Errorf("unknown WorkflowInstance field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WorkflowInstanceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case workflowinstance.FieldInstanceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstanceID(v)
+		return nil
+	case workflowinstance.FieldExternalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalID(v)
+		return nil
+	case workflowinstance.FieldInvokedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInvokedBy(v)
+		return nil
+	case workflowinstance.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case workflowinstance.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	case workflowinstance.FieldBeginTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBeginTime(v)
+		return nil
+	case workflowinstance.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case workflowinstance.FieldFlow:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlow(v)
+		return nil
+	case workflowinstance.FieldInput:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInput(v)
+		return nil
+	case workflowinstance.FieldOutput:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutput(v)
+		return nil
+	case workflowinstance.FieldStateData:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStateData(v)
+		return nil
+	case workflowinstance.FieldMemory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemory(v)
+		return nil
+	case workflowinstance.FieldMemoryData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemoryData(v)
+		return nil
+	case workflowinstance.FieldDeadline:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeadline(v)
+		return nil
+	case workflowinstance.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case workflowinstance.FieldErrorCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorCode(v)
+		return nil
+	case workflowinstance.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case workflowinstance.FieldErrorData:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorData(v)
+		return nil
+	case workflowinstance.FieldErrorCauses:
+		v, ok := value.([]schema.ErrorCause)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorCauses(v)
+		return nil
+	case workflowinstance.FieldCancelCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelCategory(v)
+		return nil
+	case workflowinstance.FieldCancelReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelReason(v)
+		return nil
+	case workflowinstance.FieldProgress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProgress(v)
+		return nil
+	case workflowinstance.FieldStateBeginTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStateBeginTime(v)
+		return nil
+	case workflowinstance.FieldController:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetController(v)
+		return nil
+	case workflowinstance.FieldHandoff:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHandoff(v)
+		return nil
+	case workflowinstance.FieldSimulation:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSimulation(v)
+		return nil
+	case workflowinstance.FieldDebug:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDebug(v)
+		return nil
+	case workflowinstance.FieldHold:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHold(v)
+		return nil
+	case workflowinstance.FieldProfile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfile(v)
+		return nil
+	case workflowinstance.FieldReceivedEvents:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceivedEvents(v)
+		return nil
+	case workflowinstance.FieldAwaitingCancels:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAwaitingCancels(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowInstance field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WorkflowInstanceMutation) AddedFields() []string {
+	var fields []string
+	if m.addrevision != nil {
+		fields = append(fields, workflowinstance.FieldRevision)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, workflowinstance.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WorkflowInstanceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case workflowinstance.FieldRevision:
+		return m.AddedRevision()
+	case workflowinstance.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WorkflowInstanceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case workflowinstance.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
+	case workflowinstance.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowInstance numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WorkflowInstanceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(workflowinstance.FieldExternalID) {
+		fields = append(fields, workflowinstance.FieldExternalID)
+	}
+	if m.FieldCleared(workflowinstance.FieldEndTime) {
+		fields = append(fields, workflowinstance.FieldEndTime)
+	}
+	if m.FieldCleared(workflowinstance.FieldFlow) {
+		fields = append(fields, workflowinstance.FieldFlow)
+	}
+	if m.FieldCleared(workflowinstance.FieldOutput) {
+		fields = append(fields, workflowinstance.FieldOutput)
+	}
+	if m.FieldCleared(workflowinstance.FieldStateData) {
+		fields = append(fields, workflowinstance.FieldStateData)
+	}
+	if m.FieldCleared(workflowinstance.FieldMemory) {
+		fields = append(fields, workflowinstance.FieldMemory)
+	}
+	if m.FieldCleared(workflowinstance.FieldMemoryData) {
+		fields = append(fields, workflowinstance.FieldMemoryData)
+	}
+	if m.FieldCleared(workflowinstance.FieldDeadline) {
+		fields = append(fields, workflowinstance.FieldDeadline)
+	}
+	if m.FieldCleared(workflowinstance.FieldAttempts) {
+		fields = append(fields, workflowinstance.FieldAttempts)
+	}
+	if m.FieldCleared(workflowinstance.FieldErrorCode) {
+		fields = append(fields, workflowinstance.FieldErrorCode)
+	}
+	if m.FieldCleared(workflowinstance.FieldErrorMessage) {
+		fields = append(fields, workflowinstance.FieldErrorMessage)
+	}
+	if m.FieldCleared(workflowinstance.FieldErrorData) {
+		fields = append(fields, workflowinstance.FieldErrorData)
+	}
+	if m.FieldCleared(workflowinstance.FieldErrorCauses) {
+		fields = append(fields, workflowinstance.FieldErrorCauses)
+	}
+	if m.FieldCleared(workflowinstance.FieldCancelCategory) {
+		fields = append(fields, workflowinstance.FieldCancelCategory)
+	}
+	if m.FieldCleared(workflowinstance.FieldCancelReason) {
+		fields = append(fields, workflowinstance.FieldCancelReason)
+	}
+	if m.FieldCleared(workflowinstance.FieldProgress) {
+		fields = append(fields, workflowinstance.FieldProgress)
+	}
+	if m.FieldCleared(workflowinstance.FieldStateBeginTime) {
+		fields = append(fields, workflowinstance.FieldStateBeginTime)
+	}
+	if m.FieldCleared(workflowinstance.FieldController) {
+		fields = append(fields, workflowinstance.FieldController)
+	}
+	if m.FieldCleared(workflowinstance.FieldHandoff) {
+		fields = append(fields, workflowinstance.FieldHandoff)
+	}
+	if m.FieldCleared(workflowinstance.FieldSimulation) {
+		fields = append(fields, workflowinstance.FieldSimulation)
+	}
+	if m.FieldCleared(workflowinstance.FieldDebug) {
+		fields = append(fields, workflowinstance.FieldDebug)
+	}
+	if m.FieldCleared(workflowinstance.FieldHold) {
+		fields = append(fields, workflowinstance.FieldHold)
+	}
+	if m.FieldCleared(workflowinstance.FieldProfile) {
+		fields = append(fields, workflowinstance.FieldProfile)
+	}
+	if m.FieldCleared(workflowinstance.FieldReceivedEvents) {
+		fields = append(fields, workflowinstance.FieldReceivedEvents)
+	}
+	if m.FieldCleared(workflowinstance.FieldAwaitingCancels) {
+		fields = append(fields, workflowinstance.FieldAwaitingCancels)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WorkflowInstanceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WorkflowInstanceMutation) ClearField(name string) error {
+	switch name {
+	case workflowinstance.FieldExternalID:
+		m.ClearExternalID()
+		return nil
+	case workflowinstance.FieldEndTime:
+		m.ClearEndTime()
+		return nil
+	case workflowinstance.FieldFlow:
+		m.ClearFlow()
+		return nil
+	case workflowinstance.FieldOutput:
+		m.ClearOutput()
+		return nil
+	case workflowinstance.FieldStateData:
+		m.ClearStateData()
+		return nil
+	case workflowinstance.FieldMemory:
+		m.ClearMemory()
+		return nil
+	case workflowinstance.FieldMemoryData:
+		m.ClearMemoryData()
+		return nil
+	case workflowinstance.FieldDeadline:
+		m.ClearDeadline()
+		return nil
+	case workflowinstance.FieldAttempts:
+		m.ClearAttempts()
+		return nil
+	case workflowinstance.FieldErrorCode:
+		m.ClearErrorCode()
+		return nil
+	case workflowinstance.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case workflowinstance.FieldErrorData:
+		m.ClearErrorData()
+		return nil
+	case workflowinstance.FieldErrorCauses:
+		m.ClearErrorCauses()
+		return nil
+	case workflowinstance.FieldCancelCategory:
+		m.ClearCancelCategory()
+		return nil
+	case workflowinstance.FieldCancelReason:
+		m.ClearCancelReason()
+		return nil
+	case workflowinstance.FieldProgress:
+		m.ClearProgress()
+		return nil
+	case workflowinstance.FieldStateBeginTime:
+		m.ClearStateBeginTime()
+		return nil
+	case workflowinstance.FieldController:
+		m.ClearController()
+		return nil
+	case workflowinstance.FieldHandoff:
+		m.ClearHandoff()
+		return nil
+	case workflowinstance.FieldSimulation:
+		m.ClearSimulation()
+		return nil
+	case workflowinstance.FieldDebug:
+		m.ClearDebug()
+		return nil
+	case workflowinstance.FieldHold:
+		m.ClearHold()
+		return nil
+	case workflowinstance.FieldProfile:
+		m.ClearProfile()
+		return nil
+	case workflowinstance.FieldReceivedEvents:
+		m.ClearReceivedEvents()
+		return nil
+	case workflowinstance.FieldAwaitingCancels:
+		m.ClearAwaitingCancels()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowInstance nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WorkflowInstanceMutation) ResetField(name string) error {
+	switch name {
+	case workflowinstance.FieldInstanceID:
+		m.ResetInstanceID()
+		return nil
+	case workflowinstance.FieldExternalID:
+		m.ResetExternalID()
+		return nil
+	case workflowinstance.FieldInvokedBy:
+		m.ResetInvokedBy()
+		return nil
+	case workflowinstance.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case workflowinstance.FieldRevision:
+		m.ResetRevision()
+		return nil
+	case workflowinstance.FieldBeginTime:
+		m.ResetBeginTime()
+		return nil
+	case workflowinstance.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case workflowinstance.FieldFlow:
+		m.ResetFlow()
+		return nil
+	case workflowinstance.FieldInput:
+		m.ResetInput()
+		return nil
+	case workflowinstance.FieldOutput:
+		m.ResetOutput()
+		return nil
+	case workflowinstance.FieldStateData:
+		m.ResetStateData()
+		return nil
+	case workflowinstance.FieldMemory:
+		m.ResetMemory()
+		return nil
+	case workflowinstance.FieldMemoryData:
+		m.ResetMemoryData()
+		return nil
+	case workflowinstance.FieldDeadline:
+		m.ResetDeadline()
+		return nil
+	case workflowinstance.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case workflowinstance.FieldErrorCode:
+		m.ResetErrorCode()
+		return nil
+	case workflowinstance.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case workflowinstance.FieldErrorData:
+		m.ResetErrorData()
+		return nil
+	case workflowinstance.FieldErrorCauses:
+		m.ResetErrorCauses()
+		return nil
+	case workflowinstance.FieldCancelCategory:
+		m.ResetCancelCategory()
+		return nil
+	case workflowinstance.FieldCancelReason:
+		m.ResetCancelReason()
+		return nil
+	case workflowinstance.FieldProgress:
+		m.ResetProgress()
+		return nil
+	case workflowinstance.FieldStateBeginTime:
+		m.ResetStateBeginTime()
+		return nil
+	case workflowinstance.FieldController:
+		m.ResetController()
+		return nil
+	case workflowinstance.FieldHandoff:
+		m.ResetHandoff()
+		return nil
+	case workflowinstance.FieldSimulation:
+		m.ResetSimulation()
+		return nil
+	case workflowinstance.FieldDebug:
+		m.ResetDebug()
+		return nil
+	case workflowinstance.FieldHold:
+		m.ResetHold()
+		return nil
+	case workflowinstance.FieldProfile:
+		m.ResetProfile()
+		return nil
+	case workflowinstance.FieldReceivedEvents:
+		m.ResetReceivedEvents()
+		return nil
+	case workflowinstance.FieldAwaitingCancels:
+		m.ResetAwaitingCancels()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowInstance field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WorkflowInstanceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.workflow != nil {
+		edges = append(edges, workflowinstance.EdgeWorkflow)
+	}
+	if m.instance != nil {
+		edges = append(edges, workflowinstance.EdgeInstance)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WorkflowInstanceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case workflowinstance.EdgeWorkflow:
+		if id := m.workflow; id != nil {
+			return []ent.Value{*id}
+		}
+	case workflowinstance.EdgeInstance:
+		ids := make([]ent.Value, 0, len(m.instance))
+		for id := range m.instance {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WorkflowInstanceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedinstance != nil {
+		edges = append(edges, workflowinstance.EdgeInstance)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WorkflowInstanceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case workflowinstance.EdgeInstance:
+		ids := make([]ent.Value, 0, len(m.removedinstance))
+		for id := range m.removedinstance {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WorkflowInstanceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedworkflow {
+		edges = append(edges, workflowinstance.EdgeWorkflow)
+	}
+	if m.clearedinstance {
+		edges = append(edges, workflowinstance.EdgeInstance)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WorkflowInstanceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case workflowinstance.EdgeWorkflow:
+		return m.clearedworkflow
+	case workflowinstance.EdgeInstance:
+		return m.clearedinstance
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WorkflowInstanceMutation) ClearEdge(name string) error {
+	switch name {
+	case workflowinstance.EdgeWorkflow:
+		m.ClearWorkflow()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowInstance unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WorkflowInstanceMutation) ResetEdge(name string) error {
+	switch name {
+	case workflowinstance.EdgeWorkflow:
+		m.ResetWorkflow()
+		return nil
+	case workflowinstance.EdgeInstance:
+		m.ResetInstance()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowInstance edge %s", name)
+}
+
+// WorkflowRevisionMutation represents an operation that mutates the WorkflowRevision nodes in the graph.
+type WorkflowRevisionMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	revision        *int
+	addrevision     *int
+	definition      *[]byte
+	clearedFields   map[string]struct{}
+	workflow        *uuid.UUID
+	clearedworkflow bool
+	done            bool
+	oldValue        func(context.Context) (*WorkflowRevision, error)
+	predicates      []predicate.WorkflowRevision
+}
+
+var _ ent.Mutation = (*WorkflowRevisionMutation)(nil)
+
+// workflowrevisionOption allows management of the mutation configuration using functional options.
+type workflowrevisionOption func(*WorkflowRevisionMutation)
+
+// newWorkflowRevisionMutation creates new mutation for the WorkflowRevision entity.
+func newWorkflowRevisionMutation(c config, op Op, opts ...workflowrevisionOption) *WorkflowRevisionMutation {
+	m := &WorkflowRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWorkflowRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWorkflowRevisionID sets the ID field of the mutation.
+func withWorkflowRevisionID(id int) workflowrevisionOption {
+	return func(m *WorkflowRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WorkflowRevision
+		)
+		m.oldValue = func(ctx context.Context) (*WorkflowRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WorkflowRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWorkflowRevision sets the old WorkflowRevision of the mutation.
+func withWorkflowRevision(node *WorkflowRevision) workflowrevisionOption {
+	return func(m *WorkflowRevisionMutation) {
+		m.oldValue = func(context.Context) (*WorkflowRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WorkflowRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WorkflowRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *WorkflowRevisionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetRevision sets the "revision" field.
+func (m *WorkflowRevisionMutation) SetRevision(i int) {
+	m.revision = &i
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *WorkflowRevisionMutation) Revision() (r int, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the WorkflowRevision entity.
+// If the WorkflowRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowRevisionMutation) OldRevision(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds i to the "revision" field.
+func (m *WorkflowRevisionMutation) AddRevision(i int) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *WorkflowRevisionMutation) AddedRevision() (r int, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *WorkflowRevisionMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// SetDefinition sets the "definition" field.
+func (m *WorkflowRevisionMutation) SetDefinition(b []byte) {
+	m.definition = &b
+}
+
+// Definition returns the value of the "definition" field in the mutation.
+func (m *WorkflowRevisionMutation) Definition() (r []byte, exists bool) {
+	v := m.definition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefinition returns the old "definition" field's value of the WorkflowRevision entity.
+// If the WorkflowRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowRevisionMutation) OldDefinition(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDefinition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDefinition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefinition: %w", err)
+	}
+	return oldValue.Definition, nil
+}
+
+// ResetDefinition resets all changes to the "definition" field.
+func (m *WorkflowRevisionMutation) ResetDefinition() {
+	m.definition = nil
+}
+
+// SetWorkflowID sets the "workflow" edge to the Workflow entity by id.
+func (m *WorkflowRevisionMutation) SetWorkflowID(id uuid.UUID) {
+	m.workflow = &id
+}
+
+// ClearWorkflow clears the "workflow" edge to the Workflow entity.
+func (m *WorkflowRevisionMutation) ClearWorkflow() {
+	m.clearedworkflow = true
+}
+
+// WorkflowCleared reports if the "workflow" edge to the Workflow entity was cleared.
+func (m *WorkflowRevisionMutation) WorkflowCleared() bool {
+	return m.clearedworkflow
+}
+
+// WorkflowID returns the "workflow" edge ID in the mutation.
+func (m *WorkflowRevisionMutation) WorkflowID() (id uuid.UUID, exists bool) {
+	if m.workflow != nil {
+		return *m.workflow, true
+	}
+	return
+}
+
+// WorkflowIDs returns the "workflow" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkflowID instead. It exists only for internal usage by the builders.
+func (m *WorkflowRevisionMutation) WorkflowIDs() (ids []uuid.UUID) {
+	if id := m.workflow; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkflow resets all changes to the "workflow" edge.
+func (m *WorkflowRevisionMutation) ResetWorkflow() {
+	m.workflow = nil
+	m.clearedworkflow = false
+}
+
+// Op returns the operation name.
+func (m *WorkflowRevisionMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (WorkflowRevision).
+func (m *WorkflowRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WorkflowRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.revision != nil {
+		fields = append(fields, workflowrevision.FieldRevision)
+	}
+	if m.definition != nil {
+		fields = append(fields, workflowrevision.FieldDefinition)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WorkflowRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case workflowrevision.FieldRevision:
+		return m.Revision()
+	case workflowrevision.FieldDefinition:
+		return m.Definition()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WorkflowRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case workflowrevision.FieldRevision:
+		return m.OldRevision(ctx)
+	case workflowrevision.FieldDefinition:
+		return m.OldDefinition(ctx)
+	}
+	return nil, fmt.Errorf("unknown WorkflowRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WorkflowRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case workflowrevision.FieldRevision:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAttempts(v)
-		return nil
-	case workflowinstance.FieldErrorCode:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetErrorCode(v)
-		return nil
-	case workflowinstance.FieldErrorMessage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetErrorMessage(v)
-		return nil
-	case workflowinstance.FieldStateBeginTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStateBeginTime(v)
+		m.SetRevision(v)
 		return nil
-	case workflowinstance.FieldController:
-		v, ok := value.(string)
+	case workflowrevision.FieldDefinition:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetController(v)
+		m.SetDefinition(v)
 		return nil
 	}
-	return fmt.Errorf("unknown WorkflowInstance field %s", name)
+	return fmt.Errorf("unknown WorkflowRevision field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *WorkflowInstanceMutation) AddedFields() []string {
+func (m *WorkflowRevisionMutation) AddedFields() []string {
 	var fields []string
 	if m.addrevision != nil {
-		fields = append(fields, workflowinstance.FieldRevision)
-	}
-	if m.addattempts != nil {
-		fields = append(fields, workflowinstance.FieldAttempts)
+		fields = append(fields, workflowrevision.FieldRevision)
 	}
 	return fields
 }
@@ -3722,12 +13659,10 @@ func (m *WorkflowInstanceMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *WorkflowInstanceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WorkflowRevisionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case workflowinstance.FieldRevision:
+	case workflowrevision.FieldRevision:
 		return m.AddedRevision()
-	case workflowinstance.FieldAttempts:
-		return m.AddedAttempts()
 	}
 	return nil, false
 }
@@ -3735,271 +13670,124 @@ func (m *WorkflowInstanceMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WorkflowInstanceMutation) AddField(name string, value ent.Value) error {
+func (m *WorkflowRevisionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case workflowinstance.FieldRevision:
+	case workflowrevision.FieldRevision:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddRevision(v)
 		return nil
-	case workflowinstance.FieldAttempts:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAttempts(v)
-		return nil
 	}
-	return fmt.Errorf("unknown WorkflowInstance numeric field %s", name)
+	return fmt.Errorf("unknown WorkflowRevision numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *WorkflowInstanceMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(workflowinstance.FieldEndTime) {
-		fields = append(fields, workflowinstance.FieldEndTime)
-	}
-	if m.FieldCleared(workflowinstance.FieldFlow) {
-		fields = append(fields, workflowinstance.FieldFlow)
-	}
-	if m.FieldCleared(workflowinstance.FieldOutput) {
-		fields = append(fields, workflowinstance.FieldOutput)
-	}
-	if m.FieldCleared(workflowinstance.FieldStateData) {
-		fields = append(fields, workflowinstance.FieldStateData)
-	}
-	if m.FieldCleared(workflowinstance.FieldMemory) {
-		fields = append(fields, workflowinstance.FieldMemory)
-	}
-	if m.FieldCleared(workflowinstance.FieldDeadline) {
-		fields = append(fields, workflowinstance.FieldDeadline)
-	}
-	if m.FieldCleared(workflowinstance.FieldAttempts) {
-		fields = append(fields, workflowinstance.FieldAttempts)
-	}
-	if m.FieldCleared(workflowinstance.FieldErrorCode) {
-		fields = append(fields, workflowinstance.FieldErrorCode)
-	}
-	if m.FieldCleared(workflowinstance.FieldErrorMessage) {
-		fields = append(fields, workflowinstance.FieldErrorMessage)
-	}
-	if m.FieldCleared(workflowinstance.FieldStateBeginTime) {
-		fields = append(fields, workflowinstance.FieldStateBeginTime)
-	}
-	if m.FieldCleared(workflowinstance.FieldController) {
-		fields = append(fields, workflowinstance.FieldController)
-	}
-	return fields
+func (m *WorkflowRevisionMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *WorkflowInstanceMutation) FieldCleared(name string) bool {
+func (m *WorkflowRevisionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *WorkflowInstanceMutation) ClearField(name string) error {
-	switch name {
-	case workflowinstance.FieldEndTime:
-		m.ClearEndTime()
-		return nil
-	case workflowinstance.FieldFlow:
-		m.ClearFlow()
-		return nil
-	case workflowinstance.FieldOutput:
-		m.ClearOutput()
-		return nil
-	case workflowinstance.FieldStateData:
-		m.ClearStateData()
-		return nil
-	case workflowinstance.FieldMemory:
-		m.ClearMemory()
-		return nil
-	case workflowinstance.FieldDeadline:
-		m.ClearDeadline()
-		return nil
-	case workflowinstance.FieldAttempts:
-		m.ClearAttempts()
-		return nil
-	case workflowinstance.FieldErrorCode:
-		m.ClearErrorCode()
-		return nil
-	case workflowinstance.FieldErrorMessage:
-		m.ClearErrorMessage()
-		return nil
-	case workflowinstance.FieldStateBeginTime:
-		m.ClearStateBeginTime()
-		return nil
-	case workflowinstance.FieldController:
-		m.ClearController()
-		return nil
-	}
-	return fmt.Errorf("unknown WorkflowInstance nullable field %s", name)
+func (m *WorkflowRevisionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WorkflowRevision nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *WorkflowInstanceMutation) ResetField(name string) error {
+func (m *WorkflowRevisionMutation) ResetField(name string) error {
 	switch name {
-	case workflowinstance.FieldInstanceID:
-		m.ResetInstanceID()
-		return nil
-	case workflowinstance.FieldInvokedBy:
-		m.ResetInvokedBy()
-		return nil
-	case workflowinstance.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case workflowinstance.FieldRevision:
+	case workflowrevision.FieldRevision:
 		m.ResetRevision()
 		return nil
-	case workflowinstance.FieldBeginTime:
-		m.ResetBeginTime()
-		return nil
-	case workflowinstance.FieldEndTime:
-		m.ResetEndTime()
-		return nil
-	case workflowinstance.FieldFlow:
-		m.ResetFlow()
-		return nil
-	case workflowinstance.FieldInput:
-		m.ResetInput()
-		return nil
-	case workflowinstance.FieldOutput:
-		m.ResetOutput()
-		return nil
-	case workflowinstance.FieldStateData:
-		m.ResetStateData()
-		return nil
-	case workflowinstance.FieldMemory:
-		m.ResetMemory()
-		return nil
-	case workflowinstance.FieldDeadline:
-		m.ResetDeadline()
-		return nil
-	case workflowinstance.FieldAttempts:
-		m.ResetAttempts()
-		return nil
-	case workflowinstance.FieldErrorCode:
-		m.ResetErrorCode()
-		return nil
-	case workflowinstance.FieldErrorMessage:
-		m.ResetErrorMessage()
-		return nil
-	case workflowinstance.FieldStateBeginTime:
-		m.ResetStateBeginTime()
-		return nil
-	case workflowinstance.FieldController:
-		m.ResetController()
+	case workflowrevision.FieldDefinition:
+		m.ResetDefinition()
 		return nil
 	}
-	return fmt.Errorf("unknown WorkflowInstance field %s", name)
+	return fmt.Errorf("unknown WorkflowRevision field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *WorkflowInstanceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *WorkflowRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.workflow != nil {
-		edges = append(edges, workflowinstance.EdgeWorkflow)
-	}
-	if m.instance != nil {
-		edges = append(edges, workflowinstance.EdgeInstance)
+		edges = append(edges, workflowrevision.EdgeWorkflow)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *WorkflowInstanceMutation) AddedIDs(name string) []ent.Value {
+func (m *WorkflowRevisionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case workflowinstance.EdgeWorkflow:
+	case workflowrevision.EdgeWorkflow:
 		if id := m.workflow; id != nil {
 			return []ent.Value{*id}
 		}
-	case workflowinstance.EdgeInstance:
-		ids := make([]ent.Value, 0, len(m.instance))
-		for id := range m.instance {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *WorkflowInstanceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedinstance != nil {
-		edges = append(edges, workflowinstance.EdgeInstance)
-	}
+func (m *WorkflowRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *WorkflowInstanceMutation) RemovedIDs(name string) []ent.Value {
+func (m *WorkflowRevisionMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case workflowinstance.EdgeInstance:
-		ids := make([]ent.Value, 0, len(m.removedinstance))
-		for id := range m.removedinstance {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *WorkflowInstanceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *WorkflowRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.clearedworkflow {
-		edges = append(edges, workflowinstance.EdgeWorkflow)
-	}
-	if m.clearedinstance {
-		edges = append(edges, workflowinstance.EdgeInstance)
+		edges = append(edges, workflowrevision.EdgeWorkflow)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *WorkflowInstanceMutation) EdgeCleared(name string) bool {
+func (m *WorkflowRevisionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case workflowinstance.EdgeWorkflow:
+	case workflowrevision.EdgeWorkflow:
 		return m.clearedworkflow
-	case workflowinstance.EdgeInstance:
-		return m.clearedinstance
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *WorkflowInstanceMutation) ClearEdge(name string) error {
+func (m *WorkflowRevisionMutation) ClearEdge(name string) error {
 	switch name {
-	case workflowinstance.EdgeWorkflow:
+	case workflowrevision.EdgeWorkflow:
 		m.ClearWorkflow()
 		return nil
 	}
-	return fmt.Errorf("unknown WorkflowInstance unique edge %s", name)
+	return fmt.Errorf("unknown WorkflowRevision unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *WorkflowInstanceMutation) ResetEdge(name string) error {
+func (m *WorkflowRevisionMutation) ResetEdge(name string) error {
 	switch name {
-	case workflowinstance.EdgeWorkflow:
+	case workflowrevision.EdgeWorkflow:
 		m.ResetWorkflow()
 		return nil
-	case workflowinstance.EdgeInstance:
-		m.ResetInstance()
-		return nil
 	}
-	return fmt.Errorf("unknown WorkflowInstance edge %s", name)
+	return fmt.Errorf("unknown WorkflowRevision edge %s", name)
 }
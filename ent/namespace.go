@@ -18,18 +18,39 @@ type Namespace struct {
 	ID string `json:"id,omitempty"`
 	// Created holds the value of the "created" field.
 	Created time.Time `json:"created,omitempty"`
+	// Hold holds the value of the "hold" field.
+	Hold string `json:"hold,omitempty"`
+	// Region holds the value of the "region" field.
+	Region string `json:"region,omitempty"`
+	// EventConfig holds the value of the "event_config" field.
+	EventConfig []byte `json:"event_config,omitempty"`
+	// CronSuspension holds the value of the "cron_suspension" field.
+	CronSuspension string `json:"cron_suspension,omitempty"`
+	// Constants holds the value of the "constants" field.
+	Constants []byte `json:"constants,omitempty"`
+	// Profiles holds the value of the "profiles" field.
+	Profiles []byte `json:"profiles,omitempty"`
+	// Profile holds the value of the "profile" field.
+	Profile string `json:"profile,omitempty"`
+	// RetryPolicies holds the value of the "retry_policies" field.
+	RetryPolicies []byte `json:"retry_policies,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the NamespaceQuery when eager-loading is set.
-	Edges NamespaceEdges `json:"edges"`
+	Edges              NamespaceEdges `json:"edges"`
+	namespace_children *string
 }
 
 // NamespaceEdges holds the relations/edges for other nodes in the graph.
 type NamespaceEdges struct {
 	// Workflows holds the value of the workflows edge.
 	Workflows []*Workflow `json:"workflows,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Namespace `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Namespace `json:"children,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // WorkflowsOrErr returns the Workflows value or an error if the edge
@@ -41,15 +62,42 @@ func (e NamespaceEdges) WorkflowsOrErr() ([]*Workflow, error) {
 	return nil, &NotLoadedError{edge: "workflows"}
 }
 
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NamespaceEdges) ParentOrErr() (*Namespace, error) {
+	if e.loadedTypes[1] {
+		if e.Parent == nil {
+			// The edge parent was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: namespace.Label}
+		}
+		return e.Parent, nil
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e NamespaceEdges) ChildrenOrErr() ([]*Namespace, error) {
+	if e.loadedTypes[2] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Namespace) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case namespace.FieldID:
+		case namespace.FieldEventConfig, namespace.FieldConstants, namespace.FieldProfiles, namespace.FieldRetryPolicies:
+			values[i] = new([]byte)
+		case namespace.FieldID, namespace.FieldHold, namespace.FieldRegion, namespace.FieldCronSuspension, namespace.FieldProfile:
 			values[i] = new(sql.NullString)
 		case namespace.FieldCreated:
 			values[i] = new(sql.NullTime)
+		case namespace.ForeignKeys[0]: // namespace_children
+			values[i] = new(sql.NullString)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Namespace", columns[i])
 		}
@@ -77,6 +125,61 @@ func (n *Namespace) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				n.Created = value.Time
 			}
+		case namespace.FieldHold:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hold", values[i])
+			} else if value.Valid {
+				n.Hold = value.String
+			}
+		case namespace.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				n.Region = value.String
+			}
+		case namespace.FieldEventConfig:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field event_config", values[i])
+			} else if value != nil {
+				n.EventConfig = *value
+			}
+		case namespace.FieldCronSuspension:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron_suspension", values[i])
+			} else if value.Valid {
+				n.CronSuspension = value.String
+			}
+		case namespace.FieldConstants:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field constants", values[i])
+			} else if value != nil {
+				n.Constants = *value
+			}
+		case namespace.FieldProfiles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field profiles", values[i])
+			} else if value != nil {
+				n.Profiles = *value
+			}
+		case namespace.FieldProfile:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field profile", values[i])
+			} else if value.Valid {
+				n.Profile = value.String
+			}
+		case namespace.FieldRetryPolicies:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_policies", values[i])
+			} else if value != nil {
+				n.RetryPolicies = *value
+			}
+		case namespace.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace_children", values[i])
+			} else if value.Valid {
+				n.namespace_children = new(string)
+				*n.namespace_children = value.String
+			}
 		}
 	}
 	return nil
@@ -87,6 +190,16 @@ func (n *Namespace) QueryWorkflows() *WorkflowQuery {
 	return (&NamespaceClient{config: n.config}).QueryWorkflows(n)
 }
 
+// QueryParent queries the "parent" edge of the Namespace entity.
+func (n *Namespace) QueryParent() *NamespaceQuery {
+	return (&NamespaceClient{config: n.config}).QueryParent(n)
+}
+
+// QueryChildren queries the "children" edge of the Namespace entity.
+func (n *Namespace) QueryChildren() *NamespaceQuery {
+	return (&NamespaceClient{config: n.config}).QueryChildren(n)
+}
+
 // Update returns a builder for updating this Namespace.
 // Note that you need to call Namespace.Unwrap() before calling this method if this Namespace
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -112,6 +225,22 @@ func (n *Namespace) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v", n.ID))
 	builder.WriteString(", created=")
 	builder.WriteString(n.Created.Format(time.ANSIC))
+	builder.WriteString(", hold=")
+	builder.WriteString(n.Hold)
+	builder.WriteString(", region=")
+	builder.WriteString(n.Region)
+	builder.WriteString(", event_config=")
+	builder.WriteString(fmt.Sprintf("%v", n.EventConfig))
+	builder.WriteString(", cron_suspension=")
+	builder.WriteString(n.CronSuspension)
+	builder.WriteString(", constants=")
+	builder.WriteString(fmt.Sprintf("%v", n.Constants))
+	builder.WriteString(", profiles=")
+	builder.WriteString(fmt.Sprintf("%v", n.Profiles))
+	builder.WriteString(", profile=")
+	builder.WriteString(n.Profile)
+	builder.WriteString(", retry_policies=")
+	builder.WriteString(fmt.Sprintf("%v", n.RetryPolicies))
 	builder.WriteByte(')')
 	return builder.String()
 }
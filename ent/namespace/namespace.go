@@ -13,8 +13,28 @@ const (
 	FieldID = "id"
 	// FieldCreated holds the string denoting the created field in the database.
 	FieldCreated = "created"
+	// FieldHold holds the string denoting the hold field in the database.
+	FieldHold = "hold"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// FieldEventConfig holds the string denoting the event_config field in the database.
+	FieldEventConfig = "event_config"
+	// FieldCronSuspension holds the string denoting the cron_suspension field in the database.
+	FieldCronSuspension = "cron_suspension"
+	// FieldConstants holds the string denoting the constants field in the database.
+	FieldConstants = "constants"
+	// FieldProfiles holds the string denoting the profiles field in the database.
+	FieldProfiles = "profiles"
+	// FieldProfile holds the string denoting the profile field in the database.
+	FieldProfile = "profile"
+	// FieldRetryPolicies holds the string denoting the retry_policies field in the database.
+	FieldRetryPolicies = "retry_policies"
 	// EdgeWorkflows holds the string denoting the workflows edge name in mutations.
 	EdgeWorkflows = "workflows"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
 	// Table holds the table name of the namespace in the database.
 	Table = "namespaces"
 	// WorkflowsTable is the table the holds the workflows relation/edge.
@@ -24,12 +44,34 @@ const (
 	WorkflowsInverseTable = "workflows"
 	// WorkflowsColumn is the table column denoting the workflows relation/edge.
 	WorkflowsColumn = "namespace_workflows"
+	// ParentTable is the table the holds the parent relation/edge.
+	ParentTable = "namespaces"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "namespace_children"
+	// ChildrenTable is the table the holds the children relation/edge.
+	ChildrenTable = "namespaces"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "namespace_children"
 )
 
 // Columns holds all SQL columns for namespace fields.
 var Columns = []string{
 	FieldID,
 	FieldCreated,
+	FieldHold,
+	FieldRegion,
+	FieldEventConfig,
+	FieldCronSuspension,
+	FieldConstants,
+	FieldProfiles,
+	FieldProfile,
+	FieldRetryPolicies,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "namespaces"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"namespace_children",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -39,6 +81,11 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
 
@@ -100,6 +100,62 @@ func Created(v time.Time) predicate.Namespace {
 	})
 }
 
+// Hold applies equality check predicate on the "hold" field. It's identical to HoldEQ.
+func Hold(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHold), v))
+	})
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRegion), v))
+	})
+}
+
+// EventConfig applies equality check predicate on the "event_config" field. It's identical to EventConfigEQ.
+func EventConfig(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEventConfig), v))
+	})
+}
+
+// CronSuspension applies equality check predicate on the "cron_suspension" field. It's identical to CronSuspensionEQ.
+func CronSuspension(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCronSuspension), v))
+	})
+}
+
+// Constants applies equality check predicate on the "constants" field. It's identical to ConstantsEQ.
+func Constants(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConstants), v))
+	})
+}
+
+// Profiles applies equality check predicate on the "profiles" field. It's identical to ProfilesEQ.
+func Profiles(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProfiles), v))
+	})
+}
+
+// Profile applies equality check predicate on the "profile" field. It's identical to ProfileEQ.
+func Profile(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProfile), v))
+	})
+}
+
+// RetryPolicies applies equality check predicate on the "retry_policies" field. It's identical to RetryPoliciesEQ.
+func RetryPolicies(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetryPolicies), v))
+	})
+}
+
 // CreatedEQ applies the EQ predicate on the "created" field.
 func CreatedEQ(v time.Time) predicate.Namespace {
 	return predicate.Namespace(func(s *sql.Selector) {
@@ -176,25 +232,941 @@ func CreatedLTE(v time.Time) predicate.Namespace {
 	})
 }
 
-// HasWorkflows applies the HasEdge predicate on the "workflows" edge.
-func HasWorkflows() predicate.Namespace {
+// HoldEQ applies the EQ predicate on the "hold" field.
+func HoldEQ(v string) predicate.Namespace {
 	return predicate.Namespace(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(WorkflowsTable, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, WorkflowsTable, WorkflowsColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
+		s.Where(sql.EQ(s.C(FieldHold), v))
 	})
 }
 
-// HasWorkflowsWith applies the HasEdge predicate on the "workflows" edge with a given conditions (other predicates).
-func HasWorkflowsWith(preds ...predicate.Workflow) predicate.Namespace {
+// HoldNEQ applies the NEQ predicate on the "hold" field.
+func HoldNEQ(v string) predicate.Namespace {
 	return predicate.Namespace(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(WorkflowsInverseTable, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, WorkflowsTable, WorkflowsColumn),
+		s.Where(sql.NEQ(s.C(FieldHold), v))
+	})
+}
+
+// HoldIn applies the In predicate on the "hold" field.
+func HoldIn(vs ...string) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldHold), v...))
+	})
+}
+
+// HoldNotIn applies the NotIn predicate on the "hold" field.
+func HoldNotIn(vs ...string) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldHold), v...))
+	})
+}
+
+// HoldGT applies the GT predicate on the "hold" field.
+func HoldGT(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldHold), v))
+	})
+}
+
+// HoldGTE applies the GTE predicate on the "hold" field.
+func HoldGTE(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldHold), v))
+	})
+}
+
+// HoldLT applies the LT predicate on the "hold" field.
+func HoldLT(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldHold), v))
+	})
+}
+
+// HoldLTE applies the LTE predicate on the "hold" field.
+func HoldLTE(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldHold), v))
+	})
+}
+
+// HoldContains applies the Contains predicate on the "hold" field.
+func HoldContains(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldHold), v))
+	})
+}
+
+// HoldHasPrefix applies the HasPrefix predicate on the "hold" field.
+func HoldHasPrefix(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldHold), v))
+	})
+}
+
+// HoldHasSuffix applies the HasSuffix predicate on the "hold" field.
+func HoldHasSuffix(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldHold), v))
+	})
+}
+
+// HoldIsNil applies the IsNil predicate on the "hold" field.
+func HoldIsNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldHold)))
+	})
+}
+
+// HoldNotNil applies the NotNil predicate on the "hold" field.
+func HoldNotNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldHold)))
+	})
+}
+
+// HoldEqualFold applies the EqualFold predicate on the "hold" field.
+func HoldEqualFold(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldHold), v))
+	})
+}
+
+// HoldContainsFold applies the ContainsFold predicate on the "hold" field.
+func HoldContainsFold(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldHold), v))
+	})
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRegion), v))
+	})
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRegion), v))
+	})
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRegion), v...))
+	})
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRegion), v...))
+	})
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRegion), v))
+	})
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRegion), v))
+	})
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRegion), v))
+	})
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRegion), v))
+	})
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldRegion), v))
+	})
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldRegion), v))
+	})
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldRegion), v))
+	})
+}
+
+// RegionIsNil applies the IsNil predicate on the "region" field.
+func RegionIsNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRegion)))
+	})
+}
+
+// RegionNotNil applies the NotNil predicate on the "region" field.
+func RegionNotNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRegion)))
+	})
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldRegion), v))
+	})
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldRegion), v))
+	})
+}
+
+// EventConfigEQ applies the EQ predicate on the "event_config" field.
+func EventConfigEQ(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEventConfig), v))
+	})
+}
+
+// EventConfigNEQ applies the NEQ predicate on the "event_config" field.
+func EventConfigNEQ(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEventConfig), v))
+	})
+}
+
+// EventConfigIn applies the In predicate on the "event_config" field.
+func EventConfigIn(vs ...[]byte) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEventConfig), v...))
+	})
+}
+
+// EventConfigNotIn applies the NotIn predicate on the "event_config" field.
+func EventConfigNotIn(vs ...[]byte) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEventConfig), v...))
+	})
+}
+
+// EventConfigGT applies the GT predicate on the "event_config" field.
+func EventConfigGT(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEventConfig), v))
+	})
+}
+
+// EventConfigGTE applies the GTE predicate on the "event_config" field.
+func EventConfigGTE(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEventConfig), v))
+	})
+}
+
+// EventConfigLT applies the LT predicate on the "event_config" field.
+func EventConfigLT(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEventConfig), v))
+	})
+}
+
+// EventConfigLTE applies the LTE predicate on the "event_config" field.
+func EventConfigLTE(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEventConfig), v))
+	})
+}
+
+// EventConfigIsNil applies the IsNil predicate on the "event_config" field.
+func EventConfigIsNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEventConfig)))
+	})
+}
+
+// EventConfigNotNil applies the NotNil predicate on the "event_config" field.
+func EventConfigNotNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEventConfig)))
+	})
+}
+
+// CronSuspensionEQ applies the EQ predicate on the "cron_suspension" field.
+func CronSuspensionEQ(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCronSuspension), v))
+	})
+}
+
+// CronSuspensionNEQ applies the NEQ predicate on the "cron_suspension" field.
+func CronSuspensionNEQ(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCronSuspension), v))
+	})
+}
+
+// CronSuspensionIn applies the In predicate on the "cron_suspension" field.
+func CronSuspensionIn(vs ...string) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCronSuspension), v...))
+	})
+}
+
+// CronSuspensionNotIn applies the NotIn predicate on the "cron_suspension" field.
+func CronSuspensionNotIn(vs ...string) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCronSuspension), v...))
+	})
+}
+
+// CronSuspensionGT applies the GT predicate on the "cron_suspension" field.
+func CronSuspensionGT(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCronSuspension), v))
+	})
+}
+
+// CronSuspensionGTE applies the GTE predicate on the "cron_suspension" field.
+func CronSuspensionGTE(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCronSuspension), v))
+	})
+}
+
+// CronSuspensionLT applies the LT predicate on the "cron_suspension" field.
+func CronSuspensionLT(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCronSuspension), v))
+	})
+}
+
+// CronSuspensionLTE applies the LTE predicate on the "cron_suspension" field.
+func CronSuspensionLTE(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCronSuspension), v))
+	})
+}
+
+// CronSuspensionContains applies the Contains predicate on the "cron_suspension" field.
+func CronSuspensionContains(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCronSuspension), v))
+	})
+}
+
+// CronSuspensionHasPrefix applies the HasPrefix predicate on the "cron_suspension" field.
+func CronSuspensionHasPrefix(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCronSuspension), v))
+	})
+}
+
+// CronSuspensionHasSuffix applies the HasSuffix predicate on the "cron_suspension" field.
+func CronSuspensionHasSuffix(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCronSuspension), v))
+	})
+}
+
+// CronSuspensionIsNil applies the IsNil predicate on the "cron_suspension" field.
+func CronSuspensionIsNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCronSuspension)))
+	})
+}
+
+// CronSuspensionNotNil applies the NotNil predicate on the "cron_suspension" field.
+func CronSuspensionNotNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCronSuspension)))
+	})
+}
+
+// CronSuspensionEqualFold applies the EqualFold predicate on the "cron_suspension" field.
+func CronSuspensionEqualFold(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCronSuspension), v))
+	})
+}
+
+// CronSuspensionContainsFold applies the ContainsFold predicate on the "cron_suspension" field.
+func CronSuspensionContainsFold(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCronSuspension), v))
+	})
+}
+
+// ConstantsEQ applies the EQ predicate on the "constants" field.
+func ConstantsEQ(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConstants), v))
+	})
+}
+
+// ConstantsNEQ applies the NEQ predicate on the "constants" field.
+func ConstantsNEQ(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldConstants), v))
+	})
+}
+
+// ConstantsIn applies the In predicate on the "constants" field.
+func ConstantsIn(vs ...[]byte) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldConstants), v...))
+	})
+}
+
+// ConstantsNotIn applies the NotIn predicate on the "constants" field.
+func ConstantsNotIn(vs ...[]byte) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldConstants), v...))
+	})
+}
+
+// ConstantsGT applies the GT predicate on the "constants" field.
+func ConstantsGT(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldConstants), v))
+	})
+}
+
+// ConstantsGTE applies the GTE predicate on the "constants" field.
+func ConstantsGTE(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldConstants), v))
+	})
+}
+
+// ConstantsLT applies the LT predicate on the "constants" field.
+func ConstantsLT(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldConstants), v))
+	})
+}
+
+// ConstantsLTE applies the LTE predicate on the "constants" field.
+func ConstantsLTE(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldConstants), v))
+	})
+}
+
+// ConstantsIsNil applies the IsNil predicate on the "constants" field.
+func ConstantsIsNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldConstants)))
+	})
+}
+
+// ConstantsNotNil applies the NotNil predicate on the "constants" field.
+func ConstantsNotNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldConstants)))
+	})
+}
+
+// ProfilesEQ applies the EQ predicate on the "profiles" field.
+func ProfilesEQ(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProfiles), v))
+	})
+}
+
+// ProfilesNEQ applies the NEQ predicate on the "profiles" field.
+func ProfilesNEQ(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProfiles), v))
+	})
+}
+
+// ProfilesIn applies the In predicate on the "profiles" field.
+func ProfilesIn(vs ...[]byte) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProfiles), v...))
+	})
+}
+
+// ProfilesNotIn applies the NotIn predicate on the "profiles" field.
+func ProfilesNotIn(vs ...[]byte) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProfiles), v...))
+	})
+}
+
+// ProfilesGT applies the GT predicate on the "profiles" field.
+func ProfilesGT(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProfiles), v))
+	})
+}
+
+// ProfilesGTE applies the GTE predicate on the "profiles" field.
+func ProfilesGTE(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProfiles), v))
+	})
+}
+
+// ProfilesLT applies the LT predicate on the "profiles" field.
+func ProfilesLT(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProfiles), v))
+	})
+}
+
+// ProfilesLTE applies the LTE predicate on the "profiles" field.
+func ProfilesLTE(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProfiles), v))
+	})
+}
+
+// ProfilesIsNil applies the IsNil predicate on the "profiles" field.
+func ProfilesIsNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldProfiles)))
+	})
+}
+
+// ProfilesNotNil applies the NotNil predicate on the "profiles" field.
+func ProfilesNotNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldProfiles)))
+	})
+}
+
+// ProfileEQ applies the EQ predicate on the "profile" field.
+func ProfileEQ(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileNEQ applies the NEQ predicate on the "profile" field.
+func ProfileNEQ(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileIn applies the In predicate on the "profile" field.
+func ProfileIn(vs ...string) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProfile), v...))
+	})
+}
+
+// ProfileNotIn applies the NotIn predicate on the "profile" field.
+func ProfileNotIn(vs ...string) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProfile), v...))
+	})
+}
+
+// ProfileGT applies the GT predicate on the "profile" field.
+func ProfileGT(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileGTE applies the GTE predicate on the "profile" field.
+func ProfileGTE(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileLT applies the LT predicate on the "profile" field.
+func ProfileLT(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileLTE applies the LTE predicate on the "profile" field.
+func ProfileLTE(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileContains applies the Contains predicate on the "profile" field.
+func ProfileContains(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileHasPrefix applies the HasPrefix predicate on the "profile" field.
+func ProfileHasPrefix(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileHasSuffix applies the HasSuffix predicate on the "profile" field.
+func ProfileHasSuffix(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileIsNil applies the IsNil predicate on the "profile" field.
+func ProfileIsNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldProfile)))
+	})
+}
+
+// ProfileNotNil applies the NotNil predicate on the "profile" field.
+func ProfileNotNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldProfile)))
+	})
+}
+
+// ProfileEqualFold applies the EqualFold predicate on the "profile" field.
+func ProfileEqualFold(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileContainsFold applies the ContainsFold predicate on the "profile" field.
+func ProfileContainsFold(v string) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldProfile), v))
+	})
+}
+
+// RetryPoliciesEQ applies the EQ predicate on the "retry_policies" field.
+func RetryPoliciesEQ(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRetryPolicies), v))
+	})
+}
+
+// RetryPoliciesNEQ applies the NEQ predicate on the "retry_policies" field.
+func RetryPoliciesNEQ(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRetryPolicies), v))
+	})
+}
+
+// RetryPoliciesIn applies the In predicate on the "retry_policies" field.
+func RetryPoliciesIn(vs ...[]byte) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRetryPolicies), v...))
+	})
+}
+
+// RetryPoliciesNotIn applies the NotIn predicate on the "retry_policies" field.
+func RetryPoliciesNotIn(vs ...[]byte) predicate.Namespace {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Namespace(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRetryPolicies), v...))
+	})
+}
+
+// RetryPoliciesGT applies the GT predicate on the "retry_policies" field.
+func RetryPoliciesGT(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRetryPolicies), v))
+	})
+}
+
+// RetryPoliciesGTE applies the GTE predicate on the "retry_policies" field.
+func RetryPoliciesGTE(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRetryPolicies), v))
+	})
+}
+
+// RetryPoliciesLT applies the LT predicate on the "retry_policies" field.
+func RetryPoliciesLT(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRetryPolicies), v))
+	})
+}
+
+// RetryPoliciesLTE applies the LTE predicate on the "retry_policies" field.
+func RetryPoliciesLTE(v []byte) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRetryPolicies), v))
+	})
+}
+
+// RetryPoliciesIsNil applies the IsNil predicate on the "retry_policies" field.
+func RetryPoliciesIsNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRetryPolicies)))
+	})
+}
+
+// RetryPoliciesNotNil applies the NotNil predicate on the "retry_policies" field.
+func RetryPoliciesNotNil() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRetryPolicies)))
+	})
+}
+
+// HasWorkflows applies the HasEdge predicate on the "workflows" edge.
+func HasWorkflows() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WorkflowsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WorkflowsTable, WorkflowsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkflowsWith applies the HasEdge predicate on the "workflows" edge with a given conditions (other predicates).
+func HasWorkflowsWith(preds ...predicate.Workflow) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WorkflowsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WorkflowsTable, WorkflowsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ParentTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Namespace) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ChildrenTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.Namespace) predicate.Namespace {
+	return predicate.Namespace(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
 		)
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
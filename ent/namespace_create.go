@@ -36,6 +36,86 @@ func (nc *NamespaceCreate) SetNillableCreated(t *time.Time) *NamespaceCreate {
 	return nc
 }
 
+// SetHold sets the "hold" field.
+func (nc *NamespaceCreate) SetHold(s string) *NamespaceCreate {
+	nc.mutation.SetHold(s)
+	return nc
+}
+
+// SetNillableHold sets the "hold" field if the given value is not nil.
+func (nc *NamespaceCreate) SetNillableHold(s *string) *NamespaceCreate {
+	if s != nil {
+		nc.SetHold(*s)
+	}
+	return nc
+}
+
+// SetRegion sets the "region" field.
+func (nc *NamespaceCreate) SetRegion(s string) *NamespaceCreate {
+	nc.mutation.SetRegion(s)
+	return nc
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (nc *NamespaceCreate) SetNillableRegion(s *string) *NamespaceCreate {
+	if s != nil {
+		nc.SetRegion(*s)
+	}
+	return nc
+}
+
+// SetEventConfig sets the "event_config" field.
+func (nc *NamespaceCreate) SetEventConfig(b []byte) *NamespaceCreate {
+	nc.mutation.SetEventConfig(b)
+	return nc
+}
+
+// SetCronSuspension sets the "cron_suspension" field.
+func (nc *NamespaceCreate) SetCronSuspension(s string) *NamespaceCreate {
+	nc.mutation.SetCronSuspension(s)
+	return nc
+}
+
+// SetNillableCronSuspension sets the "cron_suspension" field if the given value is not nil.
+func (nc *NamespaceCreate) SetNillableCronSuspension(s *string) *NamespaceCreate {
+	if s != nil {
+		nc.SetCronSuspension(*s)
+	}
+	return nc
+}
+
+// SetConstants sets the "constants" field.
+func (nc *NamespaceCreate) SetConstants(b []byte) *NamespaceCreate {
+	nc.mutation.SetConstants(b)
+	return nc
+}
+
+// SetProfiles sets the "profiles" field.
+func (nc *NamespaceCreate) SetProfiles(b []byte) *NamespaceCreate {
+	nc.mutation.SetProfiles(b)
+	return nc
+}
+
+// SetProfile sets the "profile" field.
+func (nc *NamespaceCreate) SetProfile(s string) *NamespaceCreate {
+	nc.mutation.SetProfile(s)
+	return nc
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (nc *NamespaceCreate) SetNillableProfile(s *string) *NamespaceCreate {
+	if s != nil {
+		nc.SetProfile(*s)
+	}
+	return nc
+}
+
+// SetRetryPolicies sets the "retry_policies" field.
+func (nc *NamespaceCreate) SetRetryPolicies(b []byte) *NamespaceCreate {
+	nc.mutation.SetRetryPolicies(b)
+	return nc
+}
+
 // SetID sets the "id" field.
 func (nc *NamespaceCreate) SetID(s string) *NamespaceCreate {
 	nc.mutation.SetID(s)
@@ -57,6 +137,40 @@ func (nc *NamespaceCreate) AddWorkflows(w ...*Workflow) *NamespaceCreate {
 	return nc.AddWorkflowIDs(ids...)
 }
 
+// SetParentID sets the "parent" edge to the Namespace entity by ID.
+func (nc *NamespaceCreate) SetParentID(id string) *NamespaceCreate {
+	nc.mutation.SetParentID(id)
+	return nc
+}
+
+// SetNillableParentID sets the "parent" edge to the Namespace entity by ID if the given value is not nil.
+func (nc *NamespaceCreate) SetNillableParentID(id *string) *NamespaceCreate {
+	if id != nil {
+		nc = nc.SetParentID(*id)
+	}
+	return nc
+}
+
+// SetParent sets the "parent" edge to the Namespace entity.
+func (nc *NamespaceCreate) SetParent(n *Namespace) *NamespaceCreate {
+	return nc.SetParentID(n.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Namespace entity by IDs.
+func (nc *NamespaceCreate) AddChildIDs(ids ...string) *NamespaceCreate {
+	nc.mutation.AddChildIDs(ids...)
+	return nc
+}
+
+// AddChildren adds the "children" edges to the Namespace entity.
+func (nc *NamespaceCreate) AddChildren(n ...*Namespace) *NamespaceCreate {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nc.AddChildIDs(ids...)
+}
+
 // Mutation returns the NamespaceMutation object of the builder.
 func (nc *NamespaceCreate) Mutation() *NamespaceMutation {
 	return nc.mutation
@@ -162,6 +276,70 @@ func (nc *NamespaceCreate) createSpec() (*Namespace, *sqlgraph.CreateSpec) {
 		})
 		_node.Created = value
 	}
+	if value, ok := nc.mutation.Hold(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespace.FieldHold,
+		})
+		_node.Hold = value
+	}
+	if value, ok := nc.mutation.Region(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespace.FieldRegion,
+		})
+		_node.Region = value
+	}
+	if value, ok := nc.mutation.EventConfig(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: namespace.FieldEventConfig,
+		})
+		_node.EventConfig = value
+	}
+	if value, ok := nc.mutation.CronSuspension(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespace.FieldCronSuspension,
+		})
+		_node.CronSuspension = value
+	}
+	if value, ok := nc.mutation.Constants(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: namespace.FieldConstants,
+		})
+		_node.Constants = value
+	}
+	if value, ok := nc.mutation.Profiles(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: namespace.FieldProfiles,
+		})
+		_node.Profiles = value
+	}
+	if value, ok := nc.mutation.Profile(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespace.FieldProfile,
+		})
+		_node.Profile = value
+	}
+	if value, ok := nc.mutation.RetryPolicies(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: namespace.FieldRetryPolicies,
+		})
+		_node.RetryPolicies = value
+	}
 	if nodes := nc.mutation.WorkflowsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -181,6 +359,45 @@ func (nc *NamespaceCreate) createSpec() (*Namespace, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := nc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   namespace.ParentTable,
+			Columns: []string{namespace.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: namespace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.namespace_children = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   namespace.ChildrenTable,
+			Columns: []string{namespace.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: namespace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
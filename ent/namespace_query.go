@@ -28,6 +28,9 @@ type NamespaceQuery struct {
 	predicates []predicate.Namespace
 	// eager-loading edges.
 	withWorkflows *WorkflowQuery
+	withParent    *NamespaceQuery
+	withChildren  *NamespaceQuery
+	withFKs       bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -86,6 +89,50 @@ func (nq *NamespaceQuery) QueryWorkflows() *WorkflowQuery {
 	return query
 }
 
+// QueryParent chains the current query on the "parent" edge.
+func (nq *NamespaceQuery) QueryParent() *NamespaceQuery {
+	query := &NamespaceQuery{config: nq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(namespace.Table, namespace.FieldID, selector),
+			sqlgraph.To(namespace.Table, namespace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, namespace.ParentTable, namespace.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (nq *NamespaceQuery) QueryChildren() *NamespaceQuery {
+	query := &NamespaceQuery{config: nq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(namespace.Table, namespace.FieldID, selector),
+			sqlgraph.To(namespace.Table, namespace.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, namespace.ChildrenTable, namespace.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Namespace entity from the query.
 // Returns a *NotFoundError when no Namespace was found.
 func (nq *NamespaceQuery) First(ctx context.Context) (*Namespace, error) {
@@ -268,6 +315,8 @@ func (nq *NamespaceQuery) Clone() *NamespaceQuery {
 		order:         append([]OrderFunc{}, nq.order...),
 		predicates:    append([]predicate.Namespace{}, nq.predicates...),
 		withWorkflows: nq.withWorkflows.Clone(),
+		withParent:    nq.withParent.Clone(),
+		withChildren:  nq.withChildren.Clone(),
 		// clone intermediate query.
 		sql:  nq.sql.Clone(),
 		path: nq.path,
@@ -285,6 +334,28 @@ func (nq *NamespaceQuery) WithWorkflows(opts ...func(*WorkflowQuery)) *Namespace
 	return nq
 }
 
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NamespaceQuery) WithParent(opts ...func(*NamespaceQuery)) *NamespaceQuery {
+	query := &NamespaceQuery{config: nq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withParent = query
+	return nq
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NamespaceQuery) WithChildren(opts ...func(*NamespaceQuery)) *NamespaceQuery {
+	query := &NamespaceQuery{config: nq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withChildren = query
+	return nq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -349,11 +420,20 @@ func (nq *NamespaceQuery) prepareQuery(ctx context.Context) error {
 func (nq *NamespaceQuery) sqlAll(ctx context.Context) ([]*Namespace, error) {
 	var (
 		nodes       = []*Namespace{}
+		withFKs     = nq.withFKs
 		_spec       = nq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			nq.withWorkflows != nil,
+			nq.withParent != nil,
+			nq.withChildren != nil,
 		}
 	)
+	if nq.withParent != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, namespace.ForeignKeys...)
+	}
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &Namespace{config: nq.config}
 		nodes = append(nodes, node)
@@ -403,6 +483,64 @@ func (nq *NamespaceQuery) sqlAll(ctx context.Context) ([]*Namespace, error) {
 		}
 	}
 
+	if query := nq.withParent; query != nil {
+		ids := make([]string, 0, len(nodes))
+		nodeids := make(map[string][]*Namespace)
+		for i := range nodes {
+			if nodes[i].namespace_children == nil {
+				continue
+			}
+			fk := *nodes[i].namespace_children
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(namespace.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "namespace_children" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Parent = n
+			}
+		}
+	}
+
+	if query := nq.withChildren; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[string]*Namespace)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Children = []*Namespace{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Namespace(func(s *sql.Selector) {
+			s.Where(sql.InValues(namespace.ChildrenColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.namespace_children
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "namespace_children" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "namespace_children" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Children = append(node.Edges.Children, n)
+		}
+	}
+
 	return nodes, nil
 }
 
@@ -28,6 +28,114 @@ func (nu *NamespaceUpdate) Where(ps ...predicate.Namespace) *NamespaceUpdate {
 	return nu
 }
 
+// SetHold sets the "hold" field.
+func (nu *NamespaceUpdate) SetHold(s string) *NamespaceUpdate {
+	nu.mutation.SetHold(s)
+	return nu
+}
+
+// SetNillableHold sets the "hold" field if the given value is not nil.
+func (nu *NamespaceUpdate) SetNillableHold(s *string) *NamespaceUpdate {
+	if s != nil {
+		nu.SetHold(*s)
+	}
+	return nu
+}
+
+// ClearHold clears the value of the "hold" field.
+func (nu *NamespaceUpdate) ClearHold() *NamespaceUpdate {
+	nu.mutation.ClearHold()
+	return nu
+}
+
+// SetEventConfig sets the "event_config" field.
+func (nu *NamespaceUpdate) SetEventConfig(b []byte) *NamespaceUpdate {
+	nu.mutation.SetEventConfig(b)
+	return nu
+}
+
+// ClearEventConfig clears the value of the "event_config" field.
+func (nu *NamespaceUpdate) ClearEventConfig() *NamespaceUpdate {
+	nu.mutation.ClearEventConfig()
+	return nu
+}
+
+// SetCronSuspension sets the "cron_suspension" field.
+func (nu *NamespaceUpdate) SetCronSuspension(s string) *NamespaceUpdate {
+	nu.mutation.SetCronSuspension(s)
+	return nu
+}
+
+// SetNillableCronSuspension sets the "cron_suspension" field if the given value is not nil.
+func (nu *NamespaceUpdate) SetNillableCronSuspension(s *string) *NamespaceUpdate {
+	if s != nil {
+		nu.SetCronSuspension(*s)
+	}
+	return nu
+}
+
+// ClearCronSuspension clears the value of the "cron_suspension" field.
+func (nu *NamespaceUpdate) ClearCronSuspension() *NamespaceUpdate {
+	nu.mutation.ClearCronSuspension()
+	return nu
+}
+
+// SetConstants sets the "constants" field.
+func (nu *NamespaceUpdate) SetConstants(b []byte) *NamespaceUpdate {
+	nu.mutation.SetConstants(b)
+	return nu
+}
+
+// ClearConstants clears the value of the "constants" field.
+func (nu *NamespaceUpdate) ClearConstants() *NamespaceUpdate {
+	nu.mutation.ClearConstants()
+	return nu
+}
+
+// SetProfiles sets the "profiles" field.
+func (nu *NamespaceUpdate) SetProfiles(b []byte) *NamespaceUpdate {
+	nu.mutation.SetProfiles(b)
+	return nu
+}
+
+// ClearProfiles clears the value of the "profiles" field.
+func (nu *NamespaceUpdate) ClearProfiles() *NamespaceUpdate {
+	nu.mutation.ClearProfiles()
+	return nu
+}
+
+// SetProfile sets the "profile" field.
+func (nu *NamespaceUpdate) SetProfile(s string) *NamespaceUpdate {
+	nu.mutation.SetProfile(s)
+	return nu
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (nu *NamespaceUpdate) SetNillableProfile(s *string) *NamespaceUpdate {
+	if s != nil {
+		nu.SetProfile(*s)
+	}
+	return nu
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (nu *NamespaceUpdate) ClearProfile() *NamespaceUpdate {
+	nu.mutation.ClearProfile()
+	return nu
+}
+
+// SetRetryPolicies sets the "retry_policies" field.
+func (nu *NamespaceUpdate) SetRetryPolicies(b []byte) *NamespaceUpdate {
+	nu.mutation.SetRetryPolicies(b)
+	return nu
+}
+
+// ClearRetryPolicies clears the value of the "retry_policies" field.
+func (nu *NamespaceUpdate) ClearRetryPolicies() *NamespaceUpdate {
+	nu.mutation.ClearRetryPolicies()
+	return nu
+}
+
 // AddWorkflowIDs adds the "workflows" edge to the Workflow entity by IDs.
 func (nu *NamespaceUpdate) AddWorkflowIDs(ids ...uuid.UUID) *NamespaceUpdate {
 	nu.mutation.AddWorkflowIDs(ids...)
@@ -43,6 +151,40 @@ func (nu *NamespaceUpdate) AddWorkflows(w ...*Workflow) *NamespaceUpdate {
 	return nu.AddWorkflowIDs(ids...)
 }
 
+// SetParentID sets the "parent" edge to the Namespace entity by ID.
+func (nu *NamespaceUpdate) SetParentID(id string) *NamespaceUpdate {
+	nu.mutation.SetParentID(id)
+	return nu
+}
+
+// SetNillableParentID sets the "parent" edge to the Namespace entity by ID if the given value is not nil.
+func (nu *NamespaceUpdate) SetNillableParentID(id *string) *NamespaceUpdate {
+	if id != nil {
+		nu = nu.SetParentID(*id)
+	}
+	return nu
+}
+
+// SetParent sets the "parent" edge to the Namespace entity.
+func (nu *NamespaceUpdate) SetParent(n *Namespace) *NamespaceUpdate {
+	return nu.SetParentID(n.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Namespace entity by IDs.
+func (nu *NamespaceUpdate) AddChildIDs(ids ...string) *NamespaceUpdate {
+	nu.mutation.AddChildIDs(ids...)
+	return nu
+}
+
+// AddChildren adds the "children" edges to the Namespace entity.
+func (nu *NamespaceUpdate) AddChildren(n ...*Namespace) *NamespaceUpdate {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.AddChildIDs(ids...)
+}
+
 // Mutation returns the NamespaceMutation object of the builder.
 func (nu *NamespaceUpdate) Mutation() *NamespaceMutation {
 	return nu.mutation
@@ -69,6 +211,33 @@ func (nu *NamespaceUpdate) RemoveWorkflows(w ...*Workflow) *NamespaceUpdate {
 	return nu.RemoveWorkflowIDs(ids...)
 }
 
+// ClearParent clears the "parent" edge to the Namespace entity.
+func (nu *NamespaceUpdate) ClearParent() *NamespaceUpdate {
+	nu.mutation.ClearParent()
+	return nu
+}
+
+// ClearChildren clears all "children" edges to the Namespace entity.
+func (nu *NamespaceUpdate) ClearChildren() *NamespaceUpdate {
+	nu.mutation.ClearChildren()
+	return nu
+}
+
+// RemoveChildIDs removes the "children" edge to Namespace entities by IDs.
+func (nu *NamespaceUpdate) RemoveChildIDs(ids ...string) *NamespaceUpdate {
+	nu.mutation.RemoveChildIDs(ids...)
+	return nu
+}
+
+// RemoveChildren removes "children" edges to Namespace entities.
+func (nu *NamespaceUpdate) RemoveChildren(n ...*Namespace) *NamespaceUpdate {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.RemoveChildIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (nu *NamespaceUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -138,6 +307,103 @@ func (nu *NamespaceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := nu.mutation.Hold(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespace.FieldHold,
+		})
+	}
+	if nu.mutation.HoldCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: namespace.FieldHold,
+		})
+	}
+	if nu.mutation.RegionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: namespace.FieldRegion,
+		})
+	}
+	if value, ok := nu.mutation.EventConfig(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: namespace.FieldEventConfig,
+		})
+	}
+	if nu.mutation.EventConfigCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: namespace.FieldEventConfig,
+		})
+	}
+	if value, ok := nu.mutation.CronSuspension(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespace.FieldCronSuspension,
+		})
+	}
+	if nu.mutation.CronSuspensionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: namespace.FieldCronSuspension,
+		})
+	}
+	if value, ok := nu.mutation.Constants(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: namespace.FieldConstants,
+		})
+	}
+	if nu.mutation.ConstantsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: namespace.FieldConstants,
+		})
+	}
+	if value, ok := nu.mutation.Profiles(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: namespace.FieldProfiles,
+		})
+	}
+	if nu.mutation.ProfilesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: namespace.FieldProfiles,
+		})
+	}
+	if value, ok := nu.mutation.Profile(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespace.FieldProfile,
+		})
+	}
+	if nu.mutation.ProfileCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: namespace.FieldProfile,
+		})
+	}
+	if value, ok := nu.mutation.RetryPolicies(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: namespace.FieldRetryPolicies,
+		})
+	}
+	if nu.mutation.RetryPoliciesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: namespace.FieldRetryPolicies,
+		})
+	}
 	if nu.mutation.WorkflowsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -192,6 +458,95 @@ func (nu *NamespaceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   namespace.ParentTable,
+			Columns: []string{namespace.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: namespace.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   namespace.ParentTable,
+			Columns: []string{namespace.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: namespace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   namespace.ChildrenTable,
+			Columns: []string{namespace.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: namespace.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !nu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   namespace.ChildrenTable,
+			Columns: []string{namespace.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: namespace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   namespace.ChildrenTable,
+			Columns: []string{namespace.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: namespace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{namespace.Label}
@@ -211,6 +566,114 @@ type NamespaceUpdateOne struct {
 	mutation *NamespaceMutation
 }
 
+// SetHold sets the "hold" field.
+func (nuo *NamespaceUpdateOne) SetHold(s string) *NamespaceUpdateOne {
+	nuo.mutation.SetHold(s)
+	return nuo
+}
+
+// SetNillableHold sets the "hold" field if the given value is not nil.
+func (nuo *NamespaceUpdateOne) SetNillableHold(s *string) *NamespaceUpdateOne {
+	if s != nil {
+		nuo.SetHold(*s)
+	}
+	return nuo
+}
+
+// ClearHold clears the value of the "hold" field.
+func (nuo *NamespaceUpdateOne) ClearHold() *NamespaceUpdateOne {
+	nuo.mutation.ClearHold()
+	return nuo
+}
+
+// SetEventConfig sets the "event_config" field.
+func (nuo *NamespaceUpdateOne) SetEventConfig(b []byte) *NamespaceUpdateOne {
+	nuo.mutation.SetEventConfig(b)
+	return nuo
+}
+
+// ClearEventConfig clears the value of the "event_config" field.
+func (nuo *NamespaceUpdateOne) ClearEventConfig() *NamespaceUpdateOne {
+	nuo.mutation.ClearEventConfig()
+	return nuo
+}
+
+// SetCronSuspension sets the "cron_suspension" field.
+func (nuo *NamespaceUpdateOne) SetCronSuspension(s string) *NamespaceUpdateOne {
+	nuo.mutation.SetCronSuspension(s)
+	return nuo
+}
+
+// SetNillableCronSuspension sets the "cron_suspension" field if the given value is not nil.
+func (nuo *NamespaceUpdateOne) SetNillableCronSuspension(s *string) *NamespaceUpdateOne {
+	if s != nil {
+		nuo.SetCronSuspension(*s)
+	}
+	return nuo
+}
+
+// ClearCronSuspension clears the value of the "cron_suspension" field.
+func (nuo *NamespaceUpdateOne) ClearCronSuspension() *NamespaceUpdateOne {
+	nuo.mutation.ClearCronSuspension()
+	return nuo
+}
+
+// SetConstants sets the "constants" field.
+func (nuo *NamespaceUpdateOne) SetConstants(b []byte) *NamespaceUpdateOne {
+	nuo.mutation.SetConstants(b)
+	return nuo
+}
+
+// ClearConstants clears the value of the "constants" field.
+func (nuo *NamespaceUpdateOne) ClearConstants() *NamespaceUpdateOne {
+	nuo.mutation.ClearConstants()
+	return nuo
+}
+
+// SetProfiles sets the "profiles" field.
+func (nuo *NamespaceUpdateOne) SetProfiles(b []byte) *NamespaceUpdateOne {
+	nuo.mutation.SetProfiles(b)
+	return nuo
+}
+
+// ClearProfiles clears the value of the "profiles" field.
+func (nuo *NamespaceUpdateOne) ClearProfiles() *NamespaceUpdateOne {
+	nuo.mutation.ClearProfiles()
+	return nuo
+}
+
+// SetProfile sets the "profile" field.
+func (nuo *NamespaceUpdateOne) SetProfile(s string) *NamespaceUpdateOne {
+	nuo.mutation.SetProfile(s)
+	return nuo
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (nuo *NamespaceUpdateOne) SetNillableProfile(s *string) *NamespaceUpdateOne {
+	if s != nil {
+		nuo.SetProfile(*s)
+	}
+	return nuo
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (nuo *NamespaceUpdateOne) ClearProfile() *NamespaceUpdateOne {
+	nuo.mutation.ClearProfile()
+	return nuo
+}
+
+// SetRetryPolicies sets the "retry_policies" field.
+func (nuo *NamespaceUpdateOne) SetRetryPolicies(b []byte) *NamespaceUpdateOne {
+	nuo.mutation.SetRetryPolicies(b)
+	return nuo
+}
+
+// ClearRetryPolicies clears the value of the "retry_policies" field.
+func (nuo *NamespaceUpdateOne) ClearRetryPolicies() *NamespaceUpdateOne {
+	nuo.mutation.ClearRetryPolicies()
+	return nuo
+}
+
 // AddWorkflowIDs adds the "workflows" edge to the Workflow entity by IDs.
 func (nuo *NamespaceUpdateOne) AddWorkflowIDs(ids ...uuid.UUID) *NamespaceUpdateOne {
 	nuo.mutation.AddWorkflowIDs(ids...)
@@ -226,6 +689,40 @@ func (nuo *NamespaceUpdateOne) AddWorkflows(w ...*Workflow) *NamespaceUpdateOne
 	return nuo.AddWorkflowIDs(ids...)
 }
 
+// SetParentID sets the "parent" edge to the Namespace entity by ID.
+func (nuo *NamespaceUpdateOne) SetParentID(id string) *NamespaceUpdateOne {
+	nuo.mutation.SetParentID(id)
+	return nuo
+}
+
+// SetNillableParentID sets the "parent" edge to the Namespace entity by ID if the given value is not nil.
+func (nuo *NamespaceUpdateOne) SetNillableParentID(id *string) *NamespaceUpdateOne {
+	if id != nil {
+		nuo = nuo.SetParentID(*id)
+	}
+	return nuo
+}
+
+// SetParent sets the "parent" edge to the Namespace entity.
+func (nuo *NamespaceUpdateOne) SetParent(n *Namespace) *NamespaceUpdateOne {
+	return nuo.SetParentID(n.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Namespace entity by IDs.
+func (nuo *NamespaceUpdateOne) AddChildIDs(ids ...string) *NamespaceUpdateOne {
+	nuo.mutation.AddChildIDs(ids...)
+	return nuo
+}
+
+// AddChildren adds the "children" edges to the Namespace entity.
+func (nuo *NamespaceUpdateOne) AddChildren(n ...*Namespace) *NamespaceUpdateOne {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.AddChildIDs(ids...)
+}
+
 // Mutation returns the NamespaceMutation object of the builder.
 func (nuo *NamespaceUpdateOne) Mutation() *NamespaceMutation {
 	return nuo.mutation
@@ -252,6 +749,33 @@ func (nuo *NamespaceUpdateOne) RemoveWorkflows(w ...*Workflow) *NamespaceUpdateO
 	return nuo.RemoveWorkflowIDs(ids...)
 }
 
+// ClearParent clears the "parent" edge to the Namespace entity.
+func (nuo *NamespaceUpdateOne) ClearParent() *NamespaceUpdateOne {
+	nuo.mutation.ClearParent()
+	return nuo
+}
+
+// ClearChildren clears all "children" edges to the Namespace entity.
+func (nuo *NamespaceUpdateOne) ClearChildren() *NamespaceUpdateOne {
+	nuo.mutation.ClearChildren()
+	return nuo
+}
+
+// RemoveChildIDs removes the "children" edge to Namespace entities by IDs.
+func (nuo *NamespaceUpdateOne) RemoveChildIDs(ids ...string) *NamespaceUpdateOne {
+	nuo.mutation.RemoveChildIDs(ids...)
+	return nuo
+}
+
+// RemoveChildren removes "children" edges to Namespace entities.
+func (nuo *NamespaceUpdateOne) RemoveChildren(n ...*Namespace) *NamespaceUpdateOne {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.RemoveChildIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (nuo *NamespaceUpdateOne) Select(field string, fields ...string) *NamespaceUpdateOne {
@@ -345,6 +869,103 @@ func (nuo *NamespaceUpdateOne) sqlSave(ctx context.Context) (_node *Namespace, e
 			}
 		}
 	}
+	if value, ok := nuo.mutation.Hold(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespace.FieldHold,
+		})
+	}
+	if nuo.mutation.HoldCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: namespace.FieldHold,
+		})
+	}
+	if nuo.mutation.RegionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: namespace.FieldRegion,
+		})
+	}
+	if value, ok := nuo.mutation.EventConfig(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: namespace.FieldEventConfig,
+		})
+	}
+	if nuo.mutation.EventConfigCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: namespace.FieldEventConfig,
+		})
+	}
+	if value, ok := nuo.mutation.CronSuspension(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespace.FieldCronSuspension,
+		})
+	}
+	if nuo.mutation.CronSuspensionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: namespace.FieldCronSuspension,
+		})
+	}
+	if value, ok := nuo.mutation.Constants(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: namespace.FieldConstants,
+		})
+	}
+	if nuo.mutation.ConstantsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: namespace.FieldConstants,
+		})
+	}
+	if value, ok := nuo.mutation.Profiles(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: namespace.FieldProfiles,
+		})
+	}
+	if nuo.mutation.ProfilesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: namespace.FieldProfiles,
+		})
+	}
+	if value, ok := nuo.mutation.Profile(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespace.FieldProfile,
+		})
+	}
+	if nuo.mutation.ProfileCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: namespace.FieldProfile,
+		})
+	}
+	if value, ok := nuo.mutation.RetryPolicies(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: namespace.FieldRetryPolicies,
+		})
+	}
+	if nuo.mutation.RetryPoliciesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: namespace.FieldRetryPolicies,
+		})
+	}
 	if nuo.mutation.WorkflowsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -399,6 +1020,95 @@ func (nuo *NamespaceUpdateOne) sqlSave(ctx context.Context) (_node *Namespace, e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   namespace.ParentTable,
+			Columns: []string{namespace.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: namespace.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   namespace.ParentTable,
+			Columns: []string{namespace.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: namespace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   namespace.ChildrenTable,
+			Columns: []string{namespace.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: namespace.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !nuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   namespace.ChildrenTable,
+			Columns: []string{namespace.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: namespace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   namespace.ChildrenTable,
+			Columns: []string{namespace.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: namespace.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Namespace{config: nuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
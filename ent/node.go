@@ -0,0 +1,186 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/vorteil/direktiv/ent/node"
+)
+
+// Node is the model entity for the Node schema.
+type Node struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Hostname holds the value of the "hostname" field.
+	Hostname string `json:"hostname,omitempty"`
+	// Started holds the value of the "started" field.
+	Started time.Time `json:"started,omitempty"`
+	// Heartbeat holds the value of the "heartbeat" field.
+	Heartbeat time.Time `json:"heartbeat,omitempty"`
+	// Draining holds the value of the "draining" field.
+	Draining bool `json:"draining,omitempty"`
+	// Timers holds the value of the "timers" field.
+	Timers int `json:"timers,omitempty"`
+	// Queued holds the value of the "queued" field.
+	Queued int `json:"queued,omitempty"`
+	// Promoted holds the value of the "promoted" field.
+	Promoted bool `json:"promoted,omitempty"`
+	// TimerStats holds the value of the "timer_stats" field.
+	TimerStats []byte `json:"timer_stats,omitempty"`
+	// LockStats holds the value of the "lock_stats" field.
+	LockStats []byte `json:"lock_stats,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Node) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case node.FieldTimerStats, node.FieldLockStats:
+			values[i] = new([]byte)
+		case node.FieldDraining, node.FieldPromoted:
+			values[i] = new(sql.NullBool)
+		case node.FieldID, node.FieldTimers, node.FieldQueued:
+			values[i] = new(sql.NullInt64)
+		case node.FieldHostname:
+			values[i] = new(sql.NullString)
+		case node.FieldStarted, node.FieldHeartbeat:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Node", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Node fields.
+func (n *Node) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case node.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			n.ID = int(value.Int64)
+		case node.FieldHostname:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hostname", values[i])
+			} else if value.Valid {
+				n.Hostname = value.String
+			}
+		case node.FieldStarted:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started", values[i])
+			} else if value.Valid {
+				n.Started = value.Time
+			}
+		case node.FieldHeartbeat:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field heartbeat", values[i])
+			} else if value.Valid {
+				n.Heartbeat = value.Time
+			}
+		case node.FieldDraining:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field draining", values[i])
+			} else if value.Valid {
+				n.Draining = value.Bool
+			}
+		case node.FieldTimers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timers", values[i])
+			} else if value.Valid {
+				n.Timers = int(value.Int64)
+			}
+		case node.FieldQueued:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field queued", values[i])
+			} else if value.Valid {
+				n.Queued = int(value.Int64)
+			}
+		case node.FieldPromoted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field promoted", values[i])
+			} else if value.Valid {
+				n.Promoted = value.Bool
+			}
+		case node.FieldTimerStats:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field timer_stats", values[i])
+			} else if value != nil {
+				n.TimerStats = *value
+			}
+		case node.FieldLockStats:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field lock_stats", values[i])
+			} else if value != nil {
+				n.LockStats = *value
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Node.
+// Note that you need to call Node.Unwrap() before calling this method if this Node
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (n *Node) Update() *NodeUpdateOne {
+	return (&NodeClient{config: n.config}).UpdateOne(n)
+}
+
+// Unwrap unwraps the Node entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (n *Node) Unwrap() *Node {
+	tx, ok := n.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Node is not a transactional entity")
+	}
+	n.config.driver = tx.drv
+	return n
+}
+
+// String implements the fmt.Stringer.
+func (n *Node) String() string {
+	var builder strings.Builder
+	builder.WriteString("Node(")
+	builder.WriteString(fmt.Sprintf("id=%v", n.ID))
+	builder.WriteString(", hostname=")
+	builder.WriteString(n.Hostname)
+	builder.WriteString(", started=")
+	builder.WriteString(n.Started.Format(time.ANSIC))
+	builder.WriteString(", heartbeat=")
+	builder.WriteString(n.Heartbeat.Format(time.ANSIC))
+	builder.WriteString(", draining=")
+	builder.WriteString(fmt.Sprintf("%v", n.Draining))
+	builder.WriteString(", timers=")
+	builder.WriteString(fmt.Sprintf("%v", n.Timers))
+	builder.WriteString(", queued=")
+	builder.WriteString(fmt.Sprintf("%v", n.Queued))
+	builder.WriteString(", promoted=")
+	builder.WriteString(fmt.Sprintf("%v", n.Promoted))
+	builder.WriteString(", timer_stats=")
+	builder.WriteString(fmt.Sprintf("%v", n.TimerStats))
+	builder.WriteString(", lock_stats=")
+	builder.WriteString(fmt.Sprintf("%v", n.LockStats))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Nodes is a parsable slice of Node.
+type Nodes []*Node
+
+func (n Nodes) config(cfg config) {
+	for _i := range n {
+		n[_i].config = cfg
+	}
+}
@@ -0,0 +1,65 @@
+// Code generated by entc, DO NOT EDIT.
+
+package node
+
+const (
+	// Label holds the string label denoting the node type in the database.
+	Label = "node"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldHostname holds the string denoting the hostname field in the database.
+	FieldHostname = "hostname"
+	// FieldStarted holds the string denoting the started field in the database.
+	FieldStarted = "started"
+	// FieldHeartbeat holds the string denoting the heartbeat field in the database.
+	FieldHeartbeat = "heartbeat"
+	// FieldDraining holds the string denoting the draining field in the database.
+	FieldDraining = "draining"
+	// FieldTimers holds the string denoting the timers field in the database.
+	FieldTimers = "timers"
+	// FieldQueued holds the string denoting the queued field in the database.
+	FieldQueued = "queued"
+	// FieldPromoted holds the string denoting the promoted field in the database.
+	FieldPromoted = "promoted"
+	// FieldTimerStats holds the string denoting the timer_stats field in the database.
+	FieldTimerStats = "timer_stats"
+	// FieldLockStats holds the string denoting the lock_stats field in the database.
+	FieldLockStats = "lock_stats"
+	// Table holds the table name of the node in the database.
+	Table = "nodes"
+)
+
+// Columns holds all SQL columns for node fields.
+var Columns = []string{
+	FieldID,
+	FieldHostname,
+	FieldStarted,
+	FieldHeartbeat,
+	FieldDraining,
+	FieldTimers,
+	FieldQueued,
+	FieldPromoted,
+	FieldTimerStats,
+	FieldLockStats,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDraining holds the default value on creation for the "draining" field.
+	DefaultDraining bool
+	// DefaultTimers holds the default value on creation for the "timers" field.
+	DefaultTimers int
+	// DefaultQueued holds the default value on creation for the "queued" field.
+	DefaultQueued int
+	// DefaultPromoted holds the default value on creation for the "promoted" field.
+	DefaultPromoted bool
+)
@@ -0,0 +1,811 @@
+// Code generated by entc, DO NOT EDIT.
+
+package node
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Hostname applies equality check predicate on the "hostname" field. It's identical to HostnameEQ.
+func Hostname(v string) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHostname), v))
+	})
+}
+
+// Started applies equality check predicate on the "started" field. It's identical to StartedEQ.
+func Started(v time.Time) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStarted), v))
+	})
+}
+
+// Heartbeat applies equality check predicate on the "heartbeat" field. It's identical to HeartbeatEQ.
+func Heartbeat(v time.Time) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHeartbeat), v))
+	})
+}
+
+// Draining applies equality check predicate on the "draining" field. It's identical to DrainingEQ.
+func Draining(v bool) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDraining), v))
+	})
+}
+
+// Timers applies equality check predicate on the "timers" field. It's identical to TimersEQ.
+func Timers(v int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimers), v))
+	})
+}
+
+// Queued applies equality check predicate on the "queued" field. It's identical to QueuedEQ.
+func Queued(v int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQueued), v))
+	})
+}
+
+// Promoted applies equality check predicate on the "promoted" field. It's identical to PromotedEQ.
+func Promoted(v bool) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPromoted), v))
+	})
+}
+
+// TimerStats applies equality check predicate on the "timer_stats" field. It's identical to TimerStatsEQ.
+func TimerStats(v []byte) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimerStats), v))
+	})
+}
+
+// LockStats applies equality check predicate on the "lock_stats" field. It's identical to LockStatsEQ.
+func LockStats(v []byte) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLockStats), v))
+	})
+}
+
+// HostnameEQ applies the EQ predicate on the "hostname" field.
+func HostnameEQ(v string) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHostname), v))
+	})
+}
+
+// HostnameNEQ applies the NEQ predicate on the "hostname" field.
+func HostnameNEQ(v string) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHostname), v))
+	})
+}
+
+// HostnameIn applies the In predicate on the "hostname" field.
+func HostnameIn(vs ...string) predicate.Node {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldHostname), v...))
+	})
+}
+
+// HostnameNotIn applies the NotIn predicate on the "hostname" field.
+func HostnameNotIn(vs ...string) predicate.Node {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldHostname), v...))
+	})
+}
+
+// HostnameGT applies the GT predicate on the "hostname" field.
+func HostnameGT(v string) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldHostname), v))
+	})
+}
+
+// HostnameGTE applies the GTE predicate on the "hostname" field.
+func HostnameGTE(v string) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldHostname), v))
+	})
+}
+
+// HostnameLT applies the LT predicate on the "hostname" field.
+func HostnameLT(v string) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldHostname), v))
+	})
+}
+
+// HostnameLTE applies the LTE predicate on the "hostname" field.
+func HostnameLTE(v string) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldHostname), v))
+	})
+}
+
+// HostnameContains applies the Contains predicate on the "hostname" field.
+func HostnameContains(v string) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldHostname), v))
+	})
+}
+
+// HostnameHasPrefix applies the HasPrefix predicate on the "hostname" field.
+func HostnameHasPrefix(v string) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldHostname), v))
+	})
+}
+
+// HostnameHasSuffix applies the HasSuffix predicate on the "hostname" field.
+func HostnameHasSuffix(v string) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldHostname), v))
+	})
+}
+
+// HostnameEqualFold applies the EqualFold predicate on the "hostname" field.
+func HostnameEqualFold(v string) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldHostname), v))
+	})
+}
+
+// HostnameContainsFold applies the ContainsFold predicate on the "hostname" field.
+func HostnameContainsFold(v string) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldHostname), v))
+	})
+}
+
+// StartedEQ applies the EQ predicate on the "started" field.
+func StartedEQ(v time.Time) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStarted), v))
+	})
+}
+
+// StartedNEQ applies the NEQ predicate on the "started" field.
+func StartedNEQ(v time.Time) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStarted), v))
+	})
+}
+
+// StartedIn applies the In predicate on the "started" field.
+func StartedIn(vs ...time.Time) predicate.Node {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStarted), v...))
+	})
+}
+
+// StartedNotIn applies the NotIn predicate on the "started" field.
+func StartedNotIn(vs ...time.Time) predicate.Node {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStarted), v...))
+	})
+}
+
+// StartedGT applies the GT predicate on the "started" field.
+func StartedGT(v time.Time) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStarted), v))
+	})
+}
+
+// StartedGTE applies the GTE predicate on the "started" field.
+func StartedGTE(v time.Time) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStarted), v))
+	})
+}
+
+// StartedLT applies the LT predicate on the "started" field.
+func StartedLT(v time.Time) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStarted), v))
+	})
+}
+
+// StartedLTE applies the LTE predicate on the "started" field.
+func StartedLTE(v time.Time) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStarted), v))
+	})
+}
+
+// HeartbeatEQ applies the EQ predicate on the "heartbeat" field.
+func HeartbeatEQ(v time.Time) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHeartbeat), v))
+	})
+}
+
+// HeartbeatNEQ applies the NEQ predicate on the "heartbeat" field.
+func HeartbeatNEQ(v time.Time) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHeartbeat), v))
+	})
+}
+
+// HeartbeatIn applies the In predicate on the "heartbeat" field.
+func HeartbeatIn(vs ...time.Time) predicate.Node {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldHeartbeat), v...))
+	})
+}
+
+// HeartbeatNotIn applies the NotIn predicate on the "heartbeat" field.
+func HeartbeatNotIn(vs ...time.Time) predicate.Node {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldHeartbeat), v...))
+	})
+}
+
+// HeartbeatGT applies the GT predicate on the "heartbeat" field.
+func HeartbeatGT(v time.Time) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldHeartbeat), v))
+	})
+}
+
+// HeartbeatGTE applies the GTE predicate on the "heartbeat" field.
+func HeartbeatGTE(v time.Time) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldHeartbeat), v))
+	})
+}
+
+// HeartbeatLT applies the LT predicate on the "heartbeat" field.
+func HeartbeatLT(v time.Time) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldHeartbeat), v))
+	})
+}
+
+// HeartbeatLTE applies the LTE predicate on the "heartbeat" field.
+func HeartbeatLTE(v time.Time) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldHeartbeat), v))
+	})
+}
+
+// DrainingEQ applies the EQ predicate on the "draining" field.
+func DrainingEQ(v bool) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDraining), v))
+	})
+}
+
+// DrainingNEQ applies the NEQ predicate on the "draining" field.
+func DrainingNEQ(v bool) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDraining), v))
+	})
+}
+
+// TimersEQ applies the EQ predicate on the "timers" field.
+func TimersEQ(v int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimers), v))
+	})
+}
+
+// TimersNEQ applies the NEQ predicate on the "timers" field.
+func TimersNEQ(v int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTimers), v))
+	})
+}
+
+// TimersIn applies the In predicate on the "timers" field.
+func TimersIn(vs ...int) predicate.Node {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTimers), v...))
+	})
+}
+
+// TimersNotIn applies the NotIn predicate on the "timers" field.
+func TimersNotIn(vs ...int) predicate.Node {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTimers), v...))
+	})
+}
+
+// TimersGT applies the GT predicate on the "timers" field.
+func TimersGT(v int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTimers), v))
+	})
+}
+
+// TimersGTE applies the GTE predicate on the "timers" field.
+func TimersGTE(v int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTimers), v))
+	})
+}
+
+// TimersLT applies the LT predicate on the "timers" field.
+func TimersLT(v int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTimers), v))
+	})
+}
+
+// TimersLTE applies the LTE predicate on the "timers" field.
+func TimersLTE(v int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTimers), v))
+	})
+}
+
+// QueuedEQ applies the EQ predicate on the "queued" field.
+func QueuedEQ(v int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQueued), v))
+	})
+}
+
+// QueuedNEQ applies the NEQ predicate on the "queued" field.
+func QueuedNEQ(v int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldQueued), v))
+	})
+}
+
+// QueuedIn applies the In predicate on the "queued" field.
+func QueuedIn(vs ...int) predicate.Node {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldQueued), v...))
+	})
+}
+
+// QueuedNotIn applies the NotIn predicate on the "queued" field.
+func QueuedNotIn(vs ...int) predicate.Node {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldQueued), v...))
+	})
+}
+
+// QueuedGT applies the GT predicate on the "queued" field.
+func QueuedGT(v int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldQueued), v))
+	})
+}
+
+// QueuedGTE applies the GTE predicate on the "queued" field.
+func QueuedGTE(v int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldQueued), v))
+	})
+}
+
+// QueuedLT applies the LT predicate on the "queued" field.
+func QueuedLT(v int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldQueued), v))
+	})
+}
+
+// QueuedLTE applies the LTE predicate on the "queued" field.
+func QueuedLTE(v int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldQueued), v))
+	})
+}
+
+// PromotedEQ applies the EQ predicate on the "promoted" field.
+func PromotedEQ(v bool) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPromoted), v))
+	})
+}
+
+// PromotedNEQ applies the NEQ predicate on the "promoted" field.
+func PromotedNEQ(v bool) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPromoted), v))
+	})
+}
+
+// TimerStatsEQ applies the EQ predicate on the "timer_stats" field.
+func TimerStatsEQ(v []byte) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimerStats), v))
+	})
+}
+
+// TimerStatsNEQ applies the NEQ predicate on the "timer_stats" field.
+func TimerStatsNEQ(v []byte) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTimerStats), v))
+	})
+}
+
+// TimerStatsIn applies the In predicate on the "timer_stats" field.
+func TimerStatsIn(vs ...[]byte) predicate.Node {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTimerStats), v...))
+	})
+}
+
+// TimerStatsNotIn applies the NotIn predicate on the "timer_stats" field.
+func TimerStatsNotIn(vs ...[]byte) predicate.Node {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTimerStats), v...))
+	})
+}
+
+// TimerStatsGT applies the GT predicate on the "timer_stats" field.
+func TimerStatsGT(v []byte) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTimerStats), v))
+	})
+}
+
+// TimerStatsGTE applies the GTE predicate on the "timer_stats" field.
+func TimerStatsGTE(v []byte) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTimerStats), v))
+	})
+}
+
+// TimerStatsLT applies the LT predicate on the "timer_stats" field.
+func TimerStatsLT(v []byte) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTimerStats), v))
+	})
+}
+
+// TimerStatsLTE applies the LTE predicate on the "timer_stats" field.
+func TimerStatsLTE(v []byte) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTimerStats), v))
+	})
+}
+
+// TimerStatsIsNil applies the IsNil predicate on the "timer_stats" field.
+func TimerStatsIsNil() predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTimerStats)))
+	})
+}
+
+// TimerStatsNotNil applies the NotNil predicate on the "timer_stats" field.
+func TimerStatsNotNil() predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTimerStats)))
+	})
+}
+
+// LockStatsEQ applies the EQ predicate on the "lock_stats" field.
+func LockStatsEQ(v []byte) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLockStats), v))
+	})
+}
+
+// LockStatsNEQ applies the NEQ predicate on the "lock_stats" field.
+func LockStatsNEQ(v []byte) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLockStats), v))
+	})
+}
+
+// LockStatsIn applies the In predicate on the "lock_stats" field.
+func LockStatsIn(vs ...[]byte) predicate.Node {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLockStats), v...))
+	})
+}
+
+// LockStatsNotIn applies the NotIn predicate on the "lock_stats" field.
+func LockStatsNotIn(vs ...[]byte) predicate.Node {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Node(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLockStats), v...))
+	})
+}
+
+// LockStatsGT applies the GT predicate on the "lock_stats" field.
+func LockStatsGT(v []byte) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLockStats), v))
+	})
+}
+
+// LockStatsGTE applies the GTE predicate on the "lock_stats" field.
+func LockStatsGTE(v []byte) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLockStats), v))
+	})
+}
+
+// LockStatsLT applies the LT predicate on the "lock_stats" field.
+func LockStatsLT(v []byte) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLockStats), v))
+	})
+}
+
+// LockStatsLTE applies the LTE predicate on the "lock_stats" field.
+func LockStatsLTE(v []byte) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLockStats), v))
+	})
+}
+
+// LockStatsIsNil applies the IsNil predicate on the "lock_stats" field.
+func LockStatsIsNil() predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLockStats)))
+	})
+}
+
+// LockStatsNotNil applies the NotNil predicate on the "lock_stats" field.
+func LockStatsNotNil() predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLockStats)))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Node) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Node) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Node) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
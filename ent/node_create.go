@@ -0,0 +1,369 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/node"
+)
+
+// NodeCreate is the builder for creating a Node entity.
+type NodeCreate struct {
+	config
+	mutation *NodeMutation
+	hooks    []Hook
+}
+
+// SetHostname sets the "hostname" field.
+func (nc *NodeCreate) SetHostname(s string) *NodeCreate {
+	nc.mutation.SetHostname(s)
+	return nc
+}
+
+// SetStarted sets the "started" field.
+func (nc *NodeCreate) SetStarted(t time.Time) *NodeCreate {
+	nc.mutation.SetStarted(t)
+	return nc
+}
+
+// SetHeartbeat sets the "heartbeat" field.
+func (nc *NodeCreate) SetHeartbeat(t time.Time) *NodeCreate {
+	nc.mutation.SetHeartbeat(t)
+	return nc
+}
+
+// SetDraining sets the "draining" field.
+func (nc *NodeCreate) SetDraining(b bool) *NodeCreate {
+	nc.mutation.SetDraining(b)
+	return nc
+}
+
+// SetNillableDraining sets the "draining" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableDraining(b *bool) *NodeCreate {
+	if b != nil {
+		nc.SetDraining(*b)
+	}
+	return nc
+}
+
+// SetTimers sets the "timers" field.
+func (nc *NodeCreate) SetTimers(i int) *NodeCreate {
+	nc.mutation.SetTimers(i)
+	return nc
+}
+
+// SetNillableTimers sets the "timers" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableTimers(i *int) *NodeCreate {
+	if i != nil {
+		nc.SetTimers(*i)
+	}
+	return nc
+}
+
+// SetQueued sets the "queued" field.
+func (nc *NodeCreate) SetQueued(i int) *NodeCreate {
+	nc.mutation.SetQueued(i)
+	return nc
+}
+
+// SetNillableQueued sets the "queued" field if the given value is not nil.
+func (nc *NodeCreate) SetNillableQueued(i *int) *NodeCreate {
+	if i != nil {
+		nc.SetQueued(*i)
+	}
+	return nc
+}
+
+// SetPromoted sets the "promoted" field.
+func (nc *NodeCreate) SetPromoted(b bool) *NodeCreate {
+	nc.mutation.SetPromoted(b)
+	return nc
+}
+
+// SetNillablePromoted sets the "promoted" field if the given value is not nil.
+func (nc *NodeCreate) SetNillablePromoted(b *bool) *NodeCreate {
+	if b != nil {
+		nc.SetPromoted(*b)
+	}
+	return nc
+}
+
+// SetTimerStats sets the "timer_stats" field.
+func (nc *NodeCreate) SetTimerStats(b []byte) *NodeCreate {
+	nc.mutation.SetTimerStats(b)
+	return nc
+}
+
+// SetLockStats sets the "lock_stats" field.
+func (nc *NodeCreate) SetLockStats(b []byte) *NodeCreate {
+	nc.mutation.SetLockStats(b)
+	return nc
+}
+
+// Mutation returns the NodeMutation object of the builder.
+func (nc *NodeCreate) Mutation() *NodeMutation {
+	return nc.mutation
+}
+
+// Save creates the Node in the database.
+func (nc *NodeCreate) Save(ctx context.Context) (*Node, error) {
+	var (
+		err  error
+		node *Node
+	)
+	nc.defaults()
+	if len(nc.hooks) == 0 {
+		if err = nc.check(); err != nil {
+			return nil, err
+		}
+		node, err = nc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = nc.check(); err != nil {
+				return nil, err
+			}
+			nc.mutation = mutation
+			node, err = nc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(nc.hooks) - 1; i >= 0; i-- {
+			mut = nc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nc *NodeCreate) SaveX(ctx context.Context) *Node {
+	v, err := nc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (nc *NodeCreate) defaults() {
+	if _, ok := nc.mutation.Draining(); !ok {
+		v := node.DefaultDraining
+		nc.mutation.SetDraining(v)
+	}
+	if _, ok := nc.mutation.Timers(); !ok {
+		v := node.DefaultTimers
+		nc.mutation.SetTimers(v)
+	}
+	if _, ok := nc.mutation.Queued(); !ok {
+		v := node.DefaultQueued
+		nc.mutation.SetQueued(v)
+	}
+	if _, ok := nc.mutation.Promoted(); !ok {
+		v := node.DefaultPromoted
+		nc.mutation.SetPromoted(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nc *NodeCreate) check() error {
+	if _, ok := nc.mutation.Hostname(); !ok {
+		return &ValidationError{Name: "hostname", err: errors.New("ent: missing required field \"hostname\"")}
+	}
+	if _, ok := nc.mutation.Started(); !ok {
+		return &ValidationError{Name: "started", err: errors.New("ent: missing required field \"started\"")}
+	}
+	if _, ok := nc.mutation.Heartbeat(); !ok {
+		return &ValidationError{Name: "heartbeat", err: errors.New("ent: missing required field \"heartbeat\"")}
+	}
+	if _, ok := nc.mutation.Draining(); !ok {
+		return &ValidationError{Name: "draining", err: errors.New("ent: missing required field \"draining\"")}
+	}
+	if _, ok := nc.mutation.Timers(); !ok {
+		return &ValidationError{Name: "timers", err: errors.New("ent: missing required field \"timers\"")}
+	}
+	if _, ok := nc.mutation.Queued(); !ok {
+		return &ValidationError{Name: "queued", err: errors.New("ent: missing required field \"queued\"")}
+	}
+	if _, ok := nc.mutation.Promoted(); !ok {
+		return &ValidationError{Name: "promoted", err: errors.New("ent: missing required field \"promoted\"")}
+	}
+	return nil
+}
+
+func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
+	_node, _spec := nc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (nc *NodeCreate) createSpec() (*Node, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Node{config: nc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: node.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: node.FieldID,
+			},
+		}
+	)
+	if value, ok := nc.mutation.Hostname(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: node.FieldHostname,
+		})
+		_node.Hostname = value
+	}
+	if value, ok := nc.mutation.Started(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: node.FieldStarted,
+		})
+		_node.Started = value
+	}
+	if value, ok := nc.mutation.Heartbeat(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: node.FieldHeartbeat,
+		})
+		_node.Heartbeat = value
+	}
+	if value, ok := nc.mutation.Draining(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: node.FieldDraining,
+		})
+		_node.Draining = value
+	}
+	if value, ok := nc.mutation.Timers(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldTimers,
+		})
+		_node.Timers = value
+	}
+	if value, ok := nc.mutation.Queued(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldQueued,
+		})
+		_node.Queued = value
+	}
+	if value, ok := nc.mutation.Promoted(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: node.FieldPromoted,
+		})
+		_node.Promoted = value
+	}
+	if value, ok := nc.mutation.TimerStats(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: node.FieldTimerStats,
+		})
+		_node.TimerStats = value
+	}
+	if value, ok := nc.mutation.LockStats(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: node.FieldLockStats,
+		})
+		_node.LockStats = value
+	}
+	return _node, _spec
+}
+
+// NodeCreateBulk is the builder for creating many Node entities in bulk.
+type NodeCreateBulk struct {
+	config
+	builders []*NodeCreate
+}
+
+// Save creates the Node entities in the database.
+func (ncb *NodeCreateBulk) Save(ctx context.Context) ([]*Node, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ncb.builders))
+	nodes := make([]*Node, len(ncb.builders))
+	mutators := make([]Mutator, len(ncb.builders))
+	for i := range ncb.builders {
+		func(i int, root context.Context) {
+			builder := ncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ncb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ncb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ncb *NodeCreateBulk) SaveX(ctx context.Context) []*Node {
+	v, err := ncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
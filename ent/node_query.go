@@ -0,0 +1,904 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/node"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// NodeQuery is the builder for querying Node entities.
+type NodeQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Node
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NodeQuery builder.
+func (nq *NodeQuery) Where(ps ...predicate.Node) *NodeQuery {
+	nq.predicates = append(nq.predicates, ps...)
+	return nq
+}
+
+// Limit adds a limit step to the query.
+func (nq *NodeQuery) Limit(limit int) *NodeQuery {
+	nq.limit = &limit
+	return nq
+}
+
+// Offset adds an offset step to the query.
+func (nq *NodeQuery) Offset(offset int) *NodeQuery {
+	nq.offset = &offset
+	return nq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (nq *NodeQuery) Unique(unique bool) *NodeQuery {
+	nq.unique = &unique
+	return nq
+}
+
+// Order adds an order step to the query.
+func (nq *NodeQuery) Order(o ...OrderFunc) *NodeQuery {
+	nq.order = append(nq.order, o...)
+	return nq
+}
+
+// First returns the first Node entity from the query.
+// Returns a *NotFoundError when no Node was found.
+func (nq *NodeQuery) First(ctx context.Context) (*Node, error) {
+	nodes, err := nq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{node.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (nq *NodeQuery) FirstX(ctx context.Context) *Node {
+	node, err := nq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Node ID from the query.
+// Returns a *NotFoundError when no Node ID was found.
+func (nq *NodeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = nq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{node.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (nq *NodeQuery) FirstIDX(ctx context.Context) int {
+	id, err := nq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Node entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one Node entity is not found.
+// Returns a *NotFoundError when no Node entities are found.
+func (nq *NodeQuery) Only(ctx context.Context) (*Node, error) {
+	nodes, err := nq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{node.Label}
+	default:
+		return nil, &NotSingularError{node.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (nq *NodeQuery) OnlyX(ctx context.Context) *Node {
+	node, err := nq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Node ID in the query.
+// Returns a *NotSingularError when exactly one Node ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (nq *NodeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = nq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{node.Label}
+	default:
+		err = &NotSingularError{node.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (nq *NodeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := nq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Nodes.
+func (nq *NodeQuery) All(ctx context.Context) ([]*Node, error) {
+	if err := nq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return nq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (nq *NodeQuery) AllX(ctx context.Context) []*Node {
+	nodes, err := nq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Node IDs.
+func (nq *NodeQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := nq.Select(node.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (nq *NodeQuery) IDsX(ctx context.Context) []int {
+	ids, err := nq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (nq *NodeQuery) Count(ctx context.Context) (int, error) {
+	if err := nq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return nq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (nq *NodeQuery) CountX(ctx context.Context) int {
+	count, err := nq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (nq *NodeQuery) Exist(ctx context.Context) (bool, error) {
+	if err := nq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return nq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (nq *NodeQuery) ExistX(ctx context.Context) bool {
+	exist, err := nq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NodeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (nq *NodeQuery) Clone() *NodeQuery {
+	if nq == nil {
+		return nil
+	}
+	return &NodeQuery{
+		config:     nq.config,
+		limit:      nq.limit,
+		offset:     nq.offset,
+		order:      append([]OrderFunc{}, nq.order...),
+		predicates: append([]predicate.Node{}, nq.predicates...),
+		// clone intermediate query.
+		sql:  nq.sql.Clone(),
+		path: nq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Hostname string `json:"hostname,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Node.Query().
+//		GroupBy(node.FieldHostname).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (nq *NodeQuery) GroupBy(field string, fields ...string) *NodeGroupBy {
+	group := &NodeGroupBy{config: nq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return nq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Hostname string `json:"hostname,omitempty"`
+//	}
+//
+//	client.Node.Query().
+//		Select(node.FieldHostname).
+//		Scan(ctx, &v)
+func (nq *NodeQuery) Select(field string, fields ...string) *NodeSelect {
+	nq.fields = append([]string{field}, fields...)
+	return &NodeSelect{NodeQuery: nq}
+}
+
+func (nq *NodeQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range nq.fields {
+		if !node.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if nq.path != nil {
+		prev, err := nq.path(ctx)
+		if err != nil {
+			return err
+		}
+		nq.sql = prev
+	}
+	return nil
+}
+
+func (nq *NodeQuery) sqlAll(ctx context.Context) ([]*Node, error) {
+	var (
+		nodes = []*Node{}
+		_spec = nq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &Node{config: nq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, nq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (nq *NodeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := nq.querySpec()
+	return sqlgraph.CountNodes(ctx, nq.driver, _spec)
+}
+
+func (nq *NodeQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := nq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (nq *NodeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   node.Table,
+			Columns: node.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: node.FieldID,
+			},
+		},
+		From:   nq.sql,
+		Unique: true,
+	}
+	if unique := nq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := nq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, node.FieldID)
+		for i := range fields {
+			if fields[i] != node.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := nq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := nq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := nq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := nq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (nq *NodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(nq.driver.Dialect())
+	t1 := builder.Table(node.Table)
+	selector := builder.Select(t1.Columns(node.Columns...)...).From(t1)
+	if nq.sql != nil {
+		selector = nq.sql
+		selector.Select(selector.Columns(node.Columns...)...)
+	}
+	for _, p := range nq.predicates {
+		p(selector)
+	}
+	for _, p := range nq.order {
+		p(selector)
+	}
+	if offset := nq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := nq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// NodeGroupBy is the group-by builder for Node entities.
+type NodeGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ngb *NodeGroupBy) Aggregate(fns ...AggregateFunc) *NodeGroupBy {
+	ngb.fns = append(ngb.fns, fns...)
+	return ngb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ngb *NodeGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ngb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ngb.sql = query
+	return ngb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ngb *NodeGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := ngb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NodeGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(ngb.fields) > 1 {
+		return nil, errors.New("ent: NodeGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := ngb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ngb *NodeGroupBy) StringsX(ctx context.Context) []string {
+	v, err := ngb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NodeGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ngb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{node.Label}
+	default:
+		err = fmt.Errorf("ent: NodeGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ngb *NodeGroupBy) StringX(ctx context.Context) string {
+	v, err := ngb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NodeGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(ngb.fields) > 1 {
+		return nil, errors.New("ent: NodeGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := ngb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ngb *NodeGroupBy) IntsX(ctx context.Context) []int {
+	v, err := ngb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NodeGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ngb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{node.Label}
+	default:
+		err = fmt.Errorf("ent: NodeGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ngb *NodeGroupBy) IntX(ctx context.Context) int {
+	v, err := ngb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NodeGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ngb.fields) > 1 {
+		return nil, errors.New("ent: NodeGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := ngb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ngb *NodeGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := ngb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NodeGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ngb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{node.Label}
+	default:
+		err = fmt.Errorf("ent: NodeGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ngb *NodeGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := ngb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NodeGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(ngb.fields) > 1 {
+		return nil, errors.New("ent: NodeGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := ngb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ngb *NodeGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := ngb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ngb *NodeGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ngb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{node.Label}
+	default:
+		err = fmt.Errorf("ent: NodeGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ngb *NodeGroupBy) BoolX(ctx context.Context) bool {
+	v, err := ngb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ngb *NodeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ngb.fields {
+		if !node.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ngb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ngb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ngb *NodeGroupBy) sqlQuery() *sql.Selector {
+	selector := ngb.sql
+	columns := make([]string, 0, len(ngb.fields)+len(ngb.fns))
+	columns = append(columns, ngb.fields...)
+	for _, fn := range ngb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(ngb.fields...)
+}
+
+// NodeSelect is the builder for selecting fields of Node entities.
+type NodeSelect struct {
+	*NodeQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ns *NodeSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ns.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ns.sql = ns.NodeQuery.sqlQuery(ctx)
+	return ns.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ns *NodeSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ns.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ns *NodeSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ns.fields) > 1 {
+		return nil, errors.New("ent: NodeSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ns.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ns *NodeSelect) StringsX(ctx context.Context) []string {
+	v, err := ns.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ns *NodeSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ns.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{node.Label}
+	default:
+		err = fmt.Errorf("ent: NodeSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ns *NodeSelect) StringX(ctx context.Context) string {
+	v, err := ns.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ns *NodeSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ns.fields) > 1 {
+		return nil, errors.New("ent: NodeSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ns.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ns *NodeSelect) IntsX(ctx context.Context) []int {
+	v, err := ns.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ns *NodeSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ns.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{node.Label}
+	default:
+		err = fmt.Errorf("ent: NodeSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ns *NodeSelect) IntX(ctx context.Context) int {
+	v, err := ns.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ns *NodeSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ns.fields) > 1 {
+		return nil, errors.New("ent: NodeSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ns.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ns *NodeSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ns.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ns *NodeSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ns.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{node.Label}
+	default:
+		err = fmt.Errorf("ent: NodeSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ns *NodeSelect) Float64X(ctx context.Context) float64 {
+	v, err := ns.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ns *NodeSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ns.fields) > 1 {
+		return nil, errors.New("ent: NodeSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ns.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ns *NodeSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ns.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ns *NodeSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ns.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{node.Label}
+	default:
+		err = fmt.Errorf("ent: NodeSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ns *NodeSelect) BoolX(ctx context.Context) bool {
+	v, err := ns.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ns *NodeSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ns.sqlQuery().Query()
+	if err := ns.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ns *NodeSelect) sqlQuery() sql.Querier {
+	selector := ns.sql
+	selector.Select(selector.Columns(ns.fields...)...)
+	return selector
+}
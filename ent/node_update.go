@@ -0,0 +1,635 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/node"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// NodeUpdate is the builder for updating Node entities.
+type NodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NodeMutation
+}
+
+// Where adds a new predicate for the NodeUpdate builder.
+func (nu *NodeUpdate) Where(ps ...predicate.Node) *NodeUpdate {
+	nu.mutation.predicates = append(nu.mutation.predicates, ps...)
+	return nu
+}
+
+// SetHostname sets the "hostname" field.
+func (nu *NodeUpdate) SetHostname(s string) *NodeUpdate {
+	nu.mutation.SetHostname(s)
+	return nu
+}
+
+// SetStarted sets the "started" field.
+func (nu *NodeUpdate) SetStarted(t time.Time) *NodeUpdate {
+	nu.mutation.SetStarted(t)
+	return nu
+}
+
+// SetHeartbeat sets the "heartbeat" field.
+func (nu *NodeUpdate) SetHeartbeat(t time.Time) *NodeUpdate {
+	nu.mutation.SetHeartbeat(t)
+	return nu
+}
+
+// SetDraining sets the "draining" field.
+func (nu *NodeUpdate) SetDraining(b bool) *NodeUpdate {
+	nu.mutation.SetDraining(b)
+	return nu
+}
+
+// SetNillableDraining sets the "draining" field if the given value is not nil.
+func (nu *NodeUpdate) SetNillableDraining(b *bool) *NodeUpdate {
+	if b != nil {
+		nu.SetDraining(*b)
+	}
+	return nu
+}
+
+// SetTimers sets the "timers" field.
+func (nu *NodeUpdate) SetTimers(i int) *NodeUpdate {
+	nu.mutation.ResetTimers()
+	nu.mutation.SetTimers(i)
+	return nu
+}
+
+// SetNillableTimers sets the "timers" field if the given value is not nil.
+func (nu *NodeUpdate) SetNillableTimers(i *int) *NodeUpdate {
+	if i != nil {
+		nu.SetTimers(*i)
+	}
+	return nu
+}
+
+// AddTimers adds i to the "timers" field.
+func (nu *NodeUpdate) AddTimers(i int) *NodeUpdate {
+	nu.mutation.AddTimers(i)
+	return nu
+}
+
+// SetQueued sets the "queued" field.
+func (nu *NodeUpdate) SetQueued(i int) *NodeUpdate {
+	nu.mutation.ResetQueued()
+	nu.mutation.SetQueued(i)
+	return nu
+}
+
+// SetNillableQueued sets the "queued" field if the given value is not nil.
+func (nu *NodeUpdate) SetNillableQueued(i *int) *NodeUpdate {
+	if i != nil {
+		nu.SetQueued(*i)
+	}
+	return nu
+}
+
+// AddQueued adds i to the "queued" field.
+func (nu *NodeUpdate) AddQueued(i int) *NodeUpdate {
+	nu.mutation.AddQueued(i)
+	return nu
+}
+
+// SetPromoted sets the "promoted" field.
+func (nu *NodeUpdate) SetPromoted(b bool) *NodeUpdate {
+	nu.mutation.SetPromoted(b)
+	return nu
+}
+
+// SetNillablePromoted sets the "promoted" field if the given value is not nil.
+func (nu *NodeUpdate) SetNillablePromoted(b *bool) *NodeUpdate {
+	if b != nil {
+		nu.SetPromoted(*b)
+	}
+	return nu
+}
+
+// SetTimerStats sets the "timer_stats" field.
+func (nu *NodeUpdate) SetTimerStats(b []byte) *NodeUpdate {
+	nu.mutation.SetTimerStats(b)
+	return nu
+}
+
+// ClearTimerStats clears the value of the "timer_stats" field.
+func (nu *NodeUpdate) ClearTimerStats() *NodeUpdate {
+	nu.mutation.ClearTimerStats()
+	return nu
+}
+
+// SetLockStats sets the "lock_stats" field.
+func (nu *NodeUpdate) SetLockStats(b []byte) *NodeUpdate {
+	nu.mutation.SetLockStats(b)
+	return nu
+}
+
+// ClearLockStats clears the value of the "lock_stats" field.
+func (nu *NodeUpdate) ClearLockStats() *NodeUpdate {
+	nu.mutation.ClearLockStats()
+	return nu
+}
+
+// Mutation returns the NodeMutation object of the builder.
+func (nu *NodeUpdate) Mutation() *NodeMutation {
+	return nu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nu *NodeUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(nu.hooks) == 0 {
+		affected, err = nu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			nu.mutation = mutation
+			affected, err = nu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(nu.hooks) - 1; i >= 0; i-- {
+			mut = nu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nu *NodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := nu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nu *NodeUpdate) Exec(ctx context.Context) error {
+	_, err := nu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nu *NodeUpdate) ExecX(ctx context.Context) {
+	if err := nu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   node.Table,
+			Columns: node.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: node.FieldID,
+			},
+		},
+	}
+	if ps := nu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nu.mutation.Hostname(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: node.FieldHostname,
+		})
+	}
+	if value, ok := nu.mutation.Started(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: node.FieldStarted,
+		})
+	}
+	if value, ok := nu.mutation.Heartbeat(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: node.FieldHeartbeat,
+		})
+	}
+	if value, ok := nu.mutation.Draining(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: node.FieldDraining,
+		})
+	}
+	if value, ok := nu.mutation.Timers(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldTimers,
+		})
+	}
+	if value, ok := nu.mutation.AddedTimers(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldTimers,
+		})
+	}
+	if value, ok := nu.mutation.Queued(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldQueued,
+		})
+	}
+	if value, ok := nu.mutation.AddedQueued(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldQueued,
+		})
+	}
+	if value, ok := nu.mutation.Promoted(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: node.FieldPromoted,
+		})
+	}
+	if value, ok := nu.mutation.TimerStats(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: node.FieldTimerStats,
+		})
+	}
+	if nu.mutation.TimerStatsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: node.FieldTimerStats,
+		})
+	}
+	if value, ok := nu.mutation.LockStats(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: node.FieldLockStats,
+		})
+	}
+	if nu.mutation.LockStatsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: node.FieldLockStats,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{node.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// NodeUpdateOne is the builder for updating a single Node entity.
+type NodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NodeMutation
+}
+
+// SetHostname sets the "hostname" field.
+func (nuo *NodeUpdateOne) SetHostname(s string) *NodeUpdateOne {
+	nuo.mutation.SetHostname(s)
+	return nuo
+}
+
+// SetStarted sets the "started" field.
+func (nuo *NodeUpdateOne) SetStarted(t time.Time) *NodeUpdateOne {
+	nuo.mutation.SetStarted(t)
+	return nuo
+}
+
+// SetHeartbeat sets the "heartbeat" field.
+func (nuo *NodeUpdateOne) SetHeartbeat(t time.Time) *NodeUpdateOne {
+	nuo.mutation.SetHeartbeat(t)
+	return nuo
+}
+
+// SetDraining sets the "draining" field.
+func (nuo *NodeUpdateOne) SetDraining(b bool) *NodeUpdateOne {
+	nuo.mutation.SetDraining(b)
+	return nuo
+}
+
+// SetNillableDraining sets the "draining" field if the given value is not nil.
+func (nuo *NodeUpdateOne) SetNillableDraining(b *bool) *NodeUpdateOne {
+	if b != nil {
+		nuo.SetDraining(*b)
+	}
+	return nuo
+}
+
+// SetTimers sets the "timers" field.
+func (nuo *NodeUpdateOne) SetTimers(i int) *NodeUpdateOne {
+	nuo.mutation.ResetTimers()
+	nuo.mutation.SetTimers(i)
+	return nuo
+}
+
+// SetNillableTimers sets the "timers" field if the given value is not nil.
+func (nuo *NodeUpdateOne) SetNillableTimers(i *int) *NodeUpdateOne {
+	if i != nil {
+		nuo.SetTimers(*i)
+	}
+	return nuo
+}
+
+// AddTimers adds i to the "timers" field.
+func (nuo *NodeUpdateOne) AddTimers(i int) *NodeUpdateOne {
+	nuo.mutation.AddTimers(i)
+	return nuo
+}
+
+// SetQueued sets the "queued" field.
+func (nuo *NodeUpdateOne) SetQueued(i int) *NodeUpdateOne {
+	nuo.mutation.ResetQueued()
+	nuo.mutation.SetQueued(i)
+	return nuo
+}
+
+// SetNillableQueued sets the "queued" field if the given value is not nil.
+func (nuo *NodeUpdateOne) SetNillableQueued(i *int) *NodeUpdateOne {
+	if i != nil {
+		nuo.SetQueued(*i)
+	}
+	return nuo
+}
+
+// AddQueued adds i to the "queued" field.
+func (nuo *NodeUpdateOne) AddQueued(i int) *NodeUpdateOne {
+	nuo.mutation.AddQueued(i)
+	return nuo
+}
+
+// SetPromoted sets the "promoted" field.
+func (nuo *NodeUpdateOne) SetPromoted(b bool) *NodeUpdateOne {
+	nuo.mutation.SetPromoted(b)
+	return nuo
+}
+
+// SetNillablePromoted sets the "promoted" field if the given value is not nil.
+func (nuo *NodeUpdateOne) SetNillablePromoted(b *bool) *NodeUpdateOne {
+	if b != nil {
+		nuo.SetPromoted(*b)
+	}
+	return nuo
+}
+
+// SetTimerStats sets the "timer_stats" field.
+func (nuo *NodeUpdateOne) SetTimerStats(b []byte) *NodeUpdateOne {
+	nuo.mutation.SetTimerStats(b)
+	return nuo
+}
+
+// ClearTimerStats clears the value of the "timer_stats" field.
+func (nuo *NodeUpdateOne) ClearTimerStats() *NodeUpdateOne {
+	nuo.mutation.ClearTimerStats()
+	return nuo
+}
+
+// SetLockStats sets the "lock_stats" field.
+func (nuo *NodeUpdateOne) SetLockStats(b []byte) *NodeUpdateOne {
+	nuo.mutation.SetLockStats(b)
+	return nuo
+}
+
+// ClearLockStats clears the value of the "lock_stats" field.
+func (nuo *NodeUpdateOne) ClearLockStats() *NodeUpdateOne {
+	nuo.mutation.ClearLockStats()
+	return nuo
+}
+
+// Mutation returns the NodeMutation object of the builder.
+func (nuo *NodeUpdateOne) Mutation() *NodeMutation {
+	return nuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nuo *NodeUpdateOne) Select(field string, fields ...string) *NodeUpdateOne {
+	nuo.fields = append([]string{field}, fields...)
+	return nuo
+}
+
+// Save executes the query and returns the updated Node entity.
+func (nuo *NodeUpdateOne) Save(ctx context.Context) (*Node, error) {
+	var (
+		err  error
+		node *Node
+	)
+	if len(nuo.hooks) == 0 {
+		node, err = nuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NodeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			nuo.mutation = mutation
+			node, err = nuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(nuo.hooks) - 1; i >= 0; i-- {
+			mut = nuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nuo *NodeUpdateOne) SaveX(ctx context.Context) *Node {
+	node, err := nuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nuo *NodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := nuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nuo *NodeUpdateOne) ExecX(ctx context.Context) {
+	if err := nuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (_node *Node, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   node.Table,
+			Columns: node.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: node.FieldID,
+			},
+		},
+	}
+	id, ok := nuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Node.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, node.FieldID)
+		for _, f := range fields {
+			if !node.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != node.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nuo.mutation.Hostname(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: node.FieldHostname,
+		})
+	}
+	if value, ok := nuo.mutation.Started(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: node.FieldStarted,
+		})
+	}
+	if value, ok := nuo.mutation.Heartbeat(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: node.FieldHeartbeat,
+		})
+	}
+	if value, ok := nuo.mutation.Draining(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: node.FieldDraining,
+		})
+	}
+	if value, ok := nuo.mutation.Timers(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldTimers,
+		})
+	}
+	if value, ok := nuo.mutation.AddedTimers(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldTimers,
+		})
+	}
+	if value, ok := nuo.mutation.Queued(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldQueued,
+		})
+	}
+	if value, ok := nuo.mutation.AddedQueued(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldQueued,
+		})
+	}
+	if value, ok := nuo.mutation.Promoted(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: node.FieldPromoted,
+		})
+	}
+	if value, ok := nuo.mutation.TimerStats(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: node.FieldTimerStats,
+		})
+	}
+	if nuo.mutation.TimerStatsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: node.FieldTimerStats,
+		})
+	}
+	if value, ok := nuo.mutation.LockStats(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: node.FieldLockStats,
+		})
+	}
+	if nuo.mutation.LockStatsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: node.FieldLockStats,
+		})
+	}
+	_node = &Node{config: nuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{node.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
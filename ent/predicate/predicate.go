@@ -6,9 +6,48 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// EventCounter is the predicate function for eventcounter builders.
+type EventCounter func(*sql.Selector)
+
+// InstanceHistory is the predicate function for instancehistory builders.
+type InstanceHistory func(*sql.Selector)
+
+// JQModule is the predicate function for jqmodule builders.
+type JQModule func(*sql.Selector)
+
+// JSONSchema is the predicate function for jsonschema builders.
+type JSONSchema func(*sql.Selector)
+
+// LockLease is the predicate function for locklease builders.
+type LockLease func(*sql.Selector)
+
 // Namespace is the predicate function for namespace builders.
 type Namespace func(*sql.Selector)
 
+// Node is the predicate function for node builders.
+type Node func(*sql.Selector)
+
+// QueuedInvocation is the predicate function for queuedinvocation builders.
+type QueuedInvocation func(*sql.Selector)
+
+// QueuedState is the predicate function for queuedstate builders.
+type QueuedState func(*sql.Selector)
+
+// ScheduledInvocation is the predicate function for scheduledinvocation builders.
+type ScheduledInvocation func(*sql.Selector)
+
+// Setting is the predicate function for setting builders.
+type Setting func(*sql.Selector)
+
+// Timer is the predicate function for timer builders.
+type Timer func(*sql.Selector)
+
+// UnmatchedEvent is the predicate function for unmatchedevent builders.
+type UnmatchedEvent func(*sql.Selector)
+
+// WasmModule is the predicate function for wasmmodule builders.
+type WasmModule func(*sql.Selector)
+
 // Workflow is the predicate function for workflow builders.
 type Workflow func(*sql.Selector)
 
@@ -20,3 +59,6 @@ type WorkflowEventsWait func(*sql.Selector)
 
 // WorkflowInstance is the predicate function for workflowinstance builders.
 type WorkflowInstance func(*sql.Selector)
+
+// WorkflowRevision is the predicate function for workflowrevision builders.
+type WorkflowRevision func(*sql.Selector)
@@ -0,0 +1,54 @@
+// Code generated by entc, DO NOT EDIT.
+
+package queuedinvocation
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the queuedinvocation type in the database.
+	Label = "queued_invocation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldInstanceID holds the string denoting the instance_id field in the database.
+	FieldInstanceID = "instance_id"
+	// FieldWorkflow holds the string denoting the workflow field in the database.
+	FieldWorkflow = "workflow"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldParams holds the string denoting the params field in the database.
+	FieldParams = "params"
+	// FieldCreated holds the string denoting the created field in the database.
+	FieldCreated = "created"
+	// FieldExpires holds the string denoting the expires field in the database.
+	FieldExpires = "expires"
+	// Table holds the table name of the queuedinvocation in the database.
+	Table = "queued_invocations"
+)
+
+// Columns holds all SQL columns for queuedinvocation fields.
+var Columns = []string{
+	FieldID,
+	FieldInstanceID,
+	FieldWorkflow,
+	FieldSource,
+	FieldParams,
+	FieldCreated,
+	FieldExpires,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreated holds the default value on creation for the "created" field.
+	DefaultCreated func() time.Time
+)
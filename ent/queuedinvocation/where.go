@@ -0,0 +1,694 @@
+// Code generated by entc, DO NOT EDIT.
+
+package queuedinvocation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// InstanceID applies equality check predicate on the "instance_id" field. It's identical to InstanceIDEQ.
+func InstanceID(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInstanceID), v))
+	})
+}
+
+// Workflow applies equality check predicate on the "workflow" field. It's identical to WorkflowEQ.
+func Workflow(v uuid.UUID) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWorkflow), v))
+	})
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// Params applies equality check predicate on the "params" field. It's identical to ParamsEQ.
+func Params(v []byte) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldParams), v))
+	})
+}
+
+// Created applies equality check predicate on the "created" field. It's identical to CreatedEQ.
+func Created(v time.Time) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// Expires applies equality check predicate on the "expires" field. It's identical to ExpiresEQ.
+func Expires(v time.Time) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpires), v))
+	})
+}
+
+// InstanceIDEQ applies the EQ predicate on the "instance_id" field.
+func InstanceIDEQ(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDNEQ applies the NEQ predicate on the "instance_id" field.
+func InstanceIDNEQ(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDIn applies the In predicate on the "instance_id" field.
+func InstanceIDIn(vs ...string) predicate.QueuedInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldInstanceID), v...))
+	})
+}
+
+// InstanceIDNotIn applies the NotIn predicate on the "instance_id" field.
+func InstanceIDNotIn(vs ...string) predicate.QueuedInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldInstanceID), v...))
+	})
+}
+
+// InstanceIDGT applies the GT predicate on the "instance_id" field.
+func InstanceIDGT(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDGTE applies the GTE predicate on the "instance_id" field.
+func InstanceIDGTE(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDLT applies the LT predicate on the "instance_id" field.
+func InstanceIDLT(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDLTE applies the LTE predicate on the "instance_id" field.
+func InstanceIDLTE(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDContains applies the Contains predicate on the "instance_id" field.
+func InstanceIDContains(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDHasPrefix applies the HasPrefix predicate on the "instance_id" field.
+func InstanceIDHasPrefix(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDHasSuffix applies the HasSuffix predicate on the "instance_id" field.
+func InstanceIDHasSuffix(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDEqualFold applies the EqualFold predicate on the "instance_id" field.
+func InstanceIDEqualFold(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldInstanceID), v))
+	})
+}
+
+// InstanceIDContainsFold applies the ContainsFold predicate on the "instance_id" field.
+func InstanceIDContainsFold(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldInstanceID), v))
+	})
+}
+
+// WorkflowEQ applies the EQ predicate on the "workflow" field.
+func WorkflowEQ(v uuid.UUID) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWorkflow), v))
+	})
+}
+
+// WorkflowNEQ applies the NEQ predicate on the "workflow" field.
+func WorkflowNEQ(v uuid.UUID) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWorkflow), v))
+	})
+}
+
+// WorkflowIn applies the In predicate on the "workflow" field.
+func WorkflowIn(vs ...uuid.UUID) predicate.QueuedInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldWorkflow), v...))
+	})
+}
+
+// WorkflowNotIn applies the NotIn predicate on the "workflow" field.
+func WorkflowNotIn(vs ...uuid.UUID) predicate.QueuedInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldWorkflow), v...))
+	})
+}
+
+// WorkflowGT applies the GT predicate on the "workflow" field.
+func WorkflowGT(v uuid.UUID) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWorkflow), v))
+	})
+}
+
+// WorkflowGTE applies the GTE predicate on the "workflow" field.
+func WorkflowGTE(v uuid.UUID) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWorkflow), v))
+	})
+}
+
+// WorkflowLT applies the LT predicate on the "workflow" field.
+func WorkflowLT(v uuid.UUID) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWorkflow), v))
+	})
+}
+
+// WorkflowLTE applies the LTE predicate on the "workflow" field.
+func WorkflowLTE(v uuid.UUID) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWorkflow), v))
+	})
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.QueuedInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSource), v...))
+	})
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.QueuedInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSource), v...))
+	})
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSource), v))
+	})
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSource), v))
+	})
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSource), v))
+	})
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSource), v))
+	})
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSource), v))
+	})
+}
+
+// ParamsEQ applies the EQ predicate on the "params" field.
+func ParamsEQ(v []byte) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldParams), v))
+	})
+}
+
+// ParamsNEQ applies the NEQ predicate on the "params" field.
+func ParamsNEQ(v []byte) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldParams), v))
+	})
+}
+
+// ParamsIn applies the In predicate on the "params" field.
+func ParamsIn(vs ...[]byte) predicate.QueuedInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldParams), v...))
+	})
+}
+
+// ParamsNotIn applies the NotIn predicate on the "params" field.
+func ParamsNotIn(vs ...[]byte) predicate.QueuedInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldParams), v...))
+	})
+}
+
+// ParamsGT applies the GT predicate on the "params" field.
+func ParamsGT(v []byte) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldParams), v))
+	})
+}
+
+// ParamsGTE applies the GTE predicate on the "params" field.
+func ParamsGTE(v []byte) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldParams), v))
+	})
+}
+
+// ParamsLT applies the LT predicate on the "params" field.
+func ParamsLT(v []byte) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldParams), v))
+	})
+}
+
+// ParamsLTE applies the LTE predicate on the "params" field.
+func ParamsLTE(v []byte) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldParams), v))
+	})
+}
+
+// CreatedEQ applies the EQ predicate on the "created" field.
+func CreatedEQ(v time.Time) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedNEQ applies the NEQ predicate on the "created" field.
+func CreatedNEQ(v time.Time) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedIn applies the In predicate on the "created" field.
+func CreatedIn(vs ...time.Time) predicate.QueuedInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedNotIn applies the NotIn predicate on the "created" field.
+func CreatedNotIn(vs ...time.Time) predicate.QueuedInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedGT applies the GT predicate on the "created" field.
+func CreatedGT(v time.Time) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedGTE applies the GTE predicate on the "created" field.
+func CreatedGTE(v time.Time) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLT applies the LT predicate on the "created" field.
+func CreatedLT(v time.Time) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLTE applies the LTE predicate on the "created" field.
+func CreatedLTE(v time.Time) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreated), v))
+	})
+}
+
+// ExpiresEQ applies the EQ predicate on the "expires" field.
+func ExpiresEQ(v time.Time) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresNEQ applies the NEQ predicate on the "expires" field.
+func ExpiresNEQ(v time.Time) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresIn applies the In predicate on the "expires" field.
+func ExpiresIn(vs ...time.Time) predicate.QueuedInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpires), v...))
+	})
+}
+
+// ExpiresNotIn applies the NotIn predicate on the "expires" field.
+func ExpiresNotIn(vs ...time.Time) predicate.QueuedInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpires), v...))
+	})
+}
+
+// ExpiresGT applies the GT predicate on the "expires" field.
+func ExpiresGT(v time.Time) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresGTE applies the GTE predicate on the "expires" field.
+func ExpiresGTE(v time.Time) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresLT applies the LT predicate on the "expires" field.
+func ExpiresLT(v time.Time) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresLTE applies the LTE predicate on the "expires" field.
+func ExpiresLTE(v time.Time) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpires), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QueuedInvocation) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QueuedInvocation) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QueuedInvocation) predicate.QueuedInvocation {
+	return predicate.QueuedInvocation(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
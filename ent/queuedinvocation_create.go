@@ -0,0 +1,289 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent/queuedinvocation"
+)
+
+// QueuedInvocationCreate is the builder for creating a QueuedInvocation entity.
+type QueuedInvocationCreate struct {
+	config
+	mutation *QueuedInvocationMutation
+	hooks    []Hook
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (qic *QueuedInvocationCreate) SetInstanceID(s string) *QueuedInvocationCreate {
+	qic.mutation.SetInstanceID(s)
+	return qic
+}
+
+// SetWorkflow sets the "workflow" field.
+func (qic *QueuedInvocationCreate) SetWorkflow(u uuid.UUID) *QueuedInvocationCreate {
+	qic.mutation.SetWorkflow(u)
+	return qic
+}
+
+// SetSource sets the "source" field.
+func (qic *QueuedInvocationCreate) SetSource(s string) *QueuedInvocationCreate {
+	qic.mutation.SetSource(s)
+	return qic
+}
+
+// SetParams sets the "params" field.
+func (qic *QueuedInvocationCreate) SetParams(b []byte) *QueuedInvocationCreate {
+	qic.mutation.SetParams(b)
+	return qic
+}
+
+// SetCreated sets the "created" field.
+func (qic *QueuedInvocationCreate) SetCreated(t time.Time) *QueuedInvocationCreate {
+	qic.mutation.SetCreated(t)
+	return qic
+}
+
+// SetNillableCreated sets the "created" field if the given value is not nil.
+func (qic *QueuedInvocationCreate) SetNillableCreated(t *time.Time) *QueuedInvocationCreate {
+	if t != nil {
+		qic.SetCreated(*t)
+	}
+	return qic
+}
+
+// SetExpires sets the "expires" field.
+func (qic *QueuedInvocationCreate) SetExpires(t time.Time) *QueuedInvocationCreate {
+	qic.mutation.SetExpires(t)
+	return qic
+}
+
+// Mutation returns the QueuedInvocationMutation object of the builder.
+func (qic *QueuedInvocationCreate) Mutation() *QueuedInvocationMutation {
+	return qic.mutation
+}
+
+// Save creates the QueuedInvocation in the database.
+func (qic *QueuedInvocationCreate) Save(ctx context.Context) (*QueuedInvocation, error) {
+	var (
+		err  error
+		node *QueuedInvocation
+	)
+	qic.defaults()
+	if len(qic.hooks) == 0 {
+		if err = qic.check(); err != nil {
+			return nil, err
+		}
+		node, err = qic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*QueuedInvocationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = qic.check(); err != nil {
+				return nil, err
+			}
+			qic.mutation = mutation
+			node, err = qic.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(qic.hooks) - 1; i >= 0; i-- {
+			mut = qic.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, qic.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qic *QueuedInvocationCreate) SaveX(ctx context.Context) *QueuedInvocation {
+	v, err := qic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (qic *QueuedInvocationCreate) defaults() {
+	if _, ok := qic.mutation.Created(); !ok {
+		v := queuedinvocation.DefaultCreated()
+		qic.mutation.SetCreated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qic *QueuedInvocationCreate) check() error {
+	if _, ok := qic.mutation.InstanceID(); !ok {
+		return &ValidationError{Name: "instance_id", err: errors.New("ent: missing required field \"instance_id\"")}
+	}
+	if _, ok := qic.mutation.Workflow(); !ok {
+		return &ValidationError{Name: "workflow", err: errors.New("ent: missing required field \"workflow\"")}
+	}
+	if _, ok := qic.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New("ent: missing required field \"source\"")}
+	}
+	if _, ok := qic.mutation.Params(); !ok {
+		return &ValidationError{Name: "params", err: errors.New("ent: missing required field \"params\"")}
+	}
+	if _, ok := qic.mutation.Created(); !ok {
+		return &ValidationError{Name: "created", err: errors.New("ent: missing required field \"created\"")}
+	}
+	if _, ok := qic.mutation.Expires(); !ok {
+		return &ValidationError{Name: "expires", err: errors.New("ent: missing required field \"expires\"")}
+	}
+	return nil
+}
+
+func (qic *QueuedInvocationCreate) sqlSave(ctx context.Context) (*QueuedInvocation, error) {
+	_node, _spec := qic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qic.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (qic *QueuedInvocationCreate) createSpec() (*QueuedInvocation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QueuedInvocation{config: qic.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: queuedinvocation.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: queuedinvocation.FieldID,
+			},
+		}
+	)
+	if value, ok := qic.mutation.InstanceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: queuedinvocation.FieldInstanceID,
+		})
+		_node.InstanceID = value
+	}
+	if value, ok := qic.mutation.Workflow(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: queuedinvocation.FieldWorkflow,
+		})
+		_node.Workflow = value
+	}
+	if value, ok := qic.mutation.Source(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: queuedinvocation.FieldSource,
+		})
+		_node.Source = value
+	}
+	if value, ok := qic.mutation.Params(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: queuedinvocation.FieldParams,
+		})
+		_node.Params = value
+	}
+	if value, ok := qic.mutation.Created(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: queuedinvocation.FieldCreated,
+		})
+		_node.Created = value
+	}
+	if value, ok := qic.mutation.Expires(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: queuedinvocation.FieldExpires,
+		})
+		_node.Expires = value
+	}
+	return _node, _spec
+}
+
+// QueuedInvocationCreateBulk is the builder for creating many QueuedInvocation entities in bulk.
+type QueuedInvocationCreateBulk struct {
+	config
+	builders []*QueuedInvocationCreate
+}
+
+// Save creates the QueuedInvocation entities in the database.
+func (qicb *QueuedInvocationCreateBulk) Save(ctx context.Context) ([]*QueuedInvocation, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(qicb.builders))
+	nodes := make([]*QueuedInvocation, len(qicb.builders))
+	mutators := make([]Mutator, len(qicb.builders))
+	for i := range qicb.builders {
+		func(i int, root context.Context) {
+			builder := qicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QueuedInvocationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qicb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qicb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qicb *QueuedInvocationCreateBulk) SaveX(ctx context.Context) []*QueuedInvocation {
+	v, err := qicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
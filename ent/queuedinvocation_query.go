@@ -0,0 +1,904 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/queuedinvocation"
+)
+
+// QueuedInvocationQuery is the builder for querying QueuedInvocation entities.
+type QueuedInvocationQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.QueuedInvocation
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QueuedInvocationQuery builder.
+func (qiq *QueuedInvocationQuery) Where(ps ...predicate.QueuedInvocation) *QueuedInvocationQuery {
+	qiq.predicates = append(qiq.predicates, ps...)
+	return qiq
+}
+
+// Limit adds a limit step to the query.
+func (qiq *QueuedInvocationQuery) Limit(limit int) *QueuedInvocationQuery {
+	qiq.limit = &limit
+	return qiq
+}
+
+// Offset adds an offset step to the query.
+func (qiq *QueuedInvocationQuery) Offset(offset int) *QueuedInvocationQuery {
+	qiq.offset = &offset
+	return qiq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (qiq *QueuedInvocationQuery) Unique(unique bool) *QueuedInvocationQuery {
+	qiq.unique = &unique
+	return qiq
+}
+
+// Order adds an order step to the query.
+func (qiq *QueuedInvocationQuery) Order(o ...OrderFunc) *QueuedInvocationQuery {
+	qiq.order = append(qiq.order, o...)
+	return qiq
+}
+
+// First returns the first QueuedInvocation entity from the query.
+// Returns a *NotFoundError when no QueuedInvocation was found.
+func (qiq *QueuedInvocationQuery) First(ctx context.Context) (*QueuedInvocation, error) {
+	nodes, err := qiq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{queuedinvocation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (qiq *QueuedInvocationQuery) FirstX(ctx context.Context) *QueuedInvocation {
+	node, err := qiq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QueuedInvocation ID from the query.
+// Returns a *NotFoundError when no QueuedInvocation ID was found.
+func (qiq *QueuedInvocationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qiq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{queuedinvocation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (qiq *QueuedInvocationQuery) FirstIDX(ctx context.Context) int {
+	id, err := qiq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QueuedInvocation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one QueuedInvocation entity is not found.
+// Returns a *NotFoundError when no QueuedInvocation entities are found.
+func (qiq *QueuedInvocationQuery) Only(ctx context.Context) (*QueuedInvocation, error) {
+	nodes, err := qiq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{queuedinvocation.Label}
+	default:
+		return nil, &NotSingularError{queuedinvocation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (qiq *QueuedInvocationQuery) OnlyX(ctx context.Context) *QueuedInvocation {
+	node, err := qiq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QueuedInvocation ID in the query.
+// Returns a *NotSingularError when exactly one QueuedInvocation ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (qiq *QueuedInvocationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qiq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{queuedinvocation.Label}
+	default:
+		err = &NotSingularError{queuedinvocation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (qiq *QueuedInvocationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := qiq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QueuedInvocations.
+func (qiq *QueuedInvocationQuery) All(ctx context.Context) ([]*QueuedInvocation, error) {
+	if err := qiq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return qiq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (qiq *QueuedInvocationQuery) AllX(ctx context.Context) []*QueuedInvocation {
+	nodes, err := qiq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QueuedInvocation IDs.
+func (qiq *QueuedInvocationQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := qiq.Select(queuedinvocation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (qiq *QueuedInvocationQuery) IDsX(ctx context.Context) []int {
+	ids, err := qiq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (qiq *QueuedInvocationQuery) Count(ctx context.Context) (int, error) {
+	if err := qiq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return qiq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (qiq *QueuedInvocationQuery) CountX(ctx context.Context) int {
+	count, err := qiq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (qiq *QueuedInvocationQuery) Exist(ctx context.Context) (bool, error) {
+	if err := qiq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return qiq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (qiq *QueuedInvocationQuery) ExistX(ctx context.Context) bool {
+	exist, err := qiq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QueuedInvocationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (qiq *QueuedInvocationQuery) Clone() *QueuedInvocationQuery {
+	if qiq == nil {
+		return nil
+	}
+	return &QueuedInvocationQuery{
+		config:     qiq.config,
+		limit:      qiq.limit,
+		offset:     qiq.offset,
+		order:      append([]OrderFunc{}, qiq.order...),
+		predicates: append([]predicate.QueuedInvocation{}, qiq.predicates...),
+		// clone intermediate query.
+		sql:  qiq.sql.Clone(),
+		path: qiq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		InstanceID string `json:"instance_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QueuedInvocation.Query().
+//		GroupBy(queuedinvocation.FieldInstanceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (qiq *QueuedInvocationQuery) GroupBy(field string, fields ...string) *QueuedInvocationGroupBy {
+	group := &QueuedInvocationGroupBy{config: qiq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := qiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return qiq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		InstanceID string `json:"instance_id,omitempty"`
+//	}
+//
+//	client.QueuedInvocation.Query().
+//		Select(queuedinvocation.FieldInstanceID).
+//		Scan(ctx, &v)
+func (qiq *QueuedInvocationQuery) Select(field string, fields ...string) *QueuedInvocationSelect {
+	qiq.fields = append([]string{field}, fields...)
+	return &QueuedInvocationSelect{QueuedInvocationQuery: qiq}
+}
+
+func (qiq *QueuedInvocationQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range qiq.fields {
+		if !queuedinvocation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if qiq.path != nil {
+		prev, err := qiq.path(ctx)
+		if err != nil {
+			return err
+		}
+		qiq.sql = prev
+	}
+	return nil
+}
+
+func (qiq *QueuedInvocationQuery) sqlAll(ctx context.Context) ([]*QueuedInvocation, error) {
+	var (
+		nodes = []*QueuedInvocation{}
+		_spec = qiq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &QueuedInvocation{config: qiq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, qiq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (qiq *QueuedInvocationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := qiq.querySpec()
+	return sqlgraph.CountNodes(ctx, qiq.driver, _spec)
+}
+
+func (qiq *QueuedInvocationQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := qiq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (qiq *QueuedInvocationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   queuedinvocation.Table,
+			Columns: queuedinvocation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: queuedinvocation.FieldID,
+			},
+		},
+		From:   qiq.sql,
+		Unique: true,
+	}
+	if unique := qiq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := qiq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, queuedinvocation.FieldID)
+		for i := range fields {
+			if fields[i] != queuedinvocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := qiq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := qiq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := qiq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := qiq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (qiq *QueuedInvocationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(qiq.driver.Dialect())
+	t1 := builder.Table(queuedinvocation.Table)
+	selector := builder.Select(t1.Columns(queuedinvocation.Columns...)...).From(t1)
+	if qiq.sql != nil {
+		selector = qiq.sql
+		selector.Select(selector.Columns(queuedinvocation.Columns...)...)
+	}
+	for _, p := range qiq.predicates {
+		p(selector)
+	}
+	for _, p := range qiq.order {
+		p(selector)
+	}
+	if offset := qiq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := qiq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// QueuedInvocationGroupBy is the group-by builder for QueuedInvocation entities.
+type QueuedInvocationGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (qigb *QueuedInvocationGroupBy) Aggregate(fns ...AggregateFunc) *QueuedInvocationGroupBy {
+	qigb.fns = append(qigb.fns, fns...)
+	return qigb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (qigb *QueuedInvocationGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := qigb.path(ctx)
+	if err != nil {
+		return err
+	}
+	qigb.sql = query
+	return qigb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (qigb *QueuedInvocationGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := qigb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (qigb *QueuedInvocationGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(qigb.fields) > 1 {
+		return nil, errors.New("ent: QueuedInvocationGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := qigb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (qigb *QueuedInvocationGroupBy) StringsX(ctx context.Context) []string {
+	v, err := qigb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (qigb *QueuedInvocationGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = qigb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedInvocationGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (qigb *QueuedInvocationGroupBy) StringX(ctx context.Context) string {
+	v, err := qigb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (qigb *QueuedInvocationGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(qigb.fields) > 1 {
+		return nil, errors.New("ent: QueuedInvocationGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := qigb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (qigb *QueuedInvocationGroupBy) IntsX(ctx context.Context) []int {
+	v, err := qigb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (qigb *QueuedInvocationGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = qigb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedInvocationGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (qigb *QueuedInvocationGroupBy) IntX(ctx context.Context) int {
+	v, err := qigb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (qigb *QueuedInvocationGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(qigb.fields) > 1 {
+		return nil, errors.New("ent: QueuedInvocationGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := qigb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (qigb *QueuedInvocationGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := qigb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (qigb *QueuedInvocationGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = qigb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedInvocationGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (qigb *QueuedInvocationGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := qigb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (qigb *QueuedInvocationGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(qigb.fields) > 1 {
+		return nil, errors.New("ent: QueuedInvocationGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := qigb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (qigb *QueuedInvocationGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := qigb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (qigb *QueuedInvocationGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = qigb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedInvocationGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (qigb *QueuedInvocationGroupBy) BoolX(ctx context.Context) bool {
+	v, err := qigb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (qigb *QueuedInvocationGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range qigb.fields {
+		if !queuedinvocation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := qigb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qigb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (qigb *QueuedInvocationGroupBy) sqlQuery() *sql.Selector {
+	selector := qigb.sql
+	columns := make([]string, 0, len(qigb.fields)+len(qigb.fns))
+	columns = append(columns, qigb.fields...)
+	for _, fn := range qigb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(qigb.fields...)
+}
+
+// QueuedInvocationSelect is the builder for selecting fields of QueuedInvocation entities.
+type QueuedInvocationSelect struct {
+	*QueuedInvocationQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qis *QueuedInvocationSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := qis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qis.sql = qis.QueuedInvocationQuery.sqlQuery(ctx)
+	return qis.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (qis *QueuedInvocationSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := qis.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (qis *QueuedInvocationSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(qis.fields) > 1 {
+		return nil, errors.New("ent: QueuedInvocationSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := qis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (qis *QueuedInvocationSelect) StringsX(ctx context.Context) []string {
+	v, err := qis.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (qis *QueuedInvocationSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = qis.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedInvocationSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (qis *QueuedInvocationSelect) StringX(ctx context.Context) string {
+	v, err := qis.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (qis *QueuedInvocationSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(qis.fields) > 1 {
+		return nil, errors.New("ent: QueuedInvocationSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := qis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (qis *QueuedInvocationSelect) IntsX(ctx context.Context) []int {
+	v, err := qis.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (qis *QueuedInvocationSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = qis.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedInvocationSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (qis *QueuedInvocationSelect) IntX(ctx context.Context) int {
+	v, err := qis.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (qis *QueuedInvocationSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(qis.fields) > 1 {
+		return nil, errors.New("ent: QueuedInvocationSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := qis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (qis *QueuedInvocationSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := qis.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (qis *QueuedInvocationSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = qis.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedInvocationSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (qis *QueuedInvocationSelect) Float64X(ctx context.Context) float64 {
+	v, err := qis.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (qis *QueuedInvocationSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(qis.fields) > 1 {
+		return nil, errors.New("ent: QueuedInvocationSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := qis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (qis *QueuedInvocationSelect) BoolsX(ctx context.Context) []bool {
+	v, err := qis.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (qis *QueuedInvocationSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = qis.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedInvocationSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (qis *QueuedInvocationSelect) BoolX(ctx context.Context) bool {
+	v, err := qis.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (qis *QueuedInvocationSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := qis.sqlQuery().Query()
+	if err := qis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (qis *QueuedInvocationSelect) sqlQuery() sql.Querier {
+	selector := qis.sql
+	selector.Select(selector.Columns(qis.fields...)...)
+	return selector
+}
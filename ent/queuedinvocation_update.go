@@ -0,0 +1,364 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/queuedinvocation"
+)
+
+// QueuedInvocationUpdate is the builder for updating QueuedInvocation entities.
+type QueuedInvocationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QueuedInvocationMutation
+}
+
+// Where adds a new predicate for the QueuedInvocationUpdate builder.
+func (qiu *QueuedInvocationUpdate) Where(ps ...predicate.QueuedInvocation) *QueuedInvocationUpdate {
+	qiu.mutation.predicates = append(qiu.mutation.predicates, ps...)
+	return qiu
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (qiu *QueuedInvocationUpdate) SetInstanceID(s string) *QueuedInvocationUpdate {
+	qiu.mutation.SetInstanceID(s)
+	return qiu
+}
+
+// SetWorkflow sets the "workflow" field.
+func (qiu *QueuedInvocationUpdate) SetWorkflow(u uuid.UUID) *QueuedInvocationUpdate {
+	qiu.mutation.SetWorkflow(u)
+	return qiu
+}
+
+// SetSource sets the "source" field.
+func (qiu *QueuedInvocationUpdate) SetSource(s string) *QueuedInvocationUpdate {
+	qiu.mutation.SetSource(s)
+	return qiu
+}
+
+// SetParams sets the "params" field.
+func (qiu *QueuedInvocationUpdate) SetParams(b []byte) *QueuedInvocationUpdate {
+	qiu.mutation.SetParams(b)
+	return qiu
+}
+
+// SetExpires sets the "expires" field.
+func (qiu *QueuedInvocationUpdate) SetExpires(t time.Time) *QueuedInvocationUpdate {
+	qiu.mutation.SetExpires(t)
+	return qiu
+}
+
+// Mutation returns the QueuedInvocationMutation object of the builder.
+func (qiu *QueuedInvocationUpdate) Mutation() *QueuedInvocationMutation {
+	return qiu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qiu *QueuedInvocationUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(qiu.hooks) == 0 {
+		affected, err = qiu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*QueuedInvocationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			qiu.mutation = mutation
+			affected, err = qiu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(qiu.hooks) - 1; i >= 0; i-- {
+			mut = qiu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, qiu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qiu *QueuedInvocationUpdate) SaveX(ctx context.Context) int {
+	affected, err := qiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qiu *QueuedInvocationUpdate) Exec(ctx context.Context) error {
+	_, err := qiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qiu *QueuedInvocationUpdate) ExecX(ctx context.Context) {
+	if err := qiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (qiu *QueuedInvocationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   queuedinvocation.Table,
+			Columns: queuedinvocation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: queuedinvocation.FieldID,
+			},
+		},
+	}
+	if ps := qiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qiu.mutation.InstanceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: queuedinvocation.FieldInstanceID,
+		})
+	}
+	if value, ok := qiu.mutation.Workflow(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: queuedinvocation.FieldWorkflow,
+		})
+	}
+	if value, ok := qiu.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: queuedinvocation.FieldSource,
+		})
+	}
+	if value, ok := qiu.mutation.Params(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: queuedinvocation.FieldParams,
+		})
+	}
+	if value, ok := qiu.mutation.Expires(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: queuedinvocation.FieldExpires,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{queuedinvocation.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// QueuedInvocationUpdateOne is the builder for updating a single QueuedInvocation entity.
+type QueuedInvocationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QueuedInvocationMutation
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (qiuo *QueuedInvocationUpdateOne) SetInstanceID(s string) *QueuedInvocationUpdateOne {
+	qiuo.mutation.SetInstanceID(s)
+	return qiuo
+}
+
+// SetWorkflow sets the "workflow" field.
+func (qiuo *QueuedInvocationUpdateOne) SetWorkflow(u uuid.UUID) *QueuedInvocationUpdateOne {
+	qiuo.mutation.SetWorkflow(u)
+	return qiuo
+}
+
+// SetSource sets the "source" field.
+func (qiuo *QueuedInvocationUpdateOne) SetSource(s string) *QueuedInvocationUpdateOne {
+	qiuo.mutation.SetSource(s)
+	return qiuo
+}
+
+// SetParams sets the "params" field.
+func (qiuo *QueuedInvocationUpdateOne) SetParams(b []byte) *QueuedInvocationUpdateOne {
+	qiuo.mutation.SetParams(b)
+	return qiuo
+}
+
+// SetExpires sets the "expires" field.
+func (qiuo *QueuedInvocationUpdateOne) SetExpires(t time.Time) *QueuedInvocationUpdateOne {
+	qiuo.mutation.SetExpires(t)
+	return qiuo
+}
+
+// Mutation returns the QueuedInvocationMutation object of the builder.
+func (qiuo *QueuedInvocationUpdateOne) Mutation() *QueuedInvocationMutation {
+	return qiuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (qiuo *QueuedInvocationUpdateOne) Select(field string, fields ...string) *QueuedInvocationUpdateOne {
+	qiuo.fields = append([]string{field}, fields...)
+	return qiuo
+}
+
+// Save executes the query and returns the updated QueuedInvocation entity.
+func (qiuo *QueuedInvocationUpdateOne) Save(ctx context.Context) (*QueuedInvocation, error) {
+	var (
+		err  error
+		node *QueuedInvocation
+	)
+	if len(qiuo.hooks) == 0 {
+		node, err = qiuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*QueuedInvocationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			qiuo.mutation = mutation
+			node, err = qiuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(qiuo.hooks) - 1; i >= 0; i-- {
+			mut = qiuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, qiuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qiuo *QueuedInvocationUpdateOne) SaveX(ctx context.Context) *QueuedInvocation {
+	node, err := qiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (qiuo *QueuedInvocationUpdateOne) Exec(ctx context.Context) error {
+	_, err := qiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qiuo *QueuedInvocationUpdateOne) ExecX(ctx context.Context) {
+	if err := qiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (qiuo *QueuedInvocationUpdateOne) sqlSave(ctx context.Context) (_node *QueuedInvocation, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   queuedinvocation.Table,
+			Columns: queuedinvocation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: queuedinvocation.FieldID,
+			},
+		},
+	}
+	id, ok := qiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing QueuedInvocation.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := qiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, queuedinvocation.FieldID)
+		for _, f := range fields {
+			if !queuedinvocation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != queuedinvocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := qiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qiuo.mutation.InstanceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: queuedinvocation.FieldInstanceID,
+		})
+	}
+	if value, ok := qiuo.mutation.Workflow(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: queuedinvocation.FieldWorkflow,
+		})
+	}
+	if value, ok := qiuo.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: queuedinvocation.FieldSource,
+		})
+	}
+	if value, ok := qiuo.mutation.Params(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: queuedinvocation.FieldParams,
+		})
+	}
+	if value, ok := qiuo.mutation.Expires(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: queuedinvocation.FieldExpires,
+		})
+	}
+	_node = &QueuedInvocation{config: qiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, qiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{queuedinvocation.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by entc, DO NOT EDIT.
+
+package queuedstate
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the queuedstate type in the database.
+	Label = "queued_state"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldHostname holds the string denoting the hostname field in the database.
+	FieldHostname = "hostname"
+	// FieldInstanceID holds the string denoting the instance_id field in the database.
+	FieldInstanceID = "instance_id"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldCreated holds the string denoting the created field in the database.
+	FieldCreated = "created"
+	// Table holds the table name of the queuedstate in the database.
+	Table = "queued_states"
+)
+
+// Columns holds all SQL columns for queuedstate fields.
+var Columns = []string{
+	FieldID,
+	FieldHostname,
+	FieldInstanceID,
+	FieldMessage,
+	FieldSize,
+	FieldCreated,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreated holds the default value on creation for the "created" field.
+	DefaultCreated func() time.Time
+)
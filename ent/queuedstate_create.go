@@ -0,0 +1,271 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/queuedstate"
+)
+
+// QueuedStateCreate is the builder for creating a QueuedState entity.
+type QueuedStateCreate struct {
+	config
+	mutation *QueuedStateMutation
+	hooks    []Hook
+}
+
+// SetHostname sets the "hostname" field.
+func (qsc *QueuedStateCreate) SetHostname(s string) *QueuedStateCreate {
+	qsc.mutation.SetHostname(s)
+	return qsc
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (qsc *QueuedStateCreate) SetInstanceID(s string) *QueuedStateCreate {
+	qsc.mutation.SetInstanceID(s)
+	return qsc
+}
+
+// SetMessage sets the "message" field.
+func (qsc *QueuedStateCreate) SetMessage(b []byte) *QueuedStateCreate {
+	qsc.mutation.SetMessage(b)
+	return qsc
+}
+
+// SetSize sets the "size" field.
+func (qsc *QueuedStateCreate) SetSize(i int) *QueuedStateCreate {
+	qsc.mutation.SetSize(i)
+	return qsc
+}
+
+// SetCreated sets the "created" field.
+func (qsc *QueuedStateCreate) SetCreated(t time.Time) *QueuedStateCreate {
+	qsc.mutation.SetCreated(t)
+	return qsc
+}
+
+// SetNillableCreated sets the "created" field if the given value is not nil.
+func (qsc *QueuedStateCreate) SetNillableCreated(t *time.Time) *QueuedStateCreate {
+	if t != nil {
+		qsc.SetCreated(*t)
+	}
+	return qsc
+}
+
+// Mutation returns the QueuedStateMutation object of the builder.
+func (qsc *QueuedStateCreate) Mutation() *QueuedStateMutation {
+	return qsc.mutation
+}
+
+// Save creates the QueuedState in the database.
+func (qsc *QueuedStateCreate) Save(ctx context.Context) (*QueuedState, error) {
+	var (
+		err  error
+		node *QueuedState
+	)
+	qsc.defaults()
+	if len(qsc.hooks) == 0 {
+		if err = qsc.check(); err != nil {
+			return nil, err
+		}
+		node, err = qsc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*QueuedStateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = qsc.check(); err != nil {
+				return nil, err
+			}
+			qsc.mutation = mutation
+			node, err = qsc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(qsc.hooks) - 1; i >= 0; i-- {
+			mut = qsc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, qsc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qsc *QueuedStateCreate) SaveX(ctx context.Context) *QueuedState {
+	v, err := qsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (qsc *QueuedStateCreate) defaults() {
+	if _, ok := qsc.mutation.Created(); !ok {
+		v := queuedstate.DefaultCreated()
+		qsc.mutation.SetCreated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qsc *QueuedStateCreate) check() error {
+	if _, ok := qsc.mutation.Hostname(); !ok {
+		return &ValidationError{Name: "hostname", err: errors.New("ent: missing required field \"hostname\"")}
+	}
+	if _, ok := qsc.mutation.InstanceID(); !ok {
+		return &ValidationError{Name: "instance_id", err: errors.New("ent: missing required field \"instance_id\"")}
+	}
+	if _, ok := qsc.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New("ent: missing required field \"message\"")}
+	}
+	if _, ok := qsc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New("ent: missing required field \"size\"")}
+	}
+	if _, ok := qsc.mutation.Created(); !ok {
+		return &ValidationError{Name: "created", err: errors.New("ent: missing required field \"created\"")}
+	}
+	return nil
+}
+
+func (qsc *QueuedStateCreate) sqlSave(ctx context.Context) (*QueuedState, error) {
+	_node, _spec := qsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qsc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (qsc *QueuedStateCreate) createSpec() (*QueuedState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QueuedState{config: qsc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: queuedstate.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: queuedstate.FieldID,
+			},
+		}
+	)
+	if value, ok := qsc.mutation.Hostname(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: queuedstate.FieldHostname,
+		})
+		_node.Hostname = value
+	}
+	if value, ok := qsc.mutation.InstanceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: queuedstate.FieldInstanceID,
+		})
+		_node.InstanceID = value
+	}
+	if value, ok := qsc.mutation.Message(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: queuedstate.FieldMessage,
+		})
+		_node.Message = value
+	}
+	if value, ok := qsc.mutation.Size(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: queuedstate.FieldSize,
+		})
+		_node.Size = value
+	}
+	if value, ok := qsc.mutation.Created(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: queuedstate.FieldCreated,
+		})
+		_node.Created = value
+	}
+	return _node, _spec
+}
+
+// QueuedStateCreateBulk is the builder for creating many QueuedState entities in bulk.
+type QueuedStateCreateBulk struct {
+	config
+	builders []*QueuedStateCreate
+}
+
+// Save creates the QueuedState entities in the database.
+func (qscb *QueuedStateCreateBulk) Save(ctx context.Context) ([]*QueuedState, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(qscb.builders))
+	nodes := make([]*QueuedState, len(qscb.builders))
+	mutators := make([]Mutator, len(qscb.builders))
+	for i := range qscb.builders {
+		func(i int, root context.Context) {
+			builder := qscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QueuedStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qscb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qscb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qscb *QueuedStateCreateBulk) SaveX(ctx context.Context) []*QueuedState {
+	v, err := qscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,904 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/queuedstate"
+)
+
+// QueuedStateQuery is the builder for querying QueuedState entities.
+type QueuedStateQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.QueuedState
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QueuedStateQuery builder.
+func (qsq *QueuedStateQuery) Where(ps ...predicate.QueuedState) *QueuedStateQuery {
+	qsq.predicates = append(qsq.predicates, ps...)
+	return qsq
+}
+
+// Limit adds a limit step to the query.
+func (qsq *QueuedStateQuery) Limit(limit int) *QueuedStateQuery {
+	qsq.limit = &limit
+	return qsq
+}
+
+// Offset adds an offset step to the query.
+func (qsq *QueuedStateQuery) Offset(offset int) *QueuedStateQuery {
+	qsq.offset = &offset
+	return qsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (qsq *QueuedStateQuery) Unique(unique bool) *QueuedStateQuery {
+	qsq.unique = &unique
+	return qsq
+}
+
+// Order adds an order step to the query.
+func (qsq *QueuedStateQuery) Order(o ...OrderFunc) *QueuedStateQuery {
+	qsq.order = append(qsq.order, o...)
+	return qsq
+}
+
+// First returns the first QueuedState entity from the query.
+// Returns a *NotFoundError when no QueuedState was found.
+func (qsq *QueuedStateQuery) First(ctx context.Context) (*QueuedState, error) {
+	nodes, err := qsq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{queuedstate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (qsq *QueuedStateQuery) FirstX(ctx context.Context) *QueuedState {
+	node, err := qsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QueuedState ID from the query.
+// Returns a *NotFoundError when no QueuedState ID was found.
+func (qsq *QueuedStateQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qsq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{queuedstate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (qsq *QueuedStateQuery) FirstIDX(ctx context.Context) int {
+	id, err := qsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QueuedState entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one QueuedState entity is not found.
+// Returns a *NotFoundError when no QueuedState entities are found.
+func (qsq *QueuedStateQuery) Only(ctx context.Context) (*QueuedState, error) {
+	nodes, err := qsq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{queuedstate.Label}
+	default:
+		return nil, &NotSingularError{queuedstate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (qsq *QueuedStateQuery) OnlyX(ctx context.Context) *QueuedState {
+	node, err := qsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QueuedState ID in the query.
+// Returns a *NotSingularError when exactly one QueuedState ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (qsq *QueuedStateQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qsq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{queuedstate.Label}
+	default:
+		err = &NotSingularError{queuedstate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (qsq *QueuedStateQuery) OnlyIDX(ctx context.Context) int {
+	id, err := qsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QueuedStates.
+func (qsq *QueuedStateQuery) All(ctx context.Context) ([]*QueuedState, error) {
+	if err := qsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return qsq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (qsq *QueuedStateQuery) AllX(ctx context.Context) []*QueuedState {
+	nodes, err := qsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QueuedState IDs.
+func (qsq *QueuedStateQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := qsq.Select(queuedstate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (qsq *QueuedStateQuery) IDsX(ctx context.Context) []int {
+	ids, err := qsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (qsq *QueuedStateQuery) Count(ctx context.Context) (int, error) {
+	if err := qsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return qsq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (qsq *QueuedStateQuery) CountX(ctx context.Context) int {
+	count, err := qsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (qsq *QueuedStateQuery) Exist(ctx context.Context) (bool, error) {
+	if err := qsq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return qsq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (qsq *QueuedStateQuery) ExistX(ctx context.Context) bool {
+	exist, err := qsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QueuedStateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (qsq *QueuedStateQuery) Clone() *QueuedStateQuery {
+	if qsq == nil {
+		return nil
+	}
+	return &QueuedStateQuery{
+		config:     qsq.config,
+		limit:      qsq.limit,
+		offset:     qsq.offset,
+		order:      append([]OrderFunc{}, qsq.order...),
+		predicates: append([]predicate.QueuedState{}, qsq.predicates...),
+		// clone intermediate query.
+		sql:  qsq.sql.Clone(),
+		path: qsq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Hostname string `json:"hostname,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QueuedState.Query().
+//		GroupBy(queuedstate.FieldHostname).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (qsq *QueuedStateQuery) GroupBy(field string, fields ...string) *QueuedStateGroupBy {
+	group := &QueuedStateGroupBy{config: qsq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := qsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return qsq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Hostname string `json:"hostname,omitempty"`
+//	}
+//
+//	client.QueuedState.Query().
+//		Select(queuedstate.FieldHostname).
+//		Scan(ctx, &v)
+func (qsq *QueuedStateQuery) Select(field string, fields ...string) *QueuedStateSelect {
+	qsq.fields = append([]string{field}, fields...)
+	return &QueuedStateSelect{QueuedStateQuery: qsq}
+}
+
+func (qsq *QueuedStateQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range qsq.fields {
+		if !queuedstate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if qsq.path != nil {
+		prev, err := qsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		qsq.sql = prev
+	}
+	return nil
+}
+
+func (qsq *QueuedStateQuery) sqlAll(ctx context.Context) ([]*QueuedState, error) {
+	var (
+		nodes = []*QueuedState{}
+		_spec = qsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &QueuedState{config: qsq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, qsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (qsq *QueuedStateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := qsq.querySpec()
+	return sqlgraph.CountNodes(ctx, qsq.driver, _spec)
+}
+
+func (qsq *QueuedStateQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := qsq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (qsq *QueuedStateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   queuedstate.Table,
+			Columns: queuedstate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: queuedstate.FieldID,
+			},
+		},
+		From:   qsq.sql,
+		Unique: true,
+	}
+	if unique := qsq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := qsq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, queuedstate.FieldID)
+		for i := range fields {
+			if fields[i] != queuedstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := qsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := qsq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := qsq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := qsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (qsq *QueuedStateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(qsq.driver.Dialect())
+	t1 := builder.Table(queuedstate.Table)
+	selector := builder.Select(t1.Columns(queuedstate.Columns...)...).From(t1)
+	if qsq.sql != nil {
+		selector = qsq.sql
+		selector.Select(selector.Columns(queuedstate.Columns...)...)
+	}
+	for _, p := range qsq.predicates {
+		p(selector)
+	}
+	for _, p := range qsq.order {
+		p(selector)
+	}
+	if offset := qsq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := qsq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// QueuedStateGroupBy is the group-by builder for QueuedState entities.
+type QueuedStateGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (qsgb *QueuedStateGroupBy) Aggregate(fns ...AggregateFunc) *QueuedStateGroupBy {
+	qsgb.fns = append(qsgb.fns, fns...)
+	return qsgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (qsgb *QueuedStateGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := qsgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	qsgb.sql = query
+	return qsgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (qsgb *QueuedStateGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := qsgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (qsgb *QueuedStateGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(qsgb.fields) > 1 {
+		return nil, errors.New("ent: QueuedStateGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := qsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (qsgb *QueuedStateGroupBy) StringsX(ctx context.Context) []string {
+	v, err := qsgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (qsgb *QueuedStateGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = qsgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedstate.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedStateGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (qsgb *QueuedStateGroupBy) StringX(ctx context.Context) string {
+	v, err := qsgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (qsgb *QueuedStateGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(qsgb.fields) > 1 {
+		return nil, errors.New("ent: QueuedStateGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := qsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (qsgb *QueuedStateGroupBy) IntsX(ctx context.Context) []int {
+	v, err := qsgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (qsgb *QueuedStateGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = qsgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedstate.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedStateGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (qsgb *QueuedStateGroupBy) IntX(ctx context.Context) int {
+	v, err := qsgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (qsgb *QueuedStateGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(qsgb.fields) > 1 {
+		return nil, errors.New("ent: QueuedStateGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := qsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (qsgb *QueuedStateGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := qsgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (qsgb *QueuedStateGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = qsgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedstate.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedStateGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (qsgb *QueuedStateGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := qsgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (qsgb *QueuedStateGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(qsgb.fields) > 1 {
+		return nil, errors.New("ent: QueuedStateGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := qsgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (qsgb *QueuedStateGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := qsgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (qsgb *QueuedStateGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = qsgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedstate.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedStateGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (qsgb *QueuedStateGroupBy) BoolX(ctx context.Context) bool {
+	v, err := qsgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (qsgb *QueuedStateGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range qsgb.fields {
+		if !queuedstate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := qsgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qsgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (qsgb *QueuedStateGroupBy) sqlQuery() *sql.Selector {
+	selector := qsgb.sql
+	columns := make([]string, 0, len(qsgb.fields)+len(qsgb.fns))
+	columns = append(columns, qsgb.fields...)
+	for _, fn := range qsgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(qsgb.fields...)
+}
+
+// QueuedStateSelect is the builder for selecting fields of QueuedState entities.
+type QueuedStateSelect struct {
+	*QueuedStateQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qss *QueuedStateSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := qss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qss.sql = qss.QueuedStateQuery.sqlQuery(ctx)
+	return qss.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (qss *QueuedStateSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := qss.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (qss *QueuedStateSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(qss.fields) > 1 {
+		return nil, errors.New("ent: QueuedStateSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := qss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (qss *QueuedStateSelect) StringsX(ctx context.Context) []string {
+	v, err := qss.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (qss *QueuedStateSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = qss.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedstate.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedStateSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (qss *QueuedStateSelect) StringX(ctx context.Context) string {
+	v, err := qss.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (qss *QueuedStateSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(qss.fields) > 1 {
+		return nil, errors.New("ent: QueuedStateSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := qss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (qss *QueuedStateSelect) IntsX(ctx context.Context) []int {
+	v, err := qss.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (qss *QueuedStateSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = qss.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedstate.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedStateSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (qss *QueuedStateSelect) IntX(ctx context.Context) int {
+	v, err := qss.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (qss *QueuedStateSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(qss.fields) > 1 {
+		return nil, errors.New("ent: QueuedStateSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := qss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (qss *QueuedStateSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := qss.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (qss *QueuedStateSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = qss.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedstate.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedStateSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (qss *QueuedStateSelect) Float64X(ctx context.Context) float64 {
+	v, err := qss.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (qss *QueuedStateSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(qss.fields) > 1 {
+		return nil, errors.New("ent: QueuedStateSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := qss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (qss *QueuedStateSelect) BoolsX(ctx context.Context) []bool {
+	v, err := qss.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (qss *QueuedStateSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = qss.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{queuedstate.Label}
+	default:
+		err = fmt.Errorf("ent: QueuedStateSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (qss *QueuedStateSelect) BoolX(ctx context.Context) bool {
+	v, err := qss.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (qss *QueuedStateSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := qss.sqlQuery().Query()
+	if err := qss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (qss *QueuedStateSelect) sqlQuery() sql.Querier {
+	selector := qss.sql
+	selector.Select(selector.Columns(qss.fields...)...)
+	return selector
+}
@@ -0,0 +1,364 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/queuedstate"
+)
+
+// QueuedStateUpdate is the builder for updating QueuedState entities.
+type QueuedStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QueuedStateMutation
+}
+
+// Where adds a new predicate for the QueuedStateUpdate builder.
+func (qsu *QueuedStateUpdate) Where(ps ...predicate.QueuedState) *QueuedStateUpdate {
+	qsu.mutation.predicates = append(qsu.mutation.predicates, ps...)
+	return qsu
+}
+
+// SetHostname sets the "hostname" field.
+func (qsu *QueuedStateUpdate) SetHostname(s string) *QueuedStateUpdate {
+	qsu.mutation.SetHostname(s)
+	return qsu
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (qsu *QueuedStateUpdate) SetInstanceID(s string) *QueuedStateUpdate {
+	qsu.mutation.SetInstanceID(s)
+	return qsu
+}
+
+// SetMessage sets the "message" field.
+func (qsu *QueuedStateUpdate) SetMessage(b []byte) *QueuedStateUpdate {
+	qsu.mutation.SetMessage(b)
+	return qsu
+}
+
+// SetSize sets the "size" field.
+func (qsu *QueuedStateUpdate) SetSize(i int) *QueuedStateUpdate {
+	qsu.mutation.ResetSize()
+	qsu.mutation.SetSize(i)
+	return qsu
+}
+
+// AddSize adds i to the "size" field.
+func (qsu *QueuedStateUpdate) AddSize(i int) *QueuedStateUpdate {
+	qsu.mutation.AddSize(i)
+	return qsu
+}
+
+// Mutation returns the QueuedStateMutation object of the builder.
+func (qsu *QueuedStateUpdate) Mutation() *QueuedStateMutation {
+	return qsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qsu *QueuedStateUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(qsu.hooks) == 0 {
+		affected, err = qsu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*QueuedStateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			qsu.mutation = mutation
+			affected, err = qsu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(qsu.hooks) - 1; i >= 0; i-- {
+			mut = qsu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, qsu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qsu *QueuedStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := qsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qsu *QueuedStateUpdate) Exec(ctx context.Context) error {
+	_, err := qsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qsu *QueuedStateUpdate) ExecX(ctx context.Context) {
+	if err := qsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (qsu *QueuedStateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   queuedstate.Table,
+			Columns: queuedstate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: queuedstate.FieldID,
+			},
+		},
+	}
+	if ps := qsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qsu.mutation.Hostname(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: queuedstate.FieldHostname,
+		})
+	}
+	if value, ok := qsu.mutation.InstanceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: queuedstate.FieldInstanceID,
+		})
+	}
+	if value, ok := qsu.mutation.Message(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: queuedstate.FieldMessage,
+		})
+	}
+	if value, ok := qsu.mutation.Size(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: queuedstate.FieldSize,
+		})
+	}
+	if value, ok := qsu.mutation.AddedSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: queuedstate.FieldSize,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{queuedstate.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// QueuedStateUpdateOne is the builder for updating a single QueuedState entity.
+type QueuedStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QueuedStateMutation
+}
+
+// SetHostname sets the "hostname" field.
+func (qsuo *QueuedStateUpdateOne) SetHostname(s string) *QueuedStateUpdateOne {
+	qsuo.mutation.SetHostname(s)
+	return qsuo
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (qsuo *QueuedStateUpdateOne) SetInstanceID(s string) *QueuedStateUpdateOne {
+	qsuo.mutation.SetInstanceID(s)
+	return qsuo
+}
+
+// SetMessage sets the "message" field.
+func (qsuo *QueuedStateUpdateOne) SetMessage(b []byte) *QueuedStateUpdateOne {
+	qsuo.mutation.SetMessage(b)
+	return qsuo
+}
+
+// SetSize sets the "size" field.
+func (qsuo *QueuedStateUpdateOne) SetSize(i int) *QueuedStateUpdateOne {
+	qsuo.mutation.ResetSize()
+	qsuo.mutation.SetSize(i)
+	return qsuo
+}
+
+// AddSize adds i to the "size" field.
+func (qsuo *QueuedStateUpdateOne) AddSize(i int) *QueuedStateUpdateOne {
+	qsuo.mutation.AddSize(i)
+	return qsuo
+}
+
+// Mutation returns the QueuedStateMutation object of the builder.
+func (qsuo *QueuedStateUpdateOne) Mutation() *QueuedStateMutation {
+	return qsuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (qsuo *QueuedStateUpdateOne) Select(field string, fields ...string) *QueuedStateUpdateOne {
+	qsuo.fields = append([]string{field}, fields...)
+	return qsuo
+}
+
+// Save executes the query and returns the updated QueuedState entity.
+func (qsuo *QueuedStateUpdateOne) Save(ctx context.Context) (*QueuedState, error) {
+	var (
+		err  error
+		node *QueuedState
+	)
+	if len(qsuo.hooks) == 0 {
+		node, err = qsuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*QueuedStateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			qsuo.mutation = mutation
+			node, err = qsuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(qsuo.hooks) - 1; i >= 0; i-- {
+			mut = qsuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, qsuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qsuo *QueuedStateUpdateOne) SaveX(ctx context.Context) *QueuedState {
+	node, err := qsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (qsuo *QueuedStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := qsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qsuo *QueuedStateUpdateOne) ExecX(ctx context.Context) {
+	if err := qsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (qsuo *QueuedStateUpdateOne) sqlSave(ctx context.Context) (_node *QueuedState, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   queuedstate.Table,
+			Columns: queuedstate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: queuedstate.FieldID,
+			},
+		},
+	}
+	id, ok := qsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing QueuedState.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := qsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, queuedstate.FieldID)
+		for _, f := range fields {
+			if !queuedstate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != queuedstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := qsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qsuo.mutation.Hostname(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: queuedstate.FieldHostname,
+		})
+	}
+	if value, ok := qsuo.mutation.InstanceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: queuedstate.FieldInstanceID,
+		})
+	}
+	if value, ok := qsuo.mutation.Message(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: queuedstate.FieldMessage,
+		})
+	}
+	if value, ok := qsuo.mutation.Size(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: queuedstate.FieldSize,
+		})
+	}
+	if value, ok := qsuo.mutation.AddedSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: queuedstate.FieldSize,
+		})
+	}
+	_node = &QueuedState{config: qsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, qsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{queuedstate.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
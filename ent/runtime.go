@@ -6,8 +6,17 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent/eventcounter"
+	"github.com/vorteil/direktiv/ent/jqmodule"
+	"github.com/vorteil/direktiv/ent/jsonschema"
 	"github.com/vorteil/direktiv/ent/namespace"
+	"github.com/vorteil/direktiv/ent/node"
+	"github.com/vorteil/direktiv/ent/queuedinvocation"
+	"github.com/vorteil/direktiv/ent/queuedstate"
+	"github.com/vorteil/direktiv/ent/scheduledinvocation"
 	"github.com/vorteil/direktiv/ent/schema"
+	"github.com/vorteil/direktiv/ent/setting"
+	"github.com/vorteil/direktiv/ent/wasmmodule"
 	"github.com/vorteil/direktiv/ent/workflow"
 )
 
@@ -15,6 +24,38 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	eventcounterFields := schema.EventCounter{}.Fields()
+	_ = eventcounterFields
+	// eventcounterDescReceived is the schema descriptor for received field.
+	eventcounterDescReceived := eventcounterFields[2].Descriptor()
+	// eventcounter.DefaultReceived holds the default value on creation for the received field.
+	eventcounter.DefaultReceived = eventcounterDescReceived.Default.(int64)
+	// eventcounterDescMatched is the schema descriptor for matched field.
+	eventcounterDescMatched := eventcounterFields[3].Descriptor()
+	// eventcounter.DefaultMatched holds the default value on creation for the matched field.
+	eventcounter.DefaultMatched = eventcounterDescMatched.Default.(int64)
+	// eventcounterDescUnmatched is the schema descriptor for unmatched field.
+	eventcounterDescUnmatched := eventcounterFields[4].Descriptor()
+	// eventcounter.DefaultUnmatched holds the default value on creation for the unmatched field.
+	eventcounter.DefaultUnmatched = eventcounterDescUnmatched.Default.(int64)
+	// eventcounterDescRejected is the schema descriptor for rejected field.
+	eventcounterDescRejected := eventcounterFields[5].Descriptor()
+	// eventcounter.DefaultRejected holds the default value on creation for the rejected field.
+	eventcounter.DefaultRejected = eventcounterDescRejected.Default.(int64)
+	jqmoduleFields := schema.JQModule{}.Fields()
+	_ = jqmoduleFields
+	// jqmoduleDescUpdated is the schema descriptor for updated field.
+	jqmoduleDescUpdated := jqmoduleFields[3].Descriptor()
+	// jqmodule.DefaultUpdated holds the default value on creation for the updated field.
+	jqmodule.DefaultUpdated = jqmoduleDescUpdated.Default.(func() time.Time)
+	// jqmodule.UpdateDefaultUpdated holds the default value on update for the updated field.
+	jqmodule.UpdateDefaultUpdated = jqmoduleDescUpdated.UpdateDefault.(func() time.Time)
+	jsonschemaFields := schema.JSONSchema{}.Fields()
+	_ = jsonschemaFields
+	// jsonschemaDescCreated is the schema descriptor for created field.
+	jsonschemaDescCreated := jsonschemaFields[5].Descriptor()
+	// jsonschema.DefaultCreated holds the default value on creation for the created field.
+	jsonschema.DefaultCreated = jsonschemaDescCreated.Default.(func() time.Time)
 	namespaceFields := schema.Namespace{}.Fields()
 	_ = namespaceFields
 	// namespaceDescCreated is the schema descriptor for created field.
@@ -40,6 +81,70 @@ func init() {
 			return nil
 		}
 	}()
+	nodeFields := schema.Node{}.Fields()
+	_ = nodeFields
+	// nodeDescDraining is the schema descriptor for draining field.
+	nodeDescDraining := nodeFields[3].Descriptor()
+	// node.DefaultDraining holds the default value on creation for the draining field.
+	node.DefaultDraining = nodeDescDraining.Default.(bool)
+	// nodeDescTimers is the schema descriptor for timers field.
+	nodeDescTimers := nodeFields[4].Descriptor()
+	// node.DefaultTimers holds the default value on creation for the timers field.
+	node.DefaultTimers = nodeDescTimers.Default.(int)
+	// nodeDescQueued is the schema descriptor for queued field.
+	nodeDescQueued := nodeFields[5].Descriptor()
+	// node.DefaultQueued holds the default value on creation for the queued field.
+	node.DefaultQueued = nodeDescQueued.Default.(int)
+	// nodeDescPromoted is the schema descriptor for promoted field.
+	nodeDescPromoted := nodeFields[6].Descriptor()
+	// node.DefaultPromoted holds the default value on creation for the promoted field.
+	node.DefaultPromoted = nodeDescPromoted.Default.(bool)
+	queuedinvocationFields := schema.QueuedInvocation{}.Fields()
+	_ = queuedinvocationFields
+	// queuedinvocationDescCreated is the schema descriptor for created field.
+	queuedinvocationDescCreated := queuedinvocationFields[4].Descriptor()
+	// queuedinvocation.DefaultCreated holds the default value on creation for the created field.
+	queuedinvocation.DefaultCreated = queuedinvocationDescCreated.Default.(func() time.Time)
+	queuedstateFields := schema.QueuedState{}.Fields()
+	_ = queuedstateFields
+	// queuedstateDescCreated is the schema descriptor for created field.
+	queuedstateDescCreated := queuedstateFields[4].Descriptor()
+	// queuedstate.DefaultCreated holds the default value on creation for the created field.
+	queuedstate.DefaultCreated = queuedstateDescCreated.Default.(func() time.Time)
+	scheduledinvocationFields := schema.ScheduledInvocation{}.Fields()
+	_ = scheduledinvocationFields
+	// scheduledinvocationDescNotify is the schema descriptor for notify field.
+	scheduledinvocationDescNotify := scheduledinvocationFields[8].Descriptor()
+	// scheduledinvocation.DefaultNotify holds the default value on creation for the notify field.
+	scheduledinvocation.DefaultNotify = scheduledinvocationDescNotify.Default.(bool)
+	// scheduledinvocationDescCreated is the schema descriptor for created field.
+	scheduledinvocationDescCreated := scheduledinvocationFields[9].Descriptor()
+	// scheduledinvocation.DefaultCreated holds the default value on creation for the created field.
+	scheduledinvocation.DefaultCreated = scheduledinvocationDescCreated.Default.(func() time.Time)
+	// scheduledinvocationDescID is the schema descriptor for id field.
+	scheduledinvocationDescID := scheduledinvocationFields[0].Descriptor()
+	// scheduledinvocation.DefaultID holds the default value on creation for the id field.
+	scheduledinvocation.DefaultID = scheduledinvocationDescID.Default.(func() uuid.UUID)
+	settingFields := schema.Setting{}.Fields()
+	_ = settingFields
+	// settingDescKey is the schema descriptor for key field.
+	settingDescKey := settingFields[0].Descriptor()
+	// setting.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	setting.KeyValidator = settingDescKey.Validators[0].(func(string) error)
+	// settingDescUpdated is the schema descriptor for updated field.
+	settingDescUpdated := settingFields[2].Descriptor()
+	// setting.DefaultUpdated holds the default value on creation for the updated field.
+	setting.DefaultUpdated = settingDescUpdated.Default.(func() time.Time)
+	// setting.UpdateDefaultUpdated holds the default value on update for the updated field.
+	setting.UpdateDefaultUpdated = settingDescUpdated.UpdateDefault.(func() time.Time)
+	wasmmoduleFields := schema.WasmModule{}.Fields()
+	_ = wasmmoduleFields
+	// wasmmoduleDescUpdated is the schema descriptor for updated field.
+	wasmmoduleDescUpdated := wasmmoduleFields[4].Descriptor()
+	// wasmmodule.DefaultUpdated holds the default value on creation for the updated field.
+	wasmmodule.DefaultUpdated = wasmmoduleDescUpdated.Default.(func() time.Time)
+	// wasmmodule.UpdateDefaultUpdated holds the default value on update for the updated field.
+	wasmmodule.UpdateDefaultUpdated = wasmmoduleDescUpdated.UpdateDefault.(func() time.Time)
 	workflowFields := schema.Workflow{}.Fields()
 	_ = workflowFields
 	// workflowDescName is the schema descriptor for name field.
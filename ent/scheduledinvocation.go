@@ -0,0 +1,190 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent/scheduledinvocation"
+)
+
+// ScheduledInvocation is the model entity for the ScheduledInvocation schema.
+type ScheduledInvocation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Workflow holds the value of the "workflow" field.
+	Workflow string `json:"workflow,omitempty"`
+	// Time holds the value of the "time" field.
+	Time time.Time `json:"time,omitempty"`
+	// Input holds the value of the "input" field.
+	Input []byte `json:"input,omitempty"`
+	// State holds the value of the "state" field.
+	State string `json:"state,omitempty"`
+	// ExternalID holds the value of the "external_id" field.
+	ExternalID string `json:"external_id,omitempty"`
+	// Expires holds the value of the "expires" field.
+	Expires *time.Time `json:"expires,omitempty"`
+	// Notify holds the value of the "notify" field.
+	Notify bool `json:"notify,omitempty"`
+	// Created holds the value of the "created" field.
+	Created time.Time `json:"created,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduledInvocation) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scheduledinvocation.FieldInput:
+			values[i] = new([]byte)
+		case scheduledinvocation.FieldNotify:
+			values[i] = new(sql.NullBool)
+		case scheduledinvocation.FieldNamespace, scheduledinvocation.FieldWorkflow, scheduledinvocation.FieldState, scheduledinvocation.FieldExternalID:
+			values[i] = new(sql.NullString)
+		case scheduledinvocation.FieldTime, scheduledinvocation.FieldExpires, scheduledinvocation.FieldCreated:
+			values[i] = new(sql.NullTime)
+		case scheduledinvocation.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ScheduledInvocation", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduledInvocation fields.
+func (si *ScheduledInvocation) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scheduledinvocation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				si.ID = *value
+			}
+		case scheduledinvocation.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				si.Namespace = value.String
+			}
+		case scheduledinvocation.FieldWorkflow:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow", values[i])
+			} else if value.Valid {
+				si.Workflow = value.String
+			}
+		case scheduledinvocation.FieldTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field time", values[i])
+			} else if value.Valid {
+				si.Time = value.Time
+			}
+		case scheduledinvocation.FieldInput:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field input", values[i])
+			} else if value != nil {
+				si.Input = *value
+			}
+		case scheduledinvocation.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				si.State = value.String
+			}
+		case scheduledinvocation.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				si.ExternalID = value.String
+			}
+		case scheduledinvocation.FieldExpires:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires", values[i])
+			} else if value.Valid {
+				si.Expires = new(time.Time)
+				*si.Expires = value.Time
+			}
+		case scheduledinvocation.FieldNotify:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field notify", values[i])
+			} else if value.Valid {
+				si.Notify = value.Bool
+			}
+		case scheduledinvocation.FieldCreated:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created", values[i])
+			} else if value.Valid {
+				si.Created = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ScheduledInvocation.
+// Note that you need to call ScheduledInvocation.Unwrap() before calling this method if this ScheduledInvocation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (si *ScheduledInvocation) Update() *ScheduledInvocationUpdateOne {
+	return (&ScheduledInvocationClient{config: si.config}).UpdateOne(si)
+}
+
+// Unwrap unwraps the ScheduledInvocation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (si *ScheduledInvocation) Unwrap() *ScheduledInvocation {
+	tx, ok := si.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduledInvocation is not a transactional entity")
+	}
+	si.config.driver = tx.drv
+	return si
+}
+
+// String implements the fmt.Stringer.
+func (si *ScheduledInvocation) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduledInvocation(")
+	builder.WriteString(fmt.Sprintf("id=%v", si.ID))
+	builder.WriteString(", namespace=")
+	builder.WriteString(si.Namespace)
+	builder.WriteString(", workflow=")
+	builder.WriteString(si.Workflow)
+	builder.WriteString(", time=")
+	builder.WriteString(si.Time.Format(time.ANSIC))
+	builder.WriteString(", input=")
+	builder.WriteString(fmt.Sprintf("%v", si.Input))
+	builder.WriteString(", state=")
+	builder.WriteString(si.State)
+	builder.WriteString(", external_id=")
+	builder.WriteString(si.ExternalID)
+	if v := si.Expires; v != nil {
+		builder.WriteString(", expires=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", notify=")
+	builder.WriteString(fmt.Sprintf("%v", si.Notify))
+	builder.WriteString(", created=")
+	builder.WriteString(si.Created.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduledInvocations is a parsable slice of ScheduledInvocation.
+type ScheduledInvocations []*ScheduledInvocation
+
+func (si ScheduledInvocations) config(cfg config) {
+	for _i := range si {
+		si[_i].config = cfg
+	}
+}
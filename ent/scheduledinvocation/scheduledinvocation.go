@@ -0,0 +1,69 @@
+// Code generated by entc, DO NOT EDIT.
+
+package scheduledinvocation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the scheduledinvocation type in the database.
+	Label = "scheduled_invocation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldWorkflow holds the string denoting the workflow field in the database.
+	FieldWorkflow = "workflow"
+	// FieldTime holds the string denoting the time field in the database.
+	FieldTime = "time"
+	// FieldInput holds the string denoting the input field in the database.
+	FieldInput = "input"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldExternalID holds the string denoting the external_id field in the database.
+	FieldExternalID = "external_id"
+	// FieldExpires holds the string denoting the expires field in the database.
+	FieldExpires = "expires"
+	// FieldNotify holds the string denoting the notify field in the database.
+	FieldNotify = "notify"
+	// FieldCreated holds the string denoting the created field in the database.
+	FieldCreated = "created"
+	// Table holds the table name of the scheduledinvocation in the database.
+	Table = "scheduled_invocations"
+)
+
+// Columns holds all SQL columns for scheduledinvocation fields.
+var Columns = []string{
+	FieldID,
+	FieldNamespace,
+	FieldWorkflow,
+	FieldTime,
+	FieldInput,
+	FieldState,
+	FieldExternalID,
+	FieldExpires,
+	FieldNotify,
+	FieldCreated,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultNotify holds the default value on creation for the "notify" field.
+	DefaultNotify bool
+	// DefaultCreated holds the default value on creation for the "created" field.
+	DefaultCreated func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
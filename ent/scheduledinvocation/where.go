@@ -0,0 +1,993 @@
+// Code generated by entc, DO NOT EDIT.
+
+package scheduledinvocation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNamespace), v))
+	})
+}
+
+// Workflow applies equality check predicate on the "workflow" field. It's identical to WorkflowEQ.
+func Workflow(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWorkflow), v))
+	})
+}
+
+// Time applies equality check predicate on the "time" field. It's identical to TimeEQ.
+func Time(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTime), v))
+	})
+}
+
+// Input applies equality check predicate on the "input" field. It's identical to InputEQ.
+func Input(v []byte) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInput), v))
+	})
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldState), v))
+	})
+}
+
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExternalID), v))
+	})
+}
+
+// Expires applies equality check predicate on the "expires" field. It's identical to ExpiresEQ.
+func Expires(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpires), v))
+	})
+}
+
+// Notify applies equality check predicate on the "notify" field. It's identical to NotifyEQ.
+func Notify(v bool) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNotify), v))
+	})
+}
+
+// Created applies equality check predicate on the "created" field. It's identical to CreatedEQ.
+func Created(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNamespace), v...))
+	})
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNamespace), v...))
+	})
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNamespace), v))
+	})
+}
+
+// WorkflowEQ applies the EQ predicate on the "workflow" field.
+func WorkflowEQ(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWorkflow), v))
+	})
+}
+
+// WorkflowNEQ applies the NEQ predicate on the "workflow" field.
+func WorkflowNEQ(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWorkflow), v))
+	})
+}
+
+// WorkflowIn applies the In predicate on the "workflow" field.
+func WorkflowIn(vs ...string) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldWorkflow), v...))
+	})
+}
+
+// WorkflowNotIn applies the NotIn predicate on the "workflow" field.
+func WorkflowNotIn(vs ...string) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldWorkflow), v...))
+	})
+}
+
+// WorkflowGT applies the GT predicate on the "workflow" field.
+func WorkflowGT(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWorkflow), v))
+	})
+}
+
+// WorkflowGTE applies the GTE predicate on the "workflow" field.
+func WorkflowGTE(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWorkflow), v))
+	})
+}
+
+// WorkflowLT applies the LT predicate on the "workflow" field.
+func WorkflowLT(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWorkflow), v))
+	})
+}
+
+// WorkflowLTE applies the LTE predicate on the "workflow" field.
+func WorkflowLTE(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWorkflow), v))
+	})
+}
+
+// WorkflowContains applies the Contains predicate on the "workflow" field.
+func WorkflowContains(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldWorkflow), v))
+	})
+}
+
+// WorkflowHasPrefix applies the HasPrefix predicate on the "workflow" field.
+func WorkflowHasPrefix(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldWorkflow), v))
+	})
+}
+
+// WorkflowHasSuffix applies the HasSuffix predicate on the "workflow" field.
+func WorkflowHasSuffix(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldWorkflow), v))
+	})
+}
+
+// WorkflowEqualFold applies the EqualFold predicate on the "workflow" field.
+func WorkflowEqualFold(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldWorkflow), v))
+	})
+}
+
+// WorkflowContainsFold applies the ContainsFold predicate on the "workflow" field.
+func WorkflowContainsFold(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldWorkflow), v))
+	})
+}
+
+// TimeEQ applies the EQ predicate on the "time" field.
+func TimeEQ(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTime), v))
+	})
+}
+
+// TimeNEQ applies the NEQ predicate on the "time" field.
+func TimeNEQ(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTime), v))
+	})
+}
+
+// TimeIn applies the In predicate on the "time" field.
+func TimeIn(vs ...time.Time) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTime), v...))
+	})
+}
+
+// TimeNotIn applies the NotIn predicate on the "time" field.
+func TimeNotIn(vs ...time.Time) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTime), v...))
+	})
+}
+
+// TimeGT applies the GT predicate on the "time" field.
+func TimeGT(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTime), v))
+	})
+}
+
+// TimeGTE applies the GTE predicate on the "time" field.
+func TimeGTE(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTime), v))
+	})
+}
+
+// TimeLT applies the LT predicate on the "time" field.
+func TimeLT(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTime), v))
+	})
+}
+
+// TimeLTE applies the LTE predicate on the "time" field.
+func TimeLTE(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTime), v))
+	})
+}
+
+// InputEQ applies the EQ predicate on the "input" field.
+func InputEQ(v []byte) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldInput), v))
+	})
+}
+
+// InputNEQ applies the NEQ predicate on the "input" field.
+func InputNEQ(v []byte) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldInput), v))
+	})
+}
+
+// InputIn applies the In predicate on the "input" field.
+func InputIn(vs ...[]byte) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldInput), v...))
+	})
+}
+
+// InputNotIn applies the NotIn predicate on the "input" field.
+func InputNotIn(vs ...[]byte) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldInput), v...))
+	})
+}
+
+// InputGT applies the GT predicate on the "input" field.
+func InputGT(v []byte) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldInput), v))
+	})
+}
+
+// InputGTE applies the GTE predicate on the "input" field.
+func InputGTE(v []byte) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldInput), v))
+	})
+}
+
+// InputLT applies the LT predicate on the "input" field.
+func InputLT(v []byte) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldInput), v))
+	})
+}
+
+// InputLTE applies the LTE predicate on the "input" field.
+func InputLTE(v []byte) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldInput), v))
+	})
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldState), v))
+	})
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldState), v))
+	})
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldState), v...))
+	})
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldState), v...))
+	})
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldState), v))
+	})
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldState), v))
+	})
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldState), v))
+	})
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldState), v))
+	})
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldState), v))
+	})
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldState), v))
+	})
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldState), v))
+	})
+}
+
+// StateIsNil applies the IsNil predicate on the "state" field.
+func StateIsNil() predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldState)))
+	})
+}
+
+// StateNotNil applies the NotNil predicate on the "state" field.
+func StateNotNil() predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldState)))
+	})
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldState), v))
+	})
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldState), v))
+	})
+}
+
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExternalID), v...))
+	})
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExternalID), v...))
+	})
+}
+
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDIsNil applies the IsNil predicate on the "external_id" field.
+func ExternalIDIsNil() predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExternalID)))
+	})
+}
+
+// ExternalIDNotNil applies the NotNil predicate on the "external_id" field.
+func ExternalIDNotNil() predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExternalID)))
+	})
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldExternalID), v))
+	})
+}
+
+// ExpiresEQ applies the EQ predicate on the "expires" field.
+func ExpiresEQ(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresNEQ applies the NEQ predicate on the "expires" field.
+func ExpiresNEQ(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresIn applies the In predicate on the "expires" field.
+func ExpiresIn(vs ...time.Time) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpires), v...))
+	})
+}
+
+// ExpiresNotIn applies the NotIn predicate on the "expires" field.
+func ExpiresNotIn(vs ...time.Time) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpires), v...))
+	})
+}
+
+// ExpiresGT applies the GT predicate on the "expires" field.
+func ExpiresGT(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresGTE applies the GTE predicate on the "expires" field.
+func ExpiresGTE(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresLT applies the LT predicate on the "expires" field.
+func ExpiresLT(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresLTE applies the LTE predicate on the "expires" field.
+func ExpiresLTE(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpires), v))
+	})
+}
+
+// ExpiresIsNil applies the IsNil predicate on the "expires" field.
+func ExpiresIsNil() predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExpires)))
+	})
+}
+
+// ExpiresNotNil applies the NotNil predicate on the "expires" field.
+func ExpiresNotNil() predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExpires)))
+	})
+}
+
+// NotifyEQ applies the EQ predicate on the "notify" field.
+func NotifyEQ(v bool) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNotify), v))
+	})
+}
+
+// NotifyNEQ applies the NEQ predicate on the "notify" field.
+func NotifyNEQ(v bool) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNotify), v))
+	})
+}
+
+// CreatedEQ applies the EQ predicate on the "created" field.
+func CreatedEQ(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedNEQ applies the NEQ predicate on the "created" field.
+func CreatedNEQ(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedIn applies the In predicate on the "created" field.
+func CreatedIn(vs ...time.Time) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedNotIn applies the NotIn predicate on the "created" field.
+func CreatedNotIn(vs ...time.Time) predicate.ScheduledInvocation {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreated), v...))
+	})
+}
+
+// CreatedGT applies the GT predicate on the "created" field.
+func CreatedGT(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedGTE applies the GTE predicate on the "created" field.
+func CreatedGTE(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLT applies the LT predicate on the "created" field.
+func CreatedLT(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreated), v))
+	})
+}
+
+// CreatedLTE applies the LTE predicate on the "created" field.
+func CreatedLTE(v time.Time) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreated), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScheduledInvocation) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScheduledInvocation) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScheduledInvocation) predicate.ScheduledInvocation {
+	return predicate.ScheduledInvocation(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,377 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent/scheduledinvocation"
+)
+
+// ScheduledInvocationCreate is the builder for creating a ScheduledInvocation entity.
+type ScheduledInvocationCreate struct {
+	config
+	mutation *ScheduledInvocationMutation
+	hooks    []Hook
+}
+
+// SetNamespace sets the "namespace" field.
+func (sic *ScheduledInvocationCreate) SetNamespace(s string) *ScheduledInvocationCreate {
+	sic.mutation.SetNamespace(s)
+	return sic
+}
+
+// SetWorkflow sets the "workflow" field.
+func (sic *ScheduledInvocationCreate) SetWorkflow(s string) *ScheduledInvocationCreate {
+	sic.mutation.SetWorkflow(s)
+	return sic
+}
+
+// SetTime sets the "time" field.
+func (sic *ScheduledInvocationCreate) SetTime(t time.Time) *ScheduledInvocationCreate {
+	sic.mutation.SetTime(t)
+	return sic
+}
+
+// SetInput sets the "input" field.
+func (sic *ScheduledInvocationCreate) SetInput(b []byte) *ScheduledInvocationCreate {
+	sic.mutation.SetInput(b)
+	return sic
+}
+
+// SetState sets the "state" field.
+func (sic *ScheduledInvocationCreate) SetState(s string) *ScheduledInvocationCreate {
+	sic.mutation.SetState(s)
+	return sic
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (sic *ScheduledInvocationCreate) SetNillableState(s *string) *ScheduledInvocationCreate {
+	if s != nil {
+		sic.SetState(*s)
+	}
+	return sic
+}
+
+// SetExternalID sets the "external_id" field.
+func (sic *ScheduledInvocationCreate) SetExternalID(s string) *ScheduledInvocationCreate {
+	sic.mutation.SetExternalID(s)
+	return sic
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (sic *ScheduledInvocationCreate) SetNillableExternalID(s *string) *ScheduledInvocationCreate {
+	if s != nil {
+		sic.SetExternalID(*s)
+	}
+	return sic
+}
+
+// SetExpires sets the "expires" field.
+func (sic *ScheduledInvocationCreate) SetExpires(t time.Time) *ScheduledInvocationCreate {
+	sic.mutation.SetExpires(t)
+	return sic
+}
+
+// SetNillableExpires sets the "expires" field if the given value is not nil.
+func (sic *ScheduledInvocationCreate) SetNillableExpires(t *time.Time) *ScheduledInvocationCreate {
+	if t != nil {
+		sic.SetExpires(*t)
+	}
+	return sic
+}
+
+// SetNotify sets the "notify" field.
+func (sic *ScheduledInvocationCreate) SetNotify(b bool) *ScheduledInvocationCreate {
+	sic.mutation.SetNotify(b)
+	return sic
+}
+
+// SetNillableNotify sets the "notify" field if the given value is not nil.
+func (sic *ScheduledInvocationCreate) SetNillableNotify(b *bool) *ScheduledInvocationCreate {
+	if b != nil {
+		sic.SetNotify(*b)
+	}
+	return sic
+}
+
+// SetCreated sets the "created" field.
+func (sic *ScheduledInvocationCreate) SetCreated(t time.Time) *ScheduledInvocationCreate {
+	sic.mutation.SetCreated(t)
+	return sic
+}
+
+// SetNillableCreated sets the "created" field if the given value is not nil.
+func (sic *ScheduledInvocationCreate) SetNillableCreated(t *time.Time) *ScheduledInvocationCreate {
+	if t != nil {
+		sic.SetCreated(*t)
+	}
+	return sic
+}
+
+// SetID sets the "id" field.
+func (sic *ScheduledInvocationCreate) SetID(u uuid.UUID) *ScheduledInvocationCreate {
+	sic.mutation.SetID(u)
+	return sic
+}
+
+// Mutation returns the ScheduledInvocationMutation object of the builder.
+func (sic *ScheduledInvocationCreate) Mutation() *ScheduledInvocationMutation {
+	return sic.mutation
+}
+
+// Save creates the ScheduledInvocation in the database.
+func (sic *ScheduledInvocationCreate) Save(ctx context.Context) (*ScheduledInvocation, error) {
+	var (
+		err  error
+		node *ScheduledInvocation
+	)
+	sic.defaults()
+	if len(sic.hooks) == 0 {
+		if err = sic.check(); err != nil {
+			return nil, err
+		}
+		node, err = sic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ScheduledInvocationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sic.check(); err != nil {
+				return nil, err
+			}
+			sic.mutation = mutation
+			node, err = sic.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sic.hooks) - 1; i >= 0; i-- {
+			mut = sic.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sic.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sic *ScheduledInvocationCreate) SaveX(ctx context.Context) *ScheduledInvocation {
+	v, err := sic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (sic *ScheduledInvocationCreate) defaults() {
+	if _, ok := sic.mutation.Notify(); !ok {
+		v := scheduledinvocation.DefaultNotify
+		sic.mutation.SetNotify(v)
+	}
+	if _, ok := sic.mutation.Created(); !ok {
+		v := scheduledinvocation.DefaultCreated()
+		sic.mutation.SetCreated(v)
+	}
+	if _, ok := sic.mutation.ID(); !ok {
+		v := scheduledinvocation.DefaultID()
+		sic.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sic *ScheduledInvocationCreate) check() error {
+	if _, ok := sic.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New("ent: missing required field \"namespace\"")}
+	}
+	if _, ok := sic.mutation.Workflow(); !ok {
+		return &ValidationError{Name: "workflow", err: errors.New("ent: missing required field \"workflow\"")}
+	}
+	if _, ok := sic.mutation.Time(); !ok {
+		return &ValidationError{Name: "time", err: errors.New("ent: missing required field \"time\"")}
+	}
+	if _, ok := sic.mutation.Input(); !ok {
+		return &ValidationError{Name: "input", err: errors.New("ent: missing required field \"input\"")}
+	}
+	if _, ok := sic.mutation.Notify(); !ok {
+		return &ValidationError{Name: "notify", err: errors.New("ent: missing required field \"notify\"")}
+	}
+	if _, ok := sic.mutation.Created(); !ok {
+		return &ValidationError{Name: "created", err: errors.New("ent: missing required field \"created\"")}
+	}
+	return nil
+}
+
+func (sic *ScheduledInvocationCreate) sqlSave(ctx context.Context) (*ScheduledInvocation, error) {
+	_node, _spec := sic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sic.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (sic *ScheduledInvocationCreate) createSpec() (*ScheduledInvocation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduledInvocation{config: sic.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: scheduledinvocation.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: scheduledinvocation.FieldID,
+			},
+		}
+	)
+	if id, ok := sic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sic.mutation.Namespace(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: scheduledinvocation.FieldNamespace,
+		})
+		_node.Namespace = value
+	}
+	if value, ok := sic.mutation.Workflow(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: scheduledinvocation.FieldWorkflow,
+		})
+		_node.Workflow = value
+	}
+	if value, ok := sic.mutation.Time(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: scheduledinvocation.FieldTime,
+		})
+		_node.Time = value
+	}
+	if value, ok := sic.mutation.Input(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: scheduledinvocation.FieldInput,
+		})
+		_node.Input = value
+	}
+	if value, ok := sic.mutation.State(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: scheduledinvocation.FieldState,
+		})
+		_node.State = value
+	}
+	if value, ok := sic.mutation.ExternalID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: scheduledinvocation.FieldExternalID,
+		})
+		_node.ExternalID = value
+	}
+	if value, ok := sic.mutation.Expires(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: scheduledinvocation.FieldExpires,
+		})
+		_node.Expires = &value
+	}
+	if value, ok := sic.mutation.Notify(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: scheduledinvocation.FieldNotify,
+		})
+		_node.Notify = value
+	}
+	if value, ok := sic.mutation.Created(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: scheduledinvocation.FieldCreated,
+		})
+		_node.Created = value
+	}
+	return _node, _spec
+}
+
+// ScheduledInvocationCreateBulk is the builder for creating many ScheduledInvocation entities in bulk.
+type ScheduledInvocationCreateBulk struct {
+	config
+	builders []*ScheduledInvocationCreate
+}
+
+// Save creates the ScheduledInvocation entities in the database.
+func (sicb *ScheduledInvocationCreateBulk) Save(ctx context.Context) ([]*ScheduledInvocation, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(sicb.builders))
+	nodes := make([]*ScheduledInvocation, len(sicb.builders))
+	mutators := make([]Mutator, len(sicb.builders))
+	for i := range sicb.builders {
+		func(i int, root context.Context) {
+			builder := sicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduledInvocationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sicb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sicb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sicb *ScheduledInvocationCreateBulk) SaveX(ctx context.Context) []*ScheduledInvocation {
+	v, err := sicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
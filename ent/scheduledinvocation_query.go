@@ -0,0 +1,905 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/scheduledinvocation"
+)
+
+// ScheduledInvocationQuery is the builder for querying ScheduledInvocation entities.
+type ScheduledInvocationQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ScheduledInvocation
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ScheduledInvocationQuery builder.
+func (siq *ScheduledInvocationQuery) Where(ps ...predicate.ScheduledInvocation) *ScheduledInvocationQuery {
+	siq.predicates = append(siq.predicates, ps...)
+	return siq
+}
+
+// Limit adds a limit step to the query.
+func (siq *ScheduledInvocationQuery) Limit(limit int) *ScheduledInvocationQuery {
+	siq.limit = &limit
+	return siq
+}
+
+// Offset adds an offset step to the query.
+func (siq *ScheduledInvocationQuery) Offset(offset int) *ScheduledInvocationQuery {
+	siq.offset = &offset
+	return siq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (siq *ScheduledInvocationQuery) Unique(unique bool) *ScheduledInvocationQuery {
+	siq.unique = &unique
+	return siq
+}
+
+// Order adds an order step to the query.
+func (siq *ScheduledInvocationQuery) Order(o ...OrderFunc) *ScheduledInvocationQuery {
+	siq.order = append(siq.order, o...)
+	return siq
+}
+
+// First returns the first ScheduledInvocation entity from the query.
+// Returns a *NotFoundError when no ScheduledInvocation was found.
+func (siq *ScheduledInvocationQuery) First(ctx context.Context) (*ScheduledInvocation, error) {
+	nodes, err := siq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{scheduledinvocation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (siq *ScheduledInvocationQuery) FirstX(ctx context.Context) *ScheduledInvocation {
+	node, err := siq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ScheduledInvocation ID from the query.
+// Returns a *NotFoundError when no ScheduledInvocation ID was found.
+func (siq *ScheduledInvocationQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = siq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{scheduledinvocation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (siq *ScheduledInvocationQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := siq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ScheduledInvocation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one ScheduledInvocation entity is not found.
+// Returns a *NotFoundError when no ScheduledInvocation entities are found.
+func (siq *ScheduledInvocationQuery) Only(ctx context.Context) (*ScheduledInvocation, error) {
+	nodes, err := siq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{scheduledinvocation.Label}
+	default:
+		return nil, &NotSingularError{scheduledinvocation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (siq *ScheduledInvocationQuery) OnlyX(ctx context.Context) *ScheduledInvocation {
+	node, err := siq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ScheduledInvocation ID in the query.
+// Returns a *NotSingularError when exactly one ScheduledInvocation ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (siq *ScheduledInvocationQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = siq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{scheduledinvocation.Label}
+	default:
+		err = &NotSingularError{scheduledinvocation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (siq *ScheduledInvocationQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := siq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ScheduledInvocations.
+func (siq *ScheduledInvocationQuery) All(ctx context.Context) ([]*ScheduledInvocation, error) {
+	if err := siq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return siq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (siq *ScheduledInvocationQuery) AllX(ctx context.Context) []*ScheduledInvocation {
+	nodes, err := siq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ScheduledInvocation IDs.
+func (siq *ScheduledInvocationQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := siq.Select(scheduledinvocation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (siq *ScheduledInvocationQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := siq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (siq *ScheduledInvocationQuery) Count(ctx context.Context) (int, error) {
+	if err := siq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return siq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (siq *ScheduledInvocationQuery) CountX(ctx context.Context) int {
+	count, err := siq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (siq *ScheduledInvocationQuery) Exist(ctx context.Context) (bool, error) {
+	if err := siq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return siq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (siq *ScheduledInvocationQuery) ExistX(ctx context.Context) bool {
+	exist, err := siq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ScheduledInvocationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (siq *ScheduledInvocationQuery) Clone() *ScheduledInvocationQuery {
+	if siq == nil {
+		return nil
+	}
+	return &ScheduledInvocationQuery{
+		config:     siq.config,
+		limit:      siq.limit,
+		offset:     siq.offset,
+		order:      append([]OrderFunc{}, siq.order...),
+		predicates: append([]predicate.ScheduledInvocation{}, siq.predicates...),
+		// clone intermediate query.
+		sql:  siq.sql.Clone(),
+		path: siq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Namespace string `json:"namespace,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ScheduledInvocation.Query().
+//		GroupBy(scheduledinvocation.FieldNamespace).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (siq *ScheduledInvocationQuery) GroupBy(field string, fields ...string) *ScheduledInvocationGroupBy {
+	group := &ScheduledInvocationGroupBy{config: siq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := siq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return siq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Namespace string `json:"namespace,omitempty"`
+//	}
+//
+//	client.ScheduledInvocation.Query().
+//		Select(scheduledinvocation.FieldNamespace).
+//		Scan(ctx, &v)
+func (siq *ScheduledInvocationQuery) Select(field string, fields ...string) *ScheduledInvocationSelect {
+	siq.fields = append([]string{field}, fields...)
+	return &ScheduledInvocationSelect{ScheduledInvocationQuery: siq}
+}
+
+func (siq *ScheduledInvocationQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range siq.fields {
+		if !scheduledinvocation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if siq.path != nil {
+		prev, err := siq.path(ctx)
+		if err != nil {
+			return err
+		}
+		siq.sql = prev
+	}
+	return nil
+}
+
+func (siq *ScheduledInvocationQuery) sqlAll(ctx context.Context) ([]*ScheduledInvocation, error) {
+	var (
+		nodes = []*ScheduledInvocation{}
+		_spec = siq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &ScheduledInvocation{config: siq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, siq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (siq *ScheduledInvocationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := siq.querySpec()
+	return sqlgraph.CountNodes(ctx, siq.driver, _spec)
+}
+
+func (siq *ScheduledInvocationQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := siq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (siq *ScheduledInvocationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   scheduledinvocation.Table,
+			Columns: scheduledinvocation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: scheduledinvocation.FieldID,
+			},
+		},
+		From:   siq.sql,
+		Unique: true,
+	}
+	if unique := siq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := siq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledinvocation.FieldID)
+		for i := range fields {
+			if fields[i] != scheduledinvocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := siq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := siq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := siq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := siq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (siq *ScheduledInvocationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(siq.driver.Dialect())
+	t1 := builder.Table(scheduledinvocation.Table)
+	selector := builder.Select(t1.Columns(scheduledinvocation.Columns...)...).From(t1)
+	if siq.sql != nil {
+		selector = siq.sql
+		selector.Select(selector.Columns(scheduledinvocation.Columns...)...)
+	}
+	for _, p := range siq.predicates {
+		p(selector)
+	}
+	for _, p := range siq.order {
+		p(selector)
+	}
+	if offset := siq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := siq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ScheduledInvocationGroupBy is the group-by builder for ScheduledInvocation entities.
+type ScheduledInvocationGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sigb *ScheduledInvocationGroupBy) Aggregate(fns ...AggregateFunc) *ScheduledInvocationGroupBy {
+	sigb.fns = append(sigb.fns, fns...)
+	return sigb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (sigb *ScheduledInvocationGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := sigb.path(ctx)
+	if err != nil {
+		return err
+	}
+	sigb.sql = query
+	return sigb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (sigb *ScheduledInvocationGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := sigb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sigb *ScheduledInvocationGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(sigb.fields) > 1 {
+		return nil, errors.New("ent: ScheduledInvocationGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := sigb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (sigb *ScheduledInvocationGroupBy) StringsX(ctx context.Context) []string {
+	v, err := sigb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sigb *ScheduledInvocationGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = sigb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{scheduledinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: ScheduledInvocationGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (sigb *ScheduledInvocationGroupBy) StringX(ctx context.Context) string {
+	v, err := sigb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sigb *ScheduledInvocationGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(sigb.fields) > 1 {
+		return nil, errors.New("ent: ScheduledInvocationGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := sigb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (sigb *ScheduledInvocationGroupBy) IntsX(ctx context.Context) []int {
+	v, err := sigb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sigb *ScheduledInvocationGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = sigb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{scheduledinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: ScheduledInvocationGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (sigb *ScheduledInvocationGroupBy) IntX(ctx context.Context) int {
+	v, err := sigb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sigb *ScheduledInvocationGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(sigb.fields) > 1 {
+		return nil, errors.New("ent: ScheduledInvocationGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := sigb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (sigb *ScheduledInvocationGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := sigb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sigb *ScheduledInvocationGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = sigb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{scheduledinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: ScheduledInvocationGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (sigb *ScheduledInvocationGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := sigb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sigb *ScheduledInvocationGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(sigb.fields) > 1 {
+		return nil, errors.New("ent: ScheduledInvocationGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := sigb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (sigb *ScheduledInvocationGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := sigb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sigb *ScheduledInvocationGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = sigb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{scheduledinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: ScheduledInvocationGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (sigb *ScheduledInvocationGroupBy) BoolX(ctx context.Context) bool {
+	v, err := sigb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (sigb *ScheduledInvocationGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range sigb.fields {
+		if !scheduledinvocation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := sigb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sigb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (sigb *ScheduledInvocationGroupBy) sqlQuery() *sql.Selector {
+	selector := sigb.sql
+	columns := make([]string, 0, len(sigb.fields)+len(sigb.fns))
+	columns = append(columns, sigb.fields...)
+	for _, fn := range sigb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(sigb.fields...)
+}
+
+// ScheduledInvocationSelect is the builder for selecting fields of ScheduledInvocation entities.
+type ScheduledInvocationSelect struct {
+	*ScheduledInvocationQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sis *ScheduledInvocationSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := sis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	sis.sql = sis.ScheduledInvocationQuery.sqlQuery(ctx)
+	return sis.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (sis *ScheduledInvocationSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := sis.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (sis *ScheduledInvocationSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(sis.fields) > 1 {
+		return nil, errors.New("ent: ScheduledInvocationSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := sis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (sis *ScheduledInvocationSelect) StringsX(ctx context.Context) []string {
+	v, err := sis.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (sis *ScheduledInvocationSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = sis.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{scheduledinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: ScheduledInvocationSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (sis *ScheduledInvocationSelect) StringX(ctx context.Context) string {
+	v, err := sis.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (sis *ScheduledInvocationSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(sis.fields) > 1 {
+		return nil, errors.New("ent: ScheduledInvocationSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := sis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (sis *ScheduledInvocationSelect) IntsX(ctx context.Context) []int {
+	v, err := sis.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (sis *ScheduledInvocationSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = sis.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{scheduledinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: ScheduledInvocationSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (sis *ScheduledInvocationSelect) IntX(ctx context.Context) int {
+	v, err := sis.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (sis *ScheduledInvocationSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(sis.fields) > 1 {
+		return nil, errors.New("ent: ScheduledInvocationSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := sis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (sis *ScheduledInvocationSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := sis.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (sis *ScheduledInvocationSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = sis.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{scheduledinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: ScheduledInvocationSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (sis *ScheduledInvocationSelect) Float64X(ctx context.Context) float64 {
+	v, err := sis.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (sis *ScheduledInvocationSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(sis.fields) > 1 {
+		return nil, errors.New("ent: ScheduledInvocationSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := sis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (sis *ScheduledInvocationSelect) BoolsX(ctx context.Context) []bool {
+	v, err := sis.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (sis *ScheduledInvocationSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = sis.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{scheduledinvocation.Label}
+	default:
+		err = fmt.Errorf("ent: ScheduledInvocationSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (sis *ScheduledInvocationSelect) BoolX(ctx context.Context) bool {
+	v, err := sis.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (sis *ScheduledInvocationSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := sis.sqlQuery().Query()
+	if err := sis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (sis *ScheduledInvocationSelect) sqlQuery() sql.Querier {
+	selector := sis.sql
+	selector.Select(selector.Columns(sis.fields...)...)
+	return selector
+}
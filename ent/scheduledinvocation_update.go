@@ -0,0 +1,577 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/scheduledinvocation"
+)
+
+// ScheduledInvocationUpdate is the builder for updating ScheduledInvocation entities.
+type ScheduledInvocationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduledInvocationMutation
+}
+
+// Where adds a new predicate for the ScheduledInvocationUpdate builder.
+func (siu *ScheduledInvocationUpdate) Where(ps ...predicate.ScheduledInvocation) *ScheduledInvocationUpdate {
+	siu.mutation.predicates = append(siu.mutation.predicates, ps...)
+	return siu
+}
+
+// SetNamespace sets the "namespace" field.
+func (siu *ScheduledInvocationUpdate) SetNamespace(s string) *ScheduledInvocationUpdate {
+	siu.mutation.SetNamespace(s)
+	return siu
+}
+
+// SetWorkflow sets the "workflow" field.
+func (siu *ScheduledInvocationUpdate) SetWorkflow(s string) *ScheduledInvocationUpdate {
+	siu.mutation.SetWorkflow(s)
+	return siu
+}
+
+// SetTime sets the "time" field.
+func (siu *ScheduledInvocationUpdate) SetTime(t time.Time) *ScheduledInvocationUpdate {
+	siu.mutation.SetTime(t)
+	return siu
+}
+
+// SetInput sets the "input" field.
+func (siu *ScheduledInvocationUpdate) SetInput(b []byte) *ScheduledInvocationUpdate {
+	siu.mutation.SetInput(b)
+	return siu
+}
+
+// SetState sets the "state" field.
+func (siu *ScheduledInvocationUpdate) SetState(s string) *ScheduledInvocationUpdate {
+	siu.mutation.SetState(s)
+	return siu
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (siu *ScheduledInvocationUpdate) SetNillableState(s *string) *ScheduledInvocationUpdate {
+	if s != nil {
+		siu.SetState(*s)
+	}
+	return siu
+}
+
+// ClearState clears the value of the "state" field.
+func (siu *ScheduledInvocationUpdate) ClearState() *ScheduledInvocationUpdate {
+	siu.mutation.ClearState()
+	return siu
+}
+
+// SetExternalID sets the "external_id" field.
+func (siu *ScheduledInvocationUpdate) SetExternalID(s string) *ScheduledInvocationUpdate {
+	siu.mutation.SetExternalID(s)
+	return siu
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (siu *ScheduledInvocationUpdate) SetNillableExternalID(s *string) *ScheduledInvocationUpdate {
+	if s != nil {
+		siu.SetExternalID(*s)
+	}
+	return siu
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (siu *ScheduledInvocationUpdate) ClearExternalID() *ScheduledInvocationUpdate {
+	siu.mutation.ClearExternalID()
+	return siu
+}
+
+// SetExpires sets the "expires" field.
+func (siu *ScheduledInvocationUpdate) SetExpires(t time.Time) *ScheduledInvocationUpdate {
+	siu.mutation.SetExpires(t)
+	return siu
+}
+
+// SetNillableExpires sets the "expires" field if the given value is not nil.
+func (siu *ScheduledInvocationUpdate) SetNillableExpires(t *time.Time) *ScheduledInvocationUpdate {
+	if t != nil {
+		siu.SetExpires(*t)
+	}
+	return siu
+}
+
+// ClearExpires clears the value of the "expires" field.
+func (siu *ScheduledInvocationUpdate) ClearExpires() *ScheduledInvocationUpdate {
+	siu.mutation.ClearExpires()
+	return siu
+}
+
+// SetNotify sets the "notify" field.
+func (siu *ScheduledInvocationUpdate) SetNotify(b bool) *ScheduledInvocationUpdate {
+	siu.mutation.SetNotify(b)
+	return siu
+}
+
+// SetNillableNotify sets the "notify" field if the given value is not nil.
+func (siu *ScheduledInvocationUpdate) SetNillableNotify(b *bool) *ScheduledInvocationUpdate {
+	if b != nil {
+		siu.SetNotify(*b)
+	}
+	return siu
+}
+
+// Mutation returns the ScheduledInvocationMutation object of the builder.
+func (siu *ScheduledInvocationUpdate) Mutation() *ScheduledInvocationMutation {
+	return siu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (siu *ScheduledInvocationUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(siu.hooks) == 0 {
+		affected, err = siu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ScheduledInvocationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			siu.mutation = mutation
+			affected, err = siu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(siu.hooks) - 1; i >= 0; i-- {
+			mut = siu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, siu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siu *ScheduledInvocationUpdate) SaveX(ctx context.Context) int {
+	affected, err := siu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (siu *ScheduledInvocationUpdate) Exec(ctx context.Context) error {
+	_, err := siu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siu *ScheduledInvocationUpdate) ExecX(ctx context.Context) {
+	if err := siu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (siu *ScheduledInvocationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   scheduledinvocation.Table,
+			Columns: scheduledinvocation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: scheduledinvocation.FieldID,
+			},
+		},
+	}
+	if ps := siu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siu.mutation.Namespace(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: scheduledinvocation.FieldNamespace,
+		})
+	}
+	if value, ok := siu.mutation.Workflow(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: scheduledinvocation.FieldWorkflow,
+		})
+	}
+	if value, ok := siu.mutation.Time(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: scheduledinvocation.FieldTime,
+		})
+	}
+	if value, ok := siu.mutation.Input(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: scheduledinvocation.FieldInput,
+		})
+	}
+	if value, ok := siu.mutation.State(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: scheduledinvocation.FieldState,
+		})
+	}
+	if siu.mutation.StateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: scheduledinvocation.FieldState,
+		})
+	}
+	if value, ok := siu.mutation.ExternalID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: scheduledinvocation.FieldExternalID,
+		})
+	}
+	if siu.mutation.ExternalIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: scheduledinvocation.FieldExternalID,
+		})
+	}
+	if value, ok := siu.mutation.Expires(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: scheduledinvocation.FieldExpires,
+		})
+	}
+	if siu.mutation.ExpiresCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: scheduledinvocation.FieldExpires,
+		})
+	}
+	if value, ok := siu.mutation.Notify(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: scheduledinvocation.FieldNotify,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, siu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledinvocation.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ScheduledInvocationUpdateOne is the builder for updating a single ScheduledInvocation entity.
+type ScheduledInvocationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduledInvocationMutation
+}
+
+// SetNamespace sets the "namespace" field.
+func (siuo *ScheduledInvocationUpdateOne) SetNamespace(s string) *ScheduledInvocationUpdateOne {
+	siuo.mutation.SetNamespace(s)
+	return siuo
+}
+
+// SetWorkflow sets the "workflow" field.
+func (siuo *ScheduledInvocationUpdateOne) SetWorkflow(s string) *ScheduledInvocationUpdateOne {
+	siuo.mutation.SetWorkflow(s)
+	return siuo
+}
+
+// SetTime sets the "time" field.
+func (siuo *ScheduledInvocationUpdateOne) SetTime(t time.Time) *ScheduledInvocationUpdateOne {
+	siuo.mutation.SetTime(t)
+	return siuo
+}
+
+// SetInput sets the "input" field.
+func (siuo *ScheduledInvocationUpdateOne) SetInput(b []byte) *ScheduledInvocationUpdateOne {
+	siuo.mutation.SetInput(b)
+	return siuo
+}
+
+// SetState sets the "state" field.
+func (siuo *ScheduledInvocationUpdateOne) SetState(s string) *ScheduledInvocationUpdateOne {
+	siuo.mutation.SetState(s)
+	return siuo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (siuo *ScheduledInvocationUpdateOne) SetNillableState(s *string) *ScheduledInvocationUpdateOne {
+	if s != nil {
+		siuo.SetState(*s)
+	}
+	return siuo
+}
+
+// ClearState clears the value of the "state" field.
+func (siuo *ScheduledInvocationUpdateOne) ClearState() *ScheduledInvocationUpdateOne {
+	siuo.mutation.ClearState()
+	return siuo
+}
+
+// SetExternalID sets the "external_id" field.
+func (siuo *ScheduledInvocationUpdateOne) SetExternalID(s string) *ScheduledInvocationUpdateOne {
+	siuo.mutation.SetExternalID(s)
+	return siuo
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (siuo *ScheduledInvocationUpdateOne) SetNillableExternalID(s *string) *ScheduledInvocationUpdateOne {
+	if s != nil {
+		siuo.SetExternalID(*s)
+	}
+	return siuo
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (siuo *ScheduledInvocationUpdateOne) ClearExternalID() *ScheduledInvocationUpdateOne {
+	siuo.mutation.ClearExternalID()
+	return siuo
+}
+
+// SetExpires sets the "expires" field.
+func (siuo *ScheduledInvocationUpdateOne) SetExpires(t time.Time) *ScheduledInvocationUpdateOne {
+	siuo.mutation.SetExpires(t)
+	return siuo
+}
+
+// SetNillableExpires sets the "expires" field if the given value is not nil.
+func (siuo *ScheduledInvocationUpdateOne) SetNillableExpires(t *time.Time) *ScheduledInvocationUpdateOne {
+	if t != nil {
+		siuo.SetExpires(*t)
+	}
+	return siuo
+}
+
+// ClearExpires clears the value of the "expires" field.
+func (siuo *ScheduledInvocationUpdateOne) ClearExpires() *ScheduledInvocationUpdateOne {
+	siuo.mutation.ClearExpires()
+	return siuo
+}
+
+// SetNotify sets the "notify" field.
+func (siuo *ScheduledInvocationUpdateOne) SetNotify(b bool) *ScheduledInvocationUpdateOne {
+	siuo.mutation.SetNotify(b)
+	return siuo
+}
+
+// SetNillableNotify sets the "notify" field if the given value is not nil.
+func (siuo *ScheduledInvocationUpdateOne) SetNillableNotify(b *bool) *ScheduledInvocationUpdateOne {
+	if b != nil {
+		siuo.SetNotify(*b)
+	}
+	return siuo
+}
+
+// Mutation returns the ScheduledInvocationMutation object of the builder.
+func (siuo *ScheduledInvocationUpdateOne) Mutation() *ScheduledInvocationMutation {
+	return siuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (siuo *ScheduledInvocationUpdateOne) Select(field string, fields ...string) *ScheduledInvocationUpdateOne {
+	siuo.fields = append([]string{field}, fields...)
+	return siuo
+}
+
+// Save executes the query and returns the updated ScheduledInvocation entity.
+func (siuo *ScheduledInvocationUpdateOne) Save(ctx context.Context) (*ScheduledInvocation, error) {
+	var (
+		err  error
+		node *ScheduledInvocation
+	)
+	if len(siuo.hooks) == 0 {
+		node, err = siuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ScheduledInvocationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			siuo.mutation = mutation
+			node, err = siuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(siuo.hooks) - 1; i >= 0; i-- {
+			mut = siuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, siuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siuo *ScheduledInvocationUpdateOne) SaveX(ctx context.Context) *ScheduledInvocation {
+	node, err := siuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (siuo *ScheduledInvocationUpdateOne) Exec(ctx context.Context) error {
+	_, err := siuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siuo *ScheduledInvocationUpdateOne) ExecX(ctx context.Context) {
+	if err := siuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (siuo *ScheduledInvocationUpdateOne) sqlSave(ctx context.Context) (_node *ScheduledInvocation, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   scheduledinvocation.Table,
+			Columns: scheduledinvocation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: scheduledinvocation.FieldID,
+			},
+		},
+	}
+	id, ok := siuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing ScheduledInvocation.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := siuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledinvocation.FieldID)
+		for _, f := range fields {
+			if !scheduledinvocation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scheduledinvocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := siuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siuo.mutation.Namespace(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: scheduledinvocation.FieldNamespace,
+		})
+	}
+	if value, ok := siuo.mutation.Workflow(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: scheduledinvocation.FieldWorkflow,
+		})
+	}
+	if value, ok := siuo.mutation.Time(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: scheduledinvocation.FieldTime,
+		})
+	}
+	if value, ok := siuo.mutation.Input(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: scheduledinvocation.FieldInput,
+		})
+	}
+	if value, ok := siuo.mutation.State(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: scheduledinvocation.FieldState,
+		})
+	}
+	if siuo.mutation.StateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: scheduledinvocation.FieldState,
+		})
+	}
+	if value, ok := siuo.mutation.ExternalID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: scheduledinvocation.FieldExternalID,
+		})
+	}
+	if siuo.mutation.ExternalIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: scheduledinvocation.FieldExternalID,
+		})
+	}
+	if value, ok := siuo.mutation.Expires(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: scheduledinvocation.FieldExpires,
+		})
+	}
+	if siuo.mutation.ExpiresCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: scheduledinvocation.FieldExpires,
+		})
+	}
+	if value, ok := siuo.mutation.Notify(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: scheduledinvocation.FieldNotify,
+		})
+	}
+	_node = &ScheduledInvocation{config: siuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, siuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledinvocation.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EventCounter holds the schema definition for the EventCounter entity, the
+// cloud events of a type a namespace received and what became of them.
+type EventCounter struct {
+	ent.Schema
+}
+
+// Fields of the EventCounter.
+func (EventCounter) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("namespace"),
+		field.String("type"),
+		field.Int64("received").Default(0),
+		field.Int64("matched").Default(0),
+		field.Int64("unmatched").Default(0),
+		field.Int64("rejected").Default(0),
+		field.Time("last_received"),
+	}
+}
+
+// Edges of the EventCounter.
+func (EventCounter) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the EventCounter.
+func (EventCounter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "type").Unique(),
+	}
+}
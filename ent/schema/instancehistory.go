@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InstanceHistory holds the schema definition for the InstanceHistory entity,
+// one recorded run of an instance's state logic that a debugger can replay.
+type InstanceHistory struct {
+	ent.Schema
+}
+
+// Fields of the InstanceHistory.
+func (InstanceHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("instanceID"),
+		field.Int("step"),
+		field.Int("revision"),
+		field.Time("time"),
+		field.Bytes("data"),
+	}
+}
+
+// Edges of the InstanceHistory.
+func (InstanceHistory) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the InstanceHistory.
+func (InstanceHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("instanceID"),
+	}
+}
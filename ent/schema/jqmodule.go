@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// JQModule holds the schema definition for the JQModule entity, a jq module
+// of a namespace's library that the transforms of its workflows can include.
+type JQModule struct {
+	ent.Schema
+}
+
+// Fields of the JQModule.
+func (JQModule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("namespace"),
+		field.String("name"),
+		field.Text("source"),
+		field.Time("updated").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the JQModule.
+func (JQModule) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the JQModule.
+func (JQModule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "name").Unique(),
+	}
+}
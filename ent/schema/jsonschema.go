@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// JSONSchema holds the schema definition for the JSONSchema entity, a
+// version of a JSON Schema a namespace stored for its validate states to
+// reference by name.
+type JSONSchema struct {
+	ent.Schema
+}
+
+// Fields of the JSONSchema.
+func (JSONSchema) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("namespace"),
+		field.String("name"),
+		field.Int("version"),
+		field.String("schema"),
+		field.String("hash"),
+		field.Time("created").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the JSONSchema.
+func (JSONSchema) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the JSONSchema.
+func (JSONSchema) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "name", "version").Unique(),
+	}
+}
@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// LockLease holds the schema definition for the LockLease entity, a lock held
+// by a flow server until it releases it or the lease expires, for databases
+// without advisory locks.
+type LockLease struct {
+	ent.Schema
+}
+
+// Fields of the LockLease.
+func (LockLease) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("key").Unique(),
+		field.String("holder"),
+		field.String("token"),
+		field.Time("expires"),
+	}
+}
+
+// Edges of the LockLease.
+func (LockLease) Edges() []ent.Edge {
+	return nil
+}
@@ -18,6 +18,14 @@ func (Namespace) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").Immutable().Unique().NotEmpty().MaxLen(64).MinLen(1),
 		field.Time("created").Immutable().Default(time.Now),
+		field.String("hold").Optional(),
+		field.String("region").Optional().Immutable(),
+		field.Bytes("event_config").Optional(),
+		field.String("cron_suspension").Optional(),
+		field.Bytes("constants").Optional(),
+		field.Bytes("profiles").Optional(),
+		field.String("profile").Optional(),
+		field.Bytes("retry_policies").Optional(),
 	}
 }
 
@@ -25,5 +33,6 @@ func (Namespace) Fields() []ent.Field {
 func (Namespace) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("workflows", Workflow.Type),
+		edge.To("children", Namespace.Type).From("parent").Unique(),
 	}
 }
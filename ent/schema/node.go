@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Node holds the schema definition for the Node entity, a flow server that
+// can own workflow instances.
+type Node struct {
+	ent.Schema
+}
+
+// Fields of the Node.
+func (Node) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("hostname").Unique(),
+		field.Time("started"),
+		field.Time("heartbeat"),
+		field.Bool("draining").Default(false),
+		field.Int("timers").Default(0),
+		field.Int("queued").Default(0),
+		field.Bool("promoted").Default(false),
+		field.Bytes("timer_stats").Optional(),
+		field.Bytes("lock_stats").Optional(),
+	}
+}
+
+// Edges of the Node.
+func (Node) Edges() []ent.Edge {
+	return nil
+}
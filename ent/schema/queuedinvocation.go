@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// QueuedInvocation holds the schema definition for the QueuedInvocation
+// entity, an invocation of a singular workflow waiting for its running
+// instance to finish.
+type QueuedInvocation struct {
+	ent.Schema
+}
+
+// Fields of the QueuedInvocation.
+func (QueuedInvocation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("instance_id").Unique(),
+		field.UUID("workflow", uuid.UUID{}),
+		field.String("source"),
+		field.Bytes("params"),
+		field.Time("created").Immutable().Default(time.Now),
+		field.Time("expires"),
+	}
+}
+
+// Edges of the QueuedInvocation.
+func (QueuedInvocation) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the QueuedInvocation.
+func (QueuedInvocation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workflow", "created"),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// QueuedState holds the schema definition for the QueuedState entity, state
+// logic submitted to a node while all of its workers were busy.
+type QueuedState struct {
+	ent.Schema
+}
+
+// Fields of the QueuedState.
+func (QueuedState) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("hostname"),
+		field.String("instance_id"),
+		field.Bytes("message"),
+		field.Int("size"),
+		field.Time("created").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the QueuedState.
+func (QueuedState) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the QueuedState.
+func (QueuedState) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("hostname", "created"),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ScheduledInvocation holds the schema definition for the
+// ScheduledInvocation entity, an invocation of a workflow requested for a
+// later time.
+type ScheduledInvocation struct {
+	ent.Schema
+}
+
+// Fields of the ScheduledInvocation.
+func (ScheduledInvocation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("namespace"),
+		field.String("workflow"),
+		field.Time("time"),
+		field.Bytes("input"),
+		field.String("state").Optional(),
+		field.String("external_id").Optional(),
+		field.Time("expires").Optional().Nillable(),
+		field.Bool("notify").Default(false),
+		field.Time("created").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the ScheduledInvocation.
+func (ScheduledInvocation) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ScheduledInvocation.
+func (ScheduledInvocation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "time"),
+		index.Fields("time"),
+	}
+}
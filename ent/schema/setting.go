@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Setting holds the schema definition for the Setting entity, a setting that
+// applies to every flow server of a cluster.
+type Setting struct {
+	ent.Schema
+}
+
+// Fields of the Setting.
+func (Setting) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").Unique().NotEmpty(),
+		field.String("value"),
+		field.Time("updated").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Setting.
+func (Setting) Edges() []ent.Edge {
+	return nil
+}
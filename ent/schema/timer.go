@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Timer holds the schema definition for the Timer entity, an instance timer
+// recorded so that a standby cluster can reschedule it when promoted.
+type Timer struct {
+	ent.Schema
+}
+
+// Fields of the Timer.
+func (Timer) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique(),
+		field.String("function"),
+		field.Time("time"),
+		field.Bytes("data").Optional(),
+	}
+}
+
+// Edges of the Timer.
+func (Timer) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UnmatchedEvent holds the schema definition for the UnmatchedEvent entity, a
+// sample of the cloud events a namespace received that no workflow listened
+// for.
+type UnmatchedEvent struct {
+	ent.Schema
+}
+
+// Fields of the UnmatchedEvent.
+func (UnmatchedEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("namespace"),
+		field.String("type"),
+		field.String("source"),
+		field.String("event_id"),
+		field.Time("received"),
+		field.Bytes("cloudevent"),
+	}
+}
+
+// Edges of the UnmatchedEvent.
+func (UnmatchedEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UnmatchedEvent.
+func (UnmatchedEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "received"),
+	}
+}
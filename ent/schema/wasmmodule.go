@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WasmModule holds the schema definition for the WasmModule entity, a
+// WebAssembly module a namespace uploaded for its workflows to run as
+// transforms and states.
+type WasmModule struct {
+	ent.Schema
+}
+
+// Fields of the WasmModule.
+func (WasmModule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("namespace"),
+		field.String("name"),
+		field.Bytes("binary"),
+		field.String("hash"),
+		field.Time("updated").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the WasmModule.
+func (WasmModule) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the WasmModule.
+func (WasmModule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "name").Unique(),
+	}
+}
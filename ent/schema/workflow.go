@@ -44,6 +44,7 @@ func (Workflow) Edges() []ent.Edge {
 			Unique().Required(),
 		edge.To("instances", WorkflowInstance.Type),
 		edge.To("wfevents", WorkflowEvents.Type),
+		edge.To("revisions", WorkflowRevision.Type),
 	}
 }
 
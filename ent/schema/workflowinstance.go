@@ -2,8 +2,10 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // WorkflowInstance holds the schema definition for the WorkflowInstance entity.
@@ -15,6 +17,7 @@ type WorkflowInstance struct {
 func (WorkflowInstance) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("instanceID").Unique(),
+		field.String("externalID").Optional().Nillable(),
 		field.String("invokedBy"),
 		field.String("status"),
 		field.Int("revision"),
@@ -22,18 +25,45 @@ func (WorkflowInstance) Fields() []ent.Field {
 		field.Time("endTime").Optional(),
 		field.Strings("flow").Optional(),
 		field.String("input"),
-		field.String("output").Optional(),
-		field.String("stateData").Optional(),
+		// stored as jsonb so that instances can be filtered by their content
+		field.String("output").Optional().SchemaType(map[string]string{
+			dialect.Postgres: "jsonb",
+		}),
+		field.String("stateData").Optional().SchemaType(map[string]string{
+			dialect.Postgres: "jsonb",
+		}),
 		field.String("memory").Optional(),
+		field.Bytes("memoryData").Optional(),
 		field.Time("deadline").Optional(),
 		field.Int("attempts").Optional(),
 		field.String("errorCode").Optional(),
 		field.String("errorMessage").Optional(),
+		field.String("errorData").Optional(),
+		field.JSON("errorCauses", []ErrorCause{}).Optional(),
+		field.String("cancelCategory").Optional(),
+		field.String("cancelReason").Optional(),
+		field.String("progress").Optional(),
 		field.Time("stateBeginTime").Optional(),
 		field.String("controller").Optional(),
+		field.Bytes("handoff").Optional(),
+		field.Bytes("simulation").Optional(),
+		field.Bytes("debug").Optional(),
+		field.String("hold").Optional(),
+		field.String("profile").Optional(),
+		field.String("receivedEvents").Optional(),
+		// the error the instance moves on with once its cancelled isolates
+		// have stopped
+		field.Bytes("awaitingCancels").Optional(),
 	}
 }
 
+// ErrorCause is one error in the cause chain of an instance that crashed, as
+// users may see it.
+type ErrorCause struct {
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
 // Edges of the WorkflowInstance.
 func (WorkflowInstance) Edges() []ent.Edge {
 	return []ent.Edge{
@@ -43,3 +73,11 @@ func (WorkflowInstance) Edges() []ent.Edge {
 		edge.To("instance", WorkflowEvents.Type),
 	}
 }
+
+// Indexes of the WorkflowInstance.
+func (WorkflowInstance) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("externalID").Edges("workflow").
+			Unique(),
+	}
+}
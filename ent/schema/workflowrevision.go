@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WorkflowRevision holds the schema definition for the WorkflowRevision
+// entity, the definition a workflow was saved with at a revision, so that
+// instances pinned to it can still run it after the workflow is updated.
+type WorkflowRevision struct {
+	ent.Schema
+}
+
+// Fields of the WorkflowRevision.
+func (WorkflowRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("revision"),
+		field.Bytes("definition"),
+	}
+}
+
+// Edges of the WorkflowRevision.
+func (WorkflowRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workflow", Workflow.Type).
+			Ref("revisions").
+			Unique().Required(),
+	}
+}
+
+// Indexes of the WorkflowRevision.
+func (WorkflowRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("revision").Edges("workflow").
+			Unique(),
+	}
+}
@@ -0,0 +1,49 @@
+// Code generated by entc, DO NOT EDIT.
+
+package setting
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the setting type in the database.
+	Label = "setting"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldUpdated holds the string denoting the updated field in the database.
+	FieldUpdated = "updated"
+	// Table holds the table name of the setting in the database.
+	Table = "settings"
+)
+
+// Columns holds all SQL columns for setting fields.
+var Columns = []string{
+	FieldID,
+	FieldKey,
+	FieldValue,
+	FieldUpdated,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	KeyValidator func(string) error
+	// DefaultUpdated holds the default value on creation for the "updated" field.
+	DefaultUpdated func() time.Time
+	// UpdateDefaultUpdated holds the default value on update for the "updated" field.
+	UpdateDefaultUpdated func() time.Time
+)
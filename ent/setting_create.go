@@ -0,0 +1,242 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/setting"
+)
+
+// SettingCreate is the builder for creating a Setting entity.
+type SettingCreate struct {
+	config
+	mutation *SettingMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (sc *SettingCreate) SetKey(s string) *SettingCreate {
+	sc.mutation.SetKey(s)
+	return sc
+}
+
+// SetValue sets the "value" field.
+func (sc *SettingCreate) SetValue(s string) *SettingCreate {
+	sc.mutation.SetValue(s)
+	return sc
+}
+
+// SetUpdated sets the "updated" field.
+func (sc *SettingCreate) SetUpdated(t time.Time) *SettingCreate {
+	sc.mutation.SetUpdated(t)
+	return sc
+}
+
+// SetNillableUpdated sets the "updated" field if the given value is not nil.
+func (sc *SettingCreate) SetNillableUpdated(t *time.Time) *SettingCreate {
+	if t != nil {
+		sc.SetUpdated(*t)
+	}
+	return sc
+}
+
+// Mutation returns the SettingMutation object of the builder.
+func (sc *SettingCreate) Mutation() *SettingMutation {
+	return sc.mutation
+}
+
+// Save creates the Setting in the database.
+func (sc *SettingCreate) Save(ctx context.Context) (*Setting, error) {
+	var (
+		err  error
+		node *Setting
+	)
+	sc.defaults()
+	if len(sc.hooks) == 0 {
+		if err = sc.check(); err != nil {
+			return nil, err
+		}
+		node, err = sc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SettingMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sc.check(); err != nil {
+				return nil, err
+			}
+			sc.mutation = mutation
+			node, err = sc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sc.hooks) - 1; i >= 0; i-- {
+			mut = sc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SettingCreate) SaveX(ctx context.Context) *Setting {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SettingCreate) defaults() {
+	if _, ok := sc.mutation.Updated(); !ok {
+		v := setting.DefaultUpdated()
+		sc.mutation.SetUpdated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SettingCreate) check() error {
+	if _, ok := sc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New("ent: missing required field \"key\"")}
+	}
+	if v, ok := sc.mutation.Key(); ok {
+		if err := setting.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf("ent: validator failed for field \"key\": %w", err)}
+		}
+	}
+	if _, ok := sc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New("ent: missing required field \"value\"")}
+	}
+	if _, ok := sc.mutation.Updated(); !ok {
+		return &ValidationError{Name: "updated", err: errors.New("ent: missing required field \"updated\"")}
+	}
+	return nil
+}
+
+func (sc *SettingCreate) sqlSave(ctx context.Context) (*Setting, error) {
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (sc *SettingCreate) createSpec() (*Setting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Setting{config: sc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: setting.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: setting.FieldID,
+			},
+		}
+	)
+	if value, ok := sc.mutation.Key(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: setting.FieldKey,
+		})
+		_node.Key = value
+	}
+	if value, ok := sc.mutation.Value(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: setting.FieldValue,
+		})
+		_node.Value = value
+	}
+	if value, ok := sc.mutation.Updated(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: setting.FieldUpdated,
+		})
+		_node.Updated = value
+	}
+	return _node, _spec
+}
+
+// SettingCreateBulk is the builder for creating many Setting entities in bulk.
+type SettingCreateBulk struct {
+	config
+	builders []*SettingCreate
+}
+
+// Save creates the Setting entities in the database.
+func (scb *SettingCreateBulk) Save(ctx context.Context) ([]*Setting, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Setting, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SettingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SettingCreateBulk) SaveX(ctx context.Context) []*Setting {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
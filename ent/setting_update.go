@@ -0,0 +1,361 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/setting"
+)
+
+// SettingUpdate is the builder for updating Setting entities.
+type SettingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SettingMutation
+}
+
+// Where adds a new predicate for the SettingUpdate builder.
+func (su *SettingUpdate) Where(ps ...predicate.Setting) *SettingUpdate {
+	su.mutation.predicates = append(su.mutation.predicates, ps...)
+	return su
+}
+
+// SetKey sets the "key" field.
+func (su *SettingUpdate) SetKey(s string) *SettingUpdate {
+	su.mutation.SetKey(s)
+	return su
+}
+
+// SetValue sets the "value" field.
+func (su *SettingUpdate) SetValue(s string) *SettingUpdate {
+	su.mutation.SetValue(s)
+	return su
+}
+
+// SetUpdated sets the "updated" field.
+func (su *SettingUpdate) SetUpdated(t time.Time) *SettingUpdate {
+	su.mutation.SetUpdated(t)
+	return su
+}
+
+// Mutation returns the SettingMutation object of the builder.
+func (su *SettingUpdate) Mutation() *SettingMutation {
+	return su.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SettingUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	su.defaults()
+	if len(su.hooks) == 0 {
+		if err = su.check(); err != nil {
+			return 0, err
+		}
+		affected, err = su.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SettingMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = su.check(); err != nil {
+				return 0, err
+			}
+			su.mutation = mutation
+			affected, err = su.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(su.hooks) - 1; i >= 0; i-- {
+			mut = su.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, su.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SettingUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SettingUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SettingUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *SettingUpdate) defaults() {
+	if _, ok := su.mutation.Updated(); !ok {
+		v := setting.UpdateDefaultUpdated()
+		su.mutation.SetUpdated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SettingUpdate) check() error {
+	if v, ok := su.mutation.Key(); ok {
+		if err := setting.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf("ent: validator failed for field \"key\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (su *SettingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   setting.Table,
+			Columns: setting.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: setting.FieldID,
+			},
+		},
+	}
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Key(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: setting.FieldKey,
+		})
+	}
+	if value, ok := su.mutation.Value(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: setting.FieldValue,
+		})
+	}
+	if value, ok := su.mutation.Updated(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: setting.FieldUpdated,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{setting.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SettingUpdateOne is the builder for updating a single Setting entity.
+type SettingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SettingMutation
+}
+
+// SetKey sets the "key" field.
+func (suo *SettingUpdateOne) SetKey(s string) *SettingUpdateOne {
+	suo.mutation.SetKey(s)
+	return suo
+}
+
+// SetValue sets the "value" field.
+func (suo *SettingUpdateOne) SetValue(s string) *SettingUpdateOne {
+	suo.mutation.SetValue(s)
+	return suo
+}
+
+// SetUpdated sets the "updated" field.
+func (suo *SettingUpdateOne) SetUpdated(t time.Time) *SettingUpdateOne {
+	suo.mutation.SetUpdated(t)
+	return suo
+}
+
+// Mutation returns the SettingMutation object of the builder.
+func (suo *SettingUpdateOne) Mutation() *SettingMutation {
+	return suo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SettingUpdateOne) Select(field string, fields ...string) *SettingUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Setting entity.
+func (suo *SettingUpdateOne) Save(ctx context.Context) (*Setting, error) {
+	var (
+		err  error
+		node *Setting
+	)
+	suo.defaults()
+	if len(suo.hooks) == 0 {
+		if err = suo.check(); err != nil {
+			return nil, err
+		}
+		node, err = suo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SettingMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = suo.check(); err != nil {
+				return nil, err
+			}
+			suo.mutation = mutation
+			node, err = suo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(suo.hooks) - 1; i >= 0; i-- {
+			mut = suo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, suo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SettingUpdateOne) SaveX(ctx context.Context) *Setting {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SettingUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SettingUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *SettingUpdateOne) defaults() {
+	if _, ok := suo.mutation.Updated(); !ok {
+		v := setting.UpdateDefaultUpdated()
+		suo.mutation.SetUpdated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SettingUpdateOne) check() error {
+	if v, ok := suo.mutation.Key(); ok {
+		if err := setting.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf("ent: validator failed for field \"key\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (suo *SettingUpdateOne) sqlSave(ctx context.Context) (_node *Setting, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   setting.Table,
+			Columns: setting.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: setting.FieldID,
+			},
+		},
+	}
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Setting.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, setting.FieldID)
+		for _, f := range fields {
+			if !setting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != setting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Key(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: setting.FieldKey,
+		})
+	}
+	if value, ok := suo.mutation.Value(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: setting.FieldValue,
+		})
+	}
+	if value, ok := suo.mutation.Updated(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: setting.FieldUpdated,
+		})
+	}
+	_node = &Setting{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{setting.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,39 @@
+// Code generated by entc, DO NOT EDIT.
+
+package timer
+
+const (
+	// Label holds the string label denoting the timer type in the database.
+	Label = "timer"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldFunction holds the string denoting the function field in the database.
+	FieldFunction = "function"
+	// FieldTime holds the string denoting the time field in the database.
+	FieldTime = "time"
+	// FieldData holds the string denoting the data field in the database.
+	FieldData = "data"
+	// Table holds the table name of the timer in the database.
+	Table = "timers"
+)
+
+// Columns holds all SQL columns for timer fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldFunction,
+	FieldTime,
+	FieldData,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
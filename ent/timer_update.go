@@ -0,0 +1,361 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/timer"
+)
+
+// TimerUpdate is the builder for updating Timer entities.
+type TimerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TimerMutation
+}
+
+// Where adds a new predicate for the TimerUpdate builder.
+func (tu *TimerUpdate) Where(ps ...predicate.Timer) *TimerUpdate {
+	tu.mutation.predicates = append(tu.mutation.predicates, ps...)
+	return tu
+}
+
+// SetName sets the "name" field.
+func (tu *TimerUpdate) SetName(s string) *TimerUpdate {
+	tu.mutation.SetName(s)
+	return tu
+}
+
+// SetFunction sets the "function" field.
+func (tu *TimerUpdate) SetFunction(s string) *TimerUpdate {
+	tu.mutation.SetFunction(s)
+	return tu
+}
+
+// SetTime sets the "time" field.
+func (tu *TimerUpdate) SetTime(t time.Time) *TimerUpdate {
+	tu.mutation.SetTime(t)
+	return tu
+}
+
+// SetData sets the "data" field.
+func (tu *TimerUpdate) SetData(b []byte) *TimerUpdate {
+	tu.mutation.SetData(b)
+	return tu
+}
+
+// ClearData clears the value of the "data" field.
+func (tu *TimerUpdate) ClearData() *TimerUpdate {
+	tu.mutation.ClearData()
+	return tu
+}
+
+// Mutation returns the TimerMutation object of the builder.
+func (tu *TimerUpdate) Mutation() *TimerMutation {
+	return tu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TimerUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(tu.hooks) == 0 {
+		affected, err = tu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TimerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tu.mutation = mutation
+			affected, err = tu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(tu.hooks) - 1; i >= 0; i-- {
+			mut = tu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TimerUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TimerUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TimerUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tu *TimerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   timer.Table,
+			Columns: timer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: timer.FieldID,
+			},
+		},
+	}
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: timer.FieldName,
+		})
+	}
+	if value, ok := tu.mutation.Function(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: timer.FieldFunction,
+		})
+	}
+	if value, ok := tu.mutation.Time(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: timer.FieldTime,
+		})
+	}
+	if value, ok := tu.mutation.Data(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: timer.FieldData,
+		})
+	}
+	if tu.mutation.DataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: timer.FieldData,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{timer.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TimerUpdateOne is the builder for updating a single Timer entity.
+type TimerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TimerMutation
+}
+
+// SetName sets the "name" field.
+func (tuo *TimerUpdateOne) SetName(s string) *TimerUpdateOne {
+	tuo.mutation.SetName(s)
+	return tuo
+}
+
+// SetFunction sets the "function" field.
+func (tuo *TimerUpdateOne) SetFunction(s string) *TimerUpdateOne {
+	tuo.mutation.SetFunction(s)
+	return tuo
+}
+
+// SetTime sets the "time" field.
+func (tuo *TimerUpdateOne) SetTime(t time.Time) *TimerUpdateOne {
+	tuo.mutation.SetTime(t)
+	return tuo
+}
+
+// SetData sets the "data" field.
+func (tuo *TimerUpdateOne) SetData(b []byte) *TimerUpdateOne {
+	tuo.mutation.SetData(b)
+	return tuo
+}
+
+// ClearData clears the value of the "data" field.
+func (tuo *TimerUpdateOne) ClearData() *TimerUpdateOne {
+	tuo.mutation.ClearData()
+	return tuo
+}
+
+// Mutation returns the TimerMutation object of the builder.
+func (tuo *TimerUpdateOne) Mutation() *TimerMutation {
+	return tuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TimerUpdateOne) Select(field string, fields ...string) *TimerUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Timer entity.
+func (tuo *TimerUpdateOne) Save(ctx context.Context) (*Timer, error) {
+	var (
+		err  error
+		node *Timer
+	)
+	if len(tuo.hooks) == 0 {
+		node, err = tuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TimerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tuo.mutation = mutation
+			node, err = tuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tuo.hooks) - 1; i >= 0; i-- {
+			mut = tuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TimerUpdateOne) SaveX(ctx context.Context) *Timer {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TimerUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TimerUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tuo *TimerUpdateOne) sqlSave(ctx context.Context) (_node *Timer, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   timer.Table,
+			Columns: timer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: timer.FieldID,
+			},
+		},
+	}
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Timer.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, timer.FieldID)
+		for _, f := range fields {
+			if !timer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != timer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: timer.FieldName,
+		})
+	}
+	if value, ok := tuo.mutation.Function(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: timer.FieldFunction,
+		})
+	}
+	if value, ok := tuo.mutation.Time(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: timer.FieldTime,
+		})
+	}
+	if value, ok := tuo.mutation.Data(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: timer.FieldData,
+		})
+	}
+	if tuo.mutation.DataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: timer.FieldData,
+		})
+	}
+	_node = &Timer{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{timer.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
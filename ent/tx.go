@@ -12,8 +12,34 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// EventCounter is the client for interacting with the EventCounter builders.
+	EventCounter *EventCounterClient
+	// InstanceHistory is the client for interacting with the InstanceHistory builders.
+	InstanceHistory *InstanceHistoryClient
+	// JQModule is the client for interacting with the JQModule builders.
+	JQModule *JQModuleClient
+	// JSONSchema is the client for interacting with the JSONSchema builders.
+	JSONSchema *JSONSchemaClient
+	// LockLease is the client for interacting with the LockLease builders.
+	LockLease *LockLeaseClient
 	// Namespace is the client for interacting with the Namespace builders.
 	Namespace *NamespaceClient
+	// Node is the client for interacting with the Node builders.
+	Node *NodeClient
+	// QueuedInvocation is the client for interacting with the QueuedInvocation builders.
+	QueuedInvocation *QueuedInvocationClient
+	// QueuedState is the client for interacting with the QueuedState builders.
+	QueuedState *QueuedStateClient
+	// ScheduledInvocation is the client for interacting with the ScheduledInvocation builders.
+	ScheduledInvocation *ScheduledInvocationClient
+	// Setting is the client for interacting with the Setting builders.
+	Setting *SettingClient
+	// Timer is the client for interacting with the Timer builders.
+	Timer *TimerClient
+	// UnmatchedEvent is the client for interacting with the UnmatchedEvent builders.
+	UnmatchedEvent *UnmatchedEventClient
+	// WasmModule is the client for interacting with the WasmModule builders.
+	WasmModule *WasmModuleClient
 	// Workflow is the client for interacting with the Workflow builders.
 	Workflow *WorkflowClient
 	// WorkflowEvents is the client for interacting with the WorkflowEvents builders.
@@ -22,6 +48,8 @@ type Tx struct {
 	WorkflowEventsWait *WorkflowEventsWaitClient
 	// WorkflowInstance is the client for interacting with the WorkflowInstance builders.
 	WorkflowInstance *WorkflowInstanceClient
+	// WorkflowRevision is the client for interacting with the WorkflowRevision builders.
+	WorkflowRevision *WorkflowRevisionClient
 
 	// lazily loaded.
 	client     *Client
@@ -157,11 +185,25 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.EventCounter = NewEventCounterClient(tx.config)
+	tx.InstanceHistory = NewInstanceHistoryClient(tx.config)
+	tx.JQModule = NewJQModuleClient(tx.config)
+	tx.JSONSchema = NewJSONSchemaClient(tx.config)
+	tx.LockLease = NewLockLeaseClient(tx.config)
 	tx.Namespace = NewNamespaceClient(tx.config)
+	tx.Node = NewNodeClient(tx.config)
+	tx.QueuedInvocation = NewQueuedInvocationClient(tx.config)
+	tx.QueuedState = NewQueuedStateClient(tx.config)
+	tx.ScheduledInvocation = NewScheduledInvocationClient(tx.config)
+	tx.Setting = NewSettingClient(tx.config)
+	tx.Timer = NewTimerClient(tx.config)
+	tx.UnmatchedEvent = NewUnmatchedEventClient(tx.config)
+	tx.WasmModule = NewWasmModuleClient(tx.config)
 	tx.Workflow = NewWorkflowClient(tx.config)
 	tx.WorkflowEvents = NewWorkflowEventsClient(tx.config)
 	tx.WorkflowEventsWait = NewWorkflowEventsWaitClient(tx.config)
 	tx.WorkflowInstance = NewWorkflowInstanceClient(tx.config)
+	tx.WorkflowRevision = NewWorkflowRevisionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -171,7 +213,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Namespace.QueryXXX(), the query will be executed
+// applies a query, for example: EventCounter.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,45 @@
+// Code generated by entc, DO NOT EDIT.
+
+package unmatchedevent
+
+const (
+	// Label holds the string label denoting the unmatchedevent type in the database.
+	Label = "unmatched_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldEventID holds the string denoting the event_id field in the database.
+	FieldEventID = "event_id"
+	// FieldReceived holds the string denoting the received field in the database.
+	FieldReceived = "received"
+	// FieldCloudevent holds the string denoting the cloudevent field in the database.
+	FieldCloudevent = "cloudevent"
+	// Table holds the table name of the unmatchedevent in the database.
+	Table = "unmatched_events"
+)
+
+// Columns holds all SQL columns for unmatchedevent fields.
+var Columns = []string{
+	FieldID,
+	FieldNamespace,
+	FieldType,
+	FieldSource,
+	FieldEventID,
+	FieldReceived,
+	FieldCloudevent,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,763 @@
+// Code generated by entc, DO NOT EDIT.
+
+package unmatchedevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNamespace), v))
+	})
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldType), v))
+	})
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEventID), v))
+	})
+}
+
+// Received applies equality check predicate on the "received" field. It's identical to ReceivedEQ.
+func Received(v time.Time) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReceived), v))
+	})
+}
+
+// Cloudevent applies equality check predicate on the "cloudevent" field. It's identical to CloudeventEQ.
+func Cloudevent(v []byte) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCloudevent), v))
+	})
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.UnmatchedEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNamespace), v...))
+	})
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.UnmatchedEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNamespace), v...))
+	})
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNamespace), v))
+	})
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNamespace), v))
+	})
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldType), v))
+	})
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldType), v))
+	})
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.UnmatchedEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldType), v...))
+	})
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.UnmatchedEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldType), v...))
+	})
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldType), v))
+	})
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldType), v))
+	})
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldType), v))
+	})
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldType), v))
+	})
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldType), v))
+	})
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldType), v))
+	})
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldType), v))
+	})
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldType), v))
+	})
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldType), v))
+	})
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.UnmatchedEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSource), v...))
+	})
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.UnmatchedEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSource), v...))
+	})
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSource), v))
+	})
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSource), v))
+	})
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSource), v))
+	})
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSource), v))
+	})
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSource), v))
+	})
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...string) predicate.UnmatchedEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEventID), v...))
+	})
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...string) predicate.UnmatchedEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEventID), v...))
+	})
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDContains applies the Contains predicate on the "event_id" field.
+func EventIDContains(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDHasPrefix applies the HasPrefix predicate on the "event_id" field.
+func EventIDHasPrefix(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDHasSuffix applies the HasSuffix predicate on the "event_id" field.
+func EventIDHasSuffix(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDEqualFold applies the EqualFold predicate on the "event_id" field.
+func EventIDEqualFold(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEventID), v))
+	})
+}
+
+// EventIDContainsFold applies the ContainsFold predicate on the "event_id" field.
+func EventIDContainsFold(v string) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEventID), v))
+	})
+}
+
+// ReceivedEQ applies the EQ predicate on the "received" field.
+func ReceivedEQ(v time.Time) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReceived), v))
+	})
+}
+
+// ReceivedNEQ applies the NEQ predicate on the "received" field.
+func ReceivedNEQ(v time.Time) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReceived), v))
+	})
+}
+
+// ReceivedIn applies the In predicate on the "received" field.
+func ReceivedIn(vs ...time.Time) predicate.UnmatchedEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReceived), v...))
+	})
+}
+
+// ReceivedNotIn applies the NotIn predicate on the "received" field.
+func ReceivedNotIn(vs ...time.Time) predicate.UnmatchedEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReceived), v...))
+	})
+}
+
+// ReceivedGT applies the GT predicate on the "received" field.
+func ReceivedGT(v time.Time) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReceived), v))
+	})
+}
+
+// ReceivedGTE applies the GTE predicate on the "received" field.
+func ReceivedGTE(v time.Time) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReceived), v))
+	})
+}
+
+// ReceivedLT applies the LT predicate on the "received" field.
+func ReceivedLT(v time.Time) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReceived), v))
+	})
+}
+
+// ReceivedLTE applies the LTE predicate on the "received" field.
+func ReceivedLTE(v time.Time) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReceived), v))
+	})
+}
+
+// CloudeventEQ applies the EQ predicate on the "cloudevent" field.
+func CloudeventEQ(v []byte) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCloudevent), v))
+	})
+}
+
+// CloudeventNEQ applies the NEQ predicate on the "cloudevent" field.
+func CloudeventNEQ(v []byte) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCloudevent), v))
+	})
+}
+
+// CloudeventIn applies the In predicate on the "cloudevent" field.
+func CloudeventIn(vs ...[]byte) predicate.UnmatchedEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCloudevent), v...))
+	})
+}
+
+// CloudeventNotIn applies the NotIn predicate on the "cloudevent" field.
+func CloudeventNotIn(vs ...[]byte) predicate.UnmatchedEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCloudevent), v...))
+	})
+}
+
+// CloudeventGT applies the GT predicate on the "cloudevent" field.
+func CloudeventGT(v []byte) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCloudevent), v))
+	})
+}
+
+// CloudeventGTE applies the GTE predicate on the "cloudevent" field.
+func CloudeventGTE(v []byte) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCloudevent), v))
+	})
+}
+
+// CloudeventLT applies the LT predicate on the "cloudevent" field.
+func CloudeventLT(v []byte) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCloudevent), v))
+	})
+}
+
+// CloudeventLTE applies the LTE predicate on the "cloudevent" field.
+func CloudeventLTE(v []byte) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCloudevent), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UnmatchedEvent) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UnmatchedEvent) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UnmatchedEvent) predicate.UnmatchedEvent {
+	return predicate.UnmatchedEvent(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
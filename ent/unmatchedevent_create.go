@@ -0,0 +1,270 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/unmatchedevent"
+)
+
+// UnmatchedEventCreate is the builder for creating a UnmatchedEvent entity.
+type UnmatchedEventCreate struct {
+	config
+	mutation *UnmatchedEventMutation
+	hooks    []Hook
+}
+
+// SetNamespace sets the "namespace" field.
+func (uec *UnmatchedEventCreate) SetNamespace(s string) *UnmatchedEventCreate {
+	uec.mutation.SetNamespace(s)
+	return uec
+}
+
+// SetType sets the "type" field.
+func (uec *UnmatchedEventCreate) SetType(s string) *UnmatchedEventCreate {
+	uec.mutation.SetType(s)
+	return uec
+}
+
+// SetSource sets the "source" field.
+func (uec *UnmatchedEventCreate) SetSource(s string) *UnmatchedEventCreate {
+	uec.mutation.SetSource(s)
+	return uec
+}
+
+// SetEventID sets the "event_id" field.
+func (uec *UnmatchedEventCreate) SetEventID(s string) *UnmatchedEventCreate {
+	uec.mutation.SetEventID(s)
+	return uec
+}
+
+// SetReceived sets the "received" field.
+func (uec *UnmatchedEventCreate) SetReceived(t time.Time) *UnmatchedEventCreate {
+	uec.mutation.SetReceived(t)
+	return uec
+}
+
+// SetCloudevent sets the "cloudevent" field.
+func (uec *UnmatchedEventCreate) SetCloudevent(b []byte) *UnmatchedEventCreate {
+	uec.mutation.SetCloudevent(b)
+	return uec
+}
+
+// Mutation returns the UnmatchedEventMutation object of the builder.
+func (uec *UnmatchedEventCreate) Mutation() *UnmatchedEventMutation {
+	return uec.mutation
+}
+
+// Save creates the UnmatchedEvent in the database.
+func (uec *UnmatchedEventCreate) Save(ctx context.Context) (*UnmatchedEvent, error) {
+	var (
+		err  error
+		node *UnmatchedEvent
+	)
+	if len(uec.hooks) == 0 {
+		if err = uec.check(); err != nil {
+			return nil, err
+		}
+		node, err = uec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UnmatchedEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = uec.check(); err != nil {
+				return nil, err
+			}
+			uec.mutation = mutation
+			node, err = uec.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(uec.hooks) - 1; i >= 0; i-- {
+			mut = uec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, uec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uec *UnmatchedEventCreate) SaveX(ctx context.Context) *UnmatchedEvent {
+	v, err := uec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uec *UnmatchedEventCreate) check() error {
+	if _, ok := uec.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New("ent: missing required field \"namespace\"")}
+	}
+	if _, ok := uec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New("ent: missing required field \"type\"")}
+	}
+	if _, ok := uec.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New("ent: missing required field \"source\"")}
+	}
+	if _, ok := uec.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New("ent: missing required field \"event_id\"")}
+	}
+	if _, ok := uec.mutation.Received(); !ok {
+		return &ValidationError{Name: "received", err: errors.New("ent: missing required field \"received\"")}
+	}
+	if _, ok := uec.mutation.Cloudevent(); !ok {
+		return &ValidationError{Name: "cloudevent", err: errors.New("ent: missing required field \"cloudevent\"")}
+	}
+	return nil
+}
+
+func (uec *UnmatchedEventCreate) sqlSave(ctx context.Context) (*UnmatchedEvent, error) {
+	_node, _spec := uec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uec.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (uec *UnmatchedEventCreate) createSpec() (*UnmatchedEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UnmatchedEvent{config: uec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: unmatchedevent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: unmatchedevent.FieldID,
+			},
+		}
+	)
+	if value, ok := uec.mutation.Namespace(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: unmatchedevent.FieldNamespace,
+		})
+		_node.Namespace = value
+	}
+	if value, ok := uec.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: unmatchedevent.FieldType,
+		})
+		_node.Type = value
+	}
+	if value, ok := uec.mutation.Source(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: unmatchedevent.FieldSource,
+		})
+		_node.Source = value
+	}
+	if value, ok := uec.mutation.EventID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: unmatchedevent.FieldEventID,
+		})
+		_node.EventID = value
+	}
+	if value, ok := uec.mutation.Received(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: unmatchedevent.FieldReceived,
+		})
+		_node.Received = value
+	}
+	if value, ok := uec.mutation.Cloudevent(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: unmatchedevent.FieldCloudevent,
+		})
+		_node.Cloudevent = value
+	}
+	return _node, _spec
+}
+
+// UnmatchedEventCreateBulk is the builder for creating many UnmatchedEvent entities in bulk.
+type UnmatchedEventCreateBulk struct {
+	config
+	builders []*UnmatchedEventCreate
+}
+
+// Save creates the UnmatchedEvent entities in the database.
+func (uecb *UnmatchedEventCreateBulk) Save(ctx context.Context) ([]*UnmatchedEvent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(uecb.builders))
+	nodes := make([]*UnmatchedEvent, len(uecb.builders))
+	mutators := make([]Mutator, len(uecb.builders))
+	for i := range uecb.builders {
+		func(i int, root context.Context) {
+			builder := uecb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UnmatchedEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uecb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uecb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uecb *UnmatchedEventCreateBulk) SaveX(ctx context.Context) []*UnmatchedEvent {
+	v, err := uecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
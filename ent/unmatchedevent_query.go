@@ -0,0 +1,904 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/unmatchedevent"
+)
+
+// UnmatchedEventQuery is the builder for querying UnmatchedEvent entities.
+type UnmatchedEventQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.UnmatchedEvent
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UnmatchedEventQuery builder.
+func (ueq *UnmatchedEventQuery) Where(ps ...predicate.UnmatchedEvent) *UnmatchedEventQuery {
+	ueq.predicates = append(ueq.predicates, ps...)
+	return ueq
+}
+
+// Limit adds a limit step to the query.
+func (ueq *UnmatchedEventQuery) Limit(limit int) *UnmatchedEventQuery {
+	ueq.limit = &limit
+	return ueq
+}
+
+// Offset adds an offset step to the query.
+func (ueq *UnmatchedEventQuery) Offset(offset int) *UnmatchedEventQuery {
+	ueq.offset = &offset
+	return ueq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ueq *UnmatchedEventQuery) Unique(unique bool) *UnmatchedEventQuery {
+	ueq.unique = &unique
+	return ueq
+}
+
+// Order adds an order step to the query.
+func (ueq *UnmatchedEventQuery) Order(o ...OrderFunc) *UnmatchedEventQuery {
+	ueq.order = append(ueq.order, o...)
+	return ueq
+}
+
+// First returns the first UnmatchedEvent entity from the query.
+// Returns a *NotFoundError when no UnmatchedEvent was found.
+func (ueq *UnmatchedEventQuery) First(ctx context.Context) (*UnmatchedEvent, error) {
+	nodes, err := ueq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{unmatchedevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ueq *UnmatchedEventQuery) FirstX(ctx context.Context) *UnmatchedEvent {
+	node, err := ueq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UnmatchedEvent ID from the query.
+// Returns a *NotFoundError when no UnmatchedEvent ID was found.
+func (ueq *UnmatchedEventQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ueq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{unmatchedevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ueq *UnmatchedEventQuery) FirstIDX(ctx context.Context) int {
+	id, err := ueq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UnmatchedEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one UnmatchedEvent entity is not found.
+// Returns a *NotFoundError when no UnmatchedEvent entities are found.
+func (ueq *UnmatchedEventQuery) Only(ctx context.Context) (*UnmatchedEvent, error) {
+	nodes, err := ueq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{unmatchedevent.Label}
+	default:
+		return nil, &NotSingularError{unmatchedevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ueq *UnmatchedEventQuery) OnlyX(ctx context.Context) *UnmatchedEvent {
+	node, err := ueq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UnmatchedEvent ID in the query.
+// Returns a *NotSingularError when exactly one UnmatchedEvent ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (ueq *UnmatchedEventQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ueq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{unmatchedevent.Label}
+	default:
+		err = &NotSingularError{unmatchedevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ueq *UnmatchedEventQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ueq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UnmatchedEvents.
+func (ueq *UnmatchedEventQuery) All(ctx context.Context) ([]*UnmatchedEvent, error) {
+	if err := ueq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ueq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ueq *UnmatchedEventQuery) AllX(ctx context.Context) []*UnmatchedEvent {
+	nodes, err := ueq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UnmatchedEvent IDs.
+func (ueq *UnmatchedEventQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := ueq.Select(unmatchedevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ueq *UnmatchedEventQuery) IDsX(ctx context.Context) []int {
+	ids, err := ueq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ueq *UnmatchedEventQuery) Count(ctx context.Context) (int, error) {
+	if err := ueq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ueq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ueq *UnmatchedEventQuery) CountX(ctx context.Context) int {
+	count, err := ueq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ueq *UnmatchedEventQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ueq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ueq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ueq *UnmatchedEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := ueq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UnmatchedEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ueq *UnmatchedEventQuery) Clone() *UnmatchedEventQuery {
+	if ueq == nil {
+		return nil
+	}
+	return &UnmatchedEventQuery{
+		config:     ueq.config,
+		limit:      ueq.limit,
+		offset:     ueq.offset,
+		order:      append([]OrderFunc{}, ueq.order...),
+		predicates: append([]predicate.UnmatchedEvent{}, ueq.predicates...),
+		// clone intermediate query.
+		sql:  ueq.sql.Clone(),
+		path: ueq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Namespace string `json:"namespace,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UnmatchedEvent.Query().
+//		GroupBy(unmatchedevent.FieldNamespace).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ueq *UnmatchedEventQuery) GroupBy(field string, fields ...string) *UnmatchedEventGroupBy {
+	group := &UnmatchedEventGroupBy{config: ueq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ueq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ueq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Namespace string `json:"namespace,omitempty"`
+//	}
+//
+//	client.UnmatchedEvent.Query().
+//		Select(unmatchedevent.FieldNamespace).
+//		Scan(ctx, &v)
+func (ueq *UnmatchedEventQuery) Select(field string, fields ...string) *UnmatchedEventSelect {
+	ueq.fields = append([]string{field}, fields...)
+	return &UnmatchedEventSelect{UnmatchedEventQuery: ueq}
+}
+
+func (ueq *UnmatchedEventQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ueq.fields {
+		if !unmatchedevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ueq.path != nil {
+		prev, err := ueq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ueq.sql = prev
+	}
+	return nil
+}
+
+func (ueq *UnmatchedEventQuery) sqlAll(ctx context.Context) ([]*UnmatchedEvent, error) {
+	var (
+		nodes = []*UnmatchedEvent{}
+		_spec = ueq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &UnmatchedEvent{config: ueq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ueq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ueq *UnmatchedEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ueq.querySpec()
+	return sqlgraph.CountNodes(ctx, ueq.driver, _spec)
+}
+
+func (ueq *UnmatchedEventQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ueq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ueq *UnmatchedEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   unmatchedevent.Table,
+			Columns: unmatchedevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: unmatchedevent.FieldID,
+			},
+		},
+		From:   ueq.sql,
+		Unique: true,
+	}
+	if unique := ueq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ueq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, unmatchedevent.FieldID)
+		for i := range fields {
+			if fields[i] != unmatchedevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ueq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ueq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ueq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ueq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ueq *UnmatchedEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ueq.driver.Dialect())
+	t1 := builder.Table(unmatchedevent.Table)
+	selector := builder.Select(t1.Columns(unmatchedevent.Columns...)...).From(t1)
+	if ueq.sql != nil {
+		selector = ueq.sql
+		selector.Select(selector.Columns(unmatchedevent.Columns...)...)
+	}
+	for _, p := range ueq.predicates {
+		p(selector)
+	}
+	for _, p := range ueq.order {
+		p(selector)
+	}
+	if offset := ueq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ueq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UnmatchedEventGroupBy is the group-by builder for UnmatchedEvent entities.
+type UnmatchedEventGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (uegb *UnmatchedEventGroupBy) Aggregate(fns ...AggregateFunc) *UnmatchedEventGroupBy {
+	uegb.fns = append(uegb.fns, fns...)
+	return uegb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (uegb *UnmatchedEventGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := uegb.path(ctx)
+	if err != nil {
+		return err
+	}
+	uegb.sql = query
+	return uegb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (uegb *UnmatchedEventGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := uegb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (uegb *UnmatchedEventGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(uegb.fields) > 1 {
+		return nil, errors.New("ent: UnmatchedEventGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := uegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (uegb *UnmatchedEventGroupBy) StringsX(ctx context.Context) []string {
+	v, err := uegb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (uegb *UnmatchedEventGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = uegb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{unmatchedevent.Label}
+	default:
+		err = fmt.Errorf("ent: UnmatchedEventGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (uegb *UnmatchedEventGroupBy) StringX(ctx context.Context) string {
+	v, err := uegb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (uegb *UnmatchedEventGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(uegb.fields) > 1 {
+		return nil, errors.New("ent: UnmatchedEventGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := uegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (uegb *UnmatchedEventGroupBy) IntsX(ctx context.Context) []int {
+	v, err := uegb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (uegb *UnmatchedEventGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = uegb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{unmatchedevent.Label}
+	default:
+		err = fmt.Errorf("ent: UnmatchedEventGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (uegb *UnmatchedEventGroupBy) IntX(ctx context.Context) int {
+	v, err := uegb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (uegb *UnmatchedEventGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(uegb.fields) > 1 {
+		return nil, errors.New("ent: UnmatchedEventGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := uegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (uegb *UnmatchedEventGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := uegb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (uegb *UnmatchedEventGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = uegb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{unmatchedevent.Label}
+	default:
+		err = fmt.Errorf("ent: UnmatchedEventGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (uegb *UnmatchedEventGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := uegb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (uegb *UnmatchedEventGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(uegb.fields) > 1 {
+		return nil, errors.New("ent: UnmatchedEventGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := uegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (uegb *UnmatchedEventGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := uegb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (uegb *UnmatchedEventGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = uegb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{unmatchedevent.Label}
+	default:
+		err = fmt.Errorf("ent: UnmatchedEventGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (uegb *UnmatchedEventGroupBy) BoolX(ctx context.Context) bool {
+	v, err := uegb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (uegb *UnmatchedEventGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range uegb.fields {
+		if !unmatchedevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := uegb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uegb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (uegb *UnmatchedEventGroupBy) sqlQuery() *sql.Selector {
+	selector := uegb.sql
+	columns := make([]string, 0, len(uegb.fields)+len(uegb.fns))
+	columns = append(columns, uegb.fields...)
+	for _, fn := range uegb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(uegb.fields...)
+}
+
+// UnmatchedEventSelect is the builder for selecting fields of UnmatchedEvent entities.
+type UnmatchedEventSelect struct {
+	*UnmatchedEventQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ues *UnmatchedEventSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ues.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ues.sql = ues.UnmatchedEventQuery.sqlQuery(ctx)
+	return ues.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ues *UnmatchedEventSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ues.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ues *UnmatchedEventSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ues.fields) > 1 {
+		return nil, errors.New("ent: UnmatchedEventSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ues.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ues *UnmatchedEventSelect) StringsX(ctx context.Context) []string {
+	v, err := ues.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ues *UnmatchedEventSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ues.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{unmatchedevent.Label}
+	default:
+		err = fmt.Errorf("ent: UnmatchedEventSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ues *UnmatchedEventSelect) StringX(ctx context.Context) string {
+	v, err := ues.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ues *UnmatchedEventSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ues.fields) > 1 {
+		return nil, errors.New("ent: UnmatchedEventSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ues.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ues *UnmatchedEventSelect) IntsX(ctx context.Context) []int {
+	v, err := ues.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ues *UnmatchedEventSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ues.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{unmatchedevent.Label}
+	default:
+		err = fmt.Errorf("ent: UnmatchedEventSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ues *UnmatchedEventSelect) IntX(ctx context.Context) int {
+	v, err := ues.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ues *UnmatchedEventSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ues.fields) > 1 {
+		return nil, errors.New("ent: UnmatchedEventSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ues.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ues *UnmatchedEventSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ues.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ues *UnmatchedEventSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ues.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{unmatchedevent.Label}
+	default:
+		err = fmt.Errorf("ent: UnmatchedEventSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ues *UnmatchedEventSelect) Float64X(ctx context.Context) float64 {
+	v, err := ues.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ues *UnmatchedEventSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ues.fields) > 1 {
+		return nil, errors.New("ent: UnmatchedEventSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ues.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ues *UnmatchedEventSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ues.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ues *UnmatchedEventSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ues.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{unmatchedevent.Label}
+	default:
+		err = fmt.Errorf("ent: UnmatchedEventSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ues *UnmatchedEventSelect) BoolX(ctx context.Context) bool {
+	v, err := ues.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ues *UnmatchedEventSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ues.sqlQuery().Query()
+	if err := ues.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ues *UnmatchedEventSelect) sqlQuery() sql.Querier {
+	selector := ues.sql
+	selector.Select(selector.Columns(ues.fields...)...)
+	return selector
+}
@@ -0,0 +1,389 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/unmatchedevent"
+)
+
+// UnmatchedEventUpdate is the builder for updating UnmatchedEvent entities.
+type UnmatchedEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UnmatchedEventMutation
+}
+
+// Where adds a new predicate for the UnmatchedEventUpdate builder.
+func (ueu *UnmatchedEventUpdate) Where(ps ...predicate.UnmatchedEvent) *UnmatchedEventUpdate {
+	ueu.mutation.predicates = append(ueu.mutation.predicates, ps...)
+	return ueu
+}
+
+// SetNamespace sets the "namespace" field.
+func (ueu *UnmatchedEventUpdate) SetNamespace(s string) *UnmatchedEventUpdate {
+	ueu.mutation.SetNamespace(s)
+	return ueu
+}
+
+// SetType sets the "type" field.
+func (ueu *UnmatchedEventUpdate) SetType(s string) *UnmatchedEventUpdate {
+	ueu.mutation.SetType(s)
+	return ueu
+}
+
+// SetSource sets the "source" field.
+func (ueu *UnmatchedEventUpdate) SetSource(s string) *UnmatchedEventUpdate {
+	ueu.mutation.SetSource(s)
+	return ueu
+}
+
+// SetEventID sets the "event_id" field.
+func (ueu *UnmatchedEventUpdate) SetEventID(s string) *UnmatchedEventUpdate {
+	ueu.mutation.SetEventID(s)
+	return ueu
+}
+
+// SetReceived sets the "received" field.
+func (ueu *UnmatchedEventUpdate) SetReceived(t time.Time) *UnmatchedEventUpdate {
+	ueu.mutation.SetReceived(t)
+	return ueu
+}
+
+// SetCloudevent sets the "cloudevent" field.
+func (ueu *UnmatchedEventUpdate) SetCloudevent(b []byte) *UnmatchedEventUpdate {
+	ueu.mutation.SetCloudevent(b)
+	return ueu
+}
+
+// Mutation returns the UnmatchedEventMutation object of the builder.
+func (ueu *UnmatchedEventUpdate) Mutation() *UnmatchedEventMutation {
+	return ueu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ueu *UnmatchedEventUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ueu.hooks) == 0 {
+		affected, err = ueu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UnmatchedEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ueu.mutation = mutation
+			affected, err = ueu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ueu.hooks) - 1; i >= 0; i-- {
+			mut = ueu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ueu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ueu *UnmatchedEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := ueu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ueu *UnmatchedEventUpdate) Exec(ctx context.Context) error {
+	_, err := ueu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ueu *UnmatchedEventUpdate) ExecX(ctx context.Context) {
+	if err := ueu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ueu *UnmatchedEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   unmatchedevent.Table,
+			Columns: unmatchedevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: unmatchedevent.FieldID,
+			},
+		},
+	}
+	if ps := ueu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ueu.mutation.Namespace(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: unmatchedevent.FieldNamespace,
+		})
+	}
+	if value, ok := ueu.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: unmatchedevent.FieldType,
+		})
+	}
+	if value, ok := ueu.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: unmatchedevent.FieldSource,
+		})
+	}
+	if value, ok := ueu.mutation.EventID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: unmatchedevent.FieldEventID,
+		})
+	}
+	if value, ok := ueu.mutation.Received(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: unmatchedevent.FieldReceived,
+		})
+	}
+	if value, ok := ueu.mutation.Cloudevent(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: unmatchedevent.FieldCloudevent,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ueu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{unmatchedevent.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// UnmatchedEventUpdateOne is the builder for updating a single UnmatchedEvent entity.
+type UnmatchedEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UnmatchedEventMutation
+}
+
+// SetNamespace sets the "namespace" field.
+func (ueuo *UnmatchedEventUpdateOne) SetNamespace(s string) *UnmatchedEventUpdateOne {
+	ueuo.mutation.SetNamespace(s)
+	return ueuo
+}
+
+// SetType sets the "type" field.
+func (ueuo *UnmatchedEventUpdateOne) SetType(s string) *UnmatchedEventUpdateOne {
+	ueuo.mutation.SetType(s)
+	return ueuo
+}
+
+// SetSource sets the "source" field.
+func (ueuo *UnmatchedEventUpdateOne) SetSource(s string) *UnmatchedEventUpdateOne {
+	ueuo.mutation.SetSource(s)
+	return ueuo
+}
+
+// SetEventID sets the "event_id" field.
+func (ueuo *UnmatchedEventUpdateOne) SetEventID(s string) *UnmatchedEventUpdateOne {
+	ueuo.mutation.SetEventID(s)
+	return ueuo
+}
+
+// SetReceived sets the "received" field.
+func (ueuo *UnmatchedEventUpdateOne) SetReceived(t time.Time) *UnmatchedEventUpdateOne {
+	ueuo.mutation.SetReceived(t)
+	return ueuo
+}
+
+// SetCloudevent sets the "cloudevent" field.
+func (ueuo *UnmatchedEventUpdateOne) SetCloudevent(b []byte) *UnmatchedEventUpdateOne {
+	ueuo.mutation.SetCloudevent(b)
+	return ueuo
+}
+
+// Mutation returns the UnmatchedEventMutation object of the builder.
+func (ueuo *UnmatchedEventUpdateOne) Mutation() *UnmatchedEventMutation {
+	return ueuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ueuo *UnmatchedEventUpdateOne) Select(field string, fields ...string) *UnmatchedEventUpdateOne {
+	ueuo.fields = append([]string{field}, fields...)
+	return ueuo
+}
+
+// Save executes the query and returns the updated UnmatchedEvent entity.
+func (ueuo *UnmatchedEventUpdateOne) Save(ctx context.Context) (*UnmatchedEvent, error) {
+	var (
+		err  error
+		node *UnmatchedEvent
+	)
+	if len(ueuo.hooks) == 0 {
+		node, err = ueuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UnmatchedEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ueuo.mutation = mutation
+			node, err = ueuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ueuo.hooks) - 1; i >= 0; i-- {
+			mut = ueuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ueuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ueuo *UnmatchedEventUpdateOne) SaveX(ctx context.Context) *UnmatchedEvent {
+	node, err := ueuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ueuo *UnmatchedEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := ueuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ueuo *UnmatchedEventUpdateOne) ExecX(ctx context.Context) {
+	if err := ueuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ueuo *UnmatchedEventUpdateOne) sqlSave(ctx context.Context) (_node *UnmatchedEvent, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   unmatchedevent.Table,
+			Columns: unmatchedevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: unmatchedevent.FieldID,
+			},
+		},
+	}
+	id, ok := ueuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing UnmatchedEvent.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ueuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, unmatchedevent.FieldID)
+		for _, f := range fields {
+			if !unmatchedevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != unmatchedevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ueuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ueuo.mutation.Namespace(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: unmatchedevent.FieldNamespace,
+		})
+	}
+	if value, ok := ueuo.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: unmatchedevent.FieldType,
+		})
+	}
+	if value, ok := ueuo.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: unmatchedevent.FieldSource,
+		})
+	}
+	if value, ok := ueuo.mutation.EventID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: unmatchedevent.FieldEventID,
+		})
+	}
+	if value, ok := ueuo.mutation.Received(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: unmatchedevent.FieldReceived,
+		})
+	}
+	if value, ok := ueuo.mutation.Cloudevent(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: unmatchedevent.FieldCloudevent,
+		})
+	}
+	_node = &UnmatchedEvent{config: ueuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ueuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{unmatchedevent.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,53 @@
+// Code generated by entc, DO NOT EDIT.
+
+package wasmmodule
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the wasmmodule type in the database.
+	Label = "wasm_module"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldBinary holds the string denoting the binary field in the database.
+	FieldBinary = "binary"
+	// FieldHash holds the string denoting the hash field in the database.
+	FieldHash = "hash"
+	// FieldUpdated holds the string denoting the updated field in the database.
+	FieldUpdated = "updated"
+	// Table holds the table name of the wasmmodule in the database.
+	Table = "wasm_modules"
+)
+
+// Columns holds all SQL columns for wasmmodule fields.
+var Columns = []string{
+	FieldID,
+	FieldNamespace,
+	FieldName,
+	FieldBinary,
+	FieldHash,
+	FieldUpdated,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUpdated holds the default value on creation for the "updated" field.
+	DefaultUpdated func() time.Time
+	// UpdateDefaultUpdated holds the default value on update for the "updated" field.
+	UpdateDefaultUpdated func() time.Time
+)
@@ -0,0 +1,271 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/wasmmodule"
+)
+
+// WasmModuleCreate is the builder for creating a WasmModule entity.
+type WasmModuleCreate struct {
+	config
+	mutation *WasmModuleMutation
+	hooks    []Hook
+}
+
+// SetNamespace sets the "namespace" field.
+func (wmc *WasmModuleCreate) SetNamespace(s string) *WasmModuleCreate {
+	wmc.mutation.SetNamespace(s)
+	return wmc
+}
+
+// SetName sets the "name" field.
+func (wmc *WasmModuleCreate) SetName(s string) *WasmModuleCreate {
+	wmc.mutation.SetName(s)
+	return wmc
+}
+
+// SetBinary sets the "binary" field.
+func (wmc *WasmModuleCreate) SetBinary(b []byte) *WasmModuleCreate {
+	wmc.mutation.SetBinary(b)
+	return wmc
+}
+
+// SetHash sets the "hash" field.
+func (wmc *WasmModuleCreate) SetHash(s string) *WasmModuleCreate {
+	wmc.mutation.SetHash(s)
+	return wmc
+}
+
+// SetUpdated sets the "updated" field.
+func (wmc *WasmModuleCreate) SetUpdated(t time.Time) *WasmModuleCreate {
+	wmc.mutation.SetUpdated(t)
+	return wmc
+}
+
+// SetNillableUpdated sets the "updated" field if the given value is not nil.
+func (wmc *WasmModuleCreate) SetNillableUpdated(t *time.Time) *WasmModuleCreate {
+	if t != nil {
+		wmc.SetUpdated(*t)
+	}
+	return wmc
+}
+
+// Mutation returns the WasmModuleMutation object of the builder.
+func (wmc *WasmModuleCreate) Mutation() *WasmModuleMutation {
+	return wmc.mutation
+}
+
+// Save creates the WasmModule in the database.
+func (wmc *WasmModuleCreate) Save(ctx context.Context) (*WasmModule, error) {
+	var (
+		err  error
+		node *WasmModule
+	)
+	wmc.defaults()
+	if len(wmc.hooks) == 0 {
+		if err = wmc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wmc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WasmModuleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wmc.check(); err != nil {
+				return nil, err
+			}
+			wmc.mutation = mutation
+			node, err = wmc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wmc.hooks) - 1; i >= 0; i-- {
+			mut = wmc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wmc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wmc *WasmModuleCreate) SaveX(ctx context.Context) *WasmModule {
+	v, err := wmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (wmc *WasmModuleCreate) defaults() {
+	if _, ok := wmc.mutation.Updated(); !ok {
+		v := wasmmodule.DefaultUpdated()
+		wmc.mutation.SetUpdated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wmc *WasmModuleCreate) check() error {
+	if _, ok := wmc.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New("ent: missing required field \"namespace\"")}
+	}
+	if _, ok := wmc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if _, ok := wmc.mutation.Binary(); !ok {
+		return &ValidationError{Name: "binary", err: errors.New("ent: missing required field \"binary\"")}
+	}
+	if _, ok := wmc.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New("ent: missing required field \"hash\"")}
+	}
+	if _, ok := wmc.mutation.Updated(); !ok {
+		return &ValidationError{Name: "updated", err: errors.New("ent: missing required field \"updated\"")}
+	}
+	return nil
+}
+
+func (wmc *WasmModuleCreate) sqlSave(ctx context.Context) (*WasmModule, error) {
+	_node, _spec := wmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wmc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (wmc *WasmModuleCreate) createSpec() (*WasmModule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WasmModule{config: wmc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: wasmmodule.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: wasmmodule.FieldID,
+			},
+		}
+	)
+	if value, ok := wmc.mutation.Namespace(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wasmmodule.FieldNamespace,
+		})
+		_node.Namespace = value
+	}
+	if value, ok := wmc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wasmmodule.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := wmc.mutation.Binary(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: wasmmodule.FieldBinary,
+		})
+		_node.Binary = value
+	}
+	if value, ok := wmc.mutation.Hash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wasmmodule.FieldHash,
+		})
+		_node.Hash = value
+	}
+	if value, ok := wmc.mutation.Updated(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: wasmmodule.FieldUpdated,
+		})
+		_node.Updated = value
+	}
+	return _node, _spec
+}
+
+// WasmModuleCreateBulk is the builder for creating many WasmModule entities in bulk.
+type WasmModuleCreateBulk struct {
+	config
+	builders []*WasmModuleCreate
+}
+
+// Save creates the WasmModule entities in the database.
+func (wmcb *WasmModuleCreateBulk) Save(ctx context.Context) ([]*WasmModule, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wmcb.builders))
+	nodes := make([]*WasmModule, len(wmcb.builders))
+	mutators := make([]Mutator, len(wmcb.builders))
+	for i := range wmcb.builders {
+		func(i int, root context.Context) {
+			builder := wmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WasmModuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wmcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wmcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wmcb *WasmModuleCreateBulk) SaveX(ctx context.Context) []*WasmModule {
+	v, err := wmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
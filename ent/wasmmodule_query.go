@@ -0,0 +1,904 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/wasmmodule"
+)
+
+// WasmModuleQuery is the builder for querying WasmModule entities.
+type WasmModuleQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.WasmModule
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WasmModuleQuery builder.
+func (wmq *WasmModuleQuery) Where(ps ...predicate.WasmModule) *WasmModuleQuery {
+	wmq.predicates = append(wmq.predicates, ps...)
+	return wmq
+}
+
+// Limit adds a limit step to the query.
+func (wmq *WasmModuleQuery) Limit(limit int) *WasmModuleQuery {
+	wmq.limit = &limit
+	return wmq
+}
+
+// Offset adds an offset step to the query.
+func (wmq *WasmModuleQuery) Offset(offset int) *WasmModuleQuery {
+	wmq.offset = &offset
+	return wmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wmq *WasmModuleQuery) Unique(unique bool) *WasmModuleQuery {
+	wmq.unique = &unique
+	return wmq
+}
+
+// Order adds an order step to the query.
+func (wmq *WasmModuleQuery) Order(o ...OrderFunc) *WasmModuleQuery {
+	wmq.order = append(wmq.order, o...)
+	return wmq
+}
+
+// First returns the first WasmModule entity from the query.
+// Returns a *NotFoundError when no WasmModule was found.
+func (wmq *WasmModuleQuery) First(ctx context.Context) (*WasmModule, error) {
+	nodes, err := wmq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{wasmmodule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wmq *WasmModuleQuery) FirstX(ctx context.Context) *WasmModule {
+	node, err := wmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WasmModule ID from the query.
+// Returns a *NotFoundError when no WasmModule ID was found.
+func (wmq *WasmModuleQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wmq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{wasmmodule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wmq *WasmModuleQuery) FirstIDX(ctx context.Context) int {
+	id, err := wmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WasmModule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one WasmModule entity is not found.
+// Returns a *NotFoundError when no WasmModule entities are found.
+func (wmq *WasmModuleQuery) Only(ctx context.Context) (*WasmModule, error) {
+	nodes, err := wmq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{wasmmodule.Label}
+	default:
+		return nil, &NotSingularError{wasmmodule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wmq *WasmModuleQuery) OnlyX(ctx context.Context) *WasmModule {
+	node, err := wmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WasmModule ID in the query.
+// Returns a *NotSingularError when exactly one WasmModule ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (wmq *WasmModuleQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wmq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{wasmmodule.Label}
+	default:
+		err = &NotSingularError{wasmmodule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wmq *WasmModuleQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WasmModules.
+func (wmq *WasmModuleQuery) All(ctx context.Context) ([]*WasmModule, error) {
+	if err := wmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wmq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wmq *WasmModuleQuery) AllX(ctx context.Context) []*WasmModule {
+	nodes, err := wmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WasmModule IDs.
+func (wmq *WasmModuleQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := wmq.Select(wasmmodule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wmq *WasmModuleQuery) IDsX(ctx context.Context) []int {
+	ids, err := wmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wmq *WasmModuleQuery) Count(ctx context.Context) (int, error) {
+	if err := wmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wmq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wmq *WasmModuleQuery) CountX(ctx context.Context) int {
+	count, err := wmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wmq *WasmModuleQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wmq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wmq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wmq *WasmModuleQuery) ExistX(ctx context.Context) bool {
+	exist, err := wmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WasmModuleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wmq *WasmModuleQuery) Clone() *WasmModuleQuery {
+	if wmq == nil {
+		return nil
+	}
+	return &WasmModuleQuery{
+		config:     wmq.config,
+		limit:      wmq.limit,
+		offset:     wmq.offset,
+		order:      append([]OrderFunc{}, wmq.order...),
+		predicates: append([]predicate.WasmModule{}, wmq.predicates...),
+		// clone intermediate query.
+		sql:  wmq.sql.Clone(),
+		path: wmq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Namespace string `json:"namespace,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WasmModule.Query().
+//		GroupBy(wasmmodule.FieldNamespace).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wmq *WasmModuleQuery) GroupBy(field string, fields ...string) *WasmModuleGroupBy {
+	group := &WasmModuleGroupBy{config: wmq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wmq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Namespace string `json:"namespace,omitempty"`
+//	}
+//
+//	client.WasmModule.Query().
+//		Select(wasmmodule.FieldNamespace).
+//		Scan(ctx, &v)
+func (wmq *WasmModuleQuery) Select(field string, fields ...string) *WasmModuleSelect {
+	wmq.fields = append([]string{field}, fields...)
+	return &WasmModuleSelect{WasmModuleQuery: wmq}
+}
+
+func (wmq *WasmModuleQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range wmq.fields {
+		if !wasmmodule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wmq.path != nil {
+		prev, err := wmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wmq.sql = prev
+	}
+	return nil
+}
+
+func (wmq *WasmModuleQuery) sqlAll(ctx context.Context) ([]*WasmModule, error) {
+	var (
+		nodes = []*WasmModule{}
+		_spec = wmq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &WasmModule{config: wmq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (wmq *WasmModuleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wmq.querySpec()
+	return sqlgraph.CountNodes(ctx, wmq.driver, _spec)
+}
+
+func (wmq *WasmModuleQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wmq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (wmq *WasmModuleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   wasmmodule.Table,
+			Columns: wasmmodule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: wasmmodule.FieldID,
+			},
+		},
+		From:   wmq.sql,
+		Unique: true,
+	}
+	if unique := wmq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := wmq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wasmmodule.FieldID)
+		for i := range fields {
+			if fields[i] != wasmmodule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wmq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wmq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wmq *WasmModuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wmq.driver.Dialect())
+	t1 := builder.Table(wasmmodule.Table)
+	selector := builder.Select(t1.Columns(wasmmodule.Columns...)...).From(t1)
+	if wmq.sql != nil {
+		selector = wmq.sql
+		selector.Select(selector.Columns(wasmmodule.Columns...)...)
+	}
+	for _, p := range wmq.predicates {
+		p(selector)
+	}
+	for _, p := range wmq.order {
+		p(selector)
+	}
+	if offset := wmq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wmq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WasmModuleGroupBy is the group-by builder for WasmModule entities.
+type WasmModuleGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wmgb *WasmModuleGroupBy) Aggregate(fns ...AggregateFunc) *WasmModuleGroupBy {
+	wmgb.fns = append(wmgb.fns, fns...)
+	return wmgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wmgb *WasmModuleGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wmgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wmgb.sql = query
+	return wmgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wmgb *WasmModuleGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := wmgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wmgb *WasmModuleGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(wmgb.fields) > 1 {
+		return nil, errors.New("ent: WasmModuleGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := wmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wmgb *WasmModuleGroupBy) StringsX(ctx context.Context) []string {
+	v, err := wmgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wmgb *WasmModuleGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wmgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{wasmmodule.Label}
+	default:
+		err = fmt.Errorf("ent: WasmModuleGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wmgb *WasmModuleGroupBy) StringX(ctx context.Context) string {
+	v, err := wmgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wmgb *WasmModuleGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(wmgb.fields) > 1 {
+		return nil, errors.New("ent: WasmModuleGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := wmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wmgb *WasmModuleGroupBy) IntsX(ctx context.Context) []int {
+	v, err := wmgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wmgb *WasmModuleGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wmgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{wasmmodule.Label}
+	default:
+		err = fmt.Errorf("ent: WasmModuleGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wmgb *WasmModuleGroupBy) IntX(ctx context.Context) int {
+	v, err := wmgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wmgb *WasmModuleGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wmgb.fields) > 1 {
+		return nil, errors.New("ent: WasmModuleGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := wmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wmgb *WasmModuleGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := wmgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wmgb *WasmModuleGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wmgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{wasmmodule.Label}
+	default:
+		err = fmt.Errorf("ent: WasmModuleGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wmgb *WasmModuleGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := wmgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wmgb *WasmModuleGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(wmgb.fields) > 1 {
+		return nil, errors.New("ent: WasmModuleGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := wmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wmgb *WasmModuleGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := wmgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wmgb *WasmModuleGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wmgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{wasmmodule.Label}
+	default:
+		err = fmt.Errorf("ent: WasmModuleGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wmgb *WasmModuleGroupBy) BoolX(ctx context.Context) bool {
+	v, err := wmgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wmgb *WasmModuleGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range wmgb.fields {
+		if !wasmmodule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wmgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wmgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wmgb *WasmModuleGroupBy) sqlQuery() *sql.Selector {
+	selector := wmgb.sql
+	columns := make([]string, 0, len(wmgb.fields)+len(wmgb.fns))
+	columns = append(columns, wmgb.fields...)
+	for _, fn := range wmgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(wmgb.fields...)
+}
+
+// WasmModuleSelect is the builder for selecting fields of WasmModule entities.
+type WasmModuleSelect struct {
+	*WasmModuleQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wms *WasmModuleSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := wms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wms.sql = wms.WasmModuleQuery.sqlQuery(ctx)
+	return wms.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wms *WasmModuleSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := wms.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (wms *WasmModuleSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(wms.fields) > 1 {
+		return nil, errors.New("ent: WasmModuleSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := wms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wms *WasmModuleSelect) StringsX(ctx context.Context) []string {
+	v, err := wms.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (wms *WasmModuleSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wms.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{wasmmodule.Label}
+	default:
+		err = fmt.Errorf("ent: WasmModuleSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wms *WasmModuleSelect) StringX(ctx context.Context) string {
+	v, err := wms.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (wms *WasmModuleSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(wms.fields) > 1 {
+		return nil, errors.New("ent: WasmModuleSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := wms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wms *WasmModuleSelect) IntsX(ctx context.Context) []int {
+	v, err := wms.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (wms *WasmModuleSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wms.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{wasmmodule.Label}
+	default:
+		err = fmt.Errorf("ent: WasmModuleSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wms *WasmModuleSelect) IntX(ctx context.Context) int {
+	v, err := wms.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (wms *WasmModuleSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wms.fields) > 1 {
+		return nil, errors.New("ent: WasmModuleSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := wms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wms *WasmModuleSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := wms.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (wms *WasmModuleSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wms.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{wasmmodule.Label}
+	default:
+		err = fmt.Errorf("ent: WasmModuleSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wms *WasmModuleSelect) Float64X(ctx context.Context) float64 {
+	v, err := wms.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (wms *WasmModuleSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(wms.fields) > 1 {
+		return nil, errors.New("ent: WasmModuleSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := wms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wms *WasmModuleSelect) BoolsX(ctx context.Context) []bool {
+	v, err := wms.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (wms *WasmModuleSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wms.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{wasmmodule.Label}
+	default:
+		err = fmt.Errorf("ent: WasmModuleSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wms *WasmModuleSelect) BoolX(ctx context.Context) bool {
+	v, err := wms.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wms *WasmModuleSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := wms.sqlQuery().Query()
+	if err := wms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wms *WasmModuleSelect) sqlQuery() sql.Querier {
+	selector := wms.sql
+	selector.Select(selector.Columns(wms.fields...)...)
+	return selector
+}
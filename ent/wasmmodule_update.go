@@ -0,0 +1,381 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/wasmmodule"
+)
+
+// WasmModuleUpdate is the builder for updating WasmModule entities.
+type WasmModuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WasmModuleMutation
+}
+
+// Where adds a new predicate for the WasmModuleUpdate builder.
+func (wmu *WasmModuleUpdate) Where(ps ...predicate.WasmModule) *WasmModuleUpdate {
+	wmu.mutation.predicates = append(wmu.mutation.predicates, ps...)
+	return wmu
+}
+
+// SetNamespace sets the "namespace" field.
+func (wmu *WasmModuleUpdate) SetNamespace(s string) *WasmModuleUpdate {
+	wmu.mutation.SetNamespace(s)
+	return wmu
+}
+
+// SetName sets the "name" field.
+func (wmu *WasmModuleUpdate) SetName(s string) *WasmModuleUpdate {
+	wmu.mutation.SetName(s)
+	return wmu
+}
+
+// SetBinary sets the "binary" field.
+func (wmu *WasmModuleUpdate) SetBinary(b []byte) *WasmModuleUpdate {
+	wmu.mutation.SetBinary(b)
+	return wmu
+}
+
+// SetHash sets the "hash" field.
+func (wmu *WasmModuleUpdate) SetHash(s string) *WasmModuleUpdate {
+	wmu.mutation.SetHash(s)
+	return wmu
+}
+
+// SetUpdated sets the "updated" field.
+func (wmu *WasmModuleUpdate) SetUpdated(t time.Time) *WasmModuleUpdate {
+	wmu.mutation.SetUpdated(t)
+	return wmu
+}
+
+// Mutation returns the WasmModuleMutation object of the builder.
+func (wmu *WasmModuleUpdate) Mutation() *WasmModuleMutation {
+	return wmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wmu *WasmModuleUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	wmu.defaults()
+	if len(wmu.hooks) == 0 {
+		affected, err = wmu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WasmModuleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wmu.mutation = mutation
+			affected, err = wmu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wmu.hooks) - 1; i >= 0; i-- {
+			mut = wmu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wmu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wmu *WasmModuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := wmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wmu *WasmModuleUpdate) Exec(ctx context.Context) error {
+	_, err := wmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wmu *WasmModuleUpdate) ExecX(ctx context.Context) {
+	if err := wmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wmu *WasmModuleUpdate) defaults() {
+	if _, ok := wmu.mutation.Updated(); !ok {
+		v := wasmmodule.UpdateDefaultUpdated()
+		wmu.mutation.SetUpdated(v)
+	}
+}
+
+func (wmu *WasmModuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   wasmmodule.Table,
+			Columns: wasmmodule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: wasmmodule.FieldID,
+			},
+		},
+	}
+	if ps := wmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wmu.mutation.Namespace(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wasmmodule.FieldNamespace,
+		})
+	}
+	if value, ok := wmu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wasmmodule.FieldName,
+		})
+	}
+	if value, ok := wmu.mutation.Binary(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: wasmmodule.FieldBinary,
+		})
+	}
+	if value, ok := wmu.mutation.Hash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wasmmodule.FieldHash,
+		})
+	}
+	if value, ok := wmu.mutation.Updated(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: wasmmodule.FieldUpdated,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wasmmodule.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WasmModuleUpdateOne is the builder for updating a single WasmModule entity.
+type WasmModuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WasmModuleMutation
+}
+
+// SetNamespace sets the "namespace" field.
+func (wmuo *WasmModuleUpdateOne) SetNamespace(s string) *WasmModuleUpdateOne {
+	wmuo.mutation.SetNamespace(s)
+	return wmuo
+}
+
+// SetName sets the "name" field.
+func (wmuo *WasmModuleUpdateOne) SetName(s string) *WasmModuleUpdateOne {
+	wmuo.mutation.SetName(s)
+	return wmuo
+}
+
+// SetBinary sets the "binary" field.
+func (wmuo *WasmModuleUpdateOne) SetBinary(b []byte) *WasmModuleUpdateOne {
+	wmuo.mutation.SetBinary(b)
+	return wmuo
+}
+
+// SetHash sets the "hash" field.
+func (wmuo *WasmModuleUpdateOne) SetHash(s string) *WasmModuleUpdateOne {
+	wmuo.mutation.SetHash(s)
+	return wmuo
+}
+
+// SetUpdated sets the "updated" field.
+func (wmuo *WasmModuleUpdateOne) SetUpdated(t time.Time) *WasmModuleUpdateOne {
+	wmuo.mutation.SetUpdated(t)
+	return wmuo
+}
+
+// Mutation returns the WasmModuleMutation object of the builder.
+func (wmuo *WasmModuleUpdateOne) Mutation() *WasmModuleMutation {
+	return wmuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wmuo *WasmModuleUpdateOne) Select(field string, fields ...string) *WasmModuleUpdateOne {
+	wmuo.fields = append([]string{field}, fields...)
+	return wmuo
+}
+
+// Save executes the query and returns the updated WasmModule entity.
+func (wmuo *WasmModuleUpdateOne) Save(ctx context.Context) (*WasmModule, error) {
+	var (
+		err  error
+		node *WasmModule
+	)
+	wmuo.defaults()
+	if len(wmuo.hooks) == 0 {
+		node, err = wmuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WasmModuleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wmuo.mutation = mutation
+			node, err = wmuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wmuo.hooks) - 1; i >= 0; i-- {
+			mut = wmuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wmuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wmuo *WasmModuleUpdateOne) SaveX(ctx context.Context) *WasmModule {
+	node, err := wmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wmuo *WasmModuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := wmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wmuo *WasmModuleUpdateOne) ExecX(ctx context.Context) {
+	if err := wmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wmuo *WasmModuleUpdateOne) defaults() {
+	if _, ok := wmuo.mutation.Updated(); !ok {
+		v := wasmmodule.UpdateDefaultUpdated()
+		wmuo.mutation.SetUpdated(v)
+	}
+}
+
+func (wmuo *WasmModuleUpdateOne) sqlSave(ctx context.Context) (_node *WasmModule, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   wasmmodule.Table,
+			Columns: wasmmodule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: wasmmodule.FieldID,
+			},
+		},
+	}
+	id, ok := wmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing WasmModule.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wasmmodule.FieldID)
+		for _, f := range fields {
+			if !wasmmodule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wasmmodule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wmuo.mutation.Namespace(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wasmmodule.FieldNamespace,
+		})
+	}
+	if value, ok := wmuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wasmmodule.FieldName,
+		})
+	}
+	if value, ok := wmuo.mutation.Binary(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: wasmmodule.FieldBinary,
+		})
+	}
+	if value, ok := wmuo.mutation.Hash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wasmmodule.FieldHash,
+		})
+	}
+	if value, ok := wmuo.mutation.Updated(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: wasmmodule.FieldUpdated,
+		})
+	}
+	_node = &WasmModule{config: wmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wasmmodule.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
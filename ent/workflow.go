@@ -46,9 +46,11 @@ type WorkflowEdges struct {
 	Instances []*WorkflowInstance `json:"instances,omitempty"`
 	// Wfevents holds the value of the wfevents edge.
 	Wfevents []*WorkflowEvents `json:"wfevents,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*WorkflowRevision `json:"revisions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // NamespaceOrErr returns the Namespace value or an error if the edge
@@ -83,6 +85,15 @@ func (e WorkflowEdges) WfeventsOrErr() ([]*WorkflowEvents, error) {
 	return nil, &NotLoadedError{edge: "wfevents"}
 }
 
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkflowEdges) RevisionsOrErr() ([]*WorkflowRevision, error) {
+	if e.loadedTypes[3] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Workflow) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
@@ -192,6 +203,11 @@ func (w *Workflow) QueryWfevents() *WorkflowEventsQuery {
 	return (&WorkflowClient{config: w.config}).QueryWfevents(w)
 }
 
+// QueryRevisions queries the "revisions" edge of the Workflow entity.
+func (w *Workflow) QueryRevisions() *WorkflowRevisionQuery {
+	return (&WorkflowClient{config: w.config}).QueryRevisions(w)
+}
+
 // Update returns a builder for updating this Workflow.
 // Note that you need to call Workflow.Unwrap() before calling this method if this Workflow
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -33,6 +33,8 @@ const (
 	EdgeInstances = "instances"
 	// EdgeWfevents holds the string denoting the wfevents edge name in mutations.
 	EdgeWfevents = "wfevents"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
 	// Table holds the table name of the workflow in the database.
 	Table = "workflows"
 	// NamespaceTable is the table the holds the namespace relation/edge.
@@ -56,6 +58,13 @@ const (
 	WfeventsInverseTable = "workflow_events"
 	// WfeventsColumn is the table column denoting the wfevents relation/edge.
 	WfeventsColumn = "workflow_wfevents"
+	// RevisionsTable is the table the holds the revisions relation/edge.
+	RevisionsTable = "workflow_revisions"
+	// RevisionsInverseTable is the table name for the WorkflowRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "workflowrevision" package.
+	RevisionsInverseTable = "workflow_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "workflow_revisions"
 )
 
 // Columns holds all SQL columns for workflow fields.
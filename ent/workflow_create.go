@@ -15,6 +15,7 @@ import (
 	"github.com/vorteil/direktiv/ent/workflow"
 	"github.com/vorteil/direktiv/ent/workflowevents"
 	"github.com/vorteil/direktiv/ent/workflowinstance"
+	"github.com/vorteil/direktiv/ent/workflowrevision"
 )
 
 // WorkflowCreate is the builder for creating a Workflow entity.
@@ -153,6 +154,21 @@ func (wc *WorkflowCreate) AddWfevents(w ...*WorkflowEvents) *WorkflowCreate {
 	return wc.AddWfeventIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the WorkflowRevision entity by IDs.
+func (wc *WorkflowCreate) AddRevisionIDs(ids ...int) *WorkflowCreate {
+	wc.mutation.AddRevisionIDs(ids...)
+	return wc
+}
+
+// AddRevisions adds the "revisions" edges to the WorkflowRevision entity.
+func (wc *WorkflowCreate) AddRevisions(w ...*WorkflowRevision) *WorkflowCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wc.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the WorkflowMutation object of the builder.
 func (wc *WorkflowCreate) Mutation() *WorkflowMutation {
 	return wc.mutation
@@ -400,6 +416,25 @@ func (wc *WorkflowCreate) createSpec() (*Workflow, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := wc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.RevisionsTable,
+			Columns: []string{workflow.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: workflowrevision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
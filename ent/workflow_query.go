@@ -18,6 +18,7 @@ import (
 	"github.com/vorteil/direktiv/ent/workflow"
 	"github.com/vorteil/direktiv/ent/workflowevents"
 	"github.com/vorteil/direktiv/ent/workflowinstance"
+	"github.com/vorteil/direktiv/ent/workflowrevision"
 )
 
 // WorkflowQuery is the builder for querying Workflow entities.
@@ -33,6 +34,7 @@ type WorkflowQuery struct {
 	withNamespace *NamespaceQuery
 	withInstances *WorkflowInstanceQuery
 	withWfevents  *WorkflowEventsQuery
+	withRevisions *WorkflowRevisionQuery
 	withFKs       bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -136,6 +138,28 @@ func (wq *WorkflowQuery) QueryWfevents() *WorkflowEventsQuery {
 	return query
 }
 
+// QueryRevisions chains the current query on the "revisions" edge.
+func (wq *WorkflowQuery) QueryRevisions() *WorkflowRevisionQuery {
+	query := &WorkflowRevisionQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflow.Table, workflow.FieldID, selector),
+			sqlgraph.To(workflowrevision.Table, workflowrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workflow.RevisionsTable, workflow.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Workflow entity from the query.
 // Returns a *NotFoundError when no Workflow was found.
 func (wq *WorkflowQuery) First(ctx context.Context) (*Workflow, error) {
@@ -320,6 +344,7 @@ func (wq *WorkflowQuery) Clone() *WorkflowQuery {
 		withNamespace: wq.withNamespace.Clone(),
 		withInstances: wq.withInstances.Clone(),
 		withWfevents:  wq.withWfevents.Clone(),
+		withRevisions: wq.withRevisions.Clone(),
 		// clone intermediate query.
 		sql:  wq.sql.Clone(),
 		path: wq.path,
@@ -359,6 +384,17 @@ func (wq *WorkflowQuery) WithWfevents(opts ...func(*WorkflowEventsQuery)) *Workf
 	return wq
 }
 
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WorkflowQuery) WithRevisions(opts ...func(*WorkflowRevisionQuery)) *WorkflowQuery {
+	query := &WorkflowRevisionQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withRevisions = query
+	return wq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -425,10 +461,11 @@ func (wq *WorkflowQuery) sqlAll(ctx context.Context) ([]*Workflow, error) {
 		nodes       = []*Workflow{}
 		withFKs     = wq.withFKs
 		_spec       = wq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			wq.withNamespace != nil,
 			wq.withInstances != nil,
 			wq.withWfevents != nil,
+			wq.withRevisions != nil,
 		}
 	)
 	if wq.withNamespace != nil {
@@ -544,6 +581,35 @@ func (wq *WorkflowQuery) sqlAll(ctx context.Context) ([]*Workflow, error) {
 		}
 	}
 
+	if query := wq.withRevisions; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*Workflow)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Revisions = []*WorkflowRevision{}
+		}
+		query.withFKs = true
+		query.Where(predicate.WorkflowRevision(func(s *sql.Selector) {
+			s.Where(sql.InValues(workflow.RevisionsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.workflow_revisions
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "workflow_revisions" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "workflow_revisions" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Revisions = append(node.Edges.Revisions, n)
+		}
+	}
+
 	return nodes, nil
 }
 
@@ -15,6 +15,7 @@ import (
 	"github.com/vorteil/direktiv/ent/workflow"
 	"github.com/vorteil/direktiv/ent/workflowevents"
 	"github.com/vorteil/direktiv/ent/workflowinstance"
+	"github.com/vorteil/direktiv/ent/workflowrevision"
 )
 
 // WorkflowUpdate is the builder for updating Workflow entities.
@@ -158,6 +159,21 @@ func (wu *WorkflowUpdate) AddWfevents(w ...*WorkflowEvents) *WorkflowUpdate {
 	return wu.AddWfeventIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the WorkflowRevision entity by IDs.
+func (wu *WorkflowUpdate) AddRevisionIDs(ids ...int) *WorkflowUpdate {
+	wu.mutation.AddRevisionIDs(ids...)
+	return wu
+}
+
+// AddRevisions adds the "revisions" edges to the WorkflowRevision entity.
+func (wu *WorkflowUpdate) AddRevisions(w ...*WorkflowRevision) *WorkflowUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the WorkflowMutation object of the builder.
 func (wu *WorkflowUpdate) Mutation() *WorkflowMutation {
 	return wu.mutation
@@ -211,6 +227,27 @@ func (wu *WorkflowUpdate) RemoveWfevents(w ...*WorkflowEvents) *WorkflowUpdate {
 	return wu.RemoveWfeventIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the WorkflowRevision entity.
+func (wu *WorkflowUpdate) ClearRevisions() *WorkflowUpdate {
+	wu.mutation.ClearRevisions()
+	return wu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to WorkflowRevision entities by IDs.
+func (wu *WorkflowUpdate) RemoveRevisionIDs(ids ...int) *WorkflowUpdate {
+	wu.mutation.RemoveRevisionIDs(ids...)
+	return wu
+}
+
+// RemoveRevisions removes "revisions" edges to WorkflowRevision entities.
+func (wu *WorkflowUpdate) RemoveRevisions(w ...*WorkflowRevision) *WorkflowUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.RemoveRevisionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (wu *WorkflowUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -508,6 +545,60 @@ func (wu *WorkflowUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if wu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.RevisionsTable,
+			Columns: []string{workflow.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: workflowrevision.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !wu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.RevisionsTable,
+			Columns: []string{workflow.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: workflowrevision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.RevisionsTable,
+			Columns: []string{workflow.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: workflowrevision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{workflow.Label}
@@ -655,6 +746,21 @@ func (wuo *WorkflowUpdateOne) AddWfevents(w ...*WorkflowEvents) *WorkflowUpdateO
 	return wuo.AddWfeventIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the WorkflowRevision entity by IDs.
+func (wuo *WorkflowUpdateOne) AddRevisionIDs(ids ...int) *WorkflowUpdateOne {
+	wuo.mutation.AddRevisionIDs(ids...)
+	return wuo
+}
+
+// AddRevisions adds the "revisions" edges to the WorkflowRevision entity.
+func (wuo *WorkflowUpdateOne) AddRevisions(w ...*WorkflowRevision) *WorkflowUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the WorkflowMutation object of the builder.
 func (wuo *WorkflowUpdateOne) Mutation() *WorkflowMutation {
 	return wuo.mutation
@@ -708,6 +814,27 @@ func (wuo *WorkflowUpdateOne) RemoveWfevents(w ...*WorkflowEvents) *WorkflowUpda
 	return wuo.RemoveWfeventIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the WorkflowRevision entity.
+func (wuo *WorkflowUpdateOne) ClearRevisions() *WorkflowUpdateOne {
+	wuo.mutation.ClearRevisions()
+	return wuo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to WorkflowRevision entities by IDs.
+func (wuo *WorkflowUpdateOne) RemoveRevisionIDs(ids ...int) *WorkflowUpdateOne {
+	wuo.mutation.RemoveRevisionIDs(ids...)
+	return wuo
+}
+
+// RemoveRevisions removes "revisions" edges to WorkflowRevision entities.
+func (wuo *WorkflowUpdateOne) RemoveRevisions(w ...*WorkflowRevision) *WorkflowUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.RemoveRevisionIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (wuo *WorkflowUpdateOne) Select(field string, fields ...string) *WorkflowUpdateOne {
@@ -1029,6 +1156,60 @@ func (wuo *WorkflowUpdateOne) sqlSave(ctx context.Context) (_node *Workflow, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if wuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.RevisionsTable,
+			Columns: []string{workflow.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: workflowrevision.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !wuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.RevisionsTable,
+			Columns: []string{workflow.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: workflowrevision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflow.RevisionsTable,
+			Columns: []string{workflow.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: workflowrevision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Workflow{config: wuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent/schema"
 	"github.com/vorteil/direktiv/ent/workflow"
 	"github.com/vorteil/direktiv/ent/workflowinstance"
 )
@@ -21,6 +22,8 @@ type WorkflowInstance struct {
 	ID int `json:"id,omitempty"`
 	// InstanceID holds the value of the "instanceID" field.
 	InstanceID string `json:"instanceID,omitempty"`
+	// ExternalID holds the value of the "externalID" field.
+	ExternalID *string `json:"externalID,omitempty"`
 	// InvokedBy holds the value of the "invokedBy" field.
 	InvokedBy string `json:"invokedBy,omitempty"`
 	// Status holds the value of the "status" field.
@@ -41,6 +44,8 @@ type WorkflowInstance struct {
 	StateData string `json:"stateData,omitempty"`
 	// Memory holds the value of the "memory" field.
 	Memory string `json:"memory,omitempty"`
+	// MemoryData holds the value of the "memoryData" field.
+	MemoryData []byte `json:"memoryData,omitempty"`
 	// Deadline holds the value of the "deadline" field.
 	Deadline time.Time `json:"deadline,omitempty"`
 	// Attempts holds the value of the "attempts" field.
@@ -49,10 +54,34 @@ type WorkflowInstance struct {
 	ErrorCode string `json:"errorCode,omitempty"`
 	// ErrorMessage holds the value of the "errorMessage" field.
 	ErrorMessage string `json:"errorMessage,omitempty"`
+	// ErrorData holds the value of the "errorData" field.
+	ErrorData string `json:"errorData,omitempty"`
+	// ErrorCauses holds the value of the "errorCauses" field.
+	ErrorCauses []schema.ErrorCause `json:"errorCauses,omitempty"`
+	// CancelCategory holds the value of the "cancelCategory" field.
+	CancelCategory string `json:"cancelCategory,omitempty"`
+	// CancelReason holds the value of the "cancelReason" field.
+	CancelReason string `json:"cancelReason,omitempty"`
+	// Progress holds the value of the "progress" field.
+	Progress string `json:"progress,omitempty"`
 	// StateBeginTime holds the value of the "stateBeginTime" field.
 	StateBeginTime time.Time `json:"stateBeginTime,omitempty"`
 	// Controller holds the value of the "controller" field.
 	Controller string `json:"controller,omitempty"`
+	// Handoff holds the value of the "handoff" field.
+	Handoff []byte `json:"handoff,omitempty"`
+	// Simulation holds the value of the "simulation" field.
+	Simulation []byte `json:"simulation,omitempty"`
+	// Debug holds the value of the "debug" field.
+	Debug []byte `json:"debug,omitempty"`
+	// Hold holds the value of the "hold" field.
+	Hold string `json:"hold,omitempty"`
+	// Profile holds the value of the "profile" field.
+	Profile string `json:"profile,omitempty"`
+	// ReceivedEvents holds the value of the "receivedEvents" field.
+	ReceivedEvents string `json:"receivedEvents,omitempty"`
+	// AwaitingCancels holds the value of the "awaitingCancels" field.
+	AwaitingCancels []byte `json:"awaitingCancels,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the WorkflowInstanceQuery when eager-loading is set.
 	Edges              WorkflowInstanceEdges `json:"edges"`
@@ -98,11 +127,11 @@ func (*WorkflowInstance) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case workflowinstance.FieldFlow:
+		case workflowinstance.FieldFlow, workflowinstance.FieldMemoryData, workflowinstance.FieldErrorCauses, workflowinstance.FieldHandoff, workflowinstance.FieldSimulation, workflowinstance.FieldDebug, workflowinstance.FieldAwaitingCancels:
 			values[i] = new([]byte)
 		case workflowinstance.FieldID, workflowinstance.FieldRevision, workflowinstance.FieldAttempts:
 			values[i] = new(sql.NullInt64)
-		case workflowinstance.FieldInstanceID, workflowinstance.FieldInvokedBy, workflowinstance.FieldStatus, workflowinstance.FieldInput, workflowinstance.FieldOutput, workflowinstance.FieldStateData, workflowinstance.FieldMemory, workflowinstance.FieldErrorCode, workflowinstance.FieldErrorMessage, workflowinstance.FieldController:
+		case workflowinstance.FieldInstanceID, workflowinstance.FieldExternalID, workflowinstance.FieldInvokedBy, workflowinstance.FieldStatus, workflowinstance.FieldInput, workflowinstance.FieldOutput, workflowinstance.FieldStateData, workflowinstance.FieldMemory, workflowinstance.FieldErrorCode, workflowinstance.FieldErrorMessage, workflowinstance.FieldErrorData, workflowinstance.FieldCancelCategory, workflowinstance.FieldCancelReason, workflowinstance.FieldProgress, workflowinstance.FieldController, workflowinstance.FieldHold, workflowinstance.FieldProfile, workflowinstance.FieldReceivedEvents:
 			values[i] = new(sql.NullString)
 		case workflowinstance.FieldBeginTime, workflowinstance.FieldEndTime, workflowinstance.FieldDeadline, workflowinstance.FieldStateBeginTime:
 			values[i] = new(sql.NullTime)
@@ -135,6 +164,13 @@ func (wi *WorkflowInstance) assignValues(columns []string, values []interface{})
 			} else if value.Valid {
 				wi.InstanceID = value.String
 			}
+		case workflowinstance.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field externalID", values[i])
+			} else if value.Valid {
+				wi.ExternalID = new(string)
+				*wi.ExternalID = value.String
+			}
 		case workflowinstance.FieldInvokedBy:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field invokedBy", values[i])
@@ -198,6 +234,12 @@ func (wi *WorkflowInstance) assignValues(columns []string, values []interface{})
 			} else if value.Valid {
 				wi.Memory = value.String
 			}
+		case workflowinstance.FieldMemoryData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field memoryData", values[i])
+			} else if value != nil {
+				wi.MemoryData = *value
+			}
 		case workflowinstance.FieldDeadline:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field deadline", values[i])
@@ -222,6 +264,39 @@ func (wi *WorkflowInstance) assignValues(columns []string, values []interface{})
 			} else if value.Valid {
 				wi.ErrorMessage = value.String
 			}
+		case workflowinstance.FieldErrorData:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field errorData", values[i])
+			} else if value.Valid {
+				wi.ErrorData = value.String
+			}
+		case workflowinstance.FieldErrorCauses:
+
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field errorCauses", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wi.ErrorCauses); err != nil {
+					return fmt.Errorf("unmarshal field errorCauses: %w", err)
+				}
+			}
+		case workflowinstance.FieldCancelCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelCategory", values[i])
+			} else if value.Valid {
+				wi.CancelCategory = value.String
+			}
+		case workflowinstance.FieldCancelReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelReason", values[i])
+			} else if value.Valid {
+				wi.CancelReason = value.String
+			}
+		case workflowinstance.FieldProgress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field progress", values[i])
+			} else if value.Valid {
+				wi.Progress = value.String
+			}
 		case workflowinstance.FieldStateBeginTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field stateBeginTime", values[i])
@@ -234,6 +309,48 @@ func (wi *WorkflowInstance) assignValues(columns []string, values []interface{})
 			} else if value.Valid {
 				wi.Controller = value.String
 			}
+		case workflowinstance.FieldHandoff:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field handoff", values[i])
+			} else if value != nil {
+				wi.Handoff = *value
+			}
+		case workflowinstance.FieldSimulation:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field simulation", values[i])
+			} else if value != nil {
+				wi.Simulation = *value
+			}
+		case workflowinstance.FieldDebug:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field debug", values[i])
+			} else if value != nil {
+				wi.Debug = *value
+			}
+		case workflowinstance.FieldHold:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hold", values[i])
+			} else if value.Valid {
+				wi.Hold = value.String
+			}
+		case workflowinstance.FieldProfile:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field profile", values[i])
+			} else if value.Valid {
+				wi.Profile = value.String
+			}
+		case workflowinstance.FieldReceivedEvents:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field receivedEvents", values[i])
+			} else if value.Valid {
+				wi.ReceivedEvents = value.String
+			}
+		case workflowinstance.FieldAwaitingCancels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field awaitingCancels", values[i])
+			} else if value != nil {
+				wi.AwaitingCancels = *value
+			}
 		case workflowinstance.ForeignKeys[0]:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field workflow_instances", values[i])
@@ -280,6 +397,10 @@ func (wi *WorkflowInstance) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v", wi.ID))
 	builder.WriteString(", instanceID=")
 	builder.WriteString(wi.InstanceID)
+	if v := wi.ExternalID; v != nil {
+		builder.WriteString(", externalID=")
+		builder.WriteString(*v)
+	}
 	builder.WriteString(", invokedBy=")
 	builder.WriteString(wi.InvokedBy)
 	builder.WriteString(", status=")
@@ -300,6 +421,8 @@ func (wi *WorkflowInstance) String() string {
 	builder.WriteString(wi.StateData)
 	builder.WriteString(", memory=")
 	builder.WriteString(wi.Memory)
+	builder.WriteString(", memoryData=")
+	builder.WriteString(fmt.Sprintf("%v", wi.MemoryData))
 	builder.WriteString(", deadline=")
 	builder.WriteString(wi.Deadline.Format(time.ANSIC))
 	builder.WriteString(", attempts=")
@@ -308,10 +431,34 @@ func (wi *WorkflowInstance) String() string {
 	builder.WriteString(wi.ErrorCode)
 	builder.WriteString(", errorMessage=")
 	builder.WriteString(wi.ErrorMessage)
+	builder.WriteString(", errorData=")
+	builder.WriteString(wi.ErrorData)
+	builder.WriteString(", errorCauses=")
+	builder.WriteString(fmt.Sprintf("%v", wi.ErrorCauses))
+	builder.WriteString(", cancelCategory=")
+	builder.WriteString(wi.CancelCategory)
+	builder.WriteString(", cancelReason=")
+	builder.WriteString(wi.CancelReason)
+	builder.WriteString(", progress=")
+	builder.WriteString(wi.Progress)
 	builder.WriteString(", stateBeginTime=")
 	builder.WriteString(wi.StateBeginTime.Format(time.ANSIC))
 	builder.WriteString(", controller=")
 	builder.WriteString(wi.Controller)
+	builder.WriteString(", handoff=")
+	builder.WriteString(fmt.Sprintf("%v", wi.Handoff))
+	builder.WriteString(", simulation=")
+	builder.WriteString(fmt.Sprintf("%v", wi.Simulation))
+	builder.WriteString(", debug=")
+	builder.WriteString(fmt.Sprintf("%v", wi.Debug))
+	builder.WriteString(", hold=")
+	builder.WriteString(wi.Hold)
+	builder.WriteString(", profile=")
+	builder.WriteString(wi.Profile)
+	builder.WriteString(", receivedEvents=")
+	builder.WriteString(wi.ReceivedEvents)
+	builder.WriteString(", awaitingCancels=")
+	builder.WriteString(fmt.Sprintf("%v", wi.AwaitingCancels))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -100,6 +100,13 @@ func InstanceID(v string) predicate.WorkflowInstance {
 	})
 }
 
+// ExternalID applies equality check predicate on the "externalID" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExternalID), v))
+	})
+}
+
 // InvokedBy applies equality check predicate on the "invokedBy" field. It's identical to InvokedByEQ.
 func InvokedBy(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
@@ -163,6 +170,13 @@ func Memory(v string) predicate.WorkflowInstance {
 	})
 }
 
+// MemoryData applies equality check predicate on the "memoryData" field. It's identical to MemoryDataEQ.
+func MemoryData(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMemoryData), v))
+	})
+}
+
 // Deadline applies equality check predicate on the "deadline" field. It's identical to DeadlineEQ.
 func Deadline(v time.Time) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
@@ -191,6 +205,34 @@ func ErrorMessage(v string) predicate.WorkflowInstance {
 	})
 }
 
+// ErrorData applies equality check predicate on the "errorData" field. It's identical to ErrorDataEQ.
+func ErrorData(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldErrorData), v))
+	})
+}
+
+// CancelCategory applies equality check predicate on the "cancelCategory" field. It's identical to CancelCategoryEQ.
+func CancelCategory(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCancelCategory), v))
+	})
+}
+
+// CancelReason applies equality check predicate on the "cancelReason" field. It's identical to CancelReasonEQ.
+func CancelReason(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCancelReason), v))
+	})
+}
+
+// Progress applies equality check predicate on the "progress" field. It's identical to ProgressEQ.
+func Progress(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProgress), v))
+	})
+}
+
 // StateBeginTime applies equality check predicate on the "stateBeginTime" field. It's identical to StateBeginTimeEQ.
 func StateBeginTime(v time.Time) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
@@ -205,6 +247,55 @@ func Controller(v string) predicate.WorkflowInstance {
 	})
 }
 
+// Handoff applies equality check predicate on the "handoff" field. It's identical to HandoffEQ.
+func Handoff(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHandoff), v))
+	})
+}
+
+// Simulation applies equality check predicate on the "simulation" field. It's identical to SimulationEQ.
+func Simulation(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSimulation), v))
+	})
+}
+
+// Debug applies equality check predicate on the "debug" field. It's identical to DebugEQ.
+func Debug(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDebug), v))
+	})
+}
+
+// Hold applies equality check predicate on the "hold" field. It's identical to HoldEQ.
+func Hold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHold), v))
+	})
+}
+
+// Profile applies equality check predicate on the "profile" field. It's identical to ProfileEQ.
+func Profile(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProfile), v))
+	})
+}
+
+// ReceivedEvents applies equality check predicate on the "receivedEvents" field. It's identical to ReceivedEventsEQ.
+func ReceivedEvents(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReceivedEvents), v))
+	})
+}
+
+// AwaitingCancels applies equality check predicate on the "awaitingCancels" field. It's identical to AwaitingCancelsEQ.
+func AwaitingCancels(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAwaitingCancels), v))
+	})
+}
+
 // InstanceIDEQ applies the EQ predicate on the "instanceID" field.
 func InstanceIDEQ(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
@@ -316,6 +407,131 @@ func InstanceIDContainsFold(v string) predicate.WorkflowInstance {
 	})
 }
 
+// ExternalIDEQ applies the EQ predicate on the "externalID" field.
+func ExternalIDEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "externalID" field.
+func ExternalIDNEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDIn applies the In predicate on the "externalID" field.
+func ExternalIDIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExternalID), v...))
+	})
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "externalID" field.
+func ExternalIDNotIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExternalID), v...))
+	})
+}
+
+// ExternalIDGT applies the GT predicate on the "externalID" field.
+func ExternalIDGT(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDGTE applies the GTE predicate on the "externalID" field.
+func ExternalIDGTE(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDLT applies the LT predicate on the "externalID" field.
+func ExternalIDLT(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDLTE applies the LTE predicate on the "externalID" field.
+func ExternalIDLTE(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDContains applies the Contains predicate on the "externalID" field.
+func ExternalIDContains(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "externalID" field.
+func ExternalIDHasPrefix(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "externalID" field.
+func ExternalIDHasSuffix(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDIsNil applies the IsNil predicate on the "externalID" field.
+func ExternalIDIsNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExternalID)))
+	})
+}
+
+// ExternalIDNotNil applies the NotNil predicate on the "externalID" field.
+func ExternalIDNotNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExternalID)))
+	})
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "externalID" field.
+func ExternalIDEqualFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldExternalID), v))
+	})
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "externalID" field.
+func ExternalIDContainsFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldExternalID), v))
+	})
+}
+
 // InvokedByEQ applies the EQ predicate on the "invokedBy" field.
 func InvokedByEQ(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
@@ -1280,6 +1496,96 @@ func MemoryContainsFold(v string) predicate.WorkflowInstance {
 	})
 }
 
+// MemoryDataEQ applies the EQ predicate on the "memoryData" field.
+func MemoryDataEQ(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMemoryData), v))
+	})
+}
+
+// MemoryDataNEQ applies the NEQ predicate on the "memoryData" field.
+func MemoryDataNEQ(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMemoryData), v))
+	})
+}
+
+// MemoryDataIn applies the In predicate on the "memoryData" field.
+func MemoryDataIn(vs ...[]byte) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMemoryData), v...))
+	})
+}
+
+// MemoryDataNotIn applies the NotIn predicate on the "memoryData" field.
+func MemoryDataNotIn(vs ...[]byte) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMemoryData), v...))
+	})
+}
+
+// MemoryDataGT applies the GT predicate on the "memoryData" field.
+func MemoryDataGT(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMemoryData), v))
+	})
+}
+
+// MemoryDataGTE applies the GTE predicate on the "memoryData" field.
+func MemoryDataGTE(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMemoryData), v))
+	})
+}
+
+// MemoryDataLT applies the LT predicate on the "memoryData" field.
+func MemoryDataLT(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMemoryData), v))
+	})
+}
+
+// MemoryDataLTE applies the LTE predicate on the "memoryData" field.
+func MemoryDataLTE(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMemoryData), v))
+	})
+}
+
+// MemoryDataIsNil applies the IsNil predicate on the "memoryData" field.
+func MemoryDataIsNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMemoryData)))
+	})
+}
+
+// MemoryDataNotNil applies the NotNil predicate on the "memoryData" field.
+func MemoryDataNotNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMemoryData)))
+	})
+}
+
 // DeadlineEQ applies the EQ predicate on the "deadline" field.
 func DeadlineEQ(v time.Time) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
@@ -1710,22 +2016,22 @@ func ErrorMessageContainsFold(v string) predicate.WorkflowInstance {
 	})
 }
 
-// StateBeginTimeEQ applies the EQ predicate on the "stateBeginTime" field.
-func StateBeginTimeEQ(v time.Time) predicate.WorkflowInstance {
+// ErrorDataEQ applies the EQ predicate on the "errorData" field.
+func ErrorDataEQ(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldStateBeginTime), v))
+		s.Where(sql.EQ(s.C(FieldErrorData), v))
 	})
 }
 
-// StateBeginTimeNEQ applies the NEQ predicate on the "stateBeginTime" field.
-func StateBeginTimeNEQ(v time.Time) predicate.WorkflowInstance {
+// ErrorDataNEQ applies the NEQ predicate on the "errorData" field.
+func ErrorDataNEQ(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldStateBeginTime), v))
+		s.Where(sql.NEQ(s.C(FieldErrorData), v))
 	})
 }
 
-// StateBeginTimeIn applies the In predicate on the "stateBeginTime" field.
-func StateBeginTimeIn(vs ...time.Time) predicate.WorkflowInstance {
+// ErrorDataIn applies the In predicate on the "errorData" field.
+func ErrorDataIn(vs ...string) predicate.WorkflowInstance {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -1737,12 +2043,12 @@ func StateBeginTimeIn(vs ...time.Time) predicate.WorkflowInstance {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.In(s.C(FieldStateBeginTime), v...))
+		s.Where(sql.In(s.C(FieldErrorData), v...))
 	})
 }
 
-// StateBeginTimeNotIn applies the NotIn predicate on the "stateBeginTime" field.
-func StateBeginTimeNotIn(vs ...time.Time) predicate.WorkflowInstance {
+// ErrorDataNotIn applies the NotIn predicate on the "errorData" field.
+func ErrorDataNotIn(vs ...string) predicate.WorkflowInstance {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -1754,174 +2060,1423 @@ func StateBeginTimeNotIn(vs ...time.Time) predicate.WorkflowInstance {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.NotIn(s.C(FieldStateBeginTime), v...))
+		s.Where(sql.NotIn(s.C(FieldErrorData), v...))
 	})
 }
 
-// StateBeginTimeGT applies the GT predicate on the "stateBeginTime" field.
-func StateBeginTimeGT(v time.Time) predicate.WorkflowInstance {
+// ErrorDataGT applies the GT predicate on the "errorData" field.
+func ErrorDataGT(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.GT(s.C(FieldStateBeginTime), v))
+		s.Where(sql.GT(s.C(FieldErrorData), v))
 	})
 }
 
-// StateBeginTimeGTE applies the GTE predicate on the "stateBeginTime" field.
-func StateBeginTimeGTE(v time.Time) predicate.WorkflowInstance {
+// ErrorDataGTE applies the GTE predicate on the "errorData" field.
+func ErrorDataGTE(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.GTE(s.C(FieldStateBeginTime), v))
+		s.Where(sql.GTE(s.C(FieldErrorData), v))
 	})
 }
 
-// StateBeginTimeLT applies the LT predicate on the "stateBeginTime" field.
-func StateBeginTimeLT(v time.Time) predicate.WorkflowInstance {
+// ErrorDataLT applies the LT predicate on the "errorData" field.
+func ErrorDataLT(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.LT(s.C(FieldStateBeginTime), v))
+		s.Where(sql.LT(s.C(FieldErrorData), v))
 	})
 }
 
-// StateBeginTimeLTE applies the LTE predicate on the "stateBeginTime" field.
-func StateBeginTimeLTE(v time.Time) predicate.WorkflowInstance {
+// ErrorDataLTE applies the LTE predicate on the "errorData" field.
+func ErrorDataLTE(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.LTE(s.C(FieldStateBeginTime), v))
+		s.Where(sql.LTE(s.C(FieldErrorData), v))
 	})
 }
 
-// StateBeginTimeIsNil applies the IsNil predicate on the "stateBeginTime" field.
-func StateBeginTimeIsNil() predicate.WorkflowInstance {
+// ErrorDataContains applies the Contains predicate on the "errorData" field.
+func ErrorDataContains(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.IsNull(s.C(FieldStateBeginTime)))
+		s.Where(sql.Contains(s.C(FieldErrorData), v))
 	})
 }
 
-// StateBeginTimeNotNil applies the NotNil predicate on the "stateBeginTime" field.
-func StateBeginTimeNotNil() predicate.WorkflowInstance {
+// ErrorDataHasPrefix applies the HasPrefix predicate on the "errorData" field.
+func ErrorDataHasPrefix(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.NotNull(s.C(FieldStateBeginTime)))
+		s.Where(sql.HasPrefix(s.C(FieldErrorData), v))
 	})
 }
 
-// ControllerEQ applies the EQ predicate on the "controller" field.
-func ControllerEQ(v string) predicate.WorkflowInstance {
+// ErrorDataHasSuffix applies the HasSuffix predicate on the "errorData" field.
+func ErrorDataHasSuffix(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldController), v))
+		s.Where(sql.HasSuffix(s.C(FieldErrorData), v))
 	})
 }
 
-// ControllerNEQ applies the NEQ predicate on the "controller" field.
-func ControllerNEQ(v string) predicate.WorkflowInstance {
+// ErrorDataIsNil applies the IsNil predicate on the "errorData" field.
+func ErrorDataIsNil() predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldController), v))
+		s.Where(sql.IsNull(s.C(FieldErrorData)))
 	})
 }
 
-// ControllerIn applies the In predicate on the "controller" field.
-func ControllerIn(vs ...string) predicate.WorkflowInstance {
-	v := make([]interface{}, len(vs))
-	for i := range v {
-		v[i] = vs[i]
-	}
+// ErrorDataNotNil applies the NotNil predicate on the "errorData" field.
+func ErrorDataNotNil() predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		// if not arguments were provided, append the FALSE constants,
-		// since we can't apply "IN ()". This will make this predicate falsy.
-		if len(v) == 0 {
-			s.Where(sql.False())
-			return
-		}
-		s.Where(sql.In(s.C(FieldController), v...))
+		s.Where(sql.NotNull(s.C(FieldErrorData)))
 	})
 }
 
-// ControllerNotIn applies the NotIn predicate on the "controller" field.
-func ControllerNotIn(vs ...string) predicate.WorkflowInstance {
-	v := make([]interface{}, len(vs))
-	for i := range v {
-		v[i] = vs[i]
-	}
+// ErrorDataEqualFold applies the EqualFold predicate on the "errorData" field.
+func ErrorDataEqualFold(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		// if not arguments were provided, append the FALSE constants,
-		// since we can't apply "IN ()". This will make this predicate falsy.
-		if len(v) == 0 {
-			s.Where(sql.False())
-			return
-		}
-		s.Where(sql.NotIn(s.C(FieldController), v...))
+		s.Where(sql.EqualFold(s.C(FieldErrorData), v))
 	})
 }
 
-// ControllerGT applies the GT predicate on the "controller" field.
-func ControllerGT(v string) predicate.WorkflowInstance {
+// ErrorDataContainsFold applies the ContainsFold predicate on the "errorData" field.
+func ErrorDataContainsFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldErrorData), v))
+	})
+}
+
+// ErrorCausesIsNil applies the IsNil predicate on the "errorCauses" field.
+func ErrorCausesIsNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldErrorCauses)))
+	})
+}
+
+// ErrorCausesNotNil applies the NotNil predicate on the "errorCauses" field.
+func ErrorCausesNotNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldErrorCauses)))
+	})
+}
+
+// CancelCategoryEQ applies the EQ predicate on the "cancelCategory" field.
+func CancelCategoryEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCancelCategory), v))
+	})
+}
+
+// CancelCategoryNEQ applies the NEQ predicate on the "cancelCategory" field.
+func CancelCategoryNEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCancelCategory), v))
+	})
+}
+
+// CancelCategoryIn applies the In predicate on the "cancelCategory" field.
+func CancelCategoryIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCancelCategory), v...))
+	})
+}
+
+// CancelCategoryNotIn applies the NotIn predicate on the "cancelCategory" field.
+func CancelCategoryNotIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCancelCategory), v...))
+	})
+}
+
+// CancelCategoryGT applies the GT predicate on the "cancelCategory" field.
+func CancelCategoryGT(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCancelCategory), v))
+	})
+}
+
+// CancelCategoryGTE applies the GTE predicate on the "cancelCategory" field.
+func CancelCategoryGTE(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCancelCategory), v))
+	})
+}
+
+// CancelCategoryLT applies the LT predicate on the "cancelCategory" field.
+func CancelCategoryLT(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCancelCategory), v))
+	})
+}
+
+// CancelCategoryLTE applies the LTE predicate on the "cancelCategory" field.
+func CancelCategoryLTE(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCancelCategory), v))
+	})
+}
+
+// CancelCategoryContains applies the Contains predicate on the "cancelCategory" field.
+func CancelCategoryContains(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCancelCategory), v))
+	})
+}
+
+// CancelCategoryHasPrefix applies the HasPrefix predicate on the "cancelCategory" field.
+func CancelCategoryHasPrefix(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCancelCategory), v))
+	})
+}
+
+// CancelCategoryHasSuffix applies the HasSuffix predicate on the "cancelCategory" field.
+func CancelCategoryHasSuffix(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCancelCategory), v))
+	})
+}
+
+// CancelCategoryIsNil applies the IsNil predicate on the "cancelCategory" field.
+func CancelCategoryIsNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCancelCategory)))
+	})
+}
+
+// CancelCategoryNotNil applies the NotNil predicate on the "cancelCategory" field.
+func CancelCategoryNotNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCancelCategory)))
+	})
+}
+
+// CancelCategoryEqualFold applies the EqualFold predicate on the "cancelCategory" field.
+func CancelCategoryEqualFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCancelCategory), v))
+	})
+}
+
+// CancelCategoryContainsFold applies the ContainsFold predicate on the "cancelCategory" field.
+func CancelCategoryContainsFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCancelCategory), v))
+	})
+}
+
+// CancelReasonEQ applies the EQ predicate on the "cancelReason" field.
+func CancelReasonEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCancelReason), v))
+	})
+}
+
+// CancelReasonNEQ applies the NEQ predicate on the "cancelReason" field.
+func CancelReasonNEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCancelReason), v))
+	})
+}
+
+// CancelReasonIn applies the In predicate on the "cancelReason" field.
+func CancelReasonIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCancelReason), v...))
+	})
+}
+
+// CancelReasonNotIn applies the NotIn predicate on the "cancelReason" field.
+func CancelReasonNotIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCancelReason), v...))
+	})
+}
+
+// CancelReasonGT applies the GT predicate on the "cancelReason" field.
+func CancelReasonGT(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCancelReason), v))
+	})
+}
+
+// CancelReasonGTE applies the GTE predicate on the "cancelReason" field.
+func CancelReasonGTE(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCancelReason), v))
+	})
+}
+
+// CancelReasonLT applies the LT predicate on the "cancelReason" field.
+func CancelReasonLT(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCancelReason), v))
+	})
+}
+
+// CancelReasonLTE applies the LTE predicate on the "cancelReason" field.
+func CancelReasonLTE(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCancelReason), v))
+	})
+}
+
+// CancelReasonContains applies the Contains predicate on the "cancelReason" field.
+func CancelReasonContains(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCancelReason), v))
+	})
+}
+
+// CancelReasonHasPrefix applies the HasPrefix predicate on the "cancelReason" field.
+func CancelReasonHasPrefix(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCancelReason), v))
+	})
+}
+
+// CancelReasonHasSuffix applies the HasSuffix predicate on the "cancelReason" field.
+func CancelReasonHasSuffix(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCancelReason), v))
+	})
+}
+
+// CancelReasonIsNil applies the IsNil predicate on the "cancelReason" field.
+func CancelReasonIsNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCancelReason)))
+	})
+}
+
+// CancelReasonNotNil applies the NotNil predicate on the "cancelReason" field.
+func CancelReasonNotNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCancelReason)))
+	})
+}
+
+// CancelReasonEqualFold applies the EqualFold predicate on the "cancelReason" field.
+func CancelReasonEqualFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCancelReason), v))
+	})
+}
+
+// CancelReasonContainsFold applies the ContainsFold predicate on the "cancelReason" field.
+func CancelReasonContainsFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCancelReason), v))
+	})
+}
+
+// ProgressEQ applies the EQ predicate on the "progress" field.
+func ProgressEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProgress), v))
+	})
+}
+
+// ProgressNEQ applies the NEQ predicate on the "progress" field.
+func ProgressNEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProgress), v))
+	})
+}
+
+// ProgressIn applies the In predicate on the "progress" field.
+func ProgressIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProgress), v...))
+	})
+}
+
+// ProgressNotIn applies the NotIn predicate on the "progress" field.
+func ProgressNotIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProgress), v...))
+	})
+}
+
+// ProgressGT applies the GT predicate on the "progress" field.
+func ProgressGT(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProgress), v))
+	})
+}
+
+// ProgressGTE applies the GTE predicate on the "progress" field.
+func ProgressGTE(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProgress), v))
+	})
+}
+
+// ProgressLT applies the LT predicate on the "progress" field.
+func ProgressLT(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProgress), v))
+	})
+}
+
+// ProgressLTE applies the LTE predicate on the "progress" field.
+func ProgressLTE(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProgress), v))
+	})
+}
+
+// ProgressContains applies the Contains predicate on the "progress" field.
+func ProgressContains(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldProgress), v))
+	})
+}
+
+// ProgressHasPrefix applies the HasPrefix predicate on the "progress" field.
+func ProgressHasPrefix(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldProgress), v))
+	})
+}
+
+// ProgressHasSuffix applies the HasSuffix predicate on the "progress" field.
+func ProgressHasSuffix(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldProgress), v))
+	})
+}
+
+// ProgressIsNil applies the IsNil predicate on the "progress" field.
+func ProgressIsNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldProgress)))
+	})
+}
+
+// ProgressNotNil applies the NotNil predicate on the "progress" field.
+func ProgressNotNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldProgress)))
+	})
+}
+
+// ProgressEqualFold applies the EqualFold predicate on the "progress" field.
+func ProgressEqualFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldProgress), v))
+	})
+}
+
+// ProgressContainsFold applies the ContainsFold predicate on the "progress" field.
+func ProgressContainsFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldProgress), v))
+	})
+}
+
+// StateBeginTimeEQ applies the EQ predicate on the "stateBeginTime" field.
+func StateBeginTimeEQ(v time.Time) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStateBeginTime), v))
+	})
+}
+
+// StateBeginTimeNEQ applies the NEQ predicate on the "stateBeginTime" field.
+func StateBeginTimeNEQ(v time.Time) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStateBeginTime), v))
+	})
+}
+
+// StateBeginTimeIn applies the In predicate on the "stateBeginTime" field.
+func StateBeginTimeIn(vs ...time.Time) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStateBeginTime), v...))
+	})
+}
+
+// StateBeginTimeNotIn applies the NotIn predicate on the "stateBeginTime" field.
+func StateBeginTimeNotIn(vs ...time.Time) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStateBeginTime), v...))
+	})
+}
+
+// StateBeginTimeGT applies the GT predicate on the "stateBeginTime" field.
+func StateBeginTimeGT(v time.Time) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStateBeginTime), v))
+	})
+}
+
+// StateBeginTimeGTE applies the GTE predicate on the "stateBeginTime" field.
+func StateBeginTimeGTE(v time.Time) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStateBeginTime), v))
+	})
+}
+
+// StateBeginTimeLT applies the LT predicate on the "stateBeginTime" field.
+func StateBeginTimeLT(v time.Time) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStateBeginTime), v))
+	})
+}
+
+// StateBeginTimeLTE applies the LTE predicate on the "stateBeginTime" field.
+func StateBeginTimeLTE(v time.Time) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStateBeginTime), v))
+	})
+}
+
+// StateBeginTimeIsNil applies the IsNil predicate on the "stateBeginTime" field.
+func StateBeginTimeIsNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStateBeginTime)))
+	})
+}
+
+// StateBeginTimeNotNil applies the NotNil predicate on the "stateBeginTime" field.
+func StateBeginTimeNotNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStateBeginTime)))
+	})
+}
+
+// ControllerEQ applies the EQ predicate on the "controller" field.
+func ControllerEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldController), v))
+	})
+}
+
+// ControllerNEQ applies the NEQ predicate on the "controller" field.
+func ControllerNEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldController), v))
+	})
+}
+
+// ControllerIn applies the In predicate on the "controller" field.
+func ControllerIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldController), v...))
+	})
+}
+
+// ControllerNotIn applies the NotIn predicate on the "controller" field.
+func ControllerNotIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldController), v...))
+	})
+}
+
+// ControllerGT applies the GT predicate on the "controller" field.
+func ControllerGT(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
 		s.Where(sql.GT(s.C(FieldController), v))
 	})
 }
 
-// ControllerGTE applies the GTE predicate on the "controller" field.
-func ControllerGTE(v string) predicate.WorkflowInstance {
+// ControllerGTE applies the GTE predicate on the "controller" field.
+func ControllerGTE(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldController), v))
+	})
+}
+
+// ControllerLT applies the LT predicate on the "controller" field.
+func ControllerLT(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldController), v))
+	})
+}
+
+// ControllerLTE applies the LTE predicate on the "controller" field.
+func ControllerLTE(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldController), v))
+	})
+}
+
+// ControllerContains applies the Contains predicate on the "controller" field.
+func ControllerContains(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldController), v))
+	})
+}
+
+// ControllerHasPrefix applies the HasPrefix predicate on the "controller" field.
+func ControllerHasPrefix(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldController), v))
+	})
+}
+
+// ControllerHasSuffix applies the HasSuffix predicate on the "controller" field.
+func ControllerHasSuffix(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldController), v))
+	})
+}
+
+// ControllerIsNil applies the IsNil predicate on the "controller" field.
+func ControllerIsNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldController)))
+	})
+}
+
+// ControllerNotNil applies the NotNil predicate on the "controller" field.
+func ControllerNotNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldController)))
+	})
+}
+
+// ControllerEqualFold applies the EqualFold predicate on the "controller" field.
+func ControllerEqualFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldController), v))
+	})
+}
+
+// ControllerContainsFold applies the ContainsFold predicate on the "controller" field.
+func ControllerContainsFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldController), v))
+	})
+}
+
+// HandoffEQ applies the EQ predicate on the "handoff" field.
+func HandoffEQ(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHandoff), v))
+	})
+}
+
+// HandoffNEQ applies the NEQ predicate on the "handoff" field.
+func HandoffNEQ(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHandoff), v))
+	})
+}
+
+// HandoffIn applies the In predicate on the "handoff" field.
+func HandoffIn(vs ...[]byte) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldHandoff), v...))
+	})
+}
+
+// HandoffNotIn applies the NotIn predicate on the "handoff" field.
+func HandoffNotIn(vs ...[]byte) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldHandoff), v...))
+	})
+}
+
+// HandoffGT applies the GT predicate on the "handoff" field.
+func HandoffGT(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldHandoff), v))
+	})
+}
+
+// HandoffGTE applies the GTE predicate on the "handoff" field.
+func HandoffGTE(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldHandoff), v))
+	})
+}
+
+// HandoffLT applies the LT predicate on the "handoff" field.
+func HandoffLT(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldHandoff), v))
+	})
+}
+
+// HandoffLTE applies the LTE predicate on the "handoff" field.
+func HandoffLTE(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldHandoff), v))
+	})
+}
+
+// HandoffIsNil applies the IsNil predicate on the "handoff" field.
+func HandoffIsNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldHandoff)))
+	})
+}
+
+// HandoffNotNil applies the NotNil predicate on the "handoff" field.
+func HandoffNotNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldHandoff)))
+	})
+}
+
+// SimulationEQ applies the EQ predicate on the "simulation" field.
+func SimulationEQ(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSimulation), v))
+	})
+}
+
+// SimulationNEQ applies the NEQ predicate on the "simulation" field.
+func SimulationNEQ(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSimulation), v))
+	})
+}
+
+// SimulationIn applies the In predicate on the "simulation" field.
+func SimulationIn(vs ...[]byte) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSimulation), v...))
+	})
+}
+
+// SimulationNotIn applies the NotIn predicate on the "simulation" field.
+func SimulationNotIn(vs ...[]byte) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSimulation), v...))
+	})
+}
+
+// SimulationGT applies the GT predicate on the "simulation" field.
+func SimulationGT(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSimulation), v))
+	})
+}
+
+// SimulationGTE applies the GTE predicate on the "simulation" field.
+func SimulationGTE(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSimulation), v))
+	})
+}
+
+// SimulationLT applies the LT predicate on the "simulation" field.
+func SimulationLT(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSimulation), v))
+	})
+}
+
+// SimulationLTE applies the LTE predicate on the "simulation" field.
+func SimulationLTE(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSimulation), v))
+	})
+}
+
+// SimulationIsNil applies the IsNil predicate on the "simulation" field.
+func SimulationIsNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSimulation)))
+	})
+}
+
+// SimulationNotNil applies the NotNil predicate on the "simulation" field.
+func SimulationNotNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSimulation)))
+	})
+}
+
+// DebugEQ applies the EQ predicate on the "debug" field.
+func DebugEQ(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDebug), v))
+	})
+}
+
+// DebugNEQ applies the NEQ predicate on the "debug" field.
+func DebugNEQ(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDebug), v))
+	})
+}
+
+// DebugIn applies the In predicate on the "debug" field.
+func DebugIn(vs ...[]byte) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDebug), v...))
+	})
+}
+
+// DebugNotIn applies the NotIn predicate on the "debug" field.
+func DebugNotIn(vs ...[]byte) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDebug), v...))
+	})
+}
+
+// DebugGT applies the GT predicate on the "debug" field.
+func DebugGT(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDebug), v))
+	})
+}
+
+// DebugGTE applies the GTE predicate on the "debug" field.
+func DebugGTE(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDebug), v))
+	})
+}
+
+// DebugLT applies the LT predicate on the "debug" field.
+func DebugLT(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDebug), v))
+	})
+}
+
+// DebugLTE applies the LTE predicate on the "debug" field.
+func DebugLTE(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDebug), v))
+	})
+}
+
+// DebugIsNil applies the IsNil predicate on the "debug" field.
+func DebugIsNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDebug)))
+	})
+}
+
+// DebugNotNil applies the NotNil predicate on the "debug" field.
+func DebugNotNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDebug)))
+	})
+}
+
+// HoldEQ applies the EQ predicate on the "hold" field.
+func HoldEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHold), v))
+	})
+}
+
+// HoldNEQ applies the NEQ predicate on the "hold" field.
+func HoldNEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHold), v))
+	})
+}
+
+// HoldIn applies the In predicate on the "hold" field.
+func HoldIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldHold), v...))
+	})
+}
+
+// HoldNotIn applies the NotIn predicate on the "hold" field.
+func HoldNotIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldHold), v...))
+	})
+}
+
+// HoldGT applies the GT predicate on the "hold" field.
+func HoldGT(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.GTE(s.C(FieldController), v))
+		s.Where(sql.GT(s.C(FieldHold), v))
 	})
 }
 
-// ControllerLT applies the LT predicate on the "controller" field.
-func ControllerLT(v string) predicate.WorkflowInstance {
+// HoldGTE applies the GTE predicate on the "hold" field.
+func HoldGTE(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.LT(s.C(FieldController), v))
+		s.Where(sql.GTE(s.C(FieldHold), v))
 	})
 }
 
-// ControllerLTE applies the LTE predicate on the "controller" field.
-func ControllerLTE(v string) predicate.WorkflowInstance {
+// HoldLT applies the LT predicate on the "hold" field.
+func HoldLT(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.LTE(s.C(FieldController), v))
+		s.Where(sql.LT(s.C(FieldHold), v))
 	})
 }
 
-// ControllerContains applies the Contains predicate on the "controller" field.
-func ControllerContains(v string) predicate.WorkflowInstance {
+// HoldLTE applies the LTE predicate on the "hold" field.
+func HoldLTE(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.Contains(s.C(FieldController), v))
+		s.Where(sql.LTE(s.C(FieldHold), v))
 	})
 }
 
-// ControllerHasPrefix applies the HasPrefix predicate on the "controller" field.
-func ControllerHasPrefix(v string) predicate.WorkflowInstance {
+// HoldContains applies the Contains predicate on the "hold" field.
+func HoldContains(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.HasPrefix(s.C(FieldController), v))
+		s.Where(sql.Contains(s.C(FieldHold), v))
 	})
 }
 
-// ControllerHasSuffix applies the HasSuffix predicate on the "controller" field.
-func ControllerHasSuffix(v string) predicate.WorkflowInstance {
+// HoldHasPrefix applies the HasPrefix predicate on the "hold" field.
+func HoldHasPrefix(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.HasSuffix(s.C(FieldController), v))
+		s.Where(sql.HasPrefix(s.C(FieldHold), v))
 	})
 }
 
-// ControllerIsNil applies the IsNil predicate on the "controller" field.
-func ControllerIsNil() predicate.WorkflowInstance {
+// HoldHasSuffix applies the HasSuffix predicate on the "hold" field.
+func HoldHasSuffix(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.IsNull(s.C(FieldController)))
+		s.Where(sql.HasSuffix(s.C(FieldHold), v))
 	})
 }
 
-// ControllerNotNil applies the NotNil predicate on the "controller" field.
-func ControllerNotNil() predicate.WorkflowInstance {
+// HoldIsNil applies the IsNil predicate on the "hold" field.
+func HoldIsNil() predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.NotNull(s.C(FieldController)))
+		s.Where(sql.IsNull(s.C(FieldHold)))
 	})
 }
 
-// ControllerEqualFold applies the EqualFold predicate on the "controller" field.
-func ControllerEqualFold(v string) predicate.WorkflowInstance {
+// HoldNotNil applies the NotNil predicate on the "hold" field.
+func HoldNotNil() predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.EqualFold(s.C(FieldController), v))
+		s.Where(sql.NotNull(s.C(FieldHold)))
 	})
 }
 
-// ControllerContainsFold applies the ContainsFold predicate on the "controller" field.
-func ControllerContainsFold(v string) predicate.WorkflowInstance {
+// HoldEqualFold applies the EqualFold predicate on the "hold" field.
+func HoldEqualFold(v string) predicate.WorkflowInstance {
 	return predicate.WorkflowInstance(func(s *sql.Selector) {
-		s.Where(sql.ContainsFold(s.C(FieldController), v))
+		s.Where(sql.EqualFold(s.C(FieldHold), v))
+	})
+}
+
+// HoldContainsFold applies the ContainsFold predicate on the "hold" field.
+func HoldContainsFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldHold), v))
+	})
+}
+
+// ProfileEQ applies the EQ predicate on the "profile" field.
+func ProfileEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileNEQ applies the NEQ predicate on the "profile" field.
+func ProfileNEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileIn applies the In predicate on the "profile" field.
+func ProfileIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProfile), v...))
+	})
+}
+
+// ProfileNotIn applies the NotIn predicate on the "profile" field.
+func ProfileNotIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProfile), v...))
+	})
+}
+
+// ProfileGT applies the GT predicate on the "profile" field.
+func ProfileGT(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileGTE applies the GTE predicate on the "profile" field.
+func ProfileGTE(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileLT applies the LT predicate on the "profile" field.
+func ProfileLT(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileLTE applies the LTE predicate on the "profile" field.
+func ProfileLTE(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileContains applies the Contains predicate on the "profile" field.
+func ProfileContains(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileHasPrefix applies the HasPrefix predicate on the "profile" field.
+func ProfileHasPrefix(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileHasSuffix applies the HasSuffix predicate on the "profile" field.
+func ProfileHasSuffix(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileIsNil applies the IsNil predicate on the "profile" field.
+func ProfileIsNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldProfile)))
+	})
+}
+
+// ProfileNotNil applies the NotNil predicate on the "profile" field.
+func ProfileNotNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldProfile)))
+	})
+}
+
+// ProfileEqualFold applies the EqualFold predicate on the "profile" field.
+func ProfileEqualFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldProfile), v))
+	})
+}
+
+// ProfileContainsFold applies the ContainsFold predicate on the "profile" field.
+func ProfileContainsFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldProfile), v))
+	})
+}
+
+// ReceivedEventsEQ applies the EQ predicate on the "receivedEvents" field.
+func ReceivedEventsEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReceivedEvents), v))
+	})
+}
+
+// ReceivedEventsNEQ applies the NEQ predicate on the "receivedEvents" field.
+func ReceivedEventsNEQ(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReceivedEvents), v))
+	})
+}
+
+// ReceivedEventsIn applies the In predicate on the "receivedEvents" field.
+func ReceivedEventsIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReceivedEvents), v...))
+	})
+}
+
+// ReceivedEventsNotIn applies the NotIn predicate on the "receivedEvents" field.
+func ReceivedEventsNotIn(vs ...string) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReceivedEvents), v...))
+	})
+}
+
+// ReceivedEventsGT applies the GT predicate on the "receivedEvents" field.
+func ReceivedEventsGT(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReceivedEvents), v))
+	})
+}
+
+// ReceivedEventsGTE applies the GTE predicate on the "receivedEvents" field.
+func ReceivedEventsGTE(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReceivedEvents), v))
+	})
+}
+
+// ReceivedEventsLT applies the LT predicate on the "receivedEvents" field.
+func ReceivedEventsLT(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReceivedEvents), v))
+	})
+}
+
+// ReceivedEventsLTE applies the LTE predicate on the "receivedEvents" field.
+func ReceivedEventsLTE(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReceivedEvents), v))
+	})
+}
+
+// ReceivedEventsContains applies the Contains predicate on the "receivedEvents" field.
+func ReceivedEventsContains(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldReceivedEvents), v))
+	})
+}
+
+// ReceivedEventsHasPrefix applies the HasPrefix predicate on the "receivedEvents" field.
+func ReceivedEventsHasPrefix(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldReceivedEvents), v))
+	})
+}
+
+// ReceivedEventsHasSuffix applies the HasSuffix predicate on the "receivedEvents" field.
+func ReceivedEventsHasSuffix(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldReceivedEvents), v))
+	})
+}
+
+// ReceivedEventsIsNil applies the IsNil predicate on the "receivedEvents" field.
+func ReceivedEventsIsNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldReceivedEvents)))
+	})
+}
+
+// ReceivedEventsNotNil applies the NotNil predicate on the "receivedEvents" field.
+func ReceivedEventsNotNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldReceivedEvents)))
+	})
+}
+
+// ReceivedEventsEqualFold applies the EqualFold predicate on the "receivedEvents" field.
+func ReceivedEventsEqualFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldReceivedEvents), v))
+	})
+}
+
+// ReceivedEventsContainsFold applies the ContainsFold predicate on the "receivedEvents" field.
+func ReceivedEventsContainsFold(v string) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldReceivedEvents), v))
+	})
+}
+
+// AwaitingCancelsEQ applies the EQ predicate on the "awaitingCancels" field.
+func AwaitingCancelsEQ(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAwaitingCancels), v))
+	})
+}
+
+// AwaitingCancelsNEQ applies the NEQ predicate on the "awaitingCancels" field.
+func AwaitingCancelsNEQ(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAwaitingCancels), v))
+	})
+}
+
+// AwaitingCancelsIn applies the In predicate on the "awaitingCancels" field.
+func AwaitingCancelsIn(vs ...[]byte) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAwaitingCancels), v...))
+	})
+}
+
+// AwaitingCancelsNotIn applies the NotIn predicate on the "awaitingCancels" field.
+func AwaitingCancelsNotIn(vs ...[]byte) predicate.WorkflowInstance {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAwaitingCancels), v...))
+	})
+}
+
+// AwaitingCancelsGT applies the GT predicate on the "awaitingCancels" field.
+func AwaitingCancelsGT(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAwaitingCancels), v))
+	})
+}
+
+// AwaitingCancelsGTE applies the GTE predicate on the "awaitingCancels" field.
+func AwaitingCancelsGTE(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAwaitingCancels), v))
+	})
+}
+
+// AwaitingCancelsLT applies the LT predicate on the "awaitingCancels" field.
+func AwaitingCancelsLT(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAwaitingCancels), v))
+	})
+}
+
+// AwaitingCancelsLTE applies the LTE predicate on the "awaitingCancels" field.
+func AwaitingCancelsLTE(v []byte) predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAwaitingCancels), v))
+	})
+}
+
+// AwaitingCancelsIsNil applies the IsNil predicate on the "awaitingCancels" field.
+func AwaitingCancelsIsNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAwaitingCancels)))
+	})
+}
+
+// AwaitingCancelsNotNil applies the NotNil predicate on the "awaitingCancels" field.
+func AwaitingCancelsNotNil() predicate.WorkflowInstance {
+	return predicate.WorkflowInstance(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAwaitingCancels)))
 	})
 }
 
@@ -9,6 +9,8 @@ const (
 	FieldID = "id"
 	// FieldInstanceID holds the string denoting the instanceid field in the database.
 	FieldInstanceID = "instance_id"
+	// FieldExternalID holds the string denoting the externalid field in the database.
+	FieldExternalID = "external_id"
 	// FieldInvokedBy holds the string denoting the invokedby field in the database.
 	FieldInvokedBy = "invoked_by"
 	// FieldStatus holds the string denoting the status field in the database.
@@ -29,6 +31,8 @@ const (
 	FieldStateData = "state_data"
 	// FieldMemory holds the string denoting the memory field in the database.
 	FieldMemory = "memory"
+	// FieldMemoryData holds the string denoting the memorydata field in the database.
+	FieldMemoryData = "memory_data"
 	// FieldDeadline holds the string denoting the deadline field in the database.
 	FieldDeadline = "deadline"
 	// FieldAttempts holds the string denoting the attempts field in the database.
@@ -37,10 +41,34 @@ const (
 	FieldErrorCode = "error_code"
 	// FieldErrorMessage holds the string denoting the errormessage field in the database.
 	FieldErrorMessage = "error_message"
+	// FieldErrorData holds the string denoting the errordata field in the database.
+	FieldErrorData = "error_data"
+	// FieldErrorCauses holds the string denoting the errorcauses field in the database.
+	FieldErrorCauses = "error_causes"
+	// FieldCancelCategory holds the string denoting the cancelcategory field in the database.
+	FieldCancelCategory = "cancel_category"
+	// FieldCancelReason holds the string denoting the cancelreason field in the database.
+	FieldCancelReason = "cancel_reason"
+	// FieldProgress holds the string denoting the progress field in the database.
+	FieldProgress = "progress"
 	// FieldStateBeginTime holds the string denoting the statebegintime field in the database.
 	FieldStateBeginTime = "state_begin_time"
 	// FieldController holds the string denoting the controller field in the database.
 	FieldController = "controller"
+	// FieldHandoff holds the string denoting the handoff field in the database.
+	FieldHandoff = "handoff"
+	// FieldSimulation holds the string denoting the simulation field in the database.
+	FieldSimulation = "simulation"
+	// FieldDebug holds the string denoting the debug field in the database.
+	FieldDebug = "debug"
+	// FieldHold holds the string denoting the hold field in the database.
+	FieldHold = "hold"
+	// FieldProfile holds the string denoting the profile field in the database.
+	FieldProfile = "profile"
+	// FieldReceivedEvents holds the string denoting the receivedevents field in the database.
+	FieldReceivedEvents = "received_events"
+	// FieldAwaitingCancels holds the string denoting the awaitingcancels field in the database.
+	FieldAwaitingCancels = "awaiting_cancels"
 	// EdgeWorkflow holds the string denoting the workflow edge name in mutations.
 	EdgeWorkflow = "workflow"
 	// EdgeInstance holds the string denoting the instance edge name in mutations.
@@ -67,6 +95,7 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldInstanceID,
+	FieldExternalID,
 	FieldInvokedBy,
 	FieldStatus,
 	FieldRevision,
@@ -77,12 +106,25 @@ var Columns = []string{
 	FieldOutput,
 	FieldStateData,
 	FieldMemory,
+	FieldMemoryData,
 	FieldDeadline,
 	FieldAttempts,
 	FieldErrorCode,
 	FieldErrorMessage,
+	FieldErrorData,
+	FieldErrorCauses,
+	FieldCancelCategory,
+	FieldCancelReason,
+	FieldProgress,
 	FieldStateBeginTime,
 	FieldController,
+	FieldHandoff,
+	FieldSimulation,
+	FieldDebug,
+	FieldHold,
+	FieldProfile,
+	FieldReceivedEvents,
+	FieldAwaitingCancels,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "workflow_instances"
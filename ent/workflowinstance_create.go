@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent/schema"
 	"github.com/vorteil/direktiv/ent/workflow"
 	"github.com/vorteil/direktiv/ent/workflowevents"
 	"github.com/vorteil/direktiv/ent/workflowinstance"
@@ -29,6 +30,20 @@ func (wic *WorkflowInstanceCreate) SetInstanceID(s string) *WorkflowInstanceCrea
 	return wic
 }
 
+// SetExternalID sets the "externalID" field.
+func (wic *WorkflowInstanceCreate) SetExternalID(s string) *WorkflowInstanceCreate {
+	wic.mutation.SetExternalID(s)
+	return wic
+}
+
+// SetNillableExternalID sets the "externalID" field if the given value is not nil.
+func (wic *WorkflowInstanceCreate) SetNillableExternalID(s *string) *WorkflowInstanceCreate {
+	if s != nil {
+		wic.SetExternalID(*s)
+	}
+	return wic
+}
+
 // SetInvokedBy sets the "invokedBy" field.
 func (wic *WorkflowInstanceCreate) SetInvokedBy(s string) *WorkflowInstanceCreate {
 	wic.mutation.SetInvokedBy(s)
@@ -121,6 +136,12 @@ func (wic *WorkflowInstanceCreate) SetNillableMemory(s *string) *WorkflowInstanc
 	return wic
 }
 
+// SetMemoryData sets the "memoryData" field.
+func (wic *WorkflowInstanceCreate) SetMemoryData(b []byte) *WorkflowInstanceCreate {
+	wic.mutation.SetMemoryData(b)
+	return wic
+}
+
 // SetDeadline sets the "deadline" field.
 func (wic *WorkflowInstanceCreate) SetDeadline(t time.Time) *WorkflowInstanceCreate {
 	wic.mutation.SetDeadline(t)
@@ -177,6 +198,68 @@ func (wic *WorkflowInstanceCreate) SetNillableErrorMessage(s *string) *WorkflowI
 	return wic
 }
 
+// SetErrorData sets the "errorData" field.
+func (wic *WorkflowInstanceCreate) SetErrorData(s string) *WorkflowInstanceCreate {
+	wic.mutation.SetErrorData(s)
+	return wic
+}
+
+// SetNillableErrorData sets the "errorData" field if the given value is not nil.
+func (wic *WorkflowInstanceCreate) SetNillableErrorData(s *string) *WorkflowInstanceCreate {
+	if s != nil {
+		wic.SetErrorData(*s)
+	}
+	return wic
+}
+
+// SetErrorCauses sets the "errorCauses" field.
+func (wic *WorkflowInstanceCreate) SetErrorCauses(sc []schema.ErrorCause) *WorkflowInstanceCreate {
+	wic.mutation.SetErrorCauses(sc)
+	return wic
+}
+
+// SetCancelCategory sets the "cancelCategory" field.
+func (wic *WorkflowInstanceCreate) SetCancelCategory(s string) *WorkflowInstanceCreate {
+	wic.mutation.SetCancelCategory(s)
+	return wic
+}
+
+// SetNillableCancelCategory sets the "cancelCategory" field if the given value is not nil.
+func (wic *WorkflowInstanceCreate) SetNillableCancelCategory(s *string) *WorkflowInstanceCreate {
+	if s != nil {
+		wic.SetCancelCategory(*s)
+	}
+	return wic
+}
+
+// SetCancelReason sets the "cancelReason" field.
+func (wic *WorkflowInstanceCreate) SetCancelReason(s string) *WorkflowInstanceCreate {
+	wic.mutation.SetCancelReason(s)
+	return wic
+}
+
+// SetNillableCancelReason sets the "cancelReason" field if the given value is not nil.
+func (wic *WorkflowInstanceCreate) SetNillableCancelReason(s *string) *WorkflowInstanceCreate {
+	if s != nil {
+		wic.SetCancelReason(*s)
+	}
+	return wic
+}
+
+// SetProgress sets the "progress" field.
+func (wic *WorkflowInstanceCreate) SetProgress(s string) *WorkflowInstanceCreate {
+	wic.mutation.SetProgress(s)
+	return wic
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (wic *WorkflowInstanceCreate) SetNillableProgress(s *string) *WorkflowInstanceCreate {
+	if s != nil {
+		wic.SetProgress(*s)
+	}
+	return wic
+}
+
 // SetStateBeginTime sets the "stateBeginTime" field.
 func (wic *WorkflowInstanceCreate) SetStateBeginTime(t time.Time) *WorkflowInstanceCreate {
 	wic.mutation.SetStateBeginTime(t)
@@ -205,6 +288,72 @@ func (wic *WorkflowInstanceCreate) SetNillableController(s *string) *WorkflowIns
 	return wic
 }
 
+// SetHandoff sets the "handoff" field.
+func (wic *WorkflowInstanceCreate) SetHandoff(b []byte) *WorkflowInstanceCreate {
+	wic.mutation.SetHandoff(b)
+	return wic
+}
+
+// SetSimulation sets the "simulation" field.
+func (wic *WorkflowInstanceCreate) SetSimulation(b []byte) *WorkflowInstanceCreate {
+	wic.mutation.SetSimulation(b)
+	return wic
+}
+
+// SetDebug sets the "debug" field.
+func (wic *WorkflowInstanceCreate) SetDebug(b []byte) *WorkflowInstanceCreate {
+	wic.mutation.SetDebug(b)
+	return wic
+}
+
+// SetHold sets the "hold" field.
+func (wic *WorkflowInstanceCreate) SetHold(s string) *WorkflowInstanceCreate {
+	wic.mutation.SetHold(s)
+	return wic
+}
+
+// SetNillableHold sets the "hold" field if the given value is not nil.
+func (wic *WorkflowInstanceCreate) SetNillableHold(s *string) *WorkflowInstanceCreate {
+	if s != nil {
+		wic.SetHold(*s)
+	}
+	return wic
+}
+
+// SetProfile sets the "profile" field.
+func (wic *WorkflowInstanceCreate) SetProfile(s string) *WorkflowInstanceCreate {
+	wic.mutation.SetProfile(s)
+	return wic
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (wic *WorkflowInstanceCreate) SetNillableProfile(s *string) *WorkflowInstanceCreate {
+	if s != nil {
+		wic.SetProfile(*s)
+	}
+	return wic
+}
+
+// SetReceivedEvents sets the "receivedEvents" field.
+func (wic *WorkflowInstanceCreate) SetReceivedEvents(s string) *WorkflowInstanceCreate {
+	wic.mutation.SetReceivedEvents(s)
+	return wic
+}
+
+// SetNillableReceivedEvents sets the "receivedEvents" field if the given value is not nil.
+func (wic *WorkflowInstanceCreate) SetNillableReceivedEvents(s *string) *WorkflowInstanceCreate {
+	if s != nil {
+		wic.SetReceivedEvents(*s)
+	}
+	return wic
+}
+
+// SetAwaitingCancels sets the "awaitingCancels" field.
+func (wic *WorkflowInstanceCreate) SetAwaitingCancels(b []byte) *WorkflowInstanceCreate {
+	wic.mutation.SetAwaitingCancels(b)
+	return wic
+}
+
 // SetWorkflowID sets the "workflow" edge to the Workflow entity by ID.
 func (wic *WorkflowInstanceCreate) SetWorkflowID(id uuid.UUID) *WorkflowInstanceCreate {
 	wic.mutation.SetWorkflowID(id)
@@ -338,6 +487,14 @@ func (wic *WorkflowInstanceCreate) createSpec() (*WorkflowInstance, *sqlgraph.Cr
 		})
 		_node.InstanceID = value
 	}
+	if value, ok := wic.mutation.ExternalID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldExternalID,
+		})
+		_node.ExternalID = &value
+	}
 	if value, ok := wic.mutation.InvokedBy(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -418,6 +575,14 @@ func (wic *WorkflowInstanceCreate) createSpec() (*WorkflowInstance, *sqlgraph.Cr
 		})
 		_node.Memory = value
 	}
+	if value, ok := wic.mutation.MemoryData(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowinstance.FieldMemoryData,
+		})
+		_node.MemoryData = value
+	}
 	if value, ok := wic.mutation.Deadline(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -450,6 +615,46 @@ func (wic *WorkflowInstanceCreate) createSpec() (*WorkflowInstance, *sqlgraph.Cr
 		})
 		_node.ErrorMessage = value
 	}
+	if value, ok := wic.mutation.ErrorData(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldErrorData,
+		})
+		_node.ErrorData = value
+	}
+	if value, ok := wic.mutation.ErrorCauses(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: workflowinstance.FieldErrorCauses,
+		})
+		_node.ErrorCauses = value
+	}
+	if value, ok := wic.mutation.CancelCategory(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldCancelCategory,
+		})
+		_node.CancelCategory = value
+	}
+	if value, ok := wic.mutation.CancelReason(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldCancelReason,
+		})
+		_node.CancelReason = value
+	}
+	if value, ok := wic.mutation.Progress(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldProgress,
+		})
+		_node.Progress = value
+	}
 	if value, ok := wic.mutation.StateBeginTime(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -466,6 +671,62 @@ func (wic *WorkflowInstanceCreate) createSpec() (*WorkflowInstance, *sqlgraph.Cr
 		})
 		_node.Controller = value
 	}
+	if value, ok := wic.mutation.Handoff(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowinstance.FieldHandoff,
+		})
+		_node.Handoff = value
+	}
+	if value, ok := wic.mutation.Simulation(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowinstance.FieldSimulation,
+		})
+		_node.Simulation = value
+	}
+	if value, ok := wic.mutation.Debug(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowinstance.FieldDebug,
+		})
+		_node.Debug = value
+	}
+	if value, ok := wic.mutation.Hold(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldHold,
+		})
+		_node.Hold = value
+	}
+	if value, ok := wic.mutation.Profile(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldProfile,
+		})
+		_node.Profile = value
+	}
+	if value, ok := wic.mutation.ReceivedEvents(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldReceivedEvents,
+		})
+		_node.ReceivedEvents = value
+	}
+	if value, ok := wic.mutation.AwaitingCancels(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowinstance.FieldAwaitingCancels,
+		})
+		_node.AwaitingCancels = value
+	}
 	if nodes := wic.mutation.WorkflowIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/schema"
 	"github.com/vorteil/direktiv/ent/workflow"
 	"github.com/vorteil/direktiv/ent/workflowevents"
 	"github.com/vorteil/direktiv/ent/workflowinstance"
@@ -37,6 +38,26 @@ func (wiu *WorkflowInstanceUpdate) SetInstanceID(s string) *WorkflowInstanceUpda
 	return wiu
 }
 
+// SetExternalID sets the "externalID" field.
+func (wiu *WorkflowInstanceUpdate) SetExternalID(s string) *WorkflowInstanceUpdate {
+	wiu.mutation.SetExternalID(s)
+	return wiu
+}
+
+// SetNillableExternalID sets the "externalID" field if the given value is not nil.
+func (wiu *WorkflowInstanceUpdate) SetNillableExternalID(s *string) *WorkflowInstanceUpdate {
+	if s != nil {
+		wiu.SetExternalID(*s)
+	}
+	return wiu
+}
+
+// ClearExternalID clears the value of the "externalID" field.
+func (wiu *WorkflowInstanceUpdate) ClearExternalID() *WorkflowInstanceUpdate {
+	wiu.mutation.ClearExternalID()
+	return wiu
+}
+
 // SetInvokedBy sets the "invokedBy" field.
 func (wiu *WorkflowInstanceUpdate) SetInvokedBy(s string) *WorkflowInstanceUpdate {
 	wiu.mutation.SetInvokedBy(s)
@@ -166,6 +187,18 @@ func (wiu *WorkflowInstanceUpdate) ClearMemory() *WorkflowInstanceUpdate {
 	return wiu
 }
 
+// SetMemoryData sets the "memoryData" field.
+func (wiu *WorkflowInstanceUpdate) SetMemoryData(b []byte) *WorkflowInstanceUpdate {
+	wiu.mutation.SetMemoryData(b)
+	return wiu
+}
+
+// ClearMemoryData clears the value of the "memoryData" field.
+func (wiu *WorkflowInstanceUpdate) ClearMemoryData() *WorkflowInstanceUpdate {
+	wiu.mutation.ClearMemoryData()
+	return wiu
+}
+
 // SetDeadline sets the "deadline" field.
 func (wiu *WorkflowInstanceUpdate) SetDeadline(t time.Time) *WorkflowInstanceUpdate {
 	wiu.mutation.SetDeadline(t)
@@ -253,6 +286,98 @@ func (wiu *WorkflowInstanceUpdate) ClearErrorMessage() *WorkflowInstanceUpdate {
 	return wiu
 }
 
+// SetErrorData sets the "errorData" field.
+func (wiu *WorkflowInstanceUpdate) SetErrorData(s string) *WorkflowInstanceUpdate {
+	wiu.mutation.SetErrorData(s)
+	return wiu
+}
+
+// SetNillableErrorData sets the "errorData" field if the given value is not nil.
+func (wiu *WorkflowInstanceUpdate) SetNillableErrorData(s *string) *WorkflowInstanceUpdate {
+	if s != nil {
+		wiu.SetErrorData(*s)
+	}
+	return wiu
+}
+
+// ClearErrorData clears the value of the "errorData" field.
+func (wiu *WorkflowInstanceUpdate) ClearErrorData() *WorkflowInstanceUpdate {
+	wiu.mutation.ClearErrorData()
+	return wiu
+}
+
+// SetErrorCauses sets the "errorCauses" field.
+func (wiu *WorkflowInstanceUpdate) SetErrorCauses(sc []schema.ErrorCause) *WorkflowInstanceUpdate {
+	wiu.mutation.SetErrorCauses(sc)
+	return wiu
+}
+
+// ClearErrorCauses clears the value of the "errorCauses" field.
+func (wiu *WorkflowInstanceUpdate) ClearErrorCauses() *WorkflowInstanceUpdate {
+	wiu.mutation.ClearErrorCauses()
+	return wiu
+}
+
+// SetCancelCategory sets the "cancelCategory" field.
+func (wiu *WorkflowInstanceUpdate) SetCancelCategory(s string) *WorkflowInstanceUpdate {
+	wiu.mutation.SetCancelCategory(s)
+	return wiu
+}
+
+// SetNillableCancelCategory sets the "cancelCategory" field if the given value is not nil.
+func (wiu *WorkflowInstanceUpdate) SetNillableCancelCategory(s *string) *WorkflowInstanceUpdate {
+	if s != nil {
+		wiu.SetCancelCategory(*s)
+	}
+	return wiu
+}
+
+// ClearCancelCategory clears the value of the "cancelCategory" field.
+func (wiu *WorkflowInstanceUpdate) ClearCancelCategory() *WorkflowInstanceUpdate {
+	wiu.mutation.ClearCancelCategory()
+	return wiu
+}
+
+// SetCancelReason sets the "cancelReason" field.
+func (wiu *WorkflowInstanceUpdate) SetCancelReason(s string) *WorkflowInstanceUpdate {
+	wiu.mutation.SetCancelReason(s)
+	return wiu
+}
+
+// SetNillableCancelReason sets the "cancelReason" field if the given value is not nil.
+func (wiu *WorkflowInstanceUpdate) SetNillableCancelReason(s *string) *WorkflowInstanceUpdate {
+	if s != nil {
+		wiu.SetCancelReason(*s)
+	}
+	return wiu
+}
+
+// ClearCancelReason clears the value of the "cancelReason" field.
+func (wiu *WorkflowInstanceUpdate) ClearCancelReason() *WorkflowInstanceUpdate {
+	wiu.mutation.ClearCancelReason()
+	return wiu
+}
+
+// SetProgress sets the "progress" field.
+func (wiu *WorkflowInstanceUpdate) SetProgress(s string) *WorkflowInstanceUpdate {
+	wiu.mutation.SetProgress(s)
+	return wiu
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (wiu *WorkflowInstanceUpdate) SetNillableProgress(s *string) *WorkflowInstanceUpdate {
+	if s != nil {
+		wiu.SetProgress(*s)
+	}
+	return wiu
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (wiu *WorkflowInstanceUpdate) ClearProgress() *WorkflowInstanceUpdate {
+	wiu.mutation.ClearProgress()
+	return wiu
+}
+
 // SetStateBeginTime sets the "stateBeginTime" field.
 func (wiu *WorkflowInstanceUpdate) SetStateBeginTime(t time.Time) *WorkflowInstanceUpdate {
 	wiu.mutation.SetStateBeginTime(t)
@@ -293,6 +418,114 @@ func (wiu *WorkflowInstanceUpdate) ClearController() *WorkflowInstanceUpdate {
 	return wiu
 }
 
+// SetHandoff sets the "handoff" field.
+func (wiu *WorkflowInstanceUpdate) SetHandoff(b []byte) *WorkflowInstanceUpdate {
+	wiu.mutation.SetHandoff(b)
+	return wiu
+}
+
+// ClearHandoff clears the value of the "handoff" field.
+func (wiu *WorkflowInstanceUpdate) ClearHandoff() *WorkflowInstanceUpdate {
+	wiu.mutation.ClearHandoff()
+	return wiu
+}
+
+// SetSimulation sets the "simulation" field.
+func (wiu *WorkflowInstanceUpdate) SetSimulation(b []byte) *WorkflowInstanceUpdate {
+	wiu.mutation.SetSimulation(b)
+	return wiu
+}
+
+// ClearSimulation clears the value of the "simulation" field.
+func (wiu *WorkflowInstanceUpdate) ClearSimulation() *WorkflowInstanceUpdate {
+	wiu.mutation.ClearSimulation()
+	return wiu
+}
+
+// SetDebug sets the "debug" field.
+func (wiu *WorkflowInstanceUpdate) SetDebug(b []byte) *WorkflowInstanceUpdate {
+	wiu.mutation.SetDebug(b)
+	return wiu
+}
+
+// ClearDebug clears the value of the "debug" field.
+func (wiu *WorkflowInstanceUpdate) ClearDebug() *WorkflowInstanceUpdate {
+	wiu.mutation.ClearDebug()
+	return wiu
+}
+
+// SetHold sets the "hold" field.
+func (wiu *WorkflowInstanceUpdate) SetHold(s string) *WorkflowInstanceUpdate {
+	wiu.mutation.SetHold(s)
+	return wiu
+}
+
+// SetNillableHold sets the "hold" field if the given value is not nil.
+func (wiu *WorkflowInstanceUpdate) SetNillableHold(s *string) *WorkflowInstanceUpdate {
+	if s != nil {
+		wiu.SetHold(*s)
+	}
+	return wiu
+}
+
+// ClearHold clears the value of the "hold" field.
+func (wiu *WorkflowInstanceUpdate) ClearHold() *WorkflowInstanceUpdate {
+	wiu.mutation.ClearHold()
+	return wiu
+}
+
+// SetProfile sets the "profile" field.
+func (wiu *WorkflowInstanceUpdate) SetProfile(s string) *WorkflowInstanceUpdate {
+	wiu.mutation.SetProfile(s)
+	return wiu
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (wiu *WorkflowInstanceUpdate) SetNillableProfile(s *string) *WorkflowInstanceUpdate {
+	if s != nil {
+		wiu.SetProfile(*s)
+	}
+	return wiu
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (wiu *WorkflowInstanceUpdate) ClearProfile() *WorkflowInstanceUpdate {
+	wiu.mutation.ClearProfile()
+	return wiu
+}
+
+// SetReceivedEvents sets the "receivedEvents" field.
+func (wiu *WorkflowInstanceUpdate) SetReceivedEvents(s string) *WorkflowInstanceUpdate {
+	wiu.mutation.SetReceivedEvents(s)
+	return wiu
+}
+
+// SetNillableReceivedEvents sets the "receivedEvents" field if the given value is not nil.
+func (wiu *WorkflowInstanceUpdate) SetNillableReceivedEvents(s *string) *WorkflowInstanceUpdate {
+	if s != nil {
+		wiu.SetReceivedEvents(*s)
+	}
+	return wiu
+}
+
+// ClearReceivedEvents clears the value of the "receivedEvents" field.
+func (wiu *WorkflowInstanceUpdate) ClearReceivedEvents() *WorkflowInstanceUpdate {
+	wiu.mutation.ClearReceivedEvents()
+	return wiu
+}
+
+// SetAwaitingCancels sets the "awaitingCancels" field.
+func (wiu *WorkflowInstanceUpdate) SetAwaitingCancels(b []byte) *WorkflowInstanceUpdate {
+	wiu.mutation.SetAwaitingCancels(b)
+	return wiu
+}
+
+// ClearAwaitingCancels clears the value of the "awaitingCancels" field.
+func (wiu *WorkflowInstanceUpdate) ClearAwaitingCancels() *WorkflowInstanceUpdate {
+	wiu.mutation.ClearAwaitingCancels()
+	return wiu
+}
+
 // SetWorkflowID sets the "workflow" edge to the Workflow entity by ID.
 func (wiu *WorkflowInstanceUpdate) SetWorkflowID(id uuid.UUID) *WorkflowInstanceUpdate {
 	wiu.mutation.SetWorkflowID(id)
@@ -441,6 +674,19 @@ func (wiu *WorkflowInstanceUpdate) sqlSave(ctx context.Context) (n int, err erro
 			Column: workflowinstance.FieldInstanceID,
 		})
 	}
+	if value, ok := wiu.mutation.ExternalID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldExternalID,
+		})
+	}
+	if wiu.mutation.ExternalIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldExternalID,
+		})
+	}
 	if value, ok := wiu.mutation.InvokedBy(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -548,6 +794,19 @@ func (wiu *WorkflowInstanceUpdate) sqlSave(ctx context.Context) (n int, err erro
 			Column: workflowinstance.FieldMemory,
 		})
 	}
+	if value, ok := wiu.mutation.MemoryData(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowinstance.FieldMemoryData,
+		})
+	}
+	if wiu.mutation.MemoryDataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: workflowinstance.FieldMemoryData,
+		})
+	}
 	if value, ok := wiu.mutation.Deadline(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -607,6 +866,71 @@ func (wiu *WorkflowInstanceUpdate) sqlSave(ctx context.Context) (n int, err erro
 			Column: workflowinstance.FieldErrorMessage,
 		})
 	}
+	if value, ok := wiu.mutation.ErrorData(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldErrorData,
+		})
+	}
+	if wiu.mutation.ErrorDataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldErrorData,
+		})
+	}
+	if value, ok := wiu.mutation.ErrorCauses(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: workflowinstance.FieldErrorCauses,
+		})
+	}
+	if wiu.mutation.ErrorCausesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: workflowinstance.FieldErrorCauses,
+		})
+	}
+	if value, ok := wiu.mutation.CancelCategory(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldCancelCategory,
+		})
+	}
+	if wiu.mutation.CancelCategoryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldCancelCategory,
+		})
+	}
+	if value, ok := wiu.mutation.CancelReason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldCancelReason,
+		})
+	}
+	if wiu.mutation.CancelReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldCancelReason,
+		})
+	}
+	if value, ok := wiu.mutation.Progress(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldProgress,
+		})
+	}
+	if wiu.mutation.ProgressCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldProgress,
+		})
+	}
 	if value, ok := wiu.mutation.StateBeginTime(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -633,6 +957,97 @@ func (wiu *WorkflowInstanceUpdate) sqlSave(ctx context.Context) (n int, err erro
 			Column: workflowinstance.FieldController,
 		})
 	}
+	if value, ok := wiu.mutation.Handoff(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowinstance.FieldHandoff,
+		})
+	}
+	if wiu.mutation.HandoffCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: workflowinstance.FieldHandoff,
+		})
+	}
+	if value, ok := wiu.mutation.Simulation(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowinstance.FieldSimulation,
+		})
+	}
+	if wiu.mutation.SimulationCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: workflowinstance.FieldSimulation,
+		})
+	}
+	if value, ok := wiu.mutation.Debug(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowinstance.FieldDebug,
+		})
+	}
+	if wiu.mutation.DebugCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: workflowinstance.FieldDebug,
+		})
+	}
+	if value, ok := wiu.mutation.Hold(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldHold,
+		})
+	}
+	if wiu.mutation.HoldCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldHold,
+		})
+	}
+	if value, ok := wiu.mutation.Profile(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldProfile,
+		})
+	}
+	if wiu.mutation.ProfileCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldProfile,
+		})
+	}
+	if value, ok := wiu.mutation.ReceivedEvents(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldReceivedEvents,
+		})
+	}
+	if wiu.mutation.ReceivedEventsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldReceivedEvents,
+		})
+	}
+	if value, ok := wiu.mutation.AwaitingCancels(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowinstance.FieldAwaitingCancels,
+		})
+	}
+	if wiu.mutation.AwaitingCancelsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: workflowinstance.FieldAwaitingCancels,
+		})
+	}
 	if wiu.mutation.WorkflowCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -747,6 +1162,26 @@ func (wiuo *WorkflowInstanceUpdateOne) SetInstanceID(s string) *WorkflowInstance
 	return wiuo
 }
 
+// SetExternalID sets the "externalID" field.
+func (wiuo *WorkflowInstanceUpdateOne) SetExternalID(s string) *WorkflowInstanceUpdateOne {
+	wiuo.mutation.SetExternalID(s)
+	return wiuo
+}
+
+// SetNillableExternalID sets the "externalID" field if the given value is not nil.
+func (wiuo *WorkflowInstanceUpdateOne) SetNillableExternalID(s *string) *WorkflowInstanceUpdateOne {
+	if s != nil {
+		wiuo.SetExternalID(*s)
+	}
+	return wiuo
+}
+
+// ClearExternalID clears the value of the "externalID" field.
+func (wiuo *WorkflowInstanceUpdateOne) ClearExternalID() *WorkflowInstanceUpdateOne {
+	wiuo.mutation.ClearExternalID()
+	return wiuo
+}
+
 // SetInvokedBy sets the "invokedBy" field.
 func (wiuo *WorkflowInstanceUpdateOne) SetInvokedBy(s string) *WorkflowInstanceUpdateOne {
 	wiuo.mutation.SetInvokedBy(s)
@@ -876,6 +1311,18 @@ func (wiuo *WorkflowInstanceUpdateOne) ClearMemory() *WorkflowInstanceUpdateOne
 	return wiuo
 }
 
+// SetMemoryData sets the "memoryData" field.
+func (wiuo *WorkflowInstanceUpdateOne) SetMemoryData(b []byte) *WorkflowInstanceUpdateOne {
+	wiuo.mutation.SetMemoryData(b)
+	return wiuo
+}
+
+// ClearMemoryData clears the value of the "memoryData" field.
+func (wiuo *WorkflowInstanceUpdateOne) ClearMemoryData() *WorkflowInstanceUpdateOne {
+	wiuo.mutation.ClearMemoryData()
+	return wiuo
+}
+
 // SetDeadline sets the "deadline" field.
 func (wiuo *WorkflowInstanceUpdateOne) SetDeadline(t time.Time) *WorkflowInstanceUpdateOne {
 	wiuo.mutation.SetDeadline(t)
@@ -963,6 +1410,98 @@ func (wiuo *WorkflowInstanceUpdateOne) ClearErrorMessage() *WorkflowInstanceUpda
 	return wiuo
 }
 
+// SetErrorData sets the "errorData" field.
+func (wiuo *WorkflowInstanceUpdateOne) SetErrorData(s string) *WorkflowInstanceUpdateOne {
+	wiuo.mutation.SetErrorData(s)
+	return wiuo
+}
+
+// SetNillableErrorData sets the "errorData" field if the given value is not nil.
+func (wiuo *WorkflowInstanceUpdateOne) SetNillableErrorData(s *string) *WorkflowInstanceUpdateOne {
+	if s != nil {
+		wiuo.SetErrorData(*s)
+	}
+	return wiuo
+}
+
+// ClearErrorData clears the value of the "errorData" field.
+func (wiuo *WorkflowInstanceUpdateOne) ClearErrorData() *WorkflowInstanceUpdateOne {
+	wiuo.mutation.ClearErrorData()
+	return wiuo
+}
+
+// SetErrorCauses sets the "errorCauses" field.
+func (wiuo *WorkflowInstanceUpdateOne) SetErrorCauses(sc []schema.ErrorCause) *WorkflowInstanceUpdateOne {
+	wiuo.mutation.SetErrorCauses(sc)
+	return wiuo
+}
+
+// ClearErrorCauses clears the value of the "errorCauses" field.
+func (wiuo *WorkflowInstanceUpdateOne) ClearErrorCauses() *WorkflowInstanceUpdateOne {
+	wiuo.mutation.ClearErrorCauses()
+	return wiuo
+}
+
+// SetCancelCategory sets the "cancelCategory" field.
+func (wiuo *WorkflowInstanceUpdateOne) SetCancelCategory(s string) *WorkflowInstanceUpdateOne {
+	wiuo.mutation.SetCancelCategory(s)
+	return wiuo
+}
+
+// SetNillableCancelCategory sets the "cancelCategory" field if the given value is not nil.
+func (wiuo *WorkflowInstanceUpdateOne) SetNillableCancelCategory(s *string) *WorkflowInstanceUpdateOne {
+	if s != nil {
+		wiuo.SetCancelCategory(*s)
+	}
+	return wiuo
+}
+
+// ClearCancelCategory clears the value of the "cancelCategory" field.
+func (wiuo *WorkflowInstanceUpdateOne) ClearCancelCategory() *WorkflowInstanceUpdateOne {
+	wiuo.mutation.ClearCancelCategory()
+	return wiuo
+}
+
+// SetCancelReason sets the "cancelReason" field.
+func (wiuo *WorkflowInstanceUpdateOne) SetCancelReason(s string) *WorkflowInstanceUpdateOne {
+	wiuo.mutation.SetCancelReason(s)
+	return wiuo
+}
+
+// SetNillableCancelReason sets the "cancelReason" field if the given value is not nil.
+func (wiuo *WorkflowInstanceUpdateOne) SetNillableCancelReason(s *string) *WorkflowInstanceUpdateOne {
+	if s != nil {
+		wiuo.SetCancelReason(*s)
+	}
+	return wiuo
+}
+
+// ClearCancelReason clears the value of the "cancelReason" field.
+func (wiuo *WorkflowInstanceUpdateOne) ClearCancelReason() *WorkflowInstanceUpdateOne {
+	wiuo.mutation.ClearCancelReason()
+	return wiuo
+}
+
+// SetProgress sets the "progress" field.
+func (wiuo *WorkflowInstanceUpdateOne) SetProgress(s string) *WorkflowInstanceUpdateOne {
+	wiuo.mutation.SetProgress(s)
+	return wiuo
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (wiuo *WorkflowInstanceUpdateOne) SetNillableProgress(s *string) *WorkflowInstanceUpdateOne {
+	if s != nil {
+		wiuo.SetProgress(*s)
+	}
+	return wiuo
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (wiuo *WorkflowInstanceUpdateOne) ClearProgress() *WorkflowInstanceUpdateOne {
+	wiuo.mutation.ClearProgress()
+	return wiuo
+}
+
 // SetStateBeginTime sets the "stateBeginTime" field.
 func (wiuo *WorkflowInstanceUpdateOne) SetStateBeginTime(t time.Time) *WorkflowInstanceUpdateOne {
 	wiuo.mutation.SetStateBeginTime(t)
@@ -1003,6 +1542,114 @@ func (wiuo *WorkflowInstanceUpdateOne) ClearController() *WorkflowInstanceUpdate
 	return wiuo
 }
 
+// SetHandoff sets the "handoff" field.
+func (wiuo *WorkflowInstanceUpdateOne) SetHandoff(b []byte) *WorkflowInstanceUpdateOne {
+	wiuo.mutation.SetHandoff(b)
+	return wiuo
+}
+
+// ClearHandoff clears the value of the "handoff" field.
+func (wiuo *WorkflowInstanceUpdateOne) ClearHandoff() *WorkflowInstanceUpdateOne {
+	wiuo.mutation.ClearHandoff()
+	return wiuo
+}
+
+// SetSimulation sets the "simulation" field.
+func (wiuo *WorkflowInstanceUpdateOne) SetSimulation(b []byte) *WorkflowInstanceUpdateOne {
+	wiuo.mutation.SetSimulation(b)
+	return wiuo
+}
+
+// ClearSimulation clears the value of the "simulation" field.
+func (wiuo *WorkflowInstanceUpdateOne) ClearSimulation() *WorkflowInstanceUpdateOne {
+	wiuo.mutation.ClearSimulation()
+	return wiuo
+}
+
+// SetDebug sets the "debug" field.
+func (wiuo *WorkflowInstanceUpdateOne) SetDebug(b []byte) *WorkflowInstanceUpdateOne {
+	wiuo.mutation.SetDebug(b)
+	return wiuo
+}
+
+// ClearDebug clears the value of the "debug" field.
+func (wiuo *WorkflowInstanceUpdateOne) ClearDebug() *WorkflowInstanceUpdateOne {
+	wiuo.mutation.ClearDebug()
+	return wiuo
+}
+
+// SetHold sets the "hold" field.
+func (wiuo *WorkflowInstanceUpdateOne) SetHold(s string) *WorkflowInstanceUpdateOne {
+	wiuo.mutation.SetHold(s)
+	return wiuo
+}
+
+// SetNillableHold sets the "hold" field if the given value is not nil.
+func (wiuo *WorkflowInstanceUpdateOne) SetNillableHold(s *string) *WorkflowInstanceUpdateOne {
+	if s != nil {
+		wiuo.SetHold(*s)
+	}
+	return wiuo
+}
+
+// ClearHold clears the value of the "hold" field.
+func (wiuo *WorkflowInstanceUpdateOne) ClearHold() *WorkflowInstanceUpdateOne {
+	wiuo.mutation.ClearHold()
+	return wiuo
+}
+
+// SetProfile sets the "profile" field.
+func (wiuo *WorkflowInstanceUpdateOne) SetProfile(s string) *WorkflowInstanceUpdateOne {
+	wiuo.mutation.SetProfile(s)
+	return wiuo
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (wiuo *WorkflowInstanceUpdateOne) SetNillableProfile(s *string) *WorkflowInstanceUpdateOne {
+	if s != nil {
+		wiuo.SetProfile(*s)
+	}
+	return wiuo
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (wiuo *WorkflowInstanceUpdateOne) ClearProfile() *WorkflowInstanceUpdateOne {
+	wiuo.mutation.ClearProfile()
+	return wiuo
+}
+
+// SetReceivedEvents sets the "receivedEvents" field.
+func (wiuo *WorkflowInstanceUpdateOne) SetReceivedEvents(s string) *WorkflowInstanceUpdateOne {
+	wiuo.mutation.SetReceivedEvents(s)
+	return wiuo
+}
+
+// SetNillableReceivedEvents sets the "receivedEvents" field if the given value is not nil.
+func (wiuo *WorkflowInstanceUpdateOne) SetNillableReceivedEvents(s *string) *WorkflowInstanceUpdateOne {
+	if s != nil {
+		wiuo.SetReceivedEvents(*s)
+	}
+	return wiuo
+}
+
+// ClearReceivedEvents clears the value of the "receivedEvents" field.
+func (wiuo *WorkflowInstanceUpdateOne) ClearReceivedEvents() *WorkflowInstanceUpdateOne {
+	wiuo.mutation.ClearReceivedEvents()
+	return wiuo
+}
+
+// SetAwaitingCancels sets the "awaitingCancels" field.
+func (wiuo *WorkflowInstanceUpdateOne) SetAwaitingCancels(b []byte) *WorkflowInstanceUpdateOne {
+	wiuo.mutation.SetAwaitingCancels(b)
+	return wiuo
+}
+
+// ClearAwaitingCancels clears the value of the "awaitingCancels" field.
+func (wiuo *WorkflowInstanceUpdateOne) ClearAwaitingCancels() *WorkflowInstanceUpdateOne {
+	wiuo.mutation.ClearAwaitingCancels()
+	return wiuo
+}
+
 // SetWorkflowID sets the "workflow" edge to the Workflow entity by ID.
 func (wiuo *WorkflowInstanceUpdateOne) SetWorkflowID(id uuid.UUID) *WorkflowInstanceUpdateOne {
 	wiuo.mutation.SetWorkflowID(id)
@@ -1175,6 +1822,19 @@ func (wiuo *WorkflowInstanceUpdateOne) sqlSave(ctx context.Context) (_node *Work
 			Column: workflowinstance.FieldInstanceID,
 		})
 	}
+	if value, ok := wiuo.mutation.ExternalID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldExternalID,
+		})
+	}
+	if wiuo.mutation.ExternalIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldExternalID,
+		})
+	}
 	if value, ok := wiuo.mutation.InvokedBy(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -1282,6 +1942,19 @@ func (wiuo *WorkflowInstanceUpdateOne) sqlSave(ctx context.Context) (_node *Work
 			Column: workflowinstance.FieldMemory,
 		})
 	}
+	if value, ok := wiuo.mutation.MemoryData(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowinstance.FieldMemoryData,
+		})
+	}
+	if wiuo.mutation.MemoryDataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: workflowinstance.FieldMemoryData,
+		})
+	}
 	if value, ok := wiuo.mutation.Deadline(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -1341,6 +2014,71 @@ func (wiuo *WorkflowInstanceUpdateOne) sqlSave(ctx context.Context) (_node *Work
 			Column: workflowinstance.FieldErrorMessage,
 		})
 	}
+	if value, ok := wiuo.mutation.ErrorData(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldErrorData,
+		})
+	}
+	if wiuo.mutation.ErrorDataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldErrorData,
+		})
+	}
+	if value, ok := wiuo.mutation.ErrorCauses(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: workflowinstance.FieldErrorCauses,
+		})
+	}
+	if wiuo.mutation.ErrorCausesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: workflowinstance.FieldErrorCauses,
+		})
+	}
+	if value, ok := wiuo.mutation.CancelCategory(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldCancelCategory,
+		})
+	}
+	if wiuo.mutation.CancelCategoryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldCancelCategory,
+		})
+	}
+	if value, ok := wiuo.mutation.CancelReason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldCancelReason,
+		})
+	}
+	if wiuo.mutation.CancelReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldCancelReason,
+		})
+	}
+	if value, ok := wiuo.mutation.Progress(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldProgress,
+		})
+	}
+	if wiuo.mutation.ProgressCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldProgress,
+		})
+	}
 	if value, ok := wiuo.mutation.StateBeginTime(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -1367,6 +2105,97 @@ func (wiuo *WorkflowInstanceUpdateOne) sqlSave(ctx context.Context) (_node *Work
 			Column: workflowinstance.FieldController,
 		})
 	}
+	if value, ok := wiuo.mutation.Handoff(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowinstance.FieldHandoff,
+		})
+	}
+	if wiuo.mutation.HandoffCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: workflowinstance.FieldHandoff,
+		})
+	}
+	if value, ok := wiuo.mutation.Simulation(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowinstance.FieldSimulation,
+		})
+	}
+	if wiuo.mutation.SimulationCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: workflowinstance.FieldSimulation,
+		})
+	}
+	if value, ok := wiuo.mutation.Debug(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowinstance.FieldDebug,
+		})
+	}
+	if wiuo.mutation.DebugCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: workflowinstance.FieldDebug,
+		})
+	}
+	if value, ok := wiuo.mutation.Hold(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldHold,
+		})
+	}
+	if wiuo.mutation.HoldCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldHold,
+		})
+	}
+	if value, ok := wiuo.mutation.Profile(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldProfile,
+		})
+	}
+	if wiuo.mutation.ProfileCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldProfile,
+		})
+	}
+	if value, ok := wiuo.mutation.ReceivedEvents(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: workflowinstance.FieldReceivedEvents,
+		})
+	}
+	if wiuo.mutation.ReceivedEventsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: workflowinstance.FieldReceivedEvents,
+		})
+	}
+	if value, ok := wiuo.mutation.AwaitingCancels(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowinstance.FieldAwaitingCancels,
+		})
+	}
+	if wiuo.mutation.AwaitingCancelsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: workflowinstance.FieldAwaitingCancels,
+		})
+	}
 	if wiuo.mutation.WorkflowCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
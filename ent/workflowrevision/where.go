@@ -0,0 +1,318 @@
+// Code generated by entc, DO NOT EDIT.
+
+package workflowrevision
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevision), v))
+	})
+}
+
+// Definition applies equality check predicate on the "definition" field. It's identical to DefinitionEQ.
+func Definition(v []byte) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDefinition), v))
+	})
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevision), v))
+	})
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRevision), v))
+	})
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int) predicate.WorkflowRevision {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRevision), v...))
+	})
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int) predicate.WorkflowRevision {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRevision), v...))
+	})
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRevision), v))
+	})
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRevision), v))
+	})
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRevision), v))
+	})
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRevision), v))
+	})
+}
+
+// DefinitionEQ applies the EQ predicate on the "definition" field.
+func DefinitionEQ(v []byte) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDefinition), v))
+	})
+}
+
+// DefinitionNEQ applies the NEQ predicate on the "definition" field.
+func DefinitionNEQ(v []byte) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDefinition), v))
+	})
+}
+
+// DefinitionIn applies the In predicate on the "definition" field.
+func DefinitionIn(vs ...[]byte) predicate.WorkflowRevision {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDefinition), v...))
+	})
+}
+
+// DefinitionNotIn applies the NotIn predicate on the "definition" field.
+func DefinitionNotIn(vs ...[]byte) predicate.WorkflowRevision {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDefinition), v...))
+	})
+}
+
+// DefinitionGT applies the GT predicate on the "definition" field.
+func DefinitionGT(v []byte) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDefinition), v))
+	})
+}
+
+// DefinitionGTE applies the GTE predicate on the "definition" field.
+func DefinitionGTE(v []byte) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDefinition), v))
+	})
+}
+
+// DefinitionLT applies the LT predicate on the "definition" field.
+func DefinitionLT(v []byte) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDefinition), v))
+	})
+}
+
+// DefinitionLTE applies the LTE predicate on the "definition" field.
+func DefinitionLTE(v []byte) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDefinition), v))
+	})
+}
+
+// HasWorkflow applies the HasEdge predicate on the "workflow" edge.
+func HasWorkflow() predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WorkflowTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkflowTable, WorkflowColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkflowWith applies the HasEdge predicate on the "workflow" edge with a given conditions (other predicates).
+func HasWorkflowWith(preds ...predicate.Workflow) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WorkflowInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkflowTable, WorkflowColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WorkflowRevision) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WorkflowRevision) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WorkflowRevision) predicate.WorkflowRevision {
+	return predicate.WorkflowRevision(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,53 @@
+// Code generated by entc, DO NOT EDIT.
+
+package workflowrevision
+
+const (
+	// Label holds the string label denoting the workflowrevision type in the database.
+	Label = "workflow_revision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// FieldDefinition holds the string denoting the definition field in the database.
+	FieldDefinition = "definition"
+	// EdgeWorkflow holds the string denoting the workflow edge name in mutations.
+	EdgeWorkflow = "workflow"
+	// Table holds the table name of the workflowrevision in the database.
+	Table = "workflow_revisions"
+	// WorkflowTable is the table the holds the workflow relation/edge.
+	WorkflowTable = "workflow_revisions"
+	// WorkflowInverseTable is the table name for the Workflow entity.
+	// It exists in this package in order to avoid circular dependency with the "workflow" package.
+	WorkflowInverseTable = "workflows"
+	// WorkflowColumn is the table column denoting the workflow relation/edge.
+	WorkflowColumn = "workflow_revisions"
+)
+
+// Columns holds all SQL columns for workflowrevision fields.
+var Columns = []string{
+	FieldID,
+	FieldRevision,
+	FieldDefinition,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "workflow_revisions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"workflow_revisions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,237 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent/workflow"
+	"github.com/vorteil/direktiv/ent/workflowrevision"
+)
+
+// WorkflowRevisionCreate is the builder for creating a WorkflowRevision entity.
+type WorkflowRevisionCreate struct {
+	config
+	mutation *WorkflowRevisionMutation
+	hooks    []Hook
+}
+
+// SetRevision sets the "revision" field.
+func (wrc *WorkflowRevisionCreate) SetRevision(i int) *WorkflowRevisionCreate {
+	wrc.mutation.SetRevision(i)
+	return wrc
+}
+
+// SetDefinition sets the "definition" field.
+func (wrc *WorkflowRevisionCreate) SetDefinition(b []byte) *WorkflowRevisionCreate {
+	wrc.mutation.SetDefinition(b)
+	return wrc
+}
+
+// SetWorkflowID sets the "workflow" edge to the Workflow entity by ID.
+func (wrc *WorkflowRevisionCreate) SetWorkflowID(id uuid.UUID) *WorkflowRevisionCreate {
+	wrc.mutation.SetWorkflowID(id)
+	return wrc
+}
+
+// SetWorkflow sets the "workflow" edge to the Workflow entity.
+func (wrc *WorkflowRevisionCreate) SetWorkflow(w *Workflow) *WorkflowRevisionCreate {
+	return wrc.SetWorkflowID(w.ID)
+}
+
+// Mutation returns the WorkflowRevisionMutation object of the builder.
+func (wrc *WorkflowRevisionCreate) Mutation() *WorkflowRevisionMutation {
+	return wrc.mutation
+}
+
+// Save creates the WorkflowRevision in the database.
+func (wrc *WorkflowRevisionCreate) Save(ctx context.Context) (*WorkflowRevision, error) {
+	var (
+		err  error
+		node *WorkflowRevision
+	)
+	if len(wrc.hooks) == 0 {
+		if err = wrc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wrc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WorkflowRevisionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wrc.check(); err != nil {
+				return nil, err
+			}
+			wrc.mutation = mutation
+			node, err = wrc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wrc.hooks) - 1; i >= 0; i-- {
+			mut = wrc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wrc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wrc *WorkflowRevisionCreate) SaveX(ctx context.Context) *WorkflowRevision {
+	v, err := wrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wrc *WorkflowRevisionCreate) check() error {
+	if _, ok := wrc.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New("ent: missing required field \"revision\"")}
+	}
+	if _, ok := wrc.mutation.Definition(); !ok {
+		return &ValidationError{Name: "definition", err: errors.New("ent: missing required field \"definition\"")}
+	}
+	if _, ok := wrc.mutation.WorkflowID(); !ok {
+		return &ValidationError{Name: "workflow", err: errors.New("ent: missing required edge \"workflow\"")}
+	}
+	return nil
+}
+
+func (wrc *WorkflowRevisionCreate) sqlSave(ctx context.Context) (*WorkflowRevision, error) {
+	_node, _spec := wrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wrc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (wrc *WorkflowRevisionCreate) createSpec() (*WorkflowRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WorkflowRevision{config: wrc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: workflowrevision.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: workflowrevision.FieldID,
+			},
+		}
+	)
+	if value, ok := wrc.mutation.Revision(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: workflowrevision.FieldRevision,
+		})
+		_node.Revision = value
+	}
+	if value, ok := wrc.mutation.Definition(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowrevision.FieldDefinition,
+		})
+		_node.Definition = value
+	}
+	if nodes := wrc.mutation.WorkflowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowrevision.WorkflowTable,
+			Columns: []string{workflowrevision.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: workflow.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.workflow_revisions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WorkflowRevisionCreateBulk is the builder for creating many WorkflowRevision entities in bulk.
+type WorkflowRevisionCreateBulk struct {
+	config
+	builders []*WorkflowRevisionCreate
+}
+
+// Save creates the WorkflowRevision entities in the database.
+func (wrcb *WorkflowRevisionCreateBulk) Save(ctx context.Context) ([]*WorkflowRevision, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wrcb.builders))
+	nodes := make([]*WorkflowRevision, len(wrcb.builders))
+	mutators := make([]Mutator, len(wrcb.builders))
+	for i := range wrcb.builders {
+		func(i int, root context.Context) {
+			builder := wrcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkflowRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wrcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wrcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wrcb *WorkflowRevisionCreateBulk) SaveX(ctx context.Context) []*WorkflowRevision {
+	v, err := wrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,984 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/workflow"
+	"github.com/vorteil/direktiv/ent/workflowrevision"
+)
+
+// WorkflowRevisionQuery is the builder for querying WorkflowRevision entities.
+type WorkflowRevisionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.WorkflowRevision
+	// eager-loading edges.
+	withWorkflow *WorkflowQuery
+	withFKs      bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WorkflowRevisionQuery builder.
+func (wrq *WorkflowRevisionQuery) Where(ps ...predicate.WorkflowRevision) *WorkflowRevisionQuery {
+	wrq.predicates = append(wrq.predicates, ps...)
+	return wrq
+}
+
+// Limit adds a limit step to the query.
+func (wrq *WorkflowRevisionQuery) Limit(limit int) *WorkflowRevisionQuery {
+	wrq.limit = &limit
+	return wrq
+}
+
+// Offset adds an offset step to the query.
+func (wrq *WorkflowRevisionQuery) Offset(offset int) *WorkflowRevisionQuery {
+	wrq.offset = &offset
+	return wrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wrq *WorkflowRevisionQuery) Unique(unique bool) *WorkflowRevisionQuery {
+	wrq.unique = &unique
+	return wrq
+}
+
+// Order adds an order step to the query.
+func (wrq *WorkflowRevisionQuery) Order(o ...OrderFunc) *WorkflowRevisionQuery {
+	wrq.order = append(wrq.order, o...)
+	return wrq
+}
+
+// QueryWorkflow chains the current query on the "workflow" edge.
+func (wrq *WorkflowRevisionQuery) QueryWorkflow() *WorkflowQuery {
+	query := &WorkflowQuery{config: wrq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowrevision.Table, workflowrevision.FieldID, selector),
+			sqlgraph.To(workflow.Table, workflow.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workflowrevision.WorkflowTable, workflowrevision.WorkflowColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WorkflowRevision entity from the query.
+// Returns a *NotFoundError when no WorkflowRevision was found.
+func (wrq *WorkflowRevisionQuery) First(ctx context.Context) (*WorkflowRevision, error) {
+	nodes, err := wrq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{workflowrevision.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wrq *WorkflowRevisionQuery) FirstX(ctx context.Context) *WorkflowRevision {
+	node, err := wrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WorkflowRevision ID from the query.
+// Returns a *NotFoundError when no WorkflowRevision ID was found.
+func (wrq *WorkflowRevisionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wrq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{workflowrevision.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wrq *WorkflowRevisionQuery) FirstIDX(ctx context.Context) int {
+	id, err := wrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WorkflowRevision entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one WorkflowRevision entity is not found.
+// Returns a *NotFoundError when no WorkflowRevision entities are found.
+func (wrq *WorkflowRevisionQuery) Only(ctx context.Context) (*WorkflowRevision, error) {
+	nodes, err := wrq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{workflowrevision.Label}
+	default:
+		return nil, &NotSingularError{workflowrevision.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wrq *WorkflowRevisionQuery) OnlyX(ctx context.Context) *WorkflowRevision {
+	node, err := wrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WorkflowRevision ID in the query.
+// Returns a *NotSingularError when exactly one WorkflowRevision ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (wrq *WorkflowRevisionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wrq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{workflowrevision.Label}
+	default:
+		err = &NotSingularError{workflowrevision.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wrq *WorkflowRevisionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WorkflowRevisions.
+func (wrq *WorkflowRevisionQuery) All(ctx context.Context) ([]*WorkflowRevision, error) {
+	if err := wrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wrq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wrq *WorkflowRevisionQuery) AllX(ctx context.Context) []*WorkflowRevision {
+	nodes, err := wrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WorkflowRevision IDs.
+func (wrq *WorkflowRevisionQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := wrq.Select(workflowrevision.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wrq *WorkflowRevisionQuery) IDsX(ctx context.Context) []int {
+	ids, err := wrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wrq *WorkflowRevisionQuery) Count(ctx context.Context) (int, error) {
+	if err := wrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wrq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wrq *WorkflowRevisionQuery) CountX(ctx context.Context) int {
+	count, err := wrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wrq *WorkflowRevisionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wrq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wrq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wrq *WorkflowRevisionQuery) ExistX(ctx context.Context) bool {
+	exist, err := wrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WorkflowRevisionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wrq *WorkflowRevisionQuery) Clone() *WorkflowRevisionQuery {
+	if wrq == nil {
+		return nil
+	}
+	return &WorkflowRevisionQuery{
+		config:       wrq.config,
+		limit:        wrq.limit,
+		offset:       wrq.offset,
+		order:        append([]OrderFunc{}, wrq.order...),
+		predicates:   append([]predicate.WorkflowRevision{}, wrq.predicates...),
+		withWorkflow: wrq.withWorkflow.Clone(),
+		// clone intermediate query.
+		sql:  wrq.sql.Clone(),
+		path: wrq.path,
+	}
+}
+
+// WithWorkflow tells the query-builder to eager-load the nodes that are connected to
+// the "workflow" edge. The optional arguments are used to configure the query builder of the edge.
+func (wrq *WorkflowRevisionQuery) WithWorkflow(opts ...func(*WorkflowQuery)) *WorkflowRevisionQuery {
+	query := &WorkflowQuery{config: wrq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wrq.withWorkflow = query
+	return wrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Revision int `json:"revision,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WorkflowRevision.Query().
+//		GroupBy(workflowrevision.FieldRevision).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wrq *WorkflowRevisionQuery) GroupBy(field string, fields ...string) *WorkflowRevisionGroupBy {
+	group := &WorkflowRevisionGroupBy{config: wrq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wrq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Revision int `json:"revision,omitempty"`
+//	}
+//
+//	client.WorkflowRevision.Query().
+//		Select(workflowrevision.FieldRevision).
+//		Scan(ctx, &v)
+func (wrq *WorkflowRevisionQuery) Select(field string, fields ...string) *WorkflowRevisionSelect {
+	wrq.fields = append([]string{field}, fields...)
+	return &WorkflowRevisionSelect{WorkflowRevisionQuery: wrq}
+}
+
+func (wrq *WorkflowRevisionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range wrq.fields {
+		if !workflowrevision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wrq.path != nil {
+		prev, err := wrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wrq.sql = prev
+	}
+	return nil
+}
+
+func (wrq *WorkflowRevisionQuery) sqlAll(ctx context.Context) ([]*WorkflowRevision, error) {
+	var (
+		nodes       = []*WorkflowRevision{}
+		withFKs     = wrq.withFKs
+		_spec       = wrq.querySpec()
+		loadedTypes = [1]bool{
+			wrq.withWorkflow != nil,
+		}
+	)
+	if wrq.withWorkflow != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, workflowrevision.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &WorkflowRevision{config: wrq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := wrq.withWorkflow; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*WorkflowRevision)
+		for i := range nodes {
+			if nodes[i].workflow_revisions == nil {
+				continue
+			}
+			fk := *nodes[i].workflow_revisions
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(workflow.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "workflow_revisions" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Workflow = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (wrq *WorkflowRevisionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wrq.querySpec()
+	return sqlgraph.CountNodes(ctx, wrq.driver, _spec)
+}
+
+func (wrq *WorkflowRevisionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wrq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (wrq *WorkflowRevisionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   workflowrevision.Table,
+			Columns: workflowrevision.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: workflowrevision.FieldID,
+			},
+		},
+		From:   wrq.sql,
+		Unique: true,
+	}
+	if unique := wrq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := wrq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workflowrevision.FieldID)
+		for i := range fields {
+			if fields[i] != workflowrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wrq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wrq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wrq *WorkflowRevisionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wrq.driver.Dialect())
+	t1 := builder.Table(workflowrevision.Table)
+	selector := builder.Select(t1.Columns(workflowrevision.Columns...)...).From(t1)
+	if wrq.sql != nil {
+		selector = wrq.sql
+		selector.Select(selector.Columns(workflowrevision.Columns...)...)
+	}
+	for _, p := range wrq.predicates {
+		p(selector)
+	}
+	for _, p := range wrq.order {
+		p(selector)
+	}
+	if offset := wrq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wrq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WorkflowRevisionGroupBy is the group-by builder for WorkflowRevision entities.
+type WorkflowRevisionGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wrgb *WorkflowRevisionGroupBy) Aggregate(fns ...AggregateFunc) *WorkflowRevisionGroupBy {
+	wrgb.fns = append(wrgb.fns, fns...)
+	return wrgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wrgb *WorkflowRevisionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wrgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wrgb.sql = query
+	return wrgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wrgb *WorkflowRevisionGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := wrgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wrgb *WorkflowRevisionGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(wrgb.fields) > 1 {
+		return nil, errors.New("ent: WorkflowRevisionGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := wrgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wrgb *WorkflowRevisionGroupBy) StringsX(ctx context.Context) []string {
+	v, err := wrgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wrgb *WorkflowRevisionGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wrgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{workflowrevision.Label}
+	default:
+		err = fmt.Errorf("ent: WorkflowRevisionGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wrgb *WorkflowRevisionGroupBy) StringX(ctx context.Context) string {
+	v, err := wrgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wrgb *WorkflowRevisionGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(wrgb.fields) > 1 {
+		return nil, errors.New("ent: WorkflowRevisionGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := wrgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wrgb *WorkflowRevisionGroupBy) IntsX(ctx context.Context) []int {
+	v, err := wrgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wrgb *WorkflowRevisionGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wrgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{workflowrevision.Label}
+	default:
+		err = fmt.Errorf("ent: WorkflowRevisionGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wrgb *WorkflowRevisionGroupBy) IntX(ctx context.Context) int {
+	v, err := wrgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wrgb *WorkflowRevisionGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wrgb.fields) > 1 {
+		return nil, errors.New("ent: WorkflowRevisionGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := wrgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wrgb *WorkflowRevisionGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := wrgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wrgb *WorkflowRevisionGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wrgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{workflowrevision.Label}
+	default:
+		err = fmt.Errorf("ent: WorkflowRevisionGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wrgb *WorkflowRevisionGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := wrgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wrgb *WorkflowRevisionGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(wrgb.fields) > 1 {
+		return nil, errors.New("ent: WorkflowRevisionGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := wrgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wrgb *WorkflowRevisionGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := wrgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wrgb *WorkflowRevisionGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wrgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{workflowrevision.Label}
+	default:
+		err = fmt.Errorf("ent: WorkflowRevisionGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wrgb *WorkflowRevisionGroupBy) BoolX(ctx context.Context) bool {
+	v, err := wrgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wrgb *WorkflowRevisionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range wrgb.fields {
+		if !workflowrevision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wrgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wrgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wrgb *WorkflowRevisionGroupBy) sqlQuery() *sql.Selector {
+	selector := wrgb.sql
+	columns := make([]string, 0, len(wrgb.fields)+len(wrgb.fns))
+	columns = append(columns, wrgb.fields...)
+	for _, fn := range wrgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(wrgb.fields...)
+}
+
+// WorkflowRevisionSelect is the builder for selecting fields of WorkflowRevision entities.
+type WorkflowRevisionSelect struct {
+	*WorkflowRevisionQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wrs *WorkflowRevisionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := wrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wrs.sql = wrs.WorkflowRevisionQuery.sqlQuery(ctx)
+	return wrs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wrs *WorkflowRevisionSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := wrs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (wrs *WorkflowRevisionSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(wrs.fields) > 1 {
+		return nil, errors.New("ent: WorkflowRevisionSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := wrs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wrs *WorkflowRevisionSelect) StringsX(ctx context.Context) []string {
+	v, err := wrs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (wrs *WorkflowRevisionSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wrs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{workflowrevision.Label}
+	default:
+		err = fmt.Errorf("ent: WorkflowRevisionSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wrs *WorkflowRevisionSelect) StringX(ctx context.Context) string {
+	v, err := wrs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (wrs *WorkflowRevisionSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(wrs.fields) > 1 {
+		return nil, errors.New("ent: WorkflowRevisionSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := wrs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wrs *WorkflowRevisionSelect) IntsX(ctx context.Context) []int {
+	v, err := wrs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (wrs *WorkflowRevisionSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wrs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{workflowrevision.Label}
+	default:
+		err = fmt.Errorf("ent: WorkflowRevisionSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wrs *WorkflowRevisionSelect) IntX(ctx context.Context) int {
+	v, err := wrs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (wrs *WorkflowRevisionSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wrs.fields) > 1 {
+		return nil, errors.New("ent: WorkflowRevisionSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := wrs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wrs *WorkflowRevisionSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := wrs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (wrs *WorkflowRevisionSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wrs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{workflowrevision.Label}
+	default:
+		err = fmt.Errorf("ent: WorkflowRevisionSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wrs *WorkflowRevisionSelect) Float64X(ctx context.Context) float64 {
+	v, err := wrs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (wrs *WorkflowRevisionSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(wrs.fields) > 1 {
+		return nil, errors.New("ent: WorkflowRevisionSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := wrs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wrs *WorkflowRevisionSelect) BoolsX(ctx context.Context) []bool {
+	v, err := wrs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (wrs *WorkflowRevisionSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wrs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{workflowrevision.Label}
+	default:
+		err = fmt.Errorf("ent: WorkflowRevisionSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wrs *WorkflowRevisionSelect) BoolX(ctx context.Context) bool {
+	v, err := wrs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wrs *WorkflowRevisionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := wrs.sqlQuery().Query()
+	if err := wrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wrs *WorkflowRevisionSelect) sqlQuery() sql.Querier {
+	selector := wrs.sql
+	selector.Select(selector.Columns(wrs.fields...)...)
+	return selector
+}
@@ -0,0 +1,447 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/workflow"
+	"github.com/vorteil/direktiv/ent/workflowrevision"
+)
+
+// WorkflowRevisionUpdate is the builder for updating WorkflowRevision entities.
+type WorkflowRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WorkflowRevisionMutation
+}
+
+// Where adds a new predicate for the WorkflowRevisionUpdate builder.
+func (wru *WorkflowRevisionUpdate) Where(ps ...predicate.WorkflowRevision) *WorkflowRevisionUpdate {
+	wru.mutation.predicates = append(wru.mutation.predicates, ps...)
+	return wru
+}
+
+// SetRevision sets the "revision" field.
+func (wru *WorkflowRevisionUpdate) SetRevision(i int) *WorkflowRevisionUpdate {
+	wru.mutation.ResetRevision()
+	wru.mutation.SetRevision(i)
+	return wru
+}
+
+// AddRevision adds i to the "revision" field.
+func (wru *WorkflowRevisionUpdate) AddRevision(i int) *WorkflowRevisionUpdate {
+	wru.mutation.AddRevision(i)
+	return wru
+}
+
+// SetDefinition sets the "definition" field.
+func (wru *WorkflowRevisionUpdate) SetDefinition(b []byte) *WorkflowRevisionUpdate {
+	wru.mutation.SetDefinition(b)
+	return wru
+}
+
+// SetWorkflowID sets the "workflow" edge to the Workflow entity by ID.
+func (wru *WorkflowRevisionUpdate) SetWorkflowID(id uuid.UUID) *WorkflowRevisionUpdate {
+	wru.mutation.SetWorkflowID(id)
+	return wru
+}
+
+// SetWorkflow sets the "workflow" edge to the Workflow entity.
+func (wru *WorkflowRevisionUpdate) SetWorkflow(w *Workflow) *WorkflowRevisionUpdate {
+	return wru.SetWorkflowID(w.ID)
+}
+
+// Mutation returns the WorkflowRevisionMutation object of the builder.
+func (wru *WorkflowRevisionUpdate) Mutation() *WorkflowRevisionMutation {
+	return wru.mutation
+}
+
+// ClearWorkflow clears the "workflow" edge to the Workflow entity.
+func (wru *WorkflowRevisionUpdate) ClearWorkflow() *WorkflowRevisionUpdate {
+	wru.mutation.ClearWorkflow()
+	return wru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wru *WorkflowRevisionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wru.hooks) == 0 {
+		if err = wru.check(); err != nil {
+			return 0, err
+		}
+		affected, err = wru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WorkflowRevisionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wru.check(); err != nil {
+				return 0, err
+			}
+			wru.mutation = mutation
+			affected, err = wru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wru.hooks) - 1; i >= 0; i-- {
+			mut = wru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wru *WorkflowRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := wru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wru *WorkflowRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := wru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wru *WorkflowRevisionUpdate) ExecX(ctx context.Context) {
+	if err := wru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wru *WorkflowRevisionUpdate) check() error {
+	if _, ok := wru.mutation.WorkflowID(); wru.mutation.WorkflowCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"workflow\"")
+	}
+	return nil
+}
+
+func (wru *WorkflowRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   workflowrevision.Table,
+			Columns: workflowrevision.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: workflowrevision.FieldID,
+			},
+		},
+	}
+	if ps := wru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wru.mutation.Revision(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: workflowrevision.FieldRevision,
+		})
+	}
+	if value, ok := wru.mutation.AddedRevision(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: workflowrevision.FieldRevision,
+		})
+	}
+	if value, ok := wru.mutation.Definition(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowrevision.FieldDefinition,
+		})
+	}
+	if wru.mutation.WorkflowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowrevision.WorkflowTable,
+			Columns: []string{workflowrevision.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: workflow.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wru.mutation.WorkflowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowrevision.WorkflowTable,
+			Columns: []string{workflowrevision.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: workflow.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflowrevision.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WorkflowRevisionUpdateOne is the builder for updating a single WorkflowRevision entity.
+type WorkflowRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WorkflowRevisionMutation
+}
+
+// SetRevision sets the "revision" field.
+func (wruo *WorkflowRevisionUpdateOne) SetRevision(i int) *WorkflowRevisionUpdateOne {
+	wruo.mutation.ResetRevision()
+	wruo.mutation.SetRevision(i)
+	return wruo
+}
+
+// AddRevision adds i to the "revision" field.
+func (wruo *WorkflowRevisionUpdateOne) AddRevision(i int) *WorkflowRevisionUpdateOne {
+	wruo.mutation.AddRevision(i)
+	return wruo
+}
+
+// SetDefinition sets the "definition" field.
+func (wruo *WorkflowRevisionUpdateOne) SetDefinition(b []byte) *WorkflowRevisionUpdateOne {
+	wruo.mutation.SetDefinition(b)
+	return wruo
+}
+
+// SetWorkflowID sets the "workflow" edge to the Workflow entity by ID.
+func (wruo *WorkflowRevisionUpdateOne) SetWorkflowID(id uuid.UUID) *WorkflowRevisionUpdateOne {
+	wruo.mutation.SetWorkflowID(id)
+	return wruo
+}
+
+// SetWorkflow sets the "workflow" edge to the Workflow entity.
+func (wruo *WorkflowRevisionUpdateOne) SetWorkflow(w *Workflow) *WorkflowRevisionUpdateOne {
+	return wruo.SetWorkflowID(w.ID)
+}
+
+// Mutation returns the WorkflowRevisionMutation object of the builder.
+func (wruo *WorkflowRevisionUpdateOne) Mutation() *WorkflowRevisionMutation {
+	return wruo.mutation
+}
+
+// ClearWorkflow clears the "workflow" edge to the Workflow entity.
+func (wruo *WorkflowRevisionUpdateOne) ClearWorkflow() *WorkflowRevisionUpdateOne {
+	wruo.mutation.ClearWorkflow()
+	return wruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wruo *WorkflowRevisionUpdateOne) Select(field string, fields ...string) *WorkflowRevisionUpdateOne {
+	wruo.fields = append([]string{field}, fields...)
+	return wruo
+}
+
+// Save executes the query and returns the updated WorkflowRevision entity.
+func (wruo *WorkflowRevisionUpdateOne) Save(ctx context.Context) (*WorkflowRevision, error) {
+	var (
+		err  error
+		node *WorkflowRevision
+	)
+	if len(wruo.hooks) == 0 {
+		if err = wruo.check(); err != nil {
+			return nil, err
+		}
+		node, err = wruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WorkflowRevisionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wruo.check(); err != nil {
+				return nil, err
+			}
+			wruo.mutation = mutation
+			node, err = wruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wruo.hooks) - 1; i >= 0; i-- {
+			mut = wruo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wruo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wruo *WorkflowRevisionUpdateOne) SaveX(ctx context.Context) *WorkflowRevision {
+	node, err := wruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wruo *WorkflowRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := wruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wruo *WorkflowRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := wruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wruo *WorkflowRevisionUpdateOne) check() error {
+	if _, ok := wruo.mutation.WorkflowID(); wruo.mutation.WorkflowCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"workflow\"")
+	}
+	return nil
+}
+
+func (wruo *WorkflowRevisionUpdateOne) sqlSave(ctx context.Context) (_node *WorkflowRevision, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   workflowrevision.Table,
+			Columns: workflowrevision.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: workflowrevision.FieldID,
+			},
+		},
+	}
+	id, ok := wruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing WorkflowRevision.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workflowrevision.FieldID)
+		for _, f := range fields {
+			if !workflowrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != workflowrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wruo.mutation.Revision(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: workflowrevision.FieldRevision,
+		})
+	}
+	if value, ok := wruo.mutation.AddedRevision(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: workflowrevision.FieldRevision,
+		})
+	}
+	if value, ok := wruo.mutation.Definition(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: workflowrevision.FieldDefinition,
+		})
+	}
+	if wruo.mutation.WorkflowCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowrevision.WorkflowTable,
+			Columns: []string{workflowrevision.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: workflow.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wruo.mutation.WorkflowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowrevision.WorkflowTable,
+			Columns: []string{workflowrevision.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: workflow.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WorkflowRevision{config: wruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflowrevision.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
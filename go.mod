@@ -35,10 +35,13 @@ require (
 	github.com/sirupsen/logrus v1.8.1
 	github.com/sisatech/toml v0.0.0-20181010232116-ca247dd35773
 	github.com/spf13/cobra v1.1.3
+	github.com/tetratelabs/wazero v1.7.0
 	github.com/valyala/fasthttp v1.22.0
 	github.com/vorteil/direktiv-apps v0.0.0-20210423031131-1bc5000144a1
 	github.com/xeipuuv/gojsonschema v1.2.0
+	github.com/yuin/gopher-lua v1.1.0
 	golang.org/x/sys v0.0.0-20210320140829-1e4c9ba3b0c4
+	golang.org/x/time v0.0.0-20201208040808-7e3f01d25324
 	google.golang.org/grpc v1.36.0
 	google.golang.org/protobuf v1.25.0
 	gopkg.in/go-playground/assert.v1 v1.2.1 // indirect
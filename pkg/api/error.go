@@ -29,7 +29,7 @@ var grpcErrorHttpCodeMap = map[codes.Code]int{
 	codes.NotFound:           http.StatusNotFound,
 	codes.AlreadyExists:      http.StatusConflict,
 	codes.PermissionDenied:   http.StatusBadRequest,
-	codes.ResourceExhausted:  http.StatusBadRequest,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
 	codes.FailedPrecondition: http.StatusBadRequest,
 	codes.Aborted:            http.StatusBadRequest,
 	codes.OutOfRange:         http.StatusBadRequest,
@@ -0,0 +1,308 @@
+package api
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/vorteil/direktiv/pkg/ingress"
+)
+
+// formats instances can be exported in
+const (
+	exportJSONLines = "jsonl"
+	exportCSV       = "csv"
+)
+
+var exportColumns = []string{
+	"id", "workflow", "revision", "status", "invokedBy", "beginTime", "endTime",
+	"flow", "input", "output", "errorCode", "errorMessage", "logs",
+}
+
+type exportedLog struct {
+	Level   string            `json:"level,omitempty"`
+	Time    time.Time         `json:"time"`
+	Message string            `json:"message"`
+	Context map[string]string `json:"context,omitempty"`
+}
+
+type exportedInstance struct {
+	ID           string          `json:"id"`
+	Workflow     string          `json:"workflow"`
+	Revision     int32           `json:"revision"`
+	Status       string          `json:"status"`
+	InvokedBy    string          `json:"invokedBy,omitempty"`
+	BeginTime    time.Time       `json:"beginTime"`
+	EndTime      *time.Time      `json:"endTime,omitempty"`
+	Flow         []string        `json:"flow"`
+	Input        json.RawMessage `json:"input,omitempty"`
+	Output       json.RawMessage `json:"output,omitempty"`
+	ErrorCode    string          `json:"errorCode,omitempty"`
+	ErrorMessage string          `json:"errorMessage,omitempty"`
+	Logs         []exportedLog   `json:"logs,omitempty"`
+}
+
+// exportData returns b as JSON, or as a JSON string holding b if it isn't.
+func exportData(b []byte) json.RawMessage {
+
+	if len(b) == 0 {
+		return nil
+	}
+
+	if json.Valid(b) {
+		return json.RawMessage(b)
+	}
+
+	s, _ := json.Marshal(string(b))
+	return json.RawMessage(s)
+
+}
+
+func newExportedInstance(in *ingress.ExportInstancesResponse) *exportedInstance {
+
+	x := &exportedInstance{
+		ID:           in.GetId(),
+		Workflow:     in.GetWorkflow(),
+		Revision:     in.GetRevision(),
+		Status:       in.GetStatus(),
+		InvokedBy:    in.GetInvokedBy(),
+		BeginTime:    in.GetBeginTime().AsTime(),
+		Flow:         in.GetFlow(),
+		Input:        exportData(in.GetInput()),
+		Output:       exportData(in.GetOutput()),
+		ErrorCode:    in.GetErrorCode(),
+		ErrorMessage: in.GetErrorMessage(),
+	}
+
+	if in.EndTime != nil {
+		t := in.EndTime.AsTime()
+		x.EndTime = &t
+	}
+
+	for _, l := range in.GetLogs() {
+		x.Logs = append(x.Logs, exportedLog{
+			Level:   l.GetLevel(),
+			Time:    l.GetTimestamp().AsTime(),
+			Message: l.GetMessage(),
+			Context: l.GetContext(),
+		})
+	}
+
+	return x
+
+}
+
+// instanceExporter writes exported instances to a writer in one of the
+// export formats.
+type instanceExporter interface {
+	write(x *exportedInstance) error
+	flush() error
+}
+
+func newInstanceExporter(w io.Writer, format string) (instanceExporter, error) {
+
+	switch format {
+	case "", exportJSONLines:
+		return &jsonLinesExporter{enc: json.NewEncoder(w)}, nil
+	case exportCSV:
+		return &csvExporter{w: csv.NewWriter(w)}, nil
+	}
+
+	return nil, status.Errorf(codes.InvalidArgument, "unsupported export format '%s', expected %s or %s",
+		format, exportJSONLines, exportCSV)
+
+}
+
+type jsonLinesExporter struct {
+	enc *json.Encoder
+}
+
+func (e *jsonLinesExporter) write(x *exportedInstance) error {
+	return e.enc.Encode(x)
+}
+
+func (e *jsonLinesExporter) flush() error {
+	return nil
+}
+
+// csvExporter writes an instance per row. The flow is separated by commas
+// and the logs are a JSON array.
+type csvExporter struct {
+	w      *csv.Writer
+	header bool
+}
+
+func (e *csvExporter) write(x *exportedInstance) error {
+
+	if !e.header {
+		e.header = true
+		err := e.w.Write(exportColumns)
+		if err != nil {
+			return err
+		}
+	}
+
+	var end string
+	if x.EndTime != nil {
+		end = x.EndTime.Format(time.RFC3339Nano)
+	}
+
+	var logs []byte
+	if len(x.Logs) > 0 {
+		var err error
+		logs, err = json.Marshal(x.Logs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.w.Write([]string{
+		x.ID,
+		x.Workflow,
+		strconv.Itoa(int(x.Revision)),
+		x.Status,
+		x.InvokedBy,
+		x.BeginTime.Format(time.RFC3339Nano),
+		end,
+		strings.Join(x.Flow, ","),
+		string(x.Input),
+		string(x.Output),
+		x.ErrorCode,
+		x.ErrorMessage,
+		string(logs),
+	})
+
+}
+
+func (e *csvExporter) flush() error {
+
+	if !e.header {
+		e.header = true
+		err := e.w.Write(exportColumns)
+		if err != nil {
+			return err
+		}
+	}
+
+	e.w.Flush()
+	return e.w.Error()
+
+}
+
+func exportTimeParam(r *http.Request, name string) (*timestamppb.Timestamp, error) {
+
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s must be an RFC3339 timestamp: %v", name, err)
+	}
+
+	return timestamppb.New(t), nil
+
+}
+
+// exportInstances streams the instances of a namespace, oldest first, as
+// JSON Lines or CSV. They can be filtered by workflow, status and begin time,
+// and include their logs if logs is true.
+func (h *Handler) exportInstances(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	q := r.URL.Query()
+	format := q.Get("format")
+	wf := q.Get("workflow")
+	st := q.Get("status")
+	logs := q.Get("logs") == "true"
+
+	after, err := exportTimeParam(r, "after")
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	before, err := exportTimeParam(r, "before")
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	exp, err := newInstanceExporter(w, format)
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	// exports can take much longer than other requests
+	ctx := r.Context()
+
+	client, err := h.s.direktiv.ExportInstances(ctx, &ingress.ExportInstancesRequest{
+		Namespace: &n,
+		Workflow:  &wf,
+		Status:    &st,
+		After:     after,
+		Before:    before,
+		Logs:      &logs,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	// errors can still be reported until the first instance is written
+	in, err := client.Recv()
+	if err != nil && err != io.EOF {
+		ErrResponse(w, err)
+		return
+	}
+
+	ext, ct := exportJSONLines, "application/x-ndjson"
+	if format == exportCSV {
+		ext, ct = exportCSV, "text/csv"
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s-instances.%s", n, ext))
+	w.Header().Set("Content-Type", ct)
+
+	flusher, _ := w.(http.Flusher)
+
+	for err == nil {
+
+		err = exp.write(newExportedInstance(in))
+		if err != nil {
+			log.Errorf("error writing instance export: %v", err)
+			return
+		}
+
+		if flusher != nil {
+			_ = exp.flush()
+			flusher.Flush()
+		}
+
+		in, err = client.Recv()
+
+	}
+
+	// the response can only be cut short now
+	if err != io.EOF {
+		log.Errorf("error exporting instances of %s: %v", n, err)
+		return
+	}
+
+	_ = exp.flush()
+
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/vorteil/direktiv/pkg/ingress"
+)
+
+// legalHold places a legal hold on a namespace or instance with PUT, giving
+// its reason in the body, or releases it with DELETE.
+func (h *Handler) legalHold(w http.ResponseWriter, r *http.Request) {
+
+	vars := mux.Vars(r)
+	n := vars["namespace"]
+
+	var iid string
+	if id, ok := vars["id"]; ok {
+		iid = fmt.Sprintf("%s/%s/%s", n, vars["workflowTarget"], id)
+	}
+
+	hold := r.Method == http.MethodPut
+
+	body := new(struct {
+		Reason string `json:"reason"`
+	})
+
+	if hold {
+		err := json.NewDecoder(r.Body).Decode(body)
+		if err != nil && err != io.EOF {
+			ErrResponse(w, fmt.Errorf("invalid legal hold: %v", err))
+			return
+		}
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.SetLegalHold(ctx, &ingress.SetLegalHoldRequest{
+		Namespace: &n,
+		Instance:  &iid,
+		Hold:      &hold,
+		Reason:    &body.Reason,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// cronSuspension suspends the scheduled starts of a namespace with PUT,
+// optionally giving a reason in the body, or resumes them with DELETE.
+func (h *Handler) cronSuspension(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	suspend := r.Method == http.MethodPut
+
+	body := new(struct {
+		Reason string `json:"reason"`
+	})
+
+	if suspend {
+		err := json.NewDecoder(r.Body).Decode(body)
+		if err != nil && err != io.EOF {
+			ErrResponse(w, fmt.Errorf("invalid cron suspension: %v", err))
+			return
+		}
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.SetCronSuspension(ctx, &ingress.SetCronSuspensionRequest{
+		Namespace: &n,
+		Suspend:   &suspend,
+		Reason:    &body.Reason,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
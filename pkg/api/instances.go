@@ -1,8 +1,13 @@
 package api
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/rung/go-safecast"
@@ -35,6 +40,10 @@ func (h *Handler) instances(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// filters are json documents the instance's data must contain
+	data := r.URL.Query().Get("data")
+	output := r.URL.Query().Get("output")
+
 	ctx, cancel := CtxDeadline(r.Context())
 	defer cancel()
 
@@ -42,6 +51,8 @@ func (h *Handler) instances(w http.ResponseWriter, r *http.Request) {
 		Namespace: &n,
 		Offset:    &offset,
 		Limit:     &limit,
+		StateData: &data,
+		Output:    &output,
 	})
 
 	if err != nil {
@@ -83,12 +94,77 @@ func (h *Handler) cancelInstance(w http.ResponseWriter, r *http.Request) {
 	id := mux.Vars(r)["id"]
 
 	iid := fmt.Sprintf("%s/%s/%s", n, name, id)
+	reason := r.URL.Query().Get("reason")
+	category := r.URL.Query().Get("category")
 
 	ctx, cancel := CtxDeadline(r.Context())
 	defer cancel()
 
 	resp, err := h.s.direktiv.CancelWorkflowInstance(ctx, &ingress.CancelWorkflowInstanceRequest{
-		Id: &iid,
+		Id:       &iid,
+		Reason:   &reason,
+		Category: &category,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// actionCallback receives the results of an action that reports back when it
+// finishes. The body is the action output, and errors are reported with the
+// same headers that isolates use. Only addresses signed by the flow server,
+// carrying their token, are accepted.
+func (h *Handler) actionCallback(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	name := mux.Vars(r)["workflowTarget"]
+	id := mux.Vars(r)["id"]
+	action := mux.Vars(r)["action"]
+
+	iid := fmt.Sprintf("%s/%s/%s", n, name, id)
+
+	s, err := strconv.Atoi(r.URL.Query().Get("step"))
+	if err != nil {
+		ErrResponse(w, fmt.Errorf("invalid step: %v", err))
+		return
+	}
+
+	step, err := safecast.Int32(s)
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	output, err := ioutil.ReadAll(io.LimitReader(r.Body, grpcChunkSize+1))
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	if len(output) > grpcChunkSize {
+		ErrResponse(w, errors.New("action output is too large"))
+		return
+	}
+
+	code := r.Header.Get("Direktiv-ErrorCode")
+	msg := r.Header.Get("Direktiv-ErrorMessage")
+	token := r.URL.Query().Get("token")
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.ReportActionCallback(ctx, &ingress.ReportActionCallbackRequest{
+		InstanceId:   &iid,
+		Step:         &step,
+		ActionId:     &action,
+		ErrorCode:    &code,
+		ErrorMessage: &msg,
+		Output:       output,
+		Token:        &token,
 	})
 	if err != nil {
 		ErrResponse(w, err)
@@ -144,3 +220,400 @@ func (h *Handler) instanceLogs(w http.ResponseWriter, r *http.Request) {
 	writeData(resp, w)
 
 }
+
+func (h *Handler) queryInstance(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	name := mux.Vars(r)["workflowTarget"]
+	id := mux.Vars(r)["id"]
+
+	iid := fmt.Sprintf("%s/%s/%s", n, name, id)
+	query := r.URL.Query().Get("query")
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.QueryWorkflowInstance(ctx, &ingress.QueryWorkflowInstanceRequest{
+		Id:    &iid,
+		Query: &query,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+func (h *Handler) exportInstance(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	name := mux.Vars(r)["workflowTarget"]
+	id := mux.Vars(r)["id"]
+
+	iid := fmt.Sprintf("%s/%s/%s", n, name, id)
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.ExportWorkflowInstance(ctx, &ingress.ExportWorkflowInstanceRequest{
+		Id: &iid,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s-%s.json", name, id))
+	w.Header().Set("Content-Type", "application/json")
+
+	_, _ = w.Write(resp.Snapshot)
+
+}
+
+func (h *Handler) instanceHistory(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	name := mux.Vars(r)["workflowTarget"]
+	id := mux.Vars(r)["id"]
+
+	iid := fmt.Sprintf("%s/%s/%s", n, name, id)
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetWorkflowInstanceHistory(ctx, &ingress.GetWorkflowInstanceHistoryRequest{
+		Id: &iid,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s-%s-history.json", name, id))
+	w.Header().Set("Content-Type", "application/json")
+
+	_, _ = w.Write(resp.History)
+
+}
+
+// setBreakpoints replaces the breakpoints of a running instance with the
+// states listed in the body, an empty list clears them.
+func (h *Handler) setBreakpoints(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	name := mux.Vars(r)["workflowTarget"]
+	id := mux.Vars(r)["id"]
+
+	iid := fmt.Sprintf("%s/%s/%s", n, name, id)
+
+	body := new(struct {
+		Breakpoints []string `json:"breakpoints"`
+	})
+
+	err := json.NewDecoder(r.Body).Decode(body)
+	if err != nil {
+		ErrResponse(w, fmt.Errorf("invalid breakpoints: %v", err))
+		return
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.SetWorkflowInstanceBreakpoints(ctx, &ingress.SetWorkflowInstanceBreakpointsRequest{
+		Id:          &iid,
+		Breakpoints: body.Breakpoints,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// setInstanceDebug turns debugging the transforms of a running instance on or
+// off, e.g. {"transforms": true}.
+func (h *Handler) setInstanceDebug(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	name := mux.Vars(r)["workflowTarget"]
+	id := mux.Vars(r)["id"]
+
+	iid := fmt.Sprintf("%s/%s/%s", n, name, id)
+
+	body := new(struct {
+		Transforms bool `json:"transforms"`
+	})
+
+	err := json.NewDecoder(r.Body).Decode(body)
+	if err != nil {
+		ErrResponse(w, fmt.Errorf("invalid debug settings: %v", err))
+		return
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.SetWorkflowInstanceDebug(ctx, &ingress.SetWorkflowInstanceDebugRequest{
+		Id:         &iid,
+		Transforms: &body.Transforms,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// resumeInstance resumes an instance paused at a breakpoint. The body names
+// the action and optionally the state data to resume with, and the state to
+// skip to.
+func (h *Handler) resumeInstance(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	name := mux.Vars(r)["workflowTarget"]
+	id := mux.Vars(r)["id"]
+
+	iid := fmt.Sprintf("%s/%s/%s", n, name, id)
+
+	body := new(struct {
+		Action string          `json:"action"`
+		Data   json.RawMessage `json:"data"`
+		Next   string          `json:"next"`
+	})
+
+	err := json.NewDecoder(r.Body).Decode(body)
+	if err != nil && err != io.EOF {
+		ErrResponse(w, fmt.Errorf("invalid resume request: %v", err))
+		return
+	}
+
+	if string(body.Data) == "null" {
+		body.Data = nil
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.ResumeWorkflowInstance(ctx, &ingress.ResumeWorkflowInstanceRequest{
+		Id:     &iid,
+		Action: &body.Action,
+		Data:   body.Data,
+		Next:   &body.Next,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// forceTransition moves a stuck instance to the state named in the body, e.g.
+// {"state": "retry", "data": {...}, "reason": "..."}. The data replaces the
+// state data if given, and the reason is required.
+func (h *Handler) forceTransition(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	name := mux.Vars(r)["workflowTarget"]
+	id := mux.Vars(r)["id"]
+
+	iid := fmt.Sprintf("%s/%s/%s", n, name, id)
+
+	body := new(struct {
+		State  string          `json:"state"`
+		Data   json.RawMessage `json:"data"`
+		Reason string          `json:"reason"`
+	})
+
+	err := json.NewDecoder(r.Body).Decode(body)
+	if err != nil {
+		ErrResponse(w, fmt.Errorf("invalid transition request: %v", err))
+		return
+	}
+
+	if string(body.Data) == "null" {
+		body.Data = nil
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.ForceWorkflowInstanceTransition(ctx, &ingress.ForceWorkflowInstanceTransitionRequest{
+		Id:     &iid,
+		State:  &body.State,
+		Data:   body.Data,
+		Reason: &body.Reason,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// patchInstanceData applies the jq query in the body to the state data of a
+// pending instance, e.g. {"query": ".retries = 0", "reason": "..."}, and
+// returns the new state data. With "dryRun" it is only returned.
+func (h *Handler) patchInstanceData(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	name := mux.Vars(r)["workflowTarget"]
+	id := mux.Vars(r)["id"]
+
+	iid := fmt.Sprintf("%s/%s/%s", n, name, id)
+
+	body := new(struct {
+		Query  string `json:"query"`
+		Reason string `json:"reason"`
+		DryRun bool   `json:"dryRun"`
+	})
+
+	err := json.NewDecoder(r.Body).Decode(body)
+	if err != nil {
+		ErrResponse(w, fmt.Errorf("invalid patch request: %v", err))
+		return
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.PatchWorkflowInstanceData(ctx, &ingress.PatchWorkflowInstanceDataRequest{
+		Id:     &iid,
+		Query:  &body.Query,
+		Reason: &body.Reason,
+		DryRun: &body.DryRun,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// breakInstanceLock breaks the lock of an instance held by a node that is
+// gone, e.g. {"reason": "..."}, and runs the instance's current state again.
+func (h *Handler) breakInstanceLock(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	name := mux.Vars(r)["workflowTarget"]
+	id := mux.Vars(r)["id"]
+
+	iid := fmt.Sprintf("%s/%s/%s", n, name, id)
+
+	body := new(struct {
+		Reason string `json:"reason"`
+	})
+
+	err := json.NewDecoder(r.Body).Decode(body)
+	if err != nil {
+		ErrResponse(w, fmt.Errorf("invalid lock request: %v", err))
+		return
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.BreakWorkflowInstanceLock(ctx, &ingress.BreakWorkflowInstanceLockRequest{
+		Id:     &iid,
+		Reason: &body.Reason,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+func (h *Handler) importInstance(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	snapshot, err := ioutil.ReadAll(io.LimitReader(r.Body, grpcChunkSize+1))
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	if len(snapshot) > grpcChunkSize {
+		ErrResponse(w, errors.New("instance snapshot is too large"))
+		return
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.ImportWorkflowInstance(ctx, &ingress.ImportWorkflowInstanceRequest{
+		Namespace: &n,
+		Snapshot:  snapshot,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// purgeInstances erases the data of a subject from the finished instances of
+// a namespace. The subject is named by the external id its instances were
+// invoked with, or by JSON documents their input, state data or output
+// contain.
+func (h *Handler) purgeInstances(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	body := new(struct {
+		Workflow   string          `json:"workflow"`
+		ExternalID string          `json:"externalId"`
+		Input      json.RawMessage `json:"input"`
+		Data       json.RawMessage `json:"data"`
+		Output     json.RawMessage `json:"output"`
+	})
+
+	err := json.NewDecoder(r.Body).Decode(body)
+	if err != nil {
+		ErrResponse(w, fmt.Errorf("invalid purge request: %v", err))
+		return
+	}
+
+	filter := func(doc json.RawMessage) *string {
+		s := string(doc)
+		if s == "null" {
+			s = ""
+		}
+		return &s
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.PurgeInstances(ctx, &ingress.PurgeInstancesRequest{
+		Namespace:  &n,
+		Workflow:   &body.Workflow,
+		ExternalId: &body.ExternalID,
+		Input:      filter(body.Input),
+		StateData:  filter(body.Data),
+		Output:     filter(body.Output),
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
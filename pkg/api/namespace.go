@@ -1,14 +1,20 @@
 package api
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 
-	cloudevents "github.com/cloudevents/sdk-go"
 	"github.com/gorilla/mux"
 	"github.com/rung/go-safecast"
 	log "github.com/sirupsen/logrus"
 	"github.com/vorteil/direktiv/pkg/ingress"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (h *Handler) namespaces(w http.ResponseWriter, r *http.Request) {
@@ -34,8 +40,13 @@ func (h *Handler) addNamespace(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := CtxDeadline(r.Context())
 	defer cancel()
 
+	region := r.URL.Query().Get("region")
+	parent := r.URL.Query().Get("parent")
+
 	resp, err := h.s.direktiv.AddNamespace(ctx, &ingress.AddNamespaceRequest{
-		Name: &n,
+		Name:   &n,
+		Region: &region,
+		Parent: &parent,
 	})
 	if err != nil {
 		ErrResponse(w, err)
@@ -105,6 +116,25 @@ func (h *Handler) namespaceLogs(w http.ResponseWriter, r *http.Request) {
 	writeData(resp, w)
 }
 
+// namespaceDeadlines returns how close the states of a namespace came to
+// their deadlines lately, and whether they are slipping.
+func (h *Handler) namespaceDeadlines(w http.ResponseWriter, r *http.Request) {
+	n := mux.Vars(r)["namespace"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetDeadlineUsage(ctx, &ingress.GetDeadlineUsageRequest{
+		Namespace: &n,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+}
+
 func (h *Handler) namespaceEvent(w http.ResponseWriter, r *http.Request) {
 
 	n := mux.Vars(r)["namespace"]
@@ -115,22 +145,680 @@ func (h *Handler) namespaceEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	event := new(cloudevents.Event)
-	err = event.UnmarshalJSON(b)
-	if err != nil {
-		ErrResponse(w, err)
+	// invalid events are rejected, and counted, by the flow server
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	// traced events report the listeners they would match instead
+	if r.URL.Query().Get("trace") != "" {
+		resp, err := h.s.direktiv.TraceEvent(ctx, &ingress.TraceEventRequest{
+			Namespace:  &n,
+			Cloudevent: b,
+		})
+		if err != nil {
+			ErrResponse(w, err)
+			return
+		}
+		writeData(resp, w)
 		return
 	}
 
+	external := true
 	req := ingress.BroadcastEventRequest{
 		Namespace:  &n,
 		Cloudevent: b,
+		External:   &external,
+	}
+
+	resp, err := h.s.direktiv.BroadcastEvent(ctx, &req)
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// namespaceEventStats returns how many events of each type a namespace
+// received and whether they reached a workflow, with a sample of the recent
+// events that didn't.
+func (h *Handler) namespaceEventStats(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetEventStats(ctx, &ingress.GetEventStatsRequest{
+		Namespace: &n,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
 	}
 
+	writeData(resp, w)
+
+}
+
+// namespaceEventConfig returns the inbound event types and the sinks a
+// namespace configured for itself.
+func (h *Handler) namespaceEventConfig(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
 	ctx, cancel := CtxDeadline(r.Context())
 	defer cancel()
 
-	resp, err := h.s.direktiv.BroadcastEvent(ctx, &req)
+	resp, err := h.s.direktiv.GetNamespaceEventConfig(ctx, &ingress.GetNamespaceEventConfigRequest{
+		Namespace: &n,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// setNamespaceEventConfig replaces the event config of a namespace with the
+// one in the body, in the form namespaceEventConfig returns it. Unknown
+// fields are refused, so that settings of transports other than http sinks
+// aren't silently ignored.
+func (h *Handler) setNamespaceEventConfig(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	req := new(ingress.SetNamespaceEventConfigRequest)
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	err := dec.Decode(req)
+	if err != nil {
+		ErrResponse(w, status.Errorf(codes.InvalidArgument, "invalid event config: %v", err))
+		return
+	}
+
+	req.Namespace = &n
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.SetNamespaceEventConfig(ctx, req)
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// namespaceConstants returns the json object of constants a namespace
+// overrides for its workflows.
+func (h *Handler) namespaceConstants(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetNamespaceConstants(ctx, &ingress.GetNamespaceConstantsRequest{
+		Namespace: &n,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	data := resp.GetConstants()
+	if len(data) == 0 {
+		data = []byte("{}")
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+
+}
+
+// setNamespaceConstants replaces the constants of a namespace with the json
+// object in the body.
+func (h *Handler) setNamespaceConstants(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.SetNamespaceConstants(ctx, &ingress.SetNamespaceConstantsRequest{
+		Namespace: &n,
+		Constants: data,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// namespaceRetryPolicies returns the json object of retry policies a
+// namespace defines for its workflows.
+func (h *Handler) namespaceRetryPolicies(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetNamespaceRetryPolicies(ctx, &ingress.GetNamespaceRetryPoliciesRequest{
+		Namespace: &n,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	data := resp.GetPolicies()
+	if len(data) == 0 {
+		data = []byte("{}")
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+
+}
+
+// setNamespaceRetryPolicies replaces the retry policies of a namespace with
+// the json object in the body.
+func (h *Handler) setNamespaceRetryPolicies(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.SetNamespaceRetryPolicies(ctx, &ingress.SetNamespaceRetryPoliciesRequest{
+		Namespace: &n,
+		Policies:  data,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// namespaceProfiles returns the profiles of constants a namespace defines,
+// and the profile its new instances use.
+func (h *Handler) namespaceProfiles(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetNamespaceProfiles(ctx, &ingress.GetNamespaceProfilesRequest{
+		Namespace: &n,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	x := struct {
+		Namespace string                     `json:"namespace"`
+		Active    string                     `json:"active,omitempty"`
+		Inherited string                     `json:"inherited,omitempty"`
+		Profiles  map[string]json.RawMessage `json:"profiles"`
+	}{
+		Namespace: resp.GetNamespace(),
+		Active:    resp.GetActive(),
+		Inherited: resp.GetInherited(),
+		Profiles:  make(map[string]json.RawMessage),
+	}
+
+	for _, p := range resp.GetProfiles() {
+		x.Profiles[p.GetName()] = json.RawMessage(p.GetConstants())
+	}
+
+	data, err := json.Marshal(x)
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+
+}
+
+// setNamespaceProfile replaces the constants of a profile of a namespace
+// with the json object in the body, or deletes the profile.
+func (h *Handler) setNamespaceProfile(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	p := mux.Vars(r)["profile"]
+
+	var data []byte
+
+	if r.Method != http.MethodDelete {
+		var err error
+		data, err = ioutil.ReadAll(r.Body)
+		if err != nil {
+			ErrResponse(w, err)
+			return
+		}
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.SetNamespaceProfile(ctx, &ingress.SetNamespaceProfileRequest{
+		Namespace: &n,
+		Name:      &p,
+		Constants: data,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// setNamespaceActiveProfile selects the profile new instances of a namespace
+// use, or makes it inherit the one of its ancestors again.
+func (h *Handler) setNamespaceActiveProfile(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	body := new(struct {
+		Profile string `json:"profile"`
+	})
+
+	if r.Method != http.MethodDelete {
+		err := json.NewDecoder(r.Body).Decode(body)
+		if err != nil && err != io.EOF {
+			ErrResponse(w, fmt.Errorf("invalid profile: %v", err))
+			return
+		}
+		if body.Profile == "" {
+			ErrResponse(w, status.Error(codes.InvalidArgument, "a profile is required"))
+			return
+		}
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.SetNamespaceActiveProfile(ctx, &ingress.SetNamespaceActiveProfileRequest{
+		Namespace: &n,
+		Profile:   &body.Profile,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+func (h *Handler) jqModules(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetJQModules(ctx, &ingress.GetJQModulesRequest{
+		Namespace: &n,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// jqModule returns the source of a jq module of a namespace.
+func (h *Handler) jqModule(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	m := mux.Vars(r)["module"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetJQModule(ctx, &ingress.GetJQModuleRequest{
+		Namespace: &n,
+		Name:      &m,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	io.WriteString(w, resp.GetSource())
+
+}
+
+// setJQModule replaces a jq module of a namespace with the source in the
+// body.
+func (h *Handler) setJQModule(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	m := mux.Vars(r)["module"]
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	src := string(data)
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.SetJQModule(ctx, &ingress.SetJQModuleRequest{
+		Namespace: &n,
+		Name:      &m,
+		Source:    &src,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+func (h *Handler) deleteJQModule(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	m := mux.Vars(r)["module"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.DeleteJQModule(ctx, &ingress.DeleteJQModuleRequest{
+		Namespace: &n,
+		Name:      &m,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+func (h *Handler) wasmModules(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetWasmModules(ctx, &ingress.GetWasmModulesRequest{
+		Namespace: &n,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// wasmModule returns the binary of a wasm module of a namespace.
+func (h *Handler) wasmModule(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	m := mux.Vars(r)["module"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetWasmModule(ctx, &ingress.GetWasmModuleRequest{
+		Namespace: &n,
+		Name:      &m,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/wasm")
+	w.Write(resp.GetBinary())
+
+}
+
+// setWasmModule replaces a wasm module of a namespace with the binary in the
+// body.
+func (h *Handler) setWasmModule(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	m := mux.Vars(r)["module"]
+
+	binary, err := ioutil.ReadAll(io.LimitReader(r.Body, grpcChunkSize+1))
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	if len(binary) > grpcChunkSize {
+		ErrResponse(w, errors.New("wasm module is too large"))
+		return
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.SetWasmModule(ctx, &ingress.SetWasmModuleRequest{
+		Namespace: &n,
+		Name:      &m,
+		Binary:    binary,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+func (h *Handler) deleteWasmModule(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	m := mux.Vars(r)["module"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.DeleteWasmModule(ctx, &ingress.DeleteWasmModuleRequest{
+		Namespace: &n,
+		Name:      &m,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+func (h *Handler) jsonSchemas(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetJSONSchemas(ctx, &ingress.GetJSONSchemasRequest{
+		Namespace: &n,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// jsonSchemaVersion returns the version query parameter, or 0 for the
+// latest version if there is none.
+func jsonSchemaVersion(r *http.Request) (int32, error) {
+
+	v := r.URL.Query().Get("version")
+	if v == "" {
+		return 0, nil
+	}
+
+	i, err := strconv.Atoi(v)
+	if err != nil || i <= 0 {
+		return 0, fmt.Errorf("invalid version: %s", v)
+	}
+
+	return safecast.Int32(i)
+
+}
+
+// jsonSchema returns a version of a json schema of a namespace, the latest
+// unless the version query parameter asks for another.
+func (h *Handler) jsonSchema(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	s := mux.Vars(r)["schema"]
+
+	version, err := jsonSchemaVersion(r)
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetJSONSchema(ctx, &ingress.GetJSONSchemaRequest{
+		Namespace: &n,
+		Name:      &s,
+		Version:   &version,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Direktiv-Schema-Version", strconv.Itoa(int(resp.GetVersion())))
+	w.Write(resp.GetSchema())
+
+}
+
+// addJSONSchema stores the json schema in the body as the next version of a
+// schema of a namespace.
+func (h *Handler) addJSONSchema(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	s := mux.Vars(r)["schema"]
+
+	schema, err := ioutil.ReadAll(io.LimitReader(r.Body, grpcChunkSize+1))
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	if len(schema) > grpcChunkSize {
+		ErrResponse(w, errors.New("json schema is too large"))
+		return
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.AddJSONSchema(ctx, &ingress.AddJSONSchemaRequest{
+		Namespace: &n,
+		Name:      &s,
+		Schema:    schema,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// deleteJSONSchema deletes a json schema of a namespace, or only the version
+// the version query parameter asks for.
+func (h *Handler) deleteJSONSchema(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+	s := mux.Vars(r)["schema"]
+
+	version, err := jsonSchemaVersion(r)
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.DeleteJSONSchema(ctx, &ingress.DeleteJSONSchemaRequest{
+		Namespace: &n,
+		Name:      &s,
+		Version:   &version,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+func (h *Handler) namespaceActions(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetNamespaceActions(ctx, &ingress.GetNamespaceActionsRequest{
+		Namespace: &n,
+	})
 	if err != nil {
 		ErrResponse(w, err)
 		return
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/rung/go-safecast"
+
+	"github.com/vorteil/direktiv/pkg/ingress"
+)
+
+func (h *Handler) nodes(w http.ResponseWriter, r *http.Request) {
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetNodes(ctx, &ingress.GetNodesRequest{})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+func (h *Handler) nodeInstances(w http.ResponseWriter, r *http.Request) {
+
+	hostname := mux.Vars(r)["hostname"]
+	o, l := paginationParams(r)
+
+	if l < 1 {
+		l = 10
+	}
+
+	if o < 0 {
+		o = 0
+	}
+
+	limit, err := safecast.Int32(l)
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	offset, err := safecast.Int32(o)
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetNodeInstances(ctx, &ingress.GetNodeInstancesRequest{
+		Hostname: &hostname,
+		Offset:   &offset,
+		Limit:    &limit,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+func (h *Handler) promoteNode(w http.ResponseWriter, r *http.Request) {
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.PromoteNode(ctx, &ingress.PromoteNodeRequest{})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+func (h *Handler) invocationHalt(w http.ResponseWriter, r *http.Request) {
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetInvocationHalt(ctx, &ingress.GetInvocationHaltRequest{})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// setInvocationHalt stops the creation of new instances across the cluster
+// with PUT, giving the reason in the body, or lets it go on with DELETE.
+func (h *Handler) setInvocationHalt(w http.ResponseWriter, r *http.Request) {
+
+	halt := r.Method == http.MethodPut
+
+	body := new(struct {
+		Reason string `json:"reason"`
+	})
+
+	if halt {
+		err := json.NewDecoder(r.Body).Decode(body)
+		if err != nil && err != io.EOF {
+			ErrResponse(w, fmt.Errorf("invalid invocation halt: %v", err))
+			return
+		}
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.SetInvocationHalt(ctx, &ingress.SetInvocationHaltRequest{
+		Halt:   &halt,
+		Reason: &body.Reason,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
@@ -8,9 +8,40 @@ const (
 	RN_AddNamespace                = "addNamespace"
 	RN_DeleteNamespace             = "deleteNamespace"
 	RN_NamespaceEvent              = "namespaceEvent"
+	RN_GetNamespaceEventStats      = "getNamespaceEventStats"
+	RN_GetNamespaceEventConfig     = "getNamespaceEventConfig"
+	RN_SetNamespaceEventConfig     = "setNamespaceEventConfig"
+	RN_GetNamespaceConstants       = "getNamespaceConstants"
+	RN_SetNamespaceConstants       = "setNamespaceConstants"
+	RN_GetNamespaceRetryPolicies   = "getNamespaceRetryPolicies"
+	RN_SetNamespaceRetryPolicies   = "setNamespaceRetryPolicies"
+	RN_GetNamespaceProfiles        = "getNamespaceProfiles"
+	RN_SetNamespaceProfile         = "setNamespaceProfile"
+	RN_DeleteNamespaceProfile      = "deleteNamespaceProfile"
+	RN_SetNamespaceActiveProfile   = "setNamespaceActiveProfile"
+	RN_ClearNamespaceActiveProfile = "clearNamespaceActiveProfile"
+	RN_ListJQModules               = "listJQModules"
+	RN_GetJQModule                 = "getJQModule"
+	RN_SetJQModule                 = "setJQModule"
+	RN_DeleteJQModule              = "deleteJQModule"
+	RN_ListWasmModules             = "listWasmModules"
+	RN_GetWasmModule               = "getWasmModule"
+	RN_SetWasmModule               = "setWasmModule"
+	RN_DeleteWasmModule            = "deleteWasmModule"
+	RN_ListJSONSchemas             = "listJSONSchemas"
+	RN_GetJSONSchema               = "getJSONSchema"
+	RN_AddJSONSchema               = "addJSONSchema"
+	RN_DeleteJSONSchema            = "deleteJSONSchema"
+	RN_SetNamespaceHold            = "setNamespaceHold"
+	RN_ReleaseNamespaceHold        = "releaseNamespaceHold"
+	RN_SuspendNamespaceCrons       = "suspendNamespaceCrons"
+	RN_ResumeNamespaceCrons        = "resumeNamespaceCrons"
 	RN_ListSecrets                 = "listSecrets"
 	RN_CreateSecret                = "createSecret"
 	RN_DeleteSecret                = "deleteSecret"
+	RN_GetNamespaceKey             = "getNamespaceKey"
+	RN_SetNamespaceKey             = "setNamespaceKey"
+	RN_RemoveNamespaceKey          = "removeNamespaceKey"
 	RN_ListRegistries              = "listRegistries"
 	RN_CreateRegistry              = "createRegistry"
 	RN_DeleteRegistry              = "deleteRegistry"
@@ -23,11 +54,30 @@ const (
 	RN_DeleteWorkflow              = "deleteWorkflow"
 	RN_DownloadWorkflow            = "downloadWorkflow"
 	RN_ExecuteWorkflow             = "executeWorkflow"
+	RN_SimulateWorkflow            = "simulateWorkflow"
+	RN_ScheduleWorkflow            = "scheduleWorkflow"
+	RN_ListScheduledInvocations    = "listScheduledInvocations"
+	RN_CancelScheduledInvocation   = "cancelScheduledInvocation"
 	RN_ListWorkflowInstances       = "listWorkflowInstances"
 	RN_ListInstances               = "listInstances"
 	RN_GetInstance                 = "getInstance"
 	RN_CancelInstance              = "cancelInstance"
 	RN_GetInstanceLogs             = "getInstanceLogs"
+	RN_QueryInstance               = "queryInstance"
+	RN_ExportInstance              = "exportInstance"
+	RN_ImportInstance              = "importInstance"
+	RN_ExportInstances             = "exportInstances"
+	RN_PurgeInstances              = "purgeInstances"
+	RN_SetInstanceHold             = "setInstanceHold"
+	RN_ReleaseInstanceHold         = "releaseInstanceHold"
+	RN_GetInstanceHistory          = "getInstanceHistory"
+	RN_SetInstanceBreakpoints      = "setInstanceBreakpoints"
+	RN_SetInstanceDebug            = "setInstanceDebug"
+	RN_ResumeInstance              = "resumeInstance"
+	RN_ForceInstanceTransition     = "forceInstanceTransition"
+	RN_PatchInstanceData           = "patchInstanceData"
+	RN_BreakInstanceLock           = "breakInstanceLock"
+	RN_ReportActionCallback        = "reportActionCallback"
 	RN_ListActionTemplateFolders   = "listActionTemplateFolders"
 	RN_ListActionTemplates         = "listActionTemplates"
 	RN_GetActionTemplate           = "getActionTemplate"
@@ -40,8 +90,16 @@ const (
 	RN_ListNamespaceVariables      = "listNamespaceVariables"
 	RN_GetNamespaceVariable        = "getNamespaceVariable"
 	RN_GetNamespaceLogs            = "getNamespaceLogs"
+	RN_GetNamespaceDeadlines       = "getNamespaceDeadlines"
+	RN_GetNamespaceActions         = "getNamespaceActions"
 	RN_SetNamespaceVariable        = "setNamespaceVariable"
 	RN_JQPlayground                = "jqPlayground"
+	RN_ListNodes                   = "listNodes"
+	RN_ListNodeInstances           = "listNodeInstances"
+	RN_PromoteNode                 = "promoteNode"
+	RN_GetInvocationHalt           = "getInvocationHalt"
+	RN_HaltInvocations             = "haltInvocations"
+	RN_ResumeInvocations           = "resumeInvocations"
 )
 
 var RouteNames = []string{
@@ -50,10 +108,43 @@ var RouteNames = []string{
 	RN_AddNamespace,
 	RN_DeleteNamespace,
 	RN_NamespaceEvent,
+	RN_GetNamespaceEventStats,
+	RN_GetNamespaceEventConfig,
+	RN_SetNamespaceEventConfig,
+	RN_GetNamespaceConstants,
+	RN_SetNamespaceConstants,
+	RN_GetNamespaceRetryPolicies,
+	RN_SetNamespaceRetryPolicies,
+	RN_GetNamespaceProfiles,
+	RN_SetNamespaceProfile,
+	RN_DeleteNamespaceProfile,
+	RN_SetNamespaceActiveProfile,
+	RN_ClearNamespaceActiveProfile,
+	RN_ListJQModules,
+	RN_GetJQModule,
+	RN_SetJQModule,
+	RN_DeleteJQModule,
+	RN_ListWasmModules,
+	RN_GetWasmModule,
+	RN_SetWasmModule,
+	RN_DeleteWasmModule,
+	RN_ListJSONSchemas,
+	RN_GetJSONSchema,
+	RN_AddJSONSchema,
+	RN_DeleteJSONSchema,
+	RN_SetNamespaceHold,
+	RN_ReleaseNamespaceHold,
+	RN_SuspendNamespaceCrons,
+	RN_ResumeNamespaceCrons,
 	RN_GetNamespaceLogs,
+	RN_GetNamespaceDeadlines,
+	RN_GetNamespaceActions,
 	RN_ListSecrets,
 	RN_CreateSecret,
 	RN_DeleteSecret,
+	RN_GetNamespaceKey,
+	RN_SetNamespaceKey,
+	RN_RemoveNamespaceKey,
 	RN_ListRegistries,
 	RN_CreateRegistry,
 	RN_DeleteRegistry,
@@ -66,11 +157,30 @@ var RouteNames = []string{
 	RN_DeleteWorkflow,
 	RN_DownloadWorkflow,
 	RN_ExecuteWorkflow,
+	RN_SimulateWorkflow,
+	RN_ScheduleWorkflow,
+	RN_ListScheduledInvocations,
+	RN_CancelScheduledInvocation,
 	RN_ListWorkflowInstances,
 	RN_ListInstances,
 	RN_GetInstance,
 	RN_CancelInstance,
 	RN_GetInstanceLogs,
+	RN_QueryInstance,
+	RN_ExportInstance,
+	RN_ImportInstance,
+	RN_ExportInstances,
+	RN_PurgeInstances,
+	RN_SetInstanceHold,
+	RN_ReleaseInstanceHold,
+	RN_GetInstanceHistory,
+	RN_SetInstanceBreakpoints,
+	RN_SetInstanceDebug,
+	RN_ResumeInstance,
+	RN_ForceInstanceTransition,
+	RN_PatchInstanceData,
+	RN_BreakInstanceLock,
+	RN_ReportActionCallback,
 	RN_ListActionTemplateFolders,
 	RN_ListActionTemplates,
 	RN_GetActionTemplate,
@@ -84,4 +194,10 @@ var RouteNames = []string{
 	RN_GetNamespaceVariable,
 	RN_SetNamespaceVariable,
 	RN_JQPlayground,
+	RN_ListNodes,
+	RN_ListNodeInstances,
+	RN_PromoteNode,
+	RN_GetInvocationHalt,
+	RN_HaltInvocations,
+	RN_ResumeInvocations,
 }
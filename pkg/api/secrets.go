@@ -7,6 +7,8 @@ import (
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/vorteil/direktiv/pkg/ingress"
 )
@@ -147,3 +149,62 @@ func (h *Handler) deleteSecretOrRegistry(w http.ResponseWriter, r *http.Request)
 	writeData(resp, w)
 
 }
+
+func (h *Handler) getNamespaceKey(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetNamespaceKey(ctx, &ingress.GetNamespaceKeyRequest{
+		Namespace: &n,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+// setNamespaceKey makes the secrets of a namespace be encrypted with a data
+// key wrapped by the KMS key given in the body with PUT, e.g.
+// {"keyRef": "transit:payments"}. Putting the same key again rotates the
+// data key. DELETE goes back to the key of the server.
+func (h *Handler) setNamespaceKey(w http.ResponseWriter, r *http.Request) {
+
+	n := mux.Vars(r)["namespace"]
+
+	body := new(struct {
+		KeyRef string `json:"keyRef"`
+	})
+
+	if r.Method == http.MethodPut {
+		err := json.NewDecoder(r.Body).Decode(body)
+		if err != nil {
+			ErrResponse(w, fmt.Errorf("invalid namespace key: %v", err))
+			return
+		}
+		if body.KeyRef == "" {
+			ErrResponse(w, status.Errorf(codes.InvalidArgument, "keyRef is required"))
+			return
+		}
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.SetNamespaceKey(ctx, &ingress.SetNamespaceKeyRequest{
+		Namespace: &n,
+		KeyRef:    &body.KeyRef,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
@@ -83,7 +83,7 @@ func (s *Server) Router() *mux.Router {
 
 func (s *Server) initDirektiv() error {
 
-	conn, err := direktiv.GetEndpointTLS(s.cfg.Ingress.Endpoint, true)
+	conn, err := direktiv.GetEndpointPool(s.cfg.Ingress.Endpoint, true)
 	if err != nil {
 		log.Errorf("can not connect to direktiv ingress: %v", err)
 		return err
@@ -116,14 +116,47 @@ func (s *Server) prepareRoutes() {
 
 	// Logs ..
 	s.Router().HandleFunc("/api/namespaces/{namespace}/logs", s.handler.namespaceLogs).Methods(http.MethodGet).Name(RN_GetNamespaceLogs)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/deadlines", s.handler.namespaceDeadlines).Methods(http.MethodGet).Name(RN_GetNamespaceDeadlines)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/actions", s.handler.namespaceActions).Methods(http.MethodGet).Name(RN_GetNamespaceActions)
 
 	// Event ..
 	s.Router().HandleFunc("/api/namespaces/{namespace}/event", s.handler.namespaceEvent).Methods(http.MethodPost).Name(RN_NamespaceEvent)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/event/stats", s.handler.namespaceEventStats).Methods(http.MethodGet).Name(RN_GetNamespaceEventStats)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/event/config", s.handler.namespaceEventConfig).Methods(http.MethodGet).Name(RN_GetNamespaceEventConfig)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/event/config", s.handler.setNamespaceEventConfig).Methods(http.MethodPut).Name(RN_SetNamespaceEventConfig)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/constants", s.handler.namespaceConstants).Methods(http.MethodGet).Name(RN_GetNamespaceConstants)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/constants", s.handler.setNamespaceConstants).Methods(http.MethodPut).Name(RN_SetNamespaceConstants)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/retry-policies", s.handler.namespaceRetryPolicies).Methods(http.MethodGet).Name(RN_GetNamespaceRetryPolicies)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/retry-policies", s.handler.setNamespaceRetryPolicies).Methods(http.MethodPut).Name(RN_SetNamespaceRetryPolicies)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/profiles", s.handler.namespaceProfiles).Methods(http.MethodGet).Name(RN_GetNamespaceProfiles)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/profiles/{profile}", s.handler.setNamespaceProfile).Methods(http.MethodPut).Name(RN_SetNamespaceProfile)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/profiles/{profile}", s.handler.setNamespaceProfile).Methods(http.MethodDelete).Name(RN_DeleteNamespaceProfile)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/profile", s.handler.setNamespaceActiveProfile).Methods(http.MethodPut).Name(RN_SetNamespaceActiveProfile)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/profile", s.handler.setNamespaceActiveProfile).Methods(http.MethodDelete).Name(RN_ClearNamespaceActiveProfile)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/jq-modules/", s.handler.jqModules).Methods(http.MethodGet).Name(RN_ListJQModules)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/jq-modules/{module}", s.handler.jqModule).Methods(http.MethodGet).Name(RN_GetJQModule)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/jq-modules/{module}", s.handler.setJQModule).Methods(http.MethodPut).Name(RN_SetJQModule)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/jq-modules/{module}", s.handler.deleteJQModule).Methods(http.MethodDelete).Name(RN_DeleteJQModule)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/wasm-modules/", s.handler.wasmModules).Methods(http.MethodGet).Name(RN_ListWasmModules)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/wasm-modules/{module}", s.handler.wasmModule).Methods(http.MethodGet).Name(RN_GetWasmModule)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/wasm-modules/{module}", s.handler.setWasmModule).Methods(http.MethodPut).Name(RN_SetWasmModule)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/wasm-modules/{module}", s.handler.deleteWasmModule).Methods(http.MethodDelete).Name(RN_DeleteWasmModule)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/schemas/", s.handler.jsonSchemas).Methods(http.MethodGet).Name(RN_ListJSONSchemas)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/schemas/{schema}", s.handler.jsonSchema).Methods(http.MethodGet).Name(RN_GetJSONSchema)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/schemas/{schema}", s.handler.addJSONSchema).Methods(http.MethodPut).Name(RN_AddJSONSchema)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/schemas/{schema}", s.handler.deleteJSONSchema).Methods(http.MethodDelete).Name(RN_DeleteJSONSchema)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/hold", s.handler.legalHold).Methods(http.MethodPut).Name(RN_SetNamespaceHold)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/hold", s.handler.legalHold).Methods(http.MethodDelete).Name(RN_ReleaseNamespaceHold)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/crons/suspension", s.handler.cronSuspension).Methods(http.MethodPut).Name(RN_SuspendNamespaceCrons)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/crons/suspension", s.handler.cronSuspension).Methods(http.MethodDelete).Name(RN_ResumeNamespaceCrons)
 
 	// Secret ..
 	s.Router().HandleFunc("/api/namespaces/{namespace}/secrets/", s.handler.getSecretsOrRegistries).Methods(http.MethodGet).Name(RN_ListSecrets)
 	s.Router().HandleFunc("/api/namespaces/{namespace}/secrets/", s.handler.createSecretOrRegistry).Methods(http.MethodPost).Name(RN_CreateSecret)
 	s.Router().HandleFunc("/api/namespaces/{namespace}/secrets/", s.handler.deleteSecretOrRegistry).Methods(http.MethodDelete).Name(RN_DeleteSecret)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/key", s.handler.getNamespaceKey).Methods(http.MethodGet).Name(RN_GetNamespaceKey)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/key", s.handler.setNamespaceKey).Methods(http.MethodPut).Name(RN_SetNamespaceKey)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/key", s.handler.setNamespaceKey).Methods(http.MethodDelete).Name(RN_RemoveNamespaceKey)
 
 	// Registry ..
 	s.Router().HandleFunc("/api/namespaces/{namespace}/registries/", s.handler.getSecretsOrRegistries).Methods(http.MethodGet).Name(RN_ListRegistries)
@@ -142,6 +175,10 @@ func (s *Server) prepareRoutes() {
 	s.Router().HandleFunc("/api/namespaces/{namespace}/workflows/{workflowTarget}", s.handler.deleteWorkflow).Methods(http.MethodDelete).Name(RN_DeleteWorkflow)
 	s.Router().HandleFunc("/api/namespaces/{namespace}/workflows/{workflowTarget}/download", s.handler.downloadWorkflow).Methods(http.MethodGet).Name(RN_DownloadWorkflow)
 	s.Router().HandleFunc("/api/namespaces/{namespace}/workflows/{workflowTarget}/execute", s.handler.executeWorkflow).Methods(http.MethodPost, http.MethodGet).Name(RN_ExecuteWorkflow)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/workflows/{workflowTarget}/simulate", s.handler.simulateWorkflow).Methods(http.MethodPost).Name(RN_SimulateWorkflow)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/workflows/{workflowTarget}/schedule", s.handler.scheduleWorkflow).Methods(http.MethodPost).Name(RN_ScheduleWorkflow)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/scheduled/", s.handler.scheduledInvocations).Methods(http.MethodGet).Name(RN_ListScheduledInvocations)
+	s.Router().HandleFunc("/api/namespaces/{namespace}/scheduled/{id}", s.handler.cancelScheduledInvocation).Methods(http.MethodDelete).Name(RN_CancelScheduledInvocation)
 	s.Router().HandleFunc("/api/namespaces/{namespace}/workflows/{workflowTarget}/instances/", s.handler.workflowInstances).Methods(http.MethodGet).Name(RN_ListWorkflowInstances)
 
 	// Instance ..
@@ -149,6 +186,21 @@ func (s *Server) prepareRoutes() {
 	s.Router().HandleFunc("/api/instances/{namespace}/{workflowTarget}/{id}", s.handler.getInstance).Methods(http.MethodGet).Name(RN_GetInstance)
 	s.Router().HandleFunc("/api/instances/{namespace}/{workflowTarget}/{id}", s.handler.cancelInstance).Methods(http.MethodDelete).Name(RN_CancelInstance)
 	s.Router().HandleFunc("/api/instances/{namespace}/{workflowTarget}/{id}/logs", s.handler.instanceLogs).Methods(http.MethodGet).Name(RN_GetInstanceLogs)
+	s.Router().HandleFunc("/api/instances/{namespace}/{workflowTarget}/{id}/query", s.handler.queryInstance).Methods(http.MethodGet).Name(RN_QueryInstance)
+	s.Router().HandleFunc("/api/instances/{namespace}/{workflowTarget}/{id}/export", s.handler.exportInstance).Methods(http.MethodGet).Name(RN_ExportInstance)
+	s.Router().HandleFunc("/api/instances/{namespace}/import", s.handler.importInstance).Methods(http.MethodPost).Name(RN_ImportInstance)
+	s.Router().HandleFunc("/api/instances/{namespace}/export", s.handler.exportInstances).Methods(http.MethodGet).Name(RN_ExportInstances)
+	s.Router().HandleFunc("/api/instances/{namespace}/purge", s.handler.purgeInstances).Methods(http.MethodPost).Name(RN_PurgeInstances)
+	s.Router().HandleFunc("/api/instances/{namespace}/{workflowTarget}/{id}/history", s.handler.instanceHistory).Methods(http.MethodGet).Name(RN_GetInstanceHistory)
+	s.Router().HandleFunc("/api/instances/{namespace}/{workflowTarget}/{id}/breakpoints", s.handler.setBreakpoints).Methods(http.MethodPut).Name(RN_SetInstanceBreakpoints)
+	s.Router().HandleFunc("/api/instances/{namespace}/{workflowTarget}/{id}/debug", s.handler.setInstanceDebug).Methods(http.MethodPut).Name(RN_SetInstanceDebug)
+	s.Router().HandleFunc("/api/instances/{namespace}/{workflowTarget}/{id}/resume", s.handler.resumeInstance).Methods(http.MethodPost).Name(RN_ResumeInstance)
+	s.Router().HandleFunc("/api/instances/{namespace}/{workflowTarget}/{id}/transition", s.handler.forceTransition).Methods(http.MethodPost).Name(RN_ForceInstanceTransition)
+	s.Router().HandleFunc("/api/instances/{namespace}/{workflowTarget}/{id}/data", s.handler.patchInstanceData).Methods(http.MethodPatch).Name(RN_PatchInstanceData)
+	s.Router().HandleFunc("/api/instances/{namespace}/{workflowTarget}/{id}/lock", s.handler.breakInstanceLock).Methods(http.MethodDelete).Name(RN_BreakInstanceLock)
+	s.Router().HandleFunc("/api/instances/{namespace}/{workflowTarget}/{id}/hold", s.handler.legalHold).Methods(http.MethodPut).Name(RN_SetInstanceHold)
+	s.Router().HandleFunc("/api/instances/{namespace}/{workflowTarget}/{id}/hold", s.handler.legalHold).Methods(http.MethodDelete).Name(RN_ReleaseInstanceHold)
+	s.Router().HandleFunc("/api/instances/{namespace}/{workflowTarget}/{id}/actions/{action}", s.handler.actionCallback).Methods(http.MethodPost).Name(RN_ReportActionCallback)
 
 	// Templates ..
 	s.Router().HandleFunc("/api/action-templates/", s.handler.templateFolders).Methods(http.MethodGet).Name(RN_ListActionTemplateFolders)
@@ -170,6 +222,14 @@ func (s *Server) prepareRoutes() {
 	// jq Playground ...
 	s.Router().HandleFunc("/api/jq-playground", s.handler.jqPlayground).Methods(http.MethodPost).Name(RN_JQPlayground)
 
+	// Nodes ..
+	s.Router().HandleFunc("/api/nodes/", s.handler.nodes).Methods(http.MethodGet).Name(RN_ListNodes)
+	s.Router().HandleFunc("/api/nodes/{hostname}/instances/", s.handler.nodeInstances).Methods(http.MethodGet).Name(RN_ListNodeInstances)
+	s.Router().HandleFunc("/api/nodes/promote", s.handler.promoteNode).Methods(http.MethodPost).Name(RN_PromoteNode)
+	s.Router().HandleFunc("/api/halt", s.handler.invocationHalt).Methods(http.MethodGet).Name(RN_GetInvocationHalt)
+	s.Router().HandleFunc("/api/halt", s.handler.setInvocationHalt).Methods(http.MethodPut).Name(RN_HaltInvocations)
+	s.Router().HandleFunc("/api/halt", s.handler.setInvocationHalt).Methods(http.MethodDelete).Name(RN_ResumeInvocations)
+
 }
 
 const tlsDir = "/etc/certs/servedirektiv"
@@ -9,6 +9,7 @@ import (
 	"os"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -66,6 +67,22 @@ func paginationParams(r *http.Request) (offset, limit int) {
 	return
 }
 
+// breakpointsParam returns the states of the comma separated 'breakpoints'
+// query parameter.
+func breakpointsParam(r *http.Request) []string {
+
+	var breakpoints []string
+
+	for _, b := range strings.Split(r.URL.Query().Get("breakpoints"), ",") {
+		if b = strings.TrimSpace(b); b != "" {
+			breakpoints = append(breakpoints, b)
+		}
+	}
+
+	return breakpoints
+
+}
+
 // ErrResponse creates error based on grpc error
 func ErrResponse(w http.ResponseWriter, err error) {
 	eo := GenerateErrObject(err)
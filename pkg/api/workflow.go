@@ -402,6 +402,31 @@ func (h *Handler) executeWorkflow(w http.ResponseWriter, r *http.Request) {
 		field = r.URL.Query().Get("field")
 	}
 
+	entry := r.URL.Query().Get("entry")
+	external := r.URL.Query().Get("external")
+	breakpoints := breakpointsParam(r)
+	debugTransforms := r.URL.Query().Get("debugTransforms") != ""
+	verbose := r.URL.Query().Get("verbose") != ""
+
+	// set when another cluster invokes the workflow as an action
+	callback := r.Header.Get(direktiv.DirektivCallbackHeader)
+	callbackToken := r.Header.Get(direktiv.DirektivCallbackTokenHeader)
+	timeout := r.Header.Get(direktiv.DirektivTimeoutHeader)
+
+	var depth int32
+	if v := r.Header.Get(direktiv.DirektivCallerDepthHeader); v != "" {
+		d, err := strconv.Atoi(v)
+		if err != nil {
+			ErrResponse(w, fmt.Errorf("invalid caller depth: %v", err))
+			return
+		}
+		depth, err = safecast.Int32(d)
+		if err != nil {
+			ErrResponse(w, err)
+			return
+		}
+	}
+
 	var b []byte
 	var err error
 	if r.Method == http.MethodPost {
@@ -415,6 +440,11 @@ func (h *Handler) executeWorkflow(w http.ResponseWriter, r *http.Request) {
 		values := r.URL.Query()
 		values.Del("wait")
 		values.Del("field")
+		values.Del("entry")
+		values.Del("external")
+		values.Del("breakpoints")
+		values.Del("debugTransforms")
+		values.Del("verbose")
 
 		body := make(map[string]interface{})
 		for k := range values {
@@ -432,10 +462,19 @@ func (h *Handler) executeWorkflow(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 
 	resp, err := h.s.direktiv.InvokeWorkflow(ctx, &ingress.InvokeWorkflowRequest{
-		Namespace: &ns,
-		Name:      &name,
-		Input:     b,
-		Wait:      &wait,
+		Namespace:       &ns,
+		Name:            &name,
+		Input:           b,
+		Wait:            &wait,
+		State:           &entry,
+		ExternalId:      &external,
+		Callback:        &callback,
+		CallbackToken:   &callbackToken,
+		Timeout:         &timeout,
+		CallerDepth:     &depth,
+		Breakpoints:     breakpoints,
+		DebugTransforms: &debugTransforms,
+		Verbose:         &verbose,
 	})
 
 	if err != nil {
@@ -443,6 +482,11 @@ func (h *Handler) executeWorkflow(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// queued invocations have no output to wait for yet
+	if resp.GetQueued() {
+		wait = false
+	}
+
 	// for wait there is special handling
 	if wait && field != "" {
 
@@ -469,6 +513,159 @@ func (h *Handler) executeWorkflow(w http.ResponseWriter, r *http.Request) {
 
 }
 
+// simulateWorkflow executes a workflow with mocked actions. The body holds the
+// input and the mocks, and 'wait' and 'entry' work as they do for execute.
+func (h *Handler) simulateWorkflow(w http.ResponseWriter, r *http.Request) {
+
+	ns := mux.Vars(r)["namespace"]
+	name := mux.Vars(r)["workflowTarget"]
+
+	wait := r.URL.Query().Get("wait") != ""
+	entry := r.URL.Query().Get("entry")
+	breakpoints := breakpointsParam(r)
+	debugTransforms := r.URL.Query().Get("debugTransforms") != ""
+	verbose := r.URL.Query().Get("verbose") != ""
+
+	body := new(struct {
+		Input json.RawMessage `json:"input"`
+		Mocks json.RawMessage `json:"mocks"`
+	})
+
+	err := json.NewDecoder(r.Body).Decode(body)
+	if err != nil {
+		ErrResponse(w, fmt.Errorf("invalid simulation: %v", err))
+		return
+	}
+
+	if len(body.Input) == 0 {
+		body.Input = json.RawMessage("{}")
+	}
+
+	// an empty mock specification still keeps every action from running
+	if len(body.Mocks) == 0 || string(body.Mocks) == "null" {
+		body.Mocks = json.RawMessage("{}")
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.InvokeWorkflow(ctx, &ingress.InvokeWorkflowRequest{
+		Namespace:       &ns,
+		Name:            &name,
+		Input:           body.Input,
+		Wait:            &wait,
+		State:           &entry,
+		Mocks:           body.Mocks,
+		Breakpoints:     breakpoints,
+		DebugTransforms: &debugTransforms,
+		Verbose:         &verbose,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	if wait && !resp.GetQueued() {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(direktiv.DirektivInstanceIDHeader, resp.GetInstanceId())
+		w.Write(resp.Output)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		ErrResponse(w, err)
+	}
+
+}
+
+// scheduleWorkflow invokes a workflow once at the RFC3339 timestamp in the
+// 'time' query parameter, with the body as input. 'entry' and 'external'
+// work as they do for execute. With an ISO8601 'ttl' the invocation expires
+// if it hasn't started that long after its time, and 'notify' emits an event
+// when it does.
+func (h *Handler) scheduleWorkflow(w http.ResponseWriter, r *http.Request) {
+
+	ns := mux.Vars(r)["namespace"]
+	name := mux.Vars(r)["workflowTarget"]
+
+	entry := r.URL.Query().Get("entry")
+	external := r.URL.Query().Get("external")
+	ttl := r.URL.Query().Get("ttl")
+	notify := r.URL.Query().Get("notify") != ""
+
+	t, err := exportTimeParam(r, "time")
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.ScheduleInvocation(ctx, &ingress.ScheduleInvocationRequest{
+		Namespace:  &ns,
+		Name:       &name,
+		Time:       t,
+		Input:      b,
+		State:      &entry,
+		ExternalId: &external,
+		Ttl:        &ttl,
+		Notify:     &notify,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+func (h *Handler) scheduledInvocations(w http.ResponseWriter, r *http.Request) {
+
+	ns := mux.Vars(r)["namespace"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	resp, err := h.s.direktiv.GetScheduledInvocations(ctx, &ingress.GetScheduledInvocationsRequest{
+		Namespace: &ns,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+	writeData(resp, w)
+
+}
+
+func (h *Handler) cancelScheduledInvocation(w http.ResponseWriter, r *http.Request) {
+
+	ns := mux.Vars(r)["namespace"]
+	id := mux.Vars(r)["id"]
+
+	ctx, cancel := CtxDeadline(r.Context())
+	defer cancel()
+
+	_, err := h.s.direktiv.CancelScheduledInvocation(ctx, &ingress.CancelScheduledInvocationRequest{
+		Namespace: &ns,
+		Id:        &id,
+	})
+	if err != nil {
+		ErrResponse(w, err)
+		return
+	}
+
+}
+
 func (h *Handler) workflowInstances(w http.ResponseWriter, r *http.Request) {
 
 	ns := mux.Vars(r)["namespace"]
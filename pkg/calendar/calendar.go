@@ -0,0 +1,149 @@
+package calendar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// DateFormat is the layout of holiday dates.
+	DateFormat = "2006-01-02"
+	// ClockFormat is the layout of times of day.
+	ClockFormat = "15:04"
+)
+
+// maxSearchDays bounds the search for a business day so that a calendar made
+// up entirely of holidays can't loop forever.
+const maxSearchDays = 366 * 5
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Calendar describes which days are business days. A nil Calendar treats
+// every day as a business day in UTC.
+type Calendar struct {
+	Timezone    string   `json:"timezone,omitempty"`
+	WorkingDays []string `json:"workingDays,omitempty"`
+	Holidays    []string `json:"holidays,omitempty"`
+
+	loc      *time.Location
+	days     map[time.Weekday]bool
+	holidays map[string]bool
+}
+
+// Parse decodes a JSON calendar document. Working days default to Monday to
+// Friday and the timezone defaults to UTC.
+func Parse(data []byte) (*Calendar, error) {
+
+	c := new(Calendar)
+
+	err := json.Unmarshal(data, c)
+	if err != nil {
+		return nil, fmt.Errorf("invalid calendar: %v", err)
+	}
+
+	c.loc = time.UTC
+	if c.Timezone != "" {
+		c.loc, err = time.LoadLocation(c.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid calendar timezone: %v", err)
+		}
+	}
+
+	days := c.WorkingDays
+	if len(days) == 0 {
+		days = []string{"mon", "tue", "wed", "thu", "fri"}
+	}
+
+	c.days = make(map[time.Weekday]bool)
+	for _, s := range days {
+		key := strings.ToLower(s)
+		if len(key) > 3 {
+			key = key[:3]
+		}
+		day, ok := weekdays[key]
+		if !ok {
+			return nil, fmt.Errorf("invalid calendar working day: %s", s)
+		}
+		c.days[day] = true
+	}
+
+	c.holidays = make(map[string]bool)
+	for _, s := range c.Holidays {
+		if _, err = time.Parse(DateFormat, s); err != nil {
+			return nil, fmt.Errorf("invalid calendar holiday: %s", s)
+		}
+		c.holidays[s] = true
+	}
+
+	return c, nil
+
+}
+
+func (c *Calendar) location() *time.Location {
+	if c == nil {
+		return time.UTC
+	}
+	return c.loc
+}
+
+// IsBusinessDay reports whether t falls on a working day that isn't a holiday,
+// according to the calendar's timezone.
+func (c *Calendar) IsBusinessDay(t time.Time) bool {
+
+	if c == nil {
+		return true
+	}
+
+	t = t.In(c.loc)
+
+	return c.days[t.Weekday()] && !c.holidays[t.Format(DateFormat)]
+
+}
+
+// NextBusinessDay returns t if it falls on a business day, or otherwise the
+// same time of day on the first business day after it.
+func (c *Calendar) NextBusinessDay(t time.Time) (time.Time, error) {
+
+	t = t.In(c.location())
+
+	for i := 0; i < maxSearchDays; i++ {
+		if c.IsBusinessDay(t) {
+			return t, nil
+		}
+		t = t.AddDate(0, 0, 1)
+	}
+
+	return t, errors.New("calendar has no business days")
+
+}
+
+// NextAt returns the first time after t that falls on a business day at the
+// given time of day (in ClockFormat).
+func (c *Calendar) NextAt(t time.Time, clock string) (time.Time, error) {
+
+	hm, err := time.Parse(ClockFormat, clock)
+	if err != nil {
+		return t, fmt.Errorf("invalid time of day: %s", clock)
+	}
+
+	t = t.In(c.location())
+
+	next := time.Date(t.Year(), t.Month(), t.Day(), hm.Hour(), hm.Minute(), 0, 0, t.Location())
+	if !next.After(t) {
+		next = next.AddDate(0, 0, 1)
+	}
+
+	return c.NextBusinessDay(next)
+
+}
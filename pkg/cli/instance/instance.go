@@ -60,11 +60,14 @@ type instanceLogs struct {
 // CreateCommand adds instance commands
 func CreateCommand() *cobra.Command {
 
-	cmd := util.GenerateCmd("instances", "List, get and retrieve logs for instances", "", nil, nil)
+	cmd := util.GenerateCmd("instances", "List, get, retrieve logs for and replay instances", "", nil, nil)
 
 	cmd.AddCommand(instanceGetCmd)
 	cmd.AddCommand(instanceListCmd)
 	cmd.AddCommand(instanceLogsCmd)
+	cmd.AddCommand(instanceHistoryCmd)
+	cmd.AddCommand(instanceReplayCmd)
+	cmd.AddCommand(instanceGoldenCmd)
 
 	return cmd
 
@@ -0,0 +1,207 @@
+package instance
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vorteil/direktiv/pkg/cli/util"
+	"github.com/vorteil/direktiv/pkg/replay"
+)
+
+var instanceHistoryCmd = util.GenerateCmd("history ID [FILE]", "Download the recorded history of an instance for replays", "", func(cmd *cobra.Command, args []string) {
+
+	h, err := util.DoRequest(http.MethodGet, fmt.Sprintf("/instances/%s/history", args[0]),
+		util.NONECt, nil)
+	if err != nil {
+		log.Fatalf("error getting instance history: %v", err)
+	}
+
+	if len(args) < 2 {
+		fmt.Println(string(h))
+		return
+	}
+
+	err = ioutil.WriteFile(args[1], h, 0644)
+	if err != nil {
+		log.Fatalf("error writing instance history: %v", err)
+	}
+
+}, cobra.RangeArgs(1, 2))
+
+var instanceGoldenCmd = util.GenerateCmd("golden ID FILE", "Record a completed instance as a golden run to verify workflows against", "", func(cmd *cobra.Command, args []string) {
+
+	data, err := util.DoRequest(http.MethodGet, fmt.Sprintf("/instances/%s/history", args[0]),
+		util.NONECt, nil)
+	if err != nil {
+		log.Fatalf("error getting instance history: %v", err)
+	}
+
+	h := new(replay.History)
+	err = json.Unmarshal(data, h)
+	if err != nil {
+		log.Fatalf("error reading instance history: %v", err)
+	}
+
+	err = replay.Golden(h)
+	if err != nil {
+		log.Fatalf("instance can not be a golden run: %v", err)
+	}
+
+	data, err = json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		log.Fatalf("error writing golden run: %v", err)
+	}
+
+	err = ioutil.WriteFile(args[1], data, 0644)
+	if err != nil {
+		log.Fatalf("error writing golden run: %v", err)
+	}
+
+}, cobra.ExactArgs(2))
+
+const replayHelp = `enter or n: next step, d: data of the step, c: continue to the next divergence, q: quit`
+
+var instanceReplayCmd = util.GenerateCmd("replay FILE", "Step through a recorded instance history", replayHelp, func(cmd *cobra.Command, args []string) {
+
+	/* #nosec */
+	data, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		log.Fatalf("error reading instance history: %v", err)
+	}
+
+	h := new(replay.History)
+	err = json.Unmarshal(data, h)
+	if err != nil {
+		log.Fatalf("error reading instance history: %v", err)
+	}
+
+	r, err := replay.New(h)
+	if err != nil {
+		log.Fatalf("error replaying instance history: %v", err)
+	}
+
+	fmt.Printf("Replaying %s of %s/%s (%s), %d steps.\n%s\n", h.Instance, h.Namespace, h.Workflow, h.Status, len(h.Steps), replayHelp)
+
+	in := bufio.NewScanner(os.Stdin)
+
+	var f *replay.Frame
+
+	for r.More() {
+
+		f, err = r.Next()
+		if err != nil {
+			log.Fatalf("error replaying instance history: %v", err)
+		}
+
+		printFrame(f)
+
+	prompt:
+		fmt.Print("> ")
+		if !in.Scan() {
+			return
+		}
+
+		switch strings.TrimSpace(in.Text()) {
+		case "", "n":
+		case "d":
+			printFrameData(f)
+			goto prompt
+		case "c":
+			for r.More() {
+				f, err = r.Next()
+				if err != nil {
+					log.Fatalf("error replaying instance history: %v", err)
+				}
+				printFrame(f)
+				if f.Diverged() {
+					break
+				}
+			}
+			if r.More() {
+				goto prompt
+			}
+		case "q":
+			return
+		default:
+			fmt.Println(replayHelp)
+			goto prompt
+		}
+
+	}
+
+	fmt.Println("End of history.")
+
+}, cobra.ExactArgs(1))
+
+func printFrame(f *replay.Frame) {
+
+	s := f.Step
+
+	fmt.Printf("\n#%d step %d: %s (%s), %s -> %s", f.Index, s.Step, s.State, s.Type, s.Cause, s.Outcome)
+	if s.Next != "" {
+		fmt.Printf(" '%s'", s.Next)
+	}
+	fmt.Printf(" at %s\n", s.Time.Format("15:04:05.000"))
+
+	if s.Fault != nil {
+		fmt.Printf("  woken with error %s: %s\n", s.Fault.Code, s.Fault.Message)
+	}
+
+	if s.Error != nil {
+		fmt.Printf("  error %s: %s\n", s.Error.Code, s.Error.Message)
+	}
+
+	for _, c := range f.Checks {
+		mark := "ok"
+		if !c.Passed {
+			mark = "DIVERGED"
+		}
+		fmt.Printf("  [%s] %s: %s\n", mark, c.Name, c.Detail)
+	}
+
+}
+
+func printFrameData(f *replay.Frame) {
+
+	s := f.Step
+
+	for _, x := range []struct {
+		name string
+		data json.RawMessage
+	}{
+		{"input", s.Input},
+		{"memory", s.Memory},
+		{"wake", s.Wake},
+		{"result", s.Result},
+		{"output", s.Output},
+	} {
+
+		if len(x.data) == 0 {
+			continue
+		}
+
+		var buf bytes.Buffer
+		if json.Indent(&buf, x.data, "  ", "  ") != nil {
+			buf.Reset()
+			buf.Write(x.data)
+		}
+
+		fmt.Printf("  %s: %s\n", x.name, buf.String())
+
+	}
+
+	if s.Transform != nil {
+		t, _ := json.Marshal(s.Transform)
+		fmt.Printf("  transform: %s\n", t)
+	}
+
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 
 	"github.com/olekukonko/tablewriter"
@@ -65,16 +66,21 @@ var listCmd = util.GenerateCmd("list", "Returns a list of namespaces", "", func(
 
 }, cobra.ExactArgs(0))
 
-var createCmd = util.GenerateCmd("create NAME", "Creates a namespaces", "", func(cmd *cobra.Command, args []string) {
+var createCmd = util.GenerateCmd("create NAME [REGION]", "Creates a namespaces, optionally pinned to a storage region", "", func(cmd *cobra.Command, args []string) {
 
-	_, err := util.DoRequest(http.MethodPost, fmt.Sprintf("/namespaces/%s", args[0]), util.NONECt, nil)
+	path := fmt.Sprintf("/namespaces/%s", args[0])
+	if len(args) > 1 {
+		path = fmt.Sprintf("%s?region=%s", path, url.QueryEscape(args[1]))
+	}
+
+	_, err := util.DoRequest(http.MethodPost, path, util.NONECt, nil)
 	if err != nil {
 		log.Fatalf("error creating namespace: %v", err)
 	}
 
 	log.Printf("namespace %s created", args[0])
 
-}, cobra.ExactArgs(1))
+}, cobra.RangeArgs(1, 2))
 
 var deleteCmd = util.GenerateCmd("delete NAME", "Deletes a namespace", "", func(cmd *cobra.Command, args []string) {
 
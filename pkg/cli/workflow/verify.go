@@ -0,0 +1,86 @@
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vorteil/direktiv/pkg/cli/util"
+	"github.com/vorteil/direktiv/pkg/replay"
+)
+
+var workflowVerifyCmd = util.GenerateCmd("verify FILE GOLDEN...", "Replays golden runs against a workflow definition and reports divergences", "", func(cmd *cobra.Command, args []string) {
+
+	definition, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		log.Fatalf("can not read workflow: %v", err)
+	}
+
+	var diverged bool
+
+	for _, golden := range args[1:] {
+
+		h, err := readGolden(golden)
+		if err != nil {
+			log.Fatalf("can not read golden run %s: %v", golden, err)
+		}
+
+		r, err := replay.Verify(h, definition)
+		if err != nil {
+			log.Fatalf("can not verify golden run %s: %v", golden, err)
+		}
+
+		frames, err := r.Run()
+		if err != nil {
+			log.Fatalf("can not verify golden run %s: %v", golden, err)
+		}
+
+		var failed int
+		for _, f := range frames {
+			if !f.Diverged() {
+				continue
+			}
+			failed++
+			for _, c := range f.Checks {
+				if !c.Passed {
+					fmt.Printf("%s: step %d (%s): %s: %s\n", golden, f.Step.Step, f.Step.State, c.Name, c.Detail)
+				}
+			}
+		}
+
+		if failed > 0 {
+			diverged = true
+			fmt.Printf("%s: %d of %d steps diverged\n", golden, failed, len(frames))
+		} else {
+			fmt.Printf("%s: ok, %d steps\n", golden, len(frames))
+		}
+
+	}
+
+	if diverged {
+		os.Exit(1)
+	}
+
+}, cobra.MinimumNArgs(2))
+
+func readGolden(path string) (*replay.History, error) {
+
+	/* #nosec */
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	h := new(replay.History)
+	err = json.Unmarshal(data, h)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+
+}
@@ -40,7 +40,7 @@ type executed struct {
 // CreateCommand create the namespace command and subcommands
 func CreateCommand() *cobra.Command {
 
-	cmd := util.GenerateCmd("workflows", "List, create, get and execute workflows", "", nil, nil)
+	cmd := util.GenerateCmd("workflows", "List, create, get, execute and verify workflows", "", nil, nil)
 
 	cmd.AddCommand(workflowAddCmd)
 	cmd.AddCommand(workflowUpdateCmd)
@@ -49,6 +49,7 @@ func CreateCommand() *cobra.Command {
 	cmd.AddCommand(workflowGetCmd)
 	cmd.AddCommand(workflowExecuteCmd)
 	cmd.AddCommand(workflowToggleCmd)
+	cmd.AddCommand(workflowVerifyCmd)
 
 	return cmd
 
@@ -0,0 +1,222 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Manual is a clock that only moves when it's told to. When it moves, the
+// timers, tickers and sleeps that fall due fire in the order they are due,
+// each with the clock set to its due time. Functions waiting on AfterFunc
+// run in the goroutine that moves the clock, one after the other, so that
+// everything due has run by the time Advance or Set returns.
+type Manual struct {
+	mtx     sync.Mutex
+	now     time.Time
+	waiters []*waiter
+}
+
+type waiter struct {
+	at     time.Time
+	period time.Duration
+	ch     chan time.Time
+	fn     func()
+}
+
+// NewManual returns a Manual clock set to t.
+func NewManual(t time.Time) *Manual {
+	return &Manual{
+		now: t,
+	}
+}
+
+// Now returns the time the clock is set to.
+func (m *Manual) Now() time.Time {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.now
+
+}
+
+// After returns a channel that receives the time once the clock has moved on
+// by d.
+func (m *Manual) After(d time.Duration) <-chan time.Time {
+	return m.add(d, 0, nil).ch
+}
+
+// AfterFunc calls f once the clock has moved on by d.
+func (m *Manual) AfterFunc(d time.Duration, f func()) Timer {
+	return &manualTimer{m: m, w: m.add(d, 0, f)}
+}
+
+// NewTicker returns a ticker that ticks every time the clock moves on by d.
+// Like the tickers of the time package, it drops ticks nobody receives.
+func (m *Manual) NewTicker(d time.Duration) Ticker {
+
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+
+	return &manualTicker{m: m, w: m.add(d, d, nil)}
+
+}
+
+// Sleep blocks until the clock has moved on by d.
+func (m *Manual) Sleep(d time.Duration) {
+	<-m.After(d)
+}
+
+// Advance moves the clock on by d.
+func (m *Manual) Advance(d time.Duration) {
+	m.Set(m.Now().Add(d))
+}
+
+// Set moves the clock on to t. A clock can't move back, so earlier times only
+// fire what was already due.
+func (m *Manual) Set(t time.Time) {
+
+	for {
+
+		m.mtx.Lock()
+
+		w := m.due(t)
+		if w == nil {
+			if t.After(m.now) {
+				m.now = t
+			}
+			m.mtx.Unlock()
+			return
+		}
+
+		if w.at.After(m.now) {
+			m.now = w.at
+		}
+		now := m.now
+
+		if w.period > 0 {
+			w.at = w.at.Add(w.period)
+		} else {
+			m.remove(w)
+		}
+
+		m.mtx.Unlock()
+
+		if w.fn != nil {
+			w.fn()
+		} else {
+			select {
+			case w.ch <- now:
+			default:
+			}
+		}
+
+	}
+
+}
+
+// Waiters returns the number of timers, tickers and sleeps waiting on the
+// clock, so that tests can tell when what they drive has started to wait.
+func (m *Manual) Waiters() int {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return len(m.waiters)
+
+}
+
+func (m *Manual) add(d time.Duration, period time.Duration, fn func()) *waiter {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	w := &waiter{
+		at:     m.now.Add(d),
+		period: period,
+		fn:     fn,
+	}
+
+	if fn == nil {
+		w.ch = make(chan time.Time, 1)
+	}
+
+	// like the time package, waiting for nothing is done at once
+	if d <= 0 && period == 0 {
+		if fn != nil {
+			go fn()
+		} else {
+			w.ch <- m.now
+		}
+		return w
+	}
+
+	m.waiters = append(m.waiters, w)
+
+	return w
+
+}
+
+// due returns the waiter due first by t, if any.
+func (m *Manual) due(t time.Time) *waiter {
+
+	var first *waiter
+
+	for _, w := range m.waiters {
+		if w.at.After(t) {
+			continue
+		}
+		if first == nil || w.at.Before(first.at) {
+			first = w
+		}
+	}
+
+	return first
+
+}
+
+func (m *Manual) remove(w *waiter) bool {
+
+	for i := range m.waiters {
+		if m.waiters[i] == w {
+			m.waiters = append(m.waiters[:i], m.waiters[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+
+}
+
+type manualTimer struct {
+	m *Manual
+	w *waiter
+}
+
+func (t *manualTimer) Stop() bool {
+
+	t.m.mtx.Lock()
+	defer t.m.mtx.Unlock()
+
+	return t.m.remove(t.w)
+
+}
+
+type manualTicker struct {
+	m *Manual
+	w *waiter
+}
+
+func (t *manualTicker) C() <-chan time.Time {
+	return t.w.ch
+}
+
+func (t *manualTicker) Stop() {
+
+	t.m.mtx.Lock()
+	defer t.m.mtx.Unlock()
+
+	t.m.remove(t.w)
+
+}
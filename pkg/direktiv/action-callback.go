@@ -0,0 +1,57 @@
+package direktiv
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Actions that report their results by calling the api back, such as lambda
+// functions invoked asynchronously and workflows of remote clusters, are given
+// a callback address signed with the server's callback key. The api only
+// accepts results for the instance, action and step the signature was made
+// for, so knowing an instance id isn't enough to forge them.
+
+// actionCallbackURL returns the address under base that the results of an
+// action are reported to.
+func (we *workflowEngine) actionCallbackURL(base, instanceID, actionID string, step int) (string, error) {
+
+	token, err := we.actionCallbackToken(instanceID, actionID, step)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/api/instances/%s/actions/%s?step=%d&token=%s", strings.TrimSuffix(base, "/"),
+		instanceID, actionID, step, url.QueryEscape(token)), nil
+
+}
+
+func (we *workflowEngine) actionCallbackToken(instanceID, actionID string, step int) (string, error) {
+
+	key := we.server.config.CallbackKey
+	if key == "" {
+		return "", NewUncatchableError("direktiv.callback.key", "no callback key configured")
+	}
+
+	mac := hmac.New(sha256.New, []byte(key))
+	fmt.Fprintf(mac, "%s\n%s\n%d", instanceID, actionID, step)
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+
+}
+
+// verifyActionCallback reports whether token was issued for the results of
+// an action.
+func (we *workflowEngine) verifyActionCallback(instanceID, actionID string, step int, token string) bool {
+
+	expected, err := we.actionCallbackToken(instanceID, actionID, step)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal([]byte(expected), []byte(token))
+
+}
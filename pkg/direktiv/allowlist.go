@@ -0,0 +1,199 @@
+package direktiv
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	glob "github.com/ryanuber/go-glob"
+	"github.com/vorteil/direktiv/pkg/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Workflows can make the flow server call out to other addresses, which
+// would let any workflow author reach whatever the flow server can reach,
+// such as sidecars on localhost, cloud metadata endpoints or the rest of the
+// cluster. Only addresses the operator allowed can be called, which is
+// checked when workflows are saved, again before every request, and for
+// every redirect. Lambda functions are invoked with the credentials of the
+// flow server, so they are limited in the same way, keeping namespaces from
+// invoking the functions of other tenants or of the platform.
+
+const maxAllowedRedirects = 10
+
+var errRedirectNotAllowed = errors.New("redirect to an address that is not allowed")
+
+func validateAllowedURLs(c *Config) error {
+
+	lists := [][]string{c.AllowedURLs}
+	for _, l := range c.NamespaceAllowedURLs {
+		lists = append(lists, l)
+	}
+
+	for _, l := range lists {
+		for _, a := range l {
+
+			if !strings.Contains(a, "://") {
+				if strings.ContainsAny(a, "/?#@") {
+					return fmt.Errorf("allowed url '%s' must be a host or an http or https url", a)
+				}
+				continue
+			}
+
+			u, err := url.Parse(a)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" ||
+				u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+				return fmt.Errorf("allowed url '%s' must be a host or an http or https url", a)
+			}
+
+		}
+	}
+
+	return nil
+
+}
+
+// hasDotSegments reports whether a path has '.' or '..' segments, which
+// servers may resolve to paths outside of an allowed prefix.
+func hasDotSegments(p string) bool {
+
+	for _, seg := range strings.Split(p, "/") {
+		if seg == "." || seg == ".." {
+			return true
+		}
+	}
+
+	return false
+
+}
+
+// urlAllowed reports whether u is one of the allowed addresses.
+func urlAllowed(allowed []string, u *url.URL) bool {
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || hasDotSegments(u.Path) {
+		return false
+	}
+
+	for _, a := range allowed {
+
+		if !strings.Contains(a, "://") {
+			if strings.EqualFold(a, u.Host) || strings.EqualFold(a, u.Hostname()) {
+				return true
+			}
+			continue
+		}
+
+		p, err := url.Parse(a)
+		if err != nil || p.Scheme != u.Scheme || !strings.EqualFold(p.Host, u.Host) {
+			continue
+		}
+
+		prefix := strings.TrimSuffix(p.Path, "/")
+		if prefix == "" || u.Path == prefix || strings.HasPrefix(u.Path, prefix+"/") {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+// urlAllowed reports whether the workflows of a namespace may call u.
+func (c *Config) urlAllowed(ns string, u *url.URL) bool {
+	return urlAllowed(c.AllowedURLs, u) || urlAllowed(c.NamespaceAllowedURLs[ns], u)
+}
+
+// checkURL returns an error unless the workflows of a namespace may call
+// addr.
+func (c *Config) checkURL(ns, addr string) error {
+
+	u, err := url.Parse(addr)
+	if err != nil {
+		return err
+	}
+
+	if !c.urlAllowed(ns, u) {
+		return fmt.Errorf("'%s' is not an allowed address in namespace '%s'", addr, ns)
+	}
+
+	return nil
+
+}
+
+// allowedClient returns a client that only follows redirects to addresses
+// the workflows of a namespace may call.
+func (c *Config) allowedClient(ns string) *http.Client {
+
+	return &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+
+			if len(via) >= maxAllowedRedirects {
+				return errors.New("stopped after too many redirects")
+			}
+
+			if !c.urlAllowed(ns, req.URL) {
+				return fmt.Errorf("%w: %s", errRedirectNotAllowed, req.URL.Redacted())
+			}
+
+			return nil
+
+		},
+	}
+
+}
+
+// lambdaAllowed reports whether the workflows of a namespace may invoke a
+// lambda function.
+func (c *Config) lambdaAllowed(ns, function string) bool {
+
+	for _, l := range [][]string{c.AllowedLambdas, c.NamespaceAllowedLambdas[ns]} {
+		for _, pattern := range l {
+			if glob.Glob(pattern, function) {
+				return true
+			}
+		}
+	}
+
+	return false
+
+}
+
+// checkLambda returns an error unless the workflows of a namespace may
+// invoke a lambda function.
+func (c *Config) checkLambda(ns, function string) error {
+
+	if !c.lambdaAllowed(ns, function) {
+		return fmt.Errorf("lambda function '%s' is not allowed in namespace '%s'", function, ns)
+	}
+
+	return nil
+
+}
+
+// checkNamespaceFunctions refuses workflows of a namespace whose functions
+// call addresses or lambda functions the operator didn't allow.
+func (we *workflowEngine) checkNamespaceFunctions(ns string, wf *model.Workflow) error {
+
+	for _, f := range wf.GetFunctions() {
+
+		var err error
+
+		if f.Service != "" {
+			err = we.server.config.checkURL(ns, f.Service)
+		} else if f.Lambda != "" {
+			err = we.server.config.checkLambda(ns, f.Lambda)
+		}
+
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "bad workflow definition: function '%s': %v", f.ID, err)
+		}
+
+	}
+
+	return nil
+
+}
@@ -0,0 +1,115 @@
+package direktiv
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestURLAllowed(t *testing.T) {
+
+	allowed := []string{
+		"geo.internal",
+		"resize.functions.svc.cluster.local:8080",
+		"https://api.example.com/tenant-a/",
+	}
+
+	tests := []struct {
+		addr    string
+		allowed bool
+	}{
+		{"http://geo.internal/lookup", true},
+		{"https://GEO.internal:8443/", true},
+		{"http://resize.functions.svc.cluster.local:8080", true},
+		{"http://resize.functions.svc.cluster.local", false},
+		{"https://api.example.com/tenant-a", true},
+		{"https://api.example.com/tenant-a/orders", true},
+		{"https://api.example.com/tenant-ab", false},
+		{"https://api.example.com/tenant-a/../admin", false},
+		{"https://api.example.com/tenant-a/%2e%2e/admin", false},
+		{"http://api.example.com/tenant-a/", false},
+		{"https://api.example.com.evil.net/tenant-a/", false},
+		{"https://api.example.com@evil.net/tenant-a/", false},
+		{"http://169.254.169.254/latest/meta-data", false},
+		{"http://localhost:8889", false},
+		{"ftp://geo.internal/", false},
+	}
+
+	for _, test := range tests {
+
+		u, err := url.Parse(test.addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if urlAllowed(allowed, u) != test.allowed {
+			t.Errorf("%s: allowed should be %v", test.addr, test.allowed)
+		}
+
+	}
+
+	u, _ := url.Parse("http://geo.internal/")
+	if urlAllowed(nil, u) {
+		t.Errorf("an empty list should allow nothing")
+	}
+
+}
+
+func TestValidateAllowedURLs(t *testing.T) {
+
+	tests := []struct {
+		entry string
+		valid bool
+	}{
+		{"geo.internal", true},
+		{"geo.internal:8080", true},
+		{"https://api.example.com/tenant-a/", true},
+		{"geo.internal/path", false},
+		{"ftp://geo.internal", false},
+		{"https://user@api.example.com", false},
+		{"https://api.example.com/?a=b", false},
+	}
+
+	for _, test := range tests {
+
+		c := &Config{NamespaceAllowedURLs: map[string][]string{"ns": {test.entry}}}
+
+		err := validateAllowedURLs(c)
+		if (err == nil) != test.valid {
+			t.Errorf("%s: unexpected validation result: %v", test.entry, err)
+		}
+
+	}
+
+}
+
+func TestLambdaAllowed(t *testing.T) {
+
+	c := &Config{
+		AllowedLambdas: []string{"arn:aws:lambda:*:123456789012:function:shared-*"},
+		NamespaceAllowedLambdas: map[string][]string{
+			"a": {"tenant-a-*", "arn:aws:lambda:us-east-1:111111111111:function:train"},
+		},
+	}
+
+	tests := []struct {
+		ns, function string
+		allowed      bool
+	}{
+		{"a", "tenant-a-resize", true},
+		{"a", "tenant-a-resize:prod", true},
+		{"a", "arn:aws:lambda:us-east-1:111111111111:function:train", true},
+		{"a", "arn:aws:lambda:eu-west-1:123456789012:function:shared-geo", true},
+		{"a", "tenant-b-resize", false},
+		{"a", "arn:aws:lambda:us-east-1:222222222222:function:tenant-a-resize", false},
+		{"b", "tenant-a-resize", false},
+		{"b", "arn:aws:lambda:us-east-1:123456789012:function:shared-geo", true},
+		{"b", "arn:aws:lambda:us-east-1:123456789012:function:platform", false},
+	}
+
+	for _, test := range tests {
+		if c.lambdaAllowed(test.ns, test.function) != test.allowed {
+			t.Errorf("%s in %s: allowed should be %v", test.function, test.ns, test.allowed)
+		}
+	}
+
+}
@@ -0,0 +1,60 @@
+package direktiv
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// audit records an operator's intervention in an instance, with the reason
+// they gave, in the instance and namespace logs and as a warning in the
+// server log. The server log entry carries an audit field naming the action,
+// so interventions can be picked out of it.
+func (wli *workflowLogicInstance) audit(action, reason string, fields log.Fields, msg string, a ...interface{}) {
+
+	s := fmt.Sprintf(msg, a...)
+
+	f := log.Fields{
+		"audit":     action,
+		"namespace": wli.namespace,
+		"instance":  wli.id,
+	}
+
+	for k, v := range fields {
+		f[k] = v
+	}
+
+	log.WithFields(f).Warnf("instance %s: %s: %s", wli.id, s, reason)
+
+	wli.NamespaceLog("Instance '%s': %s by an operator: %s", wli.id, s, reason)
+	wli.Log("%s by an operator: %s", s, reason)
+
+}
+
+// namespaceAudit records something the engine did to work of a namespace on
+// its own, such as dropping an invocation, in the namespace log and as a
+// warning in the server log, with an audit field naming the action.
+func (we *workflowEngine) namespaceAudit(ns, action string, fields log.Fields, msg string, a ...interface{}) {
+
+	s := fmt.Sprintf(msg, a...)
+
+	f := log.Fields{
+		"audit":     action,
+		"namespace": ns,
+	}
+
+	for k, v := range fields {
+		f[k] = v
+	}
+
+	log.WithFields(f).Warnf("namespace %s: %s", ns, s)
+
+	l, err := we.server.instanceLogger.NamespaceLogger(ns)
+	if err != nil {
+		return
+	}
+	defer l.Close()
+
+	l.Warn(s)
+
+}
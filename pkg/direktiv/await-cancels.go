@@ -0,0 +1,176 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/ent"
+)
+
+// A state that fails while its isolates are running cancels them and gives
+// them the grace period to clean up before its error is handled. The instance
+// doesn't hold its lock or a work queue slot while it waits: the error is
+// saved with the instance and handled once every isolate has confirmed its
+// cancellation, or by a timer when the grace period runs out. Until then,
+// messages for the failed state are refused.
+
+const cancelsWakeupFunction = "cancelsWakeup"
+
+type awaitedCancels struct {
+	Step        int
+	Until       time.Time
+	Catchable   *CatchableError   `json:",omitempty"`
+	Uncatchable *UncatchableError `json:",omitempty"`
+}
+
+type cancelsMessage struct {
+	InstanceID string
+	Step       int
+}
+
+func cancelsTimer(id string) string {
+	return fmt.Sprintf("%s:cancels", id)
+}
+
+// awaitingCancels reports whether the instance is waiting for the isolates
+// cancelled at a step to stop.
+func (we *workflowEngine) awaitingCancels(rec *ent.WorkflowInstance, step int) bool {
+
+	if len(rec.AwaitingCancels) == 0 {
+		return false
+	}
+
+	ac := new(awaitedCancels)
+	err := json.Unmarshal(rec.AwaitingCancels, ac)
+	if err != nil {
+		return false
+	}
+
+	// a lost timer mustn't keep the instance waiting forever
+	return ac.Step == step && we.clock.Now().Before(ac.Until)
+
+}
+
+// awaitCancels saves the error that made the state cancel its isolates and
+// closes the instance, which resumes with that error once the isolates have
+// stopped. It reports false if the error should be handled right away.
+func (wli *workflowLogicInstance) awaitCancels(ctx context.Context, isolates []string, err error) bool {
+
+	we := wli.engine
+
+	if len(isolates) == 0 {
+		return false
+	}
+
+	ac := &awaitedCancels{
+		Step:  wli.step,
+		Until: we.clock.Now().Add(we.cancellations.timeout()),
+	}
+
+	switch e := err.(type) {
+	case *CatchableError:
+		ac.Catchable = e
+	case *UncatchableError:
+		ac.Uncatchable = e
+	default:
+		// a crashing instance has nothing left to wait for
+		we.cancellations.forget(isolates)
+		return false
+	}
+
+	data, err := json.Marshal(ac)
+	if err != nil {
+		log.Errorf("cannot marshal awaited cancellations: %v", err)
+		we.cancellations.wait(isolates)
+		return false
+	}
+
+	wf := wli.rec.Edges.Workflow
+
+	rec, err := wli.rec.Update().SetAwaitingCancels(data).Save(ctx)
+	if err != nil {
+		log.Errorf("cannot save awaited cancellations: %v", err)
+		we.cancellations.wait(isolates)
+		return false
+	}
+	wli.rec = rec
+	wli.rec.Edges.Workflow = wf
+
+	msg, _ := json.Marshal(&cancelsMessage{
+		InstanceID: wli.id,
+		Step:       wli.step,
+	})
+
+	err = we.timer.addOneShot(cancelsTimer(wli.id), cancelsWakeupFunction, ac.Until, msg)
+	if err != nil {
+		log.Errorf("cannot schedule awaited cancellations timeout: %v", err)
+	}
+
+	wli.Log("Waiting up to %v for %d cancelled isolates to stop.", we.cancellations.timeout(), len(isolates))
+	wli.Close()
+
+	go func() {
+		we.cancellations.wait(isolates)
+		_ = we.cancelsWakeup(msg)
+	}()
+
+	return true
+
+}
+
+func (we *workflowEngine) cancelsWakeup(data []byte) error {
+
+	msg := new(cancelsMessage)
+
+	err := json.Unmarshal(data, msg)
+	if err != nil {
+		log.Errorf("cannot handle awaited cancellations: %v", err)
+		return nil
+	}
+
+	ctx, wli, err := we.loadResumingLogicInstance(msg.InstanceID, msg.Step)
+	if err != nil {
+		log.Errorf("cannot load workflow logic instance: %v", err)
+		return nil
+	}
+
+	// the other of the confirmations and the timer already resumed it
+	ac := new(awaitedCancels)
+	if len(wli.rec.AwaitingCancels) == 0 || json.Unmarshal(wli.rec.AwaitingCancels, ac) != nil || ac.Step != wli.step {
+		wli.Close()
+		return nil
+	}
+
+	wf := wli.rec.Edges.Workflow
+
+	rec, err := wli.rec.Update().ClearAwaitingCancels().Save(ctx)
+	if err != nil {
+		log.Errorf("cannot clear awaited cancellations: %v", err)
+		wli.Close()
+		return nil
+	}
+	wli.rec = rec
+	wli.rec.Edges.Workflow = wf
+
+	err = we.timer.deleteTimerByName("", "", cancelsTimer(wli.id))
+	if err != nil {
+		log.Error(err)
+	}
+
+	var cause error = ac.Uncatchable
+	if ac.Catchable != nil {
+		cause = ac.Catchable
+	}
+
+	wli.childrenCancelled = true
+
+	we.queue.submit(func() {
+		we.runState(ctx, wli, nil, nil, cause)
+	})
+
+	return nil
+
+}
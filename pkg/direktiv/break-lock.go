@@ -0,0 +1,109 @@
+package direktiv
+
+import (
+	"context"
+	"database/sql"
+
+	hashstructure "github.com/mitchellh/hashstructure/v2"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// A node that dies while it runs the logic of an instance keeps holding the
+// instance's advisory lock until postgres notices the connection is gone,
+// which can take as long as the TCP timeouts, or until its lease expires with
+// table locking. Operators can break such a lock instead: the lock
+// connections of every node carry its hostname, so the holder is looked up
+// in pg_stat_activity, and if its node is no longer healthy the connection
+// is terminated, or the lease deleted. The current state of the instance is
+// then run again from its start, and the takeover is audited.
+
+func (we *workflowEngine) breakInstanceLock(ctx context.Context, id, reason string) (string, int, error) {
+
+	if reason == "" {
+		return "", 0, grpc.Errorf(codes.InvalidArgument, "a reason for breaking the lock is required")
+	}
+
+	rec, err := we.db.getWorkflowInstance(ctx, id)
+	if err != nil {
+		return "", 0, grpcDatabaseError(err, "instance", id)
+	}
+
+	if rec.Status != "pending" && rec.Status != "running" {
+		return "", 0, grpc.Errorf(codes.FailedPrecondition, "instance '%s' has already finished", id)
+	}
+
+	if len(rec.Flow) == 0 {
+		return "", 0, grpc.Errorf(codes.FailedPrecondition, "instance '%s' hasn't started yet", id)
+	}
+
+	hash, err := hashstructure.Hash(id, hashstructure.FormatV2, nil)
+	if err != nil {
+		return "", 0, NewInternalError(err)
+	}
+
+	pid, node, err := we.db.lockHolder(ctx, hash)
+	if err == sql.ErrNoRows {
+		return "", 0, grpc.Errorf(codes.FailedPrecondition, "instance '%s' isn't locked", id)
+	}
+	if err != nil {
+		return "", 0, NewInternalError(err)
+	}
+
+	if node == "" {
+		return "", 0, grpc.Errorf(codes.FailedPrecondition, "lock of instance '%s' is held by connection %d, which doesn't belong to a flow server", id, pid)
+	}
+
+	if lockAppName(node) == lockAppName(we.server.hostname) {
+		return "", 0, grpc.Errorf(codes.FailedPrecondition, "lock of instance '%s' is held by node %s, which is alive", id, node)
+	}
+
+	nodes, err := we.db.getNodes(ctx)
+	if err != nil {
+		return "", 0, NewInternalError(err)
+	}
+
+	for _, n := range nodes {
+		if lockAppName(n.Hostname) == lockAppName(node) {
+			node = n.Hostname
+			if nodeHealthy(n.Heartbeat) {
+				return "", 0, grpc.Errorf(codes.FailedPrecondition, "lock of instance '%s' is held by node %s, which is alive", id, node)
+			}
+		}
+	}
+
+	ok, err := we.db.breakLock(ctx, hash, pid)
+	if err != nil {
+		return "", 0, NewInternalError(err)
+	}
+
+	if !ok {
+		return "", 0, grpc.Errorf(codes.Aborted, "cannot break the lock of instance '%s' held by node %s", id, node)
+	}
+
+	// waits for a terminated connection's lock to be released
+	_, wli, err := we.loadWorkflowLogicInstance(id, -1)
+	if err != nil {
+		return "", 0, err
+	}
+
+	state := wli.logic.ID()
+	step := wli.step
+
+	wli.audit("breakLock", reason, log.Fields{
+		"node":  node,
+		"pid":   pid,
+		"state": state,
+	}, "Lock held by node %s broken, state %s runs again", node, state)
+
+	wli.Close()
+
+	err = we.dispatchState(id, state, step)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return node, pid, nil
+
+}
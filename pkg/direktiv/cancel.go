@@ -0,0 +1,159 @@
+package direktiv
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// isolateCancellations tracks cancelled isolates until their sidecars confirm
+// that they have stopped.
+type isolateCancellations struct {
+	grace   time.Duration
+	mtx     sync.Mutex
+	pending map[string]chan struct{}
+
+	// actions run by this server that can be cancelled directly
+	local map[string]func()
+}
+
+func newIsolateCancellations(config *Config) *isolateCancellations {
+
+	grace := config.CancelGracePeriod
+	if grace < 0 {
+		grace = 0
+	}
+
+	return &isolateCancellations{
+		grace:   time.Duration(grace) * time.Second,
+		pending: make(map[string]chan struct{}),
+		local:   make(map[string]func()),
+	}
+
+}
+
+// gracePeriod returns the grace period in seconds, as sent to sidecars.
+func (ic *isolateCancellations) gracePeriod() int {
+	return int(ic.grace / time.Second)
+}
+
+func (ic *isolateCancellations) expect(actionID string) {
+
+	ic.mtx.Lock()
+	defer ic.mtx.Unlock()
+
+	if _, exists := ic.pending[actionID]; !exists {
+		ic.pending[actionID] = make(chan struct{})
+	}
+
+}
+
+func (ic *isolateCancellations) confirm(actionID string) {
+
+	ic.mtx.Lock()
+	defer ic.mtx.Unlock()
+
+	ch, exists := ic.pending[actionID]
+	if !exists {
+		return
+	}
+
+	delete(ic.pending, actionID)
+	close(ch)
+
+}
+
+// timeout returns how long to wait for cancellations to be confirmed: the
+// grace period plus a little slack for the confirmation to arrive.
+func (ic *isolateCancellations) timeout() time.Duration {
+	return ic.grace + 5*time.Second
+}
+
+// wait blocks until every action has confirmed its cancellation, or until the
+// timeout expires.
+func (ic *isolateCancellations) wait(actionIDs []string) {
+
+	if len(actionIDs) == 0 {
+		return
+	}
+
+	timer := time.NewTimer(ic.timeout())
+	defer timer.Stop()
+
+	for _, id := range actionIDs {
+
+		ic.mtx.Lock()
+		ch, exists := ic.pending[id]
+		ic.mtx.Unlock()
+
+		if !exists {
+			continue
+		}
+
+		select {
+		case <-ch:
+		case <-timer.C:
+			log.Warnf("Isolates failed to confirm cancellation in time: %v.", ic.forget(actionIDs))
+			return
+		}
+
+	}
+
+}
+
+// forget stops tracking the actions and returns those that were unconfirmed.
+func (ic *isolateCancellations) forget(actionIDs []string) []string {
+
+	ic.mtx.Lock()
+	defer ic.mtx.Unlock()
+
+	var unconfirmed []string
+
+	for _, id := range actionIDs {
+		if _, exists := ic.pending[id]; exists {
+			unconfirmed = append(unconfirmed, id)
+			delete(ic.pending, id)
+		}
+	}
+
+	return unconfirmed
+
+}
+
+// track registers an action run by this server so that cancelling it doesn't
+// need a sidecar.
+func (ic *isolateCancellations) track(actionID string, cancel func()) {
+
+	ic.mtx.Lock()
+	defer ic.mtx.Unlock()
+
+	ic.local[actionID] = cancel
+
+}
+
+func (ic *isolateCancellations) untrack(actionID string) {
+
+	ic.mtx.Lock()
+	defer ic.mtx.Unlock()
+
+	delete(ic.local, actionID)
+
+}
+
+// cancelLocal cancels an action run by this server, reporting whether it was
+// found.
+func (ic *isolateCancellations) cancelLocal(actionID string) bool {
+
+	ic.mtx.Lock()
+	cancel, exists := ic.local[actionID]
+	delete(ic.local, actionID)
+	ic.mtx.Unlock()
+
+	if exists {
+		cancel()
+	}
+
+	return exists
+
+}
@@ -0,0 +1,115 @@
+package direktiv
+
+import (
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	cancelShards = 64
+
+	// waits on a shard longer than this are counted as contended
+	contendedWait = time.Microsecond * 10
+
+	contentionReportInterval = time.Minute
+)
+
+// instanceCancels holds the functions that cancel the contexts of instances
+// locked by this server. It is split into shards with their own mutexes so
+// that locking and unlocking instances doesn't serialize the whole server.
+// How often the shards are contended is logged periodically.
+type instanceCancels struct {
+	// updated atomically, kept first for alignment
+	acquired  uint64
+	contended uint64
+	waited    int64
+
+	shards [cancelShards]cancelShard
+}
+
+type cancelShard struct {
+	mtx     sync.Mutex
+	cancels map[string]func()
+}
+
+func newInstanceCancels() *instanceCancels {
+
+	ic := new(instanceCancels)
+	for i := range ic.shards {
+		ic.shards[i].cancels = make(map[string]func())
+	}
+
+	go ic.report()
+
+	return ic
+
+}
+
+// lockShard locks and returns the shard holding id.
+func (ic *instanceCancels) lockShard(id string) *cancelShard {
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(id))
+
+	s := &ic.shards[h.Sum32()%cancelShards]
+
+	t := time.Now()
+	s.mtx.Lock()
+	d := time.Since(t)
+
+	atomic.AddUint64(&ic.acquired, 1)
+	if d > contendedWait {
+		atomic.AddUint64(&ic.contended, 1)
+		atomic.AddInt64(&ic.waited, int64(d))
+	}
+
+	return s
+
+}
+
+// store registers the cancel function of an instance this server has locked.
+func (ic *instanceCancels) store(id string, cancel func()) {
+	s := ic.lockShard(id)
+	s.cancels[id] = cancel
+	s.mtx.Unlock()
+}
+
+// take removes and returns the cancel function of an instance, or nil if the
+// instance isn't locked here or has already been cancelled.
+func (ic *instanceCancels) take(id string) func() {
+
+	s := ic.lockShard(id)
+	cancel, exists := s.cancels[id]
+	if exists {
+		delete(s.cancels, id)
+	}
+	s.mtx.Unlock()
+
+	return cancel
+
+}
+
+func (ic *instanceCancels) report() {
+
+	ticker := time.NewTicker(contentionReportInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+
+		acquired := atomic.SwapUint64(&ic.acquired, 0)
+		contended := atomic.SwapUint64(&ic.contended, 0)
+		waited := time.Duration(atomic.SwapInt64(&ic.waited, 0))
+
+		if contended == 0 {
+			continue
+		}
+
+		log.Infof("instance cancels: %d of %d lookups contended in the last %v, waiting %v in total", contended, acquired, contentionReportInterval, waited)
+
+	}
+
+}
@@ -5,7 +5,9 @@ import (
 	"io/ioutil"
 	"net"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/sisatech/toml"
@@ -31,8 +33,114 @@ const (
 	// DBConn database connection
 	DBConn = "DIREKTIV_DB"
 
+	// DBReplicaConn read replica connection for listings, logs and metrics
+	DBReplicaConn = "DIREKTIV_DB_REPLICA"
+
+	// how instances are locked, advisory or table
+	dbLocking = "DIREKTIV_DB_LOCKING"
+
 	// instance logging
 	instanceLoggingDriver = "DIREKTIV_INSTANCE_LOGGING_DRIVER"
+
+	// options of the logging driver as key=value|key=value, and more sinks
+	// logs are written to as driver|key=value,driver2|key=value
+	instanceLoggingOptions = "DIREKTIV_INSTANCE_LOGGING_OPTIONS"
+	instanceLoggingSinks   = "DIREKTIV_INSTANCE_LOGGING_SINKS"
+
+	// directory instance logs are spooled to while loggers can't be
+	// created, and the megabytes they may take up
+	instanceLoggingSpool     = "DIREKTIV_INSTANCE_LOGGING_SPOOL"
+	instanceLoggingSpoolSize = "DIREKTIV_INSTANCE_LOGGING_SPOOL_SIZE"
+
+	// action throttling, rate limits are a comma-separated list of
+	// image=rate[:burst]
+	actionRateLimits    = "DIREKTIV_ACTION_RATE_LIMITS"
+	maxNamespaceActions = "DIREKTIV_MAX_NAMESPACE_ACTIONS"
+
+	// seconds an isolate is given to clean up after being cancelled
+	cancelGracePeriod = "DIREKTIV_CANCEL_GRACE_PERIOD"
+
+	// public api address lambda functions call back to
+	lambdaCallbackURL = "DIREKTIV_LAMBDA_CALLBACK_URL"
+
+	// key action callback addresses are signed with
+	callbackKey = "DIREKTIV_CALLBACK_KEY"
+
+	// comma-separated list of gateways to reach isolate services through
+	isolateEndpoints = "DIREKTIV_ISOLATE_ENDPOINTS"
+
+	// per namespace gateways, as ns=host:port|host:port,ns2=host:port
+	namespaceIsolateEndpoints = "DIREKTIV_NAMESPACE_ISOLATE_ENDPOINTS"
+
+	// comma-separated hosts and url prefixes functions may call as services
+	// and events may be posted to, and more for some namespaces as
+	// ns=url|host,ns2=url
+	allowedURLs          = "DIREKTIV_ALLOWED_URLS"
+	namespaceAllowedURLs = "DIREKTIV_NAMESPACE_ALLOWED_URLS"
+
+	// comma-separated lambda functions, or patterns of them, functions may
+	// invoke, and more for some namespaces as ns=name|arn,ns2=name
+	allowedLambdas          = "DIREKTIV_ALLOWED_LAMBDAS"
+	namespaceAllowedLambdas = "DIREKTIV_NAMESPACE_ALLOWED_LAMBDAS"
+
+	// state logic workers and the backlog at which invocations are refused
+	workers       = "DIREKTIV_WORKERS"
+	maxQueuedWork = "DIREKTIV_MAX_QUEUED_WORK"
+
+	// milliseconds metrics records are held to write them in batches
+	deferredWriteInterval = "DIREKTIV_DEFERRED_WRITE_INTERVAL"
+
+	// compression of saved instance memory, none or gzip
+	memoryCompression = "DIREKTIV_MEMORY_COMPRESSION"
+
+	// seconds a stopping server waits for running states before handing off
+	drainTimeout = "DIREKTIV_DRAIN_TIMEOUT"
+
+	// backlog above which idle servers take queued states, 0 disables
+	stealThreshold = "DIREKTIV_STEAL_THRESHOLD"
+
+	// seconds to wait for the lock of an instance
+	instanceLockWait = "DIREKTIV_LOCK_WAIT"
+
+	// percentage of states near their deadline that raises an alert, 0
+	// disables the deadline monitor
+	deadlineAlertPercent = "DIREKTIV_DEADLINE_ALERT_PERCENT"
+
+	// milliseconds, call depth, stack size, megabytes of memory and
+	// kilobytes of output lua state scripts may use
+	luaTimeout   = "DIREKTIV_LUA_TIMEOUT"
+	luaCallDepth = "DIREKTIV_LUA_CALL_DEPTH"
+	luaStackSize = "DIREKTIV_LUA_STACK_SIZE"
+	luaMemory    = "DIREKTIV_LUA_MEMORY"
+	luaOutput    = "DIREKTIV_LUA_OUTPUT"
+
+	// milliseconds, megabytes of memory and kilobytes of output wasm
+	// modules may use
+	wasmTimeout = "DIREKTIV_WASM_TIMEOUT"
+	wasmMemory  = "DIREKTIV_WASM_MEMORY"
+	wasmOutput  = "DIREKTIV_WASM_OUTPUT"
+
+	// namespaces of other clusters actions may invoke workflows in, as
+	// name=url|namespace|token|callbackToken,name2=...
+	remoteNamespaces = "DIREKTIV_REMOTE_NAMESPACES"
+
+	// public api address remote clusters report workflow results to
+	remoteCallbackURL = "DIREKTIV_REMOTE_CALLBACK_URL"
+
+	// primary or standby for active-passive failover, empty disables it
+	failoverRole = "DIREKTIV_FAILOVER_ROLE"
+
+	// failed or all to record the history of instances for replays
+	recordHistory = "DIREKTIV_RECORD_HISTORY"
+
+	// OTLP/HTTP traces endpoint finished instances are exported to, and the
+	// headers sent with them as name=value,name2=value
+	traceExportEndpoint = "DIREKTIV_TRACE_EXPORT_ENDPOINT"
+	traceExportHeaders  = "DIREKTIV_TRACE_EXPORT_HEADERS"
+
+	// storage backends namespaces can be pinned to, as
+	// name=database|replica|traceExportEndpoint,name2=...
+	storageRegions = "DIREKTIV_STORAGE_REGIONS"
 )
 
 // Config is the configuration for workflow and runner server
@@ -50,17 +158,226 @@ type Config struct {
 		Endpoint string
 	} `toml:"ingressAPI"`
 
+	// Database.Replica is an optional read-only replica of DB. Instance
+	// listings, logs and metrics, which may lag a little behind, are read
+	// from it so that dashboards don't load the database instances run on.
+	// Database.Locking is how servers lock instances: "advisory" for
+	// postgres advisory locks, or "table" for leases in a lock table, for
+	// databases without advisory locks.
 	Database struct {
-		DB string
+		DB      string
+		Replica string
+		Locking string
 	}
 
+	// InstanceLogging.Driver is the log sink instance logs are written to and
+	// queried from, configured by Options. Logs are written to Sinks as
+	// well, to have them where the rest of the platform logs. While loggers
+	// can't be created, logs are spooled to the Spool directory, up to
+	// SpoolSize megabytes, and written once they can again.
 	InstanceLogging struct {
-		Driver string
+		Driver    string
+		Options   map[string]string `toml:"options"`
+		Sinks     []LogSink         `toml:"sinks"`
+		Spool     string            `toml:"spool"`
+		SpoolSize int               `toml:"spoolSize"`
 	}
 
 	VariablesStorage struct {
 		Driver string
 	}
+
+	ActionRateLimits []ActionRateLimit `toml:"actionRateLimits"`
+
+	// MaxNamespaceActions caps the isolate actions each namespace may have in
+	// flight on each flow server, so the cap of the whole cluster is this
+	// times the number of flow replicas. Zero means no cap.
+	MaxNamespaceActions int `toml:"maxNamespaceActions"`
+
+	// CancelGracePeriod is how many seconds a cancelled isolate has to clean
+	// up before it is killed. Parent instances wait for the isolate to
+	// confirm its cancellation for this long before they finish.
+	CancelGracePeriod int `toml:"cancelGracePeriod"`
+
+	// LambdaCallbackURL is the address of the api that lambda functions
+	// invoked with a callback report their results to.
+	LambdaCallbackURL string `toml:"lambdaCallbackURL"`
+
+	// CallbackKey signs the action callback addresses handed to lambda
+	// functions and remote clusters, so that only they can report results.
+	// Callbacks are refused without it.
+	CallbackKey string `toml:"callbackKey"`
+
+	// IsolateEndpoints are the host:port addresses of gateways that route to
+	// isolate services. Requests are balanced over the healthy gateways. If
+	// none are set, isolate services are reached directly.
+	IsolateEndpoints []string `toml:"isolateEndpoints"`
+
+	// NamespaceIsolateEndpoints overrides IsolateEndpoints for the actions of
+	// specific namespaces, e.g. to route them to a restricted network zone.
+	NamespaceIsolateEndpoints map[string][]string `toml:"namespaceIsolateEndpoints"`
+
+	// AllowedURLs are the addresses functions may call as services, and
+	// namespaces may post their events to. An entry is a host, optionally
+	// with a port, allowing any http or https address on it, or a url
+	// prefix, allowing its scheme, host and the paths below its own. Nothing
+	// can be called if there are none.
+	AllowedURLs []string `toml:"allowedURLs"`
+
+	// NamespaceAllowedURLs are addresses allowed in addition to AllowedURLs
+	// for the workflows of specific namespaces.
+	NamespaceAllowedURLs map[string][]string `toml:"namespaceAllowedURLs"`
+
+	// AllowedLambdas are the lambda functions functions may invoke with the
+	// credentials of the flow server. Entries are matched against the name or
+	// arn exactly as workflows give it, and may use * as a wildcard, e.g.
+	// "arn:aws:lambda:*:123456789012:function:shared-*". No lambda function
+	// can be invoked if there are none.
+	AllowedLambdas []string `toml:"allowedLambdas"`
+
+	// NamespaceAllowedLambdas are lambda functions allowed in addition to
+	// AllowedLambdas for the workflows of specific namespaces, so that each
+	// tenant can only invoke its own.
+	NamespaceAllowedLambdas map[string][]string `toml:"namespaceAllowedLambdas"`
+
+	// Workers is how many goroutines run state logic. Transitions, wakeups
+	// and invocations beyond that wait in the database for a free worker.
+	Workers int `toml:"workers"`
+
+	// MaxQueuedWork is how long the queue may grow before new invocations
+	// are refused. Work continuing existing instances is always queued.
+	MaxQueuedWork int `toml:"maxQueuedWork"`
+
+	// DeferredWriteInterval is how many milliseconds per-state metrics
+	// records may be held to be written in batches. Zero writes them as they
+	// happen, which is slower but loses nothing if the server dies. Instance
+	// records are never deferred: a transition saves its flow, state data,
+	// deadline and attempts in a single update.
+	DeferredWriteInterval int `toml:"deferredWriteInterval"`
+
+	// MemoryCompression compresses the memory states save between steps,
+	// which can be large for foreach and parallel states. It is "none" or
+	// "gzip".
+	MemoryCompression string `toml:"memoryCompression"`
+
+	// DrainTimeout is how many seconds a stopping server waits for the states
+	// it is running to finish before handing its instances to another server.
+	DrainTimeout int `toml:"drainTimeout"`
+
+	// StealThreshold lets servers with free workers take queued states from
+	// servers whose work queue is longer than this. 0 disables work stealing.
+	StealThreshold int `toml:"stealThreshold"`
+
+	// LockWait is how many seconds a server waits for the lock of an
+	// instance another server or goroutine holds before it gives up on
+	// the work it wanted to do.
+	LockWait int `toml:"lockWait"`
+
+	// DeadlineAlertPercent is the percentage of a namespace's recent states
+	// using most of the time until their deadline that raises an alert, as
+	// a direktiv.deadlines.slipping event in the namespace. 0 disables it.
+	DeadlineAlertPercent int `toml:"deadlineAlertPercent"`
+
+	// Lua bounds the scripts of lua states, which run inside the server.
+	// Timeout is how many milliseconds a script may run, CallDepth how deeply
+	// its function calls may nest, StackSize how many values its stack may
+	// grow to hold, Memory roughly how many megabytes its values may take up
+	// and Output how many kilobytes of json it may return.
+	Lua struct {
+		Timeout   int `toml:"timeout"`
+		CallDepth int `toml:"callDepth"`
+		StackSize int `toml:"stackSize"`
+		Memory    int `toml:"memory"`
+		Output    int `toml:"output"`
+	} `toml:"lua"`
+
+	// Wasm bounds the wasm modules namespaces run inside the server. Timeout
+	// is how many milliseconds a module may run, Memory how many megabytes
+	// of memory it may grow to and Output how many kilobytes of json it may
+	// return.
+	Wasm struct {
+		Timeout int `toml:"timeout"`
+		Memory  int `toml:"memory"`
+		Output  int `toml:"output"`
+	} `toml:"wasm"`
+
+	// RemoteNamespaces are namespaces of other direktiv clusters whose
+	// workflows actions can invoke by naming them as their remote.
+	RemoteNamespaces []RemoteNamespace `toml:"remoteNamespaces"`
+
+	// RemoteCallbackURL is the address of the api that remote clusters report
+	// the results of workflows invoked through RemoteNamespaces to.
+	RemoteCallbackURL string `toml:"remoteCallbackURL"`
+
+	// FailoverRole is "primary" for servers whose instance timers are recorded
+	// in the database for a standby cluster, or "standby" for servers that
+	// wait on a replica of the primary's database until they are promoted.
+	FailoverRole string `toml:"failoverRole"`
+
+	// RecordHistory records every run of the state logic of instances, for
+	// the replay debugger. It is "failed" to keep only the histories of
+	// instances that didn't complete, "all" to keep every history, or empty
+	// to record nothing.
+	RecordHistory string `toml:"recordHistory"`
+
+	// TraceExport.Endpoint is the traces endpoint of an OTLP/HTTP collector,
+	// e.g. http://collector:4318/v1/traces. The recorded histories of
+	// finished instances are exported to it as traces, so RecordHistory must
+	// be set. Headers are sent with every export, e.g. to authenticate.
+	TraceExport struct {
+		Endpoint string
+		Headers  map[string]string
+	} `toml:"traceExport"`
+
+	// StorageRegions are the storage backends namespaces will be pinned to,
+	// for customers with data residency constraints. The instance logs and
+	// variables of a pinned namespace are written to the database of its
+	// region, and the traces of its instances are only exported to the
+	// region's collector. Because the rest of the instance data still stays
+	// in the main database, namespaces can't be pinned yet.
+	StorageRegions []StorageRegion `toml:"storageRegions"`
+}
+
+// RemoteNamespace is the namespace Namespace of the direktiv cluster whose api
+// is at URL, known to workflows as Name. Token is sent to authenticate
+// invocations, and the remote cluster sends CallbackToken back with results.
+// The remote cluster has to have this cluster configured as well, with
+// CallbackToken as its Token, before it accepts invocations with callbacks to
+// this cluster's RemoteCallbackURL, which has to be under that entry's URL.
+type RemoteNamespace struct {
+	Name          string
+	URL           string
+	Namespace     string
+	Token         string
+	CallbackToken string
+}
+
+// StorageRegion is a storage backend named Name. Database and Replica are
+// used like those of the main database, through the configured variables
+// driver and the database logging driver. Other logging drivers and sinks
+// aren't used for regions, so logs don't leave them. TraceExport is the traces endpoint of the region's
+// OTLP/HTTP collector, sent the headers of the main one.
+type StorageRegion struct {
+	Name        string
+	Database    string
+	Replica     string
+	TraceExport string
+}
+
+// LogSink is a log sink instance logs are written to besides the logging
+// driver. Driver is the name the sink is registered as, and Options are
+// passed to it, such as the address of the service to ship logs to.
+type LogSink struct {
+	Driver  string
+	Options map[string]string
+}
+
+// ActionRateLimit throttles dispatches of actions using Image to Rate per
+// second, allowing bursts of up to Burst actions.
+type ActionRateLimit struct {
+	Image string
+	Rate  float64
+	Burst int
 }
 
 func setIP(config *Config, env string, value *net.IP) error {
@@ -107,6 +424,281 @@ func setString(config *Config, env string, value *string) error {
 
 }
 
+// setStringList sets list to the comma-separated values of env, if set.
+func setStringList(list *[]string, env string) {
+
+	v := os.Getenv(env)
+	if len(v) == 0 {
+		return
+	}
+
+	*list = nil
+
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			*list = append(*list, s)
+		}
+	}
+
+	log.Debugf("setting %s to %v", env, *list)
+
+}
+
+// setNamespaceStringLists sets lists to the values of env, if set, given as
+// ns=value|value,ns2=value.
+func setNamespaceStringLists(lists *map[string][]string, env string) error {
+
+	v := os.Getenv(env)
+	if len(v) == 0 {
+		return nil
+	}
+
+	*lists = make(map[string][]string)
+
+	for _, s := range strings.Split(v, ",") {
+
+		idx := strings.Index(s, "=")
+		if idx < 0 {
+			return fmt.Errorf("can not parse %s entry %s", env, s)
+		}
+
+		ns := strings.TrimSpace(s[:idx])
+
+		for _, x := range strings.Split(s[idx+1:], "|") {
+			if x = strings.TrimSpace(x); x != "" {
+				(*lists)[ns] = append((*lists)[ns], x)
+			}
+		}
+
+	}
+
+	log.Debugf("setting %s to %v", env, *lists)
+
+	return nil
+
+}
+
+func setRemoteNamespaces(config *Config, env string) error {
+
+	v := os.Getenv(env)
+	if len(v) == 0 {
+		return nil
+	}
+
+	config.RemoteNamespaces = nil
+
+	for _, s := range strings.Split(v, ",") {
+
+		idx := strings.Index(s, "=")
+		if idx < 0 {
+			return fmt.Errorf("can not parse remote namespace %s", s)
+		}
+
+		val := strings.Split(s[idx+1:], "|")
+		if len(val) < 2 || len(val) > 4 {
+			return fmt.Errorf("can not parse remote namespace %s", s)
+		}
+
+		val = append(val, "", "")
+
+		config.RemoteNamespaces = append(config.RemoteNamespaces, RemoteNamespace{
+			Name:          strings.TrimSpace(s[:idx]),
+			URL:           strings.TrimSpace(val[0]),
+			Namespace:     strings.TrimSpace(val[1]),
+			Token:         strings.TrimSpace(val[2]),
+			CallbackToken: strings.TrimSpace(val[3]),
+		})
+
+	}
+
+	log.Debugf("setting %s via env", env)
+
+	return nil
+
+}
+
+func setStorageRegions(config *Config, env string) error {
+
+	v := os.Getenv(env)
+	if len(v) == 0 {
+		return nil
+	}
+
+	config.StorageRegions = nil
+
+	for _, s := range strings.Split(v, ",") {
+
+		idx := strings.Index(s, "=")
+		if idx < 0 {
+			return fmt.Errorf("can not parse storage region %s", s)
+		}
+
+		val := strings.Split(s[idx+1:], "|")
+		if len(val) > 3 {
+			return fmt.Errorf("can not parse storage region %s", s)
+		}
+
+		val = append(val, "", "")
+
+		config.StorageRegions = append(config.StorageRegions, StorageRegion{
+			Name:        strings.TrimSpace(s[:idx]),
+			Database:    strings.TrimSpace(val[0]),
+			Replica:     strings.TrimSpace(val[1]),
+			TraceExport: strings.TrimSpace(val[2]),
+		})
+
+	}
+
+	log.Debugf("setting %s via env", env)
+
+	return nil
+
+}
+
+// parseSinkOptions parses options given as key=value|key=value.
+func parseSinkOptions(opts []string) (map[string]string, error) {
+
+	m := make(map[string]string)
+
+	for _, o := range opts {
+
+		if strings.TrimSpace(o) == "" {
+			continue
+		}
+
+		idx := strings.Index(o, "=")
+		if idx < 0 {
+			return nil, fmt.Errorf("can not parse log sink option %s", o)
+		}
+
+		m[strings.TrimSpace(o[:idx])] = strings.TrimSpace(o[idx+1:])
+
+	}
+
+	return m, nil
+
+}
+
+func setInstanceLoggingOptions(config *Config, env string) error {
+
+	v := os.Getenv(env)
+	if len(v) == 0 {
+		return nil
+	}
+
+	opts, err := parseSinkOptions(strings.Split(v, "|"))
+	if err != nil {
+		return err
+	}
+
+	config.InstanceLogging.Options = opts
+
+	log.Debugf("setting %s via env", env)
+
+	return nil
+
+}
+
+func setInstanceLoggingSinks(config *Config, env string) error {
+
+	v := os.Getenv(env)
+	if len(v) == 0 {
+		return nil
+	}
+
+	config.InstanceLogging.Sinks = nil
+
+	for _, s := range strings.Split(v, ",") {
+
+		val := strings.Split(s, "|")
+
+		opts, err := parseSinkOptions(val[1:])
+		if err != nil {
+			return err
+		}
+
+		config.InstanceLogging.Sinks = append(config.InstanceLogging.Sinks, LogSink{
+			Driver:  strings.TrimSpace(val[0]),
+			Options: opts,
+		})
+
+	}
+
+	log.Debugf("setting %s via env", env)
+
+	return nil
+
+}
+
+func setActionRateLimits(config *Config, env string) error {
+
+	v := os.Getenv(env)
+	if len(v) == 0 {
+		return nil
+	}
+
+	for _, s := range strings.Split(v, ",") {
+
+		idx := strings.LastIndex(s, "=")
+		if idx < 0 {
+			return fmt.Errorf("can not parse action rate limit %s", s)
+		}
+
+		l := ActionRateLimit{
+			Image: strings.TrimSpace(s[:idx]),
+		}
+
+		val := strings.SplitN(s[idx+1:], ":", 2)
+
+		var err error
+		l.Rate, err = strconv.ParseFloat(strings.TrimSpace(val[0]), 64)
+		if err != nil {
+			return fmt.Errorf("can not parse action rate limit %s: %v", s, err)
+		}
+
+		if len(val) > 1 {
+			l.Burst, err = strconv.Atoi(strings.TrimSpace(val[1]))
+			if err != nil {
+				return fmt.Errorf("can not parse action rate limit %s: %v", s, err)
+			}
+		}
+
+		config.ActionRateLimits = append(config.ActionRateLimits, l)
+
+	}
+
+	log.Debugf("setting %s to %v", env, config.ActionRateLimits)
+
+	return nil
+
+}
+
+func setTraceExportHeaders(config *Config, env string) error {
+
+	v := os.Getenv(env)
+	if len(v) == 0 {
+		return nil
+	}
+
+	config.TraceExport.Headers = make(map[string]string)
+
+	for _, s := range strings.Split(v, ",") {
+
+		idx := strings.Index(s, "=")
+		if idx < 0 {
+			return fmt.Errorf("can not parse trace export header %s", s)
+		}
+
+		config.TraceExport.Headers[strings.TrimSpace(s[:idx])] = strings.TrimSpace(s[idx+1:])
+
+	}
+
+	log.Debugf("setting %s via env", env)
+
+	return nil
+
+}
+
 // ReadConfig reads the configuration file and overwrites with environment variables if set
 func ReadConfig(file string) (*Config, error) {
 
@@ -123,6 +715,24 @@ func ReadConfig(file string) (*Config, error) {
 	c.IngressAPI.Bind = fmt.Sprintf("%s:6666", localIP)
 	c.IngressAPI.Endpoint = c.IngressAPI.Bind
 
+	c.CancelGracePeriod = 10
+	c.Workers = 128
+	c.MaxQueuedWork = 4096
+	c.DrainTimeout = 20
+	c.LockWait = defaultLockWait
+	c.DeadlineAlertPercent = 25
+	c.Lua.Timeout = 1000
+	c.Lua.CallDepth = 200
+	c.Lua.StackSize = 65536
+	c.Lua.Memory = 32
+	c.Lua.Output = 1024
+	c.Wasm.Timeout = 1000
+	c.Wasm.Memory = 16
+	c.Wasm.Output = 1024
+
+	c.InstanceLogging.Spool = filepath.Join(os.TempDir(), "direktiv-logs")
+	c.InstanceLogging.SpoolSize = 256
+
 	// read config file if exists
 	if len(file) > 0 {
 
@@ -145,7 +755,26 @@ func ReadConfig(file string) (*Config, error) {
 	ints := []struct {
 		name  string
 		value *int
-	}{}
+	}{
+		{maxNamespaceActions, &c.MaxNamespaceActions},
+		{cancelGracePeriod, &c.CancelGracePeriod},
+		{workers, &c.Workers},
+		{maxQueuedWork, &c.MaxQueuedWork},
+		{deferredWriteInterval, &c.DeferredWriteInterval},
+		{drainTimeout, &c.DrainTimeout},
+		{stealThreshold, &c.StealThreshold},
+		{instanceLockWait, &c.LockWait},
+		{instanceLoggingSpoolSize, &c.InstanceLogging.SpoolSize},
+		{deadlineAlertPercent, &c.DeadlineAlertPercent},
+		{luaTimeout, &c.Lua.Timeout},
+		{luaCallDepth, &c.Lua.CallDepth},
+		{luaStackSize, &c.Lua.StackSize},
+		{luaMemory, &c.Lua.Memory},
+		{luaOutput, &c.Lua.Output},
+		{wasmTimeout, &c.Wasm.Timeout},
+		{wasmMemory, &c.Wasm.Memory},
+		{wasmOutput, &c.Wasm.Output},
+	}
 
 	for _, i := range ints {
 		err := setInt(c, i.name, i.value)
@@ -159,7 +788,10 @@ func ReadConfig(file string) (*Config, error) {
 		value *string
 	}{
 		{DBConn, &c.Database.DB},
+		{DBReplicaConn, &c.Database.Replica},
+		{dbLocking, &c.Database.Locking},
 		{instanceLoggingDriver, &c.InstanceLogging.Driver},
+		{instanceLoggingSpool, &c.InstanceLogging.Spool},
 		{flowBind, &c.FlowAPI.Bind},
 		{flowEndpoint, &c.FlowAPI.Endpoint},
 		{ingressBind, &c.IngressAPI.Bind},
@@ -167,6 +799,13 @@ func ReadConfig(file string) (*Config, error) {
 		{flowExchange, &c.FlowAPI.Exchange},
 		{flowSidecar, &c.FlowAPI.Sidecar},
 		{flowProtocol, &c.FlowAPI.Protocol},
+		{lambdaCallbackURL, &c.LambdaCallbackURL},
+		{callbackKey, &c.CallbackKey},
+		{memoryCompression, &c.MemoryCompression},
+		{remoteCallbackURL, &c.RemoteCallbackURL},
+		{failoverRole, &c.FailoverRole},
+		{recordHistory, &c.RecordHistory},
+		{traceExportEndpoint, &c.TraceExport.Endpoint},
 	}
 
 	for _, i := range strings {
@@ -176,6 +815,89 @@ func ReadConfig(file string) (*Config, error) {
 		}
 	}
 
+	err := setActionRateLimits(c, actionRateLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	setStringList(&c.IsolateEndpoints, isolateEndpoints)
+
+	err = setNamespaceStringLists(&c.NamespaceIsolateEndpoints, namespaceIsolateEndpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	setStringList(&c.AllowedURLs, allowedURLs)
+
+	err = setNamespaceStringLists(&c.NamespaceAllowedURLs, namespaceAllowedURLs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateAllowedURLs(c)
+	if err != nil {
+		return nil, err
+	}
+
+	setStringList(&c.AllowedLambdas, allowedLambdas)
+
+	err = setNamespaceStringLists(&c.NamespaceAllowedLambdas, namespaceAllowedLambdas)
+	if err != nil {
+		return nil, err
+	}
+
+	err = setRemoteNamespaces(c, remoteNamespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	err = setTraceExportHeaders(c, traceExportHeaders)
+	if err != nil {
+		return nil, err
+	}
+
+	err = setStorageRegions(c, storageRegions)
+	if err != nil {
+		return nil, err
+	}
+
+	err = setInstanceLoggingOptions(c, instanceLoggingOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	err = setInstanceLoggingSinks(c, instanceLoggingSinks)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Lua.Timeout <= 0 || c.Lua.Timeout > maxLuaTimeout {
+		return nil, fmt.Errorf("lua timeout must be between 1 and %d milliseconds", maxLuaTimeout)
+	}
+
+	if c.Lua.CallDepth <= 0 || c.Lua.StackSize <= 0 {
+		return nil, fmt.Errorf("lua call depth and stack size must be positive")
+	}
+
+	if c.Lua.Memory <= 0 || c.Lua.Memory > maxLuaMemory {
+		return nil, fmt.Errorf("lua memory must be between 1 and %d megabytes", maxLuaMemory)
+	}
+
+	if c.Wasm.Timeout <= 0 || c.Wasm.Timeout > maxWasmTimeout {
+		return nil, fmt.Errorf("wasm timeout must be between 1 and %d milliseconds", maxWasmTimeout)
+	}
+
+	// wasm memory is at most 4GB
+	if c.Wasm.Memory <= 0 || c.Wasm.Memory > 4096 {
+		return nil, fmt.Errorf("wasm memory must be between 1 and 4096 megabytes")
+	}
+
+	// outputs of scripts and modules are capped like those of actions
+	maxOutput := maxActionOutputSize >> 10
+	if c.Lua.Output <= 0 || c.Lua.Output > maxOutput || c.Wasm.Output <= 0 || c.Wasm.Output > maxOutput {
+		return nil, fmt.Errorf("lua and wasm output must be between 1 and %d kilobytes", maxOutput)
+	}
+
 	// test database is set
 	if len(c.Database.DB) == 0 {
 		return nil, fmt.Errorf("no database configured")
@@ -0,0 +1,258 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/jqer"
+	"github.com/vorteil/direktiv/pkg/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Workflows can declare constants, which their jq queries see as $const,
+// instead of hardcoding values that differ between environments. Namespaces
+// can override them: the constants of a namespace replace the workflow's
+// ones with the same name, and add to them, and those of its ancestors apply
+// beneath its own, nearest first. Constants are resolved whenever an
+// instance is loaded, so changes apply to running instances from their next
+// state on.
+//
+// Namespaces can also keep named profiles of constants, such as dev, stage
+// and prod, and select one of them as active, or inherit the active profile
+// of their nearest ancestor that selects one. The active profile is resolved
+// when an instance is created and recorded with it, so switching profiles
+// doesn't affect instances that are already running. The profile constants
+// of a namespace apply above its own constants, so a workflow definition can
+// run unchanged in every environment.
+
+var profileNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+
+type namespaceProfiles map[string]map[string]interface{}
+
+func (db *dbManager) namespaceProfiles(ctx context.Context, ns string) (namespaceProfiles, string, error) {
+
+	data, active, err := db.getNamespaceProfiles(ctx, ns)
+	if err != nil {
+		return nil, "", err
+	}
+
+	profiles := make(namespaceProfiles)
+	if len(data) == 0 {
+		return profiles, active, nil
+	}
+
+	err = json.Unmarshal(data, &profiles)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return profiles, active, nil
+
+}
+
+// resolveProfile returns the profile that instances created in a namespace
+// use, which is empty if neither it nor its ancestors select one.
+func (db *dbManager) resolveProfile(ctx context.Context, ns string) (string, error) {
+
+	_, active, err := db.getNamespaceProfiles(ctx, ns)
+	if err != nil {
+		return "", err
+	}
+
+	if active != "" {
+		return active, nil
+	}
+
+	ancestors, err := db.namespaceAncestors(ctx, ns)
+	if err != nil {
+		return "", err
+	}
+
+	for _, a := range ancestors {
+		_, active, err = db.getNamespaceProfiles(ctx, a)
+		if err != nil {
+			return "", err
+		}
+		if active != "" {
+			return active, nil
+		}
+	}
+
+	return "", nil
+
+}
+
+// resolveConstants returns the constants of a workflow in a namespace, with
+// the given profile.
+func (db *dbManager) resolveConstants(ctx context.Context, ns, profile string, wf *model.Workflow) (map[string]interface{}, error) {
+
+	ancestors, err := db.namespaceAncestors(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	constants := make(map[string]interface{})
+	for k, v := range wf.Constants {
+		constants[k] = copyJSONValue(v)
+	}
+
+	names := append([]string{ns}, ancestors...)
+
+	for i := len(names) - 1; i >= 0; i-- {
+
+		data, err := db.getNamespaceConstants(ctx, names[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if len(data) > 0 {
+			var m map[string]interface{}
+			err = json.Unmarshal(data, &m)
+			if err != nil {
+				return nil, err
+			}
+
+			for k, v := range m {
+				constants[k] = v
+			}
+		}
+
+		if profile == "" {
+			continue
+		}
+
+		profiles, _, err := db.namespaceProfiles(ctx, names[i])
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range profiles[profile] {
+			constants[k] = v
+		}
+
+	}
+
+	return constants, nil
+
+}
+
+func (we *workflowEngine) setNamespaceConstants(ctx context.Context, ns string, data []byte) error {
+
+	if len(data) > 0 {
+		var m map[string]interface{}
+		if err := json.Unmarshal(data, &m); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "constants must be a json object: %v", err)
+		}
+		if len(m) == 0 {
+			data = nil
+		}
+	}
+
+	err := we.db.setNamespaceConstants(ctx, ns, data)
+	if err != nil {
+		return grpcDatabaseError(err, "namespace", ns)
+	}
+
+	return nil
+
+}
+
+func (we *workflowEngine) setNamespaceProfile(ctx context.Context, ns, profile string, data []byte) error {
+
+	if !profileNameRegex.MatchString(profile) {
+		return grpc.Errorf(codes.InvalidArgument, "invalid profile name '%s'", profile)
+	}
+
+	var m map[string]interface{}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &m); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "profile constants must be a json object: %v", err)
+		}
+	}
+
+	err := we.db.setNamespaceProfile(ctx, ns, profile, m)
+	if err != nil {
+		return grpcDatabaseError(err, "namespace", ns)
+	}
+
+	return nil
+
+}
+
+// setNamespaceActiveProfile selects the profile new instances in a namespace
+// use, which has to be defined by it or one of its ancestors. An empty
+// profile makes the namespace inherit the one of its ancestors again.
+func (we *workflowEngine) setNamespaceActiveProfile(ctx context.Context, ns, profile string) error {
+
+	if profile != "" {
+
+		if !profileNameRegex.MatchString(profile) {
+			return grpc.Errorf(codes.InvalidArgument, "invalid profile name '%s'", profile)
+		}
+
+		ancestors, err := we.db.namespaceAncestors(ctx, ns)
+		if err != nil {
+			return grpcDatabaseError(err, "namespace", ns)
+		}
+
+		var defined bool
+
+		for _, name := range append([]string{ns}, ancestors...) {
+			profiles, _, err := we.db.namespaceProfiles(ctx, name)
+			if err != nil {
+				return grpcDatabaseError(err, "namespace", ns)
+			}
+			if _, defined = profiles[profile]; defined {
+				break
+			}
+		}
+
+		if !defined {
+			return grpc.Errorf(codes.NotFound, "profile '%s' isn't defined in namespace '%s' or its ancestors", profile, ns)
+		}
+
+	}
+
+	err := we.db.setNamespaceActiveProfile(ctx, ns, profile)
+	if err != nil {
+		return grpcDatabaseError(err, "namespace", ns)
+	}
+
+	return nil
+
+}
+
+// jqScope returns the variables and modules the jq queries of an instance
+// see: its constants, and read-only variables describing the instance and
+// the events it received. $now is the time the query runs, to the second so
+// that fromdate parses it. JSONata keeps its own $now() function.
+func (wli *workflowLogicInstance) jqScope() *jqer.Scope {
+
+	attempt := 1
+	if wli.rec != nil {
+		attempt += wli.rec.Attempts
+	}
+
+	event, events := wli.eventVariables()
+
+	return &jqer.Scope{
+		Variables: jqer.Variables{
+			"const": wli.constants,
+			"instance": map[string]interface{}{
+				"id": wli.id,
+			},
+			"namespace": wli.namespace,
+			"workflow":  wli.wf.ID,
+			"step":      wli.step,
+			"attempt":   attempt,
+			"now":       wli.engine.clock.Now().UTC().Format(time.RFC3339),
+			"event":     event,
+			"events":    events,
+		},
+		Modules: wli.modules,
+	}
+
+}
@@ -0,0 +1,47 @@
+package direktiv
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/ingress"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The scheduled starts of a namespace can be suspended, e.g. for a
+// maintenance window, without deactivating or deleting its workflows. Their
+// crons keep firing, but wfCronHandler drops the invocations while the
+// namespace or one of its ancestors is suspended. Missed invocations aren't
+// made up once the crons are resumed. A suspension is its reason.
+
+const defaultCronSuspension = "suspended"
+
+func (s *WorkflowServer) setCronSuspension(ctx context.Context, in *ingress.SetCronSuspensionRequest) error {
+
+	ns := in.GetNamespace()
+	if ns == "" {
+		return status.Errorf(codes.InvalidArgument, "required namespace")
+	}
+
+	reason := in.GetReason()
+	if !in.GetSuspend() {
+		reason = ""
+	} else if reason == "" {
+		reason = defaultCronSuspension
+	}
+
+	err := s.dbManager.setCronSuspension(ctx, ns, reason)
+	if err != nil {
+		return grpcDatabaseError(err, "namespace", ns)
+	}
+
+	if reason == "" {
+		log.Infof("Scheduled starts of namespace %s resumed.", ns)
+	} else {
+		log.Infof("Scheduled starts of namespace %s suspended: %s", ns, reason)
+	}
+
+	return nil
+
+}
@@ -7,6 +7,9 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/ent/eventcounter"
+	"github.com/vorteil/direktiv/ent/namespace"
+	"github.com/vorteil/direktiv/ent/unmatchedevent"
 	"github.com/vorteil/direktiv/ent/workflow"
 	"github.com/vorteil/direktiv/ent/workflowevents"
 	"github.com/vorteil/direktiv/ent/workfloweventswait"
@@ -42,6 +45,29 @@ func (db *dbManager) deleteWorkflowEventListener(id int) error {
 	return err
 }
 
+// deleteOrphanedListeners removes the event listeners of instances that are
+// no longer running.
+func (db *dbManager) deleteOrphanedListeners(ctx context.Context) (int, error) {
+
+	ids, err := db.dbEnt.WorkflowEvents.
+		Query().
+		Where(workflowevents.HasWorkflowinstanceWith(workflowinstance.StatusNEQ("pending"))).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, id := range ids {
+		err = db.deleteWorkflowEventListener(id)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(ids), nil
+
+}
+
 func (db *dbManager) deleteWorkflowEventWaitByListenerID(id int) error {
 
 	_, err := db.dbEnt.WorkflowEventsWait.
@@ -94,6 +120,25 @@ func (db *dbManager) addWorkflowEventWait(ev map[string]interface{}, count, id i
 
 }
 
+// startEventMaps returns the events of a start definition the way listeners
+// store them.
+func startEventMaps(events []model.StartEventDefinition) []map[string]interface{} {
+
+	var ev []map[string]interface{}
+	for _, e := range events {
+		em := make(map[string]interface{})
+		em[eventTypeString] = e.Type
+
+		for kf, vf := range e.Filters {
+			em[fmt.Sprintf("%s%s", filterPrefix, strings.ToLower(kf))] = vf
+		}
+		ev = append(ev, em)
+	}
+
+	return ev
+
+}
+
 // called by add workflow, adds event listeners if required
 func (db *dbManager) processWorkflowEvents(ctx context.Context, tx *ent.Tx,
 	wf *ent.Workflow, startDefinition model.StartDefinition, active bool) error {
@@ -111,16 +156,7 @@ func (db *dbManager) processWorkflowEvents(ctx context.Context, tx *ent.Tx,
 			db.deleteWorkflowEventListener(wfe.ID)
 		}
 
-		var ev []map[string]interface{}
-		for _, e := range events {
-			em := make(map[string]interface{})
-			em[eventTypeString] = e.Type
-
-			for kf, vf := range e.Filters {
-				em[fmt.Sprintf("%s%s", filterPrefix, strings.ToLower(kf))] = vf
-			}
-			ev = append(ev, em)
-		}
+		ev := startEventMaps(events)
 
 		correlations := []string{}
 		count := 1
@@ -216,3 +252,191 @@ func (db *dbManager) getWorkflowEventByInstanceID(id int) (*ent.WorkflowEvents,
 		Only(db.ctx)
 
 }
+
+// getNamespaceEventListeners returns the event listeners of a namespace's
+// workflows and instances with the events they are already waiting on.
+func (db *dbManager) getNamespaceEventListeners(ctx context.Context, ns string) ([]*ent.WorkflowEvents, error) {
+
+	return db.dbRead.WorkflowEvents.
+		Query().
+		Where(workflowevents.HasWorkflowWith(
+			workflow.HasNamespaceWith(namespace.IDEQ(ns)),
+		)).
+		WithWorkflow(func(q *ent.WorkflowQuery) {
+			q.Select(workflow.FieldName)
+		}).
+		WithWorkflowinstance(func(q *ent.WorkflowInstanceQuery) {
+			q.Select(workflowinstance.FieldInstanceID)
+		}).
+		WithWfeventswait().
+		All(ctx)
+
+}
+
+// addEventCounts adds to the event counters of a namespace and type, which
+// are created on the first event. Events of namespaces that don't exist
+// aren't counted.
+func (db *dbManager) addEventCounts(ctx context.Context, ns, typ string, c *eventCounts) error {
+
+	update := func() (int, error) {
+		return db.dbEnt.EventCounter.
+			Update().
+			Where(eventcounter.NamespaceEQ(ns), eventcounter.TypeEQ(typ)).
+			AddReceived(c.received).
+			AddMatched(c.matched).
+			AddUnmatched(c.unmatched).
+			AddRejected(c.rejected).
+			SetLastReceived(c.last).
+			Save(ctx)
+	}
+
+	n, err := update()
+	if err != nil || n > 0 {
+		return err
+	}
+
+	exists, err := db.dbEnt.Namespace.
+		Query().
+		Where(namespace.IDEQ(ns)).
+		Exist(ctx)
+	if err != nil || !exists {
+		return err
+	}
+
+	_, err = db.dbEnt.EventCounter.
+		Create().
+		SetNamespace(ns).
+		SetType(typ).
+		SetReceived(c.received).
+		SetMatched(c.matched).
+		SetUnmatched(c.unmatched).
+		SetRejected(c.rejected).
+		SetLastReceived(c.last).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// another server counted the first event at the same time
+		_, err = update()
+	}
+
+	return err
+
+}
+
+// addUnmatchedEvents records sampled unmatched events, keeping only the
+// newest of each namespace. Events of namespaces that don't exist aren't
+// recorded.
+func (db *dbManager) addUnmatchedEvents(ctx context.Context, samples []*unmatchedEventSample, keep int) error {
+
+	var names []string
+	for _, s := range samples {
+		names = append(names, s.namespace)
+	}
+
+	existing, err := db.dbEnt.Namespace.
+		Query().
+		Where(namespace.IDIn(names...)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	namespaces := make(map[string]bool)
+	for _, ns := range existing {
+		namespaces[ns] = true
+	}
+
+	bulk := make([]*ent.UnmatchedEventCreate, 0, len(samples))
+
+	for _, s := range samples {
+		if !namespaces[s.namespace] {
+			continue
+		}
+		bulk = append(bulk, db.dbEnt.UnmatchedEvent.
+			Create().
+			SetNamespace(s.namespace).
+			SetType(s.typ).
+			SetSource(s.source).
+			SetEventID(s.id).
+			SetReceived(s.received).
+			SetCloudevent(s.data))
+	}
+
+	if len(bulk) == 0 {
+		return nil
+	}
+
+	_, err = db.dbEnt.UnmatchedEvent.CreateBulk(bulk...).Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	for ns := range namespaces {
+
+		old, err := db.dbEnt.UnmatchedEvent.
+			Query().
+			Where(unmatchedevent.NamespaceEQ(ns)).
+			Order(ent.Desc(unmatchedevent.FieldReceived)).
+			Offset(keep).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(old) == 0 {
+			continue
+		}
+
+		_, err = db.dbEnt.UnmatchedEvent.
+			Delete().
+			Where(unmatchedevent.IDIn(old...)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+
+}
+
+func (db *dbManager) getEventCounters(ctx context.Context, ns string) ([]*ent.EventCounter, error) {
+
+	return db.dbEnt.EventCounter.
+		Query().
+		Where(eventcounter.NamespaceEQ(ns)).
+		Order(ent.Asc(eventcounter.FieldType)).
+		All(ctx)
+
+}
+
+func (db *dbManager) getUnmatchedEvents(ctx context.Context, ns string) ([]*ent.UnmatchedEvent, error) {
+
+	return db.dbEnt.UnmatchedEvent.
+		Query().
+		Where(unmatchedevent.NamespaceEQ(ns)).
+		Order(ent.Desc(unmatchedevent.FieldReceived)).
+		All(ctx)
+
+}
+
+// deleteNamespaceEventStats removes the event counters and unmatched events
+// of a namespace.
+func (db *dbManager) deleteNamespaceEventStats(ctx context.Context, ns string) error {
+
+	_, err := db.dbEnt.EventCounter.
+		Delete().
+		Where(eventcounter.NamespaceEQ(ns)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.dbEnt.UnmatchedEvent.
+		Delete().
+		Where(unmatchedevent.NamespaceEQ(ns)).
+		Exec(ctx)
+
+	return err
+
+}
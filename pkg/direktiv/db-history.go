@@ -0,0 +1,60 @@
+package direktiv
+
+import (
+	"context"
+	"time"
+
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/ent/instancehistory"
+)
+
+func (db *dbManager) addHistory(ctx context.Context, id string, step, revision int, t time.Time, data []byte) error {
+
+	_, err := db.dbEnt.InstanceHistory.
+		Create().
+		SetInstanceID(id).
+		SetStep(step).
+		SetRevision(revision).
+		SetTime(t).
+		SetData(data).
+		Save(ctx)
+
+	return err
+
+}
+
+// historyHasRevision reports whether an instance has recorded a step at a
+// revision of its workflow.
+func (db *dbManager) historyHasRevision(ctx context.Context, id string, revision int) (bool, error) {
+
+	return db.dbEnt.InstanceHistory.
+		Query().
+		Where(
+			instancehistory.InstanceIDEQ(id),
+			instancehistory.RevisionEQ(revision),
+		).
+		Exist(ctx)
+
+}
+
+// getHistory returns the recorded steps of an instance in the order they ran.
+func (db *dbManager) getHistory(ctx context.Context, id string) ([]*ent.InstanceHistory, error) {
+
+	return db.dbRead.InstanceHistory.
+		Query().
+		Where(instancehistory.InstanceIDEQ(id)).
+		Order(ent.Asc(instancehistory.FieldTime), ent.Asc(instancehistory.FieldID)).
+		All(ctx)
+
+}
+
+func (db *dbManager) deleteHistory(ctx context.Context, id string) error {
+
+	_, err := db.dbEnt.InstanceHistory.
+		Delete().
+		Where(instancehistory.InstanceIDEQ(id)).
+		Exec(ctx)
+
+	return err
+
+}
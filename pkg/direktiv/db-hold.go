@@ -0,0 +1,126 @@
+package direktiv
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/ent/namespace"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/workflow"
+	"github.com/vorteil/direktiv/ent/workflowinstance"
+)
+
+// instanceNotHeld matches instances without a legal hold on them or on their
+// namespace.
+func instanceNotHeld() predicate.WorkflowInstance {
+	return workflowinstance.And(
+		workflowinstance.Or(workflowinstance.HoldIsNil(), workflowinstance.HoldEQ("")),
+		workflowinstance.Not(workflowinstance.HasWorkflowWith(workflow.HasNamespaceWith(namespaceUnderHold()))),
+	)
+}
+
+// namespaceUnderHold matches namespaces under a legal hold, placed on them or
+// inherited from an ancestor.
+func namespaceUnderHold() predicate.Namespace {
+
+	// null holds must not make the predicate null, or negating it would
+	// match nothing
+	held := namespace.And(namespace.HoldNotNil(), namespace.HoldNEQ(""))
+
+	p := held
+	for i := 1; i < maxNamespaceDepth; i++ {
+		p = namespace.Or(held, namespace.HasParentWith(p))
+	}
+
+	return p
+
+}
+
+func (db *dbManager) setNamespaceHold(ctx context.Context, ns, reason string) error {
+
+	upd := db.dbEnt.Namespace.Update().Where(namespace.IDEQ(ns))
+	if reason == "" {
+		upd = upd.ClearHold()
+	} else {
+		upd = upd.SetHold(reason)
+	}
+
+	n, err := upd.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+
+}
+
+func (db *dbManager) setInstanceHold(ctx context.Context, id, reason string) error {
+
+	upd := db.dbEnt.WorkflowInstance.Update().Where(workflowinstance.InstanceIDEQ(id))
+	if reason == "" {
+		upd = upd.ClearHold()
+	} else {
+		upd = upd.SetHold(reason)
+	}
+
+	n, err := upd.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+
+}
+
+// namespaceOnHold reports whether a namespace is under a legal hold, placed
+// on it or on one of its ancestors.
+func (db *dbManager) namespaceOnHold(ctx context.Context, ns string) (bool, error) {
+
+	return db.dbEnt.Namespace.
+		Query().
+		Where(namespace.IDEQ(ns), namespaceUnderHold()).
+		Exist(ctx)
+
+}
+
+// namespaceHeld reports whether a namespace or any of its instances are
+// under a legal hold, which includes holds on its ancestors.
+func (db *dbManager) namespaceHeld(ctx context.Context, ns string) (bool, error) {
+
+	held, err := db.namespaceOnHold(ctx, ns)
+	if err != nil || held {
+		return held, err
+	}
+
+	return db.dbEnt.WorkflowInstance.
+		Query().
+		Where(
+			workflowinstance.HoldNEQ(""),
+			workflowinstance.HasWorkflowWith(workflow.HasNamespaceWith(namespace.IDEQ(ns))),
+		).
+		Exist(ctx)
+
+}
+
+// workflowHeld reports whether any instances of a workflow are under a legal
+// hold, directly or through their namespace.
+func (db *dbManager) workflowHeld(ctx context.Context, wf uuid.UUID) (bool, error) {
+
+	return db.dbEnt.WorkflowInstance.
+		Query().
+		Where(
+			workflowinstance.HasWorkflowWith(workflow.IDEQ(wf)),
+			workflowinstance.Not(instanceNotHeld()),
+		).
+		Exist(ctx)
+
+}
@@ -2,14 +2,18 @@ package direktiv
 
 import (
 	"context"
-	"database/sql"
+	dbsql "database/sql"
+	"encoding/json"
 	"errors"
 	"math"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/vorteil/direktiv/ent"
 	"github.com/vorteil/direktiv/ent/namespace"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/queuedinvocation"
 	"github.com/vorteil/direktiv/ent/workflow"
 	"github.com/vorteil/direktiv/ent/workflowinstance"
 
@@ -39,6 +43,11 @@ func (db *dbManager) deleteWorkflowInstance(id int) error {
 		log.Errorf("can not delete event listeners for instance: %v", err)
 	}
 
+	err = db.deleteHistory(db.ctx, wfi.InstanceID)
+	if err != nil {
+		log.Errorf("can not delete history for instance: %v", err)
+	}
+
 	err = db.dbEnt.WorkflowInstance.DeleteOneID(id).Exec(db.ctx)
 	if err != nil {
 		return err
@@ -54,25 +63,29 @@ func (db *dbManager) deleteWorkflowInstance(id int) error {
 
 func (db *dbManager) deleteWorkflowInstancesByWorkflow(ctx context.Context, wf uuid.UUID) error {
 
-	instances, err := db.getWorkflowInstancesByWFID(ctx, wf, 0, 0)
+	// not read from the replica, every instance has to be found
+	ids, err := db.dbEnt.WorkflowInstance.
+		Query().
+		Where(workflowinstance.HasWorkflowWith(workflow.IDEQ(wf))).
+		IDs(ctx)
 	if err != nil {
 		return err
 	}
 
-	for _, i := range instances {
-		err := db.deleteWorkflowInstance(i.ID)
+	for _, id := range ids {
+		err := db.deleteWorkflowInstance(id)
 		if err != nil {
-			log.Errorf("can not delete workflow instance %s", i.InstanceID)
+			log.Errorf("can not delete workflow instance %d", id)
 		}
 	}
 
 	return nil
 }
 
-func (db *dbManager) addWorkflowInstance(ctx context.Context, ns, workflowID, instanceID, input string, cronCheck, mutex bool, callerData []byte) (*ent.WorkflowInstance, error) {
+func (db *dbManager) addWorkflowInstance(ctx context.Context, ns, workflowID string, revision int, instanceID, externalID, input, profile string, cronCheck bool, mutex mutexMode, callerData []byte) (*ent.WorkflowInstance, error) {
 
-	tx, err := db.dbEnt.BeginTx(ctx, &sql.TxOptions{
-		Isolation: sql.LevelSerializable,
+	tx, err := db.dbEnt.BeginTx(ctx, &dbsql.TxOptions{
+		Isolation: dbsql.LevelSerializable,
 	})
 	if err != nil {
 		return nil, err
@@ -111,7 +124,7 @@ func (db *dbManager) addWorkflowInstance(ctx context.Context, ns, workflowID, in
 	var status = "pending"
 	var errCode, errMsg string
 
-	if mutex {
+	if mutex != mutexNone {
 		// NOTE: I know this is ugly and similar to the cronCheck. But they solve different problems because
 		// 	we don't want duplicate crons to be added to the database.
 
@@ -124,13 +137,33 @@ func (db *dbManager) addWorkflowInstance(ctx context.Context, ns, workflowID, in
 		wfs, err := tx.WorkflowInstance.
 			Query().
 			Limit(1).
-			Where(workflowinstance.StatusEQ("pending")).
+			Where(
+				workflowinstance.HasWorkflowWith(workflow.IDEQ(wf.ID)),
+				workflowinstance.StatusEQ("pending"),
+			).
 			Order(ent.Desc(workflowinstance.FieldBeginTime)).All(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		if len(wfs) > 0 {
+		busy := len(wfs) > 0
+
+		// queued invocations go first
+		if !busy && mutex == mutexQueue {
+			busy, err = tx.QueuedInvocation.
+				Query().
+				Where(queuedinvocation.WorkflowEQ(wf.ID)).
+				Exist(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if busy && mutex != mutexFail {
+			return nil, errWorkflowBusy
+		}
+
+		if busy {
 			status = "failed"
 			errCode = "direktiv.mutex"
 			errMsg = "exclusive property prevents new instances while another exists"
@@ -138,12 +171,22 @@ func (db *dbManager) addWorkflowInstance(ctx context.Context, ns, workflowID, in
 
 	}
 
-	wi, err := tx.WorkflowInstance.
+	create := tx.WorkflowInstance.
 		Create().
-		SetInstanceID(instanceID).
-		SetRevision(wf.Revision).
+		SetInstanceID(instanceID)
+
+	if externalID != "" {
+		create = create.SetExternalID(externalID)
+	}
+
+	if profile != "" {
+		create = create.SetProfile(profile)
+	}
+
+	wi, err := create.
+		SetRevision(revision).
 		SetStatus(status).
-		SetBeginTime(time.Now()).
+		SetBeginTime(db.clock.Now()).
 		SetInput(input).
 		SetWorkflow(wf).
 		SetInvokedBy(string(callerData)).
@@ -175,6 +218,106 @@ func (db *dbManager) addWorkflowInstance(ctx context.Context, ns, workflowID, in
 
 }
 
+// importWorkflowInstance recreates a snapshotted instance and its event
+// listeners under a new id.
+func (db *dbManager) importWorkflowInstance(ctx context.Context, wf *ent.Workflow, id string, snap *instanceSnapshot, memory []byte) (*ent.WorkflowInstance, error) {
+
+	tx, err := db.dbEnt.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	create := tx.WorkflowInstance.
+		Create().
+		SetInstanceID(id).
+		SetInvokedBy("").
+		SetStatus("pending").
+		SetRevision(wf.Revision).
+		SetBeginTime(snap.BeginTime).
+		SetStateBeginTime(snap.StateBeginTime).
+		SetFlow(snap.Flow).
+		SetAttempts(snap.Attempts).
+		SetProgress(snap.Progress).
+		SetInput(snapshotText(snap.Input)).
+		SetWorkflow(wf)
+
+	// jsonb columns can't hold empty strings
+	if data := snapshotText(snap.StateData); data != "" {
+		create = create.SetStateData(data)
+	}
+
+	if len(memory) > 0 {
+		create = create.SetMemoryData(memory)
+	}
+
+	if len(snap.Simulation) > 0 {
+		create = create.SetSimulation(snap.Simulation)
+	}
+
+	if len(snap.Events) > 0 {
+		create = create.SetReceivedEvents(snapshotText(snap.Events))
+	}
+
+	if !snap.Deadline.IsZero() {
+		create = create.SetDeadline(snap.Deadline)
+	}
+
+	wi, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range snap.Listeners {
+
+		signature, err := json.Marshal(&eventsWaiterSignature{
+			InstanceID: id,
+			Step:       l.Step,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		correlations := l.Correlations
+		if correlations == nil {
+			correlations = []string{}
+		}
+
+		el, err := tx.WorkflowEvents.
+			Create().
+			SetWorkflow(wf).
+			SetWorkflowinstance(wi).
+			SetEvents(l.Events).
+			SetCorrelations(correlations).
+			SetSignature(signature).
+			SetCount(l.Count).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ev := range l.Received {
+			_, err = tx.WorkflowEventsWait.
+				Create().
+				SetEvents(ev).
+				SetWorkflowevent(el).
+				Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return wi, nil
+
+}
+
 func (db *dbManager) getWorkflowInstanceByID(ctx context.Context, id int) (*ent.WorkflowInstance, error) {
 
 	return db.dbEnt.WorkflowInstance.
@@ -189,7 +332,7 @@ func (db *dbManager) getWorkflowInstanceByID(ctx context.Context, id int) (*ent.
 
 func (db *dbManager) getWorkflowInstanceExpired(ctx context.Context) ([]*ent.WorkflowInstance, error) {
 
-	t := time.Now().Add(-1 * time.Minute)
+	t := db.clock.Now().Add(-1 * time.Minute)
 
 	return db.dbEnt.WorkflowInstance.
 		Query().
@@ -207,9 +350,29 @@ func (db *dbManager) getWorkflowInstanceExpired(ctx context.Context) ([]*ent.Wor
 
 func (db *dbManager) getWorkflowInstance(ctx context.Context, id string) (*ent.WorkflowInstance, error) {
 
+	// the definition itself is left out, instances get it from the workflow
+	// cache by revision
 	return db.dbEnt.WorkflowInstance.
 		Query().
 		Where(workflowinstance.InstanceIDEQ(id)).
+		WithWorkflow(func(q *ent.WorkflowQuery) {
+			q.Select(workflow.FieldName, workflow.FieldCreated, workflow.FieldDescription,
+				workflow.FieldActive, workflow.FieldRevision, workflow.FieldLogToEvents)
+			q.WithNamespace()
+		}).
+		Only(ctx)
+
+}
+
+func (db *dbManager) getWorkflowInstanceByExternalID(ctx context.Context, ns, wf, externalID string) (*ent.WorkflowInstance, error) {
+
+	return db.dbEnt.WorkflowInstance.
+		Query().
+		Where(workflowinstance.ExternalIDEQ(externalID)).
+		Where(workflowinstance.HasWorkflowWith(
+			workflow.NameEQ(wf),
+			workflow.HasNamespaceWith(namespace.IDEQ(ns)),
+		)).
 		WithWorkflow(func(q *ent.WorkflowQuery) {
 			q.WithNamespace()
 		}).
@@ -217,18 +380,53 @@ func (db *dbManager) getWorkflowInstance(ctx context.Context, id string) (*ent.W
 
 }
 
-func (db *dbManager) getWorkflowInstances(ctx context.Context, ns string, offset, limit int) ([]*ent.WorkflowInstance, error) {
+// instanceJSONContains matches instances whose JSON column contains the
+// given JSON document, using postgres' jsonb containment operator. The
+// instanceJSONColumns are jsonb already and indexed, others are cast.
+func instanceJSONContains(field, document string) predicate.WorkflowInstance {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			if isInstanceJSONColumn(field) {
+				b.WriteString(s.C(field)).WriteString(" @> ")
+			} else {
+				b.WriteString("NULLIF(").WriteString(s.C(field)).WriteString(", '')::jsonb @> ")
+			}
+			b.Arg(document).WriteString("::jsonb")
+		}))
+	}
+}
+
+func isInstanceJSONColumn(field string) bool {
+	for _, col := range instanceJSONColumns {
+		if col == field {
+			return true
+		}
+	}
+	return false
+}
+
+func (db *dbManager) getWorkflowInstances(ctx context.Context, ns string, offset, limit int, stateData, output string) ([]*ent.WorkflowInstance, error) {
 
 	if limit == 0 {
 		limit = math.MaxInt32
 	}
 
-	wfs, err := db.dbEnt.WorkflowInstance.
+	query := db.dbRead.WorkflowInstance.
 		Query().
 		Limit(limit).
 		Offset(offset).
 		Select(workflowinstance.FieldInstanceID, workflowinstance.FieldStatus, workflowinstance.FieldBeginTime).
-		Where(workflowinstance.HasWorkflowWith(workflow.HasNamespaceWith(namespace.IDEQ(ns)))).
+		Where(workflowinstance.HasWorkflowWith(workflow.HasNamespaceWith(namespace.IDEQ(ns))))
+
+	if stateData != "" {
+		query = query.Where(instanceJSONContains(workflowinstance.FieldStateData, stateData))
+	}
+
+	if output != "" {
+		query = query.Where(instanceJSONContains(workflowinstance.FieldOutput, output))
+	}
+
+	wfs, err := query.
 		Order(ent.Desc(workflowinstance.FieldBeginTime)).
 		All(ctx)
 
@@ -240,9 +438,107 @@ func (db *dbManager) getWorkflowInstances(ctx context.Context, ns string, offset
 
 }
 
+// getSubjectInstances returns the instances of a namespace that hold data of
+// a subject: those invoked with its external id, or whose input, state data
+// or output contain the given JSON documents. Empty filters are ignored.
+func (db *dbManager) getSubjectInstances(ctx context.Context, ns, wf, externalID, input, stateData, output string) ([]*ent.WorkflowInstance, error) {
+
+	query := db.dbEnt.WorkflowInstance.
+		Query().
+		Select(workflowinstance.FieldInstanceID, workflowinstance.FieldStatus, workflowinstance.FieldHold).
+		Where(workflowinstance.HasWorkflowWith(workflow.HasNamespaceWith(namespace.IDEQ(ns))))
+
+	if wf != "" {
+		query = query.Where(workflowinstance.HasWorkflowWith(workflow.NameEQ(wf)))
+	}
+
+	if externalID != "" {
+		query = query.Where(workflowinstance.ExternalIDEQ(externalID))
+	}
+
+	if input != "" {
+		query = query.Where(instanceJSONContains(workflowinstance.FieldInput, input))
+	}
+
+	if stateData != "" {
+		query = query.Where(instanceJSONContains(workflowinstance.FieldStateData, stateData))
+	}
+
+	if output != "" {
+		query = query.Where(instanceJSONContains(workflowinstance.FieldOutput, output))
+	}
+
+	return query.All(ctx)
+
+}
+
+// scrubWorkflowInstance irreversibly removes the data of a finished instance,
+// including the events it received, keeping only how it ran: its id,
+// external id, invoker, workflow, revision, status, times, flow, attempts,
+// error code, cancel category, controller, profile and hold. It reports false and leaves the instance alone if it is under a legal
+// hold, which is checked in the same statement.
+func (db *dbManager) scrubWorkflowInstance(ctx context.Context, id int) (bool, error) {
+
+	n, err := db.dbEnt.WorkflowInstance.
+		Update().
+		Where(workflowinstance.IDEQ(id), instanceNotHeld()).
+		SetInput("").
+		ClearOutput().
+		ClearStateData().
+		ClearMemory().
+		ClearMemoryData().
+		ClearErrorMessage().
+		ClearErrorData().
+		ClearErrorCauses().
+		ClearCancelReason().
+		ClearProgress().
+		ClearHandoff().
+		ClearSimulation().
+		ClearDebug().
+		ClearReceivedEvents().
+		Save(ctx)
+
+	return n == 1, err
+
+}
+
+// exportWorkflowInstances returns a page of the instances of a namespace
+// matching the filters of an export, oldest first. Empty filters match all
+// instances.
+func (db *dbManager) exportWorkflowInstances(ctx context.Context, ns, wf, status string, after, before time.Time, offset, limit int) ([]*ent.WorkflowInstance, error) {
+
+	query := db.dbRead.WorkflowInstance.
+		Query().
+		Where(workflowinstance.HasWorkflowWith(workflow.HasNamespaceWith(namespace.IDEQ(ns))))
+
+	if wf != "" {
+		query = query.Where(workflowinstance.HasWorkflowWith(workflow.NameEQ(wf)))
+	}
+
+	if status != "" {
+		query = query.Where(workflowinstance.StatusEQ(status))
+	}
+
+	if !after.IsZero() {
+		query = query.Where(workflowinstance.BeginTimeGTE(after))
+	}
+
+	if !before.IsZero() {
+		query = query.Where(workflowinstance.BeginTimeLT(before))
+	}
+
+	return query.
+		WithWorkflow().
+		Order(ent.Asc(workflowinstance.FieldBeginTime), ent.Asc(workflowinstance.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+
+}
+
 func (db *dbManager) getWorkflowInstancesByWFID(ctx context.Context, wf uuid.UUID, offset, limit int) ([]*ent.WorkflowInstance, error) {
 
-	wfs, err := db.dbEnt.WorkflowInstance.
+	wfs, err := db.dbRead.WorkflowInstance.
 		Query().
 		Select(workflowinstance.FieldInstanceID, workflowinstance.FieldStatus, workflowinstance.FieldBeginTime).
 		Where(workflowinstance.HasWorkflowWith(workflow.IDEQ(wf))).
@@ -258,3 +554,18 @@ func (db *dbManager) getWorkflowInstancesByWFID(ctx context.Context, wf uuid.UUI
 	return wfs, nil
 
 }
+
+// getRunningInstancesByWFID returns the ids of a workflow's instances that
+// haven't finished yet.
+func (db *dbManager) getRunningInstancesByWFID(ctx context.Context, wf uuid.UUID) ([]string, error) {
+
+	return db.dbEnt.WorkflowInstance.
+		Query().
+		Where(
+			workflowinstance.HasWorkflowWith(workflow.IDEQ(wf)),
+			workflowinstance.StatusEQ("pending"),
+		).
+		Select(workflowinstance.FieldInstanceID).
+		Strings(ctx)
+
+}
@@ -0,0 +1,95 @@
+package direktiv
+
+import (
+	"context"
+
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/ent/jqmodule"
+	"github.com/vorteil/direktiv/ent/namespace"
+)
+
+func (db *dbManager) getJQModules(ctx context.Context, ns string) ([]*ent.JQModule, error) {
+
+	return db.dbEnt.JQModule.
+		Query().
+		Where(jqmodule.NamespaceEQ(ns)).
+		Order(ent.Asc(jqmodule.FieldName)).
+		All(ctx)
+
+}
+
+func (db *dbManager) getJQModule(ctx context.Context, ns, name string) (*ent.JQModule, error) {
+
+	return db.dbEnt.JQModule.
+		Query().
+		Where(jqmodule.NamespaceEQ(ns), jqmodule.NameEQ(name)).
+		Only(ctx)
+
+}
+
+func (db *dbManager) setJQModule(ctx context.Context, ns, name, source string) error {
+
+	exists, err := db.dbEnt.Namespace.
+		Query().
+		Where(namespace.IDEQ(ns)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return &ent.NotFoundError{}
+	}
+
+	n, err := db.dbEnt.JQModule.
+		Update().
+		Where(jqmodule.NamespaceEQ(ns), jqmodule.NameEQ(name)).
+		SetSource(source).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		return nil
+	}
+
+	_, err = db.dbEnt.JQModule.
+		Create().
+		SetNamespace(ns).
+		SetName(name).
+		SetSource(source).
+		Save(ctx)
+
+	return err
+
+}
+
+func (db *dbManager) deleteJQModule(ctx context.Context, ns, name string) error {
+
+	n, err := db.dbEnt.JQModule.
+		Delete().
+		Where(jqmodule.NamespaceEQ(ns), jqmodule.NameEQ(name)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+
+}
+
+func (db *dbManager) deleteJQModules(ctx context.Context, ns string) error {
+
+	_, err := db.dbEnt.JQModule.
+		Delete().
+		Where(jqmodule.NamespaceEQ(ns)).
+		Exec(ctx)
+
+	return err
+
+}
@@ -0,0 +1,147 @@
+package direktiv
+
+import (
+	"context"
+
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/ent/jsonschema"
+	"github.com/vorteil/direktiv/ent/namespace"
+)
+
+// getJSONSchemas returns every version of every schema a namespace stored.
+func (db *dbManager) getJSONSchemas(ctx context.Context, ns string) ([]*ent.JSONSchema, error) {
+
+	return db.dbEnt.JSONSchema.
+		Query().
+		Where(jsonschema.NamespaceEQ(ns)).
+		Order(ent.Asc(jsonschema.FieldName), ent.Asc(jsonschema.FieldVersion)).
+		All(ctx)
+
+}
+
+// getJSONSchema returns a version of a schema, or its latest version if
+// version is 0.
+func (db *dbManager) getJSONSchema(ctx context.Context, ns, name string, version int) (*ent.JSONSchema, error) {
+
+	query := db.dbEnt.JSONSchema.
+		Query().
+		Where(jsonschema.NamespaceEQ(ns), jsonschema.NameEQ(name))
+
+	if version > 0 {
+		query = query.Where(jsonschema.VersionEQ(version))
+	}
+
+	return query.
+		Order(ent.Desc(jsonschema.FieldVersion)).
+		First(ctx)
+
+}
+
+// getJSONSchemaHash returns the version and hash of a schema like
+// getJSONSchema without loading the schema, and a zero version if the
+// namespace has no such schema.
+func (db *dbManager) getJSONSchemaHash(ctx context.Context, ns, name string, version int) (int, string, error) {
+
+	query := db.dbEnt.JSONSchema.
+		Query().
+		Where(jsonschema.NamespaceEQ(ns), jsonschema.NameEQ(name))
+
+	if version > 0 {
+		query = query.Where(jsonschema.VersionEQ(version))
+	}
+
+	var v []struct {
+		Version int    `json:"version"`
+		Hash    string `json:"hash"`
+	}
+
+	err := query.
+		Order(ent.Desc(jsonschema.FieldVersion)).
+		Limit(1).
+		Select(jsonschema.FieldVersion, jsonschema.FieldHash).
+		Scan(ctx, &v)
+	if err != nil || len(v) == 0 {
+		return 0, "", err
+	}
+
+	return v[0].Version, v[0].Hash, nil
+
+}
+
+// addJSONSchema stores a schema as the next version of its name and returns
+// that version, or the latest version if the schema didn't change.
+func (db *dbManager) addJSONSchema(ctx context.Context, ns, name, schema, hash string) (int, error) {
+
+	exists, err := db.dbEnt.Namespace.
+		Query().
+		Where(namespace.IDEQ(ns)).
+		Exist(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if !exists {
+		return 0, &ent.NotFoundError{}
+	}
+
+	version, latest, err := db.getJSONSchemaHash(ctx, ns, name, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	if version > 0 && latest == hash {
+		return version, nil
+	}
+
+	// the unique index rejects concurrent additions of the same version
+	js, err := db.dbEnt.JSONSchema.
+		Create().
+		SetNamespace(ns).
+		SetName(name).
+		SetVersion(version + 1).
+		SetSchema(schema).
+		SetHash(hash).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return js.Version, nil
+
+}
+
+// deleteJSONSchema deletes a version of a schema, or all of its versions if
+// version is 0.
+func (db *dbManager) deleteJSONSchema(ctx context.Context, ns, name string, version int) error {
+
+	del := db.dbEnt.JSONSchema.
+		Delete().
+		Where(jsonschema.NamespaceEQ(ns), jsonschema.NameEQ(name))
+
+	if version > 0 {
+		del = del.Where(jsonschema.VersionEQ(version))
+	}
+
+	n, err := del.Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+
+}
+
+func (db *dbManager) deleteJSONSchemas(ctx context.Context, ns string) error {
+
+	_, err := db.dbEnt.JSONSchema.
+		Delete().
+		Where(jsonschema.NamespaceEQ(ns)).
+		Exec(ctx)
+
+	return err
+
+}
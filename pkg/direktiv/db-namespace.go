@@ -2,13 +2,20 @@ package direktiv
 
 import (
 	"context"
+	dbsql "database/sql"
+	"encoding/json"
 	"math"
 
+	"github.com/google/uuid"
 	"github.com/vorteil/direktiv/ent"
 	"github.com/vorteil/direktiv/ent/namespace"
+	"github.com/vorteil/direktiv/ent/predicate"
+	"github.com/vorteil/direktiv/ent/workflow"
 	secretsgrpc "github.com/vorteil/direktiv/pkg/secrets/grpc"
 
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (db *dbManager) getNamespace(name string) (*ent.Namespace, error) {
@@ -26,17 +33,30 @@ func (db *dbManager) getNamespace(name string) (*ent.Namespace, error) {
 
 }
 
-func (db *dbManager) addNamespace(ctx context.Context, name string) (*ent.Namespace, error) {
+// maxNamespaceDepth is how deep namespaces can be nested, counting the
+// top-level namespace.
+const maxNamespaceDepth = 4
+
+func (db *dbManager) addNamespace(ctx context.Context, name, region, parent string) (*ent.Namespace, error) {
 
 	tx, err := db.dbEnt.Tx(db.ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	ns, err := tx.Namespace.
+	nc := tx.Namespace.
 		Create().
-		SetID(name).
-		Save(ctx)
+		SetID(name)
+
+	if region != "" {
+		nc.SetRegion(region)
+	}
+
+	if parent != "" {
+		nc.SetParentID(parent)
+	}
+
+	ns, err := nc.Save(ctx)
 	if err != nil {
 		return nil, rollback(tx, err)
 	}
@@ -52,6 +72,32 @@ func (db *dbManager) addNamespace(ctx context.Context, name string) (*ent.Namesp
 
 func (db *dbManager) deleteNamespace(ctx context.Context, name string) error {
 
+	held, err := db.namespaceHeld(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if held {
+		return errLegalHold("namespace", name)
+	}
+
+	parent, err := db.dbEnt.Namespace.
+		Query().
+		Where(namespace.IDEQ(name), namespace.HasChildren()).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+
+	if parent {
+		return status.Errorf(codes.FailedPrecondition, "namespace '%s' has child namespaces", name)
+	}
+
+	vs, err := db.namespaceVarStorage(name)
+	if err != nil {
+		return err
+	}
+
 	// delete all workflows
 	wfs, err := db.getWorkflows(ctx, name, 0, 0)
 	if err != nil {
@@ -84,6 +130,26 @@ func (db *dbManager) deleteNamespace(ctx context.Context, name string) error {
 		log.Errorf("can not delete kubernetes service account: %v", err)
 	}
 
+	err = db.deleteNamespaceEventStats(ctx, name)
+	if err != nil {
+		log.Errorf("can not delete event stats of namespace %s: %v", name, err)
+	}
+
+	err = db.deleteJQModules(ctx, name)
+	if err != nil {
+		log.Errorf("can not delete jq modules of namespace %s: %v", name, err)
+	}
+
+	err = db.deleteWasmModules(ctx, name)
+	if err != nil {
+		log.Errorf("can not delete wasm modules of namespace %s: %v", name, err)
+	}
+
+	err = db.deleteJSONSchemas(ctx, name)
+	if err != nil {
+		log.Errorf("can not delete json schemas of namespace %s: %v", name, err)
+	}
+
 	// delete secrets from secrets backend
 	dr := &secretsgrpc.DeleteSecretsRequest{
 		Namespace: &name,
@@ -93,7 +159,7 @@ func (db *dbManager) deleteNamespace(ctx context.Context, name string) error {
 		return err
 	}
 
-	err = (*db.varStorage).DeleteAllInScope(ctx, name)
+	err = vs.DeleteAllInScope(ctx, name)
 	if err != nil {
 		return err
 	}
@@ -110,6 +176,7 @@ func (db *dbManager) getNamespaces(ctx context.Context, offset, limit int) ([]*e
 
 	ns, err := db.dbEnt.Namespace.
 		Query().
+		WithParent().
 		Limit(limit).
 		Offset(offset).
 		Order(ent.Asc(namespace.FieldID)).
@@ -122,3 +189,312 @@ func (db *dbManager) getNamespaces(ctx context.Context, offset, limit int) ([]*e
 	return ns, nil
 
 }
+
+// namespaceAncestors returns the parent of a namespace, its parent and so
+// on.
+func (db *dbManager) namespaceAncestors(ctx context.Context, name string) ([]string, error) {
+
+	var ancestors []string
+
+	for len(ancestors) < maxNamespaceDepth {
+
+		parent, err := db.dbEnt.Namespace.
+			Query().
+			Where(namespace.HasChildrenWith(namespace.IDEQ(name))).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		name = parent.ID
+		ancestors = append(ancestors, name)
+
+	}
+
+	return ancestors, nil
+
+}
+
+// namespaceDescendants returns the children of a namespace, their children
+// and so on.
+func (db *dbManager) namespaceDescendants(ctx context.Context, name string) ([]string, error) {
+
+	var descendants []string
+
+	parents := []string{name}
+
+	for depth := 1; depth < maxNamespaceDepth && len(parents) > 0; depth++ {
+
+		children, err := db.dbEnt.Namespace.
+			Query().
+			Where(namespace.HasParentWith(namespace.IDIn(parents...))).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		descendants = append(descendants, children...)
+		parents = children
+
+	}
+
+	return descendants, nil
+
+}
+
+// getNamespaceEventConfig returns the stored event config of a namespace,
+// which is empty if it has none.
+func (db *dbManager) getNamespaceEventConfig(ctx context.Context, name string) ([]byte, error) {
+
+	ns, err := db.dbEnt.Namespace.
+		Query().
+		Where(namespace.IDEQ(name)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ns.EventConfig, nil
+
+}
+
+func (db *dbManager) setNamespaceEventConfig(ctx context.Context, name string, data []byte) error {
+
+	upd := db.dbEnt.Namespace.Update().Where(namespace.IDEQ(name))
+	if len(data) == 0 {
+		upd = upd.ClearEventConfig()
+	} else {
+		upd = upd.SetEventConfig(data)
+	}
+
+	n, err := upd.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+
+}
+
+func (db *dbManager) getNamespaceConstants(ctx context.Context, name string) ([]byte, error) {
+
+	ns, err := db.dbEnt.Namespace.
+		Query().
+		Where(namespace.IDEQ(name)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ns.Constants, nil
+
+}
+
+func (db *dbManager) setNamespaceConstants(ctx context.Context, name string, data []byte) error {
+
+	upd := db.dbEnt.Namespace.Update().Where(namespace.IDEQ(name))
+	if len(data) == 0 {
+		upd = upd.ClearConstants()
+	} else {
+		upd = upd.SetConstants(data)
+	}
+
+	n, err := upd.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+
+}
+
+func (db *dbManager) getNamespaceRetryPolicies(ctx context.Context, name string) ([]byte, error) {
+
+	ns, err := db.dbEnt.Namespace.
+		Query().
+		Where(namespace.IDEQ(name)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ns.RetryPolicies, nil
+
+}
+
+func (db *dbManager) setNamespaceRetryPolicies(ctx context.Context, name string, data []byte) error {
+
+	upd := db.dbEnt.Namespace.Update().Where(namespace.IDEQ(name))
+	if len(data) == 0 {
+		upd = upd.ClearRetryPolicies()
+	} else {
+		upd = upd.SetRetryPolicies(data)
+	}
+
+	n, err := upd.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+
+}
+
+func (db *dbManager) getNamespaceProfiles(ctx context.Context, name string) ([]byte, string, error) {
+
+	ns, err := db.dbEnt.Namespace.
+		Query().
+		Where(namespace.IDEQ(name)).
+		Only(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return ns.Profiles, ns.Profile, nil
+
+}
+
+// setNamespaceProfile replaces the constants of one profile of a namespace,
+// or deletes the profile if there are none.
+func (db *dbManager) setNamespaceProfile(ctx context.Context, name, profile string, constants map[string]interface{}) error {
+
+	tx, err := db.dbEnt.BeginTx(ctx, &dbsql.TxOptions{
+		Isolation: dbsql.LevelSerializable,
+	})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	ns, err := tx.Namespace.
+		Query().
+		Where(namespace.IDEQ(name)).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	profiles := make(map[string]map[string]interface{})
+	if len(ns.Profiles) > 0 {
+		err = json.Unmarshal(ns.Profiles, &profiles)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(constants) == 0 {
+		delete(profiles, profile)
+	} else {
+		profiles[profile] = constants
+	}
+
+	upd := tx.Namespace.UpdateOne(ns)
+	if len(profiles) == 0 {
+		upd = upd.ClearProfiles()
+	} else {
+		data, err := json.Marshal(profiles)
+		if err != nil {
+			return err
+		}
+		upd = upd.SetProfiles(data)
+	}
+
+	_, err = upd.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+
+}
+
+func (db *dbManager) setNamespaceActiveProfile(ctx context.Context, name, profile string) error {
+
+	upd := db.dbEnt.Namespace.Update().Where(namespace.IDEQ(name))
+	if profile == "" {
+		upd = upd.ClearProfile()
+	} else {
+		upd = upd.SetProfile(profile)
+	}
+
+	n, err := upd.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+
+}
+
+func (db *dbManager) setCronSuspension(ctx context.Context, name, reason string) error {
+
+	upd := db.dbEnt.Namespace.Update().Where(namespace.IDEQ(name))
+	if reason == "" {
+		upd = upd.ClearCronSuspension()
+	} else {
+		upd = upd.SetCronSuspension(reason)
+	}
+
+	n, err := upd.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+
+}
+
+// namespaceCronsSuspended matches namespaces whose scheduled starts are
+// suspended, by themselves or by an ancestor.
+func namespaceCronsSuspended() predicate.Namespace {
+
+	p := namespace.CronSuspensionNEQ("")
+	for i := 1; i < maxNamespaceDepth; i++ {
+		p = namespace.Or(namespace.CronSuspensionNEQ(""), namespace.HasParentWith(p))
+	}
+
+	return p
+
+}
+
+// workflowCronsSuspended reports whether the scheduled starts of a workflow's
+// namespace are suspended.
+func (db *dbManager) workflowCronsSuspended(ctx context.Context, id string) (bool, error) {
+
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return false, err
+	}
+
+	return db.dbEnt.Workflow.
+		Query().
+		Where(
+			workflow.IDEQ(u),
+			workflow.HasNamespaceWith(namespaceCronsSuspended()),
+		).
+		Exist(ctx)
+
+}
@@ -0,0 +1,265 @@
+package direktiv
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/ent/node"
+	"github.com/vorteil/direktiv/ent/workflowinstance"
+)
+
+func (db *dbManager) registerNode(ctx context.Context, hostname string, promoted bool) error {
+
+	_, err := db.dbEnt.Node.
+		Delete().
+		Where(node.HostnameEQ(hostname)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	t := time.Now()
+
+	_, err = db.dbEnt.Node.
+		Create().
+		SetHostname(hostname).
+		SetStarted(t).
+		SetHeartbeat(t).
+		SetPromoted(promoted).
+		Save(ctx)
+
+	return err
+
+}
+
+// heartbeatNode refreshes a node's heartbeat, load, timer and lock stats, and
+// removes the records of nodes that have been gone since before expired.
+func (db *dbManager) heartbeatNode(ctx context.Context, hostname string, expired time.Time, timers, queued int,
+	timerStats, lockStats []byte) error {
+
+	_, err := db.dbEnt.Node.
+		Update().
+		Where(node.HostnameEQ(hostname)).
+		SetHeartbeat(time.Now()).
+		SetTimers(timers).
+		SetQueued(queued).
+		SetTimerStats(timerStats).
+		SetLockStats(lockStats).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.dbEnt.Node.
+		Delete().
+		Where(node.HeartbeatLT(expired)).
+		Exec(ctx)
+
+	return err
+
+}
+
+func (db *dbManager) setNodeDraining(ctx context.Context, hostname string) error {
+
+	_, err := db.dbEnt.Node.
+		Update().
+		Where(node.HostnameEQ(hostname)).
+		SetDraining(true).
+		Save(ctx)
+
+	return err
+
+}
+
+func (db *dbManager) setNodeQueued(ctx context.Context, hostname string, queued int) error {
+
+	_, err := db.dbEnt.Node.
+		Update().
+		Where(node.HostnameEQ(hostname)).
+		SetQueued(queued).
+		Save(ctx)
+
+	return err
+
+}
+
+func (db *dbManager) deleteNode(ctx context.Context, hostname string) error {
+
+	_, err := db.dbEnt.Node.
+		Delete().
+		Where(node.HostnameEQ(hostname)).
+		Exec(ctx)
+
+	return err
+
+}
+
+func (db *dbManager) getNodes(ctx context.Context) ([]*ent.Node, error) {
+
+	return db.dbEnt.Node.
+		Query().
+		Order(ent.Asc(node.FieldHostname)).
+		All(ctx)
+
+}
+
+// countOwnedInstances returns how many running instances each node controls.
+func (db *dbManager) countOwnedInstances(ctx context.Context) (map[string]int, error) {
+
+	var v []struct {
+		Controller string `json:"controller"`
+		Count      int    `json:"count"`
+	}
+
+	err := db.dbRead.WorkflowInstance.
+		Query().
+		Where(
+			workflowinstance.StatusEQ("pending"),
+			workflowinstance.ControllerNotNil(),
+		).
+		GroupBy(workflowinstance.FieldController).
+		Aggregate(ent.Count()).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int)
+	for _, x := range v {
+		counts[x.Controller] = x.Count
+	}
+
+	return counts, nil
+
+}
+
+// getLiveNodes returns the sorted hostnames of the nodes, other than hostname,
+// that aren't draining and have sent a heartbeat since alive.
+func (db *dbManager) getLiveNodes(ctx context.Context, hostname string, alive time.Time) ([]string, error) {
+
+	hostnames, err := db.dbEnt.Node.
+		Query().
+		Where(
+			node.HostnameNEQ(hostname),
+			node.DrainingEQ(false),
+			node.HeartbeatGT(alive),
+		).
+		Select(node.FieldHostname).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(hostnames)
+
+	return hostnames, nil
+
+}
+
+// getOwnedInstances returns the ids of the running instances a node controls.
+func (db *dbManager) getOwnedInstances(ctx context.Context, hostname string) ([]string, error) {
+
+	return db.dbEnt.WorkflowInstance.
+		Query().
+		Where(
+			workflowinstance.ControllerEQ(hostname),
+			workflowinstance.StatusEQ("pending"),
+		).
+		Select(workflowinstance.FieldInstanceID).
+		Strings(ctx)
+
+}
+
+func (db *dbManager) getNodeInstances(ctx context.Context, hostname string, offset, limit int) ([]*ent.WorkflowInstance, error) {
+
+	if limit == 0 {
+		limit = math.MaxInt32
+	}
+
+	return db.dbRead.WorkflowInstance.
+		Query().
+		Where(
+			workflowinstance.ControllerEQ(hostname),
+			workflowinstance.StatusEQ("pending"),
+		).
+		Select(workflowinstance.FieldInstanceID, workflowinstance.FieldBeginTime,
+			workflowinstance.FieldDeadline, workflowinstance.FieldFlow, workflowinstance.FieldHandoff).
+		Order(ent.Desc(workflowinstance.FieldBeginTime)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+
+}
+
+// getHandedOffInstances returns the ids of instances handed to a node that it
+// hasn't adopted yet.
+func (db *dbManager) getHandedOffInstances(ctx context.Context, hostname string) ([]string, error) {
+
+	return db.dbEnt.WorkflowInstance.
+		Query().
+		Where(
+			workflowinstance.ControllerEQ(hostname),
+			workflowinstance.HandoffNotNil(),
+		).
+		Select(workflowinstance.FieldInstanceID).
+		Strings(ctx)
+
+}
+
+func (db *dbManager) handOffInstance(ctx context.Context, rec *ent.WorkflowInstance, hostname string, timers []byte) error {
+
+	_, err := rec.Update().
+		SetController(hostname).
+		SetHandoff(timers).
+		Save(ctx)
+
+	return err
+
+}
+
+func (db *dbManager) clearHandoff(ctx context.Context, rec *ent.WorkflowInstance) error {
+
+	_, err := rec.Update().
+		ClearHandoff().
+		Save(ctx)
+
+	return err
+
+}
+
+// deleteStaleNodes removes the records of nodes other than hostname that
+// weren't promoted with it, which belong to the cluster it took over from.
+func (db *dbManager) deleteStaleNodes(ctx context.Context, hostname string) (int, error) {
+
+	return db.dbEnt.Node.
+		Delete().
+		Where(
+			node.HostnameNEQ(hostname),
+			node.PromotedEQ(false),
+		).
+		Exec(ctx)
+
+}
+
+// takeOverInstances makes hostname the owner of every running instance.
+func (db *dbManager) takeOverInstances(ctx context.Context, hostname string) ([]*ent.WorkflowInstance, error) {
+
+	_, err := db.dbEnt.WorkflowInstance.
+		Update().
+		Where(workflowinstance.StatusEQ("pending")).
+		SetController(hostname).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.dbEnt.WorkflowInstance.
+		Query().
+		Where(workflowinstance.StatusEQ("pending")).
+		Select(workflowinstance.FieldInstanceID, workflowinstance.FieldDeadline, workflowinstance.FieldFlow).
+		All(ctx)
+
+}
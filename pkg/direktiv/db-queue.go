@@ -0,0 +1,88 @@
+package direktiv
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/ent/queuedinvocation"
+)
+
+func (db *dbManager) addQueuedInvocation(ctx context.Context, wf uuid.UUID, id, source string, params []byte, expires time.Time) error {
+
+	_, err := db.dbEnt.QueuedInvocation.
+		Create().
+		SetInstanceID(id).
+		SetWorkflow(wf).
+		SetSource(source).
+		SetParams(params).
+		SetExpires(expires).
+		Save(ctx)
+
+	return err
+
+}
+
+// nextQueuedInvocation returns the oldest invocation queued for a workflow,
+// or nil if there is none.
+func (db *dbManager) nextQueuedInvocation(ctx context.Context, wf uuid.UUID) (*ent.QueuedInvocation, error) {
+
+	qi, err := db.dbEnt.QueuedInvocation.
+		Query().
+		Where(queuedinvocation.WorkflowEQ(wf)).
+		Order(ent.Asc(queuedinvocation.FieldCreated), ent.Asc(queuedinvocation.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+
+	return qi, err
+
+}
+
+func (db *dbManager) deleteQueuedInvocation(ctx context.Context, id int) error {
+
+	_, err := db.dbEnt.QueuedInvocation.
+		Delete().
+		Where(queuedinvocation.IDEQ(id)).
+		Exec(ctx)
+
+	return err
+
+}
+
+// expiredQueuedInvocations returns the queued invocations whose ttl passed
+// by now.
+func (db *dbManager) expiredQueuedInvocations(ctx context.Context, now time.Time) ([]*ent.QueuedInvocation, error) {
+
+	return db.dbEnt.QueuedInvocation.
+		Query().
+		Where(queuedinvocation.ExpiresLT(now)).
+		All(ctx)
+
+}
+
+// queuedWorkflows returns the workflows that have queued invocations.
+func (db *dbManager) queuedWorkflows(ctx context.Context) ([]uuid.UUID, error) {
+
+	var v []struct {
+		Workflow uuid.UUID `json:"workflow"`
+	}
+
+	err := db.dbEnt.QueuedInvocation.
+		Query().
+		GroupBy(queuedinvocation.FieldWorkflow).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	wfs := make([]uuid.UUID, len(v))
+	for i := range v {
+		wfs[i] = v[i].Workflow
+	}
+
+	return wfs, nil
+
+}
@@ -0,0 +1,111 @@
+package direktiv
+
+import (
+	"context"
+
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/ent/queuedstate"
+)
+
+func (db *dbManager) addQueuedState(ctx context.Context, hostname, instanceID string, msg []byte) error {
+
+	_, err := db.dbEnt.QueuedState.
+		Create().
+		SetHostname(hostname).
+		SetInstanceID(instanceID).
+		SetMessage(msg).
+		SetSize(len(msg)).
+		Save(ctx)
+
+	return err
+
+}
+
+// takeQueuedState removes and returns the oldest state queued for a node, or
+// its newest one no larger than maxSize if maxSize isn't 0. It returns nil if
+// there is none.
+func (db *dbManager) takeQueuedState(ctx context.Context, hostname string, maxSize int) (*ent.QueuedState, error) {
+
+	for {
+
+		query := db.dbEnt.QueuedState.
+			Query().
+			Where(queuedstate.HostnameEQ(hostname))
+
+		order := ent.Asc(queuedstate.FieldCreated)
+		if maxSize > 0 {
+			query = query.Where(queuedstate.SizeLTE(maxSize))
+			order = ent.Desc(queuedstate.FieldCreated)
+		}
+
+		qs, err := query.Order(order).First(ctx)
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// another worker may have taken it first
+		n, err := db.dbEnt.QueuedState.
+			Delete().
+			Where(queuedstate.IDEQ(qs.ID)).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if n == 1 {
+			return qs, nil
+		}
+
+	}
+
+}
+
+func (db *dbManager) countQueuedStates(ctx context.Context, hostname string) (int, error) {
+
+	return db.dbEnt.QueuedState.
+		Query().
+		Where(queuedstate.HostnameEQ(hostname)).
+		Count(ctx)
+
+}
+
+// getNodeQueuedStates returns the states queued for a node, without their
+// messages.
+func (db *dbManager) getNodeQueuedStates(ctx context.Context, hostname string) ([]*ent.QueuedState, error) {
+
+	return db.dbEnt.QueuedState.
+		Query().
+		Where(queuedstate.HostnameEQ(hostname)).
+		Select(queuedstate.FieldID, queuedstate.FieldHostname, queuedstate.FieldInstanceID).
+		All(ctx)
+
+}
+
+// getForeignQueuedStates returns the states queued for nodes other than the
+// ones listed, without their messages.
+func (db *dbManager) getForeignQueuedStates(ctx context.Context, hostnames []string) ([]*ent.QueuedState, error) {
+
+	return db.dbEnt.QueuedState.
+		Query().
+		Where(queuedstate.HostnameNotIn(hostnames...)).
+		Select(queuedstate.FieldID, queuedstate.FieldHostname, queuedstate.FieldInstanceID).
+		All(ctx)
+
+}
+
+// moveQueuedState queues a state for another node, unless it was taken from
+// its old one in the meantime.
+func (db *dbManager) moveQueuedState(ctx context.Context, qs *ent.QueuedState, to string) error {
+
+	_, err := db.dbEnt.QueuedState.
+		Update().
+		Where(queuedstate.IDEQ(qs.ID), queuedstate.HostnameEQ(qs.Hostname)).
+		SetHostname(to).
+		Save(ctx)
+
+	return err
+
+}
@@ -0,0 +1,94 @@
+package direktiv
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/ent/scheduledinvocation"
+)
+
+func (db *dbManager) addScheduledInvocation(ctx context.Context, ns, wf string, t time.Time, input []byte, state, externalID string, expires *time.Time, notify bool) (*ent.ScheduledInvocation, error) {
+
+	return db.dbEnt.ScheduledInvocation.
+		Create().
+		SetNamespace(ns).
+		SetWorkflow(wf).
+		SetTime(t).
+		SetInput(input).
+		SetState(state).
+		SetExternalID(externalID).
+		SetNillableExpires(expires).
+		SetNotify(notify).
+		Save(ctx)
+
+}
+
+func (db *dbManager) getScheduledInvocations(ctx context.Context, ns string) ([]*ent.ScheduledInvocation, error) {
+
+	return db.dbEnt.ScheduledInvocation.
+		Query().
+		Where(scheduledinvocation.NamespaceEQ(ns)).
+		Order(ent.Asc(scheduledinvocation.FieldTime)).
+		All(ctx)
+
+}
+
+// getScheduledInvocation returns a scheduled invocation, or nil if it is
+// gone.
+func (db *dbManager) getScheduledInvocation(ctx context.Context, id uuid.UUID) (*ent.ScheduledInvocation, error) {
+
+	si, err := db.dbEnt.ScheduledInvocation.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+
+	return si, err
+
+}
+
+// claimScheduledInvocation deletes a scheduled invocation, reporting whether
+// it was still there, so that only one server starts it.
+func (db *dbManager) claimScheduledInvocation(ctx context.Context, id uuid.UUID) (bool, error) {
+
+	n, err := db.dbEnt.ScheduledInvocation.
+		Delete().
+		Where(scheduledinvocation.IDEQ(id)).
+		Exec(ctx)
+
+	return n > 0, err
+
+}
+
+func (db *dbManager) deleteScheduledInvocation(ctx context.Context, ns string, id uuid.UUID) error {
+
+	n, err := db.dbEnt.ScheduledInvocation.
+		Delete().
+		Where(
+			scheduledinvocation.IDEQ(id),
+			scheduledinvocation.NamespaceEQ(ns),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+
+}
+
+// dueScheduledInvocations returns the ids of the invocations scheduled
+// before t.
+func (db *dbManager) dueScheduledInvocations(ctx context.Context, t time.Time) ([]uuid.UUID, error) {
+
+	return db.dbEnt.ScheduledInvocation.
+		Query().
+		Where(scheduledinvocation.TimeLT(t)).
+		IDs(ctx)
+
+}
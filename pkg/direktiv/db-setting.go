@@ -0,0 +1,70 @@
+package direktiv
+
+import (
+	"context"
+
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/ent/setting"
+)
+
+// getSetting returns a cluster setting, or nil if it isn't set.
+func (db *dbManager) getSetting(ctx context.Context, key string) (*ent.Setting, error) {
+
+	s, err := db.dbEnt.Setting.
+		Query().
+		Where(setting.KeyEQ(key)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+
+}
+
+func (db *dbManager) setSetting(ctx context.Context, key, value string) error {
+
+	for {
+
+		n, err := db.dbEnt.Setting.
+			Update().
+			Where(setting.KeyEQ(key)).
+			SetValue(value).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		if n > 0 {
+			return nil
+		}
+
+		_, err = db.dbEnt.Setting.
+			Create().
+			SetKey(key).
+			SetValue(value).
+			Save(ctx)
+		// someone else created it in the meantime
+		if ent.IsConstraintError(err) {
+			continue
+		}
+
+		return err
+
+	}
+
+}
+
+func (db *dbManager) deleteSetting(ctx context.Context, key string) error {
+
+	_, err := db.dbEnt.Setting.
+		Delete().
+		Where(setting.KeyEQ(key)).
+		Exec(ctx)
+
+	return err
+
+}
@@ -0,0 +1,63 @@
+package direktiv
+
+import (
+	"context"
+	"time"
+
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/ent/timer"
+)
+
+func (db *dbManager) saveTimer(ctx context.Context, name, fn string, t time.Time, data []byte) error {
+
+	err := db.deleteTimer(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.dbEnt.Timer.
+		Create().
+		SetName(name).
+		SetFunction(fn).
+		SetTime(t).
+		SetData(data).
+		Save(ctx)
+
+	return err
+
+}
+
+func (db *dbManager) deleteTimer(ctx context.Context, name string) error {
+
+	_, err := db.dbEnt.Timer.
+		Delete().
+		Where(timer.NameEQ(name)).
+		Exec(ctx)
+
+	return err
+
+}
+
+// deleteInstanceTimers removes the recorded timers of an instance, named the
+// same way as the timers deleteTimersForInstance stops.
+func (db *dbManager) deleteInstanceTimers(ctx context.Context, id string) error {
+
+	_, err := db.dbEnt.Timer.
+		Delete().
+		Where(timer.Or(
+			timer.NameHasPrefix("timeout:"+id),
+			timer.NameHasPrefix(id),
+		)).
+		Exec(ctx)
+
+	return err
+
+}
+
+func (db *dbManager) getTimers(ctx context.Context) ([]*ent.Timer, error) {
+
+	return db.dbEnt.Timer.
+		Query().
+		All(ctx)
+
+}
@@ -0,0 +1,114 @@
+package direktiv
+
+import (
+	"context"
+
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/ent/namespace"
+	"github.com/vorteil/direktiv/ent/wasmmodule"
+)
+
+func (db *dbManager) getWasmModules(ctx context.Context, ns string) ([]*ent.WasmModule, error) {
+
+	return db.dbEnt.WasmModule.
+		Query().
+		Where(wasmmodule.NamespaceEQ(ns)).
+		Order(ent.Asc(wasmmodule.FieldName)).
+		All(ctx)
+
+}
+
+func (db *dbManager) getWasmModule(ctx context.Context, ns, name string) (*ent.WasmModule, error) {
+
+	return db.dbEnt.WasmModule.
+		Query().
+		Where(wasmmodule.NamespaceEQ(ns), wasmmodule.NameEQ(name)).
+		Only(ctx)
+
+}
+
+// getWasmModuleHash returns the hash of a module without loading its binary,
+// or an empty string if the namespace has no such module.
+func (db *dbManager) getWasmModuleHash(ctx context.Context, ns, name string) (string, error) {
+
+	hashes, err := db.dbEnt.WasmModule.
+		Query().
+		Where(wasmmodule.NamespaceEQ(ns), wasmmodule.NameEQ(name)).
+		Select(wasmmodule.FieldHash).
+		Strings(ctx)
+	if err != nil || len(hashes) == 0 {
+		return "", err
+	}
+
+	return hashes[0], nil
+
+}
+
+func (db *dbManager) setWasmModule(ctx context.Context, ns, name string, binary []byte, hash string) error {
+
+	exists, err := db.dbEnt.Namespace.
+		Query().
+		Where(namespace.IDEQ(ns)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return &ent.NotFoundError{}
+	}
+
+	n, err := db.dbEnt.WasmModule.
+		Update().
+		Where(wasmmodule.NamespaceEQ(ns), wasmmodule.NameEQ(name)).
+		SetBinary(binary).
+		SetHash(hash).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		return nil
+	}
+
+	_, err = db.dbEnt.WasmModule.
+		Create().
+		SetNamespace(ns).
+		SetName(name).
+		SetBinary(binary).
+		SetHash(hash).
+		Save(ctx)
+
+	return err
+
+}
+
+func (db *dbManager) deleteWasmModule(ctx context.Context, ns, name string) error {
+
+	n, err := db.dbEnt.WasmModule.
+		Delete().
+		Where(wasmmodule.NamespaceEQ(ns), wasmmodule.NameEQ(name)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+
+}
+
+func (db *dbManager) deleteWasmModules(ctx context.Context, ns string) error {
+
+	_, err := db.dbEnt.WasmModule.
+		Delete().
+		Where(wasmmodule.NamespaceEQ(ns)).
+		Exec(ctx)
+
+	return err
+
+}
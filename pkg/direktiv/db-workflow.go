@@ -9,6 +9,7 @@ import (
 	"github.com/vorteil/direktiv/ent"
 	"github.com/vorteil/direktiv/ent/namespace"
 	"github.com/vorteil/direktiv/ent/workflow"
+	"github.com/vorteil/direktiv/ent/workflowrevision"
 	"github.com/vorteil/direktiv/pkg/model"
 
 	"github.com/google/uuid"
@@ -37,6 +38,11 @@ func (db *dbManager) addWorkflow(ctx context.Context, ns, name, description stri
 		return nil, rollback(tx, err)
 	}
 
+	err = addWorkflowRevision(ctx, tx, wf)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
 	err = db.processWorkflowEvents(ctx, tx, wf, startDefinition, active)
 	if err != nil {
 		return nil, rollback(tx, err)
@@ -98,6 +104,11 @@ func (db *dbManager) updateWorkflow(ctx context.Context, id string, revision *in
 		return nil, rollback(tx, err)
 	}
 
+	err = addWorkflowRevision(ctx, tx, wf)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
 	// delete functions first
 	err = deleteKnativeFunctions(id, db)
 	if err != nil {
@@ -125,6 +136,15 @@ func (db *dbManager) deleteWorkflow(ctx context.Context, id string) error {
 		return err
 	}
 
+	held, err := db.workflowHeld(ctx, u)
+	if err != nil {
+		return err
+	}
+
+	if held {
+		return errLegalHold("workflow", wf.Name)
+	}
+
 	ns := wf.Edges.Namespace
 
 	// delete all event listeners and events
@@ -156,6 +176,13 @@ func (db *dbManager) deleteWorkflow(ctx context.Context, id string) error {
 		log.Errorf("Can not delete cron for workflow: %v", id)
 	}
 
+	_, err = db.dbEnt.WorkflowRevision.Delete().
+		Where(workflowrevision.HasWorkflowWith(workflow.IDEQ(u))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
 	i, err := db.dbEnt.Workflow.Delete().
 		Where(workflow.IDEQ(u)).
 		Exec(ctx)
@@ -177,6 +204,37 @@ func (db *dbManager) deleteWorkflow(ctx context.Context, id string) error {
 
 }
 
+// addWorkflowRevision keeps the definition wf was saved with, so that
+// instances pinned to its revision can run it after later updates.
+func addWorkflowRevision(ctx context.Context, tx *ent.Tx, wf *ent.Workflow) error {
+
+	_, err := tx.WorkflowRevision.
+		Create().
+		SetRevision(wf.Revision).
+		SetDefinition(wf.Workflow).
+		SetWorkflowID(wf.ID).
+		Save(ctx)
+
+	return err
+
+}
+
+// getWorkflowRevision returns the definition a workflow had at revision.
+// Revisions that didn't change the definition, such as toggling the
+// workflow, aren't stored, so this is the latest one saved up to revision.
+func (db *dbManager) getWorkflowRevision(ctx context.Context, id uuid.UUID, revision int) (*ent.WorkflowRevision, error) {
+
+	return db.dbEnt.WorkflowRevision.
+		Query().
+		Where(
+			workflowrevision.HasWorkflowWith(workflow.IDEQ(id)),
+			workflowrevision.RevisionLTE(revision),
+		).
+		Order(ent.Desc(workflowrevision.FieldRevision)).
+		First(ctx)
+
+}
+
 func (db *dbManager) getAllWorkflows() ([]*ent.Workflow, error) {
 	return db.dbEnt.Workflow.Query().Select(workflow.FieldID).All(db.ctx)
 }
@@ -264,6 +322,21 @@ func (db *dbManager) getWorkflows(ctx context.Context, ns string, offset, limit
 
 }
 
+// getInactiveWorkflows returns the disabled workflows of a namespace with
+// their definitions.
+func (db *dbManager) getInactiveWorkflows(ctx context.Context, ns string) ([]*ent.Workflow, error) {
+
+	return db.dbRead.Workflow.
+		Query().
+		Where(
+			workflow.HasNamespaceWith(namespace.IDEQ(ns)),
+			workflow.ActiveEQ(false),
+		).
+		Order(ent.Asc(workflow.FieldName)).
+		All(ctx)
+
+}
+
 func (db *dbManager) getWorkflowCount(ctx context.Context, ns string, offset, limit int) (int, error) {
 
 	wfCount, err := db.dbEnt.Workflow.
@@ -5,12 +5,17 @@ import (
 	"database/sql"
 	"fmt"
 	"io/ioutil"
+	"net/url"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/lib/pq"
 	log "github.com/sirupsen/logrus"
 	"github.com/vorteil/direktiv/ent"
 	"github.com/vorteil/direktiv/ent/hook"
+	"github.com/vorteil/direktiv/ent/workflowinstance"
+	"github.com/vorteil/direktiv/pkg/clock"
 	secretsgrpc "github.com/vorteil/direktiv/pkg/secrets/grpc"
 	"github.com/vorteil/direktiv/pkg/varstore"
 	"google.golang.org/grpc"
@@ -23,19 +28,68 @@ const (
 // DBManager contains all database related information and functions
 type dbManager struct {
 	dbEnt      *ent.Client
+	dbRead     *ent.Client
 	ctx        context.Context
 	tm         *timerManager
+	clock      clock.Clock
 	varStorage *varstore.VarStorage
 
 	grpcConn      *grpc.ClientConn
 	secretsClient secretsgrpc.SecretsServiceClient
 
 	dbForLock *sql.DB
+	leases    *lockLeases
+}
+
+// dbLock is a lock held in the database: an advisory lock, held as long as
+// conn, or a lease of the lock table.
+type dbLock struct {
+	conn  *sql.Conn
+	lease *lockLease
+}
+
+// lockAppPrefix starts the application name of the lock connections, which
+// is followed by the hostname of the node so that the holder of a lock can be
+// told from pg_stat_activity.
+const lockAppPrefix = "direktiv-flow:"
+
+// lockAppName returns the application name of a node's lock connections,
+// cut to the length postgres keeps.
+func lockAppName(hostname string) string {
+
+	name := lockAppPrefix + hostname
+	if len(name) > 63 {
+		name = name[:63]
+	}
+
+	return name
+
+}
+
+func lockConnString(conn, hostname string) string {
+
+	name := lockAppName(hostname)
+
+	u, err := url.Parse(conn)
+	if err == nil && (u.Scheme == "postgres" || u.Scheme == "postgresql") {
+		q := u.Query()
+		q.Set("application_name", name)
+		u.RawQuery = q.Encode()
+		return u.String()
+	}
+
+	return fmt.Sprintf("%s application_name='%s'", conn, name)
+
 }
 
 func prepLockDB(conn string) (*sql.DB, error) {
 
-	db, err := sql.Open("postgres", conn)
+	hn, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("postgres", lockConnString(conn, hn))
 
 	db.SetConnMaxIdleTime(-1)
 	db.SetConnMaxLifetime(-1)
@@ -65,12 +119,33 @@ func newDBManager(ctx context.Context, conn string, config *Config) (*dbManager,
 	udb.SetMaxIdleConns(10)
 	udb.SetMaxOpenConns(10)
 
-	// Run the auto migration tool.
-	if err := db.dbEnt.Schema.Create(db.ctx); err != nil {
+	switch config.Database.Locking {
+	case "", lockingAdvisory, lockingTable:
+	default:
+		return nil, fmt.Errorf("unknown database locking '%s'", config.Database.Locking)
+	}
+
+	if err := migrateSchema(db.ctx, db.dbEnt, udb); err != nil {
 		log.Errorf("failed creating schema resources: %v", err)
 		return nil, err
 	}
 
+	// reads that can lag behind writes go to the replica if there is one
+	db.dbRead = db.dbEnt
+	if config.Database.Replica != "" {
+
+		db.dbRead, err = ent.Open("postgres", config.Database.Replica)
+		if err != nil {
+			log.Errorf("can not connect to db replica: %v", err)
+			return nil, err
+		}
+
+		rdb := db.dbRead.DB()
+		rdb.SetMaxIdleConns(10)
+		rdb.SetMaxOpenConns(10)
+
+	}
+
 	// increasing the version of the workflow by one
 	// can be used to lookup which workflow uses which revision
 	db.dbEnt.Workflow.Use(func(next ent.Mutator) ent.Mutator {
@@ -99,10 +174,137 @@ func newDBManager(ctx context.Context, conn string, config *Config) (*dbManager,
 		return nil, err
 	}
 
+	if config.Database.Locking == lockingTable {
+		hn, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		db.leases = &lockLeases{
+			client: db.dbEnt,
+			db:     db.dbEnt.DB(),
+			holder: hn,
+		}
+	}
+
 	return db, nil
 
 }
 
+// migrationLock is the advisory lock servers hold while migrating the
+// schema, so that only one of them changes it at a time.
+const migrationLock = 0x6469726b6d696772
+
+// migrateSchema runs the auto migration tool. On postgres it also moves
+// instance data to indexed jsonb columns, which databases that only speak
+// its protocol, such as CockroachDB, don't accept the statements for, and
+// holds a lock while doing so, so that servers starting at the same time
+// don't migrate at once and those starting later find nothing left to do.
+func migrateSchema(ctx context.Context, client *ent.Client, udb *sql.DB) error {
+
+	var version string
+	err := udb.QueryRowContext(ctx, "SELECT version()").Scan(&version)
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(version, "PostgreSQL") {
+		log.Warnf("not converting and indexing the jsonb columns of %s on '%s', columns older versions stored as text have to be converted by hand",
+			workflowinstance.Table, version)
+		return client.Schema.Create(ctx)
+	}
+
+	conn, err := udb.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", int64(migrationLock))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", int64(migrationLock))
+		if err != nil {
+			log.Errorf("can not unlock the migration lock: %v", err)
+		}
+	}()
+
+	err = convertInstanceJSONColumns(ctx, conn)
+	if err != nil {
+		return fmt.Errorf("failed converting instance columns: %v", err)
+	}
+
+	err = client.Schema.Create(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = indexInstanceJSONColumns(ctx, conn)
+	if err != nil {
+		return fmt.Errorf("failed indexing instance columns: %v", err)
+	}
+
+	return nil
+
+}
+
+// instanceJSONColumns are the instance columns stored as jsonb, which
+// instance listings can be filtered by.
+var instanceJSONColumns = []string{
+	workflowinstance.FieldStateData,
+	workflowinstance.FieldOutput,
+}
+
+// convertInstanceJSONColumns converts the instance columns older versions
+// stored as text to jsonb, which the migration tool can't do by itself.
+func convertInstanceJSONColumns(ctx context.Context, conn *sql.Conn) error {
+
+	for _, col := range instanceJSONColumns {
+
+		var typ string
+		err := conn.QueryRowContext(ctx, `SELECT data_type FROM information_schema.columns
+			WHERE table_schema = current_schema() AND table_name = $1 AND column_name = $2`,
+			workflowinstance.Table, col).Scan(&typ)
+		if err == sql.ErrNoRows || typ == "jsonb" {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		log.Infof("converting column %s of %s to jsonb", col, workflowinstance.Table)
+
+		_, err = conn.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE jsonb USING NULLIF(%s, '')::jsonb",
+			workflowinstance.Table, col, col))
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+
+}
+
+// indexInstanceJSONColumns adds the GIN indexes that serve the containment
+// queries of instance filters, which ent's schema can't declare.
+func indexInstanceJSONColumns(ctx context.Context, conn *sql.Conn) error {
+
+	for _, col := range instanceJSONColumns {
+
+		_, err := conn.ExecContext(ctx, fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s_%s_gin ON %s USING GIN (%s jsonb_path_ops)",
+			workflowinstance.Table, col, workflowinstance.Table, col))
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+
+}
+
 func rollback(tx *ent.Tx, err error) error {
 	if rerr := tx.Rollback(); rerr != nil {
 		err = fmt.Errorf("%v: %v", err, rerr)
@@ -110,7 +312,15 @@ func rollback(tx *ent.Tx, err error) error {
 	return err
 }
 
-func (db *dbManager) tryLockDB(id uint64) (bool, *sql.Conn, error) {
+func (db *dbManager) tryLockDB(id uint64) (bool, *dbLock, error) {
+
+	if db.leases != nil {
+		l, err := db.leases.try(context.Background(), id)
+		if err != nil || l == nil {
+			return false, nil, err
+		}
+		return true, &dbLock{lease: l}, nil
+	}
 
 	var gotLock bool
 
@@ -122,13 +332,23 @@ func (db *dbManager) tryLockDB(id uint64) (bool, *sql.Conn, error) {
 	conn.QueryRowContext(context.Background(), "SELECT pg_try_advisory_lock($1)", int64(id)).Scan(&gotLock)
 	if !gotLock {
 		conn.Close()
+		return false, nil, nil
 	}
 
-	return gotLock, conn, nil
+	return gotLock, &dbLock{conn: conn}, nil
 
 }
 
-func (db *dbManager) lockDB(id uint64, wait int) (*sql.Conn, error) {
+func (db *dbManager) lockDB(id uint64, wait int) (*dbLock, error) {
+	return db.waitLockDB(id, wait, nil)
+}
+
+// lockHolderFunc returns the holder of a lock like lockHolder.
+type lockHolderFunc func(ctx context.Context) (int, string, error)
+
+// waitLockDB waits up to wait seconds for the lock id. If someone else holds
+// it, contended is called before waiting, with a way to look up the holder.
+func (db *dbManager) waitLockDB(id uint64, wait int, contended func(holder lockHolderFunc)) (*dbLock, error) {
 
 	var err error
 
@@ -136,28 +356,163 @@ func (db *dbManager) lockDB(id uint64, wait int) (*sql.Conn, error) {
 		time.Duration(wait)*time.Second)
 	defer cancel()
 
+	if db.leases != nil {
+		var notify func()
+		if contended != nil {
+			notify = func() {
+				contended(func(ctx context.Context) (int, string, error) {
+					return db.lockHolder(ctx, id)
+				})
+			}
+		}
+		l, err := db.leases.lock(ctx, id, notify)
+		if err != nil {
+			return nil, err
+		}
+		return &dbLock{lease: l}, nil
+	}
+
 	conn, err := db.dbForLock.Conn(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	if contended != nil {
+		var gotLock bool
+		err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", int64(id)).Scan(&gotLock)
+		if err == nil && gotLock {
+			return &dbLock{conn: conn}, nil
+		}
+		if err == nil {
+			// asks through conn, so that it doesn't wait for a free
+			// lock connection
+			contended(func(ctx context.Context) (int, string, error) {
+				return queryLockHolder(ctx, conn, id)
+			})
+		}
+	}
+
 	_, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", int64(id))
+	if err != nil {
+		conn.Close()
+	}
 
 	if err, ok := err.(*pq.Error); ok {
 
 		log.Debugf("db lock failed: %v", err)
 		if err.Code == "57014" {
-			return conn, fmt.Errorf("canceled query")
+			return nil, fmt.Errorf("canceled query")
 		}
-		return conn, err
+		return nil, err
+
+	}
 
+	if err != nil {
+		return nil, err
 	}
 
-	return conn, err
+	return &dbLock{conn: conn}, nil
 
 }
 
-func (db *dbManager) unlockDB(id uint64, conn *sql.Conn) error {
+// lockHolder returns the backend pid of the connection holding the lock id
+// and the hostname of its node, which is empty if it isn't a flow server, or
+// sql.ErrNoRows if nobody holds it. Leases have no pid.
+func (db *dbManager) lockHolder(ctx context.Context, id uint64) (int, string, error) {
+
+	if db.leases != nil {
+		node, err := db.leases.holderOf(ctx, id)
+		return 0, node, err
+	}
+
+	return queryLockHolder(ctx, db.dbForLock, id)
+
+}
+
+type rowQuerier interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func queryLockHolder(ctx context.Context, q rowQuerier, id uint64) (int, string, error) {
+
+	var pid int
+	var app string
+
+	err := q.QueryRowContext(ctx, `SELECT a.pid, a.application_name
+		FROM pg_locks l JOIN pg_stat_activity a ON a.pid = l.pid
+		WHERE l.locktype = 'advisory' AND l.granted AND l.objsubid = 1
+		AND l.classid::bigint = $1 AND l.objid::bigint = $2`,
+		int64(id>>32), int64(id&0xffffffff)).Scan(&pid, &app)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if !strings.HasPrefix(app, lockAppPrefix) {
+		return pid, "", nil
+	}
+
+	return pid, strings.TrimPrefix(app, lockAppPrefix), nil
+
+}
+
+// breakLock frees the lock id of a holder that is gone, by terminating the
+// connection pid holding an advisory lock or deleting the lease.
+func (db *dbManager) breakLock(ctx context.Context, id uint64, pid int) (bool, error) {
+
+	if db.leases != nil {
+		return db.leases.breakLease(ctx, id)
+	}
+
+	return db.terminateBackend(ctx, pid)
+
+}
+
+// lockHeld reports whether a lock is still held.
+func (db *dbManager) lockHeld(ctx context.Context, l *dbLock) bool {
+
+	if l.lease != nil {
+		return db.leases.held(ctx, l.lease)
+	}
+
+	return l.conn.PingContext(ctx) == nil
+
+}
+
+// dropLock forgets a lock that is no longer held.
+func (db *dbManager) dropLock(l *dbLock) {
+
+	if l.lease != nil {
+		_ = db.leases.unlock(l.lease)
+		return
+	}
+
+	l.conn.Close()
+
+}
+
+// terminateBackend closes the database connection of a backend, which
+// releases every advisory lock it holds.
+func (db *dbManager) terminateBackend(ctx context.Context, pid int) (bool, error) {
+
+	var ok bool
+
+	err := db.dbForLock.QueryRowContext(ctx, "SELECT pg_terminate_backend($1)", pid).Scan(&ok)
+
+	return ok, err
+
+}
+
+func (db *dbManager) unlockDB(id uint64, l *dbLock) error {
+
+	if l.lease != nil {
+		err := db.leases.unlock(l.lease)
+		if err != nil {
+			log.Errorf("can not unlock lock %d: %v", id, err)
+		}
+		return err
+	}
+
+	conn := l.conn
 
 	_, err := conn.ExecContext(context.Background(),
 		"SELECT pg_advisory_unlock($1)", int64(id))
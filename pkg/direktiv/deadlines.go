@@ -0,0 +1,201 @@
+package direktiv
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	hashstructure "github.com/mitchellh/hashstructure/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/metrics"
+	"github.com/vorteil/direktiv/pkg/model"
+)
+
+// The deadline monitor warns about namespaces whose states routinely come
+// close to their deadlines, which happens when workers or isolates can't
+// keep up well before instances start timing out en masse. Every completed
+// state records how long it had until its deadline next to how long it
+// took. Once a minute one of the flow servers looks at the states of the
+// last quarter hour, and raises an alert for a namespace when enough of them
+// used most of their time. The alert is a direktiv.deadlines.slipping event
+// in the namespace and a namespace log line, and it is lifted again with a
+// direktiv.deadlines.recovered event once the share drops to half of the
+// threshold.
+
+const (
+	deadlineCheckInterval = time.Minute
+	deadlineWindow        = time.Minute * 15
+
+	// share of its time a state has to use to count as near its deadline
+	deadlineNearUsage = 0.8
+
+	// namespaces with fewer states in the window aren't judged
+	deadlineMinSamples = 20
+
+	deadlineMonitorLock = "deadlineMonitor"
+
+	deadlinesSlippingEvent  = "direktiv.deadlines.slipping"
+	deadlinesRecoveredEvent = "direktiv.deadlines.recovered"
+)
+
+// waitingStateTypes wait for their deadline, or something close to it, by
+// design, so how much of their time they use says nothing about capacity.
+var waitingStateTypes = map[string]bool{
+	model.StateTypeDelay.String():        true,
+	model.StateTypeConsumeEvent.String(): true,
+	model.StateTypeEventsAnd.String():    true,
+	model.StateTypeEventsXor.String():    true,
+	model.StateTypeEventBatch.String():   true,
+	model.StateTypePoll.String():         true,
+}
+
+func (we *workflowEngine) monitorsDeadlines() bool {
+	return we.server.config.DeadlineAlertPercent > 0
+}
+
+func (we *workflowEngine) deadlineUsage(ctx context.Context, namespace string) ([]*metrics.DeadlineUsage, error) {
+	return we.metricsClient.GetDeadlineUsage(ctx, namespace, time.Now().Add(-deadlineWindow), deadlineNearUsage)
+}
+
+func (we *workflowEngine) monitorDeadlines() {
+
+	hash, err := hashstructure.Hash(deadlineMonitorLock, hashstructure.FormatV2, nil)
+	if err != nil {
+		log.Errorf("cannot start deadline monitor: %v", err)
+		return
+	}
+
+	// the server holding the lock monitors, and remembers the alerts raised
+	var lock *dbLock
+	var raised map[string]bool
+
+	defer func() {
+		if lock != nil {
+			_ = we.db.unlockDB(hash, lock)
+		}
+	}()
+
+	ticker := time.NewTicker(deadlineCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-we.nodeDone:
+			return
+		case <-ticker.C:
+		}
+
+		if we.isStandby() {
+			continue
+		}
+
+		if lock != nil && !we.db.lockHeld(context.Background(), lock) {
+			we.db.dropLock(lock)
+			lock = nil
+		}
+
+		if lock == nil {
+			var locked bool
+			locked, lock, err = we.db.tryLockDB(hash)
+			if err != nil || !locked {
+				lock = nil
+				continue
+			}
+			raised = make(map[string]bool)
+		}
+
+		we.checkDeadlines(raised)
+
+	}
+
+}
+
+func (we *workflowEngine) checkDeadlines(raised map[string]bool) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), deadlineCheckInterval)
+	defer cancel()
+
+	usages, err := we.deadlineUsage(ctx, "")
+	if err != nil {
+		log.Errorf("cannot check instance deadlines: %v", err)
+		return
+	}
+
+	threshold := float64(we.server.config.DeadlineAlertPercent)
+	seen := make(map[string]bool)
+
+	for _, du := range usages {
+
+		seen[du.Namespace] = true
+		share := du.NearShare()
+
+		if !raised[du.Namespace] {
+			if du.Samples >= deadlineMinSamples && share >= threshold {
+				raised[du.Namespace] = true
+				we.deadlineAlert(ctx, du, deadlinesSlippingEvent,
+					"%.0f%% of %d states in the last %v used %.0f%% of their time or more, %d timed out",
+					share, du.Samples, deadlineWindow, deadlineNearUsage*100, du.TimedOut)
+			}
+			continue
+		}
+
+		if du.Samples < deadlineMinSamples || share < threshold/2 {
+			delete(raised, du.Namespace)
+			we.deadlineAlert(ctx, du, deadlinesRecoveredEvent,
+				"%.0f%% of %d states in the last %v came near their deadline, states keep to their deadlines again",
+				share, du.Samples, deadlineWindow)
+		}
+
+	}
+
+	// nothing ran in these for a while
+	for ns := range raised {
+		if !seen[ns] {
+			delete(raised, ns)
+			we.deadlineAlert(ctx, &metrics.DeadlineUsage{Namespace: ns}, deadlinesRecoveredEvent,
+				"no states in the last %v, states keep to their deadlines again", deadlineWindow)
+		}
+	}
+
+}
+
+func (we *workflowEngine) deadlineAlert(ctx context.Context, du *metrics.DeadlineUsage, typ, msg string, a ...interface{}) {
+
+	s := fmt.Sprintf(msg, a...)
+	slipping := typ == deadlinesSlippingEvent
+
+	if slipping {
+		log.Warnf("deadlines of namespace %s are slipping: %s", du.Namespace, s)
+	} else {
+		log.Infof("deadlines of namespace %s recovered: %s", du.Namespace, s)
+	}
+
+	l, err := we.server.instanceLogger.NamespaceLogger(du.Namespace)
+	if err == nil {
+		if slipping {
+			l.Warn(s)
+		} else {
+			l.Info(s)
+		}
+		l.Close()
+	}
+
+	event := cloudevents.NewEvent()
+	event.SetID(uuid.New().String())
+	event.SetSource("direktiv")
+	event.SetType(typ)
+
+	err = event.SetData("application/json", du)
+	if err != nil {
+		log.Errorf("failed to marshal deadline alert: %v", err)
+		return
+	}
+
+	err = we.emitEvent(ctx, du.Namespace, &event)
+	if err != nil {
+		log.Errorf("failed to broadcast deadline alert: %v", err)
+	}
+
+}
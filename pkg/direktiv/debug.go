@@ -0,0 +1,531 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/pkg/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Breakpoints are states an instance pauses before. The instance transitions
+// into the state as usual but doesn't run it, and waits with its deadline
+// cleared until it is resumed through the api: it can continue, step to the
+// next state and pause again, skip the state, or abort, and its state data can
+// be replaced first. The breakpoint "*" pauses before every state. Timeouts of
+// the workflow itself still apply to a paused instance.
+
+// Instances can also have their transforms debugged, which records the query,
+// input and output or error of every state transform and action input in the
+// instance log. Secrets are redacted, and each of them is cut to
+// transformDebugMaxSize. Instances invoked with verbose logging log at debug
+// level when they take and release their lock, enter and leave states, and
+// schedule timeouts and wakeups.
+
+// breakpoint matching every state
+const allStates = "*"
+
+// ways to resume a paused instance
+const (
+	debugContinue = "continue"
+	debugStep     = "step"
+	debugSkip     = "skip"
+	debugAbort    = "abort"
+)
+
+type debugger struct {
+	Breakpoints []string `json:"breakpoints,omitempty"`
+
+	// Step pauses before the next state, whatever it is
+	Step bool `json:"step,omitempty"`
+
+	// Paused is the step the instance is paused before
+	Paused int `json:"paused,omitempty"`
+
+	// Transforms records every jq transform in the instance log
+	Transforms bool `json:"transforms,omitempty"`
+
+	// Verbose logs locking, timers and state changes in the instance log
+	Verbose bool `json:"verbose,omitempty"`
+}
+
+func loadDebugger(rec *ent.WorkflowInstance) (*debugger, error) {
+
+	dbg := new(debugger)
+
+	if len(rec.Debug) == 0 {
+		return dbg, nil
+	}
+
+	err := json.Unmarshal(rec.Debug, dbg)
+	if err != nil {
+		return nil, NewInternalError(fmt.Errorf("cannot load instance breakpoints: %v", err))
+	}
+
+	return dbg, nil
+
+}
+
+// paused reports whether rec is paused at a breakpoint.
+func (dbg *debugger) paused(rec *ent.WorkflowInstance) bool {
+	return dbg.Paused > 0 && dbg.Paused == len(rec.Flow) && rec.Status == "pending"
+}
+
+func (dbg *debugger) breaksAt(state string) bool {
+
+	for _, b := range dbg.Breakpoints {
+		if b == state || b == allStates {
+			return true
+		}
+	}
+
+	return false
+
+}
+
+func checkBreakpoints(wf *model.Workflow, breakpoints []string) error {
+
+	states := wf.GetStatesMap()
+
+	for _, b := range breakpoints {
+		if b == allStates {
+			continue
+		}
+		if _, ok := states[b]; !ok {
+			return grpc.Errorf(codes.InvalidArgument, "breakpoint '%s' isn't a state of the workflow", b)
+		}
+	}
+
+	return nil
+
+}
+
+// skipTransition returns the state a skipped state moves on to when none is
+// named, which is its only transition other than those of its error catchers.
+func skipTransition(state model.State) (string, error) {
+
+	caught := make(map[string]int)
+	for _, catch := range state.ErrorDefinitions() {
+		if catch.Transition != "" {
+			caught[catch.Transition]++
+		}
+	}
+
+	var next []string
+	seen := make(map[string]bool)
+	for _, t := range state.GetTransitions() {
+		if caught[t] > 0 {
+			caught[t]--
+			continue
+		}
+		if !seen[t] {
+			seen[t] = true
+			next = append(next, t)
+		}
+	}
+
+	switch len(next) {
+	case 0:
+		return "", nil
+	case 1:
+		return next[0], nil
+	}
+
+	return "", grpc.Errorf(codes.InvalidArgument, "state '%s' can transition to more than one state, name the one to skip to", state.GetID())
+
+}
+
+// breakpoint pauses the instance before the state it just transitioned to if
+// that is a breakpoint, and reports whether it did.
+func (wli *workflowLogicInstance) breakpoint(ctx context.Context, oldController string) bool {
+
+	if len(wli.rec.Debug) == 0 {
+		return false
+	}
+
+	dbg, err := loadDebugger(wli.rec)
+	if err != nil {
+		log.Error(err)
+		return false
+	}
+
+	if !dbg.Step && !dbg.breaksAt(wli.logic.ID()) {
+		return false
+	}
+
+	dbg.Step = false
+	dbg.Paused = wli.step
+
+	data, err := json.Marshal(dbg)
+	if err != nil {
+		log.Error(err)
+		return false
+	}
+
+	wf := wli.rec.Edges.Workflow
+
+	rec, err := wli.rec.Update().SetDebug(data).ClearDeadline().Save(ctx)
+	if err != nil {
+		log.Error(err)
+		return false
+	}
+	wli.rec = rec
+	wli.rec.Edges.Workflow = wf
+
+	// the previous state's timeouts would otherwise still fire
+	oldID := fmt.Sprintf("timeout:%s:soft:%d", wli.id, wli.step-1)
+	wli.engine.timer.deleteTimerByName(oldController, wli.engine.server.hostname, oldID)
+	wli.cancelStateHardTimeout(oldController)
+
+	wli.Log("Paused at breakpoint before state: %s (%d).", wli.logic.ID(), wli.step)
+
+	return true
+
+}
+
+func (we *workflowEngine) setBreakpoints(ctx context.Context, id string, breakpoints []string) error {
+
+	rec, err := we.db.getWorkflowInstance(ctx, id)
+	if err != nil {
+		return grpcDatabaseError(err, "instance", id)
+	}
+
+	if rec.Status != "pending" {
+		return grpc.Errorf(codes.FailedPrecondition, "instance '%s' has already finished", id)
+	}
+
+	if len(rec.Flow) == 0 {
+		return grpc.Errorf(codes.FailedPrecondition, "instance '%s' hasn't started yet", id)
+	}
+
+	lctx, wli, err := we.loadWorkflowLogicInstance(id, -1)
+	if err != nil {
+		return err
+	}
+	defer wli.Close()
+
+	err = checkBreakpoints(wli.wf, breakpoints)
+	if err != nil {
+		return err
+	}
+
+	dbg, err := loadDebugger(wli.rec)
+	if err != nil {
+		return err
+	}
+	dbg.Breakpoints = breakpoints
+
+	data, err := json.Marshal(dbg)
+	if err != nil {
+		return NewInternalError(err)
+	}
+
+	err = wli.rec.Update().SetDebug(data).Exec(lctx)
+	if err != nil {
+		return NewInternalError(err)
+	}
+
+	if len(breakpoints) == 0 {
+		wli.Log("Breakpoints cleared.")
+	} else {
+		wli.Log("Breakpoints set: %s.", strings.Join(breakpoints, ", "))
+	}
+
+	return nil
+
+}
+
+// resumeInstance resumes an instance paused at a breakpoint. If data isn't
+// empty it replaces the state data first.
+func (we *workflowEngine) resumeInstance(ctx context.Context, id, action string, data []byte, next string) error {
+
+	if action == "" {
+		action = debugContinue
+	}
+
+	switch action {
+	case debugContinue, debugStep, debugSkip, debugAbort:
+	default:
+		return grpc.Errorf(codes.InvalidArgument, "unknown action '%s', expected one of: %s, %s, %s, %s",
+			action, debugContinue, debugStep, debugSkip, debugAbort)
+	}
+
+	rec, err := we.db.getWorkflowInstance(ctx, id)
+	if err != nil {
+		return grpcDatabaseError(err, "instance", id)
+	}
+
+	dbg, err := loadDebugger(rec)
+	if err != nil {
+		return err
+	}
+
+	if !dbg.paused(rec) {
+		return grpc.Errorf(codes.FailedPrecondition, "instance '%s' isn't paused at a breakpoint", id)
+	}
+
+	if action == debugAbort {
+		return we.hardCancelInstance(id, cancelCodePrefix+"debug", "aborted at breakpoint")
+	}
+
+	var stateData map[string]interface{}
+	if len(data) > 0 {
+		err = json.Unmarshal(data, &stateData)
+		if err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "state data must be a json object: %v", err)
+		}
+	}
+
+	lctx, wli, err := we.loadWorkflowLogicInstance(id, dbg.Paused)
+	if err != nil {
+		return err
+	}
+
+	var success bool
+	defer func() {
+		if !success {
+			wli.Close()
+		}
+	}()
+
+	// check again now that the instance is locked
+	dbg, err = loadDebugger(wli.rec)
+	if err != nil {
+		return err
+	}
+
+	if !dbg.paused(wli.rec) {
+		return grpc.Errorf(codes.FailedPrecondition, "instance '%s' isn't paused at a breakpoint", id)
+	}
+
+	states := wli.wf.GetStatesMap()
+	state := states[wli.logic.ID()]
+
+	if action == debugSkip {
+		if next == "" {
+			next, err = skipTransition(state)
+			if err != nil {
+				return err
+			}
+		} else if _, ok := states[next]; !ok {
+			return grpc.Errorf(codes.InvalidArgument, "workflow has no state '%s'", next)
+		}
+	}
+
+	dbg.Paused = 0
+	dbg.Step = action == debugStep
+
+	b, err := json.Marshal(dbg)
+	if err != nil {
+		return NewInternalError(err)
+	}
+
+	upd := wli.rec.Update().SetDebug(b)
+
+	if stateData != nil {
+		upd = upd.SetStateData(string(data))
+	}
+
+	var deadline, hardDeadline time.Time
+	if action != debugSkip {
+		t := we.clock.Now()
+		deadline, hardDeadline, err = stateDeadlines(state, wli.logic, t)
+		if err != nil {
+			return NewInternalError(err)
+		}
+		upd = upd.SetDeadline(deadline).SetStateBeginTime(t).SetController(we.server.hostname)
+	}
+
+	wf := wli.rec.Edges.Workflow
+	oldController := wli.rec.Controller
+
+	wli.rec, err = upd.Save(lctx)
+	if err != nil {
+		return NewInternalError(err)
+	}
+	wli.rec.Edges.Workflow = wf
+
+	if stateData != nil {
+		wli.data = stateData
+		wli.Log("State data replaced at breakpoint.")
+	}
+
+	success = true
+
+	if action == debugSkip {
+		wli.Log("Skipped state at breakpoint: %s.", wli.logic.ID())
+		we.queue.submit(func() {
+			we.transitionState(lctx, wli, &stateTransition{NextState: next}, "")
+		})
+		return nil
+	}
+
+	wli.ScheduleSoftTimeout(oldController, deadline)
+	if !hardDeadline.IsZero() {
+		wli.ScheduleHardTimeout(oldController, hardDeadline)
+	}
+
+	if action == debugStep {
+		wli.Log("Stepping from breakpoint.")
+	} else {
+		wli.Log("Continuing from breakpoint.")
+	}
+
+	we.queue.submit(func() {
+		we.runState(lctx, wli, nil, nil, nil)
+	})
+
+	return nil
+
+}
+
+// setTransformDebug turns debugging the transforms of a running instance on
+// or off.
+func (we *workflowEngine) setTransformDebug(ctx context.Context, id string, enabled bool) error {
+
+	rec, err := we.db.getWorkflowInstance(ctx, id)
+	if err != nil {
+		return grpcDatabaseError(err, "instance", id)
+	}
+
+	if rec.Status != "pending" {
+		return grpc.Errorf(codes.FailedPrecondition, "instance '%s' has already finished", id)
+	}
+
+	lctx, wli, err := we.loadWorkflowLogicInstance(id, -1)
+	if err != nil {
+		return err
+	}
+	defer wli.Close()
+
+	dbg, err := loadDebugger(wli.rec)
+	if err != nil {
+		return err
+	}
+	dbg.Transforms = enabled
+
+	data, err := json.Marshal(dbg)
+	if err != nil {
+		return NewInternalError(err)
+	}
+
+	err = wli.rec.Update().SetDebug(data).Exec(lctx)
+	if err != nil {
+		return NewInternalError(err)
+	}
+
+	if enabled {
+		wli.Log("Transform debugging enabled.")
+	} else {
+		wli.Log("Transform debugging disabled.")
+	}
+
+	return nil
+
+}
+
+// transformDebugMaxSize caps the query, input and output recorded for a
+// debugged transform.
+const transformDebugMaxSize = 4096
+
+const redacted = "[redacted]"
+
+// debugger returns the debug settings of the instance, which are empty if
+// its record isn't loaded yet.
+func (wli *workflowLogicInstance) debugger() *debugger {
+
+	if wli.rec == nil || len(wli.rec.Debug) == 0 {
+		return new(debugger)
+	}
+
+	dbg, err := loadDebugger(wli.rec)
+	if err != nil {
+		log.Error(err)
+		return new(debugger)
+	}
+
+	return dbg
+
+}
+
+// debugTransform records a transform of the instance in its log if its
+// transforms are debugged, with the values of secrets redacted. Redaction
+// only finds the secrets as they are, so the output of transforms that have
+// secrets, which may hold values derived from them such as encoded auth
+// headers, isn't recorded at all.
+func (wli *workflowLogicInstance) debugTransform(what string, query, input, output interface{}, err error, secrets map[string]string) {
+
+	if !wli.debugger().Transforms {
+		return
+	}
+
+	q, ok := query.(string)
+	if !ok {
+		q = debugJSON(query, secrets)
+	}
+
+	msg := fmt.Sprintf("Debug %s in state %s:\nquery: %s\ninput: %s\n", what, wli.logic.ID(),
+		truncateDebug(q), debugJSON(input, secrets))
+
+	if err != nil {
+		msg += fmt.Sprintf("error: %s", truncateDebug(redactSecrets(err.Error(), secrets)))
+	} else if len(secrets) > 0 {
+		msg += "output: [not recorded, the transform uses secrets]"
+	} else {
+		msg += fmt.Sprintf("output: %s", debugJSON(output, secrets))
+	}
+
+	wli.Log("%s", msg)
+
+}
+
+func debugJSON(v interface{}, secrets map[string]string) string {
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+
+	return truncateDebug(redactSecrets(string(data), secrets))
+
+}
+
+// redactSecrets replaces the values of secrets in s, which may be JSON.
+func redactSecrets(s string, secrets map[string]string) string {
+
+	for _, v := range secrets {
+
+		if v == "" {
+			continue
+		}
+
+		s = strings.ReplaceAll(s, v, redacted)
+
+		// as it appears in JSON strings
+		data, _ := json.Marshal(v)
+		if esc := string(data[1 : len(data)-1]); esc != v {
+			s = strings.ReplaceAll(s, esc, redacted)
+		}
+
+	}
+
+	return s
+
+}
+
+func truncateDebug(s string) string {
+
+	if len(s) <= transformDebugMaxSize {
+		return s
+	}
+
+	return strings.ToValidUTF8(s[:transformDebugMaxSize], "") +
+		fmt.Sprintf("... (%d bytes truncated)", len(s)-transformDebugMaxSize)
+
+}
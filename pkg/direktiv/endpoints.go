@@ -0,0 +1,232 @@
+package direktiv
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	endpointCheckInterval = 10 * time.Second
+	endpointDialTimeout   = 5 * time.Second
+)
+
+// endpointPool spreads isolate requests over several gateways that route to
+// the isolate services, such as knative ingress gateways. Requests keep their
+// service address and only the connection is made to a gateway. Gateways are
+// health checked in the background and connections fail over to the next
+// healthy gateway.
+type endpointPool struct {
+	mtx       sync.Mutex
+	endpoints []*poolEndpoint
+	next      int
+	dialer    *net.Dialer
+}
+
+type poolEndpoint struct {
+	addr    string
+	healthy bool
+}
+
+// newEndpointPool returns nil if no endpoints are configured, in which case
+// isolate services are dialed directly.
+func newEndpointPool(addrs []string) *endpointPool {
+
+	if len(addrs) == 0 {
+		return nil
+	}
+
+	p := &endpointPool{
+		dialer: &net.Dialer{
+			Timeout:   endpointDialTimeout,
+			KeepAlive: 30 * time.Second,
+		},
+	}
+
+	for _, addr := range addrs {
+		p.endpoints = append(p.endpoints, &poolEndpoint{
+			addr:    addr,
+			healthy: true,
+		})
+	}
+
+	go p.check()
+
+	return p
+
+}
+
+func (p *endpointPool) check() {
+
+	for {
+
+		for _, e := range p.candidates(true) {
+
+			conn, err := net.DialTimeout("tcp", e.addr, endpointDialTimeout)
+			if err == nil {
+				_ = conn.Close()
+			}
+
+			p.mark(e, err)
+
+		}
+
+		time.Sleep(endpointCheckInterval)
+
+	}
+
+}
+
+func (p *endpointPool) mark(e *poolEndpoint, err error) {
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	healthy := err == nil
+	if e.healthy == healthy {
+		return
+	}
+
+	e.healthy = healthy
+
+	if healthy {
+		log.Infof("isolate endpoint %s is healthy again", e.addr)
+	} else {
+		log.Warnf("isolate endpoint %s is unhealthy: %v", e.addr, err)
+	}
+
+}
+
+// healthy reports whether any of the endpoints is healthy.
+func (p *endpointPool) healthy() bool {
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, e := range p.endpoints {
+		if e.healthy {
+			return true
+		}
+	}
+
+	return false
+
+}
+
+// candidates returns the endpoints in the order they should be tried,
+// starting at the next one in the rotation. Healthy endpoints come first, so
+// unhealthy ones are only tried when nothing else works.
+func (p *endpointPool) candidates(all bool) []*poolEndpoint {
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	n := len(p.endpoints)
+	start := p.next
+	p.next = (p.next + 1) % n
+
+	var healthy, unhealthy []*poolEndpoint
+
+	for i := 0; i < n; i++ {
+		e := p.endpoints[(start+i)%n]
+		if e.healthy {
+			healthy = append(healthy, e)
+		} else {
+			unhealthy = append(unhealthy, e)
+		}
+	}
+
+	if all || len(healthy) == 0 {
+		return append(healthy, unhealthy...)
+	}
+
+	return healthy
+
+}
+
+// DialContext connects to an endpoint instead of addr, trying each endpoint
+// in turn until one accepts the connection.
+func (p *endpointPool) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+
+	err := errors.New("no isolate endpoints")
+
+	for _, e := range p.candidates(false) {
+
+		var conn net.Conn
+		conn, err = p.dialer.DialContext(ctx, network, e.addr)
+		if err == nil {
+			return conn, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, err
+		}
+
+		p.mark(e, err)
+
+	}
+
+	return nil, err
+
+}
+
+// isolatePools resolves the endpoint pool for each namespace's actions.
+type isolatePools struct {
+	global     *endpointPool
+	namespaces map[string]*endpointPool
+}
+
+func newIsolatePools(config *Config) *isolatePools {
+
+	pools := &isolatePools{
+		global:     newEndpointPool(config.IsolateEndpoints),
+		namespaces: make(map[string]*endpointPool),
+	}
+
+	for ns, addrs := range config.NamespaceIsolateEndpoints {
+		if p := newEndpointPool(addrs); p != nil {
+			pools.namespaces[ns] = p
+		}
+	}
+
+	return pools
+
+}
+
+// unhealthy returns the names of the pools without any healthy endpoint.
+func (pools *isolatePools) unhealthy() []string {
+
+	var names []string
+
+	if pools.global != nil && !pools.global.healthy() {
+		names = append(names, "global")
+	}
+
+	for ns, p := range pools.namespaces {
+		if !p.healthy() {
+			names = append(names, ns)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+
+}
+
+// pool returns the namespace's own pool if it has one, or the global pool. A
+// nil pool means isolate services are dialed directly.
+func (pools *isolatePools) pool(namespace string) *endpointPool {
+
+	if p, ok := pools.namespaces[namespace]; ok {
+		return p
+	}
+
+	return pools.global
+
+}
@@ -18,3 +18,12 @@ func randSeq(n int) string {
 	}
 	return string(b)
 }
+
+func contains(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
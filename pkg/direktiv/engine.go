@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
@@ -18,18 +19,27 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/vorteil/direktiv/pkg/calendar"
+	"github.com/vorteil/direktiv/pkg/clock"
 	"github.com/vorteil/direktiv/pkg/ingress"
+	"github.com/vorteil/direktiv/pkg/jqer"
+	"github.com/vorteil/direktiv/pkg/lambda"
 	"github.com/vorteil/direktiv/pkg/metrics"
+	"github.com/vorteil/direktiv/pkg/otlp"
 	secretsgrpc "github.com/vorteil/direktiv/pkg/secrets/grpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
 
 	"github.com/jinzhu/copier"
 	"github.com/vorteil/direktiv/pkg/flow"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/google/uuid"
+	"github.com/senseyeio/duration"
 	log "github.com/sirupsen/logrus"
 	"github.com/vorteil/direktiv/ent"
 	"github.com/vorteil/direktiv/pkg/dlog"
@@ -47,25 +57,60 @@ var (
 	ErrCodeJQNotObject       = "direktiv.jq.notObject"
 	ErrCodeMultipleErrors    = "direktiv.workflow.multipleErrors"
 	ErrCodeAllBranchesFailed = "direktiv.parallel.allFailed"
+	ErrCodeInvalidDelay      = "direktiv.delay.invalid"
+	ErrCodePollExhausted     = "direktiv.poll.exhausted"
+	ErrCodeInvalidCalendar   = "direktiv.calendar.invalid"
+	ErrCodeLambdaFunction    = "direktiv.lambda.error"
+	ErrCodeLuaScript         = "direktiv.lua.error"
+	ErrCodeLuaLimit          = "direktiv.lua.limitExceeded"
+	ErrCodeWasmModule        = "direktiv.wasm.error"
+	ErrCodeWasmLimit         = "direktiv.wasm.limitExceeded"
+	ErrCodeSchemaNotFound    = "direktiv.schema.notFound"
 )
 
 type workflowEngine struct {
 	db             *dbManager
 	timer          *timerManager
+	clock          clock.Clock
 	instanceLogger *dlog.Log
 	stateLogics    map[model.StateType]func(*model.Workflow, model.State) (stateLogic, error)
 	server         *WorkflowServer
 
-	cancels     map[string]func()
-	cancelsLock sync.Mutex
+	cancels *instanceCancels
 
 	flowClient flow.DirektivFlowClient
 
 	secretsClient secretsgrpc.SecretsServiceClient
 	ingressClient ingress.DirektivIngressClient
-	grpcConns     []*grpc.ClientConn
+	grpcConns     []io.Closer
+	downstreams   map[string]func() connectivity.State
 
 	metricsClient *metrics.Client
+
+	throttle      *actionThrottle
+	actions       *namespaceActions
+	cancellations *isolateCancellations
+	lambda        *lambda.Client
+	isolates      *isolatePools
+	queue         *workQueue
+	workflows     *workflowCache
+	events        *eventStats
+	locks         *lockStats
+	traces        *otlp.Exporter
+	wasm          *wasmRuntime
+	schemas       *jsonSchemaCache
+
+	// an invocationHalt, see invocation-halt.go
+	halt atomic.Value
+
+	memoryEncoding byte
+
+	nodeDone chan bool
+
+	// set while a standby server waits to be promoted
+	standby  int32
+	promoted bool
+	promote  sync.Mutex
 }
 
 func newWorkflowEngine(s *WorkflowServer) (*workflowEngine, error) {
@@ -74,10 +119,52 @@ func newWorkflowEngine(s *WorkflowServer) (*workflowEngine, error) {
 
 	we := new(workflowEngine)
 	we.server = s
+	we.clock = s.clock
 	we.db = s.dbManager
 	we.timer = s.tmManager
 	we.instanceLogger = &s.instanceLogger
-	we.cancels = make(map[string]func())
+	we.cancels = newInstanceCancels()
+	we.throttle = newActionThrottle(s.config)
+	we.actions = newNamespaceActions(s.config)
+	we.cancellations = newIsolateCancellations(s.config)
+	we.lambda = lambda.NewClientFromEnv()
+	we.isolates = newIsolatePools(s.config)
+	we.queue = newWorkQueue(s.config, s.dbManager, s.hostname, we.runQueuedState)
+	we.workflows = newWorkflowCache()
+	we.events = newEventStats()
+	we.locks = newLockStats()
+	we.schemas = newJSONSchemaCache()
+
+	we.memoryEncoding, err = memoryEncoding(s.config.MemoryCompression)
+	if err != nil {
+		return nil, err
+	}
+
+	we.wasm, err = newWasmRuntime(s.config)
+	if err != nil {
+		return nil, err
+	}
+
+	switch s.config.FailoverRole {
+	case "", failoverPrimary:
+	case failoverStandby:
+		we.standby = 1
+	default:
+		return nil, fmt.Errorf("unsupported failover role '%s'", s.config.FailoverRole)
+	}
+
+	switch s.config.RecordHistory {
+	case "", historyFailed, historyAll:
+	default:
+		return nil, fmt.Errorf("unsupported history recording '%s'", s.config.RecordHistory)
+	}
+
+	if ep := s.config.TraceExport.Endpoint; ep != "" {
+		if s.config.RecordHistory == "" {
+			return nil, fmt.Errorf("trace export needs instance histories to be recorded")
+		}
+		we.traces = otlp.NewExporter(ep, s.config.TraceExport.Headers)
+	}
 
 	we.stateLogics = map[model.StateType]func(*model.Workflow, model.State) (stateLogic, error){
 		model.StateTypeNoop:          initNoopStateLogic,
@@ -94,6 +181,11 @@ func newWorkflowEngine(s *WorkflowServer) (*workflowEngine, error) {
 		model.StateTypeValidate:      initValidateStateLogic,
 		model.StateTypeGetter:        initGetterStateLogic,
 		model.StateTypeSetter:        initSetterStateLogic,
+		model.StateTypeEventBatch:    initEventBatchStateLogic,
+		model.StateTypeMapReduce:     initMapReduceStateLogic,
+		model.StateTypePoll:          initPollStateLogic,
+		model.StateTypeLua:           initLuaStateLogic,
+		model.StateTypeWasm:          initWasmStateLogic,
 	}
 
 	err = we.timer.registerFunction(sleepWakeupFunction, we.sleepWakeup)
@@ -111,35 +203,50 @@ func newWorkflowEngine(s *WorkflowServer) (*workflowEngine, error) {
 		return nil, err
 	}
 
+	err = we.timer.registerFunction(cancelsWakeupFunction, we.cancelsWakeup)
+	if err != nil {
+		return nil, err
+	}
+
 	err = we.timer.registerFunction(wfCron, we.wfCronHandler)
 	if err != nil {
 		return nil, err
 	}
 
+	err = we.timer.registerFunction(scheduledInvocationFunction, we.scheduledInvocationHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	// get flow client
-	conn, err := GetEndpointTLS(s.config.FlowAPI.Endpoint, true)
+	pool, err := GetEndpointPool(s.config.FlowAPI.Endpoint, true)
 	if err != nil {
 		return nil, err
 	}
-	we.grpcConns = append(we.grpcConns, conn)
+	we.grpcConns = append(we.grpcConns, pool)
+	we.downstreams = map[string]func() connectivity.State{
+		flowComponent: pool.GetState,
+	}
 
-	we.flowClient = flow.NewDirektivFlowClient(conn)
+	we.flowClient = flow.NewDirektivFlowClient(pool)
 
 	// get secrets client
-	conn, err = GetEndpointTLS(secretsEndpoint, false)
+	conn, err := GetEndpointTLS(secretsEndpoint, false)
 	if err != nil {
 		return nil, err
 	}
 	we.grpcConns = append(we.grpcConns, conn)
+	we.downstreams[secretsComponent] = conn.GetState
 	we.secretsClient = secretsgrpc.NewSecretsServiceClient(conn)
 
 	// get ingress client
-	conn, err = GetEndpointTLS(s.config.IngressAPI.Endpoint, true)
+	pool, err = GetEndpointPool(s.config.IngressAPI.Endpoint, true)
 	if err != nil {
 		return nil, err
 	}
-	we.grpcConns = append(we.grpcConns, conn)
-	we.ingressClient = ingress.NewDirektivIngressClient(conn)
+	we.grpcConns = append(we.grpcConns, pool)
+	we.downstreams[ingressComponent] = pool.GetState
+	we.ingressClient = ingress.NewDirektivIngressClient(pool)
 
 	// setup metrics client
 	we.metricsClient, err = metrics.NewClient()
@@ -147,6 +254,10 @@ func newWorkflowEngine(s *WorkflowServer) (*workflowEngine, error) {
 		return nil, err
 	}
 
+	if ms := s.config.DeferredWriteInterval; ms > 0 {
+		we.metricsClient.Batch(time.Duration(ms) * time.Millisecond)
+	}
+
 	go we.checkTimeoutInstances()
 
 	return we, nil
@@ -158,11 +269,11 @@ func newWorkflowEngine(s *WorkflowServer) (*workflowEngine, error) {
 // if that fails instance is getting cancelled
 func (we *workflowEngine) checkTimeoutInstances() {
 
-	ticker := time.NewTicker(5 * time.Minute)
+	ticker := we.clock.NewTicker(5 * time.Minute)
 
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			log.Debugf("run expired worklflow thread")
 			in, err := we.db.getWorkflowInstanceExpired(context.Background())
 			if err != nil {
@@ -198,13 +309,9 @@ func (we *workflowEngine) localCancel(id string) {
 		}
 	}
 
-	we.cancelsLock.Lock()
-	cancel, exists := we.cancels[id]
-	if exists {
-		delete(we.cancels, id)
-		defer cancel()
+	if cancel := we.cancels.take(id); cancel != nil {
+		cancel()
 	}
-	we.cancelsLock.Unlock()
 
 }
 
@@ -212,10 +319,20 @@ func (we *workflowEngine) finishCancelSubflow(id string) {
 	we.localCancel(id)
 }
 
+// runStateMessage describes state logic well enough for any node to load the
+// instance and run it.
 type runStateMessage struct {
 	InstanceID string
 	State      string
 	Step       int
+
+	// Memory resumes the state from the memory it saved
+	Memory   bool
+	Wakedata []byte
+
+	// NextState transitions to that state with Data instead of running State
+	NextState string
+	Data      json.RawMessage
 }
 
 func (we *workflowEngine) dispatchState(id, state string, step int) error {
@@ -282,7 +399,15 @@ func (we *workflowEngine) wakeEventsWaiter(signature []byte, events []*cloudeven
 		return err
 	}
 
-	go wli.engine.runState(ctx, wli, savedata, wakedata, nil)
+	wli.engine.submitState(wli, &runStateMessage{
+		InstanceID: wli.id,
+		State:      wli.logic.ID(),
+		Step:       wli.step,
+		Memory:     true,
+		Wakedata:   wakedata,
+	}, func() {
+		wli.engine.runState(ctx, wli, savedata, wakedata, nil)
+	})
 
 	return nil
 
@@ -293,6 +418,45 @@ type actionResultPayload struct {
 	ErrorCode    string
 	ErrorMessage string
 	Output       []byte
+	Cancel       *CancelReason
+}
+
+// catchableError returns the error reported by an action. Failed subflows
+// deliver any structured error data in place of their output, anything other
+// actions output before failing is dropped.
+func (payload *actionResultPayload) catchableError(action *model.ActionDefinition) *CatchableError {
+
+	cerr := NewCatchableError(payload.ErrorCode, payload.ErrorMessage)
+	cerr.Cancel = payload.Cancel
+
+	if action.Workflow != "" && len(payload.Output) > 0 {
+		var x interface{}
+		err := json.Unmarshal(payload.Output, &x)
+		if err == nil {
+			cerr.Data = x
+		}
+	}
+
+	return cerr
+
+}
+
+// output decodes the output of a successful action, applying the caller's
+// output mapping to subflow results.
+func (payload *actionResultPayload) output(action *model.ActionDefinition, scope *jqer.Scope) (interface{}, error) {
+
+	var x interface{}
+	err := json.Unmarshal(payload.Output, &x)
+	if err != nil {
+		x = base64.StdEncoding.EncodeToString(payload.Output)
+	}
+
+	if payload.ErrorCode != "" || payload.ErrorMessage != "" || action.Output == nil {
+		return x, nil
+	}
+
+	return jqOne(x, action.Output, scope)
+
 }
 
 type actionResultMessage struct {
@@ -304,18 +468,24 @@ type actionResultMessage struct {
 
 func (we *workflowEngine) doActionRequest(ctx context.Context, ar *isolateRequest) error {
 
-	// TODO: should this ctx be modified with a shorter deadline?
-
-	// generate hash name as "url"
-	actionHash, err := serviceToHash(ar)
-
-	if err != nil {
-		return NewInternalError(err)
+	if ar.Workflow.Timeout == 0 {
+		ar.Workflow.Timeout = 15 * 60 // 15 minutes default
 	}
 
-	go we.doHTTPRequest(ctx, actionHash, ar)
+	// the request can't be allowed to outlive the state it belongs to, so the
+	// timeout is shortened to the instance deadline if that comes first
+	now := we.clock.Now()
+	deadline := now.Add(time.Duration(ar.Workflow.Timeout) * time.Second)
+	if !ar.Workflow.Deadline.IsZero() && ar.Workflow.Deadline.Before(deadline) {
+		deadline = ar.Workflow.Deadline
+		ar.Workflow.Timeout = int(deadline.Sub(now).Seconds())
+		if ar.Workflow.Timeout < 1 {
+			ar.Workflow.Timeout = 1
+		}
+	}
+	ar.Workflow.Deadline = deadline
 
-	return nil
+	return we.executor(ar).execute(ctx, ar)
 
 }
 
@@ -357,6 +527,12 @@ func (we *workflowEngine) doHTTPRequest(ctx context.Context,
 		ExpectContinueTimeout: 1 * time.Second,
 	}
 
+	// route through the namespace's gateways if it has any
+	pool := we.isolates.pool(ar.Workflow.Namespace)
+	if pool != nil {
+		tr.DialContext = pool.DialContext
+	}
+
 	// on https we add the cert to ca
 	if we.server.config.FlowAPI.Protocol == "https" {
 
@@ -393,16 +569,34 @@ func (we *workflowEngine) doHTTPRequest(ctx context.Context,
 
 	log.Debugf("isolate request: %v", addr)
 
-	if ar.Workflow.Timeout == 0 {
-		ar.Workflow.Timeout = 15 * 60 // 15 minutes default
-	}
-
-	deadline := time.Now().Add(time.Duration(ar.Workflow.Timeout) * time.Second)
+	deadline := ar.Workflow.Deadline
 	rctx, cancel := context.WithDeadline(context.Background(), deadline)
 	defer cancel()
 
 	log.Debugf("deadline for request: %v", deadline.Sub(time.Now()))
 
+	err := we.actions.acquire(rctx, ar.Workflow.Namespace)
+	if err != nil {
+		reportErr(err)
+		return
+	}
+	defer we.actions.release(ar.Workflow.Namespace)
+
+	err = we.throttle.wait(rctx, ar.Container.Image)
+	if err != nil {
+		reportErr(err)
+		return
+	}
+
+	var profile *resourceProfile
+	if ar.Container.Profile != "" {
+		profile, err = we.loadResourceProfile(rctx, ar.Workflow.Namespace, ar.Container.Profile)
+		if err != nil {
+			reportErr(err)
+			return
+		}
+	}
+
 	req, err := http.NewRequestWithContext(rctx, http.MethodPost, addr,
 		bytes.NewReader(ar.Container.Data))
 	if err != nil {
@@ -436,6 +630,21 @@ func (we *workflowEngine) doHTTPRequest(ctx context.Context,
 		resp *http.Response
 	)
 
+	// creates the function's service if it doesn't exist yet
+	ensureService := func() bool {
+		kubeReq.mtx.Lock()
+		defer kubeReq.mtx.Unlock()
+		err := getKnativeFunction(fmt.Sprintf("%s-%s", ar.Workflow.Namespace, ah))
+		if err != nil {
+			err := addKnativeFunction(ar, profile)
+			if err != nil {
+				reportErr(fmt.Errorf("can not create knative function %v: %v", addr, err))
+				return false
+			}
+		}
+		return true
+	}
+
 	// potentially dns error for a brand new service
 	for i := 0; i < 400; i++ {
 		log.Debugf("isolate request (%d): %v", i, addr)
@@ -449,16 +658,9 @@ func (we *workflowEngine) doHTTPRequest(ctx context.Context,
 				if err, ok := err.Err.(*net.OpError); ok {
 					if _, ok := err.Err.(*net.DNSError); ok {
 						// this happens because the function does not exist
-						kubeReq.mtx.Lock()
-						err := getKnativeFunction(fmt.Sprintf("%s-%s", ar.Workflow.Namespace, ah))
-						if err != nil {
-							err := addKnativeFunction(ar)
-							if err != nil {
-								reportErr(fmt.Errorf("can not create knative function %v: %v", addr, err))
-								return
-							}
+						if !ensureService() {
+							return
 						}
-						kubeReq.mtx.Unlock()
 
 						time.Sleep(250 * time.Millisecond)
 						continue
@@ -468,6 +670,24 @@ func (we *workflowEngine) doHTTPRequest(ctx context.Context,
 
 			time.Sleep(250 * time.Millisecond)
 
+		} else if pool != nil && resp.StatusCode == http.StatusNotFound {
+
+			// gateways resolve the service themselves and respond with
+			// not found while it doesn't exist
+			_ = resp.Body.Close()
+
+			if !ensureService() {
+				return
+			}
+
+			req.Body, err = req.GetBody()
+			if err != nil {
+				reportErr(err)
+				return
+			}
+
+			time.Sleep(250 * time.Millisecond)
+
 		} else {
 			break
 		}
@@ -496,7 +716,7 @@ func (we *workflowEngine) wakeCaller(ctx context.Context, msg *actionResultMessa
 	var step int32
 	step = int32(msg.Step)
 
-	_, err := we.flowClient.ReportActionResults(ctx, &flow.ReportActionResultsRequest{
+	err := ReportActionResults(ctx, we.flowClient, &flow.ReportActionResultsRequest{
 		InstanceId:   &msg.InstanceID,
 		Step:         &step,
 		ActionId:     &msg.Payload.ActionID,
@@ -512,11 +732,95 @@ func (we *workflowEngine) wakeCaller(ctx context.Context, msg *actionResultMessa
 
 }
 
+// loadCalendar reads the business calendar stored in the namespace variable
+// named by key.
+func (we *workflowEngine) loadCalendar(ctx context.Context, namespace, key string) (*calendar.Calendar, error) {
+
+	r, err := we.server.variableStorage.Retrieve(ctx, key, namespace)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	if len(data) == 0 {
+		return nil, NewCatchableError(ErrCodeInvalidCalendar, "calendar '%s' does not exist", key)
+	}
+
+	cal, err := calendar.Parse(data)
+	if err != nil {
+		return nil, NewCatchableError(ErrCodeInvalidCalendar, "calendar '%s' is invalid: %v", key, err)
+	}
+
+	return cal, nil
+
+}
+
 const wfCron = "wfcron"
 
 func (we *workflowEngine) wfCronHandler(data []byte) error {
 
-	return we.CronInvoke(string(data))
+	uid := string(data)
+
+	if we.invocationHalt().reason != "" {
+		log.Infof("Skipping cron invoke of workflow %s, new instances are halted.", uid)
+		return nil
+	}
+
+	suspended, err := we.db.workflowCronsSuspended(context.Background(), uid)
+	if err != nil {
+		return err
+	}
+
+	if suspended {
+		log.Debugf("Skipping cron invoke of workflow %s, its namespace's scheduled starts are suspended.", uid)
+		return nil
+	}
+
+	return we.CronInvoke(uid)
+
+}
+
+func deferredCronTimer(uid string) string {
+	return fmt.Sprintf("cron:%s:deferred", uid)
+}
+
+// deferCronInvoke moves a scheduled run that fired outside of the business
+// days of its calendar to the same time on the next business day. Nothing is
+// deferred if the cron fires on that day by then anyway, so that a daily run
+// skipped on a weekend doesn't run twice on monday.
+func (we *workflowEngine) deferCronInvoke(uid, name, pattern string, cal *calendar.Calendar, fired time.Time) error {
+
+	deferred, err := cal.NextBusinessDay(fired)
+	if err != nil {
+		return err
+	}
+
+	schedule, err := parseCron(pattern)
+	if err != nil {
+		return err
+	}
+
+	// the days between are not business days, so only the cron's runs on
+	// the day it is deferred to count. Crons fire in the server's timezone.
+	day := time.Date(deferred.Year(), deferred.Month(), deferred.Day(), 0, 0, 0, 0, deferred.Location())
+	if next := schedule.Next(day.Add(-time.Second).Local()); !next.IsZero() && !next.After(deferred) {
+		log.Debugf("Skipping cron invoke of '%s' outside of business days, it next runs at %v.", name, next)
+		return nil
+	}
+
+	timer := deferredCronTimer(uid)
+	if we.timer.hasTimer(timer) {
+		return nil
+	}
+
+	log.Debugf("Deferring cron invoke of '%s' outside of business days to %v.", name, deferred)
+
+	return we.timer.addOneShot(timer, wfCron, deferred, []byte(uid))
 
 }
 
@@ -525,22 +829,30 @@ type retryMessage struct {
 	State      string
 	Step       int
 	Data       []byte
+
+	// set when the whole state is retried, rather than one of its actions
+	Restart bool `json:",omitempty"`
 }
 
 const retryWakeupFunction = "retryWakeup"
 
-func (we *workflowEngine) scheduleRetry(id, state string, step int, t time.Time, data []byte) error {
+func (we *workflowEngine) scheduleRetry(wli *workflowLogicInstance, t time.Time, data []byte, restart bool) error {
+
+	id := wli.id
 
 	data, _ = json.Marshal(&retryMessage{
 		InstanceID: id,
-		State:      state,
-		Step:       step,
+		State:      wli.logic.ID(),
+		Step:       wli.step,
 		Data:       data,
+		Restart:    restart,
 	})
 
-	if d := t.Sub(time.Now()); d < time.Second*5 {
+	wli.verbose("Scheduling retry of state %s at %s.", wli.logic.ID(), t.UTC().Format(time.RFC3339))
+
+	if d := t.Sub(we.clock.Now()); d < time.Second*5 {
 		go func() {
-			time.Sleep(d)
+			we.clock.Sleep(d)
 			we.retryWakeup(data)
 		}()
 		return nil
@@ -573,12 +885,29 @@ func (we *workflowEngine) retryWakeup(data []byte) error {
 
 	wli.Log("Waking up to retry.")
 
+	if msg.Restart {
+		err = wli.restartState(ctx)
+		if err != nil {
+			wli.Close()
+			log.Errorf("cannot restart state of %s: %v", wli.id, err)
+			return nil
+		}
+	}
+
 	savedata, err := InstanceMemory(wli.rec)
 	if err != nil {
 		return err
 	}
 
-	go wli.engine.runState(ctx, wli, savedata, []byte(msg.Data), nil)
+	wli.engine.submitState(wli, &runStateMessage{
+		InstanceID: wli.id,
+		State:      wli.logic.ID(),
+		Step:       wli.step,
+		Memory:     true,
+		Wakedata:   msg.Data,
+	}, func() {
+		wli.engine.runState(ctx, wli, savedata, []byte(msg.Data), nil)
+	})
 
 	return nil
 
@@ -593,15 +922,17 @@ type sleepMessage struct {
 const sleepWakeupFunction = "sleepWakeup"
 const sleepWakedata = "sleep"
 
-func (we *workflowEngine) sleep(id, state string, step int, t time.Time) error {
+func (we *workflowEngine) sleep(wli *workflowLogicInstance, t time.Time) error {
 
 	data, _ := json.Marshal(&sleepMessage{
-		InstanceID: id,
-		State:      state,
-		Step:       step,
+		InstanceID: wli.id,
+		State:      wli.logic.ID(),
+		Step:       wli.step,
 	})
 
-	err := we.timer.addOneShot(id, sleepWakeupFunction, t, data)
+	wli.verbose("Scheduling wakeup of state %s at %s.", wli.logic.ID(), t.UTC().Format(time.RFC3339))
+
+	err := we.timer.addOneShot(wli.id, sleepWakeupFunction, t, data)
 	if err != nil {
 		return NewInternalError(err)
 	}
@@ -628,7 +959,14 @@ func (we *workflowEngine) sleepWakeup(data []byte) error {
 
 	wli.Log("Waking up from sleep.")
 
-	go wli.engine.runState(ctx, wli, nil, []byte(sleepWakedata), nil)
+	wli.engine.submitState(wli, &runStateMessage{
+		InstanceID: wli.id,
+		State:      wli.logic.ID(),
+		Step:       wli.step,
+		Wakedata:   []byte(sleepWakedata),
+	}, func() {
+		wli.engine.runState(ctx, wli, nil, []byte(sleepWakedata), nil)
+	})
 
 	return nil
 
@@ -641,8 +979,7 @@ func (we *workflowEngine) cancelRecordsChildren(ctx context.Context, rec *ent.Wo
 		return err
 	}
 
-	wf := new(model.Workflow)
-	err = wf.Load(wfrec.Workflow)
+	wf, _, err := we.loadInstanceWorkflow(ctx, wfrec, rec.Revision)
 	if err != nil {
 		return err
 	}
@@ -659,38 +996,45 @@ func (we *workflowEngine) cancelRecordsChildren(ctx context.Context, rec *ent.Wo
 	if !exists {
 		return NewInternalError(fmt.Errorf("workflow cannot resolve state: %s", state))
 	}
-	init, exists := we.stateLogics[stateObject.GetType()]
-	if !exists {
-		return NewInternalError(fmt.Errorf("engine cannot resolve state type: %s", stateObject.GetType().String()))
-	}
-	stateLogic, err := init(wf, stateObject)
+	logic, err := we.initStateLogic(wf, stateObject)
 	if err != nil {
-		return NewInternalError(fmt.Errorf("cannot initialize state logic: %v", err))
+		return NewInternalError(err)
 	}
-	logic := stateLogic
 
 	savedata, err := InstanceMemory(rec)
 	if err != nil {
 		return err
 	}
 
-	we.cancelChildren(logic, savedata)
+	we.cancellations.wait(we.cancelChildren(logic, savedata))
 
 	return nil
 
 }
 
-func (we *workflowEngine) cancelChildren(logic stateLogic, savedata []byte) {
+// cancelChildren cancels the living children of a state and returns the
+// isolates whose cancellations are expected to be confirmed.
+func (we *workflowEngine) cancelChildren(logic stateLogic, savedata []byte) []string {
 
 	if len(savedata) == 0 {
-		return
+		return nil
 	}
 
+	var isolates []string
+
 	children := logic.LivingChildren(savedata)
 	for _, child := range children {
 		switch child.Type {
 		case "isolate":
-			syncServer(context.Background(), we.db, &we.server.id, child.Id, CancelIsolate)
+			if we.cancellations.cancelLocal(child.Id) {
+				continue
+			}
+			we.cancellations.expect(child.Id)
+			isolates = append(isolates, child.Id)
+			syncServer(context.Background(), we.db, &we.server.id, &CancelIsolateRequest{
+				ActionID:    child.Id,
+				GracePeriod: we.cancellations.gracePeriod(),
+			}, CancelIsolate)
 		case "subflow":
 			go func(id string) {
 				we.hardCancelInstance(id, "direktiv.cancels.parent", "cancelled by parent workflow")
@@ -700,6 +1044,8 @@ func (we *workflowEngine) cancelChildren(logic stateLogic, savedata []byte) {
 		}
 	}
 
+	return isolates
+
 }
 
 func (we *workflowEngine) hardCancelInstance(instanceId, code, message string) error {
@@ -725,6 +1071,9 @@ func (we *workflowEngine) freeResources(rec *ent.WorkflowInstance) {
 
 	we.clearEventListeners(rec)
 
+	// a singular workflow may have invocations waiting for this one
+	go we.dequeueInvocation(rec.Edges.Workflow.ID)
+
 	var namespace, workflow, instance string
 	namespace = rec.Edges.Workflow.Edges.Namespace.ID
 	workflow = rec.Edges.Workflow.ID.String()
@@ -778,10 +1127,16 @@ func (we *workflowEngine) cancelInstance(instanceId, code, message string, soft
 	if soft {
 		err = NewCatchableError(code, message)
 	} else {
+		err = wli.setCancelReason(ctx, code, message)
+		if err != nil {
+			log.Error(err)
+		}
 		err = NewUncatchableError(code, message)
 	}
 
-	go wli.engine.runState(ctx, wli, savedata, nil, err)
+	wli.engine.queue.submit(func() {
+		wli.engine.runState(ctx, wli, savedata, nil, err)
+	})
 
 	return nil
 
@@ -810,7 +1165,9 @@ func (we *workflowEngine) transformState(wli *workflowLogicInstance, transition
 
 }
 
-func (we *workflowEngine) completeState(ctx context.Context, rec *ent.WorkflowInstance, nextState, errCode string, retrying bool) {
+func (we *workflowEngine) completeState(ctx context.Context, wli *workflowLogicInstance, nextState, errCode string, retrying bool) {
+
+	rec := wli.rec
 
 	if len(rec.Flow) == 0 {
 		return
@@ -832,9 +1189,13 @@ func (we *workflowEngine) completeState(ctx context.Context, rec *ent.WorkflowIn
 
 	args.State = rec.Flow[len(rec.Flow)-1]
 
-	d := time.Now().Sub(rec.StateBeginTime)
+	d := we.clock.Now().Sub(rec.StateBeginTime)
 	args.WorkflowMilliSeconds = d.Milliseconds()
 
+	if wli.logic != nil && !waitingStateTypes[wli.logic.Type()] && !rec.Deadline.IsZero() {
+		args.DeadlineMilliSeconds = rec.Deadline.Sub(rec.StateBeginTime).Milliseconds()
+	}
+
 	args.ErrorCode = errCode
 	args.Transition = nextState
 	args.Next = metrics.NextTransition
@@ -853,6 +1214,8 @@ func (we *workflowEngine) completeState(ctx context.Context, rec *ent.WorkflowIn
 		log.Error(err)
 	}
 
+	wli.verbose("Leaving state %s after %v.", args.State, d)
+
 }
 
 func (we *workflowEngine) transitionState(ctx context.Context, wli *workflowLogicInstance, transition *stateTransition, errCode string) {
@@ -862,11 +1225,26 @@ func (we *workflowEngine) transitionState(ctx context.Context, wli *workflowLogi
 		return
 	}
 
-	we.completeState(ctx, wli.rec, transition.NextState, errCode, false)
+	we.completeState(ctx, wli, transition.NextState, errCode, false)
 
 	if transition.NextState != "" {
 		wli.Log("Transitioning to next state: %s (%d).", transition.NextState, wli.step+1)
-		go wli.Transition(ctx, transition.NextState, 0)
+		fn := func() {
+			wli.Transition(ctx, transition.NextState, 0)
+		}
+		// the data is only saved by the transition itself
+		data, err := json.Marshal(wli.data)
+		if err != nil {
+			we.queue.submit(fn)
+			return
+		}
+		we.submitState(wli, &runStateMessage{
+			InstanceID: wli.id,
+			State:      wli.logic.ID(),
+			Step:       wli.step,
+			NextState:  transition.NextState,
+			Data:       data,
+		}, fn)
 		return
 	}
 
@@ -887,8 +1265,13 @@ func (we *workflowEngine) transitionState(ctx context.Context, wli *workflowLogi
 		wli.Log("Workflow failed with error '%s': %s", wli.rec.ErrorCode, wli.rec.ErrorMessage)
 	}
 
+	if !wli.lockHeld(ctx) {
+		wli.Close()
+		return
+	}
+
 	wf := wli.rec.Edges.Workflow
-	rec, err = wli.rec.Update().SetOutput(string(data)).SetEndTime(time.Now()).SetStatus(status).Save(ctx)
+	rec, err = wli.rec.Update().SetOutput(string(data)).SetEndTime(wli.engine.clock.Now()).SetStatus(status).Save(ctx)
 	if err != nil {
 		log.Error(err)
 		wli.engine.freeResources(wli.rec)
@@ -902,6 +1285,12 @@ func (we *workflowEngine) transitionState(ctx context.Context, wli *workflowLogi
 	log.Debugf("Workflow instance completed: %s", wli.id)
 	wli.Log("Workflow completed.")
 
+	// a subflow that finishes early mustn't be killed by its timeout later
+	err = wli.engine.timer.deleteTimerByName("", "", subflowTimeoutTimer(wli.id))
+	if err != nil {
+		log.Error(err)
+	}
+
 	wli.engine.freeResources(rec)
 	wli.wakeCaller(ctx, data)
 	wli.Close()
@@ -913,6 +1302,8 @@ func (we *workflowEngine) logRunState(wli *workflowLogicInstance, savedata, wake
 	log.Debugf("Running state logic -- %s:%v (%s)", wli.id, wli.step, wli.logic.ID())
 	if len(savedata) == 0 && len(wakedata) == 0 && err == nil {
 		wli.Log("Running state logic -- %s:%v (%s)", wli.logic.ID(), wli.step, wli.logic.Type())
+	} else {
+		wli.verbose("Resuming state logic -- %s:%v (%s)", wli.logic.ID(), wli.step, wli.logic.Type())
 	}
 
 }
@@ -921,6 +1312,9 @@ func (we *workflowEngine) runState(ctx context.Context, wli *workflowLogicInstan
 
 	we.logRunState(wli, savedata, wakedata, err)
 
+	h := we.beginHistory(wli, savedata, wakedata, err)
+	defer h.save(ctx)
+
 	var code string
 	var transition *stateTransition
 
@@ -930,7 +1324,7 @@ func (we *workflowEngine) runState(ctx context.Context, wli *workflowLogicInstan
 
 	if lq := wli.logic.LogJQ(); len(savedata) == 0 && len(wakedata) == 0 && lq != nil {
 		var object interface{}
-		object, err = jqOne(wli.data, lq)
+		object, err = jqOne(wli.data, lq, wli.jqScope())
 		if err != nil {
 			goto failure
 		}
@@ -950,26 +1344,35 @@ func (we *workflowEngine) runState(ctx context.Context, wli *workflowLogicInstan
 		goto failure
 	}
 
+	h.ran(transition)
+
 	err = we.transformState(wli, transition)
 	if err != nil {
 		goto failure
 	}
 
 next:
+	h.transitioned(transition)
 	we.transitionState(ctx, wli, transition, code)
 	return
 
 failure:
 
+	h.failed(err)
+
 	var breaker int
 
 	if breaker > 10 {
 		err = NewInternalError(errors.New("somehow ended up in a catchable error loop"))
 	}
 
-	savedata, err2 := InstanceMemory(wli.rec)
-	if err2 == nil {
-		wli.engine.cancelChildren(wli.logic, savedata)
+	// give isolates a chance to clean up before the parent moves on
+	if !wli.childrenCancelled {
+		wli.childrenCancelled = true
+		savedata, err2 := InstanceMemory(wli.rec)
+		if err2 == nil && wli.awaitCancels(ctx, wli.engine.cancelChildren(wli.logic, savedata), err) {
+			return
+		}
 	}
 
 	if uerr, ok := err.(*UncatchableError); ok {
@@ -1003,6 +1406,13 @@ failure:
 
 			matched, _ = regexp.MatchString(errRegex, cerr.Code)
 
+			// catchers with an exhausted transition also match the
+			// original error once its retries have run out
+			exhausted := cerr.Exhausted != "" && catch.Exhausted != ""
+			if !matched && exhausted {
+				matched, _ = regexp.MatchString(errRegex, cerr.Exhausted)
+			}
+
 			if matched {
 
 				wli.Log("State failed with error '%s': %s", cerr.Code, cerr.Message)
@@ -1013,6 +1423,11 @@ failure:
 					NextState: catch.Transition,
 				}
 
+				if exhausted {
+					wli.Log("Retries exhausted after %d attempts.", cerr.Attempts)
+					transition.NextState = catch.Exhausted
+				}
+
 				breaker++
 
 				code = cerr.Code
@@ -1023,6 +1438,43 @@ failure:
 
 		}
 
+		// errors that already exhausted their own retries aren't retried again
+		if retry := wli.wf.Retries; retry != nil && cerr.Exhausted == "" {
+
+			d, rerr := preprocessRetry(retry, wli.rec.Attempts, cerr)
+			if rerr == nil {
+
+				wli.Log("State failed with error '%s': %s", cerr.Code, cerr.Message)
+				wli.Log("Scheduling workflow retry attempt in: %v.", d)
+
+				h.retrying()
+				we.completeState(ctx, wli, wli.logic.ID(), cerr.Code, true)
+
+				err = wli.retryState(ctx, d)
+				if err != nil {
+					err = NewInternalError(err)
+					goto failure
+				}
+
+				wli.Close()
+				return
+
+			} else if xerr, ok := rerr.(*CatchableError); ok && xerr.Exhausted != "" {
+				wli.Log("Workflow retries exhausted after %d attempts.", xerr.Attempts)
+			} else if uerr, ok := rerr.(*UncatchableError); ok {
+				// a broken retry condition fails the workflow with its own error
+				err = uerr
+				goto failure
+			}
+
+		}
+
+		err = wli.setErrorData(ctx, cerr)
+		if err != nil {
+			err = NewInternalError(err)
+			goto failure
+		}
+
 		err = wli.setStatus(ctx, "failed", cerr.Code, cerr.Message)
 		if err != nil {
 			err = NewInternalError(err)
@@ -1041,7 +1493,10 @@ failure:
 		msg := "an internal error occurred"
 
 		var err error
-		err = wli.setStatus(ctx, "crashed", code, msg)
+		err = wli.setErrorCauses(ctx, ierr)
+		if err == nil {
+			err = wli.setStatus(ctx, "crashed", code, msg)
+		}
 		if err == nil {
 			log.Errorf("Workflow failed with internal error: %s", ierr.Error())
 			wli.Log("Workflow failed with internal error: %s", ierr.Error())
@@ -1093,12 +1548,57 @@ func (we *workflowEngine) CronInvoke(uid string) error {
 		}
 	}
 
-	if wli.wf.Start == nil || wli.wf.Start.GetType() != model.StartTypeScheduled {
+	scheduled, ok := wli.wf.Start.(*model.ScheduledStart)
+	if !ok {
 		wli.Close()
-		return fmt.Errorf("cannot cron invoke workflows with '%s' starts", wli.wf.Start.GetType())
+		return fmt.Errorf("cannot cron invoke workflow '%s' without a scheduled start", wf.Name)
+	}
+
+	if scheduled.Calendar != "" {
+
+		var cal *calendar.Calendar
+		cal, err = we.loadCalendar(ctx, ns.ID, scheduled.Calendar)
+		if err != nil {
+			wli.Close()
+			return err
+		}
+
+		now := time.Now()
+		if !cal.IsBusinessDay(now) {
+			wli.Close()
+			return we.deferCronInvoke(uid, wf.Name, scheduled.Cron, cal, now)
+		}
+
+	}
+
+	// scheduled workflows can't be invoked any other way, so their running
+	// instances were all started by the cron
+	if scheduled.Concurrency == model.ConcurrencyForbid || scheduled.Concurrency == model.ConcurrencyReplace {
+
+		var running []string
+		running, err = we.db.getRunningInstancesByWFID(ctx, wf.ID)
+		if err != nil {
+			wli.Close()
+			return NewInternalError(err)
+		}
+
+		if len(running) > 0 && scheduled.Concurrency == model.ConcurrencyForbid {
+			wli.NamespaceLog("Scheduled run of workflow '%s' skipped, instance %s is still running.", wf.Name, running[0])
+			wli.Close()
+			return nil
+		}
+
+		for _, id := range running {
+			wli.NamespaceLog("Instance %s replaced by a new scheduled run of workflow '%s'.", id, wf.Name)
+			err = we.hardCancelInstance(id, cancelCodePrefix+"cron", "replaced by a new scheduled run")
+			if err != nil {
+				log.Errorf("cannot cancel instance %s replaced by a scheduled run: %v", id, err)
+			}
+		}
+
 	}
 
-	wli.rec, err = we.db.addWorkflowInstance(ctx, ns.ID, wf.Name, wli.id, string(wli.startData), true, wli.wf.Exclusive, nil)
+	wli.rec, err = we.db.addWorkflowInstance(ctx, ns.ID, wf.Name, wli.revision, wli.id, "", string(wli.startData), wli.profile, true, workflowMutex(wli.wf, false), nil)
 	if err != nil {
 		wli.Close()
 		if strings.Contains(err.Error(), "invoked") || strings.Contains(err.Error(), "transactions") {
@@ -1113,60 +1613,229 @@ func (we *workflowEngine) CronInvoke(uid string) error {
 
 	wli.Log("Preparing workflow triggered by cron scheduler.")
 
-	go wli.start()
+	we.submitStart(wli)
 
 	return nil
 
 }
 
-func (we *workflowEngine) PrepareInvoke(ctx context.Context, namespace, name string, input []byte) (*workflowLogicInstance, error) {
+var externalIDRegex = regexp.MustCompile(`^[\w.-]{1,64}$`)
 
-	var err error
+// PrepareInvoke creates an instance invoked through the API. Instances invoked
+// by another cluster have a caller to report back to and may have a timeout.
+// Instances with mocks are simulated. If the workflow is singular and busy,
+// the invocation may be queued instead, and its id is returned.
+func (we *workflowEngine) PrepareInvoke(ctx context.Context, namespace, name, state, externalID string, input []byte, caller *subflowCaller, timeout string, mocks []byte, breakpoints []string, debugTransforms, verbose bool) (*workflowLogicInstance, string, error) {
+
+	if we.isStandby() {
+		return nil, "", errStandby
+	}
 
-	wli, err := we.newWorkflowLogicInstance(ctx, namespace, name, input)
+	err := we.queue.admit()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if externalID != "" && !externalIDRegex.MatchString(externalID) {
+		return nil, "", grpc.Errorf(codes.InvalidArgument, "external id must match regex: %s", externalIDRegex)
+	}
+
+	return we.prepareInvoke(ctx, &apiInvocation{
+		Namespace:       namespace,
+		Name:            name,
+		State:           state,
+		ExternalID:      externalID,
+		Input:           input,
+		Caller:          caller,
+		Timeout:         timeout,
+		Mocks:           mocks,
+		Breakpoints:     breakpoints,
+		DebugTransforms: debugTransforms,
+		Verbose:         verbose,
+	}, "")
+
+}
+
+// prepareInvoke creates an instance for an API invocation, unless it gets
+// queued. Queued invocations are started with the id they were given.
+func (we *workflowEngine) prepareInvoke(ctx context.Context, inv *apiInvocation, id string) (*workflowLogicInstance, string, error) {
+
+	namespace, name, state, externalID := inv.Namespace, inv.Name, inv.State, inv.ExternalID
+	input, caller, timeout, mocks := inv.Input, inv.Caller, inv.Timeout, inv.Mocks
+	breakpoints, debugTransforms, verbose := inv.Breakpoints, inv.DebugTransforms, inv.Verbose
+
+	wli, err := we.newWorkflowLogicInstanceWithID(ctx, namespace, name, id, input)
 	if err != nil {
 		if _, ok := err.(*InternalError); ok {
 			log.Errorf("Internal error on DirectInvoke: %v", err)
-			return nil, errors.New("an internal error occurred")
+			return nil, "", errors.New("an internal error occurred")
 		}
 
-		return nil, err
+		return nil, "", err
 	}
 
 	if wli.wf.Start != nil && wli.wf.Start.GetType() != model.StartTypeDefault {
 		wli.Close()
-		return nil, fmt.Errorf("cannot directly invoke workflows with '%s' starts", wli.wf.Start.GetType())
+		return nil, "", fmt.Errorf("cannot directly invoke workflows with '%s' starts", wli.wf.Start.GetType())
+	}
+
+	// callers in other clusters can't be matched against the callers of the
+	// workflow, whose names are only unique within this one
+	if caller != nil && wli.wf.Access != nil && wli.wf.Access.Callers != nil {
+		wli.Close()
+		return nil, "", grpc.Errorf(codes.PermissionDenied, "workflow '%s' restricts its callers and can't be called from another cluster", name)
 	}
 
-	wli.rec, err = we.db.addWorkflowInstance(ctx, namespace, name, wli.id, string(wli.startData), false, wli.wf.Exclusive, nil)
+	start, err := wli.wf.GetEntryState(state)
 	if err != nil {
 		wli.Close()
-		return nil, NewInternalError(err)
+		return nil, "", grpc.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	wli.entryState = start
 
-	start := wli.wf.GetStartState()
+	var d duration.Duration
+	if timeout != "" {
+		d, err = duration.ParseISO8601(timeout)
+		if err != nil {
+			wli.Close()
+			return nil, "", grpc.Errorf(codes.InvalidArgument, "invalid timeout: %v", err)
+		}
+	}
+
+	var callerData []byte
+	if caller != nil {
+		callerData, err = json.Marshal(caller)
+		if err != nil {
+			wli.Close()
+			return nil, "", NewInternalError(err)
+		}
+	}
+
+	var sim []byte
+	if len(mocks) > 0 {
+		sim, err = parseMocks(mocks)
+		if err != nil {
+			wli.Close()
+			return nil, "", err
+		}
+	}
+
+	var dbg []byte
+	if len(breakpoints) > 0 || debugTransforms || verbose {
+		err = checkBreakpoints(wli.wf, breakpoints)
+		if err != nil {
+			wli.Close()
+			return nil, "", err
+		}
+		dbg, err = json.Marshal(&debugger{Breakpoints: breakpoints, Transforms: debugTransforms, Verbose: verbose})
+		if err != nil {
+			wli.Close()
+			return nil, "", NewInternalError(err)
+		}
+	}
+
+	mutex := workflowMutex(wli.wf, true)
+	if id != "" {
+		mutex = mutexDequeue
+	}
+
+	wli.rec, err = we.db.addWorkflowInstance(ctx, namespace, name, wli.revision, wli.id, externalID, string(wli.startData), wli.profile, false, mutex, callerData)
+	if err == errWorkflowBusy && id == "" {
+		defer wli.Close()
+		var wf *ent.Workflow
+		wf, err = we.db.getNamespaceWorkflow(ctx, name, namespace)
+		if err == nil {
+			err = we.queueInvocation(ctx, wf.ID, wli, queuedFromAPI, inv)
+		}
+		if err != nil {
+			return nil, "", NewInternalError(err)
+		}
+		return nil, wli.id, nil
+	}
+	if err == errWorkflowBusy {
+		wli.Close()
+		return nil, "", err
+	}
+	if err != nil {
+		wli.Close()
+		if externalID != "" && ent.IsConstraintError(err) {
+			return nil, "", grpc.Errorf(codes.AlreadyExists, "external id '%s' is already in use", externalID)
+		}
+		return nil, "", NewInternalError(err)
+	}
+
+	if sim != nil {
+		wf := wli.rec.Edges.Workflow
+		wli.rec, err = wli.rec.Update().SetSimulation(sim).Save(ctx)
+		if err != nil {
+			wli.Close()
+			return nil, "", NewInternalError(err)
+		}
+		wli.rec.Edges.Workflow = wf
+	}
+
+	if dbg != nil {
+		wf := wli.rec.Edges.Workflow
+		wli.rec, err = wli.rec.Update().SetDebug(dbg).Save(ctx)
+		if err != nil {
+			wli.Close()
+			return nil, "", NewInternalError(err)
+		}
+		wli.rec.Edges.Workflow = wf
+	}
 
 	wli.NamespaceLog("Workflow '%s' has been triggered by the API.", start.GetID())
 	wli.Log("Preparing workflow triggered by API.")
+	if sim != nil {
+		wli.Log("Simulating the workflow's actions with mocks.")
+	}
+	if len(breakpoints) > 0 {
+		wli.Log("Breakpoints set: %s.", strings.Join(breakpoints, ", "))
+	}
+	if debugTransforms {
+		wli.Log("Transform debugging enabled.")
+	}
+	if verbose {
+		wli.Log("Verbose logging enabled.")
+	}
 
-	return wli, nil
+	if timeout != "" {
+		wli.ScheduleSubflowTimeout(d.Shift(we.clock.Now()))
+	}
+
+	return wli, "", nil
 
 }
 
 func (we *workflowEngine) EventsInvoke(workflowID uuid.UUID, events ...*cloudevents.Event) {
 
+	if we.invocationHalt().reason != "" {
+		log.Warnf("Not starting workflow %s for events, new instances are halted.", workflowID)
+		return
+	}
+
+	err := we.eventsInvoke(workflowID, "", events)
+	if err != nil {
+		log.Errorf("Internal error on EventsInvoke: %v", err)
+	}
+
+}
+
+// eventsInvoke starts an instance for events, or queues it if its singular
+// workflow is busy. Queued invocations are started with the id they were
+// given.
+func (we *workflowEngine) eventsInvoke(workflowID uuid.UUID, id string, events []*cloudevents.Event) error {
+
 	ctx := context.Background()
 
 	wf, err := we.db.getWorkflowByID(workflowID)
 	if err != nil {
-		log.Errorf("Internal error on EventsInvoke: %v", err)
-		return
+		return err
 	}
 
 	ns, err := wf.QueryNamespace().Only(ctx)
 	if err != nil {
-		log.Errorf("Internal error on EventsInvoke: %v", err)
-		return
+		return err
 	}
 
 	var input []byte
@@ -1181,7 +1850,7 @@ func (we *workflowEngine) EventsInvoke(workflowID uuid.UUID, events ...*cloudeve
 
 		x, err = extractEventPayload(event)
 		if err != nil {
-			return
+			return err
 		}
 
 		m[event.Type()] = x
@@ -1190,17 +1859,15 @@ func (we *workflowEngine) EventsInvoke(workflowID uuid.UUID, events ...*cloudeve
 
 	input, err = json.Marshal(m)
 	if err != nil {
-		log.Errorf("Internal error on EventsInvoke: %v", err)
-		return
+		return err
 	}
 
 	namespace := ns.ID
 	name := wf.Name
 
-	wli, err := we.newWorkflowLogicInstance(ctx, namespace, name, input)
+	wli, err := we.newWorkflowLogicInstanceWithID(ctx, namespace, name, id, input)
 	if err != nil {
-		log.Errorf("Internal error on EventsInvoke: %v", err)
-		return
+		return err
 	}
 
 	var stype model.StartType
@@ -1214,15 +1881,37 @@ func (we *workflowEngine) EventsInvoke(workflowID uuid.UUID, events ...*cloudeve
 	case model.StartTypeEventsXor:
 	default:
 		wli.Close()
-		log.Errorf("cannot event invoke workflows with '%s' starts", stype)
-		return
+		return fmt.Errorf("cannot event invoke workflows with '%s' starts", stype)
+	}
+
+	for _, event := range events {
+		if event != nil && !wli.wf.Access.PermitsEventSource(event.Source()) {
+			wli.NamespaceLog("Workflow '%s' not triggered, it doesn't accept events from source '%s'.", name, event.Source())
+			wli.Close()
+			log.Warnf("workflow %s/%s doesn't accept events from source '%s'", namespace, name, event.Source())
+			return nil
+		}
+	}
+
+	mutex := workflowMutex(wli.wf, true)
+	if id != "" {
+		mutex = mutexDequeue
 	}
 
-	wli.rec, err = we.db.addWorkflowInstance(ctx, namespace, name, wli.id, string(wli.startData), false, wli.wf.Exclusive, nil)
+	wli.rec, err = we.db.addWorkflowInstance(ctx, namespace, name, wli.revision, wli.id, "", string(wli.startData), wli.profile, false, mutex, nil)
+	if err == errWorkflowBusy && id == "" {
+		defer wli.Close()
+		return we.queueInvocation(ctx, workflowID, wli, queuedFromEvents, events)
+	}
 	if err != nil {
 		wli.Close()
-		log.Errorf("Internal error on EventsInvoke: %v", err)
-		return
+		return err
+	}
+
+	err = wli.receivedEvents(ctx, events)
+	if err != nil {
+		wli.Close()
+		return err
 	}
 
 	if len(events) == 1 {
@@ -1239,7 +1928,9 @@ func (we *workflowEngine) EventsInvoke(workflowID uuid.UUID, events ...*cloudeve
 		wli.Log("Preparing workflow triggered by events: %v", ids)
 	}
 
-	go wli.start()
+	we.submitStart(wli)
+
+	return nil
 
 }
 
@@ -1248,30 +1939,57 @@ type subflowCaller struct {
 	State      string
 	Step       int
 	Depth      int
+
+	// callers in other clusters are reported to over http
+	Callback      string `json:",omitempty"`
+	CallbackToken string `json:",omitempty"`
 }
 
 const maxSubflowDepth = 5
 
-func (we *workflowEngine) subflowInvoke(ctx context.Context, caller *subflowCaller, callersCaller, namespace, name string, input []byte) (string, error) {
+// subflowDepth returns the depth of a subflow invoked by an instance that was
+// invoked by callersCaller.
+func subflowDepth(callersCaller string) (int, error) {
+
+	if callersCaller == "" {
+		return 0, nil
+	}
+
+	cc := new(subflowCaller)
+	err := json.Unmarshal([]byte(callersCaller), cc)
+	if err != nil {
+		log.Errorf("Internal error on subflowInvoke: %v", err)
+		return 0, errors.New("an internal error occurred")
+	}
+
+	depth := cc.Depth + 1
+	if depth > maxSubflowDepth {
+		return 0, NewUncatchableError("direktiv.limits.depth", "instance aborted for exceeding the maximum subflow depth (%d)", maxSubflowDepth)
+	}
+
+	return depth, nil
+
+}
+
+func (we *workflowEngine) subflowInvoke(ctx context.Context, caller *subflowCaller, callersCaller, namespace, name, timeout string, input []byte) (string, error) {
 
 	var err error
 
-	if callersCaller != "" {
-		cc := new(subflowCaller)
-		err = json.Unmarshal([]byte(callersCaller), cc)
-		if err != nil {
-			log.Errorf("Internal error on subflowInvoke: %v", err)
-			return "", errors.New("an internal error occurred")
-		}
+	name, revision, err := model.SplitWorkflowReference(name)
+	if err != nil {
+		return "", NewUncatchableError("direktiv.subflow.invalid", err.Error())
+	}
 
-		caller.Depth = cc.Depth + 1
-		if caller.Depth > maxSubflowDepth {
-			err = NewUncatchableError("direktiv.limits.depth", "instance aborted for exceeding the maximum subflow depth (%d)", maxSubflowDepth)
-			return "", err
-		}
+	caller.Depth, err = subflowDepth(callersCaller)
+	if err != nil {
+		return "", err
 	}
 
-	wli, err := we.newWorkflowLogicInstance(ctx, namespace, name, input)
+	if h := we.invocationHalt(); h.reason != "" {
+		return "", NewCatchableError(invocationHaltCode, "new instances are halted: %s", h.reason)
+	}
+
+	wli, err := we.newWorkflowLogicInstanceAt(ctx, namespace, name, "", revision, input)
 	if err != nil {
 		if _, ok := err.(*InternalError); ok {
 			log.Errorf("Internal error on subflowInvoke: %v", err)
@@ -1286,6 +2004,13 @@ func (we *workflowEngine) subflowInvoke(ctx context.Context, caller *subflowCall
 		return "", fmt.Errorf("cannot subflow invoke workflows with '%s' starts", wli.wf.Start.GetType())
 	}
 
+	// instance ids are namespace/workflow/suffix
+	cid := strings.SplitN(caller.InstanceID, "/", 3)
+	if len(cid) < 2 || !wli.wf.Access.PermitsCaller(cid[0], cid[1]) {
+		wli.Close()
+		return "", NewUncatchableError("direktiv.subflow.denied", "workflow '%s' may not be called from instance '%s'", name, caller.InstanceID)
+	}
+
 	var callerData []byte
 	if caller != nil {
 
@@ -1297,7 +2022,7 @@ func (we *workflowEngine) subflowInvoke(ctx context.Context, caller *subflowCall
 
 	}
 
-	wli.rec, err = we.db.addWorkflowInstance(ctx, namespace, name, wli.id, string(wli.startData), false, wli.wf.Exclusive, callerData)
+	wli.rec, err = we.db.addWorkflowInstance(ctx, namespace, name, wli.revision, wli.id, "", string(wli.startData), wli.profile, false, workflowMutex(wli.wf, false), callerData)
 	if err != nil {
 		wli.Close()
 		return "", NewInternalError(err)
@@ -1306,7 +2031,17 @@ func (we *workflowEngine) subflowInvoke(ctx context.Context, caller *subflowCall
 	wli.NamespaceLog("Workflow '%s' triggered as subflow from '%s'", name, caller.InstanceID)
 	wli.Log("Preparing workflow triggered as subflow to caller: %s", caller.InstanceID)
 
-	go wli.start()
+	if timeout != "" {
+		var d duration.Duration
+		d, err = duration.ParseISO8601(timeout)
+		if err != nil {
+			wli.Close()
+			return "", NewInternalError(err)
+		}
+		wli.ScheduleSubflowTimeout(d.Shift(we.clock.Now()))
+	}
+
+	we.submitStart(wli)
 
 	return wli.id, nil
 
@@ -1328,6 +2063,20 @@ func (we *workflowEngine) timeoutHandler(input []byte) error {
 		return err
 	}
 
+	// timers of finished instances are deleted, but one may fire meanwhile
+	rec, err := we.db.getWorkflowInstance(context.Background(), args.InstanceId)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if rec.Status != "pending" && rec.Status != "running" {
+		log.Debugf("Ignoring timeout of finished instance %s.", args.InstanceId)
+		return nil
+	}
+
 	if args.Soft {
 		we.softCancelInstance(args.InstanceId, args.Step, "direktiv.cancels.timeout", "operation timed out")
 	} else {
@@ -1370,7 +2119,7 @@ func (we *workflowEngine) listenForEvents(ctx context.Context, wli *workflowLogi
 			if strings.HasPrefix(str, "{{") && strings.HasSuffix(str, "}}") {
 
 				query := str[2 : len(str)-2]
-				x, err := jqOne(wli.data, query)
+				x, err := jqOne(wli.data, query, wli.jqScope())
 				if err != nil {
 					return fmt.Errorf("failed to execute jq query for key '%s' on event definition %d: %v", k, i, err)
 				}
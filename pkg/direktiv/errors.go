@@ -1,7 +1,10 @@
 package direktiv
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"regexp"
 	"runtime"
 	"strings"
 
@@ -91,6 +94,38 @@ func (err *UncatchableError) Error() string {
 type CatchableError struct {
 	Code    string `json:"code"`
 	Message string `json:"msg"`
+
+	// Exhausted is set to the code of the original error if this error was
+	// raised because that error used up every retry attempt.
+	Exhausted string `json:"exhausted,omitempty"`
+	Attempts  int    `json:"attempts,omitempty"`
+
+	// Data is an optional structured payload attached by an error state.
+	Data interface{} `json:"data,omitempty"`
+
+	// Cancel is set if the error was raised because a subflow was cancelled.
+	Cancel *CancelReason `json:"cancel,omitempty"`
+}
+
+const cancelCodePrefix = "direktiv.cancels."
+
+var cancelCategoryRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+
+// categories of the cancellations the engine raises itself, which catchers
+// should be able to rely on
+var reservedCancelCategories = map[string]bool{
+	"timeout":   true,
+	"parent":    true,
+	"kickstart": true,
+	"cron":      true,
+	"debug":     true,
+}
+
+// CancelReason describes why an instance was cancelled. The category is the
+// last element of the 'direktiv.cancels.*' error code.
+type CancelReason struct {
+	Category string `json:"category"`
+	Reason   string `json:"reason"`
 }
 
 func NewCatchableError(code string, msg string, a ...interface{}) *CatchableError {
@@ -110,6 +145,7 @@ func WrapCatchableError(msg string, err error) error {
 		return &CatchableError{
 			Code:    cerr.Code,
 			Message: fmt.Sprintf(msg, err),
+			Data:    cerr.Data,
 		}
 	} else {
 		return err
@@ -161,6 +197,90 @@ func (err *InternalError) Unwrap() error {
 	return err.Err
 }
 
+// ErrorCauses returns what users may see of the errors wrapped by err,
+// outermost first. The codes and messages of direktiv's own errors are kept,
+// and the contexts errors were wrapped in by the engine, but anything else is
+// reduced to a public code, so that raw database or system messages don't
+// reach instance records. Each message appears once, as the text a wrapper
+// shares with the error it wraps is only kept with the inner error.
+func ErrorCauses(err error) []schema.ErrorCause {
+
+	causes := make([]schema.ErrorCause, 0)
+	seen := make(map[schema.ErrorCause]bool)
+
+	for ; err != nil; err = errors.Unwrap(err) {
+
+		if _, ok := err.(*InternalError); ok {
+			continue
+		}
+
+		cause, ok := publicCause(err)
+		if !ok || seen[cause] {
+			continue
+		}
+
+		seen[cause] = true
+		causes = append(causes, cause)
+
+	}
+
+	return causes
+
+}
+
+// publicCause returns what users may see of err itself, without the errors
+// it wraps, or false if it only adds them.
+func publicCause(err error) (schema.ErrorCause, bool) {
+
+	code := func(code string) (schema.ErrorCause, bool) {
+		return schema.ErrorCause{Code: code}, true
+	}
+
+	switch e := err.(type) {
+	case *CatchableError:
+		return schema.ErrorCause{Code: e.Code, Message: e.Message}, true
+	case *UncatchableError:
+		return schema.ErrorCause{Code: e.Code, Message: e.Message}, true
+	case *ent.NotFoundError:
+		return code("direktiv.internal.database.notFound")
+	case *ent.NotSingularError:
+		return code("direktiv.internal.database.notSingular")
+	case *ent.NotLoadedError:
+		return code("direktiv.internal.database.notLoaded")
+	case *ent.ConstraintError:
+		return code("direktiv.internal.database.constraint")
+	case *ent.ValidationError:
+		return code("direktiv.internal.database.validation")
+	}
+
+	switch err {
+	case context.Canceled:
+		return code("direktiv.internal.canceled")
+	case context.DeadlineExceeded:
+		return code("direktiv.internal.deadlineExceeded")
+	}
+
+	if se, ok := err.(interface {
+		GRPCStatus() *status.Status
+	}); ok {
+		return code("direktiv.internal.grpc." + se.GRPCStatus().Code().String())
+	}
+
+	// errors wrapped with %w by the engine are prefixed with its context
+	if inner := errors.Unwrap(err); inner != nil {
+		if prefix := strings.TrimSuffix(err.Error(), inner.Error()); prefix != err.Error() {
+			prefix = strings.TrimSuffix(strings.TrimSpace(prefix), ":")
+			if prefix == "" {
+				return schema.ErrorCause{}, false
+			}
+			return schema.ErrorCause{Code: "direktiv.internal.error", Message: prefix}, true
+		}
+	}
+
+	return code("direktiv.internal.error")
+
+}
+
 // ErrorType types of errors direktiv commands can return
 type ErrorType int
 
@@ -0,0 +1,97 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	log "github.com/sirupsen/logrus"
+)
+
+// Only the data of the events an instance receives ends up in its state
+// data. Their attributes are kept with the instance instead, replaced by the
+// next events it receives, so that its queries can see them as $event, the
+// last of them, and $events, the last of each type.
+
+type eventMetadata struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Source  string `json:"source"`
+	Subject string `json:"subject,omitempty"`
+	Time    string `json:"time,omitempty"`
+}
+
+// receivedEvents records the attributes of events the instance received.
+func (wli *workflowLogicInstance) receivedEvents(ctx context.Context, events []*cloudevents.Event) error {
+
+	metadata := make([]eventMetadata, 0, len(events))
+
+	for _, event := range events {
+
+		if event == nil {
+			continue
+		}
+
+		md := eventMetadata{
+			ID:      event.ID(),
+			Type:    event.Type(),
+			Source:  event.Source(),
+			Subject: event.Subject(),
+		}
+
+		if t := event.Time(); !t.IsZero() {
+			md.Time = t.UTC().Format(time.RFC3339Nano)
+		}
+
+		metadata = append(metadata, md)
+
+	}
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return NewInternalError(err)
+	}
+
+	wf := wli.rec.Edges.Workflow
+
+	rec, err := wli.rec.Update().
+		SetReceivedEvents(string(data)).
+		Save(ctx)
+	if err != nil {
+		return NewInternalError(err)
+	}
+	wli.rec = rec
+	wli.rec.Edges.Workflow = wf
+
+	return nil
+
+}
+
+// eventVariables returns the values of $event and $events, which are null
+// until the instance receives an event.
+func (wli *workflowLogicInstance) eventVariables() (interface{}, interface{}) {
+
+	if wli.rec == nil || wli.rec.ReceivedEvents == "" {
+		return nil, nil
+	}
+
+	var metadata []eventMetadata
+	err := json.Unmarshal([]byte(wli.rec.ReceivedEvents), &metadata)
+	if err != nil {
+		log.Errorf("bad received events recorded for instance %s: %v", wli.id, err)
+		return nil, nil
+	}
+
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+
+	types := make(map[string]interface{})
+	for _, md := range metadata {
+		types[md.Type] = md
+	}
+
+	return metadata[len(metadata)-1], types
+
+}
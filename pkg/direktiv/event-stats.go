@@ -0,0 +1,218 @@
+package direktiv
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	log "github.com/sirupsen/logrus"
+)
+
+// Every flow server counts the cloud events broadcast to it by namespace
+// and type: received, matched by at least one listener of a workflow or a
+// waiting instance, unmatched, or rejected because they were invalid or
+// couldn't be handled. The counts are added to the event counters in the
+// database every few seconds, and unmatched events are sampled so that
+// producers can see what arrives without reaching any workflow.
+
+const (
+	eventStatsInterval = time.Second * 10
+
+	// at most one unmatched event of a type is sampled per namespace and
+	// interval, and the newest eventSamplesKept of a namespace are kept
+	eventSampleInterval = time.Minute
+	eventSamplesKept    = 100
+)
+
+type eventOutcome int
+
+const (
+	eventMatched eventOutcome = iota
+	eventUnmatched
+	eventRejected
+)
+
+type eventCounts struct {
+	received  int64
+	matched   int64
+	unmatched int64
+	rejected  int64
+	last      time.Time
+}
+
+func (c *eventCounts) add(o *eventCounts) {
+
+	c.received += o.received
+	c.matched += o.matched
+	c.unmatched += o.unmatched
+	c.rejected += o.rejected
+
+	if o.last.After(c.last) {
+		c.last = o.last
+	}
+
+}
+
+type eventStatsKey struct {
+	namespace string
+	typ       string
+}
+
+type unmatchedEventSample struct {
+	namespace string
+	typ       string
+	source    string
+	id        string
+	received  time.Time
+	data      []byte
+}
+
+// eventStats holds the event counts and samples not yet written to the
+// database.
+type eventStats struct {
+	mtx     sync.Mutex
+	counts  map[eventStatsKey]*eventCounts
+	samples []*unmatchedEventSample
+	sampled map[eventStatsKey]time.Time
+}
+
+func newEventStats() *eventStats {
+	return &eventStats{
+		counts:  make(map[eventStatsKey]*eventCounts),
+		sampled: make(map[eventStatsKey]time.Time),
+	}
+}
+
+// record counts an event of a namespace, which is nil if it couldn't be
+// parsed from data.
+func (es *eventStats) record(namespace string, ce *cloudevents.Event, data []byte, outcome eventOutcome) {
+
+	var typ string
+	if ce != nil {
+		typ = ce.Type()
+	}
+
+	k := eventStatsKey{namespace: namespace, typ: typ}
+	now := time.Now()
+
+	es.mtx.Lock()
+	defer es.mtx.Unlock()
+
+	c, ok := es.counts[k]
+	if !ok {
+		c = new(eventCounts)
+		es.counts[k] = c
+	}
+
+	c.received++
+	c.last = now
+
+	switch outcome {
+	case eventMatched:
+		c.matched++
+	case eventUnmatched:
+		c.unmatched++
+	case eventRejected:
+		c.rejected++
+	}
+
+	if outcome != eventUnmatched || now.Sub(es.sampled[k]) < eventSampleInterval {
+		return
+	}
+
+	es.sampled[k] = now
+	es.samples = append(es.samples, &unmatchedEventSample{
+		namespace: namespace,
+		typ:       typ,
+		source:    ce.Source(),
+		id:        ce.ID(),
+		received:  now,
+		data:      data,
+	})
+
+}
+
+// take returns the counts and samples recorded since it was last called.
+func (es *eventStats) take() (map[eventStatsKey]*eventCounts, []*unmatchedEventSample) {
+
+	es.mtx.Lock()
+	defer es.mtx.Unlock()
+
+	counts, samples := es.counts, es.samples
+	es.counts = make(map[eventStatsKey]*eventCounts)
+	es.samples = nil
+
+	for k, t := range es.sampled {
+		if time.Since(t) >= eventSampleInterval {
+			delete(es.sampled, k)
+		}
+	}
+
+	return counts, samples
+
+}
+
+// putBack returns counts that couldn't be written, to be written with the
+// next ones.
+func (es *eventStats) putBack(counts map[eventStatsKey]*eventCounts) {
+
+	es.mtx.Lock()
+	defer es.mtx.Unlock()
+
+	for k, c := range counts {
+		if cur, ok := es.counts[k]; ok {
+			cur.add(c)
+		} else {
+			es.counts[k] = c
+		}
+	}
+
+}
+
+func (we *workflowEngine) writeEventStats() {
+
+	ctx, cancel := context.WithTimeout(context.Background(), eventStatsInterval)
+	defer cancel()
+
+	counts, samples := we.events.take()
+	failed := make(map[eventStatsKey]*eventCounts)
+
+	for k, c := range counts {
+		err := we.db.addEventCounts(ctx, k.namespace, k.typ, c)
+		if err != nil {
+			log.Errorf("cannot write event counts of namespace %s: %v", k.namespace, err)
+			failed[k] = c
+		}
+	}
+
+	we.events.putBack(failed)
+
+	if len(samples) == 0 {
+		return
+	}
+
+	err := we.db.addUnmatchedEvents(ctx, samples, eventSamplesKept)
+	if err != nil {
+		log.Errorf("cannot write unmatched events: %v", err)
+	}
+
+}
+
+func (we *workflowEngine) flushEventStats() {
+
+	ticker := time.NewTicker(eventStatsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-we.nodeDone:
+			we.writeEventStats()
+			return
+		case <-ticker.C:
+		}
+
+		we.writeEventStats()
+	}
+
+}
@@ -0,0 +1,283 @@
+package direktiv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/ingress"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Every namespace can have its own event transport: the events posted to it
+// from outside can be limited to some types, and the events emitted in it,
+// by generateEvent states, log-to-events and deadline alerts, are posted to
+// its sinks as well as broadcast to its workflows. A namespace without an
+// event config of its own uses the one of its nearest ancestor that has one.
+// Sinks get structured cloud events over http, with the namespace secret
+// they name as the Authorization header, so credentials aren't stored in the
+// config itself. Sinks have to be addresses the operator allowed, like the
+// services of functions. Brokers such as Kafka or NATS aren't transports of
+// their own, they are reached through http bridges, like the brokers of
+// knative eventing.
+
+const eventSinkTimeout = time.Second * 10
+
+type namespaceEventConfig struct {
+	Inbound struct {
+		Types []string `json:"types,omitempty"`
+	} `json:"inbound"`
+	Sinks []eventSink `json:"sinks,omitempty"`
+}
+
+type eventSink struct {
+	URL        string            `json:"url"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	AuthSecret string            `json:"authSecret,omitempty"`
+	Types      []string          `json:"types,omitempty"`
+}
+
+// eventTypeMatches reports whether an event type is one of types, exactly or
+// by a prefix ending in *. Every type matches an empty list.
+func eventTypeMatches(types []string, typ string) bool {
+
+	if len(types) == 0 {
+		return true
+	}
+
+	for _, t := range types {
+		if strings.HasSuffix(t, "*") {
+			if strings.HasPrefix(typ, strings.TrimSuffix(t, "*")) {
+				return true
+			}
+		} else if t == typ {
+			return true
+		}
+	}
+
+	return false
+
+}
+
+func (conf *namespaceEventConfig) validate() error {
+
+	for _, t := range conf.Inbound.Types {
+		if t == "" || strings.Contains(strings.TrimSuffix(t, "*"), "*") {
+			return fmt.Errorf("invalid event type '%s'", t)
+		}
+	}
+
+	for i, sink := range conf.Sinks {
+
+		u, err := url.Parse(sink.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("sink %d: url must be an absolute http or https url", i)
+		}
+
+		for _, t := range sink.Types {
+			if t == "" || strings.Contains(strings.TrimSuffix(t, "*"), "*") {
+				return fmt.Errorf("sink %d: invalid event type '%s'", i, t)
+			}
+		}
+
+		for k := range sink.Headers {
+			if strings.EqualFold(k, "Authorization") && sink.AuthSecret != "" {
+				return fmt.Errorf("sink %d: authorization header conflicts with authSecret", i)
+			}
+		}
+
+	}
+
+	return nil
+
+}
+
+func (db *dbManager) namespaceEventConfig(ctx context.Context, ns string) (*namespaceEventConfig, error) {
+
+	data, err := db.getNamespaceEventConfig(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := new(namespaceEventConfig)
+	if len(data) == 0 {
+		return conf, nil
+	}
+
+	err = json.Unmarshal(data, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+
+}
+
+// resolveEventConfig returns the event config that applies to a namespace,
+// and the namespace that it belongs to.
+func (db *dbManager) resolveEventConfig(ctx context.Context, ns string) (*namespaceEventConfig, string, error) {
+
+	data, err := db.getNamespaceEventConfig(ctx, ns)
+	if err != nil {
+		return nil, "", err
+	}
+
+	owner := ns
+
+	if len(data) == 0 {
+
+		ancestors, err := db.namespaceAncestors(ctx, ns)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, a := range ancestors {
+			data, err = db.getNamespaceEventConfig(ctx, a)
+			if err != nil {
+				return nil, "", err
+			}
+			if len(data) > 0 {
+				owner = a
+				break
+			}
+		}
+
+	}
+
+	conf := new(namespaceEventConfig)
+	if len(data) == 0 {
+		return conf, ns, nil
+	}
+
+	err = json.Unmarshal(data, conf)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return conf, owner, nil
+
+}
+
+// acceptInboundEvent refuses events posted to a namespace from outside whose
+// type it doesn't accept.
+func (we *workflowEngine) acceptInboundEvent(ctx context.Context, ns string, event *cloudevents.Event) error {
+
+	conf, _, err := we.db.resolveEventConfig(ctx, ns)
+	if err != nil {
+		return grpcDatabaseError(err, "namespace", ns)
+	}
+
+	if !eventTypeMatches(conf.Inbound.Types, event.Type()) {
+		return status.Errorf(codes.InvalidArgument, "namespace '%s' doesn't accept events of type '%s'", ns, event.Type())
+	}
+
+	return nil
+
+}
+
+// emitEvent broadcasts an event to the workflows of a namespace, and posts
+// it to the namespace's sinks in the background.
+func (we *workflowEngine) emitEvent(ctx context.Context, ns string, event *cloudevents.Event) error {
+
+	data, err := event.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	_, err = we.ingressClient.BroadcastEvent(ctx, &ingress.BroadcastEventRequest{
+		Namespace:  &ns,
+		Cloudevent: data,
+	})
+	if err != nil {
+		return err
+	}
+
+	conf, owner, err := we.db.resolveEventConfig(ctx, ns)
+	if err != nil {
+		log.Errorf("cannot resolve event config of namespace '%s': %v", ns, err)
+		return nil
+	}
+
+	for i := range conf.Sinks {
+		sink := conf.Sinks[i]
+		if !eventTypeMatches(sink.Types, event.Type()) {
+			continue
+		}
+		go we.publishEvent(ns, owner, &sink, event.ID(), data)
+	}
+
+	return nil
+
+}
+
+// publishEvent posts an event to a sink, taking its credentials from the
+// namespace that configured it.
+func (we *workflowEngine) publishEvent(ns, owner string, sink *eventSink, id string, data []byte) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), eventSinkTimeout)
+	defer cancel()
+
+	err := we.postEvent(ctx, owner, sink, data)
+	if err == nil {
+		return
+	}
+
+	log.Errorf("cannot publish event %s of namespace '%s' to %s: %v", id, ns, sink.URL, err)
+
+	l, lerr := we.server.instanceLogger.NamespaceLogger(ns)
+	if lerr != nil {
+		return
+	}
+	defer l.Close()
+
+	l.Error(fmt.Sprintf("Cannot publish event %s to %s: %v", id, sink.URL, err))
+
+}
+
+func (we *workflowEngine) postEvent(ctx context.Context, owner string, sink *eventSink, data []byte) error {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sink.URL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	for k, v := range sink.Headers {
+		req.Header.Set(k, v)
+	}
+
+	req.Header.Set("Content-Type", "application/cloudevents+json")
+
+	// sinks saved before their address was disallowed may still use it
+	if !we.server.config.urlAllowed(owner, req.URL) {
+		return fmt.Errorf("'%s' is not an allowed address in namespace '%s'", sink.URL, owner)
+	}
+
+	if sink.AuthSecret != "" {
+		secret, err := we.namespaceSecret(ctx, owner, sink.AuthSecret)
+		if err != nil {
+			return fmt.Errorf("cannot get secret '%s': %v", sink.AuthSecret, err)
+		}
+		req.Header.Set("Authorization", string(secret))
+	}
+
+	resp, err := we.server.config.allowedClient(owner).Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("sink responded with %s", resp.Status)
+	}
+
+	return nil
+
+}
@@ -0,0 +1,166 @@
+package direktiv
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/pkg/ingress"
+	"github.com/vorteil/direktiv/pkg/model"
+)
+
+// traceEvent works out what handleEvent would do with an event without doing
+// any of it: which listeners of the namespace it matches and why the others
+// don't, and whether a match would invoke its workflow or wake its instance
+// or only be stored until the other events the listener waits for arrive.
+// Disabled workflows with event starts have no listeners, but are traced too
+// so that it's clear why they won't be invoked.
+func (s *WorkflowServer) traceEvent(ctx context.Context, ns string, ce *cloudevents.Event) ([]*ingress.TraceEventListener, error) {
+
+	// the source is filtered like any extension
+	extensions := make(map[string]interface{})
+	for k, v := range ce.Extensions() {
+		extensions[k] = v
+	}
+	extensions["source"] = ce.Source()
+
+	listeners, err := s.dbManager.getNamespaceEventListeners(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	var traces []*ingress.TraceEventListener
+
+	for _, l := range listeners {
+
+		t, matched := traceEventDefinitions(ce, extensions, l.Events)
+
+		if wf := l.Edges.Workflow; wf != nil {
+			t.Workflow = &wf.Name
+		}
+
+		if inst := l.Edges.Workflowinstance; inst != nil {
+			t.Instance = &inst.InstanceID
+		}
+
+		outcome := "no events match"
+		if matched {
+			outcome = traceEventOutcome(ce, l)
+		}
+		t.Outcome = &outcome
+
+		traces = append(traces, t)
+
+	}
+
+	wfs, err := s.dbManager.getInactiveWorkflows(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, wf := range wfs {
+
+		var workflow model.Workflow
+		err = workflow.Load(wf.Workflow)
+		if err != nil {
+			continue
+		}
+
+		start := workflow.GetStartDefinition()
+		if len(start.GetEvents()) == 0 {
+			continue
+		}
+
+		name := wf.Name
+		outcome := "workflow is disabled"
+
+		t, _ := traceEventDefinitions(ce, extensions, startEventMaps(start.GetEvents()))
+		t.Workflow = &name
+		t.Outcome = &outcome
+
+		traces = append(traces, t)
+
+	}
+
+	return traces, nil
+
+}
+
+func traceEventDefinitions(ce *cloudevents.Event, extensions map[string]interface{}, events []map[string]interface{}) (*ingress.TraceEventListener, bool) {
+
+	t := new(ingress.TraceEventListener)
+
+	var matched bool
+
+	for _, em := range events {
+
+		typ, _ := em[eventTypeString].(string)
+
+		reason := fmt.Sprintf("type '%s' does not match '%s'", ce.Type(), typ)
+		if typ == ce.Type() {
+			reason = extensionsMismatch(em, extensions)
+		}
+
+		ok := reason == ""
+		if ok {
+			reason = "type and filters match"
+			matched = true
+		}
+
+		t.Events = append(t.Events, &ingress.TraceEventDefinition{
+			Type:    &typ,
+			Matched: &ok,
+			Reason:  &reason,
+		})
+
+	}
+
+	t.Matched = &matched
+
+	return t, matched
+
+}
+
+func traceEventOutcome(ce *cloudevents.Event, l *ent.WorkflowEvents) string {
+
+	action := "invoke the workflow"
+	if len(l.Signature) > 0 {
+		action = "wake the instance"
+	}
+
+	if l.Count == 1 {
+		return fmt.Sprintf("would %s", action)
+	}
+
+	for _, k := range l.Correlations {
+		if ce.Extensions()[strings.ToLower(k)] == nil {
+			return fmt.Sprintf("event does not contain correlation id %s and would be ignored", k)
+		}
+	}
+
+	chash := generateCorrelationHash(ce, ce.Type(), l.Correlations)
+
+	for _, w := range l.Edges.Wfeventswait {
+
+		if _, ok := w.Events[chash]; !ok {
+			continue
+		}
+
+		complete := true
+		for k, v := range w.Events {
+			if k != chash && v == nil {
+				complete = false
+			}
+		}
+
+		if complete {
+			return fmt.Sprintf("would complete the events waiting and %s", action)
+		}
+
+	}
+
+	return fmt.Sprintf("would wait for the other events before it would %s", action)
+
+}
@@ -2,7 +2,6 @@ package direktiv
 
 import (
 	"bytes"
-	"database/sql"
 	"encoding/base64"
 	"encoding/gob"
 	"encoding/json"
@@ -28,6 +27,18 @@ func init() {
 
 func matchesExtensions(eventMap, extensions map[string]interface{}) bool {
 
+	if reason := extensionsMismatch(eventMap, extensions); reason != "" {
+		log.Debugf("%s", reason)
+		return false
+	}
+
+	return true
+}
+
+// extensionsMismatch explains why the extensions of an event don't pass the
+// filters of an event definition, or returns an empty string if they do.
+func extensionsMismatch(eventMap, extensions map[string]interface{}) string {
+
 	for k, f := range eventMap {
 
 		if strings.HasPrefix(k, filterPrefix) {
@@ -40,19 +51,17 @@ func matchesExtensions(eventMap, extensions map[string]interface{}) bool {
 
 				// if both are strings we can glob
 				if ok && ok2 && !glob.Glob(fs, vs) {
-					log.Debugf("%s does not match %s", vs, fs)
-					return false
+					return fmt.Sprintf("%s '%s' does not match '%s'", kt, vs, fs)
 				}
 
 			} else {
-				log.Debugf("event does not contain %v", kt)
-				return false
+				return fmt.Sprintf("event does not contain %v", kt)
 			}
 
 		}
 	}
 
-	return true
+	return ""
 }
 
 func hasEventInList(ev *cloudevents.Event, evl []*cloudevents.Event) bool {
@@ -146,7 +155,9 @@ func (s *WorkflowServer) updateMultipleEvents(ce *cloudevents.Event, id int,
 
 }
 
-func (s *WorkflowServer) handleEvent(namespace string, ce *cloudevents.Event) error {
+// handleEvent invokes the workflows and wakes the instances listening for an
+// event, and reports whether any listener matched it.
+func (s *WorkflowServer) handleEvent(namespace string, ce *cloudevents.Event) (bool, error) {
 
 	log.Debugf("handle event %s", ce.Type())
 
@@ -177,11 +188,12 @@ func (s *WorkflowServer) handleEvent(namespace string, ce *cloudevents.Event) er
 	where v::json->>'type' = $1
 	and n.id = $2`, ce.Type(), namespace)
 	if err != nil {
-		return err
+		return false, err
 	}
 	defer rows.Close()
 
-	var conn *sql.Conn
+	var conn *dbLock
+	var matched bool
 	for rows.Next() {
 
 		err := rows.Scan(&id, &signature, &count, &corBytes, &allEvents, &wf, &singleEvent)
@@ -232,6 +244,8 @@ func (s *WorkflowServer) handleEvent(namespace string, ce *cloudevents.Event) er
 			continue
 		}
 
+		matched = true
+
 		var ae []map[string]interface{}
 		json.Unmarshal(allEvents, &ae)
 
@@ -303,7 +317,7 @@ func (s *WorkflowServer) handleEvent(namespace string, ce *cloudevents.Event) er
 
 	}
 
-	return nil
+	return matched, nil
 }
 
 func generateCorrelationHash(cevent *cloudevents.Event,
@@ -0,0 +1,369 @@
+package direktiv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/senseyeio/duration"
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/flow"
+	"github.com/vorteil/direktiv/pkg/lambda"
+)
+
+// actionExecutor dispatches an action. Executors return once the action has
+// been dispatched and report its results to flow asynchronously.
+type actionExecutor interface {
+	execute(ctx context.Context, ar *isolateRequest) error
+}
+
+func (we *workflowEngine) executor(ar *isolateRequest) actionExecutor {
+
+	if ar.Container.Service != "" {
+		return &serviceExecutor{engine: we}
+	}
+
+	if ar.Container.Lambda != "" {
+		return &lambdaExecutor{engine: we}
+	}
+
+	return &isolateExecutor{engine: we}
+
+}
+
+// isolateExecutor runs actions as direktiv isolates, creating a knative
+// service with the direktiv sidecar for each function.
+type isolateExecutor struct {
+	engine *workflowEngine
+}
+
+func (x *isolateExecutor) execute(ctx context.Context, ar *isolateRequest) error {
+
+	// generate hash name as "url"
+	actionHash, err := serviceToHash(ar)
+	if err != nil {
+		return NewInternalError(err)
+	}
+
+	go x.engine.doHTTPRequest(ctx, actionHash, ar)
+
+	return nil
+
+}
+
+// serviceExecutor calls an existing http service, such as a knative service or
+// a plain internal endpoint, directly. The service receives the action input
+// as the request body and its response body becomes the action output.
+// Services can return catchable errors using the same headers as isolates.
+type serviceExecutor struct {
+	engine *workflowEngine
+}
+
+func (x *serviceExecutor) execute(ctx context.Context, ar *isolateRequest) error {
+
+	// workflows saved before the address was disallowed may still use it
+	err := x.engine.server.config.checkURL(ar.Workflow.Namespace, ar.Container.Service)
+	if err != nil {
+		return NewUncatchableError("direktiv.service.forbidden", "%v", err)
+	}
+
+	go x.run(ar)
+
+	return nil
+
+}
+
+func (x *serviceExecutor) report(ar *isolateRequest, output []byte, code, msg string) {
+	x.engine.reportExecutorResults(ar, output, code, msg)
+}
+
+// reportExecutorResults reports the results of an action run by an executor
+// without a sidecar.
+func (we *workflowEngine) reportExecutorResults(ar *isolateRequest, output []byte, code, msg string) {
+
+	step := int32(ar.Workflow.Step)
+
+	err := ReportActionResults(context.Background(), we.flowClient, &flow.ReportActionResultsRequest{
+		InstanceId:   &ar.Workflow.InstanceID,
+		Step:         &step,
+		ActionId:     &ar.ActionID,
+		ErrorCode:    &code,
+		ErrorMessage: &msg,
+		Output:       output,
+	})
+	if err != nil {
+		log.Errorf("can not respond to flow: %v", err)
+	}
+
+}
+
+// serviceOutcome is the result of one request to a service.
+type serviceOutcome struct {
+	data      []byte
+	code, msg string
+	retry     bool
+}
+
+func (x *serviceExecutor) run(ar *isolateRequest) {
+
+	we := x.engine
+
+	ctx, cancel := context.WithDeadline(context.Background(), ar.Workflow.Deadline)
+	defer cancel()
+
+	we.cancellations.track(ar.ActionID, cancel)
+	defer we.cancellations.untrack(ar.ActionID)
+
+	err := we.actions.acquire(ctx, ar.Workflow.Namespace)
+	if err != nil {
+		x.report(ar, nil, "", err.Error())
+		return
+	}
+	defer we.actions.release(ar.Workflow.Namespace)
+
+	var timeout time.Duration
+	var retries int
+	if opts := ar.Container.HTTP; opts != nil {
+		retries = opts.Retries
+		if opts.Timeout != "" {
+			d, err := duration.ParseISO8601(opts.Timeout)
+			if err != nil {
+				x.report(ar, nil, "", fmt.Sprintf("invalid service timeout: %v", err))
+				return
+			}
+			now := time.Now()
+			timeout = d.Shift(now).Sub(now)
+		}
+	}
+
+	var out *serviceOutcome
+
+	for attempt := 0; attempt <= retries; attempt++ {
+
+		if attempt > 0 {
+			// back off exponentially between attempts, up to 30 seconds
+			wait := time.Second << uint(attempt-1)
+			if wait > 30*time.Second {
+				wait = 30 * time.Second
+			}
+			log.Debugf("retrying service request in %v: %s", wait, out.msg)
+			select {
+			case <-time.After(wait):
+			case <-ctx.Done():
+				x.report(ar, nil, "", fmt.Sprintf("%s (%v)", out.msg, ctx.Err()))
+				return
+			}
+		}
+
+		err = we.throttle.wait(ctx, ar.Container.Service)
+		if err != nil {
+			x.report(ar, nil, "", err.Error())
+			return
+		}
+
+		out = x.attempt(ctx, ar, ar.Workflow.Deadline, timeout)
+		if !out.retry {
+			break
+		}
+
+	}
+
+	x.report(ar, out.data, out.code, out.msg)
+
+	log.Debugf("service request done")
+
+}
+
+// attempt makes a single request to the service. Network errors, timeouts,
+// and statuses that suggest the service is temporarily unavailable can be
+// retried.
+func (x *serviceExecutor) attempt(ctx context.Context, ar *isolateRequest, deadline time.Time, timeout time.Duration) *serviceOutcome {
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ar.Container.Service,
+		bytes.NewReader(ar.Container.Data))
+	if err != nil {
+		return &serviceOutcome{msg: err.Error()}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if opts := ar.Container.HTTP; opts != nil {
+		for k, v := range opts.Headers {
+			req.Header.Set(k, v)
+		}
+	}
+
+	req.Header.Set(DirektivDeadlineHeader, deadline.Format(time.RFC3339))
+	req.Header.Set(DirektivNamespaceHeader, ar.Workflow.Namespace)
+	req.Header.Set(DirektivActionIDHeader, ar.ActionID)
+	req.Header.Set(DirektivInstanceIDHeader, ar.Workflow.InstanceID)
+	req.Header.Set(DirektivStepHeader, fmt.Sprintf("%d",
+		int64(ar.Workflow.Step)))
+
+	log.Debugf("service request: %v", ar.Container.Service)
+
+	resp, err := x.engine.server.config.allowedClient(ar.Workflow.Namespace).Do(req)
+	if err != nil {
+		return &serviceOutcome{
+			msg:   fmt.Sprintf("service request failed: %v", err),
+			retry: !errors.Is(err, errRedirectNotAllowed),
+		}
+	}
+	defer resp.Body.Close()
+
+	code := resp.Header.Get(DirektivErrorCodeHeader)
+	if code != "" {
+		return &serviceOutcome{code: code, msg: resp.Header.Get(DirektivErrorMessageHeader)}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		out := &serviceOutcome{msg: fmt.Sprintf("service responded with status: %d", resp.StatusCode)}
+		switch resp.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway,
+			http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			out.retry = true
+		}
+		return out
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxActionOutputSize+1))
+	if err != nil {
+		return &serviceOutcome{
+			msg:   fmt.Sprintf("failed to read service response: %v", err),
+			retry: true,
+		}
+	}
+
+	if len(data) > maxActionOutputSize {
+		return &serviceOutcome{msg: "service response is too large"}
+	}
+
+	return &serviceOutcome{data: data}
+
+}
+
+// lambdaExecutor invokes AWS Lambda functions. By default the function runs
+// synchronously with the action input as its payload and its response as the
+// action output. Functions with a callback are invoked asynchronously with the
+// input and a callback url, and report their results to the api when done.
+type lambdaExecutor struct {
+	engine *workflowEngine
+}
+
+// lambdaCallbackPayload is the payload of functions invoked with a callback.
+type lambdaCallbackPayload struct {
+	Input    json.RawMessage `json:"input"`
+	Callback string          `json:"callback"`
+}
+
+func (x *lambdaExecutor) execute(ctx context.Context, ar *isolateRequest) error {
+
+	// workflows saved before the function was disallowed may still use it
+	err := x.engine.server.config.checkLambda(ar.Workflow.Namespace, ar.Container.Lambda)
+	if err != nil {
+		return NewUncatchableError("direktiv.lambda.forbidden", "%v", err)
+	}
+
+	if ar.Container.Callback && x.engine.server.config.LambdaCallbackURL == "" {
+		return NewUncatchableError("direktiv.lambda.callback", "no lambda callback url configured")
+	}
+
+	if ar.Container.Callback && x.engine.server.config.CallbackKey == "" {
+		return NewUncatchableError("direktiv.lambda.callback", "no callback key configured")
+	}
+
+	go x.run(ar)
+
+	return nil
+
+}
+
+func (x *lambdaExecutor) callbackURL(ar *isolateRequest) (string, error) {
+	return x.engine.actionCallbackURL(x.engine.server.config.LambdaCallbackURL,
+		ar.Workflow.InstanceID, ar.ActionID, ar.Workflow.Step)
+}
+
+func (x *lambdaExecutor) run(ar *isolateRequest) {
+
+	we := x.engine
+
+	ctx, cancel := context.WithDeadline(context.Background(), ar.Workflow.Deadline)
+	defer cancel()
+
+	err := we.throttle.wait(ctx, ar.Container.Lambda)
+	if err != nil {
+		we.reportExecutorResults(ar, nil, "", err.Error())
+		return
+	}
+
+	if ar.Container.Callback {
+
+		callback, err := x.callbackURL(ar)
+		if err != nil {
+			we.reportExecutorResults(ar, nil, "", err.Error())
+			return
+		}
+
+		payload, err := json.Marshal(&lambdaCallbackPayload{
+			Input:    json.RawMessage(ar.Container.Data),
+			Callback: callback,
+		})
+		if err != nil {
+			we.reportExecutorResults(ar, nil, "", err.Error())
+			return
+		}
+
+		err = we.lambda.InvokeAsync(ctx, ar.Container.Lambda, payload)
+		if err != nil {
+			we.reportExecutorResults(ar, nil, "", fmt.Sprintf("lambda invocation failed: %v", err))
+			return
+		}
+
+		log.Debugf("lambda invoked asynchronously: %v", ar.Container.Lambda)
+		return
+
+	}
+
+	err = we.actions.acquire(ctx, ar.Workflow.Namespace)
+	if err != nil {
+		we.reportExecutorResults(ar, nil, "", err.Error())
+		return
+	}
+	defer we.actions.release(ar.Workflow.Namespace)
+
+	we.cancellations.track(ar.ActionID, cancel)
+	defer we.cancellations.untrack(ar.ActionID)
+
+	result, err := we.lambda.Invoke(ctx, ar.Container.Lambda, ar.Container.Data)
+	if err != nil {
+		we.reportExecutorResults(ar, nil, "", fmt.Sprintf("lambda invocation failed: %v", err))
+		return
+	}
+
+	if result.FunctionError != "" {
+		fe := new(lambda.FunctionErrorPayload)
+		_ = json.Unmarshal(result.Payload, fe)
+		msg := fe.ErrorMessage
+		if fe.ErrorType != "" {
+			msg = fmt.Sprintf("%s: %s", fe.ErrorType, fe.ErrorMessage)
+		}
+		we.reportExecutorResults(ar, nil, ErrCodeLambdaFunction, msg)
+		return
+	}
+
+	we.reportExecutorResults(ar, result.Payload, "", "")
+
+	log.Debugf("lambda request done")
+
+}
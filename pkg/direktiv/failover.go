@@ -0,0 +1,202 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	failoverPrimary = "primary"
+	failoverStandby = "standby"
+
+	promotionPollInterval = time.Second * 10
+)
+
+var errStandby = status.Error(codes.Unavailable, "server is a standby")
+
+// A standby cluster runs its flow servers against a replica of the primary
+// cluster's database. They serve reads but run nothing until one of them is
+// promoted, after the replica itself has been made writable. Timers only live
+// in memory, so primary servers also record the timers of their instances in
+// the database for the standby to reschedule.
+//
+// The promoted server takes over every running instance, reschedules the
+// recorded timers, gives instances without any timers a timeout at their
+// deadline and removes the event listeners of instances that ended before the
+// replica caught up. The other standby servers of the cluster notice the
+// promoted node and follow, without taking anything over.
+
+func (we *workflowEngine) isStandby() bool {
+	return atomic.LoadInt32(&we.standby) == 1
+}
+
+// replicatesTimers reports whether instance timers are recorded for a standby.
+func (we *workflowEngine) replicatesTimers() bool {
+	return we.server.config.FailoverRole != "" && !we.isStandby()
+}
+
+// awaitPromotion follows a standby server of the same cluster that has been
+// promoted.
+func (s *WorkflowServer) awaitPromotion() {
+
+	ticker := time.NewTicker(promotionPollInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+
+		if !s.engine.isStandby() {
+			return
+		}
+
+		nodes, err := s.dbManager.getNodes(context.Background())
+		if err != nil {
+			log.Errorf("cannot list nodes: %v", err)
+			continue
+		}
+
+		for _, node := range nodes {
+			if node.Promoted && node.Hostname != s.hostname && nodeHealthy(node.Heartbeat) {
+				_, err = s.promote(context.Background(), false)
+				if err != nil {
+					log.Errorf("cannot follow promoted node %s: %v", node.Hostname, err)
+				}
+				break
+			}
+		}
+
+	}
+
+}
+
+// promotion describes what a promoted server took over.
+type promotion struct {
+	instances int
+	timers    int
+	listeners int
+}
+
+// promote turns a standby server into a primary one. Only the server promotion
+// was requested from reconciles the cluster's instances.
+func (s *WorkflowServer) promote(ctx context.Context, reconcile bool) (*promotion, error) {
+
+	we := s.engine
+
+	we.promote.Lock()
+	defer we.promote.Unlock()
+
+	if !we.isStandby() {
+		return nil, status.Error(codes.FailedPrecondition, "server is not a standby")
+	}
+
+	atomic.StoreInt32(&we.standby, 0)
+	we.promoted = true
+
+	// registering the node fails while the database is still read-only
+	err := we.startNode()
+	if err != nil {
+		atomic.StoreInt32(&we.standby, 1)
+		we.promoted = false
+		return nil, status.Errorf(codes.Unavailable, "cannot promote server, is the database writable? %v", err)
+	}
+
+	err = s.startDatabaseListener()
+	if err != nil {
+		log.Errorf("cannot subscribe to sync queue: %v", err)
+	}
+
+	if is, ok := s.components[ingressComponent].(*ingressServer); ok {
+		is.cronPoll()
+	}
+
+	log.Infof("promoted server %s", s.hostname)
+
+	p := new(promotion)
+	if reconcile {
+		p, err = we.reconcile(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "server promoted, but reconciling failed: %v", err)
+		}
+	}
+
+	return p, nil
+
+}
+
+func (we *workflowEngine) reconcile(ctx context.Context) (*promotion, error) {
+
+	hostname := we.server.hostname
+	p := new(promotion)
+
+	_, err := we.db.deleteStaleNodes(ctx, hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	recs, err := we.db.takeOverInstances(ctx, hostname)
+	if err != nil {
+		return nil, err
+	}
+	p.instances = len(recs)
+
+	timers, err := we.db.getTimers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	timed := make(map[string]bool)
+	for _, t := range timers {
+		owner := new(struct {
+			InstanceID string
+			InstanceId string
+		})
+		if json.Unmarshal(t.Data, owner) == nil {
+			timed[owner.InstanceID+owner.InstanceId] = true
+		}
+		we.restoreTimer(snapshotTimer{
+			Name:     t.Name,
+			Function: t.Function,
+			Time:     t.Time,
+			Data:     t.Data,
+		})
+	}
+	p.timers = len(timers)
+
+	// nothing would ever finish these instances if their timers went missing
+	for _, rec := range recs {
+		if timed[rec.InstanceID] || len(rec.Flow) == 0 {
+			continue
+		}
+		data, err := json.Marshal(&timeoutArgs{
+			InstanceId: rec.InstanceID,
+			Step:       len(rec.Flow),
+			Soft:       true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		we.restoreTimer(snapshotTimer{
+			Name:     fmt.Sprintf("timeout:%s:soft:%d", rec.InstanceID, len(rec.Flow)),
+			Function: timeoutFunction,
+			Time:     rec.Deadline,
+			Data:     data,
+		})
+	}
+
+	p.listeners, err = we.db.deleteOrphanedListeners(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("took over %d instances, rescheduled %d timers and removed %d orphaned event listeners",
+		p.instances, p.timers, p.listeners)
+
+	return p, nil
+
+}
@@ -0,0 +1,280 @@
+package direktiv
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/segmentio/ksuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// headers for invocations between clusters
+const (
+	DirektivCallbackHeader      = "Direktiv-Callback"
+	DirektivCallbackTokenHeader = "Direktiv-Callback-Token"
+	DirektivCallerDepthHeader   = "Direktiv-Caller-Depth"
+)
+
+const (
+	remoteInvokeTimeout = time.Second * 30
+
+	// times a finished instance tries to report to its remote caller
+	remoteCallbackAttempts = 5
+)
+
+// Actions can invoke workflows of namespaces in other direktiv clusters. The
+// remote namespaces are configured with the address of the other cluster's
+// api and the tokens both sides authenticate with. The calling cluster
+// executes the workflow through the remote api, passing the address of its own
+// action callback, and the remote instance reports its results there when it
+// finishes, the same way a local subflow wakes its caller. A cluster only
+// accepts invocations with a callback from clusters it has configured as
+// remote namespaces itself.
+
+func (we *workflowEngine) remoteNamespace(name string) *RemoteNamespace {
+
+	for i := range we.server.config.RemoteNamespaces {
+		rn := &we.server.config.RemoteNamespaces[i]
+		if rn.Name == name {
+			return rn
+		}
+	}
+
+	return nil
+
+}
+
+// remoteCaller returns the caller of an instance another cluster invokes with
+// a callback. The callback has to lead to the api of a configured remote
+// namespace and come with the token this cluster authenticates to that api
+// with, which only the other cluster knows. Anything else is refused, so the
+// api can't be used to make requests to arbitrary addresses or to spoof the
+// subflow depth of a caller. Results are reported with the configured token.
+func (we *workflowEngine) remoteCaller(callback, token string, depth int) (*subflowCaller, error) {
+
+	u, err := url.Parse(callback)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.User != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid callback '%s'", callback)
+	}
+
+	var rn *RemoteNamespace
+	for i := range we.server.config.RemoteNamespaces {
+		if remoteCallbackAllowed(u, &we.server.config.RemoteNamespaces[i]) {
+			rn = &we.server.config.RemoteNamespaces[i]
+			break
+		}
+	}
+
+	if rn == nil || rn.Token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(rn.Token)) != 1 {
+		return nil, status.Errorf(codes.PermissionDenied, "callback '%s' is not a configured remote cluster", callback)
+	}
+
+	if depth < 0 || depth > maxSubflowDepth {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid caller depth %d", depth)
+	}
+
+	return &subflowCaller{
+		Depth:         depth,
+		Callback:      callback,
+		CallbackToken: rn.Token,
+	}, nil
+
+}
+
+// remoteCallbackAllowed reports whether u is an action callback of the api of
+// rn.
+func remoteCallbackAllowed(u *url.URL, rn *RemoteNamespace) bool {
+
+	base, err := url.Parse(rn.URL)
+	if err != nil {
+		return false
+	}
+
+	prefix := strings.TrimSuffix(base.Path, "/") + "/api/instances/"
+
+	return u.Scheme == base.Scheme && strings.EqualFold(u.Host, base.Host) &&
+		strings.HasPrefix(path.Clean(u.Path), prefix)
+
+}
+
+// invokeWorkflowAction starts the workflow of an action and returns the id its
+// results are reported with.
+func (wli *workflowLogicInstance) invokeWorkflowAction(ctx context.Context, caller *subflowCaller, action *model.ActionDefinition, input []byte) (string, error) {
+
+	if wli.simulated() {
+		return wli.mockWorkflowAction(ctx, caller, action)
+	}
+
+	if action.Remote == "" {
+		return wli.engine.subflowInvoke(ctx, caller, wli.rec.InvokedBy, wli.namespace, action.Workflow, action.Timeout, input)
+	}
+
+	id, remoteID, err := wli.engine.remoteInvoke(ctx, caller, wli.rec.InvokedBy, action.Remote, action.Workflow, action.Timeout, input)
+	if err != nil {
+		return "", err
+	}
+
+	wli.Log("Workflow '%s' of remote namespace '%s' running as '%s'.", action.Workflow, action.Remote, remoteID)
+
+	return id, nil
+
+}
+
+// remoteInvoke executes a workflow of a remote namespace. It returns the action
+// id the remote cluster reports back with and the id of the remote instance.
+func (we *workflowEngine) remoteInvoke(ctx context.Context, caller *subflowCaller, callersCaller, remote, name, timeout string, input []byte) (string, string, error) {
+
+	rn := we.remoteNamespace(remote)
+	if rn == nil {
+		return "", "", NewUncatchableError("direktiv.remote.unknown", "remote namespace '%s' is not configured", remote)
+	}
+
+	base := strings.TrimSuffix(we.server.config.RemoteCallbackURL, "/")
+	if base == "" {
+		return "", "", NewUncatchableError("direktiv.remote.callback", "no remote callback url configured")
+	}
+
+	depth, err := subflowDepth(callersCaller)
+	if err != nil {
+		return "", "", err
+	}
+
+	id := ksuid.New().String()
+
+	ctx, cancel := context.WithTimeout(ctx, remoteInvokeTimeout)
+	defer cancel()
+
+	addr := fmt.Sprintf("%s/api/namespaces/%s/workflows/%s/execute", strings.TrimSuffix(rn.URL, "/"), rn.Namespace, name)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, addr, bytes.NewReader(input))
+	if err != nil {
+		return "", "", NewInternalError(err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if rn.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+rn.Token)
+	}
+
+	callback, err := we.actionCallbackURL(base, caller.InstanceID, id, caller.Step)
+	if err != nil {
+		return "", "", err
+	}
+
+	req.Header.Set(DirektivCallbackHeader, callback)
+	if rn.CallbackToken != "" {
+		req.Header.Set(DirektivCallbackTokenHeader, rn.CallbackToken)
+	}
+
+	req.Header.Set(DirektivCallerDepthHeader, strconv.Itoa(depth))
+	if timeout != "" {
+		req.Header.Set(DirektivTimeoutHeader, timeout)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", "", NewCatchableError("direktiv.remote.unreachable", "cannot reach remote namespace '%s': %v", remote, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxActionOutputSize))
+	if err != nil {
+		return "", "", NewCatchableError("direktiv.remote.unreachable", "cannot read response of remote namespace '%s': %v", remote, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		eo := new(struct{ Message string })
+		if json.Unmarshal(data, eo) != nil || eo.Message == "" {
+			eo.Message = http.StatusText(resp.StatusCode)
+		}
+		return "", "", NewCatchableError("direktiv.remote.invoke", "remote namespace '%s' refused to run '%s' (%d): %s", remote, name, resp.StatusCode, eo.Message)
+	}
+
+	out := new(struct {
+		InstanceID string `json:"instanceId"`
+	})
+	err = json.Unmarshal(data, out)
+	if err != nil {
+		return "", "", NewCatchableError("direktiv.remote.invoke", "invalid response from remote namespace '%s': %v", remote, err)
+	}
+
+	return id, out.InstanceID, nil
+
+}
+
+// reportToRemoteCaller delivers the results of an instance started by another
+// cluster to the callback it was given.
+func (we *workflowEngine) reportToRemoteCaller(id string, caller *subflowCaller, payload *actionResultPayload) {
+
+	var err error
+
+	for i := 0; i < remoteCallbackAttempts; i++ {
+
+		if i > 0 {
+			time.Sleep(time.Second << uint(i))
+		}
+
+		var retry bool
+		retry, err = postRemoteCallback(caller, payload)
+		if err == nil || !retry {
+			break
+		}
+
+		log.Debugf("retrying callback of %s: %v", id, err)
+
+	}
+
+	if err != nil {
+		log.Errorf("cannot report results of %s to remote caller: %v", id, err)
+	}
+
+}
+
+func postRemoteCallback(caller *subflowCaller, payload *actionResultPayload) (bool, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteInvokeTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, caller.Callback, bytes.NewReader(payload.Output))
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if caller.CallbackToken != "" {
+		req.Header.Set("Authorization", "Bearer "+caller.CallbackToken)
+	}
+
+	if payload.ErrorCode != "" || payload.ErrorMessage != "" {
+		req.Header.Set(DirektivErrorCodeHeader, payload.ErrorCode)
+		req.Header.Set(DirektivErrorMessageHeader, payload.ErrorMessage)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		return retry, fmt.Errorf("callback responded with status: %d", resp.StatusCode)
+	}
+
+	return false, nil
+
+}
@@ -73,6 +73,118 @@ func (fs *flowServer) ActionLog(ctx context.Context, in *flow.ActionLogRequest)
 
 }
 
+// ActionHeartbeat records an action's progress on its instance. If the state
+// expects heartbeats, its deadline is extended too.
+func (fs *flowServer) ActionHeartbeat(ctx context.Context, in *flow.ActionHeartbeatRequest) (*emptypb.Empty, error) {
+
+	var resp emptypb.Empty
+
+	ctx, wli, err := fs.engine.loadWorkflowLogicInstance(in.GetInstanceId(), int(in.GetStep()))
+	if err != nil {
+		return nil, err
+	}
+	defer wli.Close()
+
+	wf := wli.rec.Edges.Workflow
+
+	rec, err := wli.rec.Update().SetProgress(in.GetProgress()).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wli.rec = rec
+	wli.rec.Edges.Workflow = wf
+
+	hsl, ok := wli.logic.(heartbeatStateLogic)
+	if !ok {
+		return &resp, nil
+	}
+
+	deadline := hsl.HeartbeatDeadline(wli.engine.clock.Now())
+	if deadline.IsZero() {
+		return &resp, nil
+	}
+
+	state, exists := wli.wf.GetStatesMap()[wli.logic.ID()]
+	if !exists {
+		return nil, fmt.Errorf("workflow cannot resolve state: %s", wli.logic.ID())
+	}
+
+	err = wli.ExtendDeadline(ctx, state, deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+
+}
+
+func (fs *flowServer) ActionCancelled(ctx context.Context, in *flow.ActionCancelledRequest) (*emptypb.Empty, error) {
+
+	var resp emptypb.Empty
+
+	log.Debugf("action '%s' of instance '%s' confirmed cancellation", in.GetActionId(), in.GetInstanceId())
+
+	fs.engine.cancellations.confirm(in.GetActionId())
+
+	err := syncServer(ctx, fs.engine.db, &fs.engine.server.id, in.GetActionId(), ConfirmCancelIsolate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+
+}
+
+// ReportActionResultsStream accepts action results whose output is too large
+// for a single message. The first message carries the results and the output
+// is assembled from every message's chunk until totalSize bytes have arrived.
+func (fs *flowServer) ReportActionResultsStream(srv flow.DirektivFlow_ReportActionResultsStreamServer) error {
+
+	in, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+
+	totalSize := in.GetTotalSize()
+	if totalSize < 0 || totalSize > maxActionOutputSize {
+		return fmt.Errorf("action output of %d bytes exceeds the limit of %d bytes", totalSize, maxActionOutputSize)
+	}
+
+	buf := new(bytes.Buffer)
+	buf.Grow(int(totalSize))
+
+	for {
+		buf.Write(in.GetOutput())
+		if int64(buf.Len()) >= totalSize {
+			break
+		}
+		next, err := srv.Recv()
+		if err != nil {
+			return err
+		}
+		in.Output = next.GetOutput()
+	}
+
+	if int64(buf.Len()) != totalSize {
+		return fmt.Errorf("received %d bytes of action output, expected %d", buf.Len(), totalSize)
+	}
+
+	_, err = fs.ReportActionResults(srv.Context(), &flow.ReportActionResultsRequest{
+		InstanceId:   in.InstanceId,
+		Step:         in.Step,
+		ActionId:     in.ActionId,
+		ErrorCode:    in.ErrorCode,
+		ErrorMessage: in.ErrorMessage,
+		Output:       buf.Bytes(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return srv.SendAndClose(&emptypb.Empty{})
+
+}
+
 func (fs *flowServer) ReportActionResults(ctx context.Context, in *flow.ReportActionResultsRequest) (*emptypb.Empty, error) {
 
 	log.Debugf("action response: %v", in.GetActionId())
@@ -103,7 +215,15 @@ func (fs *flowServer) ReportActionResults(ctx context.Context, in *flow.ReportAc
 		return nil, err
 	}
 
-	go fs.engine.runState(ctx, wli, savedata, wakedata, nil)
+	fs.engine.submitState(wli, &runStateMessage{
+		InstanceID: wli.id,
+		State:      wli.logic.ID(),
+		Step:       wli.step,
+		Memory:     true,
+		Wakedata:   wakedata,
+	}, func() {
+		fs.engine.runState(ctx, wli, savedata, wakedata, nil)
+	})
 
 	return &resp, nil
 
@@ -118,14 +238,75 @@ func (fs *flowServer) Resume(ctx context.Context, in *flow.ResumeRequest) (*empt
 		return nil, err
 	}
 
-	go fs.engine.runState(ctx, wli, nil, nil, nil)
+	fs.engine.submitState(wli, &runStateMessage{
+		InstanceID: wli.id,
+		State:      wli.logic.ID(),
+		Step:       wli.step,
+	}, func() {
+		fs.engine.runState(ctx, wli, nil, nil, nil)
+	})
 
 	return &resp, nil
 
 }
 
+// grpcChunkSize is the most data sent in a single grpc message, well below the
+// default 4 MiB message limit.
 const grpcChunkSize = 2 * 1024 * 1024
 
+// maxActionOutputSize limits how much output an action can stream back.
+const maxActionOutputSize = 64 * 1024 * 1024
+
+// ReportActionResults sends action results to the flow server, streaming the
+// output in chunks if it is too large for a single message.
+func ReportActionResults(ctx context.Context, client flow.DirektivFlowClient, in *flow.ReportActionResultsRequest) error {
+
+	if len(in.Output) <= grpcChunkSize {
+		_, err := client.ReportActionResults(ctx, in)
+		return err
+	}
+
+	stream, err := client.ReportActionResultsStream(ctx)
+	if err != nil {
+		return err
+	}
+
+	totalSize := int64(len(in.Output))
+	chunkSize := int64(grpcChunkSize)
+
+	for written := int64(0); written < totalSize; written += chunkSize {
+
+		end := written + chunkSize
+		if end > totalSize {
+			end = totalSize
+		}
+
+		req := &flow.ReportActionResultsStreamRequest{
+			Output:    in.Output[written:end],
+			TotalSize: &totalSize,
+			ChunkSize: &chunkSize,
+		}
+
+		if written == 0 {
+			req.InstanceId = in.InstanceId
+			req.Step = in.Step
+			req.ActionId = in.ActionId
+			req.ErrorCode = in.ErrorCode
+			req.ErrorMessage = in.ErrorMessage
+		}
+
+		err = stream.Send(req)
+		if err != nil {
+			return err
+		}
+
+	}
+
+	_, err = stream.CloseAndRecv()
+	return err
+
+}
+
 func (fs *flowServer) GetNamespaceVariable(in *flow.GetNamespaceVariableRequest, out flow.DirektivFlow_GetNamespaceVariableServer) error {
 
 	ctx := out.Context()
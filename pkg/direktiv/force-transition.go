@@ -0,0 +1,97 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Operators can move a stuck instance on to any state of its workflow, with
+// new state data if they give any. Whatever the instance's current state was
+// waiting for is abandoned: its actions and subflows are cancelled and its
+// event listeners removed. The state is completed with forcedTransitionCode
+// in the metrics, and the intervention is audited.
+
+const forcedTransitionCode = "direktiv.forced"
+
+func (we *workflowEngine) forceTransition(ctx context.Context, id, state string, data []byte, reason string) error {
+
+	if state == "" {
+		return grpc.Errorf(codes.InvalidArgument, "state is required")
+	}
+
+	if reason == "" {
+		return grpc.Errorf(codes.InvalidArgument, "a reason for the transition is required")
+	}
+
+	var stateData map[string]interface{}
+	if len(data) > 0 {
+		err := json.Unmarshal(data, &stateData)
+		if err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "state data must be a json object: %v", err)
+		}
+	}
+
+	rec, err := we.db.getWorkflowInstance(ctx, id)
+	if err != nil {
+		return grpcDatabaseError(err, "instance", id)
+	}
+
+	if rec.Status != "pending" && rec.Status != "running" {
+		return grpc.Errorf(codes.FailedPrecondition, "instance '%s' has already finished", id)
+	}
+
+	if len(rec.Flow) == 0 {
+		return grpc.Errorf(codes.FailedPrecondition, "instance '%s' hasn't started yet", id)
+	}
+
+	lctx, wli, err := we.loadWorkflowLogicInstance(id, -1)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := wli.wf.GetStatesMap()[state]; !ok {
+		wli.Close()
+		return grpc.Errorf(codes.InvalidArgument, "workflow has no state '%s'", state)
+	}
+
+	savedata, err := InstanceMemory(wli.rec)
+	if err != nil {
+		wli.Close()
+		return err
+	}
+
+	from := wli.logic.ID()
+
+	isolates := we.cancelChildren(wli.logic, savedata)
+	we.clearEventListeners(wli.rec)
+
+	if stateData != nil {
+		wli.data = stateData
+	}
+
+	wli.audit("forceTransition", reason, log.Fields{
+		"from": from,
+		"to":   state,
+		"data": stateData != nil,
+	}, "Forced from state %s to %s", from, state)
+
+	if stateData != nil {
+		wli.Log("State data replaced by an operator.")
+	}
+
+	// the instance stays locked while its isolates clean up, but neither the
+	// request nor a work queue slot waits for them
+	go func() {
+		we.cancellations.wait(isolates)
+		we.queue.submit(func() {
+			we.transitionState(lctx, wli, &stateTransition{NextState: state}, forcedTransitionCode)
+		})
+	}()
+
+	return nil
+
+}
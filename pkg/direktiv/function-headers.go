@@ -0,0 +1,110 @@
+package direktiv
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/jqer"
+	"github.com/vorteil/direktiv/pkg/model"
+)
+
+// The headers of service functions can be jq queries, evaluated against the
+// same data as the input of each action, and only those queries can read
+// secrets, by calling secret("name"). Secrets are looked up when the action
+// runs and held only in memory for its request, never in the instance or its
+// logs, and every read is audited in the namespace and server logs.
+
+// httpDefinition returns the http options of a function for an action, with
+// its headers evaluated against data in the scope of the action's input.
+func (wli *workflowLogicInstance) httpDefinition(ctx context.Context, data interface{}, fn *model.FunctionDefinition, input *jqer.Scope) (*model.HTTPDefinition, error) {
+
+	if fn.HTTP == nil || len(fn.HTTP.Headers) == 0 {
+		return fn.HTTP, nil
+	}
+
+	secrets := make(map[string]string)
+	var serr error
+
+	scope := *input
+	scope.Functions = []jqer.Function{{
+		Name:     "secret",
+		MinArity: 1,
+		MaxArity: 1,
+		Call: func(_ interface{}, args []interface{}) interface{} {
+
+			name, ok := args[0].(string)
+			if !ok {
+				return errors.New("secret: name must be a string")
+			}
+
+			value, err := wli.readSecret(ctx, name, fn.ID)
+			if err != nil {
+				serr = err
+				return err
+			}
+
+			secrets[name] = value
+
+			return value
+
+		},
+	}}
+
+	names := make([]string, 0, len(fn.HTTP.Headers))
+	for k := range fn.HTTP.Headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	opts := *fn.HTTP
+	opts.Headers = make(map[string]string, len(names))
+
+	for _, k := range names {
+
+		x, err := jqOne(data, fn.HTTP.Headers[k], &scope)
+		if serr != nil {
+			return nil, serr
+		}
+		if err != nil {
+			// failed queries can quote what secret returned
+			if cerr, ok := err.(*CatchableError); ok {
+				cerr.Message = redactSecrets(cerr.Message, secrets)
+			}
+			return nil, err
+		}
+
+		s, ok := x.(string)
+		if !ok {
+			return nil, NewCatchableError(ErrCodeJQBadQuery, "header '%s' of function '%s' is not a string", k, fn.ID)
+		}
+
+		opts.Headers[k] = s
+
+	}
+
+	return &opts, nil
+
+}
+
+// readSecret returns a secret for a header of a function and audits the read.
+func (wli *workflowLogicInstance) readSecret(ctx context.Context, name, function string) (string, error) {
+
+	data, err := wli.engine.namespaceSecret(ctx, wli.namespace, name)
+	if err != nil {
+		return "", err
+	}
+
+	wli.engine.namespaceAudit(wli.namespace, "readSecret", log.Fields{
+		"instance": wli.id,
+		"state":    wli.logic.ID(),
+		"function": function,
+		"secret":   name,
+	}, "Instance '%s' read secret '%s' for the headers of function '%s'", wli.id, name, function)
+
+	wli.Log("Read secret '%s' for the headers of function '%s'.", name, function)
+
+	return string(data), nil
+
+}
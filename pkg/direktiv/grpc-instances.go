@@ -2,17 +2,66 @@ package direktiv
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/pkg/flow"
 	"github.com/vorteil/direktiv/pkg/ingress"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// resolveInstanceID accepts either an instance id or one where the random
+// suffix is replaced by the external id supplied when the instance was invoked.
+func (is *ingressServer) resolveInstanceID(ctx context.Context, id string) string {
+
+	elems := strings.SplitN(id, "/", 3)
+	if len(elems) != 3 {
+		return id
+	}
+
+	_, err := is.wfServer.dbManager.getWorkflowInstance(ctx, id)
+	if err == nil || !ent.IsNotFound(err) {
+		return id
+	}
+
+	inst, err := is.wfServer.dbManager.getWorkflowInstanceByExternalID(ctx, elems[0], elems[1], elems[2])
+	if err != nil {
+		return id
+	}
+
+	return inst.InstanceID
+
+}
+
 func (is *ingressServer) CancelWorkflowInstance(ctx context.Context, in *ingress.CancelWorkflowInstanceRequest) (*emptypb.Empty, error) {
 
-	err := is.wfServer.engine.hardCancelInstance(in.GetId(), "direktiv.cancels.api", "cancelled by api request")
+	id := is.resolveInstanceID(ctx, in.GetId())
+
+	category := in.GetCategory()
+	if category == "" {
+		category = "api"
+	}
+
+	if !cancelCategoryRegex.MatchString(category) {
+		return nil, status.Errorf(codes.InvalidArgument, "cancel category must match regex: %s", cancelCategoryRegex)
+	}
+
+	if reservedCancelCategories[category] {
+		return nil, status.Errorf(codes.InvalidArgument, "cancel category '%s' is reserved", category)
+	}
+
+	reason := in.GetReason()
+	if reason == "" {
+		reason = "cancelled by api request"
+	}
+
+	err := is.wfServer.engine.hardCancelInstance(id, cancelCodePrefix+category, reason)
 	if err != nil {
 		log.Errorf("error cancelling instance: %v", err)
 	}
@@ -20,11 +69,39 @@ func (is *ingressServer) CancelWorkflowInstance(ctx context.Context, in *ingress
 
 }
 
+// ReportActionCallback accepts the results of an action that calls back when it
+// finishes, such as a lambda function invoked asynchronously.
+func (is *ingressServer) ReportActionCallback(ctx context.Context, in *ingress.ReportActionCallbackRequest) (*emptypb.Empty, error) {
+
+	if in.GetInstanceId() == "" || in.GetActionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "instance and action required")
+	}
+
+	if !is.wfServer.engine.verifyActionCallback(in.GetInstanceId(), in.GetActionId(), int(in.GetStep()), in.GetToken()) {
+		return nil, status.Error(codes.PermissionDenied, "invalid callback token")
+	}
+
+	err := ReportActionResults(ctx, is.wfServer.engine.flowClient, &flow.ReportActionResultsRequest{
+		InstanceId:   in.InstanceId,
+		Step:         in.Step,
+		ActionId:     in.ActionId,
+		ErrorCode:    in.ErrorCode,
+		ErrorMessage: in.ErrorMessage,
+		Output:       in.Output,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+
+}
+
 func (is *ingressServer) GetWorkflowInstance(ctx context.Context, in *ingress.GetWorkflowInstanceRequest) (*ingress.GetWorkflowInstanceResponse, error) {
 
 	var resp ingress.GetWorkflowInstanceResponse
 
-	id := in.GetId()
+	id := is.resolveInstanceID(ctx, in.GetId())
 
 	inst, err := is.wfServer.dbManager.getWorkflowInstance(ctx, id)
 	if err != nil {
@@ -57,6 +134,231 @@ func (is *ingressServer) GetWorkflowInstance(ctx context.Context, in *ingress.Ge
 
 	resp.ErrorCode = &inst.ErrorCode
 	resp.ErrorMessage = &inst.ErrorMessage
+	for i := range inst.ErrorCauses {
+		cause := inst.ErrorCauses[i]
+		resp.ErrorCauses = append(resp.ErrorCauses, &ingress.GetWorkflowInstanceResponse_ErrorCause{
+			Code:    &cause.Code,
+			Message: &cause.Message,
+		})
+	}
+	resp.ExternalId = inst.ExternalID
+	resp.CancelCategory = &inst.CancelCategory
+	resp.CancelReason = &inst.CancelReason
+	resp.Progress = &inst.Progress
+	resp.Profile = &inst.Profile
+
+	if inst.ErrorData != "" {
+		resp.ErrorData = []byte(inst.ErrorData)
+	}
+
+	dbg, err := loadDebugger(inst)
+	if err != nil {
+		return nil, err
+	}
+
+	paused := dbg.paused(inst)
+	resp.Breakpoints = dbg.Breakpoints
+	resp.Paused = &paused
+	resp.Hold = &inst.Hold
+	resp.DebugTransforms = &dbg.Transforms
+	resp.Verbose = &dbg.Verbose
+
+	return &resp, nil
+
+}
+
+func (is *ingressServer) QueryWorkflowInstance(ctx context.Context, in *ingress.QueryWorkflowInstanceRequest) (*ingress.QueryWorkflowInstanceResponse, error) {
+
+	var resp ingress.QueryWorkflowInstanceResponse
+
+	id := is.resolveInstanceID(ctx, in.GetId())
+
+	inst, err := is.wfServer.dbManager.getWorkflowInstance(ctx, id)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "instance", id)
+	}
+
+	// instances that haven't completed a state yet only have their input
+	data := inst.StateData
+	if data == "" {
+		data = inst.Input
+	}
+
+	var x interface{}
+	err = json.Unmarshal([]byte(data), &x)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "instance", id)
+	}
+
+	out, err := jq(x, in.GetQuery(), nil)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	output, err := json.Marshal(out)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "instance", id)
+	}
+
+	resp.Id = &id
+	resp.Output = output
+
+	return &resp, nil
+
+}
+
+// ExportWorkflowInstance snapshots a running instance into a portable document
+// that ImportWorkflowInstance accepts, possibly on another installation.
+func (is *ingressServer) ExportWorkflowInstance(ctx context.Context, in *ingress.ExportWorkflowInstanceRequest) (*ingress.ExportWorkflowInstanceResponse, error) {
+
+	var resp ingress.ExportWorkflowInstanceResponse
+
+	id := is.resolveInstanceID(ctx, in.GetId())
+
+	snapshot, err := is.wfServer.engine.exportInstance(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Id = &id
+	resp.Snapshot = snapshot
+
+	return &resp, nil
+
+}
+
+// GetWorkflowInstanceHistory returns the recorded history of an instance for
+// the replay debugger.
+func (is *ingressServer) GetWorkflowInstanceHistory(ctx context.Context, in *ingress.GetWorkflowInstanceHistoryRequest) (*ingress.GetWorkflowInstanceHistoryResponse, error) {
+
+	var resp ingress.GetWorkflowInstanceHistoryResponse
+
+	id := is.resolveInstanceID(ctx, in.GetId())
+
+	history, err := is.wfServer.engine.instanceHistory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Id = &id
+	resp.History = history
+
+	return &resp, nil
+
+}
+
+// SetWorkflowInstanceBreakpoints replaces the states a running instance pauses
+// before.
+func (is *ingressServer) SetWorkflowInstanceBreakpoints(ctx context.Context, in *ingress.SetWorkflowInstanceBreakpointsRequest) (*emptypb.Empty, error) {
+
+	id := is.resolveInstanceID(ctx, in.GetId())
+
+	err := is.wfServer.engine.setBreakpoints(ctx, id, in.GetBreakpoints())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+
+}
+
+// SetWorkflowInstanceDebug turns debugging the transforms of a running
+// instance on or off.
+func (is *ingressServer) SetWorkflowInstanceDebug(ctx context.Context, in *ingress.SetWorkflowInstanceDebugRequest) (*emptypb.Empty, error) {
+
+	id := is.resolveInstanceID(ctx, in.GetId())
+
+	err := is.wfServer.engine.setTransformDebug(ctx, id, in.GetTransforms())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+
+}
+
+// ForceWorkflowInstanceTransition moves a stuck instance to another state.
+func (is *ingressServer) ForceWorkflowInstanceTransition(ctx context.Context, in *ingress.ForceWorkflowInstanceTransitionRequest) (*emptypb.Empty, error) {
+
+	id := is.resolveInstanceID(ctx, in.GetId())
+
+	err := is.wfServer.engine.forceTransition(ctx, id, in.GetState(), in.GetData(), in.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+
+}
+
+// PatchWorkflowInstanceData applies a jq query to the state data of a pending
+// instance.
+func (is *ingressServer) PatchWorkflowInstanceData(ctx context.Context, in *ingress.PatchWorkflowInstanceDataRequest) (*ingress.PatchWorkflowInstanceDataResponse, error) {
+
+	id := is.resolveInstanceID(ctx, in.GetId())
+
+	data, err := is.wfServer.engine.patchStateData(ctx, id, in.GetQuery(), in.GetReason(), in.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+
+	return &ingress.PatchWorkflowInstanceDataResponse{
+		Data: data,
+	}, nil
+
+}
+
+// BreakWorkflowInstanceLock breaks the lock of an instance held by a node
+// that is gone, and runs its current state again.
+func (is *ingressServer) BreakWorkflowInstanceLock(ctx context.Context, in *ingress.BreakWorkflowInstanceLockRequest) (*ingress.BreakWorkflowInstanceLockResponse, error) {
+
+	id := is.resolveInstanceID(ctx, in.GetId())
+
+	node, pid, err := is.wfServer.engine.breakInstanceLock(ctx, id, in.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	pid32 := int32(pid)
+
+	return &ingress.BreakWorkflowInstanceLockResponse{
+		Node: &node,
+		Pid:  &pid32,
+	}, nil
+
+}
+
+// ResumeWorkflowInstance continues, steps, skips or aborts an instance paused
+// at a breakpoint.
+func (is *ingressServer) ResumeWorkflowInstance(ctx context.Context, in *ingress.ResumeWorkflowInstanceRequest) (*emptypb.Empty, error) {
+
+	id := is.resolveInstanceID(ctx, in.GetId())
+
+	err := is.wfServer.engine.resumeInstance(ctx, id, in.GetAction(), in.GetData(), in.GetNext())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+
+}
+
+// ImportWorkflowInstance resumes a snapshotted instance as a new instance of the
+// namespace's workflow of the same name.
+func (is *ingressServer) ImportWorkflowInstance(ctx context.Context, in *ingress.ImportWorkflowInstanceRequest) (*ingress.ImportWorkflowInstanceResponse, error) {
+
+	var resp ingress.ImportWorkflowInstanceResponse
+
+	if in.GetNamespace() == "" || len(in.GetSnapshot()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "namespace and snapshot required")
+	}
+
+	id, err := is.wfServer.engine.importInstance(ctx, in.GetNamespace(), in.GetSnapshot())
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Id = &id
 
 	return &resp, nil
 
@@ -66,7 +368,7 @@ func (is *ingressServer) GetWorkflowInstanceLogs(ctx context.Context, in *ingres
 
 	var resp ingress.GetWorkflowInstanceLogsResponse
 
-	instance := in.GetInstanceId()
+	instance := is.resolveInstanceID(ctx, in.GetInstanceId())
 	offset := in.GetOffset()
 	limit := in.GetLimit()
 
@@ -136,8 +438,18 @@ func (is *ingressServer) GetWorkflowInstances(ctx context.Context, in *ingress.G
 	namespace := in.GetNamespace()
 	offset := in.GetOffset()
 	limit := in.GetLimit()
+	stateData := in.GetStateData()
+	output := in.GetOutput()
 
-	instances, err := is.wfServer.dbManager.getWorkflowInstances(ctx, namespace, int(offset), int(limit))
+	if stateData != "" && !json.Valid([]byte(stateData)) {
+		return nil, status.Errorf(codes.InvalidArgument, "state data filter is not valid json")
+	}
+
+	if output != "" && !json.Valid([]byte(output)) {
+		return nil, status.Errorf(codes.InvalidArgument, "output filter is not valid json")
+	}
+
+	instances, err := is.wfServer.dbManager.getWorkflowInstances(ctx, namespace, int(offset), int(limit), stateData, output)
 	if err != nil {
 		return nil, grpcDatabaseError(err, "namespace", "")
 	}
@@ -158,3 +470,135 @@ func (is *ingressServer) GetWorkflowInstances(ctx context.Context, in *ingress.G
 	return &resp, nil
 
 }
+
+// instances and log lines read per query of an export
+const exportPageSize = 100
+
+// ExportInstances streams the instances of a namespace matching the filters
+// of the request, oldest first, with their logs if requested.
+func (is *ingressServer) ExportInstances(in *ingress.ExportInstancesRequest, out ingress.DirektivIngress_ExportInstancesServer) error {
+
+	ctx := out.Context()
+
+	namespace := in.GetNamespace()
+	if namespace == "" {
+		return status.Errorf(codes.InvalidArgument, "required namespace")
+	}
+
+	var after, before time.Time
+	if in.After != nil {
+		after = in.After.AsTime()
+	}
+	if in.Before != nil {
+		before = in.Before.AsTime()
+	}
+
+	for offset := 0; ; offset += exportPageSize {
+
+		instances, err := is.wfServer.dbManager.exportWorkflowInstances(ctx, namespace, in.GetWorkflow(),
+			in.GetStatus(), after, before, offset, exportPageSize)
+		if err != nil {
+			return grpcDatabaseError(err, "namespace", namespace)
+		}
+
+		for _, inst := range instances {
+
+			resp, err := is.exportInstance(ctx, inst, in.GetLogs())
+			if err != nil {
+				return err
+			}
+
+			err = out.Send(resp)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if len(instances) < exportPageSize {
+			return nil
+		}
+
+	}
+
+}
+
+func (is *ingressServer) exportInstance(ctx context.Context, inst *ent.WorkflowInstance, logs bool) (*ingress.ExportInstancesResponse, error) {
+
+	rev := int32(inst.Revision)
+
+	resp := &ingress.ExportInstancesResponse{
+		Id:           &inst.InstanceID,
+		Revision:     &rev,
+		Status:       &inst.Status,
+		InvokedBy:    &inst.InvokedBy,
+		BeginTime:    timestamppb.New(inst.BeginTime),
+		Flow:         inst.Flow,
+		Input:        []byte(inst.Input),
+		Output:       []byte(inst.Output),
+		ErrorCode:    &inst.ErrorCode,
+		ErrorMessage: &inst.ErrorMessage,
+	}
+
+	if wf := inst.Edges.Workflow; wf != nil {
+		resp.Workflow = &wf.Name
+	}
+
+	if !inst.EndTime.IsZero() {
+		resp.EndTime = timestamppb.New(inst.EndTime)
+	}
+
+	if !logs {
+		return resp, nil
+	}
+
+	for offset := 0; ; offset += exportPageSize {
+
+		page, err := is.wfServer.instanceLogger.QueryLogs(ctx, inst.InstanceID, exportPageSize, offset)
+		if err != nil {
+			return nil, grpcDatabaseError(err, "instance", inst.InstanceID)
+		}
+
+		for i := range page.Logs {
+			l := &page.Logs[i]
+			resp.Logs = append(resp.Logs, &ingress.ExportInstancesResponse_Log{
+				Level:     &l.Level,
+				Timestamp: timestamppb.New(time.Unix(0, l.Timestamp)),
+				Message:   &l.Message,
+				Context:   l.Context,
+			})
+		}
+
+		if len(page.Logs) < exportPageSize {
+			return resp, nil
+		}
+
+	}
+
+}
+
+func (is *ingressServer) PurgeInstances(ctx context.Context, in *ingress.PurgeInstancesRequest) (*ingress.PurgeInstancesResponse, error) {
+	return is.wfServer.purgeInstances(ctx, in)
+}
+
+func (is *ingressServer) SetLegalHold(ctx context.Context, in *ingress.SetLegalHoldRequest) (*emptypb.Empty, error) {
+
+	err := is.wfServer.setLegalHold(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+
+}
+
+func (is *ingressServer) SetCronSuspension(ctx context.Context, in *ingress.SetCronSuspensionRequest) (*emptypb.Empty, error) {
+
+	err := is.wfServer.setCronSuspension(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+
+}
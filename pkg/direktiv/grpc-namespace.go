@@ -2,13 +2,17 @@ package direktiv
 
 import (
 	"context"
+	"encoding/json"
 	"regexp"
+	"sort"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/vorteil/direktiv/pkg/ingress"
+	"github.com/vorteil/direktiv/pkg/metrics"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -30,15 +34,58 @@ func (is *ingressServer) AddNamespace(ctx context.Context, in *ingress.AddNamesp
 		return nil, status.Errorf(codes.InvalidArgument, "namespace name must match regex: %s", regex)
 	}
 
-	namespace, err := is.wfServer.dbManager.addNamespace(ctx, name)
+	// only logs, variables and traces are routed to a region so far, the
+	// input, state data, output, memory, histories and queued work of the
+	// instances of a pinned namespace would still leave it
+	region := in.GetRegion()
+	if region != "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "namespaces can't be pinned to storage regions yet, the data of their instances would be stored outside of the region")
+	}
+
+	parent := in.GetParent()
+	if parent != "" {
+
+		p, err := is.wfServer.dbManager.getNamespace(parent)
+		if err != nil {
+			return nil, grpcDatabaseError(err, "namespace", parent)
+		}
+
+		ancestors, err := is.wfServer.dbManager.namespaceAncestors(ctx, parent)
+		if err != nil {
+			return nil, grpcDatabaseError(err, "namespace", parent)
+		}
+
+		if len(ancestors)+2 > maxNamespaceDepth {
+			return nil, status.Errorf(codes.InvalidArgument, "namespaces can't be nested more than %d deep", maxNamespaceDepth)
+		}
+
+		// the data of a child stays where the parent's has to be
+		if region == "" {
+			region = p.Region
+		} else if p.Region != "" && region != p.Region {
+			return nil, status.Errorf(codes.InvalidArgument, "namespace must be in the storage region '%s' of its parent", p.Region)
+		}
+
+	}
+
+	namespace, err := is.wfServer.dbManager.addNamespace(ctx, name, region, parent)
 	if err != nil {
 		return nil, grpcDatabaseError(err, "namespace", name)
 	}
 
+	if parent != "" {
+		err = is.syncRegistries(ctx, name)
+		if err != nil {
+			log.Errorf("cannot attach inherited registries to namespace %s: %v", name, err)
+		}
+	}
+
 	log.Debugf("Added namespace: %v", name)
 
 	resp.Name = &name
 	resp.CreatedAt = timestamppb.New(namespace.Created)
+	resp.Region = &namespace.Region
+	resp.Parent = &parent
 
 	return &resp, nil
 
@@ -95,6 +142,45 @@ func (is *ingressServer) GetNamespaceLogs(ctx context.Context, in *ingress.GetNa
 
 }
 
+// GetNamespaceActions reports the namespace's isolate actions: how many are
+// in flight and how many are queued behind the namespace cap. With a cap the
+// counts are those of every flow server as of their latest samples, and the
+// limit applies to each server. Without one only this server's are known.
+func (is *ingressServer) GetNamespaceActions(ctx context.Context, in *ingress.GetNamespaceActionsRequest) (*ingress.GetNamespaceActionsResponse, error) {
+
+	var resp ingress.GetNamespaceActionsResponse
+
+	namespace := in.GetNamespace()
+
+	_, err := is.wfServer.dbManager.getNamespace(namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	active, queued := is.wfServer.engine.actions.stats(namespace)
+
+	if is.wfServer.engine.actions.max > 0 {
+		counts, err := is.wfServer.engine.namespaceActionCounts(ctx, namespace)
+		if err != nil {
+			log.Errorf("cannot get action counts of namespace %s: %v", namespace, err)
+			return nil, grpcErrInternal
+		}
+		active, queued = counts.Active, counts.Queued
+	}
+
+	a := int32(active)
+	q := int32(queued)
+	l := int32(is.wfServer.engine.actions.max)
+
+	resp.Namespace = &namespace
+	resp.Active = &a
+	resp.Queued = &q
+	resp.Limit = &l
+
+	return &resp, nil
+
+}
+
 func (is *ingressServer) GetNamespaces(ctx context.Context, in *ingress.GetNamespacesRequest) (*ingress.GetNamespacesResponse, error) {
 
 	var resp ingress.GetNamespacesResponse
@@ -113,14 +199,568 @@ func (is *ingressServer) GetNamespaces(ctx context.Context, in *ingress.GetNames
 
 		name := namespace.ID
 		createdAt := namespace.Created
+		hold := namespace.Hold
+		region := namespace.Region
+		suspension := namespace.CronSuspension
+
+		var parent string
+		if namespace.Edges.Parent != nil {
+			parent = namespace.Edges.Parent.ID
+		}
 
 		resp.Namespaces = append(resp.Namespaces, &ingress.GetNamespacesResponse_Namespace{
-			Name:      &name,
-			CreatedAt: timestamppb.New(createdAt),
+			Name:           &name,
+			CreatedAt:      timestamppb.New(createdAt),
+			Hold:           &hold,
+			Region:         &region,
+			Parent:         &parent,
+			CronSuspension: &suspension,
+		})
+
+	}
+
+	return &resp, nil
+
+}
+
+// GetDeadlineUsage reports how close the recent states of a namespace came
+// to their deadlines. Slipping is whether the share of states near their
+// deadline is over the alert threshold now.
+func (is *ingressServer) GetDeadlineUsage(ctx context.Context, in *ingress.GetDeadlineUsageRequest) (*ingress.GetDeadlineUsageResponse, error) {
+
+	namespace := in.GetNamespace()
+
+	_, err := is.wfServer.dbManager.getNamespace(namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	usages, err := is.wfServer.engine.deadlineUsage(ctx, namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot query deadline usage: %v", err)
+	}
+
+	du := &metrics.DeadlineUsage{}
+	if len(usages) > 0 {
+		du = usages[0]
+	}
+
+	window := int32(deadlineWindow.Seconds())
+	near := deadlineNearUsage
+	samples := int32(du.Samples)
+	nearCount := int32(du.Near)
+	timedOut := int32(du.TimedOut)
+
+	threshold := is.wfServer.config.DeadlineAlertPercent
+	slipping := threshold > 0 && du.Samples >= deadlineMinSamples && du.NearShare() >= float64(threshold)
+
+	return &ingress.GetDeadlineUsageResponse{
+		Window:    &window,
+		NearUsage: &near,
+		Samples:   &samples,
+		Near:      &nearCount,
+		TimedOut:  &timedOut,
+		MeanUsage: &du.MeanUsage,
+		P95Usage:  &du.P95Usage,
+		Slipping:  &slipping,
+	}, nil
+
+}
+
+// GetNamespaceEventConfig returns a namespace's own event config, without
+// what it might inherit.
+func (is *ingressServer) GetNamespaceEventConfig(ctx context.Context, in *ingress.GetNamespaceEventConfigRequest) (*ingress.GetNamespaceEventConfigResponse, error) {
+
+	var resp ingress.GetNamespaceEventConfigResponse
+
+	namespace := in.GetNamespace()
+
+	conf, err := is.wfServer.dbManager.namespaceEventConfig(ctx, namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	resp.Namespace = &namespace
+	resp.InboundTypes = conf.Inbound.Types
+
+	for i := range conf.Sinks {
+		sink := &conf.Sinks[i]
+		resp.Sinks = append(resp.Sinks, &ingress.NamespaceEventSink{
+			Url:        &sink.URL,
+			Headers:    sink.Headers,
+			AuthSecret: &sink.AuthSecret,
+			Types:      sink.Types,
+		})
+	}
+
+	return &resp, nil
+
+}
+
+// SetNamespaceEventConfig replaces the event config of a namespace. An empty
+// one removes it, and the namespace inherits its parent's again.
+func (is *ingressServer) SetNamespaceEventConfig(ctx context.Context, in *ingress.SetNamespaceEventConfigRequest) (*emptypb.Empty, error) {
+
+	namespace := in.GetNamespace()
+
+	conf := new(namespaceEventConfig)
+	conf.Inbound.Types = in.GetInboundTypes()
+
+	for _, sink := range in.GetSinks() {
+		conf.Sinks = append(conf.Sinks, eventSink{
+			URL:        sink.GetUrl(),
+			Headers:    sink.GetHeaders(),
+			AuthSecret: sink.GetAuthSecret(),
+			Types:      sink.GetTypes(),
+		})
+	}
+
+	err := conf.validate()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid event config: %v", err)
+	}
+
+	for i, sink := range conf.Sinks {
+		err = is.wfServer.config.checkURL(namespace, sink.URL)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event config: sink %d: %v", i, err)
+		}
+	}
+
+	var data []byte
+	if len(conf.Inbound.Types) > 0 || len(conf.Sinks) > 0 {
+		data, err = json.Marshal(conf)
+		if err != nil {
+			return nil, NewInternalError(err)
+		}
+	}
+
+	err = is.wfServer.dbManager.setNamespaceEventConfig(ctx, namespace, data)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	log.Debugf("event config of namespace '%s' updated", namespace)
+
+	return &emptypb.Empty{}, nil
+
+}
+
+// GetNamespaceConstants returns the constants a namespace overrides itself,
+// without those of its ancestors.
+func (is *ingressServer) GetNamespaceConstants(ctx context.Context, in *ingress.GetNamespaceConstantsRequest) (*ingress.GetNamespaceConstantsResponse, error) {
+
+	var resp ingress.GetNamespaceConstantsResponse
+
+	namespace := in.GetNamespace()
+
+	data, err := is.wfServer.dbManager.getNamespaceConstants(ctx, namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	resp.Namespace = &namespace
+	resp.Constants = data
+
+	return &resp, nil
+
+}
+
+// SetNamespaceConstants replaces the constants a namespace overrides. An
+// empty object removes them.
+func (is *ingressServer) SetNamespaceConstants(ctx context.Context, in *ingress.SetNamespaceConstantsRequest) (*emptypb.Empty, error) {
+
+	namespace := in.GetNamespace()
+
+	err := is.wfServer.engine.setNamespaceConstants(ctx, namespace, in.GetConstants())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("constants of namespace '%s' updated", namespace)
+
+	return &emptypb.Empty{}, nil
+
+}
+
+// GetNamespaceRetryPolicies returns the retry policies a namespace defines
+// itself, without those of its ancestors.
+func (is *ingressServer) GetNamespaceRetryPolicies(ctx context.Context, in *ingress.GetNamespaceRetryPoliciesRequest) (*ingress.GetNamespaceRetryPoliciesResponse, error) {
+
+	var resp ingress.GetNamespaceRetryPoliciesResponse
+
+	namespace := in.GetNamespace()
+
+	data, err := is.wfServer.dbManager.getNamespaceRetryPolicies(ctx, namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	resp.Namespace = &namespace
+	resp.Policies = data
+
+	return &resp, nil
+
+}
+
+// SetNamespaceRetryPolicies replaces the retry policies a namespace defines.
+// An empty object removes them.
+func (is *ingressServer) SetNamespaceRetryPolicies(ctx context.Context, in *ingress.SetNamespaceRetryPoliciesRequest) (*emptypb.Empty, error) {
+
+	namespace := in.GetNamespace()
+
+	err := is.wfServer.engine.setNamespaceRetryPolicies(ctx, namespace, in.GetPolicies())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("retry policies of namespace '%s' updated", namespace)
+
+	return &emptypb.Empty{}, nil
+
+}
+
+// GetNamespaceProfiles returns the profiles a namespace defines itself, and
+// the profile its new instances use.
+func (is *ingressServer) GetNamespaceProfiles(ctx context.Context, in *ingress.GetNamespaceProfilesRequest) (*ingress.GetNamespaceProfilesResponse, error) {
+
+	var resp ingress.GetNamespaceProfilesResponse
+
+	namespace := in.GetNamespace()
+
+	profiles, active, err := is.wfServer.dbManager.namespaceProfiles(ctx, namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	inherited, err := is.wfServer.dbManager.resolveProfile(ctx, namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i := range names {
+		data, err := json.Marshal(profiles[names[i]])
+		if err != nil {
+			return nil, NewInternalError(err)
+		}
+		resp.Profiles = append(resp.Profiles, &ingress.GetNamespaceProfilesResponse_Profile{
+			Name:      &names[i],
+			Constants: data,
+		})
+	}
+
+	resp.Namespace = &namespace
+	resp.Active = &active
+	resp.Inherited = &inherited
+
+	return &resp, nil
+
+}
+
+// SetNamespaceProfile replaces the constants of a profile of a namespace. An
+// empty object deletes the profile.
+func (is *ingressServer) SetNamespaceProfile(ctx context.Context, in *ingress.SetNamespaceProfileRequest) (*emptypb.Empty, error) {
+
+	namespace := in.GetNamespace()
+	name := in.GetName()
+
+	err := is.wfServer.engine.setNamespaceProfile(ctx, namespace, name, in.GetConstants())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("profile '%s' of namespace '%s' updated", name, namespace)
+
+	return &emptypb.Empty{}, nil
+
+}
+
+// SetNamespaceActiveProfile selects the profile new instances of a namespace
+// use. An empty profile inherits it from the namespace's ancestors.
+func (is *ingressServer) SetNamespaceActiveProfile(ctx context.Context, in *ingress.SetNamespaceActiveProfileRequest) (*emptypb.Empty, error) {
+
+	namespace := in.GetNamespace()
+	profile := in.GetProfile()
+
+	err := is.wfServer.engine.setNamespaceActiveProfile(ctx, namespace, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("active profile of namespace '%s' set to '%s'", namespace, profile)
+
+	return &emptypb.Empty{}, nil
+
+}
+
+// GetJQModules lists the jq modules a namespace defines itself.
+func (is *ingressServer) GetJQModules(ctx context.Context, in *ingress.GetJQModulesRequest) (*ingress.GetJQModulesResponse, error) {
+
+	var resp ingress.GetJQModulesResponse
+
+	namespace := in.GetNamespace()
+
+	_, err := is.wfServer.dbManager.getNamespace(namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	mods, err := is.wfServer.dbManager.getJQModules(ctx, namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	for _, mod := range mods {
+		name := mod.Name
+		resp.Modules = append(resp.Modules, &ingress.JQModule{
+			Name:    &name,
+			Updated: timestamppb.New(mod.Updated),
 		})
+	}
+
+	return &resp, nil
+
+}
+
+func (is *ingressServer) GetJQModule(ctx context.Context, in *ingress.GetJQModuleRequest) (*ingress.GetJQModuleResponse, error) {
+
+	var resp ingress.GetJQModuleResponse
+
+	namespace := in.GetNamespace()
+	name := in.GetName()
+
+	mod, err := is.wfServer.dbManager.getJQModule(ctx, namespace, name)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "jq module", name)
+	}
+
+	resp.Name = &mod.Name
+	resp.Updated = timestamppb.New(mod.Updated)
+	resp.Source = &mod.Source
+
+	return &resp, nil
 
+}
+
+// SetJQModule adds a jq module to the library of a namespace, or replaces
+// the module of the same name.
+func (is *ingressServer) SetJQModule(ctx context.Context, in *ingress.SetJQModuleRequest) (*emptypb.Empty, error) {
+
+	namespace := in.GetNamespace()
+	name := in.GetName()
+
+	err := is.wfServer.engine.setJQModule(ctx, namespace, name, in.GetSource())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("jq module '%s' of namespace '%s' updated", name, namespace)
+
+	return &emptypb.Empty{}, nil
+
+}
+
+func (is *ingressServer) DeleteJQModule(ctx context.Context, in *ingress.DeleteJQModuleRequest) (*emptypb.Empty, error) {
+
+	namespace := in.GetNamespace()
+	name := in.GetName()
+
+	err := is.wfServer.dbManager.deleteJQModule(ctx, namespace, name)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "jq module", name)
+	}
+
+	log.Debugf("jq module '%s' of namespace '%s' deleted", name, namespace)
+
+	return &emptypb.Empty{}, nil
+
+}
+
+// GetWasmModules lists the wasm modules a namespace uploaded itself.
+func (is *ingressServer) GetWasmModules(ctx context.Context, in *ingress.GetWasmModulesRequest) (*ingress.GetWasmModulesResponse, error) {
+
+	var resp ingress.GetWasmModulesResponse
+
+	namespace := in.GetNamespace()
+
+	_, err := is.wfServer.dbManager.getNamespace(namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	mods, err := is.wfServer.dbManager.getWasmModules(ctx, namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	for _, mod := range mods {
+		name := mod.Name
+		hash := mod.Hash
+		size := int64(len(mod.Binary))
+		resp.Modules = append(resp.Modules, &ingress.WasmModule{
+			Name:    &name,
+			Updated: timestamppb.New(mod.Updated),
+			Size:    &size,
+			Hash:    &hash,
+		})
 	}
 
 	return &resp, nil
 
 }
+
+func (is *ingressServer) GetWasmModule(ctx context.Context, in *ingress.GetWasmModuleRequest) (*ingress.GetWasmModuleResponse, error) {
+
+	var resp ingress.GetWasmModuleResponse
+
+	namespace := in.GetNamespace()
+	name := in.GetName()
+
+	mod, err := is.wfServer.dbManager.getWasmModule(ctx, namespace, name)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "wasm module", name)
+	}
+
+	resp.Name = &mod.Name
+	resp.Updated = timestamppb.New(mod.Updated)
+	resp.Hash = &mod.Hash
+	resp.Binary = mod.Binary
+
+	return &resp, nil
+
+}
+
+// SetWasmModule uploads a wasm module to a namespace, or replaces the module
+// of the same name.
+func (is *ingressServer) SetWasmModule(ctx context.Context, in *ingress.SetWasmModuleRequest) (*emptypb.Empty, error) {
+
+	namespace := in.GetNamespace()
+	name := in.GetName()
+
+	err := is.wfServer.engine.setWasmModule(ctx, namespace, name, in.GetBinary())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("wasm module '%s' of namespace '%s' updated", name, namespace)
+
+	return &emptypb.Empty{}, nil
+
+}
+
+func (is *ingressServer) DeleteWasmModule(ctx context.Context, in *ingress.DeleteWasmModuleRequest) (*emptypb.Empty, error) {
+
+	namespace := in.GetNamespace()
+	name := in.GetName()
+
+	err := is.wfServer.dbManager.deleteWasmModule(ctx, namespace, name)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "wasm module", name)
+	}
+
+	log.Debugf("wasm module '%s' of namespace '%s' deleted", name, namespace)
+
+	return &emptypb.Empty{}, nil
+
+}
+
+// GetJSONSchemas lists every version of the json schemas a namespace stored
+// itself.
+func (is *ingressServer) GetJSONSchemas(ctx context.Context, in *ingress.GetJSONSchemasRequest) (*ingress.GetJSONSchemasResponse, error) {
+
+	var resp ingress.GetJSONSchemasResponse
+
+	namespace := in.GetNamespace()
+
+	_, err := is.wfServer.dbManager.getNamespace(namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	schemas, err := is.wfServer.dbManager.getJSONSchemas(ctx, namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	for _, js := range schemas {
+		name := js.Name
+		version := int32(js.Version)
+		hash := js.Hash
+		resp.Schemas = append(resp.Schemas, &ingress.JSONSchema{
+			Name:    &name,
+			Version: &version,
+			Created: timestamppb.New(js.Created),
+			Hash:    &hash,
+		})
+	}
+
+	return &resp, nil
+
+}
+
+func (is *ingressServer) GetJSONSchema(ctx context.Context, in *ingress.GetJSONSchemaRequest) (*ingress.GetJSONSchemaResponse, error) {
+
+	var resp ingress.GetJSONSchemaResponse
+
+	namespace := in.GetNamespace()
+	name := in.GetName()
+
+	js, err := is.wfServer.dbManager.getJSONSchema(ctx, namespace, name, int(in.GetVersion()))
+	if err != nil {
+		return nil, grpcDatabaseError(err, "json schema", name)
+	}
+
+	version := int32(js.Version)
+
+	resp.Name = &js.Name
+	resp.Version = &version
+	resp.Created = timestamppb.New(js.Created)
+	resp.Hash = &js.Hash
+	resp.Schema = []byte(js.Schema)
+
+	return &resp, nil
+
+}
+
+// AddJSONSchema stores a json schema as the next version of its name.
+func (is *ingressServer) AddJSONSchema(ctx context.Context, in *ingress.AddJSONSchemaRequest) (*ingress.AddJSONSchemaResponse, error) {
+
+	namespace := in.GetNamespace()
+	name := in.GetName()
+
+	v, err := is.wfServer.engine.addJSONSchema(ctx, namespace, name, in.GetSchema())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("json schema '%s' of namespace '%s' is at version %d", name, namespace, v)
+
+	version := int32(v)
+
+	return &ingress.AddJSONSchemaResponse{
+		Version: &version,
+	}, nil
+
+}
+
+func (is *ingressServer) DeleteJSONSchema(ctx context.Context, in *ingress.DeleteJSONSchemaRequest) (*emptypb.Empty, error) {
+
+	namespace := in.GetNamespace()
+	name := in.GetName()
+
+	err := is.wfServer.dbManager.deleteJSONSchema(ctx, namespace, name, int(in.GetVersion()))
+	if err != nil {
+		return nil, grpcDatabaseError(err, "json schema", name)
+	}
+
+	log.Debugf("json schema '%s' of namespace '%s' deleted", name, namespace)
+
+	return &emptypb.Empty{}, nil
+
+}
@@ -0,0 +1,225 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/vorteil/direktiv/pkg/ingress"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetNodes lists the flow servers with their health and load. Timer, queue and
+// lock figures, and the stats of each timer function, are as of each node's last
+// heartbeat.
+func (is *ingressServer) GetNodes(ctx context.Context, in *ingress.GetNodesRequest) (*ingress.GetNodesResponse, error) {
+
+	var resp ingress.GetNodesResponse
+
+	nodes, err := is.wfServer.dbManager.getNodes(ctx)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "nodes", "")
+	}
+
+	counts, err := is.wfServer.dbManager.countOwnedInstances(ctx)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "nodes", "")
+	}
+
+	for _, n := range nodes {
+
+		healthy := nodeHealthy(n.Heartbeat)
+		instances := int32(counts[n.Hostname])
+		timers := int32(n.Timers)
+		queued := int32(n.Queued)
+
+		resp.Nodes = append(resp.Nodes, &ingress.GetNodesResponse_Node{
+			Hostname:  &n.Hostname,
+			Started:   timestamppb.New(n.Started),
+			Heartbeat: timestamppb.New(n.Heartbeat),
+			Healthy:   &healthy,
+			Draining:  &n.Draining,
+			Instances: &instances,
+			Timers:    &timers,
+			Queued:    &queued,
+			Promoted:  &n.Promoted,
+
+			TimerFunctions: timerFunctions(n.TimerStats),
+			Locks:          nodeLocks(n.LockStats),
+		})
+
+	}
+
+	return &resp, nil
+
+}
+
+func timerFunctions(data []byte) []*ingress.GetNodesResponse_TimerFunction {
+
+	var stats []timerFunctionStats
+
+	if len(data) == 0 || json.Unmarshal(data, &stats) != nil {
+		return nil
+	}
+
+	fns := make([]*ingress.GetNodesResponse_TimerFunction, len(stats))
+
+	for i := range stats {
+
+		s := &stats[i]
+		pending := int32(s.Pending)
+		overdue := int32(s.Overdue)
+
+		fn := &ingress.GetNodesResponse_TimerFunction{
+			Function: &s.Function,
+			Pending:  &pending,
+			Overdue:  &overdue,
+			Fired:    &s.Fired,
+			Failed:   &s.Failed,
+			Lag:      &s.Lag,
+			MaxLag:   &s.MaxLag,
+			AvgLag:   &s.AvgLag,
+		}
+
+		if s.LastFired != nil {
+			fn.LastFired = timestamppb.New(*s.LastFired)
+		}
+
+		if s.LastFailed != nil {
+			fn.LastFailed = timestamppb.New(*s.LastFailed)
+			fn.LastError = &s.LastError
+		}
+
+		fns[i] = fn
+
+	}
+
+	return fns
+
+}
+
+// GetNodeInstances lists the running instances a node owns.
+func (is *ingressServer) GetNodeInstances(ctx context.Context, in *ingress.GetNodeInstancesRequest) (*ingress.GetNodeInstancesResponse, error) {
+
+	var resp ingress.GetNodeInstancesResponse
+
+	hostname := in.GetHostname()
+	offset := in.GetOffset()
+	limit := in.GetLimit()
+
+	if hostname == "" {
+		return nil, status.Error(codes.InvalidArgument, "hostname required")
+	}
+
+	instances, err := is.wfServer.dbManager.getNodeInstances(ctx, hostname, int(offset), int(limit))
+	if err != nil {
+		return nil, grpcDatabaseError(err, "node", hostname)
+	}
+
+	resp.Offset = &offset
+	resp.Limit = &limit
+
+	for _, inst := range instances {
+
+		handoff := len(inst.Handoff) > 0
+
+		var state string
+		if len(inst.Flow) > 0 {
+			state = inst.Flow[len(inst.Flow)-1]
+		}
+
+		resp.WorkflowInstances = append(resp.WorkflowInstances, &ingress.GetNodeInstancesResponse_WorkflowInstance{
+			Id:        &inst.InstanceID,
+			State:     &state,
+			BeginTime: timestamppb.New(inst.BeginTime),
+			Deadline:  timestamppb.New(inst.Deadline),
+			Handoff:   &handoff,
+		})
+
+	}
+
+	return &resp, nil
+
+}
+
+// PromoteNode turns the standby server that receives it into a primary one,
+// taking over the instances of the cluster it stands in for. The other standby
+// servers follow on their own.
+func (is *ingressServer) PromoteNode(ctx context.Context, in *ingress.PromoteNodeRequest) (*ingress.PromoteNodeResponse, error) {
+
+	var resp ingress.PromoteNodeResponse
+
+	p, err := is.wfServer.promote(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	instances := int32(p.instances)
+	timers := int32(p.timers)
+	listeners := int32(p.listeners)
+
+	resp.Hostname = &is.wfServer.hostname
+	resp.Instances = &instances
+	resp.Timers = &timers
+	resp.Listeners = &listeners
+
+	return &resp, nil
+
+}
+
+// SetInvocationHalt halts or resumes the creation of new instances across
+// the cluster.
+func (is *ingressServer) SetInvocationHalt(ctx context.Context, in *ingress.SetInvocationHaltRequest) (*emptypb.Empty, error) {
+
+	err := is.wfServer.setInvocationHalt(ctx, in.GetHalt(), in.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+
+}
+
+func (is *ingressServer) GetInvocationHalt(ctx context.Context, in *ingress.GetInvocationHaltRequest) (*ingress.GetInvocationHaltResponse, error) {
+
+	var resp ingress.GetInvocationHaltResponse
+
+	h := is.wfServer.engine.invocationHalt()
+	halted := h.reason != ""
+
+	resp.Halted = &halted
+	if halted {
+		resp.Reason = &h.reason
+		resp.Since = timestamppb.New(h.since)
+	}
+
+	return &resp, nil
+
+}
+
+func nodeLocks(data []byte) *ingress.GetNodesResponse_Locks {
+
+	var s instanceLockStats
+
+	if len(data) == 0 || json.Unmarshal(data, &s) != nil {
+		return nil
+	}
+
+	locks := &ingress.GetNodesResponse_Locks{
+		Acquired:  &s.Acquired,
+		Contended: &s.Contended,
+		Failed:    &s.Failed,
+		AvgWait:   &s.AvgWait,
+		MaxWait:   &s.MaxWait,
+	}
+
+	if s.LastFailed != nil {
+		locks.LastFailed = timestamppb.New(*s.LastFailed)
+		locks.LastError = &s.LastError
+	}
+
+	return locks
+
+}
@@ -3,6 +3,7 @@ package direktiv
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"encoding/base64"
@@ -30,10 +31,55 @@ func (is *ingressServer) DeleteRegistry(ctx context.Context, in *ingress.DeleteR
 	var resp emptypb.Empty
 
 	err := kubernetesDeleteSecret(in.GetName(), in.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	err = is.syncRegistries(ctx, in.GetNamespace())
 
 	return &resp, err
 }
 
+// syncRegistries attaches the registries a namespace and its descendants
+// inherit to their service accounts, after the registries of the namespace
+// changed.
+func (is *ingressServer) syncRegistries(ctx context.Context, ns string) error {
+
+	descendants, err := is.wfServer.dbManager.namespaceDescendants(ctx, ns)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range append([]string{ns}, descendants...) {
+
+		ancestors, err := is.wfServer.dbManager.namespaceAncestors(ctx, n)
+		if err != nil {
+			return err
+		}
+
+		err = kubernetesSyncRegistries(n, ancestors)
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+
+}
+
+// namespaceChain returns a namespace followed by its ancestors, nearest first.
+func (is *ingressServer) namespaceChain(ctx context.Context, ns string) ([]string, error) {
+
+	ancestors, err := is.wfServer.dbManager.namespaceAncestors(ctx, ns)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", ns)
+	}
+
+	return append([]string{ns}, ancestors...), nil
+
+}
+
 func (is *ingressServer) fetchSecrets(ctx context.Context, ns string) (*secretsgrpc.GetSecretsResponse, error) {
 
 	return is.secretsClient.GetSecrets(ctx, &secretsgrpc.GetSecretsRequest{
@@ -42,45 +88,92 @@ func (is *ingressServer) fetchSecrets(ctx context.Context, ns string) (*secretsg
 
 }
 
+// GetSecrets lists the secrets of a namespace and those it inherits from its
+// ancestors, unless it or a nearer ancestor has a secret of the same name.
 func (is *ingressServer) GetSecrets(ctx context.Context, in *ingress.GetSecretsRequest) (*ingress.GetSecretsResponse, error) {
 
-	output, err := is.fetchSecrets(ctx, in.GetNamespace())
+	chain, err := is.namespaceChain(ctx, in.GetNamespace())
 	if err != nil {
 		return nil, err
 	}
 
 	resp := new(ingress.GetSecretsResponse)
-	for i := range output.Secrets {
-		resp.Secrets = append(resp.Secrets, &ingress.GetSecretsResponse_Secret{
-			Name: output.Secrets[i].Name,
-		})
+	seen := make(map[string]bool)
+
+	for i := range chain {
+
+		output, err := is.fetchSecrets(ctx, chain[i])
+		if err != nil {
+			return nil, err
+		}
+
+		for j := range output.Secrets {
+
+			name := output.Secrets[j].GetName()
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			resp.Secrets = append(resp.Secrets, &ingress.GetSecretsResponse_Secret{
+				Name:      output.Secrets[j].Name,
+				Namespace: &chain[i],
+			})
+
+		}
+
 	}
 
 	return resp, nil
 
 }
 
+// GetRegistries lists the registries of a namespace and those it inherits from
+// its ancestors, unless it or a nearer ancestor has one for the same host.
 func (is *ingressServer) GetRegistries(ctx context.Context, in *ingress.GetRegistriesRequest) (*ingress.GetRegistriesResponse, error) {
 
 	resp := new(ingress.GetRegistriesResponse)
 
-	regs, err := kubernetesListRegistries(in.GetNamespace())
-
+	chain, err := is.namespaceChain(ctx, in.GetNamespace())
 	if err != nil {
-		return resp, err
+		return nil, err
 	}
 
-	for _, reg := range regs {
-		split := strings.SplitN(reg, "###", 2)
+	hosts := make(map[string]bool)
+
+	for i := range chain {
 
-		if len(split) != 2 {
-			return nil, fmt.Errorf("invalid registry format")
+		regs, err := kubernetesListRegistries(chain[i])
+		if err != nil {
+			return resp, err
+		}
+
+		var found []string
+
+		for _, reg := range regs {
+			split := strings.SplitN(reg, "###", 2)
+
+			if len(split) != 2 {
+				return nil, fmt.Errorf("invalid registry format")
+			}
+
+			u, err := url.Parse(split[0])
+			if err != nil || hosts[u.Hostname()] {
+				continue
+			}
+			found = append(found, u.Hostname())
+
+			resp.Registries = append(resp.Registries, &ingress.GetRegistriesResponse_Registry{
+				Name:      &split[0],
+				Id:        &split[1],
+				Namespace: &chain[i],
+			})
+		}
+
+		for _, h := range found {
+			hosts[h] = true
 		}
 
-		resp.Registries = append(resp.Registries, &ingress.GetRegistriesResponse_Registry{
-			Name: &split[0],
-			Id:   &split[1],
-		})
 	}
 
 	return resp, nil
@@ -135,6 +228,56 @@ func (is *ingressServer) StoreRegistry(ctx context.Context, in *ingress.StoreReg
 		return nil, err
 	}
 
+	err = is.syncRegistries(ctx, in.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
 	return &resp, nil
 
 }
+
+func (is *ingressServer) SetNamespaceKey(ctx context.Context, in *ingress.SetNamespaceKeyRequest) (*emptypb.Empty, error) {
+
+	namespace := in.GetNamespace()
+	keyRef := in.GetKeyRef()
+
+	_, err := is.wfServer.dbManager.getNamespace(namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	_, err = is.secretsClient.SetNamespaceKey(ctx, &secretsgrpc.SetNamespaceKeyRequest{
+		Namespace: &namespace,
+		KeyRef:    &keyRef,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+
+}
+
+func (is *ingressServer) GetNamespaceKey(ctx context.Context, in *ingress.GetNamespaceKeyRequest) (*ingress.GetNamespaceKeyResponse, error) {
+
+	namespace := in.GetNamespace()
+
+	_, err := is.wfServer.dbManager.getNamespace(namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	key, err := is.secretsClient.GetNamespaceKey(ctx, &secretsgrpc.GetNamespaceKeyRequest{
+		Namespace: &namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ingress.GetNamespaceKeyResponse{
+		KeyRef:  key.KeyRef,
+		Rotated: key.Rotated,
+	}, nil
+
+}
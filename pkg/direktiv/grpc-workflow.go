@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
 	hash "github.com/mitchellh/hashstructure/v2"
 	log "github.com/sirupsen/logrus"
 	"github.com/vorteil/direktiv/pkg/ingress"
 	"github.com/vorteil/direktiv/pkg/model"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -31,9 +33,9 @@ func (is *ingressServer) AddWorkflow(ctx context.Context, in *ingress.AddWorkflo
 
 	var workflow model.Workflow
 	document := in.GetWorkflow()
-	err := workflow.Load(document)
+	err := is.wfServer.engine.loadNamespaceWorkflow(ctx, namespace, &workflow, document)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "bad workflow definition: %v", err)
+		return nil, err
 	}
 
 	wf, err := is.wfServer.dbManager.addWorkflow(ctx, namespace, workflow.ID,
@@ -42,6 +44,8 @@ func (is *ingressServer) AddWorkflow(ctx context.Context, in *ingress.AddWorkflo
 		return nil, grpcDatabaseError(err, "workflow", workflow.ID)
 	}
 
+	is.wfServer.engine.workflows.invalidate(wf.ID)
+
 	is.wfServer.tmManager.deleteTimerByName("", "", fmt.Sprintf("cron:%s", wf.ID.String()))
 	if active {
 		def := workflow.GetStartDefinition()
@@ -78,6 +82,10 @@ func (is *ingressServer) DeleteWorkflow(ctx context.Context, in *ingress.DeleteW
 		return nil, grpcDatabaseError(err, "workflow", uid)
 	}
 
+	if id, err := uuid.Parse(uid); err == nil {
+		is.wfServer.engine.workflows.invalidate(id)
+	}
+
 	err = is.wfServer.tmManager.deleteTimerByName("", "", fmt.Sprintf("cron:%s", uid))
 	if err != nil {
 		log.Error(err)
@@ -98,12 +106,33 @@ func (is *ingressServer) InvokeWorkflow(ctx context.Context, in *ingress.InvokeW
 	namespace := in.GetNamespace()
 	workflow := in.GetName()
 	input := in.GetInput()
+	state := in.GetState()
+	externalID := in.GetExternalId()
+
+	// the caller depth is only trusted from an authenticated remote caller
+	var caller *subflowCaller
+	if cb := in.GetCallback(); cb != "" {
+		var err error
+		caller, err = is.wfServer.engine.remoteCaller(cb, in.GetCallbackToken(), int(in.GetCallerDepth()))
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	inst, err := is.wfServer.engine.PrepareInvoke(ctx, namespace, workflow, input)
+	inst, queued, err := is.wfServer.engine.PrepareInvoke(ctx, namespace, workflow, state, externalID, input, caller, in.GetTimeout(), in.GetMocks(), in.GetBreakpoints(), in.GetDebugTransforms(), in.GetVerbose())
 	if err != nil {
 		return nil, grpcDatabaseError(err, "instance", fmt.Sprintf("%s/%s", namespace, workflow))
 	}
 
+	// queued invocations start later, so there's nothing to wait for
+	if queued != "" {
+		log.Debugf("Queued invocation of workflow %s/%s: %s", namespace, workflow, queued)
+		q := true
+		resp.InstanceId = &queued
+		resp.Queued = &q
+		return &resp, nil
+	}
+
 	log.Debugf("Invoked workflow %s/%s: %s", namespace, workflow, inst.id)
 
 	resp.InstanceId = &inst.id
@@ -119,7 +148,7 @@ func (is *ingressServer) InvokeWorkflow(ctx context.Context, in *ingress.InvokeW
 		<-done
 	}
 
-	go inst.start()
+	is.wfServer.engine.submitStart(inst)
 
 	if in.GetWait() {
 		log.Debugf("waiting for response %v", inst.id)
@@ -138,17 +167,95 @@ func (is *ingressServer) InvokeWorkflow(ctx context.Context, in *ingress.InvokeW
 
 }
 
+func (is *ingressServer) ScheduleInvocation(ctx context.Context, in *ingress.ScheduleInvocationRequest) (*ingress.ScheduleInvocationResponse, error) {
+
+	var resp ingress.ScheduleInvocationResponse
+
+	if in.GetTime() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "time required")
+	}
+
+	si, err := is.wfServer.engine.scheduleInvocation(ctx, in.GetNamespace(), in.GetName(), in.GetTime().AsTime(), in.GetInput(), in.GetState(), in.GetExternalId(), in.GetTtl(), in.GetNotify())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Scheduled invocation of workflow %s/%s at %v: %s", si.Namespace, si.Workflow, si.Time, si.ID)
+
+	id := si.ID.String()
+	resp.Id = &id
+	resp.Time = timestamppb.New(si.Time)
+	if si.Expires != nil {
+		resp.Expires = timestamppb.New(*si.Expires)
+	}
+
+	return &resp, nil
+
+}
+
+func (is *ingressServer) GetScheduledInvocations(ctx context.Context, in *ingress.GetScheduledInvocationsRequest) (*ingress.GetScheduledInvocationsResponse, error) {
+
+	var resp ingress.GetScheduledInvocationsResponse
+
+	namespace := in.GetNamespace()
+
+	sis, err := is.wfServer.dbManager.getScheduledInvocations(ctx, namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	for _, si := range sis {
+		id := si.ID.String()
+		workflow := si.Workflow
+		state := si.State
+		externalID := si.ExternalID
+		notify := si.Notify
+		inv := &ingress.ScheduledInvocation{
+			Id:         &id,
+			Workflow:   &workflow,
+			Time:       timestamppb.New(si.Time),
+			State:      &state,
+			ExternalId: &externalID,
+			Created:    timestamppb.New(si.Created),
+			Notify:     &notify,
+		}
+		if si.Expires != nil {
+			inv.Expires = timestamppb.New(*si.Expires)
+		}
+		resp.Invocations = append(resp.Invocations, inv)
+	}
+
+	return &resp, nil
+
+}
+
+func (is *ingressServer) CancelScheduledInvocation(ctx context.Context, in *ingress.CancelScheduledInvocationRequest) (*emptypb.Empty, error) {
+
+	err := is.wfServer.engine.cancelScheduledInvocation(ctx, in.GetNamespace(), in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+
+}
+
 func (is *ingressServer) UpdateWorkflow(ctx context.Context, in *ingress.UpdateWorkflowRequest) (*ingress.UpdateWorkflowResponse, error) {
 
 	var resp ingress.UpdateWorkflowResponse
 
 	uid := in.GetUid()
 
+	current, err := is.wfServer.dbManager.getWorkflowByUid(ctx, uid)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "workflow", uid)
+	}
+
 	var workflow model.Workflow
 	document := in.GetWorkflow()
-	err := workflow.Load(document)
+	err = is.wfServer.engine.loadNamespaceWorkflow(ctx, current.Edges.Namespace.ID, &workflow, document)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "bad workflow definition: %v", err)
+		return nil, err
 	}
 
 	var checkRevisionVal int
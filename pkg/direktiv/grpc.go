@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ingressServer struct {
@@ -40,7 +41,8 @@ func (is *ingressServer) stop() {
 		is.grpcConn.Close()
 	}
 
-	// stop engine client
+	// stop engine workers and clients
+	is.wfServer.engine.queue.close()
 	for _, c := range is.wfServer.engine.grpcConns {
 		c.Close()
 	}
@@ -69,7 +71,9 @@ func (is *ingressServer) start(s *WorkflowServer) error {
 	is.grpcConn = conn
 	is.secretsClient = secretsgrpc.NewSecretsServiceClient(conn)
 
-	is.cronPoll()
+	if !is.wfServer.engine.isStandby() {
+		is.cronPoll()
+	}
 	go is.cronPoller()
 
 	return GrpcStart(&is.grpc, "ingress", s.config.IngressAPI.Bind, func(srv *grpc.Server) {
@@ -86,7 +90,9 @@ func (is *ingressServer) start(s *WorkflowServer) error {
 func (is *ingressServer) cronPoller() {
 	for {
 		time.Sleep(time.Minute * 15)
-		is.cronPoll()
+		if !is.wfServer.engine.isStandby() {
+			is.cronPoll()
+		}
 	}
 }
 
@@ -131,27 +137,128 @@ func (is *ingressServer) BroadcastEvent(ctx context.Context, in *ingress.Broadca
 
 	var resp emptypb.Empty
 
+	if is.wfServer.engine.isStandby() {
+		return nil, errStandby
+	}
+
 	namespace := in.GetNamespace()
 	rawevent := in.GetCloudevent()
 
 	event := new(cloudevents.Event)
 	err := event.UnmarshalJSON(rawevent)
 	if err != nil {
+		is.wfServer.engine.events.record(namespace, nil, rawevent, eventRejected)
 		return nil, status.Errorf(codes.InvalidArgument, "invalid cloudevent: %v", err)
 	}
 
+	if in.GetExternal() {
+		err = is.wfServer.engine.acceptInboundEvent(ctx, namespace, event)
+		if err != nil {
+			is.wfServer.engine.events.record(namespace, event, rawevent, eventRejected)
+			return nil, err
+		}
+	}
+
 	log.Debugf("Broadcasting event on namespace '%s': %s/%s", namespace, event.Type(), event.Source())
 	dlogger, err := is.wfServer.instanceLogger.NamespaceLogger(namespace)
 	if err != nil {
+		is.wfServer.engine.events.record(namespace, event, rawevent, eventRejected)
 		return nil, err
 	}
 
 	dlogger.Info(fmt.Sprintf("Broadcasting event: type=%s, source=%s", event.Type(), event.Source()))
 
-	err = is.wfServer.handleEvent(*in.Namespace, event)
+	matched, err := is.wfServer.handleEvent(*in.Namespace, event)
+
+	switch {
+	case err != nil:
+		is.wfServer.engine.events.record(namespace, event, rawevent, eventRejected)
+	case matched:
+		is.wfServer.engine.events.record(namespace, event, rawevent, eventMatched)
+	default:
+		is.wfServer.engine.events.record(namespace, event, rawevent, eventUnmatched)
+	}
 
 	dlogger.Close()
 
 	return &resp, err
 
 }
+
+// TraceEvent reports which listeners an event would match if it were
+// broadcast, without invoking or waking anything.
+func (is *ingressServer) TraceEvent(ctx context.Context, in *ingress.TraceEventRequest) (*ingress.TraceEventResponse, error) {
+
+	var resp ingress.TraceEventResponse
+
+	namespace := in.GetNamespace()
+
+	event := new(cloudevents.Event)
+	err := event.UnmarshalJSON(in.GetCloudevent())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cloudevent: %v", err)
+	}
+
+	listeners, err := is.wfServer.traceEvent(ctx, namespace, event)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	resp.Namespace = &namespace
+	resp.Listeners = listeners
+
+	return &resp, nil
+
+}
+
+// GetEventStats reports what became of the events broadcast to a namespace,
+// and the unmatched events sampled lately.
+func (is *ingressServer) GetEventStats(ctx context.Context, in *ingress.GetEventStatsRequest) (*ingress.GetEventStatsResponse, error) {
+
+	var resp ingress.GetEventStatsResponse
+
+	namespace := in.GetNamespace()
+
+	_, err := is.wfServer.dbManager.getNamespace(namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	counters, err := is.wfServer.dbManager.getEventCounters(ctx, namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	unmatched, err := is.wfServer.dbManager.getUnmatchedEvents(ctx, namespace)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", namespace)
+	}
+
+	resp.Namespace = &namespace
+
+	for _, c := range counters {
+		c := c
+		resp.Types = append(resp.Types, &ingress.GetEventStatsResponse_EventType{
+			Type:         &c.Type,
+			Received:     &c.Received,
+			Matched:      &c.Matched,
+			Unmatched:    &c.Unmatched,
+			Rejected:     &c.Rejected,
+			LastReceived: timestamppb.New(c.LastReceived),
+		})
+	}
+
+	for _, e := range unmatched {
+		e := e
+		resp.Unmatched = append(resp.Unmatched, &ingress.GetEventStatsResponse_UnmatchedEvent{
+			Type:       &e.Type,
+			Source:     &e.Source,
+			Id:         &e.EventID,
+			Received:   timestamppb.New(e.Received),
+			Cloudevent: e.Cloudevent,
+		})
+	}
+
+	return &resp, nil
+
+}
@@ -1,14 +1,20 @@
 package direktiv
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"os"
+	"strconv"
+	"sync/atomic"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/balancer/roundrobin"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
 )
 
 const (
@@ -23,8 +29,46 @@ const (
 	TLSKey = "/etc/certs/direktiv/tls.key"
 	// TLSCA cert CA
 	TLSCA = "/etc/certs/direktiv/ca.crt"
+
+	// keepalive and connection pool settings shared by all grpc clients
+	grpcKeepaliveTime    = "DIREKTIV_GRPC_KEEPALIVE_TIME"
+	grpcKeepaliveTimeout = "DIREKTIV_GRPC_KEEPALIVE_TIMEOUT"
+	grpcPoolSize         = "DIREKTIV_GRPC_POOL_SIZE"
+)
+
+const (
+	defaultGRPCKeepaliveTime    = 30 * time.Second
+	defaultGRPCKeepaliveTimeout = 10 * time.Second
+	defaultGRPCPoolSize         = 4
 )
 
+func envDuration(env string, def time.Duration) time.Duration {
+
+	v := os.Getenv(env)
+	if len(v) == 0 {
+		return def
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		log.Warnf("ignoring invalid %s: %s", env, v)
+		return def
+	}
+
+	return d
+
+}
+
+// grpcKeepaliveParams pings idle connections so that load balancers don't
+// silently drop them, and detects dead connections before calls stall.
+func grpcKeepaliveParams() keepalive.ClientParameters {
+	return keepalive.ClientParameters{
+		Time:                envDuration(grpcKeepaliveTime, defaultGRPCKeepaliveTime),
+		Timeout:             envDuration(grpcKeepaliveTimeout, defaultGRPCKeepaliveTimeout),
+		PermitWithoutStream: true,
+	}
+}
+
 var globalGRPCDialOptions []grpc.DialOption
 
 func AddGlobalGRPCDialOption(opt grpc.DialOption) {
@@ -57,6 +101,8 @@ func GetEndpointTLS(endpoint string, rr bool) (*grpc.ClientConn, error) {
 		options = append(options, grpc.WithBalancerName(roundrobin.Name))
 	}
 
+	options = append(options, grpc.WithKeepaliveParams(grpcKeepaliveParams()))
+
 	options = append(options, globalGRPCDialOptions...)
 
 	return grpc.Dial(endpoint, options...)
@@ -85,6 +131,17 @@ func GrpcStart(server **grpc.Server, name, bind string, register func(srv *grpc.
 		return err
 	}
 
+	// accept pings as often as clients may send them, and ping idle clients
+	// in turn
+	kp := grpcKeepaliveParams()
+	options = append(options, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+		MinTime:             kp.Time / 2,
+		PermitWithoutStream: true,
+	}), grpc.KeepaliveParams(keepalive.ServerParameters{
+		Time:    kp.Time * 2,
+		Timeout: kp.Timeout,
+	}))
+
 	options = append(options, globalGRPCServerOptions...)
 
 	(*server) = grpc.NewServer(options...)
@@ -96,3 +153,91 @@ func GrpcStart(server **grpc.Server, name, bind string, register func(srv *grpc.
 	return nil
 
 }
+
+// ConnPool spreads calls over several connections to the same endpoint so a
+// single stalled connection doesn't hold up every call.
+type ConnPool struct {
+	conns []*grpc.ClientConn
+	next  uint32
+}
+
+// GetEndpointPool creates a pool of grpc clients for the endpoint. The size
+// defaults to DIREKTIV_GRPC_POOL_SIZE.
+func GetEndpointPool(endpoint string, rr bool) (*ConnPool, error) {
+
+	size := defaultGRPCPoolSize
+	if v := os.Getenv(grpcPoolSize); len(v) > 0 {
+		i, err := strconv.Atoi(v)
+		if err != nil || i < 1 {
+			log.Warnf("ignoring invalid %s: %s", grpcPoolSize, v)
+		} else {
+			size = i
+		}
+	}
+
+	pool := new(ConnPool)
+
+	for i := 0; i < size; i++ {
+		conn, err := GetEndpointTLS(endpoint, rr)
+		if err != nil {
+			pool.Close()
+			return nil, err
+		}
+		pool.conns = append(pool.conns, conn)
+	}
+
+	return pool, nil
+
+}
+
+func (pool *ConnPool) conn() *grpc.ClientConn {
+	n := atomic.AddUint32(&pool.next, 1)
+	return pool.conns[int(n)%len(pool.conns)]
+}
+
+// Invoke implements grpc.ClientConnInterface.
+func (pool *ConnPool) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	return pool.conn().Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream implements grpc.ClientConnInterface.
+func (pool *ConnPool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return pool.conn().NewStream(ctx, desc, method, opts...)
+}
+
+// GetState returns the best state of the pool's connections.
+func (pool *ConnPool) GetState() connectivity.State {
+
+	state := connectivity.Shutdown
+
+	for _, conn := range pool.conns {
+		switch s := conn.GetState(); s {
+		case connectivity.Ready:
+			return s
+		case connectivity.Idle, connectivity.Connecting:
+			state = s
+		case connectivity.TransientFailure:
+			if state == connectivity.Shutdown {
+				state = s
+			}
+		}
+	}
+
+	return state
+
+}
+
+// Close closes every connection in the pool.
+func (pool *ConnPool) Close() error {
+
+	var err error
+
+	for _, conn := range pool.conns {
+		if e := conn.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+
+	return err
+
+}
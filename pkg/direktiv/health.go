@@ -2,22 +2,52 @@ package direktiv
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
 
 	"github.com/vorteil/direktiv/pkg/health"
 )
 
+// services of the health server, an empty service means readiness
+const (
+	livenessService  = "liveness"
+	readinessService = "readiness"
+)
+
+const (
+	healthCheckTimeout = time.Second * 5
+	healthWatchPeriod  = time.Second * 10
+)
+
+// The liveness service only checks that the server itself still works, which
+// for now means its timers keep firing, so orchestrators restart a server that
+// is stuck. The readiness service also checks the database and the grpc
+// services the server depends on, so traffic stops going to a server that
+// can't do anything useful with it until they are reachable again.
+
 type healthServer struct {
 	health.UnimplementedHealthServer
 	grpc *grpc.Server
 
 	s *WorkflowServer
+
+	mtx     sync.Mutex
+	reasons map[string]string
 }
 
 func newHealthServer(s *WorkflowServer) *healthServer {
 	return &healthServer{
-		s: s,
+		s:       s,
+		reasons: make(map[string]string),
 	}
 }
 
@@ -27,27 +57,173 @@ func (hs *healthServer) name() string {
 
 func (hs *healthServer) Check(ctx context.Context, in *health.HealthCheckRequest) (*health.HealthCheckResponse, error) {
 
-	var resp health.HealthCheckResponse
-	resp.Status = health.HealthCheckResponse_SERVING
-
-	_, err := hs.s.dbManager.getNamespaces(context.Background(), int(0), int(10))
+	st, err := hs.check(ctx, in.GetService())
 	if err != nil {
 		return nil, err
 	}
 
-	return &resp, nil
+	return &health.HealthCheckResponse{
+		Status: st,
+	}, nil
 
 }
 
 func (hs *healthServer) Watch(in *health.HealthCheckRequest, srv health.Health_WatchServer) error {
 
-	var resp health.HealthCheckResponse
+	ticker := time.NewTicker(healthWatchPeriod)
+	defer ticker.Stop()
+
+	last := health.HealthCheckResponse_UNKNOWN
+
+	for {
+
+		st, err := hs.check(srv.Context(), in.GetService())
+		if err != nil {
+			if status.Code(err) != codes.NotFound {
+				return err
+			}
+			st = health.HealthCheckResponse_SERVICE_UNKNOWN
+		}
+
+		if st != last {
+			err = srv.Send(&health.HealthCheckResponse{
+				Status: st,
+			})
+			if err != nil {
+				return err
+			}
+			last = st
+		}
+
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+
+	}
+
+}
+
+func (hs *healthServer) check(ctx context.Context, service string) (health.HealthCheckResponse_ServingStatus, error) {
+
+	var checks []func(context.Context) error
+
+	switch service {
+	case livenessService:
+		checks = []func(context.Context) error{
+			hs.checkTimers,
+		}
+	case "", readinessService:
+		checks = []func(context.Context) error{
+			hs.checkTimers,
+			hs.checkDatabase,
+			hs.checkDownstreams,
+			hs.checkIsolates,
+		}
+	default:
+		return health.HealthCheckResponse_UNKNOWN, status.Errorf(codes.NotFound, "unknown service '%s'", service)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	var reasons []string
+	for _, check := range checks {
+		if err := check(ctx); err != nil {
+			reasons = append(reasons, err.Error())
+		}
+	}
+
+	hs.report(service, strings.Join(reasons, "; "))
+
+	if len(reasons) > 0 {
+		return health.HealthCheckResponse_NOT_SERVING, nil
+	}
+
+	return health.HealthCheckResponse_SERVING, nil
+
+}
+
+// report logs when the outcome of a service's checks changes, probes run far
+// too often to log every failure.
+func (hs *healthServer) report(service, reason string) {
+
+	if service == "" {
+		service = readinessService
+	}
+
+	hs.mtx.Lock()
+	defer hs.mtx.Unlock()
+
+	if hs.reasons[service] == reason {
+		return
+	}
+
+	hs.reasons[service] = reason
 
-	resp.Status = health.HealthCheckResponse_SERVING
+	if reason == "" {
+		log.Infof("%s checks pass again", service)
+	} else {
+		log.Warnf("%s checks failed: %s", service, reason)
+	}
+
+}
+
+func (hs *healthServer) checkTimers(ctx context.Context) error {
+	return hs.s.tmManager.healthy()
+}
+
+func (hs *healthServer) checkDatabase(ctx context.Context) error {
 
-	err := srv.Send(&resp)
+	db := hs.s.dbManager
+
+	err := db.dbEnt.DB().PingContext(ctx)
 	if err != nil {
-		return err
+		return fmt.Errorf("database unreachable: %v", err)
+	}
+
+	if db.dbRead != db.dbEnt {
+		err = db.dbRead.DB().PingContext(ctx)
+		if err != nil {
+			return fmt.Errorf("database replica unreachable: %v", err)
+		}
+	}
+
+	err = db.dbForLock.PingContext(ctx)
+	if err != nil {
+		return fmt.Errorf("lock database unreachable: %v", err)
+	}
+
+	return nil
+
+}
+
+func (hs *healthServer) checkDownstreams(ctx context.Context) error {
+
+	var down []string
+
+	for name, state := range hs.s.engine.downstreams {
+		switch s := state(); s {
+		case connectivity.TransientFailure, connectivity.Shutdown:
+			down = append(down, fmt.Sprintf("%s (%s)", name, strings.ToLower(s.String())))
+		}
+	}
+
+	if len(down) > 0 {
+		sort.Strings(down)
+		return fmt.Errorf("grpc services unreachable: %s", strings.Join(down, ", "))
+	}
+
+	return nil
+
+}
+
+func (hs *healthServer) checkIsolates(ctx context.Context) error {
+
+	pools := hs.s.engine.isolates.unhealthy()
+	if len(pools) > 0 {
+		return fmt.Errorf("no healthy isolate endpoints for: %s", strings.Join(pools, ", "))
 	}
 
 	return nil
@@ -0,0 +1,256 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/replay"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// history recording modes
+const (
+	historyFailed = "failed"
+	historyAll    = "all"
+)
+
+// When history is recorded, every run of an instance's state logic is saved
+// with the data it started with, the memory, action results, events or timer
+// it woke with, and what it did, so that the replay debugger can step through
+// the instance without running anything. In the failed mode the history of an
+// instance that completes successfully is thrown away.
+
+type historyRecorder struct {
+	wli  *workflowLogicInstance
+	step replay.Step
+}
+
+// beginHistory starts recording a run of wli's state logic. It returns nil if
+// history isn't recorded, and the recorder's methods do nothing on nil.
+func (we *workflowEngine) beginHistory(wli *workflowLogicInstance, savedata, wakedata []byte, err error) *historyRecorder {
+
+	if we.server.config.RecordHistory == "" {
+		return nil
+	}
+
+	h := &historyRecorder{
+		wli: wli,
+		step: replay.Step{
+			Step:     wli.step,
+			State:    wli.logic.ID(),
+			Type:     wli.logic.Type(),
+			Time:     we.clock.Now(),
+			Cause:    replay.CauseEnter,
+			Memory:   replay.Data(savedata),
+			Wake:     replay.Data(wakedata),
+			Revision: wli.revision,
+		},
+	}
+
+	h.step.Input, _ = json.Marshal(wli.data)
+
+	if err != nil {
+		h.step.Cause = replay.CauseFault
+		h.step.Fault = historyError(err)
+	} else if len(savedata) > 0 || len(wakedata) > 0 {
+		h.step.Cause = replay.CauseWake
+	}
+
+	return h
+
+}
+
+func historyError(err error) *replay.Error {
+
+	switch e := err.(type) {
+	case *CatchableError:
+		return &replay.Error{
+			Code:    e.Code,
+			Message: e.Message,
+		}
+	case *UncatchableError:
+		return &replay.Error{
+			Code:        e.Code,
+			Message:     e.Message,
+			Uncatchable: true,
+		}
+	}
+
+	return &replay.Error{
+		Message:     err.Error(),
+		Uncatchable: true,
+	}
+
+}
+
+// ran records what the state logic returned.
+func (h *historyRecorder) ran(transition *stateTransition) {
+
+	if h == nil {
+		return
+	}
+
+	h.step.Result, _ = json.Marshal(h.wli.data)
+
+	if transition == nil {
+		h.step.Outcome = replay.OutcomeWait
+		return
+	}
+
+	h.step.Transform = transition.Transform
+
+}
+
+// transitioned records the state the instance moves on to, if any.
+func (h *historyRecorder) transitioned(transition *stateTransition) {
+
+	if h == nil || transition == nil {
+		return
+	}
+
+	h.step.Next = transition.NextState
+	h.step.Output, _ = json.Marshal(h.wli.data)
+
+	h.step.Outcome = replay.OutcomeTransition
+	if transition.NextState == "" {
+		h.step.Outcome = replay.OutcomeEnd
+	}
+
+}
+
+func (h *historyRecorder) failed(err error) {
+
+	if h == nil {
+		return
+	}
+
+	h.step.Error = historyError(err)
+	h.step.Outcome = replay.OutcomeFailed
+
+}
+
+func (h *historyRecorder) retrying() {
+
+	if h == nil {
+		return
+	}
+
+	h.step.Outcome = replay.OutcomeRetry
+
+}
+
+func (h *historyRecorder) save(ctx context.Context) {
+
+	if h == nil {
+		return
+	}
+
+	we := h.wli.engine
+	id := h.wli.id
+
+	if we.server.config.RecordHistory == historyFailed && h.step.Outcome == replay.OutcomeEnd &&
+		h.wli.rec.Status == "complete" {
+		we.exportTrace(ctx, h.wli, &h.step)
+		err := we.db.deleteHistory(ctx, id)
+		if err != nil {
+			log.Errorf("cannot delete history of %s: %v", id, err)
+		}
+		return
+	}
+
+	if wf := h.wli.rec.Edges.Workflow; wf != nil {
+		recorded, err := we.db.historyHasRevision(ctx, id, h.step.Revision)
+		if err != nil {
+			log.Errorf("cannot record history of %s: %v", id, err)
+			return
+		}
+		if !recorded {
+			full, err := we.db.getWorkflowByID(wf.ID)
+			if err != nil {
+				log.Errorf("cannot record history of %s: %v", id, err)
+				return
+			}
+			h.step.Definition = string(full.Workflow)
+			// the instance may be running a revision it was pinned to
+			if full.Revision != h.step.Revision {
+				r, err := we.db.getWorkflowRevision(ctx, wf.ID, h.step.Revision)
+				if err != nil {
+					log.Errorf("cannot record history of %s: %v", id, err)
+					return
+				}
+				h.step.Definition = string(r.Definition)
+			}
+		}
+	}
+
+	data, err := json.Marshal(&h.step)
+	if err != nil {
+		log.Errorf("cannot record history of %s: %v", id, err)
+		return
+	}
+
+	err = we.db.addHistory(ctx, id, h.step.Step, h.step.Revision, h.step.Time, data)
+	if err != nil {
+		log.Errorf("cannot record history of %s: %v", id, err)
+		return
+	}
+
+	if h.wli.rec.Status != "pending" {
+		we.exportTrace(ctx, h.wli, nil)
+	}
+
+}
+
+// instanceHistory assembles the history document of an instance.
+func (we *workflowEngine) instanceHistory(ctx context.Context, id string) ([]byte, error) {
+
+	h, err := we.loadHistory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(h)
+
+}
+
+func (we *workflowEngine) loadHistory(ctx context.Context, id string) (*replay.History, error) {
+
+	rec, err := we.db.getWorkflowInstance(ctx, id)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "instance", id)
+	}
+
+	rows, err := we.db.getHistory(ctx, id)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "instance", id)
+	}
+
+	if len(rows) == 0 {
+		return nil, grpc.Errorf(codes.NotFound, "no history recorded for instance '%s'", id)
+	}
+
+	wf := rec.Edges.Workflow
+
+	h := &replay.History{
+		Version:   replay.Version,
+		Instance:  id,
+		Namespace: wf.Edges.Namespace.ID,
+		Workflow:  wf.Name,
+		Status:    rec.Status,
+		Input:     replay.Data([]byte(rec.Input)),
+		Steps:     make([]replay.Step, len(rows)),
+	}
+
+	for i, row := range rows {
+		err = json.Unmarshal(row.Data, &h.Steps[i])
+		if err != nil {
+			return nil, NewInternalError(fmt.Errorf("bad history of %s: %v", id, err))
+		}
+	}
+
+	return h, nil
+
+}
@@ -0,0 +1,66 @@
+package direktiv
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/ingress"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// A legal hold keeps the records of an instance, or of every instance of a
+// namespace, until it is released: retention pruning passes them over, purges
+// skip them, and the workflows and namespaces they belong to can't be
+// deleted. A hold is the reason it was placed, e.g. a case reference.
+
+func (s *WorkflowServer) setLegalHold(ctx context.Context, in *ingress.SetLegalHoldRequest) error {
+
+	reason := in.GetReason()
+
+	if !in.GetHold() {
+		reason = ""
+	} else if reason == "" {
+		return status.Errorf(codes.InvalidArgument, "a legal hold requires a reason")
+	}
+
+	if id := in.GetInstance(); id != "" {
+
+		err := s.dbManager.setInstanceHold(ctx, id, reason)
+		if err != nil {
+			return grpcDatabaseError(err, "instance", id)
+		}
+
+		if reason == "" {
+			log.Infof("Legal hold on instance %s released.", id)
+		} else {
+			log.Infof("Legal hold placed on instance %s: %s", id, reason)
+		}
+
+		return nil
+
+	}
+
+	ns := in.GetNamespace()
+	if ns == "" {
+		return status.Errorf(codes.InvalidArgument, "required namespace or instance")
+	}
+
+	err := s.dbManager.setNamespaceHold(ctx, ns, reason)
+	if err != nil {
+		return grpcDatabaseError(err, "namespace", ns)
+	}
+
+	if reason == "" {
+		log.Infof("Legal hold on namespace %s released.", ns)
+	} else {
+		log.Infof("Legal hold placed on namespace %s: %s", ns, reason)
+	}
+
+	return nil
+
+}
+
+func errLegalHold(otype, oval string) error {
+	return status.Errorf(codes.FailedPrecondition, "%s '%s' holds records under a legal hold", otype, oval)
+}
@@ -0,0 +1,64 @@
+package direktiv
+
+import (
+	"context"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+// Queued and scheduled invocations can expire, so that work doesn't start
+// long after it stopped being relevant. An expired invocation is dropped
+// with an audit record, and if asked for, a direktiv.invocation.expired
+// event is emitted in its namespace, reaching its workflows and event sinks.
+
+const invocationExpiredEvent = "direktiv.invocation.expired"
+
+const (
+	expiredFromQueue    = "queue"
+	expiredFromSchedule = "schedule"
+)
+
+// expiredInvocation is the data of an invocation expired event.
+type expiredInvocation struct {
+	ID        string    `json:"id"`
+	Namespace string    `json:"namespace"`
+	Workflow  string    `json:"workflow"`
+	From      string    `json:"from"`
+	Created   time.Time `json:"created"`
+	Expires   time.Time `json:"expires"`
+}
+
+func (we *workflowEngine) expireInvocation(ctx context.Context, ei *expiredInvocation, notify bool) {
+
+	we.namespaceAudit(ei.Namespace, "expireInvocation", log.Fields{
+		"workflow":   ei.Workflow,
+		"invocation": ei.ID,
+		"from":       ei.From,
+		"expires":    ei.Expires,
+	}, "Invocation %s of workflow '%s' expired at %s without starting, dropped from the %s",
+		ei.ID, ei.Workflow, ei.Expires.UTC().Format(time.RFC3339), ei.From)
+
+	if !notify {
+		return
+	}
+
+	event := cloudevents.NewEvent()
+	event.SetID(uuid.New().String())
+	event.SetSource("direktiv")
+	event.SetType(invocationExpiredEvent)
+
+	err := event.SetData("application/json", ei)
+	if err != nil {
+		log.Errorf("failed to marshal invocation expired event: %v", err)
+		return
+	}
+
+	err = we.emitEvent(ctx, ei.Namespace, &event)
+	if err != nil {
+		log.Errorf("failed to broadcast invocation expired event: %v", err)
+	}
+
+}
@@ -0,0 +1,123 @@
+package direktiv
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Halting invocations is an emergency brake for incidents: while it is on,
+// no flow server of the cluster creates new instances, whether they are
+// invoked directly, by crons, by events or as subflows, but the instances
+// already running carry on. Invocations are refused rather than queued:
+// scheduled starts are skipped, events only wake instances that already wait
+// for them, and subflow calls fail with a catchable error. The halt is a
+// cluster setting every server caches. Servers are told to reload it when it
+// changes, and reload it with every heartbeat in case they missed that.
+
+const invocationHaltSetting = "invocations.halt"
+
+const invocationHaltCode = "direktiv.invocations.halted"
+
+// invocationHalt is why new instances are halted and since when, or empty if
+// they aren't.
+type invocationHalt struct {
+	reason string
+	since  time.Time
+}
+
+func (we *workflowEngine) invocationHalt() invocationHalt {
+
+	if h, ok := we.halt.Load().(invocationHalt); ok {
+		return h
+	}
+
+	return invocationHalt{}
+
+}
+
+// checkInvocationHalt returns an error if new instances are halted.
+func (we *workflowEngine) checkInvocationHalt() error {
+
+	h := we.invocationHalt()
+	if h.reason == "" {
+		return nil
+	}
+
+	return status.Errorf(codes.Unavailable, "new instances are halted: %s", h.reason)
+
+}
+
+func (we *workflowEngine) loadInvocationHalt(ctx context.Context) error {
+
+	s, err := we.db.getSetting(ctx, invocationHaltSetting)
+	if err != nil {
+		return err
+	}
+
+	var h invocationHalt
+	if s != nil {
+		h.reason = s.Value
+		h.since = s.Updated
+	}
+
+	if prev := we.invocationHalt(); prev.reason != h.reason {
+		if h.reason == "" {
+			log.Infof("New instances are no longer halted.")
+		} else {
+			log.Warnf("New instances are halted: %s", h.reason)
+		}
+	}
+
+	we.halt.Store(h)
+
+	return nil
+
+}
+
+func (we *workflowEngine) reloadInvocationHalt() {
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	err := we.loadInvocationHalt(ctx)
+	if err != nil {
+		log.Errorf("cannot load invocation halt: %v", err)
+	}
+
+}
+
+// setInvocationHalt halts or resumes new instances on every server of the
+// cluster. A halt requires a reason.
+func (s *WorkflowServer) setInvocationHalt(ctx context.Context, halt bool, reason string) error {
+
+	var err error
+
+	if halt {
+		if reason == "" {
+			return status.Errorf(codes.InvalidArgument, "halting invocations requires a reason")
+		}
+		err = s.dbManager.setSetting(ctx, invocationHaltSetting, reason)
+	} else {
+		err = s.dbManager.deleteSetting(ctx, invocationHaltSetting)
+	}
+	if err != nil {
+		return NewInternalError(err)
+	}
+
+	err = s.engine.loadInvocationHalt(ctx)
+	if err != nil {
+		return NewInternalError(err)
+	}
+
+	err = syncServer(ctx, s.dbManager, &s.id, nil, ReloadInvocationHalt)
+	if err != nil {
+		log.Errorf("cannot notify servers of invocation halt: %v", err)
+	}
+
+	return nil
+
+}
@@ -0,0 +1,295 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/senseyeio/duration"
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/pkg/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// A singular workflow with a queue doesn't fail the invocations it gets
+// while one of its instances runs: API and event invocations are stored in
+// the queued_invocations table instead, under the instance id they will get,
+// and started in order whenever the workflow is free again. Servers try to
+// start the next one whenever an instance finishes, and with every heartbeat
+// in case that didn't work out. Invocations that are still queued after the
+// workflow's queue ttl are dropped. Subflow and cron invocations are never
+// queued, their callers handle failures themselves.
+
+const (
+	queuedFromAPI    = "api"
+	queuedFromEvents = "events"
+
+	queueExpiredCode = "direktiv.queue.expired"
+)
+
+var errWorkflowBusy = errors.New("singular workflow is busy")
+
+// mutexMode is what addWorkflowInstance does while another instance of a
+// singular workflow runs.
+type mutexMode int
+
+const (
+	// mutexNone ignores other instances.
+	mutexNone mutexMode = iota
+	// mutexFail creates the instance failed with direktiv.mutex.
+	mutexFail
+	// mutexQueue returns errWorkflowBusy, also while invocations are queued
+	// before this one.
+	mutexQueue
+	// mutexDequeue returns errWorkflowBusy, for queued invocations.
+	mutexDequeue
+)
+
+// workflowMutex returns how new instances of a workflow wait for each other.
+// Only invocations that can be queued are.
+func workflowMutex(wf *model.Workflow, queue bool) mutexMode {
+
+	if !wf.Exclusive {
+		return mutexNone
+	}
+
+	if queue && wf.Queue != nil {
+		return mutexQueue
+	}
+
+	return mutexFail
+
+}
+
+// apiInvocation is an invocation through the API, as it is queued.
+type apiInvocation struct {
+	Namespace       string
+	Name            string
+	State           string         `json:",omitempty"`
+	ExternalID      string         `json:",omitempty"`
+	Input           []byte         `json:",omitempty"`
+	Caller          *subflowCaller `json:",omitempty"`
+	Timeout         string         `json:",omitempty"`
+	Mocks           []byte         `json:",omitempty"`
+	Breakpoints     []string       `json:",omitempty"`
+	DebugTransforms bool           `json:",omitempty"`
+	Verbose         bool           `json:",omitempty"`
+}
+
+func (we *workflowEngine) queueInvocation(ctx context.Context, wf uuid.UUID, wli *workflowLogicInstance, source string, params interface{}) error {
+
+	ttl, err := duration.ParseISO8601(wli.wf.Queue.TTL)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	err = we.db.addQueuedInvocation(ctx, wf, wli.id, source, data, ttl.Shift(we.clock.Now()))
+	if err != nil {
+		return err
+	}
+
+	wli.NamespaceLog("Workflow '%s' is busy, invocation %s queued.", wli.wf.ID, wli.id)
+
+	// the instance in the way may have finished in the meantime
+	go we.dequeueInvocation(wf)
+
+	return nil
+
+}
+
+// dequeueInvocation starts the next invocation queued for a workflow, if the
+// workflow is free.
+func (we *workflowEngine) dequeueInvocation(wf uuid.UUID) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	for {
+
+		qi, err := we.db.nextQueuedInvocation(ctx, wf)
+		if err != nil {
+			log.Errorf("cannot get queued invocations of workflow %s: %v", wf, err)
+			return
+		}
+
+		if qi == nil {
+			return
+		}
+
+		if we.clock.Now().After(qi.Expires) {
+			we.dropQueuedInvocation(ctx, qi, queueExpiredCode, "invocation expired in the queue")
+			continue
+		}
+
+		err = we.startQueuedInvocation(ctx, qi)
+		if err == errWorkflowBusy || status.Code(err) == codes.Unavailable {
+			return
+		}
+
+		if err != nil {
+			// another server started it first but hasn't removed it yet
+			if _, gerr := we.db.getWorkflowInstance(ctx, qi.InstanceID); gerr == nil {
+				err = we.db.deleteQueuedInvocation(ctx, qi.ID)
+				if err != nil {
+					log.Errorf("cannot remove started invocation %s from the queue: %v", qi.InstanceID, err)
+					return
+				}
+				continue
+			}
+			we.dropQueuedInvocation(ctx, qi, "direktiv.queue.failed", fmt.Sprintf("cannot start queued invocation: %v", err))
+			continue
+		}
+
+		err = we.db.deleteQueuedInvocation(ctx, qi.ID)
+		if err != nil {
+			log.Errorf("cannot remove started invocation %s from the queue: %v", qi.InstanceID, err)
+		}
+
+		return
+
+	}
+
+}
+
+func (we *workflowEngine) startQueuedInvocation(ctx context.Context, qi *ent.QueuedInvocation) error {
+
+	switch qi.Source {
+	case queuedFromAPI:
+
+		inv := new(apiInvocation)
+		err := json.Unmarshal(qi.Params, inv)
+		if err != nil {
+			return err
+		}
+
+		wli, _, err := we.prepareInvoke(ctx, inv, qi.InstanceID)
+		if err != nil {
+			return err
+		}
+
+		we.submitStart(wli)
+
+	case queuedFromEvents:
+
+		var raw []json.RawMessage
+		err := json.Unmarshal(qi.Params, &raw)
+		if err != nil {
+			return err
+		}
+
+		events := make([]*cloudevents.Event, len(raw))
+		for i := range raw {
+			events[i] = new(cloudevents.Event)
+			err = events[i].UnmarshalJSON(raw[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		return we.eventsInvoke(qi.Workflow, qi.InstanceID, events)
+
+	default:
+		return fmt.Errorf("unknown invocation source '%s'", qi.Source)
+	}
+
+	return nil
+
+}
+
+// dropQueuedInvocation removes an invocation that won't start from the queue.
+// Expired invocations are audited, and callers in other clusters are told it
+// failed.
+func (we *workflowEngine) dropQueuedInvocation(ctx context.Context, qi *ent.QueuedInvocation, code, msg string) {
+
+	err := we.db.deleteQueuedInvocation(ctx, qi.ID)
+	if err != nil {
+		log.Errorf("cannot remove invocation %s from the queue: %v", qi.InstanceID, err)
+		return
+	}
+
+	var ns *ent.Namespace
+	wf, err := we.db.getWorkflowByID(qi.Workflow)
+	if err == nil {
+		ns, err = wf.QueryNamespace().Only(ctx)
+	}
+
+	switch {
+	case err != nil:
+		log.Infof("Queued invocation %s dropped: %s", qi.InstanceID, msg)
+	case code == queueExpiredCode:
+		var notify bool
+		if def, err := we.loadWorkflow(ctx, wf); err == nil && def.Queue != nil {
+			notify = def.Queue.Notify
+		}
+		we.expireInvocation(ctx, &expiredInvocation{
+			ID:        qi.InstanceID,
+			Namespace: ns.ID,
+			Workflow:  wf.Name,
+			From:      expiredFromQueue,
+			Created:   qi.Created,
+			Expires:   qi.Expires,
+		}, notify)
+	default:
+		log.Infof("Queued invocation %s dropped: %s", qi.InstanceID, msg)
+		if l, err := we.server.instanceLogger.NamespaceLogger(ns.ID); err == nil {
+			l.Info(fmt.Sprintf("Queued invocation %s of workflow '%s' dropped: %s", qi.InstanceID, wf.Name, msg))
+			l.Close()
+		}
+	}
+
+	if qi.Source != queuedFromAPI {
+		return
+	}
+
+	inv := new(apiInvocation)
+	if json.Unmarshal(qi.Params, inv) != nil || inv.Caller == nil || inv.Caller.Callback == "" {
+		return
+	}
+
+	go we.reportToRemoteCaller(qi.InstanceID, inv.Caller, &actionResultPayload{
+		ActionID:     qi.InstanceID,
+		ErrorCode:    code,
+		ErrorMessage: msg,
+	})
+
+}
+
+// sweepInvocationQueue drops the expired invocations, and starts the queued
+// ones of workflows that are free.
+func (we *workflowEngine) sweepInvocationQueue() {
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	expired, err := we.db.expiredQueuedInvocations(ctx, we.clock.Now())
+	if err != nil {
+		log.Errorf("cannot get expired queued invocations: %v", err)
+		return
+	}
+
+	for _, qi := range expired {
+		we.dropQueuedInvocation(ctx, qi, queueExpiredCode, "invocation expired in the queue")
+	}
+
+	wfs, err := we.db.queuedWorkflows(ctx)
+	if err != nil {
+		log.Errorf("cannot get queued invocations: %v", err)
+		return
+	}
+
+	for _, wf := range wfs {
+		we.dequeueInvocation(wf)
+	}
+
+}
@@ -1,6 +1,10 @@
 package direktiv
 
-import "github.com/vorteil/direktiv/pkg/model"
+import (
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/model"
+)
 
 // headers for flow->container communication
 const (
@@ -46,8 +50,13 @@ type isolateRequest struct {
 type isolateContainer struct {
 	ID         string
 	Image, Cmd string
+	Service    string
+	HTTP       *model.HTTPDefinition
+	Lambda     string
+	Callback   bool
 	Data       []byte
 	Size       model.Size
+	Profile    string
 	Scale      int
 	Files      []model.FunctionFileDefinition
 }
@@ -60,4 +69,5 @@ type isolateWorkflow struct {
 	State      string
 	Step       int
 	Timeout    int
+	Deadline   time.Time // instance deadline, zero if unbounded
 }
@@ -0,0 +1,72 @@
+package direktiv
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/vorteil/direktiv/pkg/jqer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Every namespace has a library of jq modules that the jq queries of its
+// workflows can include or import by name, such as `include "dates";`, so
+// common transformation logic lives in one place instead of being copied
+// into every workflow that needs it. The modules of its ancestors are
+// available too, unless it has a module of the same name itself. Like
+// constants, the library is resolved whenever an instance is loaded.
+
+var jqModuleNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,63}$`)
+
+// resolveJQModules returns the jq modules the queries of a namespace's
+// workflows can use, or nil if there are none.
+func (db *dbManager) resolveJQModules(ctx context.Context, ns string) (*jqer.Modules, error) {
+
+	ancestors, err := db.namespaceAncestors(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	names := append([]string{ns}, ancestors...)
+	sources := make(map[string]string)
+
+	for i := len(names) - 1; i >= 0; i-- {
+
+		mods, err := db.getJQModules(ctx, names[i])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, mod := range mods {
+			sources[mod.Name] = mod.Source
+		}
+
+	}
+
+	if len(sources) == 0 {
+		return nil, nil
+	}
+
+	return jqer.NewModules(sources), nil
+
+}
+
+func (we *workflowEngine) setJQModule(ctx context.Context, ns, name, source string) error {
+
+	if !jqModuleNameRegex.MatchString(name) {
+		return grpc.Errorf(codes.InvalidArgument, "invalid module name '%s'", name)
+	}
+
+	err := jqer.ParseModule(source)
+	if err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "invalid jq module: %v", err)
+	}
+
+	err = we.db.setJQModule(ctx, ns, name, source)
+	if err != nil {
+		return grpcDatabaseError(err, "namespace", ns)
+	}
+
+	return nil
+
+}
@@ -0,0 +1,218 @@
+package direktiv
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+	"sync"
+
+	"github.com/vorteil/direktiv/pkg/model"
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Namespaces can store JSON Schemas that validate states reference by name,
+// instead of every workflow embedding its own copy. Storing a schema under a
+// name adds a new version of it, so workflows can pin the version they were
+// written against or follow the latest. A namespace's schemas are available
+// to its descendants too, unless they store a schema of the same name
+// themselves.
+//
+// Stored versions never change, so compiled schemas are cached by the hash
+// of their json, a cache the inline schemas of validate states share.
+
+const (
+	// maxJSONSchemaSize keeps schemas, and the grpc messages carrying them,
+	// small.
+	maxJSONSchemaSize = 1 << 20
+
+	// maxCachedJSONSchemas is how many compiled schemas the engine keeps.
+	maxCachedJSONSchemas = 256
+)
+
+var jsonSchemaNameRegex = regexp.MustCompile(model.JSONSchemaRegex)
+
+type jsonSchemaCache struct {
+	mtx      sync.Mutex
+	compiled map[string]*gojsonschema.Schema
+}
+
+func newJSONSchemaCache() *jsonSchemaCache {
+	return &jsonSchemaCache{
+		compiled: make(map[string]*gojsonschema.Schema),
+	}
+}
+
+// schema returns the compiled schema of a hash, compiling the json load
+// returns if it isn't cached.
+func (c *jsonSchemaCache) schema(hash string, load func() ([]byte, error)) (*gojsonschema.Schema, error) {
+
+	c.mtx.Lock()
+	compiled, ok := c.compiled[hash]
+	c.mtx.Unlock()
+
+	if ok {
+		return compiled, nil
+	}
+
+	data, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, err = gojsonschema.NewSchema(gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if len(c.compiled) >= maxCachedJSONSchemas {
+		for k := range c.compiled {
+			delete(c.compiled, k)
+			break
+		}
+	}
+
+	c.compiled[hash] = compiled
+
+	return compiled, nil
+
+}
+
+func jsonSchemaHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// resolveJSONSchema returns the namespace whose schema of a name the
+// workflows of ns reference, and the version and hash the reference
+// resolves to.
+func (db *dbManager) resolveJSONSchema(ctx context.Context, ns string, ref *model.JSONSchemaReference) (string, int, string, error) {
+
+	ancestors, err := db.namespaceAncestors(ctx, ns)
+	if err != nil {
+		return "", 0, "", err
+	}
+
+	for _, owner := range append([]string{ns}, ancestors...) {
+
+		version, hash, err := db.getJSONSchemaHash(ctx, owner, ref.Name, 0)
+		if err != nil {
+			return "", 0, "", err
+		}
+
+		if version == 0 {
+			continue
+		}
+
+		if ref.Version == 0 {
+			return owner, version, hash, nil
+		}
+
+		version, hash, err = db.getJSONSchemaHash(ctx, owner, ref.Name, ref.Version)
+		if err != nil {
+			return "", 0, "", err
+		}
+
+		if version == 0 {
+			return "", 0, "", NewCatchableError(ErrCodeSchemaNotFound, "json schema '%s' has no version %d", ref.Name, ref.Version)
+		}
+
+		return owner, version, hash, nil
+
+	}
+
+	return "", 0, "", NewCatchableError(ErrCodeSchemaNotFound, "json schema '%s' does not exist", ref.Name)
+
+}
+
+// validationSchema returns the compiled schema a validate state validates
+// against, either its own or the stored one it references.
+func (wli *workflowLogicInstance) validationSchema(ctx context.Context, state *model.ValidateState) (*gojsonschema.Schema, error) {
+
+	we := wli.engine
+
+	if state.SchemaRef == nil {
+
+		data, err := json.Marshal(state.Schema)
+		if err != nil {
+			return nil, NewInternalError(err)
+		}
+
+		compiled, err := we.schemas.schema(jsonSchemaHash(data), func() ([]byte, error) {
+			return data, nil
+		})
+		if err != nil {
+			return nil, NewInternalError(err)
+		}
+
+		return compiled, nil
+
+	}
+
+	ref := state.SchemaRef
+
+	owner, version, hash, err := we.db.resolveJSONSchema(ctx, wli.namespace, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	wli.Log("Validating against version %d of json schema '%s'.", version, ref.Name)
+
+	compiled, err := we.schemas.schema(hash, func() ([]byte, error) {
+		js, err := we.db.getJSONSchema(ctx, owner, ref.Name, version)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(js.Schema), nil
+	})
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	return compiled, nil
+
+}
+
+// addJSONSchema stores a schema as the next version of its name, and returns
+// that version.
+func (we *workflowEngine) addJSONSchema(ctx context.Context, ns, name string, data []byte) (int, error) {
+
+	if !jsonSchemaNameRegex.MatchString(name) {
+		return 0, grpc.Errorf(codes.InvalidArgument, "invalid schema name '%s'", name)
+	}
+
+	if len(data) > maxJSONSchemaSize {
+		return 0, grpc.Errorf(codes.InvalidArgument, "json schemas can't be larger than %d bytes", maxJSONSchemaSize)
+	}
+
+	var x interface{}
+	err := json.Unmarshal(data, &x)
+	if err != nil {
+		return 0, grpc.Errorf(codes.InvalidArgument, "schema is not valid json: %v", err)
+	}
+
+	// re-encoding gives the same schema the same hash, however it's formatted
+	data, err = json.Marshal(x)
+	if err != nil {
+		return 0, grpc.Errorf(codes.InvalidArgument, "schema is not valid json: %v", err)
+	}
+
+	_, err = gojsonschema.NewSchema(gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return 0, grpc.Errorf(codes.InvalidArgument, "invalid json schema: %v", err)
+	}
+
+	version, err := we.db.addJSONSchema(ctx, ns, name, string(data), jsonSchemaHash(data))
+	if err != nil {
+		return 0, grpcDatabaseError(err, "namespace", ns)
+	}
+
+	return version, nil
+
+}
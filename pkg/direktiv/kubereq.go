@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"sort"
 	"sync"
 
 	hash "github.com/mitchellh/hashstructure/v2"
@@ -94,6 +95,11 @@ func kubernetesActionServiceAccount(name string, create bool) error {
 		}
 
 		for _, ps := range sa.ImagePullSecrets {
+			// registries inherited from parent namespaces aren't ours
+			s, err := clientset.CoreV1().Secrets(kns).Get(context.Background(), ps.Name, metav1.GetOptions{})
+			if err != nil || s.Annotations[annotationNamespace] != name {
+				continue
+			}
 			err = clientset.CoreV1().Secrets(kns).Delete(context.Background(), ps.Name, metav1.DeleteOptions{})
 			if err != nil {
 				// we can keep going
@@ -287,6 +293,74 @@ func kubernetesSecretToServiceAccount(name, secret string) error {
 
 }
 
+// kubernetesSyncRegistries attaches the registries of a namespace and of its
+// ancestors, nearest first, to its service account. A registry host is only
+// pulled from with the credentials of the nearest namespace that has them.
+func kubernetesSyncRegistries(namespace string, ancestors []string) error {
+
+	clientset, kns, err := getClientSet()
+	if err != nil {
+		return err
+	}
+
+	var lo metav1.ListOptions
+	secrets, err := clientset.CoreV1().Secrets(kns).List(context.Background(), lo)
+	if err != nil {
+		return err
+	}
+
+	// secret names by namespace and registry host
+	hosts := make(map[string]map[string]string)
+	for _, s := range secrets.Items {
+
+		ns := s.Annotations[annotationNamespace]
+		u, err := url.Parse(s.Annotations[annotationURL])
+		if ns == "" || err != nil {
+			continue
+		}
+
+		if hosts[ns] == nil {
+			hosts[ns] = make(map[string]string)
+		}
+		hosts[ns][u.Hostname()] = s.Name
+
+	}
+
+	var pulls []v1.LocalObjectReference
+	covered := make(map[string]bool)
+
+	for _, ns := range append([]string{namespace}, ancestors...) {
+
+		var names []string
+		for host, name := range hosts[ns] {
+			if !covered[host] {
+				covered[host] = true
+				names = append(names, name)
+			}
+		}
+
+		sort.Strings(names)
+		for _, name := range names {
+			pulls = append(pulls, v1.LocalObjectReference{Name: name})
+		}
+
+	}
+
+	var opt metav1.GetOptions
+	sa, err := clientset.CoreV1().ServiceAccounts(kns).Get(context.Background(),
+		fmt.Sprintf("%s-%s", serviceAccountPrefix, namespace), opt)
+	if err != nil {
+		return err
+	}
+
+	sa.ImagePullSecrets = pulls
+
+	_, err = clientset.CoreV1().ServiceAccounts(kns).Update(context.Background(), sa, metav1.UpdateOptions{})
+
+	return err
+
+}
+
 func getClientSet() (*kubernetes.Clientset, string, error) {
 	config, err := rest.InClusterConfig()
 	if err != nil {
@@ -324,6 +398,11 @@ func deleteKnativeFunctions(uid string, db *dbManager) error {
 
 	for _, f := range wf.GetFunctions() {
 
+		// services and lambda functions aren't managed by direktiv
+		if f.Service != "" || f.Lambda != "" {
+			continue
+		}
+
 		var ir isolateRequest
 
 		ir.Workflow.Namespace = namespace
@@ -333,6 +412,7 @@ func deleteKnativeFunctions(uid string, db *dbManager) error {
 		ir.Container.Image = f.Image
 		ir.Container.Cmd = f.Cmd
 		ir.Container.Size = f.Size
+		ir.Container.Profile = f.Profile
 		ir.Container.Scale = f.Scale
 		ir.Container.ID = f.ID
 
@@ -385,7 +465,7 @@ func getKnativeFunction(svc string) error {
 	return nil
 }
 
-func addKnativeFunction(ir *isolateRequest) error {
+func addKnativeFunction(ir *isolateRequest, profile *resourceProfile) error {
 
 	log.Debugf("adding knative service")
 
@@ -398,28 +478,29 @@ func addKnativeFunction(ir *isolateRequest) error {
 
 	log.Debugf("adding knative service hash %v", ah)
 
-	var (
-		cpu float64
-		mem int
-	)
-
-	switch ir.Container.Size {
-	case 1:
-		cpu = 1
-		mem = 512
-	case 2:
-		cpu = 2
-		mem = 1024
-	default:
-		cpu = 0.5
-		mem = 256
+	if profile == nil {
+		profile = new(resourceProfile)
+		switch ir.Container.Size {
+		case 1:
+			profile.CPU = 1
+			profile.Memory = 512
+		case 2:
+			profile.CPU = 2
+			profile.Memory = 1024
+		default:
+			profile.CPU = 0.5
+			profile.Memory = 256
+		}
+		profile.CPULimit = profile.CPU * 2
+		profile.MemoryLimit = profile.Memory * 2
 	}
 
 	u := fmt.Sprintf(kubeAPIKServiceURL, os.Getenv(direktivWorkflowNamespace))
 
 	svc := fmt.Sprintf(kubeReq.serviceTempl, fmt.Sprintf("%s-%s", namespace, ah), ir.Container.Scale,
 		fmt.Sprintf("%s-%s", serviceAccountPrefix, namespace),
-		ir.Container.Image, cpu, fmt.Sprintf("%dM", mem), cpu*2, fmt.Sprintf("%dM", mem*2),
+		ir.Container.Image, profile.CPU, fmt.Sprintf("%dM", profile.Memory),
+		profile.CPULimit, fmt.Sprintf("%dM", profile.MemoryLimit), profile.limitsExtra(),
 		kubeReq.sidecar)
 
 	resp, err := sendKuberequest(http.MethodPost, u, bytes.NewBufferString(svc))
@@ -481,8 +562,17 @@ func k8sNamespace() string {
 
 func serviceToHash(ar *isolateRequest) (string, error) {
 
-	h, err := hash.Hash(fmt.Sprintf("%s-%s-%s", ar.Workflow.Namespace,
-		ar.Workflow.ID, ar.Container.ID), hash.FormatV2, nil)
+	key := fmt.Sprintf("%s-%s-%s", ar.Workflow.Namespace,
+		ar.Workflow.ID, ar.Container.ID)
+
+	// a function moved to another profile needs a service of its own, as
+	// the resources of existing services aren't updated. Functions without
+	// one keep the names they had before profiles existed.
+	if ar.Container.Profile != "" {
+		key = fmt.Sprintf("%s-%s", key, ar.Container.Profile)
+	}
+
+	h, err := hash.Hash(key, hash.FormatV2, nil)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,278 @@
+package direktiv
+
+import (
+	"context"
+	"database/sql"
+	"sync/atomic"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/ent/locklease"
+	"github.com/vorteil/direktiv/ent/predicate"
+)
+
+// Databases that speak the postgres protocol without advisory locks, such as
+// CockroachDB, get their locks from the lock_leases table instead. A lock is
+// a row leased by a server for lockLeaseDuration, which it renews while it
+// holds the lock and deletes to release it. Leases aren't tied to database
+// connections, so dropped connections don't lose locks, and the locks of a
+// server that dies are free again once their leases expire. Servers waiting
+// for a lock poll for it. Leases expire by the database's clock, so that
+// servers whose clocks disagree still agree on who holds a lock.
+
+const (
+	lockingAdvisory = "advisory"
+	lockingTable    = "table"
+
+	lockLeaseDuration = time.Second * 30
+	lockLeaseRenewal  = lockLeaseDuration / 3
+
+	lockLeaseMinPoll = time.Millisecond * 50
+	lockLeaseMaxPoll = time.Second
+)
+
+type lockLeases struct {
+	client *ent.Client
+	db     *sql.DB
+	holder string
+}
+
+// lockLease is a lease a server holds. lost is set once it failed to renew
+// the lease in time.
+type lockLease struct {
+	key   int64
+	token string
+	done  chan struct{}
+	lost  int32
+}
+
+// now returns the time by the database's clock.
+func (ll *lockLeases) now(ctx context.Context) (time.Time, error) {
+
+	var t time.Time
+	err := ll.db.QueryRowContext(ctx, "SELECT now()").Scan(&t)
+
+	return t, err
+
+}
+
+// unexpired matches the leases that haven't expired by the database's clock.
+func unexpired() predicate.LockLease {
+	return predicate.LockLease(func(s *entsql.Selector) {
+		s.Where(entsql.ExprP(s.C(locklease.FieldExpires) + " > now()"))
+	})
+}
+
+// try takes the lease of lock id if nobody holds it, or returns nil.
+func (ll *lockLeases) try(ctx context.Context, id uint64) (*lockLease, error) {
+
+	key := int64(id)
+	token := uuid.New().String()
+
+	now, err := ll.now(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := ll.client.LockLease.
+		Update().
+		Where(locklease.KeyEQ(key), locklease.ExpiresLT(now)).
+		SetHolder(ll.holder).
+		SetToken(token).
+		SetExpires(now.Add(lockLeaseDuration)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if n == 0 {
+		_, err = ll.client.LockLease.
+			Create().
+			SetKey(key).
+			SetHolder(ll.holder).
+			SetToken(token).
+			SetExpires(now.Add(lockLeaseDuration)).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	l := &lockLease{
+		key:   key,
+		token: token,
+		done:  make(chan struct{}),
+	}
+
+	go ll.renew(l)
+
+	return l, nil
+
+}
+
+// lock waits for the lease of lock id until ctx is done. If someone else
+// holds it, contended is called before waiting.
+func (ll *lockLeases) lock(ctx context.Context, id uint64, contended func()) (*lockLease, error) {
+
+	poll := lockLeaseMinPoll
+
+	for {
+
+		l, err := ll.try(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if l != nil {
+			return l, nil
+		}
+
+		if contended != nil {
+			contended()
+			contended = nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(poll):
+		}
+
+		poll *= 2
+		if poll > lockLeaseMaxPoll {
+			poll = lockLeaseMaxPoll
+		}
+
+	}
+
+}
+
+func (ll *lockLeases) renew(l *lockLease) {
+
+	ticker := time.NewTicker(lockLeaseRenewal)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.done:
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), lockLeaseRenewal)
+		now, err := ll.now(ctx)
+		var n int
+		if err == nil {
+			// a lease that expired may already belong to someone else
+			n, err = ll.client.LockLease.
+				Update().
+				Where(locklease.KeyEQ(l.key), locklease.TokenEQ(l.token), locklease.ExpiresGT(now)).
+				SetExpires(now.Add(lockLeaseDuration)).
+				Save(ctx)
+		}
+		cancel()
+		if err != nil {
+			log.Errorf("cannot renew lease of lock %d: %v", l.key, err)
+			continue
+		}
+
+		if n == 0 {
+			log.Errorf("lease of lock %d expired before it was renewed", l.key)
+			atomic.StoreInt32(&l.lost, 1)
+			return
+		}
+	}
+
+}
+
+// unlock stops renewing a lease and deletes it, unless it was taken over.
+func (ll *lockLeases) unlock(l *lockLease) error {
+
+	close(l.done)
+
+	_, err := ll.client.LockLease.
+		Delete().
+		Where(locklease.KeyEQ(l.key), locklease.TokenEQ(l.token)).
+		Exec(context.Background())
+
+	return err
+
+}
+
+// held reports whether a lease is still held.
+func (ll *lockLeases) held(ctx context.Context, l *lockLease) bool {
+
+	if atomic.LoadInt32(&l.lost) != 0 {
+		return false
+	}
+
+	n, err := ll.client.LockLease.
+		Query().
+		Where(
+			locklease.KeyEQ(l.key),
+			locklease.TokenEQ(l.token),
+			unexpired(),
+		).
+		Count(ctx)
+
+	return err == nil && n > 0
+
+}
+
+// holderOf returns the hostname of the server leasing lock id, or
+// sql.ErrNoRows if nobody does.
+func (ll *lockLeases) holderOf(ctx context.Context, id uint64) (string, error) {
+
+	l, err := ll.client.LockLease.
+		Query().
+		Where(
+			locklease.KeyEQ(int64(id)),
+			unexpired(),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", sql.ErrNoRows
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return l.Holder, nil
+
+}
+
+// breakLease deletes the lease of lock id, whoever holds it.
+func (ll *lockLeases) breakLease(ctx context.Context, id uint64) (bool, error) {
+
+	n, err := ll.client.LockLease.
+		Delete().
+		Where(locklease.KeyEQ(int64(id))).
+		Exec(ctx)
+
+	return n > 0, err
+
+}
+
+// purge deletes the leases that expired a while ago, left behind by servers
+// that died holding them.
+func (ll *lockLeases) purge(ctx context.Context) error {
+
+	now, err := ll.now(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = ll.client.LockLease.
+		Delete().
+		Where(locklease.ExpiresLT(now.Add(-lockLeaseDuration))).
+		Exec(ctx)
+
+	return err
+
+}
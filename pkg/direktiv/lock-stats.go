@@ -0,0 +1,176 @@
+package direktiv
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// instanceLockStats is how the instance locks of a server are doing, as
+// reported with its node heartbeat. Waits are in milliseconds: MaxWait is the
+// worst since the previous heartbeat. Contended locks were held by someone
+// else when the server asked for them, failed ones it gave up on after the
+// configured lock wait.
+type instanceLockStats struct {
+	Acquired   int64      `json:"acquired"`
+	Contended  int64      `json:"contended"`
+	Failed     int64      `json:"failed"`
+	AvgWait    int64      `json:"avgWait"`
+	MaxWait    int64      `json:"maxWait"`
+	LastFailed *time.Time `json:"lastFailed,omitempty"`
+	LastError  string     `json:"lastError,omitempty"`
+}
+
+// lockStats counts the instance locks a server acquired, waited for and
+// failed to get since it started.
+type lockStats struct {
+	mtx        sync.Mutex
+	acquires   int64
+	contention int64
+	failures   int64
+	totalWait  time.Duration
+	maxWait    time.Duration
+	lastFailed time.Time
+	lastError  string
+}
+
+func newLockStats() *lockStats {
+	return new(lockStats)
+}
+
+func (ls *lockStats) acquired(wait time.Duration, contended bool) {
+
+	ls.mtx.Lock()
+	defer ls.mtx.Unlock()
+
+	ls.acquires++
+	ls.totalWait += wait
+
+	if contended {
+		ls.contention++
+	}
+
+	if wait > ls.maxWait {
+		ls.maxWait = wait
+	}
+
+}
+
+func (ls *lockStats) failed(wait time.Duration, err error) {
+
+	ls.mtx.Lock()
+	defer ls.mtx.Unlock()
+
+	ls.contention++
+	ls.failures++
+	ls.lastFailed = time.Now()
+	ls.lastError = err.Error()
+
+	if wait > ls.maxWait {
+		ls.maxWait = wait
+	}
+
+}
+
+// snapshot returns the stats, and starts measuring the worst wait anew.
+func (ls *lockStats) snapshot() instanceLockStats {
+
+	ls.mtx.Lock()
+	defer ls.mtx.Unlock()
+
+	s := instanceLockStats{
+		Acquired:  ls.acquires,
+		Contended: ls.contention,
+		Failed:    ls.failures,
+		MaxWait:   ls.maxWait.Milliseconds(),
+		LastError: ls.lastError,
+	}
+
+	if ls.acquires > 0 {
+		s.AvgWait = (ls.totalWait / time.Duration(ls.acquires)).Milliseconds()
+	}
+
+	if ls.failures > 0 {
+		t := ls.lastFailed
+		s.LastFailed = &t
+	}
+
+	ls.maxWait = 0
+
+	return s
+
+}
+
+// lockHolderName describes who holds a lock, for the logs.
+func (we *workflowEngine) lockHolderName(holder lockHolderFunc) string {
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	pid, node, err := holder(ctx)
+	if err == sql.ErrNoRows {
+		return "nobody anymore"
+	}
+	if err != nil {
+		return "an unknown holder"
+	}
+
+	if node == "" {
+		return fmt.Sprintf("connection %d", pid)
+	}
+
+	name := "node " + node
+	if lockAppName(node) == lockAppName(we.server.hostname) {
+		name = "this node"
+	}
+
+	if pid == 0 {
+		return name
+	}
+
+	return fmt.Sprintf("%s (connection %d)", name, pid)
+
+}
+
+// lockWait is how long to wait for the lock of an instance.
+func (we *workflowEngine) lockWait() time.Duration {
+
+	if we.server.config.LockWait <= 0 {
+		return time.Second * defaultLockWait
+	}
+
+	return time.Second * time.Duration(we.server.config.LockWait)
+
+}
+
+// lockInstance waits up to timeout for the lock of instance id, counting and
+// logging contention and failures.
+func (we *workflowEngine) lockInstance(id string, hash uint64, timeout time.Duration) (*dbLock, time.Duration, error) {
+
+	var contended bool
+
+	t := time.Now()
+
+	l, err := we.db.waitLockDB(hash, int(timeout.Seconds()), func(holder lockHolderFunc) {
+		contended = true
+		log.Infof("instance %s is locked by %s, waiting up to %v", id, we.lockHolderName(holder), timeout)
+	})
+	wait := time.Since(t)
+	if err != nil {
+		we.locks.failed(wait, err)
+		log.Warnf("cannot lock instance %s within %v, it is held by %s: %v", id, timeout,
+			we.lockHolderName(func(ctx context.Context) (int, string, error) {
+				return we.db.lockHolder(ctx, hash)
+			}), err)
+		return nil, wait, err
+	}
+
+	we.locks.acquired(wait, contended)
+
+	return l, wait, nil
+
+}
@@ -0,0 +1,495 @@
+package direktiv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	lua "github.com/yuin/gopher-lua"
+	"github.com/yuin/gopher-lua/pm"
+)
+
+// gopher-lua can't cap the memory of a state, so scripts are metered instead.
+// The vm checks its context for cancellation before every instruction, which
+// lets luaBudget step in on the script's own goroutine: it sums the strings
+// in the registers of the running function, which catches strings doubling
+// themselves by concatenation, and every so often it walks all values the
+// script can reach, roughly as often as the walk costs instructions. Library functions that build strings check the
+// size of their result against the budget before building it.
+
+const (
+	// luaWalkInterval is how many instructions at least pass between walks
+	// of everything a script can reach.
+	luaWalkInterval = 1024
+
+	// luaLongString is the length from which strings are counted once, no
+	// matter how many values refer to them.
+	luaLongString = 1024
+)
+
+var errLuaMemory = errors.New("lua memory limit exceeded")
+
+type luaBudget struct {
+	context.Context
+	L *lua.LState
+
+	limit    int
+	used     int
+	steps    int
+	nextWalk int
+
+	exceeded chan struct{}
+	err      error
+}
+
+func newLuaBudget(ctx context.Context, L *lua.LState, limit int) *luaBudget {
+	return &luaBudget{
+		Context:  ctx,
+		L:        L,
+		limit:    limit,
+		nextWalk: luaWalkInterval,
+		exceeded: make(chan struct{}),
+	}
+}
+
+// Done is called by the vm before every instruction. Contexts must not be
+// derived from a budget, as they might call it from other goroutines.
+func (b *luaBudget) Done() <-chan struct{} {
+
+	if b.err == nil {
+
+		b.steps++
+
+		if b.steps >= b.nextWalk {
+			b.walk()
+		} else {
+			b.checkRegisters()
+		}
+
+	}
+
+	if b.err != nil {
+		return b.exceeded
+	}
+
+	return b.Context.Done()
+
+}
+
+func (b *luaBudget) Err() error {
+
+	if b.err != nil {
+		return b.err
+	}
+
+	return b.Context.Err()
+
+}
+
+func (b *luaBudget) exceed() {
+	if b.err == nil {
+		b.err = errLuaMemory
+		close(b.exceeded)
+	}
+}
+
+// reserve raises an error in the script if building n more bytes would
+// exceed the budget.
+func (b *luaBudget) reserve(n int) {
+
+	if n < 0 || b.used+n > b.limit {
+		b.exceed()
+		b.L.RaiseError(errLuaMemory.Error())
+	}
+
+}
+
+func (b *luaBudget) checkRegisters() {
+
+	n := b.used
+
+	top := b.L.GetTop()
+	for i := 1; i <= top; i++ {
+		if s, ok := b.L.Get(i).(lua.LString); ok {
+			n += len(s)
+		}
+	}
+
+	if n > b.limit {
+		b.exceed()
+	}
+
+}
+
+func (b *luaBudget) walk() {
+
+	w := &luaWalker{
+		tables:    make(map[*lua.LTable]bool),
+		functions: make(map[*lua.LFunction]bool),
+		strings:   make(map[string]bool),
+	}
+
+	L := b.L
+
+	w.value(L.G.Global)
+	w.value(L.G.Registry)
+
+	for level := 0; ; level++ {
+
+		dbg, ok := L.GetStack(level)
+		if !ok {
+			break
+		}
+
+		if fn, err := L.GetInfo("f", dbg, lua.LNil); err == nil {
+			w.value(fn)
+		}
+
+		for i := 1; ; i++ {
+			name, v := L.GetLocal(dbg, i)
+			if name == "" {
+				break
+			}
+			w.value(v)
+		}
+
+	}
+
+	top := L.GetTop()
+	for i := 1; i <= top; i++ {
+		w.value(L.Get(i))
+	}
+
+	b.used = w.size
+
+	interval := w.visits
+	if interval < luaWalkInterval {
+		interval = luaWalkInterval
+	}
+	b.nextWalk = b.steps + interval
+
+	if b.used > b.limit {
+		b.exceed()
+	}
+
+}
+
+// luaWalker estimates the memory of the values it visits.
+type luaWalker struct {
+	tables    map[*lua.LTable]bool
+	functions map[*lua.LFunction]bool
+	strings   map[string]bool
+	size      int
+	visits    int
+}
+
+func (w *luaWalker) value(v lua.LValue) {
+
+	w.visits++
+
+	switch x := v.(type) {
+
+	case lua.LString:
+		if len(x) >= luaLongString {
+			if w.strings[string(x)] {
+				return
+			}
+			w.strings[string(x)] = true
+		}
+		w.size += 16 + len(x)
+
+	case *lua.LTable:
+		if w.tables[x] {
+			return
+		}
+		w.tables[x] = true
+		w.size += 64
+		x.ForEach(func(k, elem lua.LValue) {
+			w.size += 32
+			w.value(k)
+			w.value(elem)
+		})
+		if mt, ok := x.Metatable.(*lua.LTable); ok {
+			w.value(mt)
+		}
+
+	case *lua.LFunction:
+		if w.functions[x] {
+			return
+		}
+		w.functions[x] = true
+		w.size += 64
+		for _, uv := range x.Upvalues {
+			w.value(uv.Value())
+		}
+		w.value(x.Env)
+
+	case *lua.LUserData:
+		w.size += 64
+
+	default:
+		w.size += 16
+
+	}
+
+}
+
+// budgetedStringLibs wraps or replaces the library functions that can build
+// strings much larger than their arguments, so that they check the budget
+// first.
+func budgetedStringLibs(L *lua.LState, b *luaBudget) {
+
+	strlib := L.GetGlobal(lua.StringLibName).(*lua.LTable)
+	tablib := L.GetGlobal(lua.TabLibName).(*lua.LTable)
+
+	strlib.RawSetString("rep", L.NewFunction(func(L *lua.LState) int {
+
+		s := L.CheckString(1)
+		n := L.CheckInt(2)
+
+		if n <= 0 {
+			L.Push(lua.LString(""))
+			return 1
+		}
+
+		if len(s) > 0 && n > b.limit/len(s) {
+			b.reserve(-1)
+		}
+		b.reserve(len(s) * n)
+
+		L.Push(lua.LString(strings.Repeat(s, n)))
+
+		return 1
+
+	}))
+
+	wrap := func(lib *lua.LTable, name string, bound func(L *lua.LState) int) {
+		fn := lib.RawGetString(name).(*lua.LFunction).GFunction
+		lib.RawSetString(name, L.NewFunction(func(L *lua.LState) int {
+			b.reserve(bound(L))
+			return fn(L)
+		}))
+	}
+
+	wrap(strlib, "format", func(L *lua.LState) int {
+		f := L.CheckString(1)
+		widths := luaFormatWidths(f)
+		if widths < 0 {
+			return -1
+		}
+		n := len(f) + widths
+		for i := 2; i <= L.GetTop(); i++ {
+			n += 32
+			if s, ok := L.Get(i).(lua.LString); ok {
+				n += len(s)
+			}
+		}
+		return n
+	})
+
+	strlib.RawSetString("gsub", L.NewFunction(func(L *lua.LState) int {
+		return luaGsub(L, b)
+	}))
+
+	wrap(tablib, "concat", func(L *lua.LState) int {
+		t := L.CheckTable(1)
+		sep := len(L.OptString(2, ""))
+		n := 0
+		for i := 1; i <= t.Len(); i++ {
+			v := t.RawGetInt(i)
+			if v == lua.LNil {
+				break
+			}
+			n += len(v.String()) + sep
+			if n > b.limit {
+				return -1
+			}
+		}
+		return n
+	})
+
+}
+
+// luaGsub is string.gsub, built up in one pass. gopher-lua's copies the whole
+// string for every replacement, which takes long enough for scripts to
+// outlast their timeout within a single call. This one checks the budget and
+// the timeout as the result grows.
+func luaGsub(L *lua.LState, b *luaBudget) int {
+
+	str := L.CheckString(1)
+	pat := L.CheckString(2)
+	L.CheckTypes(3, lua.LTString, lua.LTTable, lua.LTFunction)
+	repl := L.CheckAny(3)
+	limit := L.OptInt(4, -1)
+
+	src := []byte(str)
+
+	var out strings.Builder
+	var n, last int
+
+	for pos := 0; limit < 0 || n < limit; {
+
+		matches, err := pm.Find(pat, src, pos, 1)
+		if err != nil {
+			L.RaiseError(err.Error())
+		}
+		if len(matches) == 0 {
+			break
+		}
+
+		m := matches[0]
+		start, end := m.Capture(0), m.Capture(1)
+
+		out.WriteString(str[last:start])
+		out.WriteString(luaGsubReplacement(L, str, m, repl))
+		last = end
+		n++
+
+		b.reserve(out.Len())
+
+		// the vm can't stop scripts while they're in here
+		if n%1024 == 0 && b.Context.Err() != nil {
+			L.RaiseError(b.Context.Err().Error())
+		}
+
+		// an empty match moves on by a byte, anchored patterns match once
+		pos = end
+		if end == start {
+			if end == len(str) {
+				break
+			}
+			out.WriteByte(str[end])
+			last++
+			pos++
+		}
+
+		if strings.HasPrefix(pat, "^") {
+			break
+		}
+
+	}
+
+	out.WriteString(str[last:])
+
+	L.Push(lua.LString(out.String()))
+	L.Push(lua.LNumber(n))
+
+	return 2
+
+}
+
+func luaGsubReplacement(L *lua.LState, str string, m *pm.MatchData, repl lua.LValue) string {
+
+	match := str[m.Capture(0):m.Capture(1)]
+
+	capture := func(i int) lua.LValue {
+		// %1 is the whole match of patterns without captures
+		if i == 1 && m.CaptureLength() <= 2 {
+			i = 0
+		}
+		if i != 0 && 2*i >= m.CaptureLength() {
+			L.RaiseError("invalid capture index")
+		}
+		if m.IsPosCapture(2 * i) {
+			return lua.LNumber(m.Capture(2 * i))
+		}
+		return lua.LString(str[m.Capture(2*i):m.Capture(2*i+1)])
+	}
+
+	var value lua.LValue
+
+	switch x := repl.(type) {
+
+	case lua.LString:
+		var sb strings.Builder
+		for i := 0; i < len(x); i++ {
+			c := x[i]
+			if c != '%' || i == len(x)-1 {
+				sb.WriteByte(c)
+				continue
+			}
+			i++
+			if x[i] >= '0' && x[i] <= '9' {
+				sb.WriteString(capture(int(x[i] - '0')).String())
+			} else {
+				sb.WriteByte(x[i])
+			}
+		}
+		return sb.String()
+
+	case *lua.LTable:
+		value = L.GetTable(x, capture(1))
+
+	case *lua.LFunction:
+		L.Push(x)
+		args := 1
+		if m.CaptureLength() > 2 {
+			args = m.CaptureLength()/2 - 1
+		}
+		for i := 1; i <= args; i++ {
+			L.Push(capture(i))
+		}
+		L.Call(args, 1)
+		value = L.Get(-1)
+		L.Pop(1)
+
+	}
+
+	switch value.(type) {
+	case lua.LString, lua.LNumber:
+		return value.String()
+	}
+
+	if lua.LVIsFalse(value) {
+		return match
+	}
+
+	L.RaiseError(fmt.Sprintf("invalid replacement value (a %s)", value.Type().String()))
+
+	return ""
+
+}
+
+// luaFormatWidths sums the widths and precisions of the verbs of a format
+// string, which Sprintf pads results to.
+func luaFormatWidths(f string) int {
+
+	n := 0
+
+	for i := 0; i < len(f); i++ {
+
+		if f[i] != '%' {
+			continue
+		}
+
+		i++
+
+		for i < len(f) && strings.IndexByte("-+ #0", f[i]) >= 0 {
+			i++
+		}
+
+		// the width, then the precision
+		for part := 0; part < 2; part++ {
+
+			x := 0
+			for ; i < len(f) && f[i] >= '0' && f[i] <= '9'; i++ {
+				x = x*10 + int(f[i]-'0')
+				if x > 1<<30 {
+					return -1
+				}
+			}
+			n += x
+
+			if i >= len(f) || f[i] != '.' {
+				break
+			}
+			i++
+
+		}
+
+	}
+
+	return n
+
+}
@@ -0,0 +1,112 @@
+package direktiv
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/ent"
+)
+
+// Instance memory is stored as binary, prefixed by a byte naming its encoding
+// so that memory saved before a change of compression can still be read.
+const (
+	memoryEncodingRaw byte = iota
+	memoryEncodingGzip
+)
+
+// memory smaller than this isn't worth compressing
+const minCompressedMemory = 1024
+
+func memoryEncoding(compression string) (byte, error) {
+
+	switch compression {
+	case "", "none":
+		return memoryEncodingRaw, nil
+	case "gzip":
+		return memoryEncodingGzip, nil
+	default:
+		return 0, fmt.Errorf("unsupported memory compression '%s'", compression)
+	}
+
+}
+
+func encodeMemory(encoding byte, data []byte) ([]byte, error) {
+
+	if encoding == memoryEncodingRaw || len(data) < minCompressedMemory {
+		return append([]byte{memoryEncodingRaw}, data...), nil
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteByte(memoryEncodingGzip)
+
+	zw := gzip.NewWriter(buf)
+
+	_, err := zw.Write(data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = zw.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+
+}
+
+func decodeMemory(data []byte) ([]byte, error) {
+
+	if len(data) == 0 {
+		return nil, errors.New("missing memory encoding")
+	}
+
+	switch data[0] {
+	case memoryEncodingRaw:
+		return data[1:], nil
+	case memoryEncodingGzip:
+		zr, err := gzip.NewReader(bytes.NewReader(data[1:]))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		return ioutil.ReadAll(zr)
+	default:
+		return nil, fmt.Errorf("unknown memory encoding %d", data[0])
+	}
+
+}
+
+// InstanceMemory returns the savedata of the instance's current state. Memory
+// saved by older servers as base64 text is still understood.
+func InstanceMemory(rec *ent.WorkflowInstance) ([]byte, error) {
+
+	if len(rec.MemoryData) > 0 {
+		savedata, err := decodeMemory(rec.MemoryData)
+		if err != nil {
+			err = fmt.Errorf("cannot decode the savedata: %v", err)
+			log.Error(err)
+			return nil, err
+		}
+		return savedata, nil
+	}
+
+	if rec.Memory == "" {
+		return nil, nil
+	}
+
+	savedata, err := base64.StdEncoding.DecodeString(rec.Memory)
+	if err != nil {
+		err = fmt.Errorf("cannot decode the savedata: %v", err)
+		log.Error(err)
+		return nil, err
+	}
+
+	return savedata, nil
+
+}
@@ -0,0 +1,474 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"hash/fnv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	nodeHeartbeatInterval = time.Second * 15
+
+	// nodes that have missed this many heartbeats are presumed dead
+	nodeMissedHeartbeats = 3
+
+	// records of dead nodes are removed after this long
+	nodeRecordExpiry = time.Hour
+
+	adoptInstanceAction = "adoptInstance"
+	queuedStatesAction  = "queuedStates"
+)
+
+// Every flow server registers itself as a node and the instance's controller
+// records the node that owns it. Timers only exist in the memory of the node
+// that scheduled them, so a node that is stopped gracefully first drains its
+// work queue and then hands each of its instances, together with their
+// timers, to one of the remaining live nodes. The successor of an instance is
+// chosen by rendezvous hashing over the live nodes, so handoffs are spread
+// evenly and any node draining at the same moment picks the same successor.
+// States the node's work queue persisted go to the same successors. Instances
+// of a node that dies without draining are still recovered by their
+// deadlines, and its persisted states are adopted by the successors the live
+// nodes agree on.
+
+func (we *workflowEngine) startNode() error {
+
+	ctx := context.Background()
+
+	err := we.db.registerNode(ctx, we.server.hostname, we.promoted)
+	if err != nil {
+		return err
+	}
+
+	err = we.loadInvocationHalt(ctx)
+	if err != nil {
+		return err
+	}
+
+	we.nodeDone = make(chan bool)
+	go we.nodeHeartbeat()
+	go we.flushEventStats()
+
+	if we.stealing() {
+		go we.stealWork()
+	}
+
+	if we.monitorsDeadlines() {
+		go we.monitorDeadlines()
+	}
+
+	if we.actions.max > 0 {
+		go we.sampleNamespaceActions()
+	}
+
+	// instances may have been handed to this hostname while it was down
+	we.adoptInstances()
+
+	we.queue.recount()
+
+	return nil
+
+}
+
+func (we *workflowEngine) nodeHeartbeat() {
+
+	ticker := time.NewTicker(nodeHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-we.nodeDone:
+			return
+		case <-ticker.C:
+		}
+
+		stats, err := json.Marshal(we.timer.statsSnapshot())
+		if err != nil {
+			log.Errorf("cannot encode timer stats: %v", err)
+		}
+
+		locks, err := json.Marshal(we.locks.snapshot())
+		if err != nil {
+			log.Errorf("cannot encode lock stats: %v", err)
+		}
+
+		err = we.db.heartbeatNode(context.Background(), we.server.hostname, time.Now().Add(-nodeRecordExpiry),
+			we.instanceTimerCount(), we.queue.length(), stats, locks)
+		if err != nil {
+			log.Errorf("cannot update node heartbeat: %v", err)
+		}
+
+		we.reloadInvocationHalt()
+
+		go we.sweepInvocationQueue()
+
+		go we.sweepScheduledInvocations()
+
+		if we.db.leases != nil {
+			err = we.db.leases.purge(context.Background())
+			if err != nil {
+				log.Errorf("cannot purge expired lock leases: %v", err)
+			}
+		}
+
+		// picks up handoffs whose notification was missed
+		we.adoptInstances()
+
+		we.adoptQueuedStates()
+
+		we.queue.recount()
+	}
+
+}
+
+func (we *workflowEngine) instanceTimerCount() int {
+
+	var n int
+	for _, ti := range we.timer.oneShotTimers() {
+		if snapshotTimerFunctions[ti.fnName] {
+			n++
+		}
+	}
+
+	return n
+
+}
+
+// nodeHealthy reports whether a node's heartbeat is recent enough for it to
+// receive handoffs.
+func nodeHealthy(heartbeat time.Time) bool {
+	return heartbeat.After(time.Now().Add(-nodeHeartbeatInterval * nodeMissedHeartbeats))
+}
+
+// successor returns the node an instance is handed to.
+func successor(id string, nodes []string) string {
+
+	var best string
+	var top uint64
+
+	for _, n := range nodes {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(n))
+		_, _ = h.Write([]byte(id))
+		if v := h.Sum64(); best == "" || v > top {
+			best = n
+			top = v
+		}
+	}
+
+	return best
+
+}
+
+// drain hands every instance this node owns or holds timers for to another
+// live node, after waiting for the states it is running to finish.
+func (we *workflowEngine) drain() {
+
+	if we.nodeDone == nil {
+		return
+	}
+
+	ctx := context.Background()
+	hostname := we.server.hostname
+
+	err := we.db.setNodeDraining(ctx, hostname)
+	if err != nil {
+		log.Errorf("cannot mark node as draining: %v", err)
+	}
+
+	close(we.nodeDone)
+
+	timeout := time.Duration(we.server.config.DrainTimeout) * time.Second
+	if !we.queue.drain(timeout) {
+		log.Warnf("still running states after waiting %v, handing off anyway", timeout)
+	}
+
+	defer func() {
+		err := we.db.deleteNode(ctx, hostname)
+		if err != nil {
+			log.Errorf("cannot remove node record: %v", err)
+		}
+	}()
+
+	nodes, err := we.db.getLiveNodes(ctx, hostname, time.Now().Add(-nodeHeartbeatInterval*nodeMissedHeartbeats))
+	if err != nil {
+		log.Errorf("cannot list live nodes: %v", err)
+		return
+	}
+
+	if len(nodes) == 0 {
+		log.Warnf("no live node to hand instances to, they will be recovered by their deadlines")
+		return
+	}
+
+	we.handOffQueuedStates(ctx, nodes)
+
+	ids, err := we.db.getOwnedInstances(ctx, hostname)
+	if err != nil {
+		log.Errorf("cannot list owned instances: %v", err)
+		return
+	}
+
+	timers := make(map[string][]snapshotTimer)
+	for _, ti := range we.timer.oneShotTimers() {
+		if !snapshotTimerFunctions[ti.fnName] {
+			continue
+		}
+		owner := new(struct{ InstanceID string })
+		if json.Unmarshal(ti.data, owner) != nil || owner.InstanceID == "" {
+			continue
+		}
+		timers[owner.InstanceID] = append(timers[owner.InstanceID], snapshotTimer{
+			Name:     ti.name,
+			Function: ti.fnName,
+			Time:     *ti.oneshot.time,
+			Data:     json.RawMessage(ti.data),
+		})
+	}
+
+	for id := range timers {
+		if !contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	var n int
+	for _, id := range ids {
+		err = we.handOffInstance(ctx, id, timers[id], nodes)
+		if err != nil {
+			log.Errorf("cannot hand off instance %s: %v", id, err)
+			continue
+		}
+		n++
+	}
+
+	log.Infof("handed %d instances to %d nodes", n, len(nodes))
+
+}
+
+// handOffInstance records timers with an instance for the node that takes it
+// over. The owner of an instance stays its owner if it is still live.
+func (we *workflowEngine) handOffInstance(ctx context.Context, id string, timers []snapshotTimer, nodes []string) error {
+
+	wli := new(workflowLogicInstance)
+	wli.id = id
+	wli.engine = we
+
+	_, err := wli.lock(we.lockWait())
+	if err != nil {
+		return err
+	}
+	defer wli.unlock()
+
+	// local timers are stopped either way, the new owner reschedules them
+	defer we.timer.deleteTimersForInstanceNoBroadcast(id)
+
+	rec, err := we.db.getWorkflowInstance(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if rec.Status != "pending" {
+		return nil
+	}
+
+	to := rec.Controller
+	if to == we.server.hostname || !contains(nodes, to) {
+		to = successor(id, nodes)
+	}
+
+	// timers handed over earlier and not yet adopted are kept
+	if len(rec.Handoff) > 0 {
+		var pending []snapshotTimer
+		err = json.Unmarshal(rec.Handoff, &pending)
+		if err != nil {
+			return err
+		}
+		timers = append(pending, timers...)
+	}
+
+	if timers == nil {
+		timers = []snapshotTimer{}
+	}
+
+	data, err := json.Marshal(timers)
+	if err != nil {
+		return err
+	}
+
+	err = we.db.handOffInstance(ctx, rec, to, data)
+	if err != nil {
+		return err
+	}
+
+	return publishToHostname(we.db, to, map[string]interface{}{
+		"action":   adoptInstanceAction,
+		"instance": id,
+	})
+
+}
+
+func (we *workflowEngine) adoptInstances() {
+
+	ids, err := we.db.getHandedOffInstances(context.Background(), we.server.hostname)
+	if err != nil {
+		log.Errorf("cannot list instances handed to this node: %v", err)
+		return
+	}
+
+	for _, id := range ids {
+		we.adoptInstance(id)
+	}
+
+}
+
+// adoptInstance schedules the timers of an instance handed to this node.
+func (we *workflowEngine) adoptInstance(id string) {
+
+	ctx := context.Background()
+
+	wli := new(workflowLogicInstance)
+	wli.id = id
+	wli.engine = we
+
+	_, err := wli.lock(we.lockWait())
+	if err != nil {
+		log.Errorf("cannot adopt instance %s: %v", id, err)
+		return
+	}
+	defer wli.unlock()
+
+	rec, err := we.db.getWorkflowInstance(ctx, id)
+	if err != nil {
+		log.Errorf("cannot adopt instance %s: %v", id, err)
+		return
+	}
+
+	if rec.Controller != we.server.hostname || len(rec.Handoff) == 0 {
+		return
+	}
+
+	var timers []snapshotTimer
+	err = json.Unmarshal(rec.Handoff, &timers)
+	if err != nil {
+		log.Errorf("cannot adopt instance %s: %v", id, err)
+	}
+
+	err = we.db.clearHandoff(ctx, rec)
+	if err != nil {
+		log.Errorf("cannot adopt instance %s: %v", id, err)
+		return
+	}
+
+	if rec.Status != "pending" {
+		return
+	}
+
+	for _, t := range timers {
+		we.restoreTimer(t)
+	}
+
+	log.Infof("adopted instance %s with %d timers", id, len(timers))
+
+}
+
+// handOffQueuedStates moves the states persisted for this node to the
+// successors of their instances.
+func (we *workflowEngine) handOffQueuedStates(ctx context.Context, nodes []string) {
+
+	hostname := we.server.hostname
+
+	states, err := we.db.getNodeQueuedStates(ctx, hostname)
+	if err != nil {
+		log.Errorf("cannot list queued states: %v", err)
+		return
+	}
+
+	var n int
+	moved := make(map[string]bool)
+
+	for _, qs := range states {
+
+		to := successor(qs.InstanceID, nodes)
+
+		err = we.db.moveQueuedState(ctx, qs, to)
+		if err != nil {
+			log.Errorf("cannot hand off queued state of %s: %v", qs.InstanceID, err)
+			continue
+		}
+
+		moved[to] = true
+		n++
+
+	}
+
+	for to := range moved {
+		err := publishToHostname(we.db, to, map[string]interface{}{
+			"action": queuedStatesAction,
+		})
+		if err != nil {
+			log.Errorf("cannot notify %s of queued states: %v", to, err)
+		}
+	}
+
+	if n > 0 {
+		log.Infof("handed %d queued states to %d nodes", n, len(moved))
+	}
+
+}
+
+// adoptQueuedStates takes over the states persisted for nodes that have died
+// whose instances this node is the successor of.
+func (we *workflowEngine) adoptQueuedStates() {
+
+	ctx := context.Background()
+	hostname := we.server.hostname
+
+	nodes, err := we.db.getLiveNodes(ctx, hostname, time.Now().Add(-nodeHeartbeatInterval*nodeMissedHeartbeats))
+	if err != nil {
+		log.Errorf("cannot list live nodes: %v", err)
+		return
+	}
+	nodes = append(nodes, hostname)
+
+	// draining nodes hand off their own states
+	all, err := we.db.getNodes(ctx)
+	if err != nil {
+		log.Errorf("cannot list nodes: %v", err)
+		return
+	}
+
+	var keep []string
+	for _, node := range all {
+		if node.Draining && nodeHealthy(node.Heartbeat) {
+			keep = append(keep, node.Hostname)
+		}
+	}
+
+	states, err := we.db.getForeignQueuedStates(ctx, append(keep, nodes...))
+	if err != nil {
+		log.Errorf("cannot list queued states of dead nodes: %v", err)
+		return
+	}
+
+	var n int
+	for _, qs := range states {
+		if successor(qs.InstanceID, nodes) != hostname {
+			continue
+		}
+		err = we.db.moveQueuedState(ctx, qs, hostname)
+		if err != nil {
+			log.Errorf("cannot adopt queued state of %s: %v", qs.InstanceID, err)
+			continue
+		}
+		n++
+	}
+
+	if n > 0 {
+		log.Infof("adopted %d queued states of dead nodes", n)
+	}
+
+}
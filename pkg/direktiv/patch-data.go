@@ -0,0 +1,76 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// patchStateData applies a jq query to the state data of a pending instance
+// that isn't running a state right now, typically one paused at a breakpoint
+// or stuck waiting, and returns the result. The query has to produce a json
+// object, and the state data is only replaced if dryRun isn't set. A paused
+// instance goes on with the new data once it is resumed, a waiting one once
+// it wakes.
+func (we *workflowEngine) patchStateData(ctx context.Context, id, query, reason string, dryRun bool) ([]byte, error) {
+
+	if query == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "a jq query is required")
+	}
+
+	if reason == "" && !dryRun {
+		return nil, grpc.Errorf(codes.InvalidArgument, "a reason for the change is required")
+	}
+
+	rec, err := we.db.getWorkflowInstance(ctx, id)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "instance", id)
+	}
+
+	if rec.Status != "pending" && rec.Status != "running" {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "instance '%s' has already finished", id)
+	}
+
+	if len(rec.Flow) == 0 {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "instance '%s' hasn't started yet", id)
+	}
+
+	lctx, wli, err := we.loadWorkflowLogicInstance(id, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer wli.Close()
+
+	x, err := jqObject(wli.data, query, wli.jqScope())
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "cannot apply query: %v", err)
+	}
+
+	data, err := json.Marshal(x)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	if dryRun {
+		return data, nil
+	}
+
+	wf := wli.rec.Edges.Workflow
+
+	wli.rec, err = wli.rec.Update().SetStateData(string(data)).Save(lctx)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+	wli.rec.Edges.Workflow = wf
+
+	wli.audit("patchStateData", reason, log.Fields{
+		"state": wli.logic.ID(),
+		"query": query,
+	}, "State data of state %s patched with %s", wli.logic.ID(), query)
+
+	return data, nil
+
+}
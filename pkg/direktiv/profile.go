@@ -0,0 +1,107 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+// defaultGPUResource is the extended resource requested for GPUs unless a
+// profile names another.
+const defaultGPUResource = "nvidia.com/gpu"
+
+// resourceProfile describes the resources of an action's container. Profiles
+// are stored as namespace variables and referenced by name from function
+// definitions. CPU is in cores and memory in megabytes. Limits default to
+// twice the requests.
+type resourceProfile struct {
+	CPU         float64 `json:"cpu"`
+	Memory      int     `json:"memory"`
+	CPULimit    float64 `json:"cpuLimit,omitempty"`
+	MemoryLimit int     `json:"memoryLimit,omitempty"`
+	GPU         int     `json:"gpu,omitempty"`
+	GPUResource string  `json:"gpuResource,omitempty"`
+}
+
+func parseResourceProfile(data []byte) (*resourceProfile, error) {
+
+	p := new(resourceProfile)
+
+	err := json.Unmarshal(data, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.CPU <= 0 {
+		return nil, errors.New("cpu must be positive")
+	}
+
+	if p.Memory <= 0 {
+		return nil, errors.New("memory must be positive")
+	}
+
+	if p.CPULimit == 0 {
+		p.CPULimit = p.CPU * 2
+	}
+
+	if p.MemoryLimit == 0 {
+		p.MemoryLimit = p.Memory * 2
+	}
+
+	if p.CPULimit < p.CPU || p.MemoryLimit < p.Memory {
+		return nil, errors.New("limits must not be less than requests")
+	}
+
+	if p.GPU < 0 {
+		return nil, errors.New("gpu must not be negative")
+	}
+
+	if p.GPU > 0 && p.GPUResource == "" {
+		p.GPUResource = defaultGPUResource
+	}
+
+	return p, nil
+
+}
+
+// limitsExtra returns the additional entries for the container's resource
+// limits, which are only needed for GPUs.
+func (p *resourceProfile) limitsExtra() string {
+
+	if p.GPU == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(",\n                    %q: %d", p.GPUResource, p.GPU)
+
+}
+
+// loadResourceProfile reads the resource profile stored in the namespace
+// variable named by key.
+func (we *workflowEngine) loadResourceProfile(ctx context.Context, namespace, key string) (*resourceProfile, error) {
+
+	r, err := we.server.variableStorage.Retrieve(ctx, key, namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("resource profile '%s' does not exist", key)
+	}
+
+	p, err := parseResourceProfile(data)
+	if err != nil {
+		return nil, fmt.Errorf("resource profile '%s' is invalid: %v", key, err)
+	}
+
+	return p, nil
+
+}
@@ -0,0 +1,111 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/ingress"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// purgeInstances erases the data of a subject from the finished instances of
+// a namespace, for right to erasure requests. Their input, state data,
+// memory, output, error details, received events, recorded histories and logs
+// are removed for good. What is kept only describes how they ran: ids, the
+// external id and invoker, status, times, flow, attempts and error and cancel
+// codes.
+// Instances that are still running are skipped, as they can't go on
+// without their data, and have to be purged again once they finish.
+// Instances under a legal hold are skipped too, and namespaces under one
+// can't be purged at all.
+func (s *WorkflowServer) purgeInstances(ctx context.Context, in *ingress.PurgeInstancesRequest) (*ingress.PurgeInstancesResponse, error) {
+
+	ns := in.GetNamespace()
+	if ns == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "required namespace")
+	}
+
+	externalID := in.GetExternalId()
+	input := in.GetInput()
+	stateData := in.GetStateData()
+	output := in.GetOutput()
+
+	// never purge a whole namespace or workflow by accident
+	if externalID == "" && input == "" && stateData == "" && output == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "purging requires an external id or a data filter")
+	}
+
+	for _, f := range []struct {
+		name, document string
+	}{
+		{"input", input},
+		{"state data", stateData},
+		{"output", output},
+	} {
+		if f.document != "" && !json.Valid([]byte(f.document)) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s filter is not valid json", f.name)
+		}
+	}
+
+	_, err := s.dbManager.getNamespace(ns)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", ns)
+	}
+
+	// holds on parent namespaces count too
+	held, err := s.dbManager.namespaceOnHold(ctx, ns)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", ns)
+	}
+
+	if held {
+		return nil, status.Errorf(codes.FailedPrecondition, "namespace '%s' is under a legal hold", ns)
+	}
+
+	recs, err := s.dbManager.getSubjectInstances(ctx, ns, in.GetWorkflow(), externalID, input, stateData, output)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "namespace", ns)
+	}
+
+	resp := new(ingress.PurgeInstancesResponse)
+
+	for _, rec := range recs {
+
+		if rec.Status == "pending" {
+			resp.Skipped = append(resp.Skipped, rec.InstanceID)
+			continue
+		}
+
+		// holds placed since the instances were listed are respected
+		scrubbed, err := s.dbManager.scrubWorkflowInstance(ctx, rec.ID)
+		if err != nil {
+			return nil, grpcDatabaseError(err, "instance", rec.InstanceID)
+		}
+
+		if !scrubbed {
+			resp.Held = append(resp.Held, rec.InstanceID)
+			continue
+		}
+
+		err = s.dbManager.deleteHistory(ctx, rec.InstanceID)
+		if err != nil {
+			return nil, grpcDatabaseError(err, "instance", rec.InstanceID)
+		}
+
+		err = s.instanceLogger.DeleteInstanceLogs(rec.InstanceID)
+		if err != nil {
+			return nil, grpcDatabaseError(err, "instance", rec.InstanceID)
+		}
+
+		resp.Purged = append(resp.Purged, rec.InstanceID)
+
+	}
+
+	log.Infof("purged %d instances of namespace %s, skipped %d still running and %d held", len(resp.Purged), ns,
+		len(resp.Skipped), len(resp.Held))
+
+	return resp, nil
+
+}
@@ -0,0 +1,289 @@
+package direktiv
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/pkg/dlog"
+	"github.com/vorteil/direktiv/pkg/otlp"
+	"github.com/vorteil/direktiv/pkg/varstore"
+)
+
+// regionRouter knows the storage regions namespaces can be pinned to. The
+// region of a namespace is looked up whenever its data is written or read,
+// so that every server routes it the same way.
+type regionRouter struct {
+	db      *dbManager
+	regions map[string]*storageRegion
+}
+
+type storageRegion struct {
+	logs   dlog.Log
+	vars   varstore.VarStorage
+	traces *otlp.Exporter
+}
+
+func newRegionRouter(db *dbManager, config *Config) (*regionRouter, error) {
+
+	rr := &regionRouter{
+		db:      db,
+		regions: make(map[string]*storageRegion),
+	}
+
+	for _, r := range config.StorageRegions {
+
+		if r.Name == "" {
+			return nil, fmt.Errorf("storage region without a name")
+		}
+
+		if _, ok := rr.regions[r.Name]; ok {
+			return nil, fmt.Errorf("storage region '%s' configured twice", r.Name)
+		}
+
+		if r.Database == "" {
+			return nil, fmt.Errorf("storage region '%s' has no database", r.Name)
+		}
+
+		region := new(storageRegion)
+
+		if r.TraceExport != "" {
+			if config.RecordHistory == "" {
+				return nil, fmt.Errorf("trace export needs instance histories to be recorded")
+			}
+			region.traces = otlp.NewExporter(r.TraceExport, config.TraceExport.Headers)
+		}
+
+		rr.regions[r.Name] = region
+
+	}
+
+	return rr, nil
+
+}
+
+func (rr *regionRouter) configured() bool {
+	return len(rr.regions) > 0
+}
+
+// region returns the storage region namespace is pinned to, or nil if it
+// isn't pinned or doesn't exist.
+func (rr *regionRouter) region(namespace string) (*storageRegion, error) {
+
+	if !rr.configured() {
+		return nil, nil
+	}
+
+	ns, err := rr.db.getNamespace(namespace)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if ns.Region == "" {
+		return nil, nil
+	}
+
+	// never fall back to the main storage, the data mustn't leave the region
+	r, ok := rr.regions[ns.Region]
+	if !ok || r.logs == nil || r.vars == nil {
+		return nil, fmt.Errorf("namespace '%s' is pinned to storage region '%s', which isn't configured",
+			namespace, ns.Region)
+	}
+
+	return r, nil
+
+}
+
+// scopeNamespace returns the namespace of a log or variable scope, which is
+// either a namespace or an instance id starting with it.
+func scopeNamespace(scope string) string {
+	return strings.SplitN(scope, "/", 2)[0]
+}
+
+// regionLogs routes the instance logs of pinned namespaces to the logger of
+// their region, and those of other namespaces to def.
+type regionLogs struct {
+	regions *regionRouter
+	def     dlog.Log
+}
+
+func (rl *regionLogs) log(namespace string) (dlog.Log, error) {
+
+	r, err := rl.regions.region(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if r == nil {
+		return rl.def, nil
+	}
+
+	return r.logs, nil
+
+}
+
+func (rl *regionLogs) LoggerFunc(namespace, instance string) (dlog.Logger, error) {
+
+	l, err := rl.log(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.LoggerFunc(namespace, instance)
+
+}
+
+func (rl *regionLogs) NamespaceLogger(namespace string) (dlog.Logger, error) {
+
+	l, err := rl.log(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.NamespaceLogger(namespace)
+
+}
+
+func (rl *regionLogs) QueryLogs(ctx context.Context, instance string, limit, offset int) (dlog.QueryReponse, error) {
+
+	l, err := rl.log(scopeNamespace(instance))
+	if err != nil {
+		return dlog.QueryReponse{}, err
+	}
+
+	return l.QueryLogs(ctx, instance, limit, offset)
+
+}
+
+func (rl *regionLogs) DeleteNamespaceLogs(namespace string) error {
+
+	l, err := rl.log(namespace)
+	if err != nil {
+		return err
+	}
+
+	return l.DeleteNamespaceLogs(namespace)
+
+}
+
+func (rl *regionLogs) DeleteInstanceLogs(instance string) error {
+
+	l, err := rl.log(scopeNamespace(instance))
+	if err != nil {
+		return err
+	}
+
+	return l.DeleteInstanceLogs(instance)
+
+}
+
+// regionVars routes the variables of pinned namespaces to the variable
+// storage of their region, and those of other namespaces to def. The first
+// element of every scope is the namespace.
+type regionVars struct {
+	regions *regionRouter
+	def     varstore.VarStorage
+}
+
+func (rv *regionVars) namespace(namespace string) (varstore.VarStorage, error) {
+
+	r, err := rv.regions.region(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if r == nil {
+		return rv.def, nil
+	}
+
+	return r.vars, nil
+
+}
+
+func (rv *regionVars) scoped(scope []string) (varstore.VarStorage, error) {
+
+	if len(scope) == 0 {
+		return rv.def, nil
+	}
+
+	return rv.namespace(scope[0])
+
+}
+
+func (rv *regionVars) Store(ctx context.Context, key string, scope ...string) (io.WriteCloser, error) {
+
+	vs, err := rv.scoped(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return vs.Store(ctx, key, scope...)
+
+}
+
+func (rv *regionVars) Retrieve(ctx context.Context, key string, scope ...string) (varstore.VarReader, error) {
+
+	vs, err := rv.scoped(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return vs.Retrieve(ctx, key, scope...)
+
+}
+
+func (rv *regionVars) List(ctx context.Context, scope ...string) ([]varstore.VarInfo, error) {
+
+	vs, err := rv.scoped(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return vs.List(ctx, scope...)
+
+}
+
+func (rv *regionVars) Delete(ctx context.Context, key string, scope ...string) error {
+
+	vs, err := rv.scoped(scope)
+	if err != nil {
+		return err
+	}
+
+	return vs.Delete(ctx, key, scope...)
+
+}
+
+func (rv *regionVars) DeleteAllInScope(ctx context.Context, scope ...string) error {
+
+	vs, err := rv.scoped(scope)
+	if err != nil {
+		return err
+	}
+
+	return vs.DeleteAllInScope(ctx, scope...)
+
+}
+
+// Close closes the default storage only, those of the regions are closed
+// by whoever created them.
+func (rv *regionVars) Close() error {
+	return rv.def.Close()
+}
+
+// namespaceVarStorage returns the variable storage of a namespace, which has
+// to be resolved before the namespace is deleted.
+func (db *dbManager) namespaceVarStorage(namespace string) (varstore.VarStorage, error) {
+
+	if rv, ok := (*db.varStorage).(*regionVars); ok {
+		return rv.namespace(namespace)
+	}
+
+	return *db.varStorage, nil
+
+}
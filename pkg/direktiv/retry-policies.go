@@ -0,0 +1,124 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/vorteil/direktiv/pkg/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Namespaces can define retry policies by name, which actions of the
+// workflows in them reference with retry_policy in the same way as the
+// policies a workflow defines itself. A workflow's own policies take
+// precedence, then those of its namespace, then those of its ancestors,
+// nearest first. References are checked when a workflow is saved and
+// resolved whenever an action is retried, so changes to the policies of a
+// namespace apply to running instances.
+
+// resolveRetryPolicies returns the retry policies the workflows of a
+// namespace can reference.
+func (db *dbManager) resolveRetryPolicies(ctx context.Context, ns string) (model.RetryPolicies, error) {
+
+	ancestors, err := db.namespaceAncestors(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	names := append([]string{ns}, ancestors...)
+	policies := make(model.RetryPolicies)
+
+	for i := len(names) - 1; i >= 0; i-- {
+
+		data, err := db.getNamespaceRetryPolicies(ctx, names[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if len(data) == 0 {
+			continue
+		}
+
+		var m model.RetryPolicies
+		err = json.Unmarshal(data, &m)
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range m {
+			policies[k] = v
+		}
+
+	}
+
+	return policies, nil
+
+}
+
+func (we *workflowEngine) setNamespaceRetryPolicies(ctx context.Context, ns string, data []byte) error {
+
+	if len(data) > 0 {
+		var m model.RetryPolicies
+		if err := json.Unmarshal(data, &m); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "retry policies must be a json object: %v", err)
+		}
+		if err := m.Validate(); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if len(m) == 0 {
+			data = nil
+		}
+	}
+
+	err := we.db.setNamespaceRetryPolicies(ctx, ns, data)
+	if err != nil {
+		return grpcDatabaseError(err, "namespace", ns)
+	}
+
+	return nil
+
+}
+
+// loadNamespaceWorkflow parses a workflow definition that is being saved in
+// a namespace, rejecting references to retry policies it can't resolve and
+// functions calling addresses it may not call.
+func (we *workflowEngine) loadNamespaceWorkflow(ctx context.Context, ns string, wf *model.Workflow, data []byte) error {
+
+	policies, err := we.db.resolveRetryPolicies(ctx, ns)
+	if err != nil {
+		return grpcDatabaseError(err, "namespace", ns)
+	}
+
+	err = wf.LoadInNamespace(data, policies)
+	if err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "bad workflow definition: %v", err)
+	}
+
+	return we.checkNamespaceFunctions(ns, wf)
+
+}
+
+// actionRetries returns the retry definition that applies to an action of
+// the instance's workflow. A policy that has been removed from the namespace
+// since the workflow was saved fails the state rather than silently
+// disabling its retries.
+func (wli *workflowLogicInstance) actionRetries(ctx context.Context, action *model.ActionDefinition) (*model.RetryDefinition, error) {
+
+	if action == nil || action.RetryPolicy == "" {
+		return wli.wf.GetActionRetries(action, nil)
+	}
+
+	policies, err := wli.engine.db.resolveRetryPolicies(ctx, wli.namespace)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	retry, err := wli.wf.GetActionRetries(action, policies)
+	if err != nil {
+		return nil, NewUncatchableError("direktiv.retries.policy", "%v", err)
+	}
+
+	return retry, nil
+
+}
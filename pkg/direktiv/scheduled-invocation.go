@@ -0,0 +1,235 @@
+package direktiv
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/senseyeio/duration"
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/pkg/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// A workflow can be invoked once at a later time without an external
+// scheduler. Scheduled invocations are stored in the scheduled_invocations
+// table, and the server that accepts one sets a one-shot timer for it. When
+// the timer fires the invocation is deleted from the table and started the
+// way an invocation through the API is, so only the server that deleted it
+// starts it. Every heartbeat starts the invocations that are overdue, in
+// case the server holding their timer went away. While new instances are
+// halted, due invocations wait for the halt to be lifted. An invocation with
+// a ttl that hasn't started by its time plus the ttl expires instead.
+
+const scheduledInvocationFunction = "scheduledInvocation"
+
+func scheduledInvocationTimer(id string) string {
+	return fmt.Sprintf("scheduled:%s", id)
+}
+
+func (we *workflowEngine) scheduleInvocation(ctx context.Context, ns, name string, t time.Time, input []byte, state, externalID, ttl string, notify bool) (*ent.ScheduledInvocation, error) {
+
+	if we.isStandby() {
+		return nil, errStandby
+	}
+
+	if !t.After(we.clock.Now()) {
+		return nil, grpc.Errorf(codes.InvalidArgument, "time must be in the future")
+	}
+
+	if externalID != "" && !externalIDRegex.MatchString(externalID) {
+		return nil, grpc.Errorf(codes.InvalidArgument, "external id must match regex: %s", externalIDRegex)
+	}
+
+	var expires *time.Time
+	if ttl != "" {
+		d, err := duration.ParseISO8601(ttl)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "invalid ttl: %v", err)
+		}
+		x := d.Shift(t)
+		if !x.After(t) {
+			return nil, grpc.Errorf(codes.InvalidArgument, "ttl must be positive")
+		}
+		expires = &x
+	}
+
+	if notify && expires == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "notify requires a ttl")
+	}
+
+	rec, err := we.db.getNamespaceWorkflow(ctx, name, ns)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "workflow", name)
+	}
+
+	wf, err := we.loadWorkflow(ctx, rec)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	if wf.Start != nil && wf.Start.GetType() != model.StartTypeDefault {
+		return nil, grpc.Errorf(codes.InvalidArgument, "cannot directly invoke workflows with '%s' starts", wf.Start.GetType())
+	}
+
+	_, err = wf.GetEntryState(state)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	si, err := we.db.addScheduledInvocation(ctx, ns, name, t, input, state, externalID, expires, notify)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	id := si.ID.String()
+
+	err = we.timer.addOneShot(scheduledInvocationTimer(id), scheduledInvocationFunction, si.Time, []byte(id))
+	if err != nil {
+		// the heartbeat starts it anyway
+		log.Errorf("cannot set timer for scheduled invocation %s: %v", id, err)
+	}
+
+	return si, nil
+
+}
+
+func (we *workflowEngine) cancelScheduledInvocation(ctx context.Context, ns, id string) error {
+
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "invalid scheduled invocation id '%s'", id)
+	}
+
+	err = we.db.deleteScheduledInvocation(ctx, ns, uid)
+	if err != nil {
+		return grpcDatabaseError(err, "scheduled invocation", id)
+	}
+
+	return we.timer.deleteTimerByName("", "", scheduledInvocationTimer(id))
+
+}
+
+func (we *workflowEngine) scheduledInvocationHandler(data []byte) error {
+
+	id, err := uuid.Parse(string(data))
+	if err != nil {
+		return err
+	}
+
+	return we.startScheduledInvocation(id)
+
+}
+
+// startScheduledInvocation starts a scheduled invocation, unless another
+// server already did, it was cancelled or it expired.
+func (we *workflowEngine) startScheduledInvocation(id uuid.UUID) error {
+
+	// a standby leaves them to the primary cluster
+	if we.isStandby() {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	si, err := we.db.getScheduledInvocation(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if si == nil {
+		return nil
+	}
+
+	expired := si.Expires != nil && we.clock.Now().After(*si.Expires)
+
+	// heartbeats start it once the halt is lifted
+	if !expired && we.invocationHalt().reason != "" {
+		log.Debugf("scheduled invocation %s waits, new instances are halted", id)
+		return nil
+	}
+
+	ok, err := we.db.claimScheduledInvocation(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return nil
+	}
+
+	if expired {
+		we.expireInvocation(ctx, &expiredInvocation{
+			ID:        id.String(),
+			Namespace: si.Namespace,
+			Workflow:  si.Workflow,
+			From:      expiredFromSchedule,
+			Created:   si.Created,
+			Expires:   *si.Expires,
+		}, si.Notify)
+		return nil
+	}
+
+	wli, queued, err := we.PrepareInvoke(ctx, si.Namespace, si.Workflow, si.State, si.ExternalID, si.Input, nil, "", nil, nil, false, false)
+	if err != nil {
+		log.Errorf("cannot start scheduled invocation %s of workflow %s/%s: %v", id, si.Namespace, si.Workflow, err)
+		we.scheduledInvocationLog(si, "Scheduled invocation %s of workflow '%s' failed: %v", id, si.Workflow, err)
+		return err
+	}
+
+	if wli == nil {
+		we.scheduledInvocationLog(si, "Scheduled invocation %s of workflow '%s' queued as %s.", id, si.Workflow, queued)
+		return nil
+	}
+
+	we.scheduledInvocationLog(si, "Scheduled invocation %s of workflow '%s' started instance %s.", id, si.Workflow, wli.id)
+
+	we.submitStart(wli)
+
+	return nil
+
+}
+
+func (we *workflowEngine) scheduledInvocationLog(si *ent.ScheduledInvocation, msg string, a ...interface{}) {
+
+	l, err := we.server.instanceLogger.NamespaceLogger(si.Namespace)
+	if err != nil {
+		return
+	}
+	defer l.Close()
+
+	l.Info(fmt.Sprintf(msg, a...))
+
+}
+
+// sweepScheduledInvocations starts the scheduled invocations whose timer
+// should have fired a while ago.
+func (we *workflowEngine) sweepScheduledInvocations() {
+
+	if we.isStandby() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	ids, err := we.db.dueScheduledInvocations(ctx, we.clock.Now().Add(-nodeHeartbeatInterval))
+	if err != nil {
+		log.Errorf("cannot get overdue scheduled invocations: %v", err)
+		return
+	}
+
+	halted := we.invocationHalt().reason != ""
+
+	for _, id := range ids {
+		if !halted {
+			log.Warnf("scheduled invocation %s is overdue, starting it", id)
+		}
+		_ = we.startScheduledInvocation(id)
+	}
+
+}
@@ -10,21 +10,48 @@ import (
 )
 
 func getSecretsForInstance(ctx context.Context, instance *workflowLogicInstance, ns, name string) ([]byte, error) {
+	return instance.engine.namespaceSecret(ctx, ns, name)
+}
+
+// namespaceSecret returns a secret of the namespace, or of the nearest
+// ancestor that has it if the namespace doesn't.
+func (we *workflowEngine) namespaceSecret(ctx context.Context, ns, name string) ([]byte, error) {
 
 	var resp *secretsgrpc.SecretsRetrieveResponse
 
-	resp, err := instance.engine.secretsClient.RetrieveSecret(ctx, &secretsgrpc.SecretsRetrieveRequest{
+	resp, err := we.secretsClient.RetrieveSecret(ctx, &secretsgrpc.SecretsRetrieveRequest{
 		Namespace: &ns,
 		Name:      &name,
 	})
-	if err != nil {
-		s := status.Convert(err)
-		if s.Code() == codes.NotFound {
-			return nil, NewUncatchableError("direktiv.secrets.notFound", "secret '%s' not found", name)
-		}
+	if err == nil {
+		return resp.GetData(), nil
+	}
+
+	if status.Code(err) != codes.NotFound {
 		return nil, NewInternalError(err)
 	}
 
-	return resp.GetData(), nil
+	ancestors, aerr := we.db.namespaceAncestors(ctx, ns)
+	if aerr != nil {
+		return nil, NewInternalError(aerr)
+	}
+
+	for i := range ancestors {
+
+		resp, err = we.secretsClient.RetrieveSecret(ctx, &secretsgrpc.SecretsRetrieveRequest{
+			Namespace: &ancestors[i],
+			Name:      &name,
+		})
+		if err == nil {
+			return resp.GetData(), nil
+		}
+
+		if status.Code(err) != codes.NotFound {
+			return nil, NewInternalError(err)
+		}
+
+	}
+
+	return nil, NewUncatchableError("direktiv.secrets.notFound", "secret '%s' not found", name)
 
 }
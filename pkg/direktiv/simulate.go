@@ -0,0 +1,203 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/segmentio/ksuid"
+	"github.com/senseyeio/duration"
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/flow"
+	"github.com/vorteil/direktiv/pkg/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// A simulated instance runs its workflow as usual, but its actions never call
+// a function or start a subflow. Each action takes the next of the canned
+// results mocked for it instead and reports it the same way a real action
+// would, so retries, catches, timeouts and transforms behave as they would in
+// a real run. Mocks are keyed by the id of the function or the name of the
+// subflow, or by "state/function" for just the actions of one state. Each
+// call of an action takes the next of its results and the last one repeats.
+// The mocks and the calls made so far are stored with the instance.
+
+type mockResult struct {
+	Output json.RawMessage `json:"output,omitempty"`
+	Error  *struct {
+		Code    string `json:"code,omitempty"`
+		Message string `json:"message,omitempty"`
+	} `json:"error,omitempty"`
+	Delay string `json:"delay,omitempty"`
+}
+
+type simulation struct {
+	Mocks map[string][]mockResult `json:"mocks"`
+	Calls map[string]int          `json:"calls,omitempty"`
+}
+
+// parseMocks validates a mock specification and returns the simulation data
+// stored with the instance.
+func parseMocks(data []byte) ([]byte, error) {
+
+	sim := new(simulation)
+
+	err := json.Unmarshal(data, &sim.Mocks)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid mocks: %v", err)
+	}
+
+	for k, results := range sim.Mocks {
+
+		if len(results) == 0 {
+			return nil, grpc.Errorf(codes.InvalidArgument, "mock '%s' has no results", k)
+		}
+
+		for i, r := range results {
+			if r.Error != nil && r.Error.Code == "" && r.Error.Message == "" {
+				return nil, grpc.Errorf(codes.InvalidArgument, "mock '%s' result %d: error needs a code or a message", k, i)
+			}
+			if r.Delay != "" {
+				_, err = duration.ParseISO8601(r.Delay)
+				if err != nil {
+					return nil, grpc.Errorf(codes.InvalidArgument, "mock '%s' result %d: invalid delay: %v", k, i, err)
+				}
+			}
+		}
+
+	}
+
+	return json.Marshal(sim)
+
+}
+
+func (wli *workflowLogicInstance) simulated() bool {
+	return len(wli.rec.Simulation) > 0
+}
+
+// nextMock returns the next result mocked for the action named name in state
+// and records the call.
+func (wli *workflowLogicInstance) nextMock(ctx context.Context, state, name string) (*mockResult, error) {
+
+	sim := new(simulation)
+	err := json.Unmarshal(wli.rec.Simulation, sim)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	key := fmt.Sprintf("%s/%s", state, name)
+	results, ok := sim.Mocks[key]
+	if !ok {
+		key = name
+		results, ok = sim.Mocks[key]
+	}
+	if !ok {
+		return nil, NewUncatchableError("direktiv.simulation.unmocked", "no mock for '%s' in state '%s'", name, state)
+	}
+
+	if sim.Calls == nil {
+		sim.Calls = make(map[string]int)
+	}
+
+	n := sim.Calls[key]
+	if n >= len(results) {
+		n = len(results) - 1
+	}
+	sim.Calls[key]++
+
+	data, err := json.Marshal(sim)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	wf := wli.rec.Edges.Workflow
+	wli.rec, err = wli.rec.Update().SetSimulation(data).Save(ctx)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+	wli.rec.Edges.Workflow = wf
+
+	return &results[n], nil
+
+}
+
+// doActionRequest runs a function, or takes its mocked result if the instance
+// is simulated.
+func (wli *workflowLogicInstance) doActionRequest(ctx context.Context, ar *isolateRequest) error {
+
+	if !wli.simulated() {
+		return wli.engine.doActionRequest(ctx, ar)
+	}
+
+	// fire-and-forget actions have no instance to report to
+	if ar.Workflow.InstanceID == "" {
+		return nil
+	}
+
+	r, err := wli.nextMock(ctx, ar.Workflow.State, ar.Container.ID)
+	if err != nil {
+		return err
+	}
+
+	wli.Log("Simulating function '%s'.", ar.Container.ID)
+
+	go wli.engine.reportMockResult(ar.Workflow.InstanceID, ar.Workflow.Step, ar.ActionID, r)
+
+	return nil
+
+}
+
+// mockWorkflowAction takes the mocked result of a subflow in place of starting
+// it.
+func (wli *workflowLogicInstance) mockWorkflowAction(ctx context.Context, caller *subflowCaller, action *model.ActionDefinition) (string, error) {
+
+	r, err := wli.nextMock(ctx, caller.State, action.Workflow)
+	if err != nil {
+		return "", err
+	}
+
+	id := ksuid.New().String()
+
+	wli.Log("Simulating subflow '%s' as '%s'.", action.Workflow, id)
+
+	go wli.engine.reportMockResult(caller.InstanceID, caller.Step, id, r)
+
+	return id, nil
+
+}
+
+func (we *workflowEngine) reportMockResult(instanceID string, step int, actionID string, r *mockResult) {
+
+	if r.Delay != "" {
+		d, _ := duration.ParseISO8601(r.Delay)
+		now := we.clock.Now()
+		we.clock.Sleep(d.Shift(now).Sub(now))
+	}
+
+	output := []byte(r.Output)
+	if len(output) == 0 {
+		output = []byte("null")
+	}
+
+	var code, msg string
+	if r.Error != nil {
+		code = r.Error.Code
+		msg = r.Error.Message
+	}
+
+	istep := int32(step)
+
+	err := ReportActionResults(context.Background(), we.flowClient, &flow.ReportActionResultsRequest{
+		InstanceId:   &instanceID,
+		Step:         &istep,
+		ActionId:     &actionID,
+		ErrorCode:    &code,
+		ErrorMessage: &msg,
+		Output:       output,
+	})
+	if err != nil {
+		log.Errorf("can not respond to flow: %v", err)
+	}
+
+}
@@ -0,0 +1,294 @@
+package direktiv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// instanceSnapshotVersion is bumped whenever the snapshot document changes in
+// a way older servers can't import.
+const instanceSnapshotVersion = 1
+
+// instanceSnapshot is the portable document a live instance is exported to.
+// It holds everything needed to resume the instance on another server or
+// installation: the definition it runs, its progress, data and memory, and the
+// timers and event listeners it is waiting on.
+//
+// Timers live in the memory of the server that scheduled them, so only the
+// timers held by the exporting server are included. An instance imported
+// without its timeout is still cancelled once its deadline passes.
+type instanceSnapshot struct {
+	Version        int                `json:"version"`
+	ID             string             `json:"id"`
+	Workflow       snapshotWorkflow   `json:"workflow"`
+	BeginTime      time.Time          `json:"beginTime"`
+	StateBeginTime time.Time          `json:"stateBeginTime"`
+	Deadline       time.Time          `json:"deadline"`
+	Flow           []string           `json:"flow"`
+	Attempts       int                `json:"attempts"`
+	Progress       string             `json:"progress,omitempty"`
+	Input          json.RawMessage    `json:"input"`
+	StateData      json.RawMessage    `json:"stateData"`
+	Memory         []byte             `json:"memory,omitempty"`
+	Simulation     json.RawMessage    `json:"simulation,omitempty"`
+	Events         json.RawMessage    `json:"events,omitempty"`
+	Timers         []snapshotTimer    `json:"timers"`
+	Listeners      []snapshotListener `json:"listeners"`
+}
+
+type snapshotWorkflow struct {
+	Name       string `json:"name"`
+	Revision   int    `json:"revision"`
+	Definition string `json:"definition"`
+}
+
+type snapshotTimer struct {
+	Name     string          `json:"name"`
+	Function string          `json:"function"`
+	Time     time.Time       `json:"time"`
+	Data     json.RawMessage `json:"data"`
+}
+
+type snapshotListener struct {
+	Step         int                      `json:"step"`
+	Events       []map[string]interface{} `json:"events"`
+	Correlations []string                 `json:"correlations"`
+	Count        int                      `json:"count"`
+	Received     []map[string]interface{} `json:"received"`
+}
+
+// functions of the timers that belong to an instance
+var snapshotTimerFunctions = map[string]bool{
+	timeoutFunction:       true,
+	sleepWakeupFunction:   true,
+	retryWakeupFunction:   true,
+	cancelsWakeupFunction: true,
+}
+
+// exportInstance snapshots a pending instance. The instance is locked while
+// the snapshot is taken so that it never captures a state halfway through.
+func (we *workflowEngine) exportInstance(ctx context.Context, id string) ([]byte, error) {
+
+	wli := new(workflowLogicInstance)
+	wli.id = id
+	wli.engine = we
+
+	_, err := wli.lock(we.lockWait())
+	if err != nil {
+		return nil, err
+	}
+	defer wli.unlock()
+
+	rec, err := we.db.getWorkflowInstance(ctx, id)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "instance", id)
+	}
+
+	if rec.Status != "pending" {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "instance '%s' is not running", id)
+	}
+
+	if len(rec.Flow) == 0 {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "instance '%s' hasn't started yet", id)
+	}
+
+	wfrec, err := we.db.getWorkflowByID(rec.Edges.Workflow.ID)
+	if err != nil {
+		return nil, grpcDatabaseError(err, "workflow", rec.Edges.Workflow.Name)
+	}
+
+	memory, err := InstanceMemory(rec)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	snap := &instanceSnapshot{
+		Version: instanceSnapshotVersion,
+		ID:      id,
+		Workflow: snapshotWorkflow{
+			Name:       wfrec.Name,
+			Revision:   rec.Revision,
+			Definition: string(wfrec.Workflow),
+		},
+		BeginTime:      rec.BeginTime,
+		StateBeginTime: rec.StateBeginTime,
+		Deadline:       rec.Deadline,
+		Flow:           rec.Flow,
+		Attempts:       rec.Attempts,
+		Progress:       rec.Progress,
+		Input:          snapshotJSON(rec.Input),
+		StateData:      snapshotJSON(rec.StateData),
+		Memory:         memory,
+		Simulation:     rec.Simulation,
+		Events:         snapshotJSON(rec.ReceivedEvents),
+		Timers:         []snapshotTimer{},
+		Listeners:      []snapshotListener{},
+	}
+
+	for _, ti := range we.timer.instanceTimers(id) {
+		if !snapshotTimerFunctions[ti.fnName] {
+			continue
+		}
+		snap.Timers = append(snap.Timers, snapshotTimer{
+			Name:     ti.name,
+			Function: ti.fnName,
+			Time:     *ti.oneshot.time,
+			Data:     json.RawMessage(ti.data),
+		})
+	}
+
+	listeners, err := rec.QueryInstance().WithWfeventswait().All(ctx)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	for _, el := range listeners {
+
+		sig := new(eventsWaiterSignature)
+		err = json.Unmarshal(el.Signature, sig)
+		if err != nil {
+			return nil, NewInternalError(fmt.Errorf("cannot read event listener signature: %v", err))
+		}
+
+		l := snapshotListener{
+			Step:         sig.Step,
+			Events:       el.Events,
+			Correlations: el.Correlations,
+			Count:        el.Count,
+			Received:     []map[string]interface{}{},
+		}
+
+		for _, ew := range el.Edges.Wfeventswait {
+			l.Received = append(l.Received, ew.Events)
+		}
+
+		snap.Listeners = append(snap.Listeners, l)
+
+	}
+
+	return json.MarshalIndent(snap, "", "  ")
+
+}
+
+// importInstance recreates a snapshotted instance in namespace under a new id
+// and schedules its timers on this server. The namespace must have a workflow
+// of the same name with an identical definition. Imported instances have no
+// caller, a subflow's parent is not carried over.
+func (we *workflowEngine) importInstance(ctx context.Context, namespace string, data []byte) (string, error) {
+
+	snap := new(instanceSnapshot)
+	err := json.Unmarshal(data, snap)
+	if err != nil {
+		return "", grpc.Errorf(codes.InvalidArgument, "invalid instance snapshot: %v", err)
+	}
+
+	if snap.Version != instanceSnapshotVersion {
+		return "", grpc.Errorf(codes.InvalidArgument, "unsupported instance snapshot version %d", snap.Version)
+	}
+
+	if len(snap.Flow) == 0 || snap.ID == "" {
+		return "", grpc.Errorf(codes.InvalidArgument, "instance snapshot is incomplete")
+	}
+
+	wf, err := we.db.getNamespaceWorkflow(ctx, snap.Workflow.Name, namespace)
+	if err != nil {
+		return "", grpcDatabaseError(err, "workflow", snap.Workflow.Name)
+	}
+
+	if !bytes.Equal(wf.Workflow, []byte(snap.Workflow.Definition)) {
+		return "", grpc.Errorf(codes.FailedPrecondition, "workflow '%s' differs from the definition the snapshot was taken at", wf.Name)
+	}
+
+	var memory []byte
+	if len(snap.Memory) > 0 {
+		memory, err = encodeMemory(we.memoryEncoding, snap.Memory)
+		if err != nil {
+			return "", NewInternalError(err)
+		}
+	}
+
+	id := fmt.Sprintf("%s/%s/%s", namespace, wf.Name, randSeq(6))
+
+	_, err = we.db.importWorkflowInstance(ctx, wf, id, snap, memory)
+	if err != nil {
+		return "", NewInternalError(err)
+	}
+
+	for _, t := range snap.Timers {
+
+		td, err := renameSnapshotTimerData(t.Data, snap.ID, id)
+		if err != nil {
+			log.Errorf("cannot import timer '%s' of instance %s: %v", t.Name, id, err)
+			continue
+		}
+
+		t.Name = strings.Replace(t.Name, snap.ID, id, 1)
+		t.Data = td
+		we.restoreTimer(t)
+
+	}
+
+	log.Infof("imported instance %s as %s", snap.ID, id)
+
+	return id, nil
+
+}
+
+// restoreTimer schedules a timer taken from another server. Timers that
+// expired in transit fire straight away.
+func (we *workflowEngine) restoreTimer(t snapshotTimer) {
+
+	at := t.Time
+	if soon := we.clock.Now().Add(time.Second); at.Before(soon) {
+		at = soon
+	}
+
+	err := we.timer.addOneShot(t.Name, t.Function, at, t.Data)
+	if err != nil {
+		log.Errorf("cannot restore timer '%s': %v", t.Name, err)
+	}
+
+}
+
+// renameSnapshotTimerData replaces the instance id in a timer payload.
+func renameSnapshotTimerData(data []byte, from, to string) ([]byte, error) {
+
+	m := make(map[string]interface{})
+	err := json.Unmarshal(data, &m)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range []string{"InstanceID", "InstanceId"} {
+		if m[k] == from {
+			m[k] = to
+		}
+	}
+
+	return json.Marshal(m)
+
+}
+
+func snapshotJSON(s string) json.RawMessage {
+	if s == "" {
+		return nil
+	}
+	return json.RawMessage(s)
+}
+
+// snapshotText converts a raw JSON field from a snapshot back to the text the
+// database stores.
+func snapshotText(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	return string(raw)
+}
@@ -3,15 +3,16 @@ package direktiv
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"math/rand"
 	"regexp"
 	"time"
 
 	"github.com/segmentio/ksuid"
 	"github.com/senseyeio/duration"
 	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/jqer"
 	"github.com/vorteil/direktiv/pkg/model"
 )
 
@@ -39,10 +40,14 @@ func (sl *actionStateLogic) Type() string {
 	return model.StateTypeAction.String()
 }
 
-func (sl *actionStateLogic) Deadline() time.Time {
+func (sl *actionStateLogic) Deadline(now time.Time) time.Time {
 
 	if sl.state.Async {
-		return time.Now().Add(time.Second * 5)
+		return now.Add(time.Second * 5)
+	}
+
+	if sl.state.Heartbeat != "" {
+		return sl.HeartbeatDeadline(now)
 	}
 
 	var t time.Time
@@ -56,13 +61,12 @@ func (sl *actionStateLogic) Deadline() time.Time {
 			// NOTE: validation should prevent this from ever happening
 			log.Errorf("Got an invalid ISO8601 timeout: %v", err)
 		} else {
-			now := time.Now()
 			later := dur.Shift(now)
 			d = later.Sub(now)
 		}
 	}
 
-	t = time.Now()
+	t = now
 	t = t.Add(d)
 	t = t.Add(time.Second * 5)
 
@@ -70,6 +74,19 @@ func (sl *actionStateLogic) Deadline() time.Time {
 
 }
 
+// HeartbeatDeadline returns the deadline that an action heartbeat received
+// now extends the state to, or the zero time if the state doesn't expect
+// heartbeats.
+func (sl *actionStateLogic) HeartbeatDeadline(now time.Time) time.Time {
+
+	if sl.state.Heartbeat == "" {
+		return time.Time{}
+	}
+
+	return deadlineFromString(now, sl.state.Heartbeat)
+
+}
+
 func (sl *actionStateLogic) ErrorCatchers() []model.ErrorDefinition {
 	return sl.state.ErrorDefinitions()
 }
@@ -90,6 +107,11 @@ func (sl *actionStateLogic) LivingChildren(savedata []byte) []stateChild {
 		return children
 	}
 
+	// actions that returned or wait to be retried have nothing to cancel
+	if sd.Op != "do" || sd.Complete {
+		return children
+	}
+
 	if sl.state.Action.Function != "" {
 
 		var uid ksuid.KSUID
@@ -124,9 +146,11 @@ func (sl *actionStateLogic) LogJQ() interface{} {
 }
 
 type actionStateSavedata struct {
-	Op       string
-	Id       string
-	Attempts int
+	Op        string
+	Id        string
+	Attempts  int
+	Iteration int
+	Complete  bool `json:",omitempty"`
 }
 
 func (sd *actionStateSavedata) Marshal() []byte {
@@ -137,10 +161,10 @@ func (sd *actionStateSavedata) Marshal() []byte {
 	return data
 }
 
-func (sl *actionStateLogic) do(ctx context.Context, instance *workflowLogicInstance, attempt int) (transition *stateTransition, err error) {
+func (sl *actionStateLogic) do(ctx context.Context, instance *workflowLogicInstance, attempt, iteration int) (transition *stateTransition, err error) {
 
 	var inputData []byte
-	inputData, err = generateActionInput(ctx, instance, instance.data, sl.state.Action)
+	inputData, err = generateActionInput(ctx, instance, instance.data, sl.state.Action, instance.jqScope())
 	if err != nil {
 		return
 	}
@@ -153,7 +177,8 @@ func (sl *actionStateLogic) do(ctx context.Context, instance *workflowLogicInsta
 		if err != nil {
 			return
 		}
-		dur := to.Shift(time.Now()).Sub(time.Now())
+		now := instance.engine.clock.Now()
+		dur := to.Shift(now).Sub(now)
 		wfto = int(dur.Seconds())
 	}
 
@@ -163,9 +188,10 @@ func (sl *actionStateLogic) do(ctx context.Context, instance *workflowLogicInsta
 		uid := ksuid.New()
 
 		sd := &actionStateSavedata{
-			Op:       "do",
-			Id:       uid.String(),
-			Attempts: attempt,
+			Op:        "do",
+			Id:        uid.String(),
+			Attempts:  attempt,
+			Iteration: iteration,
 		}
 
 		err = instance.Save(ctx, sd.Marshal())
@@ -190,11 +216,24 @@ func (sl *actionStateLogic) do(ctx context.Context, instance *workflowLogicInsta
 		ar.Workflow.ID = instance.wf.ID
 		ar.Workflow.Timeout = wfto
 
+		// heartbeats and async actions keep running past the current deadline
+		if sl.state.Heartbeat == "" && !sl.state.Async {
+			ar.Workflow.Deadline = instance.rec.Deadline
+		}
+
 		// TODO: timeout
 		ar.Container.Data = inputData
 		ar.Container.Image = fn.Image
+		ar.Container.Service = fn.Service
+		ar.Container.HTTP, err = instance.httpDefinition(ctx, instance.data, fn, instance.jqScope())
+		if err != nil {
+			return
+		}
+		ar.Container.Lambda = fn.Lambda
+		ar.Container.Callback = fn.Callback
 		ar.Container.Cmd = fn.Cmd
 		ar.Container.Size = fn.Size
+		ar.Container.Profile = fn.Profile
 		ar.Container.Scale = fn.Scale
 
 		ar.Container.ID = fn.ID
@@ -211,7 +250,7 @@ func (sl *actionStateLogic) do(ctx context.Context, instance *workflowLogicInsta
 				ar.Workflow.State = ""
 				ar.Workflow.Step = 0
 
-				err = instance.engine.doActionRequest(ctx, ar)
+				err = instance.doActionRequest(ctx, ar)
 				if err != nil {
 					return
 				}
@@ -229,7 +268,7 @@ func (sl *actionStateLogic) do(ctx context.Context, instance *workflowLogicInsta
 
 			instance.Log("Sleeping until function '%s' returns.", fn.ID)
 
-			err = instance.engine.doActionRequest(ctx, ar)
+			err = instance.doActionRequest(ctx, ar)
 			if err != nil {
 				return
 			}
@@ -249,7 +288,7 @@ func (sl *actionStateLogic) do(ctx context.Context, instance *workflowLogicInsta
 
 		if sl.state.Async {
 
-			subflowID, err = instance.engine.subflowInvoke(ctx, caller, instance.rec.InvokedBy, instance.namespace, sl.state.Action.Workflow, inputData)
+			subflowID, err = instance.invokeWorkflowAction(ctx, caller, sl.state.Action, inputData)
 			if err != nil {
 				return
 			}
@@ -265,7 +304,7 @@ func (sl *actionStateLogic) do(ctx context.Context, instance *workflowLogicInsta
 
 		} else {
 
-			subflowID, err = instance.engine.subflowInvoke(ctx, caller, instance.rec.InvokedBy, instance.namespace, sl.state.Action.Workflow, inputData)
+			subflowID, err = instance.invokeWorkflowAction(ctx, caller, sl.state.Action, inputData)
 			if err != nil {
 				return
 			}
@@ -273,9 +312,10 @@ func (sl *actionStateLogic) do(ctx context.Context, instance *workflowLogicInsta
 			instance.Log("Sleeping until subflow '%s' returns.", subflowID)
 
 			sd := &actionStateSavedata{
-				Op:       "do",
-				Id:       subflowID,
-				Attempts: attempt,
+				Op:        "do",
+				Id:        subflowID,
+				Attempts:  attempt,
+				Iteration: iteration,
 			}
 
 			err = instance.Save(ctx, sd.Marshal())
@@ -301,7 +341,7 @@ func (sl *actionStateLogic) Run(ctx context.Context, instance *workflowLogicInst
 			return
 		}
 
-		return sl.do(ctx, instance, 0)
+		return sl.do(ctx, instance, 0, 0)
 
 	}
 
@@ -312,7 +352,7 @@ func (sl *actionStateLogic) Run(ctx context.Context, instance *workflowLogicInst
 	err = dec.Decode(retryData)
 	if err == nil && retryData.Op == "retry" {
 		instance.Log("Retrying...")
-		return sl.do(ctx, instance, retryData.Attempts)
+		return sl.do(ctx, instance, retryData.Attempts, retryData.Iteration)
 	}
 
 	// second part
@@ -363,13 +403,25 @@ func (sl *actionStateLogic) Run(ctx context.Context, instance *workflowLogicInst
 
 	}
 
+	sd.Complete = true
+	err = instance.Save(ctx, sd.Marshal())
+	if err != nil {
+		return
+	}
+
 	if results.ErrorCode != "" {
 
-		err = NewCatchableError(results.ErrorCode, results.ErrorMessage)
+		err = results.catchableError(sl.state.Action)
 		instance.Log("Action raised catchable error '%s': %s.", results.ErrorCode, results.ErrorMessage)
 		var d time.Duration
 
-		d, err = preprocessRetry(sl.state.Action.Retries, sd.Attempts, err)
+		retry, rerr := instance.actionRetries(ctx, sl.state.Action)
+		if rerr != nil {
+			err = rerr
+			return
+		}
+
+		d, err = preprocessRetry(retry, sd.Attempts, err)
 		if err != nil {
 			return
 		}
@@ -389,9 +441,9 @@ func (sl *actionStateLogic) Run(ctx context.Context, instance *workflowLogicInst
 	}
 
 	var x interface{}
-	err = json.Unmarshal(results.Output, &x)
+	x, err = results.output(sl.state.Action, instance.jqScope())
 	if err != nil {
-		x = base64.StdEncoding.EncodeToString(results.Output)
+		return
 	}
 
 	err = instance.StoreData("return", x)
@@ -423,9 +475,9 @@ func (sl *actionStateLogic) scheduleRetry(ctx context.Context, instance *workflo
 		return err
 	}
 
-	t := time.Now().Add(d)
+	t := instance.engine.clock.Now().Add(d)
 
-	err = instance.engine.scheduleRetry(instance.id, sl.ID(), instance.step, t, data)
+	err = instance.engine.scheduleRetry(instance, t, data, false)
 	if err != nil {
 		return err
 	}
@@ -434,12 +486,14 @@ func (sl *actionStateLogic) scheduleRetry(ctx context.Context, instance *workflo
 
 }
 
-func generateActionInput(ctx context.Context, instance *workflowLogicInstance, data interface{}, action *model.ActionDefinition) ([]byte, error) {
+// generateActionInput evaluates the input of an action against data, with
+// the queries seeing scope.
+func generateActionInput(ctx context.Context, instance *workflowLogicInstance, data interface{}, action *model.ActionDefinition, scope *jqer.Scope) ([]byte, error) {
 
 	var err error
 	var input interface{}
 
-	input, err = jqObject(data, "jq(.)")
+	input, err = jqObject(data, "jq(.)", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -456,12 +510,14 @@ func generateActionInput(ctx context.Context, instance *workflowLogicInstance, d
 	}
 
 	if action.Input == nil {
-		input, err = jqOne(m, "jq(.)")
+		input, err = jqOne(m, "jq(.)", nil)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		input, err = jqOne(m, action.Input)
+		secrets, _ := m["secrets"].(map[string]string)
+		input, err = jqOne(m, action.Input, scope)
+		instance.debugTransform("action input", action.Input, m, input, err, secrets)
 		if err != nil {
 			return nil, err
 		}
@@ -517,6 +573,27 @@ func retryDelay(attempt int, delay string, multiplier float64) time.Duration {
 
 }
 
+// retryBackoff caps the delay at maxDelay and then shortens it by a random
+// fraction of up to jitter, so that instances retrying the same failure
+// don't all wake up at the same moment.
+func retryBackoff(d time.Duration, maxDelay string, jitter float64) time.Duration {
+
+	if x, err := duration.ParseISO8601(maxDelay); err == nil {
+		t0 := time.Now()
+		t1 := x.Shift(t0)
+		if max := t1.Sub(t0); d > max {
+			d = max
+		}
+	}
+
+	if jitter > 0 {
+		d -= time.Duration(rand.Float64() * jitter * float64(d))
+	}
+
+	return d
+
+}
+
 func preprocessRetry(retry *model.RetryDefinition, attempt int, err error) (time.Duration, error) {
 
 	var d time.Duration
@@ -534,11 +611,32 @@ func preprocessRetry(retry *model.RetryDefinition, attempt int, err error) (time
 		return d, err
 	}
 
+	if retry.Condition != nil {
+
+		x, jqErr := jqOne(map[string]interface{}{
+			"code":    cerr.Code,
+			"message": cerr.Message,
+			"attempt": attempt,
+		}, retry.Condition, nil)
+		if jqErr != nil {
+			return d, NewUncatchableError("direktiv.retries.condition", "retry condition failed to run: %v", jqErr)
+		}
+
+		if !truth(x) {
+			return d, err
+		}
+
+	}
+
 	if attempt >= retry.MaxAttempts {
-		return d, NewCatchableError("direktiv.retries.exceeded", "maximum retries exceeded")
+		xerr := NewCatchableError("direktiv.retries.exceeded", "maximum retries exceeded")
+		xerr.Exhausted = cerr.Code
+		xerr.Attempts = attempt
+		return d, xerr
 	}
 
 	d = retryDelay(attempt, retry.Delay, retry.Multiplier)
+	d = retryBackoff(d, retry.MaxDelay, retry.Jitter)
 
 	return d, nil
 
@@ -34,8 +34,8 @@ func (sl *consumeEventStateLogic) Type() string {
 	return model.StateTypeConsumeEvent.String()
 }
 
-func (sl *consumeEventStateLogic) Deadline() time.Time {
-	return deadlineFromString(sl.state.Timeout)
+func (sl *consumeEventStateLogic) Deadline(now time.Time) time.Time {
+	return deadlineFromString(now, sl.state.Timeout)
 }
 
 func (sl *consumeEventStateLogic) ErrorCatchers() []model.ErrorDefinition {
@@ -77,7 +77,7 @@ func (sl *consumeEventStateLogic) Run(ctx context.Context, instance *workflowLog
 				return
 			}
 			var x interface{}
-			x, err = jqOne(instance.data, query)
+			x, err = jqOne(instance.data, query, instance.jqScope())
 			if err != nil {
 				err = NewUncatchableError("direktiv.event.jq", "failed to process event context key '%s': %v", k, err)
 				return
@@ -116,6 +116,11 @@ func (sl *consumeEventStateLogic) Run(ctx context.Context, instance *workflowLog
 		return
 	}
 
+	err = instance.receivedEvents(ctx, events)
+	if err != nil {
+		return
+	}
+
 	for _, event := range events {
 
 		var x interface{}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/senseyeio/duration"
 	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/calendar"
 	"github.com/vorteil/direktiv/pkg/model"
 )
 
@@ -32,15 +33,29 @@ func (sl *delayStateLogic) Type() string {
 	return model.StateTypeDelay.String()
 }
 
-func (sl *delayStateLogic) Deadline() time.Time {
+func (sl *delayStateLogic) Deadline(now time.Time) time.Time {
+
+	// delays computed from state data extend the deadline once known
+	if sl.dynamic() {
+		return deadlineFromString(now, "")
+	}
+
+	if sl.state.Until != "" {
+		t, err := time.Parse(time.RFC3339, sl.state.Until)
+		if err != nil {
+			log.Errorf("failed to parse timestamp: %v", err)
+			return now
+		}
+		return laterOf(t, now).Add(time.Second * 5)
+	}
 
 	d, err := duration.ParseISO8601(sl.state.Duration)
 	if err != nil {
 		log.Errorf("failed to parse duration: %v", err)
-		return time.Now()
+		return now
 	}
 
-	t := d.Shift(time.Now().Add(time.Second * 5))
+	t := d.Shift(now.Add(time.Second * 5))
 	return t
 
 }
@@ -70,16 +85,31 @@ func (sl *delayStateLogic) Run(ctx context.Context, instance *workflowLogicInsta
 
 	if len(wakedata) == 0 {
 
-		var d duration.Duration
-		d, err = duration.ParseISO8601(sl.state.Duration)
+		var t time.Time
+		t, err = sl.wakeTime(ctx, instance)
 		if err != nil {
-			err = NewInternalError(fmt.Errorf("failed to parse delay duration: %v", err))
 			return
 		}
 
-		t := d.Shift(time.Now())
+		// timers can't be scheduled in the past
+		if !t.After(instance.engine.clock.Now()) {
+			instance.Log("Delay has already elapsed.")
+			transition = &stateTransition{
+				Transform: sl.state.Transform,
+				NextState: sl.state.Transition,
+			}
+			return
+		}
 
-		err = instance.engine.sleep(instance.id, sl.ID(), instance.step, t)
+		if sl.dynamic() {
+			instance.Log("Sleeping until %s.", t.UTC().Format(time.RFC3339))
+			err = instance.ExtendDeadline(ctx, sl.state, t.Add(time.Second*5))
+			if err != nil {
+				return
+			}
+		}
+
+		err = instance.engine.sleep(instance, t)
 		if err != nil {
 			return
 		}
@@ -103,3 +133,113 @@ func (sl *delayStateLogic) Run(ctx context.Context, instance *workflowLogicInsta
 	}
 
 }
+
+func laterOf(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// dynamic reports whether the delay can only be resolved at runtime, against
+// the state data or a business calendar.
+func (sl *delayStateLogic) dynamic() bool {
+	return model.IsJQQuery(sl.state.Duration) || model.IsJQQuery(sl.state.Until) ||
+		sl.state.At != "" || sl.state.Calendar != ""
+}
+
+// wakeTime resolves when the delay ends. With a calendar, delays that would
+// end outside of a business day are moved to the same time on the next one.
+func (sl *delayStateLogic) wakeTime(ctx context.Context, instance *workflowLogicInstance) (time.Time, error) {
+
+	var err error
+	var cal *calendar.Calendar
+
+	if sl.state.Calendar != "" {
+		cal, err = instance.engine.loadCalendar(ctx, instance.namespace, sl.state.Calendar)
+		if err != nil {
+			return instance.engine.clock.Now(), err
+		}
+	}
+
+	var t time.Time
+
+	if sl.state.At != "" {
+		t, err = cal.NextAt(instance.engine.clock.Now(), sl.state.At)
+	} else {
+		t, err = sl.baseWakeTime(instance)
+		if err != nil {
+			return t, err
+		}
+		t, err = cal.NextBusinessDay(t)
+	}
+	if err != nil {
+		return t, NewCatchableError(ErrCodeInvalidCalendar, "calendar '%s' is invalid: %v", sl.state.Calendar, err)
+	}
+
+	return t, nil
+
+}
+
+// baseWakeTime resolves when the delay ends, ignoring any calendar. A duration may be computed from the
+// state data as either an ISO8601 string or a number of seconds, and a
+// timestamp as an RFC3339 string. Timestamps in the past end the delay
+// immediately.
+func (sl *delayStateLogic) baseWakeTime(instance *workflowLogicInstance) (time.Time, error) {
+
+	now := instance.engine.clock.Now()
+
+	if sl.state.Until != "" {
+
+		x, err := jqOne(instance.data, sl.state.Until, instance.jqScope())
+		if err != nil {
+			return now, err
+		}
+
+		v, ok := x.(string)
+		if !ok {
+			return now, NewCatchableError(ErrCodeInvalidDelay, "delay timestamp must be an RFC3339 string")
+		}
+
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return now, NewCatchableError(ErrCodeInvalidDelay, "delay timestamp '%s' is not a valid RFC3339 string", v)
+		}
+
+		return laterOf(t, now), nil
+
+	}
+
+	x, err := jqOne(instance.data, sl.state.Duration, instance.jqScope())
+	if err != nil {
+		return now, err
+	}
+
+	return delayWakeTime(x, now)
+
+}
+
+// delayWakeTime returns when a delay of x, an ISO8601 duration or a number of
+// seconds, started at now ends.
+func delayWakeTime(x interface{}, now time.Time) (time.Time, error) {
+
+	if v, ok := x.(string); ok {
+		d, err := duration.ParseISO8601(v)
+		if err != nil {
+			return now, NewCatchableError(ErrCodeInvalidDelay, "delay duration '%s' is not a valid ISO8601 string", v)
+		}
+		return d.Shift(now), nil
+	}
+
+	v, ok := toFloat(x)
+	if !ok {
+		return now, NewCatchableError(ErrCodeInvalidDelay, "delay duration must be an ISO8601 string or a number of seconds")
+	}
+
+	if v < 0 {
+		return now, NewCatchableError(ErrCodeInvalidDelay, "delay duration must not be negative")
+	}
+
+	return now.Add(time.Duration(v * float64(time.Second))), nil
+
+}
@@ -0,0 +1,84 @@
+package direktiv
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/jqer"
+)
+
+func TestDelayWakeTime(t *testing.T) {
+
+	// the query syntax set up by NewWorkflowServer
+	jqer.StringQueryRequiresWrappings = true
+	jqer.TrimWhitespaceOnQueryStrings = true
+	jqer.SearchInStrings = true
+	jqer.WrappingBegin = "jq"
+	jqer.WrappingIncrement = "("
+	jqer.WrappingDecrement = ")"
+	defer func() {
+		jqer.StringQueryRequiresWrappings = false
+		jqer.TrimWhitespaceOnQueryStrings = false
+		jqer.SearchInStrings = false
+		jqer.WrappingBegin = ""
+		jqer.WrappingIncrement = "{{"
+		jqer.WrappingDecrement = "}}"
+	}()
+
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		data     string
+		query    string
+		expected time.Duration
+	}{
+		{`{}`, `jq(30)`, 30 * time.Second},
+		{`{}`, `jq(1.5)`, 1500 * time.Millisecond},
+		{`{}`, `jq("PT1M")`, time.Minute},
+		{`{"h": "120"}`, `jq(.h | tonumber)`, 2 * time.Minute},
+		{`{"h": 45}`, `jq(.h)`, 45 * time.Second},
+	}
+
+	for _, test := range tests {
+
+		var data interface{}
+		err := json.Unmarshal([]byte(test.data), &data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		x, err := jqOne(data, test.query, nil)
+		if err != nil {
+			t.Errorf("%s: %v", test.query, err)
+			continue
+		}
+
+		wake, err := delayWakeTime(x, now)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.query, err)
+			continue
+		}
+
+		if d := wake.Sub(now); d != test.expected {
+			t.Errorf("%s: delay of %v, want %v", test.query, d, test.expected)
+		}
+
+	}
+
+	for _, x := range []interface{}{int64(10), json.Number("10"), big.NewInt(10)} {
+		wake, err := delayWakeTime(x, now)
+		if err != nil || wake.Sub(now) != 10*time.Second {
+			t.Errorf("%T: unexpected result: %v, %v", x, wake, err)
+		}
+	}
+
+	for _, x := range []interface{}{-1, "soon", true, nil, map[string]interface{}{}} {
+		_, err := delayWakeTime(x, now)
+		if _, ok := err.(*CatchableError); !ok {
+			t.Errorf("%v: expected a catchable error, got %v", x, err)
+		}
+	}
+
+}
@@ -30,8 +30,8 @@ func (sl *errorStateLogic) Type() string {
 	return model.StateTypeError.String()
 }
 
-func (sl *errorStateLogic) Deadline() time.Time {
-	return time.Now().Add(time.Second * 5)
+func (sl *errorStateLogic) Deadline(now time.Time) time.Time {
+	return now.Add(time.Second * 5)
 }
 
 func (sl *errorStateLogic) ErrorCatchers() []model.ErrorDefinition {
@@ -56,14 +56,23 @@ func (sl *errorStateLogic) Run(ctx context.Context, instance *workflowLogicInsta
 
 	for i := 0; i < len(a); i++ {
 		var x interface{}
-		x, err = jqObject(instance.data, sl.state.Args[i])
+		x, err = jqObject(instance.data, sl.state.Args[i], instance.jqScope())
 		if err != nil {
 			return
 		}
 		a[i] = x
 	}
 
-	err = instance.Raise(ctx, NewCatchableError(sl.state.Error, sl.state.Message, a...))
+	cerr := NewCatchableError(sl.state.Error, sl.state.Message, a...)
+
+	if sl.state.Data != nil {
+		cerr.Data, err = jqOne(instance.data, sl.state.Data, instance.jqScope())
+		if err != nil {
+			return
+		}
+	}
+
+	err = instance.Raise(ctx, cerr)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,234 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/senseyeio/duration"
+	"github.com/vorteil/direktiv/pkg/model"
+)
+
+type eventBatchStateLogic struct {
+	state    *model.EventBatchState
+	workflow *model.Workflow
+}
+
+func initEventBatchStateLogic(wf *model.Workflow, state model.State) (stateLogic, error) {
+
+	batch, ok := state.(*model.EventBatchState)
+	if !ok {
+		return nil, NewInternalError(errors.New("bad state object"))
+	}
+
+	sl := new(eventBatchStateLogic)
+	sl.state = batch
+	sl.workflow = wf
+
+	return sl, nil
+
+}
+
+func (sl *eventBatchStateLogic) Type() string {
+	return model.StateTypeEventBatch.String()
+}
+
+func (sl *eventBatchStateLogic) Deadline(now time.Time) time.Time {
+
+	// the collection window must always fit inside the deadline
+	if sl.state.Timeout == "" && sl.state.Duration != "" {
+		return deadlineFromString(now, sl.state.Duration)
+	}
+
+	return deadlineFromString(now, sl.state.Timeout)
+
+}
+
+func (sl *eventBatchStateLogic) ErrorCatchers() []model.ErrorDefinition {
+	return sl.state.ErrorDefinitions()
+}
+
+func (sl *eventBatchStateLogic) ID() string {
+	return sl.state.ID
+}
+
+func (sl *eventBatchStateLogic) LivingChildren(savedata []byte) []stateChild {
+	return nil
+}
+
+func (sl *eventBatchStateLogic) LogJQ() interface{} {
+	return sl.state.Log
+}
+
+func (sl *eventBatchStateLogic) listenForEvents(ctx context.Context, instance *workflowLogicInstance) error {
+
+	var err error
+
+	event := new(model.ConsumeEventDefinition)
+	event.Type = sl.state.Event.Type
+	event.Context = make(map[string]interface{})
+	for k, v := range sl.state.Event.Context {
+		query, ok := v.(string)
+		if !ok {
+			err = NewUncatchableError("direktiv.event.jq", "failed to process event context key '%s': not a jq query string", k)
+			return err
+		}
+		var x interface{}
+		x, err = jqOne(instance.data, query, instance.jqScope())
+		if err != nil {
+			err = NewUncatchableError("direktiv.event.jq", "failed to process event context key '%s': %v", k, err)
+			return err
+		}
+		event.Context[k] = x
+	}
+
+	instance.engine.clearEventListeners(instance.rec)
+
+	err = instance.engine.listenForEvents(ctx, instance, []*model.ConsumeEventDefinition{event}, false)
+	if err != nil {
+		return err
+	}
+
+	return nil
+
+}
+
+func (sl *eventBatchStateLogic) finish(instance *workflowLogicInstance, payloads []interface{}) (*stateTransition, error) {
+
+	instance.engine.clearEventListeners(instance.rec)
+
+	if payloads == nil {
+		payloads = make([]interface{}, 0)
+	}
+
+	instance.Log("Collected %d events.", len(payloads))
+
+	err := instance.StoreData(sl.state.Event.Type, payloads)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateTransition{
+		Transform: sl.state.Transform,
+		NextState: sl.state.Transition,
+	}, nil
+
+}
+
+func (sl *eventBatchStateLogic) Run(ctx context.Context, instance *workflowLogicInstance, savedata, wakedata []byte) (transition *stateTransition, err error) {
+
+	if len(wakedata) == 0 {
+
+		// first part
+
+		if len(savedata) != 0 {
+			err = NewInternalError(errors.New("got unexpected savedata"))
+			return
+		}
+
+		err = sl.listenForEvents(ctx, instance)
+		if err != nil {
+			return
+		}
+
+		if sl.state.Duration != "" {
+
+			var d duration.Duration
+			d, err = duration.ParseISO8601(sl.state.Duration)
+			if err != nil {
+				err = NewInternalError(fmt.Errorf("failed to parse batch duration: %v", err))
+				return
+			}
+
+			err = instance.engine.sleep(instance, d.Shift(instance.engine.clock.Now()))
+			if err != nil {
+				return
+			}
+
+		}
+
+		return
+
+	}
+
+	// sleep wakeups don't carry the savedata, so it is loaded here
+	if string(wakedata) == sleepWakedata {
+
+		savedata, err = InstanceMemory(instance.rec)
+		if err != nil {
+			err = NewInternalError(err)
+			return
+		}
+
+	}
+
+	var payloads []interface{}
+	if len(savedata) != 0 {
+		err = json.Unmarshal(savedata, &payloads)
+		if err != nil {
+			err = NewInternalError(fmt.Errorf("failed to unmarshal batch savedata: %v", err))
+			return
+		}
+	}
+
+	if string(wakedata) == sleepWakedata {
+		instance.Log("Batch window elapsed.")
+		return sl.finish(instance, payloads)
+	}
+
+	events := make([]*cloudevents.Event, 0)
+	err = json.Unmarshal(wakedata, &events)
+	if err != nil {
+		return
+	}
+
+	if len(events) == 0 {
+		err = NewInternalError(errors.New("missing event in wakeup data"))
+		return
+	}
+
+	err = instance.receivedEvents(ctx, events)
+	if err != nil {
+		return
+	}
+
+	for _, event := range events {
+
+		var x interface{}
+
+		x, err = extractEventPayload(event)
+		if err != nil {
+			return
+		}
+
+		payloads = append(payloads, x)
+
+	}
+
+	if sl.state.Count > 0 && len(payloads) >= sl.state.Count {
+		return sl.finish(instance, payloads)
+	}
+
+	savedata, err = json.Marshal(payloads)
+	if err != nil {
+		err = NewInternalError(fmt.Errorf("failed to marshal batch savedata: %v", err))
+		return
+	}
+
+	err = instance.Save(ctx, savedata)
+	if err != nil {
+		return
+	}
+
+	// the listener is consumed by every matching event
+	err = sl.listenForEvents(ctx, instance)
+	if err != nil {
+		return
+	}
+
+	return
+
+}
@@ -33,8 +33,8 @@ func (sl *eventsAndStateLogic) Type() string {
 	return model.StateTypeEventsAnd.String()
 }
 
-func (sl *eventsAndStateLogic) Deadline() time.Time {
-	return deadlineFromString(sl.state.Timeout)
+func (sl *eventsAndStateLogic) Deadline(now time.Time) time.Time {
+	return deadlineFromString(now, sl.state.Timeout)
 }
 
 func (sl *eventsAndStateLogic) ErrorCatchers() []model.ErrorDefinition {
@@ -69,7 +69,7 @@ func (sl *eventsAndStateLogic) listenForEvents(ctx context.Context, instance *wo
 				return err
 			}
 			var x interface{}
-			x, err = jqOne(instance.data, query)
+			x, err = jqOne(instance.data, query, instance.jqScope())
 			if err != nil {
 				err = NewUncatchableError("direktiv.event.jq", "failed to process event context key '%s': %v", k, err)
 				return err
@@ -114,6 +114,11 @@ func (sl *eventsAndStateLogic) Run(ctx context.Context, instance *workflowLogicI
 		return
 	}
 
+	err = instance.receivedEvents(ctx, events)
+	if err != nil {
+		return
+	}
+
 	for _, event := range events {
 
 		var x interface{}
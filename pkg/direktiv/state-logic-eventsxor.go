@@ -34,8 +34,8 @@ func (sl *eventsXorStateLogic) Type() string {
 	return model.StateTypeEventsXor.String()
 }
 
-func (sl *eventsXorStateLogic) Deadline() time.Time {
-	return deadlineFromString(sl.state.Timeout)
+func (sl *eventsXorStateLogic) Deadline(now time.Time) time.Time {
+	return deadlineFromString(now, sl.state.Timeout)
 }
 
 func (sl *eventsXorStateLogic) ErrorCatchers() []model.ErrorDefinition {
@@ -70,7 +70,7 @@ func (sl *eventsXorStateLogic) listenForEvents(ctx context.Context, instance *wo
 				return err
 			}
 			var x interface{}
-			x, err = jqOne(instance.data, query)
+			x, err = jqOne(instance.data, query, instance.jqScope())
 			if err != nil {
 				err = NewUncatchableError("direktiv.event.jq", "failed to process event context key '%s': %v", k, err)
 				return err
@@ -114,6 +114,11 @@ func (sl *eventsXorStateLogic) Run(ctx context.Context, instance *workflowLogicI
 		return
 	}
 
+	err = instance.receivedEvents(ctx, events)
+	if err != nil {
+		return
+	}
+
 	for _, event := range events {
 
 		var x interface{}
@@ -3,7 +3,6 @@ package direktiv
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,6 +10,7 @@ import (
 
 	"github.com/segmentio/ksuid"
 	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/jqer"
 	"github.com/vorteil/direktiv/pkg/model"
 )
 
@@ -38,8 +38,8 @@ func (sl *foreachStateLogic) Type() string {
 	return model.StateTypeForEach.String()
 }
 
-func (sl *foreachStateLogic) Deadline() time.Time {
-	return deadlineFromString(sl.state.Timeout)
+func (sl *foreachStateLogic) Deadline(now time.Time) time.Time {
+	return deadlineFromString(now, sl.state.Timeout)
 }
 
 func (sl *foreachStateLogic) ErrorCatchers() []model.ErrorDefinition {
@@ -80,12 +80,26 @@ func (sl *foreachStateLogic) LogJQ() interface{} {
 	return sl.state.Log
 }
 
-func (sl *foreachStateLogic) do(ctx context.Context, instance *workflowLogicInstance, inputSource interface{}, attempt int) (logic multiactionTuple, err error) {
+// iterationScope returns the scope of the queries generating the input of
+// an action, in which $iteration is the position of its item within the
+// array, leaving the item itself as it is.
+func iterationScope(instance *workflowLogicInstance, idx int) *jqer.Scope {
+
+	scope := instance.jqScope()
+	scope.Variables["iteration"] = idx
+
+	return scope
+
+}
+
+func (sl *foreachStateLogic) do(ctx context.Context, instance *workflowLogicInstance, inputSource interface{}, idx, attempt int) (logic multiactionTuple, err error) {
 
 	action := sl.state.Action
 
+	scope := iterationScope(instance, idx)
+
 	var inputData []byte
-	inputData, err = generateActionInput(ctx, instance, inputSource, action)
+	inputData, err = generateActionInput(ctx, instance, inputSource, action, scope)
 	if err != nil {
 		return
 	}
@@ -116,17 +130,26 @@ func (sl *foreachStateLogic) do(ctx context.Context, instance *workflowLogicInst
 		ar.Workflow.Step = instance.step
 		ar.Workflow.Name = instance.wf.Name
 		ar.Workflow.ID = instance.wf.ID
+		ar.Workflow.Deadline = instance.rec.Deadline
 
 		// TODO: timeout
 		ar.Container.Data = inputData
 		ar.Container.Image = fn.Image
+		ar.Container.Service = fn.Service
+		ar.Container.HTTP, err = instance.httpDefinition(ctx, inputSource, fn, scope)
+		if err != nil {
+			return
+		}
+		ar.Container.Lambda = fn.Lambda
+		ar.Container.Callback = fn.Callback
 		ar.Container.Cmd = fn.Cmd
 		ar.Container.Size = fn.Size
+		ar.Container.Profile = fn.Profile
 		ar.Container.Scale = fn.Scale
 		ar.Container.ID = fn.ID
 		ar.Container.Files = fn.Files
 
-		err = instance.engine.doActionRequest(ctx, ar)
+		err = instance.doActionRequest(ctx, ar)
 		if err != nil {
 			return
 		}
@@ -144,7 +167,7 @@ func (sl *foreachStateLogic) do(ctx context.Context, instance *workflowLogicInst
 
 		// TODO: log subflow instance IDs
 
-		subflowID, err = instance.engine.subflowInvoke(ctx, caller, instance.rec.InvokedBy, instance.namespace, action.Workflow, inputData)
+		subflowID, err = instance.invokeWorkflowAction(ctx, caller, action, inputData)
 		if err != nil {
 			return
 		}
@@ -161,15 +184,47 @@ func (sl *foreachStateLogic) do(ctx context.Context, instance *workflowLogicInst
 
 }
 
+// inputSources returns the data each action is run on. With a batch size
+// every action receives a slice of the array under 'items'.
+func (sl *foreachStateLogic) inputSources(instance *workflowLogicInstance) ([]interface{}, error) {
+
+	array, err := jq(instance.data, instance.expression(sl.state.Array), instance.jqScope())
+	if err != nil {
+		return nil, err
+	}
+
+	if sl.state.BatchSize <= 0 {
+		return array, nil
+	}
+
+	batches := make([]interface{}, 0)
+	for i := 0; i < len(array); i += sl.state.BatchSize {
+		j := i + sl.state.BatchSize
+		if j > len(array) {
+			j = len(array)
+		}
+		batches = append(batches, map[string]interface{}{
+			"items": array[i:j],
+		})
+	}
+
+	return batches, nil
+
+}
+
 func (sl *foreachStateLogic) doAll(ctx context.Context, instance *workflowLogicInstance) (err error) {
 
 	var array []interface{}
-	array, err = jq(instance.data, sl.state.Array)
+	array, err = sl.inputSources(instance)
 	if err != nil {
 		return
 	}
 
-	instance.Log("Generated %d objects to loop over.", len(array))
+	if sl.state.BatchSize > 0 {
+		instance.Log("Generated %d batches of up to %d objects to loop over.", len(array), sl.state.BatchSize)
+	} else {
+		instance.Log("Generated %d objects to loop over.", len(array))
+	}
 
 	if len(array) > maxParallelActions {
 		err = NewUncatchableError("direktiv.limits.parallel", "instance aborted for exceeding the maximum number of parallel actions (%d)", maxParallelActions)
@@ -178,9 +233,9 @@ func (sl *foreachStateLogic) doAll(ctx context.Context, instance *workflowLogicI
 
 	logics := make([]multiactionTuple, 0)
 
-	for _, inputSource := range array {
+	for i, inputSource := range array {
 		var logic multiactionTuple
-		logic, err = sl.do(ctx, instance, inputSource, 0)
+		logic, err = sl.do(ctx, instance, inputSource, i, 0)
 		if err != nil {
 			return
 		}
@@ -206,7 +261,7 @@ func (sl *foreachStateLogic) doAll(ctx context.Context, instance *workflowLogicI
 func (sl *foreachStateLogic) doSpecific(ctx context.Context, instance *workflowLogicInstance, logics []multiactionTuple, idx int) (err error) {
 
 	var array []interface{}
-	array, err = jq(instance.data, sl.state.Array)
+	array, err = sl.inputSources(instance)
 	if err != nil {
 		return
 	}
@@ -214,7 +269,7 @@ func (sl *foreachStateLogic) doSpecific(ctx context.Context, instance *workflowL
 	inputSource := array[idx]
 
 	var logic multiactionTuple
-	logic, err = sl.do(ctx, instance, inputSource, logics[idx].Attempts)
+	logic, err = sl.do(ctx, instance, inputSource, idx, logics[idx].Attempts)
 	if err != nil {
 		return
 	}
@@ -310,10 +365,17 @@ func (sl *foreachStateLogic) Run(ctx context.Context, instance *workflowLogicIns
 
 	if results.ErrorCode != "" {
 
-		err = NewCatchableError(results.ErrorCode, results.ErrorMessage)
+		err = results.catchableError(sl.state.Action)
 		instance.Log("Action raised catchable error '%s': %s.", results.ErrorCode, results.ErrorMessage)
 		var d time.Duration
-		d, err = preprocessRetry(sl.state.Action.Retries, logics[idx].Attempts, err)
+
+		retry, rerr := instance.actionRetries(ctx, sl.state.Action)
+		if rerr != nil {
+			err = rerr
+			return
+		}
+
+		d, err = preprocessRetry(retry, logics[idx].Attempts, err)
 		if err != nil {
 			return
 		}
@@ -335,9 +397,9 @@ func (sl *foreachStateLogic) Run(ctx context.Context, instance *workflowLogicIns
 	instance.Log("Action returned. (%d/%d)", completed, len(logics))
 
 	var x interface{}
-	err = json.Unmarshal(results.Output, &x)
+	x, err = results.output(sl.state.Action, instance.jqScope())
 	if err != nil {
-		x = base64.StdEncoding.EncodeToString(results.Output)
+		return
 	}
 
 	logics[idx].Results = x
@@ -422,9 +484,9 @@ func (sl *foreachStateLogic) scheduleRetry(ctx context.Context, instance *workfl
 	}
 	data = r.Marshal()
 
-	t := time.Now().Add(d)
+	t := instance.engine.clock.Now().Add(d)
 
-	err = instance.engine.scheduleRetry(instance.id, sl.ID(), instance.step, t, data)
+	err = instance.engine.scheduleRetry(instance, t, data, false)
 	if err != nil {
 		return err
 	}
@@ -8,7 +8,6 @@ import (
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/segmentio/ksuid"
-	"github.com/vorteil/direktiv/pkg/ingress"
 	"github.com/vorteil/direktiv/pkg/model"
 )
 
@@ -36,8 +35,8 @@ func (sl *generateEventStateLogic) Type() string {
 	return model.StateTypeGenerateEvent.String()
 }
 
-func (sl *generateEventStateLogic) Deadline() time.Time {
-	return time.Now().Add(time.Second * 5)
+func (sl *generateEventStateLogic) Deadline(now time.Time) time.Time {
+	return now.Add(time.Second * 5)
 }
 
 func (sl *generateEventStateLogic) ErrorCatchers() []model.ErrorDefinition {
@@ -76,7 +75,7 @@ func (sl *generateEventStateLogic) Run(ctx context.Context, instance *workflowLo
 	event.SetSource(sl.state.Event.Source)
 
 	var x interface{}
-	x, err = jqOne(instance.data, sl.state.Event.Data)
+	x, err = jqOne(instance.data, sl.state.Event.Data, instance.jqScope())
 	if err != nil {
 		return
 	}
@@ -110,17 +109,9 @@ func (sl *generateEventStateLogic) Run(ctx context.Context, instance *workflowLo
 		}
 	}
 
-	data, err = event.MarshalJSON()
-	if err != nil {
-		return
-	}
-
 	instance.Log("Broadcasting event: %s.", event.ID())
 
-	_, err = instance.engine.ingressClient.BroadcastEvent(ctx, &ingress.BroadcastEventRequest{
-		Namespace:  &instance.namespace,
-		Cloudevent: data,
-	})
+	err = instance.engine.emitEvent(ctx, instance.namespace, &event)
 	if err != nil {
 		return
 	}
@@ -33,8 +33,8 @@ func (sl *getterStateLogic) Type() string {
 	return model.StateTypeGetter.String()
 }
 
-func (sl *getterStateLogic) Deadline() time.Time {
-	return time.Now().Add(time.Second * 5)
+func (sl *getterStateLogic) Deadline(now time.Time) time.Time {
+	return now.Add(time.Second * 5)
 }
 
 func (sl *getterStateLogic) ErrorCatchers() []model.ErrorDefinition {
@@ -0,0 +1,360 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/model"
+	lua "github.com/yuin/gopher-lua"
+)
+
+const (
+	// maxLuaTimeout caps the configured run time of scripts, to keep them
+	// well within the deadline of their state.
+	maxLuaTimeout = 30000
+
+	// maxLuaMemory caps the configured memory of scripts, in megabytes.
+	maxLuaMemory = 4096
+)
+
+// luaRemovedFuncs are the base functions that reach outside the sandbox,
+// touch process-wide state or write to stdout.
+var luaRemovedFuncs = []string{
+	"collectgarbage", "dofile", "load", "loadfile", "loadstring",
+	"module", "require", "newproxy", "_printregs",
+}
+
+type luaStateLogic struct {
+	state *model.LuaState
+}
+
+func initLuaStateLogic(wf *model.Workflow, state model.State) (stateLogic, error) {
+
+	script, ok := state.(*model.LuaState)
+	if !ok {
+		return nil, NewInternalError(errors.New("bad state object"))
+	}
+
+	sl := new(luaStateLogic)
+	sl.state = script
+	return sl, nil
+
+}
+
+func (sl *luaStateLogic) Type() string {
+	return model.StateTypeLua.String()
+}
+
+func (sl *luaStateLogic) Deadline(now time.Time) time.Time {
+	return now.Add(time.Millisecond*maxLuaTimeout + time.Second*5)
+}
+
+func (sl *luaStateLogic) ErrorCatchers() []model.ErrorDefinition {
+	return sl.state.ErrorDefinitions()
+}
+
+func (sl *luaStateLogic) ID() string {
+	return sl.state.GetID()
+}
+
+func (sl *luaStateLogic) LivingChildren(savedata []byte) []stateChild {
+	return nil
+}
+
+func (sl *luaStateLogic) LogJQ() interface{} {
+	return sl.state.Log
+}
+
+func (sl *luaStateLogic) Run(ctx context.Context, instance *workflowLogicInstance, savedata, wakedata []byte) (transition *stateTransition, err error) {
+
+	if len(savedata) != 0 {
+		err = NewInternalError(errors.New("got unexpected savedata"))
+		return
+	}
+
+	if len(wakedata) != 0 {
+		err = NewInternalError(errors.New("got unexpected wakedata"))
+		return
+	}
+
+	var x interface{}
+	x, err = runLuaScript(ctx, instance, sl.state.ID, sl.state.Script)
+	if err != nil {
+		return
+	}
+
+	err = instance.StoreData("return", x)
+	if err != nil {
+		err = NewInternalError(err)
+		return
+	}
+
+	transition = &stateTransition{
+		Transform: sl.state.Transform,
+		NextState: sl.state.Transition,
+	}
+
+	return
+
+}
+
+// runLuaScript runs script in a fresh sandbox with the state data as 'data'
+// and the workflow's constants as 'const', and returns what it returns,
+// within the time, memory and output size the server allows scripts.
+func runLuaScript(ctx context.Context, instance *workflowLogicInstance, name, script string) (interface{}, error) {
+
+	limits := instance.engine.server.config.Lua
+
+	registrySize := lua.RegistrySize
+	if limits.StackSize < registrySize {
+		registrySize = limits.StackSize
+	}
+
+	L := lua.NewState(lua.Options{
+		SkipOpenLibs:        true,
+		CallStackSize:       limits.CallDepth,
+		RegistrySize:        registrySize,
+		RegistryMaxSize:     limits.StackSize,
+		MinimizeStackMemory: true,
+	})
+	defer L.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(limits.Timeout)*time.Millisecond)
+	defer cancel()
+
+	budget := newLuaBudget(ctx, L, limits.Memory<<20)
+
+	err := openLuaLibs(L, instance, budget)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	data, err := toLua(L, instance.data)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+	L.SetGlobal("data", data)
+
+	constants, err := toLua(L, instance.constants)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+	L.SetGlobal("const", constants)
+
+	L.SetContext(budget)
+
+	fn, err := L.Load(strings.NewReader(script), name)
+	if err != nil {
+		return nil, NewCatchableError(ErrCodeLuaScript, "invalid lua script: %v", err)
+	}
+
+	L.Push(fn)
+	err = L.PCall(0, 1, nil)
+
+	// scripts can catch the errors of exceeding the budget, but not undo them
+	if budget.err != nil {
+		return nil, NewCatchableError(ErrCodeLuaLimit, "lua script used more than %d megabytes of memory", limits.Memory)
+	}
+
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, NewCatchableError(ErrCodeLuaLimit, "lua script ran for longer than %dms", limits.Timeout)
+		}
+		// leave the stack trace out of the error
+		msg := err.Error()
+		if apiErr, ok := err.(*lua.ApiError); ok {
+			msg = apiErr.Object.String()
+		}
+		if strings.Contains(msg, "stack overflow") {
+			return nil, NewCatchableError(ErrCodeLuaLimit, "lua script exhausted its stack: %s", msg)
+		}
+		return nil, NewCatchableError(ErrCodeLuaScript, "lua script failed: %s", msg)
+	}
+
+	x, err := fromLua(L.Get(-1), make(map[*lua.LTable]bool))
+	if err != nil {
+		return nil, NewCatchableError(ErrCodeLuaScript, "lua script returned an invalid result: %v", err)
+	}
+
+	out, err := json.Marshal(x)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	if len(out) > limits.Output<<10 {
+		return nil, NewCatchableError(ErrCodeLuaLimit, "lua script returned more than %d kilobytes", limits.Output)
+	}
+
+	return x, nil
+
+}
+
+func openLuaLibs(L *lua.LState, instance *workflowLogicInstance, budget *luaBudget) error {
+
+	libs := []struct {
+		name string
+		open lua.LGFunction
+	}{
+		{lua.BaseLibName, lua.OpenBase},
+		{lua.TabLibName, lua.OpenTable},
+		{lua.StringLibName, lua.OpenString},
+		{lua.MathLibName, lua.OpenMath},
+	}
+
+	for _, lib := range libs {
+		err := L.CallByParam(lua.P{
+			Fn:      L.NewFunction(lib.open),
+			NRet:    0,
+			Protect: true,
+		}, lua.LString(lib.name))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, name := range luaRemovedFuncs {
+		L.SetGlobal(name, lua.LNil)
+	}
+
+	// randomseed would reseed the process' shared source
+	mathlib := L.GetGlobal(lua.MathLibName).(*lua.LTable)
+	mathlib.RawSetString("randomseed", lua.LNil)
+
+	strlib := L.GetGlobal(lua.StringLibName).(*lua.LTable)
+	strlib.RawSetString("dump", lua.LNil)
+
+	budgetedStringLibs(L, budget)
+
+	L.SetGlobal("print", L.NewFunction(func(L *lua.LState) int {
+		args := make([]string, L.GetTop())
+		for i := range args {
+			args[i] = L.ToStringMeta(L.Get(i + 1)).String()
+		}
+		instance.Log("%s", strings.Join(args, "\t"))
+		return 0
+	}))
+
+	return nil
+
+}
+
+func toLua(L *lua.LState, v interface{}) (lua.LValue, error) {
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var x interface{}
+	err = json.Unmarshal(data, &x)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonToLua(L, x), nil
+
+}
+
+func jsonToLua(L *lua.LState, v interface{}) lua.LValue {
+
+	switch x := v.(type) {
+	case bool:
+		return lua.LBool(x)
+	case float64:
+		return lua.LNumber(x)
+	case string:
+		return lua.LString(x)
+	case []interface{}:
+		t := L.CreateTable(len(x), 0)
+		for _, elem := range x {
+			t.Append(jsonToLua(L, elem))
+		}
+		return t
+	case map[string]interface{}:
+		t := L.CreateTable(0, len(x))
+		for k, elem := range x {
+			t.RawSetString(k, jsonToLua(L, elem))
+		}
+		return t
+	}
+
+	return lua.LNil
+
+}
+
+// fromLua converts a value returned by a script to json. Tables whose keys
+// are exactly 1 to n become arrays, other tables objects.
+func fromLua(v lua.LValue, visiting map[*lua.LTable]bool) (interface{}, error) {
+
+	switch x := v.(type) {
+	case *lua.LNilType:
+		return nil, nil
+	case lua.LBool:
+		return bool(x), nil
+	case lua.LNumber:
+		f := float64(x)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("%v is not a valid json number", f)
+		}
+		return f, nil
+	case lua.LString:
+		return string(x), nil
+	case *lua.LTable:
+		return luaTableToJSON(x, visiting)
+	}
+
+	return nil, fmt.Errorf("cannot convert lua %s to json", v.Type().String())
+
+}
+
+func luaTableToJSON(t *lua.LTable, visiting map[*lua.LTable]bool) (interface{}, error) {
+
+	if visiting[t] {
+		return nil, errors.New("table contains itself")
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	var keys int
+	var err error
+	t.ForEach(func(k, _ lua.LValue) {
+		keys++
+	})
+
+	n := t.MaxN()
+	if n > 0 && n == keys {
+		arr := make([]interface{}, n)
+		for i := range arr {
+			arr[i], err = fromLua(t.RawGetInt(i+1), visiting)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return arr, nil
+	}
+
+	m := make(map[string]interface{}, keys)
+	t.ForEach(func(k, elem lua.LValue) {
+		if err != nil {
+			return
+		}
+		switch k.(type) {
+		case lua.LString, lua.LNumber:
+		default:
+			err = fmt.Errorf("cannot use lua %s as an object key", k.Type().String())
+			return
+		}
+		m[k.String()], err = fromLua(elem, visiting)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+
+}
@@ -0,0 +1,68 @@
+package direktiv
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vorteil/direktiv/pkg/model"
+)
+
+type mapReduceStateLogic struct {
+	*foreachStateLogic
+	state *model.MapReduceState
+}
+
+func initMapReduceStateLogic(wf *model.Workflow, state model.State) (stateLogic, error) {
+
+	mapReduce, ok := state.(*model.MapReduceState)
+	if !ok {
+		return nil, NewInternalError(errors.New("bad state object"))
+	}
+
+	sl := new(mapReduceStateLogic)
+	sl.state = mapReduce
+	sl.foreachStateLogic = &foreachStateLogic{
+		state:    &mapReduce.ForEachState,
+		workflow: wf,
+	}
+
+	return sl, nil
+
+}
+
+func (sl *mapReduceStateLogic) Type() string {
+	return model.StateTypeMapReduce.String()
+}
+
+func (sl *mapReduceStateLogic) Run(ctx context.Context, instance *workflowLogicInstance, savedata, wakedata []byte) (transition *stateTransition, err error) {
+
+	transition, err = sl.foreachStateLogic.Run(ctx, instance, savedata, wakedata)
+	if err != nil || transition == nil {
+		return
+	}
+
+	// the map phase is complete once foreach has stored its results
+
+	m, ok := instance.data.(map[string]interface{})
+	if !ok {
+		err = NewInternalError(errors.New("state data isn't a valid JSON object"))
+		return
+	}
+
+	instance.Log("Reducing results.")
+
+	var x interface{}
+	x, err = jqOne(m["return"], instance.expression(sl.state.Reduce), instance.jqScope())
+	if err != nil {
+		err = WrapCatchableError("unable to apply reduce: %v", err)
+		return
+	}
+
+	err = instance.StoreData("return", x)
+	if err != nil {
+		return
+	}
+
+	return
+
+}
@@ -3,7 +3,6 @@ package direktiv
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -34,12 +33,39 @@ func initParallelStateLogic(wf *model.Workflow, state model.State) (stateLogic,
 
 }
 
+type parallelBranchError struct {
+	Branch  int    `json:"branch"`
+	Code    string `json:"code"`
+	Message string `json:"msg"`
+}
+
+// parallelBranchErrors lists the error each failed branch ended with, so that
+// catchers of ErrCodeAllBranchesFailed can see why every branch failed.
+func parallelBranchErrors(logics []multiactionTuple) []parallelBranchError {
+
+	errs := make([]parallelBranchError, 0)
+
+	for i, logic := range logics {
+		if logic.Error == nil {
+			continue
+		}
+		errs = append(errs, parallelBranchError{
+			Branch:  i,
+			Code:    logic.Error.Code,
+			Message: logic.Error.Message,
+		})
+	}
+
+	return errs
+
+}
+
 func (sl *parallelStateLogic) Type() string {
 	return model.StateTypeParallel.String()
 }
 
-func (sl *parallelStateLogic) Deadline() time.Time {
-	return deadlineFromString(sl.state.Timeout)
+func (sl *parallelStateLogic) Deadline(now time.Time) time.Time {
+	return deadlineFromString(now, sl.state.Timeout)
 }
 
 func (sl *parallelStateLogic) ErrorCatchers() []model.ErrorDefinition {
@@ -63,7 +89,8 @@ func (sl *parallelStateLogic) LivingChildren(savedata []byte) []stateChild {
 	}
 
 	for _, logic := range logics {
-		if logic.Complete {
+		// branches waiting on a scheduled retry have no child running
+		if logic.Complete || logic.ID == "" {
 			continue
 		}
 		children = append(children, stateChild{
@@ -79,7 +106,7 @@ func (sl *parallelStateLogic) LivingChildren(savedata []byte) []stateChild {
 func (sl *parallelStateLogic) dispatchAction(ctx context.Context, instance *workflowLogicInstance, action *model.ActionDefinition, attempt int) (logic multiactionTuple, err error) {
 
 	var inputData []byte
-	inputData, err = generateActionInput(ctx, instance, instance.data, action)
+	inputData, err = generateActionInput(ctx, instance, instance.data, action, instance.jqScope())
 	if err != nil {
 		return
 	}
@@ -110,18 +137,27 @@ func (sl *parallelStateLogic) dispatchAction(ctx context.Context, instance *work
 		ar.Workflow.Step = instance.step
 		ar.Workflow.Name = instance.wf.Name
 		ar.Workflow.ID = instance.wf.ID
+		ar.Workflow.Deadline = instance.rec.Deadline
 
 		// TODO: timeout
 		ar.Container.Data = inputData
 		ar.Container.Image = fn.Image
+		ar.Container.Service = fn.Service
+		ar.Container.HTTP, err = instance.httpDefinition(ctx, instance.data, fn, instance.jqScope())
+		if err != nil {
+			return
+		}
+		ar.Container.Lambda = fn.Lambda
+		ar.Container.Callback = fn.Callback
 		ar.Container.Cmd = fn.Cmd
 		ar.Container.Size = fn.Size
+		ar.Container.Profile = fn.Profile
 		ar.Container.Scale = fn.Scale
 
 		ar.Container.ID = fn.ID
 		ar.Container.Files = fn.Files
 
-		err = instance.engine.doActionRequest(ctx, ar)
+		err = instance.doActionRequest(ctx, ar)
 		if err != nil {
 			return
 		}
@@ -137,7 +173,7 @@ func (sl *parallelStateLogic) dispatchAction(ctx context.Context, instance *work
 
 		var subflowID string
 
-		subflowID, err = instance.engine.subflowInvoke(ctx, caller, instance.rec.InvokedBy, instance.namespace, action.Workflow, inputData)
+		subflowID, err = instance.invokeWorkflowAction(ctx, caller, action, inputData)
 		if err != nil {
 			return
 		}
@@ -288,9 +324,9 @@ func (sl *parallelStateLogic) Run(ctx context.Context, instance *workflowLogicIn
 	}
 
 	var x interface{}
-	err = json.Unmarshal(results.Output, &x)
+	x, err = results.output(&sl.state.Actions[idx], instance.jqScope())
 	if err != nil {
-		x = base64.StdEncoding.EncodeToString(results.Output)
+		return
 	}
 
 	logics[idx].Results = x
@@ -301,16 +337,23 @@ func (sl *parallelStateLogic) Run(ctx context.Context, instance *workflowLogicIn
 
 		if results.ErrorCode != "" {
 
-			err = NewCatchableError(results.ErrorCode, results.ErrorMessage)
+			err = results.catchableError(&sl.state.Actions[idx])
 			instance.Log("Action raised catchable error '%s': %s.", results.ErrorCode, results.ErrorMessage)
 
 			var d time.Duration
-			d, err = preprocessRetry(sl.state.Actions[idx].Retries, logics[idx].Attempts, err)
+
+			retry, rerr := instance.actionRetries(ctx, &sl.state.Actions[idx])
+			if rerr != nil {
+				err = rerr
+				return
+			}
+
+			d, err = preprocessRetry(retry, logics[idx].Attempts, err)
 			if err != nil {
 				return
 			}
 
-			instance.Log("Scheduling retry attempt in: %v.", d)
+			instance.Log("Scheduling retry attempt for branch %d in: %v.", idx, d)
 			err = sl.scheduleRetry(ctx, instance, logics, idx, d)
 			return
 
@@ -334,16 +377,26 @@ func (sl *parallelStateLogic) Run(ctx context.Context, instance *workflowLogicIn
 
 		if results.ErrorCode != "" {
 
-			err = NewCatchableError(results.ErrorCode, results.ErrorMessage)
+			cerr := results.catchableError(&sl.state.Actions[idx])
 			// instance.Log("Branch %d failed with error '%s': %s", idx, results.ErrorCode, results.ErrorMessage)
 			instance.Log("Action raised catchable error '%s': %s.", results.ErrorCode, results.ErrorMessage)
 			var d time.Duration
-			d, err = preprocessRetry(sl.state.Actions[idx].Retries, logics[idx].Attempts, err)
+
+			retry, rerr := instance.actionRetries(ctx, &sl.state.Actions[idx])
+			if rerr != nil {
+				err = rerr
+				return
+			}
+
+			d, err = preprocessRetry(retry, logics[idx].Attempts, cerr)
 			if err == nil {
+				instance.Log("Scheduling retry attempt for branch %d in: %v.", idx, d)
 				err = sl.scheduleRetry(ctx, instance, logics, idx, d)
 				return
 			}
 
+			logics[idx].Error = cerr
+
 		} else if results.ErrorMessage != "" {
 			instance.Log("Branch %d crashed due to an internal error: %s", idx, results.ErrorMessage)
 			err = NewInternalError(errors.New(results.ErrorMessage))
@@ -356,7 +409,9 @@ func (sl *parallelStateLogic) Run(ctx context.Context, instance *workflowLogicIn
 		completed++
 		instance.Log("Action returned. (%d/%d)", completed, len(logics))
 		if !ready && completed == len(logics) {
-			err = NewCatchableError(ErrCodeAllBranchesFailed, "all branches failed")
+			cerr := NewCatchableError(ErrCodeAllBranchesFailed, "all branches failed")
+			cerr.Data = parallelBranchErrors(logics)
+			err = cerr
 			return
 		}
 
@@ -438,9 +493,9 @@ func (sl *parallelStateLogic) scheduleRetry(ctx context.Context, instance *workf
 	}
 	data = r.Marshal()
 
-	t := time.Now().Add(d)
+	t := instance.engine.clock.Now().Add(d)
 
-	err = instance.engine.scheduleRetry(instance.id, sl.ID(), instance.step, t, data)
+	err = instance.engine.scheduleRetry(instance, t, data, false)
 	if err != nil {
 		return err
 	}
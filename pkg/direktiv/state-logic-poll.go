@@ -0,0 +1,166 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/senseyeio/duration"
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/model"
+)
+
+type pollStateLogic struct {
+	*actionStateLogic
+	state *model.PollState
+}
+
+func initPollStateLogic(wf *model.Workflow, state model.State) (stateLogic, error) {
+
+	poll, ok := state.(*model.PollState)
+	if !ok {
+		return nil, NewInternalError(errors.New("bad state object"))
+	}
+
+	sl := new(pollStateLogic)
+	sl.state = poll
+	sl.actionStateLogic = &actionStateLogic{
+		state:    &poll.ActionState,
+		workflow: wf,
+	}
+
+	return sl, nil
+
+}
+
+func (sl *pollStateLogic) Type() string {
+	return model.StateTypePoll.String()
+}
+
+func (sl *pollStateLogic) LivingChildren(savedata []byte) []stateChild {
+
+	sd := new(actionStateSavedata)
+	err := json.Unmarshal(savedata, sd)
+	if err != nil {
+		log.Error(err)
+		return nil
+	}
+
+	// nothing is running between repetitions
+	if sd.Op == "sleep" {
+		return nil
+	}
+
+	return sl.actionStateLogic.LivingChildren(savedata)
+
+}
+
+func (sl *pollStateLogic) Run(ctx context.Context, instance *workflowLogicInstance, savedata, wakedata []byte) (transition *stateTransition, err error) {
+
+	if len(wakedata) == 0 {
+		return sl.actionStateLogic.Run(ctx, instance, savedata, wakedata)
+	}
+
+	// sleep wakeups don't carry the savedata, so it is loaded here
+	if string(wakedata) == sleepWakedata {
+
+		savedata, err = InstanceMemory(instance.rec)
+		if err != nil {
+			err = NewInternalError(err)
+			return
+		}
+
+		sd := new(actionStateSavedata)
+		err = json.Unmarshal(savedata, sd)
+		if err != nil {
+			err = NewInternalError(fmt.Errorf("failed to unmarshal poll savedata: %v", err))
+			return
+		}
+
+		err = instance.ExtendDeadline(ctx, sl.state, sl.actionStateLogic.Deadline(instance.engine.clock.Now()))
+		if err != nil {
+			return
+		}
+
+		instance.Log("Starting repetition %d.", sd.Iteration+1)
+
+		return sl.do(ctx, instance, 0, sd.Iteration+1)
+
+	}
+
+	sd := new(actionStateSavedata)
+	err = json.Unmarshal(savedata, sd)
+	if err != nil {
+		err = NewInternalError(fmt.Errorf("failed to unmarshal poll savedata: %v", err))
+		return
+	}
+
+	transition, err = sl.actionStateLogic.Run(ctx, instance, savedata, wakedata)
+	if err != nil || transition == nil {
+		return
+	}
+
+	// the action returned successfully, so decide whether to go again
+
+	repetitions := sd.Iteration + 1
+
+	if sl.state.Until != nil {
+		var x interface{}
+		x, err = jqOne(instance.data, instance.expression(sl.state.Until), instance.jqScope())
+		if err != nil {
+			err = WrapCatchableError("failed to evaluate until condition: %v", err)
+			return
+		}
+		if truth(x) {
+			instance.Log("Until condition met after %d repetitions.", repetitions)
+			return
+		}
+	}
+
+	if sl.state.MaxRepetitions > 0 && repetitions >= sl.state.MaxRepetitions {
+		if sl.state.Until != nil {
+			transition = nil
+			err = NewCatchableError(ErrCodePollExhausted, "until condition not met after %d repetitions", repetitions)
+			return
+		}
+		instance.Log("Completed %d repetitions.", repetitions)
+		return
+	}
+
+	transition = nil
+
+	var d duration.Duration
+	d, err = duration.ParseISO8601(sl.state.Interval)
+	if err != nil {
+		err = NewInternalError(fmt.Errorf("failed to parse poll interval: %v", err))
+		return
+	}
+
+	t := d.Shift(instance.engine.clock.Now())
+
+	sd.Op = "sleep"
+	sd.Id = ""
+	sd.Attempts = 0
+
+	err = instance.Save(ctx, sd.Marshal())
+	if err != nil {
+		return
+	}
+
+	err = instance.ExtendDeadline(ctx, sl.state, t.Add(time.Second*5))
+	if err != nil {
+		return
+	}
+
+	instance.Log("Sleeping until %s.", t.UTC().Format(time.RFC3339))
+
+	err = instance.engine.sleep(instance, t)
+	if err != nil {
+		return
+	}
+
+	return
+
+}
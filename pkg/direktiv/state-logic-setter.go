@@ -32,8 +32,8 @@ func (sl *setterStateLogic) Type() string {
 	return model.StateTypeSetter.String()
 }
 
-func (sl *setterStateLogic) Deadline() time.Time {
-	return time.Now().Add(time.Second * 5)
+func (sl *setterStateLogic) Deadline(now time.Time) time.Time {
+	return now.Add(time.Second * 5)
 }
 
 func (sl *setterStateLogic) ErrorCatchers() []model.ErrorDefinition {
@@ -71,7 +71,7 @@ func (sl *setterStateLogic) Run(ctx context.Context, instance *workflowLogicInst
 
 	for _, v := range sl.state.Variables {
 		var x interface{}
-		x, err = jqOne(instance.data, v.Value)
+		x, err = jqOne(instance.data, v.Value, instance.jqScope())
 		if err != nil {
 			return
 		}
@@ -30,8 +30,8 @@ func (sl *switchStateLogic) Type() string {
 	return model.StateTypeSwitch.String()
 }
 
-func (sl *switchStateLogic) Deadline() time.Time {
-	return time.Now().Add(time.Second * 5)
+func (sl *switchStateLogic) Deadline(now time.Time) time.Time {
+	return now.Add(time.Second * 5)
 }
 
 func (sl *switchStateLogic) ErrorCatchers() []model.ErrorDefinition {
@@ -103,7 +103,7 @@ func (sl *switchStateLogic) Run(ctx context.Context, instance *workflowLogicInst
 	for i, condition := range sl.state.Conditions {
 
 		var x interface{}
-		x, err = jqOne(instance.data, condition.Condition)
+		x, err = jqOne(instance.data, instance.expression(condition.Condition), instance.jqScope())
 		if err != nil {
 			err = NewInternalError(fmt.Errorf("switch condition %d condition failed to run: %v", i, err))
 			return
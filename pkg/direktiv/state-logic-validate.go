@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/vorteil/direktiv/pkg/model"
@@ -32,8 +33,8 @@ func (sl *validateStateLogic) Type() string {
 	return model.StateTypeValidate.String()
 }
 
-func (sl *validateStateLogic) Deadline() time.Time {
-	return time.Now().Add(time.Second * 5)
+func (sl *validateStateLogic) Deadline(now time.Time) time.Time {
+	return now.Add(time.Second * 5)
 }
 
 func (sl *validateStateLogic) ErrorCatchers() []model.ErrorDefinition {
@@ -64,10 +65,9 @@ func (sl *validateStateLogic) Run(ctx context.Context, instance *workflowLogicIn
 		return
 	}
 
-	var schemaData []byte
-	schemaData, err = json.Marshal(sl.state.Schema)
+	var schema *gojsonschema.Schema
+	schema, err = instance.validationSchema(ctx, sl.state)
 	if err != nil {
-		err = NewInternalError(err)
 		return
 	}
 
@@ -77,7 +77,7 @@ func (sl *validateStateLogic) Run(ctx context.Context, instance *workflowLogicIn
 	}
 
 	var subject interface{}
-	subject, err = jqObject(instance.data, subjectQuery)
+	subject, err = jqObject(instance.data, instance.expression(subjectQuery), instance.jqScope())
 	if err != nil {
 		return
 	}
@@ -88,9 +88,8 @@ func (sl *validateStateLogic) Run(ctx context.Context, instance *workflowLogicIn
 		return
 	}
 
-	schema := gojsonschema.NewStringLoader(string(schemaData))
 	document := gojsonschema.NewStringLoader(string(documentData))
-	result, err := gojsonschema.Validate(schema, document)
+	result, err := schema.Validate(document)
 	if err != nil {
 		err = NewInternalError(err)
 		return
@@ -100,6 +99,10 @@ func (sl *validateStateLogic) Run(ctx context.Context, instance *workflowLogicIn
 		for _, reason := range result.Errors() {
 			instance.Log("Schema validation error: %s", reason.String())
 		}
+		err = sl.violationError(result.Errors())
+		if err != nil {
+			return
+		}
 		err = NewCatchableError("direktiv.schema.failed", fmt.Sprintf("subject failed its JSONSchema validation: %v", err))
 		return
 	}
@@ -112,3 +115,77 @@ func (sl *validateStateLogic) Run(ctx context.Context, instance *workflowLogicIn
 	return
 
 }
+
+// violationError returns the error of the first of the state's violation
+// definitions that matches one of the violations, or nil if none does.
+func (sl *validateStateLogic) violationError(reasons []gojsonschema.ResultError) error {
+
+	for _, v := range sl.state.Violations {
+		for _, reason := range reasons {
+
+			if v.Type != "" && v.Type != reason.Type() {
+				continue
+			}
+
+			if v.Path != "" && !matchViolationPath(v.Path, violationPath(reason)) {
+				continue
+			}
+
+			msg := v.Message
+			if msg == "" {
+				msg = reason.String()
+			}
+
+			return NewCatchableError(v.Error, "%s", msg)
+
+		}
+	}
+
+	return nil
+
+}
+
+// violationPath returns the path of the value a violation is about, such as
+// items.0.price, which for missing and unexpected properties is the property
+// rather than the object.
+func violationPath(reason gojsonschema.ResultError) string {
+
+	path := reason.Field()
+	if path == gojsonschema.STRING_ROOT_SCHEMA_PROPERTY {
+		path = ""
+	}
+
+	switch reason.Type() {
+	case "required", "additional_property_not_allowed":
+		if prop, ok := reason.Details()["property"].(string); ok {
+			if path != "" {
+				path += "."
+			}
+			path += prop
+		}
+	}
+
+	return path
+
+}
+
+// matchViolationPath reports whether a path matches a pattern, in which *
+// stands for any one property or index.
+func matchViolationPath(pattern, path string) bool {
+
+	pelems := strings.Split(pattern, ".")
+	elems := strings.Split(path, ".")
+
+	if len(pelems) != len(elems) {
+		return false
+	}
+
+	for i := range pelems {
+		if pelems[i] != "*" && pelems[i] != elems[i] {
+			return false
+		}
+	}
+
+	return true
+
+}
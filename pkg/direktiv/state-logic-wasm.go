@@ -0,0 +1,91 @@
+package direktiv
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/model"
+)
+
+type wasmStateLogic struct {
+	state *model.WasmState
+}
+
+func initWasmStateLogic(wf *model.Workflow, state model.State) (stateLogic, error) {
+
+	wasm, ok := state.(*model.WasmState)
+	if !ok {
+		return nil, NewInternalError(errors.New("bad state object"))
+	}
+
+	sl := new(wasmStateLogic)
+	sl.state = wasm
+	return sl, nil
+
+}
+
+func (sl *wasmStateLogic) Type() string {
+	return model.StateTypeWasm.String()
+}
+
+func (sl *wasmStateLogic) Deadline(now time.Time) time.Time {
+	return now.Add(time.Millisecond*maxWasmTimeout + time.Second*5)
+}
+
+func (sl *wasmStateLogic) ErrorCatchers() []model.ErrorDefinition {
+	return sl.state.ErrorDefinitions()
+}
+
+func (sl *wasmStateLogic) ID() string {
+	return sl.state.GetID()
+}
+
+func (sl *wasmStateLogic) LivingChildren(savedata []byte) []stateChild {
+	return nil
+}
+
+func (sl *wasmStateLogic) LogJQ() interface{} {
+	return sl.state.Log
+}
+
+func (sl *wasmStateLogic) Run(ctx context.Context, instance *workflowLogicInstance, savedata, wakedata []byte) (transition *stateTransition, err error) {
+
+	if len(savedata) != 0 {
+		err = NewInternalError(errors.New("got unexpected savedata"))
+		return
+	}
+
+	if len(wakedata) != 0 {
+		err = NewInternalError(errors.New("got unexpected wakedata"))
+		return
+	}
+
+	input := instance.data
+	if sl.state.Input != nil {
+		input, err = jqOne(instance.data, sl.state.Input, instance.jqScope())
+		if err != nil {
+			return
+		}
+	}
+
+	var x interface{}
+	x, err = instance.runWasmModule(ctx, sl.state.Module, input)
+	if err != nil {
+		return
+	}
+
+	err = instance.StoreData("return", x)
+	if err != nil {
+		err = NewInternalError(err)
+		return
+	}
+
+	transition = &stateTransition{
+		Transform: sl.state.Transform,
+		NextState: sl.state.Transition,
+	}
+
+	return
+
+}
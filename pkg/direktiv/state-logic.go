@@ -43,19 +43,27 @@ type stateChild struct {
 	Type string
 }
 
+// stateLogic implements a state type. A state's logic is shared by every
+// instance at its workflow revision, so it must not hold per-instance state.
 type stateLogic interface {
 	ID() string
 	Type() string
-	Deadline() time.Time
+	Deadline(now time.Time) time.Time
 	ErrorCatchers() []model.ErrorDefinition
 	Run(ctx context.Context, instance *workflowLogicInstance, savedata, wakedata []byte) (transition *stateTransition, err error)
 	LivingChildren(savedata []byte) []stateChild
 	LogJQ() interface{}
 }
 
+// heartbeatStateLogic is implemented by state logics that keep their deadline
+// alive while their actions send heartbeats.
+type heartbeatStateLogic interface {
+	HeartbeatDeadline(now time.Time) time.Time
+}
+
 // -------------- Helper Functions --------------
 
-func deadlineFromString(s string) time.Time {
+func deadlineFromString(now time.Time, s string) time.Time {
 
 	var t time.Time
 	var d time.Duration
@@ -68,13 +76,12 @@ func deadlineFromString(s string) time.Time {
 			// NOTE: validation should prevent this from ever happening
 			log.Errorf("Got an invalid ISO8601 timeout: %v", err)
 		} else {
-			now := time.Now()
 			later := dur.Shift(now)
 			d = later.Sub(now)
 		}
 	}
 
-	t = time.Now()
+	t = now
 	t = t.Add(d)
 	t = t.Add(time.Second * 5)
 
@@ -131,8 +138,8 @@ func (sl *noopStateLogic) Type() string {
 	return model.StateTypeNoop.String()
 }
 
-func (sl *noopStateLogic) Deadline() time.Time {
-	return time.Now().Add(time.Second * 5)
+func (sl *noopStateLogic) Deadline(now time.Time) time.Time {
+	return now.Add(time.Second * 5)
 }
 
 func (sl *noopStateLogic) ErrorCatchers() []model.ErrorDefinition {
@@ -178,6 +185,7 @@ type multiactionTuple struct {
 	Type     string
 	Attempts int
 	Results  interface{}
+	Error    *CatchableError
 }
 
 func extractEventPayload(event *cloudevents.Event) (interface{}, error) {
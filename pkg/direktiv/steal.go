@@ -0,0 +1,224 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	stealInterval = time.Second
+
+	// most states taken from another node at once
+	maxStealBatch = 32
+
+	// notifications are limited to 8000 bytes, queued states described by
+	// more than this are always run where they were queued
+	maxStolenPayload = 4096
+
+	stealWorkAction = "stealWork"
+	runStateAction  = "runState"
+)
+
+// Work stealing evens out load between flow servers. Every second a server
+// with free workers looks for the node with the longest work queue and, if it
+// is over the steal threshold, asks it for some of its work. The busy node
+// takes its newest small enough states out of the database, where its queue
+// persists its overflow, and sends their descriptions back, and the idle node
+// loads the instances and runs them. A node never gives away work below the
+// threshold.
+
+func (we *workflowEngine) stealing() bool {
+	return we.server.config.StealThreshold > 0
+}
+
+// submitState queues state logic for wli, which holds the lock of its
+// instance. If the queue has to persist it as msg instead the lock is
+// released, and the instance is loaded again to run it later, here or on
+// whichever node takes it over.
+func (we *workflowEngine) submitState(wli *workflowLogicInstance, msg *runStateMessage, fn func()) {
+
+	we.queue.submitState(fn, msg, func() {
+		wli.Close()
+	})
+
+}
+
+// submitStart queues the start of a new instance, which doesn't hold its lock
+// yet.
+func (we *workflowEngine) submitStart(wli *workflowLogicInstance) {
+
+	start := wli.entryState
+	if start == nil {
+		start = wli.wf.GetStartState()
+	}
+
+	data, err := json.Marshal(wli.data)
+	if err != nil {
+		log.Errorf("cannot marshal input of %s: %v", wli.id, err)
+		we.queue.submit(wli.start)
+		return
+	}
+
+	we.submitState(wli, &runStateMessage{
+		InstanceID: wli.id,
+		State:      start.GetID(),
+		NextState:  start.GetID(),
+		Data:       data,
+	}, wli.start)
+
+}
+
+func (we *workflowEngine) stealWork() {
+
+	threshold := we.server.config.StealThreshold
+	hostname := we.server.hostname
+
+	ticker := time.NewTicker(stealInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-we.nodeDone:
+			return
+		case <-ticker.C:
+		}
+
+		ctx := context.Background()
+
+		// others can only judge who is busy from up to date figures
+		err := we.db.setNodeQueued(ctx, hostname, we.queue.length())
+		if err != nil {
+			log.Errorf("cannot update node queue length: %v", err)
+			continue
+		}
+
+		n := we.queue.spare()
+		if n <= 0 {
+			continue
+		}
+
+		nodes, err := we.db.getNodes(ctx)
+		if err != nil {
+			log.Errorf("cannot list nodes: %v", err)
+			continue
+		}
+
+		var victim string
+		var queued int
+		for _, node := range nodes {
+			if node.Hostname == hostname || node.Draining || !nodeHealthy(node.Heartbeat) {
+				continue
+			}
+			if node.Queued > threshold && node.Queued > queued {
+				victim = node.Hostname
+				queued = node.Queued
+			}
+		}
+
+		if victim == "" {
+			continue
+		}
+
+		if n > maxStealBatch {
+			n = maxStealBatch
+		}
+
+		err = publishToHostname(we.db, victim, map[string]interface{}{
+			"action": stealWorkAction,
+			"thief":  hostname,
+			"count":  n,
+		})
+		if err != nil {
+			log.Errorf("cannot request work from %s: %v", victim, err)
+		}
+
+	}
+
+}
+
+// giveWork hands up to n queued states to thief.
+func (we *workflowEngine) giveWork(thief string, n int) {
+
+	msgs := we.queue.steal(n, we.server.config.StealThreshold)
+	if len(msgs) == 0 {
+		return
+	}
+
+	log.Debugf("handing %d queued states to %s", len(msgs), thief)
+
+	for _, msg := range msgs {
+		err := publishToHostname(we.db, thief, map[string]interface{}{
+			"action":  runStateAction,
+			"message": msg,
+		})
+		if err != nil {
+			// queue it here again after all
+			log.Errorf("cannot hand state of %s to %s: %v", msg.InstanceID, thief, err)
+			we.submitQueuedState(msg)
+		}
+	}
+
+}
+
+// submitQueuedState queues a state that was taken out of the database.
+func (we *workflowEngine) submitQueuedState(msg *runStateMessage) {
+
+	we.queue.submitState(func() {
+		we.runQueuedState(msg)
+	}, msg, func() {})
+
+}
+
+// runQueuedState loads an instance and runs the state logic msg describes.
+func (we *workflowEngine) runQueuedState(msg *runStateMessage) {
+
+	ctx, wli, err := we.loadWorkflowLogicInstance(msg.InstanceID, msg.Step)
+	if err != nil {
+		log.Errorf("cannot load workflow logic instance: %v", err)
+		return
+	}
+
+	if msg.NextState != "" {
+		err = json.Unmarshal(msg.Data, &wli.data)
+		if err != nil {
+			wli.Close()
+			log.Errorf("cannot load state data of %s: %v", msg.InstanceID, err)
+			return
+		}
+		wli.Transition(ctx, msg.NextState, 0)
+		return
+	}
+
+	var savedata []byte
+	if msg.Memory {
+		savedata, err = InstanceMemory(wli.rec)
+		if err != nil {
+			wli.Close()
+			return
+		}
+	}
+
+	we.runState(ctx, wli, savedata, msg.Wakedata, nil)
+
+}
+
+// decodeRunStateMessage reads a state description received from another node.
+func decodeRunStateMessage(x interface{}) (*runStateMessage, error) {
+
+	data, err := json.Marshal(x)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := new(runStateMessage)
+	err = json.Unmarshal(data, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+
+}
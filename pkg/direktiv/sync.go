@@ -22,8 +22,17 @@ const (
 	CancelTimer
 	CancelInstanceTimers
 	AddCron
+	ConfirmCancelIsolate
+	ReloadInvocationHalt
 )
 
+// CancelIsolateRequest asks the sidecar running an isolate to cancel it. The
+// isolate has GracePeriod seconds to clean up before it is killed.
+type CancelIsolateRequest struct {
+	ActionID    string
+	GracePeriod int
+}
+
 const ApiSync = "apisync"
 
 // SyncRequest sync maintenance requests between instances subscribed to FlowSync
@@ -183,6 +192,22 @@ func (s *WorkflowServer) startDatabaseListener() error {
 						s.tmManager.deleteTimerByName(s.hostname, s.hostname, req.ID.(string))
 					case CancelInstanceTimers:
 						s.tmManager.deleteTimersForInstanceNoBroadcast(req.ID.(string))
+					case CancelIsolate:
+						// only actions calling services run on flow servers
+						m, ok := req.ID.(map[string]interface{})
+						if ok {
+							id, _ := m["ActionID"].(string)
+							if s.engine.cancellations.cancelLocal(id) {
+								err = syncServer(context.Background(), s.dbManager, &s.id, id, ConfirmCancelIsolate)
+								if err != nil {
+									log.Error(err)
+								}
+							}
+						}
+					case ConfirmCancelIsolate:
+						s.engine.cancellations.confirm(req.ID.(string))
+					case ReloadInvocationHalt:
+						go s.engine.reloadInvocationHalt()
 					case AddCron:
 						m, ok := req.ID.(map[string]interface{})
 						if ok {
@@ -224,6 +249,29 @@ func (s *WorkflowServer) startDatabaseListener() error {
 					continue
 				}
 
+				switch m["action"] {
+				case adoptInstanceAction:
+					id, _ := m["instance"].(string)
+					go s.engine.adoptInstance(id)
+					continue
+				case queuedStatesAction:
+					go s.engine.queue.recount()
+					continue
+				case stealWorkAction:
+					thief, _ := m["thief"].(string)
+					count, _ := m["count"].(float64)
+					go s.engine.giveWork(thief, int(count))
+					continue
+				case runStateAction:
+					msg, err := decodeRunStateMessage(m["message"])
+					if err != nil {
+						log.Errorf("Unexpected notification on database listener: %v", err)
+						continue
+					}
+					s.engine.submitQueuedState(msg)
+					continue
+				}
+
 				timerId, _ := m["timerId"]
 				str, _ := timerId.(string)
 				if str == "" {
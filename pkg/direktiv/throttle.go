@@ -0,0 +1,288 @@
+package direktiv
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/metrics"
+	"golang.org/x/time/rate"
+)
+
+// actionThrottle limits the rate at which actions are dispatched to isolates
+// per container image. Dispatches over the limit wait for their turn.
+type actionThrottle struct {
+	limiters map[string]*rate.Limiter
+}
+
+func newActionThrottle(config *Config) *actionThrottle {
+
+	t := &actionThrottle{
+		limiters: make(map[string]*rate.Limiter),
+	}
+
+	for _, l := range config.ActionRateLimits {
+
+		if l.Image == "" || l.Rate <= 0 {
+			log.Warnf("ignoring invalid action rate limit for image '%s'", l.Image)
+			continue
+		}
+
+		burst := l.Burst
+		if burst < 1 {
+			burst = 1
+		}
+
+		t.limiters[l.Image] = rate.NewLimiter(rate.Limit(l.Rate), burst)
+
+	}
+
+	return t
+
+}
+
+// limiter returns the limiter for an image, falling back to one configured
+// for the image without its tag.
+func (t *actionThrottle) limiter(image string) *rate.Limiter {
+
+	if l, ok := t.limiters[image]; ok {
+		return l
+	}
+
+	if idx := strings.LastIndex(image, ":"); idx > strings.LastIndex(image, "/") {
+		return t.limiters[image[:idx]]
+	}
+
+	return nil
+
+}
+
+// wait blocks until an action using image may be dispatched. It fails if the
+// wait would outlast the context's deadline.
+func (t *actionThrottle) wait(ctx context.Context, image string) error {
+
+	l := t.limiter(image)
+	if l == nil {
+		return nil
+	}
+
+	r := l.Reserve()
+	if !r.OK() {
+		return nil
+	}
+
+	d := r.Delay()
+	if d == 0 {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		r.Cancel()
+		return fmt.Errorf("rate limit for image '%s' would delay dispatch past the action's deadline", image)
+	}
+
+	log.Debugf("throttling dispatch of action using image '%s' for %v", image, d)
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		r.Cancel()
+		return ctx.Err()
+	}
+
+}
+
+// namespaceActions tracks the isolate actions in flight for each namespace,
+// holding back dispatches for namespaces that have reached the configured cap
+// so that a single namespace can't swamp the isolates. The cap applies to
+// each flow server on its own, and the counts are sampled to the metrics
+// database every actionSampleInterval so that they can be added up across
+// the servers.
+type namespaceActions struct {
+	max        int
+	mtx        sync.Mutex
+	namespaces map[string]*namespaceActionSlots
+}
+
+type namespaceActionSlots struct {
+	active  int
+	queued  int
+	sampled bool
+	sem     chan struct{}
+}
+
+const actionSampleInterval = time.Second * 15
+
+func newNamespaceActions(config *Config) *namespaceActions {
+
+	max := config.MaxNamespaceActions
+	if max < 0 {
+		max = 0
+	}
+
+	return &namespaceActions{
+		max:        max,
+		namespaces: make(map[string]*namespaceActionSlots),
+	}
+
+}
+
+func (na *namespaceActions) slots(namespace string) *namespaceActionSlots {
+
+	na.mtx.Lock()
+	defer na.mtx.Unlock()
+
+	s, ok := na.namespaces[namespace]
+	if !ok {
+		s = new(namespaceActionSlots)
+		if na.max > 0 {
+			s.sem = make(chan struct{}, na.max)
+		}
+		na.namespaces[namespace] = s
+	}
+
+	return s
+
+}
+
+// acquire blocks until the namespace may dispatch another action. Every
+// successful call must be paired with a call to release.
+func (na *namespaceActions) acquire(ctx context.Context, namespace string) error {
+
+	s := na.slots(namespace)
+
+	if s.sem != nil {
+		select {
+		case s.sem <- struct{}{}:
+		default:
+
+			na.mtx.Lock()
+			s.queued++
+			na.mtx.Unlock()
+
+			log.Debugf("namespace '%s' is at its cap of %d actions, queueing dispatch", namespace, na.max)
+
+			var err error
+			select {
+			case s.sem <- struct{}{}:
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+
+			na.mtx.Lock()
+			s.queued--
+			na.mtx.Unlock()
+
+			if err != nil {
+				return fmt.Errorf("namespace '%s' reached its cap of %d actions: %v", namespace, na.max, err)
+			}
+
+		}
+	}
+
+	na.mtx.Lock()
+	s.active++
+	na.mtx.Unlock()
+
+	return nil
+
+}
+
+func (na *namespaceActions) release(namespace string) {
+
+	s := na.slots(namespace)
+
+	na.mtx.Lock()
+	s.active--
+	na.mtx.Unlock()
+
+	if s.sem != nil {
+		<-s.sem
+	}
+
+}
+
+// stats returns how many actions the namespace has in flight and how many are
+// waiting to be dispatched.
+func (na *namespaceActions) stats(namespace string) (active, queued int) {
+
+	na.mtx.Lock()
+	defer na.mtx.Unlock()
+
+	s, ok := na.namespaces[namespace]
+	if !ok {
+		return 0, 0
+	}
+
+	return s.active, s.queued
+
+}
+
+// sample returns the counts of the namespaces with actions in flight or
+// queued, and once more those of namespaces that had some at the previous
+// sample, so that their latest sample doesn't stay above zero.
+func (na *namespaceActions) sample() []metrics.ActionSample {
+
+	na.mtx.Lock()
+	defer na.mtx.Unlock()
+
+	var samples []metrics.ActionSample
+
+	for ns, s := range na.namespaces {
+
+		busy := s.active > 0 || s.queued > 0
+		if busy || s.sampled {
+			samples = append(samples, metrics.ActionSample{
+				Namespace: ns,
+				Active:    s.active,
+				Queued:    s.queued,
+			})
+		}
+
+		s.sampled = busy
+
+	}
+
+	return samples
+
+}
+
+// sampleNamespaceActions records the namespaces' action counts on this
+// server until the node stops.
+func (we *workflowEngine) sampleNamespaceActions() {
+
+	ticker := time.NewTicker(actionSampleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-we.nodeDone:
+			return
+		case <-ticker.C:
+		}
+
+		samples := we.actions.sample()
+		if len(samples) == 0 {
+			continue
+		}
+
+		err := we.metricsClient.RecordActionSamples(context.Background(), we.server.hostname, we.clock.Now(), samples)
+		if err != nil {
+			log.Errorf("cannot record namespace action samples: %v", err)
+		}
+	}
+
+}
+
+// namespaceActionCounts returns the actions of a namespace across the flow
+// servers, as of their latest samples.
+func (we *workflowEngine) namespaceActionCounts(ctx context.Context, namespace string) (*metrics.ActionCounts, error) {
+	return we.metricsClient.GetActionCounts(ctx, namespace, we.clock.Now().Add(-2*actionSampleInterval))
+}
@@ -0,0 +1,209 @@
+package direktiv
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// timers firing this much later than scheduled are logged, at most once per
+// heartbeat interval
+const timerLagWarning = time.Second * 30
+
+// timerFunctionStats is how the timers of a function are doing on a server,
+// as reported with its node heartbeat. Lags are in milliseconds: Lag is that
+// of the latest firing and MaxLag the worst since the previous heartbeat.
+// Overdue timers are pending past their time, because they are still running
+// or the timer manager is backlogged.
+type timerFunctionStats struct {
+	Function   string     `json:"function"`
+	Pending    int        `json:"pending"`
+	Overdue    int        `json:"overdue"`
+	Fired      int64      `json:"fired"`
+	Failed     int64      `json:"failed"`
+	Lag        int64      `json:"lag"`
+	MaxLag     int64      `json:"maxLag"`
+	AvgLag     int64      `json:"avgLag"`
+	LastFired  *time.Time `json:"lastFired,omitempty"`
+	LastFailed *time.Time `json:"lastFailed,omitempty"`
+	LastError  string     `json:"lastError,omitempty"`
+}
+
+type timerFunctionCounters struct {
+	fired      int64
+	failed     int64
+	lag        time.Duration
+	maxLag     time.Duration
+	totalLag   time.Duration
+	lastFired  time.Time
+	lastFailed time.Time
+	lastError  string
+}
+
+// timerStats counts firings and failures of timers by function since the
+// server started.
+type timerStats struct {
+	mtx    sync.Mutex
+	fns    map[string]*timerFunctionCounters
+	warned time.Time
+}
+
+func newTimerStats() *timerStats {
+	return &timerStats{
+		fns: make(map[string]*timerFunctionCounters),
+	}
+}
+
+func (ts *timerStats) counters(fn string) *timerFunctionCounters {
+
+	c, ok := ts.fns[fn]
+	if !ok {
+		c = new(timerFunctionCounters)
+		ts.fns[fn] = c
+	}
+
+	return c
+
+}
+
+// fired records that a timer of fn ran lag after it was scheduled to.
+func (ts *timerStats) fired(fn string, lag time.Duration, err error) {
+
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+
+	now := time.Now()
+
+	c := ts.counters(fn)
+	c.fired++
+	c.lag = lag
+	c.totalLag += lag
+	c.lastFired = now
+
+	if lag > c.maxLag {
+		c.maxLag = lag
+	}
+
+	if err != nil {
+		c.failed++
+		c.lastFailed = now
+		c.lastError = err.Error()
+	}
+
+	if lag > timerLagWarning && now.Sub(ts.warned) > timerHeartbeatInterval {
+		ts.warned = now
+		log.Warnf("%s timer fired %v late, timers are backlogged", fn, lag.Round(time.Millisecond))
+	}
+
+}
+
+// lag returns how late a timer fires now, and schedules the next run of a
+// cron.
+func (tm *timerManager) lag(ti *timerItem) time.Duration {
+
+	if ti.timerType == timerTypeOneShot {
+		return tm.clock.Now().Sub(*ti.oneshot.time)
+	}
+
+	tm.stats.mtx.Lock()
+	defer tm.stats.mtx.Unlock()
+
+	// crons keep to the wall clock
+	now := time.Now()
+	lag := now.Sub(ti.cron.next)
+	ti.cron.next = ti.cron.schedule.Next(now)
+
+	return lag
+
+}
+
+// statsSnapshot returns the stats of every timer function, and starts
+// measuring the worst lag anew.
+func (tm *timerManager) statsSnapshot() []timerFunctionStats {
+
+	pending := make(map[string]int)
+	overdue := make(map[string]int)
+
+	now := tm.clock.Now()
+	wall := time.Now()
+
+	tm.mtx.Lock()
+
+	for fn := range tm.fns {
+		pending[fn] += 0
+	}
+
+	for _, ti := range tm.timers {
+
+		pending[ti.fnName]++
+
+		switch ti.timerType {
+		case timerTypeOneShot:
+			if ti.oneshot.time.Before(now) {
+				overdue[ti.fnName]++
+			}
+		case timerTypeCron:
+			tm.stats.mtx.Lock()
+			if !ti.cron.next.IsZero() && ti.cron.next.Add(time.Second).Before(wall) {
+				overdue[ti.fnName]++
+			}
+			tm.stats.mtx.Unlock()
+		}
+
+	}
+
+	tm.mtx.Unlock()
+
+	tm.stats.mtx.Lock()
+	defer tm.stats.mtx.Unlock()
+
+	for fn := range tm.stats.fns {
+		pending[fn] += 0
+	}
+
+	stats := make([]timerFunctionStats, 0, len(pending))
+
+	for fn, n := range pending {
+
+		s := timerFunctionStats{
+			Function: fn,
+			Pending:  n,
+			Overdue:  overdue[fn],
+		}
+
+		if c, ok := tm.stats.fns[fn]; ok {
+
+			s.Fired = c.fired
+			s.Failed = c.failed
+			s.Lag = c.lag.Milliseconds()
+			s.MaxLag = c.maxLag.Milliseconds()
+			s.LastError = c.lastError
+
+			if c.fired > 0 {
+				s.AvgLag = (c.totalLag / time.Duration(c.fired)).Milliseconds()
+				t := c.lastFired
+				s.LastFired = &t
+			}
+
+			if c.failed > 0 {
+				t := c.lastFailed
+				s.LastFailed = &t
+			}
+
+			c.maxLag = 0
+
+		}
+
+		stats = append(stats, s)
+
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Function < stats[j].Function
+	})
+
+	return stats
+
+}
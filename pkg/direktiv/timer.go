@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	cron "github.com/robfig/cron/v3"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/ent/namespace"
 	"github.com/vorteil/direktiv/ent/workflowinstance"
+	"github.com/vorteil/direktiv/pkg/clock"
 )
 
 const (
@@ -19,30 +22,45 @@ const (
 	timerSchedWorkflow         = "schedWorkflow"
 	timerCleanInstanceRecords  = "cleanInstanceRecords"
 	timerCleanNamespaceRecords = "cleanNamespaceRecords"
+	timerHeartbeat             = "timerHeartbeat"
+
+	timerHeartbeatInterval = time.Second * 10
+
+	// the timer manager is presumed stuck after missing this many heartbeats
+	timerMissedHeartbeats = 3
 )
 
 type timerManager struct {
 	cron   *cron.Cron
 	fns    map[string]func([]byte) error
 	server *WorkflowServer
+	clock  clock.Clock
 
 	timers map[string]*timerItem
 	mtx    sync.Mutex
+
+	// unix time of the last heartbeat cron run
+	beat int64
+
+	stats *timerStats
 }
 
 type timerItem struct {
 	timerType int
 	name      string
+	fnName    string
 	data      []byte
 
 	fn   func([]byte) error
 	cron struct {
-		pattern string
-		cronID  cron.EntryID
+		pattern  string
+		cronID   cron.EntryID
+		schedule cron.Schedule
+		next     time.Time
 	}
 	oneshot struct {
 		time  *time.Time
-		timer *time.Timer
+		timer clock.Timer
 	}
 }
 
@@ -93,13 +111,45 @@ func (tm *timerManager) executeFunction(ti *timerItem) {
 
 	log.Debugf("execute timer %s", ti.name)
 
+	lag := tm.lag(ti)
+
 	err := ti.fn(ti.data)
+	tm.stats.fired(ti.fnName, lag, err)
 	if err != nil {
 		log.Errorf("can not run function for %s: %v", ti.name, err)
 	}
 
 	if ti.timerType == timerTypeOneShot {
 		tm.disableTimer(ti)
+		tm.forgetTimer(ti.name)
+	}
+
+}
+
+// recordTimer stores an instance timer in the database so that a standby
+// cluster can reschedule it when promoted.
+func (tm *timerManager) recordTimer(ti *timerItem) {
+
+	if !snapshotTimerFunctions[ti.fnName] || !tm.server.engine.replicatesTimers() {
+		return
+	}
+
+	err := tm.server.dbManager.saveTimer(context.Background(), ti.name, ti.fnName, *ti.oneshot.time, ti.data)
+	if err != nil {
+		log.Errorf("cannot record timer %s: %v", ti.name, err)
+	}
+
+}
+
+func (tm *timerManager) forgetTimer(name string) {
+
+	if strings.HasPrefix(name, "cron:") || !tm.server.engine.replicatesTimers() {
+		return
+	}
+
+	err := tm.server.dbManager.deleteTimer(context.Background(), name)
+	if err != nil {
+		log.Errorf("cannot delete recorded timer %s: %v", name, err)
 	}
 
 }
@@ -127,6 +177,7 @@ func (tm *timerManager) newTimerItem(name, fn string, data []byte, time *time.Ti
 	ti.timerType = timerTypeOneShot
 	ti.oneshot.time = time
 	ti.fn = exeFn
+	ti.fnName = fn
 	ti.name = name
 	ti.data = data
 
@@ -147,9 +198,14 @@ func newTimerManager(s *WorkflowServer) (*timerManager, error) {
 		fns:    make(map[string]func([]byte) error),
 		cron:   cron.New(),
 		server: s,
+		clock:  s.clock,
 
 		// timers can be key as name because it is unique
 		timers: make(map[string]*timerItem),
+
+		beat: time.Now().Unix(),
+
+		stats: newTimerStats(),
 	}
 
 	// kick cron
@@ -205,12 +261,17 @@ func (tm *timerManager) addCron(name, fn, pattern string, data []byte) error {
 
 }
 
+// parseCron parses a cron pattern the way crons are added.
+func parseCron(pattern string) (cron.Schedule, error) {
+	c := cron.NewParser(cron.Minute | cron.Hour | cron.Dom |
+		cron.Month | cron.DowOptional | cron.Descriptor)
+	return c.Parse(pattern)
+}
+
 func (tm *timerManager) addCronNoBroadcast(name, fn, pattern string, data []byte) error {
 
 	// check if cron pattern matches
-	c := cron.NewParser(cron.Minute | cron.Hour | cron.Dom |
-		cron.Month | cron.DowOptional | cron.Descriptor)
-	_, err := c.Parse(pattern)
+	schedule, err := parseCron(pattern)
 	if err != nil {
 		return err
 	}
@@ -220,6 +281,11 @@ func (tm *timerManager) addCronNoBroadcast(name, fn, pattern string, data []byte
 		return err
 	}
 
+	tm.stats.mtx.Lock()
+	ti.cron.schedule = schedule
+	ti.cron.next = schedule.Next(time.Now())
+	tm.stats.mtx.Unlock()
+
 	err = func(ti *timerItem) error {
 		id, err := tm.cron.AddFunc(ti.cron.pattern, func() {
 			tm.executeFunction(ti)
@@ -248,14 +314,14 @@ func (tm *timerManager) addOneShot(name, fn string, timeos time.Time, data []byt
 		return err
 	}
 
-	duration := ti.oneshot.time.UTC().Sub(time.Now().UTC())
+	duration := ti.oneshot.time.UTC().Sub(tm.clock.Now().UTC())
 	if duration < 0 {
 		return fmt.Errorf("one-shot %s is in the past", ti.name)
 	}
 
 	func(ti *timerItem, duration time.Duration) error {
 
-		timer := time.AfterFunc(duration, func() {
+		timer := tm.clock.AfterFunc(duration, func() {
 			tm.executeFunction(ti)
 		})
 		ti.oneshot.timer = timer
@@ -264,6 +330,8 @@ func (tm *timerManager) addOneShot(name, fn string, timeos time.Time, data []byt
 		return nil
 	}(ti, duration)
 
+	tm.recordTimer(ti)
+
 	return nil
 
 }
@@ -277,6 +345,13 @@ func (tm *timerManager) deleteTimersForInstance(name string) error {
 		log.Error(err)
 	}
 
+	if tm.server.engine.replicatesTimers() {
+		err = tm.server.dbManager.deleteInstanceTimers(context.Background(), name)
+		if err != nil {
+			log.Errorf("cannot delete recorded timers of %s: %v", name, err)
+		}
+	}
+
 	return tm.deleteTimersForInstanceNoBroadcast(name)
 
 }
@@ -322,8 +397,50 @@ func (tm *timerManager) deleteTimersForInstanceNoBroadcast(name string) error {
 	return nil
 }
 
+// instanceTimers returns copies of the one-shot timers this server holds for
+// an instance.
+func (tm *timerManager) instanceTimers(id string) []timerItem {
+
+	tm.mtx.Lock()
+	defer tm.mtx.Unlock()
+
+	var items []timerItem
+
+	for _, ti := range tm.timers {
+		if ti.timerType != timerTypeOneShot {
+			continue
+		}
+		if ti.name == id || strings.HasPrefix(ti.name, fmt.Sprintf("timeout:%s:", id)) {
+			items = append(items, *ti)
+		}
+	}
+
+	return items
+
+}
+
+// oneShotTimers returns copies of every one-shot timer this server holds.
+func (tm *timerManager) oneShotTimers() []timerItem {
+
+	tm.mtx.Lock()
+	defer tm.mtx.Unlock()
+
+	var items []timerItem
+
+	for _, ti := range tm.timers {
+		if ti.timerType == timerTypeOneShot {
+			items = append(items, *ti)
+		}
+	}
+
+	return items
+
+}
+
 func (tm *timerManager) deleteTimerByName(oldController, newController, name string) error {
 
+	tm.forgetTimer(name)
+
 	if oldController != newController && oldController != "" {
 		// send delete to specific server
 		var err error
@@ -365,17 +482,43 @@ func (tm *timerManager) deleteTimerByName(oldController, newController, name str
 }
 
 // cron job delete old namespace logs every 2 hrs
+// heartbeat runs as a cron to show that timers still fire.
+func (tm *timerManager) heartbeat(data []byte) error {
+	atomic.StoreInt64(&tm.beat, time.Now().Unix())
+	return nil
+}
+
+// healthy returns an error if the heartbeat cron hasn't run for too long.
+func (tm *timerManager) healthy() error {
+
+	last := time.Unix(atomic.LoadInt64(&tm.beat), 0)
+	if time.Since(last) > timerHeartbeatInterval*timerMissedHeartbeats {
+		return fmt.Errorf("timers have not fired since %v", last.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+
+}
+
 func (tm *timerManager) cleanNamespaceRecords(data []byte) error {
+	// the replica is read-only, the primary cleans up
+	if tm.server.engine.isStandby() {
+		return nil
+	}
+
 	log.Debugf("deleting old namespace records/logs")
 	ctx := context.Background()
 
-	namespaces, err := tm.server.dbManager.dbEnt.Namespace.Query().All(ctx)
+	// namespaces under a legal hold keep their logs, including holds
+	// inherited from their parents
+	namespaces, err := tm.server.dbManager.dbEnt.Namespace.Query().
+		Where(namespace.Not(namespaceUnderHold())).All(ctx)
 	if err != nil {
 		return err
 	}
 
-	for _, namespace := range namespaces {
-		err = tm.server.instanceLogger.DeleteNamespaceLogs(namespace.ID)
+	for _, ns := range namespaces {
+		err = tm.server.instanceLogger.DeleteNamespaceLogs(ns.ID)
 		if err != nil {
 			if !ent.IsNotFound(err) {
 				return err
@@ -389,12 +532,18 @@ func (tm *timerManager) cleanNamespaceRecords(data []byte) error {
 
 // cron job to delete old instance records / logs
 func (tm *timerManager) cleanInstanceRecords(data []byte) error {
+	// the replica is read-only, the primary cleans up
+	if tm.server.engine.isStandby() {
+		return nil
+	}
+
 	log.Debugf("deleting old instance records/logs")
 	ctx := context.Background()
 
-	// search db for instances where "endTime" > defined lifespan
+	// search db for instances where "endTime" > defined lifespan, except
+	// those under a legal hold
 	wfis, err := tm.server.dbManager.dbEnt.WorkflowInstance.Query().
-		Where(workflowinstance.EndTimeLTE(time.Now().Add(time.Minute * -10))).All(ctx)
+		Where(workflowinstance.EndTimeLTE(tm.clock.Now().Add(time.Minute*-10)), instanceNotHeld()).All(ctx)
 	if err != nil {
 		return err
 	}
@@ -420,7 +569,16 @@ func (tm *timerManager) cleanInstanceRecords(data []byte) error {
 	return nil
 }
 
+// hasTimer reports whether a timer is set on this server.
+func (tm *timerManager) hasTimer(name string) bool {
+	tm.mtx.Lock()
+	defer tm.mtx.Unlock()
+	_, ok := tm.timers[name]
+	return ok
+}
+
 func (tm *timerManager) deleteCronForWorkflow(id string) error {
+	tm.deleteTimerByName("", "", deferredCronTimer(id))
 	return tm.deleteTimerByName("", "", fmt.Sprintf("cron:%s", id))
 }
 
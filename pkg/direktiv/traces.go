@@ -0,0 +1,110 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/otlp"
+	"github.com/vorteil/direktiv/pkg/replay"
+)
+
+// exportTrace exports the recorded history of a finished instance to the
+// OTLP collector of its namespace as a trace. If last isn't nil it is the
+// instance's final step, which isn't saved. Subflows are exported into the
+// trace of the instance at the top of their callers, under the state that
+// invoked them.
+func (we *workflowEngine) exportTrace(ctx context.Context, wli *workflowLogicInstance, last *replay.Step) {
+
+	id := wli.id
+	exp := we.traces
+
+	region, err := we.server.regions.region(wli.namespace)
+	if err != nil {
+		log.Errorf("cannot export trace of %s: %v", id, err)
+		return
+	}
+
+	// traces of pinned namespaces only go to the collector of their region
+	if region != nil {
+		exp = region.traces
+	}
+
+	if exp == nil {
+		return
+	}
+
+	h, err := we.loadHistory(ctx, id)
+	if err != nil {
+		log.Errorf("cannot export trace of %s: %v", id, err)
+		return
+	}
+
+	if last != nil {
+		h.Steps = append(h.Steps, *last)
+	}
+
+	h.Status = wli.rec.Status
+
+	end := wli.rec.EndTime
+	if end.IsZero() {
+		end = we.clock.Now()
+	}
+
+	parent := we.traceParent(ctx, wli.rec.InvokedBy)
+
+	spans := otlp.FromHistory(h, end, parent)
+
+	go func() {
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		err := exp.Export(ctx, spans)
+		if err != nil {
+			log.Errorf("cannot export trace of %s: %v", id, err)
+		}
+
+	}()
+
+}
+
+// traceParent returns the state span of the instance that invoked a subflow,
+// or nil if the instance wasn't invoked by an instance of this cluster.
+func (we *workflowEngine) traceParent(ctx context.Context, invokedBy string) *otlp.Parent {
+
+	caller := new(subflowCaller)
+	if invokedBy == "" || json.Unmarshal([]byte(invokedBy), caller) != nil ||
+		caller.InstanceID == "" || caller.Callback != "" {
+		return nil
+	}
+
+	parent := &otlp.Parent{
+		Span: otlp.StateSpanID(caller.InstanceID, caller.Step),
+	}
+
+	// the trace belongs to the instance at the top of the callers
+	root := caller.InstanceID
+	for depth := 0; depth <= caller.Depth; depth++ {
+
+		rec, err := we.db.getWorkflowInstance(ctx, root)
+		if err != nil {
+			break
+		}
+
+		up := new(subflowCaller)
+		if rec.InvokedBy == "" || json.Unmarshal([]byte(rec.InvokedBy), up) != nil ||
+			up.InstanceID == "" || up.Callback != "" {
+			break
+		}
+
+		root = up.InstanceID
+
+	}
+
+	parent.Trace = otlp.NewTraceID(root)
+
+	return parent
+
+}
@@ -0,0 +1,432 @@
+package direktiv
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/sys"
+	"github.com/vorteil/direktiv/pkg/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Namespaces can upload small WebAssembly modules that wasm states and
+// transforms run inside the engine, for custom logic that doesn't warrant the
+// latency of a container action. A module of a namespace is available to its
+// descendants too, unless they have a module of the same name themselves.
+//
+// Modules get no WASI and nothing of the host but the 'direktiv' module's log
+// and fail functions. They export their memory, alloc(size i32) i32 to
+// reserve memory for their input, and transform(ptr i32, len i32) i64, which
+// is given the input as json and returns where its json output is, as the
+// pointer in the upper and the length in the lower 32 bits. Every run gets a
+// fresh instance, bounded by the configured memory, time and output size.
+
+const (
+	// maxWasmModuleSize keeps modules, and the grpc messages carrying them,
+	// small.
+	maxWasmModuleSize = 2 << 20
+
+	// maxWasmTimeout caps the configured run time of modules, to keep them
+	// well within the deadline of their state.
+	maxWasmTimeout = 30000
+
+	// maxCachedWasmModules is how many compiled modules the engine keeps.
+	maxCachedWasmModules = 64
+
+	// wasmMemoryMargin is how close to the memory limit a module has to be
+	// for its failures to count as running out of memory.
+	wasmMemoryMargin = 1 << 20
+
+	wasmHostModule = "direktiv"
+)
+
+var wasmModuleNameRegex = regexp.MustCompile(model.WasmModuleRegex)
+
+// wasmTransformRegex matches transforms that run a module, e.g. wasm(prices).
+var wasmTransformRegex = regexp.MustCompile(`^\s*wasm\(\s*([a-z][a-z0-9_-]{0,63})\s*\)\s*$`)
+
+type wasmRuntime struct {
+	runtime wazero.Runtime
+	timeout time.Duration
+	memory  uint64
+	output  uint32
+
+	mtx      sync.Mutex
+	compiled map[string]wazero.CompiledModule
+}
+
+// wasmRun is what the host functions of a running module report to.
+type wasmRun struct {
+	instance *workflowLogicInstance
+	failure  string
+	failed   bool
+}
+
+type wasmRunKey struct{}
+
+func newWasmRuntime(config *Config) (*wasmRuntime, error) {
+
+	ctx := context.Background()
+
+	rc := wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(uint32(config.Wasm.Memory) * 16).
+		WithCloseOnContextDone(true)
+
+	wr := &wasmRuntime{
+		runtime:  wazero.NewRuntimeWithConfig(ctx, rc),
+		timeout:  time.Duration(config.Wasm.Timeout) * time.Millisecond,
+		memory:   uint64(config.Wasm.Memory) << 20,
+		output:   uint32(config.Wasm.Output) << 10,
+		compiled: make(map[string]wazero.CompiledModule),
+	}
+
+	_, err := wr.runtime.NewHostModuleBuilder(wasmHostModule).
+		NewFunctionBuilder().WithFunc(wasmLog).Export("log").
+		NewFunctionBuilder().WithFunc(wasmFail).Export("fail").
+		Instantiate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return wr, nil
+
+}
+
+func wasmLog(ctx context.Context, m api.Module, ptr, size uint32) {
+
+	run, _ := ctx.Value(wasmRunKey{}).(*wasmRun)
+
+	msg, ok := m.Memory().Read(ptr, size)
+	if !ok || run == nil || run.instance == nil {
+		return
+	}
+
+	run.instance.Log("%s", msg)
+
+}
+
+func wasmFail(ctx context.Context, m api.Module, ptr, size uint32) {
+
+	run, _ := ctx.Value(wasmRunKey{}).(*wasmRun)
+	if run == nil {
+		return
+	}
+
+	run.failed = true
+
+	msg, ok := m.Memory().Read(ptr, size)
+	if ok {
+		run.failure = string(msg)
+	}
+
+}
+
+// compile compiles a module and checks that it implements the interface
+// modules have to.
+func (wr *wasmRuntime) compile(ctx context.Context, binary []byte) (wazero.CompiledModule, error) {
+
+	compiled, err := wr.runtime.CompileModule(ctx, binary)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkWasmModule(compiled)
+	if err != nil {
+		compiled.Close(ctx)
+		return nil, err
+	}
+
+	return compiled, nil
+
+}
+
+func checkWasmModule(compiled wazero.CompiledModule) error {
+
+	for _, fn := range compiled.ImportedFunctions() {
+		module, name, _ := fn.Import()
+		if module != wasmHostModule || (name != "log" && name != "fail") {
+			return fmt.Errorf("imports unsupported function %s.%s", module, name)
+		}
+	}
+
+	if len(compiled.ImportedMemories()) > 0 {
+		return errors.New("must not import memory")
+	}
+
+	if _, ok := compiled.ExportedMemories()["memory"]; !ok {
+		return errors.New("must export its memory as 'memory'")
+	}
+
+	i32, i64 := api.ValueTypeI32, api.ValueTypeI64
+
+	exports := compiled.ExportedFunctions()
+
+	signatures := []struct {
+		name    string
+		params  []api.ValueType
+		results []api.ValueType
+	}{
+		{"alloc", []api.ValueType{i32}, []api.ValueType{i32}},
+		{"transform", []api.ValueType{i32, i32}, []api.ValueType{i64}},
+	}
+
+	for _, sig := range signatures {
+
+		fn, ok := exports[sig.name]
+		if !ok {
+			return fmt.Errorf("must export function '%s'", sig.name)
+		}
+
+		if !equalValueTypes(fn.ParamTypes(), sig.params) || !equalValueTypes(fn.ResultTypes(), sig.results) {
+			return fmt.Errorf("function '%s' has the wrong signature", sig.name)
+		}
+
+	}
+
+	return nil
+
+}
+
+func equalValueTypes(a, b []api.ValueType) bool {
+
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+
+}
+
+// module returns the compiled module of a hash, compiling the binary load
+// returns if it isn't cached.
+func (wr *wasmRuntime) module(ctx context.Context, hash string, load func() ([]byte, error)) (wazero.CompiledModule, error) {
+
+	wr.mtx.Lock()
+	compiled, ok := wr.compiled[hash]
+	wr.mtx.Unlock()
+
+	if ok {
+		return compiled, nil
+	}
+
+	binary, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, err = wr.compile(ctx, binary)
+	if err != nil {
+		return nil, err
+	}
+
+	wr.mtx.Lock()
+	defer wr.mtx.Unlock()
+
+	if cached, ok := wr.compiled[hash]; ok {
+		compiled.Close(ctx)
+		return cached, nil
+	}
+
+	// closing is safe while instances of the module run
+	if len(wr.compiled) >= maxCachedWasmModules {
+		for k, v := range wr.compiled {
+			v.Close(ctx)
+			delete(wr.compiled, k)
+			break
+		}
+	}
+
+	wr.compiled[hash] = compiled
+
+	return compiled, nil
+
+}
+
+// run runs a compiled module on input in a fresh instance.
+func (wr *wasmRuntime) run(ctx context.Context, wli *workflowLogicInstance, compiled wazero.CompiledModule, input interface{}) (interface{}, error) {
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	run := &wasmRun{instance: wli}
+
+	ctx, cancel := context.WithTimeout(ctx, wr.timeout)
+	defer cancel()
+	ctx = context.WithValue(ctx, wasmRunKey{}, run)
+
+	// an empty name lets instances of the same module run side by side
+	mod, err := wr.runtime.InstantiateModule(ctx, compiled, wazero.NewModuleConfig().WithName(""))
+	if err != nil {
+		return nil, wr.error(ctx, nil, err)
+	}
+	defer mod.Close(context.Background())
+
+	results, err := mod.ExportedFunction("alloc").Call(ctx, uint64(len(data)))
+	if err != nil {
+		return nil, wr.error(ctx, mod, err)
+	}
+
+	ptr := uint32(results[0])
+
+	if !mod.Memory().Write(ptr, data) {
+		return nil, NewCatchableError(ErrCodeWasmModule, "wasm module allocated too little memory for its input")
+	}
+
+	results, err = mod.ExportedFunction("transform").Call(ctx, uint64(ptr), uint64(len(data)))
+	if err != nil {
+		return nil, wr.error(ctx, mod, err)
+	}
+
+	if run.failed {
+		return nil, NewCatchableError(ErrCodeWasmModule, "wasm module failed: %s", run.failure)
+	}
+
+	size := uint32(results[0])
+	if size > wr.output {
+		return nil, NewCatchableError(ErrCodeWasmLimit, "wasm module returned more than %d kilobytes", wr.output>>10)
+	}
+
+	out, ok := mod.Memory().Read(uint32(results[0]>>32), size)
+	if !ok {
+		return nil, NewCatchableError(ErrCodeWasmModule, "wasm module returned output outside its memory")
+	}
+
+	var x interface{}
+	err = json.Unmarshal(out, &x)
+	if err != nil {
+		return nil, NewCatchableError(ErrCodeWasmModule, "wasm module returned invalid json: %v", err)
+	}
+
+	return x, nil
+
+}
+
+func (wr *wasmRuntime) error(ctx context.Context, mod api.Module, err error) error {
+
+	var exit *sys.ExitError
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) || (errors.As(err, &exit) && exit.ExitCode() == sys.ExitCodeDeadlineExceeded) {
+		return NewCatchableError(ErrCodeWasmLimit, "wasm module ran for too long")
+	}
+
+	// modules can't tell why growing their memory failed, and usually trap
+	// right after, so traps close to the limit are taken to be the reason
+	if mod != nil && mod.Memory() != nil && uint64(mod.Memory().Size())+wasmMemoryMargin > wr.memory {
+		return NewCatchableError(ErrCodeWasmLimit, "wasm module failed with its memory grown to the limit of %d megabytes: %v", wr.memory>>20, err)
+	}
+
+	return NewCatchableError(ErrCodeWasmModule, "wasm module failed: %v", err)
+
+}
+
+// resolveWasmModule returns the namespace whose module of a name the
+// workflows of ns run, and the module's hash.
+func (db *dbManager) resolveWasmModule(ctx context.Context, ns, name string) (string, string, error) {
+
+	ancestors, err := db.namespaceAncestors(ctx, ns)
+	if err != nil {
+		return "", "", err
+	}
+
+	for _, owner := range append([]string{ns}, ancestors...) {
+
+		hash, err := db.getWasmModuleHash(ctx, owner, name)
+		if err != nil {
+			return "", "", err
+		}
+
+		if hash != "" {
+			return owner, hash, nil
+		}
+
+	}
+
+	return "", "", NewCatchableError(ErrCodeWasmModule, "wasm module '%s' does not exist", name)
+
+}
+
+// runWasmModule runs a module of the instance's namespace on input.
+func (wli *workflowLogicInstance) runWasmModule(ctx context.Context, name string, input interface{}) (interface{}, error) {
+
+	we := wli.engine
+
+	owner, hash, err := we.db.resolveWasmModule(ctx, wli.namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, err := we.wasm.module(ctx, hash, func() ([]byte, error) {
+		mod, err := we.db.getWasmModule(ctx, owner, name)
+		if err != nil {
+			return nil, err
+		}
+		return mod.Binary, nil
+	})
+	if err != nil {
+		return nil, NewInternalError(fmt.Errorf("cannot load wasm module '%s': %v", name, err))
+	}
+
+	return we.wasm.run(ctx, wli, compiled, input)
+
+}
+
+// wasmTransform replaces the state data with the output of a module, which
+// has to be an object like that of any other transform.
+func (wli *workflowLogicInstance) wasmTransform(name string) error {
+
+	x, err := wli.runWasmModule(context.Background(), name, wli.data)
+	if err == nil {
+		if m, ok := x.(map[string]interface{}); ok {
+			wli.data = m
+			return nil
+		}
+		err = NewCatchableError(ErrCodeJQNotObject, "wasm module '%s' produced a non-object output", name)
+	}
+
+	return WrapCatchableError("unable to apply transform: %v", err)
+
+}
+
+func (we *workflowEngine) setWasmModule(ctx context.Context, ns, name string, binary []byte) error {
+
+	if !wasmModuleNameRegex.MatchString(name) {
+		return grpc.Errorf(codes.InvalidArgument, "invalid module name '%s'", name)
+	}
+
+	if len(binary) > maxWasmModuleSize {
+		return grpc.Errorf(codes.InvalidArgument, "wasm modules can't be larger than %d bytes", maxWasmModuleSize)
+	}
+
+	compiled, err := we.wasm.compile(ctx, binary)
+	if err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "invalid wasm module: %v", err)
+	}
+	compiled.Close(ctx)
+
+	sum := sha256.Sum256(binary)
+
+	err = we.db.setWasmModule(ctx, ns, name, binary, hex.EncodeToString(sum[:]))
+	if err != nil {
+		return grpcDatabaseError(err, "namespace", ns)
+	}
+
+	return nil
+
+}
@@ -0,0 +1,254 @@
+package direktiv
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/vorteil/direktiv/ent"
+	"github.com/vorteil/direktiv/pkg/model"
+)
+
+// maxCachedWorkflows bounds how many parsed definitions a server keeps.
+const maxCachedWorkflows = 1024
+
+// workflowCache holds parsed workflow definitions so that instances don't
+// re-query and re-parse their workflow's YAML on every step. Definitions are
+// keyed by workflow id and revision, so instances still running an older
+// revision keep theirs cached next to the latest one. Every change to a
+// workflow bumps its revision, so a stale definition is never served even if
+// the change was made through another server; invalidation only frees the
+// memory early.
+//
+// Cached definitions are shared between instances and must not be modified.
+// The state logics initialized for a definition are cached alongside it.
+type workflowCache struct {
+	mtx         sync.Mutex
+	lru         *list.List
+	entries     map[workflowRevision]*list.Element
+	definitions map[*model.Workflow]*cachedWorkflow
+}
+
+type workflowRevision struct {
+	id       uuid.UUID
+	revision int
+}
+
+type cachedWorkflow struct {
+	key    workflowRevision
+	wf     *model.Workflow
+	logics map[string]stateLogic
+}
+
+func newWorkflowCache() *workflowCache {
+	return &workflowCache{
+		lru:         list.New(),
+		entries:     make(map[workflowRevision]*list.Element),
+		definitions: make(map[*model.Workflow]*cachedWorkflow),
+	}
+}
+
+func (c *workflowCache) get(id uuid.UUID, revision int) *model.Workflow {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	e, ok := c.entries[workflowRevision{id, revision}]
+	if !ok {
+		return nil
+	}
+
+	c.lru.MoveToFront(e)
+
+	return e.Value.(*cachedWorkflow).wf
+
+}
+
+func (c *workflowCache) put(id uuid.UUID, revision int, wf *model.Workflow) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	key := workflowRevision{id, revision}
+
+	// keep the definition a concurrent step loaded, and its state logics
+	if e, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(e)
+		return
+	}
+
+	cw := &cachedWorkflow{
+		key:    key,
+		wf:     wf,
+		logics: make(map[string]stateLogic),
+	}
+	c.entries[key] = c.lru.PushFront(cw)
+	c.definitions[wf] = cw
+
+	for c.lru.Len() > maxCachedWorkflows {
+		c.remove(c.lru.Back())
+	}
+
+}
+
+func (c *workflowCache) invalidate(id uuid.UUID) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for e := c.lru.Front(); e != nil; {
+		next := e.Next()
+		if e.Value.(*cachedWorkflow).key.id == id {
+			c.remove(e)
+		}
+		e = next
+	}
+
+}
+
+func (c *workflowCache) remove(e *list.Element) {
+	cw := e.Value.(*cachedWorkflow)
+	c.lru.Remove(e)
+	delete(c.entries, cw.key)
+	delete(c.definitions, cw.wf)
+}
+
+func (c *workflowCache) logic(wf *model.Workflow, state string) stateLogic {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if cw, ok := c.definitions[wf]; ok {
+		return cw.logics[state]
+	}
+
+	return nil
+
+}
+
+// storeLogic keeps an initialized state logic with its definition, if the
+// definition is one from the cache.
+func (c *workflowCache) storeLogic(wf *model.Workflow, state string, sl stateLogic) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if cw, ok := c.definitions[wf]; ok {
+		cw.logics[state] = sl
+	}
+
+}
+
+// loadWorkflow returns the parsed definition of a workflow record. Records
+// queried without their definition, as instances are, have it fetched only if
+// the revision isn't cached.
+func (we *workflowEngine) loadWorkflow(ctx context.Context, rec *ent.Workflow) (*model.Workflow, error) {
+
+	if wf := we.workflows.get(rec.ID, rec.Revision); wf != nil {
+		return wf, nil
+	}
+
+	data := rec.Workflow
+	revision := rec.Revision
+
+	if len(data) == 0 {
+		full, err := we.db.getWorkflowByID(rec.ID)
+		if err != nil {
+			return nil, err
+		}
+		data = full.Workflow
+		revision = full.Revision
+	}
+
+	wf := new(model.Workflow)
+	err := wf.Load(data)
+	if err != nil {
+		return nil, err
+	}
+
+	we.workflows.put(rec.ID, revision, wf)
+
+	return wf, nil
+
+}
+
+var errRevisionUnavailable = errors.New("revision is not available")
+
+// loadWorkflowRevision returns the definition a workflow had at revision,
+// which is its latest one unless the workflow has been updated since.
+func (we *workflowEngine) loadWorkflowRevision(ctx context.Context, rec *ent.Workflow, revision int) (*model.Workflow, error) {
+
+	if revision == rec.Revision {
+		return we.loadWorkflow(ctx, rec)
+	}
+
+	if revision > rec.Revision {
+		return nil, errRevisionUnavailable
+	}
+
+	if wf := we.workflows.get(rec.ID, revision); wf != nil {
+		return wf, nil
+	}
+
+	r, err := we.db.getWorkflowRevision(ctx, rec.ID, revision)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errRevisionUnavailable
+		}
+		return nil, err
+	}
+
+	wf := new(model.Workflow)
+	err = wf.Load(r.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	we.workflows.put(rec.ID, revision, wf)
+
+	return wf, nil
+
+}
+
+// loadInstanceWorkflow returns the definition and revision an instance that
+// started at revision runs. Instances finish on the revision they started
+// with, except those started before revisions were kept, which run the
+// latest one.
+func (we *workflowEngine) loadInstanceWorkflow(ctx context.Context, rec *ent.Workflow, revision int) (*model.Workflow, int, error) {
+
+	wf, err := we.loadWorkflowRevision(ctx, rec, revision)
+	if err == errRevisionUnavailable {
+		revision = rec.Revision
+		wf, err = we.loadWorkflow(ctx, rec)
+	}
+
+	return wf, revision, err
+
+}
+
+// initStateLogic returns the logic for one of wf's states, initializing it
+// only the first time it is needed for the revision.
+func (we *workflowEngine) initStateLogic(wf *model.Workflow, state model.State) (stateLogic, error) {
+
+	if sl := we.workflows.logic(wf, state.GetID()); sl != nil {
+		return sl, nil
+	}
+
+	init, exists := we.stateLogics[state.GetType()]
+	if !exists {
+		return nil, fmt.Errorf("engine cannot resolve state type: %s", state.GetType().String())
+	}
+
+	sl, err := init(wf, state)
+	if err != nil {
+		return nil, fmt.Errorf("cannot initialize state logic: %v", err)
+	}
+
+	we.workflows.storeLogic(wf, state.GetID(), sl)
+
+	return sl, nil
+
+}
@@ -2,8 +2,10 @@ package direktiv
 
 import (
 	"context"
+	"fmt"
 	"os"
 
+	"github.com/vorteil/direktiv/pkg/clock"
 	"github.com/vorteil/direktiv/pkg/jqer"
 	"github.com/vorteil/direktiv/pkg/varstore"
 
@@ -11,6 +13,7 @@ import (
 	_ "github.com/lib/pq" // postgres for ent
 	log "github.com/sirupsen/logrus"
 	"github.com/vorteil/direktiv/pkg/dlog"
+	"github.com/vorteil/direktiv/pkg/dlog/spool"
 	"google.golang.org/grpc/resolver"
 )
 
@@ -38,9 +41,12 @@ type WorkflowServer struct {
 	dbManager *dbManager
 	tmManager *timerManager
 	engine    *workflowEngine
+	clock     clock.Clock
+	regions   *regionRouter
 
 	LifeLine        chan bool
 	instanceLogger  dlog.Log
+	logSpool        *spool.Spool
 	variableStorage varstore.VarStorage
 
 	components map[string]component
@@ -66,6 +72,7 @@ func (s *WorkflowServer) initWorkflowServer() error {
 	var timerFunctions = map[string]func([]byte) error{
 		timerCleanInstanceRecords:  s.tmManager.cleanInstanceRecords,
 		timerCleanNamespaceRecords: s.tmManager.cleanNamespaceRecords,
+		timerHeartbeat:             s.tmManager.heartbeat,
 	}
 
 	for n, f := range timerFunctions {
@@ -83,6 +90,8 @@ func (s *WorkflowServer) initWorkflowServer() error {
 
 	addCron(timerCleanNamespaceRecords, "0 */2 * * *")
 
+	addCron(timerHeartbeat, fmt.Sprintf("@every %v", timerHeartbeatInterval))
+
 	ingressServer, err := newIngressServer(s)
 	if err != nil {
 		return err
@@ -108,6 +117,7 @@ func NewWorkflowServer(config *Config) (*WorkflowServer, error) {
 	jqer.WrappingBegin = "jq"
 	jqer.WrappingIncrement = "("
 	jqer.WrappingDecrement = ")"
+	jqer.JSONataWrappingBegin = "jsonata"
 
 	var (
 		err error
@@ -119,6 +129,7 @@ func NewWorkflowServer(config *Config) (*WorkflowServer, error) {
 		LifeLine:   make(chan bool),
 		config:     config,
 		components: make(map[string]component),
+		clock:      clock.Real,
 	}
 
 	// not needed for secrets
@@ -127,12 +138,12 @@ func NewWorkflowServer(config *Config) (*WorkflowServer, error) {
 		return nil, err
 	}
 	s.dbManager.varStorage = &s.variableStorage
+	s.dbManager.clock = s.clock
 
-	err = s.initWorkflowServer()
+	s.regions, err = newRegionRouter(s.dbManager, config)
 	if err != nil {
 		return nil, err
 	}
-	s.dbManager.tm = s.tmManager
 
 	hn, err := os.Hostname()
 	if err != nil {
@@ -141,19 +152,65 @@ func NewWorkflowServer(config *Config) (*WorkflowServer, error) {
 
 	s.hostname = hn
 
+	err = s.initWorkflowServer()
+	if err != nil {
+		return nil, err
+	}
+	s.dbManager.tm = s.tmManager
+
 	return s, nil
 
 }
 
-// SetInstanceLogger set logger for direktiv for firecracker instances
+// SetInstanceLogger set logger for direktiv for firecracker instances. Logs
+// are spooled to disk while it can't create loggers, so instances keep
+// running through outages of the log store.
 func (s *WorkflowServer) SetInstanceLogger(l dlog.Log) {
-	s.instanceLogger = l
+	if s.regions.configured() {
+		l = &regionLogs{regions: s.regions, def: l}
+	}
+	if s.logSpool != nil {
+		s.logSpool.Close()
+	}
+	s.logSpool = spool.New(l, s.config.InstanceLogging.Spool,
+		int64(s.config.InstanceLogging.SpoolSize)*1024*1024)
+	s.instanceLogger = s.logSpool
+}
+
+// SetClock replaces the clock that the engine and the timer manager go by,
+// for tests and simulations that move time on by themselves. Crons, such as
+// scheduled starts, keep to the wall clock. It must be called before Run.
+func (s *WorkflowServer) SetClock(c clock.Clock) {
+	s.clock = c
+	s.dbManager.clock = c
+	s.tmManager.clock = c
+	s.engine.clock = c
 }
 
 func (s *WorkflowServer) SetVariableStorage(vs varstore.VarStorage) {
+	if s.regions.configured() {
+		vs = &regionVars{regions: s.regions, def: vs}
+	}
 	s.variableStorage = vs
 }
 
+// SetStorageRegion sets the instance logger and variable storage of a
+// configured storage region. Namespaces pinned to a region without them
+// can't log or store variables.
+func (s *WorkflowServer) SetStorageRegion(name string, l dlog.Log, vs varstore.VarStorage) error {
+
+	r, ok := s.regions.regions[name]
+	if !ok {
+		return fmt.Errorf("storage region '%s' isn't configured", name)
+	}
+
+	r.logs = l
+	r.vars = vs
+
+	return nil
+
+}
+
 // Lifeline interface impl
 func (s *WorkflowServer) Lifeline() chan bool {
 	return s.LifeLine
@@ -164,6 +221,9 @@ func (s *WorkflowServer) cleanup() {
 	// closing db at the end
 	if s.dbManager != nil {
 		defer s.dbManager.dbEnt.Close()
+		if s.dbManager.dbRead != s.dbManager.dbEnt {
+			defer s.dbManager.dbRead.Close()
+		}
 	}
 
 	if s.tmManager != nil {
@@ -176,6 +236,11 @@ func (s *WorkflowServer) cleanup() {
 		comp.stop()
 	}
 
+	// keep what is still spooled for the next start
+	if s.logSpool != nil {
+		s.logSpool.Close()
+	}
+
 	if s.dbManager.grpcConn != nil {
 		s.dbManager.grpcConn.Close()
 	}
@@ -188,6 +253,7 @@ func (s *WorkflowServer) Stop() {
 	go func() {
 
 		log.Printf("stopping workflow server")
+		s.engine.drain()
 		s.cleanup()
 		s.LifeLine <- true
 
@@ -213,11 +279,27 @@ func (s *WorkflowServer) Kill() {
 // Run starts all components of direktiv
 func (s *WorkflowServer) Run() error {
 
-	log.Debugf("subscribing to sync queue")
-	err := s.startDatabaseListener()
-	if err != nil {
-		s.Kill()
-		return err
+	if s.engine.isStandby() {
+
+		// the database is a read-only replica until this server is promoted
+		log.Infof("starting as standby")
+		go s.awaitPromotion()
+
+	} else {
+
+		log.Debugf("subscribing to sync queue")
+		err := s.startDatabaseListener()
+		if err != nil {
+			s.Kill()
+			return err
+		}
+
+		err = s.engine.startNode()
+		if err != nil {
+			s.Kill()
+			return err
+		}
+
 	}
 
 	for _, comp := range s.components {
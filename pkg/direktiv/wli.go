@@ -2,11 +2,12 @@ package direktiv
 
 import (
 	"context"
-	"database/sql"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
+	"strings"
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -16,7 +17,6 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/vorteil/direktiv/ent"
 	"github.com/vorteil/direktiv/pkg/dlog"
-	"github.com/vorteil/direktiv/pkg/ingress"
 	"github.com/vorteil/direktiv/pkg/jqer"
 	"github.com/vorteil/direktiv/pkg/model"
 	"google.golang.org/grpc"
@@ -30,28 +30,55 @@ type workflowLogicInstance struct {
 	wf        *model.Workflow
 	rec       *ent.WorkflowInstance
 	step      int
+	profile   string
+	constants map[string]interface{}
+	modules   *jqer.Modules
 
 	namespace       string
 	id              string
 	logToEvents     string
-	lockConn        *sql.Conn
+	revision        int
+	entryState      model.State
+	dblock          *dbLock
+	lockWait        time.Duration
 	logic           stateLogic
 	logger          dlog.Logger
 	namespaceLogger dlog.Logger
+
+	// set once the failing state has cancelled its children
+	childrenCancelled bool
 }
 
 func (we *workflowEngine) newWorkflowLogicInstance(ctx context.Context, namespace, name string, input []byte) (*workflowLogicInstance, error) {
+	return we.newWorkflowLogicInstanceWithID(ctx, namespace, name, "", input)
+}
+
+// newWorkflowLogicInstanceWithID prepares an instance with the given id, or a
+// new one if it is empty.
+func (we *workflowEngine) newWorkflowLogicInstanceWithID(ctx context.Context, namespace, name, id string, input []byte) (*workflowLogicInstance, error) {
+	return we.newWorkflowLogicInstanceAt(ctx, namespace, name, id, -1, input)
+}
+
+// newWorkflowLogicInstanceAt prepares an instance running the workflow's
+// definition at revision, or its latest one if revision is negative.
+func (we *workflowEngine) newWorkflowLogicInstanceAt(ctx context.Context, namespace, name, id string, revision int, input []byte) (*workflowLogicInstance, error) {
 
 	var err error
-	var inputData, stateData interface{}
+	var inputData interface{}
+	var stateData map[string]interface{}
+
+	err = we.checkInvocationHalt()
+	if err != nil {
+		return nil, err
+	}
 
 	err = json.Unmarshal(input, &inputData)
 	if err != nil {
 		inputData = base64.StdEncoding.EncodeToString(input)
 	}
 
-	if _, ok := inputData.(map[string]interface{}); ok {
-		stateData = inputData
+	if m, ok := inputData.(map[string]interface{}); ok {
+		stateData = m
 	} else {
 		stateData = map[string]interface{}{
 			"input": inputData,
@@ -70,8 +97,31 @@ func (we *workflowEngine) newWorkflowLogicInstance(ctx context.Context, namespac
 		return nil, grpc.Errorf(codes.InvalidArgument, "workflow is inactive")
 	}
 
-	wf := new(model.Workflow)
-	err = wf.Load(rec.Workflow)
+	if revision < 0 {
+		revision = rec.Revision
+	}
+
+	wf, err := we.loadWorkflowRevision(ctx, rec, revision)
+	if err != nil {
+		if err == errRevisionUnavailable {
+			return nil, NewUncatchableError("direktiv.subflow.revision", "workflow '%s' revision %d is not available (latest: %d)", name, revision, rec.Revision)
+		}
+		return nil, NewInternalError(err)
+	}
+
+	mergeDefaults(stateData, wf.Defaults)
+
+	profile, err := we.db.resolveProfile(ctx, namespace)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	constants, err := we.db.resolveConstants(ctx, namespace, profile, wf)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	modules, err := we.db.resolveJQModules(ctx, namespace)
 	if err != nil {
 		return nil, NewInternalError(err)
 	}
@@ -80,10 +130,17 @@ func (we *workflowEngine) newWorkflowLogicInstance(ctx context.Context, namespac
 	wli.namespace = namespace
 	wli.engine = we
 	wli.wf = wf
+	wli.profile = profile
+	wli.constants = constants
+	wli.modules = modules
 	wli.data = stateData
 	wli.logToEvents = rec.LogToEvents
+	wli.revision = revision
 
-	wli.id = fmt.Sprintf("%s/%s/%s", namespace, name, randSeq(6))
+	wli.id = id
+	if wli.id == "" {
+		wli.id = fmt.Sprintf("%s/%s/%s", namespace, name, randSeq(6))
+	}
 	wli.startData, err = json.MarshalIndent(wli.data, "", "  ")
 	if err != nil {
 		return nil, NewInternalError(err)
@@ -103,21 +160,83 @@ func (we *workflowEngine) newWorkflowLogicInstance(ctx context.Context, namespac
 
 }
 
+// mergeDefaults fills in the fields missing from data with copies of the
+// ones in defaults, recursing into objects that both have. Fields given in
+// data win, even if they are null.
+func mergeDefaults(data, defaults map[string]interface{}) {
+
+	for k, v := range defaults {
+
+		x, ok := data[k]
+		if !ok {
+			data[k] = copyJSONValue(v)
+			continue
+		}
+
+		xm, ok := x.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if vm, ok := v.(map[string]interface{}); ok {
+			mergeDefaults(xm, vm)
+		}
+
+	}
+
+}
+
+// copyJSONValue copies the objects and arrays of a decoded json value, so that
+// instances can't change the cached workflow definition they come from.
+func copyJSONValue(v interface{}) interface{} {
+
+	switch x := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(x))
+		for k, y := range x {
+			m[k] = copyJSONValue(y)
+		}
+		return m
+	case []interface{}:
+		a := make([]interface{}, len(x))
+		for i, y := range x {
+			a[i] = copyJSONValue(y)
+		}
+		return a
+	default:
+		return v
+	}
+
+}
+
 func (wli *workflowLogicInstance) start() {
 
-	ctx, err := wli.lock(time.Second * 5)
+	ctx, err := wli.lock(wli.engine.lockWait())
 	if err != nil {
 		log.Error(err)
 		return
 	}
 
 	log.Debugf("Starting workflow %v", wli.id)
-	start := wli.wf.GetStartState()
+	start := wli.entryState
+	if start == nil {
+		start = wli.wf.GetStartState()
+	}
 	wli.Transition(ctx, start.GetID(), 0)
 
 }
 
 func (we *workflowEngine) loadWorkflowLogicInstance(id string, step int) (context.Context, *workflowLogicInstance, error) {
+	return we.loadLogicInstance(id, step, false)
+}
+
+// loadResumingLogicInstance loads an instance that waited for its cancelled
+// isolates to stop.
+func (we *workflowEngine) loadResumingLogicInstance(id string, step int) (context.Context, *workflowLogicInstance, error) {
+	return we.loadLogicInstance(id, step, true)
+}
+
+func (we *workflowEngine) loadLogicInstance(id string, step int, resuming bool) (context.Context, *workflowLogicInstance, error) {
 
 	wli := new(workflowLogicInstance)
 	wli.id = id
@@ -131,7 +250,7 @@ func (we *workflowEngine) loadWorkflowLogicInstance(id string, step int) (contex
 		}
 	}()
 
-	ctx, err := wli.lock(time.Second * defaultLockWait)
+	ctx, err := wli.lock(we.lockWait())
 	if err != nil {
 		return ctx, nil, NewInternalError(fmt.Errorf("cannot assume control of workflow instance lock: %v", err))
 	}
@@ -160,21 +279,36 @@ func (we *workflowEngine) loadWorkflowLogicInstance(id string, step int) (contex
 		return ctx, nil, NewInternalError(fmt.Errorf("cannot initialize instance logger: %v", err))
 	}
 
+	wli.verbose("Acquired instance lock after %v.", wli.lockWait)
+
 	err = json.Unmarshal([]byte(rec.StateData), &wli.data)
 	if err != nil {
 		wli.unlock()
 		return ctx, nil, NewInternalError(fmt.Errorf("cannot load saved workflow state data: %v", err))
 	}
 
-	wli.wf = new(model.Workflow)
 	wli.logToEvents = qwf.LogToEvents
 
-	err = wli.wf.Load(qwf.Workflow)
+	wli.wf, wli.revision, err = we.loadInstanceWorkflow(ctx, qwf, rec.Revision)
 	if err != nil {
 		wli.unlock()
 		return ctx, nil, NewInternalError(fmt.Errorf("cannot load saved workflow definition: %v", err))
 	}
 
+	wli.profile = rec.Profile
+
+	wli.constants, err = we.db.resolveConstants(ctx, qns.ID, wli.profile, wli.wf)
+	if err != nil {
+		wli.unlock()
+		return ctx, nil, NewInternalError(fmt.Errorf("cannot resolve workflow constants: %v", err))
+	}
+
+	wli.modules, err = we.db.resolveJQModules(ctx, qns.ID)
+	if err != nil {
+		wli.unlock()
+		return ctx, nil, NewInternalError(fmt.Errorf("cannot resolve jq modules: %v", err))
+	}
+
 	if rec.Status != "pending" && rec.Status != "running" {
 		wli.unlock()
 		return ctx, nil, NewInternalError(fmt.Errorf("aborting workflow logic: database records instance terminated"))
@@ -185,28 +319,35 @@ func (we *workflowEngine) loadWorkflowLogicInstance(id string, step int) (contex
 		wli.unlock()
 		return ctx, nil, NewInternalError(fmt.Errorf("aborting workflow logic: steps out of sync (expect/actual - %d/%d)", step, len(rec.Flow)))
 	}
-	step = wli.step
-
-	state := rec.Flow[step-1]
-	states := wli.wf.GetStatesMap()
-	stateObject, exists := states[state]
-	if !exists {
+	// only a queued start can load an instance that hasn't run a state yet
+	if wli.step == 0 && step != 0 {
 		wli.unlock()
-		return ctx, nil, NewInternalError(fmt.Errorf("workflow cannot resolve state: %s", state))
+		return ctx, nil, NewInternalError(fmt.Errorf("aborting workflow logic: instance has not started"))
 	}
-
-	init, exists := wli.engine.stateLogics[stateObject.GetType()]
-	if !exists {
+	if step >= 0 && !resuming && we.awaitingCancels(rec, wli.step) {
 		wli.unlock()
-		return ctx, nil, NewInternalError(fmt.Errorf("engine cannot resolve state type: %s", stateObject.GetType().String()))
+		return ctx, nil, NewInternalError(fmt.Errorf("aborting workflow logic: instance is waiting for cancelled isolates to stop"))
 	}
+	step = wli.step
+
+	if step > 0 {
+
+		state := rec.Flow[step-1]
+		states := wli.wf.GetStatesMap()
+		stateObject, exists := states[state]
+		if !exists {
+			wli.unlock()
+			return ctx, nil, NewInternalError(fmt.Errorf("workflow cannot resolve state: %s", state))
+		}
+
+		stateLogic, err := we.initStateLogic(wli.wf, stateObject)
+		if err != nil {
+			wli.unlock()
+			return ctx, nil, NewInternalError(err)
+		}
+		wli.logic = stateLogic
 
-	stateLogic, err := init(wli.wf, stateObject)
-	if err != nil {
-		wli.unlock()
-		return ctx, nil, NewInternalError(fmt.Errorf("cannot initialize state logic: %v", err))
 	}
-	wli.logic = stateLogic
 
 	success = true
 
@@ -216,7 +357,7 @@ func (we *workflowEngine) loadWorkflowLogicInstance(id string, step int) (contex
 
 func (wli *workflowLogicInstance) Close() error {
 
-	if wli.lockConn != nil {
+	if wli.dblock != nil {
 		wli.unlock()
 	}
 
@@ -244,10 +385,16 @@ func (wli *workflowLogicInstance) Raise(ctx context.Context, cerr *CatchableErro
 
 	if wli.rec.ErrorCode == "" {
 		wf := wli.rec.Edges.Workflow
+		var data string
+		data, err = marshalErrorData(cerr)
+		if err != nil {
+			return NewInternalError(err)
+		}
 		wli.rec, err = wli.rec.Update().
 			SetStatus("failed").
 			SetErrorCode(cerr.Code).
 			SetErrorMessage(cerr.Message).
+			SetErrorData(data).
 			Save(ctx)
 		wli.rec.Edges.Workflow = wf
 		if err != nil {
@@ -261,6 +408,86 @@ func (wli *workflowLogicInstance) Raise(ctx context.Context, cerr *CatchableErro
 
 }
 
+// setErrorData records the structured payload of the error that is about to
+// fail the instance, unless an earlier error has already been recorded.
+func (wli *workflowLogicInstance) setErrorData(ctx context.Context, cerr *CatchableError) error {
+
+	if wli.rec.ErrorCode != "" || cerr.Data == nil {
+		return nil
+	}
+
+	data, err := marshalErrorData(cerr)
+	if err != nil {
+		return err
+	}
+
+	wf := wli.rec.Edges.Workflow
+	wli.rec, err = wli.rec.Update().SetErrorData(data).Save(ctx)
+	if err != nil {
+		return err
+	}
+	wli.rec.Edges.Workflow = wf
+
+	return nil
+
+}
+
+// setCancelReason records why a pending instance is being hard cancelled,
+// unless it has already failed for another reason.
+func (wli *workflowLogicInstance) setCancelReason(ctx context.Context, code, message string) error {
+
+	if wli.rec.Status != "pending" || wli.rec.ErrorCode != "" || wli.rec.CancelCategory != "" {
+		return nil
+	}
+
+	var err error
+
+	wf := wli.rec.Edges.Workflow
+	wli.rec, err = wli.rec.Update().
+		SetCancelCategory(strings.TrimPrefix(code, cancelCodePrefix)).
+		SetCancelReason(message).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	wli.rec.Edges.Workflow = wf
+
+	return nil
+
+}
+
+func marshalErrorData(cerr *CatchableError) (string, error) {
+
+	if cerr.Data == nil {
+		return "", nil
+	}
+
+	data, err := json.Marshal(cerr.Data)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+
+}
+
+// setErrorCauses records the sanitized cause chain of the internal error that
+// crashed the instance.
+func (wli *workflowLogicInstance) setErrorCauses(ctx context.Context, ierr *InternalError) error {
+
+	var err error
+
+	wf := wli.rec.Edges.Workflow
+	wli.rec, err = wli.rec.Update().SetErrorCauses(ErrorCauses(ierr)).Save(ctx)
+	if err != nil {
+		return err
+	}
+	wli.rec.Edges.Workflow = wf
+
+	return nil
+
+}
+
 func (wli *workflowLogicInstance) setStatus(ctx context.Context, status, code, message string) error {
 
 	var err error
@@ -269,14 +496,14 @@ func (wli *workflowLogicInstance) setStatus(ctx context.Context, status, code, m
 		code = "direktiv.internal.error"
 	}
 
-	wli.engine.completeState(ctx, wli.rec, "", code, false)
+	wli.engine.completeState(ctx, wli, "", code, false)
 
 	wf := wli.rec.Edges.Workflow
 
 	if wli.rec.ErrorCode == "" {
 		wli.rec, err = wli.rec.Update().
 			SetStatus(status).
-			SetEndTime(time.Now()).
+			SetEndTime(wli.engine.clock.Now()).
 			SetErrorCode(code).
 			SetErrorMessage(message).
 			Save(ctx)
@@ -285,7 +512,7 @@ func (wli *workflowLogicInstance) setStatus(ctx context.Context, status, code, m
 	}
 
 	wli.rec, err = wli.rec.Update().
-		SetEndTime(time.Now()).
+		SetEndTime(wli.engine.clock.Now()).
 		Save(ctx)
 	wli.rec.Edges.Workflow = wf
 
@@ -308,6 +535,11 @@ func (wli *workflowLogicInstance) wakeCaller(ctx context.Context, data []byte) {
 			return
 		}
 
+		// failed instances report their error data in place of output
+		if wli.rec.ErrorCode != "" && wli.rec.ErrorData != "" {
+			data = []byte(wli.rec.ErrorData)
+		}
+
 		msg := &actionResultMessage{
 			InstanceID: caller.InstanceID,
 			State:      caller.State,
@@ -320,6 +552,19 @@ func (wli *workflowLogicInstance) wakeCaller(ctx context.Context, data []byte) {
 			},
 		}
 
+		if wli.rec.CancelCategory != "" {
+			msg.Payload.Cancel = &CancelReason{
+				Category: wli.rec.CancelCategory,
+				Reason:   wli.rec.CancelReason,
+			}
+		}
+
+		if caller.Callback != "" {
+			wli.Log("Reporting results to remote calling workflow.")
+			go wli.engine.reportToRemoteCaller(wli.id, caller, &msg.Payload)
+			return
+		}
+
 		wli.Log("Reporting results to calling workflow.")
 
 		err = wli.engine.wakeCaller(ctx, msg)
@@ -332,7 +577,7 @@ func (wli *workflowLogicInstance) wakeCaller(ctx context.Context, data []byte) {
 
 }
 
-func (db *dbManager) wfLock(rec *ent.Workflow, timeout time.Duration) (*sql.Conn, error) {
+func (db *dbManager) wfLock(rec *ent.Workflow, timeout time.Duration) (*dbLock, error) {
 
 	hash, err := hashstructure.Hash(rec.ID, hashstructure.FormatV2, nil)
 	if err != nil {
@@ -340,16 +585,16 @@ func (db *dbManager) wfLock(rec *ent.Workflow, timeout time.Duration) (*sql.Conn
 	}
 
 	wait := int(timeout.Seconds())
-	conn, err := db.lockDB(hash, wait)
+	l, err := db.lockDB(hash, wait)
 	if err != nil {
 		return nil, NewInternalError(err)
 	}
 
-	return conn, nil
+	return l, nil
 
 }
 
-func (db *dbManager) wfUnlock(rec *ent.Workflow, conn *sql.Conn) {
+func (db *dbManager) wfUnlock(rec *ent.Workflow, l *dbLock) {
 
 	hash, err := hashstructure.Hash(rec.ID, hashstructure.FormatV2, nil)
 	if err != nil {
@@ -357,7 +602,7 @@ func (db *dbManager) wfUnlock(rec *ent.Workflow, conn *sql.Conn) {
 		return
 	}
 
-	err = db.unlockDB(hash, conn)
+	err = db.unlockDB(hash, l)
 	if err != nil {
 		log.Error(NewInternalError(fmt.Errorf("Failed to unlock database mutex: %v", err)))
 		return
@@ -374,17 +619,18 @@ func (wli *workflowLogicInstance) lock(timeout time.Duration) (context.Context,
 		return nil, NewInternalError(err)
 	}
 
-	wait := int(timeout.Seconds())
-	conn, err := wli.engine.db.lockDB(hash, wait)
+	l, wait, err := wli.engine.lockInstance(wli.id, hash, timeout)
 	if err != nil {
 		return nil, NewInternalError(err)
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
-	wli.engine.cancelsLock.Lock()
-	wli.lockConn = conn
-	wli.engine.cancels[wli.id] = cancel
-	wli.engine.cancelsLock.Unlock()
+	wli.dblock = l
+	wli.lockWait = wait
+	wli.engine.cancels.store(wli.id, cancel)
+
+	// loaded instances log it once their record is
+	wli.verbose("Acquired instance lock after %v.", wli.lockWait)
 
 	return ctx, nil
 
@@ -392,7 +638,7 @@ func (wli *workflowLogicInstance) lock(timeout time.Duration) (context.Context,
 
 func (wli *workflowLogicInstance) unlock() {
 
-	if wli.lockConn == nil {
+	if wli.dblock == nil {
 		return
 	}
 
@@ -402,35 +648,53 @@ func (wli *workflowLogicInstance) unlock() {
 		return
 	}
 
-	wli.engine.cancelsLock.Lock()
-	cancel := wli.engine.cancels[wli.id]
-	delete(wli.engine.cancels, wli.id)
-	cancel()
+	// the context may already have been cancelled by localCancel
+	if cancel := wli.engine.cancels.take(wli.id); cancel != nil {
+		cancel()
+	}
 
-	err = wli.engine.db.unlockDB(hash, wli.lockConn)
-	wli.lockConn = nil
-	wli.engine.cancelsLock.Unlock()
+	l := wli.dblock
+	wli.dblock = nil
 
+	err = wli.engine.db.unlockDB(hash, l)
 	if err != nil {
 		log.Error(NewInternalError(fmt.Errorf("Failed to unlock database mutex: %v", err)))
 		return
 	}
 
+	wli.verbose("Released instance lock.")
+
 	return
 
 }
 
-func jq(input interface{}, command interface{}) ([]interface{}, error) {
-	out, err := jqer.Evaluate(input, command)
+// lockHeld reports whether the instance's lock is still held. A server that
+// lost it, because its lease expired or the connection of its advisory lock
+// dropped, may be racing the server that took the instance over, so it has
+// to check before writing a state transition.
+func (wli *workflowLogicInstance) lockHeld(ctx context.Context) bool {
+
+	if wli.dblock != nil && wli.engine.db.lockHeld(ctx, wli.dblock) {
+		return true
+	}
+
+	log.Errorf("lost the lock of instance %s, abandoning it", wli.id)
+
+	return false
+
+}
+
+func jq(input interface{}, command interface{}, scope *jqer.Scope) ([]interface{}, error) {
+	out, err := jqer.EvaluateInScope(input, command, scope)
 	if err != nil {
 		return nil, NewCatchableError(ErrCodeJQBadQuery, "failed to evaluate jq: %v", err)
 	}
 	return out, nil
 }
 
-func jqOne(input interface{}, command interface{}) (interface{}, error) {
+func jqOne(input interface{}, command interface{}, scope *jqer.Scope) (interface{}, error) {
 
-	output, err := jq(input, command)
+	output, err := jq(input, command, scope)
 	if err != nil {
 		return nil, err
 	}
@@ -443,9 +707,9 @@ func jqOne(input interface{}, command interface{}) (interface{}, error) {
 
 }
 
-func jqObject(input interface{}, command interface{}) (map[string]interface{}, error) {
+func jqObject(input interface{}, command interface{}, scope *jqer.Scope) (map[string]interface{}, error) {
 
-	x, err := jqOne(input, command)
+	x, err := jqOne(input, command, scope)
 	if err != nil {
 		return nil, err
 	}
@@ -459,6 +723,42 @@ func jqObject(input interface{}, command interface{}) (map[string]interface{}, e
 
 }
 
+// toFloat converts a number produced by a query to a float64. gojq produces
+// ints for integers and big ints for large ones, while decoded JSON produces
+// float64s or json.Numbers.
+func toFloat(x interface{}) (float64, bool) {
+
+	switch v := x.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case *big.Int:
+		f, _ := new(big.Float).SetInt(v).Float64()
+		return f, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+
+	return 0, false
+
+}
+
+// expression returns a state's condition, transform or similar expression
+// ready to be evaluated.
+func (wli *workflowLogicInstance) expression(x interface{}) interface{} {
+
+	if wli.wf == nil {
+		return x
+	}
+
+	return wli.wf.Expression(x)
+
+}
+
 func (wli *workflowLogicInstance) UserLog(ctx context.Context, msg string, a ...interface{}) {
 
 	s := fmt.Sprintf(msg, a...)
@@ -474,15 +774,7 @@ func (wli *workflowLogicInstance) UserLog(ctx context.Context, msg string, a ...
 		event.SetType("direktiv.instanceLog")
 		event.SetExtension("logger", attr)
 		event.SetData("application/json", s)
-		data, err := event.MarshalJSON()
-		if err != nil {
-			log.Errorf("failed to marshal UserLog cloudevent: %v", err)
-			return
-		}
-		_, err = wli.engine.ingressClient.BroadcastEvent(ctx, &ingress.BroadcastEventRequest{
-			Namespace:  &wli.namespace,
-			Cloudevent: data,
-		})
+		err := wli.engine.emitEvent(ctx, wli.namespace, &event)
 		if err != nil {
 			log.Errorf("failed to broadcast cloudevent: %v", err)
 			return
@@ -503,13 +795,27 @@ func (wli *workflowLogicInstance) Log(msg string, a ...interface{}) {
 	wli.logger.Info(s)
 }
 
+// verbose logs what the engine does with an instance invoked with verbose
+// logging, at debug level.
+func (wli *workflowLogicInstance) verbose(msg string, a ...interface{}) {
+
+	if wli.logger == nil || !wli.debugger().Verbose {
+		return
+	}
+
+	wli.logger.Debug(fmt.Sprintf(msg, a...))
+
+}
+
 func (wli *workflowLogicInstance) Save(ctx context.Context, data []byte) error {
-	var err error
 
-	str := base64.StdEncoding.EncodeToString(data)
+	memory, err := encodeMemory(wli.engine.memoryEncoding, data)
+	if err != nil {
+		return NewInternalError(err)
+	}
 
 	wf := wli.rec.Edges.Workflow
-	wli.rec, err = wli.rec.Update().SetMemory(str).Save(ctx)
+	wli.rec, err = wli.rec.Update().SetMemoryData(memory).ClearMemory().Save(ctx)
 	if err != nil {
 		return NewInternalError(err)
 	}
@@ -532,7 +838,14 @@ func (wli *workflowLogicInstance) StoreData(key string, val interface{}) error {
 
 func (wli *workflowLogicInstance) Transform(transform interface{}) error {
 
-	x, err := jqObject(wli.data, transform)
+	if s, ok := transform.(string); ok {
+		if m := wasmTransformRegex.FindStringSubmatch(s); m != nil {
+			return wli.wasmTransform(m[1])
+		}
+	}
+
+	x, err := jqObject(wli.data, wli.expression(transform), wli.jqScope())
+	wli.debugTransform("transform", transform, wli.data, x, err, nil)
 	if err != nil {
 		return WrapCatchableError("unable to apply transform: %v", err)
 	}
@@ -577,6 +890,8 @@ func (wli *workflowLogicInstance) scheduleTimeout(oldController string, t time.T
 		log.Error(err)
 	}
 
+	wli.verbose("Scheduling %s timeout at %s.", prefix, deadline.UTC().Format(time.RFC3339))
+
 	err = wli.engine.timer.addOneShot(id, timeoutFunction, deadline, data)
 	if err != nil {
 		log.Error(err)
@@ -584,6 +899,19 @@ func (wli *workflowLogicInstance) scheduleTimeout(oldController string, t time.T
 
 }
 
+// cancelStateHardTimeout removes any kill timeout left behind by the previous
+// state's own timeout override. The workflow-level kill timeout is unaffected.
+func (wli *workflowLogicInstance) cancelStateHardTimeout(oldController string) {
+
+	if wli.step <= 1 {
+		return
+	}
+
+	oldId := fmt.Sprintf("timeout:%s:hard:%d", wli.id, wli.step-1)
+	wli.engine.timer.deleteTimerByName(oldController, wli.engine.server.hostname, oldId)
+
+}
+
 func (wli *workflowLogicInstance) ScheduleHardTimeout(oldController string, t time.Time) {
 	wli.scheduleTimeout(oldController, t, false)
 }
@@ -592,36 +920,137 @@ func (wli *workflowLogicInstance) ScheduleSoftTimeout(oldController string, t ti
 	wli.scheduleTimeout(oldController, t, true)
 }
 
+func subflowTimeoutTimer(id string) string {
+	return fmt.Sprintf("timeout:%s:subflow", id)
+}
+
+// ScheduleSubflowTimeout schedules the kill timeout requested by the action
+// that invoked this instance as a subflow. The caller receives the resulting
+// 'direktiv.cancels.timeout' error like any other subflow failure.
+func (wli *workflowLogicInstance) ScheduleSubflowTimeout(t time.Time) {
+
+	id := subflowTimeoutTimer(wli.id)
+
+	args := &timeoutArgs{
+		InstanceId: wli.id,
+		Soft:       false,
+	}
+
+	data, err := json.Marshal(args)
+	if err != nil {
+		log.Error(err)
+	}
+
+	wli.verbose("Scheduling subflow timeout at %s.", t.UTC().Format(time.RFC3339))
+
+	err = wli.engine.timer.addOneShot(id, timeoutFunction, t, data)
+	if err != nil {
+		log.Error(err)
+	}
+
+}
+
+// instanceTimeouts returns when an instance that began at t is interrupted
+// and killed, if none of its states push these out.
+func (wli *workflowLogicInstance) instanceTimeouts(t time.Time) (soft, hard time.Time, err error) {
+
+	soft = t.Add(time.Minute * 15)
+	hard = t.Add(time.Minute * 20)
+
+	if wli.wf.Timeouts == nil {
+		return
+	}
+
+	if s := wli.wf.Timeouts.Interrupt; s != "" {
+		var d duration.Duration
+		d, err = duration.ParseISO8601(s)
+		if err != nil {
+			return
+		}
+		soft = d.Shift(t)
+		hard = soft.Add(time.Minute * 5)
+	}
+
+	if s := wli.wf.Timeouts.Kill; s != "" {
+		var d duration.Duration
+		d, err = duration.ParseISO8601(s)
+		if err != nil {
+			return
+		}
+		hard = d.Shift(t)
+	}
+
+	return
+
+}
+
+// pushInstanceTimeouts moves the interrupt and kill timeouts of the whole
+// instance out to cover a state whose own timeouts run past them, so that a
+// long state doesn't need its workflow's timeouts raised for every state.
+func (wli *workflowLogicInstance) pushInstanceTimeouts(oldController string, deadline, hardDeadline time.Time) {
+
+	soft, hard, err := wli.instanceTimeouts(wli.rec.BeginTime)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	// a state interrupted at its deadline gets as long to handle the error
+	// as the instance does by default
+	if hardDeadline.IsZero() {
+		hardDeadline = deadline.Add(time.Minute * 5)
+	}
+
+	if deadline.After(soft) {
+		wli.scheduleInstanceTimeout(oldController, deadline, true)
+	}
+
+	if hardDeadline.After(hard) {
+		wli.scheduleInstanceTimeout(oldController, hardDeadline, false)
+	}
+
+}
+
+// scheduleInstanceTimeout replaces an interrupt or kill timeout of the whole
+// instance, the ones scheduled when it began.
+func (wli *workflowLogicInstance) scheduleInstanceTimeout(oldController string, t time.Time, soft bool) {
+
+	prefix := "hard"
+	if soft {
+		prefix = "soft"
+	}
+
+	id := fmt.Sprintf("timeout:%s:%s", wli.id, prefix)
+
+	wli.engine.timer.deleteTimerByName(oldController, wli.engine.server.hostname, id)
+
+	data, err := json.Marshal(&timeoutArgs{
+		InstanceId: wli.id,
+		Soft:       soft,
+	})
+	if err != nil {
+		log.Error(err)
+	}
+
+	wli.verbose("Pushing instance %s timeout out to %s.", prefix, t.UTC().Format(time.RFC3339))
+
+	err = wli.engine.timer.addOneShot(id, timeoutFunction, t, data)
+	if err != nil {
+		log.Error(err)
+	}
+
+}
+
 func (wli *workflowLogicInstance) Transition(ctx context.Context, nextState string, attempt int) {
 
 	oldController := wli.rec.Controller
 
 	if wli.step == 0 {
-		t := time.Now()
-		tSoft := time.Now().Add(time.Minute * 15)
-		tHard := time.Now().Add(time.Minute * 20)
-		if wli.wf.Timeouts != nil {
-			s := wli.wf.Timeouts.Interrupt
-			if s != "" {
-				d, err := duration.ParseISO8601(s)
-				if err != nil {
-					log.Error(err)
-					wli.Close()
-					return
-				}
-				tSoft = d.Shift(t)
-				tHard = tSoft.Add(time.Minute * 5)
-			}
-			s = wli.wf.Timeouts.Kill
-			if s != "" {
-				d, err := duration.ParseISO8601(s)
-				if err != nil {
-					log.Error(err)
-					wli.Close()
-					return
-				}
-				tHard = d.Shift(t)
-			}
+		tSoft, tHard, err := wli.instanceTimeouts(wli.engine.clock.Now())
+		if err != nil {
+			log.Error(err)
+			wli.Close()
+			return
 		}
 		wli.ScheduleSoftTimeout(oldController, tSoft)
 		wli.ScheduleHardTimeout(oldController, tHard)
@@ -655,17 +1084,8 @@ func (wli *workflowLogicInstance) Transition(ctx context.Context, nextState stri
 		return
 	}
 
-	init, exists := wli.engine.stateLogics[state.GetType()]
-	if !exists {
-		err = fmt.Errorf("engine cannot resolve state type: %s", state.GetType().String())
-		log.Error(err)
-		wli.Close()
-		return
-	}
-
-	stateLogic, err := init(wli.wf, state)
+	stateLogic, err := wli.engine.initStateLogic(wli.wf, state)
 	if err != nil {
-		err = fmt.Errorf("cannot initialize state logic: %v", err)
 		log.Error(err)
 		wli.Close()
 		return
@@ -674,18 +1094,31 @@ func (wli *workflowLogicInstance) Transition(ctx context.Context, nextState stri
 
 	flow := append(wli.rec.Flow, nextState)
 	wli.step++
-	deadline := stateLogic.Deadline()
 
-	t := time.Now()
+	t := wli.engine.clock.Now()
+
+	deadline, hardDeadline, err := stateDeadlines(state, stateLogic, t)
+	if err != nil {
+		log.Error(err)
+		wli.Close()
+		return
+	}
 
 	wf := wli.rec.Edges.Workflow
 
+	if !wli.lockHeld(ctx) {
+		wli.Close()
+		return
+	}
+
 	var rec *ent.WorkflowInstance
 	rec, err = wli.rec.Update().
 		SetDeadline(deadline).
 		SetController(wli.engine.server.hostname).
 		SetStateBeginTime(t).
-		SetNillableMemory(nil).
+		ClearMemory().
+		ClearMemoryData().
+		ClearProgress().
 		SetAttempts(attempt).
 		SetFlow(flow).
 		SetStateData(string(data)).
@@ -698,25 +1131,158 @@ func (wli *workflowLogicInstance) Transition(ctx context.Context, nextState stri
 	wli.rec = rec
 	wli.rec.Edges.Workflow = wf
 
+	wli.verbose("Entering state %s (step %d) with deadline %s.", nextState, wli.step, deadline.UTC().Format(time.RFC3339))
+
+	if wli.breakpoint(ctx, oldController) {
+		wli.Close()
+		return
+	}
+
 	wli.ScheduleSoftTimeout(oldController, deadline)
 
+	if !hardDeadline.IsZero() {
+		wli.ScheduleHardTimeout(oldController, hardDeadline)
+	} else {
+		wli.cancelStateHardTimeout(oldController)
+	}
+
+	if state.GetTimeouts() != nil {
+		wli.pushInstanceTimeouts(oldController, deadline, hardDeadline)
+	}
+
 	wli.engine.runState(ctx, wli, nil, nil, nil)
 
 }
 
-func InstanceMemory(rec *ent.WorkflowInstance) ([]byte, error) {
+// stateDeadlines returns the soft deadline for a state beginning at t, and a
+// hard deadline if the state overrides its kill timeout.
+func stateDeadlines(state model.State, logic stateLogic, t time.Time) (deadline, hardDeadline time.Time, err error) {
 
-	if rec.Memory == "" {
-		return nil, nil
+	deadline = logic.Deadline(t)
+
+	timeouts := state.GetTimeouts()
+	if timeouts == nil {
+		return
 	}
 
-	savedata, err := base64.StdEncoding.DecodeString(rec.Memory)
+	if s := timeouts.Interrupt; s != "" {
+		var d duration.Duration
+		d, err = duration.ParseISO8601(s)
+		if err != nil {
+			return
+		}
+		deadline = d.Shift(t)
+	}
+
+	if s := timeouts.Kill; s != "" {
+		var d duration.Duration
+		d, err = duration.ParseISO8601(s)
+		if err != nil {
+			return
+		}
+		hardDeadline = d.Shift(t)
+	}
+
+	return
+
+}
+
+// ExtendDeadline pushes the soft deadline of the current state back to t, for
+// states that only learn how long they will wait once they begin running. An
+// interrupt timeout set on the state itself is left alone.
+func (wli *workflowLogicInstance) ExtendDeadline(ctx context.Context, state model.State, t time.Time) error {
+
+	if timeouts := state.GetTimeouts(); timeouts != nil && timeouts.Interrupt != "" {
+		return nil
+	}
+
+	if !t.After(wli.rec.Deadline) {
+		return nil
+	}
+
+	wf := wli.rec.Edges.Workflow
+
+	rec, err := wli.rec.Update().SetDeadline(t).Save(ctx)
 	if err != nil {
-		err = fmt.Errorf("cannot decode the savedata: %v", err)
-		log.Error(err)
-		return nil, err
+		return NewInternalError(err)
 	}
+	wli.rec = rec
+	wli.rec.Edges.Workflow = wf
+
+	wli.ScheduleSoftTimeout(wli.rec.Controller, t)
 
-	return savedata, nil
+	return nil
+
+}
+
+// how long after its retry is due a state that hasn't woken up is recovered
+const retryWakeGrace = time.Second * 5
+
+// retryState schedules the current state to run again from the beginning
+// after d, as directed by the workflow's default retry policy. Until then the
+// state only has until shortly after it is due to wake up, and its deadlines
+// are computed once it does.
+func (wli *workflowLogicInstance) retryState(ctx context.Context, d time.Duration) error {
+
+	t := wli.engine.clock.Now().Add(d)
+	deadline := t.Add(retryWakeGrace)
+
+	wf := wli.rec.Edges.Workflow
+
+	rec, err := wli.rec.Update().
+		SetDeadline(deadline).
+		SetStateBeginTime(t).
+		ClearMemory().
+		ClearMemoryData().
+		ClearProgress().
+		SetAttempts(wli.rec.Attempts + 1).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	wli.rec = rec
+	wli.rec.Edges.Workflow = wf
+
+	wli.ScheduleSoftTimeout(wli.rec.Controller, deadline)
+
+	return wli.engine.scheduleRetry(wli, t, nil, true)
+
+}
+
+// restartState gives a state retried by retryState its deadlines as it wakes
+// up, so that they are measured from when it actually begins again.
+func (wli *workflowLogicInstance) restartState(ctx context.Context) error {
+
+	state, exists := wli.wf.GetStatesMap()[wli.logic.ID()]
+	if !exists {
+		return fmt.Errorf("workflow cannot resolve state: %s", wli.logic.ID())
+	}
+
+	t := wli.engine.clock.Now()
+
+	deadline, hardDeadline, err := stateDeadlines(state, wli.logic, t)
+	if err != nil {
+		return err
+	}
+
+	wf := wli.rec.Edges.Workflow
+
+	rec, err := wli.rec.Update().
+		SetDeadline(deadline).
+		SetStateBeginTime(t).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	wli.rec = rec
+	wli.rec.Edges.Workflow = wf
+
+	wli.ScheduleSoftTimeout(wli.rec.Controller, deadline)
+
+	if !hardDeadline.IsZero() {
+		wli.ScheduleHardTimeout(wli.rec.Controller, hardDeadline)
+	}
+
+	return nil
 
 }
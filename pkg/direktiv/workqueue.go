@@ -0,0 +1,353 @@
+package direktiv
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// how often drain checks whether the queue has emptied
+	drainPollInterval = time.Millisecond * 100
+
+	// how long a worker waits before retrying after the database failed it
+	queuedStateRetryInterval = time.Second
+)
+
+// workQueue runs state logic on a fixed pool of workers so that a burst of
+// transitions, wakeups and invokes can't spawn tens of thousands of
+// goroutines all contending on the database. Work is only kept in memory,
+// holding the lock of its instance, while there is a free worker to take it.
+// Everything else overflows into the database as a description of the state
+// to run, the instance's lock is released, and a worker loads and locks the
+// instance again when it gets to it. Persisted work is never dropped; it is
+// handed to another node when this one drains, adopted by a live node if this
+// one dies, and new invocations are refused while the backlog is over its
+// limit.
+type workQueue struct {
+	mtx      sync.Mutex
+	cond     *sync.Cond
+	pending  []func()
+	busy     int
+	workers  int
+	limit    int
+	closed   bool
+	draining bool
+
+	// how many states this node has persisted, as far as it knows
+	stored int
+
+	db       *dbManager
+	hostname string
+	run      func(*runStateMessage)
+}
+
+func newWorkQueue(config *Config, db *dbManager, hostname string, run func(*runStateMessage)) *workQueue {
+
+	n := config.Workers
+	if n < 1 {
+		n = 1
+	}
+
+	q := &workQueue{
+		workers:  n,
+		limit:    config.MaxQueuedWork,
+		db:       db,
+		hostname: hostname,
+		run:      run,
+	}
+	q.cond = sync.NewCond(&q.mtx)
+
+	for i := 0; i < n; i++ {
+		go q.worker()
+	}
+
+	return q
+
+}
+
+func (q *workQueue) worker() {
+
+	for {
+
+		q.mtx.Lock()
+		for len(q.pending) == 0 && (q.stored == 0 || q.draining) && !q.closed {
+			q.cond.Wait()
+		}
+		if q.closed {
+			q.mtx.Unlock()
+			return
+		}
+
+		if len(q.pending) > 0 {
+			fn := q.pending[0]
+			q.pending[0] = nil
+			q.pending = q.pending[1:]
+			q.busy++
+			q.mtx.Unlock()
+
+			fn()
+
+			q.mtx.Lock()
+			q.busy--
+			q.mtx.Unlock()
+			continue
+		}
+
+		q.stored--
+		q.busy++
+		q.mtx.Unlock()
+
+		msg, err := q.take(0)
+		if err == nil && msg != nil {
+			q.run(msg)
+		}
+
+		q.mtx.Lock()
+		q.busy--
+		if err != nil {
+			q.stored++
+		} else if msg == nil {
+			// taken by another node or moved, recount catches up with the rest
+			q.stored = 0
+		}
+		q.mtx.Unlock()
+
+		if err != nil {
+			log.Errorf("cannot take queued state: %v", err)
+			time.Sleep(queuedStateRetryInterval)
+		}
+
+	}
+
+}
+
+// submit queues fn to run on the next free worker. It never blocks. Work
+// submitted this way is only kept in memory, so it is reserved for
+// cancellations and operator interventions, which are rare; state logic
+// should go through submitState.
+func (q *workQueue) submit(fn func()) {
+
+	q.mtx.Lock()
+	q.pending = append(q.pending, fn)
+	q.mtx.Unlock()
+
+	q.cond.Signal()
+
+}
+
+// submitState runs fn on a free worker. If there isn't one the state msg
+// describes is persisted instead and release is called to give up the lock of
+// its instance, and fn never runs. It never blocks.
+func (q *workQueue) submitState(fn func(), msg *runStateMessage, release func()) {
+
+	q.mtx.Lock()
+	// persisted work keeps its place ahead of anything submitted after it
+	free := !q.draining && q.stored == 0 && q.busy+len(q.pending) < q.workers
+	if free {
+		q.pending = append(q.pending, fn)
+	}
+	q.mtx.Unlock()
+
+	if free {
+		q.cond.Signal()
+		return
+	}
+
+	err := q.persist(msg)
+	if err != nil {
+		// better to hold the lock than to lose the state
+		log.Errorf("cannot persist queued state of %s: %v", msg.InstanceID, err)
+		q.submit(fn)
+		return
+	}
+
+	release()
+
+}
+
+func (q *workQueue) persist(msg *runStateMessage) error {
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	err = q.db.addQueuedState(context.Background(), q.hostname, msg.InstanceID, data)
+	if err != nil {
+		return err
+	}
+
+	q.mtx.Lock()
+	q.stored++
+	if q.limit > 0 && q.stored+len(q.pending) == q.limit {
+		log.Warnf("work queue has reached %d pending states, refusing new invocations", q.limit)
+	}
+	q.mtx.Unlock()
+
+	q.cond.Signal()
+
+	return nil
+
+}
+
+// take removes the oldest persisted state of this node, or its newest one
+// that is no larger than maxSize if maxSize isn't 0. It returns nil if there
+// is none.
+func (q *workQueue) take(maxSize int) (*runStateMessage, error) {
+
+	qs, err := q.db.takeQueuedState(context.Background(), q.hostname, maxSize)
+	if err != nil || qs == nil {
+		return nil, err
+	}
+
+	msg := new(runStateMessage)
+	err = json.Unmarshal(qs.Message, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+
+}
+
+// recount reloads how many states are persisted for this node, which changes
+// behind its back when it adopts the work of other nodes.
+func (q *workQueue) recount() {
+
+	n, err := q.db.countQueuedStates(context.Background(), q.hostname)
+	if err != nil {
+		log.Errorf("cannot count queued states: %v", err)
+		return
+	}
+
+	q.mtx.Lock()
+	q.stored = n
+	q.mtx.Unlock()
+
+	q.cond.Broadcast()
+
+}
+
+// admit returns an error if the backlog is too long to accept another
+// invocation. Callers should pass it back to the client so it can retry later.
+func (q *workQueue) admit() error {
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if q.draining {
+		return grpc.Errorf(codes.Unavailable, "server is shutting down, try again later")
+	}
+
+	if n := q.stored + len(q.pending); q.limit > 0 && n >= q.limit {
+		return grpc.Errorf(codes.ResourceExhausted, "server is busy with %d queued workflow states, try again later", n)
+	}
+
+	return nil
+
+}
+
+// length returns how much work is queued or running.
+func (q *workQueue) length() int {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	return len(q.pending) + q.busy + q.stored
+}
+
+// spare returns how many workers are free.
+func (q *workQueue) spare() int {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	return q.workers - q.busy - len(q.pending) - q.stored
+}
+
+// steal removes up to n persisted states small enough to send to another
+// node, newest first, as long as more than keep states remain queued, and
+// returns their descriptions.
+func (q *workQueue) steal(n, keep int) []*runStateMessage {
+
+	q.mtx.Lock()
+	if avail := q.stored - keep; avail < n {
+		n = avail
+	}
+	q.mtx.Unlock()
+
+	var msgs []*runStateMessage
+
+	for len(msgs) < n {
+
+		msg, err := q.take(maxStolenPayload)
+		if err != nil {
+			log.Errorf("cannot take queued state: %v", err)
+			break
+		}
+		if msg == nil {
+			break
+		}
+
+		msgs = append(msgs, msg)
+
+	}
+
+	q.mtx.Lock()
+	q.stored -= len(msgs)
+	if q.stored < 0 {
+		q.stored = 0
+	}
+	q.mtx.Unlock()
+
+	return msgs
+
+}
+
+// drain refuses new invocations and persists all new work, then waits up to
+// timeout for the workers to finish what they were given in memory. It
+// reports whether they finished in time. Persisted work is left for the node
+// to hand off.
+func (q *workQueue) drain(timeout time.Duration) bool {
+
+	q.mtx.Lock()
+	q.draining = true
+	q.mtx.Unlock()
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+
+		q.mtx.Lock()
+		idle := len(q.pending) == 0 && q.busy == 0
+		q.mtx.Unlock()
+
+		if idle {
+			return true
+		}
+
+		if time.Now().After(deadline) {
+			return false
+		}
+
+		time.Sleep(drainPollInterval)
+
+	}
+
+}
+
+// close stops the workers once they finish what they are running. Work still
+// waiting in memory is abandoned and the instances it belongs to are
+// recovered by their deadlines, like after any other restart, but persisted
+// work stays queued for this node or whichever node adopts it.
+func (q *workQueue) close() {
+
+	q.mtx.Lock()
+	q.closed = true
+	q.mtx.Unlock()
+
+	q.cond.Broadcast()
+
+}
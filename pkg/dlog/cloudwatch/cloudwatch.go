@@ -0,0 +1,302 @@
+// Package cloudwatch is a log sink putting instance logs into Amazon
+// CloudWatch Logs, registered as "cloudwatch".
+//
+// Options are logGroup, the log group logs are put in, which has to exist,
+// region, accessKeyId, secretAccessKey and sessionToken, which fall back to
+// the AWS_REGION, AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+// AWS_SESSION_TOKEN environment variables, and endpoint, to talk to
+// something other than the regional endpoint. Every namespace logs to a
+// stream of its own, created when it first logs, and each event is JSON with
+// the instance first. CloudWatch can't delete single events, so deleting
+// logs is left to the retention of the log group.
+package cloudwatch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/dlog"
+	"github.com/vorteil/direktiv/pkg/dlog/remote"
+	"github.com/vorteil/direktiv/pkg/sigv4"
+)
+
+const (
+	service    = "logs"
+	apiVersion = "Logs_20140328"
+
+	// maxPage is how many events FilterLogEvents returns at most.
+	maxPage = 10000
+)
+
+func init() {
+	_ = dlog.RegisterSink("cloudwatch", New)
+}
+
+type backend struct {
+	endpoint    string
+	region      string
+	logGroup    string
+	credentials sigv4.Credentials
+	client      *http.Client
+}
+
+func option(options map[string]string, name, env string) string {
+
+	if v := options[name]; v != "" {
+		return v
+	}
+
+	return os.Getenv(env)
+
+}
+
+// New creates a CloudWatch sink from its options.
+func New(options map[string]string) (dlog.Log, error) {
+
+	b := &backend{
+		endpoint: strings.TrimSuffix(options["endpoint"], "/"),
+		region:   option(options, "region", "AWS_REGION"),
+		logGroup: options["logGroup"],
+		credentials: sigv4.Credentials{
+			AccessKeyID:     option(options, "accessKeyId", "AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: option(options, "secretAccessKey", "AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    option(options, "sessionToken", "AWS_SESSION_TOKEN"),
+		},
+		client: remote.NewHTTPClient(),
+	}
+
+	if b.logGroup == "" {
+		return nil, fmt.Errorf("cloudwatch sink needs a logGroup")
+	}
+
+	if b.region == "" {
+		return nil, fmt.Errorf("cloudwatch sink needs a region")
+	}
+
+	if b.credentials.AccessKeyID == "" || b.credentials.SecretAccessKey == "" {
+		return nil, fmt.Errorf("cloudwatch sink needs credentials")
+	}
+
+	if b.endpoint == "" {
+		b.endpoint = fmt.Sprintf("https://logs.%s.amazonaws.com", b.region)
+	}
+
+	return remote.New("cloudwatch", b), nil
+
+}
+
+type apiError struct {
+	Type    string `json:"__type"`
+	Message string `json:"message"`
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Type, e.Message)
+}
+
+// is reports whether the error is of CloudWatch type t, which comes as
+// either the bare type or prefixed with a namespace.
+func (e *apiError) is(t string) bool {
+	return e.Type == t || strings.HasSuffix(e.Type, "#"+t)
+}
+
+// call runs action with in as its input, decoding its output into out.
+// Errors CloudWatch responds with are returned as *apiError.
+func (b *backend) call(ctx context.Context, action string, in, out interface{}) error {
+
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", apiVersion+"."+action)
+	sigv4.Sign(req, b.credentials, b.region, service, body, time.Now())
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		e := new(apiError)
+		if json.NewDecoder(resp.Body).Decode(e) != nil || e.Type == "" {
+			return fmt.Errorf("%s responded with %s", req.URL.Host, resp.Status)
+		}
+		return e
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+
+}
+
+type event struct {
+	Timestamp int64  `json:"timestamp"`
+	Message   string `json:"message"`
+}
+
+func (b *backend) Ship(ctx context.Context, records []remote.Record) error {
+
+	streams := make(map[string][]event)
+	var order []string
+
+	for _, r := range records {
+
+		line, err := remote.NewLine(r)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := streams[r.Namespace]; !ok {
+			order = append(order, r.Namespace)
+		}
+
+		streams[r.Namespace] = append(streams[r.Namespace], event{
+			Timestamp: r.Time.UnixNano() / int64(time.Millisecond),
+			Message:   string(line),
+		})
+
+	}
+
+	for _, ns := range order {
+
+		events := streams[ns]
+
+		// events of a put have to be in order of their timestamps
+		sort.SliceStable(events, func(i, j int) bool {
+			return events[i].Timestamp < events[j].Timestamp
+		})
+
+		err := b.put(ctx, ns, events)
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+
+}
+
+func (b *backend) put(ctx context.Context, stream string, events []event) error {
+
+	in := map[string]interface{}{
+		"logGroupName":  b.logGroup,
+		"logStreamName": stream,
+		"logEvents":     events,
+	}
+
+	err := b.call(ctx, "PutLogEvents", in, nil)
+	if e, ok := err.(*apiError); !ok || !e.is("ResourceNotFoundException") {
+		return err
+	}
+
+	err = b.call(ctx, "CreateLogStream", map[string]string{
+		"logGroupName":  b.logGroup,
+		"logStreamName": stream,
+	}, nil)
+	if e, ok := err.(*apiError); ok && e.is("ResourceAlreadyExistsException") {
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return b.call(ctx, "PutLogEvents", in, nil)
+
+}
+
+func (b *backend) Query(ctx context.Context, namespace, instance string, limit, offset int) ([]dlog.LogEntry, error) {
+
+	pattern := "{ $.instance NOT EXISTS }"
+	if instance != "" {
+		pattern = fmt.Sprintf("{ $.instance = %s }", jsonString(instance))
+	}
+
+	in := map[string]interface{}{
+		"logGroupName":   b.logGroup,
+		"logStreamNames": []string{namespace},
+		"filterPattern":  pattern,
+	}
+
+	var events []event
+
+	for len(events) < offset+limit {
+
+		n := offset + limit - len(events)
+		if n > maxPage {
+			n = maxPage
+		}
+		in["limit"] = n
+
+		var resp struct {
+			Events    []event `json:"events"`
+			NextToken string  `json:"nextToken"`
+		}
+
+		err := b.call(ctx, "FilterLogEvents", in, &resp)
+		if e, ok := err.(*apiError); ok && e.is("ResourceNotFoundException") {
+			// the namespace hasn't logged yet
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, resp.Events...)
+
+		if resp.NextToken == "" {
+			break
+		}
+
+		in["nextToken"] = resp.NextToken
+
+	}
+
+	if offset >= len(events) {
+		return []dlog.LogEntry{}, nil
+	}
+
+	events = events[offset:]
+	if len(events) > limit {
+		events = events[:limit]
+	}
+
+	logs := make([]dlog.LogEntry, 0, len(events))
+
+	for _, e := range events {
+		t := time.Unix(0, e.Timestamp*int64(time.Millisecond))
+		logs = append(logs, remote.ParseLine(t, []byte(e.Message)))
+	}
+
+	return logs, nil
+
+}
+
+func jsonString(s string) string {
+	data, _ := json.Marshal(s)
+	return string(data)
+}
+
+// Delete does nothing, CloudWatch events expire with the retention of their
+// log group.
+func (b *backend) Delete(ctx context.Context, namespace, instance string) error {
+	return nil
+}
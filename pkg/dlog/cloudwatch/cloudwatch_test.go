@@ -0,0 +1,202 @@
+package cloudwatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/dlog/remote"
+	"github.com/vorteil/direktiv/pkg/sigv4"
+)
+
+// fakeLogs is a CloudWatch Logs api keeping the events of one log group.
+type fakeLogs struct {
+	t       *testing.T
+	streams map[string][]event
+	calls   []string
+}
+
+func (f *fakeLogs) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	action := strings.TrimPrefix(r.Header.Get("X-Amz-Target"), apiVersion+".")
+	f.calls = append(f.calls, action)
+
+	if auth := r.Header.Get("Authorization"); !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=key/") ||
+		!strings.Contains(auth, "/eu-west-1/logs/aws4_request") {
+		f.t.Errorf("%s: unexpected authorization: %s", action, auth)
+	}
+
+	var in struct {
+		LogGroupName   string   `json:"logGroupName"`
+		LogStreamName  string   `json:"logStreamName"`
+		LogStreamNames []string `json:"logStreamNames"`
+		LogEvents      []event  `json:"logEvents"`
+		Limit          int      `json:"limit"`
+		NextToken      string   `json:"nextToken"`
+	}
+
+	err := json.NewDecoder(r.Body).Decode(&in)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+
+	if in.LogGroupName != "group" {
+		f.t.Errorf("%s: unexpected log group %s", action, in.LogGroupName)
+	}
+
+	fail := func(t string) {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(&apiError{Type: "com.amazonaws.logs#" + t, Message: "failed"})
+	}
+
+	switch action {
+
+	case "CreateLogStream":
+		if _, ok := f.streams[in.LogStreamName]; ok {
+			fail("ResourceAlreadyExistsException")
+			return
+		}
+		f.streams[in.LogStreamName] = []event{}
+
+	case "PutLogEvents":
+		if _, ok := f.streams[in.LogStreamName]; !ok {
+			fail("ResourceNotFoundException")
+			return
+		}
+		f.streams[in.LogStreamName] = append(f.streams[in.LogStreamName], in.LogEvents...)
+
+	case "FilterLogEvents":
+		events, ok := f.streams[in.LogStreamNames[0]]
+		if !ok {
+			fail("ResourceNotFoundException")
+			return
+		}
+		// one event a page, to page through them
+		var start int
+		if in.NextToken != "" {
+			start = len(in.NextToken)
+		}
+		resp := map[string]interface{}{
+			"events": events[start : start+1],
+		}
+		if start+1 < len(events) {
+			resp["nextToken"] = strings.Repeat("x", start+1)
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+		return
+
+	default:
+		fail("UnknownOperationException")
+		return
+
+	}
+
+	_, _ = w.Write([]byte("{}"))
+
+}
+
+func newTestBackend(t *testing.T) (*backend, *fakeLogs) {
+
+	f := &fakeLogs{
+		t:       t,
+		streams: make(map[string][]event),
+	}
+
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+
+	return &backend{
+		endpoint: srv.URL,
+		region:   "eu-west-1",
+		logGroup: "group",
+		credentials: sigv4.Credentials{
+			AccessKeyID:     "key",
+			SecretAccessKey: "secret",
+		},
+		client: srv.Client(),
+	}, f
+
+}
+
+func TestShipAndQuery(t *testing.T) {
+
+	b, f := newTestBackend(t)
+	ctx := context.Background()
+
+	now := time.Now().Truncate(time.Millisecond)
+
+	err := b.Ship(ctx, []remote.Record{
+		{Namespace: "ns", Instance: "ns/wf/1", Time: now.Add(time.Millisecond), Level: "info", Message: "second"},
+		{Namespace: "ns", Instance: "ns/wf/1", Time: now, Level: "info", Message: "first"},
+		{Namespace: "other", Time: now, Level: "error", Message: "namespace"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the streams don't exist yet, so each put is retried after creating it
+	expected := "PutLogEvents,CreateLogStream,PutLogEvents,PutLogEvents,CreateLogStream,PutLogEvents"
+	if calls := strings.Join(f.calls, ","); calls != expected {
+		t.Errorf("unexpected calls %s", calls)
+	}
+
+	if events := f.streams["ns"]; len(events) != 2 || !strings.Contains(events[0].Message, "first") {
+		t.Errorf("events not put in order: %v", events)
+	}
+
+	logs, err := b.Query(ctx, "ns", "ns/wf/1", 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(logs) != 1 || logs[0].Message != "second" || logs[0].Level != "info" ||
+		logs[0].Timestamp != now.Add(time.Millisecond).UnixNano() {
+		t.Errorf("unexpected logs %+v", logs)
+	}
+
+	logs, err = b.Query(ctx, "missing", "", 10, 0)
+	if err != nil || len(logs) != 0 {
+		t.Errorf("unexpected result of querying a missing stream: %v, %v", logs, err)
+	}
+
+}
+
+func TestCallError(t *testing.T) {
+
+	b, _ := newTestBackend(t)
+
+	err := b.call(context.Background(), "DeleteLogGroup", map[string]string{"logGroupName": "group"}, nil)
+
+	e, ok := err.(*apiError)
+	if !ok || !e.is("UnknownOperationException") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+}
+
+func TestNew(t *testing.T) {
+
+	for _, k := range []string{"AWS_REGION", "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY"} {
+		old, ok := os.LookupEnv(k)
+		os.Unsetenv(k)
+		if ok {
+			defer os.Setenv(k, old)
+		}
+	}
+
+	for _, options := range []map[string]string{
+		{"region": "eu-west-1", "accessKeyId": "key", "secretAccessKey": "secret"},
+		{"logGroup": "group", "accessKeyId": "key", "secretAccessKey": "secret"},
+		{"logGroup": "group", "region": "eu-west-1"},
+	} {
+		if _, err := New(options); err == nil {
+			t.Errorf("expected an error for options %v", options)
+		}
+	}
+
+}
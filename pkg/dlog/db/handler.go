@@ -12,30 +12,36 @@ import (
 	"github.com/inconshreveable/log15"
 )
 
+const (
+	// insertFrequency is how often queued logs are written to the database.
+	insertFrequency = time.Millisecond * 250
+
+	// maxInsertRows bounds the rows written by a single insert to stay well
+	// below postgres' limit on query parameters.
+	maxInsertRows = 1000
+
+	insertColumns = 6
+
+	// maxQueuedRows bounds the logs kept while the database can't be
+	// written to, newer ones are dropped beyond it.
+	maxQueuedRows = 100000
+)
+
 type Handler struct {
-	db         *sql.DB
-	args       *HandlerArgs
-	queueMutex sync.Mutex
-	logQueue   chan *log15.Record
-	queuedLogs []log15.Record
-	closed     chan bool
+	args  *HandlerArgs
+	batch *batcher
 }
 
 type HandlerArgs struct {
-	Driver                      *sql.DB
-	InsertFrequencyMilliSeconds int
-	Namespace                   string
-	InstanceID                  string
+	Namespace  string
+	InstanceID string
 }
 
-func NewHandler(args *HandlerArgs) (*Handler, error) {
-
-	out := new(Handler)
-
-	out.args = args
-	out.db = args.Driver
-
-	return out.init()
+func (l *Logger) newHandler(args *HandlerArgs) *Handler {
+	return &Handler{
+		args:  args,
+		batch: l.batch,
+	}
 }
 
 func (l *Logger) initDB() error {
@@ -68,124 +74,172 @@ func (l *Logger) initDB() error {
 
 }
 
-func (h *Handler) onboarder() {
+// batcher queues the logs of every handler created by a Logger and writes
+// them together, so that many busy instances share a few multi-row inserts
+// rather than each one inserting its own logs on every tick.
+type batcher struct {
+	db      *sql.DB
+	mtx     sync.Mutex
+	rows    [][]interface{}
+	dropped int
+	closed  chan bool
+	done    chan bool
+}
+
+func newBatcher(db *sql.DB) *batcher {
 
-	for {
+	b := &batcher{
+		db:     db,
+		closed: make(chan bool),
+		done:   make(chan bool),
+	}
 
-		// ensure logs are inserted in order
-		r, more := <-h.logQueue
+	go b.dispatcher()
 
-		h.queueMutex.Lock()
+	return b
 
-		if !more {
-			close(h.closed)
-			h.queueMutex.Unlock()
-			return
-		}
+}
 
-		h.queuedLogs = append(h.queuedLogs, *r)
+func (b *batcher) add(row []interface{}) {
 
-		h.queueMutex.Unlock()
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
 
+	if len(b.rows) >= maxQueuedRows {
+		b.dropped++
+		return
 	}
 
+	b.rows = append(b.rows, row)
+
 }
 
-func (h *Handler) dispatcher() {
+// requeue puts rows that couldn't be inserted back in front of the queue,
+// to be inserted on the next tick.
+func (b *batcher) requeue(rows [][]interface{}) {
 
-	var closed bool
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
 
-	ticker := time.NewTicker(time.Millisecond * time.Duration(h.args.InsertFrequencyMilliSeconds))
-	defer ticker.Stop()
+	q := append(rows, b.rows...)
+	if len(q) > maxQueuedRows {
+		b.dropped += len(q) - maxQueuedRows
+		q = q[:maxQueuedRows]
+	}
 
-	for !closed {
+	b.rows = q
 
-		select {
-		case <-h.closed:
-			closed = true
-		case <-ticker.C:
-		}
+}
 
-		h.queueMutex.Lock()
+func (b *batcher) dispatcher() {
 
-		var err error
-		rowValues := make([]string, 0)
-		vals := make([]interface{}, 0)
+	defer close(b.done)
 
-		if len(h.queuedLogs) == 0 {
-			goto nextIter
-		}
+	ticker := time.NewTicker(insertFrequency)
+	defer ticker.Stop()
 
-		for i, msg := range h.queuedLogs {
+	for {
 
-			ctxMap := make(map[string]interface{}, 0)
-			for i, c := range msg.Ctx {
-				if i%2 == 1 {
-					ctxMap[fmt.Sprintf("%s", msg.Ctx[i-1])] = fmt.Sprintf("%v", c)
-				}
-			}
+		var closed bool
 
-			b, err := json.Marshal(ctxMap)
-			if err != nil {
-				fmt.Printf("(todo: improve this log!) %s", err.Error())
-			}
+		select {
+		case <-b.closed:
+			closed = true
+		case <-ticker.C:
+		}
 
-			idx := i * 6
-			if h.args.InstanceID != "" {
-				rowValues = append(rowValues, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)\n", idx+1, idx+2, idx+3, idx+4, idx+5, idx+6))
-				vals = append(vals, h.args.Namespace, h.args.InstanceID, msg.Time.UnixNano(), msg.Lvl, msg.Msg, fmt.Sprintf("%s", b))
-			} else {
-				rowValues = append(rowValues, fmt.Sprintf("($%d, $%d, $%d,  $%d, $%d)\n", idx+1, idx+2, idx+3, idx+4, idx+5))
-				vals = append(vals, h.args.Namespace, msg.Time.UnixNano(), msg.Lvl, msg.Msg, fmt.Sprintf("%s", b))
-			}
+		b.mtx.Lock()
+		rows := b.rows
+		b.rows = nil
+		dropped := b.dropped
+		b.dropped = 0
+		b.mtx.Unlock()
 
+		if dropped > 0 {
+			fmt.Printf("dropped %d logs, the database is too far behind\n", dropped)
 		}
 
-		if h.args.InstanceID != "" {
-			_, err = h.db.Exec(fmt.Sprintf("insert into logs (namespace, instance, time, lvl, msg, ctx) values %s", strings.Join(rowValues, ", ")), vals...)
-			if err != nil {
-				fmt.Printf("(todo: improve this log!) %s", err.Error())
+		// rows are inserted in the order they were logged
+		for len(rows) > 0 {
+			n := len(rows)
+			if n > maxInsertRows {
+				n = maxInsertRows
 			}
-		} else {
-			_, err = h.db.Exec(fmt.Sprintf("insert into logs (namespace, time, lvl, msg, ctx) values %s", strings.Join(rowValues, ", ")), vals...)
+			err := b.insert(rows[:n])
 			if err != nil {
-				fmt.Printf("(todo: improve this log!) %s", err.Error())
+				fmt.Printf("failed to insert %d logs: %s\n", len(rows), err.Error())
+				if !closed {
+					b.requeue(rows)
+				}
+				break
 			}
+			rows = rows[n:]
 		}
 
-	nextIter:
-		h.queuedLogs = h.queuedLogs[:0]
-		h.queueMutex.Unlock()
+		if closed {
+			return
+		}
 
 	}
 
 }
 
-func (h *Handler) init() (*Handler, error) {
+func (b *batcher) insert(rows [][]interface{}) error {
 
-	h.queuedLogs = make([]log15.Record, 0)
-	h.logQueue = make(chan *log15.Record, 100)
-	h.closed = make(chan bool)
+	rowValues := make([]string, len(rows))
+	vals := make([]interface{}, 0, len(rows)*insertColumns)
 
-	go h.onboarder()
-	go h.dispatcher()
+	for i, row := range rows {
+		idx := i * insertColumns
+		rowValues[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)", idx+1, idx+2, idx+3, idx+4, idx+5, idx+6)
+		vals = append(vals, row...)
+	}
 
-	return h, nil
+	_, err := b.db.Exec(fmt.Sprintf("insert into logs (namespace, instance, time, lvl, msg, ctx) values %s", strings.Join(rowValues, ", ")), vals...)
 
-}
+	return err
 
-func (h *Handler) Log(r *log15.Record) error {
-	h.logQueue <- r
-	return nil
 }
 
-func (h *Handler) Close() error {
+// close writes any queued logs and stops the dispatcher.
+func (b *batcher) close() {
 
 	defer func() {
 		_ = recover()
 	}()
 
-	close(h.logQueue)
+	close(b.closed)
+	<-b.done
+
+}
+
+func (h *Handler) Log(r *log15.Record) error {
+
+	ctxMap := make(map[string]interface{}, 0)
+	for i, c := range r.Ctx {
+		if i%2 == 1 {
+			ctxMap[fmt.Sprintf("%s", r.Ctx[i-1])] = fmt.Sprintf("%v", c)
+		}
+	}
+
+	b, err := json.Marshal(ctxMap)
+	if err != nil {
+		return err
+	}
+
+	// namespace logs have no instance
+	var instance interface{}
+	if h.args.InstanceID != "" {
+		instance = h.args.InstanceID
+	}
+
+	h.batch.add([]interface{}{h.args.Namespace, instance, r.Time.UnixNano(), r.Lvl, r.Msg, string(b)})
+
 	return nil
 
 }
+
+// Close is a no-op, queued logs are written by the shared dispatcher.
+func (h *Handler) Close() error {
+	return nil
+}
@@ -12,10 +12,14 @@ import (
 )
 
 type Logger struct {
-	db *sql.DB
+	db    *sql.DB
+	read  *sql.DB
+	batch *batcher
 }
 
-func (l *Logger) Connect(database string) error {
+// Connect opens the logs database. Logs are queried from replica if it is
+// set, they are always written to database.
+func (l *Logger) Connect(database, replica string) error {
 	if l == nil {
 		l = new(Logger)
 	}
@@ -32,16 +36,44 @@ func (l *Logger) Connect(database string) error {
 		return err
 	}
 
+	l.read = l.db
+	if replica != "" {
+		l.read, err = sql.Open("postgres", replica)
+		if err != nil {
+			return fmt.Errorf("Failed to initialize server: %w", err)
+		}
+	}
+
+	l.batch = newBatcher(l.db)
+
 	return nil
 }
 
 func (l *Logger) CloseConnection() error {
+	if l.batch != nil {
+		l.batch.close()
+	}
+	if l.read != nil && l.read != l.db {
+		l.read.Close()
+	}
 	return l.db.Close()
 }
 
-func NewLogger(database string) (*Logger, error) {
+// Close closes the connection like CloseConnection, so the logger is closed
+// along with other sinks.
+func (l *Logger) Close() error {
+	return l.CloseConnection()
+}
+
+func init() {
+	_ = dlog.RegisterSink("database", func(options map[string]string) (dlog.Log, error) {
+		return NewLogger(options["database"], options["replica"])
+	})
+}
+
+func NewLogger(database, replica string) (*Logger, error) {
 	l := new(Logger)
-	err := l.Connect(database)
+	err := l.Connect(database, replica)
 	return l, err
 }
 
@@ -58,14 +90,9 @@ func (l *Logger) NamespaceLogger(namespace string) (dlog.Logger, error) {
 	lg := new(dbLogger)
 	lg.Logger = log15.New()
 
-	h, err := NewHandler(&HandlerArgs{
-		Driver:                      l.db,
-		Namespace:                   namespace,
-		InsertFrequencyMilliSeconds: 500,
+	h := l.newHandler(&HandlerArgs{
+		Namespace: namespace,
 	})
-	if err != nil {
-		return nil, err
-	}
 
 	lg.handler = h
 	lg.SetHandler(h)
@@ -77,15 +104,10 @@ func (l *Logger) LoggerFunc(namespace, instance string) (dlog.Logger, error) {
 	lg := new(dbLogger)
 	lg.Logger = log15.New()
 
-	h, err := NewHandler(&HandlerArgs{
-		Driver:                      l.db,
-		Namespace:                   namespace,
-		InstanceID:                  instance,
-		InsertFrequencyMilliSeconds: 250,
+	h := l.newHandler(&HandlerArgs{
+		Namespace:  namespace,
+		InstanceID: instance,
 	})
-	if err != nil {
-		return nil, err
-	}
 
 	lg.handler = h
 	lg.SetHandler(h)
@@ -117,7 +139,7 @@ func (l *Logger) QueryLogs(ctx context.Context, instance string, limit, offset i
 		LIMIT $2 OFFSET $3;`
 	}
 
-	rows, err := l.db.Query(sqlStatement, instance, limit, offset)
+	rows, err := l.read.Query(sqlStatement, instance, limit, offset)
 	if err != nil {
 		return testLOG, err
 	}
@@ -10,6 +10,12 @@ import (
 type DummyLogger struct {
 }
 
+func init() {
+	_ = dlog.RegisterSink("dummy", func(options map[string]string) (dlog.Log, error) {
+		return NewLogger()
+	})
+}
+
 func NewLogger() (*DummyLogger, error) {
 	l := new(DummyLogger)
 	return l, nil
@@ -0,0 +1,251 @@
+// Package elasticsearch is a log sink indexing instance logs in
+// Elasticsearch, registered as "elasticsearch".
+//
+// Options are url, the address of the cluster, index, which defaults to
+// direktiv-logs, and either apiKey or username and password. Logs are
+// documents with @timestamp, time in nanoseconds, namespace, instance,
+// level, message and context fields, and are found through the keyword
+// subfields of the default dynamic mapping.
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/dlog"
+	"github.com/vorteil/direktiv/pkg/dlog/remote"
+)
+
+const defaultIndex = "direktiv-logs"
+
+func init() {
+	_ = dlog.RegisterSink("elasticsearch", New)
+}
+
+type backend struct {
+	url      string
+	index    string
+	apiKey   string
+	username string
+	password string
+	client   *http.Client
+}
+
+// New creates an Elasticsearch sink from its options.
+func New(options map[string]string) (dlog.Log, error) {
+
+	b := &backend{
+		url:      strings.TrimSuffix(options["url"], "/"),
+		index:    options["index"],
+		apiKey:   options["apiKey"],
+		username: options["username"],
+		password: options["password"],
+		client:   remote.NewHTTPClient(),
+	}
+
+	if b.url == "" {
+		return nil, fmt.Errorf("elasticsearch sink needs a url")
+	}
+
+	if b.index == "" {
+		b.index = defaultIndex
+	}
+
+	return remote.New("elasticsearch", b), nil
+
+}
+
+type document struct {
+	Timestamp string            `json:"@timestamp"`
+	Time      int64             `json:"time"`
+	Namespace string            `json:"namespace"`
+	Instance  string            `json:"instance,omitempty"`
+	Level     string            `json:"level"`
+	Message   string            `json:"message"`
+	Context   map[string]string `json:"context,omitempty"`
+}
+
+func (b *backend) request(ctx context.Context, method, path, ct string, body []byte, out interface{}) error {
+
+	req, err := http.NewRequestWithContext(ctx, method, b.url+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", ct)
+
+	if b.apiKey != "" {
+		req.Header.Set("Authorization", "ApiKey "+b.apiKey)
+	} else if b.username != "" {
+		req.SetBasicAuth(b.username, b.password)
+	}
+
+	return remote.Do(b.client, req, out)
+
+}
+
+func (b *backend) Ship(ctx context.Context, records []remote.Record) error {
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+
+	action := map[string]interface{}{
+		"create": map[string]string{"_index": b.index},
+	}
+
+	for _, r := range records {
+
+		err := enc.Encode(action)
+		if err != nil {
+			return err
+		}
+
+		err = enc.Encode(&document{
+			Timestamp: r.Time.UTC().Format(time.RFC3339Nano),
+			Time:      r.Time.UnixNano(),
+			Namespace: r.Namespace,
+			Instance:  r.Instance,
+			Level:     r.Level,
+			Message:   r.Message,
+			Context:   r.Context,
+		})
+		if err != nil {
+			return err
+		}
+
+	}
+
+	var resp struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			Status int `json:"status"`
+			Error  struct {
+				Type   string `json:"type"`
+				Reason string `json:"reason"`
+			} `json:"error"`
+		} `json:"items"`
+	}
+
+	err := b.request(ctx, http.MethodPost, "/_bulk", "application/x-ndjson", buf.Bytes(), &resp)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Errors {
+		return nil
+	}
+
+	// rejected documents aren't shipped again, that would index the others twice
+	var failed int
+	var reason string
+
+	for _, item := range resp.Items {
+		for _, res := range item {
+			if res.Status/100 != 2 {
+				failed++
+				reason = fmt.Sprintf("%s: %s", res.Error.Type, res.Error.Reason)
+			}
+		}
+	}
+
+	if failed > 0 {
+		log.Errorf("elasticsearch rejected %d of %d logs, %s", failed, len(records), reason)
+	}
+
+	return nil
+
+}
+
+func query(namespace, instance string) map[string]interface{} {
+
+	filter := []interface{}{
+		map[string]interface{}{
+			"term": map[string]string{"namespace.keyword": namespace},
+		},
+	}
+
+	q := map[string]interface{}{
+		"filter": filter,
+	}
+
+	if instance != "" {
+		q["filter"] = append(filter, map[string]interface{}{
+			"term": map[string]string{"instance.keyword": instance},
+		})
+	} else {
+		q["must_not"] = []interface{}{
+			map[string]interface{}{
+				"exists": map[string]string{"field": "instance"},
+			},
+		}
+	}
+
+	return map[string]interface{}{
+		"bool": q,
+	}
+
+}
+
+func (b *backend) Query(ctx context.Context, namespace, instance string, limit, offset int) ([]dlog.LogEntry, error) {
+
+	body, err := json.Marshal(map[string]interface{}{
+		"from": offset,
+		"size": limit,
+		"sort": []interface{}{map[string]interface{}{
+			"time": map[string]string{"order": "asc", "unmapped_type": "long"},
+		}},
+		"query": query(namespace, instance),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Hits struct {
+			Hits []struct {
+				Source document `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+
+	err = b.request(ctx, http.MethodPost, fmt.Sprintf("/%s/_search?ignore_unavailable=true", b.index),
+		"application/json", body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]dlog.LogEntry, 0, len(resp.Hits.Hits))
+
+	for _, h := range resp.Hits.Hits {
+		logs = append(logs, dlog.LogEntry{
+			Level:     h.Source.Level,
+			Timestamp: h.Source.Time,
+			Message:   h.Source.Message,
+			Context:   h.Source.Context,
+		})
+	}
+
+	return logs, nil
+
+}
+
+func (b *backend) Delete(ctx context.Context, namespace, instance string) error {
+
+	body, err := json.Marshal(map[string]interface{}{
+		"query": query(namespace, instance),
+	})
+	if err != nil {
+		return err
+	}
+
+	return b.request(ctx, http.MethodPost,
+		fmt.Sprintf("/%s/_delete_by_query?conflicts=proceed&ignore_unavailable=true", b.index),
+		"application/json", body, nil)
+
+}
@@ -0,0 +1,151 @@
+package elasticsearch
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/dlog/remote"
+)
+
+func newTestBackend(t *testing.T, handler http.HandlerFunc) *backend {
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	return &backend{
+		url:    srv.URL,
+		index:  "logs",
+		apiKey: "key",
+		client: srv.Client(),
+	}
+
+}
+
+func TestShip(t *testing.T) {
+
+	var lines []map[string]interface{}
+
+	b := newTestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+
+		if r.URL.Path != "/_bulk" || r.Header.Get("Content-Type") != "application/x-ndjson" {
+			t.Errorf("unexpected request %s %s", r.URL.Path, r.Header.Get("Content-Type"))
+		}
+
+		if auth := r.Header.Get("Authorization"); auth != "ApiKey key" {
+			t.Errorf("unexpected authorization %s", auth)
+		}
+
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var line map[string]interface{}
+			err := json.Unmarshal(scanner.Bytes(), &line)
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines = append(lines, line)
+		}
+
+		// a rejected document isn't an error, so that the rest aren't shipped again
+		_, _ = w.Write([]byte(`{"errors":true,"items":[{"create":{"status":201}},{"create":{"status":400,"error":{"type":"mapper_parsing_exception","reason":"bad"}}}]}`))
+
+	})
+
+	now := time.Unix(1600000000, 5)
+
+	err := b.Ship(context.Background(), []remote.Record{
+		{Namespace: "ns", Instance: "ns/wf/1", Time: now, Level: "info", Message: "hello", Context: map[string]string{"step": "1"}},
+		{Namespace: "ns", Time: now, Level: "error", Message: "namespace"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(lines) != 4 {
+		t.Fatalf("expected an action and a document for each record, got %v", lines)
+	}
+
+	if !reflect.DeepEqual(lines[0], map[string]interface{}{"create": map[string]interface{}{"_index": "logs"}}) {
+		t.Errorf("unexpected action %v", lines[0])
+	}
+
+	expected := map[string]interface{}{
+		"@timestamp": "2020-09-13T12:26:40.000000005Z",
+		"time":       float64(now.UnixNano()),
+		"namespace":  "ns",
+		"instance":   "ns/wf/1",
+		"level":      "info",
+		"message":    "hello",
+		"context":    map[string]interface{}{"step": "1"},
+	}
+	if !reflect.DeepEqual(lines[1], expected) {
+		t.Errorf("unexpected document %v", lines[1])
+	}
+
+	if _, ok := lines[3]["instance"]; ok {
+		t.Errorf("namespace log has an instance: %v", lines[3])
+	}
+
+}
+
+func TestQuery(t *testing.T) {
+
+	var body map[string]interface{}
+
+	b := newTestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+
+		if r.URL.Path != "/logs/_search" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		data, _ := ioutil.ReadAll(r.Body)
+		err := json.Unmarshal(data, &body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, _ = w.Write([]byte(`{"hits":{"hits":[{"_source":{"time":5,"level":"info","message":"hello","context":{"step":"1"}}}]}}`))
+
+	})
+
+	logs, err := b.Query(context.Background(), "ns", "", 10, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(logs) != 1 || logs[0].Timestamp != 5 || logs[0].Message != "hello" || logs[0].Context["step"] != "1" {
+		t.Errorf("unexpected logs %+v", logs)
+	}
+
+	if body["from"] != float64(20) || body["size"] != float64(10) {
+		t.Errorf("unexpected paging %v", body)
+	}
+
+	// namespace logs are those without an instance
+	q, _ := json.Marshal(body["query"])
+	if !bytes.Contains(q, []byte(`"must_not":[{"exists":{"field":"instance"}}]`)) {
+		t.Errorf("unexpected query %s", q)
+	}
+
+}
+
+func TestRequestError(t *testing.T) {
+
+	b := newTestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte("missing authentication"))
+	})
+
+	err := b.Delete(context.Background(), "ns", "ns/wf/1")
+	if err == nil || !bytes.Contains([]byte(err.Error()), []byte("401 Unauthorized: missing authentication")) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+}
@@ -0,0 +1,236 @@
+// Package loki is a log sink pushing instance logs to Grafana Loki,
+// registered as "loki".
+//
+// Options are url, the address of Loki, tenant, sent as X-Scope-OrgID for
+// multi-tenant setups, username and password for basic auth, and
+// queryRange, how many hours back logs are queried, which defaults to 720.
+// Logs are pushed to streams labelled job="direktiv" with their namespace
+// and level, and each line is JSON with the instance first. Deleting logs
+// needs deletion to be enabled on the Loki compactor.
+package loki
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/dlog"
+	"github.com/vorteil/direktiv/pkg/dlog/remote"
+)
+
+const defaultQueryRange = 720
+
+func init() {
+	_ = dlog.RegisterSink("loki", New)
+}
+
+type backend struct {
+	url        string
+	tenant     string
+	username   string
+	password   string
+	queryRange time.Duration
+	client     *http.Client
+}
+
+// New creates a Loki sink from its options.
+func New(options map[string]string) (dlog.Log, error) {
+
+	b := &backend{
+		url:        strings.TrimSuffix(options["url"], "/"),
+		tenant:     options["tenant"],
+		username:   options["username"],
+		password:   options["password"],
+		queryRange: defaultQueryRange * time.Hour,
+		client:     remote.NewHTTPClient(),
+	}
+
+	if b.url == "" {
+		return nil, fmt.Errorf("loki sink needs a url")
+	}
+
+	if s, ok := options["queryRange"]; ok {
+		h, err := strconv.Atoi(s)
+		if err != nil || h <= 0 {
+			return nil, fmt.Errorf("loki sink queryRange has to be a number of hours")
+		}
+		b.queryRange = time.Duration(h) * time.Hour
+	}
+
+	return remote.New("loki", b), nil
+
+}
+
+func (b *backend) request(ctx context.Context, method, path string, query url.Values, body []byte, out interface{}) error {
+
+	u := b.url + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if b.tenant != "" {
+		req.Header.Set("X-Scope-OrgID", b.tenant)
+	}
+
+	if b.username != "" {
+		req.SetBasicAuth(b.username, b.password)
+	}
+
+	return remote.Do(b.client, req, out)
+
+}
+
+type stream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+func (b *backend) Ship(ctx context.Context, records []remote.Record) error {
+
+	streams := make(map[[2]string]*stream)
+	var order []*stream
+
+	for _, r := range records {
+
+		line, err := remote.NewLine(r)
+		if err != nil {
+			return err
+		}
+
+		k := [2]string{r.Namespace, r.Level}
+
+		s, ok := streams[k]
+		if !ok {
+			s = &stream{
+				Stream: map[string]string{
+					"job":       "direktiv",
+					"namespace": r.Namespace,
+					"level":     r.Level,
+				},
+			}
+			streams[k] = s
+			order = append(order, s)
+		}
+
+		s.Values = append(s.Values, [2]string{
+			strconv.FormatInt(r.Time.UnixNano(), 10),
+			string(line),
+		})
+
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"streams": order,
+	})
+	if err != nil {
+		return err
+	}
+
+	return b.request(ctx, http.MethodPost, "/loki/api/v1/push", nil, body, nil)
+
+}
+
+// selector returns the LogQL query of the logs of an instance, or those of
+// a namespace if instance is empty. Namespace lines are the ones without an
+// instance, which lines start with.
+func selector(namespace, instance string) string {
+
+	q := fmt.Sprintf(`{job="direktiv",namespace=%s}`, strconv.Quote(namespace))
+
+	if instance != "" {
+		return q + " |= " + strconv.Quote(fmt.Sprintf(`{"instance":%s`, strconv.Quote(instance)))
+	}
+
+	return q + " !~ " + strconv.Quote(`^\{"instance"`)
+
+}
+
+func (b *backend) Query(ctx context.Context, namespace, instance string, limit, offset int) ([]dlog.LogEntry, error) {
+
+	now := time.Now()
+
+	query := url.Values{}
+	query.Set("query", selector(namespace, instance))
+	query.Set("direction", "forward")
+	query.Set("limit", strconv.Itoa(offset+limit))
+	query.Set("start", strconv.FormatInt(now.Add(-b.queryRange).UnixNano(), 10))
+	query.Set("end", strconv.FormatInt(now.UnixNano(), 10))
+
+	var resp struct {
+		Data struct {
+			Result []stream `json:"result"`
+		} `json:"data"`
+	}
+
+	err := b.request(ctx, http.MethodGet, "/loki/api/v1/query_range", query, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	type line struct {
+		t    int64
+		data string
+	}
+
+	var lines []line
+
+	// streams are returned one after another, merge them back into order
+	for _, s := range resp.Data.Result {
+		for _, v := range s.Values {
+			t, err := strconv.ParseInt(v[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("bad timestamp '%s'", v[0])
+			}
+			lines = append(lines, line{t: t, data: v[1]})
+		}
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].t < lines[j].t
+	})
+
+	if offset >= len(lines) {
+		return []dlog.LogEntry{}, nil
+	}
+
+	lines = lines[offset:]
+	if len(lines) > limit {
+		lines = lines[:limit]
+	}
+
+	logs := make([]dlog.LogEntry, 0, len(lines))
+
+	for _, l := range lines {
+		logs = append(logs, remote.ParseLine(time.Unix(0, l.t), []byte(l.data)))
+	}
+
+	return logs, nil
+
+}
+
+func (b *backend) Delete(ctx context.Context, namespace, instance string) error {
+
+	query := url.Values{}
+	query.Set("query", selector(namespace, instance))
+	query.Set("start", "0")
+	query.Set("end", strconv.FormatInt(time.Now().Unix(), 10))
+
+	return b.request(ctx, http.MethodPost, "/loki/api/v1/delete", query, nil, nil)
+
+}
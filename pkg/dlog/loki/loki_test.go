@@ -0,0 +1,142 @@
+package loki
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/dlog/remote"
+)
+
+func newTestBackend(t *testing.T, handler http.HandlerFunc) *backend {
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	return &backend{
+		url:        srv.URL,
+		tenant:     "tenant",
+		username:   "user",
+		password:   "pass",
+		queryRange: time.Hour,
+		client:     srv.Client(),
+	}
+
+}
+
+func TestShip(t *testing.T) {
+
+	var body struct {
+		Streams []stream `json:"streams"`
+	}
+
+	b := newTestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+
+		if r.URL.Path != "/loki/api/v1/push" || r.Header.Get("X-Scope-OrgID") != "tenant" {
+			t.Errorf("unexpected request %s %v", r.URL.Path, r.Header)
+		}
+
+		if u, p, ok := r.BasicAuth(); !ok || u != "user" || p != "pass" {
+			t.Errorf("unexpected basic auth %s %s", u, p)
+		}
+
+		err := json.NewDecoder(r.Body).Decode(&body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+
+	})
+
+	now := time.Unix(1600000000, 0)
+
+	err := b.Ship(context.Background(), []remote.Record{
+		{Namespace: "ns", Instance: "ns/wf/1", Time: now, Level: "info", Message: "first"},
+		{Namespace: "ns", Time: now, Level: "error", Message: "namespace"},
+		{Namespace: "ns", Instance: "ns/wf/1", Time: now.Add(time.Second), Level: "info", Message: "second"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// records are grouped into a stream for each namespace and level
+	if len(body.Streams) != 2 {
+		t.Fatalf("unexpected streams %+v", body.Streams)
+	}
+
+	labels := map[string]string{"job": "direktiv", "namespace": "ns", "level": "info"}
+	if !reflect.DeepEqual(body.Streams[0].Stream, labels) {
+		t.Errorf("unexpected labels %v", body.Streams[0].Stream)
+	}
+
+	values := [][2]string{
+		{strconv.FormatInt(now.UnixNano(), 10), `{"instance":"ns/wf/1","level":"info","msg":"first"}`},
+		{strconv.FormatInt(now.Add(time.Second).UnixNano(), 10), `{"instance":"ns/wf/1","level":"info","msg":"second"}`},
+	}
+	if !reflect.DeepEqual(body.Streams[0].Values, values) {
+		t.Errorf("unexpected values %v", body.Streams[0].Values)
+	}
+
+}
+
+func TestQuery(t *testing.T) {
+
+	b := newTestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+
+		q := r.URL.Query()
+
+		if q.Get("query") != `{job="direktiv",namespace="ns"} |= "{\"instance\":\"ns/wf/1\""` {
+			t.Errorf("unexpected query %s", q.Get("query"))
+		}
+
+		// loki has no offset, so it is asked for the logs before the page too
+		if q.Get("limit") != "3" || q.Get("direction") != "forward" {
+			t.Errorf("unexpected paging %v", q)
+		}
+
+		_, _ = w.Write([]byte(`{"data":{"result":[
+			{"stream":{"level":"info"},"values":[["1","{\"instance\":\"ns/wf/1\",\"level\":\"info\",\"msg\":\"a\"}"],["4","{\"instance\":\"ns/wf/1\",\"level\":\"info\",\"msg\":\"d\"}"]]},
+			{"stream":{"level":"error"},"values":[["2","{\"instance\":\"ns/wf/1\",\"level\":\"error\",\"msg\":\"b\"}"],["3","not json"]]}
+		]}}`))
+
+	})
+
+	logs, err := b.Query(context.Background(), "ns", "ns/wf/1", 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// streams are merged back into the order lines were logged in
+	if len(logs) != 2 || logs[0].Message != "b" || logs[0].Level != "error" || logs[1].Message != "not json" || logs[1].Timestamp != 3 {
+		t.Errorf("unexpected logs %+v", logs)
+	}
+
+}
+
+func TestSelector(t *testing.T) {
+
+	if s := selector("ns", ""); s != `{job="direktiv",namespace="ns"} !~ "^\\{\"instance\""` {
+		t.Errorf("unexpected namespace selector %s", s)
+	}
+
+}
+
+func TestNew(t *testing.T) {
+
+	for _, options := range []map[string]string{
+		{},
+		{"url": "http://loki", "queryRange": "0"},
+		{"url": "http://loki", "queryRange": "a day"},
+	} {
+		if _, err := New(options); err == nil {
+			t.Errorf("expected an error for options %v", options)
+		}
+	}
+
+}
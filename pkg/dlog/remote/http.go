@@ -0,0 +1,86 @@
+package remote
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/dlog"
+)
+
+// NewHTTPClient returns the client backends talk to their services with.
+func NewHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: shipTimeout,
+	}
+}
+
+// Do sends req and decodes the JSON it responds with into out, unless out is
+// nil. Responses other than 2xx are errors quoting the start of their body.
+func Do(client *http.Client, req *http.Request, out interface{}) error {
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s responded with %s: %s", req.URL.Host, resp.Status, bytes.TrimSpace(msg))
+	}
+
+	if out == nil {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+
+}
+
+// Line is how a record is written as a JSON log line, for services that
+// store lines rather than documents. The instance comes first so lines of an
+// instance can be found with a filter on their start.
+type Line struct {
+	Instance string            `json:"instance,omitempty"`
+	Level    string            `json:"level"`
+	Message  string            `json:"msg"`
+	Context  map[string]string `json:"ctx,omitempty"`
+}
+
+// NewLine returns the line of r.
+func NewLine(r Record) ([]byte, error) {
+	return json.Marshal(&Line{
+		Instance: r.Instance,
+		Level:    r.Level,
+		Message:  r.Message,
+		Context:  r.Context,
+	})
+}
+
+// ParseLine returns the log entry of a line logged at t. Lines that aren't
+// JSON are returned as they are.
+func ParseLine(t time.Time, data []byte) dlog.LogEntry {
+
+	e := dlog.LogEntry{
+		Timestamp: t.UnixNano(),
+	}
+
+	l := new(Line)
+	if json.Unmarshal(data, l) != nil {
+		e.Message = string(data)
+		return e
+	}
+
+	e.Level = l.Level
+	e.Message = l.Message
+	e.Context = l.Context
+
+	return e
+
+}
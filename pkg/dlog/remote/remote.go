@@ -0,0 +1,304 @@
+// Package remote ships instance logs to log services in batches. The
+// elasticsearch, loki and cloudwatch sinks are backends of it.
+package remote
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/inconshreveable/log15"
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/dlog"
+)
+
+const (
+	// flushFrequency is how often queued logs are shipped.
+	flushFrequency = time.Second
+
+	// maxBatch is how many logs are shipped at once at most. Logs queued
+	// beyond maxQueue while a service is unreachable are dropped.
+	maxBatch = 500
+	maxQueue = 100000
+
+	shipTimeout = 30 * time.Second
+)
+
+// Record is a log line of an instance, or of a namespace if Instance is
+// empty.
+type Record struct {
+	Namespace string
+	Instance  string
+	Time      time.Time
+	Level     string
+	Message   string
+	Context   map[string]string
+}
+
+// Backend is a log service records are shipped to.
+type Backend interface {
+
+	// Ship stores records, which are in the order they were logged.
+	Ship(ctx context.Context, records []Record) error
+
+	// Query returns the logs of an instance, or those of a namespace if
+	// instance is empty, oldest first.
+	Query(ctx context.Context, namespace, instance string, limit, offset int) ([]dlog.LogEntry, error)
+
+	// Delete removes the logs of an instance, or those of a namespace if
+	// instance is empty.
+	Delete(ctx context.Context, namespace, instance string) error
+}
+
+// Sink is a dlog.Log that queues logs and ships them to its backend.
+type Sink struct {
+	backend Backend
+	name    string
+
+	mtx     sync.Mutex
+	queue   []Record
+	dropped int
+
+	closed chan bool
+	done   chan bool
+	once   sync.Once
+}
+
+// New creates a sink shipping to backend, called name in its errors.
+func New(name string, backend Backend) *Sink {
+
+	s := &Sink{
+		backend: backend,
+		name:    name,
+		closed:  make(chan bool),
+		done:    make(chan bool),
+	}
+
+	go s.dispatcher()
+
+	return s
+
+}
+
+type handler struct {
+	sink      *Sink
+	namespace string
+	instance  string
+}
+
+func (h *handler) Log(r *log15.Record) error {
+
+	ctx := make(map[string]string)
+	for i := 1; i < len(r.Ctx); i += 2 {
+		ctx[fmt.Sprintf("%s", r.Ctx[i-1])] = fmt.Sprintf("%v", r.Ctx[i])
+	}
+
+	h.sink.add(Record{
+		Namespace: h.namespace,
+		Instance:  h.instance,
+		Time:      r.Time,
+		Level:     r.Lvl.String(),
+		Message:   r.Msg,
+		Context:   ctx,
+	})
+
+	return nil
+
+}
+
+type logger struct {
+	log15.Logger
+}
+
+// Close is a no-op, queued logs are shipped by the sink.
+func (l *logger) Close() error {
+	return nil
+}
+
+func (s *Sink) newLogger(namespace, instance string) dlog.Logger {
+
+	l := &logger{
+		Logger: log15.New(),
+	}
+
+	l.SetHandler(&handler{
+		sink:      s,
+		namespace: namespace,
+		instance:  instance,
+	})
+
+	return l
+
+}
+
+func (s *Sink) LoggerFunc(namespace, instance string) (dlog.Logger, error) {
+	return s.newLogger(namespace, instance), nil
+}
+
+func (s *Sink) NamespaceLogger(namespace string) (dlog.Logger, error) {
+	return s.newLogger(namespace, ""), nil
+}
+
+// splitScope returns the namespace and instance of a query, which is either
+// a namespace or an instance id starting with its namespace.
+func splitScope(scope string) (string, string) {
+
+	if !strings.Contains(scope, "/") {
+		return scope, ""
+	}
+
+	return strings.SplitN(scope, "/", 2)[0], scope
+
+}
+
+func (s *Sink) QueryLogs(ctx context.Context, instance string, limit, offset int) (dlog.QueryReponse, error) {
+
+	resp := dlog.QueryReponse{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	ns, iid := splitScope(instance)
+
+	logs, err := s.backend.Query(ctx, ns, iid, limit, offset)
+	if err != nil {
+		return resp, fmt.Errorf("can not query %s logs: %v", s.name, err)
+	}
+
+	resp.Logs = logs
+	resp.Count = len(logs)
+
+	return resp, nil
+
+}
+
+func (s *Sink) delete(namespace, instance string) error {
+
+	ctx, cancel := context.WithTimeout(context.Background(), shipTimeout)
+	defer cancel()
+
+	err := s.backend.Delete(ctx, namespace, instance)
+	if err != nil {
+		return fmt.Errorf("can not delete %s logs: %v", s.name, err)
+	}
+
+	return nil
+
+}
+
+func (s *Sink) DeleteNamespaceLogs(namespace string) error {
+	return s.delete(namespace, "")
+}
+
+func (s *Sink) DeleteInstanceLogs(instance string) error {
+	ns, iid := splitScope(instance)
+	return s.delete(ns, iid)
+}
+
+func (s *Sink) add(r Record) {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if len(s.queue) >= maxQueue {
+		s.dropped++
+		return
+	}
+
+	s.queue = append(s.queue, r)
+
+}
+
+func (s *Sink) dispatcher() {
+
+	defer close(s.done)
+
+	ticker := time.NewTicker(flushFrequency)
+	defer ticker.Stop()
+
+	for {
+
+		var closed bool
+
+		select {
+		case <-s.closed:
+			closed = true
+		case <-ticker.C:
+		}
+
+		s.flush()
+
+		if closed {
+			return
+		}
+
+	}
+
+}
+
+func (s *Sink) flush() {
+
+	s.mtx.Lock()
+	queue := s.queue
+	s.queue = nil
+	dropped := s.dropped
+	s.dropped = 0
+	s.mtx.Unlock()
+
+	if dropped > 0 {
+		log.Errorf("dropped %d logs, %s is too far behind", dropped, s.name)
+	}
+
+	for len(queue) > 0 {
+
+		n := len(queue)
+		if n > maxBatch {
+			n = maxBatch
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), shipTimeout)
+		err := s.backend.Ship(ctx, queue[:n])
+		cancel()
+
+		if err != nil {
+			// keep what couldn't be shipped for the next tick
+			log.Errorf("can not ship %d logs to %s: %v", len(queue), s.name, err)
+			s.requeue(queue)
+			return
+		}
+
+		queue = queue[n:]
+
+	}
+
+}
+
+func (s *Sink) requeue(queue []Record) {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	q := append(queue, s.queue...)
+	if len(q) > maxQueue {
+		s.dropped += len(q) - maxQueue
+		q = q[len(q)-maxQueue:]
+	}
+
+	s.queue = q
+
+}
+
+// Close ships the queued logs and stops the sink.
+func (s *Sink) Close() error {
+
+	s.once.Do(func() {
+		close(s.closed)
+	})
+
+	<-s.done
+
+	return nil
+
+}
@@ -0,0 +1,175 @@
+package dlog
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/inconshreveable/log15"
+)
+
+// SinkInstantiator creates a log sink from its options, such as the address
+// of the service logs are shipped to.
+type SinkInstantiator func(options map[string]string) (Log, error)
+
+var (
+	sinks = make(map[string]SinkInstantiator)
+)
+
+// RegisterSink makes a sink available as a logging driver. Sinks register
+// themselves when their package is imported.
+func RegisterSink(name string, fn SinkInstantiator) error {
+
+	if _, exists := sinks[name]; exists {
+		return fmt.Errorf("refusing to register log sink '%s': already registered", name)
+	}
+
+	sinks[name] = fn
+	return nil
+
+}
+
+// NewSink creates the sink registered as name.
+func NewSink(name string, options map[string]string) (Log, error) {
+
+	fn, ok := sinks[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown log sink '%s'", name)
+	}
+
+	return fn(options)
+
+}
+
+// ListSinks returns the names of the registered sinks.
+func ListSinks() []string {
+
+	names := make([]string, 0, len(sinks))
+	for k := range sinks {
+		names = append(names, k)
+	}
+
+	sort.Strings(names)
+
+	return names
+
+}
+
+// fanout writes logs to every sink it has. Logs are queried from the first,
+// which is the only one that has to answer queries.
+type fanout struct {
+	sinks []Log
+}
+
+// NewFanout returns a Log writing to primary and every one of others, that
+// queries logs from primary.
+func NewFanout(primary Log, others ...Log) Log {
+
+	if len(others) == 0 {
+		return primary
+	}
+
+	return &fanout{
+		sinks: append([]Log{primary}, others...),
+	}
+
+}
+
+type fanoutLogger struct {
+	log15.Logger
+	loggers []Logger
+}
+
+func (fl *fanoutLogger) Close() error {
+
+	var err error
+
+	for _, l := range fl.loggers {
+		if cerr := l.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+
+	return err
+
+}
+
+func (f *fanout) logger(fn func(l Log) (Logger, error)) (Logger, error) {
+
+	fl := &fanoutLogger{
+		Logger: log15.New(),
+	}
+
+	var handlers []log15.Handler
+
+	for _, s := range f.sinks {
+
+		l, err := fn(s)
+		if err != nil {
+			_ = fl.Close()
+			return nil, err
+		}
+
+		fl.loggers = append(fl.loggers, l)
+		handlers = append(handlers, l.GetHandler())
+
+	}
+
+	fl.SetHandler(log15.MultiHandler(handlers...))
+
+	return fl, nil
+
+}
+
+func (f *fanout) LoggerFunc(namespace, instance string) (Logger, error) {
+	return f.logger(func(l Log) (Logger, error) {
+		return l.LoggerFunc(namespace, instance)
+	})
+}
+
+func (f *fanout) NamespaceLogger(namespace string) (Logger, error) {
+	return f.logger(func(l Log) (Logger, error) {
+		return l.NamespaceLogger(namespace)
+	})
+}
+
+func (f *fanout) QueryLogs(ctx context.Context, instance string, limit, offset int) (QueryReponse, error) {
+	return f.sinks[0].QueryLogs(ctx, instance, limit, offset)
+}
+
+func (f *fanout) DeleteNamespaceLogs(namespace string) error {
+	return f.each(func(l Log) error {
+		return l.DeleteNamespaceLogs(namespace)
+	})
+}
+
+func (f *fanout) DeleteInstanceLogs(instance string) error {
+	return f.each(func(l Log) error {
+		return l.DeleteInstanceLogs(instance)
+	})
+}
+
+func (f *fanout) each(fn func(l Log) error) error {
+
+	var err error
+
+	for _, s := range f.sinks {
+		if serr := fn(s); serr != nil && err == nil {
+			err = serr
+		}
+	}
+
+	return err
+
+}
+
+// Close closes the sinks that need to be, writing out the logs they queue.
+func (f *fanout) Close() error {
+	return f.each(func(l Log) error {
+		if c, ok := l.(io.Closer); ok {
+			return c.Close()
+		}
+		return nil
+	})
+}
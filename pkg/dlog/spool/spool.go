@@ -0,0 +1,521 @@
+// Package spool keeps instances running while their log sink is down.
+// Loggers that can't be created are replaced by ones writing to spool files
+// on disk, and the spooled logs are written to the sink once it is back.
+package spool
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/inconshreveable/log15"
+	log "github.com/sirupsen/logrus"
+	"github.com/vorteil/direktiv/pkg/dlog"
+)
+
+const (
+	// flushFrequency is how often buffered logs are written to disk, and
+	// retryFrequency how often spooled logs are tried on the sink again.
+	flushFrequency = time.Second
+	retryFrequency = 10 * time.Second
+
+	segmentSuffix = ".spool"
+)
+
+// record is a spooled log line, one JSON object per line of a segment.
+type record struct {
+	Namespace string    `json:"ns"`
+	Instance  string    `json:"instance,omitempty"`
+	Time      time.Time `json:"time"`
+	Level     log15.Lvl `json:"lvl"`
+	Message   string    `json:"msg"`
+	Context   []string  `json:"ctx,omitempty"`
+}
+
+func (r *record) log15() *log15.Record {
+
+	ctx := make([]interface{}, len(r.Context))
+	for i := range r.Context {
+		ctx[i] = r.Context[i]
+	}
+
+	return &log15.Record{
+		Time: r.Time,
+		Lvl:  r.Level,
+		Msg:  r.Message,
+		Ctx:  ctx,
+	}
+
+}
+
+// Spool is a dlog.Log falling back to spool files in its directory when
+// the Log it wraps can't give out loggers. Spooled logs are written through
+// the wrapped Log as soon as it can again, with the time they were logged
+// at. Logs beyond maxSize bytes of spool files are dropped.
+type Spool struct {
+	log     dlog.Log
+	dir     string
+	maxSize int64
+
+	// mtx guards the segment being written, files is held while segments
+	// are read back or rewritten
+	mtx     sync.Mutex
+	files   sync.Mutex
+	f       *os.File
+	w       *bufio.Writer
+	seq     int
+	size    int64
+	dropped int
+	stopped bool
+
+	closed chan bool
+	done   chan bool
+	once   sync.Once
+}
+
+// New wraps l in a spool writing to dir. Spool files left in dir by a
+// previous run are written to l once it works.
+func New(l dlog.Log, dir string, maxSize int64) *Spool {
+
+	s := &Spool{
+		log:     l,
+		dir:     dir,
+		maxSize: maxSize,
+		closed:  make(chan bool),
+		done:    make(chan bool),
+	}
+
+	for _, seg := range s.segments() {
+		fi, err := os.Stat(seg)
+		if err == nil {
+			s.size += fi.Size()
+		}
+	}
+
+	if s.size > 0 {
+		log.Infof("found %d bytes of spooled logs", s.size)
+	}
+
+	go s.run()
+
+	return s
+
+}
+
+type handler struct {
+	spool     *Spool
+	namespace string
+	instance  string
+}
+
+func (h *handler) Log(r *log15.Record) error {
+
+	rec := &record{
+		Namespace: h.namespace,
+		Instance:  h.instance,
+		Time:      r.Time,
+		Level:     r.Lvl,
+		Message:   r.Msg,
+	}
+
+	for i := 1; i < len(r.Ctx); i += 2 {
+		rec.Context = append(rec.Context, fmt.Sprintf("%s", r.Ctx[i-1]), fmt.Sprintf("%v", r.Ctx[i]))
+	}
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	h.spool.write(append(data, '\n'))
+
+	return nil
+
+}
+
+type logger struct {
+	log15.Logger
+}
+
+// Close is a no-op, spooled logs are flushed by the spool.
+func (l *logger) Close() error {
+	return nil
+}
+
+func (s *Spool) newLogger(namespace, instance string) dlog.Logger {
+
+	l := &logger{
+		Logger: log15.New(),
+	}
+
+	l.SetHandler(&handler{
+		spool:     s,
+		namespace: namespace,
+		instance:  instance,
+	})
+
+	return l
+
+}
+
+func (s *Spool) LoggerFunc(namespace, instance string) (dlog.Logger, error) {
+
+	l, err := s.log.LoggerFunc(namespace, instance)
+	if err != nil {
+		log.Warnf("spooling logs of %s: %v", instance, err)
+		return s.newLogger(namespace, instance), nil
+	}
+
+	return l, nil
+
+}
+
+func (s *Spool) NamespaceLogger(namespace string) (dlog.Logger, error) {
+
+	l, err := s.log.NamespaceLogger(namespace)
+	if err != nil {
+		log.Warnf("spooling logs of namespace %s: %v", namespace, err)
+		return s.newLogger(namespace, ""), nil
+	}
+
+	return l, nil
+
+}
+
+// QueryLogs queries the wrapped Log, spooled logs show up once they are
+// written to it.
+func (s *Spool) QueryLogs(ctx context.Context, instance string, limit, offset int) (dlog.QueryReponse, error) {
+	return s.log.QueryLogs(ctx, instance, limit, offset)
+}
+
+// DeleteNamespaceLogs deletes the logs of a namespace and its instances,
+// spooled ones included so they aren't written back afterwards.
+func (s *Spool) DeleteNamespaceLogs(namespace string) error {
+
+	s.forget(func(r *record) bool {
+		return r.Namespace == namespace
+	})
+
+	return s.log.DeleteNamespaceLogs(namespace)
+
+}
+
+// DeleteInstanceLogs deletes the logs of an instance, spooled ones included
+// so they aren't written back afterwards.
+func (s *Spool) DeleteInstanceLogs(instance string) error {
+
+	s.forget(func(r *record) bool {
+		return r.Instance == instance
+	})
+
+	return s.log.DeleteInstanceLogs(instance)
+
+}
+
+func (s *Spool) write(data []byte) {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.size+int64(len(data)) > s.maxSize {
+		s.dropped++
+		return
+	}
+
+	if s.w == nil {
+
+		err := os.MkdirAll(s.dir, 0700)
+		if err != nil {
+			log.Errorf("can not create log spool: %v", err)
+			s.dropped++
+			return
+		}
+
+		s.seq++
+		name := filepath.Join(s.dir, fmt.Sprintf("%020d-%d%s", time.Now().UnixNano(), s.seq, segmentSuffix))
+
+		s.f, err = os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err != nil {
+			log.Errorf("can not create log spool: %v", err)
+			s.dropped++
+			return
+		}
+
+		s.w = bufio.NewWriter(s.f)
+
+	}
+
+	_, _ = s.w.Write(data)
+	s.size += int64(len(data))
+
+	// nothing flushes once the spool is closed
+	if s.stopped {
+		s.flush(false)
+	}
+
+}
+
+// flush writes buffered logs to disk, and closes the segment being written
+// if rotate is set so that it can be read back. Callers hold mtx.
+func (s *Spool) flush(rotate bool) {
+
+	if s.dropped > 0 {
+		log.Errorf("dropped %d logs, the log spool is full", s.dropped)
+		s.dropped = 0
+	}
+
+	if s.w == nil {
+		return
+	}
+
+	err := s.w.Flush()
+	if err != nil {
+		log.Errorf("can not write log spool: %v", err)
+	}
+
+	if !rotate {
+		return
+	}
+
+	_ = s.f.Close()
+	s.f = nil
+	s.w = nil
+
+}
+
+func (s *Spool) run() {
+
+	defer close(s.done)
+
+	flush := time.NewTicker(flushFrequency)
+	defer flush.Stop()
+
+	retry := time.NewTicker(retryFrequency)
+	defer retry.Stop()
+
+	for {
+
+		select {
+		case <-s.closed:
+			s.mtx.Lock()
+			s.flush(true)
+			s.stopped = true
+			s.mtx.Unlock()
+			return
+		case <-flush.C:
+			s.mtx.Lock()
+			s.flush(false)
+			s.mtx.Unlock()
+		case <-retry.C:
+			s.backfill()
+		}
+
+	}
+
+}
+
+// segments returns the spool files in dir, oldest first.
+func (s *Spool) segments() []string {
+
+	segs, _ := filepath.Glob(filepath.Join(s.dir, "*"+segmentSuffix))
+	sort.Strings(segs)
+
+	return segs
+
+}
+
+// rotate closes the segment being written and returns the segments that
+// can be read back.
+func (s *Spool) rotate() []string {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.flush(true)
+
+	return s.segments()
+
+}
+
+func (s *Spool) backfill() {
+
+	s.files.Lock()
+	defer s.files.Unlock()
+
+	for _, seg := range s.rotate() {
+		if !s.replay(seg) {
+			return
+		}
+	}
+
+}
+
+func (s *Spool) logger(r *record) (dlog.Logger, error) {
+
+	if r.Instance == "" {
+		return s.log.NamespaceLogger(r.Namespace)
+	}
+
+	return s.log.LoggerFunc(r.Namespace, r.Instance)
+
+}
+
+// replay writes the logs of a segment through the wrapped Log, and reports
+// whether all of them were. Whatever couldn't be written stays spooled.
+func (s *Spool) replay(seg string) bool {
+
+	lines, err := s.read(seg)
+	if err != nil {
+		log.Errorf("can not read log spool: %v", err)
+		return false
+	}
+
+	loggers := make(map[[2]string]dlog.Logger)
+	defer func() {
+		for _, l := range loggers {
+			_ = l.Close()
+		}
+	}()
+
+	for i, line := range lines {
+
+		r := new(record)
+		if json.Unmarshal(line, r) != nil {
+			// torn by a crash while it was written
+			continue
+		}
+
+		k := [2]string{r.Namespace, r.Instance}
+
+		l, ok := loggers[k]
+		if !ok {
+			l, err = s.logger(r)
+			if err != nil {
+				// still down, try the rest again later
+				s.rewrite(seg, lines, lines[i:])
+				return false
+			}
+			loggers[k] = l
+		}
+
+		_ = l.GetHandler().Log(r.log15())
+
+	}
+
+	s.rewrite(seg, lines, nil)
+
+	return true
+
+}
+
+func (s *Spool) read(seg string) ([][]byte, error) {
+
+	data, err := ioutil.ReadFile(seg)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines [][]byte
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, nil
+
+}
+
+func linesSize(lines [][]byte) int64 {
+
+	var n int64
+	for _, line := range lines {
+		n += int64(len(line)) + 1
+	}
+
+	return n
+
+}
+
+// rewrite replaces the lines of a segment with keep, removing it if there
+// are none. Callers hold files.
+func (s *Spool) rewrite(seg string, lines, keep [][]byte) {
+
+	if len(keep) == len(lines) {
+		return
+	}
+
+	var err error
+
+	if len(keep) == 0 {
+		err = os.Remove(seg)
+	} else {
+		tmp := strings.TrimSuffix(seg, segmentSuffix) + ".tmp"
+		err = ioutil.WriteFile(tmp, append(bytes.Join(keep, []byte("\n")), '\n'), 0600)
+		if err == nil {
+			err = os.Rename(tmp, seg)
+		}
+	}
+
+	if err != nil {
+		log.Errorf("can not rewrite log spool: %v", err)
+		return
+	}
+
+	s.mtx.Lock()
+	s.size -= linesSize(lines) - linesSize(keep)
+	s.mtx.Unlock()
+
+}
+
+// forget removes the spooled logs matching fn.
+func (s *Spool) forget(fn func(r *record) bool) {
+
+	s.files.Lock()
+	defer s.files.Unlock()
+
+	for _, seg := range s.rotate() {
+
+		lines, err := s.read(seg)
+		if err != nil {
+			log.Errorf("can not read log spool: %v", err)
+			continue
+		}
+
+		var keep [][]byte
+		for _, line := range lines {
+			r := new(record)
+			if json.Unmarshal(line, r) == nil && fn(r) {
+				continue
+			}
+			keep = append(keep, line)
+		}
+
+		s.rewrite(seg, lines, keep)
+
+	}
+
+}
+
+// Close writes buffered logs to disk and stops the spool, logs still
+// spooled are written back by the next one using the same directory.
+func (s *Spool) Close() error {
+
+	s.once.Do(func() {
+		close(s.closed)
+	})
+
+	<-s.done
+
+	return nil
+
+}
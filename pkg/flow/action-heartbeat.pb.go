@@ -0,0 +1,185 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.12.3
+// source: pkg/flow/action-heartbeat.proto
+
+package flow
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type ActionHeartbeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId *string `protobuf:"bytes,1,opt,name=instanceId,proto3,oneof" json:"instanceId,omitempty"`
+	Step       *int32  `protobuf:"varint,2,opt,name=step,proto3,oneof" json:"step,omitempty"`
+	ActionId   *string `protobuf:"bytes,3,opt,name=actionId,proto3,oneof" json:"actionId,omitempty"`
+	Progress   *string `protobuf:"bytes,4,opt,name=progress,proto3,oneof" json:"progress,omitempty"`
+}
+
+func (x *ActionHeartbeatRequest) Reset() {
+	*x = ActionHeartbeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_flow_action_heartbeat_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActionHeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionHeartbeatRequest) ProtoMessage() {}
+
+func (x *ActionHeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_flow_action_heartbeat_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionHeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*ActionHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_flow_action_heartbeat_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ActionHeartbeatRequest) GetInstanceId() string {
+	if x != nil && x.InstanceId != nil {
+		return *x.InstanceId
+	}
+	return ""
+}
+
+func (x *ActionHeartbeatRequest) GetStep() int32 {
+	if x != nil && x.Step != nil {
+		return *x.Step
+	}
+	return 0
+}
+
+func (x *ActionHeartbeatRequest) GetActionId() string {
+	if x != nil && x.ActionId != nil {
+		return *x.ActionId
+	}
+	return ""
+}
+
+func (x *ActionHeartbeatRequest) GetProgress() string {
+	if x != nil && x.Progress != nil {
+		return *x.Progress
+	}
+	return ""
+}
+
+var File_pkg_flow_action_heartbeat_proto protoreflect.FileDescriptor
+
+var file_pkg_flow_action_heartbeat_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x70, 0x6b, 0x67, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2d, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0xca, 0x01, 0x0a, 0x16, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x88,
+	0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x72, 0x74, 0x65, 0x69, 0x6c, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x6b,
+	0x74, 0x69, 0x76, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_flow_action_heartbeat_proto_rawDescOnce sync.Once
+	file_pkg_flow_action_heartbeat_proto_rawDescData = file_pkg_flow_action_heartbeat_proto_rawDesc
+)
+
+func file_pkg_flow_action_heartbeat_proto_rawDescGZIP() []byte {
+	file_pkg_flow_action_heartbeat_proto_rawDescOnce.Do(func() {
+		file_pkg_flow_action_heartbeat_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_flow_action_heartbeat_proto_rawDescData)
+	})
+	return file_pkg_flow_action_heartbeat_proto_rawDescData
+}
+
+var file_pkg_flow_action_heartbeat_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_flow_action_heartbeat_proto_goTypes = []interface{}{
+	(*ActionHeartbeatRequest)(nil), // 0: flow.ActionHeartbeatRequest
+}
+var file_pkg_flow_action_heartbeat_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_pkg_flow_action_heartbeat_proto_init() }
+func file_pkg_flow_action_heartbeat_proto_init() {
+	if File_pkg_flow_action_heartbeat_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_flow_action_heartbeat_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionHeartbeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_flow_action_heartbeat_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_flow_action_heartbeat_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_flow_action_heartbeat_proto_goTypes,
+		DependencyIndexes: file_pkg_flow_action_heartbeat_proto_depIdxs,
+		MessageInfos:      file_pkg_flow_action_heartbeat_proto_msgTypes,
+	}.Build()
+	File_pkg_flow_action_heartbeat_proto = out.File
+	file_pkg_flow_action_heartbeat_proto_rawDesc = nil
+	file_pkg_flow_action_heartbeat_proto_goTypes = nil
+	file_pkg_flow_action_heartbeat_proto_depIdxs = nil
+}
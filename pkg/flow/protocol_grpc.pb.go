@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DirektivFlowClient interface {
 	ReportActionResults(ctx context.Context, in *ReportActionResultsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ReportActionResultsStream(ctx context.Context, opts ...grpc.CallOption) (DirektivFlow_ReportActionResultsStreamClient, error)
 	Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	GetNamespaceVariable(ctx context.Context, in *GetNamespaceVariableRequest, opts ...grpc.CallOption) (DirektivFlow_GetNamespaceVariableClient, error)
 	GetWorkflowVariable(ctx context.Context, in *GetWorkflowVariableRequest, opts ...grpc.CallOption) (DirektivFlow_GetWorkflowVariableClient, error)
@@ -28,6 +29,8 @@ type DirektivFlowClient interface {
 	SetWorkflowVariable(ctx context.Context, opts ...grpc.CallOption) (DirektivFlow_SetWorkflowVariableClient, error)
 	SetInstanceVariable(ctx context.Context, opts ...grpc.CallOption) (DirektivFlow_SetInstanceVariableClient, error)
 	ActionLog(ctx context.Context, in *ActionLogRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ActionHeartbeat(ctx context.Context, in *ActionHeartbeatRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ActionCancelled(ctx context.Context, in *ActionCancelledRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type direktivFlowClient struct {
@@ -47,6 +50,40 @@ func (c *direktivFlowClient) ReportActionResults(ctx context.Context, in *Report
 	return out, nil
 }
 
+func (c *direktivFlowClient) ReportActionResultsStream(ctx context.Context, opts ...grpc.CallOption) (DirektivFlow_ReportActionResultsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DirektivFlow_ServiceDesc.Streams[0], "/flow.DirektivFlow/ReportActionResultsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &direktivFlowReportActionResultsStreamClient{stream}
+	return x, nil
+}
+
+type DirektivFlow_ReportActionResultsStreamClient interface {
+	Send(*ReportActionResultsStreamRequest) error
+	CloseAndRecv() (*empty.Empty, error)
+	grpc.ClientStream
+}
+
+type direktivFlowReportActionResultsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *direktivFlowReportActionResultsStreamClient) Send(m *ReportActionResultsStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *direktivFlowReportActionResultsStreamClient) CloseAndRecv() (*empty.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(empty.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *direktivFlowClient) Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/flow.DirektivFlow/Resume", in, out, opts...)
@@ -57,7 +94,7 @@ func (c *direktivFlowClient) Resume(ctx context.Context, in *ResumeRequest, opts
 }
 
 func (c *direktivFlowClient) GetNamespaceVariable(ctx context.Context, in *GetNamespaceVariableRequest, opts ...grpc.CallOption) (DirektivFlow_GetNamespaceVariableClient, error) {
-	stream, err := c.cc.NewStream(ctx, &DirektivFlow_ServiceDesc.Streams[0], "/flow.DirektivFlow/GetNamespaceVariable", opts...)
+	stream, err := c.cc.NewStream(ctx, &DirektivFlow_ServiceDesc.Streams[1], "/flow.DirektivFlow/GetNamespaceVariable", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +126,7 @@ func (x *direktivFlowGetNamespaceVariableClient) Recv() (*GetNamespaceVariableRe
 }
 
 func (c *direktivFlowClient) GetWorkflowVariable(ctx context.Context, in *GetWorkflowVariableRequest, opts ...grpc.CallOption) (DirektivFlow_GetWorkflowVariableClient, error) {
-	stream, err := c.cc.NewStream(ctx, &DirektivFlow_ServiceDesc.Streams[1], "/flow.DirektivFlow/GetWorkflowVariable", opts...)
+	stream, err := c.cc.NewStream(ctx, &DirektivFlow_ServiceDesc.Streams[2], "/flow.DirektivFlow/GetWorkflowVariable", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +158,7 @@ func (x *direktivFlowGetWorkflowVariableClient) Recv() (*GetWorkflowVariableResp
 }
 
 func (c *direktivFlowClient) GetInstanceVariable(ctx context.Context, in *GetInstanceVariableRequest, opts ...grpc.CallOption) (DirektivFlow_GetInstanceVariableClient, error) {
-	stream, err := c.cc.NewStream(ctx, &DirektivFlow_ServiceDesc.Streams[2], "/flow.DirektivFlow/GetInstanceVariable", opts...)
+	stream, err := c.cc.NewStream(ctx, &DirektivFlow_ServiceDesc.Streams[3], "/flow.DirektivFlow/GetInstanceVariable", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +190,7 @@ func (x *direktivFlowGetInstanceVariableClient) Recv() (*GetInstanceVariableResp
 }
 
 func (c *direktivFlowClient) SetNamespaceVariable(ctx context.Context, opts ...grpc.CallOption) (DirektivFlow_SetNamespaceVariableClient, error) {
-	stream, err := c.cc.NewStream(ctx, &DirektivFlow_ServiceDesc.Streams[3], "/flow.DirektivFlow/SetNamespaceVariable", opts...)
+	stream, err := c.cc.NewStream(ctx, &DirektivFlow_ServiceDesc.Streams[4], "/flow.DirektivFlow/SetNamespaceVariable", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +224,7 @@ func (x *direktivFlowSetNamespaceVariableClient) CloseAndRecv() (*empty.Empty, e
 }
 
 func (c *direktivFlowClient) SetWorkflowVariable(ctx context.Context, opts ...grpc.CallOption) (DirektivFlow_SetWorkflowVariableClient, error) {
-	stream, err := c.cc.NewStream(ctx, &DirektivFlow_ServiceDesc.Streams[4], "/flow.DirektivFlow/SetWorkflowVariable", opts...)
+	stream, err := c.cc.NewStream(ctx, &DirektivFlow_ServiceDesc.Streams[5], "/flow.DirektivFlow/SetWorkflowVariable", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -221,7 +258,7 @@ func (x *direktivFlowSetWorkflowVariableClient) CloseAndRecv() (*empty.Empty, er
 }
 
 func (c *direktivFlowClient) SetInstanceVariable(ctx context.Context, opts ...grpc.CallOption) (DirektivFlow_SetInstanceVariableClient, error) {
-	stream, err := c.cc.NewStream(ctx, &DirektivFlow_ServiceDesc.Streams[5], "/flow.DirektivFlow/SetInstanceVariable", opts...)
+	stream, err := c.cc.NewStream(ctx, &DirektivFlow_ServiceDesc.Streams[6], "/flow.DirektivFlow/SetInstanceVariable", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -263,11 +300,30 @@ func (c *direktivFlowClient) ActionLog(ctx context.Context, in *ActionLogRequest
 	return out, nil
 }
 
+func (c *direktivFlowClient) ActionHeartbeat(ctx context.Context, in *ActionHeartbeatRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/flow.DirektivFlow/ActionHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivFlowClient) ActionCancelled(ctx context.Context, in *ActionCancelledRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/flow.DirektivFlow/ActionCancelled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DirektivFlowServer is the server API for DirektivFlow service.
 // All implementations must embed UnimplementedDirektivFlowServer
 // for forward compatibility
 type DirektivFlowServer interface {
 	ReportActionResults(context.Context, *ReportActionResultsRequest) (*empty.Empty, error)
+	ReportActionResultsStream(DirektivFlow_ReportActionResultsStreamServer) error
 	Resume(context.Context, *ResumeRequest) (*empty.Empty, error)
 	GetNamespaceVariable(*GetNamespaceVariableRequest, DirektivFlow_GetNamespaceVariableServer) error
 	GetWorkflowVariable(*GetWorkflowVariableRequest, DirektivFlow_GetWorkflowVariableServer) error
@@ -276,6 +332,8 @@ type DirektivFlowServer interface {
 	SetWorkflowVariable(DirektivFlow_SetWorkflowVariableServer) error
 	SetInstanceVariable(DirektivFlow_SetInstanceVariableServer) error
 	ActionLog(context.Context, *ActionLogRequest) (*empty.Empty, error)
+	ActionHeartbeat(context.Context, *ActionHeartbeatRequest) (*empty.Empty, error)
+	ActionCancelled(context.Context, *ActionCancelledRequest) (*empty.Empty, error)
 	mustEmbedUnimplementedDirektivFlowServer()
 }
 
@@ -286,6 +344,9 @@ type UnimplementedDirektivFlowServer struct {
 func (UnimplementedDirektivFlowServer) ReportActionResults(context.Context, *ReportActionResultsRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportActionResults not implemented")
 }
+func (UnimplementedDirektivFlowServer) ReportActionResultsStream(DirektivFlow_ReportActionResultsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReportActionResultsStream not implemented")
+}
 func (UnimplementedDirektivFlowServer) Resume(context.Context, *ResumeRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
 }
@@ -310,6 +371,12 @@ func (UnimplementedDirektivFlowServer) SetInstanceVariable(DirektivFlow_SetInsta
 func (UnimplementedDirektivFlowServer) ActionLog(context.Context, *ActionLogRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActionLog not implemented")
 }
+func (UnimplementedDirektivFlowServer) ActionHeartbeat(context.Context, *ActionHeartbeatRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionHeartbeat not implemented")
+}
+func (UnimplementedDirektivFlowServer) ActionCancelled(context.Context, *ActionCancelledRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionCancelled not implemented")
+}
 func (UnimplementedDirektivFlowServer) mustEmbedUnimplementedDirektivFlowServer() {}
 
 // UnsafeDirektivFlowServer may be embedded to opt out of forward compatibility for this service.
@@ -341,6 +408,32 @@ func _DirektivFlow_ReportActionResults_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DirektivFlow_ReportActionResultsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DirektivFlowServer).ReportActionResultsStream(&direktivFlowReportActionResultsStreamServer{stream})
+}
+
+type DirektivFlow_ReportActionResultsStreamServer interface {
+	SendAndClose(*empty.Empty) error
+	Recv() (*ReportActionResultsStreamRequest, error)
+	grpc.ServerStream
+}
+
+type direktivFlowReportActionResultsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *direktivFlowReportActionResultsStreamServer) SendAndClose(m *empty.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *direktivFlowReportActionResultsStreamServer) Recv() (*ReportActionResultsStreamRequest, error) {
+	m := new(ReportActionResultsStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _DirektivFlow_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResumeRequest)
 	if err := dec(in); err != nil {
@@ -518,6 +611,42 @@ func _DirektivFlow_ActionLog_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DirektivFlow_ActionHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActionHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivFlowServer).ActionHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.DirektivFlow/ActionHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivFlowServer).ActionHeartbeat(ctx, req.(*ActionHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivFlow_ActionCancelled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActionCancelledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivFlowServer).ActionCancelled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.DirektivFlow/ActionCancelled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivFlowServer).ActionCancelled(ctx, req.(*ActionCancelledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DirektivFlow_ServiceDesc is the grpc.ServiceDesc for DirektivFlow service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -537,8 +666,21 @@ var DirektivFlow_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ActionLog",
 			Handler:    _DirektivFlow_ActionLog_Handler,
 		},
+		{
+			MethodName: "ActionHeartbeat",
+			Handler:    _DirektivFlow_ActionHeartbeat_Handler,
+		},
+		{
+			MethodName: "ActionCancelled",
+			Handler:    _DirektivFlow_ActionCancelled_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReportActionResultsStream",
+			Handler:       _DirektivFlow_ReportActionResultsStream_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "GetNamespaceVariable",
 			Handler:       _DirektivFlow_GetNamespaceVariable_Handler,
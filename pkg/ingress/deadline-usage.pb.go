@@ -0,0 +1,297 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: pkg/ingress/deadline-usage.proto
+
+package ingress
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type GetDeadlineUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+}
+
+func (x *GetDeadlineUsageRequest) Reset() {
+	*x = GetDeadlineUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_deadline_usage_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadlineUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadlineUsageRequest) ProtoMessage() {}
+
+func (x *GetDeadlineUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_deadline_usage_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadlineUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetDeadlineUsageRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_deadline_usage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetDeadlineUsageRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+// GetDeadlineUsageResponse is how much of the time until their deadlines
+// the states of a namespace used over the last window seconds. Usages are
+// fractions, near counts the states that used at least nearUsage of it.
+type GetDeadlineUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window    *int32   `protobuf:"varint,1,opt,name=window,proto3,oneof" json:"window,omitempty"`
+	NearUsage *float64 `protobuf:"fixed64,2,opt,name=nearUsage,proto3,oneof" json:"nearUsage,omitempty"`
+	Samples   *int32   `protobuf:"varint,3,opt,name=samples,proto3,oneof" json:"samples,omitempty"`
+	Near      *int32   `protobuf:"varint,4,opt,name=near,proto3,oneof" json:"near,omitempty"`
+	TimedOut  *int32   `protobuf:"varint,5,opt,name=timedOut,proto3,oneof" json:"timedOut,omitempty"`
+	MeanUsage *float64 `protobuf:"fixed64,6,opt,name=meanUsage,proto3,oneof" json:"meanUsage,omitempty"`
+	P95Usage  *float64 `protobuf:"fixed64,7,opt,name=p95Usage,proto3,oneof" json:"p95Usage,omitempty"`
+	Slipping  *bool    `protobuf:"varint,8,opt,name=slipping,proto3,oneof" json:"slipping,omitempty"`
+}
+
+func (x *GetDeadlineUsageResponse) Reset() {
+	*x = GetDeadlineUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_deadline_usage_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadlineUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadlineUsageResponse) ProtoMessage() {}
+
+func (x *GetDeadlineUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_deadline_usage_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadlineUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetDeadlineUsageResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_deadline_usage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetDeadlineUsageResponse) GetWindow() int32 {
+	if x != nil && x.Window != nil {
+		return *x.Window
+	}
+	return 0
+}
+
+func (x *GetDeadlineUsageResponse) GetNearUsage() float64 {
+	if x != nil && x.NearUsage != nil {
+		return *x.NearUsage
+	}
+	return 0
+}
+
+func (x *GetDeadlineUsageResponse) GetSamples() int32 {
+	if x != nil && x.Samples != nil {
+		return *x.Samples
+	}
+	return 0
+}
+
+func (x *GetDeadlineUsageResponse) GetNear() int32 {
+	if x != nil && x.Near != nil {
+		return *x.Near
+	}
+	return 0
+}
+
+func (x *GetDeadlineUsageResponse) GetTimedOut() int32 {
+	if x != nil && x.TimedOut != nil {
+		return *x.TimedOut
+	}
+	return 0
+}
+
+func (x *GetDeadlineUsageResponse) GetMeanUsage() float64 {
+	if x != nil && x.MeanUsage != nil {
+		return *x.MeanUsage
+	}
+	return 0
+}
+
+func (x *GetDeadlineUsageResponse) GetP95Usage() float64 {
+	if x != nil && x.P95Usage != nil {
+		return *x.P95Usage
+	}
+	return 0
+}
+
+func (x *GetDeadlineUsageResponse) GetSlipping() bool {
+	if x != nil && x.Slipping != nil {
+		return *x.Slipping
+	}
+	return false
+}
+
+var File_pkg_ingress_deadline_usage_proto protoreflect.FileDescriptor
+
+var file_pkg_ingress_deadline_usage_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x2d, 0x75, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4a, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xfb, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x88, 0x01,
+	0x01, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x65, 0x61, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x09, 0x6e, 0x65, 0x61, 0x72, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x03, 0x52, 0x04, 0x6e, 0x65, 0x61, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a,
+	0x09, 0x6d, 0x65, 0x61, 0x6e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x05, 0x52, 0x09, 0x6d, 0x65, 0x61, 0x6e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x08, 0x70, 0x39, 0x35, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x06, 0x52, 0x08, 0x70, 0x39, 0x35, 0x55, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x07, 0x52, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x88,
+	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6e, 0x65, 0x61, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x65, 0x61, 0x72,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x70, 0x39, 0x35, 0x55, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x6c, 0x69,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x72, 0x74, 0x65, 0x69, 0x6c, 0x2f, 0x64, 0x69, 0x72, 0x65,
+	0x6b, 0x74, 0x69, 0x76, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_ingress_deadline_usage_proto_rawDescOnce sync.Once
+	file_pkg_ingress_deadline_usage_proto_rawDescData = file_pkg_ingress_deadline_usage_proto_rawDesc
+)
+
+func file_pkg_ingress_deadline_usage_proto_rawDescGZIP() []byte {
+	file_pkg_ingress_deadline_usage_proto_rawDescOnce.Do(func() {
+		file_pkg_ingress_deadline_usage_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_ingress_deadline_usage_proto_rawDescData)
+	})
+	return file_pkg_ingress_deadline_usage_proto_rawDescData
+}
+
+var file_pkg_ingress_deadline_usage_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_ingress_deadline_usage_proto_goTypes = []interface{}{
+	(*GetDeadlineUsageRequest)(nil),  // 0: ingress.GetDeadlineUsageRequest
+	(*GetDeadlineUsageResponse)(nil), // 1: ingress.GetDeadlineUsageResponse
+}
+var file_pkg_ingress_deadline_usage_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_pkg_ingress_deadline_usage_proto_init() }
+func file_pkg_ingress_deadline_usage_proto_init() {
+	if File_pkg_ingress_deadline_usage_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_ingress_deadline_usage_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadlineUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_deadline_usage_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadlineUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_ingress_deadline_usage_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_pkg_ingress_deadline_usage_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_ingress_deadline_usage_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_ingress_deadline_usage_proto_goTypes,
+		DependencyIndexes: file_pkg_ingress_deadline_usage_proto_depIdxs,
+		MessageInfos:      file_pkg_ingress_deadline_usage_proto_msgTypes,
+	}.Build()
+	File_pkg_ingress_deadline_usage_proto = out.File
+	file_pkg_ingress_deadline_usage_proto_rawDesc = nil
+	file_pkg_ingress_deadline_usage_proto_goTypes = nil
+	file_pkg_ingress_deadline_usage_proto_depIdxs = nil
+}
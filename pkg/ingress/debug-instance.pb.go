@@ -0,0 +1,337 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: pkg/ingress/debug-instance.proto
+
+package ingress
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type SetWorkflowInstanceBreakpointsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          *string  `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Breakpoints []string `protobuf:"bytes,2,rep,name=breakpoints,proto3" json:"breakpoints,omitempty"`
+}
+
+func (x *SetWorkflowInstanceBreakpointsRequest) Reset() {
+	*x = SetWorkflowInstanceBreakpointsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_debug_instance_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetWorkflowInstanceBreakpointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorkflowInstanceBreakpointsRequest) ProtoMessage() {}
+
+func (x *SetWorkflowInstanceBreakpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_debug_instance_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorkflowInstanceBreakpointsRequest.ProtoReflect.Descriptor instead.
+func (*SetWorkflowInstanceBreakpointsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_debug_instance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetWorkflowInstanceBreakpointsRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *SetWorkflowInstanceBreakpointsRequest) GetBreakpoints() []string {
+	if x != nil {
+		return x.Breakpoints
+	}
+	return nil
+}
+
+// SetWorkflowInstanceDebugRequest turns recording the query, input and
+// output of every jq transform of an instance in its log on or off.
+type SetWorkflowInstanceDebugRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Transforms *bool   `protobuf:"varint,2,opt,name=transforms,proto3,oneof" json:"transforms,omitempty"`
+}
+
+func (x *SetWorkflowInstanceDebugRequest) Reset() {
+	*x = SetWorkflowInstanceDebugRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_debug_instance_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetWorkflowInstanceDebugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorkflowInstanceDebugRequest) ProtoMessage() {}
+
+func (x *SetWorkflowInstanceDebugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_debug_instance_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorkflowInstanceDebugRequest.ProtoReflect.Descriptor instead.
+func (*SetWorkflowInstanceDebugRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_debug_instance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetWorkflowInstanceDebugRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *SetWorkflowInstanceDebugRequest) GetTransforms() bool {
+	if x != nil && x.Transforms != nil {
+		return *x.Transforms
+	}
+	return false
+}
+
+type ResumeWorkflowInstanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Action *string `protobuf:"bytes,2,opt,name=action,proto3,oneof" json:"action,omitempty"`
+	Data   []byte  `protobuf:"bytes,3,opt,name=data,proto3,oneof" json:"data,omitempty"`
+	Next   *string `protobuf:"bytes,4,opt,name=next,proto3,oneof" json:"next,omitempty"`
+}
+
+func (x *ResumeWorkflowInstanceRequest) Reset() {
+	*x = ResumeWorkflowInstanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_debug_instance_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeWorkflowInstanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeWorkflowInstanceRequest) ProtoMessage() {}
+
+func (x *ResumeWorkflowInstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_debug_instance_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeWorkflowInstanceRequest.ProtoReflect.Descriptor instead.
+func (*ResumeWorkflowInstanceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_debug_instance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResumeWorkflowInstanceRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *ResumeWorkflowInstanceRequest) GetAction() string {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return ""
+}
+
+func (x *ResumeWorkflowInstanceRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ResumeWorkflowInstanceRequest) GetNext() string {
+	if x != nil && x.Next != nil {
+		return *x.Next
+	}
+	return ""
+}
+
+var File_pkg_ingress_debug_instance_proto protoreflect.FileDescriptor
+
+var file_pkg_ingress_debug_instance_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x65, 0x0a, 0x25, 0x53,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x5f,
+	0x69, 0x64, 0x22, 0x71, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01,
+	0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x88, 0x01, 0x01, 0x42,
+	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x02, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f,
+	0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x42,
+	0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f,
+	0x72, 0x74, 0x65, 0x69, 0x6c, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x6b, 0x74, 0x69, 0x76, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_pkg_ingress_debug_instance_proto_rawDescOnce sync.Once
+	file_pkg_ingress_debug_instance_proto_rawDescData = file_pkg_ingress_debug_instance_proto_rawDesc
+)
+
+func file_pkg_ingress_debug_instance_proto_rawDescGZIP() []byte {
+	file_pkg_ingress_debug_instance_proto_rawDescOnce.Do(func() {
+		file_pkg_ingress_debug_instance_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_ingress_debug_instance_proto_rawDescData)
+	})
+	return file_pkg_ingress_debug_instance_proto_rawDescData
+}
+
+var file_pkg_ingress_debug_instance_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_ingress_debug_instance_proto_goTypes = []interface{}{
+	(*SetWorkflowInstanceBreakpointsRequest)(nil), // 0: ingress.SetWorkflowInstanceBreakpointsRequest
+	(*SetWorkflowInstanceDebugRequest)(nil),       // 1: ingress.SetWorkflowInstanceDebugRequest
+	(*ResumeWorkflowInstanceRequest)(nil),         // 2: ingress.ResumeWorkflowInstanceRequest
+}
+var file_pkg_ingress_debug_instance_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_pkg_ingress_debug_instance_proto_init() }
+func file_pkg_ingress_debug_instance_proto_init() {
+	if File_pkg_ingress_debug_instance_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_ingress_debug_instance_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWorkflowInstanceBreakpointsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_debug_instance_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWorkflowInstanceDebugRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_debug_instance_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeWorkflowInstanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_ingress_debug_instance_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_pkg_ingress_debug_instance_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_pkg_ingress_debug_instance_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_ingress_debug_instance_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_ingress_debug_instance_proto_goTypes,
+		DependencyIndexes: file_pkg_ingress_debug_instance_proto_depIdxs,
+		MessageInfos:      file_pkg_ingress_debug_instance_proto_msgTypes,
+	}.Build()
+	File_pkg_ingress_debug_instance_proto = out.File
+	file_pkg_ingress_debug_instance_proto_rawDesc = nil
+	file_pkg_ingress_debug_instance_proto_goTypes = nil
+	file_pkg_ingress_debug_instance_proto_depIdxs = nil
+}
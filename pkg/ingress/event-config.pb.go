@@ -0,0 +1,442 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: pkg/ingress/event-config.proto
+
+package ingress
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// NamespaceEventSink is an endpoint the events emitted in a namespace are
+// posted to as structured cloud events. Types are the event types it gets,
+// exactly or by a prefix ending in *, all of them if there are none.
+// AuthSecret names a namespace secret sent as the Authorization header.
+type NamespaceEventSink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url        *string           `protobuf:"bytes,1,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	Headers    map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AuthSecret *string           `protobuf:"bytes,3,opt,name=authSecret,proto3,oneof" json:"authSecret,omitempty"`
+	Types      []string          `protobuf:"bytes,4,rep,name=types,proto3" json:"types,omitempty"`
+}
+
+func (x *NamespaceEventSink) Reset() {
+	*x = NamespaceEventSink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_event_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamespaceEventSink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceEventSink) ProtoMessage() {}
+
+func (x *NamespaceEventSink) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_event_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceEventSink.ProtoReflect.Descriptor instead.
+func (*NamespaceEventSink) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_event_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NamespaceEventSink) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *NamespaceEventSink) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *NamespaceEventSink) GetAuthSecret() string {
+	if x != nil && x.AuthSecret != nil {
+		return *x.AuthSecret
+	}
+	return ""
+}
+
+func (x *NamespaceEventSink) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type GetNamespaceEventConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+}
+
+func (x *GetNamespaceEventConfigRequest) Reset() {
+	*x = GetNamespaceEventConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_event_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNamespaceEventConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceEventConfigRequest) ProtoMessage() {}
+
+func (x *GetNamespaceEventConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_event_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceEventConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceEventConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_event_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetNamespaceEventConfigRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+// GetNamespaceEventConfigResponse is how a namespace exchanges events.
+// Events posted to it from outside must have one of the inboundTypes, if
+// there are any, and the events its workflows and direktiv emit in it are
+// posted to its sinks.
+type GetNamespaceEventConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace    *string               `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	InboundTypes []string              `protobuf:"bytes,2,rep,name=inboundTypes,proto3" json:"inboundTypes,omitempty"`
+	Sinks        []*NamespaceEventSink `protobuf:"bytes,3,rep,name=sinks,proto3" json:"sinks,omitempty"`
+}
+
+func (x *GetNamespaceEventConfigResponse) Reset() {
+	*x = GetNamespaceEventConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_event_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNamespaceEventConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceEventConfigResponse) ProtoMessage() {}
+
+func (x *GetNamespaceEventConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_event_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceEventConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceEventConfigResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_event_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetNamespaceEventConfigResponse) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *GetNamespaceEventConfigResponse) GetInboundTypes() []string {
+	if x != nil {
+		return x.InboundTypes
+	}
+	return nil
+}
+
+func (x *GetNamespaceEventConfigResponse) GetSinks() []*NamespaceEventSink {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
+type SetNamespaceEventConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace    *string               `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	InboundTypes []string              `protobuf:"bytes,2,rep,name=inboundTypes,proto3" json:"inboundTypes,omitempty"`
+	Sinks        []*NamespaceEventSink `protobuf:"bytes,3,rep,name=sinks,proto3" json:"sinks,omitempty"`
+}
+
+func (x *SetNamespaceEventConfigRequest) Reset() {
+	*x = SetNamespaceEventConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_event_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNamespaceEventConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNamespaceEventConfigRequest) ProtoMessage() {}
+
+func (x *SetNamespaceEventConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_event_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNamespaceEventConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetNamespaceEventConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_event_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetNamespaceEventConfigRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *SetNamespaceEventConfigRequest) GetInboundTypes() []string {
+	if x != nil {
+		return x.InboundTypes
+	}
+	return nil
+}
+
+func (x *SetNamespaceEventConfigRequest) GetSinks() []*NamespaceEventSink {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
+var File_pkg_ingress_event_config_proto protoreflect.FileDescriptor
+
+var file_pkg_ingress_event_config_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0xfd, 0x01, 0x0a, 0x12, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x6e, 0x6b,
+	0x12, 0x15, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x69, 0x6e, 0x6b, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0a, 0x61,
+	0x75, 0x74, 0x68, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x51, 0x0a, 0x1e, 0x47, 0x65, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xa9, 0x01, 0x0a,
+	0x1f, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x6b, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x69, 0x6e, 0x6b, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x6b, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x1e, 0x53, 0x65, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22,
+	0x0a, 0x0c, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x6e, 0x6b, 0x52, 0x05,
+	0x73, 0x69, 0x6e, 0x6b, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x76, 0x6f, 0x72, 0x74, 0x65, 0x69, 0x6c, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x6b, 0x74,
+	0x69, 0x76, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_ingress_event_config_proto_rawDescOnce sync.Once
+	file_pkg_ingress_event_config_proto_rawDescData = file_pkg_ingress_event_config_proto_rawDesc
+)
+
+func file_pkg_ingress_event_config_proto_rawDescGZIP() []byte {
+	file_pkg_ingress_event_config_proto_rawDescOnce.Do(func() {
+		file_pkg_ingress_event_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_ingress_event_config_proto_rawDescData)
+	})
+	return file_pkg_ingress_event_config_proto_rawDescData
+}
+
+var file_pkg_ingress_event_config_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_ingress_event_config_proto_goTypes = []interface{}{
+	(*NamespaceEventSink)(nil),              // 0: ingress.NamespaceEventSink
+	(*GetNamespaceEventConfigRequest)(nil),  // 1: ingress.GetNamespaceEventConfigRequest
+	(*GetNamespaceEventConfigResponse)(nil), // 2: ingress.GetNamespaceEventConfigResponse
+	(*SetNamespaceEventConfigRequest)(nil),  // 3: ingress.SetNamespaceEventConfigRequest
+	nil,                                     // 4: ingress.NamespaceEventSink.HeadersEntry
+}
+var file_pkg_ingress_event_config_proto_depIdxs = []int32{
+	4, // 0: ingress.NamespaceEventSink.headers:type_name -> ingress.NamespaceEventSink.HeadersEntry
+	0, // 1: ingress.GetNamespaceEventConfigResponse.sinks:type_name -> ingress.NamespaceEventSink
+	0, // 2: ingress.SetNamespaceEventConfigRequest.sinks:type_name -> ingress.NamespaceEventSink
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_pkg_ingress_event_config_proto_init() }
+func file_pkg_ingress_event_config_proto_init() {
+	if File_pkg_ingress_event_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_ingress_event_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceEventSink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_event_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNamespaceEventConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_event_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNamespaceEventConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_event_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNamespaceEventConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_ingress_event_config_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_pkg_ingress_event_config_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_pkg_ingress_event_config_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_pkg_ingress_event_config_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_ingress_event_config_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_ingress_event_config_proto_goTypes,
+		DependencyIndexes: file_pkg_ingress_event_config_proto_depIdxs,
+		MessageInfos:      file_pkg_ingress_event_config_proto_msgTypes,
+	}.Build()
+	File_pkg_ingress_event_config_proto = out.File
+	file_pkg_ingress_event_config_proto_rawDesc = nil
+	file_pkg_ingress_event_config_proto_goTypes = nil
+	file_pkg_ingress_event_config_proto_depIdxs = nil
+}
@@ -0,0 +1,481 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: pkg/ingress/event-stats.proto
+
+package ingress
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type GetEventStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+}
+
+func (x *GetEventStatsRequest) Reset() {
+	*x = GetEventStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_event_stats_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventStatsRequest) ProtoMessage() {}
+
+func (x *GetEventStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_event_stats_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_event_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetEventStatsRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+// GetEventStatsResponse is what became of the cloud events a namespace
+// received, by type, and a sample of the recent ones no listener matched.
+// Counts lag behind by up to ten seconds.
+type GetEventStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string                                 `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Types     []*GetEventStatsResponse_EventType      `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	Unmatched []*GetEventStatsResponse_UnmatchedEvent `protobuf:"bytes,3,rep,name=unmatched,proto3" json:"unmatched,omitempty"`
+}
+
+func (x *GetEventStatsResponse) Reset() {
+	*x = GetEventStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_event_stats_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventStatsResponse) ProtoMessage() {}
+
+func (x *GetEventStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_event_stats_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventStatsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_event_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetEventStatsResponse) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *GetEventStatsResponse) GetTypes() []*GetEventStatsResponse_EventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *GetEventStatsResponse) GetUnmatched() []*GetEventStatsResponse_UnmatchedEvent {
+	if x != nil {
+		return x.Unmatched
+	}
+	return nil
+}
+
+type GetEventStatsResponse_EventType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type         *string              `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Received     *int64               `protobuf:"varint,2,opt,name=received,proto3,oneof" json:"received,omitempty"`
+	Matched      *int64               `protobuf:"varint,3,opt,name=matched,proto3,oneof" json:"matched,omitempty"`
+	Unmatched    *int64               `protobuf:"varint,4,opt,name=unmatched,proto3,oneof" json:"unmatched,omitempty"`
+	Rejected     *int64               `protobuf:"varint,5,opt,name=rejected,proto3,oneof" json:"rejected,omitempty"`
+	LastReceived *timestamp.Timestamp `protobuf:"bytes,6,opt,name=lastReceived,proto3" json:"lastReceived,omitempty"`
+}
+
+func (x *GetEventStatsResponse_EventType) Reset() {
+	*x = GetEventStatsResponse_EventType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_event_stats_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventStatsResponse_EventType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventStatsResponse_EventType) ProtoMessage() {}
+
+func (x *GetEventStatsResponse_EventType) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_event_stats_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventStatsResponse_EventType.ProtoReflect.Descriptor instead.
+func (*GetEventStatsResponse_EventType) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_event_stats_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GetEventStatsResponse_EventType) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *GetEventStatsResponse_EventType) GetReceived() int64 {
+	if x != nil && x.Received != nil {
+		return *x.Received
+	}
+	return 0
+}
+
+func (x *GetEventStatsResponse_EventType) GetMatched() int64 {
+	if x != nil && x.Matched != nil {
+		return *x.Matched
+	}
+	return 0
+}
+
+func (x *GetEventStatsResponse_EventType) GetUnmatched() int64 {
+	if x != nil && x.Unmatched != nil {
+		return *x.Unmatched
+	}
+	return 0
+}
+
+func (x *GetEventStatsResponse_EventType) GetRejected() int64 {
+	if x != nil && x.Rejected != nil {
+		return *x.Rejected
+	}
+	return 0
+}
+
+func (x *GetEventStatsResponse_EventType) GetLastReceived() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastReceived
+	}
+	return nil
+}
+
+type GetEventStatsResponse_UnmatchedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       *string              `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Source     *string              `protobuf:"bytes,2,opt,name=source,proto3,oneof" json:"source,omitempty"`
+	Id         *string              `protobuf:"bytes,3,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Received   *timestamp.Timestamp `protobuf:"bytes,4,opt,name=received,proto3" json:"received,omitempty"`
+	Cloudevent []byte               `protobuf:"bytes,5,opt,name=cloudevent,proto3,oneof" json:"cloudevent,omitempty"`
+}
+
+func (x *GetEventStatsResponse_UnmatchedEvent) Reset() {
+	*x = GetEventStatsResponse_UnmatchedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_event_stats_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventStatsResponse_UnmatchedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventStatsResponse_UnmatchedEvent) ProtoMessage() {}
+
+func (x *GetEventStatsResponse_UnmatchedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_event_stats_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventStatsResponse_UnmatchedEvent.ProtoReflect.Descriptor instead.
+func (*GetEventStatsResponse_UnmatchedEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_event_stats_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *GetEventStatsResponse_UnmatchedEvent) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *GetEventStatsResponse_UnmatchedEvent) GetSource() string {
+	if x != nil && x.Source != nil {
+		return *x.Source
+	}
+	return ""
+}
+
+func (x *GetEventStatsResponse_UnmatchedEvent) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *GetEventStatsResponse_UnmatchedEvent) GetReceived() *timestamp.Timestamp {
+	if x != nil {
+		return x.Received
+	}
+	return nil
+}
+
+func (x *GetEventStatsResponse_UnmatchedEvent) GetCloudevent() []byte {
+	if x != nil {
+		return x.Cloudevent
+	}
+	return nil
+}
+
+var File_pkg_ingress_event_stats_proto protoreflect.FileDescriptor
+
+var file_pkg_ingress_event_stats_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x47, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x22, 0xe2, 0x05, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x3e, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x4b, 0x0a, 0x09, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x09, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x1a, 0xa5, 0x02, 0x0a,
+	0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x6e,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x1a, 0xe2, 0x01, 0x0a, 0x0e, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x1b, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x02, 0x69, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x03,
+	0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x72, 0x74, 0x65, 0x69, 0x6c, 0x2f, 0x64, 0x69,
+	0x72, 0x65, 0x6b, 0x74, 0x69, 0x76, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_ingress_event_stats_proto_rawDescOnce sync.Once
+	file_pkg_ingress_event_stats_proto_rawDescData = file_pkg_ingress_event_stats_proto_rawDesc
+)
+
+func file_pkg_ingress_event_stats_proto_rawDescGZIP() []byte {
+	file_pkg_ingress_event_stats_proto_rawDescOnce.Do(func() {
+		file_pkg_ingress_event_stats_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_ingress_event_stats_proto_rawDescData)
+	})
+	return file_pkg_ingress_event_stats_proto_rawDescData
+}
+
+var file_pkg_ingress_event_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_pkg_ingress_event_stats_proto_goTypes = []interface{}{
+	(*GetEventStatsRequest)(nil),                 // 0: ingress.GetEventStatsRequest
+	(*GetEventStatsResponse)(nil),                // 1: ingress.GetEventStatsResponse
+	(*GetEventStatsResponse_EventType)(nil),      // 2: ingress.GetEventStatsResponse.EventType
+	(*GetEventStatsResponse_UnmatchedEvent)(nil), // 3: ingress.GetEventStatsResponse.UnmatchedEvent
+	(*timestamp.Timestamp)(nil),                  // 4: google.protobuf.Timestamp
+}
+var file_pkg_ingress_event_stats_proto_depIdxs = []int32{
+	2, // 0: ingress.GetEventStatsResponse.types:type_name -> ingress.GetEventStatsResponse.EventType
+	3, // 1: ingress.GetEventStatsResponse.unmatched:type_name -> ingress.GetEventStatsResponse.UnmatchedEvent
+	4, // 2: ingress.GetEventStatsResponse.EventType.lastReceived:type_name -> google.protobuf.Timestamp
+	4, // 3: ingress.GetEventStatsResponse.UnmatchedEvent.received:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_pkg_ingress_event_stats_proto_init() }
+func file_pkg_ingress_event_stats_proto_init() {
+	if File_pkg_ingress_event_stats_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_ingress_event_stats_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_event_stats_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_event_stats_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventStatsResponse_EventType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_event_stats_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventStatsResponse_UnmatchedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_ingress_event_stats_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_pkg_ingress_event_stats_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_pkg_ingress_event_stats_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_pkg_ingress_event_stats_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_ingress_event_stats_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_ingress_event_stats_proto_goTypes,
+		DependencyIndexes: file_pkg_ingress_event_stats_proto_depIdxs,
+		MessageInfos:      file_pkg_ingress_event_stats_proto_msgTypes,
+	}.Build()
+	File_pkg_ingress_event_stats_proto = out.File
+	file_pkg_ingress_event_stats_proto_rawDesc = nil
+	file_pkg_ingress_event_stats_proto_goTypes = nil
+	file_pkg_ingress_event_stats_proto_depIdxs = nil
+}
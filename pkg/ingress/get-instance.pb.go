@@ -78,17 +78,29 @@ type GetWorkflowInstanceResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           *string              `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	Status       *string              `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
-	InvokedBy    *string              `protobuf:"bytes,3,opt,name=invokedBy,proto3,oneof" json:"invokedBy,omitempty"`
-	Revision     *int32               `protobuf:"varint,4,opt,name=revision,proto3,oneof" json:"revision,omitempty"`
-	BeginTime    *timestamp.Timestamp `protobuf:"bytes,5,opt,name=beginTime,proto3,oneof" json:"beginTime,omitempty"`
-	EndTime      *timestamp.Timestamp `protobuf:"bytes,6,opt,name=endTime,proto3,oneof" json:"endTime,omitempty"`
-	Flow         []string             `protobuf:"bytes,7,rep,name=flow,proto3" json:"flow,omitempty"`
-	Input        []byte               `protobuf:"bytes,8,opt,name=input,proto3,oneof" json:"input,omitempty"`
-	Output       []byte               `protobuf:"bytes,9,opt,name=output,proto3,oneof" json:"output,omitempty"`
-	ErrorCode    *string              `protobuf:"bytes,10,opt,name=errorCode,proto3,oneof" json:"errorCode,omitempty"`
-	ErrorMessage *string              `protobuf:"bytes,11,opt,name=errorMessage,proto3,oneof" json:"errorMessage,omitempty"`
+	Id              *string                                   `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Status          *string                                   `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	InvokedBy       *string                                   `protobuf:"bytes,3,opt,name=invokedBy,proto3,oneof" json:"invokedBy,omitempty"`
+	Revision        *int32                                    `protobuf:"varint,4,opt,name=revision,proto3,oneof" json:"revision,omitempty"`
+	BeginTime       *timestamp.Timestamp                      `protobuf:"bytes,5,opt,name=beginTime,proto3,oneof" json:"beginTime,omitempty"`
+	EndTime         *timestamp.Timestamp                      `protobuf:"bytes,6,opt,name=endTime,proto3,oneof" json:"endTime,omitempty"`
+	Flow            []string                                  `protobuf:"bytes,7,rep,name=flow,proto3" json:"flow,omitempty"`
+	Input           []byte                                    `protobuf:"bytes,8,opt,name=input,proto3,oneof" json:"input,omitempty"`
+	Output          []byte                                    `protobuf:"bytes,9,opt,name=output,proto3,oneof" json:"output,omitempty"`
+	ErrorCode       *string                                   `protobuf:"bytes,10,opt,name=errorCode,proto3,oneof" json:"errorCode,omitempty"`
+	ErrorMessage    *string                                   `protobuf:"bytes,11,opt,name=errorMessage,proto3,oneof" json:"errorMessage,omitempty"`
+	ErrorData       []byte                                    `protobuf:"bytes,12,opt,name=errorData,proto3,oneof" json:"errorData,omitempty"`
+	ErrorCauses     []*GetWorkflowInstanceResponse_ErrorCause `protobuf:"bytes,13,rep,name=errorCauses,proto3" json:"errorCauses,omitempty"`
+	ExternalId      *string                                   `protobuf:"bytes,14,opt,name=externalId,proto3,oneof" json:"externalId,omitempty"`
+	CancelCategory  *string                                   `protobuf:"bytes,15,opt,name=cancelCategory,proto3,oneof" json:"cancelCategory,omitempty"`
+	CancelReason    *string                                   `protobuf:"bytes,16,opt,name=cancelReason,proto3,oneof" json:"cancelReason,omitempty"`
+	Progress        *string                                   `protobuf:"bytes,17,opt,name=progress,proto3,oneof" json:"progress,omitempty"`
+	Breakpoints     []string                                  `protobuf:"bytes,18,rep,name=breakpoints,proto3" json:"breakpoints,omitempty"`
+	Paused          *bool                                     `protobuf:"varint,19,opt,name=paused,proto3,oneof" json:"paused,omitempty"`
+	Hold            *string                                   `protobuf:"bytes,20,opt,name=hold,proto3,oneof" json:"hold,omitempty"`
+	DebugTransforms *bool                                     `protobuf:"varint,21,opt,name=debugTransforms,proto3,oneof" json:"debugTransforms,omitempty"`
+	Verbose         *bool                                     `protobuf:"varint,22,opt,name=verbose,proto3,oneof" json:"verbose,omitempty"`
+	Profile         *string                                   `protobuf:"bytes,23,opt,name=profile,proto3,oneof" json:"profile,omitempty"`
 }
 
 func (x *GetWorkflowInstanceResponse) Reset() {
@@ -200,6 +212,145 @@ func (x *GetWorkflowInstanceResponse) GetErrorMessage() string {
 	return ""
 }
 
+func (x *GetWorkflowInstanceResponse) GetErrorData() []byte {
+	if x != nil {
+		return x.ErrorData
+	}
+	return nil
+}
+
+func (x *GetWorkflowInstanceResponse) GetErrorCauses() []*GetWorkflowInstanceResponse_ErrorCause {
+	if x != nil {
+		return x.ErrorCauses
+	}
+	return nil
+}
+
+func (x *GetWorkflowInstanceResponse) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *GetWorkflowInstanceResponse) GetCancelCategory() string {
+	if x != nil && x.CancelCategory != nil {
+		return *x.CancelCategory
+	}
+	return ""
+}
+
+func (x *GetWorkflowInstanceResponse) GetCancelReason() string {
+	if x != nil && x.CancelReason != nil {
+		return *x.CancelReason
+	}
+	return ""
+}
+
+func (x *GetWorkflowInstanceResponse) GetProgress() string {
+	if x != nil && x.Progress != nil {
+		return *x.Progress
+	}
+	return ""
+}
+
+func (x *GetWorkflowInstanceResponse) GetBreakpoints() []string {
+	if x != nil {
+		return x.Breakpoints
+	}
+	return nil
+}
+
+func (x *GetWorkflowInstanceResponse) GetPaused() bool {
+	if x != nil && x.Paused != nil {
+		return *x.Paused
+	}
+	return false
+}
+
+func (x *GetWorkflowInstanceResponse) GetHold() string {
+	if x != nil && x.Hold != nil {
+		return *x.Hold
+	}
+	return ""
+}
+
+func (x *GetWorkflowInstanceResponse) GetDebugTransforms() bool {
+	if x != nil && x.DebugTransforms != nil {
+		return *x.DebugTransforms
+	}
+	return false
+}
+
+func (x *GetWorkflowInstanceResponse) GetVerbose() bool {
+	if x != nil && x.Verbose != nil {
+		return *x.Verbose
+	}
+	return false
+}
+
+func (x *GetWorkflowInstanceResponse) GetProfile() string {
+	if x != nil && x.Profile != nil {
+		return *x.Profile
+	}
+	return ""
+}
+
+type GetWorkflowInstanceResponse_ErrorCause struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    *string `protobuf:"bytes,1,opt,name=code,proto3,oneof" json:"code,omitempty"`
+	Message *string `protobuf:"bytes,2,opt,name=message,proto3,oneof" json:"message,omitempty"`
+}
+
+func (x *GetWorkflowInstanceResponse_ErrorCause) Reset() {
+	*x = GetWorkflowInstanceResponse_ErrorCause{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_get_instance_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkflowInstanceResponse_ErrorCause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowInstanceResponse_ErrorCause) ProtoMessage() {}
+
+func (x *GetWorkflowInstanceResponse_ErrorCause) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_get_instance_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowInstanceResponse_ErrorCause.ProtoReflect.Descriptor instead.
+func (*GetWorkflowInstanceResponse_ErrorCause) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_get_instance_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GetWorkflowInstanceResponse_ErrorCause) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *GetWorkflowInstanceResponse_ErrorCause) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
 var File_pkg_ingress_get_instance_proto protoreflect.FileDescriptor
 
 var file_pkg_ingress_get_instance_proto_rawDesc = []byte{
@@ -211,7 +362,7 @@ var file_pkg_ingress_get_instance_proto_rawDesc = []byte{
 	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
 	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a,
-	0x03, 0x5f, 0x69, 0x64, 0x22, 0xa0, 0x04, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x03, 0x5f, 0x69, 0x64, 0x22, 0xe0, 0x09, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
 	0x66, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x61,
@@ -237,15 +388,59 @@ var file_pkg_ingress_get_instance_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
 	0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03,
-	0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0c,
-	0x0a, 0x0a, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x42, 0x0b, 0x0a, 0x09,
-	0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x65,
-	0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x09, 0x0a,
-	0x07, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x0a, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12,
+	0x51, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x75, 0x73, 0x65, 0x73, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x61, 0x75, 0x73, 0x65, 0x52, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x75, 0x73,
+	0x65, 0x73, 0x12, 0x23, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0b, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x0c, 0x52, 0x0e, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0d, 0x52, 0x0c, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x0e, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x20,
+	0x0a, 0x0b, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x1b, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x0f, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
+	0x04, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x48, 0x10, 0x52, 0x04, 0x68,
+	0x6f, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x11, 0x52, 0x0f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x48, 0x12, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x09, 0x48, 0x13, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x88, 0x01, 0x01, 0x1a, 0x59, 0x0a, 0x0a, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x75, 0x73,
+	0x65, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x05,
+	0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x62, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x12, 0x0a, 0x10, 0x5f,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75,
 	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x72, 0x74, 0x65, 0x69, 0x6c, 0x2f, 0x64, 0x69,
 	0x72, 0x65, 0x6b, 0x74, 0x69, 0x76, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65,
 	0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
@@ -263,20 +458,22 @@ func file_pkg_ingress_get_instance_proto_rawDescGZIP() []byte {
 	return file_pkg_ingress_get_instance_proto_rawDescData
 }
 
-var file_pkg_ingress_get_instance_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_ingress_get_instance_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_pkg_ingress_get_instance_proto_goTypes = []interface{}{
-	(*GetWorkflowInstanceRequest)(nil),  // 0: ingress.GetWorkflowInstanceRequest
-	(*GetWorkflowInstanceResponse)(nil), // 1: ingress.GetWorkflowInstanceResponse
-	(*timestamp.Timestamp)(nil),         // 2: google.protobuf.Timestamp
+	(*GetWorkflowInstanceRequest)(nil),             // 0: ingress.GetWorkflowInstanceRequest
+	(*GetWorkflowInstanceResponse)(nil),            // 1: ingress.GetWorkflowInstanceResponse
+	(*GetWorkflowInstanceResponse_ErrorCause)(nil), // 2: ingress.GetWorkflowInstanceResponse.ErrorCause
+	(*timestamp.Timestamp)(nil),                    // 3: google.protobuf.Timestamp
 }
 var file_pkg_ingress_get_instance_proto_depIdxs = []int32{
-	2, // 0: ingress.GetWorkflowInstanceResponse.beginTime:type_name -> google.protobuf.Timestamp
-	2, // 1: ingress.GetWorkflowInstanceResponse.endTime:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: ingress.GetWorkflowInstanceResponse.beginTime:type_name -> google.protobuf.Timestamp
+	3, // 1: ingress.GetWorkflowInstanceResponse.endTime:type_name -> google.protobuf.Timestamp
+	2, // 2: ingress.GetWorkflowInstanceResponse.errorCauses:type_name -> ingress.GetWorkflowInstanceResponse.ErrorCause
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_pkg_ingress_get_instance_proto_init() }
@@ -309,16 +506,29 @@ func file_pkg_ingress_get_instance_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_ingress_get_instance_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkflowInstanceResponse_ErrorCause); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_pkg_ingress_get_instance_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_pkg_ingress_get_instance_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_pkg_ingress_get_instance_proto_msgTypes[2].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_ingress_get_instance_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,701 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: pkg/ingress/get-nodes.proto
+
+package ingress
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type GetNodesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetNodesRequest) Reset() {
+	*x = GetNodesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_get_nodes_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodesRequest) ProtoMessage() {}
+
+func (x *GetNodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_get_nodes_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodesRequest.ProtoReflect.Descriptor instead.
+func (*GetNodesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_get_nodes_proto_rawDescGZIP(), []int{0}
+}
+
+type GetNodesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*GetNodesResponse_Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *GetNodesResponse) Reset() {
+	*x = GetNodesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_get_nodes_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodesResponse) ProtoMessage() {}
+
+func (x *GetNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_get_nodes_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodesResponse.ProtoReflect.Descriptor instead.
+func (*GetNodesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_get_nodes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetNodesResponse) GetNodes() []*GetNodesResponse_Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type GetNodesResponse_Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname       *string                           `protobuf:"bytes,1,opt,name=hostname,proto3,oneof" json:"hostname,omitempty"`
+	Started        *timestamp.Timestamp              `protobuf:"bytes,2,opt,name=started,proto3" json:"started,omitempty"`
+	Heartbeat      *timestamp.Timestamp              `protobuf:"bytes,3,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	Healthy        *bool                             `protobuf:"varint,4,opt,name=healthy,proto3,oneof" json:"healthy,omitempty"`
+	Draining       *bool                             `protobuf:"varint,5,opt,name=draining,proto3,oneof" json:"draining,omitempty"`
+	Instances      *int32                            `protobuf:"varint,6,opt,name=instances,proto3,oneof" json:"instances,omitempty"`
+	Timers         *int32                            `protobuf:"varint,7,opt,name=timers,proto3,oneof" json:"timers,omitempty"`
+	Queued         *int32                            `protobuf:"varint,8,opt,name=queued,proto3,oneof" json:"queued,omitempty"`
+	Promoted       *bool                             `protobuf:"varint,9,opt,name=promoted,proto3,oneof" json:"promoted,omitempty"`
+	TimerFunctions []*GetNodesResponse_TimerFunction `protobuf:"bytes,10,rep,name=timerFunctions,proto3" json:"timerFunctions,omitempty"`
+	Locks          *GetNodesResponse_Locks           `protobuf:"bytes,11,opt,name=locks,proto3" json:"locks,omitempty"`
+}
+
+func (x *GetNodesResponse_Node) Reset() {
+	*x = GetNodesResponse_Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_get_nodes_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodesResponse_Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodesResponse_Node) ProtoMessage() {}
+
+func (x *GetNodesResponse_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_get_nodes_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodesResponse_Node.ProtoReflect.Descriptor instead.
+func (*GetNodesResponse_Node) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_get_nodes_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GetNodesResponse_Node) GetHostname() string {
+	if x != nil && x.Hostname != nil {
+		return *x.Hostname
+	}
+	return ""
+}
+
+func (x *GetNodesResponse_Node) GetStarted() *timestamp.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *GetNodesResponse_Node) GetHeartbeat() *timestamp.Timestamp {
+	if x != nil {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+func (x *GetNodesResponse_Node) GetHealthy() bool {
+	if x != nil && x.Healthy != nil {
+		return *x.Healthy
+	}
+	return false
+}
+
+func (x *GetNodesResponse_Node) GetDraining() bool {
+	if x != nil && x.Draining != nil {
+		return *x.Draining
+	}
+	return false
+}
+
+func (x *GetNodesResponse_Node) GetInstances() int32 {
+	if x != nil && x.Instances != nil {
+		return *x.Instances
+	}
+	return 0
+}
+
+func (x *GetNodesResponse_Node) GetTimers() int32 {
+	if x != nil && x.Timers != nil {
+		return *x.Timers
+	}
+	return 0
+}
+
+func (x *GetNodesResponse_Node) GetQueued() int32 {
+	if x != nil && x.Queued != nil {
+		return *x.Queued
+	}
+	return 0
+}
+
+func (x *GetNodesResponse_Node) GetPromoted() bool {
+	if x != nil && x.Promoted != nil {
+		return *x.Promoted
+	}
+	return false
+}
+
+func (x *GetNodesResponse_Node) GetTimerFunctions() []*GetNodesResponse_TimerFunction {
+	if x != nil {
+		return x.TimerFunctions
+	}
+	return nil
+}
+
+func (x *GetNodesResponse_Node) GetLocks() *GetNodesResponse_Locks {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+// TimerFunction is how the timers of a function are doing on a node.
+// Lags are in milliseconds, maxLag is the worst between the node's
+// last two heartbeats.
+type GetNodesResponse_TimerFunction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Function   *string              `protobuf:"bytes,1,opt,name=function,proto3,oneof" json:"function,omitempty"`
+	Pending    *int32               `protobuf:"varint,2,opt,name=pending,proto3,oneof" json:"pending,omitempty"`
+	Overdue    *int32               `protobuf:"varint,3,opt,name=overdue,proto3,oneof" json:"overdue,omitempty"`
+	Fired      *int64               `protobuf:"varint,4,opt,name=fired,proto3,oneof" json:"fired,omitempty"`
+	Failed     *int64               `protobuf:"varint,5,opt,name=failed,proto3,oneof" json:"failed,omitempty"`
+	Lag        *int64               `protobuf:"varint,6,opt,name=lag,proto3,oneof" json:"lag,omitempty"`
+	MaxLag     *int64               `protobuf:"varint,7,opt,name=maxLag,proto3,oneof" json:"maxLag,omitempty"`
+	AvgLag     *int64               `protobuf:"varint,8,opt,name=avgLag,proto3,oneof" json:"avgLag,omitempty"`
+	LastFired  *timestamp.Timestamp `protobuf:"bytes,9,opt,name=lastFired,proto3" json:"lastFired,omitempty"`
+	LastFailed *timestamp.Timestamp `protobuf:"bytes,10,opt,name=lastFailed,proto3" json:"lastFailed,omitempty"`
+	LastError  *string              `protobuf:"bytes,11,opt,name=lastError,proto3,oneof" json:"lastError,omitempty"`
+}
+
+func (x *GetNodesResponse_TimerFunction) Reset() {
+	*x = GetNodesResponse_TimerFunction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_get_nodes_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodesResponse_TimerFunction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodesResponse_TimerFunction) ProtoMessage() {}
+
+func (x *GetNodesResponse_TimerFunction) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_get_nodes_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodesResponse_TimerFunction.ProtoReflect.Descriptor instead.
+func (*GetNodesResponse_TimerFunction) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_get_nodes_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *GetNodesResponse_TimerFunction) GetFunction() string {
+	if x != nil && x.Function != nil {
+		return *x.Function
+	}
+	return ""
+}
+
+func (x *GetNodesResponse_TimerFunction) GetPending() int32 {
+	if x != nil && x.Pending != nil {
+		return *x.Pending
+	}
+	return 0
+}
+
+func (x *GetNodesResponse_TimerFunction) GetOverdue() int32 {
+	if x != nil && x.Overdue != nil {
+		return *x.Overdue
+	}
+	return 0
+}
+
+func (x *GetNodesResponse_TimerFunction) GetFired() int64 {
+	if x != nil && x.Fired != nil {
+		return *x.Fired
+	}
+	return 0
+}
+
+func (x *GetNodesResponse_TimerFunction) GetFailed() int64 {
+	if x != nil && x.Failed != nil {
+		return *x.Failed
+	}
+	return 0
+}
+
+func (x *GetNodesResponse_TimerFunction) GetLag() int64 {
+	if x != nil && x.Lag != nil {
+		return *x.Lag
+	}
+	return 0
+}
+
+func (x *GetNodesResponse_TimerFunction) GetMaxLag() int64 {
+	if x != nil && x.MaxLag != nil {
+		return *x.MaxLag
+	}
+	return 0
+}
+
+func (x *GetNodesResponse_TimerFunction) GetAvgLag() int64 {
+	if x != nil && x.AvgLag != nil {
+		return *x.AvgLag
+	}
+	return 0
+}
+
+func (x *GetNodesResponse_TimerFunction) GetLastFired() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastFired
+	}
+	return nil
+}
+
+func (x *GetNodesResponse_TimerFunction) GetLastFailed() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastFailed
+	}
+	return nil
+}
+
+func (x *GetNodesResponse_TimerFunction) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+// Locks is how the instance locks of a node are doing. Waits are in
+// milliseconds, maxWait is the worst between the node's last two
+// heartbeats.
+type GetNodesResponse_Locks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Acquired   *int64               `protobuf:"varint,1,opt,name=acquired,proto3,oneof" json:"acquired,omitempty"`
+	Contended  *int64               `protobuf:"varint,2,opt,name=contended,proto3,oneof" json:"contended,omitempty"`
+	Failed     *int64               `protobuf:"varint,3,opt,name=failed,proto3,oneof" json:"failed,omitempty"`
+	AvgWait    *int64               `protobuf:"varint,4,opt,name=avgWait,proto3,oneof" json:"avgWait,omitempty"`
+	MaxWait    *int64               `protobuf:"varint,5,opt,name=maxWait,proto3,oneof" json:"maxWait,omitempty"`
+	LastFailed *timestamp.Timestamp `protobuf:"bytes,6,opt,name=lastFailed,proto3" json:"lastFailed,omitempty"`
+	LastError  *string              `protobuf:"bytes,7,opt,name=lastError,proto3,oneof" json:"lastError,omitempty"`
+}
+
+func (x *GetNodesResponse_Locks) Reset() {
+	*x = GetNodesResponse_Locks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_get_nodes_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodesResponse_Locks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodesResponse_Locks) ProtoMessage() {}
+
+func (x *GetNodesResponse_Locks) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_get_nodes_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodesResponse_Locks.ProtoReflect.Descriptor instead.
+func (*GetNodesResponse_Locks) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_get_nodes_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *GetNodesResponse_Locks) GetAcquired() int64 {
+	if x != nil && x.Acquired != nil {
+		return *x.Acquired
+	}
+	return 0
+}
+
+func (x *GetNodesResponse_Locks) GetContended() int64 {
+	if x != nil && x.Contended != nil {
+		return *x.Contended
+	}
+	return 0
+}
+
+func (x *GetNodesResponse_Locks) GetFailed() int64 {
+	if x != nil && x.Failed != nil {
+		return *x.Failed
+	}
+	return 0
+}
+
+func (x *GetNodesResponse_Locks) GetAvgWait() int64 {
+	if x != nil && x.AvgWait != nil {
+		return *x.AvgWait
+	}
+	return 0
+}
+
+func (x *GetNodesResponse_Locks) GetMaxWait() int64 {
+	if x != nil && x.MaxWait != nil {
+		return *x.MaxWait
+	}
+	return 0
+}
+
+func (x *GetNodesResponse_Locks) GetLastFailed() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastFailed
+	}
+	return nil
+}
+
+func (x *GetNodesResponse_Locks) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+var File_pkg_ingress_get_nodes_proto protoreflect.FileDescriptor
+
+var file_pkg_ingress_get_nodes_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x67, 0x65,
+	0x74, 0x2d, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x69,
+	0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xcc, 0x0b, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x1a, 0xb4, 0x04, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1f,
+	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x34, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12,
+	0x1d, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x01, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x02, 0x52, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12,
+	0x21, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x03, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x04, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x05, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a,
+	0x0e, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e,
+	0x74, 0x69, 0x6d, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35,
+	0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x05,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x72, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x1a, 0xf6, 0x03, 0x0a,
+	0x0d, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x1d, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x01, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1d,
+	0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x02, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
+	0x05, 0x66, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x05,
+	0x66, 0x69, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x05, 0x52, 0x03, 0x6c, 0x61, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06,
+	0x6d, 0x61, 0x78, 0x4c, 0x61, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x06,
+	0x6d, 0x61, 0x78, 0x4c, 0x61, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x76, 0x67,
+	0x4c, 0x61, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x07, 0x52, 0x06, 0x61, 0x76, 0x67,
+	0x4c, 0x61, 0x67, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x72, 0x65, 0x64,
+	0x12, 0x3a, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x08, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x76, 0x65,
+	0x72, 0x64, 0x75, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x64, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6c, 0x61,
+	0x67, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x67, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x61, 0x76, 0x67, 0x4c, 0x61, 0x67, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x61, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0xd1, 0x02, 0x0a, 0x05, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12,
+	0x1f, 0x0a, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x1d, 0x0a, 0x07, 0x61, 0x76, 0x67, 0x57, 0x61, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x03, 0x52, 0x07, 0x61, 0x76, 0x67, 0x57, 0x61, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x57, 0x61, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x57, 0x61, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3a,
+	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x76, 0x67, 0x57, 0x61, 0x69, 0x74, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x57, 0x61, 0x69, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x72, 0x74, 0x65, 0x69, 0x6c, 0x2f,
+	0x64, 0x69, 0x72, 0x65, 0x6b, 0x74, 0x69, 0x76, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_ingress_get_nodes_proto_rawDescOnce sync.Once
+	file_pkg_ingress_get_nodes_proto_rawDescData = file_pkg_ingress_get_nodes_proto_rawDesc
+)
+
+func file_pkg_ingress_get_nodes_proto_rawDescGZIP() []byte {
+	file_pkg_ingress_get_nodes_proto_rawDescOnce.Do(func() {
+		file_pkg_ingress_get_nodes_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_ingress_get_nodes_proto_rawDescData)
+	})
+	return file_pkg_ingress_get_nodes_proto_rawDescData
+}
+
+var file_pkg_ingress_get_nodes_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_ingress_get_nodes_proto_goTypes = []interface{}{
+	(*GetNodesRequest)(nil),                // 0: ingress.GetNodesRequest
+	(*GetNodesResponse)(nil),               // 1: ingress.GetNodesResponse
+	(*GetNodesResponse_Node)(nil),          // 2: ingress.GetNodesResponse.Node
+	(*GetNodesResponse_TimerFunction)(nil), // 3: ingress.GetNodesResponse.TimerFunction
+	(*GetNodesResponse_Locks)(nil),         // 4: ingress.GetNodesResponse.Locks
+	(*timestamp.Timestamp)(nil),            // 5: google.protobuf.Timestamp
+}
+var file_pkg_ingress_get_nodes_proto_depIdxs = []int32{
+	2, // 0: ingress.GetNodesResponse.nodes:type_name -> ingress.GetNodesResponse.Node
+	5, // 1: ingress.GetNodesResponse.Node.started:type_name -> google.protobuf.Timestamp
+	5, // 2: ingress.GetNodesResponse.Node.heartbeat:type_name -> google.protobuf.Timestamp
+	3, // 3: ingress.GetNodesResponse.Node.timerFunctions:type_name -> ingress.GetNodesResponse.TimerFunction
+	4, // 4: ingress.GetNodesResponse.Node.locks:type_name -> ingress.GetNodesResponse.Locks
+	5, // 5: ingress.GetNodesResponse.TimerFunction.lastFired:type_name -> google.protobuf.Timestamp
+	5, // 6: ingress.GetNodesResponse.TimerFunction.lastFailed:type_name -> google.protobuf.Timestamp
+	5, // 7: ingress.GetNodesResponse.Locks.lastFailed:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_pkg_ingress_get_nodes_proto_init() }
+func file_pkg_ingress_get_nodes_proto_init() {
+	if File_pkg_ingress_get_nodes_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_ingress_get_nodes_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_get_nodes_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_get_nodes_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodesResponse_Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_get_nodes_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodesResponse_TimerFunction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_get_nodes_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodesResponse_Locks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_ingress_get_nodes_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_pkg_ingress_get_nodes_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_pkg_ingress_get_nodes_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_ingress_get_nodes_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_ingress_get_nodes_proto_goTypes,
+		DependencyIndexes: file_pkg_ingress_get_nodes_proto_depIdxs,
+		MessageInfos:      file_pkg_ingress_get_nodes_proto_msgTypes,
+	}.Build()
+	File_pkg_ingress_get_nodes_proto = out.File
+	file_pkg_ingress_get_nodes_proto_rawDesc = nil
+	file_pkg_ingress_get_nodes_proto_goTypes = nil
+	file_pkg_ingress_get_nodes_proto_depIdxs = nil
+}
@@ -0,0 +1,228 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: pkg/ingress/instance-history.proto
+
+package ingress
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type GetWorkflowInstanceHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+}
+
+func (x *GetWorkflowInstanceHistoryRequest) Reset() {
+	*x = GetWorkflowInstanceHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_instance_history_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkflowInstanceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowInstanceHistoryRequest) ProtoMessage() {}
+
+func (x *GetWorkflowInstanceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_instance_history_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowInstanceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkflowInstanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_instance_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetWorkflowInstanceHistoryRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+type GetWorkflowInstanceHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	History []byte  `protobuf:"bytes,2,opt,name=history,proto3,oneof" json:"history,omitempty"`
+}
+
+func (x *GetWorkflowInstanceHistoryResponse) Reset() {
+	*x = GetWorkflowInstanceHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_instance_history_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkflowInstanceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowInstanceHistoryResponse) ProtoMessage() {}
+
+func (x *GetWorkflowInstanceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_instance_history_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowInstanceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkflowInstanceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_instance_history_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetWorkflowInstanceHistoryResponse) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *GetWorkflowInstanceHistoryResponse) GetHistory() []byte {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+var File_pkg_ingress_instance_history_proto protoreflect.FileDescriptor
+
+var file_pkg_ingress_instance_history_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3f, 0x0a,
+	0x21, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x6b,
+	0x0a, 0x22, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x07, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x29, 0x5a, 0x27, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x72, 0x74, 0x65, 0x69,
+	0x6c, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x6b, 0x74, 0x69, 0x76, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69,
+	0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_ingress_instance_history_proto_rawDescOnce sync.Once
+	file_pkg_ingress_instance_history_proto_rawDescData = file_pkg_ingress_instance_history_proto_rawDesc
+)
+
+func file_pkg_ingress_instance_history_proto_rawDescGZIP() []byte {
+	file_pkg_ingress_instance_history_proto_rawDescOnce.Do(func() {
+		file_pkg_ingress_instance_history_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_ingress_instance_history_proto_rawDescData)
+	})
+	return file_pkg_ingress_instance_history_proto_rawDescData
+}
+
+var file_pkg_ingress_instance_history_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_ingress_instance_history_proto_goTypes = []interface{}{
+	(*GetWorkflowInstanceHistoryRequest)(nil),  // 0: ingress.GetWorkflowInstanceHistoryRequest
+	(*GetWorkflowInstanceHistoryResponse)(nil), // 1: ingress.GetWorkflowInstanceHistoryResponse
+}
+var file_pkg_ingress_instance_history_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_pkg_ingress_instance_history_proto_init() }
+func file_pkg_ingress_instance_history_proto_init() {
+	if File_pkg_ingress_instance_history_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_ingress_instance_history_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkflowInstanceHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_instance_history_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkflowInstanceHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_ingress_instance_history_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_pkg_ingress_instance_history_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_ingress_instance_history_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_ingress_instance_history_proto_goTypes,
+		DependencyIndexes: file_pkg_ingress_instance_history_proto_depIdxs,
+		MessageInfos:      file_pkg_ingress_instance_history_proto_msgTypes,
+	}.Build()
+	File_pkg_ingress_instance_history_proto = out.File
+	file_pkg_ingress_instance_history_proto_rawDesc = nil
+	file_pkg_ingress_instance_history_proto_goTypes = nil
+	file_pkg_ingress_instance_history_proto_depIdxs = nil
+}
@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: pkg/ingress/invocation-halt.proto
+
+package ingress
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type SetInvocationHaltRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Halt   *bool   `protobuf:"varint,1,opt,name=halt,proto3,oneof" json:"halt,omitempty"`
+	Reason *string `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+}
+
+func (x *SetInvocationHaltRequest) Reset() {
+	*x = SetInvocationHaltRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_invocation_halt_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInvocationHaltRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInvocationHaltRequest) ProtoMessage() {}
+
+func (x *SetInvocationHaltRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_invocation_halt_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInvocationHaltRequest.ProtoReflect.Descriptor instead.
+func (*SetInvocationHaltRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_invocation_halt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetInvocationHaltRequest) GetHalt() bool {
+	if x != nil && x.Halt != nil {
+		return *x.Halt
+	}
+	return false
+}
+
+func (x *SetInvocationHaltRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type GetInvocationHaltRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetInvocationHaltRequest) Reset() {
+	*x = GetInvocationHaltRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_invocation_halt_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvocationHaltRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvocationHaltRequest) ProtoMessage() {}
+
+func (x *GetInvocationHaltRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_invocation_halt_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvocationHaltRequest.ProtoReflect.Descriptor instead.
+func (*GetInvocationHaltRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_invocation_halt_proto_rawDescGZIP(), []int{1}
+}
+
+// GetInvocationHaltResponse reports whether new instances are halted across
+// the cluster, why and since when.
+type GetInvocationHaltResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Halted *bool                `protobuf:"varint,1,opt,name=halted,proto3,oneof" json:"halted,omitempty"`
+	Reason *string              `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	Since  *timestamp.Timestamp `protobuf:"bytes,3,opt,name=since,proto3,oneof" json:"since,omitempty"`
+}
+
+func (x *GetInvocationHaltResponse) Reset() {
+	*x = GetInvocationHaltResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_invocation_halt_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvocationHaltResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvocationHaltResponse) ProtoMessage() {}
+
+func (x *GetInvocationHaltResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_invocation_halt_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvocationHaltResponse.ProtoReflect.Descriptor instead.
+func (*GetInvocationHaltResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_invocation_halt_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetInvocationHaltResponse) GetHalted() bool {
+	if x != nil && x.Halted != nil {
+		return *x.Halted
+	}
+	return false
+}
+
+func (x *GetInvocationHaltResponse) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *GetInvocationHaltResponse) GetSince() *timestamp.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+var File_pkg_ingress_invocation_halt_proto protoreflect.FileDescriptor
+
+var file_pkg_ingress_invocation_halt_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x69, 0x6e,
+	0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x68, 0x61, 0x6c, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x64, 0x0a,
+	0x18, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61,
+	0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x68, 0x61, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x68, 0x61, 0x6c, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x68, 0x61, 0x6c, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xac, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x61, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x06, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x06, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x02, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x42, 0x29,
+	0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x72,
+	0x74, 0x65, 0x69, 0x6c, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x6b, 0x74, 0x69, 0x76, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_pkg_ingress_invocation_halt_proto_rawDescOnce sync.Once
+	file_pkg_ingress_invocation_halt_proto_rawDescData = file_pkg_ingress_invocation_halt_proto_rawDesc
+)
+
+func file_pkg_ingress_invocation_halt_proto_rawDescGZIP() []byte {
+	file_pkg_ingress_invocation_halt_proto_rawDescOnce.Do(func() {
+		file_pkg_ingress_invocation_halt_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_ingress_invocation_halt_proto_rawDescData)
+	})
+	return file_pkg_ingress_invocation_halt_proto_rawDescData
+}
+
+var file_pkg_ingress_invocation_halt_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_ingress_invocation_halt_proto_goTypes = []interface{}{
+	(*SetInvocationHaltRequest)(nil),  // 0: ingress.SetInvocationHaltRequest
+	(*GetInvocationHaltRequest)(nil),  // 1: ingress.GetInvocationHaltRequest
+	(*GetInvocationHaltResponse)(nil), // 2: ingress.GetInvocationHaltResponse
+	(*timestamp.Timestamp)(nil),       // 3: google.protobuf.Timestamp
+}
+var file_pkg_ingress_invocation_halt_proto_depIdxs = []int32{
+	3, // 0: ingress.GetInvocationHaltResponse.since:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_pkg_ingress_invocation_halt_proto_init() }
+func file_pkg_ingress_invocation_halt_proto_init() {
+	if File_pkg_ingress_invocation_halt_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_ingress_invocation_halt_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetInvocationHaltRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_invocation_halt_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvocationHaltRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_invocation_halt_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvocationHaltResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_ingress_invocation_halt_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_pkg_ingress_invocation_halt_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_ingress_invocation_halt_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_ingress_invocation_halt_proto_goTypes,
+		DependencyIndexes: file_pkg_ingress_invocation_halt_proto_depIdxs,
+		MessageInfos:      file_pkg_ingress_invocation_halt_proto_msgTypes,
+	}.Build()
+	File_pkg_ingress_invocation_halt_proto = out.File
+	file_pkg_ingress_invocation_halt_proto_rawDesc = nil
+	file_pkg_ingress_invocation_halt_proto_goTypes = nil
+	file_pkg_ingress_invocation_halt_proto_depIdxs = nil
+}
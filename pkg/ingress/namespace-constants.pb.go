@@ -0,0 +1,310 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: pkg/ingress/namespace-constants.proto
+
+package ingress
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type GetNamespaceConstantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+}
+
+func (x *GetNamespaceConstantsRequest) Reset() {
+	*x = GetNamespaceConstantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_namespace_constants_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNamespaceConstantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceConstantsRequest) ProtoMessage() {}
+
+func (x *GetNamespaceConstantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_namespace_constants_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceConstantsRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceConstantsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_namespace_constants_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetNamespaceConstantsRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+// GetNamespaceConstantsResponse holds the json object of constants a
+// namespace overrides for its workflows, without what it inherits.
+type GetNamespaceConstantsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Constants []byte  `protobuf:"bytes,2,opt,name=constants,proto3,oneof" json:"constants,omitempty"`
+}
+
+func (x *GetNamespaceConstantsResponse) Reset() {
+	*x = GetNamespaceConstantsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_namespace_constants_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNamespaceConstantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceConstantsResponse) ProtoMessage() {}
+
+func (x *GetNamespaceConstantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_namespace_constants_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceConstantsResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceConstantsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_namespace_constants_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetNamespaceConstantsResponse) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *GetNamespaceConstantsResponse) GetConstants() []byte {
+	if x != nil {
+		return x.Constants
+	}
+	return nil
+}
+
+type SetNamespaceConstantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Constants []byte  `protobuf:"bytes,2,opt,name=constants,proto3,oneof" json:"constants,omitempty"`
+}
+
+func (x *SetNamespaceConstantsRequest) Reset() {
+	*x = SetNamespaceConstantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_namespace_constants_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNamespaceConstantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNamespaceConstantsRequest) ProtoMessage() {}
+
+func (x *SetNamespaceConstantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_namespace_constants_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNamespaceConstantsRequest.ProtoReflect.Descriptor instead.
+func (*SetNamespaceConstantsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_namespace_constants_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetNamespaceConstantsRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *SetNamespaceConstantsRequest) GetConstants() []byte {
+	if x != nil {
+		return x.Constants
+	}
+	return nil
+}
+
+var File_pkg_ingress_namespace_constants_proto protoreflect.FileDescriptor
+
+var file_pkg_ingress_namespace_constants_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2d, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x4f, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x22, 0x81, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x72, 0x74, 0x65, 0x69, 0x6c, 0x2f, 0x64,
+	0x69, 0x72, 0x65, 0x6b, 0x74, 0x69, 0x76, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_ingress_namespace_constants_proto_rawDescOnce sync.Once
+	file_pkg_ingress_namespace_constants_proto_rawDescData = file_pkg_ingress_namespace_constants_proto_rawDesc
+)
+
+func file_pkg_ingress_namespace_constants_proto_rawDescGZIP() []byte {
+	file_pkg_ingress_namespace_constants_proto_rawDescOnce.Do(func() {
+		file_pkg_ingress_namespace_constants_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_ingress_namespace_constants_proto_rawDescData)
+	})
+	return file_pkg_ingress_namespace_constants_proto_rawDescData
+}
+
+var file_pkg_ingress_namespace_constants_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_ingress_namespace_constants_proto_goTypes = []interface{}{
+	(*GetNamespaceConstantsRequest)(nil),  // 0: ingress.GetNamespaceConstantsRequest
+	(*GetNamespaceConstantsResponse)(nil), // 1: ingress.GetNamespaceConstantsResponse
+	(*SetNamespaceConstantsRequest)(nil),  // 2: ingress.SetNamespaceConstantsRequest
+}
+var file_pkg_ingress_namespace_constants_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_pkg_ingress_namespace_constants_proto_init() }
+func file_pkg_ingress_namespace_constants_proto_init() {
+	if File_pkg_ingress_namespace_constants_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_ingress_namespace_constants_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNamespaceConstantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_namespace_constants_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNamespaceConstantsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_namespace_constants_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNamespaceConstantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_ingress_namespace_constants_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_pkg_ingress_namespace_constants_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_pkg_ingress_namespace_constants_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_ingress_namespace_constants_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_ingress_namespace_constants_proto_goTypes,
+		DependencyIndexes: file_pkg_ingress_namespace_constants_proto_depIdxs,
+		MessageInfos:      file_pkg_ingress_namespace_constants_proto_msgTypes,
+	}.Build()
+	File_pkg_ingress_namespace_constants_proto = out.File
+	file_pkg_ingress_namespace_constants_proto_rawDesc = nil
+	file_pkg_ingress_namespace_constants_proto_goTypes = nil
+	file_pkg_ingress_namespace_constants_proto_depIdxs = nil
+}
@@ -0,0 +1,312 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: pkg/ingress/namespace-key.proto
+
+package ingress
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type SetNamespaceKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	KeyRef    *string `protobuf:"bytes,2,opt,name=keyRef,proto3,oneof" json:"keyRef,omitempty"`
+}
+
+func (x *SetNamespaceKeyRequest) Reset() {
+	*x = SetNamespaceKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_namespace_key_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNamespaceKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNamespaceKeyRequest) ProtoMessage() {}
+
+func (x *SetNamespaceKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_namespace_key_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNamespaceKeyRequest.ProtoReflect.Descriptor instead.
+func (*SetNamespaceKeyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_namespace_key_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetNamespaceKeyRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *SetNamespaceKeyRequest) GetKeyRef() string {
+	if x != nil && x.KeyRef != nil {
+		return *x.KeyRef
+	}
+	return ""
+}
+
+type GetNamespaceKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+}
+
+func (x *GetNamespaceKeyRequest) Reset() {
+	*x = GetNamespaceKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_namespace_key_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNamespaceKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceKeyRequest) ProtoMessage() {}
+
+func (x *GetNamespaceKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_namespace_key_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceKeyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_namespace_key_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetNamespaceKeyRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+type GetNamespaceKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyRef  *string              `protobuf:"bytes,1,opt,name=keyRef,proto3,oneof" json:"keyRef,omitempty"`
+	Rotated *timestamp.Timestamp `protobuf:"bytes,2,opt,name=rotated,proto3,oneof" json:"rotated,omitempty"`
+}
+
+func (x *GetNamespaceKeyResponse) Reset() {
+	*x = GetNamespaceKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_namespace_key_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNamespaceKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceKeyResponse) ProtoMessage() {}
+
+func (x *GetNamespaceKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_namespace_key_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceKeyResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_namespace_key_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetNamespaceKeyResponse) GetKeyRef() string {
+	if x != nil && x.KeyRef != nil {
+		return *x.KeyRef
+	}
+	return ""
+}
+
+func (x *GetNamespaceKeyResponse) GetRotated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Rotated
+	}
+	return nil
+}
+
+var File_pkg_ingress_namespace_key_proto protoreflect.FileDescriptor
+
+var file_pkg_ingress_namespace_key_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2d, 0x6b, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71, 0x0a, 0x16, 0x53,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x52,
+	0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x6b, 0x65, 0x79, 0x52,
+	0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x66, 0x22, 0x49,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x66, 0x88,
+	0x01, 0x01, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x01, 0x52, 0x07, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x66, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x72, 0x74, 0x65, 0x69, 0x6c, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x6b,
+	0x74, 0x69, 0x76, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_ingress_namespace_key_proto_rawDescOnce sync.Once
+	file_pkg_ingress_namespace_key_proto_rawDescData = file_pkg_ingress_namespace_key_proto_rawDesc
+)
+
+func file_pkg_ingress_namespace_key_proto_rawDescGZIP() []byte {
+	file_pkg_ingress_namespace_key_proto_rawDescOnce.Do(func() {
+		file_pkg_ingress_namespace_key_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_ingress_namespace_key_proto_rawDescData)
+	})
+	return file_pkg_ingress_namespace_key_proto_rawDescData
+}
+
+var file_pkg_ingress_namespace_key_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_ingress_namespace_key_proto_goTypes = []interface{}{
+	(*SetNamespaceKeyRequest)(nil),  // 0: ingress.SetNamespaceKeyRequest
+	(*GetNamespaceKeyRequest)(nil),  // 1: ingress.GetNamespaceKeyRequest
+	(*GetNamespaceKeyResponse)(nil), // 2: ingress.GetNamespaceKeyResponse
+	(*timestamp.Timestamp)(nil),     // 3: google.protobuf.Timestamp
+}
+var file_pkg_ingress_namespace_key_proto_depIdxs = []int32{
+	3, // 0: ingress.GetNamespaceKeyResponse.rotated:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_pkg_ingress_namespace_key_proto_init() }
+func file_pkg_ingress_namespace_key_proto_init() {
+	if File_pkg_ingress_namespace_key_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_ingress_namespace_key_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNamespaceKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_namespace_key_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNamespaceKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_namespace_key_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNamespaceKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_ingress_namespace_key_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_pkg_ingress_namespace_key_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_pkg_ingress_namespace_key_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_ingress_namespace_key_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_ingress_namespace_key_proto_goTypes,
+		DependencyIndexes: file_pkg_ingress_namespace_key_proto_depIdxs,
+		MessageInfos:      file_pkg_ingress_namespace_key_proto_msgTypes,
+	}.Build()
+	File_pkg_ingress_namespace_key_proto = out.File
+	file_pkg_ingress_namespace_key_proto_rawDesc = nil
+	file_pkg_ingress_namespace_key_proto_goTypes = nil
+	file_pkg_ingress_namespace_key_proto_depIdxs = nil
+}
@@ -0,0 +1,497 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: pkg/ingress/namespace-profiles.proto
+
+package ingress
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type GetNamespaceProfilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+}
+
+func (x *GetNamespaceProfilesRequest) Reset() {
+	*x = GetNamespaceProfilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_namespace_profiles_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNamespaceProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceProfilesRequest) ProtoMessage() {}
+
+func (x *GetNamespaceProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_namespace_profiles_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceProfilesRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_namespace_profiles_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetNamespaceProfilesRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+// GetNamespaceProfilesResponse holds the profiles a namespace defines itself,
+// without those of its ancestors, and the profile it selects. The profile
+// new instances use is the inherited one if it doesn't select any.
+type GetNamespaceProfilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string                                 `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Active    *string                                 `protobuf:"bytes,2,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	Inherited *string                                 `protobuf:"bytes,3,opt,name=inherited,proto3,oneof" json:"inherited,omitempty"`
+	Profiles  []*GetNamespaceProfilesResponse_Profile `protobuf:"bytes,4,rep,name=profiles,proto3" json:"profiles,omitempty"`
+}
+
+func (x *GetNamespaceProfilesResponse) Reset() {
+	*x = GetNamespaceProfilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_namespace_profiles_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNamespaceProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceProfilesResponse) ProtoMessage() {}
+
+func (x *GetNamespaceProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_namespace_profiles_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceProfilesResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_namespace_profiles_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetNamespaceProfilesResponse) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *GetNamespaceProfilesResponse) GetActive() string {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return ""
+}
+
+func (x *GetNamespaceProfilesResponse) GetInherited() string {
+	if x != nil && x.Inherited != nil {
+		return *x.Inherited
+	}
+	return ""
+}
+
+func (x *GetNamespaceProfilesResponse) GetProfiles() []*GetNamespaceProfilesResponse_Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type SetNamespaceProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Name      *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Constants []byte  `protobuf:"bytes,3,opt,name=constants,proto3,oneof" json:"constants,omitempty"`
+}
+
+func (x *SetNamespaceProfileRequest) Reset() {
+	*x = SetNamespaceProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_namespace_profiles_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNamespaceProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNamespaceProfileRequest) ProtoMessage() {}
+
+func (x *SetNamespaceProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_namespace_profiles_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNamespaceProfileRequest.ProtoReflect.Descriptor instead.
+func (*SetNamespaceProfileRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_namespace_profiles_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetNamespaceProfileRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *SetNamespaceProfileRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *SetNamespaceProfileRequest) GetConstants() []byte {
+	if x != nil {
+		return x.Constants
+	}
+	return nil
+}
+
+type SetNamespaceActiveProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Profile   *string `protobuf:"bytes,2,opt,name=profile,proto3,oneof" json:"profile,omitempty"`
+}
+
+func (x *SetNamespaceActiveProfileRequest) Reset() {
+	*x = SetNamespaceActiveProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_namespace_profiles_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNamespaceActiveProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNamespaceActiveProfileRequest) ProtoMessage() {}
+
+func (x *SetNamespaceActiveProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_namespace_profiles_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNamespaceActiveProfileRequest.ProtoReflect.Descriptor instead.
+func (*SetNamespaceActiveProfileRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_namespace_profiles_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetNamespaceActiveProfileRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *SetNamespaceActiveProfileRequest) GetProfile() string {
+	if x != nil && x.Profile != nil {
+		return *x.Profile
+	}
+	return ""
+}
+
+type GetNamespaceProfilesResponse_Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      *string `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Constants []byte  `protobuf:"bytes,2,opt,name=constants,proto3,oneof" json:"constants,omitempty"`
+}
+
+func (x *GetNamespaceProfilesResponse_Profile) Reset() {
+	*x = GetNamespaceProfilesResponse_Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_namespace_profiles_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNamespaceProfilesResponse_Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceProfilesResponse_Profile) ProtoMessage() {}
+
+func (x *GetNamespaceProfilesResponse_Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_namespace_profiles_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceProfilesResponse_Profile.ProtoReflect.Descriptor instead.
+func (*GetNamespaceProfilesResponse_Profile) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_namespace_profiles_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GetNamespaceProfilesResponse_Profile) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *GetNamespaceProfilesResponse_Profile) GetConstants() []byte {
+	if x != nil {
+		return x.Constants
+	}
+	return nil
+}
+
+var File_pkg_ingress_namespace_profiles_proto protoreflect.FileDescriptor
+
+var file_pkg_ingress_namespace_profiles_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x4e, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22,
+	0xd1, 0x02, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x21, 0x0a, 0x09, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x09, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x5c,
+	0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69,
+	0x74, 0x65, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x02, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x88, 0x01,
+	0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x7e, 0x0a, 0x20, 0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x72, 0x74, 0x65, 0x69, 0x6c, 0x2f, 0x64, 0x69, 0x72,
+	0x65, 0x6b, 0x74, 0x69, 0x76, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_ingress_namespace_profiles_proto_rawDescOnce sync.Once
+	file_pkg_ingress_namespace_profiles_proto_rawDescData = file_pkg_ingress_namespace_profiles_proto_rawDesc
+)
+
+func file_pkg_ingress_namespace_profiles_proto_rawDescGZIP() []byte {
+	file_pkg_ingress_namespace_profiles_proto_rawDescOnce.Do(func() {
+		file_pkg_ingress_namespace_profiles_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_ingress_namespace_profiles_proto_rawDescData)
+	})
+	return file_pkg_ingress_namespace_profiles_proto_rawDescData
+}
+
+var file_pkg_ingress_namespace_profiles_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_ingress_namespace_profiles_proto_goTypes = []interface{}{
+	(*GetNamespaceProfilesRequest)(nil),          // 0: ingress.GetNamespaceProfilesRequest
+	(*GetNamespaceProfilesResponse)(nil),         // 1: ingress.GetNamespaceProfilesResponse
+	(*SetNamespaceProfileRequest)(nil),           // 2: ingress.SetNamespaceProfileRequest
+	(*SetNamespaceActiveProfileRequest)(nil),     // 3: ingress.SetNamespaceActiveProfileRequest
+	(*GetNamespaceProfilesResponse_Profile)(nil), // 4: ingress.GetNamespaceProfilesResponse.Profile
+}
+var file_pkg_ingress_namespace_profiles_proto_depIdxs = []int32{
+	4, // 0: ingress.GetNamespaceProfilesResponse.profiles:type_name -> ingress.GetNamespaceProfilesResponse.Profile
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_pkg_ingress_namespace_profiles_proto_init() }
+func file_pkg_ingress_namespace_profiles_proto_init() {
+	if File_pkg_ingress_namespace_profiles_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_ingress_namespace_profiles_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNamespaceProfilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_namespace_profiles_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNamespaceProfilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_namespace_profiles_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNamespaceProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_namespace_profiles_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNamespaceActiveProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_namespace_profiles_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNamespaceProfilesResponse_Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_ingress_namespace_profiles_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_pkg_ingress_namespace_profiles_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_pkg_ingress_namespace_profiles_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_pkg_ingress_namespace_profiles_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_pkg_ingress_namespace_profiles_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_ingress_namespace_profiles_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_ingress_namespace_profiles_proto_goTypes,
+		DependencyIndexes: file_pkg_ingress_namespace_profiles_proto_depIdxs,
+		MessageInfos:      file_pkg_ingress_namespace_profiles_proto_msgTypes,
+	}.Build()
+	File_pkg_ingress_namespace_profiles_proto = out.File
+	file_pkg_ingress_namespace_profiles_proto_rawDesc = nil
+	file_pkg_ingress_namespace_profiles_proto_goTypes = nil
+	file_pkg_ingress_namespace_profiles_proto_depIdxs = nil
+}
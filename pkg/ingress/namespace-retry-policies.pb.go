@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.17.0
+// source: pkg/ingress/namespace-retry-policies.proto
+
+package ingress
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type GetNamespaceRetryPoliciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+}
+
+func (x *GetNamespaceRetryPoliciesRequest) Reset() {
+	*x = GetNamespaceRetryPoliciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_namespace_retry_policies_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNamespaceRetryPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceRetryPoliciesRequest) ProtoMessage() {}
+
+func (x *GetNamespaceRetryPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_namespace_retry_policies_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceRetryPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceRetryPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_namespace_retry_policies_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetNamespaceRetryPoliciesRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+// GetNamespaceRetryPoliciesResponse holds the json object of retry policies
+// a namespace defines for its workflows, by name, without what it inherits.
+type GetNamespaceRetryPoliciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Policies  []byte  `protobuf:"bytes,2,opt,name=policies,proto3,oneof" json:"policies,omitempty"`
+}
+
+func (x *GetNamespaceRetryPoliciesResponse) Reset() {
+	*x = GetNamespaceRetryPoliciesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_namespace_retry_policies_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNamespaceRetryPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceRetryPoliciesResponse) ProtoMessage() {}
+
+func (x *GetNamespaceRetryPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_namespace_retry_policies_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceRetryPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceRetryPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_namespace_retry_policies_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetNamespaceRetryPoliciesResponse) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *GetNamespaceRetryPoliciesResponse) GetPolicies() []byte {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type SetNamespaceRetryPoliciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Policies  []byte  `protobuf:"bytes,2,opt,name=policies,proto3,oneof" json:"policies,omitempty"`
+}
+
+func (x *SetNamespaceRetryPoliciesRequest) Reset() {
+	*x = SetNamespaceRetryPoliciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_namespace_retry_policies_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNamespaceRetryPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNamespaceRetryPoliciesRequest) ProtoMessage() {}
+
+func (x *SetNamespaceRetryPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_namespace_retry_policies_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNamespaceRetryPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*SetNamespaceRetryPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_namespace_retry_policies_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetNamespaceRetryPoliciesRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *SetNamespaceRetryPoliciesRequest) GetPolicies() []byte {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+var File_pkg_ingress_namespace_retry_policies_proto protoreflect.FileDescriptor
+
+var file_pkg_ingress_namespace_retry_policies_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2d, 0x72, 0x65, 0x74, 0x72, 0x79, 0x2d, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x53, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x21, 0x47,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22,
+	0x81, 0x01, 0x0a, 0x20, 0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x76, 0x6f, 0x72, 0x74, 0x65, 0x69, 0x6c, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x6b, 0x74,
+	0x69, 0x76, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_ingress_namespace_retry_policies_proto_rawDescOnce sync.Once
+	file_pkg_ingress_namespace_retry_policies_proto_rawDescData = file_pkg_ingress_namespace_retry_policies_proto_rawDesc
+)
+
+func file_pkg_ingress_namespace_retry_policies_proto_rawDescGZIP() []byte {
+	file_pkg_ingress_namespace_retry_policies_proto_rawDescOnce.Do(func() {
+		file_pkg_ingress_namespace_retry_policies_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_ingress_namespace_retry_policies_proto_rawDescData)
+	})
+	return file_pkg_ingress_namespace_retry_policies_proto_rawDescData
+}
+
+var file_pkg_ingress_namespace_retry_policies_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_ingress_namespace_retry_policies_proto_goTypes = []interface{}{
+	(*GetNamespaceRetryPoliciesRequest)(nil),  // 0: ingress.GetNamespaceRetryPoliciesRequest
+	(*GetNamespaceRetryPoliciesResponse)(nil), // 1: ingress.GetNamespaceRetryPoliciesResponse
+	(*SetNamespaceRetryPoliciesRequest)(nil),  // 2: ingress.SetNamespaceRetryPoliciesRequest
+}
+var file_pkg_ingress_namespace_retry_policies_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_pkg_ingress_namespace_retry_policies_proto_init() }
+func file_pkg_ingress_namespace_retry_policies_proto_init() {
+	if File_pkg_ingress_namespace_retry_policies_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_ingress_namespace_retry_policies_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNamespaceRetryPoliciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_namespace_retry_policies_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNamespaceRetryPoliciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_namespace_retry_policies_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNamespaceRetryPoliciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_ingress_namespace_retry_policies_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_pkg_ingress_namespace_retry_policies_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_pkg_ingress_namespace_retry_policies_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_ingress_namespace_retry_policies_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_ingress_namespace_retry_policies_proto_goTypes,
+		DependencyIndexes: file_pkg_ingress_namespace_retry_policies_proto_depIdxs,
+		MessageInfos:      file_pkg_ingress_namespace_retry_policies_proto_msgTypes,
+	}.Build()
+	File_pkg_ingress_namespace_retry_policies_proto = out.File
+	file_pkg_ingress_namespace_retry_policies_proto_rawDesc = nil
+	file_pkg_ingress_namespace_retry_policies_proto_goTypes = nil
+	file_pkg_ingress_namespace_retry_policies_proto_depIdxs = nil
+}
@@ -29,9 +29,14 @@ type DirektivIngressClient interface {
 	GetWorkflowInstance(ctx context.Context, in *GetWorkflowInstanceRequest, opts ...grpc.CallOption) (*GetWorkflowInstanceResponse, error)
 	GetWorkflowInstances(ctx context.Context, in *GetWorkflowInstancesRequest, opts ...grpc.CallOption) (*GetWorkflowInstancesResponse, error)
 	GetNamespaceLogs(ctx context.Context, in *GetNamespaceLogsRequest, opts ...grpc.CallOption) (*GetNamespaceLogsResponse, error)
+	GetNamespaceActions(ctx context.Context, in *GetNamespaceActionsRequest, opts ...grpc.CallOption) (*GetNamespaceActionsResponse, error)
 	GetInstancesByWorkflow(ctx context.Context, in *GetInstancesByWorkflowRequest, opts ...grpc.CallOption) (*GetInstancesByWorkflowResponse, error)
 	GetWorkflowInstanceLogs(ctx context.Context, in *GetWorkflowInstanceLogsRequest, opts ...grpc.CallOption) (*GetWorkflowInstanceLogsResponse, error)
 	CancelWorkflowInstance(ctx context.Context, in *CancelWorkflowInstanceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ReportActionCallback(ctx context.Context, in *ReportActionCallbackRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	QueryWorkflowInstance(ctx context.Context, in *QueryWorkflowInstanceRequest, opts ...grpc.CallOption) (*QueryWorkflowInstanceResponse, error)
+	ExportWorkflowInstance(ctx context.Context, in *ExportWorkflowInstanceRequest, opts ...grpc.CallOption) (*ExportWorkflowInstanceResponse, error)
+	ImportWorkflowInstance(ctx context.Context, in *ImportWorkflowInstanceRequest, opts ...grpc.CallOption) (*ImportWorkflowInstanceResponse, error)
 	GetWorkflows(ctx context.Context, in *GetWorkflowsRequest, opts ...grpc.CallOption) (*GetWorkflowsResponse, error)
 	InvokeWorkflow(ctx context.Context, in *InvokeWorkflowRequest, opts ...grpc.CallOption) (*InvokeWorkflowResponse, error)
 	UpdateWorkflow(ctx context.Context, in *UpdateWorkflowRequest, opts ...grpc.CallOption) (*UpdateWorkflowResponse, error)
@@ -49,6 +54,51 @@ type DirektivIngressClient interface {
 	GetWorkflowVariable(ctx context.Context, in *GetWorkflowVariableRequest, opts ...grpc.CallOption) (DirektivIngress_GetWorkflowVariableClient, error)
 	SetNamespaceVariable(ctx context.Context, opts ...grpc.CallOption) (DirektivIngress_SetNamespaceVariableClient, error)
 	SetWorkflowVariable(ctx context.Context, opts ...grpc.CallOption) (DirektivIngress_SetWorkflowVariableClient, error)
+	GetNodes(ctx context.Context, in *GetNodesRequest, opts ...grpc.CallOption) (*GetNodesResponse, error)
+	GetNodeInstances(ctx context.Context, in *GetNodeInstancesRequest, opts ...grpc.CallOption) (*GetNodeInstancesResponse, error)
+	PromoteNode(ctx context.Context, in *PromoteNodeRequest, opts ...grpc.CallOption) (*PromoteNodeResponse, error)
+	GetWorkflowInstanceHistory(ctx context.Context, in *GetWorkflowInstanceHistoryRequest, opts ...grpc.CallOption) (*GetWorkflowInstanceHistoryResponse, error)
+	SetWorkflowInstanceBreakpoints(ctx context.Context, in *SetWorkflowInstanceBreakpointsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ResumeWorkflowInstance(ctx context.Context, in *ResumeWorkflowInstanceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	TraceEvent(ctx context.Context, in *TraceEventRequest, opts ...grpc.CallOption) (*TraceEventResponse, error)
+	ExportInstances(ctx context.Context, in *ExportInstancesRequest, opts ...grpc.CallOption) (DirektivIngress_ExportInstancesClient, error)
+	PurgeInstances(ctx context.Context, in *PurgeInstancesRequest, opts ...grpc.CallOption) (*PurgeInstancesResponse, error)
+	SetLegalHold(ctx context.Context, in *SetLegalHoldRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	SetNamespaceKey(ctx context.Context, in *SetNamespaceKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetNamespaceKey(ctx context.Context, in *GetNamespaceKeyRequest, opts ...grpc.CallOption) (*GetNamespaceKeyResponse, error)
+	GetDeadlineUsage(ctx context.Context, in *GetDeadlineUsageRequest, opts ...grpc.CallOption) (*GetDeadlineUsageResponse, error)
+	GetEventStats(ctx context.Context, in *GetEventStatsRequest, opts ...grpc.CallOption) (*GetEventStatsResponse, error)
+	SetWorkflowInstanceDebug(ctx context.Context, in *SetWorkflowInstanceDebugRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ForceWorkflowInstanceTransition(ctx context.Context, in *ForceWorkflowInstanceTransitionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	PatchWorkflowInstanceData(ctx context.Context, in *PatchWorkflowInstanceDataRequest, opts ...grpc.CallOption) (*PatchWorkflowInstanceDataResponse, error)
+	BreakWorkflowInstanceLock(ctx context.Context, in *BreakWorkflowInstanceLockRequest, opts ...grpc.CallOption) (*BreakWorkflowInstanceLockResponse, error)
+	GetNamespaceEventConfig(ctx context.Context, in *GetNamespaceEventConfigRequest, opts ...grpc.CallOption) (*GetNamespaceEventConfigResponse, error)
+	SetNamespaceEventConfig(ctx context.Context, in *SetNamespaceEventConfigRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	SetCronSuspension(ctx context.Context, in *SetCronSuspensionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	SetInvocationHalt(ctx context.Context, in *SetInvocationHaltRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetInvocationHalt(ctx context.Context, in *GetInvocationHaltRequest, opts ...grpc.CallOption) (*GetInvocationHaltResponse, error)
+	ScheduleInvocation(ctx context.Context, in *ScheduleInvocationRequest, opts ...grpc.CallOption) (*ScheduleInvocationResponse, error)
+	GetScheduledInvocations(ctx context.Context, in *GetScheduledInvocationsRequest, opts ...grpc.CallOption) (*GetScheduledInvocationsResponse, error)
+	CancelScheduledInvocation(ctx context.Context, in *CancelScheduledInvocationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetNamespaceConstants(ctx context.Context, in *GetNamespaceConstantsRequest, opts ...grpc.CallOption) (*GetNamespaceConstantsResponse, error)
+	SetNamespaceConstants(ctx context.Context, in *SetNamespaceConstantsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetNamespaceRetryPolicies(ctx context.Context, in *GetNamespaceRetryPoliciesRequest, opts ...grpc.CallOption) (*GetNamespaceRetryPoliciesResponse, error)
+	SetNamespaceRetryPolicies(ctx context.Context, in *SetNamespaceRetryPoliciesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetNamespaceProfiles(ctx context.Context, in *GetNamespaceProfilesRequest, opts ...grpc.CallOption) (*GetNamespaceProfilesResponse, error)
+	SetNamespaceProfile(ctx context.Context, in *SetNamespaceProfileRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	SetNamespaceActiveProfile(ctx context.Context, in *SetNamespaceActiveProfileRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetJQModules(ctx context.Context, in *GetJQModulesRequest, opts ...grpc.CallOption) (*GetJQModulesResponse, error)
+	GetJQModule(ctx context.Context, in *GetJQModuleRequest, opts ...grpc.CallOption) (*GetJQModuleResponse, error)
+	SetJQModule(ctx context.Context, in *SetJQModuleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteJQModule(ctx context.Context, in *DeleteJQModuleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetWasmModules(ctx context.Context, in *GetWasmModulesRequest, opts ...grpc.CallOption) (*GetWasmModulesResponse, error)
+	GetWasmModule(ctx context.Context, in *GetWasmModuleRequest, opts ...grpc.CallOption) (*GetWasmModuleResponse, error)
+	SetWasmModule(ctx context.Context, in *SetWasmModuleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteWasmModule(ctx context.Context, in *DeleteWasmModuleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetJSONSchemas(ctx context.Context, in *GetJSONSchemasRequest, opts ...grpc.CallOption) (*GetJSONSchemasResponse, error)
+	GetJSONSchema(ctx context.Context, in *GetJSONSchemaRequest, opts ...grpc.CallOption) (*GetJSONSchemaResponse, error)
+	AddJSONSchema(ctx context.Context, in *AddJSONSchemaRequest, opts ...grpc.CallOption) (*AddJSONSchemaResponse, error)
+	DeleteJSONSchema(ctx context.Context, in *DeleteJSONSchemaRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type direktivIngressClient struct {
@@ -149,6 +199,15 @@ func (c *direktivIngressClient) GetNamespaceLogs(ctx context.Context, in *GetNam
 	return out, nil
 }
 
+func (c *direktivIngressClient) GetNamespaceActions(ctx context.Context, in *GetNamespaceActionsRequest, opts ...grpc.CallOption) (*GetNamespaceActionsResponse, error) {
+	out := new(GetNamespaceActionsResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetNamespaceActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *direktivIngressClient) GetInstancesByWorkflow(ctx context.Context, in *GetInstancesByWorkflowRequest, opts ...grpc.CallOption) (*GetInstancesByWorkflowResponse, error) {
 	out := new(GetInstancesByWorkflowResponse)
 	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetInstancesByWorkflow", in, out, opts...)
@@ -176,6 +235,42 @@ func (c *direktivIngressClient) CancelWorkflowInstance(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *direktivIngressClient) ReportActionCallback(ctx context.Context, in *ReportActionCallbackRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/ReportActionCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) QueryWorkflowInstance(ctx context.Context, in *QueryWorkflowInstanceRequest, opts ...grpc.CallOption) (*QueryWorkflowInstanceResponse, error) {
+	out := new(QueryWorkflowInstanceResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/QueryWorkflowInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) ExportWorkflowInstance(ctx context.Context, in *ExportWorkflowInstanceRequest, opts ...grpc.CallOption) (*ExportWorkflowInstanceResponse, error) {
+	out := new(ExportWorkflowInstanceResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/ExportWorkflowInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) ImportWorkflowInstance(ctx context.Context, in *ImportWorkflowInstanceRequest, opts ...grpc.CallOption) (*ImportWorkflowInstanceResponse, error) {
+	out := new(ImportWorkflowInstanceResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/ImportWorkflowInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *direktivIngressClient) GetWorkflows(ctx context.Context, in *GetWorkflowsRequest, opts ...grpc.CallOption) (*GetWorkflowsResponse, error) {
 	out := new(GetWorkflowsResponse)
 	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetWorkflows", in, out, opts...)
@@ -425,710 +520,2241 @@ func (x *direktivIngressSetWorkflowVariableClient) CloseAndRecv() (*empty.Empty,
 	return m, nil
 }
 
-// DirektivIngressServer is the server API for DirektivIngress service.
-// All implementations must embed UnimplementedDirektivIngressServer
-// for forward compatibility
-type DirektivIngressServer interface {
-	AddNamespace(context.Context, *AddNamespaceRequest) (*AddNamespaceResponse, error)
-	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error)
-	GetNamespaces(context.Context, *GetNamespacesRequest) (*GetNamespacesResponse, error)
-	AddWorkflow(context.Context, *AddWorkflowRequest) (*AddWorkflowResponse, error)
-	DeleteWorkflow(context.Context, *DeleteWorkflowRequest) (*DeleteWorkflowResponse, error)
-	GetWorkflowByName(context.Context, *GetWorkflowByNameRequest) (*GetWorkflowByNameResponse, error)
-	GetWorkflowByUid(context.Context, *GetWorkflowByUidRequest) (*GetWorkflowByUidResponse, error)
-	GetWorkflowInstance(context.Context, *GetWorkflowInstanceRequest) (*GetWorkflowInstanceResponse, error)
-	GetWorkflowInstances(context.Context, *GetWorkflowInstancesRequest) (*GetWorkflowInstancesResponse, error)
-	GetNamespaceLogs(context.Context, *GetNamespaceLogsRequest) (*GetNamespaceLogsResponse, error)
-	GetInstancesByWorkflow(context.Context, *GetInstancesByWorkflowRequest) (*GetInstancesByWorkflowResponse, error)
-	GetWorkflowInstanceLogs(context.Context, *GetWorkflowInstanceLogsRequest) (*GetWorkflowInstanceLogsResponse, error)
-	CancelWorkflowInstance(context.Context, *CancelWorkflowInstanceRequest) (*empty.Empty, error)
-	GetWorkflows(context.Context, *GetWorkflowsRequest) (*GetWorkflowsResponse, error)
-	InvokeWorkflow(context.Context, *InvokeWorkflowRequest) (*InvokeWorkflowResponse, error)
-	UpdateWorkflow(context.Context, *UpdateWorkflowRequest) (*UpdateWorkflowResponse, error)
-	BroadcastEvent(context.Context, *BroadcastEventRequest) (*empty.Empty, error)
-	GetSecrets(context.Context, *GetSecretsRequest) (*GetSecretsResponse, error)
-	DeleteSecret(context.Context, *DeleteSecretRequest) (*empty.Empty, error)
-	StoreSecret(context.Context, *StoreSecretRequest) (*empty.Empty, error)
-	GetRegistries(context.Context, *GetRegistriesRequest) (*GetRegistriesResponse, error)
-	DeleteRegistry(context.Context, *DeleteRegistryRequest) (*empty.Empty, error)
-	StoreRegistry(context.Context, *StoreRegistryRequest) (*empty.Empty, error)
-	WorkflowMetrics(context.Context, *WorkflowMetricsRequest) (*WorkflowMetricsResponse, error)
-	ListNamespaceVariables(context.Context, *ListNamespaceVariablesRequest) (*ListNamespaceVariablesResponse, error)
-	ListWorkflowVariables(context.Context, *ListWorkflowVariablesRequest) (*ListWorkflowVariablesResponse, error)
-	GetNamespaceVariable(*GetNamespaceVariableRequest, DirektivIngress_GetNamespaceVariableServer) error
-	GetWorkflowVariable(*GetWorkflowVariableRequest, DirektivIngress_GetWorkflowVariableServer) error
-	SetNamespaceVariable(DirektivIngress_SetNamespaceVariableServer) error
-	SetWorkflowVariable(DirektivIngress_SetWorkflowVariableServer) error
-	mustEmbedUnimplementedDirektivIngressServer()
+func (c *direktivIngressClient) GetNodes(ctx context.Context, in *GetNodesRequest, opts ...grpc.CallOption) (*GetNodesResponse, error) {
+	out := new(GetNodesResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedDirektivIngressServer must be embedded to have forward compatible implementations.
-type UnimplementedDirektivIngressServer struct {
+func (c *direktivIngressClient) GetNodeInstances(ctx context.Context, in *GetNodeInstancesRequest, opts ...grpc.CallOption) (*GetNodeInstancesResponse, error) {
+	out := new(GetNodeInstancesResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetNodeInstances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedDirektivIngressServer) AddNamespace(context.Context, *AddNamespaceRequest) (*AddNamespaceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddNamespace not implemented")
-}
-func (UnimplementedDirektivIngressServer) DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespace not implemented")
-}
-func (UnimplementedDirektivIngressServer) GetNamespaces(context.Context, *GetNamespacesRequest) (*GetNamespacesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaces not implemented")
-}
-func (UnimplementedDirektivIngressServer) AddWorkflow(context.Context, *AddWorkflowRequest) (*AddWorkflowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddWorkflow not implemented")
-}
-func (UnimplementedDirektivIngressServer) DeleteWorkflow(context.Context, *DeleteWorkflowRequest) (*DeleteWorkflowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflow not implemented")
-}
-func (UnimplementedDirektivIngressServer) GetWorkflowByName(context.Context, *GetWorkflowByNameRequest) (*GetWorkflowByNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowByName not implemented")
-}
-func (UnimplementedDirektivIngressServer) GetWorkflowByUid(context.Context, *GetWorkflowByUidRequest) (*GetWorkflowByUidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowByUid not implemented")
-}
-func (UnimplementedDirektivIngressServer) GetWorkflowInstance(context.Context, *GetWorkflowInstanceRequest) (*GetWorkflowInstanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowInstance not implemented")
-}
-func (UnimplementedDirektivIngressServer) GetWorkflowInstances(context.Context, *GetWorkflowInstancesRequest) (*GetWorkflowInstancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowInstances not implemented")
-}
-func (UnimplementedDirektivIngressServer) GetNamespaceLogs(context.Context, *GetNamespaceLogsRequest) (*GetNamespaceLogsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceLogs not implemented")
-}
-func (UnimplementedDirektivIngressServer) GetInstancesByWorkflow(context.Context, *GetInstancesByWorkflowRequest) (*GetInstancesByWorkflowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInstancesByWorkflow not implemented")
-}
-func (UnimplementedDirektivIngressServer) GetWorkflowInstanceLogs(context.Context, *GetWorkflowInstanceLogsRequest) (*GetWorkflowInstanceLogsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowInstanceLogs not implemented")
-}
-func (UnimplementedDirektivIngressServer) CancelWorkflowInstance(context.Context, *CancelWorkflowInstanceRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelWorkflowInstance not implemented")
-}
-func (UnimplementedDirektivIngressServer) GetWorkflows(context.Context, *GetWorkflowsRequest) (*GetWorkflowsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflows not implemented")
-}
-func (UnimplementedDirektivIngressServer) InvokeWorkflow(context.Context, *InvokeWorkflowRequest) (*InvokeWorkflowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InvokeWorkflow not implemented")
-}
-func (UnimplementedDirektivIngressServer) UpdateWorkflow(context.Context, *UpdateWorkflowRequest) (*UpdateWorkflowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflow not implemented")
-}
-func (UnimplementedDirektivIngressServer) BroadcastEvent(context.Context, *BroadcastEventRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BroadcastEvent not implemented")
-}
-func (UnimplementedDirektivIngressServer) GetSecrets(context.Context, *GetSecretsRequest) (*GetSecretsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSecrets not implemented")
-}
-func (UnimplementedDirektivIngressServer) DeleteSecret(context.Context, *DeleteSecretRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
-}
-func (UnimplementedDirektivIngressServer) StoreSecret(context.Context, *StoreSecretRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StoreSecret not implemented")
-}
-func (UnimplementedDirektivIngressServer) GetRegistries(context.Context, *GetRegistriesRequest) (*GetRegistriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRegistries not implemented")
-}
-func (UnimplementedDirektivIngressServer) DeleteRegistry(context.Context, *DeleteRegistryRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRegistry not implemented")
-}
-func (UnimplementedDirektivIngressServer) StoreRegistry(context.Context, *StoreRegistryRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StoreRegistry not implemented")
-}
-func (UnimplementedDirektivIngressServer) WorkflowMetrics(context.Context, *WorkflowMetricsRequest) (*WorkflowMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WorkflowMetrics not implemented")
-}
-func (UnimplementedDirektivIngressServer) ListNamespaceVariables(context.Context, *ListNamespaceVariablesRequest) (*ListNamespaceVariablesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaceVariables not implemented")
-}
-func (UnimplementedDirektivIngressServer) ListWorkflowVariables(context.Context, *ListWorkflowVariablesRequest) (*ListWorkflowVariablesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowVariables not implemented")
-}
-func (UnimplementedDirektivIngressServer) GetNamespaceVariable(*GetNamespaceVariableRequest, DirektivIngress_GetNamespaceVariableServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetNamespaceVariable not implemented")
-}
-func (UnimplementedDirektivIngressServer) GetWorkflowVariable(*GetWorkflowVariableRequest, DirektivIngress_GetWorkflowVariableServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetWorkflowVariable not implemented")
-}
-func (UnimplementedDirektivIngressServer) SetNamespaceVariable(DirektivIngress_SetNamespaceVariableServer) error {
-	return status.Errorf(codes.Unimplemented, "method SetNamespaceVariable not implemented")
-}
-func (UnimplementedDirektivIngressServer) SetWorkflowVariable(DirektivIngress_SetWorkflowVariableServer) error {
-	return status.Errorf(codes.Unimplemented, "method SetWorkflowVariable not implemented")
+func (c *direktivIngressClient) PromoteNode(ctx context.Context, in *PromoteNodeRequest, opts ...grpc.CallOption) (*PromoteNodeResponse, error) {
+	out := new(PromoteNodeResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/PromoteNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDirektivIngressServer) mustEmbedUnimplementedDirektivIngressServer() {}
 
-// UnsafeDirektivIngressServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to DirektivIngressServer will
-// result in compilation errors.
-type UnsafeDirektivIngressServer interface {
-	mustEmbedUnimplementedDirektivIngressServer()
+func (c *direktivIngressClient) GetWorkflowInstanceHistory(ctx context.Context, in *GetWorkflowInstanceHistoryRequest, opts ...grpc.CallOption) (*GetWorkflowInstanceHistoryResponse, error) {
+	out := new(GetWorkflowInstanceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetWorkflowInstanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterDirektivIngressServer(s grpc.ServiceRegistrar, srv DirektivIngressServer) {
-	s.RegisterService(&DirektivIngress_ServiceDesc, srv)
+func (c *direktivIngressClient) SetWorkflowInstanceBreakpoints(ctx context.Context, in *SetWorkflowInstanceBreakpointsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/SetWorkflowInstanceBreakpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _DirektivIngress_AddNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddNamespaceRequest)
-	if err := dec(in); err != nil {
+func (c *direktivIngressClient) ResumeWorkflowInstance(ctx context.Context, in *ResumeWorkflowInstanceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/ResumeWorkflowInstance", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(DirektivIngressServer).AddNamespace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/AddNamespace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).AddNamespace(ctx, req.(*AddNamespaceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _DirektivIngress_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteNamespaceRequest)
-	if err := dec(in); err != nil {
+func (c *direktivIngressClient) TraceEvent(ctx context.Context, in *TraceEventRequest, opts ...grpc.CallOption) (*TraceEventResponse, error) {
+	out := new(TraceEventResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/TraceEvent", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(DirektivIngressServer).DeleteNamespace(ctx, in)
+	return out, nil
+}
+
+func (c *direktivIngressClient) ExportInstances(ctx context.Context, in *ExportInstancesRequest, opts ...grpc.CallOption) (DirektivIngress_ExportInstancesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DirektivIngress_ServiceDesc.Streams[4], "/ingress.DirektivIngress/ExportInstances", opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/DeleteNamespace",
+	x := &direktivIngressExportInstancesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _DirektivIngress_GetNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNamespacesRequest)
-	if err := dec(in); err != nil {
+type DirektivIngress_ExportInstancesClient interface {
+	Recv() (*ExportInstancesResponse, error)
+	grpc.ClientStream
+}
+
+type direktivIngressExportInstancesClient struct {
+	grpc.ClientStream
+}
+
+func (x *direktivIngressExportInstancesClient) Recv() (*ExportInstancesResponse, error) {
+	m := new(ExportInstancesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(DirektivIngressServer).GetNamespaces(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/GetNamespaces",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).GetNamespaces(ctx, req.(*GetNamespacesRequest))
+	return m, nil
+}
+
+func (c *direktivIngressClient) PurgeInstances(ctx context.Context, in *PurgeInstancesRequest, opts ...grpc.CallOption) (*PurgeInstancesResponse, error) {
+	out := new(PurgeInstancesResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/PurgeInstances", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _DirektivIngress_AddWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddWorkflowRequest)
-	if err := dec(in); err != nil {
+func (c *direktivIngressClient) SetLegalHold(ctx context.Context, in *SetLegalHoldRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/SetLegalHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) SetNamespaceKey(ctx context.Context, in *SetNamespaceKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/SetNamespaceKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) GetNamespaceKey(ctx context.Context, in *GetNamespaceKeyRequest, opts ...grpc.CallOption) (*GetNamespaceKeyResponse, error) {
+	out := new(GetNamespaceKeyResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetNamespaceKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) GetDeadlineUsage(ctx context.Context, in *GetDeadlineUsageRequest, opts ...grpc.CallOption) (*GetDeadlineUsageResponse, error) {
+	out := new(GetDeadlineUsageResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetDeadlineUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) GetEventStats(ctx context.Context, in *GetEventStatsRequest, opts ...grpc.CallOption) (*GetEventStatsResponse, error) {
+	out := new(GetEventStatsResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetEventStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) SetWorkflowInstanceDebug(ctx context.Context, in *SetWorkflowInstanceDebugRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/SetWorkflowInstanceDebug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) ForceWorkflowInstanceTransition(ctx context.Context, in *ForceWorkflowInstanceTransitionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/ForceWorkflowInstanceTransition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) PatchWorkflowInstanceData(ctx context.Context, in *PatchWorkflowInstanceDataRequest, opts ...grpc.CallOption) (*PatchWorkflowInstanceDataResponse, error) {
+	out := new(PatchWorkflowInstanceDataResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/PatchWorkflowInstanceData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) BreakWorkflowInstanceLock(ctx context.Context, in *BreakWorkflowInstanceLockRequest, opts ...grpc.CallOption) (*BreakWorkflowInstanceLockResponse, error) {
+	out := new(BreakWorkflowInstanceLockResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/BreakWorkflowInstanceLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) GetNamespaceEventConfig(ctx context.Context, in *GetNamespaceEventConfigRequest, opts ...grpc.CallOption) (*GetNamespaceEventConfigResponse, error) {
+	out := new(GetNamespaceEventConfigResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetNamespaceEventConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) SetNamespaceEventConfig(ctx context.Context, in *SetNamespaceEventConfigRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/SetNamespaceEventConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) SetCronSuspension(ctx context.Context, in *SetCronSuspensionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/SetCronSuspension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) SetInvocationHalt(ctx context.Context, in *SetInvocationHaltRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/SetInvocationHalt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) GetInvocationHalt(ctx context.Context, in *GetInvocationHaltRequest, opts ...grpc.CallOption) (*GetInvocationHaltResponse, error) {
+	out := new(GetInvocationHaltResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetInvocationHalt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) ScheduleInvocation(ctx context.Context, in *ScheduleInvocationRequest, opts ...grpc.CallOption) (*ScheduleInvocationResponse, error) {
+	out := new(ScheduleInvocationResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/ScheduleInvocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) GetScheduledInvocations(ctx context.Context, in *GetScheduledInvocationsRequest, opts ...grpc.CallOption) (*GetScheduledInvocationsResponse, error) {
+	out := new(GetScheduledInvocationsResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetScheduledInvocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) CancelScheduledInvocation(ctx context.Context, in *CancelScheduledInvocationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/CancelScheduledInvocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) GetNamespaceConstants(ctx context.Context, in *GetNamespaceConstantsRequest, opts ...grpc.CallOption) (*GetNamespaceConstantsResponse, error) {
+	out := new(GetNamespaceConstantsResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetNamespaceConstants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) SetNamespaceConstants(ctx context.Context, in *SetNamespaceConstantsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/SetNamespaceConstants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) GetNamespaceRetryPolicies(ctx context.Context, in *GetNamespaceRetryPoliciesRequest, opts ...grpc.CallOption) (*GetNamespaceRetryPoliciesResponse, error) {
+	out := new(GetNamespaceRetryPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetNamespaceRetryPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) SetNamespaceRetryPolicies(ctx context.Context, in *SetNamespaceRetryPoliciesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/SetNamespaceRetryPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) GetNamespaceProfiles(ctx context.Context, in *GetNamespaceProfilesRequest, opts ...grpc.CallOption) (*GetNamespaceProfilesResponse, error) {
+	out := new(GetNamespaceProfilesResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetNamespaceProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) SetNamespaceProfile(ctx context.Context, in *SetNamespaceProfileRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/SetNamespaceProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) SetNamespaceActiveProfile(ctx context.Context, in *SetNamespaceActiveProfileRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/SetNamespaceActiveProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) GetJQModules(ctx context.Context, in *GetJQModulesRequest, opts ...grpc.CallOption) (*GetJQModulesResponse, error) {
+	out := new(GetJQModulesResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetJQModules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) GetJQModule(ctx context.Context, in *GetJQModuleRequest, opts ...grpc.CallOption) (*GetJQModuleResponse, error) {
+	out := new(GetJQModuleResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetJQModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) SetJQModule(ctx context.Context, in *SetJQModuleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/SetJQModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) DeleteJQModule(ctx context.Context, in *DeleteJQModuleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/DeleteJQModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) GetWasmModules(ctx context.Context, in *GetWasmModulesRequest, opts ...grpc.CallOption) (*GetWasmModulesResponse, error) {
+	out := new(GetWasmModulesResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetWasmModules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) GetWasmModule(ctx context.Context, in *GetWasmModuleRequest, opts ...grpc.CallOption) (*GetWasmModuleResponse, error) {
+	out := new(GetWasmModuleResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetWasmModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) SetWasmModule(ctx context.Context, in *SetWasmModuleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/SetWasmModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) DeleteWasmModule(ctx context.Context, in *DeleteWasmModuleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/DeleteWasmModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) GetJSONSchemas(ctx context.Context, in *GetJSONSchemasRequest, opts ...grpc.CallOption) (*GetJSONSchemasResponse, error) {
+	out := new(GetJSONSchemasResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetJSONSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) GetJSONSchema(ctx context.Context, in *GetJSONSchemaRequest, opts ...grpc.CallOption) (*GetJSONSchemaResponse, error) {
+	out := new(GetJSONSchemaResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/GetJSONSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) AddJSONSchema(ctx context.Context, in *AddJSONSchemaRequest, opts ...grpc.CallOption) (*AddJSONSchemaResponse, error) {
+	out := new(AddJSONSchemaResponse)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/AddJSONSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *direktivIngressClient) DeleteJSONSchema(ctx context.Context, in *DeleteJSONSchemaRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ingress.DirektivIngress/DeleteJSONSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DirektivIngressServer is the server API for DirektivIngress service.
+// All implementations must embed UnimplementedDirektivIngressServer
+// for forward compatibility
+type DirektivIngressServer interface {
+	AddNamespace(context.Context, *AddNamespaceRequest) (*AddNamespaceResponse, error)
+	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error)
+	GetNamespaces(context.Context, *GetNamespacesRequest) (*GetNamespacesResponse, error)
+	AddWorkflow(context.Context, *AddWorkflowRequest) (*AddWorkflowResponse, error)
+	DeleteWorkflow(context.Context, *DeleteWorkflowRequest) (*DeleteWorkflowResponse, error)
+	GetWorkflowByName(context.Context, *GetWorkflowByNameRequest) (*GetWorkflowByNameResponse, error)
+	GetWorkflowByUid(context.Context, *GetWorkflowByUidRequest) (*GetWorkflowByUidResponse, error)
+	GetWorkflowInstance(context.Context, *GetWorkflowInstanceRequest) (*GetWorkflowInstanceResponse, error)
+	GetWorkflowInstances(context.Context, *GetWorkflowInstancesRequest) (*GetWorkflowInstancesResponse, error)
+	GetNamespaceLogs(context.Context, *GetNamespaceLogsRequest) (*GetNamespaceLogsResponse, error)
+	GetNamespaceActions(context.Context, *GetNamespaceActionsRequest) (*GetNamespaceActionsResponse, error)
+	GetInstancesByWorkflow(context.Context, *GetInstancesByWorkflowRequest) (*GetInstancesByWorkflowResponse, error)
+	GetWorkflowInstanceLogs(context.Context, *GetWorkflowInstanceLogsRequest) (*GetWorkflowInstanceLogsResponse, error)
+	CancelWorkflowInstance(context.Context, *CancelWorkflowInstanceRequest) (*empty.Empty, error)
+	ReportActionCallback(context.Context, *ReportActionCallbackRequest) (*empty.Empty, error)
+	QueryWorkflowInstance(context.Context, *QueryWorkflowInstanceRequest) (*QueryWorkflowInstanceResponse, error)
+	ExportWorkflowInstance(context.Context, *ExportWorkflowInstanceRequest) (*ExportWorkflowInstanceResponse, error)
+	ImportWorkflowInstance(context.Context, *ImportWorkflowInstanceRequest) (*ImportWorkflowInstanceResponse, error)
+	GetWorkflows(context.Context, *GetWorkflowsRequest) (*GetWorkflowsResponse, error)
+	InvokeWorkflow(context.Context, *InvokeWorkflowRequest) (*InvokeWorkflowResponse, error)
+	UpdateWorkflow(context.Context, *UpdateWorkflowRequest) (*UpdateWorkflowResponse, error)
+	BroadcastEvent(context.Context, *BroadcastEventRequest) (*empty.Empty, error)
+	GetSecrets(context.Context, *GetSecretsRequest) (*GetSecretsResponse, error)
+	DeleteSecret(context.Context, *DeleteSecretRequest) (*empty.Empty, error)
+	StoreSecret(context.Context, *StoreSecretRequest) (*empty.Empty, error)
+	GetRegistries(context.Context, *GetRegistriesRequest) (*GetRegistriesResponse, error)
+	DeleteRegistry(context.Context, *DeleteRegistryRequest) (*empty.Empty, error)
+	StoreRegistry(context.Context, *StoreRegistryRequest) (*empty.Empty, error)
+	WorkflowMetrics(context.Context, *WorkflowMetricsRequest) (*WorkflowMetricsResponse, error)
+	ListNamespaceVariables(context.Context, *ListNamespaceVariablesRequest) (*ListNamespaceVariablesResponse, error)
+	ListWorkflowVariables(context.Context, *ListWorkflowVariablesRequest) (*ListWorkflowVariablesResponse, error)
+	GetNamespaceVariable(*GetNamespaceVariableRequest, DirektivIngress_GetNamespaceVariableServer) error
+	GetWorkflowVariable(*GetWorkflowVariableRequest, DirektivIngress_GetWorkflowVariableServer) error
+	SetNamespaceVariable(DirektivIngress_SetNamespaceVariableServer) error
+	SetWorkflowVariable(DirektivIngress_SetWorkflowVariableServer) error
+	GetNodes(context.Context, *GetNodesRequest) (*GetNodesResponse, error)
+	GetNodeInstances(context.Context, *GetNodeInstancesRequest) (*GetNodeInstancesResponse, error)
+	PromoteNode(context.Context, *PromoteNodeRequest) (*PromoteNodeResponse, error)
+	GetWorkflowInstanceHistory(context.Context, *GetWorkflowInstanceHistoryRequest) (*GetWorkflowInstanceHistoryResponse, error)
+	SetWorkflowInstanceBreakpoints(context.Context, *SetWorkflowInstanceBreakpointsRequest) (*empty.Empty, error)
+	ResumeWorkflowInstance(context.Context, *ResumeWorkflowInstanceRequest) (*empty.Empty, error)
+	TraceEvent(context.Context, *TraceEventRequest) (*TraceEventResponse, error)
+	ExportInstances(*ExportInstancesRequest, DirektivIngress_ExportInstancesServer) error
+	PurgeInstances(context.Context, *PurgeInstancesRequest) (*PurgeInstancesResponse, error)
+	SetLegalHold(context.Context, *SetLegalHoldRequest) (*empty.Empty, error)
+	SetNamespaceKey(context.Context, *SetNamespaceKeyRequest) (*empty.Empty, error)
+	GetNamespaceKey(context.Context, *GetNamespaceKeyRequest) (*GetNamespaceKeyResponse, error)
+	GetDeadlineUsage(context.Context, *GetDeadlineUsageRequest) (*GetDeadlineUsageResponse, error)
+	GetEventStats(context.Context, *GetEventStatsRequest) (*GetEventStatsResponse, error)
+	SetWorkflowInstanceDebug(context.Context, *SetWorkflowInstanceDebugRequest) (*empty.Empty, error)
+	ForceWorkflowInstanceTransition(context.Context, *ForceWorkflowInstanceTransitionRequest) (*empty.Empty, error)
+	PatchWorkflowInstanceData(context.Context, *PatchWorkflowInstanceDataRequest) (*PatchWorkflowInstanceDataResponse, error)
+	BreakWorkflowInstanceLock(context.Context, *BreakWorkflowInstanceLockRequest) (*BreakWorkflowInstanceLockResponse, error)
+	GetNamespaceEventConfig(context.Context, *GetNamespaceEventConfigRequest) (*GetNamespaceEventConfigResponse, error)
+	SetNamespaceEventConfig(context.Context, *SetNamespaceEventConfigRequest) (*empty.Empty, error)
+	SetCronSuspension(context.Context, *SetCronSuspensionRequest) (*empty.Empty, error)
+	SetInvocationHalt(context.Context, *SetInvocationHaltRequest) (*empty.Empty, error)
+	GetInvocationHalt(context.Context, *GetInvocationHaltRequest) (*GetInvocationHaltResponse, error)
+	ScheduleInvocation(context.Context, *ScheduleInvocationRequest) (*ScheduleInvocationResponse, error)
+	GetScheduledInvocations(context.Context, *GetScheduledInvocationsRequest) (*GetScheduledInvocationsResponse, error)
+	CancelScheduledInvocation(context.Context, *CancelScheduledInvocationRequest) (*empty.Empty, error)
+	GetNamespaceConstants(context.Context, *GetNamespaceConstantsRequest) (*GetNamespaceConstantsResponse, error)
+	SetNamespaceConstants(context.Context, *SetNamespaceConstantsRequest) (*empty.Empty, error)
+	GetNamespaceRetryPolicies(context.Context, *GetNamespaceRetryPoliciesRequest) (*GetNamespaceRetryPoliciesResponse, error)
+	SetNamespaceRetryPolicies(context.Context, *SetNamespaceRetryPoliciesRequest) (*empty.Empty, error)
+	GetNamespaceProfiles(context.Context, *GetNamespaceProfilesRequest) (*GetNamespaceProfilesResponse, error)
+	SetNamespaceProfile(context.Context, *SetNamespaceProfileRequest) (*empty.Empty, error)
+	SetNamespaceActiveProfile(context.Context, *SetNamespaceActiveProfileRequest) (*empty.Empty, error)
+	GetJQModules(context.Context, *GetJQModulesRequest) (*GetJQModulesResponse, error)
+	GetJQModule(context.Context, *GetJQModuleRequest) (*GetJQModuleResponse, error)
+	SetJQModule(context.Context, *SetJQModuleRequest) (*empty.Empty, error)
+	DeleteJQModule(context.Context, *DeleteJQModuleRequest) (*empty.Empty, error)
+	GetWasmModules(context.Context, *GetWasmModulesRequest) (*GetWasmModulesResponse, error)
+	GetWasmModule(context.Context, *GetWasmModuleRequest) (*GetWasmModuleResponse, error)
+	SetWasmModule(context.Context, *SetWasmModuleRequest) (*empty.Empty, error)
+	DeleteWasmModule(context.Context, *DeleteWasmModuleRequest) (*empty.Empty, error)
+	GetJSONSchemas(context.Context, *GetJSONSchemasRequest) (*GetJSONSchemasResponse, error)
+	GetJSONSchema(context.Context, *GetJSONSchemaRequest) (*GetJSONSchemaResponse, error)
+	AddJSONSchema(context.Context, *AddJSONSchemaRequest) (*AddJSONSchemaResponse, error)
+	DeleteJSONSchema(context.Context, *DeleteJSONSchemaRequest) (*empty.Empty, error)
+	mustEmbedUnimplementedDirektivIngressServer()
+}
+
+// UnimplementedDirektivIngressServer must be embedded to have forward compatible implementations.
+type UnimplementedDirektivIngressServer struct {
+}
+
+func (UnimplementedDirektivIngressServer) AddNamespace(context.Context, *AddNamespaceRequest) (*AddNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNamespace not implemented")
+}
+func (UnimplementedDirektivIngressServer) DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetNamespaces(context.Context, *GetNamespacesRequest) (*GetNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaces not implemented")
+}
+func (UnimplementedDirektivIngressServer) AddWorkflow(context.Context, *AddWorkflowRequest) (*AddWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWorkflow not implemented")
+}
+func (UnimplementedDirektivIngressServer) DeleteWorkflow(context.Context, *DeleteWorkflowRequest) (*DeleteWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflow not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetWorkflowByName(context.Context, *GetWorkflowByNameRequest) (*GetWorkflowByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowByName not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetWorkflowByUid(context.Context, *GetWorkflowByUidRequest) (*GetWorkflowByUidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowByUid not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetWorkflowInstance(context.Context, *GetWorkflowInstanceRequest) (*GetWorkflowInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowInstance not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetWorkflowInstances(context.Context, *GetWorkflowInstancesRequest) (*GetWorkflowInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowInstances not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetNamespaceLogs(context.Context, *GetNamespaceLogsRequest) (*GetNamespaceLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceLogs not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetNamespaceActions(context.Context, *GetNamespaceActionsRequest) (*GetNamespaceActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceActions not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetInstancesByWorkflow(context.Context, *GetInstancesByWorkflowRequest) (*GetInstancesByWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstancesByWorkflow not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetWorkflowInstanceLogs(context.Context, *GetWorkflowInstanceLogsRequest) (*GetWorkflowInstanceLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowInstanceLogs not implemented")
+}
+func (UnimplementedDirektivIngressServer) CancelWorkflowInstance(context.Context, *CancelWorkflowInstanceRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWorkflowInstance not implemented")
+}
+func (UnimplementedDirektivIngressServer) ReportActionCallback(context.Context, *ReportActionCallbackRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportActionCallback not implemented")
+}
+func (UnimplementedDirektivIngressServer) QueryWorkflowInstance(context.Context, *QueryWorkflowInstanceRequest) (*QueryWorkflowInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryWorkflowInstance not implemented")
+}
+func (UnimplementedDirektivIngressServer) ExportWorkflowInstance(context.Context, *ExportWorkflowInstanceRequest) (*ExportWorkflowInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportWorkflowInstance not implemented")
+}
+func (UnimplementedDirektivIngressServer) ImportWorkflowInstance(context.Context, *ImportWorkflowInstanceRequest) (*ImportWorkflowInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportWorkflowInstance not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetWorkflows(context.Context, *GetWorkflowsRequest) (*GetWorkflowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflows not implemented")
+}
+func (UnimplementedDirektivIngressServer) InvokeWorkflow(context.Context, *InvokeWorkflowRequest) (*InvokeWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvokeWorkflow not implemented")
+}
+func (UnimplementedDirektivIngressServer) UpdateWorkflow(context.Context, *UpdateWorkflowRequest) (*UpdateWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflow not implemented")
+}
+func (UnimplementedDirektivIngressServer) BroadcastEvent(context.Context, *BroadcastEventRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastEvent not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetSecrets(context.Context, *GetSecretsRequest) (*GetSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecrets not implemented")
+}
+func (UnimplementedDirektivIngressServer) DeleteSecret(context.Context, *DeleteSecretRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
+}
+func (UnimplementedDirektivIngressServer) StoreSecret(context.Context, *StoreSecretRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreSecret not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetRegistries(context.Context, *GetRegistriesRequest) (*GetRegistriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistries not implemented")
+}
+func (UnimplementedDirektivIngressServer) DeleteRegistry(context.Context, *DeleteRegistryRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRegistry not implemented")
+}
+func (UnimplementedDirektivIngressServer) StoreRegistry(context.Context, *StoreRegistryRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreRegistry not implemented")
+}
+func (UnimplementedDirektivIngressServer) WorkflowMetrics(context.Context, *WorkflowMetricsRequest) (*WorkflowMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowMetrics not implemented")
+}
+func (UnimplementedDirektivIngressServer) ListNamespaceVariables(context.Context, *ListNamespaceVariablesRequest) (*ListNamespaceVariablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaceVariables not implemented")
+}
+func (UnimplementedDirektivIngressServer) ListWorkflowVariables(context.Context, *ListWorkflowVariablesRequest) (*ListWorkflowVariablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowVariables not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetNamespaceVariable(*GetNamespaceVariableRequest, DirektivIngress_GetNamespaceVariableServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetNamespaceVariable not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetWorkflowVariable(*GetWorkflowVariableRequest, DirektivIngress_GetWorkflowVariableServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetWorkflowVariable not implemented")
+}
+func (UnimplementedDirektivIngressServer) SetNamespaceVariable(DirektivIngress_SetNamespaceVariableServer) error {
+	return status.Errorf(codes.Unimplemented, "method SetNamespaceVariable not implemented")
+}
+func (UnimplementedDirektivIngressServer) SetWorkflowVariable(DirektivIngress_SetWorkflowVariableServer) error {
+	return status.Errorf(codes.Unimplemented, "method SetWorkflowVariable not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetNodes(context.Context, *GetNodesRequest) (*GetNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodes not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetNodeInstances(context.Context, *GetNodeInstancesRequest) (*GetNodeInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeInstances not implemented")
+}
+func (UnimplementedDirektivIngressServer) PromoteNode(context.Context, *PromoteNodeRequest) (*PromoteNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteNode not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetWorkflowInstanceHistory(context.Context, *GetWorkflowInstanceHistoryRequest) (*GetWorkflowInstanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowInstanceHistory not implemented")
+}
+func (UnimplementedDirektivIngressServer) SetWorkflowInstanceBreakpoints(context.Context, *SetWorkflowInstanceBreakpointsRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorkflowInstanceBreakpoints not implemented")
+}
+func (UnimplementedDirektivIngressServer) ResumeWorkflowInstance(context.Context, *ResumeWorkflowInstanceRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeWorkflowInstance not implemented")
+}
+func (UnimplementedDirektivIngressServer) TraceEvent(context.Context, *TraceEventRequest) (*TraceEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceEvent not implemented")
+}
+func (UnimplementedDirektivIngressServer) ExportInstances(*ExportInstancesRequest, DirektivIngress_ExportInstancesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportInstances not implemented")
+}
+func (UnimplementedDirektivIngressServer) PurgeInstances(context.Context, *PurgeInstancesRequest) (*PurgeInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeInstances not implemented")
+}
+func (UnimplementedDirektivIngressServer) SetLegalHold(context.Context, *SetLegalHoldRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLegalHold not implemented")
+}
+func (UnimplementedDirektivIngressServer) SetNamespaceKey(context.Context, *SetNamespaceKeyRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceKey not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetNamespaceKey(context.Context, *GetNamespaceKeyRequest) (*GetNamespaceKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceKey not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetDeadlineUsage(context.Context, *GetDeadlineUsageRequest) (*GetDeadlineUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadlineUsage not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetEventStats(context.Context, *GetEventStatsRequest) (*GetEventStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventStats not implemented")
+}
+func (UnimplementedDirektivIngressServer) SetWorkflowInstanceDebug(context.Context, *SetWorkflowInstanceDebugRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorkflowInstanceDebug not implemented")
+}
+func (UnimplementedDirektivIngressServer) ForceWorkflowInstanceTransition(context.Context, *ForceWorkflowInstanceTransitionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceWorkflowInstanceTransition not implemented")
+}
+func (UnimplementedDirektivIngressServer) PatchWorkflowInstanceData(context.Context, *PatchWorkflowInstanceDataRequest) (*PatchWorkflowInstanceDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchWorkflowInstanceData not implemented")
+}
+func (UnimplementedDirektivIngressServer) BreakWorkflowInstanceLock(context.Context, *BreakWorkflowInstanceLockRequest) (*BreakWorkflowInstanceLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BreakWorkflowInstanceLock not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetNamespaceEventConfig(context.Context, *GetNamespaceEventConfigRequest) (*GetNamespaceEventConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceEventConfig not implemented")
+}
+func (UnimplementedDirektivIngressServer) SetNamespaceEventConfig(context.Context, *SetNamespaceEventConfigRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceEventConfig not implemented")
+}
+func (UnimplementedDirektivIngressServer) SetCronSuspension(context.Context, *SetCronSuspensionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCronSuspension not implemented")
+}
+func (UnimplementedDirektivIngressServer) SetInvocationHalt(context.Context, *SetInvocationHaltRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInvocationHalt not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetInvocationHalt(context.Context, *GetInvocationHaltRequest) (*GetInvocationHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvocationHalt not implemented")
+}
+func (UnimplementedDirektivIngressServer) ScheduleInvocation(context.Context, *ScheduleInvocationRequest) (*ScheduleInvocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleInvocation not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetScheduledInvocations(context.Context, *GetScheduledInvocationsRequest) (*GetScheduledInvocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledInvocations not implemented")
+}
+func (UnimplementedDirektivIngressServer) CancelScheduledInvocation(context.Context, *CancelScheduledInvocationRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledInvocation not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetNamespaceConstants(context.Context, *GetNamespaceConstantsRequest) (*GetNamespaceConstantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceConstants not implemented")
+}
+func (UnimplementedDirektivIngressServer) SetNamespaceConstants(context.Context, *SetNamespaceConstantsRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceConstants not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetNamespaceRetryPolicies(context.Context, *GetNamespaceRetryPoliciesRequest) (*GetNamespaceRetryPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceRetryPolicies not implemented")
+}
+func (UnimplementedDirektivIngressServer) SetNamespaceRetryPolicies(context.Context, *SetNamespaceRetryPoliciesRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceRetryPolicies not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetNamespaceProfiles(context.Context, *GetNamespaceProfilesRequest) (*GetNamespaceProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceProfiles not implemented")
+}
+func (UnimplementedDirektivIngressServer) SetNamespaceProfile(context.Context, *SetNamespaceProfileRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceProfile not implemented")
+}
+func (UnimplementedDirektivIngressServer) SetNamespaceActiveProfile(context.Context, *SetNamespaceActiveProfileRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceActiveProfile not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetJQModules(context.Context, *GetJQModulesRequest) (*GetJQModulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJQModules not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetJQModule(context.Context, *GetJQModuleRequest) (*GetJQModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJQModule not implemented")
+}
+func (UnimplementedDirektivIngressServer) SetJQModule(context.Context, *SetJQModuleRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetJQModule not implemented")
+}
+func (UnimplementedDirektivIngressServer) DeleteJQModule(context.Context, *DeleteJQModuleRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJQModule not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetWasmModules(context.Context, *GetWasmModulesRequest) (*GetWasmModulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWasmModules not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetWasmModule(context.Context, *GetWasmModuleRequest) (*GetWasmModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWasmModule not implemented")
+}
+func (UnimplementedDirektivIngressServer) SetWasmModule(context.Context, *SetWasmModuleRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWasmModule not implemented")
+}
+func (UnimplementedDirektivIngressServer) DeleteWasmModule(context.Context, *DeleteWasmModuleRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWasmModule not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetJSONSchemas(context.Context, *GetJSONSchemasRequest) (*GetJSONSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJSONSchemas not implemented")
+}
+func (UnimplementedDirektivIngressServer) GetJSONSchema(context.Context, *GetJSONSchemaRequest) (*GetJSONSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJSONSchema not implemented")
+}
+func (UnimplementedDirektivIngressServer) AddJSONSchema(context.Context, *AddJSONSchemaRequest) (*AddJSONSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddJSONSchema not implemented")
+}
+func (UnimplementedDirektivIngressServer) DeleteJSONSchema(context.Context, *DeleteJSONSchemaRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJSONSchema not implemented")
+}
+func (UnimplementedDirektivIngressServer) mustEmbedUnimplementedDirektivIngressServer() {}
+
+// UnsafeDirektivIngressServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DirektivIngressServer will
+// result in compilation errors.
+type UnsafeDirektivIngressServer interface {
+	mustEmbedUnimplementedDirektivIngressServer()
+}
+
+func RegisterDirektivIngressServer(s grpc.ServiceRegistrar, srv DirektivIngressServer) {
+	s.RegisterService(&DirektivIngress_ServiceDesc, srv)
+}
+
+func _DirektivIngress_AddNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).AddNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/AddNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).AddNamespace(ctx, req.(*AddNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).DeleteNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/DeleteNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetNamespaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetNamespaces(ctx, req.(*GetNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_AddWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).AddWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/AddWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).AddWorkflow(ctx, req.(*AddWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_DeleteWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).DeleteWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/DeleteWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).DeleteWorkflow(ctx, req.(*DeleteWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetWorkflowByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetWorkflowByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetWorkflowByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetWorkflowByName(ctx, req.(*GetWorkflowByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetWorkflowByUid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowByUidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetWorkflowByUid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetWorkflowByUid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetWorkflowByUid(ctx, req.(*GetWorkflowByUidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetWorkflowInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetWorkflowInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetWorkflowInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetWorkflowInstance(ctx, req.(*GetWorkflowInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetWorkflowInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetWorkflowInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetWorkflowInstances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetWorkflowInstances(ctx, req.(*GetWorkflowInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetNamespaceLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetNamespaceLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetNamespaceLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetNamespaceLogs(ctx, req.(*GetNamespaceLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetNamespaceActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetNamespaceActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetNamespaceActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetNamespaceActions(ctx, req.(*GetNamespaceActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetInstancesByWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstancesByWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetInstancesByWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetInstancesByWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetInstancesByWorkflow(ctx, req.(*GetInstancesByWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetWorkflowInstanceLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowInstanceLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetWorkflowInstanceLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetWorkflowInstanceLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetWorkflowInstanceLogs(ctx, req.(*GetWorkflowInstanceLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_CancelWorkflowInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelWorkflowInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).CancelWorkflowInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/CancelWorkflowInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).CancelWorkflowInstance(ctx, req.(*CancelWorkflowInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_ReportActionCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportActionCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).ReportActionCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/ReportActionCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).ReportActionCallback(ctx, req.(*ReportActionCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_QueryWorkflowInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkflowInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).QueryWorkflowInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/QueryWorkflowInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).QueryWorkflowInstance(ctx, req.(*QueryWorkflowInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_ExportWorkflowInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWorkflowInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).ExportWorkflowInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/ExportWorkflowInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).ExportWorkflowInstance(ctx, req.(*ExportWorkflowInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_ImportWorkflowInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWorkflowInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).ImportWorkflowInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/ImportWorkflowInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).ImportWorkflowInstance(ctx, req.(*ImportWorkflowInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetWorkflows(ctx, req.(*GetWorkflowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_InvokeWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvokeWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).InvokeWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/InvokeWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).InvokeWorkflow(ctx, req.(*InvokeWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_UpdateWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).UpdateWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/UpdateWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).UpdateWorkflow(ctx, req.(*UpdateWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_BroadcastEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).BroadcastEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/BroadcastEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).BroadcastEvent(ctx, req.(*BroadcastEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetSecrets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetSecrets(ctx, req.(*GetSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).DeleteSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/DeleteSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).DeleteSecret(ctx, req.(*DeleteSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_StoreSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).StoreSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/StoreSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).StoreSecret(ctx, req.(*StoreSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetRegistries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegistriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetRegistries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetRegistries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetRegistries(ctx, req.(*GetRegistriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_DeleteRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRegistryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).DeleteRegistry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/DeleteRegistry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).DeleteRegistry(ctx, req.(*DeleteRegistryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_StoreRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreRegistryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).StoreRegistry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/StoreRegistry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).StoreRegistry(ctx, req.(*StoreRegistryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_WorkflowMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).WorkflowMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/WorkflowMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).WorkflowMetrics(ctx, req.(*WorkflowMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_ListNamespaceVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespaceVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).ListNamespaceVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/ListNamespaceVariables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).ListNamespaceVariables(ctx, req.(*ListNamespaceVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_ListWorkflowVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkflowVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).ListWorkflowVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/ListWorkflowVariables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).ListWorkflowVariables(ctx, req.(*ListWorkflowVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetNamespaceVariable_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetNamespaceVariableRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DirektivIngressServer).GetNamespaceVariable(m, &direktivIngressGetNamespaceVariableServer{stream})
+}
+
+type DirektivIngress_GetNamespaceVariableServer interface {
+	Send(*GetNamespaceVariableResponse) error
+	grpc.ServerStream
+}
+
+type direktivIngressGetNamespaceVariableServer struct {
+	grpc.ServerStream
+}
+
+func (x *direktivIngressGetNamespaceVariableServer) Send(m *GetNamespaceVariableResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DirektivIngress_GetWorkflowVariable_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetWorkflowVariableRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DirektivIngressServer).GetWorkflowVariable(m, &direktivIngressGetWorkflowVariableServer{stream})
+}
+
+type DirektivIngress_GetWorkflowVariableServer interface {
+	Send(*GetWorkflowVariableResponse) error
+	grpc.ServerStream
+}
+
+type direktivIngressGetWorkflowVariableServer struct {
+	grpc.ServerStream
+}
+
+func (x *direktivIngressGetWorkflowVariableServer) Send(m *GetWorkflowVariableResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DirektivIngress_SetNamespaceVariable_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DirektivIngressServer).SetNamespaceVariable(&direktivIngressSetNamespaceVariableServer{stream})
+}
+
+type DirektivIngress_SetNamespaceVariableServer interface {
+	SendAndClose(*empty.Empty) error
+	Recv() (*SetNamespaceVariableRequest, error)
+	grpc.ServerStream
+}
+
+type direktivIngressSetNamespaceVariableServer struct {
+	grpc.ServerStream
+}
+
+func (x *direktivIngressSetNamespaceVariableServer) SendAndClose(m *empty.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *direktivIngressSetNamespaceVariableServer) Recv() (*SetNamespaceVariableRequest, error) {
+	m := new(SetNamespaceVariableRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _DirektivIngress_SetWorkflowVariable_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DirektivIngressServer).SetWorkflowVariable(&direktivIngressSetWorkflowVariableServer{stream})
+}
+
+type DirektivIngress_SetWorkflowVariableServer interface {
+	SendAndClose(*empty.Empty) error
+	Recv() (*SetWorkflowVariableRequest, error)
+	grpc.ServerStream
+}
+
+type direktivIngressSetWorkflowVariableServer struct {
+	grpc.ServerStream
+}
+
+func (x *direktivIngressSetWorkflowVariableServer) SendAndClose(m *empty.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *direktivIngressSetWorkflowVariableServer) Recv() (*SetWorkflowVariableRequest, error) {
+	m := new(SetWorkflowVariableRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _DirektivIngress_GetNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetNodes(ctx, req.(*GetNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetNodeInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetNodeInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetNodeInstances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetNodeInstances(ctx, req.(*GetNodeInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_PromoteNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).PromoteNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/PromoteNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).PromoteNode(ctx, req.(*PromoteNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetWorkflowInstanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowInstanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetWorkflowInstanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetWorkflowInstanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetWorkflowInstanceHistory(ctx, req.(*GetWorkflowInstanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_SetWorkflowInstanceBreakpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorkflowInstanceBreakpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).SetWorkflowInstanceBreakpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/SetWorkflowInstanceBreakpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).SetWorkflowInstanceBreakpoints(ctx, req.(*SetWorkflowInstanceBreakpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_ResumeWorkflowInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeWorkflowInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).ResumeWorkflowInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/ResumeWorkflowInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).ResumeWorkflowInstance(ctx, req.(*ResumeWorkflowInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_TraceEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceEventRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).AddWorkflow(ctx, in)
+		return srv.(DirektivIngressServer).TraceEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/AddWorkflow",
+		FullMethod: "/ingress.DirektivIngress/TraceEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).AddWorkflow(ctx, req.(*AddWorkflowRequest))
+		return srv.(DirektivIngressServer).TraceEvent(ctx, req.(*TraceEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_DeleteWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteWorkflowRequest)
+func _DirektivIngress_ExportInstances_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportInstancesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DirektivIngressServer).ExportInstances(m, &direktivIngressExportInstancesServer{stream})
+}
+
+type DirektivIngress_ExportInstancesServer interface {
+	Send(*ExportInstancesResponse) error
+	grpc.ServerStream
+}
+
+type direktivIngressExportInstancesServer struct {
+	grpc.ServerStream
+}
+
+func (x *direktivIngressExportInstancesServer) Send(m *ExportInstancesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DirektivIngress_PurgeInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeInstancesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).DeleteWorkflow(ctx, in)
+		return srv.(DirektivIngressServer).PurgeInstances(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/DeleteWorkflow",
+		FullMethod: "/ingress.DirektivIngress/PurgeInstances",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).DeleteWorkflow(ctx, req.(*DeleteWorkflowRequest))
+		return srv.(DirektivIngressServer).PurgeInstances(ctx, req.(*PurgeInstancesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_GetWorkflowByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkflowByNameRequest)
+func _DirektivIngress_SetLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLegalHoldRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).GetWorkflowByName(ctx, in)
+		return srv.(DirektivIngressServer).SetLegalHold(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/GetWorkflowByName",
+		FullMethod: "/ingress.DirektivIngress/SetLegalHold",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).GetWorkflowByName(ctx, req.(*GetWorkflowByNameRequest))
+		return srv.(DirektivIngressServer).SetLegalHold(ctx, req.(*SetLegalHoldRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_GetWorkflowByUid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkflowByUidRequest)
+func _DirektivIngress_SetNamespaceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).GetWorkflowByUid(ctx, in)
+		return srv.(DirektivIngressServer).SetNamespaceKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/GetWorkflowByUid",
+		FullMethod: "/ingress.DirektivIngress/SetNamespaceKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).GetWorkflowByUid(ctx, req.(*GetWorkflowByUidRequest))
+		return srv.(DirektivIngressServer).SetNamespaceKey(ctx, req.(*SetNamespaceKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_GetWorkflowInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkflowInstanceRequest)
+func _DirektivIngress_GetNamespaceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).GetWorkflowInstance(ctx, in)
+		return srv.(DirektivIngressServer).GetNamespaceKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/GetWorkflowInstance",
+		FullMethod: "/ingress.DirektivIngress/GetNamespaceKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).GetWorkflowInstance(ctx, req.(*GetWorkflowInstanceRequest))
+		return srv.(DirektivIngressServer).GetNamespaceKey(ctx, req.(*GetNamespaceKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_GetWorkflowInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkflowInstancesRequest)
+func _DirektivIngress_GetDeadlineUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadlineUsageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).GetWorkflowInstances(ctx, in)
+		return srv.(DirektivIngressServer).GetDeadlineUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/GetWorkflowInstances",
+		FullMethod: "/ingress.DirektivIngress/GetDeadlineUsage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).GetWorkflowInstances(ctx, req.(*GetWorkflowInstancesRequest))
+		return srv.(DirektivIngressServer).GetDeadlineUsage(ctx, req.(*GetDeadlineUsageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_GetNamespaceLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNamespaceLogsRequest)
+func _DirektivIngress_GetEventStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).GetNamespaceLogs(ctx, in)
+		return srv.(DirektivIngressServer).GetEventStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/GetNamespaceLogs",
+		FullMethod: "/ingress.DirektivIngress/GetEventStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).GetNamespaceLogs(ctx, req.(*GetNamespaceLogsRequest))
+		return srv.(DirektivIngressServer).GetEventStats(ctx, req.(*GetEventStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_GetInstancesByWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInstancesByWorkflowRequest)
+func _DirektivIngress_SetWorkflowInstanceDebug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorkflowInstanceDebugRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).GetInstancesByWorkflow(ctx, in)
+		return srv.(DirektivIngressServer).SetWorkflowInstanceDebug(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/GetInstancesByWorkflow",
+		FullMethod: "/ingress.DirektivIngress/SetWorkflowInstanceDebug",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).GetInstancesByWorkflow(ctx, req.(*GetInstancesByWorkflowRequest))
+		return srv.(DirektivIngressServer).SetWorkflowInstanceDebug(ctx, req.(*SetWorkflowInstanceDebugRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_GetWorkflowInstanceLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkflowInstanceLogsRequest)
+func _DirektivIngress_ForceWorkflowInstanceTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceWorkflowInstanceTransitionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).GetWorkflowInstanceLogs(ctx, in)
+		return srv.(DirektivIngressServer).ForceWorkflowInstanceTransition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/GetWorkflowInstanceLogs",
+		FullMethod: "/ingress.DirektivIngress/ForceWorkflowInstanceTransition",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).GetWorkflowInstanceLogs(ctx, req.(*GetWorkflowInstanceLogsRequest))
+		return srv.(DirektivIngressServer).ForceWorkflowInstanceTransition(ctx, req.(*ForceWorkflowInstanceTransitionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_CancelWorkflowInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelWorkflowInstanceRequest)
+func _DirektivIngress_PatchWorkflowInstanceData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchWorkflowInstanceDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).CancelWorkflowInstance(ctx, in)
+		return srv.(DirektivIngressServer).PatchWorkflowInstanceData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/CancelWorkflowInstance",
+		FullMethod: "/ingress.DirektivIngress/PatchWorkflowInstanceData",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).CancelWorkflowInstance(ctx, req.(*CancelWorkflowInstanceRequest))
+		return srv.(DirektivIngressServer).PatchWorkflowInstanceData(ctx, req.(*PatchWorkflowInstanceDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_GetWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkflowsRequest)
+func _DirektivIngress_BreakWorkflowInstanceLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BreakWorkflowInstanceLockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).GetWorkflows(ctx, in)
+		return srv.(DirektivIngressServer).BreakWorkflowInstanceLock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/GetWorkflows",
+		FullMethod: "/ingress.DirektivIngress/BreakWorkflowInstanceLock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).GetWorkflows(ctx, req.(*GetWorkflowsRequest))
+		return srv.(DirektivIngressServer).BreakWorkflowInstanceLock(ctx, req.(*BreakWorkflowInstanceLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetNamespaceEventConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceEventConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetNamespaceEventConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetNamespaceEventConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetNamespaceEventConfig(ctx, req.(*GetNamespaceEventConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_SetNamespaceEventConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceEventConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).SetNamespaceEventConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/SetNamespaceEventConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).SetNamespaceEventConfig(ctx, req.(*SetNamespaceEventConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_SetCronSuspension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCronSuspensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).SetCronSuspension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/SetCronSuspension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).SetCronSuspension(ctx, req.(*SetCronSuspensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_SetInvocationHalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInvocationHaltRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).SetInvocationHalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/SetInvocationHalt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).SetInvocationHalt(ctx, req.(*SetInvocationHaltRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetInvocationHalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvocationHaltRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetInvocationHalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetInvocationHalt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetInvocationHalt(ctx, req.(*GetInvocationHaltRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_ScheduleInvocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleInvocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).ScheduleInvocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/ScheduleInvocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).ScheduleInvocation(ctx, req.(*ScheduleInvocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetScheduledInvocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledInvocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetScheduledInvocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetScheduledInvocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetScheduledInvocations(ctx, req.(*GetScheduledInvocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_CancelScheduledInvocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledInvocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).CancelScheduledInvocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/CancelScheduledInvocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).CancelScheduledInvocation(ctx, req.(*CancelScheduledInvocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetNamespaceConstants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceConstantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetNamespaceConstants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetNamespaceConstants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetNamespaceConstants(ctx, req.(*GetNamespaceConstantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_SetNamespaceConstants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceConstantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).SetNamespaceConstants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/SetNamespaceConstants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).SetNamespaceConstants(ctx, req.(*SetNamespaceConstantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetNamespaceRetryPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceRetryPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetNamespaceRetryPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetNamespaceRetryPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetNamespaceRetryPolicies(ctx, req.(*GetNamespaceRetryPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_SetNamespaceRetryPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceRetryPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).SetNamespaceRetryPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/SetNamespaceRetryPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).SetNamespaceRetryPolicies(ctx, req.(*SetNamespaceRetryPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_GetNamespaceProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).GetNamespaceProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/GetNamespaceProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).GetNamespaceProfiles(ctx, req.(*GetNamespaceProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirektivIngress_SetNamespaceProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).SetNamespaceProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/SetNamespaceProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).SetNamespaceProfile(ctx, req.(*SetNamespaceProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_InvokeWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InvokeWorkflowRequest)
+func _DirektivIngress_SetNamespaceActiveProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceActiveProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).InvokeWorkflow(ctx, in)
+		return srv.(DirektivIngressServer).SetNamespaceActiveProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/InvokeWorkflow",
+		FullMethod: "/ingress.DirektivIngress/SetNamespaceActiveProfile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).InvokeWorkflow(ctx, req.(*InvokeWorkflowRequest))
+		return srv.(DirektivIngressServer).SetNamespaceActiveProfile(ctx, req.(*SetNamespaceActiveProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_UpdateWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateWorkflowRequest)
+func _DirektivIngress_GetJQModules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJQModulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).UpdateWorkflow(ctx, in)
+		return srv.(DirektivIngressServer).GetJQModules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/UpdateWorkflow",
+		FullMethod: "/ingress.DirektivIngress/GetJQModules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).UpdateWorkflow(ctx, req.(*UpdateWorkflowRequest))
+		return srv.(DirektivIngressServer).GetJQModules(ctx, req.(*GetJQModulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_BroadcastEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BroadcastEventRequest)
+func _DirektivIngress_GetJQModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJQModuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).BroadcastEvent(ctx, in)
+		return srv.(DirektivIngressServer).GetJQModule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/BroadcastEvent",
+		FullMethod: "/ingress.DirektivIngress/GetJQModule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).BroadcastEvent(ctx, req.(*BroadcastEventRequest))
+		return srv.(DirektivIngressServer).GetJQModule(ctx, req.(*GetJQModuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_GetSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSecretsRequest)
+func _DirektivIngress_SetJQModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetJQModuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).GetSecrets(ctx, in)
+		return srv.(DirektivIngressServer).SetJQModule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/GetSecrets",
+		FullMethod: "/ingress.DirektivIngress/SetJQModule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).GetSecrets(ctx, req.(*GetSecretsRequest))
+		return srv.(DirektivIngressServer).SetJQModule(ctx, req.(*SetJQModuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteSecretRequest)
+func _DirektivIngress_DeleteJQModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJQModuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).DeleteSecret(ctx, in)
+		return srv.(DirektivIngressServer).DeleteJQModule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/DeleteSecret",
+		FullMethod: "/ingress.DirektivIngress/DeleteJQModule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).DeleteSecret(ctx, req.(*DeleteSecretRequest))
+		return srv.(DirektivIngressServer).DeleteJQModule(ctx, req.(*DeleteJQModuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_StoreSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StoreSecretRequest)
+func _DirektivIngress_GetWasmModules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWasmModulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).StoreSecret(ctx, in)
+		return srv.(DirektivIngressServer).GetWasmModules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/StoreSecret",
+		FullMethod: "/ingress.DirektivIngress/GetWasmModules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).StoreSecret(ctx, req.(*StoreSecretRequest))
+		return srv.(DirektivIngressServer).GetWasmModules(ctx, req.(*GetWasmModulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_GetRegistries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegistriesRequest)
+func _DirektivIngress_GetWasmModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWasmModuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).GetRegistries(ctx, in)
+		return srv.(DirektivIngressServer).GetWasmModule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/GetRegistries",
+		FullMethod: "/ingress.DirektivIngress/GetWasmModule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).GetRegistries(ctx, req.(*GetRegistriesRequest))
+		return srv.(DirektivIngressServer).GetWasmModule(ctx, req.(*GetWasmModuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_DeleteRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRegistryRequest)
+func _DirektivIngress_SetWasmModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWasmModuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).DeleteRegistry(ctx, in)
+		return srv.(DirektivIngressServer).SetWasmModule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/DeleteRegistry",
+		FullMethod: "/ingress.DirektivIngress/SetWasmModule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).DeleteRegistry(ctx, req.(*DeleteRegistryRequest))
+		return srv.(DirektivIngressServer).SetWasmModule(ctx, req.(*SetWasmModuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_StoreRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StoreRegistryRequest)
+func _DirektivIngress_DeleteWasmModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWasmModuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).StoreRegistry(ctx, in)
+		return srv.(DirektivIngressServer).DeleteWasmModule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/StoreRegistry",
+		FullMethod: "/ingress.DirektivIngress/DeleteWasmModule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).StoreRegistry(ctx, req.(*StoreRegistryRequest))
+		return srv.(DirektivIngressServer).DeleteWasmModule(ctx, req.(*DeleteWasmModuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_WorkflowMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WorkflowMetricsRequest)
+func _DirektivIngress_GetJSONSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJSONSchemasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).WorkflowMetrics(ctx, in)
+		return srv.(DirektivIngressServer).GetJSONSchemas(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/WorkflowMetrics",
+		FullMethod: "/ingress.DirektivIngress/GetJSONSchemas",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).WorkflowMetrics(ctx, req.(*WorkflowMetricsRequest))
+		return srv.(DirektivIngressServer).GetJSONSchemas(ctx, req.(*GetJSONSchemasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_ListNamespaceVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListNamespaceVariablesRequest)
+func _DirektivIngress_GetJSONSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJSONSchemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).ListNamespaceVariables(ctx, in)
+		return srv.(DirektivIngressServer).GetJSONSchema(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/ListNamespaceVariables",
+		FullMethod: "/ingress.DirektivIngress/GetJSONSchema",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).ListNamespaceVariables(ctx, req.(*ListNamespaceVariablesRequest))
+		return srv.(DirektivIngressServer).GetJSONSchema(ctx, req.(*GetJSONSchemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_ListWorkflowVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListWorkflowVariablesRequest)
+func _DirektivIngress_AddJSONSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddJSONSchemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DirektivIngressServer).ListWorkflowVariables(ctx, in)
+		return srv.(DirektivIngressServer).AddJSONSchema(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ingress.DirektivIngress/ListWorkflowVariables",
+		FullMethod: "/ingress.DirektivIngress/AddJSONSchema",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DirektivIngressServer).ListWorkflowVariables(ctx, req.(*ListWorkflowVariablesRequest))
+		return srv.(DirektivIngressServer).AddJSONSchema(ctx, req.(*AddJSONSchemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DirektivIngress_GetNamespaceVariable_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetNamespaceVariableRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _DirektivIngress_DeleteJSONSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJSONSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(DirektivIngressServer).GetNamespaceVariable(m, &direktivIngressGetNamespaceVariableServer{stream})
-}
-
-type DirektivIngress_GetNamespaceVariableServer interface {
-	Send(*GetNamespaceVariableResponse) error
-	grpc.ServerStream
-}
-
-type direktivIngressGetNamespaceVariableServer struct {
-	grpc.ServerStream
-}
-
-func (x *direktivIngressGetNamespaceVariableServer) Send(m *GetNamespaceVariableResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _DirektivIngress_GetWorkflowVariable_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetWorkflowVariableRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(DirektivIngressServer).DeleteJSONSchema(ctx, in)
 	}
-	return srv.(DirektivIngressServer).GetWorkflowVariable(m, &direktivIngressGetWorkflowVariableServer{stream})
-}
-
-type DirektivIngress_GetWorkflowVariableServer interface {
-	Send(*GetWorkflowVariableResponse) error
-	grpc.ServerStream
-}
-
-type direktivIngressGetWorkflowVariableServer struct {
-	grpc.ServerStream
-}
-
-func (x *direktivIngressGetWorkflowVariableServer) Send(m *GetWorkflowVariableResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _DirektivIngress_SetNamespaceVariable_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(DirektivIngressServer).SetNamespaceVariable(&direktivIngressSetNamespaceVariableServer{stream})
-}
-
-type DirektivIngress_SetNamespaceVariableServer interface {
-	SendAndClose(*empty.Empty) error
-	Recv() (*SetNamespaceVariableRequest, error)
-	grpc.ServerStream
-}
-
-type direktivIngressSetNamespaceVariableServer struct {
-	grpc.ServerStream
-}
-
-func (x *direktivIngressSetNamespaceVariableServer) SendAndClose(m *empty.Empty) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *direktivIngressSetNamespaceVariableServer) Recv() (*SetNamespaceVariableRequest, error) {
-	m := new(SetNamespaceVariableRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ingress.DirektivIngress/DeleteJSONSchema",
 	}
-	return m, nil
-}
-
-func _DirektivIngress_SetWorkflowVariable_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(DirektivIngressServer).SetWorkflowVariable(&direktivIngressSetWorkflowVariableServer{stream})
-}
-
-type DirektivIngress_SetWorkflowVariableServer interface {
-	SendAndClose(*empty.Empty) error
-	Recv() (*SetWorkflowVariableRequest, error)
-	grpc.ServerStream
-}
-
-type direktivIngressSetWorkflowVariableServer struct {
-	grpc.ServerStream
-}
-
-func (x *direktivIngressSetWorkflowVariableServer) SendAndClose(m *empty.Empty) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *direktivIngressSetWorkflowVariableServer) Recv() (*SetWorkflowVariableRequest, error) {
-	m := new(SetWorkflowVariableRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirektivIngressServer).DeleteJSONSchema(ctx, req.(*DeleteJSONSchemaRequest))
 	}
-	return m, nil
+	return interceptor(ctx, in, info, handler)
 }
 
 // DirektivIngress_ServiceDesc is the grpc.ServiceDesc for DirektivIngress service.
@@ -1178,6 +2804,10 @@ var DirektivIngress_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNamespaceLogs",
 			Handler:    _DirektivIngress_GetNamespaceLogs_Handler,
 		},
+		{
+			MethodName: "GetNamespaceActions",
+			Handler:    _DirektivIngress_GetNamespaceActions_Handler,
+		},
 		{
 			MethodName: "GetInstancesByWorkflow",
 			Handler:    _DirektivIngress_GetInstancesByWorkflow_Handler,
@@ -1190,6 +2820,22 @@ var DirektivIngress_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelWorkflowInstance",
 			Handler:    _DirektivIngress_CancelWorkflowInstance_Handler,
 		},
+		{
+			MethodName: "ReportActionCallback",
+			Handler:    _DirektivIngress_ReportActionCallback_Handler,
+		},
+		{
+			MethodName: "QueryWorkflowInstance",
+			Handler:    _DirektivIngress_QueryWorkflowInstance_Handler,
+		},
+		{
+			MethodName: "ExportWorkflowInstance",
+			Handler:    _DirektivIngress_ExportWorkflowInstance_Handler,
+		},
+		{
+			MethodName: "ImportWorkflowInstance",
+			Handler:    _DirektivIngress_ImportWorkflowInstance_Handler,
+		},
 		{
 			MethodName: "GetWorkflows",
 			Handler:    _DirektivIngress_GetWorkflows_Handler,
@@ -1242,6 +2888,182 @@ var DirektivIngress_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListWorkflowVariables",
 			Handler:    _DirektivIngress_ListWorkflowVariables_Handler,
 		},
+		{
+			MethodName: "GetNodes",
+			Handler:    _DirektivIngress_GetNodes_Handler,
+		},
+		{
+			MethodName: "GetNodeInstances",
+			Handler:    _DirektivIngress_GetNodeInstances_Handler,
+		},
+		{
+			MethodName: "PromoteNode",
+			Handler:    _DirektivIngress_PromoteNode_Handler,
+		},
+		{
+			MethodName: "GetWorkflowInstanceHistory",
+			Handler:    _DirektivIngress_GetWorkflowInstanceHistory_Handler,
+		},
+		{
+			MethodName: "SetWorkflowInstanceBreakpoints",
+			Handler:    _DirektivIngress_SetWorkflowInstanceBreakpoints_Handler,
+		},
+		{
+			MethodName: "ResumeWorkflowInstance",
+			Handler:    _DirektivIngress_ResumeWorkflowInstance_Handler,
+		},
+		{
+			MethodName: "TraceEvent",
+			Handler:    _DirektivIngress_TraceEvent_Handler,
+		},
+		{
+			MethodName: "PurgeInstances",
+			Handler:    _DirektivIngress_PurgeInstances_Handler,
+		},
+		{
+			MethodName: "SetLegalHold",
+			Handler:    _DirektivIngress_SetLegalHold_Handler,
+		},
+		{
+			MethodName: "SetNamespaceKey",
+			Handler:    _DirektivIngress_SetNamespaceKey_Handler,
+		},
+		{
+			MethodName: "GetNamespaceKey",
+			Handler:    _DirektivIngress_GetNamespaceKey_Handler,
+		},
+		{
+			MethodName: "GetDeadlineUsage",
+			Handler:    _DirektivIngress_GetDeadlineUsage_Handler,
+		},
+		{
+			MethodName: "GetEventStats",
+			Handler:    _DirektivIngress_GetEventStats_Handler,
+		},
+		{
+			MethodName: "SetWorkflowInstanceDebug",
+			Handler:    _DirektivIngress_SetWorkflowInstanceDebug_Handler,
+		},
+		{
+			MethodName: "ForceWorkflowInstanceTransition",
+			Handler:    _DirektivIngress_ForceWorkflowInstanceTransition_Handler,
+		},
+		{
+			MethodName: "PatchWorkflowInstanceData",
+			Handler:    _DirektivIngress_PatchWorkflowInstanceData_Handler,
+		},
+		{
+			MethodName: "BreakWorkflowInstanceLock",
+			Handler:    _DirektivIngress_BreakWorkflowInstanceLock_Handler,
+		},
+		{
+			MethodName: "GetNamespaceEventConfig",
+			Handler:    _DirektivIngress_GetNamespaceEventConfig_Handler,
+		},
+		{
+			MethodName: "SetNamespaceEventConfig",
+			Handler:    _DirektivIngress_SetNamespaceEventConfig_Handler,
+		},
+		{
+			MethodName: "SetCronSuspension",
+			Handler:    _DirektivIngress_SetCronSuspension_Handler,
+		},
+		{
+			MethodName: "SetInvocationHalt",
+			Handler:    _DirektivIngress_SetInvocationHalt_Handler,
+		},
+		{
+			MethodName: "GetInvocationHalt",
+			Handler:    _DirektivIngress_GetInvocationHalt_Handler,
+		},
+		{
+			MethodName: "ScheduleInvocation",
+			Handler:    _DirektivIngress_ScheduleInvocation_Handler,
+		},
+		{
+			MethodName: "GetScheduledInvocations",
+			Handler:    _DirektivIngress_GetScheduledInvocations_Handler,
+		},
+		{
+			MethodName: "CancelScheduledInvocation",
+			Handler:    _DirektivIngress_CancelScheduledInvocation_Handler,
+		},
+		{
+			MethodName: "GetNamespaceConstants",
+			Handler:    _DirektivIngress_GetNamespaceConstants_Handler,
+		},
+		{
+			MethodName: "SetNamespaceConstants",
+			Handler:    _DirektivIngress_SetNamespaceConstants_Handler,
+		},
+		{
+			MethodName: "GetNamespaceRetryPolicies",
+			Handler:    _DirektivIngress_GetNamespaceRetryPolicies_Handler,
+		},
+		{
+			MethodName: "SetNamespaceRetryPolicies",
+			Handler:    _DirektivIngress_SetNamespaceRetryPolicies_Handler,
+		},
+		{
+			MethodName: "GetNamespaceProfiles",
+			Handler:    _DirektivIngress_GetNamespaceProfiles_Handler,
+		},
+		{
+			MethodName: "SetNamespaceProfile",
+			Handler:    _DirektivIngress_SetNamespaceProfile_Handler,
+		},
+		{
+			MethodName: "SetNamespaceActiveProfile",
+			Handler:    _DirektivIngress_SetNamespaceActiveProfile_Handler,
+		},
+		{
+			MethodName: "GetJQModules",
+			Handler:    _DirektivIngress_GetJQModules_Handler,
+		},
+		{
+			MethodName: "GetJQModule",
+			Handler:    _DirektivIngress_GetJQModule_Handler,
+		},
+		{
+			MethodName: "SetJQModule",
+			Handler:    _DirektivIngress_SetJQModule_Handler,
+		},
+		{
+			MethodName: "DeleteJQModule",
+			Handler:    _DirektivIngress_DeleteJQModule_Handler,
+		},
+		{
+			MethodName: "GetWasmModules",
+			Handler:    _DirektivIngress_GetWasmModules_Handler,
+		},
+		{
+			MethodName: "GetWasmModule",
+			Handler:    _DirektivIngress_GetWasmModule_Handler,
+		},
+		{
+			MethodName: "SetWasmModule",
+			Handler:    _DirektivIngress_SetWasmModule_Handler,
+		},
+		{
+			MethodName: "DeleteWasmModule",
+			Handler:    _DirektivIngress_DeleteWasmModule_Handler,
+		},
+		{
+			MethodName: "GetJSONSchemas",
+			Handler:    _DirektivIngress_GetJSONSchemas_Handler,
+		},
+		{
+			MethodName: "GetJSONSchema",
+			Handler:    _DirektivIngress_GetJSONSchema_Handler,
+		},
+		{
+			MethodName: "AddJSONSchema",
+			Handler:    _DirektivIngress_AddJSONSchema_Handler,
+		},
+		{
+			MethodName: "DeleteJSONSchema",
+			Handler:    _DirektivIngress_DeleteJSONSchema_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1264,6 +3086,11 @@ var DirektivIngress_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _DirektivIngress_SetWorkflowVariable_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ExportInstances",
+			Handler:       _DirektivIngress_ExportInstances_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "pkg/ingress/protocol.proto",
 }
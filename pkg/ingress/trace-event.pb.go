@@ -0,0 +1,438 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: pkg/ingress/trace-event.proto
+
+package ingress
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type TraceEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace  *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Cloudevent []byte  `protobuf:"bytes,2,opt,name=cloudevent,proto3,oneof" json:"cloudevent,omitempty"`
+}
+
+func (x *TraceEventRequest) Reset() {
+	*x = TraceEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_trace_event_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceEventRequest) ProtoMessage() {}
+
+func (x *TraceEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_trace_event_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceEventRequest.ProtoReflect.Descriptor instead.
+func (*TraceEventRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_trace_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TraceEventRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *TraceEventRequest) GetCloudevent() []byte {
+	if x != nil {
+		return x.Cloudevent
+	}
+	return nil
+}
+
+type TraceEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *string               `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Listeners []*TraceEventListener `protobuf:"bytes,2,rep,name=listeners,proto3" json:"listeners,omitempty"`
+}
+
+func (x *TraceEventResponse) Reset() {
+	*x = TraceEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_trace_event_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceEventResponse) ProtoMessage() {}
+
+func (x *TraceEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_trace_event_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceEventResponse.ProtoReflect.Descriptor instead.
+func (*TraceEventResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_trace_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TraceEventResponse) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *TraceEventResponse) GetListeners() []*TraceEventListener {
+	if x != nil {
+		return x.Listeners
+	}
+	return nil
+}
+
+type TraceEventListener struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workflow *string                 `protobuf:"bytes,1,opt,name=workflow,proto3,oneof" json:"workflow,omitempty"`
+	Instance *string                 `protobuf:"bytes,2,opt,name=instance,proto3,oneof" json:"instance,omitempty"`
+	Matched  *bool                   `protobuf:"varint,3,opt,name=matched,proto3,oneof" json:"matched,omitempty"`
+	Outcome  *string                 `protobuf:"bytes,4,opt,name=outcome,proto3,oneof" json:"outcome,omitempty"`
+	Events   []*TraceEventDefinition `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *TraceEventListener) Reset() {
+	*x = TraceEventListener{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_trace_event_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceEventListener) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceEventListener) ProtoMessage() {}
+
+func (x *TraceEventListener) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_trace_event_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceEventListener.ProtoReflect.Descriptor instead.
+func (*TraceEventListener) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_trace_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TraceEventListener) GetWorkflow() string {
+	if x != nil && x.Workflow != nil {
+		return *x.Workflow
+	}
+	return ""
+}
+
+func (x *TraceEventListener) GetInstance() string {
+	if x != nil && x.Instance != nil {
+		return *x.Instance
+	}
+	return ""
+}
+
+func (x *TraceEventListener) GetMatched() bool {
+	if x != nil && x.Matched != nil {
+		return *x.Matched
+	}
+	return false
+}
+
+func (x *TraceEventListener) GetOutcome() string {
+	if x != nil && x.Outcome != nil {
+		return *x.Outcome
+	}
+	return ""
+}
+
+func (x *TraceEventListener) GetEvents() []*TraceEventDefinition {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type TraceEventDefinition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    *string `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Matched *bool   `protobuf:"varint,2,opt,name=matched,proto3,oneof" json:"matched,omitempty"`
+	Reason  *string `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+}
+
+func (x *TraceEventDefinition) Reset() {
+	*x = TraceEventDefinition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_ingress_trace_event_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceEventDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceEventDefinition) ProtoMessage() {}
+
+func (x *TraceEventDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_ingress_trace_event_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceEventDefinition.ProtoReflect.Descriptor instead.
+func (*TraceEventDefinition) Descriptor() ([]byte, []int) {
+	return file_pkg_ingress_trace_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TraceEventDefinition) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *TraceEventDefinition) GetMatched() bool {
+	if x != nil && x.Matched != nil {
+		return *x.Matched
+	}
+	return false
+}
+
+func (x *TraceEventDefinition) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+var File_pkg_ingress_trace_event_proto protoreflect.FileDescriptor
+
+var file_pkg_ingress_trace_event_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x2d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x78, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x23, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x09,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x09, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xfd, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x08,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d,
+	0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x02, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a,
+	0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03,
+	0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69,
+	0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x76, 0x6f, 0x72, 0x74, 0x65, 0x69, 0x6c, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x6b, 0x74,
+	0x69, 0x76, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_ingress_trace_event_proto_rawDescOnce sync.Once
+	file_pkg_ingress_trace_event_proto_rawDescData = file_pkg_ingress_trace_event_proto_rawDesc
+)
+
+func file_pkg_ingress_trace_event_proto_rawDescGZIP() []byte {
+	file_pkg_ingress_trace_event_proto_rawDescOnce.Do(func() {
+		file_pkg_ingress_trace_event_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_ingress_trace_event_proto_rawDescData)
+	})
+	return file_pkg_ingress_trace_event_proto_rawDescData
+}
+
+var file_pkg_ingress_trace_event_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_pkg_ingress_trace_event_proto_goTypes = []interface{}{
+	(*TraceEventRequest)(nil),    // 0: ingress.TraceEventRequest
+	(*TraceEventResponse)(nil),   // 1: ingress.TraceEventResponse
+	(*TraceEventListener)(nil),   // 2: ingress.TraceEventListener
+	(*TraceEventDefinition)(nil), // 3: ingress.TraceEventDefinition
+}
+var file_pkg_ingress_trace_event_proto_depIdxs = []int32{
+	2, // 0: ingress.TraceEventResponse.listeners:type_name -> ingress.TraceEventListener
+	3, // 1: ingress.TraceEventListener.events:type_name -> ingress.TraceEventDefinition
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_pkg_ingress_trace_event_proto_init() }
+func file_pkg_ingress_trace_event_proto_init() {
+	if File_pkg_ingress_trace_event_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_ingress_trace_event_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_trace_event_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_trace_event_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceEventListener); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_ingress_trace_event_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceEventDefinition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_ingress_trace_event_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_pkg_ingress_trace_event_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_pkg_ingress_trace_event_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_pkg_ingress_trace_event_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_ingress_trace_event_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_ingress_trace_event_proto_goTypes,
+		DependencyIndexes: file_pkg_ingress_trace_event_proto_depIdxs,
+		MessageInfos:      file_pkg_ingress_trace_event_proto_msgTypes,
+	}.Build()
+	File_pkg_ingress_trace_event_proto = out.File
+	file_pkg_ingress_trace_event_proto_rawDesc = nil
+	file_pkg_ingress_trace_event_proto_goTypes = nil
+	file_pkg_ingress_trace_event_proto_depIdxs = nil
+}
@@ -0,0 +1,140 @@
+package jqer
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+
+	"github.com/itchyny/gojq"
+	"github.com/vorteil/direktiv/pkg/jsonata"
+)
+
+// QueryCacheSize is how many compiled queries are kept for reuse. Queries
+// are evaluated far more often than they change, so hot workflows compile
+// each transform and condition only once.
+var QueryCacheSize = 1024
+
+var queries = &queryCache{
+	lru:     list.New(),
+	entries: make(map[string]*list.Element),
+}
+
+type queryCache struct {
+	mtx     sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+type cachedQuery struct {
+	key  string
+	code interface{}
+}
+
+// compile returns the compiled form of command, with the variables named
+// defined and the modules and functions available, from the cache if
+// possible. Compiled code is safe to run concurrently, so it is shared by all
+// callers. Functions are usually bound to the evaluation they're given to, so
+// queries with functions are compiled every time.
+func (c *queryCache) compile(command string, variables []string, modules *Modules, functions []Function) (*gojq.Code, error) {
+
+	key := command
+	if len(variables) > 0 {
+		key = strings.Join(variables, ",") + "\x00" + command
+	}
+	if modules != nil {
+		key = modules.digest + "\x00" + key
+	}
+
+	if len(functions) == 0 {
+		if code, ok := c.get(key); ok {
+			return code.(*gojq.Code), nil
+		}
+	}
+
+	query, err := gojq.Parse(command)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []gojq.CompilerOption{gojq.WithVariables(variables)}
+	if modules != nil {
+		opts = append(opts, gojq.WithModuleLoader(&moduleLoader{modules: modules}))
+	}
+	for _, fn := range functions {
+		opts = append(opts, gojq.WithFunction(fn.Name, fn.MinArity, fn.MaxArity, fn.Call))
+	}
+
+	code, err := gojq.Compile(query, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(functions) > 0 {
+		return code, nil
+	}
+
+	return c.put(key, code).(*gojq.Code), nil
+
+}
+
+// compileJSONata returns the compiled form of a JSONata expression, from
+// the cache if possible.
+func (c *queryCache) compileJSONata(expression string) (*jsonata.Expression, error) {
+
+	key := "jsonata\x00" + expression
+
+	if expr, ok := c.get(key); ok {
+		return expr.(*jsonata.Expression), nil
+	}
+
+	expr, err := jsonata.Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.put(key, expr).(*jsonata.Expression), nil
+
+}
+
+func (c *queryCache) get(key string) (interface{}, bool) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.lru.MoveToFront(e)
+
+	return e.Value.(*cachedQuery).code, true
+
+}
+
+// put caches code, unless someone else cached it first, and returns what is
+// cached.
+func (c *queryCache) put(key string, code interface{}) interface{} {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(e)
+		return e.Value.(*cachedQuery).code
+	}
+
+	c.entries[key] = c.lru.PushFront(&cachedQuery{
+		key:  key,
+		code: code,
+	})
+
+	for c.lru.Len() > QueryCacheSize && c.lru.Len() > 0 {
+		e := c.lru.Back()
+		c.lru.Remove(e)
+		delete(c.entries, e.Value.(*cachedQuery).key)
+	}
+
+	return code
+
+}
@@ -19,6 +19,10 @@ var (
 	WrappingBegin                = ""
 	WrappingIncrement            = "{{"
 	WrappingDecrement            = "}}"
+
+	// JSONataWrappingBegin, if set, marks wrapped queries that are JSONata
+	// expressions instead of jq, like WrappingBegin marks jq ones.
+	JSONataWrappingBegin = ""
 )
 
 /*
@@ -41,7 +45,46 @@ var (
 	WrappingDecrement            = ")"
 */
 
+// Variables are made available to queries by name, "const" as $const.
+type Variables map[string]interface{}
+
 func Evaluate(data, query interface{}) ([]interface{}, error) {
+	return EvaluateWithVariables(data, query, nil)
+}
+
+// Scope is what queries can refer to besides their input.
+type Scope struct {
+	Variables Variables
+	Modules   *Modules
+
+	// Functions are only available to jq queries.
+	Functions []Function
+
+	// parseOnly makes queries check their syntax instead of running
+	parseOnly bool
+
+	// skipJQ makes parseOnly leave jq queries unchecked
+	skipJQ bool
+}
+
+// Function is a function jq queries can call by name with MinArity to
+// MaxArity arguments. Call gets the input and the argument values of a call,
+// and returns its result, or an error to fail the query with.
+type Function struct {
+	Name     string
+	MinArity int
+	MaxArity int
+	Call     func(input interface{}, args []interface{}) interface{}
+}
+
+// EvaluateWithVariables evaluates query like Evaluate, with vars defined.
+func EvaluateWithVariables(data, query interface{}, vars Variables) ([]interface{}, error) {
+	return EvaluateInScope(data, query, &Scope{Variables: vars})
+}
+
+// EvaluateInScope evaluates query like Evaluate, with the variables and
+// modules of scope available to it. A nil scope has neither.
+func EvaluateInScope(data, query interface{}, scope *Scope) ([]interface{}, error) {
 
 	if query == nil {
 		var out []interface{}
@@ -50,14 +93,32 @@ func Evaluate(data, query interface{}) ([]interface{}, error) {
 	}
 
 	if s, ok := query.(string); ok && !StringQueryRequiresWrappings {
-		return jq(data, s)
+		return jq(data, s, scope)
 	}
 
-	return recursiveEvaluate(data, query)
+	return recursiveEvaluate(data, query, scope)
+
+}
+
+// Validate returns an error if query, or any of the queries it embeds, isn't
+// syntactically valid, without running them. Whether they refer to
+// variables, modules and functions that exist is only known when they run.
+func Validate(query interface{}) error {
+
+	_, err := EvaluateInScope(nil, query, &Scope{parseOnly: true})
+	return err
+
+}
+
+// ValidateJSONata is Validate for only the JSONata queries that query embeds.
+func ValidateJSONata(query interface{}) error {
+
+	_, err := EvaluateInScope(nil, query, &Scope{parseOnly: true, skipJQ: true})
+	return err
 
 }
 
-func recursiveEvaluate(data, query interface{}) ([]interface{}, error) {
+func recursiveEvaluate(data, query interface{}, scope *Scope) ([]interface{}, error) {
 
 	var out []interface{}
 
@@ -71,11 +132,11 @@ func recursiveEvaluate(data, query interface{}) ([]interface{}, error) {
 	case int:
 	case float64:
 	case string:
-		return recurseIntoString(data, query.(string))
+		return recurseIntoString(data, query.(string), scope)
 	case map[string]interface{}:
-		return recurseIntoMap(data, query.(map[string]interface{}))
+		return recurseIntoMap(data, query.(map[string]interface{}), scope)
 	case []interface{}:
-		return recurseIntoArray(data, query.([]interface{}))
+		return recurseIntoArray(data, query.([]interface{}), scope)
 	default:
 		return nil, fmt.Errorf("unexpected type: %s", reflect.TypeOf(query).String())
 	}
@@ -86,7 +147,7 @@ func recursiveEvaluate(data, query interface{}) ([]interface{}, error) {
 
 }
 
-func recurseIntoString(data interface{}, s string) ([]interface{}, error) {
+func recurseIntoString(data interface{}, s string, scope *Scope) ([]interface{}, error) {
 
 	var out []interface{}
 	var offset int
@@ -98,9 +159,13 @@ func recurseIntoString(data interface{}, s string) ([]interface{}, error) {
 	}
 
 	if !SearchInStrings {
+		if JSONataWrappingBegin != "" && strings.HasPrefix(query, JSONataWrappingBegin+WrappingIncrement) && strings.HasSuffix(query, WrappingDecrement) {
+			query = query[len(JSONataWrappingBegin)+len(WrappingIncrement) : len(query)-len(WrappingDecrement)]
+			return jsonataQuery(data, query, scope)
+		}
 		if strings.HasPrefix(query, WrappingBegin+WrappingIncrement) && strings.HasSuffix(query, WrappingDecrement) {
 			query = query[len(WrappingBegin)+len(WrappingIncrement) : len(query)-len(WrappingDecrement)]
-			return jq(data, query)
+			return jq(data, query, scope)
 		}
 		out = append(out, s)
 		return out, nil
@@ -109,10 +174,9 @@ func recurseIntoString(data interface{}, s string) ([]interface{}, error) {
 	// search in string
 	var foundQueries bool
 	var stringParts []interface{}
-	begin := WrappingBegin + WrappingIncrement
 
 	for {
-		idx := strings.Index(query, begin)
+		idx, begin, lang, evaluate := nextQuery(query)
 		if idx < 0 {
 			if len(query) > 0 {
 				stringParts = append(stringParts, query)
@@ -163,24 +227,24 @@ func recurseIntoString(data interface{}, s string) ([]interface{}, error) {
 		}
 
 		if counter > 0 {
-			return nil, fmt.Errorf("unterminated jq query beginning at offset %v", offset)
+			return nil, fmt.Errorf("unterminated %s query beginning at offset %v", lang, offset)
 		}
 
 		var x []interface{}
 		qstr := query[len(begin) : i-1]
 		var err error
 		foundQueries = true
-		x, err = jq(data, qstr)
+		x, err = evaluate(data, qstr, scope)
 		if err != nil {
-			return nil, fmt.Errorf("error running jq query beginning at offset %v: %v", offset, err)
+			return nil, fmt.Errorf("error running %s query beginning at offset %v: %v", lang, offset, err)
 		}
 		out = x
 
 		if len(x) == 0 && len(stringParts) > 0 {
-			return nil, fmt.Errorf("error in jq query beginning at offset %v: no results", offset)
+			return nil, fmt.Errorf("error in %s query beginning at offset %v: no results", lang, offset)
 		}
 		if len(x) > 1 && len(stringParts) > 0 {
-			return nil, fmt.Errorf("error in jq query beginning at offset %v: more than one result", offset)
+			return nil, fmt.Errorf("error in %s query beginning at offset %v: more than one result", lang, offset)
 		}
 
 		stringParts = append(stringParts, x[0])
@@ -219,7 +283,7 @@ func recurseIntoString(data interface{}, s string) ([]interface{}, error) {
 
 }
 
-func recurseIntoMap(data interface{}, m map[string]interface{}) ([]interface{}, error) {
+func recurseIntoMap(data interface{}, m map[string]interface{}, scope *Scope) ([]interface{}, error) {
 	var out []interface{}
 	var results = make(map[string]interface{})
 	var keys []string
@@ -229,7 +293,7 @@ func recurseIntoMap(data interface{}, m map[string]interface{}) ([]interface{},
 	sort.Strings(keys)
 	for i := range keys {
 		k := keys[i]
-		x, err := recursiveEvaluate(data, m[k])
+		x, err := recursiveEvaluate(data, m[k], scope)
 		if err != nil {
 			return nil, fmt.Errorf("error in '%s': %v", k, err)
 		}
@@ -245,11 +309,11 @@ func recurseIntoMap(data interface{}, m map[string]interface{}) ([]interface{},
 	return out, nil
 }
 
-func recurseIntoArray(data interface{}, q []interface{}) ([]interface{}, error) {
+func recurseIntoArray(data interface{}, q []interface{}, scope *Scope) ([]interface{}, error) {
 	var out []interface{}
 	var array = make([]interface{}, 0)
 	for i := range q {
-		x, err := recursiveEvaluate(data, q[i])
+		x, err := recursiveEvaluate(data, q[i], scope)
 		if err != nil {
 			return nil, fmt.Errorf("error in element %d: %v", i, err)
 		}
@@ -265,7 +329,35 @@ func recurseIntoArray(data interface{}, q []interface{}) ([]interface{}, error)
 	return out, nil
 }
 
-func jq(input interface{}, command string) ([]interface{}, error) {
+// nextQuery finds the first wrapped query in s, returning its offset, the
+// beginning of its wrapping, its language and how to evaluate it.
+func nextQuery(s string) (int, string, string, func(interface{}, string, *Scope) ([]interface{}, error)) {
+
+	begin := WrappingBegin + WrappingIncrement
+	idx := strings.Index(s, begin)
+
+	if JSONataWrappingBegin != "" {
+		b := JSONataWrappingBegin + WrappingIncrement
+		if i := strings.Index(s, b); i >= 0 && (idx < 0 || i < idx) {
+			return i, b, "jsonata", jsonataQuery
+		}
+	}
+
+	return idx, begin, "jq", jq
+
+}
+
+func jq(input interface{}, command string, scope *Scope) ([]interface{}, error) {
+
+	if scope != nil && scope.parseOnly {
+		if !scope.skipJQ {
+			_, err := gojq.Parse(command)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return []interface{}{nil}, nil
+	}
 
 	data, err := json.Marshal(input)
 	if err != nil {
@@ -279,7 +371,16 @@ func jq(input interface{}, command string) ([]interface{}, error) {
 		return nil, err
 	}
 
-	query, err := gojq.Parse(command)
+	if scope == nil {
+		scope = new(Scope)
+	}
+
+	names, values, err := scope.Variables.normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := queries.compile(command, names, scope.Modules, scope.Functions)
 	if err != nil {
 		return nil, err
 	}
@@ -288,7 +389,7 @@ func jq(input interface{}, command string) ([]interface{}, error) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
 	defer cancel()
-	iter := query.RunWithContext(ctx, x)
+	iter := code.RunWithContext(ctx, x, values...)
 
 	for i := 0; ; i++ {
 
@@ -308,3 +409,66 @@ func jq(input interface{}, command string) ([]interface{}, error) {
 	return output, nil
 
 }
+
+// jsonataQuery evaluates a JSONata expression. JSONata doesn't produce streams
+// like jq, it has one result, which is null if the expression has none.
+func jsonataQuery(input interface{}, expression string, scope *Scope) ([]interface{}, error) {
+
+	expr, err := queries.compileJSONata(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	if scope != nil && scope.parseOnly {
+		return []interface{}{nil}, nil
+	}
+
+	var vars Variables
+	if scope != nil {
+		vars = scope.Variables
+	}
+
+	v, _, err := expr.Evaluate(input, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{v}, nil
+
+}
+
+// normalize returns the names of the variables as jq knows them, sorted, and
+// their values in the same order as gojq expects them, decoded from json.
+func (vars Variables) normalize() ([]string, []interface{}, error) {
+
+	if len(vars) == 0 {
+		return nil, nil, nil
+	}
+
+	data, err := json.Marshal(vars)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var m map[string]interface{}
+	err = json.Unmarshal(data, &m)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	names := make([]string, len(keys))
+	values := make([]interface{}, len(keys))
+	for i, k := range keys {
+		names[i] = "$" + k
+		values[i] = m[k]
+	}
+
+	return names, values, nil
+
+}
@@ -245,3 +245,103 @@ func Test007(t *testing.T) {
 	t.Logf("%+v", results)
 
 }
+
+func Test008(t *testing.T) {
+
+	size := QueryCacheSize
+	QueryCacheSize = 2
+	defer func() {
+		QueryCacheSize = size
+	}()
+
+	for i, data := range []interface{}{
+		map[string]interface{}{"id": 1},
+		map[string]interface{}{"id": 2},
+	} {
+
+		results, err := Evaluate(data, ".id")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		if len(results) != 1 || results[0] != float64(i+1) {
+			t.Errorf("unexpected results from cached query: %+v", results)
+			return
+		}
+
+	}
+
+	for _, q := range []string{".a", ".b", ".c"} {
+		_, err := Evaluate(nil, q)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+	}
+
+	if n := queries.lru.Len(); n != 2 {
+		t.Errorf("expected 2 cached queries, got %d", n)
+	}
+
+	if _, ok := queries.entries[".id"]; ok {
+		t.Error("expected least recently used query to be evicted")
+	}
+
+}
+
+func TestValidate(t *testing.T) {
+
+	StringQueryRequiresWrappings = true
+	TrimWhitespaceOnQueryStrings = true
+	SearchInStrings = true
+	WrappingBegin = "jq"
+	WrappingIncrement = "("
+	WrappingDecrement = ")"
+	JSONataWrappingBegin = "jsonata"
+	defer func() {
+		StringQueryRequiresWrappings = false
+		TrimWhitespaceOnQueryStrings = false
+		SearchInStrings = false
+		WrappingBegin = ""
+		WrappingIncrement = "{{"
+		WrappingDecrement = "}}"
+		JSONataWrappingBegin = ""
+	}()
+
+	tests := []struct {
+		query interface{}
+		valid bool
+	}{
+		{`jq(.message | test("503"))`, true},
+		{`jq($const.limit > .attempt)`, true},
+		{`code: jq(.code), attempt jq(.attempt)`, true},
+		{map[string]interface{}{"a": []interface{}{"jq(.a)", 1}}, true},
+		{`jsonata(message = "x")`, true},
+		{`no queries`, true},
+		{`jq(.message | test("503")`, false},
+		{`jq(.a |)`, false},
+		{map[string]interface{}{"a": []interface{}{"jq(.a[)"}}, false},
+		{`jsonata(message = )`, false},
+	}
+
+	for _, test := range tests {
+		err := Validate(test.query)
+		if (err == nil) != test.valid {
+			t.Errorf("%v: unexpected validation result: %v", test.query, err)
+		}
+	}
+
+	for _, q := range []string{`jq(.a |)`, `jq(.a) and jsonata(a)`} {
+		err := ValidateJSONata(q)
+		if err != nil {
+			t.Errorf("%v: unexpected jsonata validation result: %v", q, err)
+		}
+	}
+
+	err := ValidateJSONata(`jq(.a |) and jsonata(orders^(price))`)
+	if err == nil {
+		t.Errorf("expected unsupported jsonata to be invalid")
+	}
+
+}
@@ -0,0 +1,96 @@
+package jqer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/itchyny/gojq"
+)
+
+// maxModuleLoads is how many modules a query can load, directly and through
+// the modules it imports, so modules importing each other fail instead of
+// recursing forever.
+const maxModuleLoads = 64
+
+// Modules are jq modules that queries can include or import by name, as in
+// `include "strings";` or `import "strings" as s;`.
+type Modules struct {
+	sources map[string]string
+	digest  string
+}
+
+// NewModules returns the modules with the given sources, by name.
+func NewModules(sources map[string]string) *Modules {
+
+	names := make([]string, 0, len(sources))
+	for name := range sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	for _, name := range names {
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+		h.Write([]byte(sources[name]))
+		h.Write([]byte{0})
+	}
+
+	return &Modules{
+		sources: sources,
+		digest:  hex.EncodeToString(h.Sum(nil)),
+	}
+
+}
+
+// ParseModule checks that source is a jq module, which can only import
+// other modules and define functions.
+func ParseModule(source string) error {
+
+	q, err := gojq.Parse(source)
+	if err != nil {
+		return err
+	}
+
+	if q.Left != nil || q.Term == nil || q.Term.Type != gojq.TermTypeIdentity || len(q.Term.SuffixList) > 0 {
+		return errors.New("a module can only import modules and define functions")
+	}
+
+	if len(q.FuncDefs) == 0 && len(q.Imports) == 0 {
+		return errors.New("module is empty")
+	}
+
+	return nil
+
+}
+
+type moduleLoader struct {
+	modules *Modules
+	loads   int
+}
+
+// LoadModule is called by gojq for every module a query being compiled
+// includes or imports.
+func (l *moduleLoader) LoadModule(name string) (*gojq.Query, error) {
+
+	l.loads++
+	if l.loads > maxModuleLoads {
+		return nil, fmt.Errorf("more than %d modules loaded, modules may import each other", maxModuleLoads)
+	}
+
+	source, ok := l.modules.sources[name]
+	if !ok {
+		return nil, fmt.Errorf("module not found: %q", name)
+	}
+
+	q, err := gojq.Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse module %q: %v", name, err)
+	}
+
+	return q, nil
+
+}
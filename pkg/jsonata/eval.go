@@ -0,0 +1,842 @@
+package jsonata
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// undefinedType is the type of what jsonata calls undefined: the result of
+// a path that matches nothing, which is distinct from null.
+type undefinedType struct{}
+
+var undefined = undefinedType{}
+
+func isUndefined(v interface{}) bool {
+	_, ok := v.(undefinedType)
+	return ok
+}
+
+// sequence is the result of a path, which is flattened into the results of
+// the paths it is part of, unlike an array.
+type sequence []interface{}
+
+// collapse returns the value of a sequence as jsonata sees it.
+func collapse(seq sequence) interface{} {
+
+	switch len(seq) {
+	case 0:
+		return undefined
+	case 1:
+		return seq[0]
+	}
+
+	return seq
+
+}
+
+// items returns the values of v as an array.
+func items(v interface{}) []interface{} {
+
+	switch x := v.(type) {
+	case undefinedType:
+		return nil
+	case sequence:
+		return x
+	case []interface{}:
+		return x
+	}
+
+	return []interface{}{v}
+
+}
+
+type lambda struct {
+	params []string
+	body   *node
+	env    *environment
+}
+
+// maxDepth is how deep lambdas can call each other, so that recursive
+// ones fail instead of exhausting the stack.
+const maxDepth = 500
+
+type environment struct {
+	vars   map[string]interface{}
+	parent *environment
+	root   interface{}
+	depth  *int
+}
+
+func (env *environment) child() *environment {
+	return &environment{
+		vars:   make(map[string]interface{}),
+		parent: env,
+		root:   env.root,
+		depth:  env.depth,
+	}
+}
+
+func (env *environment) lookup(name string) (interface{}, bool) {
+
+	for e := env; e != nil; e = e.parent {
+		if v, ok := e.vars[name]; ok {
+			return v, true
+		}
+	}
+
+	if f, ok := builtins[name]; ok {
+		return f, true
+	}
+
+	return nil, false
+
+}
+
+func errorAt(n *node, format string, a ...interface{}) error {
+	return &Error{
+		Position: n.pos,
+		Message:  fmt.Sprintf(format, a...),
+	}
+}
+
+func eval(n *node, input interface{}, env *environment) (interface{}, error) {
+
+	switch n.typ {
+
+	case nodeLiteral:
+		return n.value, nil
+
+	case nodeName:
+		return lookupField(input, n.name), nil
+
+	case nodeWildcard:
+		return wildcard(input), nil
+
+	case nodeDescendant:
+		var seq sequence
+		descendants(input, &seq)
+		return collapse(seq), nil
+
+	case nodeVariable:
+		switch n.name {
+		case "":
+			return input, nil
+		case "$":
+			return env.root, nil
+		}
+		v, ok := env.lookup(n.name)
+		if !ok {
+			return undefined, nil
+		}
+		return v, nil
+
+	case nodePath:
+		return evalPath(n, input, env)
+
+	case nodePredicate:
+		return evalPredicate(n, input, env)
+
+	case nodeBinary:
+		return evalBinary(n, input, env)
+
+	case nodeNegate:
+		v, err := eval(n.lhs, input, env)
+		if err != nil {
+			return nil, err
+		}
+		if isUndefined(v) {
+			return undefined, nil
+		}
+		f, ok := v.(float64)
+		if !ok {
+			return nil, errorAt(n, "cannot negate a %s", typeOf(v))
+		}
+		return -f, nil
+
+	case nodeArray:
+		return evalArray(n, input, env)
+
+	case nodeRange:
+		return nil, errorAt(n, "a range is only allowed in an array constructor")
+
+	case nodeObject:
+		return evalObject(n, input, env)
+
+	case nodeBlock:
+		scope := env.child()
+		var v interface{} = undefined
+		for _, expr := range n.nodes {
+			var err error
+			v, err = eval(expr, input, scope)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+
+	case nodeCondition:
+		c, err := eval(n.cond, input, env)
+		if err != nil {
+			return nil, err
+		}
+		if toBoolean(c) {
+			return eval(n.lhs, input, env)
+		}
+		if n.rhs == nil {
+			return undefined, nil
+		}
+		return eval(n.rhs, input, env)
+
+	case nodeBind:
+		v, err := eval(n.rhs, input, env)
+		if err != nil {
+			return nil, err
+		}
+		env.vars[n.name] = v
+		return v, nil
+
+	case nodeCall:
+		fn, err := eval(n.lhs, input, env)
+		if err != nil {
+			return nil, err
+		}
+		args, err := evalArgs(n.nodes, input, env)
+		if err != nil {
+			return nil, err
+		}
+		return apply(n, fn, args, input)
+
+	case nodeLambda:
+		return &lambda{
+			params: n.names,
+			body:   n.lhs,
+			env:    env,
+		}, nil
+
+	case nodeApply:
+		lhs, err := eval(n.lhs, input, env)
+		if err != nil {
+			return nil, err
+		}
+		if n.rhs.typ == nodeCall {
+			fn, err := eval(n.rhs.lhs, input, env)
+			if err != nil {
+				return nil, err
+			}
+			args, err := evalArgs(n.rhs.nodes, input, env)
+			if err != nil {
+				return nil, err
+			}
+			return apply(n.rhs, fn, append([]interface{}{lhs}, args...), input)
+		}
+		fn, err := eval(n.rhs, input, env)
+		if err != nil {
+			return nil, err
+		}
+		return apply(n, fn, []interface{}{lhs}, input)
+
+	}
+
+	return nil, errorAt(n, "unknown expression")
+
+}
+
+func evalArgs(nodes []*node, input interface{}, env *environment) ([]interface{}, error) {
+
+	args := make([]interface{}, len(nodes))
+
+	for i := range nodes {
+		v, err := eval(nodes[i], input, env)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+
+	return args, nil
+
+}
+
+// apply calls a function with the given arguments. Lambdas get as many of
+// them as they declare parameters, which is how the callbacks of functions
+// like $map can leave out the index and the array.
+func apply(n *node, fn interface{}, args []interface{}, input interface{}) (interface{}, error) {
+
+	switch f := fn.(type) {
+
+	case *builtin:
+		return f.call(&callContext{node: n, input: input}, args)
+
+	case *lambda:
+		if *f.env.depth >= maxDepth {
+			return nil, errorAt(n, "functions nested more than %d deep", maxDepth)
+		}
+		*f.env.depth++
+		defer func() {
+			*f.env.depth--
+		}()
+		scope := f.env.child()
+		for i, name := range f.params {
+			if i < len(args) {
+				scope.vars[name] = args[i]
+			} else {
+				scope.vars[name] = undefined
+			}
+		}
+		return eval(f.body, input, scope)
+
+	}
+
+	if isUndefined(fn) {
+		return nil, errorAt(n, "attempted to call an undefined function")
+	}
+
+	return nil, errorAt(n, "attempted to call a %s", typeOf(fn))
+
+}
+
+func lookupField(input interface{}, name string) interface{} {
+
+	switch x := input.(type) {
+
+	case map[string]interface{}:
+		v, ok := x[name]
+		if !ok {
+			return undefined
+		}
+		return v
+
+	case []interface{}, sequence:
+		var seq sequence
+		for _, item := range items(x) {
+			seq = appendFlat(seq, lookupField(item, name))
+		}
+		return collapse(seq)
+
+	}
+
+	return undefined
+
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+
+}
+
+func wildcard(input interface{}) interface{} {
+
+	var seq sequence
+
+	switch x := input.(type) {
+	case map[string]interface{}:
+		for _, k := range sortedKeys(x) {
+			seq = appendFlat(seq, x[k])
+		}
+	case []interface{}, sequence:
+		for _, item := range items(x) {
+			seq = appendFlat(seq, wildcard(item))
+		}
+	}
+
+	return collapse(seq)
+
+}
+
+func descendants(input interface{}, seq *sequence) {
+
+	switch x := input.(type) {
+	case map[string]interface{}:
+		for _, k := range sortedKeys(x) {
+			v := x[k]
+			if _, ok := v.([]interface{}); !ok {
+				*seq = append(*seq, v)
+			}
+			descendants(v, seq)
+		}
+	case []interface{}:
+		for _, item := range x {
+			if _, ok := item.([]interface{}); !ok {
+				*seq = append(*seq, item)
+			}
+			descendants(item, seq)
+		}
+	}
+
+}
+
+// appendFlat adds a value to a sequence, flattening arrays and sequences.
+func appendFlat(seq sequence, v interface{}) sequence {
+
+	switch x := v.(type) {
+	case undefinedType:
+		return seq
+	case sequence:
+		return append(seq, x...)
+	case []interface{}:
+		return append(seq, x...)
+	}
+
+	return append(seq, v)
+
+}
+
+func evalPath(n *node, input interface{}, env *environment) (interface{}, error) {
+
+	current := sequence{input}
+
+	for i, step := range n.nodes {
+
+		var next sequence
+
+		for _, item := range current {
+
+			v, err := eval(step, item, env)
+			if err != nil {
+				return nil, err
+			}
+
+			// arrays built by a step are kept as they are
+			if step.typ == nodeArray && i > 0 {
+				next = append(next, v)
+				continue
+			}
+
+			next = appendFlat(next, v)
+
+		}
+
+		current = next
+
+	}
+
+	return collapse(current), nil
+
+}
+
+func evalPredicate(n *node, input interface{}, env *environment) (interface{}, error) {
+
+	v, err := eval(n.lhs, input, env)
+	if err != nil {
+		return nil, err
+	}
+
+	all := items(v)
+
+	var seq sequence
+
+	for i, item := range all {
+
+		p, err := eval(n.rhs, item, env)
+		if err != nil {
+			return nil, err
+		}
+
+		if indexes, ok := predicateIndexes(p); ok {
+			for _, f := range indexes {
+				idx := int(math.Floor(f))
+				if idx < 0 {
+					idx += len(all)
+				}
+				if idx == i {
+					seq = append(seq, item)
+					break
+				}
+			}
+			continue
+		}
+
+		if toBoolean(p) {
+			seq = append(seq, item)
+		}
+
+	}
+
+	return collapse(seq), nil
+
+}
+
+// predicateIndexes returns the indexes a predicate selects if it evaluated
+// to a number or to an array of numbers.
+func predicateIndexes(p interface{}) ([]float64, bool) {
+
+	if f, ok := p.(float64); ok {
+		return []float64{f}, true
+	}
+
+	arr, ok := p.([]interface{})
+	if !ok || len(arr) == 0 {
+		return nil, false
+	}
+
+	indexes := make([]float64, len(arr))
+	for i := range arr {
+		f, ok := arr[i].(float64)
+		if !ok {
+			return nil, false
+		}
+		indexes[i] = f
+	}
+
+	return indexes, true
+
+}
+
+func evalArray(n *node, input interface{}, env *environment) (interface{}, error) {
+
+	arr := make([]interface{}, 0, len(n.nodes))
+
+	for _, item := range n.nodes {
+
+		if item.typ == nodeRange {
+			r, err := evalRange(item, input, env)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, r...)
+			continue
+		}
+
+		v, err := eval(item, input, env)
+		if err != nil {
+			return nil, err
+		}
+
+		switch x := v.(type) {
+		case undefinedType:
+		case sequence:
+			arr = append(arr, x...)
+		default:
+			arr = append(arr, v)
+		}
+
+	}
+
+	return arr, nil
+
+}
+
+// maxRange keeps ranges from exhausting memory.
+const maxRange = 1000000
+
+func evalRange(n *node, input interface{}, env *environment) ([]interface{}, error) {
+
+	from, err := eval(n.lhs, input, env)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := eval(n.rhs, input, env)
+	if err != nil {
+		return nil, err
+	}
+
+	if isUndefined(from) || isUndefined(to) {
+		return nil, nil
+	}
+
+	a, ok1 := from.(float64)
+	b, ok2 := to.(float64)
+	if !ok1 || !ok2 || a != math.Floor(a) || b != math.Floor(b) {
+		return nil, errorAt(n, "the bounds of a range must be integers")
+	}
+
+	if b-a >= maxRange {
+		return nil, errorAt(n, "range of more than %d items", maxRange)
+	}
+
+	var r []interface{}
+	for i := a; i <= b; i++ {
+		r = append(r, i)
+	}
+
+	return r, nil
+
+}
+
+func evalObject(n *node, input interface{}, env *environment) (interface{}, error) {
+
+	obj := make(map[string]interface{})
+
+	for _, pair := range n.pairs {
+
+		k, err := eval(pair[0], input, env)
+		if err != nil {
+			return nil, err
+		}
+
+		key, ok := k.(string)
+		if !ok {
+			return nil, errorAt(pair[0], "object key must be a string, not a %s", typeOf(k))
+		}
+
+		v, err := eval(pair[1], input, env)
+		if err != nil {
+			return nil, err
+		}
+
+		if isUndefined(v) {
+			continue
+		}
+
+		obj[key] = v
+
+	}
+
+	return obj, nil
+
+}
+
+func evalBinary(n *node, input interface{}, env *environment) (interface{}, error) {
+
+	lhs, err := eval(n.lhs, input, env)
+	if err != nil {
+		return nil, err
+	}
+
+	// and and or don't evaluate their right side unless they have to
+	switch n.op {
+	case "and":
+		if !toBoolean(lhs) {
+			return false, nil
+		}
+	case "or":
+		if toBoolean(lhs) {
+			return true, nil
+		}
+	}
+
+	rhs, err := eval(n.rhs, input, env)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+
+	case "and", "or":
+		return toBoolean(rhs), nil
+
+	case "&":
+		a, err := toString(n, lhs)
+		if err != nil {
+			return nil, err
+		}
+		b, err := toString(n, rhs)
+		if err != nil {
+			return nil, err
+		}
+		return a + b, nil
+
+	case "=":
+		if isUndefined(lhs) || isUndefined(rhs) {
+			return false, nil
+		}
+		return deepEqual(lhs, rhs), nil
+
+	case "!=":
+		if isUndefined(lhs) || isUndefined(rhs) {
+			return false, nil
+		}
+		return !deepEqual(lhs, rhs), nil
+
+	case "in":
+		if isUndefined(lhs) {
+			return false, nil
+		}
+		for _, item := range items(rhs) {
+			if deepEqual(lhs, item) {
+				return true, nil
+			}
+		}
+		return false, nil
+
+	case "<", "<=", ">", ">=":
+		return compare(n, lhs, rhs)
+
+	}
+
+	if isUndefined(lhs) || isUndefined(rhs) {
+		return undefined, nil
+	}
+
+	a, ok := lhs.(float64)
+	if !ok {
+		return nil, errorAt(n, "left side of %s must be a number, not a %s", n.op, typeOf(lhs))
+	}
+
+	b, ok := rhs.(float64)
+	if !ok {
+		return nil, errorAt(n, "right side of %s must be a number, not a %s", n.op, typeOf(rhs))
+	}
+
+	var r float64
+
+	switch n.op {
+	case "+":
+		r = a + b
+	case "-":
+		r = a - b
+	case "*":
+		r = a * b
+	case "/":
+		r = a / b
+	case "%":
+		r = math.Mod(a, b)
+	}
+
+	if math.IsInf(r, 0) || math.IsNaN(r) {
+		return nil, errorAt(n, "number out of range")
+	}
+
+	return r, nil
+
+}
+
+func compare(n *node, lhs, rhs interface{}) (interface{}, error) {
+
+	if isUndefined(lhs) || isUndefined(rhs) {
+		return false, nil
+	}
+
+	var c int
+
+	switch a := lhs.(type) {
+
+	case float64:
+		b, ok := rhs.(float64)
+		if !ok {
+			return nil, errorAt(n, "cannot compare a number with a %s", typeOf(rhs))
+		}
+		if a < b {
+			c = -1
+		} else if a > b {
+			c = 1
+		}
+
+	case string:
+		b, ok := rhs.(string)
+		if !ok {
+			return nil, errorAt(n, "cannot compare a string with a %s", typeOf(rhs))
+		}
+		if a < b {
+			c = -1
+		} else if a > b {
+			c = 1
+		}
+
+	default:
+		return nil, errorAt(n, "cannot compare a %s", typeOf(lhs))
+
+	}
+
+	switch n.op {
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	}
+
+	return c >= 0, nil
+
+}
+
+func deepEqual(a, b interface{}) bool {
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+// normalize turns the sequences in v into plain arrays.
+func normalize(v interface{}) interface{} {
+
+	switch x := v.(type) {
+
+	case sequence:
+		arr := make([]interface{}, len(x))
+		for i := range x {
+			arr[i] = normalize(x[i])
+		}
+		return arr
+
+	case []interface{}:
+		arr := make([]interface{}, len(x))
+		for i := range x {
+			arr[i] = normalize(x[i])
+		}
+		return arr
+
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(x))
+		for k := range x {
+			m[k] = normalize(x[k])
+		}
+		return m
+
+	}
+
+	return v
+
+}
+
+// toBoolean casts a value to a boolean the way jsonata does.
+func toBoolean(v interface{}) bool {
+
+	switch x := v.(type) {
+	case undefinedType, nil:
+		return false
+	case bool:
+		return x
+	case float64:
+		return x != 0
+	case string:
+		return x != ""
+	case sequence, []interface{}:
+		for _, item := range items(x) {
+			if toBoolean(item) {
+				return true
+			}
+		}
+		return false
+	case map[string]interface{}:
+		return len(x) > 0
+	case *lambda, *builtin:
+		return false
+	}
+
+	return true
+
+}
+
+func typeOf(v interface{}) string {
+
+	switch v.(type) {
+	case undefinedType:
+		return "undefined"
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case sequence, []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	case *lambda, *builtin:
+		return "function"
+	}
+
+	return fmt.Sprintf("%T", v)
+
+}
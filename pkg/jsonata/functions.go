@@ -0,0 +1,865 @@
+package jsonata
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type callContext struct {
+	node  *node
+	input interface{}
+}
+
+// builtin is a function of the standard library. If it is called with fewer
+// than min arguments and takes the context, the context is its first one.
+type builtin struct {
+	name    string
+	min     int
+	max     int
+	context bool
+	fn      func(ctx *callContext, args []interface{}) (interface{}, error)
+}
+
+func (b *builtin) call(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	if len(args) == b.min-1 && b.context {
+		args = append([]interface{}{ctx.input}, args...)
+	}
+
+	if len(args) < b.min || (b.max >= 0 && len(args) > b.max) {
+		return nil, errorAt(ctx.node, "wrong number of arguments for $%s", b.name)
+	}
+
+	return b.fn(ctx, args)
+
+}
+
+var builtins map[string]*builtin
+
+// unsupportedFunctions are the functions of the standard library that aren't
+// implemented.
+var unsupportedFunctions = map[string]bool{
+	"pad":                true,
+	"match":              true,
+	"formatNumber":       true,
+	"formatBase":         true,
+	"formatInteger":      true,
+	"parseInteger":       true,
+	"base64encode":       true,
+	"base64decode":       true,
+	"encodeUrlComponent": true,
+	"encodeUrl":          true,
+	"decodeUrlComponent": true,
+	"decodeUrl":          true,
+	"eval":               true,
+	"random":             true,
+	"single":             true,
+	"zip":                true,
+	"shuffle":            true,
+	"spread":             true,
+	"sift":               true,
+	"toMillis":           true,
+	"fromMillis":         true,
+	"error":              true,
+	"assert":             true,
+}
+
+func init() {
+
+	builtins = make(map[string]*builtin)
+
+	for _, b := range []*builtin{
+		{name: "string", min: 1, max: 1, context: true, fn: fnString},
+		{name: "length", min: 1, max: 1, context: true, fn: fnLength},
+		{name: "substring", min: 2, max: 3, context: true, fn: fnSubstring},
+		{name: "substringBefore", min: 2, max: 2, context: true, fn: fnSubstringBefore},
+		{name: "substringAfter", min: 2, max: 2, context: true, fn: fnSubstringAfter},
+		{name: "uppercase", min: 1, max: 1, context: true, fn: stringFunc(strings.ToUpper)},
+		{name: "lowercase", min: 1, max: 1, context: true, fn: stringFunc(strings.ToLower)},
+		{name: "trim", min: 1, max: 1, context: true, fn: stringFunc(func(s string) string {
+			return strings.Join(strings.Fields(s), " ")
+		})},
+		{name: "contains", min: 2, max: 2, context: true, fn: fnContains},
+		{name: "split", min: 2, max: 3, context: true, fn: fnSplit},
+		{name: "join", min: 1, max: 2, fn: fnJoin},
+		{name: "replace", min: 3, max: 4, context: true, fn: fnReplace},
+		{name: "number", min: 1, max: 1, context: true, fn: fnNumber},
+		{name: "abs", min: 1, max: 1, context: true, fn: numberFunc(math.Abs)},
+		{name: "floor", min: 1, max: 1, context: true, fn: numberFunc(math.Floor)},
+		{name: "ceil", min: 1, max: 1, context: true, fn: numberFunc(math.Ceil)},
+		{name: "sqrt", min: 1, max: 1, context: true, fn: numberFunc(math.Sqrt)},
+		{name: "round", min: 1, max: 2, context: true, fn: fnRound},
+		{name: "power", min: 2, max: 2, context: true, fn: fnPower},
+		{name: "sum", min: 1, max: 1, fn: aggregate(func(ns []float64) interface{} {
+			var sum float64
+			for _, n := range ns {
+				sum += n
+			}
+			return sum
+		})},
+		{name: "max", min: 1, max: 1, fn: aggregate(func(ns []float64) interface{} {
+			if len(ns) == 0 {
+				return undefined
+			}
+			m := ns[0]
+			for _, n := range ns[1:] {
+				m = math.Max(m, n)
+			}
+			return m
+		})},
+		{name: "min", min: 1, max: 1, fn: aggregate(func(ns []float64) interface{} {
+			if len(ns) == 0 {
+				return undefined
+			}
+			m := ns[0]
+			for _, n := range ns[1:] {
+				m = math.Min(m, n)
+			}
+			return m
+		})},
+		{name: "average", min: 1, max: 1, fn: aggregate(func(ns []float64) interface{} {
+			if len(ns) == 0 {
+				return undefined
+			}
+			var sum float64
+			for _, n := range ns {
+				sum += n
+			}
+			return sum / float64(len(ns))
+		})},
+		{name: "count", min: 1, max: 1, fn: fnCount},
+		{name: "append", min: 2, max: 2, fn: fnAppend},
+		{name: "reverse", min: 1, max: 1, fn: fnReverse},
+		{name: "sort", min: 1, max: 2, fn: fnSort},
+		{name: "distinct", min: 1, max: 1, fn: fnDistinct},
+		{name: "keys", min: 1, max: 1, context: true, fn: fnKeys},
+		{name: "lookup", min: 2, max: 2, context: true, fn: fnLookup},
+		{name: "merge", min: 1, max: 1, fn: fnMerge},
+		{name: "exists", min: 1, max: 1, fn: fnExists},
+		{name: "boolean", min: 1, max: 1, context: true, fn: fnBoolean},
+		{name: "not", min: 1, max: 1, context: true, fn: fnNot},
+		{name: "type", min: 1, max: 1, fn: fnType},
+		{name: "map", min: 2, max: 2, fn: fnMap},
+		{name: "filter", min: 2, max: 2, fn: fnFilter},
+		{name: "reduce", min: 2, max: 3, fn: fnReduce},
+		{name: "each", min: 2, max: 2, context: true, fn: fnEach},
+		{name: "now", min: 0, max: 0, fn: fnNow},
+		{name: "millis", min: 0, max: 0, fn: fnMillis},
+	} {
+		builtins[b.name] = b
+	}
+
+}
+
+// formatNumber formats a number the way jsonata does, without an exponent
+// for integers that fit.
+func formatNumber(f float64) string {
+
+	if f == math.Trunc(f) && math.Abs(f) < 1e21 {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+
+	return strconv.FormatFloat(f, 'g', 15, 64)
+
+}
+
+func toString(n *node, v interface{}) (string, error) {
+
+	switch x := v.(type) {
+	case undefinedType:
+		return "", nil
+	case string:
+		return x, nil
+	case float64:
+		return formatNumber(x), nil
+	case *lambda, *builtin:
+		return "", nil
+	}
+
+	data, err := json.Marshal(normalize(v))
+	if err != nil {
+		return "", errorAt(n, "cannot convert a %s to a string: %v", typeOf(v), err)
+	}
+
+	return string(data), nil
+
+}
+
+func argString(ctx *callContext, args []interface{}, i int) (string, bool, error) {
+
+	if i >= len(args) || isUndefined(args[i]) {
+		return "", false, nil
+	}
+
+	s, ok := args[i].(string)
+	if !ok {
+		return "", false, errorAt(ctx.node, "argument %d must be a string, not a %s", i+1, typeOf(args[i]))
+	}
+
+	return s, true, nil
+
+}
+
+func argNumber(ctx *callContext, args []interface{}, i int) (float64, bool, error) {
+
+	if i >= len(args) || isUndefined(args[i]) {
+		return 0, false, nil
+	}
+
+	f, ok := args[i].(float64)
+	if !ok {
+		return 0, false, errorAt(ctx.node, "argument %d must be a number, not a %s", i+1, typeOf(args[i]))
+	}
+
+	return f, true, nil
+
+}
+
+func argFunction(ctx *callContext, args []interface{}, i int) (interface{}, error) {
+
+	switch args[i].(type) {
+	case *lambda, *builtin:
+		return args[i], nil
+	}
+
+	return nil, errorAt(ctx.node, "argument %d must be a function, not a %s", i+1, typeOf(args[i]))
+
+}
+
+func stringFunc(f func(string) string) func(*callContext, []interface{}) (interface{}, error) {
+	return func(ctx *callContext, args []interface{}) (interface{}, error) {
+		s, ok, err := argString(ctx, args, 0)
+		if err != nil || !ok {
+			return undefined, err
+		}
+		return f(s), nil
+	}
+}
+
+func numberFunc(f func(float64) float64) func(*callContext, []interface{}) (interface{}, error) {
+	return func(ctx *callContext, args []interface{}) (interface{}, error) {
+		x, ok, err := argNumber(ctx, args, 0)
+		if err != nil || !ok {
+			return undefined, err
+		}
+		r := f(x)
+		if math.IsNaN(r) || math.IsInf(r, 0) {
+			return nil, errorAt(ctx.node, "number out of range")
+		}
+		return r, nil
+	}
+}
+
+func aggregate(f func([]float64) interface{}) func(*callContext, []interface{}) (interface{}, error) {
+	return func(ctx *callContext, args []interface{}) (interface{}, error) {
+		if isUndefined(args[0]) {
+			return undefined, nil
+		}
+		var ns []float64
+		for _, item := range items(args[0]) {
+			x, ok := item.(float64)
+			if !ok {
+				return nil, errorAt(ctx.node, "argument must be an array of numbers")
+			}
+			ns = append(ns, x)
+		}
+		return f(ns), nil
+	}
+}
+
+func fnString(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	if isUndefined(args[0]) {
+		return undefined, nil
+	}
+
+	return toString(ctx.node, args[0])
+
+}
+
+func fnLength(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	s, ok, err := argString(ctx, args, 0)
+	if err != nil || !ok {
+		return undefined, err
+	}
+
+	return float64(utf8.RuneCountInString(s)), nil
+
+}
+
+func fnSubstring(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	s, ok, err := argString(ctx, args, 0)
+	if err != nil || !ok {
+		return undefined, err
+	}
+
+	start, _, err := argNumber(ctx, args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	runes := []rune(s)
+
+	from := int(start)
+	if from < 0 {
+		from += len(runes)
+		if from < 0 {
+			from = 0
+		}
+	}
+	if from > len(runes) {
+		from = len(runes)
+	}
+
+	to := len(runes)
+	if l, ok, err := argNumber(ctx, args, 2); err != nil {
+		return nil, err
+	} else if ok {
+		if l < 0 {
+			l = 0
+		}
+		if from+int(l) < to {
+			to = from + int(l)
+		}
+	}
+
+	return string(runes[from:to]), nil
+
+}
+
+func fnSubstringBefore(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	s, ok, err := argString(ctx, args, 0)
+	if err != nil || !ok {
+		return undefined, err
+	}
+
+	sep, _, err := argString(ctx, args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], nil
+	}
+
+	return s, nil
+
+}
+
+func fnSubstringAfter(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	s, ok, err := argString(ctx, args, 0)
+	if err != nil || !ok {
+		return undefined, err
+	}
+
+	sep, _, err := argString(ctx, args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[i+len(sep):], nil
+	}
+
+	return s, nil
+
+}
+
+func fnContains(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	s, ok, err := argString(ctx, args, 0)
+	if err != nil || !ok {
+		return undefined, err
+	}
+
+	sub, _, err := argString(ctx, args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Contains(s, sub), nil
+
+}
+
+func fnSplit(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	s, ok, err := argString(ctx, args, 0)
+	if err != nil || !ok {
+		return undefined, err
+	}
+
+	sep, _, err := argString(ctx, args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	n := -1
+	if limit, ok, err := argNumber(ctx, args, 2); err != nil {
+		return nil, err
+	} else if ok {
+		n = int(limit)
+	}
+
+	parts := strings.Split(s, sep)
+	if n >= 0 && n < len(parts) {
+		parts = parts[:n]
+	}
+
+	arr := make([]interface{}, len(parts))
+	for i := range parts {
+		arr[i] = parts[i]
+	}
+
+	return arr, nil
+
+}
+
+func fnJoin(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	if isUndefined(args[0]) {
+		return undefined, nil
+	}
+
+	sep, _, err := argString(ctx, args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []string
+	for _, item := range items(args[0]) {
+		s, ok := item.(string)
+		if !ok {
+			return nil, errorAt(ctx.node, "argument 1 of $join must be an array of strings")
+		}
+		parts = append(parts, s)
+	}
+
+	return strings.Join(parts, sep), nil
+
+}
+
+func fnReplace(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	s, ok, err := argString(ctx, args, 0)
+	if err != nil || !ok {
+		return undefined, err
+	}
+
+	pattern, _, err := argString(ctx, args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if pattern == "" {
+		return nil, errorAt(ctx.node, "the pattern of $replace cannot be empty")
+	}
+
+	replacement, _, err := argString(ctx, args, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	n := -1
+	if limit, ok, err := argNumber(ctx, args, 3); err != nil {
+		return nil, err
+	} else if ok {
+		n = int(limit)
+	}
+
+	return strings.Replace(s, pattern, replacement, n), nil
+
+}
+
+func fnNumber(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	switch x := args[0].(type) {
+	case undefinedType:
+		return undefined, nil
+	case float64:
+		return x, nil
+	case bool:
+		if x {
+			return float64(1), nil
+		}
+		return float64(0), nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(x), 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, errorAt(ctx.node, "cannot convert %q to a number", x)
+		}
+		return f, nil
+	}
+
+	return nil, errorAt(ctx.node, "cannot convert a %s to a number", typeOf(args[0]))
+
+}
+
+func fnRound(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	x, ok, err := argNumber(ctx, args, 0)
+	if err != nil || !ok {
+		return undefined, err
+	}
+
+	precision, _, err := argNumber(ctx, args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	// jsonata rounds half to even
+	p := math.Pow(10, precision)
+
+	return math.RoundToEven(x*p) / p, nil
+
+}
+
+func fnPower(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	x, ok, err := argNumber(ctx, args, 0)
+	if err != nil || !ok {
+		return undefined, err
+	}
+
+	y, _, err := argNumber(ctx, args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	r := math.Pow(x, y)
+	if math.IsNaN(r) || math.IsInf(r, 0) {
+		return nil, errorAt(ctx.node, "number out of range")
+	}
+
+	return r, nil
+
+}
+
+func fnCount(ctx *callContext, args []interface{}) (interface{}, error) {
+	return float64(len(items(args[0]))), nil
+}
+
+func fnAppend(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	if isUndefined(args[0]) {
+		return args[1], nil
+	}
+
+	if isUndefined(args[1]) {
+		return args[0], nil
+	}
+
+	arr := append([]interface{}{}, items(args[0])...)
+
+	return append(arr, items(args[1])...), nil
+
+}
+
+func fnReverse(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	if isUndefined(args[0]) {
+		return undefined, nil
+	}
+
+	in := items(args[0])
+	arr := make([]interface{}, len(in))
+	for i := range in {
+		arr[len(in)-1-i] = in[i]
+	}
+
+	return arr, nil
+
+}
+
+func fnSort(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	if isUndefined(args[0]) {
+		return undefined, nil
+	}
+
+	arr := append([]interface{}{}, items(args[0])...)
+
+	var err error
+
+	if len(args) > 1 {
+
+		fn, err := argFunction(ctx, args, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		// the function tells whether its first argument goes after the
+		// second one
+		sort.SliceStable(arr, func(i, j int) bool {
+			if err != nil {
+				return false
+			}
+			var after interface{}
+			after, err = apply(ctx.node, fn, []interface{}{arr[i], arr[j]}, ctx.input)
+			if err != nil {
+				return false
+			}
+			if toBoolean(after) {
+				return false
+			}
+			after, err = apply(ctx.node, fn, []interface{}{arr[j], arr[i]}, ctx.input)
+			return err == nil && toBoolean(after)
+		})
+
+		return arr, err
+
+	}
+
+	sort.SliceStable(arr, func(i, j int) bool {
+		switch a := arr[i].(type) {
+		case float64:
+			if b, ok := arr[j].(float64); ok {
+				return a < b
+			}
+		case string:
+			if b, ok := arr[j].(string); ok {
+				return a < b
+			}
+		}
+		if err == nil {
+			err = errorAt(ctx.node, "$sort can only sort numbers or strings without a function")
+		}
+		return false
+	})
+
+	return arr, err
+
+}
+
+func fnDistinct(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	if isUndefined(args[0]) {
+		return undefined, nil
+	}
+
+	var arr []interface{}
+
+outer:
+	for _, item := range items(args[0]) {
+		for _, seen := range arr {
+			if deepEqual(item, seen) {
+				continue outer
+			}
+		}
+		arr = append(arr, item)
+	}
+
+	return arr, nil
+
+}
+
+func fnKeys(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	var arr []interface{}
+	seen := make(map[string]bool)
+
+	for _, item := range items(args[0]) {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, k := range sortedKeys(m) {
+			if !seen[k] {
+				seen[k] = true
+				arr = append(arr, k)
+			}
+		}
+	}
+
+	return collapse(sequence(arr)), nil
+
+}
+
+func fnLookup(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	key, _, err := argString(ctx, args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return lookupField(args[0], key), nil
+
+}
+
+func fnMerge(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	if isUndefined(args[0]) {
+		return undefined, nil
+	}
+
+	obj := make(map[string]interface{})
+
+	for _, item := range items(args[0]) {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, errorAt(ctx.node, "argument of $merge must be an array of objects")
+		}
+		for k, v := range m {
+			obj[k] = v
+		}
+	}
+
+	return obj, nil
+
+}
+
+func fnExists(ctx *callContext, args []interface{}) (interface{}, error) {
+	return !isUndefined(args[0]), nil
+}
+
+func fnBoolean(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	if isUndefined(args[0]) {
+		return undefined, nil
+	}
+
+	return toBoolean(args[0]), nil
+
+}
+
+func fnNot(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	if isUndefined(args[0]) {
+		return undefined, nil
+	}
+
+	return !toBoolean(args[0]), nil
+
+}
+
+func fnType(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	if isUndefined(args[0]) {
+		return undefined, nil
+	}
+
+	return typeOf(args[0]), nil
+
+}
+
+func fnMap(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	fn, err := argFunction(ctx, args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	in := items(args[0])
+
+	var seq sequence
+	for i, item := range in {
+		v, err := apply(ctx.node, fn, []interface{}{item, float64(i), in}, ctx.input)
+		if err != nil {
+			return nil, err
+		}
+		if !isUndefined(v) {
+			seq = append(seq, v)
+		}
+	}
+
+	return collapse(seq), nil
+
+}
+
+func fnFilter(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	fn, err := argFunction(ctx, args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	in := items(args[0])
+
+	var seq sequence
+	for i, item := range in {
+		v, err := apply(ctx.node, fn, []interface{}{item, float64(i), in}, ctx.input)
+		if err != nil {
+			return nil, err
+		}
+		if toBoolean(v) {
+			seq = append(seq, item)
+		}
+	}
+
+	return collapse(seq), nil
+
+}
+
+func fnReduce(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	fn, err := argFunction(ctx, args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	in := items(args[0])
+
+	var acc interface{} = undefined
+	if len(args) > 2 {
+		acc = args[2]
+	} else if len(in) > 0 {
+		acc = in[0]
+		in = in[1:]
+	}
+
+	for i, item := range in {
+		acc, err = apply(ctx.node, fn, []interface{}{acc, item, float64(i), in}, ctx.input)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return acc, nil
+
+}
+
+func fnEach(ctx *callContext, args []interface{}) (interface{}, error) {
+
+	m, ok := args[0].(map[string]interface{})
+	if !ok {
+		if isUndefined(args[0]) {
+			return undefined, nil
+		}
+		return nil, errorAt(ctx.node, "argument 1 of $each must be an object")
+	}
+
+	fn, err := argFunction(ctx, args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	var seq sequence
+	for _, k := range sortedKeys(m) {
+		v, err := apply(ctx.node, fn, []interface{}{m[k], k, m}, ctx.input)
+		if err != nil {
+			return nil, err
+		}
+		if !isUndefined(v) {
+			seq = append(seq, v)
+		}
+	}
+
+	return collapse(seq), nil
+
+}
+
+func fnNow(ctx *callContext, args []interface{}) (interface{}, error) {
+	return time.Now().UTC().Format("2006-01-02T15:04:05.000Z"), nil
+}
+
+func fnMillis(ctx *callContext, args []interface{}) (interface{}, error) {
+	return float64(time.Now().UnixNano() / int64(time.Millisecond)), nil
+}
@@ -0,0 +1,113 @@
+// Package jsonata evaluates JSONata expressions against JSON data. It
+// implements the parts of the language that workflow transforms and
+// conditions use: paths with predicates and wildcards, operators,
+// conditionals, array and object constructors, variables and blocks,
+// lambdas and the ~> operator, and the common functions of the standard
+// library. Regular expressions, the parent operator, order-by, grouping,
+// context and positional variable bindings, and the rest of the standard
+// library aren't supported, and expressions using them fail to compile.
+// Objects have no key order once decoded, so wildcards and functions such as
+// $keys visit their keys in sorted order.
+package jsonata
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Error is an error in an expression, at a byte offset of its source.
+type Error struct {
+	Position int
+	Message  string
+}
+
+func (err *Error) Error() string {
+	return fmt.Sprintf("%s at position %d", err.Message, err.Position)
+}
+
+// Expression is a compiled expression. It is safe to evaluate concurrently.
+type Expression struct {
+	root *node
+}
+
+// Compile parses an expression.
+func Compile(src string) (*Expression, error) {
+
+	root, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkFunctions(root)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Expression{root: root}, nil
+
+}
+
+// Evaluate evaluates the expression against input, with vars bound as
+// variables, e.g. vars["const"] as $const. It reports whether there is a
+// result at all: jsonata distinguishes a path that matches nothing from one
+// that matches null. Variables named like built-in functions, such as now,
+// don't replace them.
+func (expr *Expression) Evaluate(input interface{}, vars map[string]interface{}) (interface{}, bool, error) {
+
+	input, err := jsonValue(input)
+	if err != nil {
+		return nil, false, err
+	}
+
+	env := &environment{
+		vars:  make(map[string]interface{}),
+		root:  input,
+		depth: new(int),
+	}
+
+	for k, v := range vars {
+		if _, ok := builtins[k]; ok {
+			continue
+		}
+		env.vars[k], err = jsonValue(v)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	v, err := eval(expr.root, input, env)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if isUndefined(v) {
+		return nil, false, nil
+	}
+
+	switch v.(type) {
+	case *lambda, *builtin:
+		return nil, false, fmt.Errorf("expression evaluates to a function")
+	}
+
+	return normalize(v), true, nil
+
+}
+
+// jsonValue returns v as it would be decoded from json, so that all numbers
+// are float64.
+func jsonValue(v interface{}) (interface{}, error) {
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var x interface{}
+	err = json.Unmarshal(data, &x)
+	if err != nil {
+		return nil, err
+	}
+
+	return x, nil
+
+}
@@ -0,0 +1,320 @@
+package jsonata
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const testData = `{
+  "name": "order",
+  "customer": {"name": "Jane", "address": {"city": "Berlin"}},
+  "orders": [
+    {"id": 1, "price": 10, "quantity": 2, "tags": ["a", "b"]},
+    {"id": 2, "price": 5.5, "quantity": 4, "tags": ["b"]},
+    {"id": 3, "price": 1, "quantity": 1, "tags": []}
+  ],
+  "empty": [],
+  "nothing": null,
+  "flag": true
+}`
+
+func evaluate(t *testing.T, src string, vars map[string]interface{}) (interface{}, bool, error) {
+
+	t.Helper()
+
+	var data interface{}
+	err := json.Unmarshal([]byte(testData), &data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expr, err := Compile(src)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return expr.Evaluate(data, vars)
+
+}
+
+func TestEvaluate(t *testing.T) {
+
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		// literals
+		{`1.5`, `1.5`},
+		{`"a" & 'b'`, `"ab"`},
+		{`"tab\té"`, `"tab\té"`},
+		{`true`, `true`},
+		{`null`, `null`},
+
+		// paths
+		{`name`, `"order"`},
+		{`customer.address.city`, `"Berlin"`},
+		{`customer.` + "`name`", `"Jane"`},
+		{`orders.id`, `[1,2,3]`},
+		{`orders.tags`, `["a","b","b"]`},
+		{`orders[0].id`, `1`},
+		{`orders[-1].id`, `3`},
+		{`orders[price > 2].id`, `[1,2]`},
+		{`orders[quantity = 4].price`, `5.5`},
+		{`orders[[0,2]].id`, `[1,3]`},
+		{`customer.*`, `[{"city":"Berlin"},"Jane"]`},
+		{`customer.**.city`, `"Berlin"`},
+		{`$.name`, `"order"`},
+		{`orders.($$.name)`, `["order","order","order"]`},
+		{`orders.(price * quantity)`, `[20,22,1]`},
+
+		// operators
+		{`1 + 2 * 3`, `7`},
+		{`(1 + 2) * 3`, `9`},
+		{`7 % 4 - -1`, `4`},
+		{`10 / 4`, `2.5`},
+		{`1 < 2 and 2 <= 2 and "b" > "a"`, `true`},
+		{`1 = 2 or 1 != 2`, `true`},
+		{`"b" in orders[0].tags`, `true`},
+		{`[1..4]`, `[1,2,3,4]`},
+		{`[1, [2, 3], 4]`, `[1,[2,3],4]`},
+		{`{"total": $sum(orders.price), "ids": orders.id}`, `{"ids":[1,2,3],"total":16.5}`},
+		{`flag ? "yes" : "no"`, `"yes"`},
+		{`missing ? "yes" : "no"`, `"no"`},
+		{`($x := 2; $x * 3)`, `6`},
+
+		// functions
+		{`$string(5)`, `"5"`},
+		{`$string({"a": 1})`, `"{\"a\":1}"`},
+		{`$length("héllo")`, `5`},
+		{`$substring("hello", 1, 3)`, `"ell"`},
+		{`$substring("hello", -3)`, `"llo"`},
+		{`$substringBefore("a-b", "-") & $substringAfter("a-b", "-")`, `"ab"`},
+		{`$uppercase(customer.name)`, `"JANE"`},
+		{`$trim("  a   b ")`, `"a b"`},
+		{`$contains("hello", "ell")`, `true`},
+		{`$split("a,b,c", ",")`, `["a","b","c"]`},
+		{`$join(["a", "b"], "-")`, `"a-b"`},
+		{`$replace("aXbX", "X", "-")`, `"a-b-"`},
+		{`$number("2.5") + 1`, `3.5`},
+		{`$round(2.567, 2)`, `2.57`},
+		{`$power(2, 10)`, `1024`},
+		{`$abs(-2) + $floor(1.7) + $ceil(1.2)`, `5`},
+		{`$sum(orders.quantity)`, `7`},
+		{`$max(orders.price)`, `10`},
+		{`$min(orders.price)`, `1`},
+		{`$average([1, 2, 3])`, `2`},
+		{`$count(orders)`, `3`},
+		{`$count(missing)`, `0`},
+		{`$append([1], 2)`, `[1,2]`},
+		{`$reverse([1, 2, 3])`, `[3,2,1]`},
+		{`$sort([3, 1, 2])`, `[1,2,3]`},
+		{`$sort(orders, function($a, $b) { $a.price > $b.price }).id`, `[3,2,1]`},
+		{`$distinct(orders.tags)`, `["a","b"]`},
+		{`$keys(customer)`, `["address","name"]`},
+		{`$lookup(customer, "name")`, `"Jane"`},
+		{`$merge([{"a": 1}, {"b": 2}])`, `{"a":1,"b":2}`},
+		{`$exists(nothing) and $not($exists(missing))`, `true`},
+		{`$boolean("") or $boolean(0)`, `false`},
+		{`$type(orders) & $type(name) & $type(nothing)`, `"arraystringnull"`},
+		{`$map([1, 2], function($v) { $v * 2 })`, `[2,4]`},
+		{`$filter(orders, function($o) { $o.price < 6 }).id`, `[2,3]`},
+		{`$reduce([1, 2, 3], function($a, $b) { $a + $b })`, `6`},
+		{`$each({"a": 1}, function($v, $k) { $k & $v })`, `"a1"`},
+		{`orders.price ~> $sum()`, `16.5`},
+		{`($double := function($x) { $x * 2 }; $double(4))`, `8`},
+
+		// variables
+		{`$const.limit * 2`, `6`},
+	}
+
+	for _, test := range tests {
+
+		v, ok, err := evaluate(t, test.expr, map[string]interface{}{
+			"const": map[string]interface{}{"limit": 3},
+		})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.expr, err)
+			continue
+		}
+
+		if !ok {
+			v = nil
+		}
+
+		var expected interface{}
+		err = json.Unmarshal([]byte(test.expected), &expected)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(v, expected) {
+			data, _ := json.Marshal(v)
+			t.Errorf("%s: got %s, want %s", test.expr, data, test.expected)
+		}
+
+	}
+
+}
+
+func TestEvaluateUndefined(t *testing.T) {
+
+	tests := []string{
+		`missing`,
+		`customer.missing.city`,
+		`orders[price > 100]`,
+		`orders[5]`,
+		`empty.id`,
+		`$nope`,
+		`$substringBefore(missing, "-")`,
+		`flag ? missing : 1`,
+		`$max([])`,
+	}
+
+	for _, test := range tests {
+
+		v, ok, err := evaluate(t, test, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test, err)
+			continue
+		}
+
+		if ok {
+			t.Errorf("%s: expected no result, got %v", test, v)
+		}
+
+	}
+
+	// null matches, unlike a missing field
+	_, ok, err := evaluate(t, `nothing`, nil)
+	if err != nil || !ok {
+		t.Errorf("nothing: expected null result, got %v, %v", ok, err)
+	}
+
+}
+
+func TestCompileErrors(t *testing.T) {
+
+	tests := []struct {
+		expr string
+		pos  int
+	}{
+		{`"abc`, 0},
+		{`1 +`, 3},
+		{`(1`, 2},
+		{`a b`, 2},
+		{`/* comment`, 0},
+		{"`name", 0},
+	}
+
+	for _, test := range tests {
+
+		_, err := Compile(test.expr)
+		if err == nil {
+			t.Errorf("%s: expected an error", test.expr)
+			continue
+		}
+
+		jerr, ok := err.(*Error)
+		if !ok {
+			t.Errorf("%s: expected *Error, got %T", test.expr, err)
+			continue
+		}
+
+		if jerr.Position != test.pos {
+			t.Errorf("%s: error at position %d, want %d: %v", test.expr, jerr.Position, test.pos, err)
+		}
+
+	}
+
+}
+
+func TestCompileUnsupported(t *testing.T) {
+
+	tests := []struct {
+		expr string
+		pos  int
+	}{
+		{`$contains(name, /ord/i)`, 16},
+		{`orders^(price)`, 6},
+		{`orders{id: price}`, 6},
+		{`orders.%.name`, 7},
+		{`orders@$o`, 6},
+		{`orders#$i`, 6},
+		{`$match(name, "o")`, 0},
+		{`$map(orders, $formatNumber)`, 13},
+		{`name ~> $pad(10)`, 8},
+	}
+
+	for _, test := range tests {
+
+		_, err := Compile(test.expr)
+		jerr, ok := err.(*Error)
+		if !ok {
+			t.Errorf("%s: expected *Error, got %v", test.expr, err)
+			continue
+		}
+
+		if jerr.Position != test.pos {
+			t.Errorf("%s: error at position %d, want %d: %v", test.expr, jerr.Position, test.pos, err)
+		}
+
+	}
+
+	// names bound by the expression aren't the unsupported functions
+	for _, expr := range []string{
+		`($pad := function($s) { $s }; $pad(name))`,
+		`$map(orders, function($match) { $match.id })`,
+	} {
+		_, err := Compile(expr)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", expr, err)
+		}
+	}
+
+}
+
+func TestEvaluateErrors(t *testing.T) {
+
+	tests := []string{
+		`name + 1`,
+		`-name`,
+		`$string(1, 2)`,
+		`$substring()`,
+		`$unknown(1)`,
+		`$length(1)`,
+		`1 < "a"`,
+		`$sum(["a"])`,
+		`$nope(1)`,
+		`function($x) { $x }`,
+		`($f := function() { $f() }; $f())`,
+	}
+
+	for _, test := range tests {
+
+		_, _, err := evaluate(t, test, nil)
+		if err == nil {
+			t.Errorf("%s: expected an error", test)
+		}
+
+	}
+
+}
+
+func TestVariablesDontReplaceBuiltins(t *testing.T) {
+
+	v, ok, err := evaluate(t, `$type($now())`, map[string]interface{}{
+		"now": "2021-01-01T00:00:00Z",
+	})
+	if err != nil || !ok || v != "string" {
+		t.Errorf("unexpected result: %v, %v, %v", v, ok, err)
+	}
+
+	v, ok, err = evaluate(t, `$step + 1`, map[string]interface{}{
+		"step": 2,
+	})
+	if err != nil || !ok || v != float64(3) {
+		t.Errorf("unexpected result: %v, %v, %v", v, ok, err)
+	}
+
+}
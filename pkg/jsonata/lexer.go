@@ -0,0 +1,252 @@
+package jsonata
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenType int
+
+const (
+	tokEOF tokenType = iota
+	tokName
+	tokVariable
+	tokString
+	tokNumber
+	tokOperator
+)
+
+type token struct {
+	typ tokenType
+	val string
+	num float64
+	pos int
+}
+
+// operators, longest first so that they are matched greedily.
+var operators = []string{
+	"~>", ":=", "!=", "<=", ">=", "..", "**",
+	".", "[", "]", "{", "}", "(", ")", ",", ";", ":", "?",
+	"+", "-", "*", "/", "%", "=", "<", ">", "&", "^",
+}
+
+type lexer struct {
+	src string
+	pos int
+}
+
+func (l *lexer) errorf(pos int, format string, a ...interface{}) error {
+	return &Error{
+		Position: pos,
+		Message:  fmt.Sprintf(format, a...),
+	}
+}
+
+func (l *lexer) skipSpace() error {
+
+	for l.pos < len(l.src) {
+
+		if strings.HasPrefix(l.src[l.pos:], "/*") {
+			end := strings.Index(l.src[l.pos+2:], "*/")
+			if end < 0 {
+				return l.errorf(l.pos, "unterminated comment")
+			}
+			l.pos += end + 4
+			continue
+		}
+
+		if !unicode.IsSpace(rune(l.src[l.pos])) {
+			break
+		}
+
+		l.pos++
+
+	}
+
+	return nil
+
+}
+
+func (l *lexer) next() (*token, error) {
+
+	err := l.skipSpace()
+	if err != nil {
+		return nil, err
+	}
+
+	start := l.pos
+
+	if l.pos >= len(l.src) {
+		return &token{typ: tokEOF, pos: start}, nil
+	}
+
+	c := l.src[l.pos]
+
+	switch {
+
+	case c == '"' || c == '\'':
+		s, err := l.lexString(c)
+		if err != nil {
+			return nil, err
+		}
+		return &token{typ: tokString, val: s, pos: start}, nil
+
+	case c == '`':
+		end := strings.IndexByte(l.src[l.pos+1:], '`')
+		if end < 0 {
+			return nil, l.errorf(start, "unterminated quoted name")
+		}
+		name := l.src[l.pos+1 : l.pos+1+end]
+		l.pos += end + 2
+		return &token{typ: tokName, val: name, pos: start}, nil
+
+	case c >= '0' && c <= '9':
+		return l.lexNumber()
+
+	case c == '$':
+		l.pos++
+		if l.pos < len(l.src) && l.src[l.pos] == '$' {
+			l.pos++
+			return &token{typ: tokVariable, val: "$", pos: start}, nil
+		}
+		name := l.lexName()
+		return &token{typ: tokVariable, val: name, pos: start}, nil
+
+	case isNameStart(c):
+		name := l.lexName()
+		return &token{typ: tokName, val: name, pos: start}, nil
+
+	}
+
+	for _, op := range operators {
+		if strings.HasPrefix(l.src[l.pos:], op) {
+			l.pos += len(op)
+			return &token{typ: tokOperator, val: op, pos: start}, nil
+		}
+	}
+
+	if c == '@' || c == '#' {
+		return nil, l.errorf(start, "context and positional variable bindings aren't supported")
+	}
+
+	return nil, l.errorf(start, "unexpected character %q", c)
+
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || (c >= '0' && c <= '9')
+}
+
+func (l *lexer) lexName() string {
+
+	start := l.pos
+	for l.pos < len(l.src) && isNameChar(l.src[l.pos]) {
+		l.pos++
+	}
+
+	return l.src[start:l.pos]
+
+}
+
+func (l *lexer) lexNumber() (*token, error) {
+
+	start := l.pos
+
+	for l.pos < len(l.src) && l.src[l.pos] >= '0' && l.src[l.pos] <= '9' {
+		l.pos++
+	}
+
+	// a range like 1..5 isn't a decimal point
+	if l.pos+1 < len(l.src) && l.src[l.pos] == '.' && l.src[l.pos+1] >= '0' && l.src[l.pos+1] <= '9' {
+		l.pos++
+		for l.pos < len(l.src) && l.src[l.pos] >= '0' && l.src[l.pos] <= '9' {
+			l.pos++
+		}
+	}
+
+	if l.pos < len(l.src) && (l.src[l.pos] == 'e' || l.src[l.pos] == 'E') {
+		l.pos++
+		if l.pos < len(l.src) && (l.src[l.pos] == '+' || l.src[l.pos] == '-') {
+			l.pos++
+		}
+		for l.pos < len(l.src) && l.src[l.pos] >= '0' && l.src[l.pos] <= '9' {
+			l.pos++
+		}
+	}
+
+	f, err := strconv.ParseFloat(l.src[start:l.pos], 64)
+	if err != nil {
+		return nil, l.errorf(start, "invalid number %s", l.src[start:l.pos])
+	}
+
+	return &token{typ: tokNumber, num: f, pos: start}, nil
+
+}
+
+func (l *lexer) lexString(quote byte) (string, error) {
+
+	start := l.pos
+	l.pos++
+
+	var sb strings.Builder
+
+	for l.pos < len(l.src) {
+
+		c := l.src[l.pos]
+
+		if c == quote {
+			l.pos++
+			return sb.String(), nil
+		}
+
+		if c != '\\' {
+			sb.WriteByte(c)
+			l.pos++
+			continue
+		}
+
+		l.pos++
+		if l.pos >= len(l.src) {
+			break
+		}
+
+		switch e := l.src[l.pos]; e {
+		case '"', '\'', '\\', '/':
+			sb.WriteByte(e)
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		case 'u':
+			if l.pos+4 >= len(l.src) {
+				return "", l.errorf(l.pos, "invalid unicode escape")
+			}
+			r, err := strconv.ParseUint(l.src[l.pos+1:l.pos+5], 16, 32)
+			if err != nil {
+				return "", l.errorf(l.pos, "invalid unicode escape")
+			}
+			sb.WriteRune(rune(r))
+			l.pos += 4
+		default:
+			return "", l.errorf(l.pos, "invalid escape sequence \\%c", e)
+		}
+
+		l.pos++
+
+	}
+
+	return "", l.errorf(start, "unterminated string")
+
+}
@@ -0,0 +1,616 @@
+package jsonata
+
+type nodeType int
+
+const (
+	nodeLiteral nodeType = iota
+	nodeName
+	nodeWildcard
+	nodeDescendant
+	nodeVariable
+	nodePath
+	nodePredicate
+	nodeBinary
+	nodeNegate
+	nodeArray
+	nodeRange
+	nodeObject
+	nodeBlock
+	nodeCondition
+	nodeBind
+	nodeCall
+	nodeLambda
+	nodeApply
+)
+
+type node struct {
+	typ   nodeType
+	pos   int
+	value interface{}
+	name  string
+	op    string
+	lhs   *node
+	rhs   *node
+	cond  *node
+	nodes []*node
+	pairs [][2]*node
+	names []string
+}
+
+// binding powers of the infix operators, as jsonata defines them.
+var bindingPowers = map[string]int{
+	".":   75,
+	"[":   80,
+	"(":   80,
+	"*":   60,
+	"/":   60,
+	"%":   60,
+	"+":   50,
+	"-":   50,
+	"&":   50,
+	"=":   40,
+	"!=":  40,
+	"<":   40,
+	"<=":  40,
+	">":   40,
+	">=":  40,
+	"in":  40,
+	"~>":  40,
+	"and": 30,
+	"or":  25,
+	"?":   20,
+	":=":  10,
+}
+
+type parser struct {
+	lex *lexer
+	tok *token
+}
+
+func parse(src string) (*node, error) {
+
+	p := &parser{
+		lex: &lexer{src: src},
+	}
+
+	err := p.advance()
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := p.expression(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.tok.typ != tokEOF {
+		return nil, p.lex.errorf(p.tok.pos, "unexpected %s", p.describe())
+	}
+
+	return n, nil
+
+}
+
+func (p *parser) advance() error {
+
+	tok, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+
+	p.tok = tok
+
+	return nil
+
+}
+
+func (p *parser) describe() string {
+
+	switch p.tok.typ {
+	case tokEOF:
+		return "end of expression"
+	case tokString:
+		return "string"
+	case tokNumber:
+		return "number"
+	case tokVariable:
+		return "$" + p.tok.val
+	}
+
+	return "'" + p.tok.val + "'"
+
+}
+
+func (p *parser) isOperator(op string) bool {
+	return p.tok.typ == tokOperator && p.tok.val == op
+}
+
+func (p *parser) expect(op string) error {
+
+	if !p.isOperator(op) {
+		return p.lex.errorf(p.tok.pos, "expected '%s', got %s", op, p.describe())
+	}
+
+	return p.advance()
+
+}
+
+// infix returns the infix operator at the current token, if there is one.
+func (p *parser) infix() (string, bool) {
+
+	switch p.tok.typ {
+	case tokOperator:
+		_, ok := bindingPowers[p.tok.val]
+		return p.tok.val, ok
+	case tokName:
+		switch p.tok.val {
+		case "and", "or", "in":
+			return p.tok.val, true
+		}
+	}
+
+	return "", false
+
+}
+
+func (p *parser) expression(rbp int) (*node, error) {
+
+	left, err := p.prefix()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+
+		op, ok := p.infix()
+		if !ok {
+			return left, p.unsupportedInfix()
+		}
+		if bindingPowers[op] <= rbp {
+			return left, nil
+		}
+
+		left, err = p.suffix(left, op)
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+}
+
+func (p *parser) prefix() (*node, error) {
+
+	tok := p.tok
+
+	switch tok.typ {
+
+	case tokEOF:
+		return nil, p.lex.errorf(tok.pos, "unexpected end of expression")
+
+	case tokNumber:
+		return &node{typ: nodeLiteral, pos: tok.pos, value: tok.num}, p.advance()
+
+	case tokString:
+		return &node{typ: nodeLiteral, pos: tok.pos, value: tok.val}, p.advance()
+
+	case tokVariable:
+		return &node{typ: nodeVariable, pos: tok.pos, name: tok.val}, p.advance()
+
+	case tokName:
+
+		err := p.advance()
+		if err != nil {
+			return nil, err
+		}
+
+		switch tok.val {
+		case "true":
+			return &node{typ: nodeLiteral, pos: tok.pos, value: true}, nil
+		case "false":
+			return &node{typ: nodeLiteral, pos: tok.pos, value: false}, nil
+		case "null":
+			return &node{typ: nodeLiteral, pos: tok.pos, value: nil}, nil
+		case "function", "λ":
+			if p.isOperator("(") {
+				return p.lambda(tok.pos)
+			}
+		}
+
+		return &node{typ: nodeName, pos: tok.pos, name: tok.val}, nil
+
+	}
+
+	switch tok.val {
+
+	case "(":
+		return p.block()
+
+	case "[":
+		return p.array()
+
+	case "{":
+		return p.object()
+
+	case "-":
+		err := p.advance()
+		if err != nil {
+			return nil, err
+		}
+		operand, err := p.expression(70)
+		if err != nil {
+			return nil, err
+		}
+		return &node{typ: nodeNegate, pos: tok.pos, lhs: operand}, nil
+
+	case "*":
+		return &node{typ: nodeWildcard, pos: tok.pos}, p.advance()
+
+	case "**":
+		return &node{typ: nodeDescendant, pos: tok.pos}, p.advance()
+
+	case "/":
+		return nil, p.lex.errorf(tok.pos, "regular expressions aren't supported")
+
+	case "%":
+		return nil, p.lex.errorf(tok.pos, "the parent operator isn't supported")
+
+	}
+
+	return nil, p.lex.errorf(tok.pos, "unexpected %s", p.describe())
+
+}
+
+// unsupportedInfix returns an error if the current token begins one of the
+// path operators that aren't implemented.
+func (p *parser) unsupportedInfix() error {
+
+	switch {
+	case p.isOperator("^"):
+		return p.lex.errorf(p.tok.pos, "order-by isn't supported")
+	case p.isOperator("{"):
+		return p.lex.errorf(p.tok.pos, "grouping isn't supported")
+	}
+
+	return nil
+
+}
+
+func (p *parser) suffix(left *node, op string) (*node, error) {
+
+	pos := p.tok.pos
+
+	err := p.advance()
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+
+	case ".":
+		rhs, err := p.expression(bindingPowers[op])
+		if err != nil {
+			return nil, err
+		}
+		steps := []*node{left, rhs}
+		if left.typ == nodePath {
+			steps = append(left.nodes, rhs)
+		}
+		return &node{typ: nodePath, pos: pos, nodes: steps}, nil
+
+	case "[":
+		// a[] keeps a single result an array
+		if p.isOperator("]") {
+			return left, p.advance()
+		}
+		pred, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+		err = p.expect("]")
+		if err != nil {
+			return nil, err
+		}
+		return &node{typ: nodePredicate, pos: pos, lhs: left, rhs: pred}, nil
+
+	case "(":
+		args, err := p.list(")")
+		if err != nil {
+			return nil, err
+		}
+		return &node{typ: nodeCall, pos: pos, lhs: left, nodes: args}, nil
+
+	case "?":
+		then, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+		n := &node{typ: nodeCondition, pos: pos, cond: left, lhs: then}
+		if p.isOperator(":") {
+			err = p.advance()
+			if err != nil {
+				return nil, err
+			}
+			n.rhs, err = p.expression(0)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return n, nil
+
+	case ":=":
+		if left.typ != nodeVariable || left.name == "" || left.name == "$" {
+			return nil, p.lex.errorf(left.pos, "left side of := must be a variable")
+		}
+		// right associative
+		rhs, err := p.expression(bindingPowers[op] - 1)
+		if err != nil {
+			return nil, err
+		}
+		return &node{typ: nodeBind, pos: pos, name: left.name, rhs: rhs}, nil
+
+	case "~>":
+		rhs, err := p.expression(bindingPowers[op])
+		if err != nil {
+			return nil, err
+		}
+		return &node{typ: nodeApply, pos: pos, lhs: left, rhs: rhs}, nil
+
+	}
+
+	rhs, err := p.expression(bindingPowers[op])
+	if err != nil {
+		return nil, err
+	}
+
+	return &node{typ: nodeBinary, pos: pos, op: op, lhs: left, rhs: rhs}, nil
+
+}
+
+// list parses expressions separated by commas up to the closing operator.
+func (p *parser) list(closing string) ([]*node, error) {
+
+	var nodes []*node
+
+	if p.isOperator(closing) {
+		return nil, p.advance()
+	}
+
+	for {
+
+		n, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.isOperator("..") {
+			pos := p.tok.pos
+			err = p.advance()
+			if err != nil {
+				return nil, err
+			}
+			end, err := p.expression(0)
+			if err != nil {
+				return nil, err
+			}
+			n = &node{typ: nodeRange, pos: pos, lhs: n, rhs: end}
+		}
+
+		nodes = append(nodes, n)
+
+		if !p.isOperator(",") {
+			break
+		}
+
+		err = p.advance()
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+	return nodes, p.expect(closing)
+
+}
+
+func (p *parser) block() (*node, error) {
+
+	pos := p.tok.pos
+
+	err := p.advance()
+	if err != nil {
+		return nil, err
+	}
+
+	n := &node{typ: nodeBlock, pos: pos}
+
+	for !p.isOperator(")") {
+
+		expr, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+
+		n.nodes = append(n.nodes, expr)
+
+		if !p.isOperator(";") {
+			break
+		}
+
+		err = p.advance()
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+	return n, p.expect(")")
+
+}
+
+func (p *parser) array() (*node, error) {
+
+	pos := p.tok.pos
+
+	err := p.advance()
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := p.list("]")
+	if err != nil {
+		return nil, err
+	}
+
+	return &node{typ: nodeArray, pos: pos, nodes: items}, nil
+
+}
+
+func (p *parser) object() (*node, error) {
+
+	pos := p.tok.pos
+
+	err := p.advance()
+	if err != nil {
+		return nil, err
+	}
+
+	n := &node{typ: nodeObject, pos: pos}
+
+	for !p.isOperator("}") {
+
+		key, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+
+		err = p.expect(":")
+		if err != nil {
+			return nil, err
+		}
+
+		val, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+
+		n.pairs = append(n.pairs, [2]*node{key, val})
+
+		if !p.isOperator(",") {
+			break
+		}
+
+		err = p.advance()
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+	return n, p.expect("}")
+
+}
+
+func (p *parser) lambda(pos int) (*node, error) {
+
+	err := p.expect("(")
+	if err != nil {
+		return nil, err
+	}
+
+	n := &node{typ: nodeLambda, pos: pos}
+
+	for !p.isOperator(")") {
+
+		if p.tok.typ != tokVariable || p.tok.val == "" || p.tok.val == "$" {
+			return nil, p.lex.errorf(p.tok.pos, "expected a parameter, got %s", p.describe())
+		}
+
+		n.names = append(n.names, p.tok.val)
+
+		err = p.advance()
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.isOperator(",") {
+			break
+		}
+
+		err = p.advance()
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+	err = p.expect(")")
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.expect("{")
+	if err != nil {
+		return nil, err
+	}
+
+	n.lhs, err = p.expression(0)
+	if err != nil {
+		return nil, err
+	}
+
+	return n, p.expect("}")
+
+}
+
+// checkFunctions refuses references to the functions of the standard library
+// that aren't implemented, unless the expression binds the name itself.
+func checkFunctions(root *node) error {
+
+	bound := make(map[string]bool)
+
+	walk(root, func(n *node) {
+		switch n.typ {
+		case nodeBind:
+			bound[n.name] = true
+		case nodeLambda:
+			for _, name := range n.names {
+				bound[name] = true
+			}
+		}
+	})
+
+	var err error
+
+	walk(root, func(n *node) {
+		if err == nil && n.typ == nodeVariable && unsupportedFunctions[n.name] && !bound[n.name] {
+			err = errorAt(n, "function $%s isn't supported", n.name)
+		}
+	})
+
+	return err
+
+}
+
+func walk(n *node, fn func(*node)) {
+
+	if n == nil {
+		return
+	}
+
+	fn(n)
+
+	walk(n.lhs, fn)
+	walk(n.rhs, fn)
+	walk(n.cond, fn)
+
+	for _, c := range n.nodes {
+		walk(c, fn)
+	}
+
+	for _, pair := range n.pairs {
+		walk(pair[0], fn)
+		walk(pair[1], fn)
+	}
+
+}
@@ -0,0 +1,208 @@
+package lambda
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/sigv4"
+)
+
+const (
+	service = "lambda"
+
+	// MaxPayloadSize is the largest response payload Lambda returns for
+	// synchronous invocations.
+	MaxPayloadSize = 6 * 1024 * 1024
+)
+
+// Credentials are the AWS credentials used to sign requests.
+type Credentials = sigv4.Credentials
+
+// Client invokes AWS Lambda functions using the Lambda REST API.
+type Client struct {
+	Region      string
+	Credentials Credentials
+	HTTPClient  *http.Client
+}
+
+// NewClientFromEnv creates a client using the standard AWS environment
+// variables for the region and credentials.
+func NewClientFromEnv() *Client {
+
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+
+	return &Client{
+		Region: region,
+		Credentials: Credentials{
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		},
+		HTTPClient: http.DefaultClient,
+	}
+
+}
+
+// Result is the outcome of a synchronous invocation. FunctionError is set if
+// the function itself failed, in which case Payload describes the error.
+type Result struct {
+	Payload       []byte
+	FunctionError string
+}
+
+// FunctionErrorPayload is the payload Lambda returns when a function fails.
+type FunctionErrorPayload struct {
+	ErrorMessage string `json:"errorMessage"`
+	ErrorType    string `json:"errorType"`
+}
+
+// Invoke runs a function synchronously and returns its result. The function
+// can be a name, a partial ARN, or a full ARN.
+func (c *Client) Invoke(ctx context.Context, function string, payload []byte) (*Result, error) {
+
+	resp, err := c.do(ctx, function, "RequestResponse", payload)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, MaxPayloadSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > MaxPayloadSize {
+		return nil, errors.New("lambda response is too large")
+	}
+
+	return &Result{
+		Payload:       data,
+		FunctionError: resp.Header.Get("X-Amz-Function-Error"),
+	}, nil
+
+}
+
+// InvokeAsync queues a function to run asynchronously.
+func (c *Client) InvokeAsync(ctx context.Context, function string, payload []byte) error {
+
+	resp, err := c.do(ctx, function, "Event", payload)
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+
+}
+
+func (c *Client) do(ctx context.Context, function, invocationType string, payload []byte) (*http.Response, error) {
+
+	region, endpoint, err := c.resolve(function)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/2015-03-31/functions/%s/invocations", sigv4.URIEncode(function))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+path, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawPath = path
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Amz-Invocation-Type", invocationType)
+
+	sigv4.Sign(req, c.Credentials, region, service, payload, time.Now())
+
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		return nil, responseError(resp)
+	}
+
+	return resp, nil
+
+}
+
+// resolve finds the region and endpoint for a function, preferring the region
+// in its ARN.
+func (c *Client) resolve(function string) (string, string, error) {
+
+	region := c.Region
+	partition := "aws"
+
+	// arn:partition:lambda:region:account:function:name[:qualifier]
+	if strings.HasPrefix(function, "arn:") {
+		parts := strings.Split(function, ":")
+		if len(parts) < 7 || parts[2] != service {
+			return "", "", fmt.Errorf("invalid lambda function arn: %s", function)
+		}
+		partition = parts[1]
+		region = parts[3]
+	}
+
+	if region == "" {
+		return "", "", errors.New("no aws region configured")
+	}
+
+	suffix := "amazonaws.com"
+	if partition == "aws-cn" {
+		suffix = "amazonaws.com.cn"
+	}
+
+	return region, fmt.Sprintf("https://%s.%s.%s", service, region, suffix), nil
+
+}
+
+func responseError(resp *http.Response) error {
+
+	data, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 0x10000))
+
+	var body struct {
+		Type         string `json:"Type"`
+		Message      string `json:"Message"`
+		MessageLower string `json:"message"`
+	}
+	_ = json.Unmarshal(data, &body)
+
+	msg := body.Message
+	if msg == "" {
+		msg = body.MessageLower
+	}
+	if msg == "" {
+		msg = http.StatusText(resp.StatusCode)
+	}
+
+	code := resp.Header.Get("X-Amzn-ErrorType")
+	if idx := strings.Index(code, ":"); idx >= 0 {
+		code = code[:idx]
+	}
+
+	if code != "" {
+		return fmt.Errorf("lambda returned %d (%s): %s", resp.StatusCode, code, msg)
+	}
+
+	return fmt.Errorf("lambda returned %d: %s", resp.StatusCode, msg)
+
+}
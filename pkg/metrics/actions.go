@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Every flow server caps the isolate actions of a namespace on its own, so
+// no single server knows how many actions a namespace has queued. Servers
+// sample their counts periodically instead, and the latest sample of each
+// server is added up when the counts are queried.
+
+const (
+	// samples older than this are deleted when new ones are recorded
+	actionSampleRetention = time.Hour
+)
+
+// ActionSample is how many isolate actions a namespace had in flight on a
+// flow server, and how many were queued behind the namespace's cap.
+type ActionSample struct {
+	Namespace string
+	Active    int
+	Queued    int
+}
+
+// ActionCounts are the isolate actions of a namespace across the flow
+// servers that sampled them, as of their latest samples.
+type ActionCounts struct {
+	Active  int
+	Queued  int
+	Servers int
+}
+
+func createActionSamples(ctx context.Context, db *sql.DB) error {
+
+	_, err := db.ExecContext(ctx, `create table if not exists action_samples (
+		server text,
+		namespace text,
+		timestamp timestamptz,
+		active integer,
+		queued integer
+	)`)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx, `create index if not exists action_samples_timestamp on action_samples (timestamp)`)
+
+	return err
+
+}
+
+// RecordActionSamples saves the samples a flow server took at t, and deletes
+// those that are no longer needed.
+func (c *Client) RecordActionSamples(ctx context.Context, server string, t time.Time, samples []ActionSample) error {
+
+	tx, err := c.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, s := range samples {
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO action_samples (server, namespace, timestamp, active, queued) VALUES ($1, $2, $3, $4, $5)`,
+			server, s.Namespace, t, s.Active, s.Queued,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM action_samples WHERE timestamp < $1`, t.Add(-actionSampleRetention))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+
+}
+
+// GetActionCounts adds up the latest samples the flow servers took of a
+// namespace since then.
+func (c *Client) GetActionCounts(ctx context.Context, namespace string, since time.Time) (*ActionCounts, error) {
+
+	rows, err := c.sql.QueryContext(ctx,
+		`SELECT DISTINCT ON (server) active, queued FROM action_samples
+		WHERE namespace = $1 AND timestamp > $2 ORDER BY server, timestamp DESC`,
+		namespace, since,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := new(ActionCounts)
+
+	for rows.Next() {
+
+		var active, queued int
+
+		err = rows.Scan(&active, &queued)
+		if err != nil {
+			return nil, err
+		}
+
+		counts.Active += active
+		counts.Queued += queued
+		counts.Servers++
+
+	}
+
+	return counts, rows.Err()
+
+}
@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/metrics/ent/metrics"
+)
+
+// timeoutErrorCode is what states cancelled by their deadline fail with.
+const timeoutErrorCode = "direktiv.cancels.timeout"
+
+// DeadlineUsage is how much of their time budget the states of a namespace
+// used. Usage is the share of the time until its deadline a state took,
+// Near counts the states that took at least the near share given, and
+// TimedOut those cancelled by their deadline.
+type DeadlineUsage struct {
+	Namespace string  `json:"namespace"`
+	Samples   int     `json:"samples"`
+	Near      int     `json:"near"`
+	TimedOut  int     `json:"timedOut"`
+	MeanUsage float64 `json:"meanUsage"`
+	P95Usage  float64 `json:"p95Usage"`
+}
+
+// NearShare returns the percentage of states that came near their deadline.
+func (du *DeadlineUsage) NearShare() float64 {
+
+	if du.Samples == 0 {
+		return 0
+	}
+
+	return float64(du.Near) * 100 / float64(du.Samples)
+
+}
+
+// GetDeadlineUsage returns the deadline usage of the states that completed
+// since then, of every namespace or of only one if namespace isn't empty.
+// States that don't record a deadline aren't counted.
+func (c *Client) GetDeadlineUsage(ctx context.Context, namespace string, since time.Time, near float64) ([]*DeadlineUsage, error) {
+
+	query := c.read.Metrics.Query().Where(
+		metrics.TimestampGT(since),
+		metrics.DeadlineMsGT(0),
+	)
+
+	if namespace != "" {
+		query = query.Where(metrics.NamespaceEQ(namespace))
+	}
+
+	var records []struct {
+		Namespace  string         `json:"namespace"`
+		WorkflowMs int64          `json:"workflow_ms"`
+		DeadlineMs int64          `json:"deadline_ms"`
+		ErrorCode  sql.NullString `json:"error_code"`
+	}
+
+	err := query.Select(metrics.FieldNamespace, metrics.FieldWorkflowMs,
+		metrics.FieldDeadlineMs, metrics.FieldErrorCode).Scan(ctx, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make(map[string][]float64)
+	m := make(map[string]*DeadlineUsage)
+
+	for _, r := range records {
+
+		du, ok := m[r.Namespace]
+		if !ok {
+			du = &DeadlineUsage{
+				Namespace: r.Namespace,
+			}
+			m[r.Namespace] = du
+		}
+
+		usage := float64(r.WorkflowMs) / float64(r.DeadlineMs)
+
+		du.Samples++
+		du.MeanUsage += usage
+
+		if usage >= near {
+			du.Near++
+		}
+
+		if r.ErrorCode.String == timeoutErrorCode {
+			du.TimedOut++
+		}
+
+		usages[r.Namespace] = append(usages[r.Namespace], usage)
+
+	}
+
+	out := make([]*DeadlineUsage, 0, len(m))
+
+	for ns, du := range m {
+
+		du.MeanUsage /= float64(du.Samples)
+
+		u := usages[ns]
+		sort.Float64s(u)
+		du.P95Usage = u[(len(u)*95+99)/100-1]
+
+		out = append(out, du)
+
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Namespace < out[j].Namespace
+	})
+
+	return out, nil
+
+}
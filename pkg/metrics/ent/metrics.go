@@ -38,6 +38,8 @@ type Metrics struct {
 	Next int8 `json:"next,omitempty"`
 	// Transition holds the value of the "transition" field.
 	Transition string `json:"transition,omitempty"`
+	// DeadlineMs holds the value of the "deadline_ms" field.
+	DeadlineMs int64 `json:"deadline_ms,omitempty"`
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -45,7 +47,7 @@ func (*Metrics) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case metrics.FieldID, metrics.FieldWorkflowMs, metrics.FieldIsolateMs, metrics.FieldNext:
+		case metrics.FieldID, metrics.FieldWorkflowMs, metrics.FieldIsolateMs, metrics.FieldNext, metrics.FieldDeadlineMs:
 			values[i] = &sql.NullInt64{}
 		case metrics.FieldNamespace, metrics.FieldWorkflow, metrics.FieldInstance, metrics.FieldState, metrics.FieldErrorCode, metrics.FieldInvoker, metrics.FieldTransition:
 			values[i] = &sql.NullString{}
@@ -138,6 +140,12 @@ func (m *Metrics) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				m.Transition = value.String
 			}
+		case metrics.FieldDeadlineMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deadline_ms", values[i])
+			} else if value.Valid {
+				m.DeadlineMs = value.Int64
+			}
 		}
 	}
 	return nil
@@ -188,6 +196,8 @@ func (m *Metrics) String() string {
 	builder.WriteString(fmt.Sprintf("%v", m.Next))
 	builder.WriteString(", transition=")
 	builder.WriteString(m.Transition)
+	builder.WriteString(", deadline_ms=")
+	builder.WriteString(fmt.Sprintf("%v", m.DeadlineMs))
 	builder.WriteByte(')')
 	return builder.String()
 }
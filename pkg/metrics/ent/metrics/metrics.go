@@ -29,6 +29,8 @@ const (
 	FieldNext = "next"
 	// FieldTransition holds the string denoting the transition field in the database.
 	FieldTransition = "transition"
+	// FieldDeadlineMs holds the string denoting the deadline_ms field in the database.
+	FieldDeadlineMs = "deadline_ms"
 	// Table holds the table name of the metrics in the database.
 	Table = "metrics"
 )
@@ -47,6 +49,7 @@ var Columns = []string{
 	FieldInvoker,
 	FieldNext,
 	FieldTransition,
+	FieldDeadlineMs,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -74,4 +77,6 @@ var (
 	IsolateMsValidator func(int64) error
 	// NextValidator is a validator for the "next" field. It is called by the builders before save.
 	NextValidator func(int8) error
+	// DeadlineMsValidator is a validator for the "deadline_ms" field. It is called by the builders before save.
+	DeadlineMsValidator func(int64) error
 )
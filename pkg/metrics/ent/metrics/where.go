@@ -169,6 +169,13 @@ func Transition(v string) predicate.Metrics {
 	})
 }
 
+// DeadlineMs applies equality check predicate on the "deadline_ms" field. It's identical to DeadlineMsEQ.
+func DeadlineMs(v int64) predicate.Metrics {
+	return predicate.Metrics(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeadlineMs), v))
+	})
+}
+
 // NamespaceEQ applies the EQ predicate on the "namespace" field.
 func NamespaceEQ(v string) predicate.Metrics {
 	return predicate.Metrics(func(s *sql.Selector) {
@@ -1278,6 +1285,96 @@ func TransitionContainsFold(v string) predicate.Metrics {
 	})
 }
 
+// DeadlineMsEQ applies the EQ predicate on the "deadline_ms" field.
+func DeadlineMsEQ(v int64) predicate.Metrics {
+	return predicate.Metrics(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeadlineMs), v))
+	})
+}
+
+// DeadlineMsNEQ applies the NEQ predicate on the "deadline_ms" field.
+func DeadlineMsNEQ(v int64) predicate.Metrics {
+	return predicate.Metrics(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeadlineMs), v))
+	})
+}
+
+// DeadlineMsIn applies the In predicate on the "deadline_ms" field.
+func DeadlineMsIn(vs ...int64) predicate.Metrics {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Metrics(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDeadlineMs), v...))
+	})
+}
+
+// DeadlineMsNotIn applies the NotIn predicate on the "deadline_ms" field.
+func DeadlineMsNotIn(vs ...int64) predicate.Metrics {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Metrics(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDeadlineMs), v...))
+	})
+}
+
+// DeadlineMsGT applies the GT predicate on the "deadline_ms" field.
+func DeadlineMsGT(v int64) predicate.Metrics {
+	return predicate.Metrics(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeadlineMs), v))
+	})
+}
+
+// DeadlineMsGTE applies the GTE predicate on the "deadline_ms" field.
+func DeadlineMsGTE(v int64) predicate.Metrics {
+	return predicate.Metrics(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeadlineMs), v))
+	})
+}
+
+// DeadlineMsLT applies the LT predicate on the "deadline_ms" field.
+func DeadlineMsLT(v int64) predicate.Metrics {
+	return predicate.Metrics(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeadlineMs), v))
+	})
+}
+
+// DeadlineMsLTE applies the LTE predicate on the "deadline_ms" field.
+func DeadlineMsLTE(v int64) predicate.Metrics {
+	return predicate.Metrics(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeadlineMs), v))
+	})
+}
+
+// DeadlineMsIsNil applies the IsNil predicate on the "deadline_ms" field.
+func DeadlineMsIsNil() predicate.Metrics {
+	return predicate.Metrics(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDeadlineMs)))
+	})
+}
+
+// DeadlineMsNotNil applies the NotNil predicate on the "deadline_ms" field.
+func DeadlineMsNotNil() predicate.Metrics {
+	return predicate.Metrics(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDeadlineMs)))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Metrics) predicate.Metrics {
 	return predicate.Metrics(func(s *sql.Selector) {
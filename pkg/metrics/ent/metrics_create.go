@@ -102,6 +102,20 @@ func (mc *MetricsCreate) SetNillableTransition(s *string) *MetricsCreate {
 	return mc
 }
 
+// SetDeadlineMs sets the "deadline_ms" field.
+func (mc *MetricsCreate) SetDeadlineMs(i int64) *MetricsCreate {
+	mc.mutation.SetDeadlineMs(i)
+	return mc
+}
+
+// SetNillableDeadlineMs sets the "deadline_ms" field if the given value is not nil.
+func (mc *MetricsCreate) SetNillableDeadlineMs(i *int64) *MetricsCreate {
+	if i != nil {
+		mc.SetDeadlineMs(*i)
+	}
+	return mc
+}
+
 // Mutation returns the MetricsMutation object of the builder.
 func (mc *MetricsCreate) Mutation() *MetricsMutation {
 	return mc.mutation
@@ -215,6 +229,11 @@ func (mc *MetricsCreate) check() error {
 			return &ValidationError{Name: "next", err: fmt.Errorf("ent: validator failed for field \"next\": %w", err)}
 		}
 	}
+	if v, ok := mc.mutation.DeadlineMs(); ok {
+		if err := metrics.DeadlineMsValidator(v); err != nil {
+			return &ValidationError{Name: "deadline_ms", err: fmt.Errorf("ent: validator failed for field \"deadline_ms\": %w", err)}
+		}
+	}
 	return nil
 }
 
@@ -330,6 +349,14 @@ func (mc *MetricsCreate) createSpec() (*Metrics, *sqlgraph.CreateSpec) {
 		})
 		_node.Transition = value
 	}
+	if value, ok := mc.mutation.DeadlineMs(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: metrics.FieldDeadlineMs,
+		})
+		_node.DeadlineMs = value
+	}
 	return _node, _spec
 }
 
@@ -142,6 +142,33 @@ func (mu *MetricsUpdate) ClearTransition() *MetricsUpdate {
 	return mu
 }
 
+// SetDeadlineMs sets the "deadline_ms" field.
+func (mu *MetricsUpdate) SetDeadlineMs(i int64) *MetricsUpdate {
+	mu.mutation.ResetDeadlineMs()
+	mu.mutation.SetDeadlineMs(i)
+	return mu
+}
+
+// SetNillableDeadlineMs sets the "deadline_ms" field if the given value is not nil.
+func (mu *MetricsUpdate) SetNillableDeadlineMs(i *int64) *MetricsUpdate {
+	if i != nil {
+		mu.SetDeadlineMs(*i)
+	}
+	return mu
+}
+
+// AddDeadlineMs adds i to the "deadline_ms" field.
+func (mu *MetricsUpdate) AddDeadlineMs(i int64) *MetricsUpdate {
+	mu.mutation.AddDeadlineMs(i)
+	return mu
+}
+
+// ClearDeadlineMs clears the value of the "deadline_ms" field.
+func (mu *MetricsUpdate) ClearDeadlineMs() *MetricsUpdate {
+	mu.mutation.ClearDeadlineMs()
+	return mu
+}
+
 // Mutation returns the MetricsMutation object of the builder.
 func (mu *MetricsUpdate) Mutation() *MetricsMutation {
 	return mu.mutation
@@ -241,6 +268,11 @@ func (mu *MetricsUpdate) check() error {
 			return &ValidationError{Name: "next", err: fmt.Errorf("ent: validator failed for field \"next\": %w", err)}
 		}
 	}
+	if v, ok := mu.mutation.DeadlineMs(); ok {
+		if err := metrics.DeadlineMsValidator(v); err != nil {
+			return &ValidationError{Name: "deadline_ms", err: fmt.Errorf("ent: validator failed for field \"deadline_ms\": %w", err)}
+		}
+	}
 	return nil
 }
 
@@ -372,6 +404,26 @@ func (mu *MetricsUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: metrics.FieldTransition,
 		})
 	}
+	if value, ok := mu.mutation.DeadlineMs(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: metrics.FieldDeadlineMs,
+		})
+	}
+	if value, ok := mu.mutation.AddedDeadlineMs(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: metrics.FieldDeadlineMs,
+		})
+	}
+	if mu.mutation.DeadlineMsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: metrics.FieldDeadlineMs,
+		})
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{metrics.Label}
@@ -386,6 +438,7 @@ func (mu *MetricsUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // MetricsUpdateOne is the builder for updating a single Metrics entity.
 type MetricsUpdateOne struct {
 	config
+	fields   []string
 	hooks    []Hook
 	mutation *MetricsMutation
 }
@@ -505,11 +558,45 @@ func (muo *MetricsUpdateOne) ClearTransition() *MetricsUpdateOne {
 	return muo
 }
 
+// SetDeadlineMs sets the "deadline_ms" field.
+func (muo *MetricsUpdateOne) SetDeadlineMs(i int64) *MetricsUpdateOne {
+	muo.mutation.ResetDeadlineMs()
+	muo.mutation.SetDeadlineMs(i)
+	return muo
+}
+
+// SetNillableDeadlineMs sets the "deadline_ms" field if the given value is not nil.
+func (muo *MetricsUpdateOne) SetNillableDeadlineMs(i *int64) *MetricsUpdateOne {
+	if i != nil {
+		muo.SetDeadlineMs(*i)
+	}
+	return muo
+}
+
+// AddDeadlineMs adds i to the "deadline_ms" field.
+func (muo *MetricsUpdateOne) AddDeadlineMs(i int64) *MetricsUpdateOne {
+	muo.mutation.AddDeadlineMs(i)
+	return muo
+}
+
+// ClearDeadlineMs clears the value of the "deadline_ms" field.
+func (muo *MetricsUpdateOne) ClearDeadlineMs() *MetricsUpdateOne {
+	muo.mutation.ClearDeadlineMs()
+	return muo
+}
+
 // Mutation returns the MetricsMutation object of the builder.
 func (muo *MetricsUpdateOne) Mutation() *MetricsMutation {
 	return muo.mutation
 }
 
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MetricsUpdateOne) Select(field string, fields ...string) *MetricsUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
 // Save executes the query and returns the updated Metrics entity.
 func (muo *MetricsUpdateOne) Save(ctx context.Context) (*Metrics, error) {
 	var (
@@ -604,6 +691,11 @@ func (muo *MetricsUpdateOne) check() error {
 			return &ValidationError{Name: "next", err: fmt.Errorf("ent: validator failed for field \"next\": %w", err)}
 		}
 	}
+	if v, ok := muo.mutation.DeadlineMs(); ok {
+		if err := metrics.DeadlineMsValidator(v); err != nil {
+			return &ValidationError{Name: "deadline_ms", err: fmt.Errorf("ent: validator failed for field \"deadline_ms\": %w", err)}
+		}
+	}
 	return nil
 }
 
@@ -623,6 +715,18 @@ func (muo *MetricsUpdateOne) sqlSave(ctx context.Context) (_node *Metrics, err e
 		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Metrics.ID for update")}
 	}
 	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, metrics.FieldID)
+		for _, f := range fields {
+			if !metrics.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != metrics.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
 	if ps := muo.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -740,6 +844,26 @@ func (muo *MetricsUpdateOne) sqlSave(ctx context.Context) (_node *Metrics, err e
 			Column: metrics.FieldTransition,
 		})
 	}
+	if value, ok := muo.mutation.DeadlineMs(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: metrics.FieldDeadlineMs,
+		})
+	}
+	if value, ok := muo.mutation.AddedDeadlineMs(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: metrics.FieldDeadlineMs,
+		})
+	}
+	if muo.mutation.DeadlineMsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: metrics.FieldDeadlineMs,
+		})
+	}
 	_node = &Metrics{config: muo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -22,6 +22,7 @@ var (
 		{Name: "invoker", Type: field.TypeString},
 		{Name: "next", Type: field.TypeInt8},
 		{Name: "transition", Type: field.TypeString, Nullable: true},
+		{Name: "deadline_ms", Type: field.TypeInt64, Nullable: true},
 	}
 	// MetricsTable holds the schema information for the "metrics" table.
 	MetricsTable = &schema.Table{
@@ -46,6 +46,8 @@ type MetricsMutation struct {
 	next           *int8
 	addnext        *int8
 	transition     *string
+	deadline_ms    *int64
+	adddeadline_ms *int64
 	clearedFields  map[string]struct{}
 	done           bool
 	oldValue       func(context.Context) (*Metrics, error)
@@ -613,6 +615,76 @@ func (m *MetricsMutation) ResetTransition() {
 	delete(m.clearedFields, metrics.FieldTransition)
 }
 
+// SetDeadlineMs sets the "deadline_ms" field.
+func (m *MetricsMutation) SetDeadlineMs(i int64) {
+	m.deadline_ms = &i
+	m.adddeadline_ms = nil
+}
+
+// DeadlineMs returns the value of the "deadline_ms" field in the mutation.
+func (m *MetricsMutation) DeadlineMs() (r int64, exists bool) {
+	v := m.deadline_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeadlineMs returns the old "deadline_ms" field's value of the Metrics entity.
+// If the Metrics object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetricsMutation) OldDeadlineMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDeadlineMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDeadlineMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeadlineMs: %w", err)
+	}
+	return oldValue.DeadlineMs, nil
+}
+
+// AddDeadlineMs adds i to the "deadline_ms" field.
+func (m *MetricsMutation) AddDeadlineMs(i int64) {
+	if m.adddeadline_ms != nil {
+		*m.adddeadline_ms += i
+	} else {
+		m.adddeadline_ms = &i
+	}
+}
+
+// AddedDeadlineMs returns the value that was added to the "deadline_ms" field in this mutation.
+func (m *MetricsMutation) AddedDeadlineMs() (r int64, exists bool) {
+	v := m.adddeadline_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDeadlineMs clears the value of the "deadline_ms" field.
+func (m *MetricsMutation) ClearDeadlineMs() {
+	m.deadline_ms = nil
+	m.adddeadline_ms = nil
+	m.clearedFields[metrics.FieldDeadlineMs] = struct{}{}
+}
+
+// DeadlineMsCleared returns if the "deadline_ms" field was cleared in this mutation.
+func (m *MetricsMutation) DeadlineMsCleared() bool {
+	_, ok := m.clearedFields[metrics.FieldDeadlineMs]
+	return ok
+}
+
+// ResetDeadlineMs resets all changes to the "deadline_ms" field.
+func (m *MetricsMutation) ResetDeadlineMs() {
+	m.deadline_ms = nil
+	m.adddeadline_ms = nil
+	delete(m.clearedFields, metrics.FieldDeadlineMs)
+}
+
 // Op returns the operation name.
 func (m *MetricsMutation) Op() Op {
 	return m.op
@@ -627,7 +699,7 @@ func (m *MetricsMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *MetricsMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 12)
 	if m.namespace != nil {
 		fields = append(fields, metrics.FieldNamespace)
 	}
@@ -661,6 +733,9 @@ func (m *MetricsMutation) Fields() []string {
 	if m.transition != nil {
 		fields = append(fields, metrics.FieldTransition)
 	}
+	if m.deadline_ms != nil {
+		fields = append(fields, metrics.FieldDeadlineMs)
+	}
 	return fields
 }
 
@@ -691,6 +766,8 @@ func (m *MetricsMutation) Field(name string) (ent.Value, bool) {
 		return m.Next()
 	case metrics.FieldTransition:
 		return m.Transition()
+	case metrics.FieldDeadlineMs:
+		return m.DeadlineMs()
 	}
 	return nil, false
 }
@@ -722,6 +799,8 @@ func (m *MetricsMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldNext(ctx)
 	case metrics.FieldTransition:
 		return m.OldTransition(ctx)
+	case metrics.FieldDeadlineMs:
+		return m.OldDeadlineMs(ctx)
 	}
 	return nil, fmt.Errorf("unknown Metrics field %s", name)
 }
@@ -808,6 +887,13 @@ func (m *MetricsMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTransition(v)
 		return nil
+	case metrics.FieldDeadlineMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeadlineMs(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Metrics field %s", name)
 }
@@ -825,6 +911,9 @@ func (m *MetricsMutation) AddedFields() []string {
 	if m.addnext != nil {
 		fields = append(fields, metrics.FieldNext)
 	}
+	if m.adddeadline_ms != nil {
+		fields = append(fields, metrics.FieldDeadlineMs)
+	}
 	return fields
 }
 
@@ -839,6 +928,8 @@ func (m *MetricsMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedIsolateMs()
 	case metrics.FieldNext:
 		return m.AddedNext()
+	case metrics.FieldDeadlineMs:
+		return m.AddedDeadlineMs()
 	}
 	return nil, false
 }
@@ -869,6 +960,13 @@ func (m *MetricsMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddNext(v)
 		return nil
+	case metrics.FieldDeadlineMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeadlineMs(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Metrics numeric field %s", name)
 }
@@ -883,6 +981,9 @@ func (m *MetricsMutation) ClearedFields() []string {
 	if m.FieldCleared(metrics.FieldTransition) {
 		fields = append(fields, metrics.FieldTransition)
 	}
+	if m.FieldCleared(metrics.FieldDeadlineMs) {
+		fields = append(fields, metrics.FieldDeadlineMs)
+	}
 	return fields
 }
 
@@ -903,6 +1004,9 @@ func (m *MetricsMutation) ClearField(name string) error {
 	case metrics.FieldTransition:
 		m.ClearTransition()
 		return nil
+	case metrics.FieldDeadlineMs:
+		m.ClearDeadlineMs()
+		return nil
 	}
 	return fmt.Errorf("unknown Metrics nullable field %s", name)
 }
@@ -944,6 +1048,9 @@ func (m *MetricsMutation) ResetField(name string) error {
 	case metrics.FieldTransition:
 		m.ResetTransition()
 		return nil
+	case metrics.FieldDeadlineMs:
+		m.ResetDeadlineMs()
+		return nil
 	}
 	return fmt.Errorf("unknown Metrics field %s", name)
 }
@@ -55,4 +55,8 @@ func init() {
 			return nil
 		}
 	}()
+	// metricsDescDeadlineMs is the schema descriptor for deadline_ms field.
+	metricsDescDeadlineMs := metricsFields[11].Descriptor()
+	// metrics.DeadlineMsValidator is a validator for the "deadline_ms" field. It is called by the builders before save.
+	metrics.DeadlineMsValidator = metricsDescDeadlineMs.Validators[0].(func(int64) error)
 }
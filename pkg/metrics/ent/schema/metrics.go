@@ -25,6 +25,7 @@ func (Metrics) Fields() []ent.Field {
 		field.String("invoker"),
 		field.Int8("next").Min(0).Max(2),
 		field.String("transition").Optional(),
+		field.Int64("deadline_ms").NonNegative().Optional(),
 	}
 }
 
@@ -2,27 +2,48 @@ package metrics
 
 import (
 	"context"
+	"database/sql"
 	"os"
+	"sync"
 	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	log "github.com/sirupsen/logrus"
+
 	"github.com/vorteil/direktiv/pkg/metrics/ent/metrics"
 
 	"github.com/vorteil/direktiv/pkg/metrics/ent"
 )
 
+// maxBatchRecords bounds the records written by a single bulk insert.
+const maxBatchRecords = 500
+
 // Client ..
 type Client struct {
 	db *ent.Client
+
+	// action queue samples are written without ent, see actions.go
+	sql *sql.DB
+
+	// metrics are queried from a replica if there is one
+	read *ent.Client
+
+	mtx     sync.Mutex
+	batched bool
+	pending []*ent.MetricsCreate
 }
 
 // NewClient ..
 func NewClient() (*Client, error) {
 
-	db, err := ent.Open("postgres", os.Getenv("DIREKTIV_DB"))
+	drv, err := entsql.Open(dialect.Postgres, os.Getenv("DIREKTIV_DB"))
 	if err != nil {
 		return nil, err
 	}
 
+	db := ent.NewClient(ent.Driver(drv))
+
 	ctx := context.Background()
 
 	// Run the auto migration tool.
@@ -30,8 +51,22 @@ func NewClient() (*Client, error) {
 		return nil, err
 	}
 
+	err = createActionSamples(ctx, drv.DB())
+	if err != nil {
+		return nil, err
+	}
+
 	out := new(Client)
 	out.db = db
+	out.sql = drv.DB()
+	out.read = db
+
+	if replica := os.Getenv("DIREKTIV_DB_REPLICA"); replica != "" {
+		out.read, err = ent.Open("postgres", replica)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return out, nil
 }
@@ -51,15 +86,71 @@ func (c *Client) InsertRecord(args *InsertRecordArgs) error {
 	r = r.SetNext(int8(args.Next))
 	r = r.SetTransition(args.Transition)
 
+	if args.DeadlineMilliSeconds > 0 {
+		r = r.SetDeadlineMs(args.DeadlineMilliSeconds)
+	}
+
+	c.mtx.Lock()
+	if c.batched {
+		c.pending = append(c.pending, r)
+		c.mtx.Unlock()
+		return nil
+	}
+	c.mtx.Unlock()
+
 	_, err := r.Save(context.Background())
 	return err
 }
 
+// Batch makes InsertRecord queue records and write them in bulk every
+// interval rather than one at a time. Records still queued when the process
+// exits are lost.
+func (c *Client) Batch(interval time.Duration) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.batched {
+		return
+	}
+	c.batched = true
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			c.flush()
+		}
+	}()
+
+}
+
+func (c *Client) flush() {
+
+	c.mtx.Lock()
+	pending := c.pending
+	c.pending = nil
+	c.mtx.Unlock()
+
+	for len(pending) > 0 {
+		n := len(pending)
+		if n > maxBatchRecords {
+			n = maxBatchRecords
+		}
+		_, err := c.db.Metrics.CreateBulk(pending[:n]...).Save(context.Background())
+		if err != nil {
+			log.Errorf("failed to write %d metrics records: %v", n, err)
+		}
+		pending = pending[n:]
+	}
+
+}
+
 func (c *Client) GetMetrics(args *GetMetricsArgs) (*Dataset, error) {
 
 	ctx := context.Background()
 
-	records, err := c.db.Metrics.Query().Where(
+	records, err := c.read.Metrics.Query().Where(
 		metrics.And(
 			metrics.NamespaceEQ(args.Namespace),
 			metrics.WorkflowEQ(args.Workflow),
@@ -36,6 +36,10 @@ type InsertRecordArgs struct {
 	Invoker              string
 	Next                 NextEnum
 	Transition           string
+
+	// DeadlineMilliSeconds is the time the state had before its deadline,
+	// zero for states that wait for their deadline by design.
+	DeadlineMilliSeconds int64
 }
 
 // GetMetricsArgs ..
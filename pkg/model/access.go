@@ -0,0 +1,125 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// AccessDefinition restricts what can start a workflow besides direct
+// invocations: callers are the workflows that may run it as a subflow, as
+// namespace/workflow, and event_sources the sources of the events that may
+// trigger it.
+type AccessDefinition struct {
+	Callers      *AccessList `yaml:"callers,omitempty" json:"callers,omitempty"`
+	EventSources *AccessList `yaml:"event_sources,omitempty" json:"event_sources,omitempty"`
+}
+
+func (o *AccessDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+
+	if err := o.Callers.Validate(); err != nil {
+		return fmt.Errorf("callers: %w", err)
+	}
+
+	if err := o.EventSources.Validate(); err != nil {
+		return fmt.Errorf("event_sources: %w", err)
+	}
+
+	return nil
+}
+
+// PermitsCaller reports whether workflow name of namespace ns may run the
+// workflow as a subflow.
+func (o *AccessDefinition) PermitsCaller(ns, name string) bool {
+	if o == nil {
+		return true
+	}
+
+	return o.Callers.Permits(ns + "/" + name)
+}
+
+// PermitsEventSource reports whether events from source may trigger the
+// workflow.
+func (o *AccessDefinition) PermitsEventSource(source string) bool {
+	if o == nil {
+		return true
+	}
+
+	return o.EventSources.Permits(source)
+}
+
+// AccessList allows and denies names by pattern. A pattern matches a name
+// exactly, or by its prefix if it ends with '*'. Denied names are refused
+// even if they are allowed too, and if there is an allow list only the names
+// it matches are permitted.
+type AccessList struct {
+	Allow []string `yaml:"allow,omitempty" json:"allow,omitempty"`
+	Deny  []string `yaml:"deny,omitempty" json:"deny,omitempty"`
+}
+
+func (o *AccessList) Validate() error {
+	if o == nil {
+		return nil
+	}
+
+	for i, p := range o.Allow {
+		if err := validateAccessPattern(p); err != nil {
+			return fmt.Errorf("allow[%v]: %w", i, err)
+		}
+	}
+
+	for i, p := range o.Deny {
+		if err := validateAccessPattern(p); err != nil {
+			return fmt.Errorf("deny[%v]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (o *AccessList) Permits(name string) bool {
+	if o == nil {
+		return true
+	}
+
+	for _, p := range o.Deny {
+		if accessPatternMatches(p, name) {
+			return false
+		}
+	}
+
+	if len(o.Allow) == 0 {
+		return true
+	}
+
+	for _, p := range o.Allow {
+		if accessPatternMatches(p, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateAccessPattern(p string) error {
+	if p == "" {
+		return errors.New("pattern must not be empty")
+	}
+
+	if strings.Contains(strings.TrimSuffix(p, "*"), "*") {
+		return errors.New("'*' is only allowed at the end of a pattern")
+	}
+
+	return nil
+}
+
+func accessPatternMatches(p, name string) bool {
+	if strings.HasSuffix(p, "*") {
+		return strings.HasPrefix(name, strings.TrimSuffix(p, "*"))
+	}
+
+	return p == name
+}
@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/qri-io/jsonschema"
 	"github.com/senseyeio/duration"
@@ -16,6 +19,12 @@ const CommonNameRegex = "^[a-z][a-z0-9._-]{1,34}[a-z0-9]$"
 const FunctionNameRegex = `^[^_-][\w\-]*[^_\-\s]$`
 const VariableNameRegex = `^\w+$`
 
+// LambdaFunctionRegex matches the names, partial arns and arns of lambda
+// functions, with an optional version or alias.
+const LambdaFunctionRegex = `^((arn:aws[a-zA-Z-]*:lambda:[a-z0-9-]+:)?[0-9]{12}:function:)?[a-zA-Z0-9_-]{1,64}(:(\$LATEST|[a-zA-Z0-9_-]+))?$`
+
+var lambdaFunctionRegex = regexp.MustCompile(LambdaFunctionRegex)
+
 type TimeoutDefinition struct {
 	Interrupt string `yaml:"interrupt,omitempty"`
 	Kill      string `yaml:"kill,omitempty"`
@@ -36,6 +45,31 @@ func (o *TimeoutDefinition) Validate() error {
 	return nil
 }
 
+// QueueDefinition makes a singular workflow queue the invocations it gets
+// while an instance runs, instead of failing them. Queued invocations that
+// haven't started within the ttl are dropped, and with notify an event
+// reports it.
+type QueueDefinition struct {
+	TTL    string `yaml:"ttl" json:"ttl"`
+	Notify bool   `yaml:"notify,omitempty" json:"notify,omitempty"`
+}
+
+func (o *QueueDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+
+	if o.TTL == "" {
+		return errors.New("ttl required")
+	}
+
+	if !isISO8601(o.TTL) {
+		return errors.New("ttl is not a ISO8601 string")
+	}
+
+	return nil
+}
+
 type FunctionFileDefinition struct {
 	Key   string `yaml:"key" json:"key"`
 	As    string `yaml:"as,omitempty" json:"as,omitempty"`
@@ -73,12 +107,17 @@ func (o FunctionFileDefinition) Validate() error {
 }
 
 type FunctionDefinition struct {
-	ID    string                   `yaml:"id"`
-	Image string                   `yaml:"image"`
-	Size  Size                     `yaml:"size,omitempty"`
-	Cmd   string                   `yaml:"cmd,omitempty"`
-	Scale int                      `yaml:"scale,omitempty"`
-	Files []FunctionFileDefinition `yaml:"files,omitempty"`
+	ID       string                   `yaml:"id"`
+	Image    string                   `yaml:"image,omitempty"`
+	Service  string                   `yaml:"service,omitempty"`
+	HTTP     *HTTPDefinition          `yaml:"http,omitempty"`
+	Lambda   string                   `yaml:"lambda,omitempty"`
+	Callback bool                     `yaml:"callback,omitempty"`
+	Size     Size                     `yaml:"size,omitempty"`
+	Profile  string                   `yaml:"profile,omitempty"`
+	Cmd      string                   `yaml:"cmd,omitempty"`
+	Scale    int                      `yaml:"scale,omitempty"`
+	Files    []FunctionFileDefinition `yaml:"files,omitempty"`
 }
 
 func (o *FunctionDefinition) Validate() error {
@@ -99,10 +138,30 @@ func (o *FunctionDefinition) Validate() error {
 		return fmt.Errorf("function id must match regex: %s", FunctionNameRegex)
 	}
 
+	if o.Service != "" || o.Lambda != "" {
+		return o.validateExternal()
+	}
+
+	if o.Callback {
+		return errors.New("callback only applies to lambda functions")
+	}
+
+	if o.HTTP != nil {
+		return errors.New("http only applies to services")
+	}
+
 	if o.Image == "" {
 		return errors.New("image required")
 	}
 
+	if o.Profile != "" && o.Size != SmallSize {
+		return errors.New("size and profile are mutually exclusive")
+	}
+
+	if err := validateVariableReference("profile", o.Profile); err != nil {
+		return err
+	}
+
 	for i, f := range o.Files {
 		err := f.Validate()
 		if err != nil {
@@ -114,6 +173,84 @@ func (o *FunctionDefinition) Validate() error {
 
 }
 
+// validateExternal checks a function that calls an existing service or lambda
+// function instead of running an image as an isolate.
+func (o *FunctionDefinition) validateExternal() error {
+
+	if o.Image != "" || (o.Service != "" && o.Lambda != "") {
+		return errors.New("image, service, and lambda are mutually exclusive")
+	}
+
+	if o.Service != "" {
+
+		u, err := url.Parse(o.Service)
+		if err != nil {
+			return fmt.Errorf("invalid service: %v", err)
+		}
+
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.New("service must be an http or https url")
+		}
+
+		if o.Callback {
+			return errors.New("callback only applies to lambda functions")
+		}
+
+		if err := o.HTTP.Validate(); err != nil {
+			return fmt.Errorf("http: %v", err)
+		}
+
+	}
+
+	if o.Lambda != "" && o.HTTP != nil {
+		return errors.New("http only applies to services")
+	}
+
+	if o.Lambda != "" && !lambdaFunctionRegex.MatchString(o.Lambda) {
+		return errors.New("lambda must be a function name or arn")
+	}
+
+	if o.Cmd != "" || o.Size != SmallSize || o.Profile != "" || o.Scale != 0 || len(o.Files) != 0 {
+		return errors.New("cmd, size, profile, scale, and files only apply to images")
+	}
+
+	return nil
+
+}
+
+// maxHTTPRetries limits how often a service request is retried.
+const maxHTTPRetries = 10
+
+// HTTPDefinition configures the requests made to a service.
+type HTTPDefinition struct {
+	Headers map[string]string `yaml:"headers,omitempty"`
+	Timeout string            `yaml:"timeout,omitempty"`
+	Retries int               `yaml:"retries,omitempty"`
+}
+
+func (o *HTTPDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+
+	for k := range o.Headers {
+		if k == "" || strings.ContainsAny(k, " :\r\n") {
+			return fmt.Errorf("invalid header name '%s'", k)
+		}
+	}
+
+	if o.Timeout != "" && !isISO8601(o.Timeout) {
+		return errors.New("timeout is not a ISO8601 string")
+	}
+
+	if o.Retries < 0 || o.Retries > maxHTTPRetries {
+		return fmt.Errorf("retries must be between 0 and %d", maxHTTPRetries)
+	}
+
+	return nil
+
+}
+
 type SchemaDefinition struct {
 	ID     string      `yaml:"id"`
 	Schema interface{} `yaml:"schema"`
@@ -137,11 +274,15 @@ func (o *SchemaDefinition) Validate() error {
 }
 
 type ActionDefinition struct {
-	Function string           `yaml:"function,omitempty"`
-	Workflow string           `yaml:"workflow,omitempty"`
-	Input    interface{}      `yaml:"input,omitempty"`
-	Secrets  []string         `yaml:"secrets,omitempty"`
-	Retries  *RetryDefinition `yaml:"retries,omitempty"`
+	Function    string           `yaml:"function,omitempty"`
+	Workflow    string           `yaml:"workflow,omitempty"`
+	Remote      string           `yaml:"remote,omitempty"`
+	Input       interface{}      `yaml:"input,omitempty"`
+	Output      interface{}      `yaml:"output,omitempty"`
+	Timeout     string           `yaml:"timeout,omitempty"`
+	Secrets     []string         `yaml:"secrets,omitempty"`
+	Retries     *RetryDefinition `yaml:"retries,omitempty"`
+	RetryPolicy string           `yaml:"retry_policy,omitempty" json:"retry_policy,omitempty"`
 }
 
 func (o *ActionDefinition) Validate() error {
@@ -157,6 +298,42 @@ func (o *ActionDefinition) Validate() error {
 		return errors.New("must define atleast one function or workflow")
 	}
 
+	if o.Output != nil && o.Workflow == "" {
+		return errors.New("output can only be used with workflow")
+	}
+
+	if o.Workflow != "" {
+		_, rev, err := SplitWorkflowReference(o.Workflow)
+		if err != nil {
+			return err
+		}
+		if o.Remote != "" && rev >= 0 {
+			return errors.New("workflows of remote namespaces cannot be pinned to a revision")
+		}
+	}
+
+	if o.Remote != "" && o.Workflow == "" {
+		return errors.New("remote can only be used with workflow")
+	}
+
+	if o.Timeout != "" && o.Workflow == "" {
+		return errors.New("timeout can only be used with workflow")
+	}
+
+	if o.Timeout != "" && !isISO8601(o.Timeout) {
+		return errors.New("timeout is not a ISO8601 string")
+	}
+
+	if s, ok := o.Output.(string); ok {
+		if err := validateTransformJQ(s); err != nil {
+			return err
+		}
+	}
+
+	if o.Retries != nil && o.RetryPolicy != "" {
+		return errors.New("retries and retry_policy cannot coexist")
+	}
+
 	if o.Retries != nil {
 		err := o.Retries.Validate()
 		if err != nil {
@@ -167,6 +344,29 @@ func (o *ActionDefinition) Validate() error {
 	return nil
 }
 
+// SplitWorkflowReference splits a subflow reference of the form
+// 'name@revision' into its parts. The revision is -1 if it isn't pinned.
+func SplitWorkflowReference(ref string) (string, int, error) {
+
+	idx := strings.LastIndex(ref, "@")
+	if idx < 0 {
+		return ref, -1, nil
+	}
+
+	name := ref[:idx]
+	if name == "" {
+		return "", 0, fmt.Errorf("workflow reference '%s' is missing a name", ref)
+	}
+
+	rev, err := strconv.Atoi(ref[idx+1:])
+	if err != nil || rev < 0 {
+		return "", 0, fmt.Errorf("workflow reference '%s' has an invalid revision", ref)
+	}
+
+	return name, rev, nil
+
+}
+
 // utils
 
 func isISO8601(date string) bool {
@@ -174,6 +374,38 @@ func isISO8601(date string) bool {
 	return (err == nil)
 }
 
+func isRFC3339(timestamp string) bool {
+	_, err := time.Parse(time.RFC3339, timestamp)
+	return (err == nil)
+}
+
+// IsJQQuery reports whether s embeds a jq or jsonata query to be evaluated
+// at runtime.
+func IsJQQuery(s string) bool {
+	return strings.Contains(s, "jq(") || strings.Contains(s, "jsonata(")
+}
+
+// validateVariableReference checks an optional reference to a namespace
+// variable, such as a calendar or resource profile.
+func validateVariableReference(field, name string) error {
+
+	if name == "" {
+		return nil
+	}
+
+	matched, err := regexp.MatchString(VariableNameRegex, name)
+	if err != nil {
+		return err
+	}
+
+	if !matched {
+		return fmt.Errorf("%s must match regex: %s", field, VariableNameRegex)
+	}
+
+	return nil
+
+}
+
 func isJSONSchema(schema interface{}) error {
 	s, err := json.Marshal(schema)
 	if err != nil {
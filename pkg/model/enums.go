@@ -193,6 +193,11 @@ const (
 	StateTypeCallback
 	StateTypeGetter
 	StateTypeSetter
+	StateTypeEventBatch
+	StateTypeMapReduce
+	StateTypePoll
+	StateTypeLua
+	StateTypeWasm
 )
 
 var stateTypeStrings []string = []string{
@@ -212,6 +217,11 @@ var stateTypeStrings []string = []string{
 	"callback",
 	"getter",
 	"setter",
+	"eventBatch",
+	"mapReduce",
+	"poll",
+	"lua",
+	"wasm",
 }
 
 func ParseStateType(s string) (StateType, error) {
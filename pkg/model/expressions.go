@@ -0,0 +1,146 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/vorteil/direktiv/pkg/jqer"
+)
+
+// Expression returns a state's condition, transform or similar expression
+// ready to be evaluated. Workflows that set 'expressions: jsonata' may write
+// these as bare jsonata, which gets wrapped here unless it already embeds a
+// jq or jsonata query.
+func (o *Workflow) Expression(x interface{}) interface{} {
+
+	s, ok := x.(string)
+	if !ok || o.Expressions != ExpressionsJSONata {
+		return x
+	}
+
+	if embedsQuery(s) {
+		return x
+	}
+
+	return "jsonata(" + s + ")"
+
+}
+
+// embedsQuery reports whether s contains a jq(...) or jsonata(...) wrapper,
+// ignoring the contents of jsonata string literals, quoted names and
+// comments, and calls of functions whose names only end in jq or jsonata.
+func embedsQuery(s string) bool {
+
+	for i := 0; i < len(s); i++ {
+
+		switch c := s[i]; {
+		case c == '"' || c == '\'' || c == '`':
+			for i++; i < len(s) && s[i] != c; i++ {
+				if s[i] == '\\' && c != '`' {
+					i++
+				}
+			}
+		case strings.HasPrefix(s[i:], "/*"):
+			end := strings.Index(s[i+2:], "*/")
+			if end < 0 {
+				return false
+			}
+			i += end + 3
+		case strings.HasPrefix(s[i:], "jq(") || strings.HasPrefix(s[i:], "jsonata("):
+			if i == 0 || !isNameChar(s[i-1]) {
+				return true
+			}
+		}
+
+	}
+
+	return false
+
+}
+
+func isNameChar(c byte) bool {
+	return c == '$' || c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// validateExpressions compiles the jsonata expressions of a state, so that
+// the parts of jsonata that aren't supported are refused when the workflow is
+// saved rather than when an instance reaches them. jq expressions are only
+// checked when they run.
+func (o *Workflow) validateExpressions(state State) error {
+
+	exprs, queries := stateExpressions(state)
+
+	for k, x := range exprs {
+		if err := jqer.ValidateJSONata(o.Expression(x)); err != nil {
+			return fmt.Errorf("%s is invalid: %v", k, err)
+		}
+	}
+
+	for k, x := range queries {
+		if err := jqer.ValidateJSONata(x); err != nil {
+			return fmt.Errorf("%s is invalid: %v", k, err)
+		}
+	}
+
+	return nil
+
+}
+
+// stateExpressions returns the expressions of a state that Expression
+// applies to, and the other values of the state that may embed queries, by
+// their names in the definition.
+func stateExpressions(state State) (map[string]interface{}, map[string]interface{}) {
+
+	exprs := make(map[string]interface{})
+	queries := make(map[string]interface{})
+
+	// every state can log, and all but the switch state have a transform
+	v := reflect.Indirect(reflect.ValueOf(state))
+	if f := v.FieldByName("Transform"); f.IsValid() {
+		exprs["transform"] = f.Interface()
+	}
+	if f := v.FieldByName("Log"); f.IsValid() {
+		queries["log"] = f.Interface()
+	}
+
+	actions := func(name string, list ...ActionDefinition) {
+		for i, a := range list {
+			queries[fmt.Sprintf("%s[%d].input", name, i)] = a.Input
+		}
+	}
+
+	switch s := state.(type) {
+	case *ActionState:
+		actions("action", *s.Action)
+	case *PollState:
+		actions("action", *s.Action)
+		exprs["until"] = s.Until
+	case *ForEachState:
+		actions("action", *s.Action)
+		exprs["array"] = s.Array
+	case *MapReduceState:
+		actions("action", *s.Action)
+		exprs["array"] = s.Array
+		exprs["reduce"] = s.Reduce
+	case *ParallelState:
+		actions("actions", s.Actions...)
+	case *SwitchState:
+		for i, c := range s.Conditions {
+			exprs[fmt.Sprintf("conditions[%d].condition", i)] = c.Condition
+			exprs[fmt.Sprintf("conditions[%d].transform", i)] = c.Transform
+		}
+		exprs["defaultTransform"] = s.DefaultTransform
+	case *EventsXorState:
+		for i, e := range s.Events {
+			exprs[fmt.Sprintf("events[%d].transform", i)] = e.Transform
+		}
+	case *ValidateState:
+		if s.Subject != "" {
+			exprs["subject"] = s.Subject
+		}
+	}
+
+	return exprs, queries
+
+}
@@ -1,8 +1,21 @@
 package model
 
+import "fmt"
+
+// Concurrency policies of scheduled starts, for when the cron fires while an
+// instance it started earlier is still running: allow runs both, forbid skips
+// the new run and replace cancels the running instance.
+const (
+	ConcurrencyAllow   = "allow"
+	ConcurrencyForbid  = "forbid"
+	ConcurrencyReplace = "replace"
+)
+
 type ScheduledStart struct {
 	StartCommon `yaml:",inline"`
 	Cron        string `yaml:"cron,omitempty"`
+	Calendar    string `yaml:"calendar,omitempty"`
+	Concurrency string `yaml:"concurrency,omitempty"`
 }
 
 func (o *ScheduledStart) GetEvents() []StartEventDefinition {
@@ -18,5 +31,15 @@ func (o *ScheduledStart) Validate() error {
 		return err
 	}
 
+	if err := validateVariableReference("calendar", o.Calendar); err != nil {
+		return err
+	}
+
+	switch o.Concurrency {
+	case "", ConcurrencyAllow, ConcurrencyForbid, ConcurrencyReplace:
+	default:
+		return fmt.Errorf("concurrency must be one of '%s', '%s' or '%s'", ConcurrencyAllow, ConcurrencyForbid, ConcurrencyReplace)
+	}
+
 	return nil
 }
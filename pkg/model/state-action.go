@@ -11,6 +11,7 @@ type ActionState struct {
 	Action      *ActionDefinition `yaml:"action"`
 	Async       bool              `yaml:"async"`
 	Timeout     string            `yaml:"timeout,omitempty"`
+	Heartbeat   string            `yaml:"heartbeat,omitempty"`
 	Transform   interface{}       `yaml:"transform,omitempty"`
 	Transition  string            `yaml:"transition,omitempty"`
 }
@@ -62,6 +63,15 @@ func (o *ActionState) Validate() error {
 		return errors.New("timeout is not a ISO8601 string")
 	}
 
+	if o.Heartbeat != "" {
+		if !isISO8601(o.Heartbeat) {
+			return errors.New("heartbeat is not a ISO8601 string")
+		}
+		if o.Async {
+			return errors.New("heartbeat is not supported by async actions")
+		}
+	}
+
 	if s, ok := o.Transform.(string); ok {
 		if err := validateTransformJQ(s); err != nil {
 			return err
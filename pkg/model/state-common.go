@@ -2,13 +2,19 @@ package model
 
 import (
 	"errors"
+	"fmt"
+
+	"github.com/vorteil/direktiv/pkg/jqer"
 )
 
 type RetryDefinition struct {
-	MaxAttempts int      `yaml:"max_attempts" json:"max_attempts"`
-	Delay       string   `yaml:"delay,omitempty" json:"delay"`
-	Multiplier  float64  `yaml:"multiplier,omitempty" json:"multiplier"`
-	Codes       []string `yaml:"codes" json:"codes"`
+	MaxAttempts int         `yaml:"max_attempts" json:"max_attempts"`
+	Delay       string      `yaml:"delay,omitempty" json:"delay"`
+	Multiplier  float64     `yaml:"multiplier,omitempty" json:"multiplier"`
+	MaxDelay    string      `yaml:"max_delay,omitempty" json:"max_delay"`
+	Jitter      float64     `yaml:"jitter,omitempty" json:"jitter"`
+	Codes       []string    `yaml:"codes" json:"codes"`
+	Condition   interface{} `yaml:"condition,omitempty" json:"condition,omitempty"`
 }
 
 func (o *RetryDefinition) Validate() error {
@@ -24,16 +30,69 @@ func (o *RetryDefinition) Validate() error {
 		return errors.New("delay is not a ISO8601 string")
 	}
 
+	if o.MaxDelay != "" && !isISO8601(o.MaxDelay) {
+		return errors.New("max_delay is not a ISO8601 string")
+	}
+
+	if o.Jitter < 0 || o.Jitter > 1 {
+		return errors.New("jitter must be between 0 and 1")
+	}
+
 	if len(o.Codes) == 0 {
 		return errors.New("retry policy requires at least one defined code")
 	}
 
+	if o.Condition != nil {
+		if err := jqer.Validate(o.Condition); err != nil {
+			return fmt.Errorf("condition is invalid: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// RetryPolicyDefinition is a RetryDefinition declared once at the workflow
+// level so that it can be referenced by name from multiple actions.
+type RetryPolicyDefinition struct {
+	ID              string `yaml:"id" json:"id"`
+	RetryDefinition `yaml:",inline"`
+}
+
+func (o *RetryPolicyDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+
+	if o.ID == "" {
+		return errors.New("id required")
+	}
+
+	return o.RetryDefinition.Validate()
+}
+
+// RetryPolicies are retry definitions by name, such as those a namespace
+// defines for the workflows in it.
+type RetryPolicies map[string]RetryDefinition
+
+// Validate returns an error if any of the policies is invalid.
+func (o RetryPolicies) Validate() error {
+	for name, policy := range o {
+		if name == "" {
+			return errors.New("retry policy without a name")
+		}
+
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("retry policy '%s' is invalid: %v", name, err)
+		}
+	}
+
 	return nil
 }
 
 type ErrorDefinition struct {
 	Error      string `yaml:"error"`
 	Transition string `yaml:"transition,omitempty"`
+	Exhausted  string `yaml:"exhausted,omitempty"`
 }
 
 func (o *ErrorDefinition) Validate() error {
@@ -49,6 +108,7 @@ type State interface {
 	GetType() StateType
 	Validate() error
 	ErrorDefinitions() []ErrorDefinition
+	GetTimeouts() *TimeoutDefinition
 	GetTransitions() []string
 	getTransitions() map[string]string
 }
@@ -88,10 +148,11 @@ func (o *ProduceEventDefinition) Validate() error {
 }
 
 type StateCommon struct {
-	ID    string            `yaml:"id"`
-	Type  StateType         `yaml:"type"`
-	Log   interface{}       `yaml:"log,omitempty"`
-	Catch []ErrorDefinition `yaml:"catch,omitempty"`
+	ID       string             `yaml:"id"`
+	Type     StateType          `yaml:"type"`
+	Log      interface{}        `yaml:"log,omitempty"`
+	Catch    []ErrorDefinition  `yaml:"catch,omitempty"`
+	Timeouts *TimeoutDefinition `yaml:"timeouts,omitempty"`
 }
 
 func (o *StateCommon) GetType() StateType {
@@ -106,6 +167,12 @@ func (o *StateCommon) ErrorDefinitions() []ErrorDefinition {
 	return o.Catch
 }
 
+// GetTimeouts returns the state's own timeout overrides, or nil if the
+// state relies on its default deadline.
+func (o *StateCommon) GetTimeouts() *TimeoutDefinition {
+	return o.Timeouts
+}
+
 func (o *StateCommon) commonValidate() error {
 	if o.ID == "" {
 		return errors.New("id required")
@@ -125,6 +192,10 @@ func (o *StateCommon) commonValidate() error {
 		}
 	}
 
+	if err := o.Timeouts.Validate(); err != nil {
+		return fmt.Errorf("timeouts invalid: %w", err)
+	}
+
 	return nil
 }
 
@@ -164,6 +235,16 @@ func getStateFromType(stype string) (State, error) {
 		s = new(GetterState)
 	case StateTypeSetter.String():
 		s = new(SetterState)
+	case StateTypeEventBatch.String():
+		s = new(EventBatchState)
+	case StateTypeMapReduce.String():
+		s = new(MapReduceState)
+	case StateTypePoll.String():
+		s = new(PollState)
+	case StateTypeLua.String():
+		s = new(LuaState)
+	case StateTypeWasm.String():
+		s = new(WasmState)
 	case "":
 		err = errors.New("type required")
 	default:
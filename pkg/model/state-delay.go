@@ -3,11 +3,17 @@ package model
 import (
 	"errors"
 	"fmt"
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/calendar"
 )
 
 type DelayState struct {
 	StateCommon `yaml:",inline"`
-	Duration    string      `yaml:"duration"`
+	Duration    string      `yaml:"duration,omitempty"`
+	Until       string      `yaml:"until,omitempty"`
+	At          string      `yaml:"at,omitempty"`
+	Calendar    string      `yaml:"calendar,omitempty"`
 	Transform   interface{} `yaml:"transform,omitempty"`
 	Transition  string      `yaml:"transition,omitempty"`
 }
@@ -57,12 +63,37 @@ func (o *DelayState) Validate() error {
 		}
 	}
 
-	if o.Duration == "" {
-		return errors.New("duration required")
+	var modes int
+	for _, s := range []string{o.Duration, o.Until, o.At} {
+		if s != "" {
+			modes++
+		}
+	}
+
+	if modes == 0 {
+		return errors.New("duration, until, or at required")
+	}
+
+	if modes > 1 {
+		return errors.New("duration, until, and at are mutually exclusive")
+	}
+
+	if o.Duration != "" && !isISO8601(o.Duration) && !IsJQQuery(o.Duration) {
+		return errors.New("duration is not a ISO8601 string or jq query")
+	}
+
+	if o.Until != "" && !isRFC3339(o.Until) && !IsJQQuery(o.Until) {
+		return errors.New("until is not a RFC3339 timestamp or jq query")
 	}
 
-	if !isISO8601(o.Duration) {
-		return errors.New("duration is not a ISO8601 string")
+	if o.At != "" {
+		if _, err := time.Parse(calendar.ClockFormat, o.At); err != nil {
+			return fmt.Errorf("at is not a time of day in the format %s", calendar.ClockFormat)
+		}
+	}
+
+	if err := validateVariableReference("calendar", o.Calendar); err != nil {
+		return err
 	}
 
 	for i, errDef := range o.ErrorDefinitions() {
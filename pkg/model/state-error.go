@@ -9,6 +9,7 @@ type ErrorState struct {
 	Error       string      `yaml:"error"`
 	Message     string      `yaml:"message"`
 	Args        []string    `yaml:"args,omitempty"`
+	Data        interface{} `yaml:"data,omitempty"`
 	Transform   interface{} `yaml:"transform,omitempty"`
 	Transition  string      `yaml:"transition,omitempty"`
 }
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+type EventBatchState struct {
+	StateCommon `yaml:",inline"`
+	Event       *ConsumeEventDefinition `yaml:"event"`
+	Duration    string                  `yaml:"duration,omitempty"`
+	Count       int                     `yaml:"count,omitempty"`
+	Timeout     string                  `yaml:"timeout,omitempty"`
+	Transform   interface{}             `yaml:"transform,omitempty"`
+	Transition  string                  `yaml:"transition,omitempty"`
+}
+
+func (o *EventBatchState) GetID() string {
+	return o.ID
+}
+
+func (o *EventBatchState) getTransitions() map[string]string {
+	transitions := make(map[string]string)
+	if o.Transition != "" {
+		transitions["transition"] = o.Transition
+	}
+
+	for i, errDef := range o.ErrorDefinitions() {
+		if errDef.Transition != "" {
+			transitions[fmt.Sprintf("errors[%v]", i)] = errDef.Transition
+		}
+	}
+
+	return transitions
+}
+
+func (o *EventBatchState) GetTransitions() []string {
+	transitions := make([]string, 0)
+	if o.Transition != "" {
+		transitions = append(transitions, o.Transition)
+	}
+
+	for _, errDef := range o.ErrorDefinitions() {
+		if errDef.Transition != "" {
+			transitions = append(transitions, errDef.Transition)
+		}
+	}
+
+	return transitions
+}
+
+func (o *EventBatchState) Validate() error {
+	if err := o.commonValidate(); err != nil {
+		return err
+	}
+
+	if s, ok := o.Transform.(string); ok {
+		if err := validateTransformJQ(s); err != nil {
+			return err
+		}
+	}
+
+	if o.Event == nil {
+		return errors.New("event required")
+	}
+
+	if err := o.Event.Validate(); err != nil {
+		return fmt.Errorf("event is invalid: %v", err)
+	}
+
+	if o.Duration == "" && o.Count == 0 {
+		return errors.New("duration or count required")
+	}
+
+	if o.Duration != "" && !isISO8601(o.Duration) {
+		return errors.New("duration is not a ISO8601 string")
+	}
+
+	if o.Count < 0 {
+		return errors.New("count must not be negative")
+	}
+
+	if o.Timeout != "" && !isISO8601(o.Timeout) {
+		return errors.New("timeout is not a ISO8601 string")
+	}
+
+	for i, errDef := range o.ErrorDefinitions() {
+		if err := errDef.Validate(); err != nil {
+			return fmt.Errorf("catch[%v] is invalid: %v", i, err)
+		}
+	}
+
+	return nil
+}
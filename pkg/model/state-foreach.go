@@ -9,6 +9,7 @@ type ForEachState struct {
 	StateCommon `yaml:",inline"`
 	Array       interface{}       `yaml:"array"`
 	Action      *ActionDefinition `yaml:"action"`
+	BatchSize   int               `yaml:"batchSize,omitempty"`
 	Timeout     string            `yaml:"timeout,omitempty"`
 	Transform   interface{}       `yaml:"transform,omitempty"`
 	Transition  string            `yaml:"transition,omitempty"`
@@ -67,6 +68,10 @@ func (o *ForEachState) Validate() error {
 		return errors.New("action required")
 	}
 
+	if o.BatchSize < 0 {
+		return errors.New("batchSize must not be negative")
+	}
+
 	if o.Timeout != "" && !isISO8601(o.Timeout) {
 		return errors.New("timeout is not a ISO8601 string")
 	}
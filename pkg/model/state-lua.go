@@ -0,0 +1,78 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/yuin/gopher-lua/parse"
+)
+
+type LuaState struct {
+	StateCommon `yaml:",inline"`
+	Script      string      `yaml:"script"`
+	Transform   interface{} `yaml:"transform,omitempty"`
+	Transition  string      `yaml:"transition,omitempty"`
+}
+
+func (o *LuaState) GetID() string {
+	return o.ID
+}
+
+func (o *LuaState) getTransitions() map[string]string {
+	transitions := make(map[string]string)
+	if o.Transition != "" {
+		transitions["transition"] = o.Transition
+	}
+
+	for i, errDef := range o.ErrorDefinitions() {
+		if errDef.Transition != "" {
+			transitions[fmt.Sprintf("errors[%v]", i)] = errDef.Transition
+		}
+	}
+
+	return transitions
+}
+
+func (o *LuaState) GetTransitions() []string {
+	transitions := make([]string, 0)
+	if o.Transition != "" {
+		transitions = append(transitions, o.Transition)
+	}
+
+	for _, errDef := range o.ErrorDefinitions() {
+		if errDef.Transition != "" {
+			transitions = append(transitions, errDef.Transition)
+		}
+	}
+
+	return transitions
+}
+
+func (o *LuaState) Validate() error {
+	if err := o.commonValidate(); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(o.Script) == "" {
+		return errors.New("script required")
+	}
+
+	if _, err := parse.Parse(strings.NewReader(o.Script), o.ID); err != nil {
+		return fmt.Errorf("script is invalid: %v", err)
+	}
+
+	if s, ok := o.Transform.(string); ok {
+		if err := validateTransformJQ(s); err != nil {
+			return err
+		}
+	}
+
+	for i, errDef := range o.ErrorDefinitions() {
+		if err := errDef.Validate(); err != nil {
+			return fmt.Errorf("catch[%v] is invalid: %v", i, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"errors"
+)
+
+type MapReduceState struct {
+	ForEachState `yaml:",inline"`
+	Reduce       interface{} `yaml:"reduce"`
+}
+
+func (o *MapReduceState) Validate() error {
+	if err := o.ForEachState.Validate(); err != nil {
+		return err
+	}
+
+	if o.Reduce == nil {
+		return errors.New("reduce required")
+	}
+
+	if s, ok := o.Reduce.(string); ok {
+		if err := validateTransformJQ(s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
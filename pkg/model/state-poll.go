@@ -0,0 +1,40 @@
+package model
+
+import (
+	"errors"
+)
+
+type PollState struct {
+	ActionState    `yaml:",inline"`
+	Interval       string      `yaml:"interval"`
+	Until          interface{} `yaml:"until,omitempty"`
+	MaxRepetitions int         `yaml:"maxRepetitions,omitempty"`
+}
+
+func (o *PollState) Validate() error {
+	if err := o.ActionState.Validate(); err != nil {
+		return err
+	}
+
+	if o.Async {
+		return errors.New("async is not supported by poll states")
+	}
+
+	if o.Interval == "" {
+		return errors.New("interval required")
+	}
+
+	if !isISO8601(o.Interval) {
+		return errors.New("interval is not a ISO8601 string")
+	}
+
+	if o.Until == nil && o.MaxRepetitions == 0 {
+		return errors.New("until or maxRepetitions required")
+	}
+
+	if o.MaxRepetitions < 0 {
+		return errors.New("maxRepetitions must not be negative")
+	}
+
+	return nil
+}
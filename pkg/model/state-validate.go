@@ -3,14 +3,76 @@ package model
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 )
 
+// JSONSchemaRegex - Regex used to validate the names of stored json schemas
+const JSONSchemaRegex = `^[a-z][a-z0-9_-]{0,63}$`
+
 type ValidateState struct {
 	StateCommon `yaml:",inline"`
-	Subject     string      `yaml:"subject"`
-	Schema      interface{} `yaml:"schema"`
-	Transform   interface{} `yaml:"transform,omitempty"`
-	Transition  string      `yaml:"transition,omitempty"`
+	Subject     string                `yaml:"subject"`
+	Schema      interface{}           `yaml:"schema,omitempty"`
+	SchemaRef   *JSONSchemaReference  `yaml:"schemaRef,omitempty"`
+	Violations  []ViolationDefinition `yaml:"violations,omitempty"`
+	Transform   interface{}           `yaml:"transform,omitempty"`
+	Transition  string                `yaml:"transition,omitempty"`
+}
+
+// ViolationDefinition turns violations of the schema at a path, such as
+// customer.email or items.*.price, into an error of the author's choosing.
+// Without a path it matches violations anywhere, and with a type only
+// violations of that JSON Schema keyword, such as required or format.
+type ViolationDefinition struct {
+	Path    string `yaml:"path,omitempty"`
+	Type    string `yaml:"type,omitempty"`
+	Error   string `yaml:"error"`
+	Message string `yaml:"message,omitempty"`
+}
+
+func (o *ViolationDefinition) Validate() error {
+
+	if o.Error == "" {
+		return errors.New("error required")
+	}
+
+	if o.Path != "" {
+		for _, elem := range strings.Split(o.Path, ".") {
+			if elem == "" {
+				return fmt.Errorf("invalid path '%s'", o.Path)
+			}
+		}
+	}
+
+	return nil
+
+}
+
+// JSONSchemaReference names a json schema of the namespace, and optionally
+// one of its versions instead of the latest.
+type JSONSchemaReference struct {
+	Name    string `yaml:"name"`
+	Version int    `yaml:"version,omitempty"`
+}
+
+func (o *JSONSchemaReference) Validate() error {
+
+	matched, err := regexp.MatchString(JSONSchemaRegex, o.Name)
+	if err != nil {
+		return err
+	}
+
+	if !matched {
+		return fmt.Errorf("name must match regex: %s", JSONSchemaRegex)
+	}
+
+	if o.Version < 0 {
+		return errors.New("version must not be negative")
+	}
+
+	return nil
+
 }
 
 func (o *ValidateState) GetID() string {
@@ -58,14 +120,28 @@ func (o *ValidateState) Validate() error {
 		}
 	}
 
-	if o.Schema == nil {
-		return errors.New("schema required")
+	if o.Schema == nil && o.SchemaRef == nil {
+		return errors.New("schema or schemaRef required")
+	}
+
+	if o.Schema != nil && o.SchemaRef != nil {
+		return errors.New("schema and schemaRef are mutually exclusive")
 	}
 
-	if err := isJSONSchema(o.Schema); err != nil {
+	if o.SchemaRef != nil {
+		if err := o.SchemaRef.Validate(); err != nil {
+			return fmt.Errorf("schemaRef is invalid: %w", err)
+		}
+	} else if err := isJSONSchema(o.Schema); err != nil {
 		return fmt.Errorf("invalid schema: %w", err)
 	}
 
+	for i, v := range o.Violations {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("violations[%v] is invalid: %v", i, err)
+		}
+	}
+
 	for i, errDef := range o.ErrorDefinitions() {
 		if err := errDef.Validate(); err != nil {
 			return fmt.Errorf("catch[%v] is invalid: %v", i, err)
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// WasmModuleRegex - Regex used to validate the names of wasm modules
+const WasmModuleRegex = `^[a-z][a-z0-9_-]{0,63}$`
+
+type WasmState struct {
+	StateCommon `yaml:",inline"`
+	Module      string      `yaml:"module"`
+	Input       interface{} `yaml:"input,omitempty"`
+	Transform   interface{} `yaml:"transform,omitempty"`
+	Transition  string      `yaml:"transition,omitempty"`
+}
+
+func (o *WasmState) GetID() string {
+	return o.ID
+}
+
+func (o *WasmState) getTransitions() map[string]string {
+	transitions := make(map[string]string)
+	if o.Transition != "" {
+		transitions["transition"] = o.Transition
+	}
+
+	for i, errDef := range o.ErrorDefinitions() {
+		if errDef.Transition != "" {
+			transitions[fmt.Sprintf("errors[%v]", i)] = errDef.Transition
+		}
+	}
+
+	return transitions
+}
+
+func (o *WasmState) GetTransitions() []string {
+	transitions := make([]string, 0)
+	if o.Transition != "" {
+		transitions = append(transitions, o.Transition)
+	}
+
+	for _, errDef := range o.ErrorDefinitions() {
+		if errDef.Transition != "" {
+			transitions = append(transitions, errDef.Transition)
+		}
+	}
+
+	return transitions
+}
+
+func (o *WasmState) Validate() error {
+	if err := o.commonValidate(); err != nil {
+		return err
+	}
+
+	if o.Module == "" {
+		return errors.New("module required")
+	}
+
+	matched, err := regexp.MatchString(WasmModuleRegex, o.Module)
+	if err != nil {
+		return err
+	}
+
+	if !matched {
+		return fmt.Errorf("module must match regex: %s", WasmModuleRegex)
+	}
+
+	if s, ok := o.Input.(string); ok {
+		if err := validateTransformJQ(s); err != nil {
+			return err
+		}
+	}
+
+	if s, ok := o.Transform.(string); ok {
+		if err := validateTransformJQ(s); err != nil {
+			return err
+		}
+	}
+
+	for i, errDef := range o.ErrorDefinitions() {
+		if err := errDef.Validate(); err != nil {
+			return fmt.Errorf("catch[%v] is invalid: %v", i, err)
+		}
+	}
+
+	return nil
+}
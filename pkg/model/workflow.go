@@ -11,17 +11,34 @@ import (
 // WorkflowIDRegex - Regex used to validate ID
 const WorkflowIDRegex = "^[a-z][a-z0-9._-]{1,34}[a-z0-9]$"
 
+// Expression languages a workflow can default to for its bare expressions.
+const (
+	ExpressionsJQ      = "jq"
+	ExpressionsJSONata = "jsonata"
+)
+
 type Workflow struct {
-	ID          string               `yaml:"id" json:"id"`
-	Name        string               `yaml:"name,omitempty" json:"name,omitempty"`
-	Description string               `yaml:"description,omitempty" json:"description,omitempty"`
-	Version     string               `yaml:"version,omitempty" json:"version,omitempty"`
-	Exclusive   bool                 `yaml:"singular,omitempty" json:"singular,omitempty"`
-	Functions   []FunctionDefinition `yaml:"functions,omitempty" json:"functions,omitempty"`
-	Schemas     []SchemaDefinition   `yaml:"schemas,omitempty" json:"schemas,omitempty"`
-	States      []State              `yaml:"states,omitempty" json:"states,omitempty"`
-	Timeouts    *TimeoutDefinition   `yaml:"timeouts,omitempty" json:"timeouts,omitempty"`
-	Start       StartDefinition      `yaml:"start,omitempty" json:"start,omitempty"`
+	ID            string                  `yaml:"id" json:"id"`
+	Name          string                  `yaml:"name,omitempty" json:"name,omitempty"`
+	Description   string                  `yaml:"description,omitempty" json:"description,omitempty"`
+	Version       string                  `yaml:"version,omitempty" json:"version,omitempty"`
+	Exclusive     bool                    `yaml:"singular,omitempty" json:"singular,omitempty"`
+	Queue         *QueueDefinition        `yaml:"queue,omitempty" json:"queue,omitempty"`
+	Functions     []FunctionDefinition    `yaml:"functions,omitempty" json:"functions,omitempty"`
+	Schemas       []SchemaDefinition      `yaml:"schemas,omitempty" json:"schemas,omitempty"`
+	RetryPolicies []RetryPolicyDefinition `yaml:"retry_policies,omitempty" json:"retry_policies,omitempty"`
+	Retries       *RetryDefinition        `yaml:"retries,omitempty" json:"retries,omitempty"`
+	States        []State                 `yaml:"states,omitempty" json:"states,omitempty"`
+	EntryStates   []string                `yaml:"entry_states,omitempty" json:"entry_states,omitempty"`
+	Timeouts      *TimeoutDefinition      `yaml:"timeouts,omitempty" json:"timeouts,omitempty"`
+	Access        *AccessDefinition       `yaml:"access,omitempty" json:"access,omitempty"`
+	Defaults      map[string]interface{}  `yaml:"defaults,omitempty" json:"defaults,omitempty"`
+	Constants     map[string]interface{}  `yaml:"constants,omitempty" json:"constants,omitempty"`
+	Expressions   string                  `yaml:"expressions,omitempty" json:"expressions,omitempty"`
+	Start         StartDefinition         `yaml:"start,omitempty" json:"start,omitempty"`
+
+	// the retry policies of the namespace the workflow is loaded in, if known
+	namespacePolicies RetryPolicies
 }
 
 func (o *Workflow) unmarshal(m map[string]interface{}) error {
@@ -137,6 +154,11 @@ func (o *Workflow) validate() error {
 		return err
 	}
 
+	policies, err := o.getRetryPolicyMap()
+	if err != nil {
+		return err
+	}
+
 	if o.Start != nil && o.Start.GetState() != "" {
 		// Check if state exists
 		if _, ok := states[o.Start.GetState()]; !ok {
@@ -144,6 +166,16 @@ func (o *Workflow) validate() error {
 		}
 	}
 
+	if s, ok := o.Start.(*ScheduledStart); ok && o.Exclusive && s.Concurrency == ConcurrencyReplace {
+		return fmt.Errorf("singular workflows can't replace running instances on schedule")
+	}
+
+	for i, id := range o.EntryStates {
+		if _, ok := states[id]; !ok {
+			return fmt.Errorf("entry_states[%v] targets state that does not exist", i)
+		}
+	}
+
 	// functions
 	for i, function := range o.GetFunctions() {
 		if sErr := function.Validate(); sErr != nil {
@@ -158,6 +190,35 @@ func (o *Workflow) validate() error {
 		}
 	}
 
+	// retry policies
+	for i, policy := range o.GetRetryPolicies() {
+		if sErr := policy.Validate(); sErr != nil {
+			return fmt.Errorf("workflow retry policy[%v] is invalid: %v", i, sErr)
+		}
+	}
+
+	if err := o.Retries.Validate(); err != nil {
+		return fmt.Errorf("workflow retries are invalid: %v", err)
+	}
+
+	if err := o.Queue.Validate(); err != nil {
+		return fmt.Errorf("workflow queue is invalid: %v", err)
+	}
+
+	if o.Queue != nil && !o.Exclusive {
+		return fmt.Errorf("only singular workflows can queue invocations")
+	}
+
+	if err := o.Access.Validate(); err != nil {
+		return fmt.Errorf("workflow access is invalid: %v", err)
+	}
+
+	switch o.Expressions {
+	case "", ExpressionsJQ, ExpressionsJSONata:
+	default:
+		return fmt.Errorf("workflow expressions must be '%s' or '%s'", ExpressionsJQ, ExpressionsJSONata)
+	}
+
 	// states
 	for i, state := range o.GetStates() {
 		// Validate All State Transitions reference a exisiting state
@@ -167,17 +228,37 @@ func (o *Workflow) validate() error {
 			}
 		}
 
+		for j, errDef := range state.ErrorDefinitions() {
+			if _, ok := states[errDef.Exhausted]; errDef.Exhausted != "" && !ok {
+				return fmt.Errorf("workflow state[%v] 'errors[%v]' exhausted transition '%s' does not exist", i, j, errDef.Exhausted)
+			}
+		}
+
+		if err := o.validateExpressions(state); err != nil {
+			return fmt.Errorf("workflow state[%v] %v", i, err)
+		}
+
 		// Check if function actions are defined
 		fActions := make([]string, 0)
+		rActions := make([]string, 0)
 		switch state.GetType() {
 		case StateTypeAction:
 			fActions = append(fActions, state.(*ActionState).Action.Function)
+			rActions = append(rActions, state.(*ActionState).Action.RetryPolicy)
 		case StateTypeParallel:
 			for _, act := range state.(*ParallelState).Actions {
 				fActions = append(fActions, act.Function)
+				rActions = append(rActions, act.RetryPolicy)
 			}
 		case StateTypeForEach:
 			fActions = append(fActions, state.(*ForEachState).Action.Function)
+			rActions = append(rActions, state.(*ForEachState).Action.RetryPolicy)
+		case StateTypeMapReduce:
+			fActions = append(fActions, state.(*MapReduceState).Action.Function)
+			rActions = append(rActions, state.(*MapReduceState).Action.RetryPolicy)
+		case StateTypePoll:
+			fActions = append(fActions, state.(*PollState).Action.Function)
+			rActions = append(rActions, state.(*PollState).Action.RetryPolicy)
 		}
 
 		for j := range fActions {
@@ -186,6 +267,18 @@ func (o *Workflow) validate() error {
 			}
 		}
 
+		for j := range rActions {
+			if rActions[j] == "" {
+				continue
+			}
+			if _, rExists := policies[rActions[j]]; rExists {
+				continue
+			}
+			if _, rExists := o.namespacePolicies[rActions[j]]; o.namespacePolicies != nil && !rExists {
+				return fmt.Errorf("workflow state[%v] actions retry policy '%s' does not exist in the workflow or its namespace", i, rActions[j])
+			}
+		}
+
 	}
 
 	// timeout
@@ -257,6 +350,67 @@ func (o *Workflow) getFunctionMap() (map[string]FunctionDefinition, error) {
 	return funcMap, nil
 }
 
+// getRetryPolicyMap : Get retry policies as a map, and returns error if the same policy id is defined more than once
+func (o *Workflow) getRetryPolicyMap() (map[string]RetryPolicyDefinition, error) {
+	policyMap := make(map[string]RetryPolicyDefinition)
+
+	for _, policy := range o.GetRetryPolicies() {
+		pID := policy.ID
+		if _, ok := policyMap[pID]; ok {
+			return policyMap, fmt.Errorf("retry policy id '%s' is used in more than one retry policy", pID)
+		}
+		policyMap[pID] = policy
+	}
+
+	return policyMap, nil
+}
+
+func (o *Workflow) GetRetryPolicies() []RetryPolicyDefinition {
+	if o.RetryPolicies == nil {
+		return make([]RetryPolicyDefinition, 0)
+	}
+
+	return o.RetryPolicies
+}
+
+func (o *Workflow) GetRetryPolicy(id string) (*RetryDefinition, error) {
+
+	for i, policy := range o.RetryPolicies {
+		if policy.ID == id {
+			return &o.RetryPolicies[i].RetryDefinition, nil
+		}
+	}
+
+	return nil, fmt.Errorf("retry policy '%s' not defined", id)
+
+}
+
+// GetActionRetries - Resolve the retry definition that applies to an action,
+// whether it is defined inline or referenced by name. Names the workflow
+// doesn't define itself are looked up in the policies of its namespace, and
+// it is an error if they are in neither.
+func (o *Workflow) GetActionRetries(action *ActionDefinition, namespace RetryPolicies) (*RetryDefinition, error) {
+
+	if action == nil {
+		return nil, nil
+	}
+
+	if action.RetryPolicy == "" {
+		return action.Retries, nil
+	}
+
+	if retry, err := o.GetRetryPolicy(action.RetryPolicy); err == nil {
+		return retry, nil
+	}
+
+	if retry, ok := namespace[action.RetryPolicy]; ok {
+		return &retry, nil
+	}
+
+	return nil, fmt.Errorf("retry policy '%s' is defined neither by the workflow nor its namespace", action.RetryPolicy)
+
+}
+
 func (o *Workflow) GetSchemas() []SchemaDefinition {
 	if o.Schemas == nil {
 		return make([]SchemaDefinition, 0)
@@ -302,10 +456,31 @@ func (o *Workflow) UnmarshalYAML(unmarshal func(interface{}) error) error {
 
 }
 
+// Load parses and validates a workflow definition. Retry policies the
+// workflow references without defining them itself can only be checked
+// against a namespace, so they are left for LoadInNamespace or for when the
+// workflow runs.
 func (o *Workflow) Load(data []byte) error {
 	return yaml.Unmarshal(data, o)
 }
 
+// LoadInNamespace is Load for a workflow saved in a namespace with the given
+// retry policies, rejecting references to policies neither defines.
+func (o *Workflow) LoadInNamespace(data []byte, policies RetryPolicies) error {
+
+	if policies == nil {
+		policies = make(RetryPolicies)
+	}
+
+	o.namespacePolicies = policies
+	defer func() {
+		o.namespacePolicies = nil
+	}()
+
+	return o.Load(data)
+
+}
+
 func (o *Workflow) GetStartState() State {
 
 	if o.Start == nil || o.Start.GetState() == "" {
@@ -322,6 +497,29 @@ func (o *Workflow) GetStartState() State {
 
 }
 
+// GetEntryState : Get the state an instance should begin at. An empty id
+// resolves to the start state, anything else must be listed in entry_states.
+func (o *Workflow) GetEntryState(id string) (State, error) {
+
+	if id == "" {
+		return o.GetStartState(), nil
+	}
+
+	for _, entry := range o.EntryStates {
+		if entry != id {
+			continue
+		}
+		for _, state := range o.States {
+			if state.GetID() == id {
+				return state, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("state '%s' is not an entry state", id)
+
+}
+
 // VariableReference - Workflow variable referenced in getter or setter
 type VariableReference struct {
 	Scope     string   `json:"scope"`
@@ -0,0 +1,241 @@
+package otlp
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/model"
+	"github.com/vorteil/direktiv/pkg/replay"
+)
+
+// Parent is the state span of the instance that invoked a subflow.
+type Parent struct {
+	Trace TraceID
+	Span  SpanID
+}
+
+// InstanceSpanID returns the id of the span of an instance.
+func InstanceSpanID(instance string) SpanID {
+	return NewSpanID(instance)
+}
+
+// StateSpanID returns the id of the span of a step of an instance.
+func StateSpanID(instance string, step int) SpanID {
+	return NewSpanID(instance, ":", step)
+}
+
+// FromHistory converts the recorded history of an instance that finished at
+// end into spans: one for the instance, a child of it for each state the
+// instance ran, and a child of that for each wait of an action, foreach,
+// parallel or poll state on its actions or subflows. If parent isn't nil the
+// instance was invoked as a subflow and is part of its caller's trace.
+func FromHistory(h *replay.History, end time.Time, parent *Parent) []*Span {
+
+	root := &Span{
+		Trace: NewTraceID(h.Instance),
+		ID:    InstanceSpanID(h.Instance),
+		Name:  fmt.Sprintf("%s/%s", h.Namespace, h.Workflow),
+		Start: end,
+		End:   end,
+		Attributes: map[string]interface{}{
+			"direktiv.namespace": h.Namespace,
+			"direktiv.workflow":  h.Workflow,
+			"direktiv.instance":  h.Instance,
+			"direktiv.status":    h.Status,
+		},
+	}
+
+	if parent != nil {
+		root.Trace = parent.Trace
+		root.Parent = parent.Span
+	}
+
+	if len(h.Steps) > 0 {
+		root.Start = h.Steps[0].Time
+	}
+
+	if h.Status != "complete" {
+		root.Error = h.Status
+	}
+
+	spans := []*Span{root}
+
+	var wf *model.Workflow
+
+	for i := 0; i < len(h.Steps); {
+
+		// runs of the same state share a step
+		j := i + 1
+		for j < len(h.Steps) && h.Steps[j].Step == h.Steps[i].Step {
+			j++
+		}
+		runs := h.Steps[i:j]
+
+		stateEnd := end
+		if j < len(h.Steps) {
+			stateEnd = h.Steps[j].Time
+		}
+
+		for _, r := range runs {
+			if r.Definition == "" {
+				continue
+			}
+			w := new(model.Workflow)
+			if w.Load([]byte(r.Definition)) == nil {
+				wf = w
+			}
+		}
+
+		first := runs[0]
+		last := runs[len(runs)-1]
+
+		state := &Span{
+			Trace:  root.Trace,
+			ID:     StateSpanID(h.Instance, first.Step),
+			Parent: root.ID,
+			Name:   first.State,
+			Start:  first.Time,
+			End:    stateEnd,
+			Attributes: map[string]interface{}{
+				"direktiv.instance":   h.Instance,
+				"direktiv.state":      first.State,
+				"direktiv.state.type": first.Type,
+				"direktiv.step":       first.Step,
+				"direktiv.runs":       len(runs),
+			},
+		}
+
+		if last.Outcome == replay.OutcomeFailed && last.Error != nil {
+			state.Error = errorMessage(last.Error)
+			if root.Error == h.Status {
+				root.Error = state.Error
+			}
+		}
+
+		spans = append(spans, state)
+
+		actions := stateActions(wf, first.State)
+
+		for k, r := range runs {
+
+			if r.Outcome != replay.OutcomeWait || len(actions) == 0 {
+				continue
+			}
+
+			wait := &Span{
+				Trace:      root.Trace,
+				ID:         NewSpanID(h.Instance, ":", first.Step, ":", k),
+				Parent:     state.ID,
+				Name:       actionsName(actions),
+				Start:      r.Time,
+				End:        stateEnd,
+				Attributes: actionsAttributes(actions),
+			}
+
+			if k+1 < len(runs) {
+				wait.End = runs[k+1].Time
+				if f := runs[k+1].Fault; f != nil {
+					wait.Error = errorMessage(f)
+				}
+			}
+
+			spans = append(spans, wait)
+
+		}
+
+		i = j
+
+	}
+
+	return spans
+
+}
+
+func errorMessage(e *replay.Error) string {
+
+	if e.Code == "" {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+
+}
+
+// stateActions returns the actions a state of wf runs, if any.
+func stateActions(wf *model.Workflow, id string) []model.ActionDefinition {
+
+	if wf == nil {
+		return nil
+	}
+
+	switch s := wf.GetStatesMap()[id].(type) {
+	case *model.ActionState:
+		if s.Action != nil {
+			return []model.ActionDefinition{*s.Action}
+		}
+	case *model.PollState:
+		if s.Action != nil {
+			return []model.ActionDefinition{*s.Action}
+		}
+	case *model.ForEachState:
+		if s.Action != nil {
+			return []model.ActionDefinition{*s.Action}
+		}
+	case *model.MapReduceState:
+		if s.Action != nil {
+			return []model.ActionDefinition{*s.Action}
+		}
+	case *model.ParallelState:
+		return s.Actions
+	}
+
+	return nil
+
+}
+
+func actionName(a model.ActionDefinition) string {
+
+	if a.Workflow != "" {
+		return "subflow " + a.Workflow
+	}
+
+	return "action " + a.Function
+
+}
+
+func actionsName(actions []model.ActionDefinition) string {
+
+	names := make([]string, len(actions))
+	for i, a := range actions {
+		names[i] = actionName(a)
+	}
+
+	return strings.Join(names, ", ")
+
+}
+
+func actionsAttributes(actions []model.ActionDefinition) map[string]interface{} {
+
+	var functions, workflows []string
+	for _, a := range actions {
+		if a.Workflow != "" {
+			workflows = append(workflows, a.Workflow)
+		} else {
+			functions = append(functions, a.Function)
+		}
+	}
+
+	attrs := make(map[string]interface{})
+
+	if len(functions) > 0 {
+		attrs["direktiv.functions"] = strings.Join(functions, ",")
+	}
+
+	if len(workflows) > 0 {
+		attrs["direktiv.subflows"] = strings.Join(workflows, ",")
+	}
+
+	return attrs
+
+}
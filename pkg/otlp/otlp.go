@@ -0,0 +1,249 @@
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	serviceName = "direktiv"
+
+	// span kinds and status codes of the OTLP protocol
+	kindInternal = 1
+	statusOK     = 1
+	statusError  = 2
+)
+
+// TraceID identifies the trace of an instance. Instances invoked as subflows
+// share the trace of the instance that invoked them.
+type TraceID [16]byte
+
+// SpanID identifies a span within a trace.
+type SpanID [8]byte
+
+// NewTraceID derives the trace id of the instance with id, so that the spans
+// of an instance always land in the same trace wherever they are exported.
+func NewTraceID(id string) TraceID {
+
+	var t TraceID
+	h := sha256.Sum256([]byte("trace:" + id))
+	copy(t[:], h[:])
+	return t
+
+}
+
+// NewSpanID derives the id of a span from the parts naming it.
+func NewSpanID(parts ...interface{}) SpanID {
+
+	var s SpanID
+	h := sha256.Sum256([]byte(fmt.Sprint(parts...)))
+	copy(s[:], h[:])
+	return s
+
+}
+
+// Span is a finished span.
+type Span struct {
+	Trace      TraceID
+	ID         SpanID
+	Parent     SpanID
+	Name       string
+	Start      time.Time
+	End        time.Time
+	Attributes map[string]interface{}
+
+	// Error marks the span failed and is the message of its status.
+	Error string
+}
+
+// Exporter ships spans to the traces endpoint of an OTLP/HTTP collector,
+// e.g. http://collector:4318/v1/traces, in the JSON encoding of the protocol.
+type Exporter struct {
+	Endpoint   string
+	Headers    map[string]string
+	HTTPClient *http.Client
+}
+
+// NewExporter creates an exporter for the collector at endpoint.
+func NewExporter(endpoint string, headers map[string]string) *Exporter {
+
+	return &Exporter{
+		Endpoint: endpoint,
+		Headers:  headers,
+		HTTPClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+
+}
+
+// Export sends spans to the collector.
+func (e *Exporter) Export(ctx context.Context, spans []*Span) error {
+
+	if len(spans) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(encode(spans))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.Endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := e.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("collector responded with %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+
+	return nil
+
+}
+
+// the JSON encoding of an ExportTraceServiceRequest
+
+type exportRequest struct {
+	ResourceSpans []resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resource     `json:"resource"`
+	ScopeSpans []scopeSpans `json:"scopeSpans"`
+}
+
+type resource struct {
+	Attributes []keyValue `json:"attributes"`
+}
+
+type scopeSpans struct {
+	Scope scope      `json:"scope"`
+	Spans []jsonSpan `json:"spans"`
+}
+
+type scope struct {
+	Name string `json:"name"`
+}
+
+type jsonSpan struct {
+	TraceID           string     `json:"traceId"`
+	SpanID            string     `json:"spanId"`
+	ParentSpanID      string     `json:"parentSpanId,omitempty"`
+	Name              string     `json:"name"`
+	Kind              int        `json:"kind"`
+	StartTimeUnixNano string     `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string     `json:"endTimeUnixNano"`
+	Attributes        []keyValue `json:"attributes,omitempty"`
+	Status            status     `json:"status"`
+}
+
+type status struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+}
+
+func value(v interface{}) anyValue {
+
+	switch x := v.(type) {
+	case int:
+		s := strconv.Itoa(x)
+		return anyValue{IntValue: &s}
+	case bool:
+		return anyValue{BoolValue: &x}
+	case string:
+		return anyValue{StringValue: &x}
+	}
+
+	s := fmt.Sprint(v)
+	return anyValue{StringValue: &s}
+
+}
+
+func attributes(m map[string]interface{}) []keyValue {
+
+	var kvs []keyValue
+
+	for k, v := range m {
+		kvs = append(kvs, keyValue{Key: k, Value: value(v)})
+	}
+
+	return kvs
+
+}
+
+func encode(spans []*Span) *exportRequest {
+
+	ss := scopeSpans{
+		Scope: scope{Name: serviceName},
+	}
+
+	for _, s := range spans {
+
+		js := jsonSpan{
+			TraceID:           hex.EncodeToString(s.Trace[:]),
+			SpanID:            hex.EncodeToString(s.ID[:]),
+			Name:              s.Name,
+			Kind:              kindInternal,
+			StartTimeUnixNano: strconv.FormatInt(s.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.End.UnixNano(), 10),
+			Attributes:        attributes(s.Attributes),
+			Status:            status{Code: statusOK},
+		}
+
+		if s.Parent != (SpanID{}) {
+			js.ParentSpanID = hex.EncodeToString(s.Parent[:])
+		}
+
+		if s.Error != "" {
+			js.Status = status{Code: statusError, Message: s.Error}
+		}
+
+		ss.Spans = append(ss.Spans, js)
+
+	}
+
+	return &exportRequest{
+		ResourceSpans: []resourceSpans{{
+			Resource: resource{
+				Attributes: attributes(map[string]interface{}{
+					"service.name": serviceName,
+				}),
+			},
+			ScopeSpans: []scopeSpans{ss},
+		}},
+	}
+
+}
@@ -0,0 +1,70 @@
+package replay
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/vorteil/direktiv/pkg/model"
+)
+
+// A golden run is the history of an instance that completed successfully,
+// recorded from its first state. Verifying a definition against it replays
+// the run with that definition in place of the ones the instance ran with:
+// every state must still exist with the same type, take the same transitions
+// and transform the recorded results into the same data. Results of the
+// states themselves, such as the output of actions, are taken as recorded,
+// so changes to what a state does before it transitions aren't caught.
+
+// Golden checks that h is the history of a golden run.
+func Golden(h *History) error {
+
+	if h.Status != "complete" {
+		return fmt.Errorf("instance is %s, golden runs must be complete", h.Status)
+	}
+
+	if len(h.Steps) == 0 {
+		return errors.New("history has no steps")
+	}
+
+	if h.Steps[0].Step != 1 {
+		return fmt.Errorf("history begins at step %d, golden runs must be recorded from the first", h.Steps[0].Step)
+	}
+
+	last := h.Steps[len(h.Steps)-1]
+	if last.Outcome != OutcomeEnd {
+		return fmt.Errorf("history ends with a %s at state '%s', golden runs must end the workflow", last.Outcome, last.State)
+	}
+
+	for _, s := range h.Steps {
+		if s.Outcome == OutcomeFailed {
+			return fmt.Errorf("state '%s' failed at step %d, golden runs must not fail", s.State, s.Step)
+		}
+	}
+
+	return nil
+
+}
+
+// Verify returns a Replayer that replays the golden run h against definition.
+func Verify(h *History, definition []byte) (*Replayer, error) {
+
+	err := Golden(h)
+	if err != nil {
+		return nil, err
+	}
+
+	wf := new(model.Workflow)
+	err = wf.Load(definition)
+	if err != nil {
+		return nil, fmt.Errorf("bad definition: %v", err)
+	}
+
+	r, err := New(h)
+	if err != nil {
+		return nil, err
+	}
+	r.verify = wf
+
+	return r, nil
+
+}
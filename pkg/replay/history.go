@@ -0,0 +1,87 @@
+package replay
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Version of the history document format.
+const Version = 1
+
+// causes of a run of state logic
+const (
+	CauseEnter = "enter" // the instance entered the state
+	CauseWake  = "wake"  // action results, an event or a timer woke the state
+	CauseFault = "fault" // the state was woken with an error, such as a timeout
+)
+
+// outcomes of a run of state logic
+const (
+	OutcomeWait       = "wait"
+	OutcomeTransition = "transition"
+	OutcomeEnd        = "end"
+	OutcomeRetry      = "retry"
+	OutcomeFailed     = "failed"
+)
+
+// History is everything recorded about the runs of an instance's state logic.
+type History struct {
+	Version   int             `json:"version"`
+	Instance  string          `json:"instance"`
+	Namespace string          `json:"namespace"`
+	Workflow  string          `json:"workflow"`
+	Status    string          `json:"status"`
+	Input     json.RawMessage `json:"input"`
+	Steps     []Step          `json:"steps"`
+}
+
+// Step records one run of an instance's state logic: the data it started
+// with, whatever woke it, and what it did.
+type Step struct {
+	Step     int       `json:"step"`
+	State    string    `json:"state"`
+	Type     string    `json:"type"`
+	Time     time.Time `json:"time"`
+	Revision int       `json:"revision"`
+
+	// Definition is the workflow definition, recorded with the first step
+	// that ran at its revision.
+	Definition string `json:"definition,omitempty"`
+
+	Cause  string          `json:"cause"`
+	Input  json.RawMessage `json:"input"`
+	Memory json.RawMessage `json:"memory,omitempty"`
+	Wake   json.RawMessage `json:"wake,omitempty"`
+	Fault  *Error          `json:"fault,omitempty"`
+
+	Outcome   string          `json:"outcome"`
+	Result    json.RawMessage `json:"result,omitempty"`
+	Transform interface{}     `json:"transform,omitempty"`
+	Next      string          `json:"next,omitempty"`
+	Error     *Error          `json:"error,omitempty"`
+	Output    json.RawMessage `json:"output,omitempty"`
+}
+
+// Error is an error raised by or delivered to state logic.
+type Error struct {
+	Code        string `json:"code,omitempty"`
+	Message     string `json:"message"`
+	Uncatchable bool   `json:"uncatchable,omitempty"`
+}
+
+// Data returns b as JSON, or as a JSON string holding b if it isn't.
+func Data(b []byte) json.RawMessage {
+
+	if len(b) == 0 {
+		return nil
+	}
+
+	if json.Valid(b) {
+		return json.RawMessage(b)
+	}
+
+	data, _ := json.Marshal(b)
+
+	return data
+
+}
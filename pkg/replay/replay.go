@@ -0,0 +1,557 @@
+package replay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"time"
+
+	"github.com/vorteil/direktiv/pkg/jqer"
+	"github.com/vorteil/direktiv/pkg/model"
+)
+
+// A Replayer steps through a recorded history without running anything. The
+// side effects of state logic, such as calling functions, are replaced by the
+// results recorded for them, and everything the workflow definition decides
+// by itself is worked out again and checked against what the instance did:
+// the data each state was entered with, switch conditions, error catchers and
+// transforms. A check that doesn't pass shows where the instance diverged
+// from its definition, whether because the definition changed or because of a
+// bug in the engine.
+type Replayer struct {
+	history     *History
+	definitions map[int]*model.Workflow
+	next        int
+
+	// verify replays every step against this definition instead
+	verify *model.Workflow
+
+	// the last step that transitioned, and the data it transitioned with
+	last *Step
+}
+
+// Frame is a replayed step with the outcome of its checks.
+type Frame struct {
+	Index  int
+	Step   *Step
+	Checks []Check
+}
+
+// Check is the outcome of checking part of a step against the definition.
+type Check struct {
+	Name   string
+	Passed bool
+	Detail string
+}
+
+// Diverged reports whether any check of the frame failed.
+func (f *Frame) Diverged() bool {
+
+	for _, c := range f.Checks {
+		if !c.Passed {
+			return true
+		}
+	}
+
+	return false
+
+}
+
+func (f *Frame) check(name string, passed bool, detail string, args ...interface{}) {
+	f.Checks = append(f.Checks, Check{
+		Name:   name,
+		Passed: passed,
+		Detail: fmt.Sprintf(detail, args...),
+	})
+}
+
+// New returns a Replayer positioned before the first step of h.
+func New(h *History) (*Replayer, error) {
+
+	if h.Version != Version {
+		return nil, fmt.Errorf("unsupported history version %d", h.Version)
+	}
+
+	if len(h.Steps) == 0 {
+		return nil, errors.New("history has no steps")
+	}
+
+	// queries must be evaluated the way the engine evaluates them
+	jqer.StringQueryRequiresWrappings = true
+	jqer.TrimWhitespaceOnQueryStrings = true
+	jqer.SearchInStrings = true
+	jqer.WrappingBegin = "jq"
+	jqer.WrappingIncrement = "("
+	jqer.WrappingDecrement = ")"
+	jqer.JSONataWrappingBegin = "jsonata"
+
+	r := &Replayer{
+		history:     h,
+		definitions: make(map[int]*model.Workflow),
+	}
+
+	for _, s := range h.Steps {
+		if s.Definition == "" {
+			continue
+		}
+		wf := new(model.Workflow)
+		err := wf.Load([]byte(s.Definition))
+		if err != nil {
+			return nil, fmt.Errorf("bad definition for revision %d: %v", s.Revision, err)
+		}
+		r.definitions[s.Revision] = wf
+	}
+
+	return r, nil
+
+}
+
+// More reports whether there are steps left to replay.
+func (r *Replayer) More() bool {
+	return r.next < len(r.history.Steps)
+}
+
+// Next replays the next step.
+func (r *Replayer) Next() (*Frame, error) {
+
+	if !r.More() {
+		return nil, errors.New("no more steps")
+	}
+
+	f := &Frame{
+		Index: r.next,
+		Step:  &r.history.Steps[r.next],
+	}
+	r.next++
+
+	s := f.Step
+
+	wf, ok := r.definitions[s.Revision]
+	if r.verify != nil {
+		wf, ok = r.verify, true
+	}
+	if !ok {
+		f.check("definition", false, "no definition recorded for revision %d", s.Revision)
+		return f, nil
+	}
+
+	state, ok := wf.GetStatesMap()[s.State]
+	if !ok {
+		f.check("state", false, "state '%s' isn't defined", s.State)
+		return f, nil
+	}
+
+	if t := state.GetType().String(); t != s.Type {
+		f.check("state", false, "state '%s' is a %s state, recorded as %s", s.State, t, s.Type)
+		return f, nil
+	}
+
+	if s.Cause == CauseEnter {
+		r.checkInput(f)
+	}
+
+	switch s.Outcome {
+	case OutcomeTransition, OutcomeEnd:
+		r.checkTransition(f, wf, state)
+		r.last = s
+	}
+
+	return f, nil
+
+}
+
+// attempt counts the tries of the frame's state since the instance last
+// entered it from another state.
+func (r *Replayer) attempt(f *Frame) int {
+
+	attempt := 1
+
+	for i := f.Index - 1; i >= 0; i-- {
+		s := &r.history.Steps[i]
+		if s.State != f.Step.State || s.Outcome == OutcomeTransition || s.Outcome == OutcomeEnd {
+			break
+		}
+		if s.Outcome == OutcomeRetry {
+			attempt++
+		}
+	}
+
+	return attempt
+
+}
+
+var eventStateTypes = map[string]bool{
+	model.StateTypeConsumeEvent.String(): true,
+	model.StateTypeEventsAnd.String():    true,
+	model.StateTypeEventsXor.String():    true,
+	model.StateTypeEventBatch.String():   true,
+}
+
+// events returns $event and $events as the frame's step saw them, from the
+// last events an event state was woken with. The events that started the
+// instance aren't recorded, so they're null until then.
+func (r *Replayer) events(f *Frame) (interface{}, interface{}) {
+
+	for i := f.Index; i >= 0; i-- {
+
+		s := &r.history.Steps[i]
+		if s.Cause != CauseWake || !eventStateTypes[s.Type] {
+			continue
+		}
+
+		var received []struct {
+			ID      string    `json:"id"`
+			Type    string    `json:"type"`
+			Source  string    `json:"source"`
+			Subject string    `json:"subject"`
+			Time    time.Time `json:"time"`
+		}
+
+		// batch windows elapsing wake event batch states too
+		if err := json.Unmarshal(s.Wake, &received); err != nil || len(received) == 0 {
+			continue
+		}
+
+		var event map[string]interface{}
+		events := make(map[string]interface{})
+
+		for _, e := range received {
+			event = map[string]interface{}{
+				"id":     e.ID,
+				"type":   e.Type,
+				"source": e.Source,
+			}
+			if e.Subject != "" {
+				event["subject"] = e.Subject
+			}
+			if !e.Time.IsZero() {
+				event["time"] = e.Time.UTC().Format(time.RFC3339Nano)
+			}
+			events[e.Type] = event
+		}
+
+		return event, events
+
+	}
+
+	return nil, nil
+
+}
+
+// Run replays every remaining step.
+func (r *Replayer) Run() ([]*Frame, error) {
+
+	var frames []*Frame
+
+	for r.More() {
+		f, err := r.Next()
+		if err != nil {
+			return frames, err
+		}
+		frames = append(frames, f)
+	}
+
+	return frames, nil
+
+}
+
+func (r *Replayer) checkInput(f *Frame) {
+
+	s := f.Step
+
+	var expected interface{}
+
+	switch {
+	case r.last == nil && f.Index == 0:
+		var input interface{}
+		if err := json.Unmarshal(r.history.Input, &input); err != nil {
+			input = string(r.history.Input)
+		}
+		expected = input
+		if _, ok := input.(map[string]interface{}); !ok {
+			expected = map[string]interface{}{
+				"input": input,
+			}
+		}
+	case r.last != nil && r.last.Next == s.State:
+		if err := json.Unmarshal(r.last.Output, &expected); err != nil {
+			f.check("input", false, "bad output recorded for step %d: %v", r.last.Step, err)
+			return
+		}
+	default:
+		// entered through an api call at a later entry state, or skipped ahead
+		return
+	}
+
+	equal, err := equalJSON(expected, s.Input)
+	if err != nil {
+		f.check("input", false, "bad input recorded: %v", err)
+	} else if !equal {
+		f.check("input", false, "entered with different data than the previous state left")
+	} else {
+		f.check("input", true, "entered with the data the previous state left")
+	}
+
+}
+
+func (r *Replayer) checkTransition(f *Frame, wf *model.Workflow, state model.State) {
+
+	s := f.Step
+
+	// namespace overrides of constants aren't recorded, and $now is when the
+	// step began rather than when the transition was evaluated
+	event, events := r.events(f)
+	vars := jqer.Variables{
+		"const": wf.Constants,
+		"instance": map[string]interface{}{
+			"id": r.history.Instance,
+		},
+		"namespace": r.history.Namespace,
+		"workflow":  r.history.Workflow,
+		"step":      s.Step,
+		"attempt":   r.attempt(f),
+		"now":       s.Time.UTC().Format(time.RFC3339),
+		"event":     event,
+		"events":    events,
+	}
+
+	var result interface{}
+	if len(s.Result) > 0 {
+		if err := json.Unmarshal(s.Result, &result); err != nil {
+			f.check("transition", false, "bad result recorded: %v", err)
+			return
+		}
+	}
+
+	transform := s.Transform
+
+	switch {
+
+	case s.Error != nil:
+
+		if s.Error.Uncatchable {
+			f.check("transition", false, "uncatchable error '%s' was caught", s.Error.Message)
+			return
+		}
+
+		catch := catcher(state, s.Error.Code)
+		if catch == nil {
+			f.check("transition", false, "no catcher matches error '%s'", s.Error.Code)
+			return
+		}
+
+		if s.Next != catch.Transition && s.Next != catch.Exhausted {
+			f.check("transition", false, "error '%s' is caught by '%s' and transitions to '%s', not '%s'",
+				s.Error.Code, catch.Error, catch.Transition, s.Next)
+			return
+		}
+
+		f.check("transition", true, "error '%s' caught by '%s'", s.Error.Code, catch.Error)
+
+		// caught errors never transform
+		return
+
+	case state.GetType() == model.StateTypeSwitch && result != nil:
+
+		next, t, detail, err := evaluateSwitch(state.(*model.SwitchState), result, vars)
+		if err != nil {
+			f.check("transition", false, "cannot evaluate switch: %v", err)
+			return
+		}
+
+		if next != s.Next {
+			f.check("transition", false, "%s, which transitions to '%s', not '%s'", detail, next, s.Next)
+			return
+		}
+
+		f.check("transition", true, "%s", detail)
+		transform = t
+
+	default:
+
+		allowed := s.Next == ""
+		for _, t := range state.GetTransitions() {
+			if t == s.Next {
+				allowed = true
+			}
+		}
+
+		if !allowed {
+			f.check("transition", false, "state '%s' can't transition to '%s'", s.State, s.Next)
+			return
+		}
+
+		if s.Next == "" {
+			f.check("transition", true, "workflow ended")
+		} else {
+			f.check("transition", true, "transitioned to '%s'", s.Next)
+		}
+
+		if t, ok := definedTransform(state, s.Next); ok {
+			transform = t
+		}
+
+	}
+
+	if result == nil || len(s.Output) == 0 {
+		return
+	}
+
+	output := result
+	if !trivialTransform(transform) {
+		out, err := jqer.EvaluateWithVariables(result, transform, vars)
+		if err != nil {
+			f.check("transform", false, "transform fails: %v", err)
+			return
+		}
+		if len(out) != 1 {
+			f.check("transform", false, "transform produced %d outputs", len(out))
+			return
+		}
+		output = out[0]
+	}
+
+	equal, err := equalJSON(output, s.Output)
+	if err != nil {
+		f.check("transform", false, "bad output recorded: %v", err)
+	} else if !equal {
+		f.check("transform", false, "transform gives different data than was recorded")
+	} else if !trivialTransform(transform) {
+		f.check("transform", true, "transform gives the recorded data")
+	}
+
+}
+
+// definedTransform returns the transform the definition gives a state that
+// transitions to next, and false if it can't tell.
+func definedTransform(state model.State, next string) (interface{}, bool) {
+
+	if xor, ok := state.(*model.EventsXorState); ok {
+		for _, e := range xor.Events {
+			if e.Transition == next {
+				return e.Transform, true
+			}
+		}
+		return nil, false
+	}
+
+	v := reflect.ValueOf(state)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	f := v.FieldByName("Transform")
+	if !f.IsValid() {
+		return nil, false
+	}
+
+	return f.Interface(), true
+
+}
+
+func catcher(state model.State, code string) *model.ErrorDefinition {
+
+	for _, catch := range state.ErrorDefinitions() {
+
+		catch := catch
+
+		errRegex := catch.Error
+		if errRegex == "*" {
+			errRegex = ".*"
+		}
+
+		if matched, _ := regexp.MatchString(errRegex, code); matched {
+			return &catch
+		}
+
+	}
+
+	return nil
+
+}
+
+func evaluateSwitch(state *model.SwitchState, data interface{}, vars jqer.Variables) (string, interface{}, string, error) {
+
+	for i, condition := range state.Conditions {
+
+		out, err := jqer.EvaluateWithVariables(data, condition.Condition, vars)
+		if err != nil {
+			return "", nil, "", fmt.Errorf("condition %d: %v", i, err)
+		}
+
+		if len(out) != 1 {
+			return "", nil, "", fmt.Errorf("condition %d produced %d outputs", i, len(out))
+		}
+
+		if truth(out[0]) {
+			return condition.Transition, condition.Transform, fmt.Sprintf("switch condition %d succeeded", i), nil
+		}
+
+	}
+
+	return state.DefaultTransition, state.DefaultTransform, "no switch conditions succeeded", nil
+
+}
+
+func truth(x interface{}) bool {
+
+	switch v := x.(type) {
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case int:
+		return v != 0
+	case float64:
+		return v != 0.0
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+
+	return false
+
+}
+
+func trivialTransform(transform interface{}) bool {
+
+	if transform == nil {
+		return true
+	}
+
+	s, ok := transform.(string)
+
+	return ok && (s == "" || s == ".")
+
+}
+
+func equalJSON(x interface{}, data json.RawMessage) (bool, error) {
+
+	var y interface{}
+	err := json.Unmarshal(data, &y)
+	if err != nil {
+		return false, err
+	}
+
+	// normalize x the same way
+	b, err := json.Marshal(x)
+	if err != nil {
+		return false, err
+	}
+
+	var z interface{}
+	err = json.Unmarshal(b, &z)
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(y, z), nil
+
+}
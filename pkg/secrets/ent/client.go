@@ -9,6 +9,7 @@ import (
 
 	"github.com/vorteil/direktiv/pkg/secrets/ent/migrate"
 
+	"github.com/vorteil/direktiv/pkg/secrets/ent/namespacekey"
 	"github.com/vorteil/direktiv/pkg/secrets/ent/namespacesecret"
 
 	"entgo.io/ent/dialect"
@@ -20,6 +21,8 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// NamespaceKey is the client for interacting with the NamespaceKey builders.
+	NamespaceKey *NamespaceKeyClient
 	// NamespaceSecret is the client for interacting with the NamespaceSecret builders.
 	NamespaceSecret *NamespaceSecretClient
 }
@@ -35,6 +38,7 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.NamespaceKey = NewNamespaceKeyClient(c.config)
 	c.NamespaceSecret = NewNamespaceSecretClient(c.config)
 }
 
@@ -69,6 +73,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:             ctx,
 		config:          cfg,
+		NamespaceKey:    NewNamespaceKeyClient(cfg),
 		NamespaceSecret: NewNamespaceSecretClient(cfg),
 	}, nil
 }
@@ -88,6 +93,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
 		config:          cfg,
+		NamespaceKey:    NewNamespaceKeyClient(cfg),
 		NamespaceSecret: NewNamespaceSecretClient(cfg),
 	}, nil
 }
@@ -95,7 +101,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		NamespaceSecret.
+//		NamespaceKey.
 //		Query().
 //		Count(ctx)
 //
@@ -118,9 +124,100 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.NamespaceKey.Use(hooks...)
 	c.NamespaceSecret.Use(hooks...)
 }
 
+// NamespaceKeyClient is a client for the NamespaceKey schema.
+type NamespaceKeyClient struct {
+	config
+}
+
+// NewNamespaceKeyClient returns a client for the NamespaceKey from the given config.
+func NewNamespaceKeyClient(c config) *NamespaceKeyClient {
+	return &NamespaceKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `namespacekey.Hooks(f(g(h())))`.
+func (c *NamespaceKeyClient) Use(hooks ...Hook) {
+	c.hooks.NamespaceKey = append(c.hooks.NamespaceKey, hooks...)
+}
+
+// Create returns a create builder for NamespaceKey.
+func (c *NamespaceKeyClient) Create() *NamespaceKeyCreate {
+	mutation := newNamespaceKeyMutation(c.config, OpCreate)
+	return &NamespaceKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NamespaceKey entities.
+func (c *NamespaceKeyClient) CreateBulk(builders ...*NamespaceKeyCreate) *NamespaceKeyCreateBulk {
+	return &NamespaceKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NamespaceKey.
+func (c *NamespaceKeyClient) Update() *NamespaceKeyUpdate {
+	mutation := newNamespaceKeyMutation(c.config, OpUpdate)
+	return &NamespaceKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NamespaceKeyClient) UpdateOne(nk *NamespaceKey) *NamespaceKeyUpdateOne {
+	mutation := newNamespaceKeyMutation(c.config, OpUpdateOne, withNamespaceKey(nk))
+	return &NamespaceKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NamespaceKeyClient) UpdateOneID(id int) *NamespaceKeyUpdateOne {
+	mutation := newNamespaceKeyMutation(c.config, OpUpdateOne, withNamespaceKeyID(id))
+	return &NamespaceKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NamespaceKey.
+func (c *NamespaceKeyClient) Delete() *NamespaceKeyDelete {
+	mutation := newNamespaceKeyMutation(c.config, OpDelete)
+	return &NamespaceKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *NamespaceKeyClient) DeleteOne(nk *NamespaceKey) *NamespaceKeyDeleteOne {
+	return c.DeleteOneID(nk.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *NamespaceKeyClient) DeleteOneID(id int) *NamespaceKeyDeleteOne {
+	builder := c.Delete().Where(namespacekey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NamespaceKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for NamespaceKey.
+func (c *NamespaceKeyClient) Query() *NamespaceKeyQuery {
+	return &NamespaceKeyQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a NamespaceKey entity by its id.
+func (c *NamespaceKeyClient) Get(ctx context.Context, id int) (*NamespaceKey, error) {
+	return c.Query().Where(namespacekey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NamespaceKeyClient) GetX(ctx context.Context, id int) *NamespaceKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NamespaceKeyClient) Hooks() []Hook {
+	return c.hooks.NamespaceKey
+}
+
 // NamespaceSecretClient is a client for the NamespaceSecret schema.
 type NamespaceSecretClient struct {
 	config
@@ -24,6 +24,7 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
+	NamespaceKey    []ent.Hook
 	NamespaceSecret []ent.Hook
 }
 
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/vorteil/direktiv/pkg/secrets/ent/namespacekey"
 	"github.com/vorteil/direktiv/pkg/secrets/ent/namespacesecret"
 )
 
@@ -31,6 +32,7 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
+		namespacekey.Table:    namespacekey.ValidColumn,
 		namespacesecret.Table: namespacesecret.ValidColumn,
 	}
 	check, ok := checks[table]
@@ -9,6 +9,19 @@ import (
 	"github.com/vorteil/direktiv/pkg/secrets/ent"
 )
 
+// The NamespaceKeyFunc type is an adapter to allow the use of ordinary
+// function as NamespaceKey mutator.
+type NamespaceKeyFunc func(context.Context, *ent.NamespaceKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NamespaceKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.NamespaceKeyMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NamespaceKeyMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The NamespaceSecretFunc type is an adapter to allow the use of ordinary
 // function as NamespaceSecret mutator.
 type NamespaceSecretFunc func(context.Context, *ent.NamespaceSecretMutation) (ent.Value, error)
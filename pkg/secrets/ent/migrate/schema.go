@@ -8,6 +8,21 @@ import (
 )
 
 var (
+	// NamespaceKeysColumns holds the columns for the "namespace_keys" table.
+	NamespaceKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "ns", Type: field.TypeString, Unique: true},
+		{Name: "key_ref", Type: field.TypeString},
+		{Name: "data_key", Type: field.TypeBytes, Size: 4096},
+		{Name: "rotated", Type: field.TypeTime},
+	}
+	// NamespaceKeysTable holds the schema information for the "namespace_keys" table.
+	NamespaceKeysTable = &schema.Table{
+		Name:        "namespace_keys",
+		Columns:     NamespaceKeysColumns,
+		PrimaryKey:  []*schema.Column{NamespaceKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+	}
 	// NamespaceSecretsColumns holds the columns for the "namespace_secrets" table.
 	NamespaceSecretsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -21,9 +36,17 @@ var (
 		Columns:     NamespaceSecretsColumns,
 		PrimaryKey:  []*schema.Column{NamespaceSecretsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{},
+		Indexes: []*schema.Index{
+			{
+				Name:    "namespacesecret_name",
+				Unique:  true,
+				Columns: []*schema.Column{NamespaceSecretsColumns[2]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		NamespaceKeysTable,
 		NamespaceSecretsTable,
 	}
 )
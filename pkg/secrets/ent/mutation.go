@@ -6,7 +6,9 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/vorteil/direktiv/pkg/secrets/ent/namespacekey"
 	"github.com/vorteil/direktiv/pkg/secrets/ent/namespacesecret"
 	"github.com/vorteil/direktiv/pkg/secrets/ent/predicate"
 
@@ -22,9 +24,459 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeNamespaceKey    = "NamespaceKey"
 	TypeNamespaceSecret = "NamespaceSecret"
 )
 
+// NamespaceKeyMutation represents an operation that mutates the NamespaceKey nodes in the graph.
+type NamespaceKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	ns            *string
+	keyRef        *string
+	dataKey       *[]byte
+	rotated       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*NamespaceKey, error)
+	predicates    []predicate.NamespaceKey
+}
+
+var _ ent.Mutation = (*NamespaceKeyMutation)(nil)
+
+// namespacekeyOption allows management of the mutation configuration using functional options.
+type namespacekeyOption func(*NamespaceKeyMutation)
+
+// newNamespaceKeyMutation creates new mutation for the NamespaceKey entity.
+func newNamespaceKeyMutation(c config, op Op, opts ...namespacekeyOption) *NamespaceKeyMutation {
+	m := &NamespaceKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNamespaceKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNamespaceKeyID sets the ID field of the mutation.
+func withNamespaceKeyID(id int) namespacekeyOption {
+	return func(m *NamespaceKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NamespaceKey
+		)
+		m.oldValue = func(ctx context.Context) (*NamespaceKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NamespaceKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNamespaceKey sets the old NamespaceKey of the mutation.
+func withNamespaceKey(node *NamespaceKey) namespacekeyOption {
+	return func(m *NamespaceKeyMutation) {
+		m.oldValue = func(context.Context) (*NamespaceKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NamespaceKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NamespaceKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *NamespaceKeyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetNs sets the "ns" field.
+func (m *NamespaceKeyMutation) SetNs(s string) {
+	m.ns = &s
+}
+
+// Ns returns the value of the "ns" field in the mutation.
+func (m *NamespaceKeyMutation) Ns() (r string, exists bool) {
+	v := m.ns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNs returns the old "ns" field's value of the NamespaceKey entity.
+// If the NamespaceKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceKeyMutation) OldNs(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldNs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldNs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNs: %w", err)
+	}
+	return oldValue.Ns, nil
+}
+
+// ResetNs resets all changes to the "ns" field.
+func (m *NamespaceKeyMutation) ResetNs() {
+	m.ns = nil
+}
+
+// SetKeyRef sets the "keyRef" field.
+func (m *NamespaceKeyMutation) SetKeyRef(s string) {
+	m.keyRef = &s
+}
+
+// KeyRef returns the value of the "keyRef" field in the mutation.
+func (m *NamespaceKeyMutation) KeyRef() (r string, exists bool) {
+	v := m.keyRef
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyRef returns the old "keyRef" field's value of the NamespaceKey entity.
+// If the NamespaceKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceKeyMutation) OldKeyRef(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldKeyRef is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldKeyRef requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyRef: %w", err)
+	}
+	return oldValue.KeyRef, nil
+}
+
+// ResetKeyRef resets all changes to the "keyRef" field.
+func (m *NamespaceKeyMutation) ResetKeyRef() {
+	m.keyRef = nil
+}
+
+// SetDataKey sets the "dataKey" field.
+func (m *NamespaceKeyMutation) SetDataKey(b []byte) {
+	m.dataKey = &b
+}
+
+// DataKey returns the value of the "dataKey" field in the mutation.
+func (m *NamespaceKeyMutation) DataKey() (r []byte, exists bool) {
+	v := m.dataKey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDataKey returns the old "dataKey" field's value of the NamespaceKey entity.
+// If the NamespaceKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceKeyMutation) OldDataKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDataKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDataKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDataKey: %w", err)
+	}
+	return oldValue.DataKey, nil
+}
+
+// ResetDataKey resets all changes to the "dataKey" field.
+func (m *NamespaceKeyMutation) ResetDataKey() {
+	m.dataKey = nil
+}
+
+// SetRotated sets the "rotated" field.
+func (m *NamespaceKeyMutation) SetRotated(t time.Time) {
+	m.rotated = &t
+}
+
+// Rotated returns the value of the "rotated" field in the mutation.
+func (m *NamespaceKeyMutation) Rotated() (r time.Time, exists bool) {
+	v := m.rotated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRotated returns the old "rotated" field's value of the NamespaceKey entity.
+// If the NamespaceKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceKeyMutation) OldRotated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRotated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRotated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRotated: %w", err)
+	}
+	return oldValue.Rotated, nil
+}
+
+// ResetRotated resets all changes to the "rotated" field.
+func (m *NamespaceKeyMutation) ResetRotated() {
+	m.rotated = nil
+}
+
+// Op returns the operation name.
+func (m *NamespaceKeyMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (NamespaceKey).
+func (m *NamespaceKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NamespaceKeyMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.ns != nil {
+		fields = append(fields, namespacekey.FieldNs)
+	}
+	if m.keyRef != nil {
+		fields = append(fields, namespacekey.FieldKeyRef)
+	}
+	if m.dataKey != nil {
+		fields = append(fields, namespacekey.FieldDataKey)
+	}
+	if m.rotated != nil {
+		fields = append(fields, namespacekey.FieldRotated)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NamespaceKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case namespacekey.FieldNs:
+		return m.Ns()
+	case namespacekey.FieldKeyRef:
+		return m.KeyRef()
+	case namespacekey.FieldDataKey:
+		return m.DataKey()
+	case namespacekey.FieldRotated:
+		return m.Rotated()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NamespaceKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case namespacekey.FieldNs:
+		return m.OldNs(ctx)
+	case namespacekey.FieldKeyRef:
+		return m.OldKeyRef(ctx)
+	case namespacekey.FieldDataKey:
+		return m.OldDataKey(ctx)
+	case namespacekey.FieldRotated:
+		return m.OldRotated(ctx)
+	}
+	return nil, fmt.Errorf("unknown NamespaceKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NamespaceKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case namespacekey.FieldNs:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNs(v)
+		return nil
+	case namespacekey.FieldKeyRef:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyRef(v)
+		return nil
+	case namespacekey.FieldDataKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDataKey(v)
+		return nil
+	case namespacekey.FieldRotated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRotated(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NamespaceKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NamespaceKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NamespaceKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NamespaceKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NamespaceKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NamespaceKeyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NamespaceKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NamespaceKeyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NamespaceKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NamespaceKeyMutation) ResetField(name string) error {
+	switch name {
+	case namespacekey.FieldNs:
+		m.ResetNs()
+		return nil
+	case namespacekey.FieldKeyRef:
+		m.ResetKeyRef()
+		return nil
+	case namespacekey.FieldDataKey:
+		m.ResetDataKey()
+		return nil
+	case namespacekey.FieldRotated:
+		m.ResetRotated()
+		return nil
+	}
+	return fmt.Errorf("unknown NamespaceKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NamespaceKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NamespaceKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NamespaceKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NamespaceKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NamespaceKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NamespaceKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NamespaceKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NamespaceKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NamespaceKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NamespaceKey edge %s", name)
+}
+
 // NamespaceSecretMutation represents an operation that mutates the NamespaceSecret nodes in the graph.
 type NamespaceSecretMutation struct {
 	config
@@ -0,0 +1,39 @@
+// Code generated by entc, DO NOT EDIT.
+
+package namespacekey
+
+const (
+	// Label holds the string label denoting the namespacekey type in the database.
+	Label = "namespace_key"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNs holds the string denoting the ns field in the database.
+	FieldNs = "ns"
+	// FieldKeyRef holds the string denoting the keyref field in the database.
+	FieldKeyRef = "key_ref"
+	// FieldDataKey holds the string denoting the datakey field in the database.
+	FieldDataKey = "data_key"
+	// FieldRotated holds the string denoting the rotated field in the database.
+	FieldRotated = "rotated"
+	// Table holds the table name of the namespacekey in the database.
+	Table = "namespace_keys"
+)
+
+// Columns holds all SQL columns for namespacekey fields.
+var Columns = []string{
+	FieldID,
+	FieldNs,
+	FieldKeyRef,
+	FieldDataKey,
+	FieldRotated,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
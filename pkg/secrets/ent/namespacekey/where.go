@@ -0,0 +1,527 @@
+// Code generated by entc, DO NOT EDIT.
+
+package namespacekey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/vorteil/direktiv/pkg/secrets/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Ns applies equality check predicate on the "ns" field. It's identical to NsEQ.
+func Ns(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNs), v))
+	})
+}
+
+// KeyRef applies equality check predicate on the "keyRef" field. It's identical to KeyRefEQ.
+func KeyRef(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKeyRef), v))
+	})
+}
+
+// DataKey applies equality check predicate on the "dataKey" field. It's identical to DataKeyEQ.
+func DataKey(v []byte) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDataKey), v))
+	})
+}
+
+// Rotated applies equality check predicate on the "rotated" field. It's identical to RotatedEQ.
+func Rotated(v time.Time) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRotated), v))
+	})
+}
+
+// NsEQ applies the EQ predicate on the "ns" field.
+func NsEQ(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNs), v))
+	})
+}
+
+// NsNEQ applies the NEQ predicate on the "ns" field.
+func NsNEQ(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNs), v))
+	})
+}
+
+// NsIn applies the In predicate on the "ns" field.
+func NsIn(vs ...string) predicate.NamespaceKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNs), v...))
+	})
+}
+
+// NsNotIn applies the NotIn predicate on the "ns" field.
+func NsNotIn(vs ...string) predicate.NamespaceKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNs), v...))
+	})
+}
+
+// NsGT applies the GT predicate on the "ns" field.
+func NsGT(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNs), v))
+	})
+}
+
+// NsGTE applies the GTE predicate on the "ns" field.
+func NsGTE(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNs), v))
+	})
+}
+
+// NsLT applies the LT predicate on the "ns" field.
+func NsLT(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNs), v))
+	})
+}
+
+// NsLTE applies the LTE predicate on the "ns" field.
+func NsLTE(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNs), v))
+	})
+}
+
+// NsContains applies the Contains predicate on the "ns" field.
+func NsContains(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNs), v))
+	})
+}
+
+// NsHasPrefix applies the HasPrefix predicate on the "ns" field.
+func NsHasPrefix(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNs), v))
+	})
+}
+
+// NsHasSuffix applies the HasSuffix predicate on the "ns" field.
+func NsHasSuffix(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNs), v))
+	})
+}
+
+// NsEqualFold applies the EqualFold predicate on the "ns" field.
+func NsEqualFold(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNs), v))
+	})
+}
+
+// NsContainsFold applies the ContainsFold predicate on the "ns" field.
+func NsContainsFold(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNs), v))
+	})
+}
+
+// KeyRefEQ applies the EQ predicate on the "keyRef" field.
+func KeyRefEQ(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKeyRef), v))
+	})
+}
+
+// KeyRefNEQ applies the NEQ predicate on the "keyRef" field.
+func KeyRefNEQ(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldKeyRef), v))
+	})
+}
+
+// KeyRefIn applies the In predicate on the "keyRef" field.
+func KeyRefIn(vs ...string) predicate.NamespaceKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldKeyRef), v...))
+	})
+}
+
+// KeyRefNotIn applies the NotIn predicate on the "keyRef" field.
+func KeyRefNotIn(vs ...string) predicate.NamespaceKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldKeyRef), v...))
+	})
+}
+
+// KeyRefGT applies the GT predicate on the "keyRef" field.
+func KeyRefGT(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldKeyRef), v))
+	})
+}
+
+// KeyRefGTE applies the GTE predicate on the "keyRef" field.
+func KeyRefGTE(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldKeyRef), v))
+	})
+}
+
+// KeyRefLT applies the LT predicate on the "keyRef" field.
+func KeyRefLT(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldKeyRef), v))
+	})
+}
+
+// KeyRefLTE applies the LTE predicate on the "keyRef" field.
+func KeyRefLTE(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldKeyRef), v))
+	})
+}
+
+// KeyRefContains applies the Contains predicate on the "keyRef" field.
+func KeyRefContains(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldKeyRef), v))
+	})
+}
+
+// KeyRefHasPrefix applies the HasPrefix predicate on the "keyRef" field.
+func KeyRefHasPrefix(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldKeyRef), v))
+	})
+}
+
+// KeyRefHasSuffix applies the HasSuffix predicate on the "keyRef" field.
+func KeyRefHasSuffix(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldKeyRef), v))
+	})
+}
+
+// KeyRefEqualFold applies the EqualFold predicate on the "keyRef" field.
+func KeyRefEqualFold(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldKeyRef), v))
+	})
+}
+
+// KeyRefContainsFold applies the ContainsFold predicate on the "keyRef" field.
+func KeyRefContainsFold(v string) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldKeyRef), v))
+	})
+}
+
+// DataKeyEQ applies the EQ predicate on the "dataKey" field.
+func DataKeyEQ(v []byte) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDataKey), v))
+	})
+}
+
+// DataKeyNEQ applies the NEQ predicate on the "dataKey" field.
+func DataKeyNEQ(v []byte) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDataKey), v))
+	})
+}
+
+// DataKeyIn applies the In predicate on the "dataKey" field.
+func DataKeyIn(vs ...[]byte) predicate.NamespaceKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDataKey), v...))
+	})
+}
+
+// DataKeyNotIn applies the NotIn predicate on the "dataKey" field.
+func DataKeyNotIn(vs ...[]byte) predicate.NamespaceKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDataKey), v...))
+	})
+}
+
+// DataKeyGT applies the GT predicate on the "dataKey" field.
+func DataKeyGT(v []byte) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDataKey), v))
+	})
+}
+
+// DataKeyGTE applies the GTE predicate on the "dataKey" field.
+func DataKeyGTE(v []byte) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDataKey), v))
+	})
+}
+
+// DataKeyLT applies the LT predicate on the "dataKey" field.
+func DataKeyLT(v []byte) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDataKey), v))
+	})
+}
+
+// DataKeyLTE applies the LTE predicate on the "dataKey" field.
+func DataKeyLTE(v []byte) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDataKey), v))
+	})
+}
+
+// RotatedEQ applies the EQ predicate on the "rotated" field.
+func RotatedEQ(v time.Time) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRotated), v))
+	})
+}
+
+// RotatedNEQ applies the NEQ predicate on the "rotated" field.
+func RotatedNEQ(v time.Time) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRotated), v))
+	})
+}
+
+// RotatedIn applies the In predicate on the "rotated" field.
+func RotatedIn(vs ...time.Time) predicate.NamespaceKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRotated), v...))
+	})
+}
+
+// RotatedNotIn applies the NotIn predicate on the "rotated" field.
+func RotatedNotIn(vs ...time.Time) predicate.NamespaceKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRotated), v...))
+	})
+}
+
+// RotatedGT applies the GT predicate on the "rotated" field.
+func RotatedGT(v time.Time) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRotated), v))
+	})
+}
+
+// RotatedGTE applies the GTE predicate on the "rotated" field.
+func RotatedGTE(v time.Time) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRotated), v))
+	})
+}
+
+// RotatedLT applies the LT predicate on the "rotated" field.
+func RotatedLT(v time.Time) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRotated), v))
+	})
+}
+
+// RotatedLTE applies the LTE predicate on the "rotated" field.
+func RotatedLTE(v time.Time) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRotated), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NamespaceKey) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NamespaceKey) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NamespaceKey) predicate.NamespaceKey {
+	return predicate.NamespaceKey(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
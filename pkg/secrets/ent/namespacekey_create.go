@@ -0,0 +1,236 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/pkg/secrets/ent/namespacekey"
+)
+
+// NamespaceKeyCreate is the builder for creating a NamespaceKey entity.
+type NamespaceKeyCreate struct {
+	config
+	mutation *NamespaceKeyMutation
+	hooks    []Hook
+}
+
+// SetNs sets the "ns" field.
+func (nkc *NamespaceKeyCreate) SetNs(s string) *NamespaceKeyCreate {
+	nkc.mutation.SetNs(s)
+	return nkc
+}
+
+// SetKeyRef sets the "keyRef" field.
+func (nkc *NamespaceKeyCreate) SetKeyRef(s string) *NamespaceKeyCreate {
+	nkc.mutation.SetKeyRef(s)
+	return nkc
+}
+
+// SetDataKey sets the "dataKey" field.
+func (nkc *NamespaceKeyCreate) SetDataKey(b []byte) *NamespaceKeyCreate {
+	nkc.mutation.SetDataKey(b)
+	return nkc
+}
+
+// SetRotated sets the "rotated" field.
+func (nkc *NamespaceKeyCreate) SetRotated(t time.Time) *NamespaceKeyCreate {
+	nkc.mutation.SetRotated(t)
+	return nkc
+}
+
+// Mutation returns the NamespaceKeyMutation object of the builder.
+func (nkc *NamespaceKeyCreate) Mutation() *NamespaceKeyMutation {
+	return nkc.mutation
+}
+
+// Save creates the NamespaceKey in the database.
+func (nkc *NamespaceKeyCreate) Save(ctx context.Context) (*NamespaceKey, error) {
+	var (
+		err  error
+		node *NamespaceKey
+	)
+	if len(nkc.hooks) == 0 {
+		if err = nkc.check(); err != nil {
+			return nil, err
+		}
+		node, err = nkc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NamespaceKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = nkc.check(); err != nil {
+				return nil, err
+			}
+			nkc.mutation = mutation
+			node, err = nkc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(nkc.hooks) - 1; i >= 0; i-- {
+			mut = nkc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nkc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nkc *NamespaceKeyCreate) SaveX(ctx context.Context) *NamespaceKey {
+	v, err := nkc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nkc *NamespaceKeyCreate) check() error {
+	if _, ok := nkc.mutation.Ns(); !ok {
+		return &ValidationError{Name: "ns", err: errors.New("ent: missing required field \"ns\"")}
+	}
+	if _, ok := nkc.mutation.KeyRef(); !ok {
+		return &ValidationError{Name: "keyRef", err: errors.New("ent: missing required field \"keyRef\"")}
+	}
+	if _, ok := nkc.mutation.DataKey(); !ok {
+		return &ValidationError{Name: "dataKey", err: errors.New("ent: missing required field \"dataKey\"")}
+	}
+	if _, ok := nkc.mutation.Rotated(); !ok {
+		return &ValidationError{Name: "rotated", err: errors.New("ent: missing required field \"rotated\"")}
+	}
+	return nil
+}
+
+func (nkc *NamespaceKeyCreate) sqlSave(ctx context.Context) (*NamespaceKey, error) {
+	_node, _spec := nkc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nkc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (nkc *NamespaceKeyCreate) createSpec() (*NamespaceKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NamespaceKey{config: nkc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: namespacekey.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: namespacekey.FieldID,
+			},
+		}
+	)
+	if value, ok := nkc.mutation.Ns(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespacekey.FieldNs,
+		})
+		_node.Ns = value
+	}
+	if value, ok := nkc.mutation.KeyRef(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespacekey.FieldKeyRef,
+		})
+		_node.KeyRef = value
+	}
+	if value, ok := nkc.mutation.DataKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: namespacekey.FieldDataKey,
+		})
+		_node.DataKey = value
+	}
+	if value, ok := nkc.mutation.Rotated(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: namespacekey.FieldRotated,
+		})
+		_node.Rotated = value
+	}
+	return _node, _spec
+}
+
+// NamespaceKeyCreateBulk is the builder for creating many NamespaceKey entities in bulk.
+type NamespaceKeyCreateBulk struct {
+	config
+	builders []*NamespaceKeyCreate
+}
+
+// Save creates the NamespaceKey entities in the database.
+func (nkcb *NamespaceKeyCreateBulk) Save(ctx context.Context) ([]*NamespaceKey, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(nkcb.builders))
+	nodes := make([]*NamespaceKey, len(nkcb.builders))
+	mutators := make([]Mutator, len(nkcb.builders))
+	for i := range nkcb.builders {
+		func(i int, root context.Context) {
+			builder := nkcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NamespaceKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, nkcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, nkcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, nkcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nkcb *NamespaceKeyCreateBulk) SaveX(ctx context.Context) []*NamespaceKey {
+	v, err := nkcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,108 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/pkg/secrets/ent/namespacekey"
+	"github.com/vorteil/direktiv/pkg/secrets/ent/predicate"
+)
+
+// NamespaceKeyDelete is the builder for deleting a NamespaceKey entity.
+type NamespaceKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *NamespaceKeyMutation
+}
+
+// Where adds a new predicate to the NamespaceKeyDelete builder.
+func (nkd *NamespaceKeyDelete) Where(ps ...predicate.NamespaceKey) *NamespaceKeyDelete {
+	nkd.mutation.predicates = append(nkd.mutation.predicates, ps...)
+	return nkd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (nkd *NamespaceKeyDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(nkd.hooks) == 0 {
+		affected, err = nkd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NamespaceKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			nkd.mutation = mutation
+			affected, err = nkd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(nkd.hooks) - 1; i >= 0; i-- {
+			mut = nkd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nkd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nkd *NamespaceKeyDelete) ExecX(ctx context.Context) int {
+	n, err := nkd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (nkd *NamespaceKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: namespacekey.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: namespacekey.FieldID,
+			},
+		},
+	}
+	if ps := nkd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, nkd.driver, _spec)
+}
+
+// NamespaceKeyDeleteOne is the builder for deleting a single NamespaceKey entity.
+type NamespaceKeyDeleteOne struct {
+	nkd *NamespaceKeyDelete
+}
+
+// Exec executes the deletion query.
+func (nkdo *NamespaceKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := nkdo.nkd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{namespacekey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nkdo *NamespaceKeyDeleteOne) ExecX(ctx context.Context) {
+	nkdo.nkd.ExecX(ctx)
+}
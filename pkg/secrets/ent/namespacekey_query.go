@@ -0,0 +1,906 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/pkg/secrets/ent/namespacekey"
+	"github.com/vorteil/direktiv/pkg/secrets/ent/predicate"
+)
+
+// NamespaceKeyQuery is the builder for querying NamespaceKey entities.
+type NamespaceKeyQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.NamespaceKey
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NamespaceKeyQuery builder.
+func (nkq *NamespaceKeyQuery) Where(ps ...predicate.NamespaceKey) *NamespaceKeyQuery {
+	nkq.predicates = append(nkq.predicates, ps...)
+	return nkq
+}
+
+// Limit adds a limit step to the query.
+func (nkq *NamespaceKeyQuery) Limit(limit int) *NamespaceKeyQuery {
+	nkq.limit = &limit
+	return nkq
+}
+
+// Offset adds an offset step to the query.
+func (nkq *NamespaceKeyQuery) Offset(offset int) *NamespaceKeyQuery {
+	nkq.offset = &offset
+	return nkq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (nkq *NamespaceKeyQuery) Unique(unique bool) *NamespaceKeyQuery {
+	nkq.unique = &unique
+	return nkq
+}
+
+// Order adds an order step to the query.
+func (nkq *NamespaceKeyQuery) Order(o ...OrderFunc) *NamespaceKeyQuery {
+	nkq.order = append(nkq.order, o...)
+	return nkq
+}
+
+// First returns the first NamespaceKey entity from the query.
+// Returns a *NotFoundError when no NamespaceKey was found.
+func (nkq *NamespaceKeyQuery) First(ctx context.Context) (*NamespaceKey, error) {
+	nodes, err := nkq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{namespacekey.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (nkq *NamespaceKeyQuery) FirstX(ctx context.Context) *NamespaceKey {
+	node, err := nkq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NamespaceKey ID from the query.
+// Returns a *NotFoundError when no NamespaceKey ID was found.
+func (nkq *NamespaceKeyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = nkq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{namespacekey.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (nkq *NamespaceKeyQuery) FirstIDX(ctx context.Context) int {
+	id, err := nkq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NamespaceKey entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one NamespaceKey entity is not found.
+// Returns a *NotFoundError when no NamespaceKey entities are found.
+func (nkq *NamespaceKeyQuery) Only(ctx context.Context) (*NamespaceKey, error) {
+	nodes, err := nkq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{namespacekey.Label}
+	default:
+		return nil, &NotSingularError{namespacekey.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (nkq *NamespaceKeyQuery) OnlyX(ctx context.Context) *NamespaceKey {
+	node, err := nkq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NamespaceKey ID in the query.
+// Returns a *NotSingularError when exactly one NamespaceKey ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (nkq *NamespaceKeyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = nkq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{namespacekey.Label}
+	default:
+		err = &NotSingularError{namespacekey.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (nkq *NamespaceKeyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := nkq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NamespaceKeys.
+func (nkq *NamespaceKeyQuery) All(ctx context.Context) ([]*NamespaceKey, error) {
+	if err := nkq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return nkq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (nkq *NamespaceKeyQuery) AllX(ctx context.Context) []*NamespaceKey {
+	nodes, err := nkq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NamespaceKey IDs.
+func (nkq *NamespaceKeyQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := nkq.Select(namespacekey.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (nkq *NamespaceKeyQuery) IDsX(ctx context.Context) []int {
+	ids, err := nkq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (nkq *NamespaceKeyQuery) Count(ctx context.Context) (int, error) {
+	if err := nkq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return nkq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (nkq *NamespaceKeyQuery) CountX(ctx context.Context) int {
+	count, err := nkq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (nkq *NamespaceKeyQuery) Exist(ctx context.Context) (bool, error) {
+	if err := nkq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return nkq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (nkq *NamespaceKeyQuery) ExistX(ctx context.Context) bool {
+	exist, err := nkq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NamespaceKeyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (nkq *NamespaceKeyQuery) Clone() *NamespaceKeyQuery {
+	if nkq == nil {
+		return nil
+	}
+	return &NamespaceKeyQuery{
+		config:     nkq.config,
+		limit:      nkq.limit,
+		offset:     nkq.offset,
+		order:      append([]OrderFunc{}, nkq.order...),
+		predicates: append([]predicate.NamespaceKey{}, nkq.predicates...),
+		// clone intermediate query.
+		sql:  nkq.sql.Clone(),
+		path: nkq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Ns string `json:"ns,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NamespaceKey.Query().
+//		GroupBy(namespacekey.FieldNs).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (nkq *NamespaceKeyQuery) GroupBy(field string, fields ...string) *NamespaceKeyGroupBy {
+	group := &NamespaceKeyGroupBy{config: nkq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := nkq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return nkq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Ns string `json:"ns,omitempty"`
+//	}
+//
+//	client.NamespaceKey.Query().
+//		Select(namespacekey.FieldNs).
+//		Scan(ctx, &v)
+//
+func (nkq *NamespaceKeyQuery) Select(field string, fields ...string) *NamespaceKeySelect {
+	nkq.fields = append([]string{field}, fields...)
+	return &NamespaceKeySelect{NamespaceKeyQuery: nkq}
+}
+
+func (nkq *NamespaceKeyQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range nkq.fields {
+		if !namespacekey.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if nkq.path != nil {
+		prev, err := nkq.path(ctx)
+		if err != nil {
+			return err
+		}
+		nkq.sql = prev
+	}
+	return nil
+}
+
+func (nkq *NamespaceKeyQuery) sqlAll(ctx context.Context) ([]*NamespaceKey, error) {
+	var (
+		nodes = []*NamespaceKey{}
+		_spec = nkq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &NamespaceKey{config: nkq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, nkq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (nkq *NamespaceKeyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := nkq.querySpec()
+	return sqlgraph.CountNodes(ctx, nkq.driver, _spec)
+}
+
+func (nkq *NamespaceKeyQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := nkq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (nkq *NamespaceKeyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   namespacekey.Table,
+			Columns: namespacekey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: namespacekey.FieldID,
+			},
+		},
+		From:   nkq.sql,
+		Unique: true,
+	}
+	if unique := nkq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := nkq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, namespacekey.FieldID)
+		for i := range fields {
+			if fields[i] != namespacekey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := nkq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := nkq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := nkq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := nkq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (nkq *NamespaceKeyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(nkq.driver.Dialect())
+	t1 := builder.Table(namespacekey.Table)
+	selector := builder.Select(t1.Columns(namespacekey.Columns...)...).From(t1)
+	if nkq.sql != nil {
+		selector = nkq.sql
+		selector.Select(selector.Columns(namespacekey.Columns...)...)
+	}
+	for _, p := range nkq.predicates {
+		p(selector)
+	}
+	for _, p := range nkq.order {
+		p(selector)
+	}
+	if offset := nkq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := nkq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// NamespaceKeyGroupBy is the group-by builder for NamespaceKey entities.
+type NamespaceKeyGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (nkgb *NamespaceKeyGroupBy) Aggregate(fns ...AggregateFunc) *NamespaceKeyGroupBy {
+	nkgb.fns = append(nkgb.fns, fns...)
+	return nkgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (nkgb *NamespaceKeyGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := nkgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	nkgb.sql = query
+	return nkgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (nkgb *NamespaceKeyGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := nkgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (nkgb *NamespaceKeyGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(nkgb.fields) > 1 {
+		return nil, errors.New("ent: NamespaceKeyGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := nkgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (nkgb *NamespaceKeyGroupBy) StringsX(ctx context.Context) []string {
+	v, err := nkgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (nkgb *NamespaceKeyGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = nkgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{namespacekey.Label}
+	default:
+		err = fmt.Errorf("ent: NamespaceKeyGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (nkgb *NamespaceKeyGroupBy) StringX(ctx context.Context) string {
+	v, err := nkgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (nkgb *NamespaceKeyGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(nkgb.fields) > 1 {
+		return nil, errors.New("ent: NamespaceKeyGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := nkgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (nkgb *NamespaceKeyGroupBy) IntsX(ctx context.Context) []int {
+	v, err := nkgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (nkgb *NamespaceKeyGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = nkgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{namespacekey.Label}
+	default:
+		err = fmt.Errorf("ent: NamespaceKeyGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (nkgb *NamespaceKeyGroupBy) IntX(ctx context.Context) int {
+	v, err := nkgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (nkgb *NamespaceKeyGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(nkgb.fields) > 1 {
+		return nil, errors.New("ent: NamespaceKeyGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := nkgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (nkgb *NamespaceKeyGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := nkgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (nkgb *NamespaceKeyGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = nkgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{namespacekey.Label}
+	default:
+		err = fmt.Errorf("ent: NamespaceKeyGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (nkgb *NamespaceKeyGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := nkgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (nkgb *NamespaceKeyGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(nkgb.fields) > 1 {
+		return nil, errors.New("ent: NamespaceKeyGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := nkgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (nkgb *NamespaceKeyGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := nkgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (nkgb *NamespaceKeyGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = nkgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{namespacekey.Label}
+	default:
+		err = fmt.Errorf("ent: NamespaceKeyGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (nkgb *NamespaceKeyGroupBy) BoolX(ctx context.Context) bool {
+	v, err := nkgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (nkgb *NamespaceKeyGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range nkgb.fields {
+		if !namespacekey.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := nkgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nkgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (nkgb *NamespaceKeyGroupBy) sqlQuery() *sql.Selector {
+	selector := nkgb.sql
+	columns := make([]string, 0, len(nkgb.fields)+len(nkgb.fns))
+	columns = append(columns, nkgb.fields...)
+	for _, fn := range nkgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(nkgb.fields...)
+}
+
+// NamespaceKeySelect is the builder for selecting fields of NamespaceKey entities.
+type NamespaceKeySelect struct {
+	*NamespaceKeyQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nks *NamespaceKeySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := nks.prepareQuery(ctx); err != nil {
+		return err
+	}
+	nks.sql = nks.NamespaceKeyQuery.sqlQuery(ctx)
+	return nks.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (nks *NamespaceKeySelect) ScanX(ctx context.Context, v interface{}) {
+	if err := nks.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (nks *NamespaceKeySelect) Strings(ctx context.Context) ([]string, error) {
+	if len(nks.fields) > 1 {
+		return nil, errors.New("ent: NamespaceKeySelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := nks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (nks *NamespaceKeySelect) StringsX(ctx context.Context) []string {
+	v, err := nks.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (nks *NamespaceKeySelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = nks.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{namespacekey.Label}
+	default:
+		err = fmt.Errorf("ent: NamespaceKeySelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (nks *NamespaceKeySelect) StringX(ctx context.Context) string {
+	v, err := nks.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (nks *NamespaceKeySelect) Ints(ctx context.Context) ([]int, error) {
+	if len(nks.fields) > 1 {
+		return nil, errors.New("ent: NamespaceKeySelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := nks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (nks *NamespaceKeySelect) IntsX(ctx context.Context) []int {
+	v, err := nks.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (nks *NamespaceKeySelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = nks.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{namespacekey.Label}
+	default:
+		err = fmt.Errorf("ent: NamespaceKeySelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (nks *NamespaceKeySelect) IntX(ctx context.Context) int {
+	v, err := nks.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (nks *NamespaceKeySelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(nks.fields) > 1 {
+		return nil, errors.New("ent: NamespaceKeySelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := nks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (nks *NamespaceKeySelect) Float64sX(ctx context.Context) []float64 {
+	v, err := nks.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (nks *NamespaceKeySelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = nks.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{namespacekey.Label}
+	default:
+		err = fmt.Errorf("ent: NamespaceKeySelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (nks *NamespaceKeySelect) Float64X(ctx context.Context) float64 {
+	v, err := nks.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (nks *NamespaceKeySelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(nks.fields) > 1 {
+		return nil, errors.New("ent: NamespaceKeySelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := nks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (nks *NamespaceKeySelect) BoolsX(ctx context.Context) []bool {
+	v, err := nks.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (nks *NamespaceKeySelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = nks.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{namespacekey.Label}
+	default:
+		err = fmt.Errorf("ent: NamespaceKeySelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (nks *NamespaceKeySelect) BoolX(ctx context.Context) bool {
+	v, err := nks.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (nks *NamespaceKeySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := nks.sqlQuery().Query()
+	if err := nks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (nks *NamespaceKeySelect) sqlQuery() sql.Querier {
+	selector := nks.sql
+	selector.Select(selector.Columns(nks.fields...)...)
+	return selector
+}
@@ -0,0 +1,337 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vorteil/direktiv/pkg/secrets/ent/namespacekey"
+	"github.com/vorteil/direktiv/pkg/secrets/ent/predicate"
+)
+
+// NamespaceKeyUpdate is the builder for updating NamespaceKey entities.
+type NamespaceKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NamespaceKeyMutation
+}
+
+// Where adds a new predicate for the NamespaceKeyUpdate builder.
+func (nku *NamespaceKeyUpdate) Where(ps ...predicate.NamespaceKey) *NamespaceKeyUpdate {
+	nku.mutation.predicates = append(nku.mutation.predicates, ps...)
+	return nku
+}
+
+// SetNs sets the "ns" field.
+func (nku *NamespaceKeyUpdate) SetNs(s string) *NamespaceKeyUpdate {
+	nku.mutation.SetNs(s)
+	return nku
+}
+
+// SetKeyRef sets the "keyRef" field.
+func (nku *NamespaceKeyUpdate) SetKeyRef(s string) *NamespaceKeyUpdate {
+	nku.mutation.SetKeyRef(s)
+	return nku
+}
+
+// SetDataKey sets the "dataKey" field.
+func (nku *NamespaceKeyUpdate) SetDataKey(b []byte) *NamespaceKeyUpdate {
+	nku.mutation.SetDataKey(b)
+	return nku
+}
+
+// SetRotated sets the "rotated" field.
+func (nku *NamespaceKeyUpdate) SetRotated(t time.Time) *NamespaceKeyUpdate {
+	nku.mutation.SetRotated(t)
+	return nku
+}
+
+// Mutation returns the NamespaceKeyMutation object of the builder.
+func (nku *NamespaceKeyUpdate) Mutation() *NamespaceKeyMutation {
+	return nku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nku *NamespaceKeyUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(nku.hooks) == 0 {
+		affected, err = nku.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NamespaceKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			nku.mutation = mutation
+			affected, err = nku.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(nku.hooks) - 1; i >= 0; i-- {
+			mut = nku.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nku.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nku *NamespaceKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := nku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nku *NamespaceKeyUpdate) Exec(ctx context.Context) error {
+	_, err := nku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nku *NamespaceKeyUpdate) ExecX(ctx context.Context) {
+	if err := nku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (nku *NamespaceKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   namespacekey.Table,
+			Columns: namespacekey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: namespacekey.FieldID,
+			},
+		},
+	}
+	if ps := nku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nku.mutation.Ns(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespacekey.FieldNs,
+		})
+	}
+	if value, ok := nku.mutation.KeyRef(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespacekey.FieldKeyRef,
+		})
+	}
+	if value, ok := nku.mutation.DataKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: namespacekey.FieldDataKey,
+		})
+	}
+	if value, ok := nku.mutation.Rotated(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: namespacekey.FieldRotated,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{namespacekey.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// NamespaceKeyUpdateOne is the builder for updating a single NamespaceKey entity.
+type NamespaceKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NamespaceKeyMutation
+}
+
+// SetNs sets the "ns" field.
+func (nkuo *NamespaceKeyUpdateOne) SetNs(s string) *NamespaceKeyUpdateOne {
+	nkuo.mutation.SetNs(s)
+	return nkuo
+}
+
+// SetKeyRef sets the "keyRef" field.
+func (nkuo *NamespaceKeyUpdateOne) SetKeyRef(s string) *NamespaceKeyUpdateOne {
+	nkuo.mutation.SetKeyRef(s)
+	return nkuo
+}
+
+// SetDataKey sets the "dataKey" field.
+func (nkuo *NamespaceKeyUpdateOne) SetDataKey(b []byte) *NamespaceKeyUpdateOne {
+	nkuo.mutation.SetDataKey(b)
+	return nkuo
+}
+
+// SetRotated sets the "rotated" field.
+func (nkuo *NamespaceKeyUpdateOne) SetRotated(t time.Time) *NamespaceKeyUpdateOne {
+	nkuo.mutation.SetRotated(t)
+	return nkuo
+}
+
+// Mutation returns the NamespaceKeyMutation object of the builder.
+func (nkuo *NamespaceKeyUpdateOne) Mutation() *NamespaceKeyMutation {
+	return nkuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nkuo *NamespaceKeyUpdateOne) Select(field string, fields ...string) *NamespaceKeyUpdateOne {
+	nkuo.fields = append([]string{field}, fields...)
+	return nkuo
+}
+
+// Save executes the query and returns the updated NamespaceKey entity.
+func (nkuo *NamespaceKeyUpdateOne) Save(ctx context.Context) (*NamespaceKey, error) {
+	var (
+		err  error
+		node *NamespaceKey
+	)
+	if len(nkuo.hooks) == 0 {
+		node, err = nkuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NamespaceKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			nkuo.mutation = mutation
+			node, err = nkuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(nkuo.hooks) - 1; i >= 0; i-- {
+			mut = nkuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nkuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nkuo *NamespaceKeyUpdateOne) SaveX(ctx context.Context) *NamespaceKey {
+	node, err := nkuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nkuo *NamespaceKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := nkuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nkuo *NamespaceKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := nkuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (nkuo *NamespaceKeyUpdateOne) sqlSave(ctx context.Context) (_node *NamespaceKey, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   namespacekey.Table,
+			Columns: namespacekey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: namespacekey.FieldID,
+			},
+		},
+	}
+	id, ok := nkuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing NamespaceKey.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nkuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, namespacekey.FieldID)
+		for _, f := range fields {
+			if !namespacekey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != namespacekey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nkuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nkuo.mutation.Ns(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespacekey.FieldNs,
+		})
+	}
+	if value, ok := nkuo.mutation.KeyRef(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: namespacekey.FieldKeyRef,
+		})
+	}
+	if value, ok := nkuo.mutation.DataKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: namespacekey.FieldDataKey,
+		})
+	}
+	if value, ok := nkuo.mutation.Rotated(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: namespacekey.FieldRotated,
+		})
+	}
+	_node = &NamespaceKey{config: nkuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nkuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{namespacekey.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
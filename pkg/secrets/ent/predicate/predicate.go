@@ -6,5 +6,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// NamespaceKey is the predicate function for namespacekey builders.
+type NamespaceKey func(*sql.Selector)
+
 // NamespaceSecret is the predicate function for namespacesecret builders.
 type NamespaceSecret func(*sql.Selector)
@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// NamespaceKey holds the schema definition for the NamespaceKey entity, the
+// data key the secrets of a namespace are encrypted with. It is stored
+// wrapped by the KMS key the namespace references.
+type NamespaceKey struct {
+	ent.Schema
+}
+
+// Fields of the NamespaceKey.
+func (NamespaceKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("ns").Unique(),
+		field.String("keyRef"),
+		field.Bytes("dataKey").MaxLen(4096),
+		field.Time("rotated"),
+	}
+}
+
+// Edges of the NamespaceKey.
+func (NamespaceKey) Edges() []ent.Edge {
+	return nil
+}
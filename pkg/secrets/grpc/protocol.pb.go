@@ -34,7 +34,7 @@ var file_pkg_secrets_grpc_protocol_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
 	0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x32, 0xf5, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
+	0x72, 0x6f, 0x74, 0x6f, 0x32, 0x92, 0x04, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x65,
 	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
 	0x63, 0x72, 0x65, 0x74, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
@@ -57,11 +57,21 @@ var file_pkg_secrets_grpc_protocol_proto_rawDesc = []byte{
 	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47,
 	0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2e, 0x5a, 0x2c,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x72, 0x74, 0x65,
-	0x69, 0x6c, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x6b, 0x74, 0x69, 0x76, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0f,
+	0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x12,
+	0x1c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x72, 0x74, 0x65, 0x69, 0x6c, 0x2f,
+	0x64, 0x69, 0x72, 0x65, 0x6b, 0x74, 0x69, 0x76, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var file_pkg_secrets_grpc_protocol_proto_goTypes = []interface{}{
@@ -70,26 +80,33 @@ var file_pkg_secrets_grpc_protocol_proto_goTypes = []interface{}{
 	(*SecretsDeleteRequest)(nil),    // 2: grpc.SecretsDeleteRequest
 	(*DeleteSecretsRequest)(nil),    // 3: grpc.DeleteSecretsRequest
 	(*GetSecretsRequest)(nil),       // 4: grpc.GetSecretsRequest
-	(*empty.Empty)(nil),             // 5: google.protobuf.Empty
-	(*SecretsRetrieveResponse)(nil), // 6: grpc.SecretsRetrieveResponse
-	(*GetSecretsResponse)(nil),      // 7: grpc.GetSecretsResponse
+	(*SetNamespaceKeyRequest)(nil),  // 5: grpc.SetNamespaceKeyRequest
+	(*GetNamespaceKeyRequest)(nil),  // 6: grpc.GetNamespaceKeyRequest
+	(*empty.Empty)(nil),             // 7: google.protobuf.Empty
+	(*SecretsRetrieveResponse)(nil), // 8: grpc.SecretsRetrieveResponse
+	(*GetSecretsResponse)(nil),      // 9: grpc.GetSecretsResponse
+	(*GetNamespaceKeyResponse)(nil), // 10: grpc.GetNamespaceKeyResponse
 }
 var file_pkg_secrets_grpc_protocol_proto_depIdxs = []int32{
-	0, // 0: grpc.SecretsService.StoreSecret:input_type -> grpc.SecretsStoreRequest
-	1, // 1: grpc.SecretsService.RetrieveSecret:input_type -> grpc.SecretsRetrieveRequest
-	2, // 2: grpc.SecretsService.DeleteSecret:input_type -> grpc.SecretsDeleteRequest
-	3, // 3: grpc.SecretsService.DeleteSecrets:input_type -> grpc.DeleteSecretsRequest
-	4, // 4: grpc.SecretsService.GetSecrets:input_type -> grpc.GetSecretsRequest
-	5, // 5: grpc.SecretsService.StoreSecret:output_type -> google.protobuf.Empty
-	6, // 6: grpc.SecretsService.RetrieveSecret:output_type -> grpc.SecretsRetrieveResponse
-	5, // 7: grpc.SecretsService.DeleteSecret:output_type -> google.protobuf.Empty
-	5, // 8: grpc.SecretsService.DeleteSecrets:output_type -> google.protobuf.Empty
-	7, // 9: grpc.SecretsService.GetSecrets:output_type -> grpc.GetSecretsResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: grpc.SecretsService.StoreSecret:input_type -> grpc.SecretsStoreRequest
+	1,  // 1: grpc.SecretsService.RetrieveSecret:input_type -> grpc.SecretsRetrieveRequest
+	2,  // 2: grpc.SecretsService.DeleteSecret:input_type -> grpc.SecretsDeleteRequest
+	3,  // 3: grpc.SecretsService.DeleteSecrets:input_type -> grpc.DeleteSecretsRequest
+	4,  // 4: grpc.SecretsService.GetSecrets:input_type -> grpc.GetSecretsRequest
+	5,  // 5: grpc.SecretsService.SetNamespaceKey:input_type -> grpc.SetNamespaceKeyRequest
+	6,  // 6: grpc.SecretsService.GetNamespaceKey:input_type -> grpc.GetNamespaceKeyRequest
+	7,  // 7: grpc.SecretsService.StoreSecret:output_type -> google.protobuf.Empty
+	8,  // 8: grpc.SecretsService.RetrieveSecret:output_type -> grpc.SecretsRetrieveResponse
+	7,  // 9: grpc.SecretsService.DeleteSecret:output_type -> google.protobuf.Empty
+	7,  // 10: grpc.SecretsService.DeleteSecrets:output_type -> google.protobuf.Empty
+	9,  // 11: grpc.SecretsService.GetSecrets:output_type -> grpc.GetSecretsResponse
+	7,  // 12: grpc.SecretsService.SetNamespaceKey:output_type -> google.protobuf.Empty
+	10, // 13: grpc.SecretsService.GetNamespaceKey:output_type -> grpc.GetNamespaceKeyResponse
+	7,  // [7:14] is the sub-list for method output_type
+	0,  // [0:7] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_pkg_secrets_grpc_protocol_proto_init() }
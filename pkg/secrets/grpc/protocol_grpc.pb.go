@@ -24,6 +24,8 @@ type SecretsServiceClient interface {
 	DeleteSecret(ctx context.Context, in *SecretsDeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	DeleteSecrets(ctx context.Context, in *DeleteSecretsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	GetSecrets(ctx context.Context, in *GetSecretsRequest, opts ...grpc.CallOption) (*GetSecretsResponse, error)
+	SetNamespaceKey(ctx context.Context, in *SetNamespaceKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetNamespaceKey(ctx context.Context, in *GetNamespaceKeyRequest, opts ...grpc.CallOption) (*GetNamespaceKeyResponse, error)
 }
 
 type secretsServiceClient struct {
@@ -79,6 +81,24 @@ func (c *secretsServiceClient) GetSecrets(ctx context.Context, in *GetSecretsReq
 	return out, nil
 }
 
+func (c *secretsServiceClient) SetNamespaceKey(ctx context.Context, in *SetNamespaceKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/grpc.SecretsService/SetNamespaceKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsServiceClient) GetNamespaceKey(ctx context.Context, in *GetNamespaceKeyRequest, opts ...grpc.CallOption) (*GetNamespaceKeyResponse, error) {
+	out := new(GetNamespaceKeyResponse)
+	err := c.cc.Invoke(ctx, "/grpc.SecretsService/GetNamespaceKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SecretsServiceServer is the server API for SecretsService service.
 // All implementations must embed UnimplementedSecretsServiceServer
 // for forward compatibility
@@ -88,6 +108,8 @@ type SecretsServiceServer interface {
 	DeleteSecret(context.Context, *SecretsDeleteRequest) (*empty.Empty, error)
 	DeleteSecrets(context.Context, *DeleteSecretsRequest) (*empty.Empty, error)
 	GetSecrets(context.Context, *GetSecretsRequest) (*GetSecretsResponse, error)
+	SetNamespaceKey(context.Context, *SetNamespaceKeyRequest) (*empty.Empty, error)
+	GetNamespaceKey(context.Context, *GetNamespaceKeyRequest) (*GetNamespaceKeyResponse, error)
 	mustEmbedUnimplementedSecretsServiceServer()
 }
 
@@ -110,6 +132,12 @@ func (UnimplementedSecretsServiceServer) DeleteSecrets(context.Context, *DeleteS
 func (UnimplementedSecretsServiceServer) GetSecrets(context.Context, *GetSecretsRequest) (*GetSecretsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSecrets not implemented")
 }
+func (UnimplementedSecretsServiceServer) SetNamespaceKey(context.Context, *SetNamespaceKeyRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceKey not implemented")
+}
+func (UnimplementedSecretsServiceServer) GetNamespaceKey(context.Context, *GetNamespaceKeyRequest) (*GetNamespaceKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceKey not implemented")
+}
 func (UnimplementedSecretsServiceServer) mustEmbedUnimplementedSecretsServiceServer() {}
 
 // UnsafeSecretsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -213,6 +241,42 @@ func _SecretsService_GetSecrets_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SecretsService_SetNamespaceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServiceServer).SetNamespaceKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.SecretsService/SetNamespaceKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServiceServer).SetNamespaceKey(ctx, req.(*SetNamespaceKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecretsService_GetNamespaceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServiceServer).GetNamespaceKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.SecretsService/GetNamespaceKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServiceServer).GetNamespaceKey(ctx, req.(*GetNamespaceKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SecretsService_ServiceDesc is the grpc.ServiceDesc for SecretsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -240,6 +304,14 @@ var SecretsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSecrets",
 			Handler:    _SecretsService_GetSecrets_Handler,
 		},
+		{
+			MethodName: "SetNamespaceKey",
+			Handler:    _SecretsService_SetNamespaceKey_Handler,
+		},
+		{
+			MethodName: "GetNamespaceKey",
+			Handler:    _SecretsService_GetNamespaceKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/secrets/grpc/protocol.proto",
@@ -5,15 +5,22 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"database/sql"
 	"fmt"
 	"io"
 	"os"
+	"strconv"
+	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/lib/pq"
 	log "github.com/sirupsen/logrus"
 	"github.com/vorteil/direktiv/pkg/secrets/ent"
 	entc "github.com/vorteil/direktiv/pkg/secrets/ent"
+	"github.com/vorteil/direktiv/pkg/secrets/ent/namespacekey"
 	"github.com/vorteil/direktiv/pkg/secrets/ent/namespacesecret"
+	"github.com/vorteil/direktiv/pkg/secrets/kms"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -22,11 +29,26 @@ import (
 const (
 	secretsConn = "DIREKTIV_SECRETS_DB"
 	secretsKey  = "DIREKTIV_SECRETS_KEY"
+
+	// seconds unwrapped namespace data keys are cached for, 0 disables it
+	keyCacheTTL = "DIREKTIV_SECRETS_KEY_CACHE_TTL"
 )
 
+const (
+	defaultKeyCacheTTL = 300
+	keyCacheSize       = 1024
+	kmsTimeout         = 10 * time.Second
+)
+
+// dbHandler encrypts secrets with the key of the server, or with the data
+// key of their namespace if it has one. Data keys are stored wrapped by the
+// KMS key the namespace references, and unwrapped when they are needed.
 type dbHandler struct {
-	db  *entc.Client
-	key string
+	db   *entc.Client
+	sql  *sql.DB
+	key  string
+	kms  *kms.Manager
+	keys *keyCache
 }
 
 func setupDB() (SecretsHandler, error) {
@@ -42,20 +64,34 @@ func setupDB() (SecretsHandler, error) {
 		return nil, fmt.Errorf("key needs to be 32 characters")
 	}
 
-	db, err := ent.Open("postgres", dbEnv)
+	ttl := defaultKeyCacheTTL
+	if v := os.Getenv(keyCacheTTL); v != "" {
+		var err error
+		ttl, err = strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("can not parse %s: %v", keyCacheTTL, err)
+		}
+	}
+
+	sqldb, err := sql.Open("postgres", dbEnv)
 	if err != nil {
 		log.Errorf("can not connect to secrets db: %v", err)
 		return nil, err
 	}
 
+	db := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, sqldb)))
+
 	if err := db.Schema.Create(context.Background()); err != nil {
 		log.Errorf("failed creating schema resources: %v", err)
 		return nil, err
 	}
 
 	return &dbHandler{
-		db:  db,
-		key: keyEnv,
+		db:   db,
+		sql:  sqldb,
+		key:  keyEnv,
+		kms:  kms.NewManager(),
+		keys: newKeyCache(time.Duration(ttl)*time.Second, keyCacheSize),
 	}, err
 }
 
@@ -63,32 +99,52 @@ func (db *dbHandler) AddSecret(namespace, name string, secret []byte) error {
 
 	log.Infof("adding secret %s", name)
 
-	bs, _ := db.db.NamespaceSecret.
+	ctx := context.Background()
+
+	// the key can't be rotated before the secret is stored with it
+	tx, client, nk, err := db.lockNamespaceKey(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	bs, _ := client.NamespaceSecret.
 		Query().
 		Where(
 			namespacesecret.And(
 				namespacesecret.NsEQ(namespace),
 				namespacesecret.NameEQ(name),
 			)).
-		Only(context.Background())
+		Only(ctx)
 
 	if bs != nil {
-		return fmt.Errorf("secret already exists")
+		return rollback(tx, fmt.Errorf("secret already exists"))
 	}
 
-	d, err := encryptData([]byte(db.key), secret)
-	if bs != nil {
-		return fmt.Errorf("error encrypting data: %v", err)
+	key := []byte(db.key)
+	if nk != nil {
+		key, err = db.unwrapKey(ctx, nk)
+		if err != nil {
+			return rollback(tx, err)
+		}
+	}
+	defer zero(key)
+
+	d, err := encryptData(key, secret)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("error encrypting data: %v", err))
 	}
 
-	_, err = db.db.NamespaceSecret.
+	_, err = client.NamespaceSecret.
 		Create().
 		SetName(name).
 		SetSecret(d).
 		SetNs(namespace).
-		Save(context.Background())
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
 
-	return err
+	return tx.Commit()
 
 }
 
@@ -110,7 +166,13 @@ func (db *dbHandler) GetSecret(namespace, name string) ([]byte, error) {
 		return nil, err
 	}
 
-	return decryptData([]byte(db.key), bs.Secret)
+	key, err := db.dataKey(context.Background(), namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(key)
+
+	return decryptData(key, bs.Secret)
 
 }
 
@@ -163,6 +225,254 @@ func (db *dbHandler) RemoveSecrets(namespace string) error {
 				namespacesecret.NsEQ(namespace),
 			)).
 		Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	_, err = db.db.NamespaceKey.
+		Delete().
+		Where(namespacekey.NsEQ(namespace)).
+		Exec(context.Background())
+
+	db.keys.drop(namespace)
+
+	return err
+
+}
+
+// dataKey returns the key the secrets of namespace are encrypted with. The
+// caller zeroes it when done.
+func (db *dbHandler) dataKey(ctx context.Context, namespace string) ([]byte, error) {
+
+	nk, err := db.db.NamespaceKey.
+		Query().
+		Where(namespacekey.NsEQ(namespace)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return []byte(db.key), nil
+		}
+		return nil, err
+	}
+
+	return db.unwrapKey(ctx, nk)
+
+}
+
+func (db *dbHandler) unwrapKey(ctx context.Context, nk *ent.NamespaceKey) ([]byte, error) {
+
+	if key := db.keys.get(nk.Ns, nk.DataKey); key != nil {
+		return key, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, kmsTimeout)
+	defer cancel()
+
+	key, err := db.kms.Unwrap(ctx, nk.KeyRef, nk.DataKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "can not unwrap key of namespace '%s' with %s: %v",
+			nk.Ns, nk.KeyRef, err)
+	}
+
+	db.keys.put(nk.Ns, nk.DataKey, key)
+
+	return key, nil
+
+}
+
+// SetNamespaceKey makes the secrets of namespace be encrypted with a new
+// data key wrapped by the KMS key keyRef references. Setting the same
+// reference again rotates the data key. An empty reference goes back to the
+// key of the server. Existing secrets are encrypted again with the new key.
+func (db *dbHandler) SetNamespaceKey(namespace, keyRef string) error {
+
+	ctx := context.Background()
+
+	if keyRef != "" {
+		_, _, err := kms.ParseKeyRef(keyRef)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	}
+
+	tx, client, nk, err := db.lockNamespaceKey(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	oldKey := []byte(db.key)
+	if nk != nil {
+		oldKey, err = db.unwrapKey(ctx, nk)
+		if err != nil {
+			return rollback(tx, err)
+		}
+	}
+	defer zero(oldKey)
+
+	newKey := []byte(db.key)
+	var wrapped []byte
+
+	if keyRef != "" {
+
+		newKey = make([]byte, 32)
+		_, err = io.ReadFull(rand.Reader, newKey)
+		if err != nil {
+			return rollback(tx, err)
+		}
+
+		kctx, cancel := context.WithTimeout(ctx, kmsTimeout)
+		wrapped, err = db.kms.Wrap(kctx, keyRef, newKey)
+		cancel()
+		if err != nil {
+			return rollback(tx, status.Errorf(codes.Unavailable, "can not wrap key with %s: %v", keyRef, err))
+		}
+
+	}
+	defer zero(newKey)
+
+	secrets, err := client.NamespaceSecret.
+		Query().
+		Where(namespacesecret.NsEQ(namespace)).
+		All(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	for _, bs := range secrets {
+
+		data, err := decryptData(oldKey, bs.Secret)
+		if err != nil {
+			return rollback(tx, fmt.Errorf("error decrypting secret %s: %v", bs.Name, err))
+		}
+
+		d, err := encryptData(newKey, data)
+		zero(data)
+		if err != nil {
+			return rollback(tx, fmt.Errorf("error encrypting secret %s: %v", bs.Name, err))
+		}
+
+		_, err = client.NamespaceSecret.UpdateOne(bs).SetSecret(d).Save(ctx)
+		if err != nil {
+			return rollback(tx, err)
+		}
+
+	}
+
+	switch {
+	case keyRef == "" && nk != nil:
+		err = client.NamespaceKey.DeleteOne(nk).Exec(ctx)
+	case keyRef == "":
+	case nk != nil:
+		_, err = client.NamespaceKey.UpdateOne(nk).
+			SetKeyRef(keyRef).
+			SetDataKey(wrapped).
+			SetRotated(time.Now()).
+			Save(ctx)
+	default:
+		_, err = client.NamespaceKey.Create().
+			SetNs(namespace).
+			SetKeyRef(keyRef).
+			SetDataKey(wrapped).
+			SetRotated(time.Now()).
+			Save(ctx)
+	}
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	err = tx.Commit()
+
+	db.keys.drop(namespace)
+
+	return err
+
+}
+
+// GetNamespaceKey returns the KMS key reference of namespace and when its
+// data key was last rotated, or an empty reference if it has no key.
+func (db *dbHandler) GetNamespaceKey(namespace string) (string, time.Time, error) {
+
+	nk, err := db.db.NamespaceKey.
+		Query().
+		Where(namespacekey.NsEQ(namespace)).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", time.Time{}, nil
+		}
+		return "", time.Time{}, err
+	}
+
+	return nk.KeyRef, nk.Rotated, nil
+
+}
+
+// lockNamespaceKey begins a transaction in which the data key of namespace
+// can't change until it ends, and returns an ent client running in it and
+// the namespace's key, or nil if it uses the key of the server. The key row
+// is locked with SELECT ... FOR UPDATE, which ent can't express. Namespaces
+// without one only have the lock on their name to serialize on, after which
+// the row is looked for again in case it was created in the meantime.
+func (db *dbHandler) lockNamespaceKey(ctx context.Context, namespace string) (*sql.Tx, *ent.Client, *ent.NamespaceKey, error) {
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s = $1 FOR UPDATE",
+		namespacekey.FieldID, namespacekey.Table, namespacekey.FieldNs)
+
+	var id int
+	err = tx.QueryRowContext(ctx, query, namespace).Scan(&id)
+	if err == sql.ErrNoRows {
+		_, err = tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", "secrets/"+namespace)
+		if err == nil {
+			err = tx.QueryRowContext(ctx, query, namespace).Scan(&id)
+		}
+	}
+	if err != nil && err != sql.ErrNoRows {
+		return nil, nil, nil, rollback(tx, err)
+	}
+
+	client := ent.NewClient(ent.Driver(txDriver{entsql.Conn{ExecQuerier: tx}}))
+
+	nk, err := client.NamespaceKey.
+		Query().
+		Where(namespacekey.NsEQ(namespace)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, nil, nil, rollback(tx, err)
+	}
+
+	return tx, client, nk, nil
+
+}
+
+// txDriver runs an ent client in a transaction begun with database/sql, so
+// that it can share it with statements ent can't express.
+type txDriver struct {
+	entsql.Conn
+}
+
+func (txDriver) Dialect() string {
+	return dialect.Postgres
+}
+
+func (d txDriver) Tx(context.Context) (dialect.Tx, error) {
+	return dialect.NopTx(d), nil
+}
+
+func (txDriver) Close() error {
+	return nil
+}
+
+func rollback(tx *sql.Tx, err error) error {
+
+	rerr := tx.Rollback()
+	if rerr != nil {
+		log.Errorf("can not rollback: %v", rerr)
+	}
 
 	return err
 
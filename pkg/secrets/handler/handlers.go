@@ -3,6 +3,7 @@ package handler
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 type SecretType string
@@ -25,6 +26,8 @@ type SecretsHandler interface {
 	RemoveSecrets(namespace string) error
 	GetSecret(namespace, name string) ([]byte, error)
 	GetSecrets(namespace string) ([]string, error)
+	SetNamespaceKey(namespace, keyRef string) error
+	GetNamespaceKey(namespace string) (string, time.Time, error)
 }
 
 func (x *SecretType) Setup() (SecretsHandler, error) {
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"bytes"
+	"sync"
+	"time"
+)
+
+// keyCache holds the unwrapped data keys of namespaces for a while, so that
+// reading a secret doesn't take a round trip to the KMS every time. A key is
+// only handed out while the wrapped key it came from is still the current
+// one of its namespace, so rotations done by other servers are picked up.
+// Keys are zeroed once they are dropped.
+type keyCache struct {
+	mtx  sync.Mutex
+	ttl  time.Duration
+	max  int
+	keys map[string]*cachedKey
+}
+
+type cachedKey struct {
+	wrapped []byte
+	key     []byte
+	expires time.Time
+}
+
+func newKeyCache(ttl time.Duration, max int) *keyCache {
+	return &keyCache{
+		ttl:  ttl,
+		max:  max,
+		keys: make(map[string]*cachedKey),
+	}
+}
+
+// get returns a copy of the cached data key of namespace if it was
+// unwrapped from wrapped. The caller zeroes it when done.
+func (c *keyCache) get(namespace string, wrapped []byte) []byte {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.sweep(time.Now())
+
+	ck, ok := c.keys[namespace]
+	if !ok {
+		return nil
+	}
+
+	if !bytes.Equal(ck.wrapped, wrapped) {
+		c.remove(namespace)
+		return nil
+	}
+
+	return append([]byte(nil), ck.key...)
+
+}
+
+// put caches a copy of the data key of namespace unwrapped from wrapped.
+func (c *keyCache) put(namespace string, wrapped, key []byte) {
+
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	now := time.Now()
+	c.sweep(now)
+	c.remove(namespace)
+
+	// make room by dropping the key closest to expiring
+	if len(c.keys) >= c.max {
+		var oldest string
+		for ns, ck := range c.keys {
+			if oldest == "" || ck.expires.Before(c.keys[oldest].expires) {
+				oldest = ns
+			}
+		}
+		c.remove(oldest)
+	}
+
+	c.keys[namespace] = &cachedKey{
+		wrapped: append([]byte(nil), wrapped...),
+		key:     append([]byte(nil), key...),
+		expires: now.Add(c.ttl),
+	}
+
+}
+
+// drop forgets the data key of namespace.
+func (c *keyCache) drop(namespace string) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.remove(namespace)
+
+}
+
+func (c *keyCache) remove(namespace string) {
+
+	ck, ok := c.keys[namespace]
+	if !ok {
+		return
+	}
+
+	zero(ck.key)
+	delete(c.keys, namespace)
+
+}
+
+func (c *keyCache) sweep(now time.Time) {
+
+	for ns, ck := range c.keys {
+		if !now.Before(ck.expires) {
+			c.remove(ns)
+		}
+	}
+
+}
+
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestKeyCache(t *testing.T) {
+
+	c := newKeyCache(time.Minute, 2)
+
+	wrapped := []byte("wrapped")
+	key := []byte("0123456789abcdef0123456789abcdef")
+
+	if k := c.get("ns", wrapped); k != nil {
+		t.Fatalf("empty cache returned a key")
+	}
+
+	c.put("ns", wrapped, key)
+
+	k := c.get("ns", wrapped)
+	if !bytes.Equal(k, key) {
+		t.Fatalf("unexpected key %q", k)
+	}
+
+	// callers zero what they get, which mustn't reach the cache
+	zero(k)
+	if k := c.get("ns", wrapped); !bytes.Equal(k, key) {
+		t.Fatalf("key changed after the caller zeroed its copy: %q", k)
+	}
+
+	// a rotation elsewhere means the cached key is stale
+	if k := c.get("ns", []byte("rotated")); k != nil {
+		t.Fatalf("key returned for a different wrapped key")
+	}
+	if k := c.get("ns", wrapped); k != nil {
+		t.Fatalf("stale key wasn't dropped")
+	}
+
+}
+
+func TestKeyCacheDisabled(t *testing.T) {
+
+	c := newKeyCache(0, 2)
+	c.put("ns", []byte("wrapped"), []byte("key"))
+
+	if k := c.get("ns", []byte("wrapped")); k != nil {
+		t.Fatalf("cache with no ttl returned a key")
+	}
+
+}
+
+func TestKeyCacheExpiry(t *testing.T) {
+
+	c := newKeyCache(time.Minute, 2)
+	c.put("ns", []byte("wrapped"), []byte("key"))
+
+	ck := c.keys["ns"]
+	ck.expires = time.Now().Add(-time.Second)
+
+	if k := c.get("ns", []byte("wrapped")); k != nil {
+		t.Fatalf("expired key returned")
+	}
+
+	if !bytes.Equal(ck.key, []byte{0, 0, 0}) {
+		t.Fatalf("expired key wasn't zeroed: %q", ck.key)
+	}
+
+}
+
+func TestKeyCacheEviction(t *testing.T) {
+
+	c := newKeyCache(time.Minute, 2)
+
+	c.put("a", []byte("a"), []byte("a"))
+	c.put("b", []byte("b"), []byte("b"))
+	c.keys["a"].expires = time.Now().Add(time.Second)
+
+	first := c.keys["a"]
+	c.put("c", []byte("c"), []byte("c"))
+
+	if len(c.keys) != 2 {
+		t.Fatalf("cache holds %d keys, expected 2", len(c.keys))
+	}
+
+	if k := c.get("a", []byte("a")); k != nil {
+		t.Fatalf("key closest to expiring wasn't evicted")
+	}
+
+	if first.key[0] != 0 {
+		t.Fatalf("evicted key wasn't zeroed")
+	}
+
+	for _, ns := range []string{"b", "c"} {
+		if k := c.get(ns, []byte(ns)); !bytes.Equal(k, []byte(ns)) {
+			t.Fatalf("key of %s missing after eviction", ns)
+		}
+	}
+
+}
+
+func TestKeyCacheDrop(t *testing.T) {
+
+	c := newKeyCache(time.Minute, 2)
+	c.put("ns", []byte("wrapped"), []byte("key"))
+
+	ck := c.keys["ns"]
+	c.drop("ns")
+
+	if k := c.get("ns", []byte("wrapped")); k != nil {
+		t.Fatalf("dropped key returned")
+	}
+
+	if !bytes.Equal(ck.key, []byte{0, 0, 0}) {
+		t.Fatalf("dropped key wasn't zeroed: %q", ck.key)
+	}
+
+}
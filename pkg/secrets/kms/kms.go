@@ -0,0 +1,116 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Provider wraps and unwraps data keys with the master keys of a key
+// management service. Master keys never leave the provider.
+type Provider interface {
+	Wrap(ctx context.Context, key string, dataKey []byte) ([]byte, error)
+	Unwrap(ctx context.Context, key string, wrapped []byte) ([]byte, error)
+}
+
+// ProviderInstantiator creates a provider from its environment.
+type ProviderInstantiator func() (Provider, error)
+
+var (
+	providers = map[string]ProviderInstantiator{
+		"local":   newLocalProvider,
+		"transit": newTransitProvider,
+	}
+)
+
+// RegisterProvider adds a provider that key references can name.
+func RegisterProvider(name string, fn ProviderInstantiator) error {
+
+	if _, exists := providers[name]; exists {
+		return fmt.Errorf("refusing to register kms provider '%s': already registered", name)
+	}
+
+	providers[name] = fn
+	return nil
+
+}
+
+// ParseKeyRef splits a key reference of the form provider:key, e.g.
+// transit:payments, into the provider and the name of its master key.
+func ParseKeyRef(ref string) (string, string, error) {
+
+	idx := strings.Index(ref, ":")
+	if idx <= 0 || idx == len(ref)-1 {
+		return "", "", fmt.Errorf("key reference '%s' isn't of the form provider:key", ref)
+	}
+
+	provider := ref[:idx]
+	if _, ok := providers[provider]; !ok {
+		return "", "", fmt.Errorf("unknown kms provider '%s'", provider)
+	}
+
+	return provider, ref[idx+1:], nil
+
+}
+
+// Manager wraps and unwraps data keys with the master key a reference names,
+// setting up each provider the first time it is used.
+type Manager struct {
+	mtx       sync.Mutex
+	providers map[string]Provider
+}
+
+// NewManager creates a manager for the registered providers.
+func NewManager() *Manager {
+	return &Manager{
+		providers: make(map[string]Provider),
+	}
+}
+
+func (m *Manager) provider(ref string) (Provider, string, error) {
+
+	name, key, err := ParseKeyRef(ref)
+	if err != nil {
+		return nil, "", err
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	p, ok := m.providers[name]
+	if !ok {
+		p, err = providers[name]()
+		if err != nil {
+			return nil, "", fmt.Errorf("can not set up kms provider '%s': %v", name, err)
+		}
+		m.providers[name] = p
+	}
+
+	return p, key, nil
+
+}
+
+// Wrap encrypts dataKey with the master key ref names.
+func (m *Manager) Wrap(ctx context.Context, ref string, dataKey []byte) ([]byte, error) {
+
+	p, key, err := m.provider(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Wrap(ctx, key, dataKey)
+
+}
+
+// Unwrap decrypts a data key wrapped with the master key ref names.
+func (m *Manager) Unwrap(ctx context.Context, ref string, wrapped []byte) ([]byte, error) {
+
+	p, key, err := m.provider(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Unwrap(ctx, key, wrapped)
+
+}
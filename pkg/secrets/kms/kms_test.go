@@ -0,0 +1,243 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+// setenv sets an environment variable for the rest of the test.
+func setenv(t *testing.T, key, value string) {
+
+	old, ok := os.LookupEnv(key)
+	os.Setenv(key, value)
+
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+
+}
+
+func testKey(b byte) string {
+	return base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, 32))
+}
+
+func TestParseKeyRef(t *testing.T) {
+
+	tests := []struct {
+		ref      string
+		provider string
+		key      string
+		ok       bool
+	}{
+		{"transit:payments", "transit", "payments", true},
+		{"local:a:b", "local", "a:b", true},
+		{"payments", "", "", false},
+		{":payments", "", "", false},
+		{"local:", "", "", false},
+		{"unknown:payments", "", "", false},
+	}
+
+	for _, test := range tests {
+
+		provider, key, err := ParseKeyRef(test.ref)
+		if (err == nil) != test.ok {
+			t.Errorf("%s: unexpected error %v", test.ref, err)
+			continue
+		}
+
+		if provider != test.provider || key != test.key {
+			t.Errorf("%s: got %s, %s", test.ref, provider, key)
+		}
+
+	}
+
+}
+
+func TestLocalProviderEnv(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		value string
+		ok    bool
+	}{
+		{"unset", "", false},
+		{"valid", "a=" + testKey(1) + ", b=" + testKey(2), true},
+		{"no name", testKey(1), false},
+		{"not base64", "a=%%%", false},
+		{"short", "a=" + base64.StdEncoding.EncodeToString([]byte("short")), false},
+	}
+
+	for _, test := range tests {
+
+		setenv(t, localKeys, test.value)
+
+		_, err := newLocalProvider()
+		if (err == nil) != test.ok {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+		}
+
+	}
+
+}
+
+func TestLocalProvider(t *testing.T) {
+
+	setenv(t, localKeys, "a="+testKey(1)+",b="+testKey(2))
+
+	p, err := newLocalProvider()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	dataKey := []byte("0123456789abcdef0123456789abcdef")
+
+	wrapped, err := p.Wrap(ctx, "a", dataKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Contains(wrapped, dataKey) {
+		t.Fatalf("wrapped key contains the data key")
+	}
+
+	k, err := p.Unwrap(ctx, "a", wrapped)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(k, dataKey) {
+		t.Fatalf("unexpected data key %q", k)
+	}
+
+	if _, err := p.Unwrap(ctx, "b", wrapped); err == nil {
+		t.Errorf("unwrapped with the wrong master key")
+	}
+
+	if _, err := p.Wrap(ctx, "c", dataKey); err == nil {
+		t.Errorf("wrapped with an unknown master key")
+	}
+
+	tampered := append([]byte(nil), wrapped...)
+	tampered[len(tampered)-1] ^= 1
+	if _, err := p.Unwrap(ctx, "a", tampered); err == nil {
+		t.Errorf("unwrapped a tampered key")
+	}
+
+	if _, err := p.Unwrap(ctx, "a", wrapped[:4]); err == nil {
+		t.Errorf("unwrapped a truncated key")
+	}
+
+}
+
+func TestTransitProvider(t *testing.T) {
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Header.Get("X-Vault-Token") != "token" {
+			http.Error(w, "permission denied", http.StatusForbidden)
+			return
+		}
+
+		var in map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var data map[string]string
+
+		switch r.URL.Path {
+		case "/v1/kms/encrypt/payments":
+			data = map[string]string{"ciphertext": "vault:v1:" + in["plaintext"]}
+		case "/v1/kms/decrypt/payments":
+			data = map[string]string{"plaintext": in["ciphertext"][len("vault:v1:"):]}
+		default:
+			http.Error(w, "no handler for route", http.StatusNotFound)
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+
+	}))
+	defer srv.Close()
+
+	setenv(t, transitAddress, srv.URL+"/")
+	setenv(t, transitToken, "token")
+	setenv(t, transitMount, "kms")
+
+	p, err := newTransitProvider()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	dataKey := []byte("0123456789abcdef0123456789abcdef")
+
+	wrapped, err := p.Wrap(ctx, "payments", dataKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	k, err := p.Unwrap(ctx, "payments", wrapped)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(k, dataKey) {
+		t.Fatalf("unexpected data key %q", k)
+	}
+
+	if _, err := p.Wrap(ctx, "missing", dataKey); err == nil {
+		t.Errorf("no error for a vault error response")
+	}
+
+	setenv(t, transitToken, "")
+	if _, err := newTransitProvider(); err == nil {
+		t.Errorf("set up without a token")
+	}
+
+}
+
+func TestManager(t *testing.T) {
+
+	setenv(t, localKeys, "a="+testKey(1))
+
+	m := NewManager()
+	ctx := context.Background()
+	dataKey := []byte("0123456789abcdef0123456789abcdef")
+
+	wrapped, err := m.Wrap(ctx, "local:a", dataKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	k, err := m.Unwrap(ctx, "local:a", wrapped)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(k, dataKey) {
+		t.Fatalf("unexpected data key %q", k)
+	}
+
+	if _, err := m.Wrap(ctx, "nope:a", dataKey); err == nil {
+		t.Errorf("wrapped with an unknown provider")
+	}
+
+	setenv(t, transitAddress, "")
+	if _, err := m.Wrap(ctx, "transit:a", dataKey); err == nil {
+		t.Errorf("wrapped with an unconfigured provider")
+	}
+
+}
@@ -0,0 +1,110 @@
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+/* #nosec */
+const localKeys = "DIREKTIV_SECRETS_KMS_LOCAL_KEYS"
+
+// localProvider wraps data keys with master keys from its environment, as
+// name=base64key,name2=base64key. The keys are 32 bytes for AES-256. It is
+// meant for setups without a key management service.
+type localProvider struct {
+	keys map[string][]byte
+}
+
+func newLocalProvider() (Provider, error) {
+
+	p := &localProvider{
+		keys: make(map[string][]byte),
+	}
+
+	v := os.Getenv(localKeys)
+	if len(v) == 0 {
+		return nil, fmt.Errorf("%s is not set", localKeys)
+	}
+
+	for _, s := range strings.Split(v, ",") {
+
+		idx := strings.Index(s, "=")
+		if idx < 0 {
+			// don't print it, it could be a key
+			return nil, fmt.Errorf("can not parse %s, expected name=base64key", localKeys)
+		}
+
+		name := strings.TrimSpace(s[:idx])
+
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s[idx+1:]))
+		if err != nil {
+			return nil, fmt.Errorf("can not decode local key %s: %v", name, err)
+		}
+
+		if len(key) != 32 {
+			return nil, fmt.Errorf("local key %s needs to be 32 bytes", name)
+		}
+
+		p.keys[name] = key
+
+	}
+
+	return p, nil
+
+}
+
+func (p *localProvider) aead(key string) (cipher.AEAD, error) {
+
+	k, ok := p.keys[key]
+	if !ok {
+		return nil, fmt.Errorf("local key '%s' not found", key)
+	}
+
+	block, err := aes.NewCipher(k)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+
+}
+
+func (p *localProvider) Wrap(ctx context.Context, key string, dataKey []byte) ([]byte, error) {
+
+	gcm, err := p.aead(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, dataKey, []byte(key)), nil
+
+}
+
+func (p *localProvider) Unwrap(ctx context.Context, key string, wrapped []byte) ([]byte, error) {
+
+	gcm, err := p.aead(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wrapped) < gcm.NonceSize() {
+		return nil, fmt.Errorf("wrapped key is too short")
+	}
+
+	nonce := wrapped[:gcm.NonceSize()]
+	return gcm.Open(nil, nonce, wrapped[gcm.NonceSize():], []byte(key))
+
+}
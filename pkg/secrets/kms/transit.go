@@ -0,0 +1,130 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+/* #nosec */
+const (
+	transitAddress = "DIREKTIV_SECRETS_KMS_VAULT"
+	transitToken   = "DIREKTIV_SECRETS_KMS_VAULT_TOKEN"
+	transitMount   = "DIREKTIV_SECRETS_KMS_VAULT_MOUNT"
+)
+
+// transitProvider wraps data keys with the transit secrets engine of a
+// Vault server. Vault keeps every version of a rotated master key, so keys
+// wrapped before a rotation can still be unwrapped.
+type transitProvider struct {
+	address string
+	token   string
+	mount   string
+	client  *http.Client
+}
+
+func newTransitProvider() (Provider, error) {
+
+	p := &transitProvider{
+		address: strings.TrimSuffix(os.Getenv(transitAddress), "/"),
+		token:   os.Getenv(transitToken),
+		mount:   os.Getenv(transitMount),
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+
+	if p.address == "" || p.token == "" {
+		return nil, fmt.Errorf("%s and %s have to be set", transitAddress, transitToken)
+	}
+
+	if p.mount == "" {
+		p.mount = "transit"
+	}
+
+	return p, nil
+
+}
+
+func (p *transitProvider) do(ctx context.Context, op, key string, in, out interface{}) error {
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	u := fmt.Sprintf("%s/v1/%s/%s/%s", p.address, p.mount, op, url.PathEscape(key))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Vault-Token", p.token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("vault responded to %s with %s: %s", op, resp.Status, bytes.TrimSpace(msg))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+
+}
+
+func (p *transitProvider) Wrap(ctx context.Context, key string, dataKey []byte) ([]byte, error) {
+
+	var out struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+
+	err := p.do(ctx, "encrypt", key, map[string]string{
+		"plaintext": base64.StdEncoding.EncodeToString(dataKey),
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Data.Ciphertext == "" {
+		return nil, fmt.Errorf("vault returned no ciphertext")
+	}
+
+	return []byte(out.Data.Ciphertext), nil
+
+}
+
+func (p *transitProvider) Unwrap(ctx context.Context, key string, wrapped []byte) ([]byte, error) {
+
+	var out struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+
+	err := p.do(ctx, "decrypt", key, map[string]string{
+		"ciphertext": string(wrapped),
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(out.Data.Plaintext)
+
+}
@@ -8,6 +8,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/vorteil/direktiv/pkg/direktiv"
 	secretsgrpc "github.com/vorteil/direktiv/pkg/secrets/grpc"
@@ -156,3 +157,41 @@ func (s *Server) DeleteSecrets(ctx context.Context, in *secretsgrpc.DeleteSecret
 	return &resp, s.handler.RemoveSecrets(in.GetNamespace())
 
 }
+
+// SetNamespaceKey sets, rotates or removes the KMS key the secrets of a
+// namespace are encrypted with
+func (s *Server) SetNamespaceKey(ctx context.Context, in *secretsgrpc.SetNamespaceKeyRequest) (*empty.Empty, error) {
+
+	var resp emptypb.Empty
+
+	if in.GetNamespace() == "" {
+		return &resp, fmt.Errorf("namespace is required")
+	}
+
+	return &resp, s.handler.SetNamespaceKey(in.GetNamespace(), in.GetKeyRef())
+
+}
+
+// GetNamespaceKey returns the KMS key the secrets of a namespace are
+// encrypted with
+func (s *Server) GetNamespaceKey(ctx context.Context, in *secretsgrpc.GetNamespaceKeyRequest) (*secretsgrpc.GetNamespaceKeyResponse, error) {
+
+	var resp secretsgrpc.GetNamespaceKeyResponse
+
+	if in.GetNamespace() == "" {
+		return &resp, fmt.Errorf("namespace is required")
+	}
+
+	ref, rotated, err := s.handler.GetNamespaceKey(in.GetNamespace())
+	if err != nil {
+		return &resp, err
+	}
+
+	resp.KeyRef = &ref
+	if ref != "" {
+		resp.Rotated = timestamppb.New(rotated)
+	}
+
+	return &resp, nil
+
+}
@@ -0,0 +1,149 @@
+package sigv4
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Test vectors from the AWS Signature Version 4 test suite.
+func TestSign(t *testing.T) {
+
+	creds := Credentials{
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+	}
+
+	now := time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		method      string
+		url         string
+		contentType string
+		body        string
+		expected    string
+	}{
+		{
+			name:     "get-vanilla",
+			method:   http.MethodGet,
+			url:      "https://example.amazonaws.com/",
+			expected: "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+		},
+		{
+			name:     "post-vanilla",
+			method:   http.MethodPost,
+			url:      "https://example.amazonaws.com/",
+			expected: "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5da7c1a2acd57cee7505fc6676e4e544621c30862966e37dddb68e92efbe5d6b",
+		},
+		{
+			name:     "get-vanilla-empty-query-key",
+			method:   http.MethodGet,
+			url:      "https://example.amazonaws.com/?Param1=value1",
+			expected: "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=a67d582fa61cc504c4bae71f336f98b97f1ea3c7a6bfe1b6e45aec72011b9aeb",
+		},
+		{
+			name:        "post-x-www-form-urlencoded",
+			method:      http.MethodPost,
+			url:         "https://example.amazonaws.com/",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "Param1=value1",
+			expected:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=content-type;host;x-amz-date, Signature=ff11897932ad3f4e8b18135d722051e5ac45fc38421b1da7b9d196a0fe09473a",
+		},
+	}
+
+	for _, test := range tests {
+
+		req, err := http.NewRequest(test.method, test.url, strings.NewReader(test.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if test.contentType != "" {
+			req.Header.Set("Content-Type", test.contentType)
+		}
+
+		Sign(req, creds, "us-east-1", "service", []byte(test.body), now)
+
+		if v := req.Header.Get("Authorization"); v != test.expected {
+			t.Errorf("%s: unexpected authorization\n got: %s\nwant: %s", test.name, v, test.expected)
+		}
+
+	}
+
+}
+
+func TestSignSessionToken(t *testing.T) {
+
+	creds := Credentials{
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+		SessionToken:    "token",
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Sign(req, creds, "us-east-1", "service", nil, time.Now())
+
+	if v := req.Header.Get("X-Amz-Security-Token"); v != "token" {
+		t.Errorf("unexpected security token header: %s", v)
+	}
+
+	if v := req.Header.Get("Authorization"); !strings.Contains(v, "SignedHeaders=host;x-amz-date;x-amz-security-token,") {
+		t.Errorf("security token isn't signed: %s", v)
+	}
+
+}
+
+func TestSignPath(t *testing.T) {
+
+	creds := Credentials{
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+	}
+
+	now := time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+
+	sign := func(path string) string {
+		req, err := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.URL.Path = path
+		Sign(req, creds, "us-east-1", "service", nil, now)
+		return req.Header.Get("Authorization")
+	}
+
+	// an empty path is signed as the root
+	if a, b := sign(""), sign("/"); a != b {
+		t.Errorf("empty path signed differently from root:\n%s\n%s", a, b)
+	}
+
+	// the path is encoded again, so escapes in it are signed escaped
+	if a, b := sign("/a b"), sign("/a%20b"); a == b {
+		t.Errorf("escaped and unescaped paths signed the same: %s", a)
+	}
+
+}
+
+func TestURIEncode(t *testing.T) {
+
+	tests := map[string]string{
+		"abc-_.~XYZ019": "abc-_.~XYZ019",
+		"a b":           "a%20b",
+		"a%20b":         "a%2520b",
+		"fn:alias":      "fn%3Aalias",
+		"é":             "%C3%A9",
+	}
+
+	for in, expected := range tests {
+		if v := URIEncode(in); v != expected {
+			t.Errorf("%s: got %s, want %s", in, v, expected)
+		}
+	}
+
+}